@@ -13,7 +13,9 @@ func queueCommand() *cli.Command {
 		Commands: []*cli.Command{
 			queue.PartitionCommand(),
 			queue.ItemCommand(),
+			queue.ItemsCommand(),
 			queue.BacklogCommand(),
+			queue.ShardCommand(),
 		},
 	}
 }
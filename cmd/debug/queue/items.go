@@ -0,0 +1,211 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inngest/inngest/pkg/cli/output"
+	debugpkg "github.com/inngest/inngest/pkg/debug"
+	pb "github.com/inngest/inngest/proto/gen/debug/v1"
+	"github.com/urfave/cli/v3"
+)
+
+func ItemsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "items",
+		Usage: "List, requeue and purge the items of a partition or backlog",
+		Commands: []*cli.Command{
+			itemsListCommand(),
+			itemsRequeueCommand(),
+			itemsPurgeCommand(),
+		},
+	}
+}
+
+// itemsFilterFlags returns the flags used to select items within a partition or backlog.
+func itemsFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "partition-id",
+			Usage: "Partition to select items from (function ID or system queue name)",
+		},
+		&cli.StringFlag{
+			Name:  "backlog-id",
+			Usage: "Key queue backlog to select items from (does not work with --partition-id)",
+		},
+		&cli.StringFlag{
+			Name:  "account-id",
+			Usage: "Account UUID that owns the partition",
+		},
+		&cli.StringFlag{
+			Name:  "env-id",
+			Usage: "Environment UUID that owns the partition",
+		},
+		&cli.StringFlag{
+			Name:    "queue-shard",
+			Aliases: []string{"qs"},
+			Usage:   "The queue shard to specify",
+		},
+		&cli.StringSliceFlag{
+			Name:  "kind",
+			Usage: "Only select items of this kind, eg. start or edge (can be repeated)",
+		},
+		&cli.StringFlag{
+			Name:  "run-id",
+			Usage: "Only select items for this run ID",
+		},
+		&cli.DurationFlag{
+			Name:  "older-than",
+			Usage: "Only select items enqueued at least this long ago, eg. 1h",
+		},
+	}
+}
+
+func itemsFilter(cmd *cli.Command) (*pb.QueueItemsFilter, error) {
+	partitionID := cmd.String("partition-id")
+	backlogID := cmd.String("backlog-id")
+
+	if partitionID == "" && backlogID == "" {
+		return nil, fmt.Errorf("either --partition-id or --backlog-id is required")
+	}
+	if partitionID != "" && backlogID != "" {
+		return nil, fmt.Errorf("--partition-id and --backlog-id are mutually exclusive")
+	}
+
+	return &pb.QueueItemsFilter{
+		PartitionId: partitionID,
+		BacklogId:   backlogID,
+		AccountId:   cmd.String("account-id"),
+		EnvId:       cmd.String("env-id"),
+		QueueShard:  cmd.String("queue-shard"),
+		Kinds:       cmd.StringSlice("kind"),
+		RunId:       cmd.String("run-id"),
+		MinAgeMs:    cmd.Duration("older-than").Milliseconds(),
+	}, nil
+}
+
+func itemsListCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "list",
+		Aliases: []string{"ls"},
+		Usage:   "List the unleased items of a partition or backlog",
+		Flags: append(itemsFilterFlags(),
+			&cli.IntFlag{
+				Name:  "limit",
+				Usage: "Maximum number of items to return",
+				Value: 100,
+			},
+			&cli.StringFlag{
+				Name:  "cursor",
+				Usage: "Cursor returned by a previous list to fetch the next page",
+			},
+		),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			filter, err := itemsFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			dbgCtx, ok := ctx.Value(debugpkg.CtxKey).(*debugpkg.Context)
+			if !ok {
+				return fmt.Errorf("debug context not found")
+			}
+
+			resp, err := dbgCtx.Client.ListQueueItems(ctx, &pb.QueueItemsRequest{
+				Filter: filter,
+				Limit:  int64(cmd.Int("limit")),
+				Cursor: cmd.String("cursor"),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list queue items: %w", err)
+			}
+
+			return output.TextQueueItemList(resp)
+		},
+	}
+}
+
+func itemsRequeueCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "requeue",
+		Usage:     "Requeue items to run now, or after --delay. Leased items are skipped",
+		ArgsUsage: "<item-id> [item-id...]",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "delay",
+				Usage: "Schedule the items this far in the future instead of now",
+			},
+			&cli.StringFlag{
+				Name:    "queue-shard",
+				Aliases: []string{"qs"},
+				Usage:   "The queue shard to specify",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() == 0 {
+				return fmt.Errorf("at least one item ID is required")
+			}
+			if cmd.Duration("delay") < 0 {
+				return fmt.Errorf("--delay must not be negative")
+			}
+
+			dbgCtx, ok := ctx.Value(debugpkg.CtxKey).(*debugpkg.Context)
+			if !ok {
+				return fmt.Errorf("debug context not found")
+			}
+
+			resp, err := dbgCtx.Client.RequeueQueueItems(ctx, &pb.RequeueQueueItemsRequest{
+				QueueShard: cmd.String("queue-shard"),
+				ItemIds:    cmd.Args().Slice(),
+				DelayMs:    cmd.Duration("delay").Milliseconds(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to requeue queue items: %w", err)
+			}
+
+			return output.TextQueueItemResults(resp)
+		},
+	}
+}
+
+func itemsPurgeCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "purge",
+		Usage: "Remove the unleased items of a partition or backlog matching the filters",
+		Flags: append(itemsFilterFlags(),
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "Show the items that would be removed without removing them",
+			},
+			&cli.IntFlag{
+				Name:  "limit",
+				Usage: "Maximum number of items to remove",
+				Value: 1000,
+			},
+		),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			filter, err := itemsFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			dryRun := cmd.Bool("dry-run")
+
+			dbgCtx, ok := ctx.Value(debugpkg.CtxKey).(*debugpkg.Context)
+			if !ok {
+				return fmt.Errorf("debug context not found")
+			}
+
+			resp, err := dbgCtx.Client.PurgeQueueItems(ctx, &pb.PurgeQueueItemsRequest{
+				Filter: filter,
+				DryRun: dryRun,
+				Limit:  int64(cmd.Int("limit")),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to purge queue items: %w", err)
+			}
+
+			return output.TextQueueItemPurge(resp, dryRun)
+		},
+	}
+}
@@ -0,0 +1,194 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inngest/inngest/pkg/cli/output"
+	debugpkg "github.com/inngest/inngest/pkg/debug"
+	pb "github.com/inngest/inngest/proto/gen/debug/v1"
+	"github.com/urfave/cli/v3"
+)
+
+func ShardCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "shard",
+		Usage: "Move accounts and functions between queue shards",
+		Commands: []*cli.Command{
+			shardMigrateCommand(),
+			shardStatusCommand(),
+			shardListCommand(),
+			shardCancelCommand(),
+			shardRollbackCommand(),
+		},
+	}
+}
+
+func shardWaitFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "wait",
+		Usage: "Wait for the migration to finish, printing its progress",
+	}
+}
+
+func shardMigrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "Move an account or function to another queue shard while the queue keeps running",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "account-id",
+				Usage: "Account UUID to migrate",
+			},
+			&cli.StringFlag{
+				Name:  "env-id",
+				Usage: "Environment UUID that owns the function",
+			},
+			&cli.StringFlag{
+				Name:  "function-id",
+				Usage: "Only migrate this function UUID",
+			},
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "The queue shard to move items from (defaults to the current shard)",
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Usage:    "The queue shard to move items to",
+				Required: true,
+			},
+			&cli.DurationFlag{
+				Name:  "drain-timeout",
+				Usage: "How long to wait for leased items on the source shard to be released",
+			},
+			shardWaitFlag(),
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			dbgCtx, ok := ctx.Value(debugpkg.CtxKey).(*debugpkg.Context)
+			if !ok {
+				return fmt.Errorf("debug context not found")
+			}
+
+			m, err := dbgCtx.Client.StartShardMigration(ctx, &pb.StartShardMigrationRequest{
+				AccountId:      cmd.String("account-id"),
+				EnvId:          cmd.String("env-id"),
+				FunctionId:     cmd.String("function-id"),
+				SourceShard:    cmd.String("from"),
+				TargetShard:    cmd.String("to"),
+				DrainTimeoutMs: cmd.Duration("drain-timeout").Milliseconds(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to start shard migration: %w", err)
+			}
+
+			return shardMigrationOutput(ctx, dbgCtx, m, cmd.Bool("wait"))
+		},
+	}
+}
+
+func shardStatusCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "status",
+		Usage:     "Show the progress of a shard migration",
+		ArgsUsage: "<migration-id>",
+		Flags:     []cli.Flag{shardWaitFlag()},
+		Action: shardMigrationAction(func(ctx context.Context, c *debugpkg.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error) {
+			return c.Client.GetShardMigration(ctx, req)
+		}),
+	}
+}
+
+func shardListCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "list",
+		Aliases: []string{"ls"},
+		Usage:   "List shard migrations",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			dbgCtx, ok := ctx.Value(debugpkg.CtxKey).(*debugpkg.Context)
+			if !ok {
+				return fmt.Errorf("debug context not found")
+			}
+
+			resp, err := dbgCtx.Client.ListShardMigrations(ctx, &pb.ShardMigrationsRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list shard migrations: %w", err)
+			}
+
+			return output.TextShardMigrationList(resp)
+		},
+	}
+}
+
+func shardCancelCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "cancel",
+		Usage:     "Stop a running shard migration. Moved items stay on the target shard",
+		ArgsUsage: "<migration-id>",
+		Action: shardMigrationAction(func(ctx context.Context, c *debugpkg.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error) {
+			return c.Client.CancelShardMigration(ctx, req)
+		}),
+	}
+}
+
+func shardRollbackCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "rollback",
+		Usage:     "Move the account or function of a migration back to its source shard",
+		ArgsUsage: "<migration-id>",
+		Flags:     []cli.Flag{shardWaitFlag()},
+		Action: shardMigrationAction(func(ctx context.Context, c *debugpkg.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error) {
+			return c.Client.RollbackShardMigration(ctx, req)
+		}),
+	}
+}
+
+func shardMigrationAction(fn func(ctx context.Context, c *debugpkg.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error)) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		if cmd.NArg() != 1 {
+			return fmt.Errorf("a migration ID is required")
+		}
+
+		dbgCtx, ok := ctx.Value(debugpkg.CtxKey).(*debugpkg.Context)
+		if !ok {
+			return fmt.Errorf("debug context not found")
+		}
+
+		m, err := fn(ctx, dbgCtx, &pb.ShardMigrationRequest{Id: cmd.Args().First()})
+		if err != nil {
+			return fmt.Errorf("shard migration request failed: %w", err)
+		}
+
+		return shardMigrationOutput(ctx, dbgCtx, m, cmd.Bool("wait"))
+	}
+}
+
+// shardMigrationOutput prints the migration, polling until it finishes when
+// wait is set.
+func shardMigrationOutput(ctx context.Context, dbgCtx *debugpkg.Context, m *pb.ShardMigration, wait bool) error {
+	if err := output.TextShardMigration(m); err != nil {
+		return err
+	}
+
+	for wait && m.Status == "running" {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+
+		var err error
+		m, err = dbgCtx.Client.GetShardMigration(ctx, &pb.ShardMigrationRequest{Id: m.Id})
+		if err != nil {
+			return fmt.Errorf("failed to get shard migration: %w", err)
+		}
+		if err := output.TextShardMigration(m); err != nil {
+			return err
+		}
+	}
+
+	if m.Status == "failed" {
+		return fmt.Errorf("shard migration failed: %s", m.Error)
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package devserver
 
 import (
+	"time"
+
 	"github.com/inngest/inngest/pkg/api"
 	connectgrpc "github.com/inngest/inngest/pkg/connect/grpc"
 	"github.com/inngest/inngest/pkg/devserver"
+	"github.com/inngest/inngest/pkg/execution/driver/execdriver"
 	"github.com/urfave/cli/v3"
 )
 
@@ -106,6 +109,47 @@ func Command() *cli.Command {
 				Value:    connectgrpc.DefaultConnectGRPCIP,
 				Usage:    "IP address other instances use to reach the connect executor gRPC server",
 			},
+			&cli.StringSliceFlag{
+				Category: "Advanced",
+				Name:     "exec-dir",
+				Usage:    "Directories containing executables which may be run by exec:// functions",
+			},
+			&cli.StringSliceFlag{
+				Category: "Advanced",
+				Name:     "exec-env",
+				Usage:    "Environment variables passed to exec:// functions.  Functions otherwise only inherit PATH",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "exec-timeout",
+				Value:    int(execdriver.DefaultTimeout / time.Second),
+				Usage:    "Maximum time in seconds each exec:// function process may run",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "exec-max-memory-mb",
+				Usage:    "Maximum memory in MB for each exec:// function process.  Zero is unlimited",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "exec-max-cpu-seconds",
+				Usage:    "Maximum CPU time in seconds for each exec:// function process.  Zero is unlimited",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "grpc-ca-file",
+				Usage:    "PEM file of the CAs used to verify grpcs:// function servers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "grpc-cert-file",
+				Usage:    "PEM client certificate presented to grpcs:// function servers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "grpc-key-file",
+				Usage:    "PEM private key for grpc-cert-file",
+			},
 			&cli.StringFlag{
 				Category: "Advanced",
 				Name:     "sqlite-dir",
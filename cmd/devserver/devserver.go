@@ -14,6 +14,7 @@ import (
 	connectConfig "github.com/inngest/inngest/pkg/config/connect"
 	connectgrpc "github.com/inngest/inngest/pkg/connect/grpc"
 	"github.com/inngest/inngest/pkg/devserver"
+	"github.com/inngest/inngest/pkg/execution/driver/execdriver"
 	"github.com/inngest/inngest/pkg/headers"
 	"github.com/inngest/inngest/pkg/logger"
 	itrace "github.com/inngest/inngest/pkg/telemetry/trace"
@@ -66,6 +67,13 @@ func action(ctx context.Context, cmd *cli.Command) error {
 	tick := localconfig.GetIntValue(cmd, "tick", devserver.DefaultTick)
 	persist := localconfig.GetBoolValue(cmd, "persist", false)
 	sqliteDir := localconfig.GetValue(cmd, "sqlite-dir", "")
+	execDirs := localconfig.GetStringSlice(cmd, "exec-dir")
+	execEnv := localconfig.GetStringSlice(cmd, "exec-env")
+	execTimeout := time.Duration(localconfig.GetIntValue(cmd, "exec-timeout", int(execdriver.DefaultTimeout/time.Second))) * time.Second
+	execLimits := execdriver.Limits{
+		MaxMemoryBytes: int64(localconfig.GetIntValue(cmd, "exec-max-memory-mb", 0)) * 1024 * 1024,
+		MaxCPU:         time.Duration(localconfig.GetIntValue(cmd, "exec-max-cpu-seconds", 0)) * time.Second,
+	}
 
 	debugAPIPort := localconfig.GetIntValue(cmd, "debug-api-port", devserver.DefaultDebugAPIPort)
 
@@ -110,6 +118,13 @@ func action(ctx context.Context, cmd *cli.Command) error {
 		PostgresConnMaxIdleTime: postgresConnMaxIdleTime,
 		PostgresConnMaxLifetime: postgresConnMaxLifetime,
 		DebugAPIPort:            debugAPIPort,
+		ExecDirs:                execDirs,
+		ExecEnv:                 execEnv,
+		ExecTimeout:             execTimeout,
+		ExecLimits:              execLimits,
+		GRPCCAFile:              localconfig.GetValue(cmd, "grpc-ca-file", ""),
+		GRPCCertFile:            localconfig.GetValue(cmd, "grpc-cert-file", ""),
+		GRPCKeyFile:             localconfig.GetValue(cmd, "grpc-key-file", ""),
 	}
 
 	l := logger.StdlibLogger(ctx)
@@ -28,6 +28,7 @@ type Config struct {
 	APIPort     int      `koanf:"api-port"`
 	Prod        bool     `koanf:"prod"`
 	Port        string   `koanf:"port"`
+	ExecDir     []string `koanf:"exec-dir"`
 
 	// Advanced dev command configuration
 	PollInterval            int    `koanf:"poll-interval"`
@@ -53,6 +54,8 @@ type Config struct {
 	PostgresConnMaxIdleTime int    `koanf:"postgres-conn-max-idle-time"`
 	PostgresConnMaxLifetime int    `koanf:"postgres-conn-max-lifetime"`
 	SqliteDir               string `koanf:"sqlite-dir"`
+	QueueBackend            string `koanf:"queue-backend"`
+	StateBackend            string `koanf:"state-backend"`
 
 	// Tracing
 	SystemTraceEndpoint string `koanf:"system-trace-endpoint"`
@@ -0,0 +1,165 @@
+package runs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/inngest/inngest/pkg/tracing/traceexport"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	defaultAPIHost = "http://localhost:8288"
+	defaultTimeout = 30 * time.Second
+)
+
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "runs",
+		Usage: "Inspect function runs",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "api-host",
+				Value: defaultAPIHost,
+				Usage: "Origin of the dev server or self-hosted Inngest server",
+			},
+			&cli.StringFlag{
+				Name:    "signing-key",
+				Usage:   "Signing key sent as a Bearer token",
+				Sources: cli.EnvVars("INNGEST_SIGNING_KEY"),
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Value: defaultTimeout,
+				Usage: "HTTP request timeout",
+			},
+		},
+		Commands: []*cli.Command{
+			traceCommand(),
+			waitsCommand(),
+		},
+	}
+}
+
+func traceCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "trace",
+		Usage: "Work with run traces",
+		Commands: []*cli.Command{
+			{
+				Name:      "export",
+				Usage:     "Download a run's trace as OTLP JSON or in the Chrome trace event format",
+				UsageText: "inngest runs trace export [--format otlp|chrome] [--include-io] [--output <file>] <run-id>",
+				Description: strings.Join([]string{
+					"OTLP JSON can be sent to any OpenTelemetry collector or tracing vendor.",
+					"Chrome trace files can be opened in https://ui.perfetto.dev or chrome://tracing.",
+				}, "\n"),
+				Arguments: []cli.Argument{
+					&cli.StringArg{Name: "run-id", UsageText: "<run-id>"},
+				},
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "format",
+						Value: string(traceexport.FormatOTLP),
+						Usage: "Export format: otlp or chrome",
+					},
+					&cli.BoolFlag{
+						Name:  "include-io",
+						Usage: "Include event payloads and step inputs and outputs, which may contain sensitive data",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "File to write the trace to.  Defaults to stdout",
+					},
+				},
+				Action: exportTrace,
+			},
+		},
+	}
+}
+
+func exportTrace(ctx context.Context, cmd *cli.Command) error {
+	runID := cmd.StringArg("run-id")
+	if runID == "" {
+		return cli.Exit("a run ID is required", 1)
+	}
+
+	format, err := traceexport.ParseFormat(cmd.String("format"))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	u, err := exportURL(cmd.String("api-host"), runID, format, cmd.Bool("include-io"))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	if key := cmd.String("signing-key"); key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+
+	client := &http.Client{Timeout: cmd.Duration("timeout")}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error requesting trace: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return cli.Exit(fmt.Sprintf("error exporting trace (%d): %s", resp.StatusCode, strings.TrimSpace(string(body))), 1)
+	}
+
+	out := cmd.Root().Writer
+	if path := cmd.String("output"); path != "" && path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("error creating output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if out == nil {
+		out = os.Stdout
+	}
+
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		return fmt.Errorf("error writing trace: %w", err)
+	}
+	return nil
+}
+
+// exportURL returns the URL of the v1 trace export endpoint for the given run.
+func exportURL(host, runID string, format traceexport.Format, includeIO bool) (string, error) {
+	if host == "" {
+		host = defaultAPIHost
+	}
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("invalid API host %q: %w", host, err)
+	}
+	u = u.JoinPath("v1", "runs", runID, "trace", "export")
+
+	q := url.Values{}
+	q.Set("format", string(format))
+	if includeIO {
+		q.Set("include_io", "true")
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
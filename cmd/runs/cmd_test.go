@@ -0,0 +1,41 @@
+package runs
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportTrace(t *testing.T) {
+	var req *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		_, _ = w.Write([]byte(`{"traceEvents":[]}`))
+	}))
+	defer srv.Close()
+
+	out := &bytes.Buffer{}
+	cmd := Command()
+	cmd.Writer = out
+	err := cmd.Run(context.Background(), []string{
+		"runs", "--api-host", srv.URL, "--signing-key", "signkey-test-abc",
+		"trace", "export", "--format", "chrome", "--include-io", "01JABCDEFGHJKMNPQRSTVWXYZ0",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "/v1/runs/01JABCDEFGHJKMNPQRSTVWXYZ0/trace/export", req.URL.Path)
+	require.Equal(t, "chrome", req.URL.Query().Get("format"))
+	require.Equal(t, "true", req.URL.Query().Get("include_io"))
+	require.Equal(t, "Bearer signkey-test-abc", req.Header.Get("Authorization"))
+	require.Equal(t, `{"traceEvents":[]}`, out.String())
+}
+
+func TestExportURL(t *testing.T) {
+	u, err := exportURL("localhost:8288", "01J", "otlp", false)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8288/v1/runs/01J/trace/export?format=otlp", u)
+}
@@ -0,0 +1,307 @@
+package runs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func waitsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "waits",
+		Usage: "Inspect and resolve the pending waits runs are blocked on",
+		Commands: []*cli.Command{
+			{
+				Name:      "list",
+				Usage:     "List the pending waits of a run, or of a function's running runs",
+				UsageText: "inngest runs waits list <run-id>\ninngest runs waits list --app <app-id> --function <function-id> [--limit <n>] [--cursor <cursor>]",
+				Arguments: []cli.Argument{
+					&cli.StringArg{Name: "run-id", UsageText: "<run-id>"},
+				},
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "app",
+						Usage: "App ID or name to list function waits for",
+					},
+					&cli.StringFlag{
+						Name:  "function",
+						Usage: "Function ID or slug to list function waits for",
+					},
+					&cli.IntFlag{
+						Name:  "limit",
+						Usage: "Number of function waits to return",
+					},
+					&cli.StringFlag{
+						Name:  "cursor",
+						Usage: "Cursor from a previous page of function waits",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print the API response as JSON",
+					},
+				},
+				Action: listWaits,
+			},
+			{
+				Name:      "resolve",
+				Usage:     "Resume a step.waitForEvent or step.waitForSignal wait with the given data",
+				UsageText: "inngest runs waits resolve [--data <json>] <run-id> <wait-id>",
+				Arguments: []cli.Argument{
+					&cli.StringArg{Name: "run-id", UsageText: "<run-id>"},
+					&cli.StringArg{Name: "wait-id", UsageText: "<wait-id>"},
+				},
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "data",
+						Usage: "JSON payload to resume the wait with.  This is the event's data for step.waitForEvent",
+					},
+				},
+				Action: resolveWait,
+			},
+			{
+				Name:      "timeout",
+				Usage:     "Resume a wait as if it timed out",
+				UsageText: "inngest runs waits timeout <run-id> <wait-id>",
+				Arguments: []cli.Argument{
+					&cli.StringArg{Name: "run-id", UsageText: "<run-id>"},
+					&cli.StringArg{Name: "wait-id", UsageText: "<wait-id>"},
+				},
+				Action: timeoutWait,
+			},
+		},
+	}
+}
+
+// wait is a wait as returned by API v2.
+type wait struct {
+	ID               string     `json:"id"`
+	RunID            string     `json:"runId"`
+	Type             string     `json:"type"`
+	StepName         string     `json:"stepName"`
+	Event            string     `json:"event"`
+	Expression       string     `json:"expression"`
+	Signal           string     `json:"signal"`
+	InvokeFunctionID string     `json:"invokeFunctionId"`
+	TimeoutAt        *time.Time `json:"timeoutAt"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+type waitsResponse struct {
+	Data []wait `json:"data"`
+	Page *struct {
+		HasMore bool   `json:"hasMore"`
+		Cursor  string `json:"cursor"`
+	} `json:"page"`
+}
+
+type waitResponse struct {
+	Data wait `json:"data"`
+}
+
+func listWaits(ctx context.Context, cmd *cli.Command) error {
+	runID := cmd.StringArg("run-id")
+	appID, fnID := cmd.String("app"), cmd.String("function")
+
+	var (
+		path  []string
+		query = url.Values{}
+	)
+	switch {
+	case runID != "" && (appID != "" || fnID != ""):
+		return cli.Exit("specify either a run ID or --app and --function, not both", 1)
+	case runID != "":
+		path = []string{"runs", runID, "waits"}
+	case appID != "" && fnID != "":
+		path = []string{"apps", appID, "functions", fnID, "waits"}
+		if limit := cmd.Int("limit"); limit > 0 {
+			query.Set("limit", strconv.Itoa(limit))
+		}
+		if cursor := cmd.String("cursor"); cursor != "" {
+			query.Set("cursor", cursor)
+		}
+	default:
+		return cli.Exit("a run ID, or --app and --function, is required", 1)
+	}
+
+	body, err := callAPI(ctx, cmd, http.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+	if cmd.Bool("json") {
+		_, err := fmt.Fprintln(writer(cmd), string(body))
+		return err
+	}
+
+	resp := waitsResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("error decoding waits: %w", err)
+	}
+	if err := printWaits(writer(cmd), resp.Data); err != nil {
+		return err
+	}
+	if resp.Page != nil && resp.Page.HasMore {
+		_, err := fmt.Fprintf(writer(cmd), "\nMore waits are available.  Use --cursor %s to list them.\n", resp.Page.Cursor)
+		return err
+	}
+	return nil
+}
+
+func resolveWait(ctx context.Context, cmd *cli.Command) error {
+	runID, waitID, err := waitArgs(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := map[string]json.RawMessage{}
+	if data := cmd.String("data"); data != "" {
+		if !json.Valid([]byte(data)) {
+			return cli.Exit("--data must be valid JSON", 1)
+		}
+		req["data"] = json.RawMessage(data)
+	}
+	return changeWait(ctx, cmd, []string{"runs", runID, "waits", waitID, "resolve"}, req)
+}
+
+func timeoutWait(ctx context.Context, cmd *cli.Command) error {
+	runID, waitID, err := waitArgs(cmd)
+	if err != nil {
+		return err
+	}
+	return changeWait(ctx, cmd, []string{"runs", runID, "waits", waitID, "timeout"}, map[string]any{})
+}
+
+func changeWait(ctx context.Context, cmd *cli.Command, path []string, req any) error {
+	byt, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	body, err := callAPI(ctx, cmd, http.MethodPost, path, nil, byt)
+	if err != nil {
+		return err
+	}
+
+	resp := waitResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("error decoding wait: %w", err)
+	}
+	return printWaits(writer(cmd), []wait{resp.Data})
+}
+
+func waitArgs(cmd *cli.Command) (string, string, error) {
+	runID, waitID := cmd.StringArg("run-id"), cmd.StringArg("wait-id")
+	if runID == "" || waitID == "" {
+		return "", "", cli.Exit("a run ID and wait ID are required", 1)
+	}
+	return runID, waitID, nil
+}
+
+func printWaits(w io.Writer, waits []wait) error {
+	if len(waits) == 0 {
+		_, err := fmt.Fprintln(w, "No pending waits")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tRUN\tTYPE\tSTEP\tWAITING FOR\tTIMEOUT")
+	for _, wt := range waits {
+		timeout := "-"
+		if wt.TimeoutAt != nil {
+			timeout = wt.TimeoutAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", wt.ID, wt.RunID, wt.Type, wt.StepName, waitingFor(wt), timeout)
+	}
+	return tw.Flush()
+}
+
+// waitingFor describes what a wait is blocked on.
+func waitingFor(w wait) string {
+	switch {
+	case w.Signal != "":
+		return "signal " + w.Signal
+	case w.InvokeFunctionID != "":
+		return "function " + w.InvokeFunctionID
+	case w.Event != "" && w.Expression != "":
+		return fmt.Sprintf("event %s if %s", w.Event, w.Expression)
+	case w.Event != "":
+		return "event " + w.Event
+	}
+	return "-"
+}
+
+// callAPI calls an API v2 endpoint and returns the response body, exiting
+// with the API's error on non-2xx responses.
+func callAPI(ctx context.Context, cmd *cli.Command, method string, path []string, query url.Values, body []byte) ([]byte, error) {
+	u, err := apiURL(cmd.String("api-host"), path, query)
+	if err != nil {
+		return nil, cli.Exit(err.Error(), 1)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if key := cmd.String("signing-key"); key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+
+	client := &http.Client{Timeout: cmd.Duration("timeout")}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	byt, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, cli.Exit(fmt.Sprintf("API error (%d): %s", resp.StatusCode, strings.TrimSpace(string(byt))), 1)
+	}
+	return byt, nil
+}
+
+// apiURL returns the URL of an API v2 endpoint.
+func apiURL(host string, path []string, query url.Values) (string, error) {
+	if host == "" {
+		host = defaultAPIHost
+	}
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("invalid API host %q: %w", host, err)
+	}
+	u = u.JoinPath(append([]string{"api", "v2"}, path...)...)
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	return u.String(), nil
+}
+
+func writer(cmd *cli.Command) io.Writer {
+	if w := cmd.Root().Writer; w != nil {
+		return w
+	}
+	return os.Stdout
+}
@@ -0,0 +1,110 @@
+package runs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func runWaitsCommand(t *testing.T, handler http.HandlerFunc, args ...string) (string, error) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	out := &bytes.Buffer{}
+	cmd := Command()
+	cmd.Writer = out
+	// Return exit errors rather than exiting the test binary.
+	cmd.ExitErrHandler = func(context.Context, *cli.Command, error) {}
+	err := cmd.Run(context.Background(), append([]string{"runs", "--api-host", srv.URL, "--signing-key", "signkey-test-abc", "waits"}, args...))
+	return out.String(), err
+}
+
+func TestListWaits(t *testing.T) {
+	t.Run("run waits", func(t *testing.T) {
+		var req *http.Request
+		out, err := runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {
+			req = r
+			_, _ = w.Write([]byte(`{"data":[{"id":"w1","runId":"01J","type":"EVENT","stepName":"approval","event":"app/approved","expression":"event.data.id == async.data.id","timeoutAt":"2026-04-09T12:00:00Z"}]}`))
+		}, "list", "01J")
+		require.NoError(t, err)
+
+		require.Equal(t, "/api/v2/runs/01J/waits", req.URL.Path)
+		require.Equal(t, "Bearer signkey-test-abc", req.Header.Get("Authorization"))
+		require.Contains(t, out, "approval")
+		require.Contains(t, out, "event app/approved if event.data.id == async.data.id")
+		require.Contains(t, out, "2026-04-09T12:00:00Z")
+	})
+
+	t.Run("function waits", func(t *testing.T) {
+		var req *http.Request
+		out, err := runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {
+			req = r
+			_, _ = w.Write([]byte(`{"data":[{"id":"w1","runId":"01J","type":"SIGNAL","stepName":"wait","signal":"approve"}],"page":{"hasMore":true,"cursor":"next"}}`))
+		}, "list", "--app", "app", "--function", "fn", "--limit", "1", "--cursor", "prev")
+		require.NoError(t, err)
+
+		require.Equal(t, "/api/v2/apps/app/functions/fn/waits", req.URL.Path)
+		require.Equal(t, "1", req.URL.Query().Get("limit"))
+		require.Equal(t, "prev", req.URL.Query().Get("cursor"))
+		require.Contains(t, out, "signal approve")
+		require.Contains(t, out, "--cursor next")
+	})
+
+	t.Run("requires a target", func(t *testing.T) {
+		_, err := runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {}, "list")
+		require.ErrorContains(t, err, "a run ID, or --app and --function, is required")
+	})
+
+	t.Run("surfaces API errors", func(t *testing.T) {
+		_, err := runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Run not found"}]}`))
+		}, "list", "01J")
+		require.ErrorContains(t, err, "API error (404)")
+	})
+}
+
+func TestResolveWait(t *testing.T) {
+	var (
+		req  *http.Request
+		body map[string]any
+	)
+	out, err := runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		byt, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(byt, &body)
+		_, _ = w.Write([]byte(`{"data":{"id":"w1","runId":"01J","type":"EVENT","stepName":"approval","event":"app/approved"}}`))
+	}, "resolve", "--data", `{"approved":true}`, "01J", "w1")
+	require.NoError(t, err)
+
+	require.Equal(t, http.MethodPost, req.Method)
+	require.Equal(t, "/api/v2/runs/01J/waits/w1/resolve", req.URL.Path)
+	require.Equal(t, map[string]any{"data": map[string]any{"approved": true}}, body)
+	require.Contains(t, out, "w1")
+
+	_, err = runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {}, "resolve", "--data", "{", "01J", "w1")
+	require.ErrorContains(t, err, "--data must be valid JSON")
+}
+
+func TestTimeoutWait(t *testing.T) {
+	var req *http.Request
+	_, err := runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		_, _ = w.Write([]byte(`{"data":{"id":"w1","runId":"01J","type":"EVENT"}}`))
+	}, "timeout", "01J", "w1")
+	require.NoError(t, err)
+
+	require.Equal(t, http.MethodPost, req.Method)
+	require.Equal(t, "/api/v2/runs/01J/waits/w1/timeout", req.URL.Path)
+
+	_, err = runWaitsCommand(t, func(w http.ResponseWriter, r *http.Request) {}, "timeout", "01J")
+	require.ErrorContains(t, err, "a run ID and wait ID are required")
+}
@@ -1,9 +1,14 @@
 package start
 
 import (
+	"time"
+
 	"github.com/inngest/inngest/pkg/api"
 	connectgrpc "github.com/inngest/inngest/pkg/connect/grpc"
 	"github.com/inngest/inngest/pkg/devserver"
+	"github.com/inngest/inngest/pkg/execution/driver/execdriver"
+	"github.com/inngest/inngest/pkg/metrics"
+	"github.com/inngest/inngest/pkg/tracing"
 	"github.com/urfave/cli/v3"
 )
 
@@ -85,6 +90,18 @@ func Command() *cli.Command {
 				Usage:    "Sets the maximum amount of time, in minutes, a PostgreSQL connection may be reused.",
 				Value:    30,
 			},
+			&cli.StringFlag{
+				Category: "Persistence",
+				Name:     "queue-backend",
+				Usage:    "Backend used for the queue: redis or postgres. The postgres backend requires postgres-uri.",
+				Value:    "redis",
+			},
+			&cli.StringFlag{
+				Category: "Persistence",
+				Name:     "state-backend",
+				Usage:    "Backend used for run state and pauses: redis or postgres. The postgres backend requires postgres-uri and redis-uri, as batches, debounces and singletons are stored in Redis.",
+				Value:    "redis",
+			},
 
 			// Advanced flags
 			&cli.IntFlag{
@@ -105,6 +122,154 @@ func Command() *cli.Command {
 				Value:    devserver.DefaultQueueWorkers,
 				Usage:    "Number of executor workers to execute steps from the queue",
 			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "metrics-max-functions",
+				Value:    metrics.DefaultMaxFunctionSeries,
+				Usage:    "Maximum number of functions labelled individually in per-function queue metrics. Further functions are reported as \"_other\"; set to -1 to report all functions as \"_other\"",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "ingest-backlog-limit",
+				Value:    0,
+				Usage:    "Number of due, unprocessed queue items across all functions at which the event API applies back-pressure. 0 disables the limit",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "ingest-function-backlog-limit",
+				Value:    0,
+				Usage:    "Number of due, unprocessed queue items for a function at which the event API applies back-pressure to events triggering it. 0 disables the limit",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "ingest-backpressure",
+				Value:    api.BackpressureReject,
+				Usage:    "How the event API applies back-pressure: reject (429 with Retry-After) or defer (accept events and defer fan-out until the backlog drains)",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "otlp-traces-endpoint",
+				Usage:    "OTLP collector endpoint to export run traces to, as host:port or URL (ex. https://api.honeycomb.io). Export is disabled if unset",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "otlp-traces-protocol",
+				Value:    tracing.OTLPProtocolHTTP,
+				Usage:    "Protocol used to export run traces: http or grpc",
+			},
+			&cli.StringSliceFlag{
+				Category: "Advanced",
+				Name:     "otlp-traces-header",
+				Usage:    "Header sent when exporting run traces, as key=value (ex. x-honeycomb-team=KEY)",
+			},
+			&cli.BoolFlag{
+				Category: "Advanced",
+				Name:     "otlp-traces-insecure",
+				Usage:    "Disable TLS when exporting run traces",
+			},
+			&cli.BoolFlag{
+				Category: "Advanced",
+				Name:     "otlp-traces-include-io",
+				Usage:    "Include event payloads and step inputs and outputs in exported run traces",
+			},
+			&cli.StringSliceFlag{
+				Category: "Advanced",
+				Name:     "exec-dir",
+				Usage:    "Directories containing executables which may be run by exec:// functions",
+			},
+			&cli.StringSliceFlag{
+				Category: "Advanced",
+				Name:     "exec-env",
+				Usage:    "Environment variables passed to exec:// functions.  Functions otherwise only inherit PATH",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "exec-timeout",
+				Value:    int(execdriver.DefaultTimeout / time.Second),
+				Usage:    "Maximum time in seconds each exec:// function process may run",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "exec-max-memory-mb",
+				Usage:    "Maximum memory in MB for each exec:// function process.  Zero is unlimited",
+			},
+			&cli.IntFlag{
+				Category: "Advanced",
+				Name:     "exec-max-cpu-seconds",
+				Usage:    "Maximum CPU time in seconds for each exec:// function process.  Zero is unlimited",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "grpc-ca-file",
+				Usage:    "PEM file of the CAs used to verify grpcs:// function servers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "grpc-cert-file",
+				Usage:    "PEM client certificate presented to grpcs:// function servers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "grpc-key-file",
+				Usage:    "PEM private key for grpc-cert-file",
+			},
+			&cli.StringSliceFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-brokers",
+				Usage:    "Kafka brokers to publish run history records to (ex. localhost:9092). Publishing is disabled if unset",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-topic",
+				Value:    "inngest.history",
+				Usage:    "Kafka topic run history records are published to",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-sasl-mechanism",
+				Usage:    "SASL mechanism used to authenticate with the history stream Kafka brokers: PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-sasl-username",
+				Usage:    "SASL username for the history stream Kafka brokers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-sasl-password",
+				Usage:    "SASL password for the history stream Kafka brokers",
+			},
+			&cli.BoolFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-tls",
+				Usage:    "Connect to the history stream Kafka brokers over TLS",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-tls-ca-file",
+				Usage:    "PEM file of the CAs used to verify the history stream Kafka brokers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-tls-cert-file",
+				Usage:    "PEM client certificate presented to the history stream Kafka brokers",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-kafka-tls-key-file",
+				Usage:    "PEM private key for history-stream-kafka-tls-cert-file",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-nats-urls",
+				Usage:    "Comma delimited NATS URLs to publish run history records to (ex. nats://localhost:4222). Publishing is disabled if unset",
+			},
+			&cli.StringFlag{
+				Category: "Advanced",
+				Name:     "history-stream-nats-subject",
+				Value:    "inngest.history",
+				Usage:    "NATS JetStream subject run history records are published to",
+			},
 			&cli.IntFlag{
 				Category: "Advanced",
 				Name:     "tick",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	localconfig "github.com/inngest/inngest/cmd/internal/config"
@@ -13,8 +14,13 @@ import (
 	connectConfig "github.com/inngest/inngest/pkg/config/connect"
 	connectgrpc "github.com/inngest/inngest/pkg/connect/grpc"
 	"github.com/inngest/inngest/pkg/devserver"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/driver/execdriver"
+	"github.com/inngest/inngest/pkg/execution/history/historystream"
 	"github.com/inngest/inngest/pkg/headers"
+	"github.com/inngest/inngest/pkg/metrics"
 	itrace "github.com/inngest/inngest/pkg/telemetry/trace"
+	"github.com/inngest/inngest/pkg/tracing"
 	"github.com/urfave/cli/v3"
 )
 
@@ -112,6 +118,100 @@ func action(ctx context.Context, cmd *cli.Command) error {
 	postgresURI := localconfig.GetValue(cmd, "postgres-uri", "")
 	redisURI := localconfig.GetValue(cmd, "redis-uri", "")
 	sqliteDir := localconfig.GetValue(cmd, "sqlite-dir", "")
+
+	queueBackend := localconfig.GetValue(cmd, "queue-backend", string(enums.QueueShardKindRedis))
+	switch enums.QueueShardKind(queueBackend) {
+	case enums.QueueShardKindRedis:
+	case enums.QueueShardKindPostgres:
+		if postgresURI == "" {
+			fmt.Println("Error: queue-backend postgres requires postgres-uri")
+			os.Exit(1)
+		}
+	default:
+		fmt.Printf("Error: unknown queue-backend %q\n", queueBackend)
+		os.Exit(1)
+	}
+	stateBackend := localconfig.GetValue(cmd, "state-backend", string(enums.StateStoreKindRedis))
+	switch enums.StateStoreKind(stateBackend) {
+	case enums.StateStoreKindRedis:
+	case enums.StateStoreKindPostgres:
+		if postgresURI == "" {
+			fmt.Println("Error: state-backend postgres requires postgres-uri")
+			os.Exit(1)
+		}
+		if redisURI == "" {
+			fmt.Println("Error: state-backend postgres requires redis-uri")
+			os.Exit(1)
+		}
+	default:
+		fmt.Printf("Error: unknown state-backend %q\n", stateBackend)
+		os.Exit(1)
+	}
+	ingestBackpressure := localconfig.GetValue(cmd, "ingest-backpressure", api.BackpressureReject)
+	switch ingestBackpressure {
+	case api.BackpressureReject, api.BackpressureDefer:
+	default:
+		fmt.Printf("Error: unknown ingest-backpressure %q\n", ingestBackpressure)
+		os.Exit(1)
+	}
+	traceExport := tracing.OTLPExportOpts{
+		Endpoint:  localconfig.GetValue(cmd, "otlp-traces-endpoint", ""),
+		Protocol:  localconfig.GetValue(cmd, "otlp-traces-protocol", tracing.OTLPProtocolHTTP),
+		Headers:   map[string]string{},
+		Insecure:  localconfig.GetBoolValue(cmd, "otlp-traces-insecure", false),
+		IncludeIO: localconfig.GetBoolValue(cmd, "otlp-traces-include-io", false),
+	}
+	switch traceExport.Protocol {
+	case tracing.OTLPProtocolHTTP, tracing.OTLPProtocolGRPC:
+	default:
+		fmt.Printf("Error: unknown otlp-traces-protocol %q\n", traceExport.Protocol)
+		os.Exit(1)
+	}
+	for _, h := range localconfig.GetStringSlice(cmd, "otlp-traces-header") {
+		k, v, ok := strings.Cut(h, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			fmt.Printf("Error: invalid otlp-traces-header %q; expected key=value\n", h)
+			os.Exit(1)
+		}
+		traceExport.Headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	historyStream := historystream.Opts{
+		KafkaBrokers:       localconfig.GetStringSlice(cmd, "history-stream-kafka-brokers"),
+		KafkaTopic:         localconfig.GetValue(cmd, "history-stream-kafka-topic", "inngest.history"),
+		KafkaSASLMechanism: strings.ToUpper(localconfig.GetValue(cmd, "history-stream-kafka-sasl-mechanism", "")),
+		KafkaSASLUsername:  localconfig.GetValue(cmd, "history-stream-kafka-sasl-username", ""),
+		KafkaSASLPassword:  localconfig.GetValue(cmd, "history-stream-kafka-sasl-password", ""),
+		KafkaTLS:           localconfig.GetBoolValue(cmd, "history-stream-kafka-tls", false),
+		KafkaTLSCAFile:     localconfig.GetValue(cmd, "history-stream-kafka-tls-ca-file", ""),
+		KafkaTLSCertFile:   localconfig.GetValue(cmd, "history-stream-kafka-tls-cert-file", ""),
+		KafkaTLSKeyFile:    localconfig.GetValue(cmd, "history-stream-kafka-tls-key-file", ""),
+		NatsURLs:           localconfig.GetValue(cmd, "history-stream-nats-urls", ""),
+		NatsSubject:        localconfig.GetValue(cmd, "history-stream-nats-subject", "inngest.history"),
+	}
+	if len(historyStream.KafkaBrokers) > 0 && historyStream.NatsURLs != "" {
+		fmt.Println("Error: history-stream-kafka-brokers and history-stream-nats-urls cannot both be set")
+		os.Exit(1)
+	}
+	switch historyStream.KafkaSASLMechanism {
+	case "", historystream.KafkaSASLPlain, historystream.KafkaSASLScramSHA256, historystream.KafkaSASLScramSHA512:
+	default:
+		fmt.Printf("Error: invalid history-stream-kafka-sasl-mechanism %q; expected PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512\n", historyStream.KafkaSASLMechanism)
+		os.Exit(1)
+	}
+	if historyStream.KafkaSASLMechanism != "" && historyStream.KafkaSASLUsername == "" {
+		fmt.Println("Error: history-stream-kafka-sasl-username is required with history-stream-kafka-sasl-mechanism")
+		os.Exit(1)
+	}
+	if (historyStream.KafkaTLSCertFile == "") != (historyStream.KafkaTLSKeyFile == "") {
+		fmt.Println("Error: history-stream-kafka-tls-cert-file and history-stream-kafka-tls-key-file must be set together")
+		os.Exit(1)
+	}
+	execEnv := localconfig.GetStringSlice(cmd, "exec-env")
+	execTimeout := time.Duration(localconfig.GetIntValue(cmd, "exec-timeout", int(execdriver.DefaultTimeout/time.Second))) * time.Second
+	execLimits := execdriver.Limits{
+		MaxMemoryBytes: int64(localconfig.GetIntValue(cmd, "exec-max-memory-mb", 0)) * 1024 * 1024,
+		MaxCPU:         time.Duration(localconfig.GetIntValue(cmd, "exec-max-cpu-seconds", 0)) * time.Second,
+	}
 	sdkURLs := localconfig.GetStringSlice(cmd, "sdk-url")
 
 	connectGatewayPort := localconfig.GetIntValue(cmd, "connect-gateway-port", devserver.DefaultConnectGatewayPort)
@@ -124,26 +224,41 @@ func action(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	opts := devserver.StartOpts{
-		Config:                  *conf,
-		ConnectGatewayHost:      conf.CoreAPI.Addr,
-		ConnectGatewayPort:      connectGatewayPort,
-		EventKeys:               eventKeys,
-		NoUI:                    localconfig.GetBoolValue(cmd, "no-ui", false),
-		Persist:                 true,
-		PollInterval:            localconfig.GetIntValue(cmd, "poll-interval", devserver.DefaultPollInterval),
-		PostgresConnMaxIdleTime: localconfig.GetIntValue(cmd, "postgres-conn-max-idle-time", 5),
-		PostgresConnMaxLifetime: localconfig.GetIntValue(cmd, "postgres-conn-max-lifetime", 30),
-		PostgresMaxIdleConns:    postgresMaxIdleConns,
-		PostgresMaxOpenConns:    postgresMaxOpenConns,
-		PostgresURI:             postgresURI,
-		QueueWorkers:            localconfig.GetIntValue(cmd, "queue-workers", devserver.DefaultQueueWorkers),
-		RedisURI:                redisURI,
-		RequireKeys:             true,
-		RetryInterval:           localconfig.GetIntValue(cmd, "retry-interval", 0),
-		SigningKey:              &signingKey,
-		SQLiteDir:               sqliteDir,
-		Tick:                    time.Duration(tick) * time.Millisecond,
-		URLs:                    sdkURLs,
+		Config:                     *conf,
+		ConnectGatewayHost:         conf.CoreAPI.Addr,
+		ConnectGatewayPort:         connectGatewayPort,
+		EventKeys:                  eventKeys,
+		NoUI:                       localconfig.GetBoolValue(cmd, "no-ui", false),
+		Persist:                    true,
+		PollInterval:               localconfig.GetIntValue(cmd, "poll-interval", devserver.DefaultPollInterval),
+		PostgresConnMaxIdleTime:    localconfig.GetIntValue(cmd, "postgres-conn-max-idle-time", 5),
+		PostgresConnMaxLifetime:    localconfig.GetIntValue(cmd, "postgres-conn-max-lifetime", 30),
+		PostgresMaxIdleConns:       postgresMaxIdleConns,
+		PostgresMaxOpenConns:       postgresMaxOpenConns,
+		PostgresURI:                postgresURI,
+		QueueBackend:               queueBackend,
+		StateBackend:               stateBackend,
+		QueueWorkers:               localconfig.GetIntValue(cmd, "queue-workers", devserver.DefaultQueueWorkers),
+		MetricsMaxFunctions:        localconfig.GetIntValue(cmd, "metrics-max-functions", metrics.DefaultMaxFunctionSeries),
+		IngestBacklogLimit:         int64(localconfig.GetIntValue(cmd, "ingest-backlog-limit", 0)),
+		IngestFunctionBacklogLimit: int64(localconfig.GetIntValue(cmd, "ingest-function-backlog-limit", 0)),
+		IngestBackpressure:         ingestBackpressure,
+		TraceExport:                traceExport,
+		HistoryStream:              historyStream,
+		ExecDirs:                   localconfig.GetStringSlice(cmd, "exec-dir"),
+		ExecEnv:                    execEnv,
+		ExecTimeout:                execTimeout,
+		ExecLimits:                 execLimits,
+		GRPCCAFile:                 localconfig.GetValue(cmd, "grpc-ca-file", ""),
+		GRPCCertFile:               localconfig.GetValue(cmd, "grpc-cert-file", ""),
+		GRPCKeyFile:                localconfig.GetValue(cmd, "grpc-key-file", ""),
+		RedisURI:                   redisURI,
+		RequireKeys:                true,
+		RetryInterval:              localconfig.GetIntValue(cmd, "retry-interval", 0),
+		SigningKey:                 &signingKey,
+		SQLiteDir:                  sqliteDir,
+		Tick:                       time.Duration(tick) * time.Millisecond,
+		URLs:                       sdkURLs,
 		ConnectGRPCConfig: connectConfig.NewGRPCConfig(
 			ctx,
 			connectGatewayGRPCIP, connectGatewayGRPCPort,
@@ -0,0 +1,560 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+)
+
+const (
+	// DefaultAdmissionRetryAfter is the Retry-After sent with events rejected
+	// due to back-pressure.
+	DefaultAdmissionRetryAfter = 5 * time.Second
+
+	// DefaultAdmissionRefreshInterval is how long queue statistics are cached
+	// when making admission decisions.
+	DefaultAdmissionRefreshInterval = time.Second
+
+	// DefaultAdmissionStatsPageSize is the number of function partitions
+	// loaded per refresh from a QueueStatsPager.
+	DefaultAdmissionStatsPageSize = 250
+
+	// DefaultAdmissionTriggerTTL is how long the functions triggered by an
+	// event name are cached when making admission decisions.
+	DefaultAdmissionTriggerTTL = 5 * time.Second
+
+	// DefaultMaxDeferredEvents is the default number of events that can be
+	// deferred at once.  Events over this limit are rejected.
+	DefaultMaxDeferredEvents = 10_000
+
+	// DefaultMaxEventDeferral is the default maximum time fan-out of an event
+	// is deferred for.  Deferred events are published once this elapses, even
+	// if the backlog is still over its threshold.
+	DefaultMaxEventDeferral = 5 * time.Minute
+)
+
+const (
+	// BackpressureReject rejects events over a backlog threshold with a 429.
+	BackpressureReject = "reject"
+	// BackpressureDefer accepts events over a backlog threshold, deferring
+	// their fan-out until the backlog drains.
+	BackpressureDefer = "defer"
+)
+
+// AdmissionAction is the action taken for an incoming event.
+type AdmissionAction int
+
+const (
+	// AdmissionAccept publishes the event immediately.
+	AdmissionAccept AdmissionAction = iota
+	// AdmissionDefer accepts the event, deferring fan-out until the backlog
+	// drains.
+	AdmissionDefer
+	// AdmissionReject rejects the event with a 429 and a Retry-After header.
+	AdmissionReject
+)
+
+// AdmissionDecision is the result of admission control for an event.
+type AdmissionDecision struct {
+	Action AdmissionAction
+	// RetryAfter is how long producers should wait before retrying rejected
+	// events.
+	RetryAfter time.Duration
+	// Reason describes why the event wasn't accepted.
+	Reason string
+}
+
+// AdmissionController decides whether incoming events are accepted, deferred
+// or rejected, allowing producers to be slowed down before the queue is
+// overwhelmed.
+type AdmissionController interface {
+	Admit(ctx context.Context, evt event.Event) (AdmissionDecision, error)
+}
+
+// QueueStatsLoader loads per-function queue statistics.  Queue shards implement
+// this.
+type QueueStatsLoader interface {
+	FunctionQueueStats(ctx context.Context) ([]queue.FunctionQueueStats, error)
+}
+
+// QueueStatsPager loads per-function queue statistics a page at a time.  Stats
+// loaders implementing it are refreshed incrementally, one page per refresh,
+// instead of loading statistics for every function at once.
+type QueueStatsPager interface {
+	// FunctionQueueStatsPage returns statistics for a page of functions and
+	// the cursor of the next page.  An empty cursor starts a scan, and an
+	// empty next cursor ends it.
+	FunctionQueueStatsPage(ctx context.Context, cursor string, limit int) ([]queue.FunctionQueueStats, string, error)
+}
+
+// TriggeredFunctionLoader loads the functions triggered by an event.
+type TriggeredFunctionLoader interface {
+	FunctionsByTrigger(ctx context.Context, eventName string) ([]inngest.Function, error)
+}
+
+// BacklogAdmissionOpts configures backlog-based admission control.
+type BacklogAdmissionOpts struct {
+	Stats     QueueStatsLoader
+	Functions TriggeredFunctionLoader
+
+	// GlobalLimit is the maximum number of due, unprocessed queue items across
+	// all functions before events are held back.  Zero disables the limit.
+	GlobalLimit int64
+
+	// FunctionLimit returns the maximum number of due, unprocessed queue items
+	// for a function before events triggering it are held back.  If nil, or
+	// if it returns zero, no per-function limit is enforced.
+	FunctionLimit func(ctx context.Context, fnID uuid.UUID) int64
+
+	// Defer accepts events over a limit and defers their fan-out, instead of
+	// rejecting them.
+	Defer bool
+
+	// RetryAfter is the Retry-After sent with rejected events.  Zero uses
+	// DefaultAdmissionRetryAfter.
+	RetryAfter time.Duration
+
+	// RefreshInterval is how long queue statistics are cached.  Zero uses
+	// DefaultAdmissionRefreshInterval.
+	RefreshInterval time.Duration
+
+	// StatsPageSize is the number of function partitions loaded per refresh
+	// if Stats implements QueueStatsPager.  Zero uses
+	// DefaultAdmissionStatsPageSize.
+	StatsPageSize int
+
+	// TriggerTTL is how long the functions triggered by an event name are
+	// cached.  Zero uses DefaultAdmissionTriggerTTL.
+	TriggerTTL time.Duration
+}
+
+// NewBacklogAdmission returns an AdmissionController which holds back events
+// once the queue backlog, globally or for any function the event triggers, is
+// over its threshold.
+//
+// The backlog is the number of unleased items that are due plus the items
+// waiting in key queue backlogs.  Other items scheduled in the future, eg.
+// sleeps, don't count towards it.
+//
+// If Stats implements QueueStatsPager, each refresh loads a single page of
+// functions, so that the sizes of other functions are as old as the last scan
+// of their page.
+func NewBacklogAdmission(opts BacklogAdmissionOpts) AdmissionController {
+	if opts.RetryAfter <= 0 {
+		opts.RetryAfter = DefaultAdmissionRetryAfter
+	}
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = DefaultAdmissionRefreshInterval
+	}
+	if opts.StatsPageSize <= 0 {
+		opts.StatsPageSize = DefaultAdmissionStatsPageSize
+	}
+	if opts.TriggerTTL <= 0 {
+		opts.TriggerTTL = DefaultAdmissionTriggerTTL
+	}
+	return &backlogAdmission{
+		opts:     opts,
+		sizes:    map[uuid.UUID]functionBacklog{},
+		triggers: map[string]triggeredFunctions{},
+	}
+}
+
+type backlogAdmission struct {
+	opts BacklogAdmissionOpts
+
+	mu        sync.Mutex
+	fetchedAt time.Time
+	global    int64
+	functions map[uuid.UUID]int64
+	err       error
+	// refreshing is closed once the in-flight refresh completes.
+	refreshing chan struct{}
+
+	// sizes, cursor and scanStart track the scan of a QueueStatsPager.
+	sizes     map[uuid.UUID]functionBacklog
+	cursor    string
+	scanStart time.Time
+
+	triggerMu sync.Mutex
+	triggers  map[string]triggeredFunctions
+}
+
+type functionBacklog struct {
+	size   int64
+	seenAt time.Time
+}
+
+// triggeredFunctions are the cached functions triggered by an event name.
+type triggeredFunctions struct {
+	fns       []inngest.Function
+	fetchedAt time.Time
+}
+
+func (b *backlogAdmission) Admit(ctx context.Context, evt event.Event) (AdmissionDecision, error) {
+	if b.opts.GlobalLimit <= 0 && b.opts.FunctionLimit == nil {
+		return AdmissionDecision{Action: AdmissionAccept}, nil
+	}
+
+	global, functions, err := b.backlog(ctx)
+	if err != nil {
+		return AdmissionDecision{Action: AdmissionAccept}, err
+	}
+
+	if b.opts.GlobalLimit > 0 && global >= b.opts.GlobalLimit {
+		return b.holdBack(fmt.Sprintf("Queue backlog of %d items is over the limit of %d", global, b.opts.GlobalLimit)), nil
+	}
+
+	if b.opts.FunctionLimit == nil {
+		return AdmissionDecision{Action: AdmissionAccept}, nil
+	}
+
+	fns, err := b.triggered(ctx, evt.Name)
+	if err != nil {
+		return AdmissionDecision{Action: AdmissionAccept}, err
+	}
+	for _, fn := range fns {
+		limit := b.opts.FunctionLimit(ctx, fn.ID)
+		if limit <= 0 {
+			continue
+		}
+		if size := functions[fn.ID]; size >= limit {
+			return b.holdBack(fmt.Sprintf("Backlog of %d items for function %q is over the limit of %d", size, fn.Slug, limit)), nil
+		}
+	}
+
+	return AdmissionDecision{Action: AdmissionAccept}, nil
+}
+
+func (b *backlogAdmission) holdBack(reason string) AdmissionDecision {
+	action := AdmissionReject
+	if b.opts.Defer {
+		action = AdmissionDefer
+	}
+	return AdmissionDecision{
+		Action:     action,
+		RetryAfter: b.opts.RetryAfter,
+		Reason:     reason,
+	}
+}
+
+// triggered returns the functions triggered by an event name, cached for the
+// trigger TTL so that admission doesn't query functions for every event.
+func (b *backlogAdmission) triggered(ctx context.Context, name string) ([]inngest.Function, error) {
+	now := time.Now()
+
+	b.triggerMu.Lock()
+	cached, ok := b.triggers[name]
+	b.triggerMu.Unlock()
+	if ok && now.Sub(cached.fetchedAt) < b.opts.TriggerTTL {
+		return cached.fns, nil
+	}
+
+	fns, err := b.opts.Functions.FunctionsByTrigger(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error loading functions by trigger: %w", err)
+	}
+
+	b.triggerMu.Lock()
+	defer b.triggerMu.Unlock()
+	// Drop expired names so that the cache only holds names seen recently.
+	for n, c := range b.triggers {
+		if now.Sub(c.fetchedAt) >= b.opts.TriggerTTL {
+			delete(b.triggers, n)
+		}
+	}
+	b.triggers[name] = triggeredFunctions{fns: fns, fetchedAt: now}
+	return fns, nil
+}
+
+// backlog returns the global and per-function backlog sizes.  Sizes are
+// cached, and refreshed in the background once the refresh interval has
+// elapsed so that admission decisions don't wait on the queue.  Only calls made
+// before the first refresh completes wait for it.
+func (b *backlogAdmission) backlog(ctx context.Context) (int64, map[uuid.UUID]int64, error) {
+	b.mu.Lock()
+	if b.refreshing == nil && (b.fetchedAt.IsZero() || time.Since(b.fetchedAt) >= b.opts.RefreshInterval) {
+		b.refreshing = make(chan struct{})
+		go b.refresh(context.WithoutCancel(ctx), b.refreshing)
+	}
+	if b.functions != nil || b.refreshing == nil {
+		defer b.mu.Unlock()
+		return b.global, b.functions, b.err
+	}
+	done := b.refreshing
+	b.mu.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return 0, nil, ctx.Err()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.global, b.functions, b.err
+}
+
+// refresh loads backlog sizes from the queue, closing done once complete.
+func (b *backlogAdmission) refresh(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	b.mu.Lock()
+	cursor := b.cursor
+	b.mu.Unlock()
+
+	var (
+		stats []queue.FunctionQueueStats
+		next  string
+		err   error
+	)
+	pager, incremental := b.opts.Stats.(QueueStatsPager)
+	if incremental {
+		stats, next, err = pager.FunctionQueueStatsPage(ctx, cursor, b.opts.StatsPageSize)
+	} else {
+		stats, err = b.opts.Stats.FunctionQueueStats(ctx)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.fetchedAt, b.refreshing = now, nil
+	if err != nil {
+		b.err = fmt.Errorf("error loading queue stats: %w", err)
+		return
+	}
+
+	if incremental && cursor == "" {
+		b.scanStart = now
+	}
+	b.cursor = next
+
+	for _, s := range stats {
+		size := s.Ready + s.Backlog
+		if size == 0 {
+			delete(b.sizes, s.FunctionID)
+			continue
+		}
+		b.sizes[s.FunctionID] = functionBacklog{size: size, seenAt: now}
+	}
+
+	// Drop functions which no longer have a backlog: those not returned by a
+	// full load, or not seen during a complete scan.
+	var before time.Time
+	switch {
+	case !incremental:
+		before = now
+	case next == "":
+		before = b.scanStart
+	}
+	if !before.IsZero() {
+		for fnID, fb := range b.sizes {
+			if fb.seenAt.Before(before) {
+				delete(b.sizes, fnID)
+			}
+		}
+	}
+
+	var global int64
+	functions := make(map[uuid.UUID]int64, len(b.sizes))
+	for fnID, fb := range b.sizes {
+		global += fb.size
+		functions[fnID] = fb.size
+	}
+	b.global, b.functions, b.err = global, functions, nil
+}
+
+// deferredEvent is an accepted event whose fan-out is deferred.
+type deferredEvent struct {
+	ctx        context.Context
+	evt        event.Event
+	seed       *event.SeededID
+	deferredAt time.Time
+}
+
+// eventDeferrer holds events accepted with AdmissionDefer, publishing them
+// once the admission controller accepts them or their maximum deferral
+// elapses.
+//
+// Deferred events are held in memory.  They're published when the API stops,
+// but are lost if the process exits uncleanly.
+type eventDeferrer struct {
+	admission AdmissionController
+	handler   EventHandler
+	log       logger.Logger
+
+	max      int
+	maxWait  time.Duration
+	interval time.Duration
+
+	mu      sync.Mutex
+	events  []deferredEvent
+	pending int
+}
+
+// add defers the events, returning false without deferring any of them if
+// too many events are deferred.
+func (d *eventDeferrer) add(ctx context.Context, events ...deferredEvent) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pending+len(events) > d.max {
+		return false
+	}
+	now := time.Now()
+	for _, e := range events {
+		e.ctx = context.WithoutCancel(ctx)
+		e.deferredAt = now
+		d.events = append(d.events, e)
+	}
+	d.pending += len(events)
+	return true
+}
+
+// run publishes deferred events as the backlog drains, until ctx is cancelled.
+func (d *eventDeferrer) run(ctx context.Context) {
+	t := time.NewTicker(d.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			d.flush(ctx, false)
+		}
+	}
+}
+
+// flush publishes deferred events that are admitted or have been deferred for
+// the maximum deferral.  If force is true, all deferred events are published.
+func (d *eventDeferrer) flush(ctx context.Context, force bool) {
+	d.mu.Lock()
+	events := d.events
+	d.events = nil
+	d.mu.Unlock()
+
+	// Events with the same name trigger the same functions, so admission is
+	// checked once per name.
+	admitted := map[string]bool{}
+
+	var remaining []deferredEvent
+	for _, e := range events {
+		if !force && time.Since(e.deferredAt) < d.maxWait {
+			ok, checked := admitted[e.evt.Name]
+			if !checked {
+				decision, err := d.admission.Admit(ctx, e.evt)
+				if err != nil {
+					d.log.Warn("error checking admission for deferred event", "error", err, "event", e.evt.Name)
+				}
+				ok = err != nil || decision.Action == AdmissionAccept
+				admitted[e.evt.Name] = ok
+			}
+			if !ok {
+				remaining = append(remaining, e)
+				continue
+			}
+		}
+
+		evt := e.evt
+		if _, err := d.handler(e.ctx, &evt, e.seed); err != nil {
+			d.log.Error("error publishing deferred event", "error", err, "event", e.evt.Name)
+			if !force {
+				remaining = append(remaining, e)
+				continue
+			}
+		}
+
+		d.mu.Lock()
+		d.pending--
+		d.mu.Unlock()
+	}
+
+	if len(remaining) > 0 {
+		// Keep deferred events in the order they were received.
+		d.mu.Lock()
+		d.events = append(remaining, d.events...)
+		d.mu.Unlock()
+	}
+}
+
+// inboundEvent is an event received by the API which is yet to be published.
+type inboundEvent struct {
+	// n is the index of the event within its request.
+	n    int
+	evt  event.Event
+	ts   time.Time
+	seed *event.SeededID
+
+	// deferred is set once the event's fan-out is deferred, with id set to the
+	// ID it will be published with.
+	deferred bool
+	id       string
+}
+
+// admit decides admission for a batch of events as a whole, before any of them
+// is published.  If any event is rejected, or the deferred events can't all be
+// held, the batch is rejected.  Otherwise deferred events are handed to the
+// deferrer and marked as deferred, and the remaining events can be published.
+func (a API) admit(ctx context.Context, batch []inboundEvent) error {
+	if a.admission == nil {
+		return nil
+	}
+
+	var (
+		deferred []deferredEvent
+		decision AdmissionDecision
+	)
+	for i := range batch {
+		in := &batch[i]
+
+		d, err := a.admission.Admit(ctx, in.evt)
+		if err != nil {
+			// Fail open: back-pressure is best effort, and events shouldn't be
+			// dropped because the queue can't be inspected.
+			a.log.Warn("error checking event admission", "error", err, "event", in.evt.Name)
+		}
+
+		switch d.Action {
+		case AdmissionReject:
+			return admissionError{decision: d}
+		case AdmissionDefer:
+			// Seed the ID so that it can be returned before the event is
+			// published.
+			if in.seed == nil {
+				in.seed = event.NewSeededID(in.ts)
+			}
+			id, err := in.seed.ToULID()
+			if err != nil {
+				return err
+			}
+			in.deferred, in.id = true, id.String()
+			deferred = append(deferred, deferredEvent{evt: in.evt, seed: in.seed})
+			decision = d
+		}
+	}
+
+	if len(deferred) > 0 && !a.deferrer.add(ctx, deferred...) {
+		return admissionError{decision: decision}
+	}
+	return nil
+}
+
+// admissionError is returned when an event is rejected due to back-pressure.
+type admissionError struct {
+	decision AdmissionDecision
+}
+
+func (e admissionError) Error() string {
+	if e.decision.Reason == "" {
+		return "Too many events; retry later"
+	}
+	return e.decision.Reason
+}
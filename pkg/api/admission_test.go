@@ -0,0 +1,463 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/coreapi/apiutil"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+type mockQueueStats struct {
+	mu    sync.Mutex
+	stats []queue.FunctionQueueStats
+	calls int
+	// block, if set, blocks loading stats until closed.
+	block chan struct{}
+}
+
+func (m *mockQueueStats) FunctionQueueStats(ctx context.Context) ([]queue.FunctionQueueStats, error) {
+	m.mu.Lock()
+	block := m.block
+	m.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	return m.stats, nil
+}
+
+func (m *mockQueueStats) callCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
+
+// mockQueueStatsPager returns one page of stats per function.
+type mockQueueStatsPager struct {
+	mockQueueStats
+	pages int
+}
+
+func (m *mockQueueStatsPager) FunctionQueueStatsPage(ctx context.Context, cursor string, limit int) ([]queue.FunctionQueueStats, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pages++
+
+	n := 0
+	if cursor != "" {
+		n, _ = strconv.Atoi(cursor)
+	}
+	if n >= len(m.stats) {
+		return nil, "", nil
+	}
+	next := ""
+	if n+1 < len(m.stats) {
+		next = strconv.Itoa(n + 1)
+	}
+	return m.stats[n : n+1], next, nil
+}
+
+type mockTriggers map[string][]inngest.Function
+
+func (m mockTriggers) FunctionsByTrigger(ctx context.Context, eventName string) ([]inngest.Function, error) {
+	return m[eventName], nil
+}
+
+// countingTriggers counts trigger lookups by event name.
+type countingTriggers struct {
+	mockTriggers
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *countingTriggers) FunctionsByTrigger(ctx context.Context, eventName string) ([]inngest.Function, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.calls == nil {
+		c.calls = map[string]int{}
+	}
+	c.calls[eventName]++
+	return c.mockTriggers.FunctionsByTrigger(ctx, eventName)
+}
+
+// countingAdmission counts admission checks by event name.
+type countingAdmission struct {
+	decision AdmissionDecision
+	calls    map[string]int
+}
+
+func (c *countingAdmission) Admit(ctx context.Context, evt event.Event) (AdmissionDecision, error) {
+	if c.calls == nil {
+		c.calls = map[string]int{}
+	}
+	c.calls[evt.Name]++
+	return c.decision, nil
+}
+
+func TestBacklogAdmission(t *testing.T) {
+	ctx := context.Background()
+	busy := inngest.Function{ID: uuid.New(), Slug: "app-busy"}
+	idle := inngest.Function{ID: uuid.New(), Slug: "app-idle"}
+
+	stats := &mockQueueStats{stats: []queue.FunctionQueueStats{
+		{FunctionID: busy.ID, Ready: 80, Backlog: 20, Scheduled: 1000},
+		{FunctionID: idle.ID, Ready: 1},
+	}}
+	triggers := mockTriggers{
+		"test/busy": {busy},
+		"test/idle": {idle},
+	}
+	fnLimit := func(context.Context, uuid.UUID) int64 { return 100 }
+
+	t.Run("accepts events under the limits", func(t *testing.T) {
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:         stats,
+			Functions:     triggers,
+			GlobalLimit:   1000,
+			FunctionLimit: fnLimit,
+		})
+
+		decision, err := a.Admit(ctx, event.Event{Name: "test/idle"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionAccept, decision.Action)
+	})
+
+	t.Run("rejects events over the global limit", func(t *testing.T) {
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:       stats,
+			Functions:   triggers,
+			GlobalLimit: 101,
+			RetryAfter:  10 * time.Second,
+		})
+
+		decision, err := a.Admit(ctx, event.Event{Name: "test/unknown"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionReject, decision.Action)
+		require.Equal(t, 10*time.Second, decision.RetryAfter)
+	})
+
+	t.Run("rejects events triggering functions over the limit", func(t *testing.T) {
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:         stats,
+			Functions:     triggers,
+			FunctionLimit: fnLimit,
+		})
+
+		decision, err := a.Admit(ctx, event.Event{Name: "test/busy"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionReject, decision.Action)
+		require.Contains(t, decision.Reason, "app-busy")
+
+		decision, err = a.Admit(ctx, event.Event{Name: "test/idle"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionAccept, decision.Action)
+	})
+
+	t.Run("defers events over the limit", func(t *testing.T) {
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:         stats,
+			Functions:     triggers,
+			FunctionLimit: fnLimit,
+			Defer:         true,
+		})
+
+		decision, err := a.Admit(ctx, event.Event{Name: "test/busy"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionDefer, decision.Action)
+	})
+
+	t.Run("caches queue stats", func(t *testing.T) {
+		stats := &mockQueueStats{}
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:       stats,
+			Functions:   triggers,
+			GlobalLimit: 1,
+		})
+
+		for range 5 {
+			_, err := a.Admit(ctx, event.Event{Name: "test/idle"})
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, stats.callCount())
+	})
+
+	t.Run("loads paged queue stats incrementally", func(t *testing.T) {
+		stats := &mockQueueStatsPager{mockQueueStats: mockQueueStats{stats: []queue.FunctionQueueStats{
+			{FunctionID: busy.ID, Ready: 80, Backlog: 20},
+			{FunctionID: idle.ID, Ready: 1},
+		}}}
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:           stats,
+			Functions:       triggers,
+			GlobalLimit:     101,
+			RefreshInterval: time.Millisecond,
+		}).(*backlogAdmission)
+
+		// The first page only includes the busy function.
+		decision, err := a.Admit(ctx, event.Event{Name: "test/unknown"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionAccept, decision.Action)
+		require.Zero(t, stats.callCount())
+
+		// Once the second page is loaded, sizes from both pages are summed.
+		require.Eventually(t, func() bool {
+			decision, err := a.Admit(ctx, event.Event{Name: "test/unknown"})
+			return err == nil && decision.Action == AdmissionReject
+		}, time.Second, time.Millisecond)
+		require.Zero(t, stats.callCount())
+
+		// Functions without a backlog are dropped once a scan completes.
+		stats.mu.Lock()
+		stats.stats = stats.stats[:1]
+		stats.mu.Unlock()
+		require.Eventually(t, func() bool {
+			if _, err := a.Admit(ctx, event.Event{Name: "test/unknown"}); err != nil {
+				return false
+			}
+
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			_, ok := a.functions[idle.ID]
+			return !ok && a.global == 100
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("caches functions by trigger", func(t *testing.T) {
+		triggers := &countingTriggers{mockTriggers: triggers}
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:         stats,
+			Functions:     triggers,
+			FunctionLimit: fnLimit,
+			TriggerTTL:    50 * time.Millisecond,
+		})
+
+		for range 5 {
+			decision, err := a.Admit(ctx, event.Event{Name: "test/busy"})
+			require.NoError(t, err)
+			require.Equal(t, AdmissionReject, decision.Action)
+			_, err = a.Admit(ctx, event.Event{Name: "test/idle"})
+			require.NoError(t, err)
+		}
+		require.Equal(t, map[string]int{"test/busy": 1, "test/idle": 1}, triggers.calls)
+
+		// Functions are reloaded once the TTL elapses.
+		time.Sleep(60 * time.Millisecond)
+		_, err := a.Admit(ctx, event.Event{Name: "test/busy"})
+		require.NoError(t, err)
+		require.Equal(t, 2, triggers.calls["test/busy"])
+	})
+
+	t.Run("refreshes queue stats in the background", func(t *testing.T) {
+		stats := &mockQueueStats{stats: []queue.FunctionQueueStats{{FunctionID: busy.ID, Ready: 10}}}
+		a := NewBacklogAdmission(BacklogAdmissionOpts{
+			Stats:           stats,
+			Functions:       triggers,
+			GlobalLimit:     5,
+			RefreshInterval: time.Millisecond,
+		})
+
+		decision, err := a.Admit(ctx, event.Event{Name: "test/idle"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionReject, decision.Action)
+
+		// While a refresh is blocked, decisions use the cached stats.
+		stats.mu.Lock()
+		stats.stats = nil
+		stats.block = make(chan struct{})
+		stats.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+
+		decision, err = a.Admit(ctx, event.Event{Name: "test/idle"})
+		require.NoError(t, err)
+		require.Equal(t, AdmissionReject, decision.Action)
+
+		close(stats.block)
+		require.Eventually(t, func() bool {
+			decision, err := a.Admit(ctx, event.Event{Name: "test/idle"})
+			return err == nil && decision.Action == AdmissionAccept
+		}, time.Second, time.Millisecond)
+	})
+}
+
+// eventAdmission returns a decision by event name, accepting unknown events.
+type eventAdmission map[string]AdmissionDecision
+
+func (e eventAdmission) Admit(ctx context.Context, evt event.Event) (AdmissionDecision, error) {
+	return e[evt.Name], nil
+}
+
+// staticAdmission returns the same decision for every event.
+type staticAdmission struct {
+	decision AdmissionDecision
+}
+
+func (s *staticAdmission) Admit(ctx context.Context, evt event.Event) (AdmissionDecision, error) {
+	return s.decision, nil
+}
+
+func newAdmissionAPI(t *testing.T, admission AdmissionController, published *[]string) *API {
+	t.Helper()
+
+	handler := func(_ context.Context, e *event.Event, seed *event.SeededID) (string, error) {
+		id := "01HZTESTEVENTID"
+		if seed != nil {
+			ulid, err := seed.ToULID()
+			require.NoError(t, err)
+			id = ulid.String()
+		}
+		*published = append(*published, id)
+		return id, nil
+	}
+
+	r, err := NewAPI(Options{
+		EventHandler: handler,
+		Logger:       logger.StdlibLogger(t.Context()),
+		Admission:    admission,
+	})
+	require.NoError(t, err)
+	return r.(*API)
+}
+
+func postAdmissionEvent(t *testing.T, a *API) (*httptest.ResponseRecorder, apiutil.EventAPIResponse) {
+	t.Helper()
+	return postAdmissionBody(t, a, `{"name": "test/event", "data": {}}`)
+}
+
+func postAdmissionBody(t *testing.T, a *API, body string) (*httptest.ResponseRecorder, apiutil.EventAPIResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/e/test-key", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("key", "test-key")
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+	w := httptest.NewRecorder()
+	a.ReceiveEvent(w, req)
+
+	var resp apiutil.EventAPIResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return w, resp
+}
+
+func TestReceiveEvent_Admission(t *testing.T) {
+	t.Run("rejects events with retry-after", func(t *testing.T) {
+		var published []string
+		a := newAdmissionAPI(t, &staticAdmission{decision: AdmissionDecision{
+			Action:     AdmissionReject,
+			RetryAfter: 1500 * time.Millisecond,
+			Reason:     "Queue backlog is over the limit",
+		}}, &published)
+
+		w, resp := postAdmissionEvent(t, a)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		require.Equal(t, "2", w.Header().Get("Retry-After"))
+		require.Equal(t, http.StatusTooManyRequests, resp.Status)
+		require.Contains(t, resp.Error, "over the limit")
+		require.Empty(t, published)
+	})
+
+	t.Run("rejects the whole batch before publishing", func(t *testing.T) {
+		var published []string
+		a := newAdmissionAPI(t, eventAdmission{
+			"test/busy": {Action: AdmissionReject, Reason: "Queue backlog is over the limit"},
+		}, &published)
+
+		w, _ := postAdmissionBody(t, a, `[{"name": "test/idle", "data": {}}, {"name": "test/busy", "data": {}}]`)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		require.Empty(t, published)
+	})
+
+	t.Run("rejects the whole batch if it can't all be deferred", func(t *testing.T) {
+		var published []string
+		a := newAdmissionAPI(t, eventAdmission{
+			"test/busy": {Action: AdmissionDefer},
+		}, &published)
+		a.deferrer.max = 1
+
+		w, _ := postAdmissionBody(t, a, `[{"name": "test/idle", "data": {}}, {"name": "test/busy", "data": {}}, {"name": "test/busy", "data": {}}]`)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		require.Empty(t, published)
+		require.Zero(t, a.deferrer.pending)
+	})
+
+	t.Run("defers fan-out of accepted events", func(t *testing.T) {
+		var published []string
+		admission := &staticAdmission{decision: AdmissionDecision{Action: AdmissionDefer}}
+		a := newAdmissionAPI(t, admission, &published)
+
+		w, resp := postAdmissionEvent(t, a)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, resp.IDs, 1)
+		require.Empty(t, published)
+
+		// Events stay deferred while the backlog is over the limit.
+		a.deferrer.flush(t.Context(), false)
+		require.Empty(t, published)
+
+		// Once the backlog drains, the event is published with the ID
+		// returned to the producer.
+		admission.decision = AdmissionDecision{Action: AdmissionAccept}
+		a.deferrer.flush(t.Context(), false)
+		require.Equal(t, resp.IDs, published)
+	})
+
+	t.Run("checks admission of deferred events once per name", func(t *testing.T) {
+		var published []string
+		admission := &countingAdmission{decision: AdmissionDecision{Action: AdmissionDefer}}
+		a := newAdmissionAPI(t, admission, &published)
+
+		w, _ := postAdmissionBody(t, a, `[{"name": "test/a", "data": {}}, {"name": "test/b", "data": {}}, {"name": "test/a", "data": {}}]`)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		admission.calls = nil
+		a.deferrer.flush(t.Context(), false)
+		require.Empty(t, published)
+		require.Equal(t, map[string]int{"test/a": 1, "test/b": 1}, admission.calls)
+
+		admission.decision = AdmissionDecision{Action: AdmissionAccept}
+		a.deferrer.flush(t.Context(), false)
+		require.Len(t, published, 3)
+	})
+
+	t.Run("rejects events once the deferral limit is reached", func(t *testing.T) {
+		var published []string
+		a := newAdmissionAPI(t, &staticAdmission{decision: AdmissionDecision{Action: AdmissionDefer}}, &published)
+		a.deferrer.max = 1
+
+		w, _ := postAdmissionEvent(t, a)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w, _ = postAdmissionEvent(t, a)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+
+	t.Run("publishes deferred events on stop", func(t *testing.T) {
+		var published []string
+		a := newAdmissionAPI(t, &staticAdmission{decision: AdmissionDecision{Action: AdmissionDefer}}, &published)
+
+		_, resp := postAdmissionEvent(t, a)
+		require.Empty(t, published)
+
+		require.NoError(t, a.Stop(t.Context()))
+		require.Equal(t, resp.IDs, published)
+	})
+}
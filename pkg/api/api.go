@@ -3,9 +3,12 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -49,6 +52,18 @@ type Options struct {
 	// the server will still boot but core actions such as syncing, runs, and
 	// ingesting events will not work.
 	RequireKeys bool
+
+	// Admission decides whether incoming events are accepted, deferred or
+	// rejected based on queue back-pressure.  If nil, all events are accepted.
+	Admission AdmissionController
+
+	// MaxDeferredEvents is the maximum number of events whose fan-out can be
+	// deferred at once.  Zero uses DefaultMaxDeferredEvents.
+	MaxDeferredEvents int
+
+	// MaxEventDeferral is the maximum time an event's fan-out is deferred.
+	// Zero uses DefaultMaxEventDeferral.
+	MaxEventDeferral time.Duration
 }
 
 func NewAPI(o Options) (chi.Router, error) {
@@ -61,6 +76,24 @@ func NewAPI(o Options) (chi.Router, error) {
 		log:            logger,
 		localEventKeys: o.LocalEventKeys,
 		requireKeys:    o.RequireKeys,
+		admission:      o.Admission,
+	}
+
+	if o.Admission != nil {
+		api.deferrer = &eventDeferrer{
+			admission: o.Admission,
+			handler:   o.EventHandler,
+			log:       logger,
+			max:       o.MaxDeferredEvents,
+			maxWait:   o.MaxEventDeferral,
+			interval:  DefaultAdmissionRefreshInterval,
+		}
+		if api.deferrer.max <= 0 {
+			api.deferrer.max = DefaultMaxDeferredEvents
+		}
+		if api.deferrer.maxWait <= 0 {
+			api.deferrer.maxWait = DefaultMaxEventDeferral
+		}
 	}
 
 	cors := cors.New(cors.Options{
@@ -101,6 +134,11 @@ type API struct {
 	// the server will still boot but core actions such as syncing, runs, and
 	// ingesting events will not work.
 	requireKeys bool
+
+	// admission decides whether incoming events are accepted, deferred or
+	// rejected.  deferrer holds events whose fan-out is deferred.
+	admission AdmissionController
+	deferrer  *eventDeferrer
 }
 
 func (a *API) AddRoutes() {
@@ -113,6 +151,10 @@ func (a *API) Start(ctx context.Context) error {
 	}
 	a.log.Info("starting server", "addr", a.server.Addr)
 
+	if a.deferrer != nil {
+		go a.deferrer.run(ctx)
+	}
+
 	lerrChan := make(chan error)
 	go func() {
 		lerrChan <- a.server.ListenAndServe()
@@ -128,6 +170,11 @@ func (a *API) Start(ctx context.Context) error {
 }
 
 func (a API) Stop(ctx context.Context) error {
+	if a.deferrer != nil {
+		// Publish deferred events so that they're not lost on shutdown.
+		a.deferrer.flush(ctx, true)
+	}
+
 	if a.server == nil {
 		return nil
 	}
@@ -233,6 +280,9 @@ func (a API) ReceiveEvent(w http.ResponseWriter, r *http.Request) {
 		// Close the idChan so that we stop appending to the ID slice.
 		defer close(idChan)
 
+		// Parse the whole batch first, so that admission is decided for every
+		// event before any is published.
+		var batch []inboundEvent
 		index := 0
 		for s := range stream {
 			index++
@@ -255,28 +305,46 @@ func (a API) ReceiveEvent(w http.ResponseWriter, r *http.Request) {
 				metrics.CounterOpt{PkgName: metricsPkgName},
 			)
 
+			batch = append(batch, inboundEvent{
+				n:   s.N,
+				evt: evt,
+				ts:  ts,
+				seed: event.SeededIDFromString(
+					r.Header.Get(headers.HeaderEventIDSeed),
+					index,
+				),
+			})
+		}
+
+		if err := a.admit(ctx, batch); err != nil {
+			return err
+		}
+
+		for _, in := range batch {
+			evt := in.evt
+
 			ctx, span := itrace.UserTracer().Provider().
 				Tracer(consts.OtelScopeEvent).
 				Start(ctx, consts.OtelSpanEvent,
-					trace.WithTimestamp(ts),
+					trace.WithTimestamp(in.ts),
 					trace.WithNewRoot(),
 					trace.WithLinks(trace.LinkFromContext(ctx)),
 				)
 			defer span.End()
 
-			seed := event.SeededIDFromString(
-				r.Header.Get(headers.HeaderEventIDSeed),
-				index,
-			)
-			id, err := a.handler(ctx, &evt, seed)
-			if err != nil {
-				a.log.Error("error handling event", "error", err, "event", evt.Name)
-				return err
+			id := in.id
+			if !in.deferred {
+				var err error
+				id, err = a.handler(ctx, &evt, in.seed)
+				if err != nil {
+					a.log.Error("error handling event", "error", err, "event", evt.Name)
+					return err
+				}
 			}
 			idChan <- struct {
 				int
 				string
-			}{s.N, id}
+			}{in.n, id}
 		}
 
 		return nil
@@ -289,6 +357,22 @@ func (a API) ReceiveEvent(w http.ResponseWriter, r *http.Request) {
 		max = len(ids) - 1
 	}
 
+	var admissionErr admissionError
+	if errors.As(err, &admissionErr) {
+		retryAfter := int(math.Ceil(admissionErr.decision.RetryAfter.Seconds()))
+		if retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(apiutil.EventAPIResponse{
+			IDs:    ids[0 : max+1],
+			Status: http.StatusTooManyRequests,
+			Error:  err.Error(),
+		})
+
+		return
+	}
+
 	if err != nil {
 		w.WriteHeader(400)
 		_ = json.NewEncoder(w).Encode(apiutil.EventAPIResponse{
@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/inngest/inngest/pkg/api"
 	"github.com/inngest/inngest/pkg/api/apiv1/apiv1auth"
+	"github.com/inngest/inngest/pkg/audit"
 	"github.com/inngest/inngest/pkg/consts"
 	"github.com/inngest/inngest/pkg/cqrs"
 	"github.com/inngest/inngest/pkg/event"
@@ -96,6 +97,10 @@ type Opts struct {
 
 	// MetadataOpts represents the required opts for the metadadata API
 	MetadataOpts MetadataOpts
+
+	// AuditLog, if set, records mutating API calls to the audit log and
+	// enables the audit log API.
+	AuditLog cqrs.AuditLogReadWriter
 }
 
 type checkpointQueue struct {
@@ -204,23 +209,37 @@ func (a *router) setup() {
 				}
 			}
 
-			r.Post("/signals", a.receiveSignal)
+			// Record operator actions to the audit log.  Checkpointing and
+			// userland traces are SDK traffic, so are not audited.
+			r.Group(func(r chi.Router) {
+				if a.opts.AuditLog != nil {
+					r.Use(audit.Middleware(a.opts.AuditLog, audit.SourceAPIv1))
+				}
+
+				r.Post("/signals", a.receiveSignal)
 
-			r.Get("/events", a.getEvents)
-			r.Get("/events/{eventID}", a.getEvent)
-			r.Get("/events/{eventID}/runs", a.getEventRuns)
-			r.Get("/runs/{runID}", a.GetFunctionRun)
-			r.Delete("/runs/{runID}", a.cancelFunctionRun)
-			r.Get("/runs/{runID}/jobs", a.GetFunctionRunJobs)
-			r.Post("/runs/{runID}/metadata", a.addRunMetadata)
+				r.Get("/events", a.getEvents)
+				r.Get("/events/{eventID}", a.getEvent)
+				r.Get("/events/{eventID}/runs", a.getEventRuns)
+				r.Get("/runs/{runID}", a.GetFunctionRun)
+				r.Delete("/runs/{runID}", a.cancelFunctionRun)
+				r.Get("/runs/{runID}/jobs", a.GetFunctionRunJobs)
+				r.Get("/runs/{runID}/trace/export", a.exportRunTrace)
+				r.Post("/runs/{runID}/metadata", a.addRunMetadata)
 
-			r.Get("/apps/{appName}/functions", a.GetAppFunctions) // Returns an app and all of its functions.
+				r.Get("/apps/{appName}/functions", a.GetAppFunctions) // Returns an app and all of its functions.
 
-			r.Post("/cancellations", a.createCancellation)
-			r.Get("/cancellations", a.getCancellations)
-			r.Delete("/cancellations/{id}", a.deleteCancellation)
+				r.Post("/cancellations", a.createCancellation)
+				r.Get("/cancellations", a.getCancellations)
+				r.Delete("/cancellations/{id}", a.deleteCancellation)
 
-			r.Get("/prom/{env}", a.promScrape)
+				r.Get("/prom/{env}", a.promScrape)
+
+				if a.opts.AuditLog != nil {
+					r.Get("/audit-logs", a.getAuditLogs)
+					r.Get("/audit-logs/export", a.exportAuditLogs)
+				}
+			})
 
 			r.Post("/traces/userland", a.traces)
 		})
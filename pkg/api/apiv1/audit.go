@@ -0,0 +1,115 @@
+package apiv1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/inngest/inngest/pkg/audit"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/dateutil"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/publicerr"
+	"github.com/inngest/inngest/pkg/util"
+	"github.com/oklog/ulid/v2"
+)
+
+// GetAuditLogs returns audit log entries in reverse chronological order, with
+// optional pagination and filtering params.
+func (a API) GetAuditLogs(ctx context.Context, opts cqrs.GetAuditLogsOpt) ([]*cqrs.AuditLog, error) {
+	if a.opts.AuditLog == nil {
+		return nil, publicerr.Errorf(500, "No audit log specified")
+	}
+
+	entries, err := a.opts.AuditLog.GetAuditLogs(ctx, opts)
+	if err != nil {
+		logger.StdlibLogger(ctx).Error("error querying audit logs", "error", err)
+		return nil, publicerr.Wrap(err, 500, "Unable to query audit logs")
+	}
+	return entries, nil
+}
+
+func (a router) getAuditLogs(w http.ResponseWriter, r *http.Request) {
+	if a.opts.RateLimited(r, w, "/v1/audit-logs") {
+		return
+	}
+
+	opts, err := auditLogsOpt(r)
+	if err != nil {
+		_ = publicerr.WriteHTTP(w, err)
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.FormValue("limit"))
+	if limit == 0 {
+		limit = cqrs.DefaultAuditLogs
+	}
+	opts.Limit = uint(util.Bound(limit, 1, cqrs.MaxAuditLogs))
+
+	entries, err := a.API.GetAuditLogs(r.Context(), opts)
+	if err != nil {
+		_ = publicerr.WriteHTTP(w, err)
+		return
+	}
+
+	// Do not cache this response.
+	_ = WriteResponse(w, entries)
+}
+
+// exportAuditLogs streams every audit log entry matching the filter params as
+// newline-delimited JSON.
+func (a router) exportAuditLogs(w http.ResponseWriter, r *http.Request) {
+	if a.opts.RateLimited(r, w, "/v1/audit-logs/export") {
+		return
+	}
+
+	ctx := r.Context()
+	opts, err := auditLogsOpt(r)
+	if err != nil {
+		_ = publicerr.WriteHTTP(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", audit.ContentTypeNDJSON)
+	w.Header().Set("Content-Disposition", `attachment; filename="audit-logs.ndjson"`)
+	if err := audit.WriteNDJSON(ctx, w, a.opts.AuditLog, opts); err != nil {
+		// Headers have likely been written, so the error can only be logged.
+		logger.StdlibLogger(ctx).Error("error exporting audit logs", "error", err)
+	}
+}
+
+// auditLogsOpt parses the filter query params shared by the audit log
+// endpoints.
+func auditLogsOpt(r *http.Request) (cqrs.GetAuditLogsOpt, error) {
+	opts := cqrs.GetAuditLogsOpt{
+		Actor:  r.FormValue("actor"),
+		Action: r.FormValue("action"),
+		Source: r.FormValue("source"),
+	}
+
+	if cursor := r.FormValue("cursor"); cursor != "" {
+		parsed, err := ulid.Parse(cursor)
+		if err != nil {
+			return opts, publicerr.Wrap(err, 400, "Invalid cursor query parameter")
+		}
+		opts.Cursor = &parsed
+	}
+
+	if before := r.FormValue("before"); before != "" {
+		parsed, err := dateutil.Parse(before)
+		if err != nil {
+			return opts, publicerr.Wrap(err, 400, "Invalid before query parameter")
+		}
+		opts.Before = parsed
+	}
+
+	if after := r.FormValue("after"); after != "" {
+		parsed, err := dateutil.Parse(after)
+		if err != nil {
+			return opts, publicerr.Wrap(err, 400, "Invalid after query parameter")
+		}
+		opts.After = parsed
+	}
+
+	return opts, nil
+}
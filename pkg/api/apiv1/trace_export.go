@@ -0,0 +1,66 @@
+package apiv1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/publicerr"
+	"github.com/inngest/inngest/pkg/tracing/traceexport"
+	"github.com/oklog/ulid/v2"
+)
+
+// exportRunTrace downloads a single run's trace as OTLP JSON or in the Chrome
+// trace event format, for sharing with vendors or opening in trace viewers.
+func (a router) exportRunTrace(w http.ResponseWriter, r *http.Request) {
+	if a.opts.RateLimited(r, w, "/v1/runs/{runID}/trace/export") {
+		return
+	}
+
+	ctx := r.Context()
+	auth, err := a.opts.AuthFinder(ctx)
+	if err != nil {
+		_ = publicerr.WriteHTTP(w, publicerr.Wrap(err, 401, "No auth found"))
+		return
+	}
+
+	runID, err := ulid.Parse(chi.URLParam(r, "runID"))
+	if err != nil {
+		_ = publicerr.WriteHTTP(w, publicerr.Wrapf(err, 400, "Invalid run ID: %s", chi.URLParam(r, "runID")))
+		return
+	}
+
+	fr, err := a.opts.TraceReader.GetRun(ctx, runID, auth.AccountID(), auth.WorkspaceID())
+	if err != nil || fr.WorkspaceID != auth.WorkspaceID() {
+		_ = publicerr.WriteHTTP(w, publicerr.Wrapf(err, 404, "Unable to load function run: %s", runID))
+		return
+	}
+
+	format, err := traceexport.ParseFormat(r.FormValue("format"))
+	if err != nil {
+		_ = publicerr.WriteHTTP(w, publicerr.Wrap(err, 400, "Invalid format query parameter"))
+		return
+	}
+
+	includeIO, _ := strconv.ParseBool(r.FormValue("include_io"))
+
+	root, err := a.opts.TraceReader.GetSpansByRunID(ctx, runID)
+	if err != nil || root == nil {
+		_ = publicerr.WriteHTTP(w, publicerr.Wrapf(err, 404, "Trace not found: %s", runID))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, format.Filename(runID.String())))
+	err = traceexport.Write(ctx, w, root, traceexport.Opts{
+		Format:    format,
+		IncludeIO: includeIO,
+		Outputs:   a.opts.TraceReader,
+	})
+	if err != nil {
+		logger.StdlibLogger(ctx).Error("error exporting run trace", "error", err, "run_id", runID)
+		_ = publicerr.WriteHTTP(w, publicerr.Wrap(err, 500, "Unable to export trace"))
+	}
+}
@@ -0,0 +1,77 @@
+package apiv1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/api/apiv1/apiv1auth"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// exportAuth authenticates as a single account and workspace.
+type exportAuth struct {
+	accountID, workspaceID uuid.UUID
+}
+
+func (a exportAuth) AccountID() uuid.UUID   { return a.accountID }
+func (a exportAuth) WorkspaceID() uuid.UUID { return a.workspaceID }
+
+// exportTraceReader stubs the trace reader with runs by workspace.
+type exportTraceReader struct {
+	cqrs.TraceReader
+	runs map[ulid.ULID]*cqrs.FunctionRun
+	// spansLoaded is set once spans are read.
+	spansLoaded bool
+}
+
+func (r *exportTraceReader) GetRun(_ context.Context, runID ulid.ULID, _, workspaceID uuid.UUID) (*cqrs.FunctionRun, error) {
+	fr, ok := r.runs[runID]
+	if !ok || fr.WorkspaceID != workspaceID {
+		return nil, errors.New("run not found")
+	}
+	return fr, nil
+}
+
+func (r *exportTraceReader) GetSpansByRunID(context.Context, ulid.ULID) (*cqrs.OtelSpan, error) {
+	r.spansLoaded = true
+	return nil, nil
+}
+
+func TestExportRunTraceChecksWorkspace(t *testing.T) {
+	auth := exportAuth{accountID: uuid.New(), workspaceID: uuid.New()}
+	other := newRunID()
+	tr := &exportTraceReader{runs: map[ulid.ULID]*cqrs.FunctionRun{
+		other: {RunID: other, WorkspaceID: uuid.New()},
+	}}
+	r := router{API: &API{opts: Opts{
+		AuthFinder:  func(context.Context) (apiv1auth.V1Auth, error) { return auth, nil },
+		RateLimited: noopRateChecker,
+		TraceReader: tr,
+	}}}
+
+	for name, runID := range map[string]ulid.ULID{
+		"unknown run":              newRunID(),
+		"run in another workspace": other,
+	} {
+		t.Run(name, func(t *testing.T) {
+			tr.spansLoaded = false
+
+			req := httptest.NewRequest(http.MethodGet, "/runs/"+runID.String()+"/trace/export", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("runID", runID.String())
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			r.exportRunTrace(w, req)
+			require.Equal(t, http.StatusNotFound, w.Code)
+			require.False(t, tr.spansLoaded)
+		})
+	}
+}
@@ -46,6 +46,15 @@ type APIServiceOptions struct {
 	// ingesting events will not work.
 	RequireKeys bool
 
+	// Admission decides whether incoming events are accepted, deferred or
+	// rejected based on queue back-pressure.  If nil, all events are accepted.
+	Admission AdmissionController
+
+	// MaxDeferredEvents and MaxEventDeferral bound events whose fan-out is
+	// deferred by Admission.
+	MaxDeferredEvents int
+	MaxEventDeferral  time.Duration
+
 	Logger logger.Logger
 }
 
@@ -55,6 +64,9 @@ func NewService(opts APIServiceOptions) service.Service {
 		mounts:         opts.Mounts,
 		localEventKeys: opts.LocalEventKeys,
 		requireKeys:    opts.RequireKeys,
+		admission:      opts.Admission,
+		maxDeferred:    opts.MaxDeferredEvents,
+		maxDeferral:    opts.MaxEventDeferral,
 		log:            opts.Logger,
 	}
 }
@@ -76,7 +88,12 @@ type apiServer struct {
 	// the server will still boot but core actions such as syncing, runs, and
 	// ingesting events will not work.
 	requireKeys bool
-	log         logger.Logger
+
+	admission   AdmissionController
+	maxDeferred int
+	maxDeferral time.Duration
+
+	log logger.Logger
 }
 
 func (a *apiServer) Name() string {
@@ -92,6 +109,10 @@ func (a *apiServer) Pre(ctx context.Context) error {
 		EventHandler:   a.handleEvent,
 		LocalEventKeys: a.localEventKeys,
 		RequireKeys:    a.requireKeys,
+
+		Admission:         a.admission,
+		MaxDeferredEvents: a.maxDeferred,
+		MaxEventDeferral:  a.maxDeferral,
 	})
 	if err != nil {
 		return err
@@ -33,6 +33,9 @@ const (
 	ErrorIdempotencyConflict   = "idempotency_conflict"
 	ErrorRunAlreadyCancelled   = "run_already_cancelled"
 	ErrorRunAlreadyEnded       = "run_already_ended"
+	ErrorBulkOperationEnded    = "bulk_operation_ended"
+	ErrorWaitNotResolvable     = "wait_not_resolvable"
+	ErrorDeliveryPending       = "delivery_pending"
 
 	// 429 Too Many Requests errors
 	ErrorRateLimited = "rate_limited"
@@ -0,0 +1,388 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/alerts"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAlertsLimit = 20
+	maxAlertsLimit     = 100
+)
+
+func (s *Service) CreateAlertRule(ctx context.Context, req *apiv2.CreateAlertRuleRequest) (*apiv2.CreateAlertRuleResponse, error) {
+	if req.Name == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Name is required")
+	}
+	if req.FunctionId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Function ID is required")
+	}
+	if req.Metric == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Metric is required")
+	}
+	if len(req.Channels) == 0 {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Channels are required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_CreateAlertRule_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no alert rule was created.")
+	}
+
+	if s.alerts == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Alerts are not yet implemented")
+	}
+
+	window, err := alertWindowFromAPI(req.WindowSeconds)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+	skipReason, err := alertSkipReasonFromAPI(req.SkipReason)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+
+	fn, err := s.functions.GetFunction(ctx, req.FunctionId)
+	if err != nil {
+		return nil, s.getFunctionError(err)
+	}
+
+	r, err := s.alerts.CreateRule(ctx, CreateAlertRuleOpts{
+		Name:         req.Name,
+		FunctionID:   fn.ID,
+		FunctionSlug: fn.Slug,
+		Metric:       alerts.Metric(req.Metric),
+		SkipReason:   skipReason,
+		Threshold:    req.Threshold,
+		Window:       window,
+		Channels:     alertChannelsFromAPI(req.Channels),
+		Disabled:     req.GetDisabled(),
+	})
+	if err != nil {
+		if errors.Is(err, alerts.ErrInvalidRule) {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to create alert rule")
+	}
+
+	return &apiv2.CreateAlertRuleResponse{
+		Data:     toAPIAlertRule(*r),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) ListAlertRules(ctx context.Context, req *apiv2.ListAlertRulesRequest) (*apiv2.ListAlertRulesResponse, error) {
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListAlertRules_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no alert rules were fetched.")
+	}
+
+	if s.alerts == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Alerts are not yet implemented")
+	}
+
+	rules, err := s.alerts.ListRules(ctx)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch alert rules")
+	}
+
+	data := make([]*apiv2.AlertRule, 0, len(rules))
+	for _, r := range rules {
+		data = append(data, toAPIAlertRule(r))
+	}
+
+	return &apiv2.ListAlertRulesResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) GetAlertRule(ctx context.Context, req *apiv2.GetAlertRuleRequest) (*apiv2.GetAlertRuleResponse, error) {
+	if req.RuleId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Rule ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_GetAlertRule_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no alert rule was fetched.")
+	}
+
+	if s.alerts == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Alerts are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.RuleId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Rule ID must be a valid ULID")
+	}
+
+	r, err := s.alerts.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, alerts.ErrRuleNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Alert rule not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch alert rule")
+	}
+
+	return &apiv2.GetAlertRuleResponse{
+		Data:     toAPIAlertRule(*r),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) UpdateAlertRule(ctx context.Context, req *apiv2.UpdateAlertRuleRequest) (*apiv2.UpdateAlertRuleResponse, error) {
+	if req.RuleId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Rule ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_UpdateAlertRule_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no alert rule was updated.")
+	}
+
+	if s.alerts == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Alerts are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.RuleId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Rule ID must be a valid ULID")
+	}
+
+	opts := alerts.UpdateRuleOpts{
+		Name:      req.Name,
+		Threshold: req.Threshold,
+		Disabled:  req.Disabled,
+	}
+	if len(req.Channels) > 0 {
+		opts.Channels = alertChannelsFromAPI(req.Channels)
+	}
+	if req.WindowSeconds != nil {
+		window, err := alertWindowFromAPI(req.WindowSeconds)
+		if err != nil {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+		}
+		opts.Window = &window
+	}
+	if opts.SkipReason, err = alertSkipReasonFromAPI(req.SkipReason); err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+
+	r, err := s.alerts.UpdateRule(ctx, id, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, alerts.ErrRuleNotFound):
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Alert rule not found")
+		case errors.Is(err, alerts.ErrInvalidRule):
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to update alert rule")
+	}
+
+	return &apiv2.UpdateAlertRuleResponse{
+		Data:     toAPIAlertRule(*r),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) DeleteAlertRule(ctx context.Context, req *apiv2.DeleteAlertRuleRequest) (*apiv2.DeleteAlertRuleResponse, error) {
+	if req.RuleId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Rule ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_DeleteAlertRule_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no alert rule was deleted.")
+	}
+
+	if s.alerts == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Alerts are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.RuleId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Rule ID must be a valid ULID")
+	}
+
+	if err := s.alerts.DeleteRule(ctx, id); err != nil {
+		if errors.Is(err, alerts.ErrRuleNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Alert rule not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to delete alert rule")
+	}
+
+	return &apiv2.DeleteAlertRuleResponse{
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) ListAlerts(ctx context.Context, req *apiv2.ListAlertsRequest) (*apiv2.ListAlertsResponse, error) {
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListAlerts_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no alerts were fetched.")
+	}
+
+	if s.alerts == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Alerts are not yet implemented")
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultAlertsLimit
+	}
+	if limit < 1 {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Limit must be at least 1")
+	}
+	if limit > maxAlertsLimit {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Limit cannot exceed %d", maxAlertsLimit))
+	}
+
+	opts := alerts.ListAlertsOpts{Limit: limit}
+	if req.GetRuleId() != "" {
+		ruleID, err := ulid.Parse(req.GetRuleId())
+		if err != nil {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Rule ID must be a valid ULID")
+		}
+		opts.RuleID = &ruleID
+	}
+	if req.GetCursor() != "" {
+		cursor, err := ulid.Parse(req.GetCursor())
+		if err != nil {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Cursor is invalid")
+		}
+		opts.Cursor = &cursor
+	}
+
+	result, hasMore, err := s.alerts.ListAlerts(ctx, opts)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch alerts")
+	}
+
+	data := make([]*apiv2.Alert, 0, len(result))
+	for _, a := range result {
+		data = append(data, toAPIAlert(a))
+	}
+
+	page := &apiv2.Page{
+		HasMore: hasMore,
+		Limit:   int32(limit),
+	}
+	if hasMore && len(result) > 0 {
+		nextCursor := result[len(result)-1].ID.String()
+		page.Cursor = &nextCursor
+	}
+
+	return &apiv2.ListAlertsResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+		Page:     page,
+	}, nil
+}
+
+func alertChannelsFromAPI(channels []string) []alerts.Channel {
+	result := make([]alerts.Channel, 0, len(channels))
+	for _, c := range channels {
+		result = append(result, alerts.Channel(c))
+	}
+	return result
+}
+
+func alertWindowFromAPI(seconds *int64) (time.Duration, error) {
+	if seconds == nil {
+		return 0, nil
+	}
+	if *seconds < 1 {
+		return 0, fmt.Errorf("window must be at least 1 second")
+	}
+	return time.Duration(*seconds) * time.Second, nil
+}
+
+func alertSkipReasonFromAPI(reason *string) (*enums.SkipReason, error) {
+	if reason == nil {
+		return nil, nil
+	}
+	r, err := enums.SkipReasonString(*reason)
+	if err != nil || r == enums.SkipReasonNone {
+		return nil, fmt.Errorf("unknown skip reason %q", *reason)
+	}
+	return &r, nil
+}
+
+func toAPIAlertRule(r alerts.Rule) *apiv2.AlertRule {
+	result := &apiv2.AlertRule{
+		Id:           r.ID.String(),
+		Name:         r.Name,
+		FunctionId:   r.FunctionID.String(),
+		FunctionSlug: r.FunctionSlug,
+		Metric:       string(r.Metric),
+		Threshold:    r.Threshold,
+		Channels:     make([]string, 0, len(r.Channels)),
+		Disabled:     r.Disabled,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+	}
+	for _, c := range r.Channels {
+		result.Channels = append(result.Channels, string(c))
+	}
+	if r.Window > 0 {
+		seconds := int64(r.Window / time.Second)
+		result.WindowSeconds = &seconds
+	}
+	if r.SkipReason != nil {
+		reason := r.SkipReason.String()
+		result.SkipReason = &reason
+	}
+	if !r.State.EvaluatedAt.IsZero() {
+		result.Status = apiv2.AlertStatus_ALERT_STATUS_RESOLVED
+		result.Value = &r.State.Value
+		result.EvaluatedAt = timestamppb.New(r.State.EvaluatedAt)
+	}
+	if r.State.Firing() {
+		alertID := r.State.AlertID.String()
+		result.Status = apiv2.AlertStatus_ALERT_STATUS_FIRING
+		result.AlertId = &alertID
+	}
+	return result
+}
+
+func toAPIAlert(a alerts.Alert) *apiv2.Alert {
+	result := &apiv2.Alert{
+		Id:           a.ID.String(),
+		RuleId:       a.RuleID.String(),
+		RuleName:     a.RuleName,
+		FunctionId:   a.FunctionID.String(),
+		FunctionSlug: a.FunctionSlug,
+		Metric:       string(a.Metric),
+		Threshold:    a.Threshold,
+		Value:        a.Value,
+		PeakValue:    a.PeakValue,
+		Status:       toAPIAlertStatus(a.Status),
+		FiredAt:      timestamppb.New(a.FiredAt),
+	}
+	if a.ResolvedAt != nil {
+		result.ResolvedAt = timestamppb.New(*a.ResolvedAt)
+	}
+	return result
+}
+
+func toAPIAlertStatus(status alerts.AlertStatus) apiv2.AlertStatus {
+	switch status {
+	case alerts.AlertStatusFiring:
+		return apiv2.AlertStatus_ALERT_STATUS_FIRING
+	case alerts.AlertStatusResolved:
+		return apiv2.AlertStatus_ALERT_STATUS_RESOLVED
+	default:
+		return apiv2.AlertStatus_ALERT_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,339 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/alerts"
+	"github.com/inngest/inngest/pkg/inngest"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateAlertRule(t *testing.T) {
+	ruleID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	fnID := uuid.MustParse("6f6f7d6d-7a1e-4f7e-9a1b-3f0e3f5c1a2b")
+	createdAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("creates a rule for the function", func(t *testing.T) {
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunction", mock.Anything, "my-app-charge").
+			Return(inngest.DeployedFunction{ID: fnID, Slug: "my-app-charge"}, nil).Once()
+
+		paused := enums.SkipReasonFunctionPaused
+		provider := &mockAlertProvider{}
+		provider.On("CreateRule", mock.Anything, CreateAlertRuleOpts{
+			Name:         "Paused runs",
+			FunctionID:   fnID,
+			FunctionSlug: "my-app-charge",
+			Metric:       alerts.MetricSkippedCount,
+			SkipReason:   &paused,
+			Threshold:    10,
+			Window:       15 * time.Minute,
+			Channels:     []alerts.Channel{alerts.ChannelEvent, alerts.ChannelWebhook},
+		}).Return(&alerts.Rule{
+			ID:           ruleID,
+			Name:         "Paused runs",
+			FunctionID:   fnID,
+			FunctionSlug: "my-app-charge",
+			Metric:       alerts.MetricSkippedCount,
+			SkipReason:   &paused,
+			Threshold:    10,
+			Window:       15 * time.Minute,
+			Channels:     []alerts.Channel{alerts.ChannelEvent, alerts.ChannelWebhook},
+			CreatedAt:    createdAt,
+			UpdatedAt:    createdAt,
+		}, nil).Once()
+		t.Cleanup(func() {
+			functions.AssertExpectations(t)
+			provider.AssertExpectations(t)
+		})
+
+		window := int64(900)
+		reason := "FunctionPaused"
+		service := NewService(ServiceOptions{Alerts: provider, Functions: functions})
+		resp, err := service.CreateAlertRule(context.Background(), &apiv2.CreateAlertRuleRequest{
+			Name:          "Paused runs",
+			FunctionId:    "my-app-charge",
+			Metric:        "skipped_count",
+			Threshold:     10,
+			WindowSeconds: &window,
+			SkipReason:    &reason,
+			Channels:      []string{"event", "webhook"},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, ruleID.String(), resp.Data.Id)
+		require.Equal(t, fnID.String(), resp.Data.FunctionId)
+		require.Equal(t, int64(900), resp.Data.GetWindowSeconds())
+		require.Equal(t, "FunctionPaused", resp.Data.GetSkipReason())
+		require.Equal(t, []string{"event", "webhook"}, resp.Data.Channels)
+		// The rule hasn't been evaluated yet.
+		require.Equal(t, apiv2.AlertStatus_ALERT_STATUS_UNSPECIFIED, resp.Data.Status)
+		require.Nil(t, resp.Data.Value)
+	})
+
+	t.Run("validates request", func(t *testing.T) {
+		zero := int64(0)
+		reason := "Sleeping"
+		valid := func(modify func(r *apiv2.CreateAlertRuleRequest)) *apiv2.CreateAlertRuleRequest {
+			r := &apiv2.CreateAlertRuleRequest{Name: "n", FunctionId: "fn", Metric: "failure_rate", Channels: []string{"event"}}
+			modify(r)
+			return r
+		}
+		tests := []struct {
+			name    string
+			req     *apiv2.CreateAlertRuleRequest
+			message string
+		}{
+			{name: "missing name", req: valid(func(r *apiv2.CreateAlertRuleRequest) { r.Name = "" }), message: "Name is required"},
+			{name: "missing function", req: valid(func(r *apiv2.CreateAlertRuleRequest) { r.FunctionId = "" }), message: "Function ID is required"},
+			{name: "missing metric", req: valid(func(r *apiv2.CreateAlertRuleRequest) { r.Metric = "" }), message: "Metric is required"},
+			{name: "missing channels", req: valid(func(r *apiv2.CreateAlertRuleRequest) { r.Channels = nil }), message: "Channels are required"},
+			{name: "zero window", req: valid(func(r *apiv2.CreateAlertRuleRequest) { r.WindowSeconds = &zero }), message: "window must be at least 1 second"},
+			{name: "unknown skip reason", req: valid(func(r *apiv2.CreateAlertRuleRequest) { r.SkipReason = &reason }), message: "unknown skip reason"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{Alerts: &mockAlertProvider{}, Functions: &mockFunctionProvider{}})
+				resp, err := service.CreateAlertRule(context.Background(), test.req)
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("maps errors", func(t *testing.T) {
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunction", mock.Anything, "missing").Return(nil, ErrFunctionNotFound).Once()
+		functions.On("GetFunction", mock.Anything, "fn").Return(inngest.DeployedFunction{ID: fnID}, nil).Once()
+
+		provider := &mockAlertProvider{}
+		provider.On("CreateRule", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: unknown metric latency", alerts.ErrInvalidRule)).Once()
+
+		service := NewService(ServiceOptions{Alerts: provider, Functions: functions})
+		resp, err := service.CreateAlertRule(context.Background(), &apiv2.CreateAlertRuleRequest{
+			Name: "n", FunctionId: "missing", Metric: "failure_rate", Channels: []string{"event"},
+		})
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Function not found")
+
+		resp, err = service.CreateAlertRule(context.Background(), &apiv2.CreateAlertRuleRequest{
+			Name: "n", FunctionId: "fn", Metric: "latency", Channels: []string{"event"},
+		})
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "unknown metric latency")
+	})
+
+	t.Run("requires provider", func(t *testing.T) {
+		service := NewService(ServiceOptions{})
+		resp, err := service.CreateAlertRule(context.Background(), &apiv2.CreateAlertRuleRequest{
+			Name: "n", FunctionId: "fn", Metric: "failure_rate", Channels: []string{"event"},
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "not yet implemented")
+	})
+
+	t.Run("applies rate limit", func(t *testing.T) {
+		rateLimiter := &mockRateLimitProvider{}
+		rateLimiter.On("CheckRateLimit", mock.Anything, apiv2.V2_CreateAlertRule_FullMethodName).
+			Return(RateLimitResult{Limited: true}).Once()
+		t.Cleanup(func() {
+			rateLimiter.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{Alerts: &mockAlertProvider{}, RateLimitProvider: rateLimiter})
+		resp, err := service.CreateAlertRule(context.Background(), &apiv2.CreateAlertRuleRequest{
+			Name: "n", FunctionId: "fn", Metric: "failure_rate", Channels: []string{"event"},
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "API rate limit exceeded")
+	})
+}
+
+func TestService_GetAlertRule(t *testing.T) {
+	ruleID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	alertID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAW")
+	evaluatedAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("includes the latest evaluation", func(t *testing.T) {
+		provider := &mockAlertProvider{}
+		provider.On("GetRule", mock.Anything, ruleID).Return(&alerts.Rule{
+			ID:     ruleID,
+			Metric: alerts.MetricBacklogAge,
+			State:  alerts.State{AlertID: &alertID, Value: 120, EvaluatedAt: evaluatedAt},
+		}, nil).Once()
+
+		service := NewService(ServiceOptions{Alerts: provider})
+		resp, err := service.GetAlertRule(context.Background(), &apiv2.GetAlertRuleRequest{RuleId: ruleID.String()})
+
+		require.NoError(t, err)
+		require.Equal(t, apiv2.AlertStatus_ALERT_STATUS_FIRING, resp.Data.Status)
+		require.Equal(t, alertID.String(), resp.Data.GetAlertId())
+		require.Equal(t, 120.0, resp.Data.GetValue())
+		require.Equal(t, evaluatedAt, resp.Data.EvaluatedAt.AsTime())
+		require.Nil(t, resp.Data.WindowSeconds)
+	})
+
+	t.Run("validates rule id", func(t *testing.T) {
+		service := NewService(ServiceOptions{Alerts: &mockAlertProvider{}})
+		resp, err := service.GetAlertRule(context.Background(), &apiv2.GetAlertRuleRequest{RuleId: "nope"})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Rule ID must be a valid ULID")
+	})
+
+	t.Run("maps missing rules", func(t *testing.T) {
+		provider := &mockAlertProvider{}
+		provider.On("GetRule", mock.Anything, ruleID).Return(nil, alerts.ErrRuleNotFound).Once()
+
+		service := NewService(ServiceOptions{Alerts: provider})
+		resp, err := service.GetAlertRule(context.Background(), &apiv2.GetAlertRuleRequest{RuleId: ruleID.String()})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Alert rule not found")
+	})
+}
+
+func TestService_UpdateAlertRule(t *testing.T) {
+	ruleID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	t.Run("updates set fields", func(t *testing.T) {
+		threshold := 0.2
+		window := int64(600)
+		windowDuration := 10 * time.Minute
+		provider := &mockAlertProvider{}
+		provider.On("UpdateRule", mock.Anything, ruleID, alerts.UpdateRuleOpts{
+			Threshold: &threshold,
+			Window:    &windowDuration,
+		}).Return(&alerts.Rule{ID: ruleID, Threshold: 0.2, Window: windowDuration}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{Alerts: provider})
+		resp, err := service.UpdateAlertRule(context.Background(), &apiv2.UpdateAlertRuleRequest{
+			RuleId:        ruleID.String(),
+			Threshold:     &threshold,
+			WindowSeconds: &window,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 0.2, resp.Data.Threshold)
+		require.Equal(t, int64(600), resp.Data.GetWindowSeconds())
+	})
+
+	t.Run("maps provider errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			err     error
+			message string
+		}{
+			{name: "missing rule", err: alerts.ErrRuleNotFound, message: "Alert rule not found"},
+			{name: "invalid rule", err: fmt.Errorf("%w: window must be between 1m0s and 24h0m0s", alerts.ErrInvalidRule), message: "window must be between"},
+			{name: "internal error", err: errors.New("failed"), message: "Unable to update alert rule"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				provider := &mockAlertProvider{}
+				provider.On("UpdateRule", mock.Anything, ruleID, mock.Anything).Return(nil, test.err).Once()
+
+				service := NewService(ServiceOptions{Alerts: provider})
+				resp, err := service.UpdateAlertRule(context.Background(), &apiv2.UpdateAlertRuleRequest{RuleId: ruleID.String()})
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+}
+
+func TestService_DeleteAlertRule(t *testing.T) {
+	ruleID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	provider := &mockAlertProvider{}
+	provider.On("DeleteRule", mock.Anything, ruleID).Return(nil).Once()
+	provider.On("DeleteRule", mock.Anything, ruleID).Return(alerts.ErrRuleNotFound).Once()
+	t.Cleanup(func() {
+		provider.AssertExpectations(t)
+	})
+
+	service := NewService(ServiceOptions{Alerts: provider})
+	resp, err := service.DeleteAlertRule(context.Background(), &apiv2.DeleteAlertRuleRequest{RuleId: ruleID.String()})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Metadata.FetchedAt)
+
+	resp, err = service.DeleteAlertRule(context.Background(), &apiv2.DeleteAlertRuleRequest{RuleId: ruleID.String()})
+	require.Nil(t, resp)
+	require.ErrorContains(t, err, "Alert rule not found")
+}
+
+func TestService_ListAlerts(t *testing.T) {
+	ruleID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	first := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAW")
+	second := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAX")
+	firedAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+	resolvedAt := firedAt.Add(5 * time.Minute)
+
+	t.Run("lists a rule's alerts with a cursor", func(t *testing.T) {
+		provider := &mockAlertProvider{}
+		provider.On("ListAlerts", mock.Anything, alerts.ListAlertsOpts{RuleID: &ruleID, Cursor: &first, Limit: 1}).
+			Return([]alerts.Alert{{
+				ID:         second,
+				RuleID:     ruleID,
+				Metric:     alerts.MetricFailureRate,
+				Threshold:  0.1,
+				Value:      0.2,
+				PeakValue:  0.5,
+				Status:     alerts.AlertStatusResolved,
+				FiredAt:    firedAt,
+				ResolvedAt: &resolvedAt,
+			}}, true, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		rule := ruleID.String()
+		cursor := first.String()
+		limit := int32(1)
+		service := NewService(ServiceOptions{Alerts: provider})
+		resp, err := service.ListAlerts(context.Background(), &apiv2.ListAlertsRequest{
+			RuleId: &rule,
+			Cursor: &cursor,
+			Limit:  &limit,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		require.True(t, resp.Page.HasMore)
+		require.Equal(t, second.String(), resp.Page.GetCursor())
+
+		a := resp.Data[0]
+		require.Equal(t, apiv2.AlertStatus_ALERT_STATUS_RESOLVED, a.Status)
+		require.Equal(t, "failure_rate", a.Metric)
+		require.Equal(t, 0.5, a.PeakValue)
+		require.Equal(t, resolvedAt, a.ResolvedAt.AsTime())
+	})
+
+	t.Run("validates page options", func(t *testing.T) {
+		tooHigh := int32(maxAlertsLimit + 1)
+		service := NewService(ServiceOptions{Alerts: &mockAlertProvider{}})
+		resp, err := service.ListAlerts(context.Background(), &apiv2.ListAlertsRequest{Limit: &tooHigh})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Limit cannot exceed 100")
+	})
+}
@@ -0,0 +1,390 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/bulk"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultBulkRunOperationsLimit = 20
+	maxBulkRunOperationsLimit     = 100
+)
+
+func (s *Service) PreviewBulkRunOperation(ctx context.Context, req *apiv2.PreviewBulkRunOperationRequest) (*apiv2.PreviewBulkRunOperationResponse, error) {
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_PreviewBulkRunOperation_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no runs were counted.")
+	}
+
+	if s.bulkRuns == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Bulk run operations are not yet implemented")
+	}
+
+	filter, err := bulkRunFilterFromAPI(req.GetFilter())
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+
+	count, err := s.bulkRuns.Preview(ctx, filter)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to count matching runs")
+	}
+
+	return &apiv2.PreviewBulkRunOperationResponse{
+		Data: &apiv2.BulkRunOperationPreview{
+			RunCount: int32(count),
+		},
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) CreateBulkRunOperation(ctx context.Context, req *apiv2.CreateBulkRunOperationRequest) (*apiv2.CreateBulkRunOperationResponse, error) {
+	if req.Action == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Action is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_CreateBulkRunOperation_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no bulk operation was created.")
+	}
+
+	if s.bulkRuns == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Bulk run operations are not yet implemented")
+	}
+
+	action, err := bulkRunActionFromAPI(req.Action)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+	filter, err := bulkRunFilterFromAPI(req.GetFilter())
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+
+	rate := int(req.GetRatePerSecond())
+	if req.RatePerSecond != nil && (rate < 1 || rate > bulk.MaxRatePerSecond) {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Rate per second must be between 1 and %d", bulk.MaxRatePerSecond))
+	}
+
+	op, err := s.bulkRuns.Create(ctx, CreateBulkRunOperationOpts{
+		Action:        action,
+		Filter:        filter,
+		RatePerSecond: rate,
+	})
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to create bulk operation")
+	}
+
+	return &apiv2.CreateBulkRunOperationResponse{
+		Data:     toAPIBulkRunOperation(*op),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) ListBulkRunOperations(ctx context.Context, req *apiv2.ListBulkRunOperationsRequest) (*apiv2.ListBulkRunOperationsResponse, error) {
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListBulkRunOperations_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no bulk operations were fetched.")
+	}
+
+	if s.bulkRuns == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Bulk run operations are not yet implemented")
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultBulkRunOperationsLimit
+	}
+	if limit < 1 {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Limit must be at least 1")
+	}
+	if limit > maxBulkRunOperationsLimit {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Limit cannot exceed %d", maxBulkRunOperationsLimit))
+	}
+
+	opts := bulk.ListOpts{Limit: limit}
+	if req.GetCursor() != "" {
+		cursor, err := ulid.Parse(req.GetCursor())
+		if err != nil {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Cursor is invalid")
+		}
+		opts.Cursor = &cursor
+	}
+
+	ops, hasMore, err := s.bulkRuns.List(ctx, opts)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch bulk operations")
+	}
+
+	data := make([]*apiv2.BulkRunOperation, 0, len(ops))
+	for _, op := range ops {
+		data = append(data, toAPIBulkRunOperation(op))
+	}
+
+	page := &apiv2.Page{
+		HasMore: hasMore,
+		Limit:   int32(limit),
+	}
+	if hasMore && len(ops) > 0 {
+		nextCursor := ops[len(ops)-1].ID.String()
+		page.Cursor = &nextCursor
+	}
+
+	return &apiv2.ListBulkRunOperationsResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+		Page:     page,
+	}, nil
+}
+
+func (s *Service) GetBulkRunOperation(ctx context.Context, req *apiv2.GetBulkRunOperationRequest) (*apiv2.GetBulkRunOperationResponse, error) {
+	if req.OperationId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Operation ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_GetBulkRunOperation_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no bulk operation was fetched.")
+	}
+
+	if s.bulkRuns == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Bulk run operations are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.OperationId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Operation ID must be a valid ULID")
+	}
+
+	op, err := s.bulkRuns.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, bulk.ErrOperationNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Bulk operation not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch bulk operation")
+	}
+
+	return &apiv2.GetBulkRunOperationResponse{
+		Data:     toAPIBulkRunOperation(*op),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) AbortBulkRunOperation(ctx context.Context, req *apiv2.AbortBulkRunOperationRequest) (*apiv2.AbortBulkRunOperationResponse, error) {
+	if req.OperationId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Operation ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_AbortBulkRunOperation_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no bulk operation was aborted.")
+	}
+
+	if s.bulkRuns == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Bulk run operations are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.OperationId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Operation ID must be a valid ULID")
+	}
+
+	op, err := s.bulkRuns.Abort(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, bulk.ErrOperationNotFound):
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Bulk operation not found")
+		case errors.Is(err, bulk.ErrOperationEnded):
+			return nil, s.base.NewError(http.StatusConflict, apiv2base.ErrorBulkOperationEnded, "Bulk operation has already ended")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to abort bulk operation")
+	}
+
+	return &apiv2.AbortBulkRunOperationResponse{
+		Data:     toAPIBulkRunOperation(*op),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func bulkRunFilterFromAPI(f *apiv2.BulkRunFilter) (bulk.Filter, error) {
+	if f == nil {
+		f = &apiv2.BulkRunFilter{}
+	}
+	if len(f.GetFunctionId()) > 0 && len(f.GetAppId()) == 0 {
+		return bulk.Filter{}, fmt.Errorf("appId is required when filtering by functionId")
+	}
+
+	from, err := optionalTimestamp(f.GetFrom(), "from")
+	if err != nil {
+		return bulk.Filter{}, err
+	}
+	until, err := optionalTimestamp(f.GetUntil(), "until")
+	if err != nil {
+		return bulk.Filter{}, err
+	}
+	if from != nil && until != nil && !from.Before(*until) {
+		return bulk.Filter{}, fmt.Errorf("from must be before until")
+	}
+
+	status, err := runStatusesFromAPI(f.GetStatus())
+	if err != nil {
+		return bulk.Filter{}, err
+	}
+	timeField, err := runTimeFieldFromAPI(f.GetTimeField())
+	if err != nil {
+		return bulk.Filter{}, err
+	}
+
+	filter := bulk.Filter{
+		AppIDs:      f.GetAppId(),
+		FunctionIDs: f.GetFunctionId(),
+		Status:      status,
+		TimeField:   traceRunTimeFromRunTimeField(timeField),
+		Query:       f.GetQuery(),
+	}
+	if from != nil {
+		filter.From = *from
+	}
+	if until != nil {
+		filter.Until = *until
+	}
+	return filter, nil
+}
+
+func bulkRunActionFromAPI(action string) (enums.BulkOperationAction, error) {
+	switch normalizeRunFilterToken(action) {
+	case "CANCEL":
+		return enums.BulkOperationActionCancel, nil
+	case "RERUN":
+		return enums.BulkOperationActionRerun, nil
+	default:
+		return enums.BulkOperationActionCancel, fmt.Errorf("action is invalid")
+	}
+}
+
+func traceRunTimeFromRunTimeField(field RunTimeField) enums.TraceRunTime {
+	switch field {
+	case RunTimeFieldStartedAt:
+		return enums.TraceRunTimeStartedAt
+	case RunTimeFieldEndedAt:
+		return enums.TraceRunTimeEndedAt
+	default:
+		return enums.TraceRunTimeQueuedAt
+	}
+}
+
+func toAPIBulkRunOperation(op bulk.Operation) *apiv2.BulkRunOperation {
+	result := &apiv2.BulkRunOperation{
+		Id:            op.ID.String(),
+		Action:        toAPIBulkRunOperationAction(op.Action),
+		Status:        toAPIBulkRunOperationStatus(op.Status),
+		Filter:        toAPIBulkRunFilter(op.Filter),
+		RatePerSecond: int32(op.RatePerSecond),
+		Total:         int32(op.Total),
+		Processed:     int32(op.Processed),
+		Succeeded:     int32(op.Succeeded),
+		Skipped:       int32(op.Skipped),
+		Failed:        int32(op.Failed),
+		CreatedAt:     timestamppb.New(op.CreatedAt),
+		UpdatedAt:     timestamppb.New(op.UpdatedAt),
+	}
+	if op.Error != "" {
+		result.Error = &op.Error
+	}
+	if op.EndedAt != nil {
+		result.EndedAt = timestamppb.New(*op.EndedAt)
+	}
+	return result
+}
+
+func toAPIBulkRunFilter(f bulk.Filter) *apiv2.BulkRunFilter {
+	result := &apiv2.BulkRunFilter{
+		AppId:      f.AppIDs,
+		FunctionId: f.FunctionIDs,
+		Status:     make([]string, 0, len(f.Status)),
+		From:       optionalAPITimestamp(f.From),
+		Until:      optionalAPITimestamp(f.Until),
+		TimeField:  toAPIRunTimeField(f.TimeField),
+	}
+	for _, status := range f.Status {
+		result.Status = append(result.Status, toAPIRunStatusFilter(status))
+	}
+	if f.Query != "" {
+		result.Query = &f.Query
+	}
+	return result
+}
+
+func toAPIRunTimeField(field enums.TraceRunTime) string {
+	switch field {
+	case enums.TraceRunTimeStartedAt:
+		return "startedAt"
+	case enums.TraceRunTimeEndedAt:
+		return "endedAt"
+	default:
+		return "queuedAt"
+	}
+}
+
+// toAPIRunStatusFilter is the inverse of runStatusesFromAPI.
+func toAPIRunStatusFilter(status enums.RunStatus) string {
+	switch status {
+	case enums.RunStatusScheduled:
+		return "QUEUED"
+	case enums.RunStatusRunning:
+		return "RUNNING"
+	case enums.RunStatusCompleted:
+		return "COMPLETED"
+	case enums.RunStatusFailed:
+		return "FAILED"
+	case enums.RunStatusCancelled:
+		return "CANCELLED"
+	default:
+		return status.String()
+	}
+}
+
+func toAPIBulkRunOperationAction(action enums.BulkOperationAction) apiv2.BulkRunOperationAction {
+	switch action {
+	case enums.BulkOperationActionCancel:
+		return apiv2.BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_CANCEL
+	case enums.BulkOperationActionRerun:
+		return apiv2.BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_RERUN
+	default:
+		return apiv2.BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_UNSPECIFIED
+	}
+}
+
+func toAPIBulkRunOperationStatus(status enums.BulkOperationStatus) apiv2.BulkRunOperationStatus {
+	switch status {
+	case enums.BulkOperationStatusRunning:
+		return apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_RUNNING
+	case enums.BulkOperationStatusCompleted:
+		return apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_COMPLETED
+	case enums.BulkOperationStatusAborted:
+		return apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_ABORTED
+	case enums.BulkOperationStatusFailed:
+		return apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_FAILED
+	default:
+		return apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_UNSPECIFIED
+	}
+}
+
+func optionalAPITimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,317 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/bulk"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_PreviewBulkRunOperation(t *testing.T) {
+	from := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+	until := from.Add(time.Hour)
+
+	t.Run("counts matching runs", func(t *testing.T) {
+		provider := &mockBulkRunOperationProvider{}
+		provider.On("Preview", mock.Anything, bulk.Filter{
+			AppIDs:      []string{"app"},
+			FunctionIDs: []string{"fn"},
+			Status:      []enums.RunStatus{enums.RunStatusFailed},
+			TimeField:   enums.TraceRunTimeStartedAt,
+			From:        from,
+			Until:       until,
+			Query:       `event.data.userId == "123"`,
+		}).Return(42, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		query := `event.data.userId == "123"`
+		service := NewService(ServiceOptions{BulkRuns: provider})
+		resp, err := service.PreviewBulkRunOperation(context.Background(), &apiv2.PreviewBulkRunOperationRequest{
+			Filter: &apiv2.BulkRunFilter{
+				AppId:      []string{"app"},
+				FunctionId: []string{"fn"},
+				Status:     []string{"failed"},
+				From:       timestamppb.New(from),
+				Until:      timestamppb.New(until),
+				TimeField:  "startedAt",
+				Query:      &query,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int32(42), resp.Data.RunCount)
+		require.NotNil(t, resp.Metadata.FetchedAt)
+	})
+
+	t.Run("validates filters", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			filter  *apiv2.BulkRunFilter
+			message string
+		}{
+			{name: "function without app", filter: &apiv2.BulkRunFilter{FunctionId: []string{"fn"}}, message: "appId is required"},
+			{name: "invalid status", filter: &apiv2.BulkRunFilter{Status: []string{"nope"}}, message: "Status is invalid"},
+			{name: "invalid time field", filter: &apiv2.BulkRunFilter{TimeField: "nope"}, message: "timeField is invalid"},
+			{name: "invalid range", filter: &apiv2.BulkRunFilter{From: timestamppb.New(until), Until: timestamppb.New(from)}, message: "from must be before until"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{BulkRuns: &mockBulkRunOperationProvider{}})
+				resp, err := service.PreviewBulkRunOperation(context.Background(), &apiv2.PreviewBulkRunOperationRequest{Filter: test.filter})
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("requires provider", func(t *testing.T) {
+		service := NewService(ServiceOptions{})
+		resp, err := service.PreviewBulkRunOperation(context.Background(), &apiv2.PreviewBulkRunOperationRequest{})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "not yet implemented")
+	})
+}
+
+func TestService_CreateBulkRunOperation(t *testing.T) {
+	opID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	createdAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("creates an operation", func(t *testing.T) {
+		provider := &mockBulkRunOperationProvider{}
+		provider.On("Create", mock.Anything, CreateBulkRunOperationOpts{
+			Action:        enums.BulkOperationActionRerun,
+			Filter:        bulk.Filter{AppIDs: []string{"app"}, Status: []enums.RunStatus{}},
+			RatePerSecond: 25,
+		}).Return(&bulk.Operation{
+			ID:            opID,
+			Action:        enums.BulkOperationActionRerun,
+			Status:        enums.BulkOperationStatusRunning,
+			Filter:        bulk.Filter{AppIDs: []string{"app"}, Until: createdAt},
+			RatePerSecond: 25,
+			Total:         100,
+			CreatedAt:     createdAt,
+			UpdatedAt:     createdAt,
+		}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		rate := int32(25)
+		service := NewService(ServiceOptions{BulkRuns: provider})
+		resp, err := service.CreateBulkRunOperation(context.Background(), &apiv2.CreateBulkRunOperationRequest{
+			Action:        "rerun",
+			Filter:        &apiv2.BulkRunFilter{AppId: []string{"app"}},
+			RatePerSecond: &rate,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, opID.String(), resp.Data.Id)
+		require.Equal(t, apiv2.BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_RERUN, resp.Data.Action)
+		require.Equal(t, apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_RUNNING, resp.Data.Status)
+		require.Equal(t, int32(100), resp.Data.Total)
+		require.Equal(t, []string{"app"}, resp.Data.Filter.AppId)
+		require.Equal(t, createdAt, resp.Data.Filter.Until.AsTime())
+		require.Nil(t, resp.Data.Filter.From)
+		require.Nil(t, resp.Data.EndedAt)
+	})
+
+	t.Run("validates request", func(t *testing.T) {
+		zero, tooHigh := int32(0), int32(bulk.MaxRatePerSecond+1)
+		tests := []struct {
+			name    string
+			req     *apiv2.CreateBulkRunOperationRequest
+			message string
+		}{
+			{name: "missing action", req: &apiv2.CreateBulkRunOperationRequest{}, message: "Action is required"},
+			{name: "invalid action", req: &apiv2.CreateBulkRunOperationRequest{Action: "delete"}, message: "action is invalid"},
+			{name: "zero rate", req: &apiv2.CreateBulkRunOperationRequest{Action: "CANCEL", RatePerSecond: &zero}, message: "Rate per second must be between 1 and 100"},
+			{name: "rate too high", req: &apiv2.CreateBulkRunOperationRequest{Action: "CANCEL", RatePerSecond: &tooHigh}, message: "Rate per second must be between 1 and 100"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{BulkRuns: &mockBulkRunOperationProvider{}})
+				resp, err := service.CreateBulkRunOperation(context.Background(), test.req)
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("applies rate limit", func(t *testing.T) {
+		rateLimiter := &mockRateLimitProvider{}
+		rateLimiter.On("CheckRateLimit", mock.Anything, apiv2.V2_CreateBulkRunOperation_FullMethodName).
+			Return(RateLimitResult{Limited: true}).Once()
+		t.Cleanup(func() {
+			rateLimiter.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{
+			BulkRuns:          &mockBulkRunOperationProvider{},
+			RateLimitProvider: rateLimiter,
+		})
+		resp, err := service.CreateBulkRunOperation(context.Background(), &apiv2.CreateBulkRunOperationRequest{Action: "CANCEL"})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "API rate limit exceeded")
+	})
+}
+
+func TestService_ListBulkRunOperations(t *testing.T) {
+	first := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	second := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAW")
+
+	t.Run("lists operations with a cursor", func(t *testing.T) {
+		provider := &mockBulkRunOperationProvider{}
+		provider.On("List", mock.Anything, bulk.ListOpts{Cursor: &first, Limit: 1}).
+			Return([]bulk.Operation{{ID: second}}, true, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		cursor := first.String()
+		limit := int32(1)
+		service := NewService(ServiceOptions{BulkRuns: provider})
+		resp, err := service.ListBulkRunOperations(context.Background(), &apiv2.ListBulkRunOperationsRequest{
+			Cursor: &cursor,
+			Limit:  &limit,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		require.True(t, resp.Page.HasMore)
+		require.Equal(t, second.String(), resp.Page.GetCursor())
+	})
+
+	t.Run("validates page options", func(t *testing.T) {
+		invalid := "not-a-ulid"
+		tooHigh := int32(maxBulkRunOperationsLimit + 1)
+
+		service := NewService(ServiceOptions{BulkRuns: &mockBulkRunOperationProvider{}})
+		_, err := service.ListBulkRunOperations(context.Background(), &apiv2.ListBulkRunOperationsRequest{Cursor: &invalid})
+		require.ErrorContains(t, err, "Cursor is invalid")
+
+		_, err = service.ListBulkRunOperations(context.Background(), &apiv2.ListBulkRunOperationsRequest{Limit: &tooHigh})
+		require.ErrorContains(t, err, "Limit cannot exceed 100")
+	})
+}
+
+func TestService_GetBulkRunOperation(t *testing.T) {
+	opID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	t.Run("validates operation id", func(t *testing.T) {
+		service := NewService(ServiceOptions{BulkRuns: &mockBulkRunOperationProvider{}})
+
+		_, err := service.GetBulkRunOperation(context.Background(), &apiv2.GetBulkRunOperationRequest{})
+		require.ErrorContains(t, err, "Operation ID is required")
+
+		_, err = service.GetBulkRunOperation(context.Background(), &apiv2.GetBulkRunOperationRequest{OperationId: "nope"})
+		require.ErrorContains(t, err, "Operation ID must be a valid ULID")
+	})
+
+	t.Run("returns progress", func(t *testing.T) {
+		endedAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+		provider := &mockBulkRunOperationProvider{}
+		provider.On("Get", mock.Anything, opID).Return(&bulk.Operation{
+			ID:        opID,
+			Action:    enums.BulkOperationActionCancel,
+			Status:    enums.BulkOperationStatusFailed,
+			Total:     10,
+			Processed: 6,
+			Succeeded: 3,
+			Skipped:   2,
+			Failed:    1,
+			Error:     "error loading runs",
+			EndedAt:   &endedAt,
+		}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{BulkRuns: provider})
+		resp, err := service.GetBulkRunOperation(context.Background(), &apiv2.GetBulkRunOperationRequest{OperationId: opID.String()})
+
+		require.NoError(t, err)
+		require.Equal(t, apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_FAILED, resp.Data.Status)
+		require.Equal(t, int32(6), resp.Data.Processed)
+		require.Equal(t, int32(3), resp.Data.Succeeded)
+		require.Equal(t, int32(2), resp.Data.Skipped)
+		require.Equal(t, int32(1), resp.Data.Failed)
+		require.Equal(t, "error loading runs", resp.Data.GetError())
+		require.Equal(t, endedAt, resp.Data.EndedAt.AsTime())
+	})
+
+	t.Run("maps missing operations", func(t *testing.T) {
+		provider := &mockBulkRunOperationProvider{}
+		provider.On("Get", mock.Anything, opID).Return(nil, bulk.ErrOperationNotFound).Once()
+
+		service := NewService(ServiceOptions{BulkRuns: provider})
+		resp, err := service.GetBulkRunOperation(context.Background(), &apiv2.GetBulkRunOperationRequest{OperationId: opID.String()})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Bulk operation not found")
+	})
+}
+
+func TestService_AbortBulkRunOperation(t *testing.T) {
+	opID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	t.Run("aborts an operation", func(t *testing.T) {
+		provider := &mockBulkRunOperationProvider{}
+		provider.On("Abort", mock.Anything, opID).Return(&bulk.Operation{
+			ID:     opID,
+			Status: enums.BulkOperationStatusAborted,
+		}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{BulkRuns: provider})
+		resp, err := service.AbortBulkRunOperation(context.Background(), &apiv2.AbortBulkRunOperationRequest{OperationId: opID.String()})
+
+		require.NoError(t, err)
+		require.Equal(t, apiv2.BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_ABORTED, resp.Data.Status)
+	})
+
+	t.Run("maps provider errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			err     error
+			message string
+		}{
+			{name: "missing operation", err: bulk.ErrOperationNotFound, message: "Bulk operation not found"},
+			{name: "ended operation", err: bulk.ErrOperationEnded, message: "Bulk operation has already ended"},
+			{name: "internal error", err: errors.New("failed"), message: "Unable to abort bulk operation"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				provider := &mockBulkRunOperationProvider{}
+				provider.On("Abort", mock.Anything, opID).Return(nil, test.err).Once()
+				t.Cleanup(func() {
+					provider.AssertExpectations(t)
+				})
+
+				service := NewService(ServiceOptions{BulkRuns: provider})
+				resp, err := service.AbortBulkRunOperation(context.Background(), &apiv2.AbortBulkRunOperationRequest{OperationId: opID.String()})
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/inngest/inngest/pkg/execution"
 	"github.com/inngest/inngest/pkg/execution/executor"
 	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/inngest/inngest/pkg/inngest"
 	"github.com/inngest/inngest/pkg/logger"
@@ -184,6 +185,14 @@ func (s *Service) InvokeFunction(ctx context.Context, req *apiv2.InvokeFunctionR
 		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Invoke is not yet implemented")
 	}
 
+	at, err := invokeScheduleTime(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if at != nil && s.scheduler == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Scheduled invocations are not yet implemented")
+	}
+
 	f, err := s.functions.GetFunctionByApp(ctx, req.AppId, req.FunctionId)
 	if err != nil {
 		return nil, s.getFunctionError(err)
@@ -223,7 +232,25 @@ func (s *Service) InvokeFunction(ctx context.Context, req *apiv2.InvokeFunctionR
 	sr := execution.NewScheduleRequest(f)
 	sr.IdempotencyKey = &idempotencyHash
 	sr.Events = append(sr.Events, event)
-	runID, _, err := s.executor.Schedule(ctx, sr)
+
+	var (
+		runID *ulid.ULID
+		inv   *scheduled.Invocation
+	)
+	if at != nil {
+		// Scheduled invocations are enqueued immediately and start at the
+		// given time, and are indexed so that they can be cancelled.
+		sr.At = at
+		inv, err = s.scheduler.Schedule(ctx, sr)
+		if inv != nil {
+			runID = &inv.ID
+		}
+		if errors.Is(err, scheduled.ErrInvalidSchedule) {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Scheduled invocations must run in the future")
+		}
+	} else {
+		runID, _, err = s.executor.Schedule(ctx, sr)
+	}
 	scheduleStatus := executor.ScheduleStatus(err)
 
 	go func() {
@@ -250,9 +277,7 @@ func (s *Service) InvokeFunction(ctx context.Context, req *apiv2.InvokeFunctionR
 		// don't hammer the DB with hundreds of thousands of these sync invokes concurrently
 		// running.
 		return &apiv2.InvokeFunctionResponse{
-			Data: &apiv2.InvokeFunctionData{
-				RunId: runID.String(),
-			},
+			Data: toAPIInvokeFunctionData(*runID, inv),
 			Metadata: &apiv2.ResponseMetadata{
 				FetchedAt: timestamppb.Now(),
 			},
@@ -278,9 +303,7 @@ func (s *Service) InvokeFunction(ctx context.Context, req *apiv2.InvokeFunctionR
 	case "idempotency":
 		_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "409"))
 		return &apiv2.InvokeFunctionResponse{
-			Data: &apiv2.InvokeFunctionData{
-				RunId: runID.String(),
-			},
+			Data: toAPIInvokeFunctionData(*runID, inv),
 			Metadata: &apiv2.ResponseMetadata{
 				FetchedAt: timestamppb.Now(),
 			},
@@ -293,9 +316,7 @@ func (s *Service) InvokeFunction(ctx context.Context, req *apiv2.InvokeFunctionR
 	if isIdempotencyError(err) {
 		_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "409"))
 		return &apiv2.InvokeFunctionResponse{
-			Data: &apiv2.InvokeFunctionData{
-				RunId: runID.String(),
-			},
+			Data: toAPIInvokeFunctionData(*runID, inv),
 			Metadata: &apiv2.ResponseMetadata{
 				FetchedAt: timestamppb.Now(),
 			},
@@ -306,6 +327,18 @@ func (s *Service) InvokeFunction(ctx context.Context, req *apiv2.InvokeFunctionR
 	return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "There was an error invoking your function")
 }
 
+func toAPIInvokeFunctionData(runID ulid.ULID, inv *scheduled.Invocation) *apiv2.InvokeFunctionData {
+	data := &apiv2.InvokeFunctionData{
+		RunId: runID.String(),
+	}
+	if inv != nil {
+		scheduleID := inv.ID.String()
+		data.ScheduleId = &scheduleID
+		data.ScheduledAt = timestamppb.New(inv.At)
+	}
+	return data
+}
+
 func decodePathParam(value string) string {
 	if decoded, err := url.PathUnescape(value); err == nil {
 		return decoded
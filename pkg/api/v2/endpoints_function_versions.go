@@ -0,0 +1,159 @@
+package apiv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) ListFunctionVersions(ctx context.Context, req *apiv2.ListFunctionVersionsRequest) (*apiv2.ListFunctionVersionsResponse, error) {
+	if req.AppId == "" || req.FunctionId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "App ID and function ID are required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListFunctionVersions_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no function versions were fetched.")
+	}
+
+	versions, err := s.loadFunctionVersions(ctx, req.AppId, req.FunctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*apiv2.FunctionVersion, 0, len(versions))
+	for _, v := range versions {
+		data = append(data, toFunctionVersion(v))
+	}
+
+	return &apiv2.ListFunctionVersionsResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) GetFunctionVersionDiff(ctx context.Context, req *apiv2.GetFunctionVersionDiffRequest) (*apiv2.GetFunctionVersionDiffResponse, error) {
+	if req.AppId == "" || req.FunctionId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "App ID and function ID are required")
+	}
+	if (req.From != nil && *req.From < 1) || (req.To != nil && *req.To < 1) {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Versions must be at least 1")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_GetFunctionVersionDiff_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no function versions were compared.")
+	}
+
+	versions, err := s.loadFunctionVersions(ctx, req.AppId, req.FunctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := cqrs.SelectFunctionVersions(versions, versionPtr(req.From), versionPtr(req.To))
+	if to == nil {
+		return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Function version not found")
+	}
+	if from == nil {
+		if req.From != nil {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Function version not found")
+		}
+		return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound,
+			fmt.Sprintf("No function version before version %d", to.Version))
+	}
+
+	changes, err := diffFunctionVersions(from, to)
+	if err != nil {
+		logger.From(ctx).Error("unable to diff function versions", "error", err)
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to compare function versions")
+	}
+
+	data := &apiv2.FunctionVersionDiff{
+		From:    int32(from.Version),
+		To:      int32(to.Version),
+		Changes: make([]*apiv2.FunctionConfigChange, 0, len(changes)),
+	}
+	for _, c := range changes {
+		data.Changes = append(data.Changes, &apiv2.FunctionConfigChange{
+			Field:  c.Field,
+			Before: jsonToValue(c.Before),
+			After:  jsonToValue(c.After),
+		})
+	}
+
+	return &apiv2.GetFunctionVersionDiffResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+// loadFunctionVersions resolves a function within an app and returns its
+// recorded versions, newest first.
+func (s *Service) loadFunctionVersions(ctx context.Context, appID, functionID string) ([]*cqrs.FunctionVersion, error) {
+	if s.functions == nil || s.functionVersions == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Function versions are not yet implemented")
+	}
+
+	fn, err := s.functions.GetFunctionByApp(ctx, decodePathParam(appID), decodePathParam(functionID))
+	if err != nil {
+		return nil, s.getFunctionError(err)
+	}
+
+	versions, err := s.functionVersions.GetFunctionVersions(ctx, fn.ID)
+	if err != nil {
+		logger.From(ctx).Error("unable to fetch function versions", "error", err)
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch function versions")
+	}
+	return versions, nil
+}
+
+func diffFunctionVersions(from, to *cqrs.FunctionVersion) ([]inngest.FunctionChange, error) {
+	a, err := from.InngestFunction()
+	if err != nil {
+		return nil, err
+	}
+	b, err := to.InngestFunction()
+	if err != nil {
+		return nil, err
+	}
+	return inngest.DiffFunctions(*a, *b)
+}
+
+func toFunctionVersion(v *cqrs.FunctionVersion) *apiv2.FunctionVersion {
+	return &apiv2.FunctionVersion{
+		Version:   int32(v.Version),
+		SyncId:    v.SyncID.String(),
+		CreatedAt: timestamppb.New(v.CreatedAt),
+		Config:    jsonToStruct(v.Config),
+	}
+}
+
+func jsonToValue(raw json.RawMessage) *structpb.Value {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return structpb.NewNullValue()
+	}
+
+	result, err := structpb.NewValue(value)
+	if err != nil {
+		return structpb.NewNullValue()
+	}
+	return result
+}
+
+func versionPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
@@ -0,0 +1,155 @@
+package apiv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/inngest"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFunctionVersions(t *testing.T, fnID uuid.UUID) []*cqrs.FunctionVersion {
+	t.Helper()
+
+	createdAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+	configs := []inngest.Function{
+		{ID: fnID, FunctionVersion: 3, Name: "Send email", Throttle: &inngest.Throttle{Limit: 10, Period: time.Minute}},
+		{ID: fnID, FunctionVersion: 2, Name: "Send email"},
+		{ID: fnID, FunctionVersion: 1, Name: "Email"},
+	}
+
+	versions := make([]*cqrs.FunctionVersion, 0, len(configs))
+	for i, fn := range configs {
+		config, err := json.Marshal(fn)
+		require.NoError(t, err)
+		versions = append(versions, &cqrs.FunctionVersion{
+			FunctionID: fnID,
+			Version:    fn.FunctionVersion,
+			SyncID:     uuid.New(),
+			Config:     config,
+			CreatedAt:  createdAt.Add(-time.Duration(i) * time.Hour),
+		})
+	}
+	return versions
+}
+
+func newFunctionVersionsService(t *testing.T, versions []*cqrs.FunctionVersion) *Service {
+	t.Helper()
+
+	fn := inngest.DeployedFunction{ID: versions[0].FunctionID}
+	functions := &mockFunctionProvider{}
+	functions.On("GetFunctionByApp", mock.Anything, "app", "fn").Return(fn, nil).Once()
+	reader := &mockFunctionVersionReader{}
+	reader.On("GetFunctionVersions", mock.Anything, fn.ID).Return(versions, nil).Once()
+	t.Cleanup(func() {
+		functions.AssertExpectations(t)
+		reader.AssertExpectations(t)
+	})
+
+	return NewService(ServiceOptions{Functions: functions, FunctionVersions: reader})
+}
+
+func TestService_ListFunctionVersions(t *testing.T) {
+	t.Run("lists versions newest first", func(t *testing.T) {
+		versions := newTestFunctionVersions(t, uuid.New())
+		service := newFunctionVersionsService(t, versions)
+
+		resp, err := service.ListFunctionVersions(context.Background(), &apiv2.ListFunctionVersionsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 3)
+		require.Equal(t, int32(3), resp.Data[0].Version)
+		require.Equal(t, versions[0].SyncID.String(), resp.Data[0].SyncId)
+		require.Equal(t, versions[0].CreatedAt, resp.Data[0].CreatedAt.AsTime())
+		require.Equal(t, "Send email", resp.Data[0].Config.Fields["name"].GetStringValue())
+	})
+
+	t.Run("maps missing functions", func(t *testing.T) {
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunctionByApp", mock.Anything, "app", "fn").
+			Return(inngest.DeployedFunction{}, fmt.Errorf("%w: app/fn", ErrFunctionNotFound)).Once()
+
+		service := NewService(ServiceOptions{Functions: functions, FunctionVersions: &mockFunctionVersionReader{}})
+		resp, err := service.ListFunctionVersions(context.Background(), &apiv2.ListFunctionVersionsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Function not found")
+	})
+}
+
+func TestService_GetFunctionVersionDiff(t *testing.T) {
+	t.Run("defaults to the latest two versions", func(t *testing.T) {
+		service := newFunctionVersionsService(t, newTestFunctionVersions(t, uuid.New()))
+
+		resp, err := service.GetFunctionVersionDiff(context.Background(), &apiv2.GetFunctionVersionDiffRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int32(2), resp.Data.From)
+		require.Equal(t, int32(3), resp.Data.To)
+		require.Len(t, resp.Data.Changes, 1)
+		require.Equal(t, "throttle", resp.Data.Changes[0].Field)
+		require.NotNil(t, resp.Data.Changes[0].Before.GetNullValue())
+		require.Equal(t, 10.0, resp.Data.Changes[0].After.GetStructValue().Fields["limit"].GetNumberValue())
+	})
+
+	t.Run("diffs the given versions", func(t *testing.T) {
+		service := newFunctionVersionsService(t, newTestFunctionVersions(t, uuid.New()))
+
+		resp, err := service.GetFunctionVersionDiff(context.Background(), &apiv2.GetFunctionVersionDiffRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			From:       int32Ptr(1),
+			To:         int32Ptr(3),
+		})
+
+		require.NoError(t, err)
+		fields := []string{}
+		for _, c := range resp.Data.Changes {
+			fields = append(fields, c.Field)
+		}
+		require.Equal(t, []string{"name", "throttle"}, fields)
+		require.Equal(t, "Email", resp.Data.Changes[0].Before.GetStringValue())
+	})
+
+	t.Run("maps missing versions", func(t *testing.T) {
+		service := newFunctionVersionsService(t, newTestFunctionVersions(t, uuid.New()))
+
+		resp, err := service.GetFunctionVersionDiff(context.Background(), &apiv2.GetFunctionVersionDiffRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			To:         int32Ptr(1),
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "No function version before version 1")
+	})
+
+	t.Run("validates versions", func(t *testing.T) {
+		service := NewService(ServiceOptions{})
+
+		resp, err := service.GetFunctionVersionDiff(context.Background(), &apiv2.GetFunctionVersionDiffRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			From:       int32Ptr(0),
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Versions must be at least 1")
+	})
+}
@@ -0,0 +1,417 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultOutboundWebhookDeliveriesLimit = 20
+	maxOutboundWebhookDeliveriesLimit     = 100
+)
+
+func (s *Service) CreateOutboundWebhook(ctx context.Context, req *apiv2.CreateOutboundWebhookRequest) (*apiv2.CreateOutboundWebhookResponse, error) {
+	if req.Url == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "URL is required")
+	}
+	if len(req.Events) == 0 {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Events are required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_CreateOutboundWebhook_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no webhook was created.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	threshold, err := outboundWebhookThresholdFromAPI(req.DurationThresholdSeconds)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+
+	e, err := s.webhooks.Create(ctx, CreateOutboundWebhookOpts{
+		URL:               req.Url,
+		Secret:            req.GetSecret(),
+		Events:            outboundWebhookEventsFromAPI(req.Events),
+		Filter:            req.GetFilter(),
+		DurationThreshold: threshold,
+		Disabled:          req.GetDisabled(),
+	})
+	if err != nil {
+		if errors.Is(err, webhooks.ErrInvalidEndpoint) {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to create webhook")
+	}
+
+	data := toAPIOutboundWebhook(*e)
+	// The secret is only ever returned when the webhook is created.
+	data.Secret = &e.Secret
+
+	return &apiv2.CreateOutboundWebhookResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) ListOutboundWebhooks(ctx context.Context, req *apiv2.ListOutboundWebhooksRequest) (*apiv2.ListOutboundWebhooksResponse, error) {
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListOutboundWebhooks_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no webhooks were fetched.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	endpoints, err := s.webhooks.List(ctx)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch webhooks")
+	}
+
+	data := make([]*apiv2.OutboundWebhook, 0, len(endpoints))
+	for _, e := range endpoints {
+		data = append(data, toAPIOutboundWebhook(e))
+	}
+
+	return &apiv2.ListOutboundWebhooksResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) GetOutboundWebhook(ctx context.Context, req *apiv2.GetOutboundWebhookRequest) (*apiv2.GetOutboundWebhookResponse, error) {
+	if req.WebhookId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Webhook ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_GetOutboundWebhook_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no webhook was fetched.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.WebhookId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Webhook ID must be a valid ULID")
+	}
+
+	e, err := s.webhooks.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrEndpointNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Webhook not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch webhook")
+	}
+
+	return &apiv2.GetOutboundWebhookResponse{
+		Data:     toAPIOutboundWebhook(*e),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) UpdateOutboundWebhook(ctx context.Context, req *apiv2.UpdateOutboundWebhookRequest) (*apiv2.UpdateOutboundWebhookResponse, error) {
+	if req.WebhookId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Webhook ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_UpdateOutboundWebhook_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no webhook was updated.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.WebhookId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Webhook ID must be a valid ULID")
+	}
+
+	opts := webhooks.UpdateEndpointOpts{
+		URL:      req.Url,
+		Filter:   req.Filter,
+		Disabled: req.Disabled,
+	}
+	if len(req.Events) > 0 {
+		opts.Events = outboundWebhookEventsFromAPI(req.Events)
+	}
+	if req.DurationThresholdSeconds != nil {
+		threshold, err := outboundWebhookThresholdFromAPI(req.DurationThresholdSeconds)
+		if err != nil {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+		}
+		opts.DurationThreshold = &threshold
+	}
+
+	e, err := s.webhooks.Update(ctx, id, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrEndpointNotFound):
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Webhook not found")
+		case errors.Is(err, webhooks.ErrInvalidEndpoint):
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to update webhook")
+	}
+
+	return &apiv2.UpdateOutboundWebhookResponse{
+		Data:     toAPIOutboundWebhook(*e),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) DeleteOutboundWebhook(ctx context.Context, req *apiv2.DeleteOutboundWebhookRequest) (*apiv2.DeleteOutboundWebhookResponse, error) {
+	if req.WebhookId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Webhook ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_DeleteOutboundWebhook_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no webhook was deleted.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.WebhookId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Webhook ID must be a valid ULID")
+	}
+
+	if err := s.webhooks.Delete(ctx, id); err != nil {
+		if errors.Is(err, webhooks.ErrEndpointNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Webhook not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to delete webhook")
+	}
+
+	return &apiv2.DeleteOutboundWebhookResponse{
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) ListOutboundWebhookDeliveries(ctx context.Context, req *apiv2.ListOutboundWebhookDeliveriesRequest) (*apiv2.ListOutboundWebhookDeliveriesResponse, error) {
+	if req.WebhookId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Webhook ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListOutboundWebhookDeliveries_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no deliveries were fetched.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.WebhookId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Webhook ID must be a valid ULID")
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultOutboundWebhookDeliveriesLimit
+	}
+	if limit < 1 {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Limit must be at least 1")
+	}
+	if limit > maxOutboundWebhookDeliveriesLimit {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Limit cannot exceed %d", maxOutboundWebhookDeliveriesLimit))
+	}
+
+	opts := webhooks.ListOpts{Limit: limit}
+	if req.GetCursor() != "" {
+		cursor, err := ulid.Parse(req.GetCursor())
+		if err != nil {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Cursor is invalid")
+		}
+		opts.Cursor = &cursor
+	}
+
+	deliveries, hasMore, err := s.webhooks.ListDeliveries(ctx, id, opts)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrEndpointNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Webhook not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch deliveries")
+	}
+
+	data := make([]*apiv2.OutboundWebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		data = append(data, toAPIOutboundWebhookDelivery(d))
+	}
+
+	page := &apiv2.Page{
+		HasMore: hasMore,
+		Limit:   int32(limit),
+	}
+	if hasMore && len(deliveries) > 0 {
+		nextCursor := deliveries[len(deliveries)-1].ID.String()
+		page.Cursor = &nextCursor
+	}
+
+	return &apiv2.ListOutboundWebhookDeliveriesResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+		Page:     page,
+	}, nil
+}
+
+func (s *Service) ReplayOutboundWebhookDelivery(ctx context.Context, req *apiv2.ReplayOutboundWebhookDeliveryRequest) (*apiv2.ReplayOutboundWebhookDeliveryResponse, error) {
+	if req.WebhookId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Webhook ID is required")
+	}
+	if req.DeliveryId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Delivery ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ReplayOutboundWebhookDelivery_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no delivery was replayed.")
+	}
+
+	if s.webhooks == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Outbound webhooks are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.WebhookId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Webhook ID must be a valid ULID")
+	}
+	deliveryID, err := ulid.Parse(req.DeliveryId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Delivery ID must be a valid ULID")
+	}
+
+	d, err := s.webhooks.Replay(ctx, id, deliveryID)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrEndpointNotFound):
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Webhook not found")
+		case errors.Is(err, webhooks.ErrDeliveryNotFound):
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Delivery not found")
+		case errors.Is(err, webhooks.ErrDeliveryPending):
+			return nil, s.base.NewError(http.StatusConflict, apiv2base.ErrorDeliveryPending, "Delivery is still pending")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to replay delivery")
+	}
+
+	return &apiv2.ReplayOutboundWebhookDeliveryResponse{
+		Data:     toAPIOutboundWebhookDelivery(*d),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func outboundWebhookEventsFromAPI(events []string) []webhooks.EventType {
+	result := make([]webhooks.EventType, 0, len(events))
+	for _, e := range events {
+		result = append(result, webhooks.EventType(e))
+	}
+	return result
+}
+
+func outboundWebhookThresholdFromAPI(seconds *int64) (time.Duration, error) {
+	if seconds == nil {
+		return 0, nil
+	}
+	if *seconds < 1 {
+		return 0, fmt.Errorf("duration threshold must be at least 1 second")
+	}
+	return time.Duration(*seconds) * time.Second, nil
+}
+
+// toAPIOutboundWebhook converts an endpoint to its API representation,
+// omitting the endpoint's secret.
+func toAPIOutboundWebhook(e webhooks.Endpoint) *apiv2.OutboundWebhook {
+	result := &apiv2.OutboundWebhook{
+		Id:        e.ID.String(),
+		Url:       e.URL,
+		Events:    make([]string, 0, len(e.Events)),
+		Disabled:  e.Disabled,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+		UpdatedAt: timestamppb.New(e.UpdatedAt),
+	}
+	for _, t := range e.Events {
+		result.Events = append(result.Events, string(t))
+	}
+	if e.Filter != "" {
+		result.Filter = &e.Filter
+	}
+	if e.DurationThreshold > 0 {
+		seconds := int64(e.DurationThreshold / time.Second)
+		result.DurationThresholdSeconds = &seconds
+	}
+	return result
+}
+
+func toAPIOutboundWebhookDelivery(d webhooks.Delivery) *apiv2.OutboundWebhookDelivery {
+	result := &apiv2.OutboundWebhookDelivery{
+		Id:        d.ID.String(),
+		WebhookId: d.EndpointID.String(),
+		Event:     string(d.Type),
+		Status:    toAPIOutboundWebhookDeliveryStatus(d.Status),
+		Payload:   string(d.Payload),
+		Attempts:  make([]*apiv2.OutboundWebhookDeliveryAttempt, 0, len(d.Attempts)),
+		CreatedAt: timestamppb.New(d.CreatedAt),
+		UpdatedAt: timestamppb.New(d.UpdatedAt),
+	}
+	if d.RunID != (ulid.ULID{}) {
+		runID := d.RunID.String()
+		result.RunId = &runID
+	}
+	for _, a := range d.Attempts {
+		attempt := &apiv2.OutboundWebhookDeliveryAttempt{
+			AttemptedAt: timestamppb.New(a.At),
+			DurationMs:  a.Duration.Milliseconds(),
+		}
+		if a.StatusCode != 0 {
+			code := int32(a.StatusCode)
+			attempt.StatusCode = &code
+		}
+		if a.Error != "" {
+			attempt.Error = &a.Error
+		}
+		result.Attempts = append(result.Attempts, attempt)
+	}
+	if d.Status == webhooks.DeliveryStatusPending {
+		result.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.ReplayOf != nil {
+		replayOf := d.ReplayOf.String()
+		result.ReplayOf = &replayOf
+	}
+	return result
+}
+
+func toAPIOutboundWebhookDeliveryStatus(status webhooks.DeliveryStatus) apiv2.OutboundWebhookDeliveryStatus {
+	switch status {
+	case webhooks.DeliveryStatusPending:
+		return apiv2.OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_PENDING
+	case webhooks.DeliveryStatusSucceeded:
+		return apiv2.OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case webhooks.DeliveryStatusFailed:
+		return apiv2.OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return apiv2.OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
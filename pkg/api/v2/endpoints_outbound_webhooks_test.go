@@ -0,0 +1,363 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/pkg/execution/webhooks"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateOutboundWebhook(t *testing.T) {
+	webhookID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	createdAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("creates a webhook", func(t *testing.T) {
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("Create", mock.Anything, CreateOutboundWebhookOpts{
+			URL:               "https://example.com/hook",
+			Events:            []webhooks.EventType{webhooks.EventRunFailed, webhooks.EventRunDurationExceeded},
+			Filter:            `run.status == "Failed"`,
+			DurationThreshold: 5 * time.Minute,
+		}).Return(&webhooks.Endpoint{
+			ID:                webhookID,
+			URL:               "https://example.com/hook",
+			Secret:            "secret",
+			Events:            []webhooks.EventType{webhooks.EventRunFailed, webhooks.EventRunDurationExceeded},
+			Filter:            `run.status == "Failed"`,
+			DurationThreshold: 5 * time.Minute,
+			CreatedAt:         createdAt,
+			UpdatedAt:         createdAt,
+		}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		filter := `run.status == "Failed"`
+		threshold := int64(300)
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.CreateOutboundWebhook(context.Background(), &apiv2.CreateOutboundWebhookRequest{
+			Url:                      "https://example.com/hook",
+			Events:                   []string{"run.failed", "run.duration_exceeded"},
+			Filter:                   &filter,
+			DurationThresholdSeconds: &threshold,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, webhookID.String(), resp.Data.Id)
+		require.Equal(t, []string{"run.failed", "run.duration_exceeded"}, resp.Data.Events)
+		require.Equal(t, int64(300), resp.Data.GetDurationThresholdSeconds())
+		require.Equal(t, "secret", resp.Data.GetSecret())
+		require.Equal(t, createdAt, resp.Data.CreatedAt.AsTime())
+	})
+
+	t.Run("validates request", func(t *testing.T) {
+		zero := int64(0)
+		tests := []struct {
+			name    string
+			req     *apiv2.CreateOutboundWebhookRequest
+			message string
+		}{
+			{name: "missing url", req: &apiv2.CreateOutboundWebhookRequest{Events: []string{"run.failed"}}, message: "URL is required"},
+			{name: "missing events", req: &apiv2.CreateOutboundWebhookRequest{Url: "https://example.com"}, message: "Events are required"},
+			{name: "zero threshold", req: &apiv2.CreateOutboundWebhookRequest{Url: "https://example.com", Events: []string{"run.failed"}, DurationThresholdSeconds: &zero}, message: "duration threshold must be at least 1 second"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{OutboundWebhooks: &mockOutboundWebhookProvider{}})
+				resp, err := service.CreateOutboundWebhook(context.Background(), test.req)
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("maps invalid webhooks", func(t *testing.T) {
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("Create", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: unknown event run.nope", webhooks.ErrInvalidEndpoint)).Once()
+
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.CreateOutboundWebhook(context.Background(), &apiv2.CreateOutboundWebhookRequest{
+			Url:    "https://example.com",
+			Events: []string{"run.nope"},
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "unknown event run.nope")
+	})
+
+	t.Run("requires provider", func(t *testing.T) {
+		service := NewService(ServiceOptions{})
+		resp, err := service.CreateOutboundWebhook(context.Background(), &apiv2.CreateOutboundWebhookRequest{
+			Url:    "https://example.com",
+			Events: []string{"run.failed"},
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "not yet implemented")
+	})
+
+	t.Run("applies rate limit", func(t *testing.T) {
+		rateLimiter := &mockRateLimitProvider{}
+		rateLimiter.On("CheckRateLimit", mock.Anything, apiv2.V2_CreateOutboundWebhook_FullMethodName).
+			Return(RateLimitResult{Limited: true}).Once()
+		t.Cleanup(func() {
+			rateLimiter.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{
+			OutboundWebhooks:  &mockOutboundWebhookProvider{},
+			RateLimitProvider: rateLimiter,
+		})
+		resp, err := service.CreateOutboundWebhook(context.Background(), &apiv2.CreateOutboundWebhookRequest{
+			Url:    "https://example.com",
+			Events: []string{"run.failed"},
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "API rate limit exceeded")
+	})
+}
+
+func TestService_GetOutboundWebhook(t *testing.T) {
+	webhookID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	t.Run("omits the secret", func(t *testing.T) {
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("Get", mock.Anything, webhookID).Return(&webhooks.Endpoint{
+			ID:     webhookID,
+			URL:    "https://example.com",
+			Secret: "secret",
+			Events: []webhooks.EventType{webhooks.EventRunCancelled},
+		}, nil).Once()
+
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.GetOutboundWebhook(context.Background(), &apiv2.GetOutboundWebhookRequest{WebhookId: webhookID.String()})
+
+		require.NoError(t, err)
+		require.Nil(t, resp.Data.Secret)
+		require.Nil(t, resp.Data.Filter)
+		require.Nil(t, resp.Data.DurationThresholdSeconds)
+	})
+
+	t.Run("validates webhook id", func(t *testing.T) {
+		service := NewService(ServiceOptions{OutboundWebhooks: &mockOutboundWebhookProvider{}})
+		resp, err := service.GetOutboundWebhook(context.Background(), &apiv2.GetOutboundWebhookRequest{WebhookId: "nope"})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Webhook ID must be a valid ULID")
+	})
+
+	t.Run("maps missing webhooks", func(t *testing.T) {
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("Get", mock.Anything, webhookID).Return(nil, webhooks.ErrEndpointNotFound).Once()
+
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.GetOutboundWebhook(context.Background(), &apiv2.GetOutboundWebhookRequest{WebhookId: webhookID.String()})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Webhook not found")
+	})
+}
+
+func TestService_UpdateOutboundWebhook(t *testing.T) {
+	webhookID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	t.Run("updates set fields", func(t *testing.T) {
+		disabled := true
+		filter := ""
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("Update", mock.Anything, webhookID, webhooks.UpdateEndpointOpts{
+			Filter:   &filter,
+			Disabled: &disabled,
+		}).Return(&webhooks.Endpoint{ID: webhookID, Disabled: true}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.UpdateOutboundWebhook(context.Background(), &apiv2.UpdateOutboundWebhookRequest{
+			WebhookId: webhookID.String(),
+			Filter:    &filter,
+			Disabled:  &disabled,
+		})
+
+		require.NoError(t, err)
+		require.True(t, resp.Data.Disabled)
+	})
+
+	t.Run("maps provider errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			err     error
+			message string
+		}{
+			{name: "missing webhook", err: webhooks.ErrEndpointNotFound, message: "Webhook not found"},
+			{name: "invalid webhook", err: fmt.Errorf("%w: invalid filter", webhooks.ErrInvalidEndpoint), message: "invalid filter"},
+			{name: "internal error", err: errors.New("failed"), message: "Unable to update webhook"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				provider := &mockOutboundWebhookProvider{}
+				provider.On("Update", mock.Anything, webhookID, mock.Anything).Return(nil, test.err).Once()
+
+				service := NewService(ServiceOptions{OutboundWebhooks: provider})
+				resp, err := service.UpdateOutboundWebhook(context.Background(), &apiv2.UpdateOutboundWebhookRequest{WebhookId: webhookID.String()})
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+}
+
+func TestService_DeleteOutboundWebhook(t *testing.T) {
+	webhookID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	provider := &mockOutboundWebhookProvider{}
+	provider.On("Delete", mock.Anything, webhookID).Return(nil).Once()
+	provider.On("Delete", mock.Anything, webhookID).Return(webhooks.ErrEndpointNotFound).Once()
+	t.Cleanup(func() {
+		provider.AssertExpectations(t)
+	})
+
+	service := NewService(ServiceOptions{OutboundWebhooks: provider})
+	resp, err := service.DeleteOutboundWebhook(context.Background(), &apiv2.DeleteOutboundWebhookRequest{WebhookId: webhookID.String()})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Metadata.FetchedAt)
+
+	resp, err = service.DeleteOutboundWebhook(context.Background(), &apiv2.DeleteOutboundWebhookRequest{WebhookId: webhookID.String()})
+	require.Nil(t, resp)
+	require.ErrorContains(t, err, "Webhook not found")
+}
+
+func TestService_ListOutboundWebhookDeliveries(t *testing.T) {
+	webhookID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	first := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAW")
+	second := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAX")
+	attemptedAt := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("lists deliveries with a cursor", func(t *testing.T) {
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("ListDeliveries", mock.Anything, webhookID, webhooks.ListOpts{Cursor: &first, Limit: 1}).
+			Return([]webhooks.Delivery{{
+				ID:         second,
+				EndpointID: webhookID,
+				Type:       webhooks.EventRunFailed,
+				Payload:    []byte(`{"type":"run.failed"}`),
+				Status:     webhooks.DeliveryStatusPending,
+				Attempts: []webhooks.Attempt{{
+					At:         attemptedAt,
+					StatusCode: 500,
+					Error:      "received status 500",
+					Duration:   20 * time.Millisecond,
+				}},
+				NextAttemptAt: attemptedAt.Add(time.Minute),
+			}}, true, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		cursor := first.String()
+		limit := int32(1)
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.ListOutboundWebhookDeliveries(context.Background(), &apiv2.ListOutboundWebhookDeliveriesRequest{
+			WebhookId: webhookID.String(),
+			Cursor:    &cursor,
+			Limit:     &limit,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		require.True(t, resp.Page.HasMore)
+		require.Equal(t, second.String(), resp.Page.GetCursor())
+
+		d := resp.Data[0]
+		require.Equal(t, apiv2.OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_PENDING, d.Status)
+		require.Equal(t, `{"type":"run.failed"}`, d.Payload)
+		require.Equal(t, attemptedAt.Add(time.Minute), d.NextAttemptAt.AsTime())
+		require.Len(t, d.Attempts, 1)
+		require.Equal(t, int32(500), d.Attempts[0].GetStatusCode())
+		require.Equal(t, int64(20), d.Attempts[0].DurationMs)
+	})
+
+	t.Run("validates page options", func(t *testing.T) {
+		tooHigh := int32(maxOutboundWebhookDeliveriesLimit + 1)
+		service := NewService(ServiceOptions{OutboundWebhooks: &mockOutboundWebhookProvider{}})
+		resp, err := service.ListOutboundWebhookDeliveries(context.Background(), &apiv2.ListOutboundWebhookDeliveriesRequest{
+			WebhookId: webhookID.String(),
+			Limit:     &tooHigh,
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Limit cannot exceed 100")
+	})
+}
+
+func TestService_ReplayOutboundWebhookDelivery(t *testing.T) {
+	webhookID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	deliveryID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAW")
+	replayID := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAX")
+
+	t.Run("replays a delivery", func(t *testing.T) {
+		provider := &mockOutboundWebhookProvider{}
+		provider.On("Replay", mock.Anything, webhookID, deliveryID).Return(&webhooks.Delivery{
+			ID:         replayID,
+			EndpointID: webhookID,
+			Status:     webhooks.DeliveryStatusPending,
+			ReplayOf:   &deliveryID,
+		}, nil).Once()
+		t.Cleanup(func() {
+			provider.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{OutboundWebhooks: provider})
+		resp, err := service.ReplayOutboundWebhookDelivery(context.Background(), &apiv2.ReplayOutboundWebhookDeliveryRequest{
+			WebhookId:  webhookID.String(),
+			DeliveryId: deliveryID.String(),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, replayID.String(), resp.Data.Id)
+		require.Equal(t, deliveryID.String(), resp.Data.GetReplayOf())
+	})
+
+	t.Run("maps provider errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			err     error
+			message string
+		}{
+			{name: "missing webhook", err: webhooks.ErrEndpointNotFound, message: "Webhook not found"},
+			{name: "missing delivery", err: webhooks.ErrDeliveryNotFound, message: "Delivery not found"},
+			{name: "pending delivery", err: webhooks.ErrDeliveryPending, message: "Delivery is still pending"},
+			{name: "internal error", err: errors.New("failed"), message: "Unable to replay delivery"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				provider := &mockOutboundWebhookProvider{}
+				provider.On("Replay", mock.Anything, webhookID, deliveryID).Return(nil, test.err).Once()
+
+				service := NewService(ServiceOptions{OutboundWebhooks: provider})
+				resp, err := service.ReplayOutboundWebhookDelivery(context.Background(), &apiv2.ReplayOutboundWebhookDeliveryRequest{
+					WebhookId:  webhookID.String(),
+					DeliveryId: deliveryID.String(),
+				})
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+}
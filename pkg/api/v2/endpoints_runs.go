@@ -302,6 +302,7 @@ func listRunsOpts(req *apiv2.ListRunsRequest) (GetRunsOpts, error) {
 		FunctionIDs:   req.GetFunctionId(),
 		IsDeferred:    req.IsDeferred,
 		Order:         order,
+		Search:        strings.TrimSpace(req.GetSearch()),
 	}, nil
 }
 
@@ -523,6 +524,11 @@ func toFunctionRun(run *cqrs.FunctionRun, fn inngest.DeployedFunction) *apiv2.Fu
 		result.DurationMs = &duration
 	}
 
+	if run.FunctionVersion > 0 {
+		version := int32(run.FunctionVersion)
+		result.FunctionVersion = &version
+	}
+
 	return result
 }
 
@@ -566,6 +572,11 @@ func toAPIRunListItem(run *RunListItem) *apiv2.FunctionRun {
 		result.Output = jsonToStruct(run.Output)
 	}
 
+	if run.FunctionVersion > 0 {
+		version := int32(run.FunctionVersion)
+		result.FunctionVersion = &version
+	}
+
 	return result
 }
 
@@ -0,0 +1,137 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultScheduledInvocationsLimit = 20
+	maxScheduledInvocationsLimit     = 100
+)
+
+func (s *Service) ListScheduledInvocations(ctx context.Context, req *apiv2.ListScheduledInvocationsRequest) (*apiv2.ListScheduledInvocationsResponse, error) {
+	if req.AppId == "" || req.FunctionId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "App ID and function ID are required")
+	}
+
+	req.FunctionId = decodePathParam(req.FunctionId)
+	req.AppId = decodePathParam(req.AppId)
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListScheduledInvocations_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no scheduled invocations were fetched.")
+	}
+
+	if s.functions == nil || s.scheduler == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Scheduled invocations are not yet implemented")
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultScheduledInvocationsLimit
+	}
+	if limit < 1 {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Limit must be at least 1")
+	}
+	if limit > maxScheduledInvocationsLimit {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Limit cannot exceed %d", maxScheduledInvocationsLimit))
+	}
+
+	f, err := s.functions.GetFunctionByApp(ctx, req.AppId, req.FunctionId)
+	if err != nil {
+		return nil, s.getFunctionError(err)
+	}
+
+	invs, cursor, err := s.scheduler.List(ctx, f.EnvironmentID, f.ID, scheduled.ListOpts{
+		Cursor: req.GetCursor(),
+		Limit:  limit,
+	})
+	if err != nil {
+		if errors.Is(err, scheduled.ErrInvalidCursor) {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Cursor is invalid")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch scheduled invocations")
+	}
+
+	data := make([]*apiv2.ScheduledInvocation, 0, len(invs))
+	for _, inv := range invs {
+		data = append(data, toAPIScheduledInvocation(inv))
+	}
+
+	page := &apiv2.Page{
+		HasMore: cursor != "",
+		Limit:   int32(limit),
+	}
+	if cursor != "" {
+		page.Cursor = &cursor
+	}
+
+	return &apiv2.ListScheduledInvocationsResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+		Page:     page,
+	}, nil
+}
+
+func (s *Service) CancelScheduledInvocation(ctx context.Context, req *apiv2.CancelScheduledInvocationRequest) (*apiv2.CancelScheduledInvocationResponse, error) {
+	if req.AppId == "" || req.FunctionId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "App ID and function ID are required")
+	}
+	if req.ScheduleId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Schedule ID is required")
+	}
+
+	req.FunctionId = decodePathParam(req.FunctionId)
+	req.AppId = decodePathParam(req.AppId)
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_CancelScheduledInvocation_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no scheduled invocation was cancelled.")
+	}
+
+	if s.functions == nil || s.scheduler == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Scheduled invocations are not yet implemented")
+	}
+
+	id, err := ulid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Schedule ID must be a valid ULID")
+	}
+
+	f, err := s.functions.GetFunctionByApp(ctx, req.AppId, req.FunctionId)
+	if err != nil {
+		return nil, s.getFunctionError(err)
+	}
+
+	inv, err := s.scheduler.Cancel(ctx, f.EnvironmentID, f.ID, id)
+	if err != nil {
+		if errors.Is(err, scheduled.ErrInvocationNotFound) {
+			return nil, s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Scheduled invocation not found")
+		}
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to cancel scheduled invocation")
+	}
+
+	return &apiv2.CancelScheduledInvocationResponse{
+		Data:     toAPIScheduledInvocation(*inv),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func toAPIScheduledInvocation(inv scheduled.Invocation) *apiv2.ScheduledInvocation {
+	return &apiv2.ScheduledInvocation{
+		Id:          inv.ID.String(),
+		RunId:       inv.ID.String(),
+		ScheduledAt: timestamppb.New(inv.At),
+		CreatedAt:   timestamppb.New(inv.CreatedAt),
+	}
+}
@@ -0,0 +1,262 @@
+package apiv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
+	"github.com/inngest/inngest/pkg/inngest"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newScheduledTestFunction() inngest.DeployedFunction {
+	return inngest.DeployedFunction{
+		Function:      inngest.Function{ID: uuid.New()},
+		AccountID:     uuid.New(),
+		EnvironmentID: uuid.New(),
+		AppID:         uuid.New(),
+	}
+}
+
+func TestService_InvokeFunctionScheduled(t *testing.T) {
+	data, err := structpb.NewStruct(map[string]any{"message": "hi"})
+	require.NoError(t, err)
+
+	t.Run("schedules with a delay", func(t *testing.T) {
+		fn := newScheduledTestFunction()
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunctionByApp", mock.Anything, "app", "fn").Return(fn, nil).Once()
+		publisher := &mockEventPublisher{}
+		publisher.On("Publish", mock.Anything, mock.Anything).Return(nil).Once()
+
+		inv := &scheduled.Invocation{ID: ulid.Make(), At: time.Now().Add(time.Hour)}
+		scheduler := &mockInvocationScheduler{}
+		scheduler.On("Schedule", mock.Anything, mock.MatchedBy(func(req execution.ScheduleRequest) bool {
+			return req.At != nil && time.Until(*req.At) > 59*time.Minute && time.Until(*req.At) <= time.Hour
+		})).Return(inv, nil).Once()
+		t.Cleanup(func() {
+			functions.AssertExpectations(t)
+			publisher.AssertExpectations(t)
+			scheduler.AssertExpectations(t)
+		})
+
+		// The executor is never called directly for scheduled invocations.
+		service := NewService(ServiceOptions{
+			Functions:      functions,
+			Executor:       &mockFunctionScheduler{},
+			EventPublisher: publisher,
+			Scheduler:      scheduler,
+		})
+		delay := "1h"
+		resp, err := service.InvokeFunction(context.Background(), &apiv2.InvokeFunctionRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			Data:       data,
+			Delay:      &delay,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, inv.ID.String(), resp.Data.RunId)
+		require.Equal(t, inv.ID.String(), resp.Data.GetScheduleId())
+		require.Equal(t, inv.At.UnixMilli(), resp.Data.ScheduledAt.AsTime().UnixMilli())
+	})
+
+	t.Run("validates schedule", func(t *testing.T) {
+		delay := "1h"
+		badDelay := "soon"
+		tests := []struct {
+			name    string
+			req     *apiv2.InvokeFunctionRequest
+			message string
+		}{
+			{
+				name:    "both set",
+				req:     &apiv2.InvokeFunctionRequest{ScheduledAt: timestamppb.New(time.Now().Add(time.Hour)), Delay: &delay},
+				message: "Only one of scheduled_at or delay may be specified",
+			},
+			{
+				name:    "invalid delay",
+				req:     &apiv2.InvokeFunctionRequest{Delay: &badDelay},
+				message: "Delay must be a positive duration",
+			},
+			{
+				name:    "in the past",
+				req:     &apiv2.InvokeFunctionRequest{ScheduledAt: timestamppb.New(time.Now().Add(-time.Hour))},
+				message: "must run in the future",
+			},
+			{
+				name:    "too far in the future",
+				req:     &apiv2.InvokeFunctionRequest{ScheduledAt: timestamppb.New(time.Now().Add(scheduled.MaxDelay + time.Hour))},
+				message: "cannot run more than",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{
+					Functions:      &mockFunctionProvider{},
+					Executor:       &mockFunctionScheduler{},
+					EventPublisher: &mockEventPublisher{},
+					Scheduler:      &mockInvocationScheduler{},
+				})
+				test.req.AppId = "app"
+				test.req.FunctionId = "fn"
+				test.req.Data = data
+
+				resp, err := service.InvokeFunction(context.Background(), test.req)
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("requires scheduler", func(t *testing.T) {
+		service := NewService(ServiceOptions{
+			Functions:      &mockFunctionProvider{},
+			Executor:       &mockFunctionScheduler{},
+			EventPublisher: &mockEventPublisher{},
+		})
+		delay := "1h"
+		resp, err := service.InvokeFunction(context.Background(), &apiv2.InvokeFunctionRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			Data:       data,
+			Delay:      &delay,
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Scheduled invocations are not yet implemented")
+	})
+}
+
+func TestService_ListScheduledInvocations(t *testing.T) {
+	t.Run("lists pending invocations", func(t *testing.T) {
+		fn := newScheduledTestFunction()
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunctionByApp", mock.Anything, "app", "fn").Return(fn, nil).Once()
+
+		at := time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)
+		inv := scheduled.Invocation{ID: ulid.Make(), At: at, CreatedAt: at.Add(-time.Hour)}
+		scheduler := &mockInvocationScheduler{}
+		scheduler.On("List", mock.Anything, fn.EnvironmentID, fn.ID, scheduled.ListOpts{Cursor: "c1", Limit: 10}).
+			Return([]scheduled.Invocation{inv}, "c2", nil).Once()
+		t.Cleanup(func() {
+			functions.AssertExpectations(t)
+			scheduler.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{Functions: functions, Scheduler: scheduler})
+		cursor := "c1"
+		limit := int32(10)
+		resp, err := service.ListScheduledInvocations(context.Background(), &apiv2.ListScheduledInvocationsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			Cursor:     &cursor,
+			Limit:      &limit,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		require.Equal(t, inv.ID.String(), resp.Data[0].Id)
+		require.Equal(t, inv.ID.String(), resp.Data[0].RunId)
+		require.Equal(t, at, resp.Data[0].ScheduledAt.AsTime())
+		require.True(t, resp.Page.HasMore)
+		require.Equal(t, "c2", resp.Page.GetCursor())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		fn := newScheduledTestFunction()
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunctionByApp", mock.Anything, "app", "fn").Return(fn, nil).Once()
+		scheduler := &mockInvocationScheduler{}
+		scheduler.On("List", mock.Anything, fn.EnvironmentID, fn.ID, mock.Anything).
+			Return(nil, "", scheduled.ErrInvalidCursor).Once()
+
+		service := NewService(ServiceOptions{Functions: functions, Scheduler: scheduler})
+		cursor := "nope"
+		resp, err := service.ListScheduledInvocations(context.Background(), &apiv2.ListScheduledInvocationsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			Cursor:     &cursor,
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Cursor is invalid")
+	})
+
+	t.Run("requires scheduler", func(t *testing.T) {
+		service := NewService(ServiceOptions{Functions: &mockFunctionProvider{}})
+		resp, err := service.ListScheduledInvocations(context.Background(), &apiv2.ListScheduledInvocationsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Scheduled invocations are not yet implemented")
+	})
+}
+
+func TestService_CancelScheduledInvocation(t *testing.T) {
+	t.Run("cancels a pending invocation", func(t *testing.T) {
+		fn := newScheduledTestFunction()
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunctionByApp", mock.Anything, "app", "fn").Return(fn, nil).Once()
+
+		inv := &scheduled.Invocation{ID: ulid.Make(), At: time.Now().Add(time.Hour)}
+		scheduler := &mockInvocationScheduler{}
+		scheduler.On("Cancel", mock.Anything, fn.EnvironmentID, fn.ID, inv.ID).Return(inv, nil).Once()
+		t.Cleanup(func() {
+			functions.AssertExpectations(t)
+			scheduler.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{Functions: functions, Scheduler: scheduler})
+		resp, err := service.CancelScheduledInvocation(context.Background(), &apiv2.CancelScheduledInvocationRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			ScheduleId: inv.ID.String(),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, inv.ID.String(), resp.Data.Id)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		fn := newScheduledTestFunction()
+		functions := &mockFunctionProvider{}
+		functions.On("GetFunctionByApp", mock.Anything, "app", "fn").Return(fn, nil).Once()
+		scheduler := &mockInvocationScheduler{}
+		scheduler.On("Cancel", mock.Anything, fn.EnvironmentID, fn.ID, mock.Anything).
+			Return(nil, scheduled.ErrInvocationNotFound).Once()
+
+		service := NewService(ServiceOptions{Functions: functions, Scheduler: scheduler})
+		resp, err := service.CancelScheduledInvocation(context.Background(), &apiv2.CancelScheduledInvocationRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			ScheduleId: ulid.Make().String(),
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Scheduled invocation not found")
+	})
+
+	t.Run("invalid schedule ID", func(t *testing.T) {
+		service := NewService(ServiceOptions{Functions: &mockFunctionProvider{}, Scheduler: &mockInvocationScheduler{}})
+		resp, err := service.CancelScheduledInvocation(context.Background(), &apiv2.CancelScheduledInvocationRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			ScheduleId: "nope",
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Schedule ID must be a valid ULID")
+	})
+}
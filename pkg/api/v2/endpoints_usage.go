@@ -0,0 +1,103 @@
+package apiv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/usage"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetUsage(ctx context.Context, req *apiv2.GetUsageRequest) (*apiv2.GetUsageResponse, error) {
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_GetUsage_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no usage was fetched.")
+	}
+
+	if s.usage == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Usage is not yet implemented")
+	}
+
+	period := enums.PeriodHour
+	if req.Period != nil {
+		p, err := enums.PeriodString(strings.ToLower(req.GetPeriod()))
+		if err != nil || p == enums.PeriodNone {
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+				"Period must be one of minute, hour, day, week or month")
+		}
+		period = p
+	}
+
+	input := usage.UsageInput{Period: &period}
+	if req.From != nil {
+		from := req.From.AsTime()
+		input.From = &from
+	}
+	if req.Until != nil {
+		until := req.Until.AsTime()
+		input.To = &until
+	}
+	if err := input.Validate(ctx, nil); err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Invalid time range: %s", err))
+	}
+
+	from, until := input.Window(time.Now())
+	rows, err := s.usage.GetUsageTotals(ctx, cqrs.GetUsageTotalsOpt{
+		Period: period,
+		From:   from,
+		Until:  until,
+	})
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch usage")
+	}
+
+	data := make([]*apiv2.UsageBucket, 0, len(rows))
+	for _, u := range rows {
+		data = append(data, toAPIUsageBucket(u))
+	}
+	total := cqrs.TotalUsage(rows)
+
+	return &apiv2.GetUsageResponse{
+		Data: data,
+		Totals: &apiv2.UsageTotals{
+			Runs:           total.Runs,
+			Steps:          total.Steps,
+			Events:         total.Events,
+			StepDurationMs: total.StepDuration.Milliseconds(),
+		},
+		Metadata: &apiv2.ResponseMetadata{
+			FetchedAt: timestamppb.Now(),
+			TimeRange: &apiv2.TimeRange{
+				From:  timestamppb.New(from),
+				Until: timestamppb.New(until),
+			},
+		},
+	}, nil
+}
+
+func toAPIUsageBucket(u *cqrs.Usage) *apiv2.UsageBucket {
+	b := &apiv2.UsageBucket{
+		Bucket:         timestamppb.New(u.Bucket),
+		EventName:      u.EventName,
+		Runs:           u.Runs,
+		Steps:          u.Steps,
+		Events:         u.Events,
+		StepDurationMs: u.StepDuration.Milliseconds(),
+	}
+	if u.AppID != uuid.Nil {
+		b.AppId = u.AppID.String()
+	}
+	if u.FunctionID != uuid.Nil {
+		b.FunctionId = u.FunctionID.String()
+	}
+	return b
+}
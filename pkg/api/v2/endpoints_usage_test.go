@@ -0,0 +1,123 @@
+package apiv2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_GetUsage(t *testing.T) {
+	appID := uuid.New()
+	fnID := uuid.New()
+	from := time.Date(2026, 4, 9, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
+
+	t.Run("returns buckets and totals", func(t *testing.T) {
+		reader := &mockUsageReader{}
+		reader.On("GetUsageTotals", mock.Anything, cqrs.GetUsageTotalsOpt{
+			Period: enums.PeriodDay,
+			From:   from,
+			Until:  until,
+		}).Return([]*cqrs.Usage{
+			{Bucket: from, AppID: appID, FunctionID: fnID, EventName: "app/signup", Runs: 2, Steps: 5, StepDuration: 1500 * time.Millisecond},
+			{Bucket: from, EventName: "app/signup", Events: 3},
+			{Bucket: from.AddDate(0, 0, 1), AppID: appID, FunctionID: fnID, EventName: "app/signup", Runs: 1, Steps: 1, StepDuration: time.Second},
+		}, nil).Once()
+		t.Cleanup(func() {
+			reader.AssertExpectations(t)
+		})
+
+		period := "DAY"
+		service := NewService(ServiceOptions{Usage: reader})
+		resp, err := service.GetUsage(context.Background(), &apiv2.GetUsageRequest{
+			Period: &period,
+			From:   timestamppb.New(from.Add(6 * time.Hour)),
+			Until:  timestamppb.New(until),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 3)
+		require.Equal(t, from, resp.Data[0].Bucket.AsTime())
+		require.Equal(t, appID.String(), resp.Data[0].AppId)
+		require.Equal(t, fnID.String(), resp.Data[0].FunctionId)
+		require.Equal(t, "app/signup", resp.Data[0].EventName)
+		require.Equal(t, int64(1500), resp.Data[0].StepDurationMs)
+		require.Empty(t, resp.Data[1].AppId)
+		require.Empty(t, resp.Data[1].FunctionId)
+
+		require.Equal(t, int64(3), resp.Totals.Runs)
+		require.Equal(t, int64(6), resp.Totals.Steps)
+		require.Equal(t, int64(3), resp.Totals.Events)
+		require.Equal(t, int64(2500), resp.Totals.StepDurationMs)
+		require.Equal(t, from, resp.Metadata.TimeRange.From.AsTime())
+		require.Equal(t, until, resp.Metadata.TimeRange.Until.AsTime())
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		unknown := "fortnight"
+		minute := "minute"
+
+		tests := []struct {
+			name    string
+			req     *apiv2.GetUsageRequest
+			message string
+		}{
+			{name: "unknown period", req: &apiv2.GetUsageRequest{Period: &unknown}, message: "Period must be one of"},
+			{name: "reversed range", req: &apiv2.GetUsageRequest{From: timestamppb.New(until), Until: timestamppb.New(from)}, message: "from must be before to"},
+			{name: "range too large", req: &apiv2.GetUsageRequest{Period: &minute, From: timestamppb.New(from), Until: timestamppb.New(until)}, message: "range must be smaller than"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{Usage: &mockUsageReader{}})
+				resp, err := service.GetUsage(context.Background(), test.req)
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("maps errors", func(t *testing.T) {
+		reader := &mockUsageReader{}
+		reader.On("GetUsageTotals", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("db closed")).Once()
+
+		service := NewService(ServiceOptions{Usage: reader})
+		resp, err := service.GetUsage(context.Background(), &apiv2.GetUsageRequest{})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Unable to fetch usage")
+	})
+
+	t.Run("requires reader", func(t *testing.T) {
+		service := NewService(ServiceOptions{})
+		resp, err := service.GetUsage(context.Background(), &apiv2.GetUsageRequest{})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "not yet implemented")
+	})
+
+	t.Run("applies rate limit", func(t *testing.T) {
+		rateLimiter := &mockRateLimitProvider{}
+		rateLimiter.On("CheckRateLimit", mock.Anything, apiv2.V2_GetUsage_FullMethodName).
+			Return(RateLimitResult{Limited: true}).Once()
+		t.Cleanup(func() {
+			rateLimiter.AssertExpectations(t)
+		})
+
+		service := NewService(ServiceOptions{Usage: &mockUsageReader{}, RateLimitProvider: rateLimiter})
+		resp, err := service.GetUsage(context.Background(), &apiv2.GetUsageRequest{})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "API rate limit exceeded")
+	})
+}
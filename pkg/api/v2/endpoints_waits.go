@@ -0,0 +1,369 @@
+package apiv2
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/pauses"
+	"github.com/inngest/inngest/pkg/execution/state"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultFunctionWaitsLimit = 20
+	maxFunctionWaitsLimit     = 100
+	// functionWaitsRunsPage is the number of running runs loaded at a time
+	// when listing a function's waits.
+	functionWaitsRunsPage = 50
+	// maxFunctionWaitsScannedRuns bounds the running runs inspected by a
+	// single page of function waits.
+	maxFunctionWaitsScannedRuns = 1000
+)
+
+func (s *Service) ListRunWaits(ctx context.Context, req *apiv2.ListRunWaitsRequest) (*apiv2.ListRunWaitsResponse, error) {
+	if req.RunId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Run ID is required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListRunWaits_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no waits were fetched.")
+	}
+
+	if s.waits == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Waits are not yet implemented")
+	}
+
+	runID, err := ulid.Parse(req.RunId)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Run ID must be a valid ULID")
+	}
+
+	waits, err := s.waits.ListRunWaits(ctx, runID)
+	if err != nil {
+		return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch waits")
+	}
+
+	data := make([]*apiv2.Wait, 0, len(waits))
+	for _, w := range waits {
+		data = append(data, toAPIWait(w))
+	}
+
+	return &apiv2.ListRunWaitsResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) ListFunctionWaits(ctx context.Context, req *apiv2.ListFunctionWaitsRequest) (*apiv2.ListFunctionWaitsResponse, error) {
+	if req.AppId == "" || req.FunctionId == "" {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "App ID and function ID are required")
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ListFunctionWaits_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no waits were fetched.")
+	}
+
+	if s.runs == nil || s.waits == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Waits are not yet implemented")
+	}
+
+	cursor, limit, err := functionWaitsPageOpts(req.GetCursor(), req.GetLimit())
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+
+	// Only running runs can be blocked on a wait, so page through the
+	// function's running runs and load the waits of each until the page of
+	// waits is full.
+	runsLimit := int32(functionWaitsRunsPage)
+	opts, err := listRunsOpts(&apiv2.ListRunsRequest{
+		Limit:      &runsLimit,
+		Status:     []string{"RUNNING"},
+		AppId:      []string{decodePathParam(req.AppId)},
+		FunctionId: []string{decodePathParam(req.FunctionId)},
+	})
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, err.Error())
+	}
+	opts.Cursor = cursor.Runs
+
+	var (
+		data    = []*apiv2.Wait{}
+		next    functionWaitsCursor
+		hasMore bool
+		scanned int
+	)
+
+scan:
+	for {
+		result, err := s.runs.GetRuns(ctx, opts)
+		if err != nil {
+			return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch runs")
+		}
+		if result == nil {
+			result = &GetRunsResult{}
+		}
+
+		// prev is the runs cursor which lists the current run first.
+		prev := opts.Cursor
+		for i, run := range result.Runs {
+			waits, err := s.waits.ListRunWaits(ctx, run.RunID)
+			if err != nil {
+				return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch waits")
+			}
+			slices.SortStableFunc(waits, compareWaits)
+
+			for _, w := range waits {
+				if cursor.RunID == run.RunID.String() && !cursor.before(w) {
+					// Returned within the previous page.
+					continue
+				}
+				if len(data) == limit {
+					hasMore = true
+					break scan
+				}
+				data = append(data, toAPIWait(w))
+				next = functionWaitsCursor{
+					Runs:      prev,
+					RunID:     run.RunID.String(),
+					CreatedAt: w.CreatedAt,
+					WaitID:    w.ID.String(),
+				}
+			}
+
+			prev = run.Cursor
+			scanned++
+			if scanned >= maxFunctionWaitsScannedRuns && (result.HasMore || i < len(result.Runs)-1) {
+				// Bound the work done per request.  The next page resumes
+				// after the last run inspected.
+				hasMore = true
+				next = functionWaitsCursor{Runs: run.Cursor}
+				break scan
+			}
+		}
+
+		if !result.HasMore || len(result.Runs) == 0 {
+			break
+		}
+		opts.Cursor = result.Runs[len(result.Runs)-1].Cursor
+	}
+
+	page := &apiv2.Page{
+		HasMore: hasMore,
+		Limit:   int32(limit),
+	}
+	if hasMore {
+		nextCursor, err := next.encode()
+		if err != nil {
+			return nil, s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, "Unable to fetch waits")
+		}
+		page.Cursor = &nextCursor
+	}
+
+	return &apiv2.ListFunctionWaitsResponse{
+		Data:     data,
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+		Page:     page,
+	}, nil
+}
+
+func (s *Service) ResolveWait(ctx context.Context, req *apiv2.ResolveWaitRequest) (*apiv2.ResolveWaitResponse, error) {
+	runID, waitID, err := s.waitIDs(req.RunId, req.WaitId)
+	if err != nil {
+		return nil, err
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_ResolveWait_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no wait was resolved.")
+	}
+
+	if s.waits == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Waits are not yet implemented")
+	}
+
+	data, err := json.Marshal(req.GetData().AsInterface())
+	if err != nil {
+		return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Data must be valid JSON")
+	}
+
+	p, err := s.waits.ResolveWait(ctx, runID, waitID, data)
+	if err != nil {
+		switch {
+		case errors.Is(err, pauses.ErrInvalidWaitData):
+			return nil, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Data must be an object when resolving step.waitForEvent")
+		case errors.Is(err, pauses.ErrWaitNotResolvable):
+			return nil, s.base.NewError(http.StatusConflict, apiv2base.ErrorWaitNotResolvable,
+				"Only step.waitForEvent and step.waitForSignal waits can be resolved. Use the timeout endpoint instead")
+		}
+		return nil, s.waitError(err, "Unable to resolve wait")
+	}
+
+	return &apiv2.ResolveWaitResponse{
+		Data:     toAPIWait(*p),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) TimeoutWait(ctx context.Context, req *apiv2.TimeoutWaitRequest) (*apiv2.TimeoutWaitResponse, error) {
+	runID, waitID, err := s.waitIDs(req.RunId, req.WaitId)
+	if err != nil {
+		return nil, err
+	}
+
+	if result := s.rateLimiter.CheckRateLimit(ctx, apiv2.V2_TimeoutWait_FullMethodName); result.Limited {
+		return nil, s.base.NewError(http.StatusTooManyRequests, apiv2base.ErrorRateLimited,
+			"API rate limit exceeded. The request was rejected and no wait was timed out.")
+	}
+
+	if s.waits == nil {
+		return nil, s.base.NewError(http.StatusNotImplemented, apiv2base.ErrorNotImplemented, "Waits are not yet implemented")
+	}
+
+	p, err := s.waits.TimeoutWait(ctx, runID, waitID)
+	if err != nil {
+		return nil, s.waitError(err, "Unable to time out wait")
+	}
+
+	return &apiv2.TimeoutWaitResponse{
+		Data:     toAPIWait(*p),
+		Metadata: &apiv2.ResponseMetadata{FetchedAt: timestamppb.Now()},
+	}, nil
+}
+
+func (s *Service) waitIDs(rawRunID, rawWaitID string) (ulid.ULID, uuid.UUID, error) {
+	if rawRunID == "" || rawWaitID == "" {
+		return ulid.ULID{}, uuid.UUID{}, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Run ID and wait ID are required")
+	}
+	runID, err := ulid.Parse(rawRunID)
+	if err != nil {
+		return ulid.ULID{}, uuid.UUID{}, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Run ID must be a valid ULID")
+	}
+	waitID, err := uuid.Parse(rawWaitID)
+	if err != nil {
+		return ulid.ULID{}, uuid.UUID{}, s.base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Wait ID must be a valid UUID")
+	}
+	return runID, waitID, nil
+}
+
+func (s *Service) waitError(err error, message string) error {
+	if errors.Is(err, pauses.ErrWaitNotFound) {
+		return s.base.NewError(http.StatusNotFound, apiv2base.ErrorNotFound, "Wait not found")
+	}
+	return s.base.NewError(http.StatusInternalServerError, apiv2base.ErrorInternalError, message)
+}
+
+// functionWaitsCursor positions a page of a function's waits.  Runs is the
+// runs cursor to resume listing running runs from.  When RunID is set, the
+// first run listed is the run of the previous page's last wait, and its waits
+// up to and including that wait are skipped.
+type functionWaitsCursor struct {
+	Runs      string    `json:"r,omitempty"`
+	RunID     string    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"t,omitzero"`
+	WaitID    string    `json:"w,omitempty"`
+}
+
+func (c functionWaitsCursor) encode() (string, error) {
+	byt, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(byt), nil
+}
+
+func (c *functionWaitsCursor) decode(val string) error {
+	byt, err := base64.StdEncoding.DecodeString(val)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(byt, c)
+}
+
+// before reports whether the cursor's wait is ordered before the given wait.
+func (c functionWaitsCursor) before(w state.Pause) bool {
+	if n := w.CreatedAt.Compare(c.CreatedAt); n != 0 {
+		return n > 0
+	}
+	return w.ID.String() > c.WaitID
+}
+
+func functionWaitsPageOpts(rawCursor string, requestedLimit int32) (functionWaitsCursor, int, error) {
+	cursor := functionWaitsCursor{}
+	limit := int(requestedLimit)
+	if limit == 0 {
+		limit = defaultFunctionWaitsLimit
+	}
+	if limit < 1 {
+		return cursor, 0, fmt.Errorf("Limit must be at least 1")
+	}
+	if limit > maxFunctionWaitsLimit {
+		return cursor, 0, fmt.Errorf("Limit cannot exceed %d", maxFunctionWaitsLimit)
+	}
+	if rawCursor == "" {
+		return cursor, limit, nil
+	}
+	if err := cursor.decode(rawCursor); err != nil || (cursor.Runs != "" && !validRunsCursor(cursor.Runs)) {
+		return cursor, 0, fmt.Errorf("Cursor is invalid")
+	}
+	if cursor.RunID != "" {
+		if _, err := ulid.Parse(cursor.RunID); err != nil {
+			return cursor, 0, fmt.Errorf("Cursor is invalid")
+		}
+	}
+	return cursor, limit, nil
+}
+
+// compareWaits orders a run's waits by creation time, then ID, so that pages
+// of waits are stable.
+func compareWaits(a, b state.Pause) int {
+	if n := a.CreatedAt.Compare(b.CreatedAt); n != 0 {
+		return n
+	}
+	return strings.Compare(a.ID.String(), b.ID.String())
+}
+
+func toAPIWait(p state.Pause) *apiv2.Wait {
+	w := &apiv2.Wait{
+		Id:               p.ID.String(),
+		RunId:            p.Identifier.RunID.String(),
+		Type:             toAPIWaitType(p),
+		StepName:         p.StepName,
+		Event:            p.Event,
+		Expression:       p.Expression,
+		Signal:           p.SignalID,
+		InvokeFunctionId: p.InvokeTargetFnID,
+		CreatedAt:        timestamppb.New(p.CreatedAt),
+	}
+	if expires := p.Expires.Time(); !expires.IsZero() {
+		w.TimeoutAt = timestamppb.New(expires)
+	}
+	return w
+}
+
+func toAPIWaitType(p state.Pause) apiv2.WaitType {
+	switch p.GetOpcode() {
+	case enums.OpcodeWaitForEvent:
+		return apiv2.WaitType_WAIT_TYPE_EVENT
+	case enums.OpcodeWaitForSignal:
+		return apiv2.WaitType_WAIT_TYPE_SIGNAL
+	case enums.OpcodeInvokeFunction:
+		return apiv2.WaitType_WAIT_TYPE_INVOKE
+	default:
+		return apiv2.WaitType_WAIT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,268 @@
+package apiv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/pauses"
+	"github.com/inngest/inngest/pkg/execution/state"
+	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestWait(runID ulid.ULID, opcode enums.Opcode) state.Pause {
+	op := opcode.String()
+	return state.Pause{
+		ID:         uuid.New(),
+		Identifier: state.PauseIdentifier{RunID: runID},
+		Opcode:     &op,
+		StepName:   "wait",
+		Expires:    state.Time(time.Date(2026, 4, 9, 12, 0, 0, 0, time.UTC)),
+		CreatedAt:  time.Date(2026, 4, 9, 11, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestService_ListRunWaits(t *testing.T) {
+	t.Run("lists waits", func(t *testing.T) {
+		runID := ulid.Make()
+		evt := "api/user.created"
+		expr := "async.data.id == event.data.id"
+		wait := newTestWait(runID, enums.OpcodeWaitForEvent)
+		wait.Event = &evt
+		wait.Expression = &expr
+
+		waits := &mockWaitProvider{}
+		waits.On("ListRunWaits", mock.Anything, runID).Return([]state.Pause{wait}, nil).Once()
+		t.Cleanup(func() { waits.AssertExpectations(t) })
+
+		service := NewService(ServiceOptions{Waits: waits})
+		resp, err := service.ListRunWaits(context.Background(), &apiv2.ListRunWaitsRequest{RunId: runID.String()})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		require.Equal(t, wait.ID.String(), resp.Data[0].Id)
+		require.Equal(t, runID.String(), resp.Data[0].RunId)
+		require.Equal(t, apiv2.WaitType_WAIT_TYPE_EVENT, resp.Data[0].Type)
+		require.Equal(t, evt, resp.Data[0].GetEvent())
+		require.Equal(t, expr, resp.Data[0].GetExpression())
+		require.Equal(t, wait.Expires.Time(), resp.Data[0].TimeoutAt.AsTime())
+	})
+
+	t.Run("invalid run ID", func(t *testing.T) {
+		service := NewService(ServiceOptions{Waits: &mockWaitProvider{}})
+		resp, err := service.ListRunWaits(context.Background(), &apiv2.ListRunWaitsRequest{RunId: "nope"})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Run ID must be a valid ULID")
+	})
+
+	t.Run("requires provider", func(t *testing.T) {
+		service := NewService(ServiceOptions{})
+		resp, err := service.ListRunWaits(context.Background(), &apiv2.ListRunWaitsRequest{RunId: ulid.Make().String()})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Waits are not yet implemented")
+	})
+}
+
+func TestService_ListFunctionWaits(t *testing.T) {
+	first, second, third := ulid.Make(), ulid.Make(), ulid.Make()
+	firstWaits := []state.Pause{
+		newTestWait(first, enums.OpcodeWaitForEvent),
+		newTestWait(first, enums.OpcodeWaitForSignal),
+	}
+	firstWaits[1].CreatedAt = firstWaits[0].CreatedAt.Add(time.Second)
+	secondWait := newTestWait(second, enums.OpcodeWaitForEvent)
+
+	newMocks := func() (*mockRunProvider, *mockWaitProvider) {
+		runs := &mockRunProvider{}
+		runs.On("GetRuns", mock.Anything, mock.MatchedBy(func(opts GetRunsOpts) bool {
+			return opts.Cursor == "" &&
+				opts.Limit == functionWaitsRunsPage &&
+				len(opts.Status) == 1 && opts.Status[0] == enums.RunStatusRunning &&
+				len(opts.AppIDs) == 1 && opts.AppIDs[0] == "app" &&
+				len(opts.FunctionIDs) == 1 && opts.FunctionIDs[0] == "fn"
+		})).Return(&GetRunsResult{
+			Runs: []*RunListItem{
+				{RunID: first, Cursor: "c1"},
+				{RunID: second, Cursor: "c2"},
+			},
+			HasMore: true,
+		}, nil).Maybe()
+		runs.On("GetRuns", mock.Anything, mock.MatchedBy(func(opts GetRunsOpts) bool {
+			return opts.Cursor == "c2"
+		})).Return(&GetRunsResult{
+			Runs: []*RunListItem{{RunID: third, Cursor: "c3"}},
+		}, nil).Maybe()
+
+		waits := &mockWaitProvider{}
+		// Waits are returned out of order to check that pages are sorted.
+		waits.On("ListRunWaits", mock.Anything, first).Return([]state.Pause{firstWaits[1], firstWaits[0]}, nil).Maybe()
+		waits.On("ListRunWaits", mock.Anything, second).Return([]state.Pause{secondWait}, nil).Maybe()
+		waits.On("ListRunWaits", mock.Anything, third).Return([]state.Pause{}, nil).Maybe()
+		return runs, waits
+	}
+
+	t.Run("pages over waits", func(t *testing.T) {
+		runs, waits := newMocks()
+		service := NewService(ServiceOptions{Runs: runs, Waits: waits})
+
+		limit := int32(2)
+		resp, err := service.ListFunctionWaits(context.Background(), &apiv2.ListFunctionWaitsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			Limit:      &limit,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 2)
+		require.Equal(t, firstWaits[0].ID.String(), resp.Data[0].Id)
+		require.Equal(t, firstWaits[1].ID.String(), resp.Data[1].Id)
+		require.True(t, resp.Page.HasMore)
+		require.EqualValues(t, 2, resp.Page.Limit)
+		require.NotEmpty(t, resp.Page.GetCursor())
+
+		resp, err = service.ListFunctionWaits(context.Background(), &apiv2.ListFunctionWaitsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+			Limit:      &limit,
+			Cursor:     resp.Page.Cursor,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 1)
+		require.Equal(t, secondWait.ID.String(), resp.Data[0].Id)
+		require.False(t, resp.Page.HasMore)
+		require.Nil(t, resp.Page.Cursor)
+	})
+
+	t.Run("fills a page from multiple runs pages", func(t *testing.T) {
+		runs, waits := newMocks()
+		service := NewService(ServiceOptions{Runs: runs, Waits: waits})
+
+		resp, err := service.ListFunctionWaits(context.Background(), &apiv2.ListFunctionWaitsRequest{
+			AppId:      "app",
+			FunctionId: "fn",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Data, 3)
+		require.False(t, resp.Page.HasMore)
+		runs.AssertCalled(t, "GetRuns", mock.Anything, mock.MatchedBy(func(opts GetRunsOpts) bool {
+			return opts.Cursor == "c2"
+		}))
+		waits.AssertCalled(t, "ListRunWaits", mock.Anything, third)
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		invalid := "nope"
+		tooMany := int32(maxFunctionWaitsLimit + 1)
+
+		tests := []struct {
+			name    string
+			req     *apiv2.ListFunctionWaitsRequest
+			message string
+		}{
+			{name: "missing IDs", req: &apiv2.ListFunctionWaitsRequest{AppId: "app"}, message: "App ID and function ID are required"},
+			{name: "invalid cursor", req: &apiv2.ListFunctionWaitsRequest{AppId: "app", FunctionId: "fn", Cursor: &invalid}, message: "Cursor is invalid"},
+			{name: "limit too large", req: &apiv2.ListFunctionWaitsRequest{AppId: "app", FunctionId: "fn", Limit: &tooMany}, message: "Limit cannot exceed"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				service := NewService(ServiceOptions{Runs: &mockRunProvider{}, Waits: &mockWaitProvider{}})
+				resp, err := service.ListFunctionWaits(context.Background(), test.req)
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+}
+
+func TestService_ResolveWait(t *testing.T) {
+	runID := ulid.Make()
+	wait := newTestWait(runID, enums.OpcodeWaitForEvent)
+	data, err := structpb.NewValue(map[string]any{"approved": true})
+	require.NoError(t, err)
+
+	t.Run("resolves a wait", func(t *testing.T) {
+		waits := &mockWaitProvider{}
+		waits.On("ResolveWait", mock.Anything, runID, wait.ID, json.RawMessage(`{"approved":true}`)).
+			Return(&wait, nil).Once()
+		t.Cleanup(func() { waits.AssertExpectations(t) })
+
+		service := NewService(ServiceOptions{Waits: waits})
+		resp, err := service.ResolveWait(context.Background(), &apiv2.ResolveWaitRequest{
+			RunId:  runID.String(),
+			WaitId: wait.ID.String(),
+			Data:   data,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, wait.ID.String(), resp.Data.Id)
+	})
+
+	t.Run("maps errors", func(t *testing.T) {
+		tests := []struct {
+			err     error
+			message string
+		}{
+			{err: pauses.ErrWaitNotFound, message: "Wait not found"},
+			{err: fmt.Errorf("%w: leased", pauses.ErrWaitNotFound), message: "Wait not found"},
+			{err: pauses.ErrWaitNotResolvable, message: "Use the timeout endpoint instead"},
+			{err: pauses.ErrInvalidWaitData, message: "Data must be an object"},
+			{err: fmt.Errorf("boom"), message: "Unable to resolve wait"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.message, func(t *testing.T) {
+				waits := &mockWaitProvider{}
+				waits.On("ResolveWait", mock.Anything, runID, wait.ID, mock.Anything).Return(nil, test.err).Once()
+
+				service := NewService(ServiceOptions{Waits: waits})
+				resp, err := service.ResolveWait(context.Background(), &apiv2.ResolveWaitRequest{
+					RunId:  runID.String(),
+					WaitId: wait.ID.String(),
+				})
+
+				require.Nil(t, resp)
+				require.ErrorContains(t, err, test.message)
+			})
+		}
+	})
+
+	t.Run("invalid wait ID", func(t *testing.T) {
+		service := NewService(ServiceOptions{Waits: &mockWaitProvider{}})
+		resp, err := service.ResolveWait(context.Background(), &apiv2.ResolveWaitRequest{
+			RunId:  runID.String(),
+			WaitId: "nope",
+		})
+
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "Wait ID must be a valid UUID")
+	})
+}
+
+func TestService_TimeoutWait(t *testing.T) {
+	runID := ulid.Make()
+	wait := newTestWait(runID, enums.OpcodeInvokeFunction)
+
+	waits := &mockWaitProvider{}
+	waits.On("TimeoutWait", mock.Anything, runID, wait.ID).Return(&wait, nil).Once()
+	t.Cleanup(func() { waits.AssertExpectations(t) })
+
+	service := NewService(ServiceOptions{Waits: waits})
+	resp, err := service.TimeoutWait(context.Background(), &apiv2.TimeoutWaitRequest{
+		RunId:  runID.String(),
+		WaitId: wait.ID.String(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, apiv2.WaitType_WAIT_TYPE_INVOKE, resp.Data.Type)
+}
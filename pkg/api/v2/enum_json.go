@@ -21,6 +21,11 @@ var responseEnumPrefixes = []string{
 	"SANDBOX_STATUS_",
 	"SANDBOX_PROCESS_STATE_",
 	"SANDBOX_LOG_STREAM_",
+	"BULK_RUN_OPERATION_ACTION_",
+	"BULK_RUN_OPERATION_STATUS_",
+	"WAIT_TYPE_",
+	"OUTBOUND_WEBHOOK_DELIVERY_STATUS_",
+	"ALERT_STATUS_",
 }
 
 type responseEnumMarshaler struct {
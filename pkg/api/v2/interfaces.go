@@ -10,7 +10,12 @@ import (
 	"github.com/inngest/inngest/pkg/enums"
 	"github.com/inngest/inngest/pkg/event"
 	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/alerts"
+	"github.com/inngest/inngest/pkg/execution/bulk"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
+	"github.com/inngest/inngest/pkg/execution/state"
 	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
 	"github.com/inngest/inngest/pkg/tracing/metadata"
 	"github.com/oklog/ulid/v2"
 )
@@ -79,6 +84,19 @@ type FunctionScheduler interface {
 	Schedule(ctx context.Context, req execution.ScheduleRequest) (*ulid.ULID, *sv2.Metadata, error)
 }
 
+// InvocationScheduler schedules function invocations to run at a future time,
+// and lists or cancels pending invocations.  Implementations return
+// scheduled.ErrInvalidSchedule, scheduled.ErrInvalidCursor, and
+// scheduled.ErrInvocationNotFound where appropriate.
+type InvocationScheduler interface {
+	// Schedule schedules the given request to run at req.At.  Errors from the
+	// underlying FunctionScheduler are returned as-is, alongside the invocation
+	// if a run ID was assigned.
+	Schedule(ctx context.Context, req execution.ScheduleRequest) (*scheduled.Invocation, error)
+	List(ctx context.Context, workspaceID, functionID uuid.UUID, opts scheduled.ListOpts) ([]scheduled.Invocation, string, error)
+	Cancel(ctx context.Context, workspaceID, functionID uuid.UUID, id ulid.ULID) (*scheduled.Invocation, error)
+}
+
 type EventPublisher interface {
 	Publish(ctx context.Context, event event.TrackedEvent) error
 }
@@ -102,6 +120,8 @@ type GetRunsOpts struct {
 	FunctionIDs   []string
 	IsDeferred    *bool
 	Order         OrderDirection
+	// Search is a full-text query over event data, step outputs and errors.
+	Search string
 }
 
 type RunTimeField int
@@ -130,6 +150,8 @@ type RunListItem struct {
 	EndedAt      *time.Time
 	Output       json.RawMessage
 
+	FunctionVersion int
+
 	FunctionID   string
 	FunctionName string
 	AppID        string
@@ -156,6 +178,89 @@ type RerunFromStep struct {
 	Input  json.RawMessage
 }
 
+// CreateBulkRunOperationOpts creates a bulk operation within the authenticated
+// environment.
+type CreateBulkRunOperationOpts struct {
+	Action        enums.BulkOperationAction
+	Filter        bulk.Filter
+	RatePerSecond int
+}
+
+// BulkRunOperationProvider previews, creates, and manages bulk operations
+// within the authenticated environment.  Implementations return
+// bulk.ErrOperationNotFound and bulk.ErrOperationEnded where appropriate.
+type BulkRunOperationProvider interface {
+	Preview(ctx context.Context, f bulk.Filter) (int, error)
+	Create(ctx context.Context, opts CreateBulkRunOperationOpts) (*bulk.Operation, error)
+	Get(ctx context.Context, id ulid.ULID) (*bulk.Operation, error)
+	List(ctx context.Context, opts bulk.ListOpts) ([]bulk.Operation, bool, error)
+	Abort(ctx context.Context, id ulid.ULID) (*bulk.Operation, error)
+}
+
+// WaitProvider lists and manually resolves the pending waits, such as
+// step.waitForEvent and step.waitForSignal, that runs within the authenticated
+// environment are blocked on.  Implementations return pauses.ErrWaitNotFound,
+// pauses.ErrWaitNotResolvable, and pauses.ErrInvalidWaitData where
+// appropriate.
+type WaitProvider interface {
+	ListRunWaits(ctx context.Context, runID ulid.ULID) ([]state.Pause, error)
+	ResolveWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID, data json.RawMessage) (*state.Pause, error)
+	TimeoutWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID) (*state.Pause, error)
+}
+
+// CreateOutboundWebhookOpts creates an outbound webhook within the
+// authenticated environment.
+type CreateOutboundWebhookOpts struct {
+	URL               string
+	Secret            string
+	Events            []webhooks.EventType
+	Filter            string
+	DurationThreshold time.Duration
+	Disabled          bool
+}
+
+// OutboundWebhookProvider manages outbound webhooks, which are notified of
+// run lifecycle events, and their deliveries within the authenticated
+// environment.  Implementations return webhooks.ErrEndpointNotFound,
+// webhooks.ErrDeliveryNotFound, webhooks.ErrDeliveryPending, and errors
+// wrapping webhooks.ErrInvalidEndpoint where appropriate.
+type OutboundWebhookProvider interface {
+	Create(ctx context.Context, opts CreateOutboundWebhookOpts) (*webhooks.Endpoint, error)
+	Get(ctx context.Context, id ulid.ULID) (*webhooks.Endpoint, error)
+	List(ctx context.Context) ([]webhooks.Endpoint, error)
+	Update(ctx context.Context, id ulid.ULID, opts webhooks.UpdateEndpointOpts) (*webhooks.Endpoint, error)
+	Delete(ctx context.Context, id ulid.ULID) error
+	ListDeliveries(ctx context.Context, id ulid.ULID, opts webhooks.ListOpts) ([]webhooks.Delivery, bool, error)
+	Replay(ctx context.Context, id ulid.ULID, deliveryID ulid.ULID) (*webhooks.Delivery, error)
+}
+
+// CreateAlertRuleOpts creates an alert rule within the authenticated
+// environment.
+type CreateAlertRuleOpts struct {
+	Name         string
+	FunctionID   uuid.UUID
+	FunctionSlug string
+	Metric       alerts.Metric
+	SkipReason   *enums.SkipReason
+	Threshold    float64
+	Window       time.Duration
+	Channels     []alerts.Channel
+	Disabled     bool
+}
+
+// AlertProvider manages alert rules on function health and their alert
+// history within the authenticated environment.  Implementations return
+// alerts.ErrRuleNotFound and errors wrapping alerts.ErrInvalidRule where
+// appropriate.
+type AlertProvider interface {
+	CreateRule(ctx context.Context, opts CreateAlertRuleOpts) (*alerts.Rule, error)
+	GetRule(ctx context.Context, id ulid.ULID) (*alerts.Rule, error)
+	ListRules(ctx context.Context) ([]alerts.Rule, error)
+	UpdateRule(ctx context.Context, id ulid.ULID, opts alerts.UpdateRuleOpts) (*alerts.Rule, error)
+	DeleteRule(ctx context.Context, id ulid.ULID) error
+	ListAlerts(ctx context.Context, opts alerts.ListAlertsOpts) ([]alerts.Alert, bool, error)
+}
+
 type FunctionTraceReader interface {
 	GetSpansByRunID(ctx context.Context, runID ulid.ULID) (*cqrs.OtelSpan, error)
 	GetSpanOutput(ctx context.Context, id cqrs.SpanIdentifier) (*cqrs.SpanOutput, error)
@@ -11,7 +11,9 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/inngest/inngest/pkg/api"
 	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/audit"
 	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/cqrs"
 	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -20,20 +22,27 @@ import (
 // Service implements the V2 API service for gRPC with grpc-gateway
 type Service struct {
 	apiv2.UnimplementedV2Server
-	signingKeys    SigningKeysProvider
-	eventKeys      EventKeysProvider
-	apps           AppProvider
-	functions      FunctionProvider
-	functionConfig FunctionConfigProvider
-	runs           RunProvider
-	traces         FunctionTraceReader
-	executor       FunctionScheduler
-	eventPublisher EventPublisher
-	eventSender    EventSender
-	maxEventSize   int
-	scores         ScoreProvider
-	rateLimiter    RateLimitProvider
-	base           *apiv2base.Base
+	signingKeys      SigningKeysProvider
+	eventKeys        EventKeysProvider
+	apps             AppProvider
+	functions        FunctionProvider
+	functionConfig   FunctionConfigProvider
+	functionVersions cqrs.FunctionVersionReader
+	runs             RunProvider
+	bulkRuns         BulkRunOperationProvider
+	waits            WaitProvider
+	webhooks         OutboundWebhookProvider
+	alerts           AlertProvider
+	traces           FunctionTraceReader
+	executor         FunctionScheduler
+	scheduler        InvocationScheduler
+	eventPublisher   EventPublisher
+	eventSender      EventSender
+	maxEventSize     int
+	scores           ScoreProvider
+	usage            cqrs.UsageReader
+	rateLimiter      RateLimitProvider
+	base             *apiv2base.Base
 }
 
 // ServiceOptions contains configuration for the V2 service
@@ -43,13 +52,20 @@ type ServiceOptions struct {
 	Apps                AppProvider
 	Functions           FunctionProvider
 	FunctionConfig      FunctionConfigProvider
+	FunctionVersions    cqrs.FunctionVersionReader
 	Runs                RunProvider
+	BulkRuns            BulkRunOperationProvider
+	Waits               WaitProvider
+	OutboundWebhooks    OutboundWebhookProvider
+	Alerts              AlertProvider
 	FunctionTraces      FunctionTraceReader
 	Executor            FunctionScheduler
+	Scheduler           InvocationScheduler
 	EventPublisher      EventPublisher
 	EventSender         EventSender
 	MaxEventSize        int
 	Scores              ScoreProvider
+	Usage               cqrs.UsageReader
 	RateLimitProvider   RateLimitProvider
 }
 
@@ -63,20 +79,27 @@ func NewService(opts ServiceOptions) *Service {
 		maxEventSize = consts.AbsoluteMaxEventSize
 	}
 	return &Service{
-		signingKeys:    opts.SigningKeysProvider,
-		eventKeys:      opts.EventKeysProvider,
-		apps:           opts.Apps,
-		functions:      opts.Functions,
-		functionConfig: opts.FunctionConfig,
-		runs:           opts.Runs,
-		traces:         opts.FunctionTraces,
-		executor:       opts.Executor,
-		eventPublisher: opts.EventPublisher,
-		eventSender:    opts.EventSender,
-		maxEventSize:   maxEventSize,
-		scores:         opts.Scores,
-		rateLimiter:    rateLimiter,
-		base:           apiv2base.NewBase(),
+		signingKeys:      opts.SigningKeysProvider,
+		eventKeys:        opts.EventKeysProvider,
+		apps:             opts.Apps,
+		functions:        opts.Functions,
+		functionConfig:   opts.FunctionConfig,
+		functionVersions: opts.FunctionVersions,
+		runs:             opts.Runs,
+		bulkRuns:         opts.BulkRuns,
+		waits:            opts.Waits,
+		webhooks:         opts.OutboundWebhooks,
+		alerts:           opts.Alerts,
+		traces:           opts.FunctionTraces,
+		executor:         opts.Executor,
+		scheduler:        opts.Scheduler,
+		eventPublisher:   opts.EventPublisher,
+		eventSender:      opts.EventSender,
+		maxEventSize:     maxEventSize,
+		scores:           opts.Scores,
+		usage:            opts.Usage,
+		rateLimiter:      rateLimiter,
+		base:             apiv2base.NewBase(),
 	}
 }
 
@@ -117,6 +140,16 @@ type HTTPHandlerOptions struct {
 	AuthnMiddleware   func(http.Handler) http.Handler
 	AuthzMiddleware   func(http.Handler) http.Handler
 	MetricsMiddleware api.MetricsMiddleware
+	// AuditLog, if set, records mutating calls to the audit log.
+	AuditLog cqrs.AuditLogWriter
+}
+
+// auditLogOption records mutating gateway calls to the given audit log, if set.
+func auditLogOption(w cqrs.AuditLogWriter) runtime.ServeMuxOption {
+	if w == nil {
+		return func(*runtime.ServeMux) {}
+	}
+	return runtime.WithMiddlewares(audit.GatewayMiddleware(w, audit.SourceAPIv2))
 }
 
 func NewHTTPHandler(ctx context.Context, serviceOpts ServiceOptions, httpOpts HTTPHandlerOptions, base *apiv2base.Base) (http.Handler, error) {
@@ -152,6 +185,7 @@ func NewHTTPHandler(ctx context.Context, serviceOpts ServiceOptions, httpOpts HT
 			}
 			return nil
 		}),
+		auditLogOption(httpOpts.AuditLog),
 	)
 	if err := apiv2.RegisterV2HandlerServer(ctx, gwmux, service); err != nil {
 		return nil, fmt.Errorf("failed to register v2 gateway handler: %w", err)
@@ -2,9 +2,18 @@ package apiv2
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/alerts"
+	"github.com/inngest/inngest/pkg/execution/bulk"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
+	"github.com/inngest/inngest/pkg/execution/state"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
 	"github.com/inngest/inngest/pkg/inngest"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/mock"
@@ -13,7 +22,16 @@ import (
 var _ AppProvider = (*mockAppProvider)(nil)
 var _ FunctionProvider = (*mockFunctionProvider)(nil)
 var _ RunProvider = (*mockRunProvider)(nil)
+var _ BulkRunOperationProvider = (*mockBulkRunOperationProvider)(nil)
+var _ WaitProvider = (*mockWaitProvider)(nil)
+var _ OutboundWebhookProvider = (*mockOutboundWebhookProvider)(nil)
+var _ AlertProvider = (*mockAlertProvider)(nil)
+var _ InvocationScheduler = (*mockInvocationScheduler)(nil)
+var _ FunctionScheduler = (*mockFunctionScheduler)(nil)
+var _ EventPublisher = (*mockEventPublisher)(nil)
 var _ FunctionTraceReader = (*mockFunctionTraceReader)(nil)
+var _ cqrs.FunctionVersionReader = (*mockFunctionVersionReader)(nil)
+var _ cqrs.UsageReader = (*mockUsageReader)(nil)
 var _ RateLimitProvider = (*mockRateLimitProvider)(nil)
 
 type mockAppProvider struct {
@@ -80,6 +98,187 @@ func (m *mockRunProvider) Cancel(ctx context.Context, runID ulid.ULID) error {
 	return m.Called(ctx, runID).Error(0)
 }
 
+type mockWaitProvider struct {
+	mock.Mock
+}
+
+func (m *mockWaitProvider) ListRunWaits(ctx context.Context, runID ulid.ULID) ([]state.Pause, error) {
+	args := m.Called(ctx, runID)
+	waits, _ := args.Get(0).([]state.Pause)
+	return waits, args.Error(1)
+}
+
+func (m *mockWaitProvider) ResolveWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID, data json.RawMessage) (*state.Pause, error) {
+	args := m.Called(ctx, runID, waitID, data)
+	p, _ := args.Get(0).(*state.Pause)
+	return p, args.Error(1)
+}
+
+func (m *mockWaitProvider) TimeoutWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID) (*state.Pause, error) {
+	args := m.Called(ctx, runID, waitID)
+	p, _ := args.Get(0).(*state.Pause)
+	return p, args.Error(1)
+}
+
+type mockBulkRunOperationProvider struct {
+	mock.Mock
+}
+
+func (m *mockBulkRunOperationProvider) Preview(ctx context.Context, f bulk.Filter) (int, error) {
+	args := m.Called(ctx, f)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockBulkRunOperationProvider) Create(ctx context.Context, opts CreateBulkRunOperationOpts) (*bulk.Operation, error) {
+	args := m.Called(ctx, opts)
+	op, _ := args.Get(0).(*bulk.Operation)
+	return op, args.Error(1)
+}
+
+func (m *mockBulkRunOperationProvider) Get(ctx context.Context, id ulid.ULID) (*bulk.Operation, error) {
+	args := m.Called(ctx, id)
+	op, _ := args.Get(0).(*bulk.Operation)
+	return op, args.Error(1)
+}
+
+func (m *mockBulkRunOperationProvider) List(ctx context.Context, opts bulk.ListOpts) ([]bulk.Operation, bool, error) {
+	args := m.Called(ctx, opts)
+	ops, _ := args.Get(0).([]bulk.Operation)
+	return ops, args.Bool(1), args.Error(2)
+}
+
+func (m *mockBulkRunOperationProvider) Abort(ctx context.Context, id ulid.ULID) (*bulk.Operation, error) {
+	args := m.Called(ctx, id)
+	op, _ := args.Get(0).(*bulk.Operation)
+	return op, args.Error(1)
+}
+
+type mockAlertProvider struct {
+	mock.Mock
+}
+
+func (m *mockAlertProvider) CreateRule(ctx context.Context, opts CreateAlertRuleOpts) (*alerts.Rule, error) {
+	args := m.Called(ctx, opts)
+	r, _ := args.Get(0).(*alerts.Rule)
+	return r, args.Error(1)
+}
+
+func (m *mockAlertProvider) GetRule(ctx context.Context, id ulid.ULID) (*alerts.Rule, error) {
+	args := m.Called(ctx, id)
+	r, _ := args.Get(0).(*alerts.Rule)
+	return r, args.Error(1)
+}
+
+func (m *mockAlertProvider) ListRules(ctx context.Context) ([]alerts.Rule, error) {
+	args := m.Called(ctx)
+	rules, _ := args.Get(0).([]alerts.Rule)
+	return rules, args.Error(1)
+}
+
+func (m *mockAlertProvider) UpdateRule(ctx context.Context, id ulid.ULID, opts alerts.UpdateRuleOpts) (*alerts.Rule, error) {
+	args := m.Called(ctx, id, opts)
+	r, _ := args.Get(0).(*alerts.Rule)
+	return r, args.Error(1)
+}
+
+func (m *mockAlertProvider) DeleteRule(ctx context.Context, id ulid.ULID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockAlertProvider) ListAlerts(ctx context.Context, opts alerts.ListAlertsOpts) ([]alerts.Alert, bool, error) {
+	args := m.Called(ctx, opts)
+	result, _ := args.Get(0).([]alerts.Alert)
+	return result, args.Bool(1), args.Error(2)
+}
+
+type mockOutboundWebhookProvider struct {
+	mock.Mock
+}
+
+func (m *mockOutboundWebhookProvider) Create(ctx context.Context, opts CreateOutboundWebhookOpts) (*webhooks.Endpoint, error) {
+	args := m.Called(ctx, opts)
+	e, _ := args.Get(0).(*webhooks.Endpoint)
+	return e, args.Error(1)
+}
+
+func (m *mockOutboundWebhookProvider) Get(ctx context.Context, id ulid.ULID) (*webhooks.Endpoint, error) {
+	args := m.Called(ctx, id)
+	e, _ := args.Get(0).(*webhooks.Endpoint)
+	return e, args.Error(1)
+}
+
+func (m *mockOutboundWebhookProvider) List(ctx context.Context) ([]webhooks.Endpoint, error) {
+	args := m.Called(ctx)
+	endpoints, _ := args.Get(0).([]webhooks.Endpoint)
+	return endpoints, args.Error(1)
+}
+
+func (m *mockOutboundWebhookProvider) Update(ctx context.Context, id ulid.ULID, opts webhooks.UpdateEndpointOpts) (*webhooks.Endpoint, error) {
+	args := m.Called(ctx, id, opts)
+	e, _ := args.Get(0).(*webhooks.Endpoint)
+	return e, args.Error(1)
+}
+
+func (m *mockOutboundWebhookProvider) Delete(ctx context.Context, id ulid.ULID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockOutboundWebhookProvider) ListDeliveries(ctx context.Context, id ulid.ULID, opts webhooks.ListOpts) ([]webhooks.Delivery, bool, error) {
+	args := m.Called(ctx, id, opts)
+	deliveries, _ := args.Get(0).([]webhooks.Delivery)
+	return deliveries, args.Bool(1), args.Error(2)
+}
+
+func (m *mockOutboundWebhookProvider) Replay(ctx context.Context, id ulid.ULID, deliveryID ulid.ULID) (*webhooks.Delivery, error) {
+	args := m.Called(ctx, id, deliveryID)
+	d, _ := args.Get(0).(*webhooks.Delivery)
+	return d, args.Error(1)
+}
+
+type mockFunctionScheduler struct {
+	mock.Mock
+}
+
+func (m *mockFunctionScheduler) Schedule(ctx context.Context, req execution.ScheduleRequest) (*ulid.ULID, *sv2.Metadata, error) {
+	args := m.Called(ctx, req)
+	runID, _ := args.Get(0).(*ulid.ULID)
+	md, _ := args.Get(1).(*sv2.Metadata)
+	return runID, md, args.Error(2)
+}
+
+type mockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, evt event.TrackedEvent) error {
+	args := m.Called(ctx, evt)
+	return args.Error(0)
+}
+
+type mockInvocationScheduler struct {
+	mock.Mock
+}
+
+func (m *mockInvocationScheduler) Schedule(ctx context.Context, req execution.ScheduleRequest) (*scheduled.Invocation, error) {
+	args := m.Called(ctx, req)
+	inv, _ := args.Get(0).(*scheduled.Invocation)
+	return inv, args.Error(1)
+}
+
+func (m *mockInvocationScheduler) List(ctx context.Context, workspaceID, functionID uuid.UUID, opts scheduled.ListOpts) ([]scheduled.Invocation, string, error) {
+	args := m.Called(ctx, workspaceID, functionID, opts)
+	invs, _ := args.Get(0).([]scheduled.Invocation)
+	return invs, args.String(1), args.Error(2)
+}
+
+func (m *mockInvocationScheduler) Cancel(ctx context.Context, workspaceID, functionID uuid.UUID, id ulid.ULID) (*scheduled.Invocation, error) {
+	args := m.Called(ctx, workspaceID, functionID, id)
+	inv, _ := args.Get(0).(*scheduled.Invocation)
+	return inv, args.Error(1)
+}
+
 type mockFunctionTraceReader struct {
 	mock.Mock
 }
@@ -102,6 +301,32 @@ func (m *mockFunctionTraceReader) GetStepSpanByStepID(ctx context.Context, runID
 	return span, args.Error(1)
 }
 
+type mockFunctionVersionReader struct {
+	mock.Mock
+}
+
+func (m *mockFunctionVersionReader) GetFunctionVersions(ctx context.Context, fnID uuid.UUID) ([]*cqrs.FunctionVersion, error) {
+	args := m.Called(ctx, fnID)
+	versions, _ := args.Get(0).([]*cqrs.FunctionVersion)
+	return versions, args.Error(1)
+}
+
+func (m *mockFunctionVersionReader) GetFunctionVersion(ctx context.Context, fnID uuid.UUID, version int) (*cqrs.FunctionVersion, error) {
+	args := m.Called(ctx, fnID, version)
+	v, _ := args.Get(0).(*cqrs.FunctionVersion)
+	return v, args.Error(1)
+}
+
+type mockUsageReader struct {
+	mock.Mock
+}
+
+func (m *mockUsageReader) GetUsageTotals(ctx context.Context, opt cqrs.GetUsageTotalsOpt) ([]*cqrs.Usage, error) {
+	args := m.Called(ctx, opt)
+	rows, _ := args.Get(0).([]*cqrs.Usage)
+	return rows, args.Error(1)
+}
+
 type mockRateLimitProvider struct {
 	mock.Mock
 }
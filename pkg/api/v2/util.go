@@ -2,10 +2,14 @@ package apiv2
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/inngest/inngest/pkg/api/v2/apiv2base"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
 	apiv2 "github.com/inngest/inngest/proto/gen/api/v2"
+	str2duration "github.com/xhit/go-str2duration/v2"
 )
 
 func validateInvokeRequest(ctx context.Context, req *apiv2.InvokeFunctionRequest) error {
@@ -21,5 +25,39 @@ func validateInvokeRequest(ctx context.Context, req *apiv2.InvokeFunctionRequest
 		return apiv2base.NewError(http.StatusBadRequest, apiv2base.ErrorMissingField, "Input data is required")
 	}
 
+	if req.ScheduledAt != nil && req.Delay != nil {
+		return apiv2base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Only one of scheduled_at or delay may be specified")
+	}
+
 	return nil
 }
+
+// invokeScheduleTime returns the time that an invoke request should run at,
+// or nil if the function should be invoked immediately.
+func invokeScheduleTime(req *apiv2.InvokeFunctionRequest, now time.Time) (*time.Time, error) {
+	var at time.Time
+	switch {
+	case req.ScheduledAt != nil:
+		if err := req.ScheduledAt.CheckValid(); err != nil {
+			return nil, apiv2base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Scheduled at must be a valid timestamp")
+		}
+		at = req.ScheduledAt.AsTime()
+	case req.Delay != nil:
+		delay, err := str2duration.ParseDuration(*req.Delay)
+		if err != nil || delay <= 0 {
+			return nil, apiv2base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Delay must be a positive duration, such as \"30m\" or \"2d\"")
+		}
+		at = now.Add(delay)
+	default:
+		return nil, nil
+	}
+
+	if !at.After(now) {
+		return nil, apiv2base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat, "Scheduled invocations must run in the future")
+	}
+	if at.Sub(now) > scheduled.MaxDelay {
+		return nil, apiv2base.NewError(http.StatusBadRequest, apiv2base.ErrorInvalidFieldFormat,
+			fmt.Sprintf("Scheduled invocations cannot run more than %s in the future", str2duration.String(scheduled.MaxDelay)))
+	}
+	return &at, nil
+}
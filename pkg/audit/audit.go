@@ -0,0 +1,163 @@
+// Package audit records mutating calls made by operators via the APIs, eg.
+// cancelling runs or changing semaphore levels, to an append-only audit log.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inngest/inngest/pkg/authn"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	SourceAPIv0   = "api.v0"
+	SourceAPIv1   = "api.v1"
+	SourceAPIv2   = "api.v2"
+	SourceGraphQL = "graphql"
+	SourceDebug   = "debug"
+
+	// ActorAnonymous is recorded as the actor for calls which were not
+	// authenticated with a key, eg. when no signing key is configured.
+	ActorAnonymous = "anonymous"
+
+	// MaxPayloadSize is the largest payload recorded with an entry.  Larger
+	// payloads are replaced with a marker noting that they were truncated.
+	MaxPayloadSize = 64 * 1024
+
+	redacted = "[redacted]"
+)
+
+// redactedFields are substrings of payload field names whose values are never
+// written to the audit log.
+var redactedFields = []string{"secret", "password", "token", "signingkey", "privatekey"}
+
+// Actor returns the actor for the call in the given context: the ID of the key
+// used to authenticate, or ActorAnonymous.
+func Actor(ctx context.Context) string {
+	if id := authn.FromContext(ctx).KeyID(); id != "" {
+		return id
+	}
+	return ActorAnonymous
+}
+
+// Record appends the given entry to the audit log.  Failing to record an entry
+// never fails the call being audited, so errors are logged and dropped.
+func Record(ctx context.Context, w cqrs.AuditLogWriter, entry cqrs.AuditLog) {
+	if w == nil {
+		return
+	}
+	if entry.ID.IsZero() {
+		entry.ID = ulid.MustNew(ulid.Now(), ulid.DefaultEntropy())
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	if entry.Actor == "" {
+		entry.Actor = Actor(ctx)
+	}
+
+	// The call may have finished with a cancelled context, eg. if the client
+	// disconnected, but its side effects still need to be recorded.
+	if err := w.InsertAuditLog(context.WithoutCancel(ctx), entry); err != nil {
+		logger.StdlibLogger(ctx).Error(
+			"error recording audit log",
+			"error", err,
+			"source", entry.Source,
+			"action", entry.Action,
+			"target", entry.Target,
+		)
+	}
+}
+
+// Payload returns the JSON payload to record for a call's input.  JSON input
+// is recorded with sensitive fields redacted; other input is recorded as a
+// JSON string.
+func Payload(byt []byte) json.RawMessage {
+	byt = bytes.TrimSpace(byt)
+	if len(byt) == 0 {
+		return nil
+	}
+	if len(byt) > MaxPayloadSize {
+		out, _ := json.Marshal(map[string]any{"truncated": true})
+		return out
+	}
+
+	var v any
+	if err := json.Unmarshal(byt, &v); err != nil {
+		out, _ := json.Marshal(string(byt))
+		return out
+	}
+	return PayloadFrom(v)
+}
+
+// PayloadFrom returns the JSON payload to record for an already decoded input,
+// with sensitive fields redacted.
+func PayloadFrom(v any) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	out, err := json.Marshal(redact(v))
+	if err != nil || len(out) > MaxPayloadSize {
+		out, _ = json.Marshal(map[string]any{"truncated": true})
+	}
+	return out
+}
+
+func redact(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			if isRedacted(k) {
+				out[k] = redacted
+				continue
+			}
+			out[k] = redact(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = redact(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func isRedacted(field string) bool {
+	field = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(field))
+	for _, f := range redactedFields {
+		if strings.Contains(field, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Target returns the value of the first of the given fields found in args, or
+// within a nested object in args, for use as an entry's target.
+func Target(args map[string]any, fields ...string) string {
+	for _, f := range fields {
+		if s, ok := args[f].(string); ok && s != "" {
+			return s
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(args)) {
+		if nested, ok := args[k].(map[string]any); ok {
+			if s := Target(nested, fields...); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
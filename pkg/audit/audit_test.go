@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type memLog struct {
+	mu      sync.Mutex
+	entries []cqrs.AuditLog
+}
+
+func (m *memLog) InsertAuditLog(ctx context.Context, entry cqrs.AuditLog) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *memLog) GetAuditLogs(ctx context.Context, opt cqrs.GetAuditLogsOpt) ([]*cqrs.AuditLog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := []*cqrs.AuditLog{}
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		entry := m.entries[i]
+		if opt.Cursor != nil && entry.ID.Compare(*opt.Cursor) >= 0 {
+			continue
+		}
+		out = append(out, &entry)
+		if uint(len(out)) == opt.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func TestPayload(t *testing.T) {
+	t.Run("redacts sensitive fields", func(t *testing.T) {
+		out := Payload([]byte(`{"name":"a","signing_key":"x","nested":[{"apiToken":"y"}]}`))
+		require.JSONEq(t, `{"name":"a","signing_key":"[redacted]","nested":[{"apiToken":"[redacted]"}]}`, string(out))
+	})
+
+	t.Run("records non-JSON input as a string", func(t *testing.T) {
+		require.JSONEq(t, `"hello"`, string(Payload([]byte("hello"))))
+	})
+
+	t.Run("truncates large input", func(t *testing.T) {
+		out := Payload(bytes.Repeat([]byte("a"), MaxPayloadSize+1))
+		require.JSONEq(t, `{"truncated":true}`, string(out))
+	})
+
+	t.Run("ignores empty input", func(t *testing.T) {
+		require.Nil(t, Payload([]byte("  ")))
+	})
+}
+
+func TestTarget(t *testing.T) {
+	args := map[string]any{
+		"input": map[string]any{"functionSlug": "app-fn"},
+		"name":  "",
+	}
+	require.Equal(t, "app-fn", Target(args, "runID", "functionSlug", "name"))
+	require.Equal(t, "01J", Target(map[string]any{"runID": "01J", "functionSlug": "fn"}, "runID", "functionSlug"))
+	require.Empty(t, Target(map[string]any{"other": 1}, "runID"))
+}
+
+func TestMiddleware(t *testing.T) {
+	log := &memLog{}
+
+	r := chi.NewRouter()
+	r.Use(Middleware(log, SourceAPIv1))
+	r.Get("/runs/{runID}", func(w http.ResponseWriter, r *http.Request) {})
+	r.Delete("/runs/{runID}", func(w http.ResponseWriter, r *http.Request) {
+		// The handler must still be able to read the body.
+		byt, _ := io.ReadAll(r.Body)
+		require.JSONEq(t, `{"reason":"stuck"}`, string(byt))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/runs/01J")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Empty(t, log.entries)
+
+	req, err := http.NewRequest(http.MethodDelete, srv.URL+"/runs/01J", strings.NewReader(`{"reason":"stuck"}`))
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	require.Len(t, log.entries, 1)
+	entry := log.entries[0]
+	require.False(t, entry.ID.IsZero())
+	require.Equal(t, ActorAnonymous, entry.Actor)
+	require.Equal(t, SourceAPIv1, entry.Source)
+	require.Equal(t, "DELETE /runs/{runID}", entry.Action)
+	require.Equal(t, "/runs/01J", entry.Target)
+	require.JSONEq(t, `{"reason":"stuck"}`, string(entry.Payload))
+	require.Equal(t, http.StatusAccepted, entry.Status)
+}
+
+func TestParamPattern(t *testing.T) {
+	require.Equal(t,
+		"/v2/runs/{runId}/cancel",
+		paramPattern("/v2/runs/01J/cancel", map[string]string{"runId": "01J"}),
+	)
+	require.Equal(t, "/v2/apps", paramPattern("/v2/apps", nil))
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	log := &memLog{}
+	for range 5 {
+		Record(context.Background(), log, cqrs.AuditLog{
+			ID:     ulid.Make(),
+			Source: SourceDebug,
+			Action: "/debug.v1.Debug/DeleteBatch",
+		})
+	}
+
+	buf := &bytes.Buffer{}
+	err := WriteNDJSON(context.Background(), buf, log, cqrs.GetAuditLogsOpt{Limit: 2})
+	require.NoError(t, err)
+
+	ids := []ulid.ULID{}
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		entry := cqrs.AuditLog{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		ids = append(ids, entry.ID)
+	}
+	require.Len(t, ids, 5)
+	for i := 1; i < len(ids); i++ {
+		require.Equal(t, -1, ids[i].Compare(ids[i-1]), "entries should be newest first")
+	}
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/inngest/inngest/pkg/cqrs"
+)
+
+// ContentTypeNDJSON is the content type of audit log exports.
+const ContentTypeNDJSON = "application/x-ndjson"
+
+// WriteNDJSON writes every entry matching the given filter to out as
+// newline-delimited JSON, newest first, paging through the audit log.  Any
+// limit in opt is used as the page size.
+func WriteNDJSON(ctx context.Context, out io.Writer, r cqrs.AuditLogReader, opt cqrs.GetAuditLogsOpt) error {
+	if opt.Limit == 0 {
+		opt.Limit = cqrs.MaxAuditLogs
+	}
+
+	enc := json.NewEncoder(out)
+	for {
+		entries, err := r.GetAuditLogs(ctx, opt)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := enc.Encode(entry); err != nil {
+				return err
+			}
+		}
+		if f, ok := out.(http.Flusher); ok {
+			f.Flush()
+		}
+
+		if uint(len(entries)) < opt.Limit {
+			return nil
+		}
+		opt.Cursor = &entries[len(entries)-1].ID
+	}
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// graphQLTargetFields are the mutation arguments used as an entry's target,
+// in order of preference.
+var graphQLTargetFields = []string{"runID", "id", "functionSlug", "name", "url"}
+
+// GraphQLExtension records every GraphQL mutation to the audit log, adding an
+// entry for each of the mutation's top-level fields.  Queries and
+// subscriptions are not recorded.
+type GraphQLExtension struct {
+	w cqrs.AuditLogWriter
+}
+
+var (
+	_ graphql.HandlerExtension    = GraphQLExtension{}
+	_ graphql.ResponseInterceptor = GraphQLExtension{}
+)
+
+// NewGraphQLExtension returns a gqlgen handler extension which records
+// mutations to the given audit log.
+func NewGraphQLExtension(w cqrs.AuditLogWriter) GraphQLExtension {
+	return GraphQLExtension{w: w}
+}
+
+func (GraphQLExtension) ExtensionName() string {
+	return "AuditLog"
+}
+
+func (GraphQLExtension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e GraphQLExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+
+	if !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Mutation {
+		return resp
+	}
+
+	// A field failed if any error's path starts at the field.
+	failed := map[string]bool{}
+	if resp != nil {
+		for _, err := range resp.Errors {
+			if len(err.Path) > 0 {
+				if name, ok := err.Path[0].(ast.PathName); ok {
+					failed[string(name)] = true
+				}
+			}
+		}
+	}
+
+	for _, field := range graphql.CollectFields(oc, oc.Operation.SelectionSet, []string{"Mutation"}) {
+		args := field.ArgumentMap(oc.Variables)
+
+		status := http.StatusOK
+		if failed[field.Alias] {
+			status = http.StatusBadRequest
+		}
+
+		Record(ctx, e.w, cqrs.AuditLog{
+			Source:  SourceGraphQL,
+			Action:  "graphql." + field.Name,
+			Target:  Target(args, graphQLTargetFields...),
+			Payload: PayloadFrom(args),
+			Status:  status,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcTargetFields are the request fields used as an entry's target, in order
+// of preference.  Requests are encoded via protojson, so fields use their JSON
+// names.
+var grpcTargetFields = []string{"id", "functionId", "appId", "name", "queueShard", "accountId"}
+
+// UnaryServerInterceptor returns a gRPC interceptor which records calls to the
+// audit log.  Only methods for which audited returns true are recorded, as
+// gRPC gives no way to tell whether a method mutates.  Actions are named by
+// the method's full name, eg. "/debug.v1.Debug/SetSemaphoreLevel".
+//
+// Unauthenticated calls are recorded with the caller's address as the actor.
+func UnaryServerInterceptor(w cqrs.AuditLogWriter, source string, audited func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !audited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+
+		var (
+			payload json.RawMessage
+			args    map[string]any
+		)
+		if msg, ok := req.(proto.Message); ok {
+			if byt, err := protojson.Marshal(msg); err == nil {
+				payload = Payload(byt)
+				_ = json.Unmarshal(byt, &args)
+			}
+		}
+
+		actor := Actor(ctx)
+		if p, ok := peer.FromContext(ctx); ok && actor == ActorAnonymous && p.Addr != nil {
+			host, _, splitErr := net.SplitHostPort(p.Addr.String())
+			if splitErr != nil {
+				host = p.Addr.String()
+			}
+			actor = "addr:" + host
+		}
+
+		Record(ctx, w, cqrs.AuditLog{
+			Actor:   actor,
+			Source:  source,
+			Action:  info.FullMethod,
+			Target:  Target(args, grpcTargetFields...),
+			Payload: payload,
+			Status:  runtime.HTTPStatusFromCode(status.Code(err)),
+		})
+
+		return resp, err
+	}
+}
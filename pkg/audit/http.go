@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/inngest/inngest/pkg/cqrs"
+)
+
+// Middleware returns HTTP middleware which records every mutating request to
+// the audit log.  Actions are named by the request's method and chi route
+// pattern, eg. "DELETE /runs/{runID}", and targets are the request's path.
+//
+// This must be used after any authentication middleware so that the actor
+// can be read from the request context.
+func Middleware(w cqrs.AuditLogWriter, source string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			serve(w, source, rw, r, next, routePattern)
+		})
+	}
+}
+
+// GatewayMiddleware returns grpc-gateway middleware which records every
+// mutating request to the audit log.  Actions are named by the request's
+// method and path, with path parameters replaced by their names.
+func GatewayMiddleware(w cqrs.AuditLogWriter, source string) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(rw http.ResponseWriter, r *http.Request, params map[string]string) {
+			handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				next(rw, r, params)
+			})
+			serve(w, source, rw, r, handler, func(r *http.Request) string {
+				return paramPattern(r.URL.Path, params)
+			})
+		}
+	}
+}
+
+func serve(
+	w cqrs.AuditLogWriter,
+	source string,
+	rw http.ResponseWriter,
+	r *http.Request,
+	next http.Handler,
+	pattern func(*http.Request) string,
+) {
+	if !isMutating(r.Method) {
+		next.ServeHTTP(rw, r)
+		return
+	}
+
+	// Read the body to record it as the payload, then replace it so that
+	// the handler can read it as usual.
+	var body []byte
+	if r.Body != nil {
+		body, _ = io.ReadAll(io.LimitReader(r.Body, MaxPayloadSize+1))
+		r.Body = readCloser{
+			Reader: io.MultiReader(bytes.NewReader(body), r.Body),
+			Closer: r.Body,
+		}
+	}
+
+	ww := middleware.NewWrapResponseWriter(rw, r.ProtoMajor)
+	next.ServeHTTP(ww, r)
+
+	status := ww.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	Record(r.Context(), w, cqrs.AuditLog{
+		Source:  source,
+		Action:  fmt.Sprintf("%s %s", r.Method, pattern(r)),
+		Target:  r.URL.Path,
+		Payload: Payload(body),
+		Status:  status,
+	})
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// routePattern returns the chi route pattern matched by the request, falling
+// back to the request's path for wildcard routes.
+func routePattern(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return r.URL.Path
+	}
+	pattern := rctx.RoutePattern()
+	if pattern == "" || strings.Contains(pattern, "*") {
+		return r.URL.Path
+	}
+	return pattern
+}
+
+// paramPattern replaces path segments matching a path parameter's value with
+// the parameter's name, eg. "/runs/01J.../cancel" becomes
+// "/runs/{runId}/cancel".
+func paramPattern(path string, params map[string]string) string {
+	if len(params) == 0 {
+		return path
+	}
+	names := make(map[string]string, len(params))
+	for k, v := range params {
+		names[v] = k
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if name, ok := names[s]; ok && s != "" {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
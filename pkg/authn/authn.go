@@ -1,6 +1,7 @@
 package authn
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -10,9 +11,27 @@ import (
 type AuthContext struct {
 	// Add fields as needed for your authentication context
 	isAuthenticated bool
+	// keyID identifies the key used to authenticate, without revealing it.
+	keyID string
 	// Add other relevant fields
 }
 
+// KeyID returns the ID of the key used to authenticate, eg. for recording
+// who made a request.
+func (a *AuthContext) KeyID() string {
+	if a == nil {
+		return ""
+	}
+	return a.keyID
+}
+
+// FromContext returns the auth context added by an authentication middleware,
+// or nil if the request was not authenticated.
+func FromContext(ctx context.Context) *AuthContext {
+	authCtx, _ := ctx.Value(authContextKey).(*AuthContext)
+	return authCtx
+}
+
 var (
 	// ErrIncorrectStrategy represents an attempt authenticating via an incorrect strategy,
 	// eg. via an API key when no API key is present in the authorization header.
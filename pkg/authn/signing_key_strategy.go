@@ -68,12 +68,25 @@ func HandleSigningKey(ctx context.Context, clientProvidedKey string, trustedSign
 		subtle.ConstantTimeCompare([]byte(normalizedClientKey), []byte(hashedTrustedKey)) == 1 {
 		return &AuthContext{
 			isAuthenticated: true,
+			keyID:           SigningKeyID(trustedSigningKey),
 		}, nil
 	}
 
 	return nil, errors.New("invalid signing key")
 }
 
+// SigningKeyID returns a stable, non-secret identifier for a signing key: the
+// key's human readable prefix followed by a truncated hash of the key.
+func SigningKeyID(key string) string {
+	prefix := keyRegexp.FindString(key)
+	if prefix == "" {
+		prefix = SigningKeyPrefix
+	}
+
+	sum := sha256.Sum256([]byte(normalizeKey(key)))
+	return prefix + hex.EncodeToString(sum[:])[:16]
+}
+
 func normalizeKey(key string) string {
 	return keyRegexp.ReplaceAllString(key, "")
 }
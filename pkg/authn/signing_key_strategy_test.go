@@ -286,3 +286,29 @@ func TestHashedSigningKey(t *testing.T) {
 		require.Equal(t, expected, hashed)
 	})
 }
+
+func TestSigningKeyID(t *testing.T) {
+	t.Run("should keep the prefix and hide the key", func(t *testing.T) {
+		id := SigningKeyID("signkey-prod-abc123def456")
+		require.Regexp(t, `^signkey-prod-[0-9a-f]{16}$`, id)
+		require.NotContains(t, id, "abc123def456")
+	})
+
+	t.Run("should be stable across prefixes", func(t *testing.T) {
+		require.Equal(t,
+			SigningKeyID("signkey-test-abc123def456")[len("signkey-test-"):],
+			SigningKeyID("signkey-prod-abc123def456")[len("signkey-prod-"):],
+		)
+	})
+
+	t.Run("should be recorded on the auth context", func(t *testing.T) {
+		trustedKey := "signkey-test-abc123def456"
+		authCtx, err := HandleSigningKey(context.Background(), trustedKey, trustedKey)
+		require.NoError(t, err)
+		require.Equal(t, SigningKeyID(trustedKey), authCtx.KeyID())
+	})
+
+	t.Run("should be empty without an auth context", func(t *testing.T) {
+		require.Empty(t, FromContext(context.Background()).KeyID())
+	})
+}
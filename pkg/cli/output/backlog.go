@@ -53,6 +53,11 @@ func TextBacklogList(resp *pb.BacklogsResponse) error {
 		)
 
 		if len(bl.ConcurrencyKeys) > 0 {
+			data.Set("Fairness", OrderedData(
+				"Weight", bl.FairnessWeight,
+				"Share", fmt.Sprintf("%.1f%%", bl.FairnessShare*100),
+			))
+
 			ckMap := NewOrderedMap()
 			for i, ck := range bl.ConcurrencyKeys {
 				ckMap.Set(fmt.Sprintf("Key #%d", i+1), OrderedData(
@@ -63,6 +68,7 @@ func TextBacklogList(resp *pb.BacklogsResponse) error {
 					"Hashed Value", ck.HashedValue,
 					"Unhashed Value", ck.UnhashedValue,
 					"Mode", ck.ConcurrencyMode,
+					"Weight", ck.Weight,
 				))
 			}
 			data.Set("Concurrency Keys", ckMap)
@@ -99,6 +105,9 @@ func TextBacklogSize(resp *pb.BacklogSizeResponse) error {
 	if resp.Backlog != nil {
 		data.Set("Shadow Partition ID", resp.Backlog.ShadowPartitionId)
 		data.Set("Start", resp.Backlog.Start)
+		if len(resp.Backlog.ConcurrencyKeys) > 0 {
+			data.Set("Fairness Weight", resp.Backlog.FairnessWeight)
+		}
 
 		if len(resp.Backlog.ConcurrencyKeys) > 0 {
 			ckMap := NewOrderedMap()
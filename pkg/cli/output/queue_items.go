@@ -0,0 +1,96 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/inngest/inngest/proto/gen/debug/v1"
+)
+
+func TextQueueItemList(resp *pb.QueueItemsResponse) error {
+	if resp == nil || len(resp.Items) == 0 {
+		fmt.Println("no queue items found")
+		return nil
+	}
+
+	w := NewTextWriter()
+
+	if err := w.WriteOrdered(OrderedData(
+		"Queue Shard", resp.QueueShard,
+		"Items", len(resp.Items),
+		"Next Cursor", resp.NextCursor,
+	), WithTextOptLeadSpace(true)); err != nil {
+		return err
+	}
+
+	for _, item := range resp.Items {
+		if err := w.WriteOrdered(OrderedData(
+			"ID", item.Id,
+			"Kind", item.Kind,
+			"RunID", item.RunId,
+			"FunctionID", item.FunctionId,
+			"Attempt", item.Attempt,
+			"At", item.At.AsTime().Format(time.RFC3339),
+			"EnqueuedAt", item.EnqueuedAt.AsTime().Format(time.RFC3339),
+			"Leased", item.Leased,
+		), WithTextOptLeadSpace(true)); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+func TextQueueItemResults(resp *pb.RequeueQueueItemsResponse) error {
+	if resp == nil || len(resp.Results) == 0 {
+		fmt.Println("no queue items requeued")
+		return nil
+	}
+
+	w := NewTextWriter()
+
+	for _, res := range resp.Results {
+		data := OrderedData(
+			"ID", res.ItemId,
+			"Status", queueItemResultStatus(res.Status),
+		)
+		if res.At != nil {
+			data.Set("At", res.At.AsTime().Format(time.RFC3339))
+		}
+
+		if err := w.WriteOrdered(data, WithTextOptLeadSpace(true)); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+func TextQueueItemPurge(resp *pb.PurgeQueueItemsResponse, dryRun bool) error {
+	if resp == nil {
+		fmt.Println("no queue items purged")
+		return nil
+	}
+
+	label := "Purged"
+	if dryRun {
+		label = "Would Purge"
+	}
+
+	w := NewTextWriter()
+	if err := w.WriteOrdered(OrderedData(
+		"Queue Shard", resp.QueueShard,
+		label, resp.Purged,
+		"Skipped (Leased)", resp.SkippedLeased,
+		"Has More", resp.HasMore,
+		"Item IDs", resp.ItemIds,
+	), WithTextOptLeadSpace(true)); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func queueItemResultStatus(s pb.QueueItemResultStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "QUEUE_ITEM_RESULT_STATUS_"))
+}
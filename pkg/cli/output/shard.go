@@ -0,0 +1,70 @@
+package output
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/inngest/inngest/proto/gen/debug/v1"
+)
+
+func TextShardMigration(m *pb.ShardMigration) error {
+	if m == nil {
+		fmt.Println("no shard migration found")
+		return nil
+	}
+
+	w := NewTextWriter()
+	if err := writeShardMigration(w, m); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func TextShardMigrationList(resp *pb.ShardMigrationsResponse) error {
+	if resp == nil || len(resp.Migrations) == 0 {
+		fmt.Println("no shard migrations found")
+		return nil
+	}
+
+	w := NewTextWriter()
+	for _, m := range resp.Migrations {
+		if err := writeShardMigration(w, m); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func writeShardMigration(w *TextWriter, m *pb.ShardMigration) error {
+	data := OrderedData(
+		"ID", m.Id,
+		"Status", m.Status,
+		"Phase", m.Phase,
+		"AccountID", m.AccountId,
+	)
+	if m.FunctionId != "" {
+		data.Set("FunctionID", m.FunctionId)
+	}
+	data.Set("Source Shard", m.SourceShard)
+	data.Set("Target Shard", m.TargetShard)
+	data.Set("Functions", m.Functions)
+	data.Set("Passes", m.Passes)
+	data.Set("Items Moved", m.ItemsMoved)
+	data.Set("Items Leased", m.ItemsLeased)
+	data.Set("In Progress", m.InProgress)
+	if m.RollbackOf != "" {
+		data.Set("Rollback Of", m.RollbackOf)
+	}
+	if m.RolledBackBy != "" {
+		data.Set("Rolled Back By", m.RolledBackBy)
+	}
+	if m.Error != "" {
+		data.Set("Error", m.Error)
+	}
+	data.Set("Started At", m.StartedAt.AsTime().Format(time.RFC3339))
+	if m.FinishedAt != nil {
+		data.Set("Finished At", m.FinishedAt.AsTime().Format(time.RFC3339))
+	}
+
+	return w.WriteOrdered(data, WithTextOptLeadSpace(true))
+}
@@ -66,6 +66,8 @@ type NewDriverOpts struct {
 	ConnectForwarder  grpc.RequestForwarder
 	ConditionalTracer trace.ConditionalTracer
 	HTTPClient        exechttp.RequestExecutor
+	// AppTLSLoader loads each app's TLS config for HTTP requests.
+	AppTLSLoader exechttp.TLSLoader
 }
 
 // DriverConfig is an interface used to determine driver config structs.
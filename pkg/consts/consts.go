@@ -124,6 +124,15 @@ const (
 	// MaxConcurrencyLimits limits the max concurrency constraints for a specific function.
 	MaxConcurrencyLimits = 2
 
+	// DefaultConcurrencyFairnessQuantum is the number of items refilled per round
+	// for a concurrency key with weight 1 when concurrency fairness is enabled.
+	DefaultConcurrencyFairnessQuantum = 10
+	// MaxConcurrencyFairnessQuantum is the largest configurable fairness quantum.
+	MaxConcurrencyFairnessQuantum = 1_000
+	// MaxConcurrencyFairnessWeight is the largest weight a concurrency key can be
+	// given.  Evaluated weights are clamped to [1, MaxConcurrencyFairnessWeight].
+	MaxConcurrencyFairnessWeight = 100
+
 	// MaxTriggers represents the maximum number of triggers a function can have.
 	MaxTriggers = 10
 
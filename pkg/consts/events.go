@@ -11,4 +11,6 @@ const (
 	FnCronName          = InternalNamePrefix + "scheduled.timer"
 	FnDeferScheduleName = InternalNamePrefix + "deferred.schedule"
 	HttpRequestName     = InternalNamePrefix + "http.request"
+	AlertFiredName      = InternalNamePrefix + "alert.fired"
+	AlertResolvedName   = InternalNamePrefix + "alert.resolved"
 )
@@ -12,6 +12,7 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/inngest/inngest/pkg/api"
 	"github.com/inngest/inngest/pkg/api/tel"
+	"github.com/inngest/inngest/pkg/audit"
 	"github.com/inngest/inngest/pkg/config"
 	connectv0 "github.com/inngest/inngest/pkg/connect/rest/v0"
 	"github.com/inngest/inngest/pkg/consts"
@@ -21,13 +22,16 @@ import (
 	"github.com/inngest/inngest/pkg/coreapi/graph/resolvers"
 	"github.com/inngest/inngest/pkg/cqrs"
 	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/pauses"
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/runner"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/inngest/inngest/pkg/headers"
 	"github.com/inngest/inngest/pkg/history_reader"
 	"github.com/inngest/inngest/pkg/logger"
 	"github.com/inngest/inngest/pkg/publicerr"
+	"github.com/inngest/inngest/pkg/run"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -44,6 +48,15 @@ type Options struct {
 	Executor       execution.Executor
 	HistoryReader  history_reader.Reader
 
+	// ScheduledInvocations schedules, lists, and cancels function invocations
+	// which run at a future time.
+	ScheduledInvocations scheduled.Scheduler
+	// Waits inspects and resolves the pauses that runs are blocked on.
+	Waits pauses.Waits
+	// RunUpdates streams run updates to GraphQL subscriptions.  Its
+	// lifecycle listener must be registered with the executor.
+	RunUpdates *run.UpdateBroker
+
 	// LocalSigningKey is the key used to sign events for self-hosted services.
 	LocalSigningKey string
 
@@ -56,6 +69,10 @@ type Options struct {
 	// DisableGraphQL controls whether GraphQL endpoints are enabled
 	DisableGraphQL *bool
 
+	// AuditLog, if set, records GraphQL mutations and mutating V0 API calls
+	// to the audit log.
+	AuditLog cqrs.AuditLogWriter
+
 	ConnectOpts connectv0.Opts
 }
 
@@ -96,17 +113,23 @@ func NewCoreApi(o Options) (*CoreAPI, error) {
 
 	if o.isGraphQLEnabled() {
 		a.resolver = &resolvers.Resolver{
-			Data:            o.Data,
-			HistoryReader:   o.HistoryReader,
-			Runner:          o.Runner,
-			QueueReader:     o.QueueReader,
-			EventHandler:    o.EventHandler,
-			Executor:        o.Executor,
-			ServerKind:      o.Config.GetServerKind(),
-			LocalSigningKey: o.LocalSigningKey,
-			RequireKeys:     o.RequireKeys,
+			Data:                 o.Data,
+			HistoryReader:        o.HistoryReader,
+			Runner:               o.Runner,
+			QueueReader:          o.QueueReader,
+			EventHandler:         o.EventHandler,
+			Executor:             o.Executor,
+			ScheduledInvocations: o.ScheduledInvocations,
+			Waits:                o.Waits,
+			RunUpdates:           o.RunUpdates,
+			ServerKind:           o.Config.GetServerKind(),
+			LocalSigningKey:      o.LocalSigningKey,
+			RequireKeys:          o.RequireKeys,
 		}
 		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: a.resolver}))
+		if o.AuditLog != nil {
+			srv.Use(audit.NewGraphQLExtension(o.AuditLog))
+		}
 
 		// TODO - Add option for enabling GraphQL Playground
 		a.Handle("/", playground.Handler("GraphQL playground", "/v0/gql"))
@@ -114,7 +137,11 @@ func NewCoreApi(o Options) (*CoreAPI, error) {
 	}
 
 	// V0 APIs
-	a.With(o.AuthMiddleware).Delete("/runs/{runID}", a.CancelRun)
+	auditor := func(next http.Handler) http.Handler { return next }
+	if o.AuditLog != nil {
+		auditor = audit.Middleware(o.AuditLog, audit.SourceAPIv0)
+	}
+	a.With(o.AuthMiddleware, auditor).Delete("/runs/{runID}", a.CancelRun)
 	// NOTE: These are present in the 2.x and 3.x SDKs to enable large payload sizes.
 	a.With(o.AuthMiddleware).Get("/runs/{runID}/batch", a.GetEventBatch)
 	a.With(o.AuthMiddleware).Get("/runs/{runID}/actions", a.GetActions)
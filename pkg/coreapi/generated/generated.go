@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -61,6 +62,7 @@ type ResolverRoot interface {
 	RunDeferredFrom() RunDeferredFromResolver
 	RunsV2Connection() RunsV2ConnectionResolver
 	StreamItem() StreamItemResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -232,6 +234,14 @@ type ComplexityRoot struct {
 		Slug           func(childComplexity int) int
 		Triggers       func(childComplexity int) int
 		URL            func(childComplexity int) int
+		VersionDiff    func(childComplexity int, from *int, to *int) int
+		Versions       func(childComplexity int) int
+	}
+
+	FunctionConfigChange struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Field  func(childComplexity int) int
 	}
 
 	FunctionConfiguration struct {
@@ -276,29 +286,30 @@ type ComplexityRoot struct {
 	}
 
 	FunctionRunV2 struct {
-		App            func(childComplexity int) int
-		AppID          func(childComplexity int) int
-		BatchCreatedAt func(childComplexity int) int
-		CronSchedule   func(childComplexity int) int
-		DeferredFrom   func(childComplexity int) int
-		Defers         func(childComplexity int) int
-		EndedAt        func(childComplexity int) int
-		EventName      func(childComplexity int) int
-		Function       func(childComplexity int) int
-		FunctionID     func(childComplexity int) int
-		HasAi          func(childComplexity int) int
-		ID             func(childComplexity int) int
-		IsBatch        func(childComplexity int) int
-		IsDeferred     func(childComplexity int) int
-		Output         func(childComplexity int) int
-		QueuedAt       func(childComplexity int) int
-		SiblingDefers  func(childComplexity int) int
-		SourceID       func(childComplexity int) int
-		StartedAt      func(childComplexity int) int
-		Status         func(childComplexity int) int
-		Trace          func(childComplexity int, preview *bool) int
-		TraceID        func(childComplexity int) int
-		TriggerIDs     func(childComplexity int) int
+		App             func(childComplexity int) int
+		AppID           func(childComplexity int) int
+		BatchCreatedAt  func(childComplexity int) int
+		CronSchedule    func(childComplexity int) int
+		DeferredFrom    func(childComplexity int) int
+		Defers          func(childComplexity int) int
+		EndedAt         func(childComplexity int) int
+		EventName       func(childComplexity int) int
+		Function        func(childComplexity int) int
+		FunctionID      func(childComplexity int) int
+		FunctionVersion func(childComplexity int) int
+		HasAi           func(childComplexity int) int
+		ID              func(childComplexity int) int
+		IsBatch         func(childComplexity int) int
+		IsDeferred      func(childComplexity int) int
+		Output          func(childComplexity int) int
+		QueuedAt        func(childComplexity int) int
+		SiblingDefers   func(childComplexity int) int
+		SourceID        func(childComplexity int) int
+		StartedAt       func(childComplexity int) int
+		Status          func(childComplexity int) int
+		Trace           func(childComplexity int, preview *bool) int
+		TraceID         func(childComplexity int) int
+		TriggerIDs      func(childComplexity int) int
 	}
 
 	FunctionRunV2Edge struct {
@@ -322,6 +333,12 @@ type ComplexityRoot struct {
 		Version    func(childComplexity int) int
 	}
 
+	FunctionVersionDiff struct {
+		Changes func(childComplexity int) int
+		From    func(childComplexity int) int
+		To      func(childComplexity int) int
+	}
+
 	InvokeStepInfo struct {
 		FunctionID        func(childComplexity int) int
 		ReturnEventID     func(childComplexity int) int
@@ -332,14 +349,18 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		CancelRun          func(childComplexity int, runID ulid.ULID) int
-		CreateApp          func(childComplexity int, input models.CreateAppInput) int
-		CreateDebugSession func(childComplexity int, input models.CreateDebugSessionInput) int
-		DeleteApp          func(childComplexity int, id string) int
-		DeleteAppByName    func(childComplexity int, name string) int
-		InvokeFunction     func(childComplexity int, data map[string]interface{}, functionSlug string, meta map[string]interface{}, user map[string]interface{}, debugSessionID *ulid.ULID, debugRunID *ulid.ULID) int
-		Rerun              func(childComplexity int, runID ulid.ULID, fromStep *models.RerunFromStepInput, debugSessionID *ulid.ULID, debugRunID *ulid.ULID) int
-		UpdateApp          func(childComplexity int, input models.UpdateAppInput) int
+		CancelRun                 func(childComplexity int, runID ulid.ULID) int
+		CancelScheduledInvocation func(childComplexity int, functionSlug string, id ulid.ULID) int
+		CreateApp                 func(childComplexity int, input models.CreateAppInput) int
+		CreateDebugSession        func(childComplexity int, input models.CreateDebugSessionInput) int
+		DeleteApp                 func(childComplexity int, id string) int
+		DeleteAppByName           func(childComplexity int, name string) int
+		InvokeFunction            func(childComplexity int, data map[string]interface{}, functionSlug string, meta map[string]interface{}, user map[string]interface{}, debugSessionID *ulid.ULID, debugRunID *ulid.ULID) int
+		Rerun                     func(childComplexity int, runID ulid.ULID, fromStep *models.RerunFromStepInput, debugSessionID *ulid.ULID, debugRunID *ulid.ULID) int
+		ResolveWait               func(childComplexity int, runID ulid.ULID, waitID uuid.UUID, data interface{}) int
+		ScheduleFunction          func(childComplexity int, data map[string]interface{}, functionSlug string, scheduledAt *time.Time, delay *string) int
+		TimeoutWait               func(childComplexity int, runID ulid.ULID, waitID uuid.UUID) int
+		UpdateApp                 func(childComplexity int, input models.UpdateAppInput) int
 	}
 
 	PageInfo struct {
@@ -360,13 +381,17 @@ type ComplexityRoot struct {
 		EventsV2               func(childComplexity int, first int, after *string, filter models.EventsFilter) int
 		FunctionBySlug         func(childComplexity int, query models.FunctionQuery) int
 		FunctionRun            func(childComplexity int, query models.FunctionRunQuery) int
+		FunctionWaits          func(childComplexity int, functionSlug string, first int) int
 		Functions              func(childComplexity int) int
 		Run                    func(childComplexity int, runID string) int
 		RunTrace               func(childComplexity int, runID string) int
 		RunTraceSpanOutputByID func(childComplexity int, outputID string) int
 		RunTrigger             func(childComplexity int, runID string) int
+		RunWaits               func(childComplexity int, runID ulid.ULID) int
 		Runs                   func(childComplexity int, first int, after *string, orderBy []*models.RunsV2OrderBy, filter models.RunsFilterV2, preview *bool) int
+		ScheduledInvocations   func(childComplexity int, functionSlug string, first int) int
 		Stream                 func(childComplexity int, query models.StreamQuery) int
+		Usage                  func(childComplexity int, period models.UsagePeriod, from *time.Time, until *time.Time) int
 		WorkerConnection       func(childComplexity int, connectionID ulid.ULID) int
 		WorkerConnections      func(childComplexity int, first int, after *string, orderBy []*models.ConnectV1WorkerConnectionsOrderBy, filter models.ConnectV1WorkerConnectionsFilter) int
 	}
@@ -461,6 +486,14 @@ type ComplexityRoot struct {
 		Timeout func(childComplexity int) int
 	}
 
+	RunStatusChange struct {
+		AppID      func(childComplexity int) int
+		FunctionID func(childComplexity int) int
+		OccurredAt func(childComplexity int) int
+		RunID      func(childComplexity int) int
+		Status     func(childComplexity int) int
+	}
+
 	RunStep struct {
 		Name   func(childComplexity int) int
 		StepID func(childComplexity int) int
@@ -468,7 +501,9 @@ type ComplexityRoot struct {
 	}
 
 	RunStepInfo struct {
-		Type func(childComplexity int) int
+		CacheHit func(childComplexity int) int
+		CacheKey func(childComplexity int) int
+		Type     func(childComplexity int) int
 	}
 
 	RunTraceSpan struct {
@@ -544,6 +579,14 @@ type ComplexityRoot struct {
 		Reason func(childComplexity int) int
 	}
 
+	ScheduledInvocation struct {
+		CreatedAt    func(childComplexity int) int
+		FunctionSlug func(childComplexity int) int
+		ID           func(childComplexity int) int
+		RunID        func(childComplexity int) int
+		ScheduledAt  func(childComplexity int) int
+	}
+
 	SingletonConfiguration struct {
 		Key  func(childComplexity int) int
 		Mode func(childComplexity int) int
@@ -593,6 +636,11 @@ type ComplexityRoot struct {
 		Type      func(childComplexity int) int
 	}
 
+	Subscription struct {
+		RunStatusChanged func(childComplexity int, functionID *uuid.UUID, appID *uuid.UUID) int
+		RunTraceSpans    func(childComplexity int, runID string) int
+	}
+
 	ThrottleConfiguration struct {
 		Burst  func(childComplexity int) int
 		Key    func(childComplexity int) int
@@ -600,6 +648,32 @@ type ComplexityRoot struct {
 		Period func(childComplexity int) int
 	}
 
+	Usage struct {
+		Buckets func(childComplexity int) int
+		From    func(childComplexity int) int
+		Period  func(childComplexity int) int
+		Totals  func(childComplexity int) int
+		Until   func(childComplexity int) int
+	}
+
+	UsageBucket struct {
+		AppID          func(childComplexity int) int
+		Bucket         func(childComplexity int) int
+		EventName      func(childComplexity int) int
+		Events         func(childComplexity int) int
+		FunctionID     func(childComplexity int) int
+		Runs           func(childComplexity int) int
+		StepDurationMs func(childComplexity int) int
+		Steps          func(childComplexity int) int
+	}
+
+	UsageTotals struct {
+		Events         func(childComplexity int) int
+		Runs           func(childComplexity int) int
+		StepDurationMs func(childComplexity int) int
+		Steps          func(childComplexity int) int
+	}
+
 	UserlandSpan struct {
 		ResourceAttrs func(childComplexity int) int
 		ScopeName     func(childComplexity int) int
@@ -610,6 +684,19 @@ type ComplexityRoot struct {
 		SpanName      func(childComplexity int) int
 	}
 
+	Wait struct {
+		CreatedAt        func(childComplexity int) int
+		Event            func(childComplexity int) int
+		Expression       func(childComplexity int) int
+		ID               func(childComplexity int) int
+		InvokeFunctionID func(childComplexity int) int
+		RunID            func(childComplexity int) int
+		Signal           func(childComplexity int) int
+		StepName         func(childComplexity int) int
+		TimeoutAt        func(childComplexity int) int
+		Type             func(childComplexity int) int
+	}
+
 	WaitForEventStepInfo struct {
 		EventName    func(childComplexity int) int
 		Expression   func(childComplexity int) int
@@ -669,6 +756,8 @@ type FunctionResolver interface {
 	FailureHandler(ctx context.Context, obj *models.Function) (*models.Function, error)
 
 	App(ctx context.Context, obj *models.Function) (*cqrs.App, error)
+	Versions(ctx context.Context, obj *models.Function) ([]*function.FunctionVersion, error)
+	VersionDiff(ctx context.Context, obj *models.Function, from *int, to *int) (*models.FunctionVersionDiff, error)
 }
 type FunctionRunResolver interface {
 	Function(ctx context.Context, obj *models.FunctionRun) (*models.Function, error)
@@ -701,7 +790,11 @@ type MutationResolver interface {
 	DeleteApp(ctx context.Context, id string) (string, error)
 	DeleteAppByName(ctx context.Context, name string) (bool, error)
 	InvokeFunction(ctx context.Context, data map[string]interface{}, functionSlug string, meta map[string]interface{}, user map[string]interface{}, debugSessionID *ulid.ULID, debugRunID *ulid.ULID) (*bool, error)
+	ScheduleFunction(ctx context.Context, data map[string]interface{}, functionSlug string, scheduledAt *time.Time, delay *string) (*models.ScheduledInvocation, error)
+	CancelScheduledInvocation(ctx context.Context, functionSlug string, id ulid.ULID) (*models.ScheduledInvocation, error)
 	CancelRun(ctx context.Context, runID ulid.ULID) (*models.FunctionRun, error)
+	ResolveWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID, data interface{}) (*models.Wait, error)
+	TimeoutWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID) (*models.Wait, error)
 	Rerun(ctx context.Context, runID ulid.ULID, fromStep *models.RerunFromStepInput, debugSessionID *ulid.ULID, debugRunID *ulid.ULID) (ulid.ULID, error)
 	CreateDebugSession(ctx context.Context, input models.CreateDebugSessionInput) (*models.CreateDebugSessionResponse, error)
 }
@@ -715,6 +808,9 @@ type QueryResolver interface {
 	EventsV2(ctx context.Context, first int, after *string, filter models.EventsFilter) (*models.EventsConnection, error)
 	FunctionBySlug(ctx context.Context, query models.FunctionQuery) (*models.Function, error)
 	Functions(ctx context.Context) ([]*models.Function, error)
+	ScheduledInvocations(ctx context.Context, functionSlug string, first int) ([]*models.ScheduledInvocation, error)
+	RunWaits(ctx context.Context, runID ulid.ULID) ([]*models.Wait, error)
+	FunctionWaits(ctx context.Context, functionSlug string, first int) ([]*models.Wait, error)
 	FunctionRun(ctx context.Context, query models.FunctionRunQuery) (*models.FunctionRun, error)
 	Runs(ctx context.Context, first int, after *string, orderBy []*models.RunsV2OrderBy, filter models.RunsFilterV2, preview *bool) (*models.RunsV2Connection, error)
 	Run(ctx context.Context, runID string) (*models.FunctionRunV2, error)
@@ -725,6 +821,7 @@ type QueryResolver interface {
 	DebugSession(ctx context.Context, query models.DebugSessionQuery) (*models.DebugSession, error)
 	WorkerConnections(ctx context.Context, first int, after *string, orderBy []*models.ConnectV1WorkerConnectionsOrderBy, filter models.ConnectV1WorkerConnectionsFilter) (*models.WorkerConnectionsConnection, error)
 	WorkerConnection(ctx context.Context, connectionID ulid.ULID) (*models.ConnectV1WorkerConnection, error)
+	Usage(ctx context.Context, period models.UsagePeriod, from *time.Time, until *time.Time) (*models.Usage, error)
 }
 type RunDeferResolver interface {
 	Function(ctx context.Context, obj *models.RunDefer) (*models.Function, error)
@@ -741,6 +838,10 @@ type RunsV2ConnectionResolver interface {
 type StreamItemResolver interface {
 	InBatch(ctx context.Context, obj *models.StreamItem) (bool, error)
 }
+type SubscriptionResolver interface {
+	RunStatusChanged(ctx context.Context, functionID *uuid.UUID, appID *uuid.UUID) (<-chan *models.RunStatusChange, error)
+	RunTraceSpans(ctx context.Context, runID string) (<-chan *models.RunTraceSpan, error)
+}
 
 type executableSchema struct {
 	resolvers  ResolverRoot
@@ -1527,6 +1628,46 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Function.URL(childComplexity), true
 
+	case "Function.versionDiff":
+		if e.complexity.Function.VersionDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Function_versionDiff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Function.VersionDiff(childComplexity, args["from"].(*int), args["to"].(*int)), true
+
+	case "Function.versions":
+		if e.complexity.Function.Versions == nil {
+			break
+		}
+
+		return e.complexity.Function.Versions(childComplexity), true
+
+	case "FunctionConfigChange.after":
+		if e.complexity.FunctionConfigChange.After == nil {
+			break
+		}
+
+		return e.complexity.FunctionConfigChange.After(childComplexity), true
+
+	case "FunctionConfigChange.before":
+		if e.complexity.FunctionConfigChange.Before == nil {
+			break
+		}
+
+		return e.complexity.FunctionConfigChange.Before(childComplexity), true
+
+	case "FunctionConfigChange.field":
+		if e.complexity.FunctionConfigChange.Field == nil {
+			break
+		}
+
+		return e.complexity.FunctionConfigChange.Field(childComplexity), true
+
 	case "FunctionConfiguration.cancellations":
 		if e.complexity.FunctionConfiguration.Cancellations == nil {
 			break
@@ -1826,6 +1967,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FunctionRunV2.FunctionID(childComplexity), true
 
+	case "FunctionRunV2.functionVersion":
+		if e.complexity.FunctionRunV2.FunctionVersion == nil {
+			break
+		}
+
+		return e.complexity.FunctionRunV2.FunctionVersion(childComplexity), true
+
 	case "FunctionRunV2.hasAI":
 		if e.complexity.FunctionRunV2.HasAi == nil {
 			break
@@ -2006,6 +2154,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FunctionVersion.Version(childComplexity), true
 
+	case "FunctionVersionDiff.changes":
+		if e.complexity.FunctionVersionDiff.Changes == nil {
+			break
+		}
+
+		return e.complexity.FunctionVersionDiff.Changes(childComplexity), true
+
+	case "FunctionVersionDiff.from":
+		if e.complexity.FunctionVersionDiff.From == nil {
+			break
+		}
+
+		return e.complexity.FunctionVersionDiff.From(childComplexity), true
+
+	case "FunctionVersionDiff.to":
+		if e.complexity.FunctionVersionDiff.To == nil {
+			break
+		}
+
+		return e.complexity.FunctionVersionDiff.To(childComplexity), true
+
 	case "InvokeStepInfo.functionID":
 		if e.complexity.InvokeStepInfo.FunctionID == nil {
 			break
@@ -2060,6 +2229,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CancelRun(childComplexity, args["runID"].(ulid.ULID)), true
 
+	case "Mutation.cancelScheduledInvocation":
+		if e.complexity.Mutation.CancelScheduledInvocation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelScheduledInvocation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelScheduledInvocation(childComplexity, args["functionSlug"].(string), args["id"].(ulid.ULID)), true
+
 	case "Mutation.createApp":
 		if e.complexity.Mutation.CreateApp == nil {
 			break
@@ -2132,6 +2313,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Rerun(childComplexity, args["runID"].(ulid.ULID), args["fromStep"].(*models.RerunFromStepInput), args["debugSessionID"].(*ulid.ULID), args["debugRunID"].(*ulid.ULID)), true
 
+	case "Mutation.resolveWait":
+		if e.complexity.Mutation.ResolveWait == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resolveWait_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResolveWait(childComplexity, args["runID"].(ulid.ULID), args["waitID"].(uuid.UUID), args["data"].(interface{})), true
+
+	case "Mutation.scheduleFunction":
+		if e.complexity.Mutation.ScheduleFunction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_scheduleFunction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ScheduleFunction(childComplexity, args["data"].(map[string]interface{}), args["functionSlug"].(string), args["scheduledAt"].(*time.Time), args["delay"].(*string)), true
+
+	case "Mutation.timeoutWait":
+		if e.complexity.Mutation.TimeoutWait == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_timeoutWait_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TimeoutWait(childComplexity, args["runID"].(ulid.ULID), args["waitID"].(uuid.UUID)), true
+
 	case "Mutation.updateApp":
 		if e.complexity.Mutation.UpdateApp == nil {
 			break
@@ -2292,6 +2509,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.FunctionRun(childComplexity, args["query"].(models.FunctionRunQuery)), true
 
+	case "Query.functionWaits":
+		if e.complexity.Query.FunctionWaits == nil {
+			break
+		}
+
+		args, err := ec.field_Query_functionWaits_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FunctionWaits(childComplexity, args["functionSlug"].(string), args["first"].(int)), true
+
 	case "Query.functions":
 		if e.complexity.Query.Functions == nil {
 			break
@@ -2347,6 +2576,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.RunTrigger(childComplexity, args["runID"].(string)), true
 
+	case "Query.runWaits":
+		if e.complexity.Query.RunWaits == nil {
+			break
+		}
+
+		args, err := ec.field_Query_runWaits_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RunWaits(childComplexity, args["runID"].(ulid.ULID)), true
+
 	case "Query.runs":
 		if e.complexity.Query.Runs == nil {
 			break
@@ -2359,6 +2600,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Runs(childComplexity, args["first"].(int), args["after"].(*string), args["orderBy"].([]*models.RunsV2OrderBy), args["filter"].(models.RunsFilterV2), args["preview"].(*bool)), true
 
+	case "Query.scheduledInvocations":
+		if e.complexity.Query.ScheduledInvocations == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scheduledInvocations_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ScheduledInvocations(childComplexity, args["functionSlug"].(string), args["first"].(int)), true
+
 	case "Query.stream":
 		if e.complexity.Query.Stream == nil {
 			break
@@ -2371,6 +2624,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Stream(childComplexity, args["query"].(models.StreamQuery)), true
 
+	case "Query.usage":
+		if e.complexity.Query.Usage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_usage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Usage(childComplexity, args["period"].(models.UsagePeriod), args["from"].(*time.Time), args["until"].(*time.Time)), true
+
 	case "Query.workerConnection":
 		if e.complexity.Query.WorkerConnection == nil {
 			break
@@ -2773,6 +3038,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RunHistoryWaitResult.Timeout(childComplexity), true
 
+	case "RunStatusChange.appID":
+		if e.complexity.RunStatusChange.AppID == nil {
+			break
+		}
+
+		return e.complexity.RunStatusChange.AppID(childComplexity), true
+
+	case "RunStatusChange.functionID":
+		if e.complexity.RunStatusChange.FunctionID == nil {
+			break
+		}
+
+		return e.complexity.RunStatusChange.FunctionID(childComplexity), true
+
+	case "RunStatusChange.occurredAt":
+		if e.complexity.RunStatusChange.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.RunStatusChange.OccurredAt(childComplexity), true
+
+	case "RunStatusChange.runID":
+		if e.complexity.RunStatusChange.RunID == nil {
+			break
+		}
+
+		return e.complexity.RunStatusChange.RunID(childComplexity), true
+
+	case "RunStatusChange.status":
+		if e.complexity.RunStatusChange.Status == nil {
+			break
+		}
+
+		return e.complexity.RunStatusChange.Status(childComplexity), true
+
 	case "RunStep.name":
 		if e.complexity.RunStep.Name == nil {
 			break
@@ -2794,6 +3094,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RunStep.StepOp(childComplexity), true
 
+	case "RunStepInfo.cacheHit":
+		if e.complexity.RunStepInfo.CacheHit == nil {
+			break
+		}
+
+		return e.complexity.RunStepInfo.CacheHit(childComplexity), true
+
+	case "RunStepInfo.cacheKey":
+		if e.complexity.RunStepInfo.CacheKey == nil {
+			break
+		}
+
+		return e.complexity.RunStepInfo.CacheKey(childComplexity), true
+
 	case "RunStepInfo.type":
 		if e.complexity.RunStepInfo.Type == nil {
 			break
@@ -3170,6 +3484,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SDKFeatureStatus.Reason(childComplexity), true
 
+	case "ScheduledInvocation.createdAt":
+		if e.complexity.ScheduledInvocation.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledInvocation.CreatedAt(childComplexity), true
+
+	case "ScheduledInvocation.functionSlug":
+		if e.complexity.ScheduledInvocation.FunctionSlug == nil {
+			break
+		}
+
+		return e.complexity.ScheduledInvocation.FunctionSlug(childComplexity), true
+
+	case "ScheduledInvocation.id":
+		if e.complexity.ScheduledInvocation.ID == nil {
+			break
+		}
+
+		return e.complexity.ScheduledInvocation.ID(childComplexity), true
+
+	case "ScheduledInvocation.runID":
+		if e.complexity.ScheduledInvocation.RunID == nil {
+			break
+		}
+
+		return e.complexity.ScheduledInvocation.RunID(childComplexity), true
+
+	case "ScheduledInvocation.scheduledAt":
+		if e.complexity.ScheduledInvocation.ScheduledAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledInvocation.ScheduledAt(childComplexity), true
+
 	case "SingletonConfiguration.key":
 		if e.complexity.SingletonConfiguration.Key == nil {
 			break
@@ -3366,6 +3715,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StreamItem.Type(childComplexity), true
 
+	case "Subscription.runStatusChanged":
+		if e.complexity.Subscription.RunStatusChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_runStatusChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.RunStatusChanged(childComplexity, args["functionID"].(*uuid.UUID), args["appID"].(*uuid.UUID)), true
+
+	case "Subscription.runTraceSpans":
+		if e.complexity.Subscription.RunTraceSpans == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_runTraceSpans_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.RunTraceSpans(childComplexity, args["runID"].(string)), true
+
 	case "ThrottleConfiguration.burst":
 		if e.complexity.ThrottleConfiguration.Burst == nil {
 			break
@@ -3394,6 +3767,125 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ThrottleConfiguration.Period(childComplexity), true
 
+	case "Usage.buckets":
+		if e.complexity.Usage.Buckets == nil {
+			break
+		}
+
+		return e.complexity.Usage.Buckets(childComplexity), true
+
+	case "Usage.from":
+		if e.complexity.Usage.From == nil {
+			break
+		}
+
+		return e.complexity.Usage.From(childComplexity), true
+
+	case "Usage.period":
+		if e.complexity.Usage.Period == nil {
+			break
+		}
+
+		return e.complexity.Usage.Period(childComplexity), true
+
+	case "Usage.totals":
+		if e.complexity.Usage.Totals == nil {
+			break
+		}
+
+		return e.complexity.Usage.Totals(childComplexity), true
+
+	case "Usage.until":
+		if e.complexity.Usage.Until == nil {
+			break
+		}
+
+		return e.complexity.Usage.Until(childComplexity), true
+
+	case "UsageBucket.appID":
+		if e.complexity.UsageBucket.AppID == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.AppID(childComplexity), true
+
+	case "UsageBucket.bucket":
+		if e.complexity.UsageBucket.Bucket == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.Bucket(childComplexity), true
+
+	case "UsageBucket.eventName":
+		if e.complexity.UsageBucket.EventName == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.EventName(childComplexity), true
+
+	case "UsageBucket.events":
+		if e.complexity.UsageBucket.Events == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.Events(childComplexity), true
+
+	case "UsageBucket.functionID":
+		if e.complexity.UsageBucket.FunctionID == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.FunctionID(childComplexity), true
+
+	case "UsageBucket.runs":
+		if e.complexity.UsageBucket.Runs == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.Runs(childComplexity), true
+
+	case "UsageBucket.stepDurationMs":
+		if e.complexity.UsageBucket.StepDurationMs == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.StepDurationMs(childComplexity), true
+
+	case "UsageBucket.steps":
+		if e.complexity.UsageBucket.Steps == nil {
+			break
+		}
+
+		return e.complexity.UsageBucket.Steps(childComplexity), true
+
+	case "UsageTotals.events":
+		if e.complexity.UsageTotals.Events == nil {
+			break
+		}
+
+		return e.complexity.UsageTotals.Events(childComplexity), true
+
+	case "UsageTotals.runs":
+		if e.complexity.UsageTotals.Runs == nil {
+			break
+		}
+
+		return e.complexity.UsageTotals.Runs(childComplexity), true
+
+	case "UsageTotals.stepDurationMs":
+		if e.complexity.UsageTotals.StepDurationMs == nil {
+			break
+		}
+
+		return e.complexity.UsageTotals.StepDurationMs(childComplexity), true
+
+	case "UsageTotals.steps":
+		if e.complexity.UsageTotals.Steps == nil {
+			break
+		}
+
+		return e.complexity.UsageTotals.Steps(childComplexity), true
+
 	case "UserlandSpan.resourceAttrs":
 		if e.complexity.UserlandSpan.ResourceAttrs == nil {
 			break
@@ -3443,6 +3935,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UserlandSpan.SpanName(childComplexity), true
 
+	case "Wait.createdAt":
+		if e.complexity.Wait.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Wait.CreatedAt(childComplexity), true
+
+	case "Wait.event":
+		if e.complexity.Wait.Event == nil {
+			break
+		}
+
+		return e.complexity.Wait.Event(childComplexity), true
+
+	case "Wait.expression":
+		if e.complexity.Wait.Expression == nil {
+			break
+		}
+
+		return e.complexity.Wait.Expression(childComplexity), true
+
+	case "Wait.id":
+		if e.complexity.Wait.ID == nil {
+			break
+		}
+
+		return e.complexity.Wait.ID(childComplexity), true
+
+	case "Wait.invokeFunctionID":
+		if e.complexity.Wait.InvokeFunctionID == nil {
+			break
+		}
+
+		return e.complexity.Wait.InvokeFunctionID(childComplexity), true
+
+	case "Wait.runID":
+		if e.complexity.Wait.RunID == nil {
+			break
+		}
+
+		return e.complexity.Wait.RunID(childComplexity), true
+
+	case "Wait.signal":
+		if e.complexity.Wait.Signal == nil {
+			break
+		}
+
+		return e.complexity.Wait.Signal(childComplexity), true
+
+	case "Wait.stepName":
+		if e.complexity.Wait.StepName == nil {
+			break
+		}
+
+		return e.complexity.Wait.StepName(childComplexity), true
+
+	case "Wait.timeoutAt":
+		if e.complexity.Wait.TimeoutAt == nil {
+			break
+		}
+
+		return e.complexity.Wait.TimeoutAt(childComplexity), true
+
+	case "Wait.type":
+		if e.complexity.Wait.Type == nil {
+			break
+		}
+
+		return e.complexity.Wait.Type(childComplexity), true
+
 	case "WaitForEventStepInfo.eventName":
 		if e.complexity.WaitForEventStepInfo.EventName == nil {
 			break
@@ -3515,6 +4077,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputActionVersionQuery,
+		ec.unmarshalInputAppTLSInput,
 		ec.unmarshalInputAppsFilterV1,
 		ec.unmarshalInputConnectV1WorkerConnectionsFilter,
 		ec.unmarshalInputConnectV1WorkerConnectionsOrderBy,
@@ -3563,6 +4126,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -3610,7 +4190,26 @@ type Mutation {
     debugRunID: ULID
   ): Boolean
 
+  # Schedule a function invocation to run at a future time, or after a delay
+  # such as "30m" or "2d".  Exactly one of scheduledAt or delay is required.
+  scheduleFunction(
+    data: Map
+    functionSlug: String!
+    scheduledAt: Time
+    delay: String
+  ): ScheduledInvocation!
+  cancelScheduledInvocation(
+    functionSlug: String!
+    id: ULID!
+  ): ScheduledInvocation!
+
   cancelRun(runID: ULID!): FunctionRun!
+
+  # Resume a pending step.waitForEvent or step.waitForSignal as if its event
+  # or signal was received with the given data.
+  resolveWait(runID: ULID!, waitID: UUID!, data: Unknown): Wait!
+  # Resume a pending wait as if it timed out.
+  timeoutWait(runID: ULID!, waitID: UUID!): Wait!
   rerun(
     runID: ULID!
     fromStep: RerunFromStepInput
@@ -3625,6 +4224,16 @@ type Mutation {
 
 input CreateAppInput {
   url: String!
+  tls: AppTLSInput
+}
+
+# TLS config used to call an app, eg. for apps served behind an internal PKI.
+# Files are read from the Dev Server's host.
+input AppTLSInput {
+  caFile: String
+  certFile: String
+  keyFile: String
+  serverName: String
 }
 
 input UpdateAppInput {
@@ -3643,6 +4252,37 @@ input CreateDebugSessionInput {
   runID: String
 }
 
+type ScheduledInvocation {
+  id: ULID!
+  # The ID of the run which starts when the invocation fires.
+  runID: ULID!
+  functionSlug: String!
+  scheduledAt: Time!
+  createdAt: Time!
+}
+
+enum WaitType {
+  EVENT
+  SIGNAL
+  INVOKE
+  UNKNOWN
+}
+
+type Wait {
+  id: UUID!
+  runID: ULID!
+  type: WaitType!
+  stepName: String!
+  # The event being waited for, for step.waitForEvent and step.invoke.
+  event: String
+  expression: String
+  # The signal being waited for, for step.waitForSignal.
+  signal: String
+  invokeFunctionID: String
+  timeoutAt: Time
+  createdAt: Time!
+}
+
 type CreateDebugSessionResponse {
   debugSessionID: ULID!
   debugRunID: ULID!
@@ -3673,6 +4313,15 @@ type CreateDebugSessionResponse {
   # Get all functions registered
   functions: [Function!]
 
+  # Get pending scheduled invocations for a function, soonest first
+  scheduledInvocations(functionSlug: String!, first: Int! = 100): [ScheduledInvocation!]!
+
+  # Get the pending waits a run is blocked on, oldest first
+  runWaits(runID: ULID!): [Wait!]!
+
+  # Get up to first pending waits of a function's running runs, newest run first
+  functionWaits(functionSlug: String!, first: Int! = 40): [Wait!]!
+
   # Get an individual function run
   functionRun(query: FunctionRunQuery!): FunctionRun
 
@@ -3699,6 +4348,10 @@ type CreateDebugSessionResponse {
     filter: ConnectV1WorkerConnectionsFilter!
   ): ConnectV1WorkerConnectionsConnection!
   workerConnection(connectionId: ULID!): ConnectV1WorkerConnection
+
+  # Get runs, steps, events and step duration totals per app, function and
+  # event name, bucketed by period
+  usage(period: UsagePeriod! = HOUR, from: Time, until: Time): Usage!
 }
 
 input ActionVersionQuery {
@@ -3970,6 +4623,20 @@ type Function {
   url: String!
   appID: String!
   app: App!
+  versions: [FunctionVersion!]! # Every synced config of the function, newest first
+  versionDiff(from: Int, to: Int): FunctionVersionDiff
+}
+
+type FunctionVersionDiff {
+  from: Int!
+  to: Int!
+  changes: [FunctionConfigChange!]!
+}
+
+type FunctionConfigChange {
+  field: String!
+  before: String # JSON-encoded value, or null if unset
+  after: String # JSON-encoded value, or null if unset
 }
 
 enum FunctionTriggerTypes {
@@ -4237,6 +4904,7 @@ input RunsFilterV2 {
   isDeferred: Boolean
 
   query: String # CEL query string
+  search: String # Full-text search over event data, step outputs and errors
 }
 
 input RunsV2OrderBy {
@@ -4284,6 +4952,7 @@ type FunctionRunV2 {
   siblingDefers: [RunDefer!]!
   deferredFrom: [RunDeferredFrom!]!
   isDeferred: Boolean!
+  functionVersion: Int # The version of the function the run executed under
 }
 
 type RunsV2Connection {
@@ -4383,6 +5052,9 @@ type WaitForSignalStepInfo {
 
 type RunStepInfo {
   type: String
+  # Whether the step's output was memoized from the cross-run step cache.
+  cacheHit: Boolean
+  cacheKey: String
 }
 
 type RunTraceSpan {
@@ -4590,6 +5262,61 @@ enum AppMethod {
 input AppsFilterV1 {
   method: AppMethod
 }
+
+enum UsagePeriod {
+  MINUTE
+  HOUR
+  DAY
+  WEEK
+  MONTH
+}
+
+# Work done within a time range, for charging usage back to the teams that own
+# each app
+type Usage {
+  period: UsagePeriod!
+  from: Time!
+  until: Time!
+  buckets: [UsageBucket!]!
+  totals: UsageTotals!
+}
+
+type UsageBucket {
+  bucket: Time!
+  appID: UUID # Null for events
+  functionID: UUID # Null for events
+  eventName: String!
+  runs: Int64!
+  steps: Int64!
+  events: Int64!
+  stepDurationMs: Int64!
+}
+
+type UsageTotals {
+  runs: Int64!
+  steps: Int64!
+  events: Int64!
+  stepDurationMs: Int64!
+}
+`, BuiltIn: false},
+	{Name: "../gql.subscriptions.graphql", Input: `type Subscription {
+  # Stream status changes of runs, optionally limited to a single function or
+  # app.  Updates are delivered as the executor processes runs.
+  runStatusChanged(functionID: UUID, appID: UUID): RunStatusChange!
+
+  # Stream the spans of a run's trace.  The run's existing spans are sent
+  # first, followed by spans as they're added or updated.  The subscription
+  # completes once the run ends.
+  runTraceSpans(runID: String!): RunTraceSpan!
+}
+
+type RunStatusChange {
+  runID: ULID!
+  appID: UUID!
+  functionID: UUID!
+  status: FunctionRunStatus!
+  occurredAt: Time!
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -4598,6 +5325,30 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Function_versionDiff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_FunctionRunV2_trace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4643,6 +5394,30 @@ func (ec *executionContext) field_Mutation_cancelRun_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_cancelScheduledInvocation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["functionSlug"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("functionSlug"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["functionSlug"] = arg0
+	var arg1 ulid.ULID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg1, err = ec.unmarshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createApp_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4805,6 +5580,105 @@ func (ec *executionContext) field_Mutation_rerun_args(ctx context.Context, rawAr
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_resolveWait_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 ulid.ULID
+	if tmp, ok := rawArgs["runID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("runID"))
+		arg0, err = ec.unmarshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["runID"] = arg0
+	var arg1 uuid.UUID
+	if tmp, ok := rawArgs["waitID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("waitID"))
+		arg1, err = ec.unmarshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["waitID"] = arg1
+	var arg2 interface{}
+	if tmp, ok := rawArgs["data"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("data"))
+		arg2, err = ec.unmarshalOUnknown2interface(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["data"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_scheduleFunction_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 map[string]interface{}
+	if tmp, ok := rawArgs["data"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("data"))
+		arg0, err = ec.unmarshalOMap2map(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["data"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["functionSlug"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("functionSlug"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["functionSlug"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["scheduledAt"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scheduledAt"))
+		arg2, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["scheduledAt"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["delay"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("delay"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["delay"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_timeoutWait_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 ulid.ULID
+	if tmp, ok := rawArgs["runID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("runID"))
+		arg0, err = ec.unmarshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["runID"] = arg0
+	var arg1 uuid.UUID
+	if tmp, ok := rawArgs["waitID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("waitID"))
+		arg1, err = ec.unmarshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["waitID"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_updateApp_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5003,6 +5877,30 @@ func (ec *executionContext) field_Query_functionRun_args(ctx context.Context, ra
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_functionWaits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["functionSlug"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("functionSlug"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["functionSlug"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_runTraceSpanOutputByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5048,6 +5946,21 @@ func (ec *executionContext) field_Query_runTrigger_args(ctx context.Context, raw
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_runWaits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 ulid.ULID
+	if tmp, ok := rawArgs["runID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("runID"))
+		arg0, err = ec.unmarshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["runID"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_run_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5114,6 +6027,30 @@ func (ec *executionContext) field_Query_runs_args(ctx context.Context, rawArgs m
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_scheduledInvocations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["functionSlug"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("functionSlug"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["functionSlug"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_stream_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5129,6 +6066,39 @@ func (ec *executionContext) field_Query_stream_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_usage_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.UsagePeriod
+	if tmp, ok := rawArgs["period"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("period"))
+		arg0, err = ec.unmarshalNUsagePeriod2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsagePeriod(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["period"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["until"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+		arg2, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["until"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_workerConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5201,6 +6171,45 @@ func (ec *executionContext) field_RunsV2Connection_totalCount_args(ctx context.C
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_runStatusChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *uuid.UUID
+	if tmp, ok := rawArgs["functionID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("functionID"))
+		arg0, err = ec.unmarshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["functionID"] = arg0
+	var arg1 *uuid.UUID
+	if tmp, ok := rawArgs["appID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("appID"))
+		arg1, err = ec.unmarshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["appID"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_runTraceSpans_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["runID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("runID"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["runID"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5684,6 +6693,10 @@ func (ec *executionContext) fieldContext_App_functions(ctx context.Context, fiel
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -9341,6 +10354,8 @@ func (ec *executionContext) fieldContext_EventV2_runs(ctx context.Context, field
 				return ec.fieldContext_FunctionRunV2_deferredFrom(ctx, field)
 			case "isDeferred":
 				return ec.fieldContext_FunctionRunV2_isDeferred(ctx, field)
+			case "functionVersion":
+				return ec.fieldContext_FunctionRunV2_functionVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type FunctionRunV2", field.Name)
 		},
@@ -10015,6 +11030,10 @@ func (ec *executionContext) fieldContext_Function_failureHandler(ctx context.Con
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -10389,6 +11408,252 @@ func (ec *executionContext) fieldContext_Function_app(ctx context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Function_versions(ctx context.Context, field graphql.CollectedField, obj *models.Function) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Function_versions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Function().Versions(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*function.FunctionVersion)
+	fc.Result = res
+	return ec.marshalNFunctionVersion2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋfunctionᚐFunctionVersionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Function_versions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Function",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "functionId":
+				return ec.fieldContext_FunctionVersion_functionId(ctx, field)
+			case "version":
+				return ec.fieldContext_FunctionVersion_version(ctx, field)
+			case "config":
+				return ec.fieldContext_FunctionVersion_config(ctx, field)
+			case "validFrom":
+				return ec.fieldContext_FunctionVersion_validFrom(ctx, field)
+			case "validTo":
+				return ec.fieldContext_FunctionVersion_validTo(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FunctionVersion_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_FunctionVersion_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FunctionVersion", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Function_versionDiff(ctx context.Context, field graphql.CollectedField, obj *models.Function) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Function_versionDiff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Function().VersionDiff(rctx, obj, fc.Args["from"].(*int), fc.Args["to"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.FunctionVersionDiff)
+	fc.Result = res
+	return ec.marshalOFunctionVersionDiff2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionVersionDiff(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Function_versionDiff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Function",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "from":
+				return ec.fieldContext_FunctionVersionDiff_from(ctx, field)
+			case "to":
+				return ec.fieldContext_FunctionVersionDiff_to(ctx, field)
+			case "changes":
+				return ec.fieldContext_FunctionVersionDiff_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FunctionVersionDiff", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Function_versionDiff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FunctionConfigChange_field(ctx context.Context, field graphql.CollectedField, obj *models.FunctionConfigChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionConfigChange_field(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Field, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionConfigChange_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionConfigChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FunctionConfigChange_before(ctx context.Context, field graphql.CollectedField, obj *models.FunctionConfigChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionConfigChange_before(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Before, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionConfigChange_before(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionConfigChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FunctionConfigChange_after(ctx context.Context, field graphql.CollectedField, obj *models.FunctionConfigChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionConfigChange_after(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.After, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionConfigChange_after(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionConfigChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _FunctionConfiguration_cancellations(ctx context.Context, field graphql.CollectedField, obj *models.FunctionConfiguration) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FunctionConfiguration_cancellations(ctx, field)
 	if err != nil {
@@ -11220,6 +12485,10 @@ func (ec *executionContext) fieldContext_FunctionRun_function(ctx context.Contex
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -12231,6 +13500,10 @@ func (ec *executionContext) fieldContext_FunctionRunV2_function(ctx context.Cont
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -13125,6 +14398,47 @@ func (ec *executionContext) fieldContext_FunctionRunV2_isDeferred(ctx context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _FunctionRunV2_functionVersion(ctx context.Context, field graphql.CollectedField, obj *models.FunctionRunV2) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionRunV2_functionVersion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FunctionVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionRunV2_functionVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionRunV2",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _FunctionRunV2Edge_node(ctx context.Context, field graphql.CollectedField, obj *models.FunctionRunV2Edge) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FunctionRunV2Edge_node(ctx, field)
 	if err != nil {
@@ -13210,6 +14524,8 @@ func (ec *executionContext) fieldContext_FunctionRunV2Edge_node(ctx context.Cont
 				return ec.fieldContext_FunctionRunV2_deferredFrom(ctx, field)
 			case "isDeferred":
 				return ec.fieldContext_FunctionRunV2_isDeferred(ctx, field)
+			case "functionVersion":
+				return ec.fieldContext_FunctionRunV2_functionVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type FunctionRunV2", field.Name)
 		},
@@ -13692,6 +15008,146 @@ func (ec *executionContext) fieldContext_FunctionVersion_updatedAt(ctx context.C
 	return fc, nil
 }
 
+func (ec *executionContext) _FunctionVersionDiff_from(ctx context.Context, field graphql.CollectedField, obj *models.FunctionVersionDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionVersionDiff_from(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionVersionDiff_from(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionVersionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FunctionVersionDiff_to(ctx context.Context, field graphql.CollectedField, obj *models.FunctionVersionDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionVersionDiff_to(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionVersionDiff_to(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionVersionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FunctionVersionDiff_changes(ctx context.Context, field graphql.CollectedField, obj *models.FunctionVersionDiff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FunctionVersionDiff_changes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Changes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.FunctionConfigChange)
+	fc.Result = res
+	return ec.marshalNFunctionConfigChange2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionConfigChangeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FunctionVersionDiff_changes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FunctionVersionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_FunctionConfigChange_field(ctx, field)
+			case "before":
+				return ec.fieldContext_FunctionConfigChange_before(ctx, field)
+			case "after":
+				return ec.fieldContext_FunctionConfigChange_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FunctionConfigChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _InvokeStepInfo_triggeringEventID(ctx context.Context, field graphql.CollectedField, obj *models.InvokeStepInfo) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_InvokeStepInfo_triggeringEventID(ctx, field)
 	if err != nil {
@@ -14287,6 +15743,140 @@ func (ec *executionContext) fieldContext_Mutation_invokeFunction(ctx context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_scheduleFunction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_scheduleFunction(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ScheduleFunction(rctx, fc.Args["data"].(map[string]interface{}), fc.Args["functionSlug"].(string), fc.Args["scheduledAt"].(*time.Time), fc.Args["delay"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.ScheduledInvocation)
+	fc.Result = res
+	return ec.marshalNScheduledInvocation2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_scheduleFunction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ScheduledInvocation_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_ScheduledInvocation_runID(ctx, field)
+			case "functionSlug":
+				return ec.fieldContext_ScheduledInvocation_functionSlug(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_ScheduledInvocation_scheduledAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ScheduledInvocation_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScheduledInvocation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_scheduleFunction_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cancelScheduledInvocation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_cancelScheduledInvocation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CancelScheduledInvocation(rctx, fc.Args["functionSlug"].(string), fc.Args["id"].(ulid.ULID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.ScheduledInvocation)
+	fc.Result = res
+	return ec.marshalNScheduledInvocation2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cancelScheduledInvocation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ScheduledInvocation_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_ScheduledInvocation_runID(ctx, field)
+			case "functionSlug":
+				return ec.fieldContext_ScheduledInvocation_functionSlug(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_ScheduledInvocation_scheduledAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ScheduledInvocation_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScheduledInvocation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelScheduledInvocation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_cancelRun(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_cancelRun(ctx, field)
 	if err != nil {
@@ -14380,6 +15970,166 @@ func (ec *executionContext) fieldContext_Mutation_cancelRun(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_resolveWait(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_resolveWait(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ResolveWait(rctx, fc.Args["runID"].(ulid.ULID), fc.Args["waitID"].(uuid.UUID),
+			func() interface{} {
+				if fc.Args["data"] == nil {
+					return nil
+				}
+				return fc.Args["data"].(interface{})
+			}())
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Wait)
+	fc.Result = res
+	return ec.marshalNWait2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWait(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_resolveWait(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Wait_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_Wait_runID(ctx, field)
+			case "type":
+				return ec.fieldContext_Wait_type(ctx, field)
+			case "stepName":
+				return ec.fieldContext_Wait_stepName(ctx, field)
+			case "event":
+				return ec.fieldContext_Wait_event(ctx, field)
+			case "expression":
+				return ec.fieldContext_Wait_expression(ctx, field)
+			case "signal":
+				return ec.fieldContext_Wait_signal(ctx, field)
+			case "invokeFunctionID":
+				return ec.fieldContext_Wait_invokeFunctionID(ctx, field)
+			case "timeoutAt":
+				return ec.fieldContext_Wait_timeoutAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Wait_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Wait", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resolveWait_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_timeoutWait(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_timeoutWait(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().TimeoutWait(rctx, fc.Args["runID"].(ulid.ULID), fc.Args["waitID"].(uuid.UUID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Wait)
+	fc.Result = res
+	return ec.marshalNWait2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWait(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_timeoutWait(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Wait_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_Wait_runID(ctx, field)
+			case "type":
+				return ec.fieldContext_Wait_type(ctx, field)
+			case "stepName":
+				return ec.fieldContext_Wait_stepName(ctx, field)
+			case "event":
+				return ec.fieldContext_Wait_event(ctx, field)
+			case "expression":
+				return ec.fieldContext_Wait_expression(ctx, field)
+			case "signal":
+				return ec.fieldContext_Wait_signal(ctx, field)
+			case "invokeFunctionID":
+				return ec.fieldContext_Wait_invokeFunctionID(ctx, field)
+			case "timeoutAt":
+				return ec.fieldContext_Wait_timeoutAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Wait_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Wait", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_timeoutWait_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_rerun(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_rerun(ctx, field)
 	if err != nil {
@@ -15264,6 +17014,10 @@ func (ec *executionContext) fieldContext_Query_functionBySlug(ctx context.Contex
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -15340,6 +17094,10 @@ func (ec *executionContext) fieldContext_Query_functions(ctx context.Context, fi
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -15347,6 +17105,227 @@ func (ec *executionContext) fieldContext_Query_functions(ctx context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_scheduledInvocations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_scheduledInvocations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ScheduledInvocations(rctx, fc.Args["functionSlug"].(string), fc.Args["first"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.ScheduledInvocation)
+	fc.Result = res
+	return ec.marshalNScheduledInvocation2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_scheduledInvocations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ScheduledInvocation_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_ScheduledInvocation_runID(ctx, field)
+			case "functionSlug":
+				return ec.fieldContext_ScheduledInvocation_functionSlug(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_ScheduledInvocation_scheduledAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ScheduledInvocation_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScheduledInvocation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_scheduledInvocations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_runWaits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_runWaits(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RunWaits(rctx, fc.Args["runID"].(ulid.ULID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Wait)
+	fc.Result = res
+	return ec.marshalNWait2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWaitᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_runWaits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Wait_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_Wait_runID(ctx, field)
+			case "type":
+				return ec.fieldContext_Wait_type(ctx, field)
+			case "stepName":
+				return ec.fieldContext_Wait_stepName(ctx, field)
+			case "event":
+				return ec.fieldContext_Wait_event(ctx, field)
+			case "expression":
+				return ec.fieldContext_Wait_expression(ctx, field)
+			case "signal":
+				return ec.fieldContext_Wait_signal(ctx, field)
+			case "invokeFunctionID":
+				return ec.fieldContext_Wait_invokeFunctionID(ctx, field)
+			case "timeoutAt":
+				return ec.fieldContext_Wait_timeoutAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Wait_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Wait", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_runWaits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_functionWaits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_functionWaits(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FunctionWaits(rctx, fc.Args["functionSlug"].(string), fc.Args["first"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Wait)
+	fc.Result = res
+	return ec.marshalNWait2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWaitᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_functionWaits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Wait_id(ctx, field)
+			case "runID":
+				return ec.fieldContext_Wait_runID(ctx, field)
+			case "type":
+				return ec.fieldContext_Wait_type(ctx, field)
+			case "stepName":
+				return ec.fieldContext_Wait_stepName(ctx, field)
+			case "event":
+				return ec.fieldContext_Wait_event(ctx, field)
+			case "expression":
+				return ec.fieldContext_Wait_expression(ctx, field)
+			case "signal":
+				return ec.fieldContext_Wait_signal(ctx, field)
+			case "invokeFunctionID":
+				return ec.fieldContext_Wait_invokeFunctionID(ctx, field)
+			case "timeoutAt":
+				return ec.fieldContext_Wait_timeoutAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Wait_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Wait", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_functionWaits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_functionRun(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_functionRun(ctx, field)
 	if err != nil {
@@ -15582,6 +17561,8 @@ func (ec *executionContext) fieldContext_Query_run(ctx context.Context, field gr
 				return ec.fieldContext_FunctionRunV2_deferredFrom(ctx, field)
 			case "isDeferred":
 				return ec.fieldContext_FunctionRunV2_isDeferred(ctx, field)
+			case "functionVersion":
+				return ec.fieldContext_FunctionRunV2_functionVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type FunctionRunV2", field.Name)
 		},
@@ -16134,6 +18115,73 @@ func (ec *executionContext) fieldContext_Query_workerConnection(ctx context.Cont
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_usage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_usage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Usage(rctx, fc.Args["period"].(models.UsagePeriod), fc.Args["from"].(*time.Time), fc.Args["until"].(*time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Usage)
+	fc.Result = res
+	return ec.marshalNUsage2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_usage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "period":
+				return ec.fieldContext_Usage_period(ctx, field)
+			case "from":
+				return ec.fieldContext_Usage_from(ctx, field)
+			case "until":
+				return ec.fieldContext_Usage_until(ctx, field)
+			case "buckets":
+				return ec.fieldContext_Usage_buckets(ctx, field)
+			case "totals":
+				return ec.fieldContext_Usage_totals(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Usage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_usage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
@@ -16711,6 +18759,10 @@ func (ec *executionContext) fieldContext_RunDefer_function(ctx context.Context,
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -16841,6 +18893,8 @@ func (ec *executionContext) fieldContext_RunDefer_run(ctx context.Context, field
 				return ec.fieldContext_FunctionRunV2_deferredFrom(ctx, field)
 			case "isDeferred":
 				return ec.fieldContext_FunctionRunV2_isDeferred(ctx, field)
+			case "functionVersion":
+				return ec.fieldContext_FunctionRunV2_functionVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type FunctionRunV2", field.Name)
 		},
@@ -16953,6 +19007,10 @@ func (ec *executionContext) fieldContext_RunDeferredFrom_function(ctx context.Co
 				return ec.fieldContext_Function_appID(ctx, field)
 			case "app":
 				return ec.fieldContext_Function_app(ctx, field)
+			case "versions":
+				return ec.fieldContext_Function_versions(ctx, field)
+			case "versionDiff":
+				return ec.fieldContext_Function_versionDiff(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Function", field.Name)
 		},
@@ -17042,6 +19100,8 @@ func (ec *executionContext) fieldContext_RunDeferredFrom_run(ctx context.Context
 				return ec.fieldContext_FunctionRunV2_deferredFrom(ctx, field)
 			case "isDeferred":
 				return ec.fieldContext_FunctionRunV2_isDeferred(ctx, field)
+			case "functionVersion":
+				return ec.fieldContext_FunctionRunV2_functionVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type FunctionRunV2", field.Name)
 		},
@@ -18762,52 +20822,8 @@ func (ec *executionContext) fieldContext_RunHistoryWaitResult_timeout(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _RunStep_stepID(ctx context.Context, field graphql.CollectedField, obj *models.RunStep) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RunStep_stepID(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StepID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_RunStep_stepID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RunStep",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _RunStep_name(ctx context.Context, field graphql.CollectedField, obj *models.RunStep) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RunStep_name(ctx, field)
+func (ec *executionContext) _RunStatusChange_runID(ctx context.Context, field graphql.CollectedField, obj *models.RunStatusChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStatusChange_runID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18820,7 +20836,7 @@ func (ec *executionContext) _RunStep_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.RunID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -18832,108 +20848,26 @@ func (ec *executionContext) _RunStep_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_RunStep_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RunStep",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _RunStep_stepOp(ctx context.Context, field graphql.CollectedField, obj *models.RunStep) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RunStep_stepOp(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StepOp, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*models.StepOp)
-	fc.Result = res
-	return ec.marshalOStepOp2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐStepOp(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_RunStep_stepOp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RunStep",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type StepOp does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _RunStepInfo_type(ctx context.Context, field graphql.CollectedField, obj *models.RunStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RunStepInfo_type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
+	res := resTmp.(ulid.ULID)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RunStepInfo_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RunStatusChange_runID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RunStepInfo",
+		Object:     "RunStatusChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ULID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RunTraceSpan_appID(ctx context.Context, field graphql.CollectedField, obj *models.RunTraceSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RunTraceSpan_appID(ctx, field)
+func (ec *executionContext) _RunStatusChange_appID(ctx context.Context, field graphql.CollectedField, obj *models.RunStatusChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStatusChange_appID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18963,9 +20897,9 @@ func (ec *executionContext) _RunTraceSpan_appID(ctx context.Context, field graph
 	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RunTraceSpan_appID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RunStatusChange_appID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RunTraceSpan",
+		Object:     "RunStatusChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -18976,8 +20910,436 @@ func (ec *executionContext) fieldContext_RunTraceSpan_appID(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _RunTraceSpan_functionID(ctx context.Context, field graphql.CollectedField, obj *models.RunTraceSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RunTraceSpan_functionID(ctx, field)
+func (ec *executionContext) _RunStatusChange_functionID(ctx context.Context, field graphql.CollectedField, obj *models.RunStatusChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStatusChange_functionID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FunctionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uuid.UUID)
+	fc.Result = res
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStatusChange_functionID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStatusChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStatusChange_status(ctx context.Context, field graphql.CollectedField, obj *models.RunStatusChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStatusChange_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.FunctionRunStatus)
+	fc.Result = res
+	return ec.marshalNFunctionRunStatus2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionRunStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStatusChange_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStatusChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FunctionRunStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStatusChange_occurredAt(ctx context.Context, field graphql.CollectedField, obj *models.RunStatusChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStatusChange_occurredAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OccurredAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStatusChange_occurredAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStatusChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStep_stepID(ctx context.Context, field graphql.CollectedField, obj *models.RunStep) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStep_stepID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StepID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStep_stepID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStep",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStep_name(ctx context.Context, field graphql.CollectedField, obj *models.RunStep) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStep_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStep_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStep",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStep_stepOp(ctx context.Context, field graphql.CollectedField, obj *models.RunStep) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStep_stepOp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StepOp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.StepOp)
+	fc.Result = res
+	return ec.marshalOStepOp2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐStepOp(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStep_stepOp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStep",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type StepOp does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStepInfo_type(ctx context.Context, field graphql.CollectedField, obj *models.RunStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStepInfo_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStepInfo_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStepInfo_cacheHit(ctx context.Context, field graphql.CollectedField, obj *models.RunStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStepInfo_cacheHit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CacheHit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStepInfo_cacheHit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunStepInfo_cacheKey(ctx context.Context, field graphql.CollectedField, obj *models.RunStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunStepInfo_cacheKey(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CacheKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunStepInfo_cacheKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunTraceSpan_appID(ctx context.Context, field graphql.CollectedField, obj *models.RunTraceSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunTraceSpan_appID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AppID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uuid.UUID)
+	fc.Result = res
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RunTraceSpan_appID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RunTraceSpan",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RunTraceSpan_functionID(ctx context.Context, field graphql.CollectedField, obj *models.RunTraceSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RunTraceSpan_functionID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -21394,6 +23756,226 @@ func (ec *executionContext) fieldContext_SDKFeatureStatus_reason(ctx context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _ScheduledInvocation_id(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledInvocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduledInvocation_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ulid.ULID)
+	fc.Result = res
+	return ec.marshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduledInvocation_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledInvocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ULID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledInvocation_runID(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledInvocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduledInvocation_runID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RunID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ulid.ULID)
+	fc.Result = res
+	return ec.marshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduledInvocation_runID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledInvocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ULID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledInvocation_functionSlug(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledInvocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduledInvocation_functionSlug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FunctionSlug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduledInvocation_functionSlug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledInvocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledInvocation_scheduledAt(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledInvocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduledInvocation_scheduledAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ScheduledAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduledInvocation_scheduledAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledInvocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledInvocation_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledInvocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduledInvocation_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduledInvocation_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledInvocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SingletonConfiguration_mode(ctx context.Context, field graphql.CollectedField, obj *models.SingletonConfiguration) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_SingletonConfiguration_mode(ctx, field)
 	if err != nil {
@@ -22669,6 +25251,224 @@ func (ec *executionContext) fieldContext_StreamItem_inBatch(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_runStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_runStatusChanged(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().RunStatusChanged(rctx, fc.Args["functionID"].(*uuid.UUID), fc.Args["appID"].(*uuid.UUID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *models.RunStatusChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNRunStatusChange2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐRunStatusChange(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_runStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "runID":
+				return ec.fieldContext_RunStatusChange_runID(ctx, field)
+			case "appID":
+				return ec.fieldContext_RunStatusChange_appID(ctx, field)
+			case "functionID":
+				return ec.fieldContext_RunStatusChange_functionID(ctx, field)
+			case "status":
+				return ec.fieldContext_RunStatusChange_status(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_RunStatusChange_occurredAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RunStatusChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_runStatusChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_runTraceSpans(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_runTraceSpans(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().RunTraceSpans(rctx, fc.Args["runID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *models.RunTraceSpan):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNRunTraceSpan2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐRunTraceSpan(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_runTraceSpans(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "appID":
+				return ec.fieldContext_RunTraceSpan_appID(ctx, field)
+			case "functionID":
+				return ec.fieldContext_RunTraceSpan_functionID(ctx, field)
+			case "runID":
+				return ec.fieldContext_RunTraceSpan_runID(ctx, field)
+			case "run":
+				return ec.fieldContext_RunTraceSpan_run(ctx, field)
+			case "spanID":
+				return ec.fieldContext_RunTraceSpan_spanID(ctx, field)
+			case "traceID":
+				return ec.fieldContext_RunTraceSpan_traceID(ctx, field)
+			case "groupID":
+				return ec.fieldContext_RunTraceSpan_groupID(ctx, field)
+			case "name":
+				return ec.fieldContext_RunTraceSpan_name(ctx, field)
+			case "status":
+				return ec.fieldContext_RunTraceSpan_status(ctx, field)
+			case "attempts":
+				return ec.fieldContext_RunTraceSpan_attempts(ctx, field)
+			case "duration":
+				return ec.fieldContext_RunTraceSpan_duration(ctx, field)
+			case "outputID":
+				return ec.fieldContext_RunTraceSpan_outputID(ctx, field)
+			case "queuedAt":
+				return ec.fieldContext_RunTraceSpan_queuedAt(ctx, field)
+			case "scheduledAt":
+				return ec.fieldContext_RunTraceSpan_scheduledAt(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_RunTraceSpan_startedAt(ctx, field)
+			case "endedAt":
+				return ec.fieldContext_RunTraceSpan_endedAt(ctx, field)
+			case "childrenSpans":
+				return ec.fieldContext_RunTraceSpan_childrenSpans(ctx, field)
+			case "stepOp":
+				return ec.fieldContext_RunTraceSpan_stepOp(ctx, field)
+			case "stepID":
+				return ec.fieldContext_RunTraceSpan_stepID(ctx, field)
+			case "stepInfo":
+				return ec.fieldContext_RunTraceSpan_stepInfo(ctx, field)
+			case "stepType":
+				return ec.fieldContext_RunTraceSpan_stepType(ctx, field)
+			case "isRoot":
+				return ec.fieldContext_RunTraceSpan_isRoot(ctx, field)
+			case "parentSpanID":
+				return ec.fieldContext_RunTraceSpan_parentSpanID(ctx, field)
+			case "parentSpan":
+				return ec.fieldContext_RunTraceSpan_parentSpan(ctx, field)
+			case "isUserland":
+				return ec.fieldContext_RunTraceSpan_isUserland(ctx, field)
+			case "userlandSpan":
+				return ec.fieldContext_RunTraceSpan_userlandSpan(ctx, field)
+			case "debugRunID":
+				return ec.fieldContext_RunTraceSpan_debugRunID(ctx, field)
+			case "debugSessionID":
+				return ec.fieldContext_RunTraceSpan_debugSessionID(ctx, field)
+			case "debugPaused":
+				return ec.fieldContext_RunTraceSpan_debugPaused(ctx, field)
+			case "skipReason":
+				return ec.fieldContext_RunTraceSpan_skipReason(ctx, field)
+			case "skipExistingRunID":
+				return ec.fieldContext_RunTraceSpan_skipExistingRunID(ctx, field)
+			case "metadata":
+				return ec.fieldContext_RunTraceSpan_metadata(ctx, field)
+			case "response":
+				return ec.fieldContext_RunTraceSpan_response(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RunTraceSpan", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_runTraceSpans_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ThrottleConfiguration_burst(ctx context.Context, field graphql.CollectedField, obj *models.ThrottleConfiguration) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_ThrottleConfiguration_burst(ctx, field)
 	if err != nil {
@@ -22842,8 +25642,8 @@ func (ec *executionContext) fieldContext_ThrottleConfiguration_period(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_spanName(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_spanName(ctx, field)
+func (ec *executionContext) _Usage_period(ctx context.Context, field graphql.CollectedField, obj *models.Usage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Usage_period(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22856,35 +25656,38 @@ func (ec *executionContext) _UserlandSpan_spanName(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpanName, nil
+		return obj.Period, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(models.UsagePeriod)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUsagePeriod2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsagePeriod(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_spanName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Usage_period(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "Usage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UsagePeriod does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_spanKind(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_spanKind(ctx, field)
+func (ec *executionContext) _Usage_from(ctx context.Context, field graphql.CollectedField, obj *models.Usage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Usage_from(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22897,35 +25700,38 @@ func (ec *executionContext) _UserlandSpan_spanKind(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpanKind, nil
+		return obj.From, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_spanKind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Usage_from(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "Usage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_serviceName(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_serviceName(ctx, field)
+func (ec *executionContext) _Usage_until(ctx context.Context, field graphql.CollectedField, obj *models.Usage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Usage_until(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22938,35 +25744,38 @@ func (ec *executionContext) _UserlandSpan_serviceName(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServiceName, nil
+		return obj.Until, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_serviceName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Usage_until(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "Usage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_resourceAttrs(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_resourceAttrs(ctx, field)
+func (ec *executionContext) _Usage_buckets(ctx context.Context, field graphql.CollectedField, obj *models.Usage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Usage_buckets(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -22979,35 +25788,56 @@ func (ec *executionContext) _UserlandSpan_resourceAttrs(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResourceAttrs, nil
+		return obj.Buckets, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*models.UsageBucket)
 	fc.Result = res
-	return ec.marshalOBytes2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUsageBucket2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsageBucketᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_resourceAttrs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Usage_buckets(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "Usage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Bytes does not have child fields")
+			switch field.Name {
+			case "bucket":
+				return ec.fieldContext_UsageBucket_bucket(ctx, field)
+			case "appID":
+				return ec.fieldContext_UsageBucket_appID(ctx, field)
+			case "functionID":
+				return ec.fieldContext_UsageBucket_functionID(ctx, field)
+			case "eventName":
+				return ec.fieldContext_UsageBucket_eventName(ctx, field)
+			case "runs":
+				return ec.fieldContext_UsageBucket_runs(ctx, field)
+			case "steps":
+				return ec.fieldContext_UsageBucket_steps(ctx, field)
+			case "events":
+				return ec.fieldContext_UsageBucket_events(ctx, field)
+			case "stepDurationMs":
+				return ec.fieldContext_UsageBucket_stepDurationMs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UsageBucket", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_scopeName(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_scopeName(ctx, field)
+func (ec *executionContext) _Usage_totals(ctx context.Context, field graphql.CollectedField, obj *models.Usage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Usage_totals(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23020,35 +25850,48 @@ func (ec *executionContext) _UserlandSpan_scopeName(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ScopeName, nil
+		return obj.Totals, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*models.UsageTotals)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUsageTotals2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsageTotals(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_scopeName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Usage_totals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "Usage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "runs":
+				return ec.fieldContext_UsageTotals_runs(ctx, field)
+			case "steps":
+				return ec.fieldContext_UsageTotals_steps(ctx, field)
+			case "events":
+				return ec.fieldContext_UsageTotals_events(ctx, field)
+			case "stepDurationMs":
+				return ec.fieldContext_UsageTotals_stepDurationMs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UsageTotals", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_scopeVersion(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_scopeVersion(ctx, field)
+func (ec *executionContext) _UsageBucket_bucket(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_bucket(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23061,35 +25904,38 @@ func (ec *executionContext) _UserlandSpan_scopeVersion(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ScopeVersion, nil
+		return obj.Bucket, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_scopeVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_bucket(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserlandSpan_spanAttrs(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserlandSpan_spanAttrs(ctx, field)
+func (ec *executionContext) _UsageBucket_appID(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_appID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23102,7 +25948,7 @@ func (ec *executionContext) _UserlandSpan_spanAttrs(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpanAttrs, nil
+		return obj.AppID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23111,26 +25957,26 @@ func (ec *executionContext) _UserlandSpan_spanAttrs(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*uuid.UUID)
 	fc.Result = res
-	return ec.marshalOBytes2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserlandSpan_spanAttrs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_appID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserlandSpan",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Bytes does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForEventStepInfo_eventName(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForEventStepInfo_eventName(ctx, field)
+func (ec *executionContext) _UsageBucket_functionID(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_functionID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23143,38 +25989,35 @@ func (ec *executionContext) _WaitForEventStepInfo_eventName(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventName, nil
+		return obj.FunctionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*uuid.UUID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForEventStepInfo_eventName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_functionID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForEventStepInfo",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForEventStepInfo_expression(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForEventStepInfo_expression(ctx, field)
+func (ec *executionContext) _UsageBucket_eventName(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_eventName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23187,23 +26030,26 @@ func (ec *executionContext) _WaitForEventStepInfo_expression(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Expression, nil
+		return obj.EventName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForEventStepInfo_expression(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_eventName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForEventStepInfo",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23214,8 +26060,8 @@ func (ec *executionContext) fieldContext_WaitForEventStepInfo_expression(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForEventStepInfo_timeout(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForEventStepInfo_timeout(ctx, field)
+func (ec *executionContext) _UsageBucket_runs(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_runs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23228,7 +26074,7 @@ func (ec *executionContext) _WaitForEventStepInfo_timeout(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timeout, nil
+		return obj.Runs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23240,26 +26086,26 @@ func (ec *executionContext) _WaitForEventStepInfo_timeout(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForEventStepInfo_timeout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_runs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForEventStepInfo",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForEventStepInfo_foundEventID(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForEventStepInfo_foundEventID(ctx, field)
+func (ec *executionContext) _UsageBucket_steps(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_steps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23272,35 +26118,38 @@ func (ec *executionContext) _WaitForEventStepInfo_foundEventID(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FoundEventID, nil
+		return obj.Steps, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*ulid.ULID)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalOULID2ᚖgithubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForEventStepInfo_foundEventID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_steps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForEventStepInfo",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ULID does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForEventStepInfo_timedOut(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForEventStepInfo_timedOut(ctx, field)
+func (ec *executionContext) _UsageBucket_events(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_events(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23313,35 +26162,38 @@ func (ec *executionContext) _WaitForEventStepInfo_timedOut(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TimedOut, nil
+		return obj.Events, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForEventStepInfo_timedOut(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForEventStepInfo",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForSignalStepInfo_signal(ctx context.Context, field graphql.CollectedField, obj *models.WaitForSignalStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForSignalStepInfo_signal(ctx, field)
+func (ec *executionContext) _UsageBucket_stepDurationMs(ctx context.Context, field graphql.CollectedField, obj *models.UsageBucket) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageBucket_stepDurationMs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23354,7 +26206,7 @@ func (ec *executionContext) _WaitForSignalStepInfo_signal(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Signal, nil
+		return obj.StepDurationMs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23366,26 +26218,26 @@ func (ec *executionContext) _WaitForSignalStepInfo_signal(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForSignalStepInfo_signal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageBucket_stepDurationMs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForSignalStepInfo",
+		Object:     "UsageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForSignalStepInfo_timeout(ctx context.Context, field graphql.CollectedField, obj *models.WaitForSignalStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForSignalStepInfo_timeout(ctx, field)
+func (ec *executionContext) _UsageTotals_runs(ctx context.Context, field graphql.CollectedField, obj *models.UsageTotals) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageTotals_runs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23398,7 +26250,7 @@ func (ec *executionContext) _WaitForSignalStepInfo_timeout(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timeout, nil
+		return obj.Runs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23410,26 +26262,26 @@ func (ec *executionContext) _WaitForSignalStepInfo_timeout(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForSignalStepInfo_timeout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageTotals_runs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForSignalStepInfo",
+		Object:     "UsageTotals",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WaitForSignalStepInfo_timedOut(ctx context.Context, field graphql.CollectedField, obj *models.WaitForSignalStepInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_WaitForSignalStepInfo_timedOut(ctx, field)
+func (ec *executionContext) _UsageTotals_steps(ctx context.Context, field graphql.CollectedField, obj *models.UsageTotals) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageTotals_steps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23442,35 +26294,38 @@ func (ec *executionContext) _WaitForSignalStepInfo_timedOut(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TimedOut, nil
+		return obj.Steps, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_WaitForSignalStepInfo_timedOut(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageTotals_steps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WaitForSignalStepInfo",
+		Object:     "UsageTotals",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Workspace_id(ctx context.Context, field graphql.CollectedField, obj *models.Workspace) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Workspace_id(ctx, field)
+func (ec *executionContext) _UsageTotals_events(ctx context.Context, field graphql.CollectedField, obj *models.UsageTotals) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageTotals_events(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23483,7 +26338,7 @@ func (ec *executionContext) _Workspace_id(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Events, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23495,26 +26350,26 @@ func (ec *executionContext) _Workspace_id(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Workspace_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageTotals_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Workspace",
+		Object:     "UsageTotals",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _UsageTotals_stepDurationMs(ctx context.Context, field graphql.CollectedField, obj *models.UsageTotals) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UsageTotals_stepDurationMs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23527,7 +26382,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.StepDurationMs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23539,26 +26394,26 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UsageTotals_stepDurationMs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "UsageTotals",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _UserlandSpan_spanName(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_spanName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23571,7 +26426,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.SpanName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23585,11 +26440,11 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_spanName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "UserlandSpan",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -23598,8 +26453,8 @@ func (ec *executionContext) fieldContext___Directive_description(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _UserlandSpan_spanKind(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_spanKind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23612,38 +26467,35 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.SpanKind, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_spanKind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "UserlandSpan",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _UserlandSpan_serviceName(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_serviceName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23656,48 +26508,35 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.ServiceName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_serviceName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "UserlandSpan",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _UserlandSpan_resourceAttrs(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_resourceAttrs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23710,38 +26549,35 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.ResourceAttrs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOBytes2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_resourceAttrs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "UserlandSpan",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Bytes does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _UserlandSpan_scopeName(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_scopeName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23754,26 +26590,23 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ScopeName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_scopeName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "UserlandSpan",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -23784,8 +26617,8 @@ func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _UserlandSpan_scopeVersion(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_scopeVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23798,7 +26631,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.ScopeVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23812,11 +26645,11 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_scopeVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "UserlandSpan",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -23825,52 +26658,8 @@ func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _UserlandSpan_spanAttrs(ctx context.Context, field graphql.CollectedField, obj *models.UserlandSpan) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserlandSpan_spanAttrs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23883,7 +26672,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.SpanAttrs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23894,24 +26683,24 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOBytes2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UserlandSpan_spanAttrs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "UserlandSpan",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Bytes does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _Wait_id(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -23924,7 +26713,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -23936,67 +26725,26 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uuid.UUID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Wait_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Wait",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _Wait_runID(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_runID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -24009,7 +26757,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.RunID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -24021,36 +26769,1283 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(ulid.ULID)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNULID2githubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Wait_runID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Wait",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type ULID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Wait_type(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.WaitType)
+	fc.Result = res
+	return ec.marshalNWaitType2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWaitType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WaitType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_stepName(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_stepName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StepName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_stepName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_event(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_event(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Event, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_event(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_expression(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_expression(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Expression, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_expression(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_signal(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_signal(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signal, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_signal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_invokeFunctionID(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_invokeFunctionID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InvokeFunctionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_invokeFunctionID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_timeoutAt(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_timeoutAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeoutAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_timeoutAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Wait_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.Wait) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Wait_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Wait_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Wait",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForEventStepInfo_eventName(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForEventStepInfo_eventName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EventName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForEventStepInfo_eventName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForEventStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForEventStepInfo_expression(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForEventStepInfo_expression(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Expression, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForEventStepInfo_expression(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForEventStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForEventStepInfo_timeout(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForEventStepInfo_timeout(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timeout, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForEventStepInfo_timeout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForEventStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForEventStepInfo_foundEventID(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForEventStepInfo_foundEventID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FoundEventID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*ulid.ULID)
+	fc.Result = res
+	return ec.marshalOULID2ᚖgithubᚗcomᚋoklogᚋulidᚋv2ᚐULID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForEventStepInfo_foundEventID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForEventStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ULID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForEventStepInfo_timedOut(ctx context.Context, field graphql.CollectedField, obj *models.WaitForEventStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForEventStepInfo_timedOut(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimedOut, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForEventStepInfo_timedOut(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForEventStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForSignalStepInfo_signal(ctx context.Context, field graphql.CollectedField, obj *models.WaitForSignalStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForSignalStepInfo_signal(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signal, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForSignalStepInfo_signal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForSignalStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForSignalStepInfo_timeout(ctx context.Context, field graphql.CollectedField, obj *models.WaitForSignalStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForSignalStepInfo_timeout(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timeout, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForSignalStepInfo_timeout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForSignalStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitForSignalStepInfo_timedOut(ctx context.Context, field graphql.CollectedField, obj *models.WaitForSignalStepInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_WaitForSignalStepInfo_timedOut(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimedOut, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_WaitForSignalStepInfo_timedOut(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitForSignalStepInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Workspace_id(ctx context.Context, field graphql.CollectedField, obj *models.Workspace) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Workspace_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Workspace_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Workspace",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -25330,6 +29325,58 @@ func (ec *executionContext) unmarshalInputActionVersionQuery(ctx context.Context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputAppTLSInput(ctx context.Context, obj interface{}) (models.AppTLSInput, error) {
+	var it models.AppTLSInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"caFile", "certFile", "keyFile", "serverName"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "caFile":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("caFile"))
+			it.CaFile, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "certFile":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("certFile"))
+			it.CertFile, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "keyFile":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keyFile"))
+			it.KeyFile, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "serverName":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serverName"))
+			it.ServerName, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputAppsFilterV1(ctx context.Context, obj interface{}) (models.AppsFilterV1, error) {
 	var it models.AppsFilterV1
 	asMap := map[string]interface{}{}
@@ -25465,7 +29512,7 @@ func (ec *executionContext) unmarshalInputCreateAppInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"url"}
+	fieldsInOrder := [...]string{"url", "tls"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -25480,6 +29527,14 @@ func (ec *executionContext) unmarshalInputCreateAppInput(ctx context.Context, ob
 			if err != nil {
 				return it, err
 			}
+		case "tls":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tls"))
+			it.TLS, err = ec.unmarshalOAppTLSInput2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐAppTLSInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -25949,7 +30004,7 @@ func (ec *executionContext) unmarshalInputRunsFilterV2(ctx context.Context, obj
 		asMap["timeField"] = "QUEUED_AT"
 	}
 
-	fieldsInOrder := [...]string{"from", "until", "timeField", "status", "functionIDs", "appIDs", "isDeferred", "query"}
+	fieldsInOrder := [...]string{"from", "until", "timeField", "status", "functionIDs", "appIDs", "isDeferred", "query", "search"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -26020,6 +30075,14 @@ func (ec *executionContext) unmarshalInputRunsFilterV2(ctx context.Context, obj
 			if err != nil {
 				return it, err
 			}
+		case "search":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
+			it.Search, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -27541,6 +31604,79 @@ func (ec *executionContext) _Function(ctx context.Context, sel ast.SelectionSet,
 				return innerFunc(ctx)
 
 			})
+		case "versions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Function_versions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "versionDiff":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Function_versionDiff(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var functionConfigChangeImplementors = []string{"FunctionConfigChange"}
+
+func (ec *executionContext) _FunctionConfigChange(ctx context.Context, sel ast.SelectionSet, obj *models.FunctionConfigChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, functionConfigChangeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FunctionConfigChange")
+		case "field":
+
+			out.Values[i] = ec._FunctionConfigChange_field(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "before":
+
+			out.Values[i] = ec._FunctionConfigChange_before(ctx, field, obj)
+
+		case "after":
+
+			out.Values[i] = ec._FunctionConfigChange_after(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -28096,6 +32232,10 @@ func (ec *executionContext) _FunctionRunV2(ctx context.Context, sel ast.Selectio
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "functionVersion":
+
+			out.Values[i] = ec._FunctionRunV2_functionVersion(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -28245,6 +32385,48 @@ func (ec *executionContext) _FunctionVersion(ctx context.Context, sel ast.Select
 	return out
 }
 
+var functionVersionDiffImplementors = []string{"FunctionVersionDiff"}
+
+func (ec *executionContext) _FunctionVersionDiff(ctx context.Context, sel ast.SelectionSet, obj *models.FunctionVersionDiff) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, functionVersionDiffImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FunctionVersionDiff")
+		case "from":
+
+			out.Values[i] = ec._FunctionVersionDiff_from(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "to":
+
+			out.Values[i] = ec._FunctionVersionDiff_to(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "changes":
+
+			out.Values[i] = ec._FunctionVersionDiff_changes(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var invokeStepInfoImplementors = []string{"InvokeStepInfo", "StepInfo"}
 
 func (ec *executionContext) _InvokeStepInfo(ctx context.Context, sel ast.SelectionSet, obj *models.InvokeStepInfo) graphql.Marshaler {
@@ -28360,12 +32542,48 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 				return ec._Mutation_invokeFunction(ctx, field)
 			})
 
+		case "scheduleFunction":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_scheduleFunction(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cancelScheduledInvocation":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelScheduledInvocation(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "cancelRun":
 
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_cancelRun(ctx, field)
 			})
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "resolveWait":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resolveWait(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timeoutWait":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_timeoutWait(ctx, field)
+			})
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -28649,6 +32867,75 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "scheduledInvocations":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_scheduledInvocations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "runWaits":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_runWaits(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "functionWaits":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_functionWaits(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -28864,6 +33151,29 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "usage":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_usage(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -29491,6 +33801,62 @@ func (ec *executionContext) _RunHistoryWaitResult(ctx context.Context, sel ast.S
 	return out
 }
 
+var runStatusChangeImplementors = []string{"RunStatusChange"}
+
+func (ec *executionContext) _RunStatusChange(ctx context.Context, sel ast.SelectionSet, obj *models.RunStatusChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, runStatusChangeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RunStatusChange")
+		case "runID":
+
+			out.Values[i] = ec._RunStatusChange_runID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "appID":
+
+			out.Values[i] = ec._RunStatusChange_appID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "functionID":
+
+			out.Values[i] = ec._RunStatusChange_functionID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+
+			out.Values[i] = ec._RunStatusChange_status(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "occurredAt":
+
+			out.Values[i] = ec._RunStatusChange_occurredAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var runStepImplementors = []string{"RunStep"}
 
 func (ec *executionContext) _RunStep(ctx context.Context, sel ast.SelectionSet, obj *models.RunStep) graphql.Marshaler {
@@ -29544,6 +33910,14 @@ func (ec *executionContext) _RunStepInfo(ctx context.Context, sel ast.SelectionS
 
 			out.Values[i] = ec._RunStepInfo_type(ctx, field, obj)
 
+		case "cacheHit":
+
+			out.Values[i] = ec._RunStepInfo_cacheHit(ctx, field, obj)
+
+		case "cacheKey":
+
+			out.Values[i] = ec._RunStepInfo_cacheKey(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -29998,6 +34372,62 @@ func (ec *executionContext) _SDKFeatureStatus(ctx context.Context, sel ast.Selec
 	return out
 }
 
+var scheduledInvocationImplementors = []string{"ScheduledInvocation"}
+
+func (ec *executionContext) _ScheduledInvocation(ctx context.Context, sel ast.SelectionSet, obj *models.ScheduledInvocation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scheduledInvocationImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ScheduledInvocation")
+		case "id":
+
+			out.Values[i] = ec._ScheduledInvocation_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "runID":
+
+			out.Values[i] = ec._ScheduledInvocation_runID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "functionSlug":
+
+			out.Values[i] = ec._ScheduledInvocation_functionSlug(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "scheduledAt":
+
+			out.Values[i] = ec._ScheduledInvocation_scheduledAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+
+			out.Values[i] = ec._ScheduledInvocation_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var singletonConfigurationImplementors = []string{"SingletonConfiguration"}
 
 func (ec *executionContext) _SingletonConfiguration(ctx context.Context, sel ast.SelectionSet, obj *models.SingletonConfiguration) graphql.Marshaler {
@@ -30309,6 +34739,28 @@ func (ec *executionContext) _StreamItem(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "runStatusChanged":
+		return ec._Subscription_runStatusChanged(ctx, fields[0])
+	case "runTraceSpans":
+		return ec._Subscription_runTraceSpans(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var throttleConfigurationImplementors = []string{"ThrottleConfiguration"}
 
 func (ec *executionContext) _ThrottleConfiguration(ctx context.Context, sel ast.SelectionSet, obj *models.ThrottleConfiguration) graphql.Marshaler {
@@ -30355,6 +34807,182 @@ func (ec *executionContext) _ThrottleConfiguration(ctx context.Context, sel ast.
 	return out
 }
 
+var usageImplementors = []string{"Usage"}
+
+func (ec *executionContext) _Usage(ctx context.Context, sel ast.SelectionSet, obj *models.Usage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, usageImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Usage")
+		case "period":
+
+			out.Values[i] = ec._Usage_period(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "from":
+
+			out.Values[i] = ec._Usage_from(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "until":
+
+			out.Values[i] = ec._Usage_until(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "buckets":
+
+			out.Values[i] = ec._Usage_buckets(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "totals":
+
+			out.Values[i] = ec._Usage_totals(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var usageBucketImplementors = []string{"UsageBucket"}
+
+func (ec *executionContext) _UsageBucket(ctx context.Context, sel ast.SelectionSet, obj *models.UsageBucket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, usageBucketImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UsageBucket")
+		case "bucket":
+
+			out.Values[i] = ec._UsageBucket_bucket(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "appID":
+
+			out.Values[i] = ec._UsageBucket_appID(ctx, field, obj)
+
+		case "functionID":
+
+			out.Values[i] = ec._UsageBucket_functionID(ctx, field, obj)
+
+		case "eventName":
+
+			out.Values[i] = ec._UsageBucket_eventName(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "runs":
+
+			out.Values[i] = ec._UsageBucket_runs(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "steps":
+
+			out.Values[i] = ec._UsageBucket_steps(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "events":
+
+			out.Values[i] = ec._UsageBucket_events(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "stepDurationMs":
+
+			out.Values[i] = ec._UsageBucket_stepDurationMs(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var usageTotalsImplementors = []string{"UsageTotals"}
+
+func (ec *executionContext) _UsageTotals(ctx context.Context, sel ast.SelectionSet, obj *models.UsageTotals) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, usageTotalsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UsageTotals")
+		case "runs":
+
+			out.Values[i] = ec._UsageTotals_runs(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "steps":
+
+			out.Values[i] = ec._UsageTotals_steps(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "events":
+
+			out.Values[i] = ec._UsageTotals_events(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "stepDurationMs":
+
+			out.Values[i] = ec._UsageTotals_stepDurationMs(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var userlandSpanImplementors = []string{"UserlandSpan"}
 
 func (ec *executionContext) _UserlandSpan(ctx context.Context, sel ast.SelectionSet, obj *models.UserlandSpan) graphql.Marshaler {
@@ -30404,6 +35032,82 @@ func (ec *executionContext) _UserlandSpan(ctx context.Context, sel ast.Selection
 	return out
 }
 
+var waitImplementors = []string{"Wait"}
+
+func (ec *executionContext) _Wait(ctx context.Context, sel ast.SelectionSet, obj *models.Wait) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, waitImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Wait")
+		case "id":
+
+			out.Values[i] = ec._Wait_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "runID":
+
+			out.Values[i] = ec._Wait_runID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+
+			out.Values[i] = ec._Wait_type(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "stepName":
+
+			out.Values[i] = ec._Wait_stepName(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "event":
+
+			out.Values[i] = ec._Wait_event(ctx, field, obj)
+
+		case "expression":
+
+			out.Values[i] = ec._Wait_expression(ctx, field, obj)
+
+		case "signal":
+
+			out.Values[i] = ec._Wait_signal(ctx, field, obj)
+
+		case "invokeFunctionID":
+
+			out.Values[i] = ec._Wait_invokeFunctionID(ctx, field, obj)
+
+		case "timeoutAt":
+
+			out.Values[i] = ec._Wait_timeoutAt(ctx, field, obj)
+
+		case "createdAt":
+
+			out.Values[i] = ec._Wait_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var waitForEventStepInfoImplementors = []string{"WaitForEventStepInfo", "StepInfo"}
 
 func (ec *executionContext) _WaitForEventStepInfo(ctx context.Context, sel ast.SelectionSet, obj *models.WaitForEventStepInfo) graphql.Marshaler {
@@ -31452,7 +36156,61 @@ func (ec *executionContext) marshalNFunction2ᚕᚖgithubᚗcomᚋinngestᚋinng
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFunction2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunction(ctx, sel, v[i])
+			ret[i] = ec.marshalNFunction2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNFunction2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunction(ctx context.Context, sel ast.SelectionSet, v *models.Function) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Function(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFunctionConfigChange2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionConfigChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.FunctionConfigChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFunctionConfigChange2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionConfigChange(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -31472,14 +36230,14 @@ func (ec *executionContext) marshalNFunction2ᚕᚖgithubᚗcomᚋinngestᚋinng
 	return ret
 }
 
-func (ec *executionContext) marshalNFunction2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunction(ctx context.Context, sel ast.SelectionSet, v *models.Function) graphql.Marshaler {
+func (ec *executionContext) marshalNFunctionConfigChange2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionConfigChange(ctx context.Context, sel ast.SelectionSet, v *models.FunctionConfigChange) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Function(ctx, sel, v)
+	return ec._FunctionConfigChange(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNFunctionConfiguration2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionConfiguration(ctx context.Context, sel ast.SelectionSet, v *models.FunctionConfiguration) graphql.Marshaler {
@@ -31654,6 +36412,60 @@ func (ec *executionContext) marshalNFunctionTriggerTypes2githubᚗcomᚋinngest
 	return v
 }
 
+func (ec *executionContext) marshalNFunctionVersion2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋfunctionᚐFunctionVersionᚄ(ctx context.Context, sel ast.SelectionSet, v []*function.FunctionVersion) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFunctionVersion2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋfunctionᚐFunctionVersion(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNFunctionVersion2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋfunctionᚐFunctionVersion(ctx context.Context, sel ast.SelectionSet, v *function.FunctionVersion) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FunctionVersion(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNHTTPHeaders2githubᚗcomᚋinngestᚋinngestᚋpkgᚋheadersᚐCompact(ctx context.Context, v interface{}) (headers.Compact, error) {
 	var res headers.Compact
 	err := res.UnmarshalGQLContext(ctx, v)
@@ -31932,6 +36744,20 @@ func (ec *executionContext) marshalNRunHistoryItem2ᚖgithubᚗcomᚋinngestᚋi
 	return ec._RunHistoryItem(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRunStatusChange2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐRunStatusChange(ctx context.Context, sel ast.SelectionSet, v models.RunStatusChange) graphql.Marshaler {
+	return ec._RunStatusChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRunStatusChange2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐRunStatusChange(ctx context.Context, sel ast.SelectionSet, v *models.RunStatusChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RunStatusChange(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRunTraceSpan2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐRunTraceSpan(ctx context.Context, sel ast.SelectionSet, v models.RunTraceSpan) graphql.Marshaler {
 	return ec._RunTraceSpan(ctx, sel, &v)
 }
@@ -32103,6 +36929,64 @@ func (ec *executionContext) marshalNSDKFeatureReadiness2ᚖgithubᚗcomᚋinnges
 	return ec._SDKFeatureReadiness(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNScheduledInvocation2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocation(ctx context.Context, sel ast.SelectionSet, v models.ScheduledInvocation) graphql.Marshaler {
+	return ec._ScheduledInvocation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNScheduledInvocation2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocationᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ScheduledInvocation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNScheduledInvocation2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNScheduledInvocation2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐScheduledInvocation(ctx context.Context, sel ast.SelectionSet, v *models.ScheduledInvocation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ScheduledInvocation(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNSingletonMode2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐSingletonMode(ctx context.Context, v interface{}) (models.SingletonMode, error) {
 	var res models.SingletonMode
 	err := res.UnmarshalGQL(v)
@@ -32390,6 +37274,158 @@ func (ec *executionContext) unmarshalNUpdateAppInput2githubᚗcomᚋinngestᚋin
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNUsage2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsage(ctx context.Context, sel ast.SelectionSet, v *models.Usage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Usage(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNUsageBucket2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsageBucketᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.UsageBucket) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNUsageBucket2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsageBucket(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNUsageBucket2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsageBucket(ctx context.Context, sel ast.SelectionSet, v *models.UsageBucket) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._UsageBucket(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNUsagePeriod2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsagePeriod(ctx context.Context, v interface{}) (models.UsagePeriod, error) {
+	var res models.UsagePeriod
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUsagePeriod2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsagePeriod(ctx context.Context, sel ast.SelectionSet, v models.UsagePeriod) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNUsageTotals2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐUsageTotals(ctx context.Context, sel ast.SelectionSet, v *models.UsageTotals) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._UsageTotals(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNWait2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWait(ctx context.Context, sel ast.SelectionSet, v models.Wait) graphql.Marshaler {
+	return ec._Wait(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWait2ᚕᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWaitᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Wait) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWait2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWait(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNWait2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWait(ctx context.Context, sel ast.SelectionSet, v *models.Wait) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Wait(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNWaitType2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWaitType(ctx context.Context, v interface{}) (models.WaitType, error) {
+	var res models.WaitType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWaitType2githubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐWaitType(ctx context.Context, sel ast.SelectionSet, v models.WaitType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -32666,6 +37702,14 @@ func (ec *executionContext) marshalOAppMethod2ᚖgithubᚗcomᚋinngestᚋinnges
 	return v
 }
 
+func (ec *executionContext) unmarshalOAppTLSInput2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐAppTLSInput(ctx context.Context, v interface{}) (*models.AppTLSInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputAppTLSInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOAppsFilterV12ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐAppsFilterV1(ctx context.Context, v interface{}) (*models.AppsFilterV1, error) {
 	if v == nil {
 		return nil, nil
@@ -33260,6 +38304,13 @@ func (ec *executionContext) marshalOFunctionTrigger2ᚕᚖgithubᚗcomᚋinngest
 	return ret
 }
 
+func (ec *executionContext) marshalOFunctionVersionDiff2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋcoreapiᚋgraphᚋmodelsᚐFunctionVersionDiff(ctx context.Context, sel ast.SelectionSet, v *models.FunctionVersionDiff) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FunctionVersionDiff(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOHistoryStepType2ᚖgithubᚗcomᚋinngestᚋinngestᚋpkgᚋenumsᚐHistoryStepType(ctx context.Context, v interface{}) (*enums.HistoryStepType, error) {
 	if v == nil {
 		return nil, nil
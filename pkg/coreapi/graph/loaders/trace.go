@@ -281,7 +281,9 @@ func (tr *traceReader) convertRunSpanToGQL(ctx context.Context, span *cqrs.OtelS
 		case models.StepOpRun:
 			{
 				gqlSpan.StepInfo = &models.RunStepInfo{
-					Type: span.Attributes.StepRunType,
+					Type:     span.Attributes.StepRunType,
+					CacheHit: span.Attributes.StepCacheHit,
+					CacheKey: span.Attributes.StepCacheKey,
 				}
 			}
 		case models.StepOpInvoke:
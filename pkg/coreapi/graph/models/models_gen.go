@@ -31,6 +31,13 @@ type ActionVersionQuery struct {
 	VersionMinor *int   `json:"versionMinor,omitempty"`
 }
 
+type AppTLSInput struct {
+	CaFile     *string `json:"caFile,omitempty"`
+	CertFile   *string `json:"certFile,omitempty"`
+	KeyFile    *string `json:"keyFile,omitempty"`
+	ServerName *string `json:"serverName,omitempty"`
+}
+
 type AppsFilterV1 struct {
 	Method *AppMethod `json:"method,omitempty"`
 }
@@ -98,7 +105,8 @@ type ConnectV1WorkerConnectionsOrderBy struct {
 }
 
 type CreateAppInput struct {
-	URL string `json:"url"`
+	URL string       `json:"url"`
+	TLS *AppTLSInput `json:"tls,omitempty"`
 }
 
 type CreateDebugSessionInput struct {
@@ -234,6 +242,12 @@ type Function struct {
 	App            *cqrs.App              `json:"app"`
 }
 
+type FunctionConfigChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
 type FunctionConfiguration struct {
 	Cancellations []*CancellationConfiguration `json:"cancellations"`
 	Retries       *RetryConfiguration          `json:"retries"`
@@ -288,29 +302,30 @@ type FunctionRunQuery struct {
 }
 
 type FunctionRunV2 struct {
-	ID             ulid.ULID          `json:"id"`
-	AppID          uuid.UUID          `json:"appID"`
-	App            *cqrs.App          `json:"app"`
-	FunctionID     uuid.UUID          `json:"functionID"`
-	Function       *Function          `json:"function"`
-	TraceID        string             `json:"traceID"`
-	QueuedAt       time.Time          `json:"queuedAt"`
-	StartedAt      *time.Time         `json:"startedAt,omitempty"`
-	EndedAt        *time.Time         `json:"endedAt,omitempty"`
-	Status         FunctionRunStatus  `json:"status"`
-	SourceID       *string            `json:"sourceID,omitempty"`
-	TriggerIDs     []ulid.ULID        `json:"triggerIDs"`
-	EventName      *string            `json:"eventName,omitempty"`
-	IsBatch        bool               `json:"isBatch"`
-	BatchCreatedAt *time.Time         `json:"batchCreatedAt,omitempty"`
-	CronSchedule   *string            `json:"cronSchedule,omitempty"`
-	Output         *string            `json:"output,omitempty"`
-	Trace          *RunTraceSpan      `json:"trace,omitempty"`
-	HasAi          bool               `json:"hasAI"`
-	Defers         []*RunDefer        `json:"defers"`
-	SiblingDefers  []*RunDefer        `json:"siblingDefers"`
-	DeferredFrom   []*RunDeferredFrom `json:"deferredFrom"`
-	IsDeferred     bool               `json:"isDeferred"`
+	ID              ulid.ULID          `json:"id"`
+	AppID           uuid.UUID          `json:"appID"`
+	App             *cqrs.App          `json:"app"`
+	FunctionID      uuid.UUID          `json:"functionID"`
+	Function        *Function          `json:"function"`
+	TraceID         string             `json:"traceID"`
+	QueuedAt        time.Time          `json:"queuedAt"`
+	StartedAt       *time.Time         `json:"startedAt,omitempty"`
+	EndedAt         *time.Time         `json:"endedAt,omitempty"`
+	Status          FunctionRunStatus  `json:"status"`
+	SourceID        *string            `json:"sourceID,omitempty"`
+	TriggerIDs      []ulid.ULID        `json:"triggerIDs"`
+	EventName       *string            `json:"eventName,omitempty"`
+	IsBatch         bool               `json:"isBatch"`
+	BatchCreatedAt  *time.Time         `json:"batchCreatedAt,omitempty"`
+	CronSchedule    *string            `json:"cronSchedule,omitempty"`
+	Output          *string            `json:"output,omitempty"`
+	Trace           *RunTraceSpan      `json:"trace,omitempty"`
+	HasAi           bool               `json:"hasAI"`
+	Defers          []*RunDefer        `json:"defers"`
+	SiblingDefers   []*RunDefer        `json:"siblingDefers"`
+	DeferredFrom    []*RunDeferredFrom `json:"deferredFrom"`
+	IsDeferred      bool               `json:"isDeferred"`
+	FunctionVersion *int               `json:"functionVersion,omitempty"`
 }
 
 type FunctionRunV2Edge struct {
@@ -328,6 +343,12 @@ type FunctionTrigger struct {
 	Condition *string              `json:"condition,omitempty"`
 }
 
+type FunctionVersionDiff struct {
+	From    int                     `json:"from"`
+	To      int                     `json:"to"`
+	Changes []*FunctionConfigChange `json:"changes"`
+}
+
 type InvokeStepInfo struct {
 	TriggeringEventID ulid.ULID  `json:"triggeringEventID"`
 	FunctionID        string     `json:"functionID"`
@@ -367,6 +388,14 @@ type RetryConfiguration struct {
 	IsDefault *bool `json:"isDefault,omitempty"`
 }
 
+type RunStatusChange struct {
+	RunID      ulid.ULID         `json:"runID"`
+	AppID      uuid.UUID         `json:"appID"`
+	FunctionID uuid.UUID         `json:"functionID"`
+	Status     FunctionRunStatus `json:"status"`
+	OccurredAt time.Time         `json:"occurredAt"`
+}
+
 type RunStep struct {
 	StepID string  `json:"stepID"`
 	Name   string  `json:"name"`
@@ -374,7 +403,9 @@ type RunStep struct {
 }
 
 type RunStepInfo struct {
-	Type *string `json:"type,omitempty"`
+	Type     *string `json:"type,omitempty"`
+	CacheHit *bool   `json:"cacheHit,omitempty"`
+	CacheKey *string `json:"cacheKey,omitempty"`
 }
 
 func (RunStepInfo) IsStepInfo() {}
@@ -409,6 +440,7 @@ type RunsFilterV2 struct {
 	AppIDs      []uuid.UUID         `json:"appIDs,omitempty"`
 	IsDeferred  *bool               `json:"isDeferred,omitempty"`
 	Query       *string             `json:"query,omitempty"`
+	Search      *string             `json:"search,omitempty"`
 }
 
 type RunsV2OrderBy struct {
@@ -426,6 +458,14 @@ type SDKFeatureStatus struct {
 	Reason *int `json:"reason,omitempty"`
 }
 
+type ScheduledInvocation struct {
+	ID           ulid.ULID `json:"id"`
+	RunID        ulid.ULID `json:"runID"`
+	FunctionSlug string    `json:"functionSlug"`
+	ScheduledAt  time.Time `json:"scheduledAt"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
 type SingletonConfiguration struct {
 	Mode SingletonMode `json:"mode"`
 	Key  *string       `json:"key,omitempty"`
@@ -498,6 +538,32 @@ type UpdateAppInput struct {
 	URL string `json:"url"`
 }
 
+type Usage struct {
+	Period  UsagePeriod    `json:"period"`
+	From    time.Time      `json:"from"`
+	Until   time.Time      `json:"until"`
+	Buckets []*UsageBucket `json:"buckets"`
+	Totals  *UsageTotals   `json:"totals"`
+}
+
+type UsageBucket struct {
+	Bucket         time.Time  `json:"bucket"`
+	AppID          *uuid.UUID `json:"appID,omitempty"`
+	FunctionID     *uuid.UUID `json:"functionID,omitempty"`
+	EventName      string     `json:"eventName"`
+	Runs           int64      `json:"runs"`
+	Steps          int64      `json:"steps"`
+	Events         int64      `json:"events"`
+	StepDurationMs int64      `json:"stepDurationMs"`
+}
+
+type UsageTotals struct {
+	Runs           int64 `json:"runs"`
+	Steps          int64 `json:"steps"`
+	Events         int64 `json:"events"`
+	StepDurationMs int64 `json:"stepDurationMs"`
+}
+
 type UserlandSpan struct {
 	SpanName      *string `json:"spanName,omitempty"`
 	SpanKind      *string `json:"spanKind,omitempty"`
@@ -508,6 +574,19 @@ type UserlandSpan struct {
 	SpanAttrs     *string `json:"spanAttrs,omitempty"`
 }
 
+type Wait struct {
+	ID               uuid.UUID  `json:"id"`
+	RunID            ulid.ULID  `json:"runID"`
+	Type             WaitType   `json:"type"`
+	StepName         string     `json:"stepName"`
+	Event            *string    `json:"event,omitempty"`
+	Expression       *string    `json:"expression,omitempty"`
+	Signal           *string    `json:"signal,omitempty"`
+	InvokeFunctionID *string    `json:"invokeFunctionID,omitempty"`
+	TimeoutAt        *time.Time `json:"timeoutAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
 type WaitForEventStepInfo struct {
 	EventName    string     `json:"eventName"`
 	Expression   *string    `json:"expression,omitempty"`
@@ -1333,3 +1412,95 @@ func (e *StreamType) UnmarshalGQL(v interface{}) error {
 func (e StreamType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type UsagePeriod string
+
+const (
+	UsagePeriodMinute UsagePeriod = "MINUTE"
+	UsagePeriodHour   UsagePeriod = "HOUR"
+	UsagePeriodDay    UsagePeriod = "DAY"
+	UsagePeriodWeek   UsagePeriod = "WEEK"
+	UsagePeriodMonth  UsagePeriod = "MONTH"
+)
+
+var AllUsagePeriod = []UsagePeriod{
+	UsagePeriodMinute,
+	UsagePeriodHour,
+	UsagePeriodDay,
+	UsagePeriodWeek,
+	UsagePeriodMonth,
+}
+
+func (e UsagePeriod) IsValid() bool {
+	switch e {
+	case UsagePeriodMinute, UsagePeriodHour, UsagePeriodDay, UsagePeriodWeek, UsagePeriodMonth:
+		return true
+	}
+	return false
+}
+
+func (e UsagePeriod) String() string {
+	return string(e)
+}
+
+func (e *UsagePeriod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UsagePeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UsagePeriod", str)
+	}
+	return nil
+}
+
+func (e UsagePeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WaitType string
+
+const (
+	WaitTypeEvent   WaitType = "EVENT"
+	WaitTypeSignal  WaitType = "SIGNAL"
+	WaitTypeInvoke  WaitType = "INVOKE"
+	WaitTypeUnknown WaitType = "UNKNOWN"
+)
+
+var AllWaitType = []WaitType{
+	WaitTypeEvent,
+	WaitTypeSignal,
+	WaitTypeInvoke,
+	WaitTypeUnknown,
+}
+
+func (e WaitType) IsValid() bool {
+	switch e {
+	case WaitTypeEvent, WaitTypeSignal, WaitTypeInvoke, WaitTypeUnknown:
+		return true
+	}
+	return false
+}
+
+func (e WaitType) String() string {
+	return string(e)
+}
+
+func (e *WaitType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WaitType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WaitType", str)
+	}
+	return nil
+}
+
+func (e WaitType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
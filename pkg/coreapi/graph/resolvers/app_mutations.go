@@ -16,6 +16,7 @@ import (
 	"github.com/inngest/inngest/pkg/cqrs"
 	"github.com/inngest/inngest/pkg/deploy"
 	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/exechttp"
 	"github.com/inngest/inngest/pkg/inngest"
 	"github.com/inngest/inngest/pkg/run"
 	"github.com/inngest/inngest/pkg/util"
@@ -34,6 +35,19 @@ func (r *mutationResolver) CreateApp(ctx context.Context, input models.CreateApp
 	// unified before deriving the placeholder app ID.
 	input.URL = util.NormalizeAppURL(input.URL, false)
 
+	var tlsConfig *exechttp.TLSConfig
+	if input.TLS != nil {
+		tlsConfig = &exechttp.TLSConfig{
+			CAFile:     util.FromPtr(input.TLS.CaFile),
+			CertFile:   util.FromPtr(input.TLS.CertFile),
+			KeyFile:    util.FromPtr(input.TLS.KeyFile),
+			ServerName: util.FromPtr(input.TLS.ServerName),
+		}
+		if err := tlsConfig.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	// This ID will not match the app ID after the sync process succeeds. That's
 	// because the eventual app ID will use the app name, rather than the URL.
 	// But we still need to create a placeholder app with a deterministic ID in
@@ -47,6 +61,7 @@ func (r *mutationResolver) CreateApp(ctx context.Context, input models.CreateApp
 	params := cqrs.UpsertAppParams{
 		ID:  appID,
 		Url: input.URL,
+		TLS: tlsConfig,
 		Error: sql.NullString{
 			Valid:  true,
 			String: deploy.DeployErrUnreachable.Error(),
@@ -54,7 +69,7 @@ func (r *mutationResolver) CreateApp(ctx context.Context, input models.CreateApp
 	}
 	app, _ := r.Data.UpsertApp(ctx, params)
 
-	if res := deploy.Ping(ctx, input.URL, r.ServerKind, r.LocalSigningKey, r.RequireKeys); res.Err != nil {
+	if res := deploy.Ping(ctx, input.URL, r.ServerKind, r.LocalSigningKey, r.RequireKeys, tlsConfig); res.Err != nil {
 		return app, res.Err
 	}
 
@@ -67,20 +67,21 @@ func (er eventV2Resolver) Runs(ctx context.Context, obj *models.EventV2) ([]*mod
 		}
 
 		functionRuns = append(functionRuns, &models.FunctionRunV2{
-			ID:             runID,
-			AppID:          r.AppID,
-			FunctionID:     r.FunctionID,
-			TraceID:        r.TraceID,
-			QueuedAt:       r.QueuedAt,
-			StartedAt:      started,
-			EndedAt:        ended,
-			SourceID:       sourceID,
-			Status:         status,
-			Output:         output,
-			IsBatch:        r.IsBatch,
-			BatchCreatedAt: batchTime,
-			CronSchedule:   r.CronSchedule,
-			HasAi:          r.HasAI,
+			ID:              runID,
+			AppID:           r.AppID,
+			FunctionID:      r.FunctionID,
+			TraceID:         r.TraceID,
+			QueuedAt:        r.QueuedAt,
+			StartedAt:       started,
+			EndedAt:         ended,
+			SourceID:        sourceID,
+			Status:          status,
+			Output:          output,
+			IsBatch:         r.IsBatch,
+			BatchCreatedAt:  batchTime,
+			CronSchedule:    r.CronSchedule,
+			HasAi:           r.HasAI,
+			FunctionVersion: functionVersion(r.FunctionVersion),
 		})
 	}
 	return functionRuns, nil
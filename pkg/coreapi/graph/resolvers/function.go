@@ -4,10 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/inngest/inngest/pkg/consts"
 	"github.com/inngest/inngest/pkg/coreapi/graph/models"
 	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/function"
 	"github.com/inngest/inngest/pkg/inngest"
 )
 
@@ -38,3 +42,87 @@ func (r *functionResolver) FailureHandler(ctx context.Context, obj *models.Funct
 
 	return models.MakeFunction(failureFn)
 }
+
+func (r *functionResolver) Versions(ctx context.Context, obj *models.Function) ([]*function.FunctionVersion, error) {
+	fnID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := r.Data.GetFunctionVersions(ctx, fnID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each version is valid until the next version was synced.
+	var validTo *time.Time
+	result := make([]*function.FunctionVersion, 0, len(versions))
+	for _, v := range versions {
+		validFrom := v.CreatedAt
+		result = append(result, &function.FunctionVersion{
+			FunctionID: v.FunctionID.String(),
+			Version:    uint(v.Version),
+			Config:     string(v.Config),
+			ValidFrom:  &validFrom,
+			ValidTo:    validTo,
+			CreatedAt:  v.CreatedAt,
+			UpdatedAt:  v.CreatedAt,
+		})
+		validTo = &validFrom
+	}
+	return result, nil
+}
+
+func (r *functionResolver) VersionDiff(ctx context.Context, obj *models.Function, from *int, to *int) (*models.FunctionVersionDiff, error) {
+	fnID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := r.Data.GetFunctionVersions(ctx, fnID)
+	if err != nil {
+		return nil, err
+	}
+
+	a, b := cqrs.SelectFunctionVersions(versions, from, to)
+	if a == nil || b == nil {
+		return nil, fmt.Errorf("function version not found")
+	}
+
+	fa, err := a.InngestFunction()
+	if err != nil {
+		return nil, err
+	}
+	fb, err := b.InngestFunction()
+	if err != nil {
+		return nil, err
+	}
+	changes, err := inngest.DiffFunctions(*fa, *fb)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &models.FunctionVersionDiff{
+		From:    a.Version,
+		To:      b.Version,
+		Changes: make([]*models.FunctionConfigChange, 0, len(changes)),
+	}
+	for _, c := range changes {
+		diff.Changes = append(diff.Changes, &models.FunctionConfigChange{
+			Field:  c.Field,
+			Before: jsonString(c.Before),
+			After:  jsonString(c.After),
+		})
+	}
+	return diff, nil
+}
+
+// jsonString returns a JSON-encoded config value, or nil if the value is
+// unset.
+func jsonString(raw []byte) *string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	s := string(raw)
+	return &s
+}
@@ -7,9 +7,12 @@ import (
 	"github.com/inngest/inngest/pkg/coreapi/generated"
 	"github.com/inngest/inngest/pkg/cqrs"
 	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/pauses"
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/runner"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
 	"github.com/inngest/inngest/pkg/history_reader"
+	"github.com/inngest/inngest/pkg/run"
 )
 
 type Resolver struct {
@@ -21,6 +24,15 @@ type Resolver struct {
 	Executor      execution.Executor
 	ServerKind    string
 
+	// ScheduledInvocations schedules, lists, and cancels function invocations
+	// which run at a future time.
+	ScheduledInvocations scheduled.Scheduler
+	// Waits inspects and resolves the pauses that runs are blocked on.
+	Waits pauses.Waits
+	// RunUpdates streams run updates from the executor to subscriptions.  If
+	// nil, subscriptions are disabled.
+	RunUpdates *run.UpdateBroker
+
 	// LocalSigningKey is the key used to sign events for self-hosted services.
 	LocalSigningKey string
 
@@ -36,6 +48,8 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
 func (r *Resolver) Event() generated.EventResolver { return &eventResolver{r} }
 
 func (r *Resolver) FunctionRun() generated.FunctionRunResolver { return &functionRunResolver{r} }
@@ -78,6 +92,7 @@ func (r *Resolver) RunDeferredFrom() generated.RunDeferredFromResolver {
 
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type eventResolver struct{ *Resolver }
 type appResolver struct{ *Resolver }
 
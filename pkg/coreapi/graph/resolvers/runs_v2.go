@@ -87,21 +87,22 @@ func (qr *queryResolver) Runs(ctx context.Context, num int, cur *string, order [
 		}
 
 		node := &models.FunctionRunV2{
-			ID:             runID,
-			AppID:          r.AppID,
-			FunctionID:     r.FunctionID,
-			TraceID:        r.TraceID,
-			QueuedAt:       r.QueuedAt,
-			StartedAt:      started,
-			EndedAt:        ended,
-			SourceID:       sourceID,
-			Status:         status,
-			Output:         output,
-			IsBatch:        r.IsBatch,
-			BatchCreatedAt: batchTime,
-			CronSchedule:   r.CronSchedule,
-			HasAi:          r.HasAI,
-			IsDeferred:     r.IsDeferred,
+			ID:              runID,
+			AppID:           r.AppID,
+			FunctionID:      r.FunctionID,
+			TraceID:         r.TraceID,
+			QueuedAt:        r.QueuedAt,
+			StartedAt:       started,
+			EndedAt:         ended,
+			SourceID:        sourceID,
+			Status:          status,
+			Output:          output,
+			IsBatch:         r.IsBatch,
+			BatchCreatedAt:  batchTime,
+			CronSchedule:    r.CronSchedule,
+			HasAi:           r.HasAI,
+			IsDeferred:      r.IsDeferred,
+			FunctionVersion: functionVersion(r.FunctionVersion),
 		}
 
 		triggerIDS := []ulid.ULID{}
@@ -220,22 +221,23 @@ func (qr *queryResolver) Run(ctx context.Context, runID string) (*models.Functio
 	}
 
 	res := models.FunctionRunV2{
-		ID:             runid,
-		AppID:          run.AppID,
-		FunctionID:     run.FunctionID,
-		TraceID:        run.TraceID,
-		QueuedAt:       run.QueuedAt,
-		StartedAt:      startedAt,
-		EndedAt:        endedAt,
-		Status:         status,
-		SourceID:       sourceID,
-		TriggerIDs:     triggerIDs,
-		IsBatch:        run.IsBatch,
-		BatchCreatedAt: batchTS,
-		CronSchedule:   run.CronSchedule,
-		Output:         output,
-		HasAi:          run.HasAI,
-		IsDeferred:     run.IsDeferred,
+		ID:              runid,
+		AppID:           run.AppID,
+		FunctionID:      run.FunctionID,
+		TraceID:         run.TraceID,
+		QueuedAt:        run.QueuedAt,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+		Status:          status,
+		SourceID:        sourceID,
+		TriggerIDs:      triggerIDs,
+		IsBatch:         run.IsBatch,
+		BatchCreatedAt:  batchTS,
+		CronSchedule:    run.CronSchedule,
+		Output:          output,
+		HasAi:           run.HasAI,
+		IsDeferred:      run.IsDeferred,
+		FunctionVersion: functionVersion(run.FunctionVersion),
 	}
 
 	return &res, nil
@@ -498,6 +500,11 @@ func toRunsQueryOpt(
 		cel = *filter.Query
 	}
 
+	var search string
+	if filter.Search != nil {
+		search = *filter.Search
+	}
+
 	until := time.Now()
 	if filter.Until != nil {
 		until = *filter.Until
@@ -519,6 +526,7 @@ func toRunsQueryOpt(
 			Until:       until,
 			Status:      statuses,
 			CEL:         cel,
+			Search:      search,
 			IsDeferred:  filter.IsDeferred,
 		},
 		Order:   orderBy,
@@ -527,3 +535,12 @@ func toRunsQueryOpt(
 		Preview: preview == nil || *preview,
 	}
 }
+
+// functionVersion returns the function version a run executed under, or nil
+// if the version was not recorded.
+func functionVersion(v int) *int {
+	if v <= 0 {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,134 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
+	"github.com/oklog/ulid/v2"
+	str2duration "github.com/xhit/go-str2duration/v2"
+)
+
+const maxScheduledInvocations = 100
+
+func (r *mutationResolver) ScheduleFunction(
+	ctx context.Context,
+	data map[string]any,
+	functionSlug string,
+	scheduledAt *time.Time,
+	delay *string,
+) (*models.ScheduledInvocation, error) {
+	if r.ScheduledInvocations == nil {
+		return nil, fmt.Errorf("scheduled invocations are not enabled")
+	}
+
+	var at time.Time
+	switch {
+	case scheduledAt != nil && delay != nil:
+		return nil, fmt.Errorf("only one of scheduledAt or delay may be specified")
+	case scheduledAt != nil:
+		at = *scheduledAt
+	case delay != nil:
+		dur, err := str2duration.ParseDuration(*delay)
+		if err != nil || dur <= 0 {
+			return nil, fmt.Errorf("delay must be a positive duration, such as \"30m\" or \"2d\"")
+		}
+		at = time.Now().Add(dur)
+	default:
+		return nil, fmt.Errorf("one of scheduledAt or delay is required")
+	}
+
+	fnCQRS, err := r.Data.GetFunctionByExternalID(ctx, consts.DevServerEnvID, "local", functionSlug)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := fnCQRS.InngestFunction()
+	if err != nil {
+		return nil, err
+	}
+
+	evt := event.NewInvocationEvent(event.NewInvocationEventOpts{
+		Event:     event.Event{Data: data},
+		FnID:      functionSlug,
+		AccountID: consts.DevServerAccountID,
+		EnvID:     consts.DevServerEnvID,
+	})
+
+	// The event is stored but not sent to the event handler, as that would
+	// invoke the function immediately.
+	if err := r.Data.InsertEvent(ctx, cqrs.ConvertFromEvent(evt.GetInternalID(), evt.GetEvent())); err != nil {
+		return nil, err
+	}
+
+	inv, err := r.ScheduledInvocations.Schedule(ctx, execution.ScheduleRequest{
+		Function:    *fn,
+		At:          &at,
+		AccountID:   consts.DevServerAccountID,
+		WorkspaceID: consts.DevServerEnvID,
+		AppID:       fnCQRS.AppID,
+		Events:      []event.TrackedEvent{evt},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toScheduledInvocation(*inv, functionSlug), nil
+}
+
+func (r *mutationResolver) CancelScheduledInvocation(ctx context.Context, functionSlug string, id ulid.ULID) (*models.ScheduledInvocation, error) {
+	if r.ScheduledInvocations == nil {
+		return nil, fmt.Errorf("scheduled invocations are not enabled")
+	}
+
+	fn, err := r.Data.GetFunctionByExternalID(ctx, consts.DevServerEnvID, "local", functionSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := r.ScheduledInvocations.Cancel(ctx, consts.DevServerEnvID, fn.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledInvocation(*inv, functionSlug), nil
+}
+
+func (qr *queryResolver) ScheduledInvocations(ctx context.Context, functionSlug string, first int) ([]*models.ScheduledInvocation, error) {
+	if qr.Resolver.ScheduledInvocations == nil {
+		return []*models.ScheduledInvocation{}, nil
+	}
+	if first < 1 || first > maxScheduledInvocations {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxScheduledInvocations)
+	}
+
+	fn, err := qr.Data.GetFunctionByExternalID(ctx, consts.DevServerEnvID, "local", functionSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	invs, _, err := qr.Resolver.ScheduledInvocations.List(ctx, consts.DevServerEnvID, fn.ID, scheduled.ListOpts{Limit: first})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.ScheduledInvocation, 0, len(invs))
+	for _, inv := range invs {
+		result = append(result, toScheduledInvocation(inv, functionSlug))
+	}
+	return result, nil
+}
+
+func toScheduledInvocation(inv scheduled.Invocation, functionSlug string) *models.ScheduledInvocation {
+	return &models.ScheduledInvocation{
+		ID:           inv.ID,
+		RunID:        inv.ID,
+		FunctionSlug: functionSlug,
+		ScheduledAt:  inv.At,
+		CreatedAt:    inv.CreatedAt,
+	}
+}
@@ -0,0 +1,194 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	loader "github.com/inngest/inngest/pkg/coreapi/graph/loaders"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/run"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// traceRefreshDelay is how long run trace subscriptions wait after an
+	// update before reloading the trace.  Spans are written as the executor
+	// runs, so this coalesces bursts of updates and gives writes time to land.
+	traceRefreshDelay = 250 * time.Millisecond
+
+	// traceEndedRefreshes is the number of times a trace is reloaded after its
+	// run ends, waiting for the run's final spans to be written.
+	traceEndedRefreshes = 8
+)
+
+var errSubscriptionsDisabled = fmt.Errorf("run subscriptions are not enabled")
+
+func (sr *subscriptionResolver) RunStatusChanged(ctx context.Context, functionID *uuid.UUID, appID *uuid.UUID) (<-chan *models.RunStatusChange, error) {
+	if sr.RunUpdates == nil {
+		return nil, errSubscriptionsDisabled
+	}
+
+	updates := sr.RunUpdates.Subscribe(ctx, run.UpdateFilter{
+		AppID:      appID,
+		FunctionID: functionID,
+		StatusOnly: true,
+	})
+
+	out := make(chan *models.RunStatusChange)
+	go func() {
+		defer close(out)
+
+		for u := range updates {
+			status, err := models.ToFunctionRunStatus(u.Status)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case out <- &models.RunStatusChange{
+				RunID:      u.RunID,
+				AppID:      u.AppID,
+				FunctionID: u.FunctionID,
+				Status:     status,
+				OccurredAt: u.At,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (sr *subscriptionResolver) RunTraceSpans(ctx context.Context, runID string) (<-chan *models.RunTraceSpan, error) {
+	if sr.RunUpdates == nil {
+		return nil, errSubscriptionsDisabled
+	}
+
+	runid, err := ulid.Parse(runID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing runID: %w", err)
+	}
+
+	// Subscribe before loading the trace so that no updates are missed.
+	updates := sr.RunUpdates.Subscribe(ctx, run.UpdateFilter{RunID: &runid})
+
+	out := make(chan *models.RunTraceSpan)
+	go func() {
+		defer close(out)
+
+		l := logger.StdlibLogger(ctx).With("run_id", runid)
+		sent := map[string]string{}
+
+		// send sends spans which are new or have changed since they were last
+		// sent, returning whether the run has ended.
+		send := func() (ended bool, ok bool) {
+			root, err := sr.loadRunTrace(ctx, runid)
+			if err != nil {
+				l.Debug("error loading run trace for subscription", "error", err)
+				return false, true
+			}
+
+			for _, span := range flattenSpans(root) {
+				version := spanVersion(span)
+				if sent[span.SpanID] == version {
+					continue
+				}
+				select {
+				case out <- span:
+					sent[span.SpanID] = version
+				case <-ctx.Done():
+					return false, false
+				}
+			}
+			return models.RunTraceEnded(root.Status), true
+		}
+
+		ended, ok := send()
+		if !ok || ended {
+			return
+		}
+
+		var (
+			refresh   <-chan time.Time
+			runEnded  bool
+			refreshes int
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case u, ok := <-updates:
+				if !ok {
+					return
+				}
+				runEnded = runEnded || u.Ended()
+				if refresh == nil {
+					refresh = time.After(traceRefreshDelay)
+				}
+			case <-refresh:
+				refresh = nil
+
+				ended, ok := send()
+				if !ok || ended {
+					return
+				}
+				if runEnded {
+					// The run has ended but its trace hasn't been fully
+					// written yet; keep checking for a little while.
+					if refreshes >= traceEndedRefreshes {
+						return
+					}
+					refreshes++
+					refresh = time.After(traceRefreshDelay)
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// loadRunTrace loads the current trace of a run, bypassing the request's
+// dataloader cache so that updates are seen.
+func (sr *subscriptionResolver) loadRunTrace(ctx context.Context, runID ulid.ULID) (*models.RunTraceSpan, error) {
+	ctx = loader.ToCtx(ctx, loader.NewLoaders(loader.LoaderParams{DB: sr.Data}))
+	return (&queryResolver{sr.Resolver}).RunTrace(ctx, runID.String())
+}
+
+// flattenSpans returns the span and all of its descendants, parents first.
+func flattenSpans(span *models.RunTraceSpan) []*models.RunTraceSpan {
+	if span == nil || span.Omit {
+		return nil
+	}
+	spans := []*models.RunTraceSpan{span}
+	for _, child := range span.ChildrenSpans {
+		spans = append(spans, flattenSpans(child)...)
+	}
+	return spans
+}
+
+// spanVersion summarizes the parts of a span which change as it runs, used to
+// detect updated spans.
+func spanVersion(span *models.RunTraceSpan) string {
+	var started, ended int64
+	if span.StartedAt != nil {
+		started = span.StartedAt.UnixMilli()
+	}
+	if span.EndedAt != nil {
+		ended = span.EndedAt.UnixMilli()
+	}
+	var attempts int
+	if span.Attempts != nil {
+		attempts = *span.Attempts
+	}
+	var outputID string
+	if span.OutputID != nil {
+		outputID = *span.OutputID
+	}
+	return fmt.Sprintf("%s:%s:%d:%d:%d:%s:%d", span.Name, span.Status, started, ended, attempts, outputID, len(span.ChildrenSpans))
+}
@@ -0,0 +1,68 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/run"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunStatusChangedSubscription(t *testing.T) {
+	r := require.New(t)
+
+	broker := run.NewUpdateBroker()
+	sr := &subscriptionResolver{&Resolver{RunUpdates: broker}}
+
+	fnID := uuid.New()
+	ch, err := sr.RunStatusChanged(t.Context(), &fnID, nil)
+	r.NoError(err)
+
+	runID := ulid.Make()
+	broker.Publish(run.Update{RunID: runID, FunctionID: fnID, Status: enums.RunStatusRunning, Step: true})
+	broker.Publish(run.Update{RunID: ulid.Make(), FunctionID: uuid.New(), Status: enums.RunStatusCompleted})
+	broker.Publish(run.Update{RunID: runID, FunctionID: fnID, Status: enums.RunStatusCompleted})
+
+	select {
+	case change := <-ch:
+		r.Equal(runID, change.RunID)
+		r.Equal(fnID, change.FunctionID)
+		r.Equal(models.FunctionRunStatusCompleted, change.Status)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for status change")
+	}
+}
+
+func TestSubscriptionsDisabled(t *testing.T) {
+	sr := &subscriptionResolver{&Resolver{}}
+
+	_, err := sr.RunStatusChanged(t.Context(), nil, nil)
+	require.ErrorIs(t, err, errSubscriptionsDisabled)
+	_, err = sr.RunTraceSpans(t.Context(), ulid.Make().String())
+	require.ErrorIs(t, err, errSubscriptionsDisabled)
+}
+
+func TestTraceSpanVersions(t *testing.T) {
+	r := require.New(t)
+
+	now := time.Now()
+	child := &models.RunTraceSpan{SpanID: "child", Name: "step", Status: models.RunTraceSpanStatusRunning, StartedAt: &now}
+	root := &models.RunTraceSpan{SpanID: "root", Name: "Run", Status: models.RunTraceSpanStatusRunning, ChildrenSpans: []*models.RunTraceSpan{
+		child,
+		{SpanID: "omitted", Omit: true},
+	}}
+
+	spans := flattenSpans(root)
+	r.Len(spans, 2)
+	r.Equal("root", spans[0].SpanID)
+	r.Equal("child", spans[1].SpanID)
+
+	before := spanVersion(child)
+	child.Status = models.RunTraceSpanStatusCompleted
+	child.EndedAt = &now
+	r.NotEqual(before, spanVersion(child))
+}
@@ -0,0 +1,71 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/usage"
+)
+
+func (qr *queryResolver) Usage(ctx context.Context, period models.UsagePeriod, from *time.Time, until *time.Time) (*models.Usage, error) {
+	p, err := enums.PeriodString(strings.ToLower(period.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	input := usage.UsageInput{Period: &p, From: from, To: until}
+	if err := input.Validate(ctx, nil); err != nil {
+		return nil, err
+	}
+	start, end := input.Window(time.Now())
+
+	rows, err := qr.Data.GetUsageTotals(ctx, cqrs.GetUsageTotalsOpt{
+		Period: p,
+		From:   start,
+		Until:  end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]*models.UsageBucket, 0, len(rows))
+	for _, u := range rows {
+		buckets = append(buckets, &models.UsageBucket{
+			Bucket:         u.Bucket,
+			AppID:          optionalUUID(u.AppID),
+			FunctionID:     optionalUUID(u.FunctionID),
+			EventName:      u.EventName,
+			Runs:           u.Runs,
+			Steps:          u.Steps,
+			Events:         u.Events,
+			StepDurationMs: u.StepDuration.Milliseconds(),
+		})
+	}
+	total := cqrs.TotalUsage(rows)
+
+	return &models.Usage{
+		Period:  period,
+		From:    start,
+		Until:   end,
+		Buckets: buckets,
+		Totals: &models.UsageTotals{
+			Runs:           total.Runs,
+			Steps:          total.Steps,
+			Events:         total.Events,
+			StepDurationMs: total.StepDuration.Milliseconds(),
+		},
+	}, nil
+}
+
+// optionalUUID returns nil for the zero UUID, which usage rows use for events.
+func optionalUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
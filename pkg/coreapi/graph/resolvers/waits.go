@@ -0,0 +1,165 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	maxFunctionWaits = 100
+	// functionWaitRunsPage is the number of running runs loaded at a time
+	// when listing a function's waits.
+	functionWaitRunsPage = 50
+	// maxFunctionWaitScannedRuns bounds the running runs inspected when
+	// listing a function's waits.
+	maxFunctionWaitScannedRuns = 1000
+)
+
+func (qr *queryResolver) RunWaits(ctx context.Context, runID ulid.ULID) ([]*models.Wait, error) {
+	if qr.Resolver.Waits == nil {
+		return []*models.Wait{}, nil
+	}
+
+	pauses, err := qr.Resolver.Waits.ForRun(ctx, consts.DevServerEnvID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return toWaits(pauses), nil
+}
+
+func (qr *queryResolver) FunctionWaits(ctx context.Context, functionSlug string, first int) ([]*models.Wait, error) {
+	if qr.Resolver.Waits == nil {
+		return []*models.Wait{}, nil
+	}
+	if first < 1 || first > maxFunctionWaits {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxFunctionWaits)
+	}
+
+	fn, err := qr.Data.GetFunctionByExternalID(ctx, consts.DevServerEnvID, "local", functionSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only running runs can be blocked on a wait, so page through the
+	// function's running runs until enough waits are found.
+	opts := cqrs.GetTraceRunOpt{
+		Filter: cqrs.GetTraceRunFilter{
+			AccountID:   consts.DevServerAccountID,
+			WorkspaceID: consts.DevServerEnvID,
+			FunctionID:  []uuid.UUID{fn.ID},
+			TimeField:   enums.TraceRunTimeQueuedAt,
+			Until:       time.Now(),
+			Status:      []enums.RunStatus{enums.RunStatusRunning},
+		},
+		Order: []cqrs.GetTraceRunOrder{{
+			Field:     enums.TraceRunTimeQueuedAt,
+			Direction: enums.TraceRunOrderDesc,
+		}},
+		Items: functionWaitRunsPage,
+	}
+
+	result := []*models.Wait{}
+	for scanned := 0; scanned < maxFunctionWaitScannedRuns; {
+		runs, err := qr.Data.GetTraceRuns(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving runs: %w", err)
+		}
+
+		for _, run := range runs {
+			scanned++
+			runID, err := ulid.Parse(run.RunID)
+			if err != nil {
+				continue
+			}
+			pauses, err := qr.Resolver.Waits.ForRun(ctx, consts.DevServerEnvID, runID)
+			if err != nil {
+				return nil, err
+			}
+			for _, w := range toWaits(pauses) {
+				result = append(result, w)
+				if len(result) == first {
+					return result, nil
+				}
+			}
+		}
+
+		if len(runs) < functionWaitRunsPage {
+			break
+		}
+		opts.Cursor = runs[len(runs)-1].Cursor
+	}
+	return result, nil
+}
+
+func (r *mutationResolver) ResolveWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID, data any) (*models.Wait, error) {
+	if r.Waits == nil {
+		return nil, fmt.Errorf("waits are not enabled")
+	}
+
+	byt, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+
+	p, err := r.Waits.Resolve(ctx, consts.DevServerEnvID, runID, waitID, byt)
+	if err != nil {
+		return nil, err
+	}
+	return toWait(*p), nil
+}
+
+func (r *mutationResolver) TimeoutWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID) (*models.Wait, error) {
+	if r.Waits == nil {
+		return nil, fmt.Errorf("waits are not enabled")
+	}
+
+	p, err := r.Waits.Timeout(ctx, consts.DevServerEnvID, runID, waitID)
+	if err != nil {
+		return nil, err
+	}
+	return toWait(*p), nil
+}
+
+func toWaits(pauses []state.Pause) []*models.Wait {
+	result := make([]*models.Wait, 0, len(pauses))
+	for _, p := range pauses {
+		result = append(result, toWait(p))
+	}
+	return result
+}
+
+func toWait(p state.Pause) *models.Wait {
+	w := &models.Wait{
+		ID:               p.ID,
+		RunID:            p.Identifier.RunID,
+		Type:             models.WaitTypeUnknown,
+		StepName:         p.StepName,
+		Event:            p.Event,
+		Expression:       p.Expression,
+		Signal:           p.SignalID,
+		InvokeFunctionID: p.InvokeTargetFnID,
+		CreatedAt:        p.CreatedAt,
+	}
+	switch p.GetOpcode() {
+	case enums.OpcodeWaitForEvent:
+		w.Type = models.WaitTypeEvent
+	case enums.OpcodeWaitForSignal:
+		w.Type = models.WaitTypeSignal
+	case enums.OpcodeInvokeFunction:
+		w.Type = models.WaitTypeInvoke
+	}
+	if expires := p.Expires.Time(); !expires.IsZero() {
+		w.TimeoutAt = &expires
+	}
+	return w
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/exechttp"
 
 	"github.com/google/uuid"
 )
@@ -25,6 +26,9 @@ type App struct {
 	Url         string
 	Method      string
 	AppVersion  string
+	// TLS is the app's TLS config, used when calling the app.  If nil, the
+	// default TLS config is used.
+	TLS *exechttp.TLSConfig
 }
 
 type AppManager interface {
@@ -86,6 +90,8 @@ type UpsertAppParams struct {
 	Url         string
 	Method      string
 	AppVersion  string
+	// TLS sets the app's TLS config.  A nil config keeps any existing config.
+	TLS *exechttp.TLSConfig
 }
 
 type UpdateAppErrorParams struct {
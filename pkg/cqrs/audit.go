@@ -0,0 +1,75 @@
+package cqrs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// DefaultAuditLogs is the number of audit log entries returned when no
+	// limit is given.
+	DefaultAuditLogs = 100
+	// MaxAuditLogs caps the number of audit log entries returned at once.
+	MaxAuditLogs = 1000
+)
+
+type AuditLogReadWriter interface {
+	AuditLogReader
+	AuditLogWriter
+}
+
+// AuditLogWriter appends operator actions to the audit log.  The audit log is
+// append-only: entries are never updated or deleted via this interface.
+type AuditLogWriter interface {
+	// InsertAuditLog appends a single entry to the audit log.
+	InsertAuditLog(ctx context.Context, entry AuditLog) error
+}
+
+// AuditLogReader loads audit log entries from a backing store.
+type AuditLogReader interface {
+	// GetAuditLogs returns entries matching the given filter, newest first.
+	GetAuditLogs(ctx context.Context, opt GetAuditLogsOpt) ([]*AuditLog, error)
+}
+
+// AuditLog records a single mutating call made by an operator via one of the
+// APIs, eg. cancelling a run or changing a semaphore level.
+type AuditLog struct {
+	ID        ulid.ULID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Actor identifies who made the call, eg. the ID of the signing key used
+	// to authenticate the request.
+	Actor string `json:"actor"`
+	// Source is the API that the call was made through, eg. "api.v1" or
+	// "graphql".
+	Source string `json:"source"`
+	// Action is the operation that was called, eg. "DELETE /v1/runs/{runID}"
+	// or "graphql.cancelRun".
+	Action string `json:"action"`
+	// Target is the resource that the call acted upon, eg. the request path.
+	Target string `json:"target"`
+	// Payload is the JSON-encoded input of the call, if any.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Status is the HTTP status code of the call's response.
+	Status int `json:"status"`
+}
+
+type GetAuditLogsOpt struct {
+	// Actor, if set, only returns entries made by the given actor.
+	Actor string
+	// Action, if set, only returns entries for the given action.
+	Action string
+	// Source, if set, only returns entries made via the given API.
+	Source string
+	// After is the lower bound for an entry's creation time, inclusive.
+	After time.Time
+	// Before is the upper bound for an entry's creation time, exclusive.
+	Before time.Time
+	// Cursor, if set, only returns entries older than the entry with this ID.
+	Cursor *ulid.ULID
+	// Limit is the maximum number of entries to return, defaulting to
+	// DefaultAuditLogs and capped at MaxAuditLogs.
+	Limit uint
+}
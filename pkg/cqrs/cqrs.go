@@ -40,6 +40,15 @@ type Manager interface {
 	// Connection history
 	ConnectionHistoryReadWriter
 
+	// Audit log of operator actions
+	AuditLogReadWriter
+
+	// Config history of synced functions
+	FunctionVersionReadWriter
+
+	// Usage totals for chargeback
+	UsageReadWriter
+
 	// Scoped allows creating a new manager using a transaction.
 	WithTx(ctx context.Context) (TxManager, error)
 }
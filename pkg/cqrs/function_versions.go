@@ -0,0 +1,71 @@
+package cqrs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/inngest"
+)
+
+type FunctionVersionReadWriter interface {
+	FunctionVersionReader
+	FunctionVersionWriter
+}
+
+// FunctionVersionWriter records each config a function is synced with.
+type FunctionVersionWriter interface {
+	// InsertFunctionVersion records a version of a function's config.  Recording
+	// the same function version twice replaces the earlier record.
+	InsertFunctionVersion(ctx context.Context, v FunctionVersion) error
+}
+
+// FunctionVersionReader loads the recorded versions of a function.
+type FunctionVersionReader interface {
+	// GetFunctionVersions returns every recorded version of a function, newest
+	// first.
+	GetFunctionVersions(ctx context.Context, fnID uuid.UUID) ([]*FunctionVersion, error)
+	// GetFunctionVersion returns a single version of a function, or
+	// sql.ErrNoRows if the version was never recorded.
+	GetFunctionVersion(ctx context.Context, fnID uuid.UUID, version int) (*FunctionVersion, error)
+}
+
+// FunctionVersion is the full config of a function as of a given app sync.
+// Runs record the version of the function they were scheduled with, so a
+// run's config can be found via its function ID and version.
+type FunctionVersion struct {
+	FunctionID uuid.UUID       `json:"function_id"`
+	Version    int             `json:"version"`
+	AppID      uuid.UUID       `json:"app_id"`
+	SyncID     uuid.UUID       `json:"sync_id"`
+	Config     json.RawMessage `json:"config"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func (v FunctionVersion) InngestFunction() (*inngest.Function, error) {
+	fn := inngest.Function{}
+	if err := json.Unmarshal(v.Config, &fn); err != nil {
+		return nil, err
+	}
+	return &fn, nil
+}
+
+// SelectFunctionVersions picks the two versions to compare from a list of
+// versions ordered newest first.  to defaults to the latest version and from
+// defaults to the version recorded before to.  Either is nil if the requested
+// version was not recorded.
+func SelectFunctionVersions(versions []*FunctionVersion, fromVersion, toVersion *int) (from, to *FunctionVersion) {
+	for i, v := range versions {
+		if to == nil && (toVersion == nil || v.Version == *toVersion) {
+			to = v
+			if fromVersion == nil && i+1 < len(versions) {
+				from = versions[i+1]
+			}
+		}
+		if fromVersion != nil && v.Version == *fromVersion {
+			from = v
+		}
+	}
+	return from, to
+}
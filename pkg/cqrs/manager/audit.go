@@ -0,0 +1,129 @@
+package manager
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/doug-martin/goqu/v9"
+	"github.com/inngest/inngest/pkg/cqrs"
+	dbpkg "github.com/inngest/inngest/pkg/db"
+	"github.com/oklog/ulid/v2"
+)
+
+func (w wrapper) InsertAuditLog(ctx context.Context, entry cqrs.AuditLog) error {
+	if entry.ID.IsZero() {
+		entry.ID = ulid.MustNew(ulid.Now(), ulid.DefaultEntropy())
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = ulid.Time(entry.ID.Time())
+	}
+
+	var payload sql.NullString
+	if len(entry.Payload) > 0 {
+		payload = sql.NullString{String: string(entry.Payload), Valid: true}
+	}
+
+	return w.q.InsertAuditLog(ctx, dbpkg.InsertAuditLogParams{
+		ID:        entry.ID,
+		CreatedAt: entry.CreatedAt.UnixMilli(),
+		Actor:     entry.Actor,
+		Source:    entry.Source,
+		Action:    entry.Action,
+		Target:    entry.Target,
+		Payload:   payload,
+		Status:    int64(entry.Status),
+	})
+}
+
+func (w wrapper) GetAuditLogs(ctx context.Context, opt cqrs.GetAuditLogsOpt) ([]*cqrs.AuditLog, error) {
+	filter := []sq.Expression{}
+	if opt.Actor != "" {
+		filter = append(filter, sq.C("actor").Eq(opt.Actor))
+	}
+	if opt.Action != "" {
+		filter = append(filter, sq.C("action").Eq(opt.Action))
+	}
+	if opt.Source != "" {
+		filter = append(filter, sq.C("source").Eq(opt.Source))
+	}
+	if !opt.After.IsZero() {
+		filter = append(filter, sq.C("created_at").Gte(opt.After.UnixMilli()))
+	}
+	if !opt.Before.IsZero() {
+		filter = append(filter, sq.C("created_at").Lt(opt.Before.UnixMilli()))
+	}
+	if opt.Cursor != nil {
+		filter = append(filter, sq.C("id").Lt(opt.Cursor.String()))
+	}
+
+	limit := opt.Limit
+	if limit == 0 {
+		limit = cqrs.DefaultAuditLogs
+	}
+	if limit > cqrs.MaxAuditLogs {
+		limit = cqrs.MaxAuditLogs
+	}
+
+	query, args, err := sq.Dialect(w.dialect()).
+		From("audit_logs").
+		Select(
+			"id",
+			"created_at",
+			"actor",
+			"source",
+			"action",
+			"target",
+			"payload",
+			"status",
+		).
+		Where(filter...).
+		Order(sq.C("id").Desc()).
+		Limit(limit).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*cqrs.AuditLog{}
+	for rows.Next() {
+		var (
+			id        string
+			createdAt int64
+			payload   sql.NullString
+			status    int64
+			entry     = &cqrs.AuditLog{}
+		)
+		if err := rows.Scan(
+			&id,
+			&createdAt,
+			&entry.Actor,
+			&entry.Source,
+			&entry.Action,
+			&entry.Target,
+			&payload,
+			&status,
+		); err != nil {
+			return nil, err
+		}
+
+		if entry.ID, err = ulid.Parse(id); err != nil {
+			return nil, err
+		}
+		entry.CreatedAt = time.UnixMilli(createdAt)
+		entry.Status = int(status)
+		if payload.Valid {
+			entry.Payload = json.RawMessage(payload.String)
+		}
+		res = append(res, entry)
+	}
+
+	return res, rows.Err()
+}
@@ -28,6 +28,7 @@ import (
 	dbpkg "github.com/inngest/inngest/pkg/db"
 	"github.com/inngest/inngest/pkg/db/driverhelp"
 	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/exechttp"
 	"github.com/inngest/inngest/pkg/execution/history"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/inngest/inngest/pkg/logger"
@@ -1293,6 +1294,7 @@ func (w wrapper) UpsertApp(ctx context.Context, arg cqrs.UpsertAppParams) (*cqrs
 		Url:         arg.Url,
 		Method:      arg.Method,
 		AppVersion:  sql.NullString{String: arg.AppVersion, Valid: arg.AppVersion != ""},
+		TlsConfig:   tlsConfigParam(arg.TLS),
 	})
 	if err != nil {
 		return nil, err
@@ -1325,6 +1327,7 @@ func (w wrapper) UpsertAppByName(ctx context.Context, arg cqrs.UpsertAppParams)
 		Url:         arg.Url,
 		Method:      arg.Method,
 		AppVersion:  sql.NullString{String: arg.AppVersion, Valid: arg.AppVersion != ""},
+		TlsConfig:   tlsConfigParam(arg.TLS),
 	})
 	if err != nil {
 		return nil, err
@@ -1333,6 +1336,19 @@ func (w wrapper) UpsertAppByName(ctx context.Context, arg cqrs.UpsertAppParams)
 	return domainToCQRS(app, domainApp), nil
 }
 
+// tlsConfigParam encodes an app's TLS config for storage, returning NULL for an
+// empty config.
+func tlsConfigParam(c *exechttp.TLSConfig) sql.NullString {
+	if c.IsZero() {
+		return sql.NullString{}
+	}
+	byt, err := json.Marshal(c)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(byt), Valid: true}
+}
+
 func (w wrapper) UpdateAppError(ctx context.Context, arg cqrs.UpdateAppErrorParams) (*cqrs.App, error) {
 	// Use the direct SQL UPDATE query instead of load-then-upsert
 	app, err := w.q.UpdateAppError(ctx, dbpkg.UpdateAppErrorParams{
@@ -2222,8 +2238,9 @@ func traceRunToCQRS(run *dbpkg.TraceRun) *cqrs.TraceRun {
 }
 
 type traceRunSpanDetail struct {
-	endedAt *time.Time
-	status  *enums.RunStatus
+	endedAt         *time.Time
+	status          *enums.RunStatus
+	functionVersion int
 }
 
 func (w wrapper) applySpanDetailsToTraceRuns(ctx context.Context, runs []*cqrs.TraceRun) error {
@@ -2253,6 +2270,9 @@ func (w wrapper) applySpanDetailsToTraceRuns(ctx context.Context, runs []*cqrs.T
 			run.EndedAt = *detail.endedAt
 			run.Duration = run.EndedAt.Sub(run.StartedAt)
 		}
+		if detail.functionVersion > 0 {
+			run.FunctionVersion = detail.functionVersion
+		}
 	}
 	return nil
 }
@@ -2277,6 +2297,7 @@ func (w wrapper) loadTraceRunSpanDetails(ctx context.Context, runIDs []string) (
 					AND s2.debug_run_id IS NULL
 					AND (s2.status IS NULL OR s2.status <> ?)
 				ORDER BY s2.end_time DESC NULLS LAST, s2.span_id DESC LIMIT 1)`, enums.RunStatusSkipped.String()).As("status"),
+			sq.L("CAST(attributes AS TEXT)").As("attributes"),
 		).
 		Where(
 			sq.C("run_id").In(runIDs),
@@ -2304,12 +2325,14 @@ func (w wrapper) loadTraceRunSpanDetails(ctx context.Context, runIDs []string) (
 	details := map[string]traceRunSpanDetail{}
 	for rows.Next() {
 		var runID string
-		var endTime, status *string
-		if err := rows.Scan(&runID, &endTime, &status); err != nil {
+		var endTime, status, attributes *string
+		if err := rows.Scan(&runID, &endTime, &status, &attributes); err != nil {
 			return nil, err
 		}
 
-		detail := traceRunSpanDetail{}
+		detail := traceRunSpanDetail{
+			functionVersion: functionVersionAttribute(decodeSpanAttributes(attributes)),
+		}
 		if endTime != nil && *endTime != "" {
 			if parsed, err := h.ParseTime(*endTime); err == nil {
 				detail.endedAt = &parsed
@@ -2640,7 +2663,7 @@ func newRunsQueryBuilder(ctx context.Context, opt cqrs.GetTraceRunOpt) *runsQuer
 func (w wrapper) GetTraceRunsCount(ctx context.Context, opt cqrs.GetTraceRunOpt) (int, error) {
 	opt.Cursor = ""
 	opt.Items = 0
-	if opt.Preview && !canReadEndedAtFromTraceRuns(opt) {
+	if readsRunsFromSpans(opt) {
 		return w.getSpanRunsCount(ctx, opt)
 	}
 	if opt.Filter.CEL == "" {
@@ -2656,13 +2679,22 @@ func (w wrapper) GetTraceRunsCount(ctx context.Context, opt cqrs.GetTraceRunOpt)
 }
 
 func (w wrapper) GetTraceRuns(ctx context.Context, opt cqrs.GetTraceRunOpt) ([]*cqrs.TraceRun, error) {
-	if opt.Preview && !canReadEndedAtFromTraceRuns(opt) {
+	if readsRunsFromSpans(opt) {
 		return w.GetRuns(ctx, opt)
 	}
 
 	return w.getTraceRunsFromTable(ctx, opt)
 }
 
+// The run search index is written as spans are exported, so searches always
+// read runs from spans.
+func readsRunsFromSpans(opt cqrs.GetTraceRunOpt) bool {
+	if strings.TrimSpace(opt.Filter.Search) != "" {
+		return true
+	}
+	return opt.Preview && !canReadEndedAtFromTraceRuns(opt)
+}
+
 // trace_runs is complete for ended runs and avoids span aggregation.
 func canReadEndedAtFromTraceRuns(opt cqrs.GetTraceRunOpt) bool {
 	return opt.Filter.TimeField == enums.TraceRunTimeEndedAt && opt.Filter.CEL == ""
@@ -3803,6 +3835,16 @@ func stringAttribute(attrs map[string]any, key string) (string, bool) {
 	return value, ok && value != ""
 }
 
+// functionVersionAttribute returns the function version a run span was
+// recorded with, or zero if the span has no version.
+func functionVersionAttribute(attrs map[string]any) int {
+	version, ok := meta.Attrs.FunctionVersion.DeserializeTypedValue(attrs[meta.Attrs.FunctionVersion.Key()])
+	if !ok || version == nil {
+		return 0
+	}
+	return *version
+}
+
 // loadSpanRunPageDetails fills in end_time and status for the selected roots.
 func (w wrapper) loadSpanRunPageDetails(
 	ctx context.Context,
@@ -3965,6 +4007,13 @@ func spanRunRootPredicates(opt cqrs.GetTraceRunOpt, h driverhelp.DialectHelpers)
 		}
 		preds = append(preds, h.EventIDsContain(eventIDs))
 	}
+	if search := strings.TrimSpace(opt.Filter.Search); search != "" {
+		matches := sq.Dialect(h.GoquDialect()).
+			From("run_search").
+			Select("run_id").
+			Where(h.RunSearchMatch(search))
+		preds = append(preds, sq.I("spans.run_id").In(matches))
+	}
 	if opt.Filter.IsDeferred != nil {
 		if *opt.Filter.IsDeferred {
 			preds = append(preds, sq.I("spans.is_deferred").IsTrue())
@@ -4285,6 +4334,7 @@ func convertSpanRunRow(
 	if cron, ok := stringAttribute(attrs, meta.Attrs.CronSchedule.Key()); ok {
 		traceRun.CronSchedule = &cron
 	}
+	traceRun.FunctionVersion = functionVersionAttribute(attrs)
 
 	if endTime != nil {
 		traceRun.EndedAt = *endTime
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	dbsqlite "github.com/inngest/inngest/pkg/db/sqlite"
 	"github.com/inngest/inngest/pkg/enums"
 	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngest/pkg/usage"
 	"github.com/inngest/inngest/tests/testutil"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
@@ -2665,8 +2667,9 @@ func TestCQRSGetRunsUsesModernSpanData(t *testing.T) {
 	deferred := true
 
 	attrs, err := json.Marshal(map[string]any{
-		meta.Attrs.BatchID.Key():      batchID.String(),
-		meta.Attrs.CronSchedule.Key(): "*/5 * * * *",
+		meta.Attrs.BatchID.Key():         batchID.String(),
+		meta.Attrs.CronSchedule.Key():    "*/5 * * * *",
+		meta.Attrs.FunctionVersion.Key(): 3,
 	})
 	require.NoError(t, err)
 	eventIDs, err := json.Marshal([]string{firstEventID.String(), eventID.String(), thirdEventID.String()})
@@ -2754,6 +2757,7 @@ func TestCQRSGetRunsUsesModernSpanData(t *testing.T) {
 	assert.Equal(t, batchID, *runs[0].BatchID)
 	require.NotNil(t, runs[0].CronSchedule)
 	assert.Equal(t, "*/5 * * * *", *runs[0].CronSchedule)
+	assert.Equal(t, 3, runs[0].FunctionVersion)
 	assert.JSONEq(t, `{"data":{"source":"function"}}`, string(runs[0].Output))
 	assert.NotEmpty(t, runs[0].Cursor)
 }
@@ -2889,6 +2893,112 @@ func TestCQRSGetRunsIncludesFinalEndedAtUntilBoundary(t *testing.T) {
 // Span Tests
 //
 
+func TestCQRSGetRunsSearch(t *testing.T) {
+	ctx := context.Background()
+	appID := uuid.New()
+
+	cm, cleanup := initCQRS(t, withInitCQRSOptApp(appID))
+	defer cleanup()
+
+	accountID := uuid.New()
+	workspaceID := uuid.New()
+	functionID := uuid.New()
+	baseTime := time.Now().UTC().Truncate(time.Second)
+	q := cm.(wrapper).q
+
+	docs := map[string][]dbpkg.InsertRunSearchDocumentParams{
+		ulid.Make().String(): {
+			{Kind: "event", Content: `[{"name":"order.created","data":{"order_id":8812}}]`},
+			{Kind: "output", Content: `{"data":{"charged":true}}`},
+		},
+		ulid.Make().String(): {
+			{Kind: "event", Content: `[{"name":"order.created","data":{"order_id":9000}}]`},
+			{Kind: "error", Content: `{"error":{"message":"card declined"}}`},
+		},
+	}
+	runIDs := map[string]string{}
+	for runID, runDocs := range docs {
+		runIDs[runDocs[1].Kind] = runID
+
+		insertTestSpan(t, cm, testSpanFields{
+			RunID:         runID,
+			DynamicSpanID: "dyn-" + runID,
+			Name:          meta.SpanNameRun,
+			Status:        enums.StepStatusCompleted.String(),
+			StartTime:     baseTime,
+			AccountID:     accountID.String(),
+			AppID:         appID.String(),
+			FunctionID:    functionID.String(),
+			EnvID:         workspaceID.String(),
+		})
+		require.NoError(t, cm.InsertTraceRun(ctx, &cqrs.TraceRun{
+			AccountID:   accountID,
+			WorkspaceID: workspaceID,
+			AppID:       appID,
+			FunctionID:  functionID,
+			TraceID:     "trace-" + runID,
+			RunID:       runID,
+			QueuedAt:    baseTime,
+			StartedAt:   baseTime,
+			EndedAt:     baseTime.Add(time.Second),
+			Status:      enums.RunStatusCompleted,
+		}))
+
+		for _, doc := range runDocs {
+			doc.RunID = runID
+			doc.SpanID = "span-" + runID
+			require.NoError(t, q.InsertRunSearchDocument(ctx, doc))
+		}
+	}
+
+	search := func(t *testing.T, query string, preview bool) []string {
+		t.Helper()
+
+		opt := cqrs.GetTraceRunOpt{
+			Filter: cqrs.GetTraceRunFilter{
+				AccountID:   accountID,
+				WorkspaceID: workspaceID,
+				TimeField:   enums.TraceRunTimeStartedAt,
+				From:        baseTime.Add(-time.Hour),
+				Until:       baseTime.Add(time.Hour),
+				Search:      query,
+			},
+			Order: []cqrs.GetTraceRunOrder{
+				{Field: enums.TraceRunTimeStartedAt, Direction: enums.TraceRunOrderDesc},
+			},
+			Items:   10,
+			Preview: preview,
+		}
+		runs, err := cm.GetTraceRuns(ctx, opt)
+		require.NoError(t, err)
+
+		count, err := cm.GetTraceRunsCount(ctx, opt)
+		require.NoError(t, err)
+		require.Equal(t, len(runs), count)
+
+		ids := []string{}
+		for _, r := range runs {
+			ids = append(ids, r.RunID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	for _, preview := range []bool{true, false} {
+		t.Run(fmt.Sprintf("preview=%t", preview), func(t *testing.T) {
+			assert.Equal(t, []string{runIDs["output"]}, search(t, "8812", preview))
+			assert.Equal(t, []string{runIDs["error"]}, search(t, "card declined", preview))
+			assert.Empty(t, search(t, "8812 declined", preview), "every term should match")
+			assert.Empty(t, search(t, `"8812 OR*`, preview), "query syntax should be treated as text")
+
+			all := []string{runIDs["output"], runIDs["error"]}
+			sort.Strings(all)
+			assert.Equal(t, all, search(t, "order.created", preview))
+			assert.Equal(t, all, search(t, "  ", preview), "blank searches should not filter")
+		})
+	}
+}
+
 func TestCQRSGetSpan(t *testing.T) {
 	// These tests insert a root and child span with different dynamic_span_ids.
 	// Each test tests a different query that GROUPs BY dynamic_span_id
@@ -3320,6 +3430,302 @@ func TestExtendedTraceReparenting(t *testing.T) {
 	})
 }
 
+//
+// Audit Log Tests
+//
+
+func TestCQRSAuditLogs(t *testing.T) {
+	ctx := context.Background()
+
+	cm, cleanup := initCQRS(t)
+	defer cleanup()
+
+	base := time.Now().Truncate(time.Millisecond).Add(-time.Hour)
+	ids := make([]ulid.ULID, 5)
+	for i := range ids {
+		createdAt := base.Add(time.Duration(i) * time.Minute)
+		ids[i] = ulid.MustNew(ulid.Timestamp(createdAt), rand.Reader)
+
+		actor := "signkey-prod-a"
+		if i%2 == 1 {
+			actor = "signkey-prod-b"
+		}
+		err := cm.InsertAuditLog(ctx, cqrs.AuditLog{
+			ID:        ids[i],
+			CreatedAt: createdAt,
+			Actor:     actor,
+			Source:    "api.v1",
+			Action:    "DELETE /runs/{runID}",
+			Target:    fmt.Sprintf("/runs/%d", i),
+			Payload:   json.RawMessage(`{"i":1}`),
+			Status:    200,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("returns newest first", func(t *testing.T) {
+		entries, err := cm.GetAuditLogs(ctx, cqrs.GetAuditLogsOpt{})
+		require.NoError(t, err)
+		require.Len(t, entries, 5)
+		assert.Equal(t, ids[4], entries[0].ID)
+		assert.Equal(t, base.Add(4*time.Minute).UnixMilli(), entries[0].CreatedAt.UnixMilli())
+		assert.Equal(t, "/runs/4", entries[0].Target)
+		assert.JSONEq(t, `{"i":1}`, string(entries[0].Payload))
+		assert.Equal(t, 200, entries[0].Status)
+	})
+
+	t.Run("filters by actor and time", func(t *testing.T) {
+		entries, err := cm.GetAuditLogs(ctx, cqrs.GetAuditLogsOpt{
+			Actor:  "signkey-prod-a",
+			After:  base.Add(time.Minute),
+			Before: base.Add(4 * time.Minute),
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, ids[2], entries[0].ID)
+	})
+
+	t.Run("pages with a cursor", func(t *testing.T) {
+		page, err := cm.GetAuditLogs(ctx, cqrs.GetAuditLogsOpt{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+
+		next, err := cm.GetAuditLogs(ctx, cqrs.GetAuditLogsOpt{Limit: 2, Cursor: &page[1].ID})
+		require.NoError(t, err)
+		require.Len(t, next, 2)
+		assert.Equal(t, ids[2], next[0].ID)
+		assert.Equal(t, ids[1], next[1].ID)
+	})
+}
+
+//
+// Function Version Tests
+//
+
+func TestCQRSFunctionVersions(t *testing.T) {
+	ctx := context.Background()
+
+	cm, cleanup := initCQRS(t)
+	defer cleanup()
+
+	fnID := uuid.New()
+	appID := uuid.New()
+	syncIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	base := time.Now().Truncate(time.Millisecond).Add(-time.Hour)
+
+	for i, syncID := range syncIDs {
+		err := cm.InsertFunctionVersion(ctx, cqrs.FunctionVersion{
+			FunctionID: fnID,
+			Version:    i + 1,
+			AppID:      appID,
+			SyncID:     syncID,
+			Config:     json.RawMessage(fmt.Sprintf(`{"fv":%d}`, i+1)),
+			CreatedAt:  base.Add(time.Duration(i) * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+
+	// Versions for other functions are never returned.
+	require.NoError(t, cm.InsertFunctionVersion(ctx, cqrs.FunctionVersion{
+		FunctionID: uuid.New(),
+		Version:    1,
+		AppID:      appID,
+		SyncID:     syncIDs[0],
+		Config:     json.RawMessage(`{}`),
+	}))
+
+	t.Run("lists versions newest first", func(t *testing.T) {
+		versions, err := cm.GetFunctionVersions(ctx, fnID)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, 2, versions[0].Version)
+		assert.Equal(t, syncIDs[1], versions[0].SyncID)
+		assert.Equal(t, appID, versions[0].AppID)
+		assert.Equal(t, base.Add(time.Minute).UnixMilli(), versions[0].CreatedAt.UnixMilli())
+		assert.JSONEq(t, `{"fv":2}`, string(versions[0].Config))
+		assert.Equal(t, 1, versions[1].Version)
+	})
+
+	t.Run("loads a single version", func(t *testing.T) {
+		v, err := cm.GetFunctionVersion(ctx, fnID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, syncIDs[0], v.SyncID)
+
+		_, err = cm.GetFunctionVersion(ctx, fnID, 3)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("re-recording a version replaces it", func(t *testing.T) {
+		syncID := uuid.New()
+		err := cm.InsertFunctionVersion(ctx, cqrs.FunctionVersion{
+			FunctionID: fnID,
+			Version:    1,
+			AppID:      appID,
+			SyncID:     syncID,
+			Config:     json.RawMessage(`{"fv":1,"name":"replaced"}`),
+		})
+		require.NoError(t, err)
+
+		v, err := cm.GetFunctionVersion(ctx, fnID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, syncID, v.SyncID)
+		assert.JSONEq(t, `{"fv":1,"name":"replaced"}`, string(v.Config))
+	})
+}
+
+func TestCQRSUsageTotals(t *testing.T) {
+	ctx := context.Background()
+
+	cm, cleanup := initCQRS(t)
+	defer cleanup()
+
+	appID := uuid.New()
+	fnID := uuid.New()
+	now := time.Now().UTC()
+	past := usage.Truncate(now, enums.PeriodHour).Add(-2*time.Hour + 10*time.Minute)
+	attrs, err := json.Marshal(map[string]any{
+		meta.Attrs.TriggeringEventName.Key(): "app/signup",
+	})
+	require.NoError(t, err)
+
+	span := func(runID, name, dynamicSpanID string, start time.Time) testSpanFields {
+		return testSpanFields{
+			RunID:         runID,
+			DynamicSpanID: dynamicSpanID,
+			Name:          name,
+			StartTime:     start,
+			AppID:         appID.String(),
+			FunctionID:    fnID.String(),
+			Attributes:    attrs,
+		}
+	}
+
+	// A run which ended two hours ago, and is rolled up.
+	runID := ulid.MustNew(ulid.Now(), rand.Reader).String()
+	insertTestSpan(t, cm, span(runID, meta.SpanNameRun, "run-1", past))
+	insertTestSpan(t, cm, span(runID, meta.SpanNameStep, "step-1", past.Add(time.Second)))
+	insertTestSpan(t, cm, span(runID, meta.SpanNameExecution, "exec-1", past.Add(time.Second)))
+
+	// Debug runs are never counted.
+	debug := span(ulid.MustNew(ulid.Now(), rand.Reader).String(), meta.SpanNameRun, "run-debug", past)
+	debug.DebugRunID = ulid.MustNew(ulid.Now(), rand.Reader).String()
+	insertTestSpan(t, cm, debug)
+
+	// A run started just now, which is read from spans directly.
+	recentID := ulid.MustNew(ulid.Now(), rand.Reader).String()
+	insertTestSpan(t, cm, span(recentID, meta.SpanNameRun, "run-2", now.Add(-time.Second)))
+
+	require.NoError(t, cm.InsertEvent(ctx, cqrs.Event{
+		ID:        ulid.MustNew(ulid.Now(), rand.Reader),
+		EventID:   "evt-usage",
+		EventName: "app/signup",
+		EventData: map[string]any{},
+		EventUser: map[string]any{},
+		EventTS:   now.UnixMilli(),
+	}))
+
+	opt := cqrs.GetUsageTotalsOpt{
+		Period: enums.PeriodHour,
+		From:   now.Add(-3 * time.Hour),
+		Until:  now.Add(time.Minute),
+	}
+
+	t.Run("buckets usage by hour", func(t *testing.T) {
+		rows, err := cm.GetUsageTotals(ctx, opt)
+		require.NoError(t, err)
+		require.NotEmpty(t, rows)
+
+		first := rows[0]
+		assert.Equal(t, usage.Truncate(past, enums.PeriodHour), first.Bucket)
+		assert.Equal(t, appID, first.AppID)
+		assert.Equal(t, fnID, first.FunctionID)
+		assert.Equal(t, "app/signup", first.EventName)
+		assert.EqualValues(t, 1, first.Runs)
+		assert.EqualValues(t, 1, first.Steps)
+		assert.Equal(t, 100*time.Millisecond, first.StepDuration)
+
+		total := cqrs.TotalUsage(rows)
+		assert.EqualValues(t, 2, total.Runs)
+		assert.EqualValues(t, 1, total.Steps)
+		assert.EqualValues(t, 1, total.Events)
+	})
+
+	rolledBuckets := func(t *testing.T) int {
+		var n int
+		err := cm.(wrapper).adapter.Conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM usage_rollup_buckets").Scan(&n)
+		require.NoError(t, err)
+		return n
+	}
+
+	t.Run("reading usage doesn't roll it up", func(t *testing.T) {
+		_, err := cm.GetUsageTotals(ctx, opt)
+		require.NoError(t, err)
+		assert.Zero(t, rolledBuckets(t))
+	})
+
+	t.Run("rolled up hours are not counted twice", func(t *testing.T) {
+		live, err := cm.GetUsageTotals(ctx, opt)
+		require.NoError(t, err)
+
+		require.NoError(t, cm.RollupUsage(ctx, opt.From, now))
+		rolled := rolledBuckets(t)
+		assert.Positive(t, rolled)
+
+		// Rolling up again skips hours which are already rolled up.
+		require.NoError(t, cm.RollupUsage(ctx, opt.From, now))
+		assert.Equal(t, rolled, rolledBuckets(t))
+
+		rows, err := cm.GetUsageTotals(ctx, opt)
+		require.NoError(t, err)
+		assert.Equal(t, live, rows)
+	})
+
+	t.Run("buckets usage by minute", func(t *testing.T) {
+		rows, err := cm.GetUsageTotals(ctx, cqrs.GetUsageTotalsOpt{
+			Period: enums.PeriodMinute,
+			From:   past,
+			Until:  past.Add(time.Minute),
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, past, rows[0].Bucket)
+		assert.EqualValues(t, 1, rows[0].Runs)
+		assert.EqualValues(t, 1, rows[0].Steps)
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		rows, err := cm.GetUsageTotals(ctx, cqrs.GetUsageTotalsOpt{
+			Period: enums.PeriodHour,
+			From:   now,
+			Until:  now,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, rows)
+	})
+
+	t.Run("steps shared by runs are counted per run", func(t *testing.T) {
+		// Dynamic span IDs are derived from step IDs, so every run of a
+		// function has the same step span ID.
+		start := usage.Truncate(now, enums.PeriodHour).Add(-5 * time.Hour)
+		for i := range 2 {
+			runID := ulid.MustNew(ulid.Now(), rand.Reader).String()
+			at := start.Add(time.Duration(i) * time.Minute)
+			insertTestSpan(t, cm, span(runID, meta.SpanNameRun, "run-"+runID, at))
+			insertTestSpan(t, cm, span(runID, meta.SpanNameStep, "step-shared", at.Add(time.Second)))
+		}
+
+		rows, err := cm.GetUsageTotals(ctx, cqrs.GetUsageTotalsOpt{
+			Period: enums.PeriodHour,
+			From:   start,
+			Until:  start.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		total := cqrs.TotalUsage(rows)
+		assert.EqualValues(t, 2, total.Runs)
+		assert.EqualValues(t, 2, total.Steps)
+	})
+}
+
 //
 // Helpers
 //
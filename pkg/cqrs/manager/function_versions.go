@@ -0,0 +1,107 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sq "github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/cqrs"
+	dbpkg "github.com/inngest/inngest/pkg/db"
+)
+
+var functionVersionColumns = []any{
+	"function_id",
+	"version",
+	"app_id",
+	"sync_id",
+	"config",
+	"created_at",
+}
+
+func (w wrapper) InsertFunctionVersion(ctx context.Context, v cqrs.FunctionVersion) error {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = time.Now()
+	}
+
+	return w.q.UpsertFunctionVersion(ctx, dbpkg.UpsertFunctionVersionParams{
+		FunctionID: v.FunctionID,
+		Version:    int64(v.Version),
+		AppID:      v.AppID,
+		SyncID:     v.SyncID,
+		Config:     string(v.Config),
+		CreatedAt:  v.CreatedAt.UnixMilli(),
+	})
+}
+
+func (w wrapper) GetFunctionVersions(ctx context.Context, fnID uuid.UUID) ([]*cqrs.FunctionVersion, error) {
+	query, args, err := sq.Dialect(w.dialect()).
+		From("function_versions").
+		Select(functionVersionColumns...).
+		Where(sq.C("function_id").Eq(fnID.String())).
+		Order(sq.C("version").Desc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*cqrs.FunctionVersion{}
+	for rows.Next() {
+		v, err := scanFunctionVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+
+	return res, rows.Err()
+}
+
+func (w wrapper) GetFunctionVersion(ctx context.Context, fnID uuid.UUID, version int) (*cqrs.FunctionVersion, error) {
+	query, args, err := sq.Dialect(w.dialect()).
+		From("function_versions").
+		Select(functionVersionColumns...).
+		Where(
+			sq.C("function_id").Eq(fnID.String()),
+			sq.C("version").Eq(version),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanFunctionVersion(w.adapter.Conn().QueryRowContext(ctx, query, args...))
+}
+
+func scanFunctionVersion(row interface{ Scan(...any) error }) (*cqrs.FunctionVersion, error) {
+	var (
+		fnID, appID, syncID string
+		config              string
+		createdAt           int64
+		v                   = &cqrs.FunctionVersion{}
+	)
+	if err := row.Scan(&fnID, &v.Version, &appID, &syncID, &config, &createdAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if v.FunctionID, err = uuid.Parse(fnID); err != nil {
+		return nil, err
+	}
+	if v.AppID, err = uuid.Parse(appID); err != nil {
+		return nil, err
+	}
+	if v.SyncID, err = uuid.Parse(syncID); err != nil {
+		return nil, err
+	}
+	v.Config = json.RawMessage(config)
+	v.CreatedAt = time.UnixMilli(createdAt)
+	return v, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/inngest/inngest/pkg/cqrs"
 	dbpkg "github.com/inngest/inngest/pkg/db"
 	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/exechttp"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -143,6 +144,14 @@ func domainApp(obj *dbpkg.App) *cqrs.App {
 	metadata := map[string]string{}
 	_ = json.Unmarshal([]byte(obj.Metadata), &metadata)
 
+	var tlsConfig *exechttp.TLSConfig
+	if obj.TlsConfig.Valid {
+		tlsConfig = &exechttp.TLSConfig{}
+		if err := json.Unmarshal([]byte(obj.TlsConfig.String), tlsConfig); err != nil {
+			tlsConfig = nil
+		}
+	}
+
 	return &cqrs.App{
 		ID:          obj.ID,
 		Name:        obj.Name,
@@ -158,6 +167,7 @@ func domainApp(obj *dbpkg.App) *cqrs.App {
 		Url:         obj.Url,
 		Method:      obj.Method,
 		AppVersion:  appVersion,
+		TLS:         tlsConfig,
 	}
 }
 
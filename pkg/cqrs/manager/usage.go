@@ -0,0 +1,589 @@
+package manager
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	sq "github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/cqrs"
+	dbpkg "github.com/inngest/inngest/pkg/db"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngest/pkg/usage"
+)
+
+const (
+	// usageRollupDelay is how long after an hour ends before its usage is
+	// rolled up, leaving time for late spans and events to be written.
+	usageRollupDelay = 5 * time.Minute
+	// usageRollupBatch is the maximum number of hours aggregated at once
+	// when rolling up usage, or when reading hours which aren't rolled up.
+	usageRollupBatch = 24
+	// usageRunLookupBatch is the maximum number of runs looked up at once when
+	// finding the triggering event of steps whose run started earlier.
+	usageRunLookupBatch = 500
+)
+
+// usageKey identifies a single row of usage.  bucket is the start of the
+// bucket in unix milliseconds.
+type usageKey struct {
+	bucket     int64
+	appID      string
+	functionID string
+	eventName  string
+}
+
+// usageTotals accumulates usage by bucket, app, function and event name.
+type usageTotals map[usageKey]*cqrs.Usage
+
+func (t usageTotals) add(k usageKey, u cqrs.Usage) {
+	row, ok := t[k]
+	if !ok {
+		row = &cqrs.Usage{}
+		t[k] = row
+	}
+	row.Add(u)
+}
+
+// rebucket returns the totals grouped into buckets of the given period.
+func (t usageTotals) rebucket(p enums.Period) usageTotals {
+	res := usageTotals{}
+	for k, u := range t {
+		k.bucket = usage.Truncate(time.UnixMilli(k.bucket), p).UnixMilli()
+		res.add(k, *u)
+	}
+	return res
+}
+
+// rows returns the totals ordered by bucket, app, function and event name.
+func (t usageTotals) rows() []*cqrs.Usage {
+	keys := make([]usageKey, 0, len(t))
+	for k := range t {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b usageKey) int {
+		if c := cmp.Compare(a.bucket, b.bucket); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.appID, b.appID); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.functionID, b.functionID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.eventName, b.eventName)
+	})
+
+	res := make([]*cqrs.Usage, 0, len(keys))
+	for _, k := range keys {
+		u := *t[k]
+		u.Bucket = time.UnixMilli(k.bucket).UTC()
+		u.AppID, _ = uuid.Parse(k.appID)
+		u.FunctionID, _ = uuid.Parse(k.functionID)
+		u.EventName = k.eventName
+		res = append(res, &u)
+	}
+	return res
+}
+
+// GetUsageTotals returns usage totals bucketed by the given period.  Usage for
+// hours which have ended is read from hourly rollups, which are stored by
+// RollupUsage.  Ended hours which haven't been rolled up yet, the current hour
+// and minute buckets are computed from spans and events.  Reading usage never
+// stores rollups.
+func (w wrapper) GetUsageTotals(ctx context.Context, opt cqrs.GetUsageTotalsOpt) ([]*cqrs.Usage, error) {
+	from, until := opt.From.UTC(), opt.Until.UTC()
+	if !from.Before(until) {
+		return []*cqrs.Usage{}, nil
+	}
+
+	totals := usageTotals{}
+	if opt.Period == enums.PeriodMinute {
+		if err := w.aggregateUsage(ctx, totals, from, until, enums.PeriodMinute); err != nil {
+			return nil, err
+		}
+		return totals.rows(), nil
+	}
+
+	start := usage.Truncate(from, enums.PeriodHour)
+	rolled := usage.Truncate(time.Now().Add(-usageRollupDelay), enums.PeriodHour)
+	if end := usage.Truncate(until, enums.PeriodHour); end.Before(rolled) {
+		rolled = end
+	}
+	if !rolled.After(start) {
+		rolled = start
+	}
+
+	if rolled.After(start) {
+		done, err := w.rolledUsageHours(ctx, start, rolled)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.loadUsageRollups(ctx, totals, start, rolled); err != nil {
+			return nil, err
+		}
+		for _, hours := range missingUsageHours(start, rolled, done) {
+			if err := w.aggregateUsage(ctx, totals, hours[0], hours[len(hours)-1].Add(time.Hour), enums.PeriodHour); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if until.After(rolled) {
+		if err := w.aggregateUsage(ctx, totals, rolled, until, enums.PeriodHour); err != nil {
+			return nil, err
+		}
+	}
+
+	return totals.rebucket(opt.Period).rows(), nil
+}
+
+// RollupUsage computes and stores hourly rollups for every hour between from
+// and until that has not already been rolled up.  Hours which ended within
+// usageRollupDelay of until are skipped.
+func (w wrapper) RollupUsage(ctx context.Context, from, until time.Time) error {
+	start := usage.Truncate(from.UTC(), enums.PeriodHour)
+	end := usage.Truncate(until.UTC().Add(-usageRollupDelay), enums.PeriodHour)
+	if !end.After(start) {
+		return nil
+	}
+
+	done, err := w.rolledUsageHours(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	for _, hours := range missingUsageHours(start, end, done) {
+		if err := w.rollupUsageHours(ctx, hours); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rolledUsageHours returns the start, in unix milliseconds, of every hour
+// between from and until which has been rolled up.
+func (w wrapper) rolledUsageHours(ctx context.Context, from, until time.Time) (map[int64]bool, error) {
+	query, args, err := sq.Dialect(w.dialect()).
+		From("usage_rollup_buckets").
+		Select("bucket").
+		Where(
+			sq.C("bucket").Gte(from.UnixMilli()),
+			sq.C("bucket").Lt(until.UnixMilli()),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	done := map[int64]bool{}
+	for rows.Next() {
+		var bucket int64
+		if err := rows.Scan(&bucket); err != nil {
+			return nil, err
+		}
+		done[bucket] = true
+	}
+	return done, rows.Err()
+}
+
+// missingUsageHours returns the hours between from and until which haven't
+// been rolled up, grouped into runs of at most usageRollupBatch consecutive
+// hours so that each run can be aggregated at once.
+func missingUsageHours(from, until time.Time, done map[int64]bool) [][]time.Time {
+	var (
+		res   [][]time.Time
+		batch []time.Time
+	)
+	for h := from; h.Before(until); h = h.Add(time.Hour) {
+		if done[h.UnixMilli()] {
+			if len(batch) > 0 {
+				res = append(res, batch)
+			}
+			batch = nil
+			continue
+		}
+		batch = append(batch, h)
+		if len(batch) == usageRollupBatch {
+			res = append(res, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		res = append(res, batch)
+	}
+	return res
+}
+
+// rollupUsageHours computes and stores rollups for consecutive hours.
+func (w wrapper) rollupUsageHours(ctx context.Context, hours []time.Time) error {
+	totals := usageTotals{}
+	from, until := hours[0], hours[len(hours)-1].Add(time.Hour)
+	if err := w.aggregateUsage(ctx, totals, from, until, enums.PeriodHour); err != nil {
+		return err
+	}
+
+	tx, err := w.adapter.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	q := tx.Q()
+
+	now := time.Now().UnixMilli()
+	for _, h := range hours {
+		err := q.InsertUsageRollupBucket(ctx, dbpkg.InsertUsageRollupBucketParams{
+			Bucket:    h.UnixMilli(),
+			CreatedAt: now,
+		})
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+	}
+	for k, u := range totals {
+		err := q.UpsertUsageRollup(ctx, dbpkg.UpsertUsageRollupParams{
+			Bucket:         k.bucket,
+			AppID:          k.appID,
+			FunctionID:     k.functionID,
+			EventName:      k.eventName,
+			Runs:           u.Runs,
+			Steps:          u.Steps,
+			Events:         u.Events,
+			StepDurationMs: u.StepDuration.Milliseconds(),
+		})
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// loadUsageRollups adds the hourly rollups between from and until to totals.
+func (w wrapper) loadUsageRollups(ctx context.Context, totals usageTotals, from, until time.Time) error {
+	query, args, err := sq.Dialect(w.dialect()).
+		From("usage_rollups").
+		Select("bucket", "app_id", "function_id", "event_name", "runs", "steps", "events", "step_duration_ms").
+		Where(
+			sq.C("bucket").Gte(from.UnixMilli()),
+			sq.C("bucket").Lt(until.UnixMilli()),
+		).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			k          usageKey
+			u          cqrs.Usage
+			durationMs int64
+		)
+		if err := rows.Scan(&k.bucket, &k.appID, &k.functionID, &k.eventName, &u.Runs, &u.Steps, &u.Events, &durationMs); err != nil {
+			return err
+		}
+		u.StepDuration = time.Duration(durationMs) * time.Millisecond
+		totals.add(k, u)
+	}
+	return rows.Err()
+}
+
+// usageSpan is a step or execution span read when aggregating usage.
+type usageSpan struct {
+	runID      string
+	name       string
+	appID      string
+	functionID string
+	startTime  time.Time
+	endTime    time.Time
+}
+
+// aggregateUsage adds the usage between from and until to totals, computed
+// from spans and events and bucketed by the given period.
+func (w wrapper) aggregateUsage(ctx context.Context, totals usageTotals, from, until time.Time, p enums.Period) error {
+	bucket := func(t time.Time) int64 {
+		return usage.Truncate(t, p).UnixMilli()
+	}
+
+	runEvents, err := w.aggregateUsageRuns(ctx, totals, from, until, bucket)
+	if err != nil {
+		return err
+	}
+
+	spans, err := w.loadUsageSpans(ctx, from, until)
+	if err != nil {
+		return err
+	}
+
+	// Steps are attributed to the event which triggered their run, which may
+	// have started before the time range.
+	missing := []string{}
+	for _, s := range spans {
+		if _, ok := runEvents[s.runID]; !ok {
+			runEvents[s.runID] = ""
+			missing = append(missing, s.runID)
+		}
+	}
+	if err := w.loadUsageRunEvents(ctx, runEvents, missing); err != nil {
+		return err
+	}
+
+	for _, s := range spans {
+		k := usageKey{
+			bucket:     bucket(s.startTime),
+			appID:      s.appID,
+			functionID: s.functionID,
+			eventName:  runEvents[s.runID],
+		}
+		switch s.name {
+		case meta.SpanNameStep:
+			totals.add(k, cqrs.Usage{Steps: 1})
+		case meta.SpanNameExecution:
+			if d := s.endTime.Sub(s.startTime); d > 0 {
+				totals.add(k, cqrs.Usage{StepDuration: d})
+			}
+		}
+	}
+
+	return w.aggregateUsageEvents(ctx, totals, from, until, bucket)
+}
+
+// aggregateUsageRuns adds the runs started between from and until to totals,
+// returning the triggering event name of each run.
+func (w wrapper) aggregateUsageRuns(
+	ctx context.Context,
+	totals usageTotals,
+	from, until time.Time,
+	bucket func(time.Time) int64,
+) (map[string]string, error) {
+	h := w.helpers()
+
+	query, args, err := sq.Dialect(h.GoquDialect()).
+		From("spans").
+		Select(
+			"run_id",
+			"app_id",
+			"function_id",
+			"start_time",
+			sq.L("CAST(attributes AS TEXT)").As("attributes"),
+		).
+		Where(
+			sq.C("name").Eq(meta.SpanNameRun),
+			sq.C("debug_run_id").IsNull(),
+			sq.Or(
+				sq.C("status").IsNull(),
+				sq.C("status").Neq(enums.RunStatusSkipped.String()),
+			),
+			sq.C("start_time").Gte(from.UTC()),
+			sq.C("start_time").Lt(until.UTC()),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runEvents := map[string]string{}
+	for rows.Next() {
+		var (
+			runID, appID, functionID, startTime string
+			attributes                          *string
+		)
+		if err := rows.Scan(&runID, &appID, &functionID, &startTime, &attributes); err != nil {
+			return nil, err
+		}
+		if _, ok := runEvents[runID]; ok {
+			continue
+		}
+
+		start, err := h.ParseTime(startTime)
+		if err != nil {
+			logger.StdlibLogger(ctx).Debug("invalid start_time", "start_time", startTime, "error", err)
+			continue
+		}
+
+		eventName, _ := stringAttribute(decodeSpanAttributes(attributes), meta.Attrs.TriggeringEventName.Key())
+		runEvents[runID] = eventName
+		totals.add(usageKey{
+			bucket:     bucket(start),
+			appID:      appID,
+			functionID: functionID,
+			eventName:  eventName,
+		}, cqrs.Usage{Runs: 1})
+	}
+	return runEvents, rows.Err()
+}
+
+// loadUsageSpans returns the step and execution spans started between from
+// and until.  Each span's end time is the latest end time of any of its
+// dynamic extensions.
+func (w wrapper) loadUsageSpans(ctx context.Context, from, until time.Time) ([]usageSpan, error) {
+	h := w.helpers()
+
+	query, args, err := sq.Dialect(h.GoquDialect()).
+		From("spans").
+		Select(
+			"run_id",
+			"dynamic_span_id",
+			"name",
+			"app_id",
+			"function_id",
+			"start_time",
+			sq.L(`COALESCE((SELECT MAX(s2.end_time) FROM spans s2
+				WHERE s2.run_id = spans.run_id
+					AND s2.dynamic_span_id = spans.dynamic_span_id), spans.end_time)`).As("end_time"),
+		).
+		Where(
+			sq.C("name").In(meta.SpanNameStep, meta.SpanNameExecution),
+			sq.C("debug_run_id").IsNull(),
+			sq.C("start_time").Gte(from.UTC()),
+			sq.C("start_time").Lt(until.UTC()),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Spans are deduplicated per run, as dynamic span IDs are derived from
+	// step IDs and are shared by every run of a function.
+	type spanKey struct{ runID, name, dynamicSpanID string }
+	seen := map[spanKey]bool{}
+	spans := []usageSpan{}
+	for rows.Next() {
+		var (
+			s                  usageSpan
+			dynamicSpanID      *string
+			startTime, endTime string
+		)
+		if err := rows.Scan(&s.runID, &dynamicSpanID, &s.name, &s.appID, &s.functionID, &startTime, &endTime); err != nil {
+			return nil, err
+		}
+		if dynamicSpanID != nil && *dynamicSpanID != "" {
+			key := spanKey{runID: s.runID, name: s.name, dynamicSpanID: *dynamicSpanID}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+
+		if s.startTime, err = h.ParseTime(startTime); err != nil {
+			logger.StdlibLogger(ctx).Debug("invalid start_time", "start_time", startTime, "error", err)
+			continue
+		}
+		if s.endTime, err = h.ParseTime(endTime); err != nil {
+			s.endTime = s.startTime
+		}
+		spans = append(spans, s)
+	}
+	return spans, rows.Err()
+}
+
+// loadUsageRunEvents sets the triggering event name of each of the given runs.
+func (w wrapper) loadUsageRunEvents(ctx context.Context, runEvents map[string]string, runIDs []string) error {
+	for batch := range slices.Chunk(runIDs, usageRunLookupBatch) {
+		query, args, err := sq.Dialect(w.dialect()).
+			From("spans").
+			Select(
+				"run_id",
+				sq.L("CAST(attributes AS TEXT)").As("attributes"),
+			).
+			Where(
+				sq.C("run_id").In(batch),
+				sq.C("name").Eq(meta.SpanNameRun),
+				sq.C("debug_run_id").IsNull(),
+			).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var (
+				runID      string
+				attributes *string
+			)
+			if err := rows.Scan(&runID, &attributes); err != nil {
+				rows.Close()
+				return err
+			}
+			if eventName, ok := stringAttribute(decodeSpanAttributes(attributes), meta.Attrs.TriggeringEventName.Key()); ok {
+				runEvents[runID] = eventName
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aggregateUsageEvents adds the events received between from and until to
+// totals.
+func (w wrapper) aggregateUsageEvents(
+	ctx context.Context,
+	totals usageTotals,
+	from, until time.Time,
+	bucket func(time.Time) int64,
+) error {
+	query, args, err := sq.Dialect(w.dialect()).
+		From("events").
+		Select("event_name", "received_at").
+		Where(
+			sq.C("received_at").Gte(from),
+			sq.C("received_at").Lt(until),
+		).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+
+	rows, err := w.adapter.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			name       string
+			receivedAt time.Time
+		)
+		if err := rows.Scan(&name, &receivedAt); err != nil {
+			return err
+		}
+		totals.add(usageKey{bucket: bucket(receivedAt), eventName: name}, cqrs.Usage{Events: 1})
+	}
+	return rows.Err()
+}
@@ -380,6 +380,9 @@ type TraceRun struct {
 	HasAI        bool            `json:"has_ai"`
 	BatchID      *ulid.ULID      `json:"batch_id,omitempty"`
 	CronSchedule *string         `json:"cron_schedule,omitempty"`
+	// FunctionVersion is the version of the function's config the run was
+	// scheduled with, or zero if unknown.
+	FunctionVersion int `json:"function_version,omitempty"`
 	// Cursor is a composite cursor used for pagination
 	Cursor string `json:"cursor"`
 
@@ -508,7 +511,10 @@ type GetTraceRunFilter struct {
 	Until        time.Time
 	Status       []enums.RunStatus
 	CEL          string
-	IsDeferred   *bool
+	// Search is a full-text query matched against event data, step outputs
+	// and errors.  Every term must match.
+	Search     string
+	IsDeferred *bool
 }
 
 type GetTraceRunOrder struct {
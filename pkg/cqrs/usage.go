@@ -0,0 +1,82 @@
+package cqrs
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+)
+
+type UsageReadWriter interface {
+	UsageReader
+	UsageWriter
+}
+
+// UsageReader reports how much work a deployment has done, for charging usage
+// back to the teams that own each app.
+type UsageReader interface {
+	// GetUsageTotals returns usage totals within the given time range, one
+	// row per bucket, app, function and event name.  Rows are ordered by
+	// bucket, then by app, function and event name.
+	GetUsageTotals(ctx context.Context, opt GetUsageTotalsOpt) ([]*Usage, error)
+}
+
+// UsageWriter stores hourly usage rollups, so that reading usage for hours
+// which have ended doesn't need to aggregate their spans and events.
+type UsageWriter interface {
+	// RollupUsage computes and stores rollups for every hour between from
+	// and until which hasn't already been rolled up.  Hours are only rolled
+	// up once they ended long enough before until for late spans and events
+	// to have been written.
+	RollupUsage(ctx context.Context, from, until time.Time) error
+}
+
+type GetUsageTotalsOpt struct {
+	// Period is the size of each bucket.
+	Period enums.Period
+	// From is the start of the first bucket, inclusive.
+	From time.Time
+	// Until is the end of the time range, exclusive.
+	Until time.Time
+}
+
+// Usage is the work done within a single bucket for an app, function and event
+// name.  Runs and steps are attributed to the app and function they ran in
+// and the name of the event which triggered the run.  Events are attributed to
+// their name only, with a zero app and function ID.
+type Usage struct {
+	// Bucket is the start of the period that the usage was recorded in.
+	Bucket     time.Time `json:"bucket"`
+	AppID      uuid.UUID `json:"app_id"`
+	FunctionID uuid.UUID `json:"function_id"`
+	EventName  string    `json:"event_name"`
+
+	// Runs is the number of runs started.
+	Runs int64 `json:"runs"`
+	// Steps is the number of steps started.
+	Steps int64 `json:"steps"`
+	// Events is the number of events received.
+	Events int64 `json:"events"`
+	// StepDuration is the total time spent executing function code, summed
+	// over every request made to the SDK.
+	StepDuration time.Duration `json:"step_duration"`
+}
+
+// Add adds the totals of b to u.
+func (u *Usage) Add(b Usage) {
+	u.Runs += b.Runs
+	u.Steps += b.Steps
+	u.Events += b.Events
+	u.StepDuration += b.StepDuration
+}
+
+// TotalUsage sums the totals of every row.  The returned usage has no bucket
+// or dimensions.
+func TotalUsage(rows []*Usage) Usage {
+	total := Usage{}
+	for _, u := range rows {
+		total.Add(*u)
+	}
+	return total
+}
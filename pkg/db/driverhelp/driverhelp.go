@@ -31,6 +31,10 @@ type DialectHelpers interface {
 	// EventIDsContain filters a span JSON event_ids column by one or more IDs.
 	EventIDsContain(ids []string) sqexp.Expression
 
+	// RunSearchMatch filters run_search rows to those whose indexed event
+	// data, output or error contains every term in the full-text query.
+	RunSearchMatch(query string) sqexp.Expression
+
 	// RunOutputExpr selects the preferred function output for a run page row.
 	RunOutputExpr() sqexp.Expression
 
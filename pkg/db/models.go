@@ -37,6 +37,7 @@ type App struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
 }
 
 // Event represents an ingested event.
@@ -22,6 +22,7 @@ type UpsertAppParams struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
 }
 
 // UpdateAppErrorParams are the parameters for updating an app's error field.
@@ -277,6 +278,58 @@ type GetWorkerConnectionParams struct {
 	ConnectionID ulid.ULID
 }
 
+// InsertAuditLogParams are the parameters for appending an audit log entry.
+type InsertAuditLogParams struct {
+	ID        ulid.ULID
+	CreatedAt int64
+	Actor     string
+	Source    string
+	Action    string
+	Target    string
+	Payload   sql.NullString
+	Status    int64
+}
+
+// InsertRunSearchDocumentParams are the parameters for adding a span's event
+// data, output or error to the full-text run search index.
+type InsertRunSearchDocumentParams struct {
+	RunID   string
+	SpanID  string
+	Kind    string
+	Content string
+}
+
+// UpsertFunctionVersionParams are the parameters for recording a version of a
+// function's config.
+type UpsertFunctionVersionParams struct {
+	FunctionID uuid.UUID
+	Version    int64
+	AppID      uuid.UUID
+	SyncID     uuid.UUID
+	Config     string
+	CreatedAt  int64
+}
+
+// UpsertUsageRollupParams are the parameters for recording an hour of usage
+// for an app, function and event name.
+type UpsertUsageRollupParams struct {
+	Bucket         int64
+	AppID          string
+	FunctionID     string
+	EventName      string
+	Runs           int64
+	Steps          int64
+	Events         int64
+	StepDurationMs int64
+}
+
+// InsertUsageRollupBucketParams are the parameters for marking an hour as
+// rolled up.
+type InsertUsageRollupBucketParams struct {
+	Bucket    int64
+	CreatedAt int64
+}
+
 // GetTraceSpansParams are the parameters for querying trace spans.
 type GetTraceSpansParams struct {
 	TraceID string
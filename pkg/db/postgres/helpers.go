@@ -41,6 +41,11 @@ func (h *helpers) EventIDsContain(ids []string) sqexp.Expression {
 	)
 }
 
+func (h *helpers) RunSearchMatch(query string) sqexp.Expression {
+	// plainto_tsquery ignores operators in user input and ANDs each term.
+	return sq.L("content_tsv @@ plainto_tsquery('simple', ?)", query)
+}
+
 func (h *helpers) RunOutputExpr() sqexp.Expression {
 	return sq.L(`COALESCE((
 		SELECT CAST(output_lookup.output AS TEXT)
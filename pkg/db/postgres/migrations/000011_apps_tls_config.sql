@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- JSON-encoded per-app TLS config (CA bundle, client cert/key, server name)
+-- used when calling the app. NULL uses the default TLS config.
+ALTER TABLE apps ADD COLUMN tls_config character varying;
+
+-- +goose Down
+
+ALTER TABLE apps DROP COLUMN tls_config;
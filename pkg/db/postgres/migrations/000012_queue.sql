@@ -0,0 +1,58 @@
+-- +goose Up
+
+-- Queue items for the postgres queue backend.  Items are ready to be peeked
+-- when lease_id is NULL, ordered by score_ms within their partition.  Leased
+-- items carry lease_until_ms so that the scavenger can find expired leases.
+CREATE TABLE queue_items (
+    shard character varying NOT NULL,
+    id character varying NOT NULL,
+    partition_id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    function_id character varying NOT NULL,
+    run_id character varying NOT NULL,
+    kind character varying NOT NULL,
+    score_ms bigint NOT NULL,
+    lease_id character varying,
+    lease_until_ms bigint,
+    concurrency_key_1 character varying,
+    concurrency_key_2 character varying,
+    item bytea NOT NULL,
+    PRIMARY KEY (shard, id)
+);
+
+CREATE INDEX idx_queue_items_ready ON queue_items (shard, partition_id, score_ms) WHERE lease_id IS NULL;
+CREATE INDEX idx_queue_items_leased ON queue_items (shard, lease_until_ms) WHERE lease_id IS NOT NULL;
+CREATE INDEX idx_queue_items_run_id ON queue_items (shard, run_id);
+
+-- Queue partitions, scored by at_s (in seconds) as the next time the
+-- partition should be peeked.
+CREATE TABLE queue_partitions (
+    shard character varying NOT NULL,
+    id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    at_s bigint NOT NULL,
+    partition bytea NOT NULL,
+    PRIMARY KEY (shard, id)
+);
+
+CREATE INDEX idx_queue_partitions_at ON queue_partitions (shard, at_s);
+CREATE INDEX idx_queue_partitions_account_at ON queue_partitions (shard, account_id, at_s);
+
+-- Small keyed values used by the queue: idempotency keys, singletons,
+-- debounces, migration locks, throttles, and role/shard leases.  Rows with
+-- an expires_at_ms in the past are treated as absent.
+CREATE TABLE queue_kv (
+    shard character varying NOT NULL,
+    key character varying NOT NULL,
+    value character varying NOT NULL,
+    expires_at_ms bigint,
+    PRIMARY KEY (shard, key)
+);
+
+CREATE INDEX idx_queue_kv_expires_at ON queue_kv (shard, expires_at_ms) WHERE expires_at_ms IS NOT NULL;
+
+-- +goose Down
+
+DROP TABLE queue_kv;
+DROP TABLE queue_partitions;
+DROP TABLE queue_items;
@@ -0,0 +1,70 @@
+-- +goose Up
+
+-- Run state for the postgres state store.  Each run has a single row holding
+-- its metadata, triggering events and size counters.  Counters are kept in
+-- their own columns so that they can be incremented atomically.
+CREATE TABLE run_state (
+    run_id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    function_id character varying NOT NULL,
+    status integer NOT NULL,
+    metadata bytea NOT NULL,
+    events bytea NOT NULL,
+    state_size bigint DEFAULT 0 NOT NULL,
+    event_size bigint DEFAULT 0 NOT NULL,
+    step_count bigint DEFAULT 0 NOT NULL,
+    metadata_size bigint DEFAULT 0 NOT NULL,
+    defer_input_size bigint DEFAULT 0 NOT NULL,
+    PRIMARY KEY (run_id)
+);
+
+-- Step outputs and inputs for a run.  stack_index is set once a step's
+-- output is saved, recording the order in which steps completed.
+CREATE TABLE run_state_steps (
+    run_id character varying NOT NULL,
+    step_id character varying NOT NULL,
+    output bytea,
+    input bytea,
+    stack_index integer,
+    PRIMARY KEY (run_id, step_id)
+);
+
+CREATE UNIQUE INDEX idx_run_state_steps_stack ON run_state_steps (run_id, stack_index) WHERE stack_index IS NOT NULL;
+
+-- Step IDs which have been scheduled but have not yet saved a response.
+CREATE TABLE run_state_pending_steps (
+    run_id character varying NOT NULL,
+    step_id character varying NOT NULL,
+    PRIMARY KEY (run_id, step_id)
+);
+
+CREATE TABLE run_state_defers (
+    run_id character varying NOT NULL,
+    hashed_id character varying NOT NULL,
+    fn_slug character varying NOT NULL,
+    schedule_status integer NOT NULL,
+    input bytea,
+    meta bytea,
+    PRIMARY KEY (run_id, hashed_id)
+);
+
+-- Account-scoped keys which outlive a run's state: function idempotency keys
+-- and finalization claims.  Rows with an expires_at_ms in the past are
+-- treated as absent.
+CREATE TABLE run_state_kv (
+    account_id character varying NOT NULL,
+    key character varying NOT NULL,
+    value character varying NOT NULL,
+    expires_at_ms bigint NOT NULL,
+    PRIMARY KEY (account_id, key)
+);
+
+CREATE INDEX idx_run_state_kv_expires_at ON run_state_kv (account_id, expires_at_ms);
+
+-- +goose Down
+
+DROP TABLE run_state_kv;
+DROP TABLE run_state_defers;
+DROP TABLE run_state_pending_steps;
+DROP TABLE run_state_steps;
+DROP TABLE run_state;
@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Append-only log of mutating operator actions made via the APIs.  created_at
+-- is in unix milliseconds; ids are ULIDs, so ordering by id orders by time.
+CREATE TABLE audit_logs (
+    id character(26) NOT NULL,
+    created_at bigint NOT NULL,
+    actor character varying NOT NULL,
+    source character varying NOT NULL,
+    action character varying NOT NULL,
+    target character varying NOT NULL,
+    payload text,
+    status integer NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX idx_audit_logs_actor_created_at ON audit_logs (actor, created_at);
+
+-- +goose Down
+
+DROP TABLE audit_logs;
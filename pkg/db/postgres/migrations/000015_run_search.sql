@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- Full-text index over event payloads, step outputs and errors, written as
+-- spans are exported.  A run may have many documents; search matches any.
+-- The 'simple' configuration is used as payloads are rarely natural language,
+-- so stemming and stop words would drop IDs and keys.
+CREATE TABLE run_search (
+    run_id character(26) NOT NULL,
+    span_id text NOT NULL,
+    kind character varying NOT NULL,
+    content text NOT NULL,
+    content_tsv tsvector GENERATED ALWAYS AS (to_tsvector('simple'::regconfig, content)) STORED
+);
+
+CREATE INDEX idx_run_search_run_id ON run_search (run_id);
+CREATE INDEX idx_run_search_content_tsv ON run_search USING gin (content_tsv);
+
+-- +goose Down
+
+DROP TABLE run_search;
@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- Every config a function has been synced with, keyed by the function's
+-- version.  sync_id is the app sync which recorded the version; created_at is
+-- in unix milliseconds.
+CREATE TABLE function_versions (
+    function_id character(36) NOT NULL,
+    version integer NOT NULL,
+    app_id character(36) NOT NULL,
+    sync_id character(36) NOT NULL,
+    config character varying NOT NULL,
+    created_at bigint NOT NULL,
+    PRIMARY KEY (function_id, version)
+);
+
+-- +goose Down
+
+DROP TABLE function_versions;
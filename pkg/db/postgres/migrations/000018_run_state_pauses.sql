@@ -0,0 +1,33 @@
+-- +goose Up
+
+-- Pauses for the postgres state store.  event_name is empty for pauses which
+-- are not matched by event name, such as invoke pauses.  created_at_s mirrors
+-- the Redis pause index score, and rows with an expires_at_ms in the past are
+-- treated as absent.
+CREATE TABLE run_state_pauses (
+    pause_id character varying NOT NULL,
+    workspace_id character varying NOT NULL,
+    run_id character varying NOT NULL,
+    event_name character varying NOT NULL,
+    data bytea NOT NULL,
+    created_at_s bigint NOT NULL,
+    expires_at_ms bigint NOT NULL,
+    PRIMARY KEY (pause_id)
+);
+
+CREATE INDEX idx_run_state_pauses_event ON run_state_pauses (workspace_id, event_name, created_at_s, pause_id);
+CREATE INDEX idx_run_state_pauses_run ON run_state_pauses (run_id);
+
+-- Invoke correlation IDs and signal IDs, mapped to the pause waiting on them.
+CREATE TABLE run_state_pause_lookups (
+    workspace_id character varying NOT NULL,
+    kind character varying NOT NULL,
+    lookup_key character varying NOT NULL,
+    pause_id character varying NOT NULL,
+    PRIMARY KEY (workspace_id, kind, lookup_key)
+);
+
+-- +goose Down
+
+DROP TABLE run_state_pause_lookups;
+DROP TABLE run_state_pauses;
@@ -0,0 +1,52 @@
+-- +goose Up
+
+-- Key queues for the postgres queue backend.  Items with a backlog_id are held
+-- in that backlog until they're refilled into their partition, and are not
+-- ready to be peeked from the partition until then.
+ALTER TABLE queue_items ADD COLUMN backlog_id character varying;
+
+DROP INDEX idx_queue_items_ready;
+CREATE INDEX idx_queue_items_ready ON queue_items (shard, partition_id, score_ms) WHERE lease_id IS NULL AND backlog_id IS NULL;
+CREATE INDEX idx_queue_items_backlog ON queue_items (shard, backlog_id, score_ms) WHERE backlog_id IS NOT NULL;
+
+-- Shadow partitions, scored by at_ms as the next time the partition's
+-- backlogs should be refilled.
+CREATE TABLE queue_shadow_partitions (
+    shard character varying NOT NULL,
+    id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    at_ms bigint NOT NULL,
+    shadow_partition bytea NOT NULL,
+    PRIMARY KEY (shard, id)
+);
+
+CREATE INDEX idx_queue_shadow_partitions_at ON queue_shadow_partitions (shard, at_ms);
+CREATE INDEX idx_queue_shadow_partitions_account_at ON queue_shadow_partitions (shard, account_id, at_ms);
+
+-- Backlogs within a shadow partition, scored by at_ms.  Backlogs which are
+-- being normalized have normalize_at_ms set, and are not refilled until all of
+-- their items have been moved to their new backlogs.
+CREATE TABLE queue_backlogs (
+    shard character varying NOT NULL,
+    id character varying NOT NULL,
+    shadow_partition_id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    at_ms bigint NOT NULL,
+    normalize_at_ms bigint,
+    backlog bytea NOT NULL,
+    PRIMARY KEY (shard, id)
+);
+
+CREATE INDEX idx_queue_backlogs_at ON queue_backlogs (shard, shadow_partition_id, at_ms) WHERE normalize_at_ms IS NULL;
+CREATE INDEX idx_queue_backlogs_normalize_at ON queue_backlogs (shard, normalize_at_ms) WHERE normalize_at_ms IS NOT NULL;
+
+-- +goose Down
+
+DROP TABLE queue_backlogs;
+DROP TABLE queue_shadow_partitions;
+
+DROP INDEX idx_queue_items_backlog;
+DROP INDEX idx_queue_items_ready;
+CREATE INDEX idx_queue_items_ready ON queue_items (shard, partition_id, score_ms) WHERE lease_id IS NULL;
+
+ALTER TABLE queue_items DROP COLUMN backlog_id;
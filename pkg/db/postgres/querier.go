@@ -110,6 +110,7 @@ func (pq *pgQuerier) UpsertApp(ctx context.Context, arg db.UpsertAppParams) (*db
 		SdkVersion: arg.SdkVersion, Framework: arg.Framework, Metadata: arg.Metadata,
 		Status: arg.Status, Error: arg.Error, Checksum: arg.Checksum,
 		Url: arg.Url, Method: arg.Method, AppVersion: arg.AppVersion,
+		TlsConfig: arg.TlsConfig,
 	})
 	if err != nil {
 		return nil, err
@@ -131,6 +132,7 @@ func (pq *pgQuerier) UpsertAppByName(ctx context.Context, arg db.UpsertAppParams
 		Url:         arg.Url,
 		Method:      arg.Method,
 		AppVersion:  arg.AppVersion,
+		TlsConfig:   arg.TlsConfig,
 	})
 	if err != nil {
 		return nil, err
@@ -805,3 +807,45 @@ func (pq *pgQuerier) GetWorkerConnection(ctx context.Context, arg db.GetWorkerCo
 	}
 	return workerConnectionFromPG(r), nil
 }
+
+// --- Audit Logs ---
+
+func (pq *pgQuerier) InsertAuditLog(ctx context.Context, arg db.InsertAuditLogParams) error {
+	return pq.q.InsertAuditLog(ctx, sqlc.InsertAuditLogParams{
+		ID: arg.ID.String(), CreatedAt: arg.CreatedAt,
+		Actor: arg.Actor, Source: arg.Source, Action: arg.Action,
+		Target: arg.Target, Payload: arg.Payload, Status: int32(arg.Status),
+	})
+}
+
+// --- Run Search ---
+
+func (pq *pgQuerier) InsertRunSearchDocument(ctx context.Context, arg db.InsertRunSearchDocumentParams) error {
+	return pq.q.InsertRunSearchDocument(ctx, sqlc.InsertRunSearchDocumentParams{
+		RunID: arg.RunID, SpanID: arg.SpanID, Kind: arg.Kind, Content: arg.Content,
+	})
+}
+
+// --- Function Versions ---
+
+func (pq *pgQuerier) UpsertFunctionVersion(ctx context.Context, arg db.UpsertFunctionVersionParams) error {
+	return pq.q.UpsertFunctionVersion(ctx, sqlc.UpsertFunctionVersionParams{
+		FunctionID: arg.FunctionID, Version: int32(arg.Version), AppID: arg.AppID,
+		SyncID: arg.SyncID, Config: arg.Config, CreatedAt: arg.CreatedAt,
+	})
+}
+
+// --- Usage Rollups ---
+
+func (pq *pgQuerier) UpsertUsageRollup(ctx context.Context, arg db.UpsertUsageRollupParams) error {
+	return pq.q.UpsertUsageRollup(ctx, sqlc.UpsertUsageRollupParams{
+		Bucket: arg.Bucket, AppID: arg.AppID, FunctionID: arg.FunctionID, EventName: arg.EventName,
+		Runs: arg.Runs, Steps: arg.Steps, Events: arg.Events, StepDurationMs: arg.StepDurationMs,
+	})
+}
+
+func (pq *pgQuerier) InsertUsageRollupBucket(ctx context.Context, arg db.InsertUsageRollupBucketParams) error {
+	return pq.q.InsertUsageRollupBucket(ctx, sqlc.InsertUsageRollupBucketParams{
+		Bucket: arg.Bucket, CreatedAt: arg.CreatedAt,
+	})
+}
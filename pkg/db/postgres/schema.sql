@@ -35,7 +35,23 @@ CREATE TABLE public.apps (
     archived_at timestamp without time zone,
     url character varying NOT NULL,
     method character varying(32) DEFAULT 'serve'::character varying NOT NULL,
-    app_version character varying(128)
+    app_version character varying(128),
+    tls_config character varying
+);
+
+--
+-- Name: audit_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_logs (
+    id character(26) NOT NULL,
+    created_at bigint NOT NULL,
+    actor character varying NOT NULL,
+    source character varying NOT NULL,
+    action character varying NOT NULL,
+    target character varying NOT NULL,
+    payload text,
+    status integer NOT NULL
 );
 
 --
@@ -101,6 +117,19 @@ CREATE TABLE public.function_runs (
     cron character varying
 );
 
+--
+-- Name: function_versions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.function_versions (
+    function_id character(36) NOT NULL,
+    version integer NOT NULL,
+    app_id character(36) NOT NULL,
+    sync_id character(36) NOT NULL,
+    config character varying NOT NULL,
+    created_at bigint NOT NULL
+);
+
 --
 -- Name: functions; Type: TABLE; Schema: public; Owner: -
 --
@@ -179,6 +208,49 @@ CREATE TABLE public.migrations (
     dirty boolean NOT NULL
 );
 
+--
+-- Name: queue_items; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.queue_items (
+    shard character varying NOT NULL,
+    id character varying NOT NULL,
+    partition_id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    function_id character varying NOT NULL,
+    run_id character varying NOT NULL,
+    kind character varying NOT NULL,
+    score_ms bigint NOT NULL,
+    lease_id character varying,
+    lease_until_ms bigint,
+    concurrency_key_1 character varying,
+    concurrency_key_2 character varying,
+    item bytea NOT NULL
+);
+
+--
+-- Name: queue_kv; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.queue_kv (
+    shard character varying NOT NULL,
+    key character varying NOT NULL,
+    value character varying NOT NULL,
+    expires_at_ms bigint
+);
+
+--
+-- Name: queue_partitions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.queue_partitions (
+    shard character varying NOT NULL,
+    id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    at_s bigint NOT NULL,
+    partition bytea NOT NULL
+);
+
 --
 -- Name: queue_snapshot_chunks; Type: TABLE; Schema: public; Owner: -
 --
@@ -189,6 +261,106 @@ CREATE TABLE public.queue_snapshot_chunks (
     data bytea
 );
 
+--
+-- Name: run_search; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_search (
+    run_id character(26) NOT NULL,
+    span_id text NOT NULL,
+    kind character varying NOT NULL,
+    content text NOT NULL,
+    content_tsv tsvector GENERATED ALWAYS AS (to_tsvector('simple'::regconfig, content)) STORED
+);
+
+--
+-- Name: run_state; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state (
+    run_id character varying NOT NULL,
+    account_id character varying NOT NULL,
+    function_id character varying NOT NULL,
+    status integer NOT NULL,
+    metadata bytea NOT NULL,
+    events bytea NOT NULL,
+    state_size bigint DEFAULT 0 NOT NULL,
+    event_size bigint DEFAULT 0 NOT NULL,
+    step_count bigint DEFAULT 0 NOT NULL,
+    metadata_size bigint DEFAULT 0 NOT NULL,
+    defer_input_size bigint DEFAULT 0 NOT NULL
+);
+
+--
+-- Name: run_state_defers; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state_defers (
+    run_id character varying NOT NULL,
+    hashed_id character varying NOT NULL,
+    fn_slug character varying NOT NULL,
+    schedule_status integer NOT NULL,
+    input bytea,
+    meta bytea
+);
+
+--
+-- Name: run_state_kv; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state_kv (
+    account_id character varying NOT NULL,
+    key character varying NOT NULL,
+    value character varying NOT NULL,
+    expires_at_ms bigint NOT NULL
+);
+
+--
+-- Name: run_state_pause_lookups; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state_pause_lookups (
+    workspace_id character varying NOT NULL,
+    kind character varying NOT NULL,
+    lookup_key character varying NOT NULL,
+    pause_id character varying NOT NULL
+);
+
+--
+-- Name: run_state_pauses; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state_pauses (
+    pause_id character varying NOT NULL,
+    workspace_id character varying NOT NULL,
+    run_id character varying NOT NULL,
+    event_name character varying NOT NULL,
+    data bytea NOT NULL,
+    created_at_s bigint NOT NULL,
+    expires_at_ms bigint NOT NULL
+);
+
+--
+-- Name: run_state_pending_steps; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state_pending_steps (
+    run_id character varying NOT NULL,
+    step_id character varying NOT NULL
+);
+
+--
+-- Name: run_state_steps; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.run_state_steps (
+    run_id character varying NOT NULL,
+    step_id character varying NOT NULL,
+    output bytea,
+    input bytea,
+    stack_index integer
+);
+
 --
 -- Name: spans; Type: TABLE; Schema: public; Owner: -
 --
@@ -267,6 +439,30 @@ CREATE TABLE public.traces (
     run_id character(26)
 );
 
+--
+-- Name: usage_rollup_buckets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.usage_rollup_buckets (
+    bucket bigint NOT NULL,
+    created_at bigint NOT NULL
+);
+
+--
+-- Name: usage_rollups; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.usage_rollups (
+    bucket bigint NOT NULL,
+    app_id character varying NOT NULL,
+    function_id character varying NOT NULL,
+    event_name character varying NOT NULL,
+    runs bigint NOT NULL,
+    steps bigint NOT NULL,
+    events bigint NOT NULL,
+    step_duration_ms bigint NOT NULL
+);
+
 --
 -- Name: worker_connections; Type: TABLE; Schema: public; Owner: -
 --
@@ -307,6 +503,13 @@ CREATE TABLE public.worker_connections (
 ALTER TABLE ONLY public.apps
     ADD CONSTRAINT apps_pkey PRIMARY KEY (id);
 
+--
+-- Name: audit_logs audit_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_logs
+    ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
+
 --
 -- Name: event_batches event_batches_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -314,6 +517,13 @@ ALTER TABLE ONLY public.apps
 ALTER TABLE ONLY public.event_batches
     ADD CONSTRAINT event_batches_pkey PRIMARY KEY (id);
 
+--
+-- Name: function_versions function_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.function_versions
+    ADD CONSTRAINT function_versions_pkey PRIMARY KEY (function_id, version);
+
 --
 -- Name: functions functions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -335,6 +545,27 @@ ALTER TABLE ONLY public.goose_db_version
 ALTER TABLE ONLY public.migrations
     ADD CONSTRAINT migrations_pkey PRIMARY KEY (version);
 
+--
+-- Name: queue_items queue_items_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.queue_items
+    ADD CONSTRAINT queue_items_pkey PRIMARY KEY (shard, id);
+
+--
+-- Name: queue_kv queue_kv_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.queue_kv
+    ADD CONSTRAINT queue_kv_pkey PRIMARY KEY (shard, key);
+
+--
+-- Name: queue_partitions queue_partitions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.queue_partitions
+    ADD CONSTRAINT queue_partitions_pkey PRIMARY KEY (shard, id);
+
 --
 -- Name: queue_snapshot_chunks queue_snapshot_chunks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -342,6 +573,55 @@ ALTER TABLE ONLY public.migrations
 ALTER TABLE ONLY public.queue_snapshot_chunks
     ADD CONSTRAINT queue_snapshot_chunks_pkey PRIMARY KEY (snapshot_id, chunk_id);
 
+--
+-- Name: run_state run_state_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state
+    ADD CONSTRAINT run_state_pkey PRIMARY KEY (run_id);
+
+--
+-- Name: run_state_defers run_state_defers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state_defers
+    ADD CONSTRAINT run_state_defers_pkey PRIMARY KEY (run_id, hashed_id);
+
+--
+-- Name: run_state_kv run_state_kv_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state_kv
+    ADD CONSTRAINT run_state_kv_pkey PRIMARY KEY (account_id, key);
+
+--
+-- Name: run_state_pause_lookups run_state_pause_lookups_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state_pause_lookups
+    ADD CONSTRAINT run_state_pause_lookups_pkey PRIMARY KEY (workspace_id, kind, lookup_key);
+
+--
+-- Name: run_state_pauses run_state_pauses_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state_pauses
+    ADD CONSTRAINT run_state_pauses_pkey PRIMARY KEY (pause_id);
+
+--
+-- Name: run_state_pending_steps run_state_pending_steps_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state_pending_steps
+    ADD CONSTRAINT run_state_pending_steps_pkey PRIMARY KEY (run_id, step_id);
+
+--
+-- Name: run_state_steps run_state_steps_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.run_state_steps
+    ADD CONSTRAINT run_state_steps_pkey PRIMARY KEY (run_id, step_id);
+
 --
 -- Name: spans spans_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -356,6 +636,20 @@ ALTER TABLE ONLY public.spans
 ALTER TABLE ONLY public.trace_runs
     ADD CONSTRAINT trace_runs_pkey PRIMARY KEY (run_id);
 
+--
+-- Name: usage_rollup_buckets usage_rollup_buckets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.usage_rollup_buckets
+    ADD CONSTRAINT usage_rollup_buckets_pkey PRIMARY KEY (bucket);
+
+--
+-- Name: usage_rollups usage_rollups_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.usage_rollups
+    ADD CONSTRAINT usage_rollups_pkey PRIMARY KEY (bucket, app_id, function_id, event_name);
+
 --
 -- Name: worker_connections worker_connections_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -375,6 +669,18 @@ CREATE UNIQUE INDEX apps_name_unique_key ON public.apps USING btree (name) WHERE
 
 CREATE UNIQUE INDEX functions_app_id_slug_active_key ON public.functions USING btree (app_id, slug) WHERE (archived_at IS NULL);
 
+--
+-- Name: idx_audit_logs_actor_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_logs_actor_created_at ON public.audit_logs USING btree (actor, created_at);
+
+--
+-- Name: idx_audit_logs_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_logs_created_at ON public.audit_logs USING btree (created_at);
+
 --
 -- Name: idx_events_internal_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -429,6 +735,78 @@ CREATE INDEX idx_history_id ON public.history USING btree (id);
 
 CREATE INDEX idx_history_run_id_created ON public.history USING btree (run_id, created_at);
 
+--
+-- Name: idx_queue_items_leased; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_queue_items_leased ON public.queue_items USING btree (shard, lease_until_ms) WHERE (lease_id IS NOT NULL);
+
+--
+-- Name: idx_queue_items_ready; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_queue_items_ready ON public.queue_items USING btree (shard, partition_id, score_ms) WHERE (lease_id IS NULL);
+
+--
+-- Name: idx_queue_items_run_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_queue_items_run_id ON public.queue_items USING btree (shard, run_id);
+
+--
+-- Name: idx_queue_kv_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_queue_kv_expires_at ON public.queue_kv USING btree (shard, expires_at_ms) WHERE (expires_at_ms IS NOT NULL);
+
+--
+-- Name: idx_queue_partitions_account_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_queue_partitions_account_at ON public.queue_partitions USING btree (shard, account_id, at_s);
+
+--
+-- Name: idx_queue_partitions_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_queue_partitions_at ON public.queue_partitions USING btree (shard, at_s);
+
+--
+-- Name: idx_run_search_content_tsv; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_run_search_content_tsv ON public.run_search USING gin (content_tsv);
+
+--
+-- Name: idx_run_search_run_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_run_search_run_id ON public.run_search USING btree (run_id);
+
+--
+-- Name: idx_run_state_kv_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_run_state_kv_expires_at ON public.run_state_kv USING btree (account_id, expires_at_ms);
+
+--
+-- Name: idx_run_state_pauses_event; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_run_state_pauses_event ON public.run_state_pauses USING btree (workspace_id, event_name, created_at_s, pause_id);
+
+--
+-- Name: idx_run_state_pauses_run; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_run_state_pauses_run ON public.run_state_pauses USING btree (run_id);
+
+--
+-- Name: idx_run_state_steps_stack; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_run_state_steps_stack ON public.run_state_steps USING btree (run_id, stack_index) WHERE (stack_index IS NOT NULL);
+
 --
 -- Name: idx_spans_account_status_time; Type: INDEX; Schema: public; Owner: -
 --
@@ -29,6 +29,18 @@ type App struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
+}
+
+type AuditLog struct {
+	ID        string
+	CreatedAt int64
+	Actor     string
+	Source    string
+	Action    string
+	Target    string
+	Payload   sql.NullString
+	Status    int32
 }
 
 type Event struct {
@@ -88,6 +100,15 @@ type FunctionRun struct {
 	Cron            sql.NullString
 }
 
+type FunctionVersion struct {
+	FunctionID uuid.UUID
+	Version    int32
+	AppID      uuid.UUID
+	SyncID     uuid.UUID
+	Config     string
+	CreatedAt  int64
+}
+
 type GooseDbVersion struct {
 	ID        int32
 	VersionID int64
@@ -127,12 +148,111 @@ type Migration struct {
 	Dirty   bool
 }
 
+type QueueItem struct {
+	Shard           string
+	ID              string
+	PartitionID     string
+	AccountID       string
+	FunctionID      string
+	RunID           string
+	Kind            string
+	ScoreMs         int64
+	LeaseID         sql.NullString
+	LeaseUntilMs    sql.NullInt64
+	ConcurrencyKey1 sql.NullString
+	ConcurrencyKey2 sql.NullString
+	Item            []byte
+}
+
+type QueueKv struct {
+	Shard       string
+	Key         string
+	Value       string
+	ExpiresAtMs sql.NullInt64
+}
+
+type QueuePartition struct {
+	Shard     string
+	ID        string
+	AccountID string
+	AtS       int64
+	Partition []byte
+}
+
 type QueueSnapshotChunk struct {
 	SnapshotID string
 	ChunkID    int32
 	Data       []byte
 }
 
+type RunSearch struct {
+	RunID      string
+	SpanID     string
+	Kind       string
+	Content    string
+	ContentTsv interface{}
+}
+
+type RunState struct {
+	RunID          string
+	AccountID      string
+	FunctionID     string
+	Status         int32
+	Metadata       []byte
+	Events         []byte
+	StateSize      int64
+	EventSize      int64
+	StepCount      int64
+	MetadataSize   int64
+	DeferInputSize int64
+}
+
+type RunStateDefer struct {
+	RunID          string
+	HashedID       string
+	FnSlug         string
+	ScheduleStatus int32
+	Input          []byte
+	Meta           []byte
+}
+
+type RunStateKv struct {
+	AccountID   string
+	Key         string
+	Value       string
+	ExpiresAtMs int64
+}
+
+type RunStatePause struct {
+	PauseID     string
+	WorkspaceID string
+	RunID       string
+	EventName   string
+	Data        []byte
+	CreatedAtS  int64
+	ExpiresAtMs int64
+}
+
+type RunStatePauseLookup struct {
+	WorkspaceID string
+	Kind        string
+	LookupKey   string
+	PauseID     string
+}
+
+type RunStatePendingStep struct {
+	RunID  string
+	StepID string
+}
+
+type RunStateStep struct {
+	RunID      string
+	StepID     string
+	Output     []byte
+	Input      []byte
+	StackIndex sql.NullInt32
+}
+
 type Span struct {
 	SpanID         string
 	TraceID        string
@@ -199,6 +319,22 @@ type TraceRun struct {
 	HasAi        bool
 }
 
+type UsageRollupBucket struct {
+	Bucket    int64
+	CreatedAt int64
+}
+
+type UsageRollup struct {
+	Bucket         int64
+	AppID          string
+	FunctionID     string
+	EventName      string
+	Runs           int64
+	Steps          int64
+	Events         int64
+	StepDurationMs int64
+}
+
 type WorkerConnection struct {
 	AccountID            uuid.UUID
 	WorkspaceID          uuid.UUID
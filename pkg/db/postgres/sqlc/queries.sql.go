@@ -64,7 +64,7 @@ func (q *Queries) DeleteOldQueueSnapshots(ctx context.Context, limit int32) (int
 }
 
 const getAllApps = `-- name: GetAllApps :many
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE archived_at IS NULL
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE archived_at IS NULL
 `
 
 func (q *Queries) GetAllApps(ctx context.Context) ([]*App, error) {
@@ -91,6 +91,7 @@ func (q *Queries) GetAllApps(ctx context.Context) ([]*App, error) {
 			&i.Url,
 			&i.Method,
 			&i.AppVersion,
+			&i.TlsConfig,
 		); err != nil {
 			return nil, err
 		}
@@ -106,7 +107,7 @@ func (q *Queries) GetAllApps(ctx context.Context) ([]*App, error) {
 }
 
 const getApp = `-- name: GetApp :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE id = $1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE id = $1
 `
 
 func (q *Queries) GetApp(ctx context.Context, id uuid.UUID) (*App, error) {
@@ -127,12 +128,13 @@ func (q *Queries) GetApp(ctx context.Context, id uuid.UUID) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByChecksum = `-- name: GetAppByChecksum :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE checksum = $1 AND archived_at IS NULL LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE checksum = $1 AND archived_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAppByChecksum(ctx context.Context, checksum string) (*App, error) {
@@ -153,12 +155,13 @@ func (q *Queries) GetAppByChecksum(ctx context.Context, checksum string) (*App,
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByID = `-- name: GetAppByID :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE id = $1 LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetAppByID(ctx context.Context, id uuid.UUID) (*App, error) {
@@ -179,12 +182,13 @@ func (q *Queries) GetAppByID(ctx context.Context, id uuid.UUID) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByName = `-- name: GetAppByName :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE name = $1 AND archived_at IS NULL LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE name = $1 AND archived_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAppByName(ctx context.Context, name string) (*App, error) {
@@ -205,12 +209,13 @@ func (q *Queries) GetAppByName(ctx context.Context, name string) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByURL = `-- name: GetAppByURL :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE url = $1 AND archived_at IS NULL LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE url = $1 AND archived_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAppByURL(ctx context.Context, url string) (*App, error) {
@@ -231,6 +236,7 @@ func (q *Queries) GetAppByURL(ctx context.Context, url string) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
@@ -342,7 +348,7 @@ func (q *Queries) GetAppFunctionsBySlug(ctx context.Context, name string) ([]*Fu
 }
 
 const getApps = `-- name: GetApps :many
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps
 WHERE (
     ($1::boolean AND archived_at IS NOT NULL)
     OR (NOT $1::boolean AND archived_at IS NULL)
@@ -389,6 +395,7 @@ func (q *Queries) GetApps(ctx context.Context, arg GetAppsParams) ([]*App, error
 			&i.Url,
 			&i.Method,
 			&i.AppVersion,
+			&i.TlsConfig,
 		); err != nil {
 			return nil, err
 		}
@@ -2051,6 +2058,36 @@ func (q *Queries) HistoryCountRuns(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const insertAuditLog = `-- name: InsertAuditLog :exec
+INSERT INTO audit_logs (id, created_at, actor, source, action, target, payload, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertAuditLogParams struct {
+	ID        string
+	CreatedAt int64
+	Actor     string
+	Source    string
+	Action    string
+	Target    string
+	Payload   sql.NullString
+	Status    int32
+}
+
+func (q *Queries) InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error {
+	_, err := q.db.ExecContext(ctx, insertAuditLog,
+		arg.ID,
+		arg.CreatedAt,
+		arg.Actor,
+		arg.Source,
+		arg.Action,
+		arg.Target,
+		arg.Payload,
+		arg.Status,
+	)
+	return err
+}
+
 const insertEvent = `-- name: InsertEvent :exec
 
 
@@ -2262,6 +2299,28 @@ func (q *Queries) InsertQueueSnapshotChunk(ctx context.Context, arg InsertQueueS
 	return err
 }
 
+const insertRunSearchDocument = `-- name: InsertRunSearchDocument :exec
+INSERT INTO run_search (run_id, span_id, kind, content)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertRunSearchDocumentParams struct {
+	RunID   string
+	SpanID  string
+	Kind    string
+	Content string
+}
+
+func (q *Queries) InsertRunSearchDocument(ctx context.Context, arg InsertRunSearchDocumentParams) error {
+	_, err := q.db.ExecContext(ctx, insertRunSearchDocument,
+		arg.RunID,
+		arg.SpanID,
+		arg.Kind,
+		arg.Content,
+	)
+	return err
+}
+
 const insertSpan = `-- name: InsertSpan :exec
 
 INSERT INTO spans (
@@ -2475,6 +2534,22 @@ func (q *Queries) InsertTraceRun(ctx context.Context, arg InsertTraceRunParams)
 	return err
 }
 
+const insertUsageRollupBucket = `-- name: InsertUsageRollupBucket :exec
+INSERT INTO usage_rollup_buckets (bucket, created_at)
+VALUES ($1, $2)
+ON CONFLICT (bucket) DO NOTHING
+`
+
+type InsertUsageRollupBucketParams struct {
+	Bucket    int64
+	CreatedAt int64
+}
+
+func (q *Queries) InsertUsageRollupBucket(ctx context.Context, arg InsertUsageRollupBucketParams) error {
+	_, err := q.db.ExecContext(ctx, insertUsageRollupBucket, arg.Bucket, arg.CreatedAt)
+	return err
+}
+
 const insertWorkerConnection = `-- name: InsertWorkerConnection :exec
 
 INSERT INTO worker_connections (
@@ -2607,7 +2682,7 @@ func (q *Queries) InsertWorkerConnection(ctx context.Context, arg InsertWorkerCo
 }
 
 const updateAppError = `-- name: UpdateAppError :one
-UPDATE apps SET error = $1 WHERE id = $2 RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+UPDATE apps SET error = $1 WHERE id = $2 RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpdateAppErrorParams struct {
@@ -2633,12 +2708,13 @@ func (q *Queries) UpdateAppError(ctx context.Context, arg UpdateAppErrorParams)
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const updateAppURL = `-- name: UpdateAppURL :one
-UPDATE apps SET url = $1 WHERE id = $2 RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+UPDATE apps SET url = $1 WHERE id = $2 RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpdateAppURLParams struct {
@@ -2664,6 +2740,7 @@ func (q *Queries) UpdateAppURL(ctx context.Context, arg UpdateAppURLParams) (*Ap
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
@@ -2693,8 +2770,8 @@ func (q *Queries) UpdateFunctionConfig(ctx context.Context, arg UpdateFunctionCo
 }
 
 const upsertApp = `-- name: UpsertApp :one
-INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version, tls_config)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 ON CONFLICT(id) DO UPDATE SET
     name = CASE WHEN excluded.name = '' THEN apps.name ELSE excluded.name END,
     sdk_language = excluded.sdk_language,
@@ -2707,8 +2784,9 @@ ON CONFLICT(id) DO UPDATE SET
     archived_at = NULL,
     "method" = excluded.method,
     app_version = excluded.app_version,
+    tls_config = COALESCE(excluded.tls_config, apps.tls_config),
     url = excluded.url
-RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpsertAppParams struct {
@@ -2724,6 +2802,7 @@ type UpsertAppParams struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
 }
 
 // Placeholder-friendly upsert: keyed by id. The placeholder paths (-u
@@ -2746,6 +2825,7 @@ func (q *Queries) UpsertApp(ctx context.Context, arg UpsertAppParams) (*App, err
 		arg.Url,
 		arg.Method,
 		arg.AppVersion,
+		arg.TlsConfig,
 	)
 	var i App
 	err := row.Scan(
@@ -2763,13 +2843,14 @@ func (q *Queries) UpsertApp(ctx context.Context, arg UpsertAppParams) (*App, err
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const upsertAppByName = `-- name: UpsertAppByName :one
-INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version, tls_config)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 ON CONFLICT (name) WHERE name <> '' DO UPDATE SET
     sdk_language = excluded.sdk_language,
     sdk_version = excluded.sdk_version,
@@ -2781,8 +2862,9 @@ ON CONFLICT (name) WHERE name <> '' DO UPDATE SET
     archived_at = NULL,
     "method" = excluded.method,
     app_version = excluded.app_version,
+    tls_config = COALESCE(excluded.tls_config, apps.tls_config),
     url = excluded.url
-RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpsertAppByNameParams struct {
@@ -2798,6 +2880,7 @@ type UpsertAppByNameParams struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
 }
 
 // For SDK /fn/register: the partial unique index apps_name_unique_key on
@@ -2808,6 +2891,8 @@ type UpsertAppByNameParams struct {
 // preserved on conflict, so v1.13.x legacy rows keyed by sha1(URL) are
 // adopted by name when an SDK re-syncs under v1.15+ (which derives ids
 // from name) - no Go-side lookup required.
+// tls_config is only overwritten when set, as SDK re-syncs never carry TLS
+// config and must keep the config the app was added with.
 func (q *Queries) UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams) (*App, error) {
 	row := q.db.QueryRowContext(ctx, upsertAppByName,
 		arg.ID,
@@ -2822,6 +2907,7 @@ func (q *Queries) UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams
 		arg.Url,
 		arg.Method,
 		arg.AppVersion,
+		arg.TlsConfig,
 	)
 	var i App
 	err := row.Scan(
@@ -2839,6 +2925,7 @@ func (q *Queries) UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
@@ -2889,3 +2976,69 @@ func (q *Queries) UpsertFunction(ctx context.Context, arg UpsertFunctionParams)
 	)
 	return &i, err
 }
+
+const upsertFunctionVersion = `-- name: UpsertFunctionVersion :exec
+INSERT INTO function_versions (function_id, version, app_id, sync_id, config, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (function_id, version) DO UPDATE SET
+	app_id = excluded.app_id,
+	sync_id = excluded.sync_id,
+	config = excluded.config,
+	created_at = excluded.created_at
+`
+
+type UpsertFunctionVersionParams struct {
+	FunctionID uuid.UUID
+	Version    int32
+	AppID      uuid.UUID
+	SyncID     uuid.UUID
+	Config     string
+	CreatedAt  int64
+}
+
+func (q *Queries) UpsertFunctionVersion(ctx context.Context, arg UpsertFunctionVersionParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFunctionVersion,
+		arg.FunctionID,
+		arg.Version,
+		arg.AppID,
+		arg.SyncID,
+		arg.Config,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const upsertUsageRollup = `-- name: UpsertUsageRollup :exec
+INSERT INTO usage_rollups (bucket, app_id, function_id, event_name, runs, steps, events, step_duration_ms)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (bucket, app_id, function_id, event_name) DO UPDATE SET
+	runs = excluded.runs,
+	steps = excluded.steps,
+	events = excluded.events,
+	step_duration_ms = excluded.step_duration_ms
+`
+
+type UpsertUsageRollupParams struct {
+	Bucket         int64
+	AppID          string
+	FunctionID     string
+	EventName      string
+	Runs           int64
+	Steps          int64
+	Events         int64
+	StepDurationMs int64
+}
+
+func (q *Queries) UpsertUsageRollup(ctx context.Context, arg UpsertUsageRollupParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUsageRollup,
+		arg.Bucket,
+		arg.AppID,
+		arg.FunctionID,
+		arg.EventName,
+		arg.Runs,
+		arg.Steps,
+		arg.Events,
+		arg.StepDurationMs,
+	)
+	return err
+}
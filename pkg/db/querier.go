@@ -98,4 +98,17 @@ type Querier interface {
 	// Worker Connections
 	InsertWorkerConnection(ctx context.Context, arg InsertWorkerConnectionParams) error
 	GetWorkerConnection(ctx context.Context, arg GetWorkerConnectionParams) (*WorkerConnection, error)
+
+	// Audit Logs
+	InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error
+
+	// Run Search
+	InsertRunSearchDocument(ctx context.Context, arg InsertRunSearchDocumentParams) error
+
+	// Function Versions
+	UpsertFunctionVersion(ctx context.Context, arg UpsertFunctionVersionParams) error
+
+	// Usage Rollups
+	UpsertUsageRollup(ctx context.Context, arg UpsertUsageRollupParams) error
+	InsertUsageRollupBucket(ctx context.Context, arg InsertUsageRollupBucketParams) error
 }
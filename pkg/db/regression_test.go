@@ -2122,10 +2122,13 @@ func readRuntimeTableNames(t *testing.T, conn *sql.DB, dialect db.Dialect) []str
 			ORDER BY table_name
 		`)
 	default:
+		// pragma_table_list reports FTS5 virtual tables and their shadow
+		// tables with their own types, matching BASE TABLE in Postgres.
 		rows, err = conn.Query(`
 			SELECT name
-			FROM sqlite_master
-			WHERE type = 'table'
+			FROM pragma_table_list
+			WHERE schema = 'main'
+			  AND type = 'table'
 			  AND name NOT LIKE 'sqlite_%'
 			ORDER BY name
 		`)
@@ -2302,11 +2305,31 @@ func normalizeDefault(defaultExpr string) string {
 	return strings.ToLower(defaultExpr)
 }
 
+// postgresOnlyTables are tables with no SQLite counterpart.
+var postgresOnlyTables = map[string]bool{
+	// The Postgres queue backend stores queue items and partitions in
+	// Postgres.  SQLite deployments always use the Redis queue.
+	"queue_items":      true,
+	"queue_kv":         true,
+	"queue_partitions": true,
+	// The Postgres state backend stores run state and pauses in Postgres.
+	// SQLite deployments always use Redis state.
+	"run_state":               true,
+	"run_state_defers":        true,
+	"run_state_kv":            true,
+	"run_state_pause_lookups": true,
+	"run_state_pauses":        true,
+	"run_state_pending_steps": true,
+	"run_state_steps":         true,
+}
+
 func toLogicalSchema(schema map[string][]schemaColumn) map[string][]logicalColumn {
 	result := make(map[string][]logicalColumn, len(schema))
 
 	for tableName, columns := range schema {
-		if tableName == "goose_db_version" {
+		// run_search is an FTS5 virtual table in SQLite and a tsvector
+		// table in Postgres, so its columns intentionally differ.
+		if tableName == "goose_db_version" || tableName == "run_search" || postgresOnlyTables[tableName] {
 			continue
 		}
 		for _, column := range columns {
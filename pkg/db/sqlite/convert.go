@@ -15,7 +15,7 @@ func appFromSQLite(s *sqlc.App) *db.App {
 		ID: s.ID, Name: s.Name, SdkLanguage: s.SdkLanguage, SdkVersion: s.SdkVersion,
 		Framework: s.Framework, Metadata: s.Metadata, Status: s.Status, Error: s.Error,
 		Checksum: s.Checksum, CreatedAt: s.CreatedAt, ArchivedAt: s.ArchivedAt,
-		Url: s.Url, Method: s.Method, AppVersion: s.AppVersion,
+		Url: s.Url, Method: s.Method, AppVersion: s.AppVersion, TlsConfig: s.TlsConfig,
 	}
 }
 
@@ -40,6 +40,20 @@ func (h *helpers) EventIDsContain(ids []string) sqexp.Expression {
 	)
 }
 
+func (h *helpers) RunSearchMatch(query string) sqexp.Expression {
+	return sq.L("run_search MATCH ?", fts5Query(query))
+}
+
+// fts5Query quotes each whitespace-separated term so that user input is never
+// parsed as FTS5 query syntax.  Quoted terms are implicitly ANDed.
+func fts5Query(query string) string {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	}
+	return strings.Join(terms, " ")
+}
+
 func (h *helpers) RunOutputExpr() sqexp.Expression {
 	return sq.L(`COALESCE((
 		SELECT CAST(output_lookup.output AS TEXT)
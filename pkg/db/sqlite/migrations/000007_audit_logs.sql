@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- Append-only log of mutating operator actions made via the APIs.  created_at
+-- is in unix milliseconds; ids are ULIDs, so ordering by id orders by time.
+CREATE TABLE audit_logs (
+    id CHAR(26) PRIMARY KEY,
+    created_at INT NOT NULL,
+    actor VARCHAR NOT NULL,
+    source VARCHAR NOT NULL,
+    action VARCHAR NOT NULL,
+    target VARCHAR NOT NULL,
+    payload VARCHAR,
+    status INT NOT NULL
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX idx_audit_logs_actor_created_at ON audit_logs (actor, created_at);
+
+-- +goose Down
+
+DROP TABLE audit_logs;
@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- Full-text index over event payloads, step outputs and errors, written as
+-- spans are exported.  A run may have many documents; search matches any.
+CREATE VIRTUAL TABLE run_search USING fts5(
+    run_id UNINDEXED,
+    span_id UNINDEXED,
+    kind UNINDEXED,
+    content
+);
+
+-- +goose Down
+
+DROP TABLE run_search;
@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- Every config a function has been synced with, keyed by the function's
+-- version.  sync_id is the app sync which recorded the version; created_at is
+-- in unix milliseconds.
+CREATE TABLE function_versions (
+    function_id CHAR(36) NOT NULL,
+    version INT NOT NULL,
+    app_id CHAR(36) NOT NULL,
+    sync_id CHAR(36) NOT NULL,
+    config VARCHAR NOT NULL,
+    created_at INT NOT NULL,
+    PRIMARY KEY (function_id, version)
+);
+
+-- +goose Down
+
+DROP TABLE function_versions;
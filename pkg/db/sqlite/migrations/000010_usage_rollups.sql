@@ -0,0 +1,28 @@
+-- +goose Up
+
+-- Hourly usage totals per app, function and event name.  bucket is the start
+-- of the hour in unix milliseconds.  Event usage has an empty app and function
+-- ID; run and step usage uses the run's triggering event name.
+CREATE TABLE usage_rollups (
+    bucket INT NOT NULL,
+    app_id VARCHAR NOT NULL,
+    function_id VARCHAR NOT NULL,
+    event_name VARCHAR NOT NULL,
+    runs INT NOT NULL,
+    steps INT NOT NULL,
+    events INT NOT NULL,
+    step_duration_ms INT NOT NULL,
+    PRIMARY KEY (bucket, app_id, function_id, event_name)
+);
+
+-- The hours which have been rolled up into usage_rollups, so that hours
+-- without usage are not recomputed.
+CREATE TABLE usage_rollup_buckets (
+    bucket INT PRIMARY KEY,
+    created_at INT NOT NULL
+);
+
+-- +goose Down
+
+DROP TABLE usage_rollup_buckets;
+DROP TABLE usage_rollups;
@@ -114,6 +114,7 @@ func (sq *sqliteQuerier) UpsertApp(ctx context.Context, arg db.UpsertAppParams)
 		SdkVersion: arg.SdkVersion, Framework: arg.Framework, Metadata: arg.Metadata,
 		Status: arg.Status, Error: arg.Error, Checksum: arg.Checksum,
 		Url: arg.Url, Method: arg.Method, AppVersion: arg.AppVersion,
+		TlsConfig: arg.TlsConfig,
 	})
 	if err != nil {
 		return nil, err
@@ -135,6 +136,7 @@ func (sq *sqliteQuerier) UpsertAppByName(ctx context.Context, arg db.UpsertAppPa
 		Url:         arg.Url,
 		Method:      arg.Method,
 		AppVersion:  arg.AppVersion,
+		TlsConfig:   arg.TlsConfig,
 	})
 	if err != nil {
 		return nil, err
@@ -716,6 +718,48 @@ func (sq *sqliteQuerier) GetWorkerConnection(ctx context.Context, arg db.GetWork
 	return workerConnectionFromSQLite(r), nil
 }
 
+// --- Audit Logs ---
+
+func (sq *sqliteQuerier) InsertAuditLog(ctx context.Context, arg db.InsertAuditLogParams) error {
+	return sq.q.InsertAuditLog(ctx, sqlc.InsertAuditLogParams{
+		ID: arg.ID.String(), CreatedAt: arg.CreatedAt,
+		Actor: arg.Actor, Source: arg.Source, Action: arg.Action,
+		Target: arg.Target, Payload: arg.Payload, Status: arg.Status,
+	})
+}
+
+// --- Run Search ---
+
+func (sq *sqliteQuerier) InsertRunSearchDocument(ctx context.Context, arg db.InsertRunSearchDocumentParams) error {
+	return sq.q.InsertRunSearchDocument(ctx, sqlc.InsertRunSearchDocumentParams{
+		RunID: arg.RunID, SpanID: arg.SpanID, Kind: arg.Kind, Content: arg.Content,
+	})
+}
+
+// --- Function Versions ---
+
+func (sq *sqliteQuerier) UpsertFunctionVersion(ctx context.Context, arg db.UpsertFunctionVersionParams) error {
+	return sq.q.UpsertFunctionVersion(ctx, sqlc.UpsertFunctionVersionParams{
+		FunctionID: arg.FunctionID, Version: arg.Version, AppID: arg.AppID,
+		SyncID: arg.SyncID, Config: arg.Config, CreatedAt: arg.CreatedAt,
+	})
+}
+
+// --- Usage Rollups ---
+
+func (sq *sqliteQuerier) UpsertUsageRollup(ctx context.Context, arg db.UpsertUsageRollupParams) error {
+	return sq.q.UpsertUsageRollup(ctx, sqlc.UpsertUsageRollupParams{
+		Bucket: arg.Bucket, AppID: arg.AppID, FunctionID: arg.FunctionID, EventName: arg.EventName,
+		Runs: arg.Runs, Steps: arg.Steps, Events: arg.Events, StepDurationMs: arg.StepDurationMs,
+	})
+}
+
+func (sq *sqliteQuerier) InsertUsageRollupBucket(ctx context.Context, arg db.InsertUsageRollupBucketParams) error {
+	return sq.q.InsertUsageRollupBucket(ctx, sqlc.InsertUsageRollupBucketParams{
+		Bucket: arg.Bucket, CreatedAt: arg.CreatedAt,
+	})
+}
+
 // --- helpers ---
 
 func convertSlice[S any, D any](src []*S, fn func(*S) *D) []*D {
@@ -19,7 +19,7 @@ CREATE TABLE apps (
 	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 	archived_at TIMESTAMP,
 	url VARCHAR NOT NULL
-, "method" VARCHAR(32) NOT NULL DEFAULT 'serve', "app_version" VARCHAR);
+, "method" VARCHAR(32) NOT NULL DEFAULT 'serve', "app_version" VARCHAR, tls_config VARCHAR);
 CREATE TABLE events (
 	internal_id BLOB,
 	-- cannot use CHAR(26) for ulids, nor primary keys for null ter
@@ -216,3 +216,45 @@ CREATE UNIQUE INDEX functions_app_id_slug_active_key
 CREATE UNIQUE INDEX apps_name_unique_key
     ON apps (name)
     WHERE name <> '';
+CREATE TABLE audit_logs (
+    id CHAR(26) PRIMARY KEY,
+    created_at INT NOT NULL,
+    actor VARCHAR NOT NULL,
+    source VARCHAR NOT NULL,
+    action VARCHAR NOT NULL,
+    target VARCHAR NOT NULL,
+    payload VARCHAR,
+    status INT NOT NULL
+);
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX idx_audit_logs_actor_created_at ON audit_logs (actor, created_at);
+CREATE VIRTUAL TABLE run_search USING fts5(
+    run_id UNINDEXED,
+    span_id UNINDEXED,
+    kind UNINDEXED,
+    content
+);
+CREATE TABLE function_versions (
+    function_id CHAR(36) NOT NULL,
+    version INT NOT NULL,
+    app_id CHAR(36) NOT NULL,
+    sync_id CHAR(36) NOT NULL,
+    config VARCHAR NOT NULL,
+    created_at INT NOT NULL,
+    PRIMARY KEY (function_id, version)
+);
+CREATE TABLE usage_rollups (
+    bucket INT NOT NULL,
+    app_id VARCHAR NOT NULL,
+    function_id VARCHAR NOT NULL,
+    event_name VARCHAR NOT NULL,
+    runs INT NOT NULL,
+    steps INT NOT NULL,
+    events INT NOT NULL,
+    step_duration_ms INT NOT NULL,
+    PRIMARY KEY (bucket, app_id, function_id, event_name)
+);
+CREATE TABLE usage_rollup_buckets (
+    bucket INT PRIMARY KEY,
+    created_at INT NOT NULL
+);
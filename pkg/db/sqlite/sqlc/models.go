@@ -28,6 +28,18 @@ type App struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
+}
+
+type AuditLog struct {
+	ID        string
+	CreatedAt int64
+	Actor     string
+	Source    string
+	Action    string
+	Target    string
+	Payload   sql.NullString
+	Status    int64
 }
 
 type Event struct {
@@ -88,6 +100,15 @@ type FunctionRun struct {
 	WorkspaceID     uuid.UUID
 }
 
+type FunctionVersion struct {
+	FunctionID uuid.UUID
+	Version    int64
+	AppID      uuid.UUID
+	SyncID     uuid.UUID
+	Config     string
+	CreatedAt  int64
+}
+
 type GooseDbVersion struct {
 	ID        int64
 	VersionID int64
@@ -133,6 +154,13 @@ type QueueSnapshotChunk struct {
 	Data       []byte
 }
 
+type RunSearch struct {
+	RunID   string
+	SpanID  string
+	Kind    string
+	Content string
+}
+
 type Span struct {
 	SpanID         string
 	TraceID        string
@@ -199,6 +227,22 @@ type TraceRun struct {
 	HasAi        bool
 }
 
+type UsageRollupBucket struct {
+	Bucket    int64
+	CreatedAt int64
+}
+
+type UsageRollup struct {
+	Bucket         int64
+	AppID          string
+	FunctionID     string
+	EventName      string
+	Runs           int64
+	Steps          int64
+	Events         int64
+	StepDurationMs int64
+}
+
 type WorkerConnection struct {
 	AccountID            uuid.UUID
 	WorkspaceID          uuid.UUID
@@ -77,6 +77,7 @@ type Querier interface {
 	//
 	// Events
 	//
+	InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error
 	InsertEvent(ctx context.Context, arg InsertEventParams) error
 	InsertEventBatch(ctx context.Context, arg InsertEventBatchParams) error
 	InsertFunctionFinish(ctx context.Context, arg InsertFunctionFinishParams) error
@@ -89,6 +90,10 @@ type Querier interface {
 	//
 	InsertHistory(ctx context.Context, arg InsertHistoryParams) error
 	InsertQueueSnapshotChunk(ctx context.Context, arg InsertQueueSnapshotChunkParams) error
+	//
+	// Run Search
+	//
+	InsertRunSearchDocument(ctx context.Context, arg InsertRunSearchDocumentParams) error
 	// New
 	InsertSpan(ctx context.Context, arg InsertSpanParams) error
 	//
@@ -98,6 +103,7 @@ type Querier interface {
 	// Terminal status codes (matches enums.runStatusCode in pkg/enums/run_status.go):
 	//   50=Overflowed, 300=Completed, 400=Failed, 500=Cancelled, 600=Skipped.
 	InsertTraceRun(ctx context.Context, arg InsertTraceRunParams) error
+	InsertUsageRollupBucket(ctx context.Context, arg InsertUsageRollupBucketParams) error
 	//
 	// Worker Connections
 	//
@@ -120,12 +126,22 @@ type Querier interface {
 	// preserved on conflict, so v1.13.x legacy rows keyed by sha1(URL) are
 	// adopted by name when an SDK re-syncs under v1.15+ (which derives ids
 	// from name) - no Go-side lookup required.
+	// tls_config is only overwritten when set, as SDK re-syncs never carry TLS
+	// config and must keep the config the app was added with.
 	UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams) (*App, error)
 	//
 	// functions
 	//
 	// note - this is very basic right now.
 	UpsertFunction(ctx context.Context, arg UpsertFunctionParams) (*Function, error)
+	//
+	// Function Versions
+	//
+	UpsertFunctionVersion(ctx context.Context, arg UpsertFunctionVersionParams) error
+	//
+	// Usage Rollups
+	//
+	UpsertUsageRollup(ctx context.Context, arg UpsertUsageRollupParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -5,8 +5,8 @@
 -- name when re-pinging the same id, so the name update is conditional.
 -- For the SDK /fn/register flow, use UpsertAppByName instead - it adopts an
 -- existing active row keyed by name regardless of how its id was minted.
-INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version, tls_config)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     name = CASE WHEN excluded.name = '' THEN apps.name ELSE excluded.name END,
     sdk_language = excluded.sdk_language,
@@ -19,6 +19,7 @@ ON CONFLICT(id) DO UPDATE SET
     archived_at = NULL,
     "method" = excluded.method,
     app_version = excluded.app_version,
+    tls_config = COALESCE(excluded.tls_config, apps.tls_config),
     url = excluded.url
 RETURNING *;
 
@@ -31,8 +32,10 @@ RETURNING *;
 -- preserved on conflict, so v1.13.x legacy rows keyed by sha1(URL) are
 -- adopted by name when an SDK re-syncs under v1.15+ (which derives ids
 -- from name) - no Go-side lookup required.
-INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+-- tls_config is only overwritten when set, as SDK re-syncs never carry TLS
+-- config and must keep the config the app was added with.
+INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version, tls_config)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT (name) WHERE name <> '' DO UPDATE SET
     sdk_language = excluded.sdk_language,
     sdk_version = excluded.sdk_version,
@@ -44,6 +47,7 @@ ON CONFLICT (name) WHERE name <> '' DO UPDATE SET
     archived_at = NULL,
     "method" = excluded.method,
     app_version = excluded.app_version,
+    tls_config = COALESCE(excluded.tls_config, apps.tls_config),
     url = excluded.url
 RETURNING *;
 
@@ -407,6 +411,53 @@ DO UPDATE SET
 -- name: GetWorkerConnection :one
 SELECT * FROM worker_connections WHERE account_id = @account_id AND workspace_id = @workspace_id AND id = @connection_id;
 
+--
+-- Audit Logs
+--
+
+-- name: InsertAuditLog :exec
+INSERT INTO audit_logs (id, created_at, actor, source, action, target, payload, status)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+--
+-- Run Search
+--
+
+-- name: InsertRunSearchDocument :exec
+INSERT INTO run_search (run_id, span_id, kind, content)
+VALUES (?, ?, ?, ?);
+
+--
+-- Function Versions
+--
+
+-- name: UpsertFunctionVersion :exec
+INSERT INTO function_versions (function_id, version, app_id, sync_id, config, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (function_id, version) DO UPDATE SET
+	app_id = excluded.app_id,
+	sync_id = excluded.sync_id,
+	config = excluded.config,
+	created_at = excluded.created_at;
+
+--
+-- Usage Rollups
+--
+
+-- name: UpsertUsageRollup :exec
+INSERT INTO usage_rollups (bucket, app_id, function_id, event_name, runs, steps, events, step_duration_ms)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (bucket, app_id, function_id, event_name) DO UPDATE SET
+	runs = excluded.runs,
+	steps = excluded.steps,
+	events = excluded.events,
+	step_duration_ms = excluded.step_duration_ms;
+
+-- name: InsertUsageRollupBucket :exec
+INSERT INTO usage_rollup_buckets (bucket, created_at)
+VALUES (?, ?)
+ON CONFLICT (bucket) DO NOTHING;
+
 -- New
 
 -- name: InsertSpan :exec
@@ -71,7 +71,7 @@ func (q *Queries) DeleteOldQueueSnapshots(ctx context.Context, limit int64) (int
 }
 
 const getAllApps = `-- name: GetAllApps :many
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE archived_at IS NULL
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE archived_at IS NULL
 `
 
 func (q *Queries) GetAllApps(ctx context.Context) ([]*App, error) {
@@ -98,6 +98,7 @@ func (q *Queries) GetAllApps(ctx context.Context) ([]*App, error) {
 			&i.Url,
 			&i.Method,
 			&i.AppVersion,
+			&i.TlsConfig,
 		); err != nil {
 			return nil, err
 		}
@@ -113,7 +114,7 @@ func (q *Queries) GetAllApps(ctx context.Context) ([]*App, error) {
 }
 
 const getApp = `-- name: GetApp :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE id = ?
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE id = ?
 `
 
 func (q *Queries) GetApp(ctx context.Context, id uuid.UUID) (*App, error) {
@@ -134,12 +135,13 @@ func (q *Queries) GetApp(ctx context.Context, id uuid.UUID) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByChecksum = `-- name: GetAppByChecksum :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE checksum = ? AND archived_at IS NULL LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE checksum = ? AND archived_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAppByChecksum(ctx context.Context, checksum string) (*App, error) {
@@ -160,12 +162,13 @@ func (q *Queries) GetAppByChecksum(ctx context.Context, checksum string) (*App,
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByID = `-- name: GetAppByID :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE id = ? LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetAppByID(ctx context.Context, id uuid.UUID) (*App, error) {
@@ -186,12 +189,13 @@ func (q *Queries) GetAppByID(ctx context.Context, id uuid.UUID) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByName = `-- name: GetAppByName :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE name = ? AND archived_at IS NULL LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE name = ? AND archived_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAppByName(ctx context.Context, name string) (*App, error) {
@@ -212,12 +216,13 @@ func (q *Queries) GetAppByName(ctx context.Context, name string) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const getAppByURL = `-- name: GetAppByURL :one
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps WHERE url = ? AND archived_at IS NULL LIMIT 1
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps WHERE url = ? AND archived_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAppByURL(ctx context.Context, url string) (*App, error) {
@@ -238,6 +243,7 @@ func (q *Queries) GetAppByURL(ctx context.Context, url string) (*App, error) {
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
@@ -359,7 +365,7 @@ func (q *Queries) GetAppFunctionsBySlug(ctx context.Context, name string) ([]*Fu
 }
 
 const getApps = `-- name: GetApps :many
-SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version FROM apps
+SELECT id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config FROM apps
 WHERE (
     (?1 AND archived_at IS NOT NULL)
     OR (NOT ?1 AND archived_at IS NULL)
@@ -406,6 +412,7 @@ func (q *Queries) GetApps(ctx context.Context, arg GetAppsParams) ([]*App, error
 			&i.Url,
 			&i.Method,
 			&i.AppVersion,
+			&i.TlsConfig,
 		); err != nil {
 			return nil, err
 		}
@@ -2104,6 +2111,36 @@ func (q *Queries) HistoryCountRuns(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const insertAuditLog = `-- name: InsertAuditLog :exec
+INSERT INTO audit_logs (id, created_at, actor, source, action, target, payload, status)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertAuditLogParams struct {
+	ID        string
+	CreatedAt int64
+	Actor     string
+	Source    string
+	Action    string
+	Target    string
+	Payload   sql.NullString
+	Status    int64
+}
+
+func (q *Queries) InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error {
+	_, err := q.db.ExecContext(ctx, insertAuditLog,
+		arg.ID,
+		arg.CreatedAt,
+		arg.Actor,
+		arg.Source,
+		arg.Action,
+		arg.Target,
+		arg.Payload,
+		arg.Status,
+	)
+	return err
+}
+
 const insertEvent = `-- name: InsertEvent :exec
 
 INSERT INTO events
@@ -2314,6 +2351,28 @@ func (q *Queries) InsertQueueSnapshotChunk(ctx context.Context, arg InsertQueueS
 	return err
 }
 
+const insertRunSearchDocument = `-- name: InsertRunSearchDocument :exec
+INSERT INTO run_search (run_id, span_id, kind, content)
+VALUES (?, ?, ?, ?)
+`
+
+type InsertRunSearchDocumentParams struct {
+	RunID   string
+	SpanID  string
+	Kind    string
+	Content string
+}
+
+func (q *Queries) InsertRunSearchDocument(ctx context.Context, arg InsertRunSearchDocumentParams) error {
+	_, err := q.db.ExecContext(ctx, insertRunSearchDocument,
+		arg.RunID,
+		arg.SpanID,
+		arg.Kind,
+		arg.Content,
+	)
+	return err
+}
+
 const insertSpan = `-- name: InsertSpan :exec
 
 INSERT INTO spans (
@@ -2551,6 +2610,22 @@ func (q *Queries) InsertTraceRun(ctx context.Context, arg InsertTraceRunParams)
 	return err
 }
 
+const insertUsageRollupBucket = `-- name: InsertUsageRollupBucket :exec
+INSERT INTO usage_rollup_buckets (bucket, created_at)
+VALUES (?, ?)
+ON CONFLICT (bucket) DO NOTHING
+`
+
+type InsertUsageRollupBucketParams struct {
+	Bucket    int64
+	CreatedAt int64
+}
+
+func (q *Queries) InsertUsageRollupBucket(ctx context.Context, arg InsertUsageRollupBucketParams) error {
+	_, err := q.db.ExecContext(ctx, insertUsageRollupBucket, arg.Bucket, arg.CreatedAt)
+	return err
+}
+
 const insertWorkerConnection = `-- name: InsertWorkerConnection :exec
 
 INSERT INTO worker_connections (
@@ -2657,7 +2732,7 @@ func (q *Queries) InsertWorkerConnection(ctx context.Context, arg InsertWorkerCo
 }
 
 const updateAppError = `-- name: UpdateAppError :one
-UPDATE apps SET error = ? WHERE id = ? RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+UPDATE apps SET error = ? WHERE id = ? RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpdateAppErrorParams struct {
@@ -2683,12 +2758,13 @@ func (q *Queries) UpdateAppError(ctx context.Context, arg UpdateAppErrorParams)
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const updateAppURL = `-- name: UpdateAppURL :one
-UPDATE apps SET url = ? WHERE id = ? RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+UPDATE apps SET url = ? WHERE id = ? RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpdateAppURLParams struct {
@@ -2714,6 +2790,7 @@ func (q *Queries) UpdateAppURL(ctx context.Context, arg UpdateAppURLParams) (*Ap
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
@@ -2743,8 +2820,8 @@ func (q *Queries) UpdateFunctionConfig(ctx context.Context, arg UpdateFunctionCo
 }
 
 const upsertApp = `-- name: UpsertApp :one
-INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version, tls_config)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     name = CASE WHEN excluded.name = '' THEN apps.name ELSE excluded.name END,
     sdk_language = excluded.sdk_language,
@@ -2757,8 +2834,9 @@ ON CONFLICT(id) DO UPDATE SET
     archived_at = NULL,
     "method" = excluded.method,
     app_version = excluded.app_version,
+    tls_config = COALESCE(excluded.tls_config, apps.tls_config),
     url = excluded.url
-RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpsertAppParams struct {
@@ -2774,6 +2852,7 @@ type UpsertAppParams struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
 }
 
 // Placeholder-friendly upsert: keyed by id. The placeholder paths (-u
@@ -2796,6 +2875,7 @@ func (q *Queries) UpsertApp(ctx context.Context, arg UpsertAppParams) (*App, err
 		arg.Url,
 		arg.Method,
 		arg.AppVersion,
+		arg.TlsConfig,
 	)
 	var i App
 	err := row.Scan(
@@ -2813,13 +2893,14 @@ func (q *Queries) UpsertApp(ctx context.Context, arg UpsertAppParams) (*App, err
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
 
 const upsertAppByName = `-- name: UpsertAppByName :one
-INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO apps (id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, url, method, app_version, tls_config)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT (name) WHERE name <> '' DO UPDATE SET
     sdk_language = excluded.sdk_language,
     sdk_version = excluded.sdk_version,
@@ -2831,8 +2912,9 @@ ON CONFLICT (name) WHERE name <> '' DO UPDATE SET
     archived_at = NULL,
     "method" = excluded.method,
     app_version = excluded.app_version,
+    tls_config = COALESCE(excluded.tls_config, apps.tls_config),
     url = excluded.url
-RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version
+RETURNING id, name, sdk_language, sdk_version, framework, metadata, status, error, checksum, created_at, archived_at, url, method, app_version, tls_config
 `
 
 type UpsertAppByNameParams struct {
@@ -2848,6 +2930,7 @@ type UpsertAppByNameParams struct {
 	Url         string
 	Method      string
 	AppVersion  sql.NullString
+	TlsConfig   sql.NullString
 }
 
 // For SDK /fn/register: the partial unique index apps_name_unique_key on
@@ -2858,6 +2941,8 @@ type UpsertAppByNameParams struct {
 // preserved on conflict, so v1.13.x legacy rows keyed by sha1(URL) are
 // adopted by name when an SDK re-syncs under v1.15+ (which derives ids
 // from name) - no Go-side lookup required.
+// tls_config is only overwritten when set, as SDK re-syncs never carry TLS
+// config and must keep the config the app was added with.
 func (q *Queries) UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams) (*App, error) {
 	row := q.db.QueryRowContext(ctx, upsertAppByName,
 		arg.ID,
@@ -2872,6 +2957,7 @@ func (q *Queries) UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams
 		arg.Url,
 		arg.Method,
 		arg.AppVersion,
+		arg.TlsConfig,
 	)
 	var i App
 	err := row.Scan(
@@ -2889,6 +2975,7 @@ func (q *Queries) UpsertAppByName(ctx context.Context, arg UpsertAppByNameParams
 		&i.Url,
 		&i.Method,
 		&i.AppVersion,
+		&i.TlsConfig,
 	)
 	return &i, err
 }
@@ -2941,3 +3028,69 @@ func (q *Queries) UpsertFunction(ctx context.Context, arg UpsertFunctionParams)
 	)
 	return &i, err
 }
+
+const upsertFunctionVersion = `-- name: UpsertFunctionVersion :exec
+INSERT INTO function_versions (function_id, version, app_id, sync_id, config, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (function_id, version) DO UPDATE SET
+	app_id = excluded.app_id,
+	sync_id = excluded.sync_id,
+	config = excluded.config,
+	created_at = excluded.created_at
+`
+
+type UpsertFunctionVersionParams struct {
+	FunctionID uuid.UUID
+	Version    int64
+	AppID      uuid.UUID
+	SyncID     uuid.UUID
+	Config     string
+	CreatedAt  int64
+}
+
+func (q *Queries) UpsertFunctionVersion(ctx context.Context, arg UpsertFunctionVersionParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFunctionVersion,
+		arg.FunctionID,
+		arg.Version,
+		arg.AppID,
+		arg.SyncID,
+		arg.Config,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const upsertUsageRollup = `-- name: UpsertUsageRollup :exec
+INSERT INTO usage_rollups (bucket, app_id, function_id, event_name, runs, steps, events, step_duration_ms)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (bucket, app_id, function_id, event_name) DO UPDATE SET
+	runs = excluded.runs,
+	steps = excluded.steps,
+	events = excluded.events,
+	step_duration_ms = excluded.step_duration_ms
+`
+
+type UpsertUsageRollupParams struct {
+	Bucket         int64
+	AppID          string
+	FunctionID     string
+	EventName      string
+	Runs           int64
+	Steps          int64
+	Events         int64
+	StepDurationMs int64
+}
+
+func (q *Queries) UpsertUsageRollup(ctx context.Context, arg UpsertUsageRollupParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUsageRollup,
+		arg.Bucket,
+		arg.AppID,
+		arg.FunctionID,
+		arg.EventName,
+		arg.Runs,
+		arg.Steps,
+		arg.Events,
+		arg.StepDurationMs,
+	)
+	return err
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/redis/rueidis"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // mockCQRSManager is a minimal mock for cqrs.Manager used in tests.
@@ -1161,3 +1163,246 @@ func TestRunDebounceInvalidFunctionID(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "invalid function_id")
 }
+
+func TestQueueItemsListRequeueAndPurge(t *testing.T) {
+	rc, _ := setupTestRedis(t)
+	ctx := context.Background()
+
+	shard := redis_state.NewQueueShard(
+		consts.DefaultQueueShardName,
+		redis_state.NewUnshardedClient(rc, redis_state.StateDefaultKey, redis_state.QueueDefaultKey).Queue(),
+	)
+	shardRegistry, err := queue.NewSingleShardRegistry(shard)
+	require.NoError(t, err)
+
+	q, err := queue.New(ctx, "debug-queue-items-test", shardRegistry)
+	require.NoError(t, err)
+
+	accountID := uuid.New()
+	envID := uuid.New()
+	functionID := uuid.New()
+	runA := ulid.MustNew(ulid.Now(), rand.Reader)
+	runB := ulid.MustNew(ulid.Now(), rand.Reader)
+
+	enqueue := func(kind string, runID ulid.ULID, at time.Time) queue.QueueItem {
+		qi, err := shard.EnqueueItem(ctx, queue.QueueItem{
+			FunctionID:  functionID,
+			WorkspaceID: envID,
+			Data: queue.Item{
+				WorkspaceID: envID,
+				Kind:        kind,
+				Identifier: state.Identifier{
+					AccountID:   accountID,
+					WorkspaceID: envID,
+					WorkflowID:  functionID,
+					RunID:       runID,
+				},
+			},
+		}, at, queue.EnqueueOpts{})
+		require.NoError(t, err)
+		return qi
+	}
+
+	now := time.Now()
+	startA := enqueue(queue.KindStart, runA, now)
+	edgeA := enqueue(queue.KindEdge, runA, now.Add(time.Second))
+	sleepB := enqueue(queue.KindSleep, runB, now.Add(24*time.Hour))
+
+	d := &debugAPI{
+		itemReader: q,
+		partReader: q,
+		shards:     shardRegistry,
+	}
+
+	filter := &pb.QueueItemsFilter{
+		PartitionId: functionID.String(),
+		AccountId:   accountID.String(),
+		EnvId:       envID.String(),
+	}
+
+	t.Run("lists items with pagination", func(t *testing.T) {
+		resp, err := d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 2)
+		require.Equal(t, startA.ID, resp.GetItems()[0].GetId())
+		require.Equal(t, edgeA.ID, resp.GetItems()[1].GetId())
+		require.NotEmpty(t, resp.GetNextCursor())
+
+		resp, err = d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter, Limit: 2, Cursor: resp.GetNextCursor()})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		require.Equal(t, sleepB.ID, resp.GetItems()[0].GetId())
+		require.Empty(t, resp.GetNextCursor())
+	})
+
+	t.Run("filters by kind and run ID", func(t *testing.T) {
+		f := &pb.QueueItemsFilter{
+			PartitionId: filter.PartitionId,
+			AccountId:   filter.AccountId,
+			EnvId:       filter.EnvId,
+			Kinds:       []string{queue.KindEdge, queue.KindSleep},
+			RunId:       runA.String(),
+		}
+		resp, err := d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: f})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		require.Equal(t, edgeA.ID, resp.GetItems()[0].GetId())
+
+		f.MinAgeMs = time.Hour.Milliseconds()
+		resp, err = d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: f})
+		require.NoError(t, err)
+		require.Empty(t, resp.GetItems())
+	})
+
+	t.Run("requeues items with a delay and skips leased items", func(t *testing.T) {
+		_, err := shard.Lease(ctx, startA, 10*time.Second, time.Now())
+		require.NoError(t, err)
+
+		resp, err := d.RequeueQueueItems(ctx, &pb.RequeueQueueItemsRequest{
+			ItemIds: []string{startA.ID, edgeA.ID, "missing"},
+			DelayMs: time.Hour.Milliseconds(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 3)
+		require.Equal(t, pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_LEASED, resp.GetResults()[0].GetStatus())
+		require.Equal(t, pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_OK, resp.GetResults()[1].GetStatus())
+		require.Equal(t, pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_NOT_FOUND, resp.GetResults()[2].GetStatus())
+
+		qi, err := shard.LoadQueueItem(ctx, edgeA.ID)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(time.Hour), time.UnixMilli(qi.AtMS), 5*time.Second)
+		require.Nil(t, qi.LeaseID)
+
+		// The leased item is left untouched.
+		qi, err = shard.LoadQueueItem(ctx, startA.ID)
+		require.NoError(t, err)
+		require.NotNil(t, qi.LeaseID)
+	})
+
+	t.Run("purges matching items", func(t *testing.T) {
+		f := &pb.QueueItemsFilter{
+			PartitionId: filter.PartitionId,
+			AccountId:   filter.AccountId,
+			EnvId:       filter.EnvId,
+			RunId:       runB.String(),
+		}
+
+		resp, err := d.PurgeQueueItems(ctx, &pb.PurgeQueueItemsRequest{Filter: f, DryRun: true})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.GetPurged())
+		require.Equal(t, []string{sleepB.ID}, resp.GetItemIds())
+
+		_, err = shard.LoadQueueItem(ctx, sleepB.ID)
+		require.NoError(t, err)
+
+		resp, err = d.PurgeQueueItems(ctx, &pb.PurgeQueueItemsRequest{Filter: f})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.GetPurged())
+
+		_, err = shard.LoadQueueItem(ctx, sleepB.ID)
+		require.ErrorIs(t, err, queue.ErrQueueItemNotFound)
+
+		list, err := d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter})
+		require.NoError(t, err)
+		require.Len(t, list.GetItems(), 1)
+		require.Equal(t, edgeA.ID, list.GetItems()[0].GetId())
+	})
+
+	t.Run("pages don't skip items once earlier items are removed", func(t *testing.T) {
+		later := enqueue(queue.KindEdge, runA, now.Add(2*time.Hour))
+		last := enqueue(queue.KindEdge, runA, now.Add(3*time.Hour))
+
+		resp, err := d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		require.Equal(t, edgeA.ID, resp.GetItems()[0].GetId())
+
+		qi, err := shard.LoadQueueItem(ctx, edgeA.ID)
+		require.NoError(t, err)
+		require.NoError(t, shard.Dequeue(ctx, *qi))
+
+		resp, err = d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter, Limit: 1, Cursor: resp.GetNextCursor()})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		require.Equal(t, later.ID, resp.GetItems()[0].GetId())
+
+		resp, err = d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter, Limit: 1, Cursor: resp.GetNextCursor()})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		require.Equal(t, last.ID, resp.GetItems()[0].GetId())
+		require.Empty(t, resp.GetNextCursor())
+
+		_, err = d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: filter, Cursor: "10"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("requires a partition or backlog", func(t *testing.T) {
+		_, err := d.ListQueueItems(ctx, &pb.QueueItemsRequest{Filter: &pb.QueueItemsFilter{}})
+		require.Error(t, err)
+	})
+}
+
+func TestShardMigrationHandlers(t *testing.T) {
+	rc, _ := setupTestRedis(t)
+	ctx := context.Background()
+
+	shard := redis_state.NewQueueShard(
+		consts.DefaultQueueShardName,
+		redis_state.NewUnshardedClient(rc, redis_state.StateDefaultKey, redis_state.QueueDefaultKey).Queue(),
+	)
+	shardRegistry, err := queue.NewSingleShardRegistry(shard)
+	require.NoError(t, err)
+
+	t.Run("nil migrator", func(t *testing.T) {
+		d := &debugAPI{shards: shardRegistry}
+		_, err := d.GetShardMigration(ctx, &pb.ShardMigrationRequest{Id: ulid.Make().String()})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	d := &debugAPI{
+		shards:   shardRegistry,
+		migrator: queue.NewShardMigrator(shardRegistry),
+	}
+
+	t.Run("target shard is required", func(t *testing.T) {
+		_, err := d.StartShardMigration(ctx, &pb.StartShardMigrationRequest{AccountId: uuid.New().String()})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown target shard", func(t *testing.T) {
+		_, err := d.StartShardMigration(ctx, &pb.StartShardMigrationRequest{
+			AccountId:   uuid.New().String(),
+			TargetShard: "missing",
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid migration id", func(t *testing.T) {
+		_, err := d.RollbackShardMigration(ctx, &pb.ShardMigrationRequest{Id: "nope"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown migration", func(t *testing.T) {
+		_, err := d.GetShardMigration(ctx, &pb.ShardMigrationRequest{Id: ulid.Make().String()})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("refuses functions with debounces", func(t *testing.T) {
+		db := &mockCQRSManager{fn: &cqrs.Function{
+			ID:     uuid.New(),
+			Slug:   "debounced",
+			Config: json.RawMessage(`{"debounce":{"period":"10s"}}`),
+		}}
+		d := &debugAPI{
+			shards:   shardRegistry,
+			migrator: queue.NewShardMigrator(shardRegistry, queue.WithShardMigratorPreflight(shardMigrationPreflight(db))),
+		}
+		_, err := d.StartShardMigration(ctx, &pb.StartShardMigrationRequest{
+			AccountId:   uuid.New().String(),
+			FunctionId:  db.fn.ID.String(),
+			TargetShard: consts.DefaultQueueShardName,
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Contains(t, err.Error(), "debounced uses debounce")
+	})
+}
@@ -92,6 +92,20 @@ func (d *debugAPI) GetBacklogs(ctx context.Context, req *pb.BacklogsRequest) (*p
 		backlogs = append(backlogs, mapBacklogToProto(bl, size))
 	}
 
+	// Report each concurrency key backlog's share of refills when fairness is
+	// enabled, relative to the other listed backlogs.
+	var totalWeight int32
+	for _, bl := range backlogs {
+		if len(bl.ConcurrencyKeys) > 0 {
+			totalWeight += bl.FairnessWeight
+		}
+	}
+	for _, bl := range backlogs {
+		if len(bl.ConcurrencyKeys) > 0 && totalWeight > 0 {
+			bl.FairnessShare = float64(bl.FairnessWeight) / float64(totalWeight)
+		}
+	}
+
 	return &pb.BacklogsResponse{
 		Backlogs:   backlogs,
 		TotalCount: count,
@@ -129,6 +143,7 @@ func mapBacklogToProto(bl *queue.QueueBacklog, itemCount int64) *pb.BacklogInfo
 		EarliestFunctionVersion: int32(bl.EarliestFunctionVersion),
 		Start:                   bl.Start,
 		ItemCount:               itemCount,
+		FairnessWeight:          int32(bl.FairnessWeight()),
 	}
 	for _, ck := range bl.ConcurrencyKeys {
 		info.ConcurrencyKeys = append(info.ConcurrencyKeys, &pb.BacklogConcurrencyKeyInfo{
@@ -139,6 +154,7 @@ func mapBacklogToProto(bl *queue.QueueBacklog, itemCount int64) *pb.BacklogInfo
 			HashedValue:         ck.HashedValue,
 			UnhashedValue:       ck.UnhashedValue,
 			ConcurrencyMode:     ck.ConcurrencyMode.String(),
+			Weight:              int32(ck.Weight),
 		})
 	}
 	if bl.Throttle != nil {
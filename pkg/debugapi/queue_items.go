@@ -0,0 +1,339 @@
+package debugapi
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	pb "github.com/inngest/inngest/proto/gen/debug/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultQueueItemsLimit = 100
+	maxQueueItemsLimit     = 10_000
+	defaultPurgeLimit      = 1_000
+)
+
+// ListQueueItems pages through the items of a partition or backlog, ordered by
+// the time they're scheduled at and their ID.  Leased items are in progress
+// and are not part of the partition or backlog, so they are never returned.
+//
+// The cursor is the position of the last item returned, so that items added or
+// removed between requests don't cause other items to be skipped or repeated.
+func (d *debugAPI) ListQueueItems(ctx context.Context, req *pb.QueueItemsRequest) (*pb.QueueItemsResponse, error) {
+	var after *queueItemCursor
+	if c := req.GetCursor(); c != "" {
+		cursor, err := parseQueueItemCursor(c)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		after = &cursor
+	}
+
+	limit := clampLimit(req.GetLimit(), defaultQueueItemsLimit)
+
+	shard, items, err := d.filteredQueueItems(ctx, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the first limit+1 items after the cursor, the extra item showing
+	// whether there's another page.  Items aren't iterated in cursor order, as
+	// partitions and backlogs are iterated one after another.
+	page := make([]*queue.QueueItem, 0, limit+1)
+	for qi := range items {
+		c := queueItemCursorOf(qi)
+		if after != nil && c.compare(*after) <= 0 {
+			continue
+		}
+		if len(page) == limit+1 && c.compare(queueItemCursorOf(page[limit])) >= 0 {
+			continue
+		}
+		i, _ := slices.BinarySearchFunc(page, c, func(qi *queue.QueueItem, c queueItemCursor) int {
+			return queueItemCursorOf(qi).compare(c)
+		})
+		page = slices.Insert(page, i, qi)
+		if len(page) > limit+1 {
+			page = page[:limit+1]
+		}
+	}
+
+	resp := &pb.QueueItemsResponse{QueueShard: shard.Name()}
+	if len(page) > limit {
+		page = page[:limit]
+		resp.NextCursor = queueItemCursorOf(page[limit-1]).String()
+	}
+
+	now := time.Now()
+	for _, qi := range page {
+		resp.Items = append(resp.Items, queueItemSummary(qi, now))
+	}
+
+	return resp, nil
+}
+
+// queueItemCursor is the position of a queue item when listing items.
+type queueItemCursor struct {
+	atMS int64
+	id   string
+}
+
+func queueItemCursorOf(qi *queue.QueueItem) queueItemCursor {
+	return queueItemCursor{atMS: qi.AtMS, id: qi.ID}
+}
+
+func parseQueueItemCursor(s string) (queueItemCursor, error) {
+	at, id, ok := strings.Cut(s, ":")
+	if !ok || id == "" {
+		return queueItemCursor{}, fmt.Errorf("invalid queue item cursor %q", s)
+	}
+	atMS, err := strconv.ParseInt(at, 10, 64)
+	if err != nil {
+		return queueItemCursor{}, fmt.Errorf("invalid queue item cursor %q: %w", s, err)
+	}
+	return queueItemCursor{atMS: atMS, id: id}, nil
+}
+
+func (c queueItemCursor) String() string {
+	return strconv.FormatInt(c.atMS, 10) + ":" + c.id
+}
+
+func (c queueItemCursor) compare(o queueItemCursor) int {
+	if n := cmp.Compare(c.atMS, o.atMS); n != 0 {
+		return n
+	}
+	return strings.Compare(c.id, o.id)
+}
+
+// RequeueQueueItems reschedules queue items.  Items leased by a worker are
+// skipped; the lease check and the requeue happen atomically within the
+// queue so that an item can never be requeued from under a worker.
+func (d *debugAPI) RequeueQueueItems(ctx context.Context, req *pb.RequeueQueueItemsRequest) (*pb.RequeueQueueItemsResponse, error) {
+	if len(req.GetItemIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "item_ids is required")
+	}
+	if req.GetDelayMs() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "delay_ms must not be negative")
+	}
+
+	shardName := consts.DefaultQueueShardName
+	if req.GetQueueShard() != "" {
+		shardName = req.GetQueueShard()
+	}
+	shard, err := d.shards.ByName(shardName)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("could not find queue shard %q", shardName))
+	}
+
+	at := time.Now().Add(time.Duration(req.GetDelayMs()) * time.Millisecond)
+
+	resp := &pb.RequeueQueueItemsResponse{QueueShard: shard.Name()}
+	for _, id := range req.GetItemIds() {
+		res := &pb.QueueItemResult{ItemId: id}
+		resp.Results = append(resp.Results, res)
+
+		qi, err := shard.LoadQueueItem(ctx, id)
+		if errors.Is(err, queue.ErrQueueItemNotFound) {
+			res.Status = pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_NOT_FOUND
+			continue
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Errorf("error loading queue item %q: %w", id, err).Error())
+		}
+
+		err = shard.Requeue(ctx, *qi, at, queue.RequeueRequireUnleased())
+		switch {
+		case err == nil:
+			res.Status = pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_OK
+			res.At = timestamppb.New(at)
+		case errors.Is(err, queue.ErrQueueItemAlreadyLeased):
+			res.Status = pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_LEASED
+		case errors.Is(err, queue.ErrQueueItemNotFound):
+			res.Status = pb.QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_NOT_FOUND
+		default:
+			return nil, status.Error(codes.Internal, fmt.Errorf("error requeueing queue item %q: %w", id, err).Error())
+		}
+	}
+
+	return resp, nil
+}
+
+// PurgeQueueItems removes the items matching a filter.  As with requeueing,
+// items leased by a worker are left in place.
+func (d *debugAPI) PurgeQueueItems(ctx context.Context, req *pb.PurgeQueueItemsRequest) (*pb.PurgeQueueItemsResponse, error) {
+	limit := clampLimit(req.GetLimit(), defaultPurgeLimit)
+
+	shard, items, err := d.filteredQueueItems(ctx, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect matches before removing anything, as dequeueing mutates the
+	// sets being iterated.
+	var matched []*queue.QueueItem
+	hasMore := false
+	for qi := range items {
+		if len(matched) == limit {
+			hasMore = true
+			break
+		}
+		matched = append(matched, qi)
+	}
+
+	resp := &pb.PurgeQueueItemsResponse{
+		QueueShard: shard.Name(),
+		HasMore:    hasMore,
+	}
+
+	for _, qi := range matched {
+		if !req.GetDryRun() {
+			err := shard.Dequeue(ctx, *qi, queue.DequeueRequireUnleased())
+			switch {
+			case errors.Is(err, queue.ErrQueueItemAlreadyLeased):
+				resp.SkippedLeased++
+				continue
+			case errors.Is(err, queue.ErrQueueItemNotFound):
+				continue
+			case err != nil:
+				return nil, status.Error(codes.Internal, fmt.Errorf("error purging queue item %q: %w", qi.ID, err).Error())
+			}
+		}
+		resp.Purged++
+		resp.ItemIds = append(resp.ItemIds, qi.ID)
+	}
+
+	return resp, nil
+}
+
+// filteredQueueItems resolves the shard for the filter and returns an
+// iterator over the partition or backlog items matching it.
+func (d *debugAPI) filteredQueueItems(ctx context.Context, f *pb.QueueItemsFilter) (queue.QueueShard, iter.Seq[*queue.QueueItem], error) {
+	if f == nil || (f.GetPartitionId() == "" && f.GetBacklogId() == "") {
+		return nil, nil, status.Error(codes.InvalidArgument, "either partition_id or backlog_id is required")
+	}
+	if f.GetPartitionId() != "" && f.GetBacklogId() != "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "only one of partition_id or backlog_id may be set")
+	}
+
+	scope := queue.Scope{
+		AccountID: consts.DevServerAccountID,
+		EnvID:     consts.DevServerEnvID,
+	}
+	if f.GetAccountId() != "" {
+		id, err := uuid.Parse(f.GetAccountId())
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid account_id: %w", err).Error())
+		}
+		scope.AccountID = id
+	}
+	if f.GetEnvId() != "" {
+		id, err := uuid.Parse(f.GetEnvId())
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid env_id: %w", err).Error())
+		}
+		scope.EnvID = id
+	}
+	if fnID, err := uuid.Parse(f.GetPartitionId()); err == nil {
+		scope.FunctionID = fnID
+	}
+
+	var (
+		shard queue.QueueShard
+		err   error
+	)
+	if f.GetQueueShard() != "" {
+		shard, err = d.shards.ByName(f.GetQueueShard())
+		if err != nil {
+			return nil, nil, status.Error(codes.NotFound, fmt.Sprintf("could not find queue shard %q", f.GetQueueShard()))
+		}
+	} else {
+		shard, err = d.shards.Resolve(ctx, scope, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not resolve queue shard for account %q: %w", scope.AccountID, err)
+		}
+	}
+
+	// Include items scheduled far in the future, eg. long sleeps.
+	until := time.Now().Add(365 * 24 * time.Hour)
+	opts := []queue.QueueIterOpt{queue.WithQueueItemIterInterval(0)}
+
+	var all iter.Seq[*queue.QueueItem]
+	if f.GetBacklogId() != "" {
+		all, err = d.backlogReader.ItemsByBacklog(ctx, shard, f.GetBacklogId(), time.Time{}, until, opts...)
+	} else {
+		all, err = d.partReader.ItemsByPartition(ctx, shard, scope, f.GetPartitionId(), time.Time{}, until, opts...)
+	}
+	if err != nil {
+		if errors.Is(err, queue.ErrPartitionNotFound) {
+			return nil, nil, status.Error(codes.NotFound, queue.ErrPartitionNotFound.Error())
+		}
+		return nil, nil, status.Error(codes.Internal, fmt.Errorf("error iterating queue items: %w", err).Error())
+	}
+
+	var enqueuedBefore int64
+	if f.GetMinAgeMs() > 0 {
+		enqueuedBefore = time.Now().UnixMilli() - f.GetMinAgeMs()
+	}
+
+	return shard, func(yield func(*queue.QueueItem) bool) {
+		for qi := range all {
+			if len(f.GetKinds()) > 0 && !slices.Contains(f.GetKinds(), qi.Data.Kind) {
+				continue
+			}
+			if f.GetRunId() != "" && qi.Data.Identifier.RunID.String() != f.GetRunId() {
+				continue
+			}
+			if enqueuedBefore > 0 && queueItemEnqueuedAt(qi) > enqueuedBefore {
+				continue
+			}
+			if !yield(qi) {
+				return
+			}
+		}
+	}, nil
+}
+
+func queueItemSummary(qi *queue.QueueItem, now time.Time) *pb.QueueItemSummary {
+	return &pb.QueueItemSummary{
+		Id:         qi.ID,
+		Kind:       qi.Data.Kind,
+		RunId:      qi.Data.Identifier.RunID.String(),
+		FunctionId: qi.FunctionID.String(),
+		Attempt:    int32(qi.Data.Attempt),
+		At:         timestamppb.New(time.UnixMilli(qi.AtMS)),
+		EnqueuedAt: timestamppb.New(time.UnixMilli(queueItemEnqueuedAt(qi))),
+		Leased:     qi.IsLeased(now),
+	}
+}
+
+// queueItemEnqueuedAt returns when the item was enqueued, falling back to
+// its scheduled time for items enqueued before EnqueuedAt was recorded.
+func queueItemEnqueuedAt(qi *queue.QueueItem) int64 {
+	if qi.EnqueuedAt > 0 {
+		return qi.EnqueuedAt
+	}
+	return qi.AtMS
+}
+
+func clampLimit(limit int64, def int) int {
+	switch {
+	case limit <= 0:
+		return def
+	case limit > maxQueueItemsLimit:
+		return maxQueueItemsLimit
+	default:
+		return int(limit)
+	}
+}
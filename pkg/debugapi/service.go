@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/inngest/inngest/pkg/audit"
 	"github.com/inngest/inngest/pkg/constraintapi"
 	"github.com/inngest/inngest/pkg/cqrs"
 	"github.com/inngest/inngest/pkg/execution/batch"
@@ -27,8 +28,24 @@ func NewDebugAPI(o Opts) service.Service {
 		port = o.Port
 	}
 
+	migrator := o.ShardMigrator
+	if migrator == nil && o.ShardRegistry != nil {
+		var opts []queue.ShardMigratorOpt
+		if o.DB != nil {
+			opts = append(opts, queue.WithShardMigratorPreflight(shardMigrationPreflight(o.DB)))
+		}
+		migrator = queue.NewShardMigrator(o.ShardRegistry, opts...)
+	}
+
+	var serverOpts []grpc.ServerOption
+	if o.AuditLog != nil {
+		serverOpts = append(serverOpts, grpc.UnaryInterceptor(
+			audit.UnaryServerInterceptor(o.AuditLog, audit.SourceDebug, isAuditedMethod),
+		))
+	}
+
 	return &debugAPI{
-		rpc:           grpc.NewServer(),
+		rpc:           grpc.NewServer(serverOpts...),
 		port:          port,
 		log:           o.Log,
 		db:            o.DB,
@@ -39,6 +56,7 @@ func NewDebugAPI(o Opts) service.Service {
 		state:         o.State,
 		croner:        o.Cron,
 		shards:        o.ShardRegistry,
+		migrator:      migrator,
 		pm:            o.PauseManager,
 		cm:            o.CapacityManager,
 		sm:            o.SemaphoreManager,
@@ -61,21 +79,53 @@ type Opts struct {
 	SemaphoreManager constraintapi.SemaphoreManager
 
 	ShardRegistry queue.ShardRegistry
+	// ShardMigrator moves accounts between queue shards.  Defaults to a
+	// migrator over ShardRegistry, refusing functions with singletons,
+	// debounces or batches when DB is set.
+	ShardMigrator queue.ShardMigrator
 
 	// Dependencies for batching and debounce insights
 	BatchManager batch.BatchManager
 	Debouncer    debounce.Debouncer
 
+	// AuditLog, if set, records calls which mutate queues, semaphores,
+	// batches, debounces, singletons or shards to the audit log.
+	AuditLog cqrs.AuditLogWriter
+
 	Port int
 }
 
+// auditedMethods are the debug API methods which mutate state, recorded to
+// the audit log.
+var auditedMethods = map[string]bool{
+	pb.Debug_RequeueQueueItems_FullMethodName:         true,
+	pb.Debug_PurgeQueueItems_FullMethodName:           true,
+	pb.Debug_SetSemaphoreLevel_FullMethodName:         true,
+	pb.Debug_SetAppSemaphoreLevel_FullMethodName:      true,
+	pb.Debug_SetFunctionSemaphoreLevel_FullMethodName: true,
+	pb.Debug_DeleteBatch_FullMethodName:               true,
+	pb.Debug_RunBatch_FullMethodName:                  true,
+	pb.Debug_DeleteSingletonLock_FullMethodName:       true,
+	pb.Debug_DeleteDebounce_FullMethodName:            true,
+	pb.Debug_RunDebounce_FullMethodName:               true,
+	pb.Debug_DeleteDebounceByID_FullMethodName:        true,
+	pb.Debug_StartShardMigration_FullMethodName:       true,
+	pb.Debug_CancelShardMigration_FullMethodName:      true,
+	pb.Debug_RollbackShardMigration_FullMethodName:    true,
+}
+
+func isAuditedMethod(fullMethod string) bool {
+	return auditedMethods[fullMethod]
+}
+
 type debugAPI struct {
 	pb.DebugServer
 	port int
 
-	rpc    *grpc.Server
-	log    logger.Logger
-	shards queue.ShardRegistry
+	rpc      *grpc.Server
+	log      logger.Logger
+	shards   queue.ShardRegistry
+	migrator queue.ShardMigrator
 
 	db            cqrs.Manager
 	runReader     queue.RunQueueReader
@@ -0,0 +1,228 @@
+package debugapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	pb "github.com/inngest/inngest/proto/gen/debug/v1"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartShardMigration starts moving an account or function to another queue
+// shard.  The migration runs in the background; use GetShardMigration to
+// follow its progress.
+func (d *debugAPI) StartShardMigration(ctx context.Context, req *pb.StartShardMigrationRequest) (*pb.ShardMigration, error) {
+	if d.migrator == nil {
+		return nil, status.Error(codes.Unimplemented, "shard migrations are not available")
+	}
+	if req.GetTargetShard() == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_shard is required")
+	}
+	if req.GetDrainTimeoutMs() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "drain_timeout_ms must not be negative")
+	}
+
+	scope := queue.Scope{
+		AccountID: consts.DevServerAccountID,
+		EnvID:     consts.DevServerEnvID,
+	}
+	if req.GetAccountId() != "" {
+		id, err := uuid.Parse(req.GetAccountId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid account_id: %w", err).Error())
+		}
+		scope.AccountID = id
+	}
+	if req.GetEnvId() != "" {
+		id, err := uuid.Parse(req.GetEnvId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid env_id: %w", err).Error())
+		}
+		scope.EnvID = id
+	}
+	if req.GetFunctionId() != "" {
+		id, err := uuid.Parse(req.GetFunctionId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid function_id: %w", err).Error())
+		}
+		scope.FunctionID = id
+	}
+
+	for _, name := range []string{req.GetSourceShard(), req.GetTargetShard()} {
+		if name == "" {
+			continue
+		}
+		if _, err := d.shards.ByName(name); err != nil {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("could not find queue shard %q", name))
+		}
+	}
+
+	m, err := d.migrator.StartShardMigration(ctx, queue.ShardMigrationRequest{
+		Scope:        scope,
+		SourceShard:  req.GetSourceShard(),
+		TargetShard:  req.GetTargetShard(),
+		DrainTimeout: time.Duration(req.GetDrainTimeoutMs()) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, shardMigrationError(err)
+	}
+	return shardMigrationToProto(m), nil
+}
+
+// GetShardMigration retrieves the progress of a shard migration.
+func (d *debugAPI) GetShardMigration(ctx context.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error) {
+	return d.withShardMigration(ctx, req, queue.ShardMigrator.ShardMigration)
+}
+
+// ListShardMigrations lists the shard migrations started by this server.
+func (d *debugAPI) ListShardMigrations(ctx context.Context, req *pb.ShardMigrationsRequest) (*pb.ShardMigrationsResponse, error) {
+	if d.migrator == nil {
+		return nil, status.Error(codes.Unimplemented, "shard migrations are not available")
+	}
+	migrations, err := d.migrator.ShardMigrations(ctx)
+	if err != nil {
+		return nil, shardMigrationError(err)
+	}
+	resp := &pb.ShardMigrationsResponse{}
+	for _, m := range migrations {
+		resp.Migrations = append(resp.Migrations, shardMigrationToProto(m))
+	}
+	return resp, nil
+}
+
+// CancelShardMigration stops a running shard migration.
+func (d *debugAPI) CancelShardMigration(ctx context.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error) {
+	return d.withShardMigration(ctx, req, queue.ShardMigrator.CancelShardMigration)
+}
+
+// RollbackShardMigration starts moving the account or function of a migration
+// back to its source shard, returning the rollback migration.
+func (d *debugAPI) RollbackShardMigration(ctx context.Context, req *pb.ShardMigrationRequest) (*pb.ShardMigration, error) {
+	return d.withShardMigration(ctx, req, queue.ShardMigrator.RollbackShardMigration)
+}
+
+// shardMigrationPreflight refuses to migrate functions using singletons,
+// debounces or batches.  Their state is not stored with queue items, and
+// would be left behind on the source shard.
+func shardMigrationPreflight(db cqrs.Manager) func(ctx context.Context, scope queue.Scope) error {
+	return func(ctx context.Context, scope queue.Scope) error {
+		var fns []*cqrs.Function
+		if scope.FunctionID != uuid.Nil {
+			fn, err := db.GetFunctionByInternalUUID(ctx, scope.FunctionID)
+			if err != nil {
+				return fmt.Errorf("could not load function %s: %w", scope.FunctionID, err)
+			}
+			fns = append(fns, fn)
+		} else {
+			all, err := db.GetFunctions(ctx)
+			if err != nil {
+				return fmt.Errorf("could not load functions: %w", err)
+			}
+			for _, fn := range all {
+				if scope.EnvID == uuid.Nil || fn.EnvID == scope.EnvID {
+					fns = append(fns, fn)
+				}
+			}
+		}
+
+		for _, fn := range fns {
+			if fn.IsArchived() {
+				continue
+			}
+			f, err := fn.InngestFunction()
+			if err != nil {
+				return fmt.Errorf("could not parse function %s: %w", fn.Slug, err)
+			}
+			var uses []string
+			if f.Singleton != nil {
+				uses = append(uses, "singleton")
+			}
+			if f.Debounce != nil {
+				uses = append(uses, "debounce")
+			}
+			if f.EventBatch != nil {
+				uses = append(uses, "batching")
+			}
+			if len(uses) > 0 {
+				return fmt.Errorf("%w: function %s uses %s", queue.ErrShardMigrationUnsupported, fn.Slug, strings.Join(uses, ", "))
+			}
+		}
+		return nil
+	}
+}
+
+func (d *debugAPI) withShardMigration(
+	ctx context.Context,
+	req *pb.ShardMigrationRequest,
+	fn func(m queue.ShardMigrator, ctx context.Context, id ulid.ULID) (queue.ShardMigration, error),
+) (*pb.ShardMigration, error) {
+	if d.migrator == nil {
+		return nil, status.Error(codes.Unimplemented, "shard migrations are not available")
+	}
+	id, err := ulid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid id: %w", err).Error())
+	}
+	m, err := fn(d.migrator, ctx, id)
+	if err != nil {
+		return nil, shardMigrationError(err)
+	}
+	return shardMigrationToProto(m), nil
+}
+
+func shardMigrationError(err error) error {
+	switch {
+	case errors.Is(err, queue.ErrShardMigrationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, queue.ErrShardMigrationInProgress),
+		errors.Is(err, queue.ErrShardMigrationUnsupported):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func shardMigrationToProto(m queue.ShardMigration) *pb.ShardMigration {
+	out := &pb.ShardMigration{
+		Id:          m.ID.String(),
+		AccountId:   m.Scope.AccountID.String(),
+		SourceShard: m.SourceShard,
+		TargetShard: m.TargetShard,
+		Status:      string(m.Status),
+		Phase:       string(m.Phase),
+		Error:       m.Error,
+		Functions:   int64(m.Functions),
+		Passes:      int64(m.Passes),
+		ItemsMoved:  m.ItemsMoved,
+		ItemsLeased: m.ItemsLeased,
+		InProgress:  m.InProgress,
+		StartedAt:   timestamppb.New(m.StartedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+	}
+	if m.Scope.EnvID != uuid.Nil {
+		out.EnvId = m.Scope.EnvID.String()
+	}
+	if m.Scope.FunctionID != uuid.Nil {
+		out.FunctionId = m.Scope.FunctionID.String()
+	}
+	if m.RollbackOf != nil {
+		out.RollbackOf = m.RollbackOf.String()
+	}
+	if m.RolledBackBy != nil {
+		out.RolledBackBy = m.RolledBackBy.String()
+	}
+	if !m.FinishedAt.IsZero() {
+		out.FinishedAt = timestamppb.New(m.FinishedAt)
+	}
+	return out
+}
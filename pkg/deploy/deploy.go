@@ -46,7 +46,9 @@ type pingResult struct {
 	IsSDK bool
 }
 
-func Ping(ctx context.Context, url string, serverKind string, signingKey string, requireKeys bool) pingResult {
+// Ping asks the SDK at the given URL to sync its functions.  The app's TLS
+// config is used to call the SDK, if set.
+func Ping(ctx context.Context, url string, serverKind string, signingKey string, requireKeys bool, tlsConfig *exechttp.TLSConfig) pingResult {
 	if requireKeys && signingKey == "" {
 		return pingResult{
 			Err: DeployErrNoServerSigningKey,
@@ -89,7 +91,21 @@ func Ping(ctx context.Context, url string, serverKind string, signingKey string,
 		req.Header.Set(headers.HeaderKeySignature, reqSig)
 	}
 
-	resp, err := Client.Do(req)
+	client, err := exechttp.ClientForTLS(&Client, tlsConfig)
+	if err != nil {
+		return pingResult{
+			Err: publicerr.WrapWithData(
+				err,
+				400,
+				fmt.Sprintf("There was an error loading your app's TLS config: %s", err.Error()),
+				map[string]any{
+					"error_code": err.Error(),
+				},
+			),
+		}
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		err = handlePingError(err)
 		return pingResult{
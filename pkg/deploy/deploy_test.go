@@ -56,7 +56,7 @@ func TestPing(t *testing.T) {
 		r.NoError(err)
 		defer close()
 
-		Ping(ctx, url, "my-server-kind", "deadbeef", true)
+		Ping(ctx, url, "my-server-kind", "deadbeef", true, nil)
 		r.Equal(map[string]any{"url": url}, reqBody)
 
 		// We need this check to prevent a regression. Apparently using
@@ -0,0 +1,159 @@
+package devserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	apiv2 "github.com/inngest/inngest/pkg/api/v2"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/alerts"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/metrics"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// alertRunsPageSize is the number of runs loaded per query when
+	// evaluating a rule.
+	alertRunsPageSize = 1_000
+	// alertRunsLimit caps the runs sampled per evaluation, keeping
+	// evaluations cheap for busy functions with long windows.
+	alertRunsLimit = 10_000
+)
+
+// NewAlertProvider returns an API v2 alert provider which manages alert rules
+// within the dev server's environment.
+func NewAlertProvider(m alerts.Manager) apiv2.AlertProvider {
+	return &alertProvider{m: m}
+}
+
+type alertProvider struct {
+	m alerts.Manager
+}
+
+func (p *alertProvider) CreateRule(ctx context.Context, opts apiv2.CreateAlertRuleOpts) (*alerts.Rule, error) {
+	return p.m.CreateRule(ctx, alerts.CreateRuleOpts{
+		AccountID:    consts.DevServerAccountID,
+		WorkspaceID:  consts.DevServerEnvID,
+		Name:         opts.Name,
+		FunctionID:   opts.FunctionID,
+		FunctionSlug: opts.FunctionSlug,
+		Metric:       opts.Metric,
+		SkipReason:   opts.SkipReason,
+		Threshold:    opts.Threshold,
+		Window:       opts.Window,
+		Channels:     opts.Channels,
+		Disabled:     opts.Disabled,
+	})
+}
+
+func (p *alertProvider) GetRule(ctx context.Context, id ulid.ULID) (*alerts.Rule, error) {
+	return p.m.GetRule(ctx, consts.DevServerEnvID, id)
+}
+
+func (p *alertProvider) ListRules(ctx context.Context) ([]alerts.Rule, error) {
+	return p.m.ListRules(ctx, consts.DevServerEnvID)
+}
+
+func (p *alertProvider) UpdateRule(ctx context.Context, id ulid.ULID, opts alerts.UpdateRuleOpts) (*alerts.Rule, error) {
+	return p.m.UpdateRule(ctx, consts.DevServerEnvID, id, opts)
+}
+
+func (p *alertProvider) DeleteRule(ctx context.Context, id ulid.ULID) error {
+	return p.m.DeleteRule(ctx, consts.DevServerEnvID, id)
+}
+
+func (p *alertProvider) ListAlerts(ctx context.Context, opts alerts.ListAlertsOpts) ([]alerts.Alert, bool, error) {
+	return p.m.ListAlerts(ctx, consts.DevServerEnvID, opts)
+}
+
+// NewAlertSource returns the source of the metrics alert rules are evaluated
+// against, reading ended runs from CQRS and backlog age from the queue.  The
+// queue may be nil if it doesn't report statistics, in which case backlog age
+// rules can't be evaluated.
+func NewAlertSource(data cqrs.Manager, q metrics.QueueStatsProvider) alerts.Source {
+	return &alertSource{data: data, queue: q}
+}
+
+type alertSource struct {
+	data  cqrs.Manager
+	queue metrics.QueueStatsProvider
+
+	// stats caches queue statistics, which are loaded for every function at
+	// once, between evaluations of each rule.
+	mu        sync.Mutex
+	stats     []queue.FunctionQueueStats
+	fetchedAt time.Time
+}
+
+func (s *alertSource) Runs(ctx context.Context, r alerts.Rule, from, until time.Time) ([]alerts.RunSample, error) {
+	var (
+		samples []alerts.RunSample
+		cursor  string
+	)
+	for len(samples) < alertRunsLimit {
+		rows, err := s.data.GetRuns(ctx, cqrs.GetTraceRunOpt{
+			Filter: cqrs.GetTraceRunFilter{
+				AccountID:   r.AccountID,
+				WorkspaceID: r.WorkspaceID,
+				FunctionID:  []uuid.UUID{r.FunctionID},
+				TimeField:   enums.TraceRunTimeEndedAt,
+				From:        from,
+				Until:       until,
+				Status: []enums.RunStatus{
+					enums.RunStatusCompleted,
+					enums.RunStatusFailed,
+					enums.RunStatusCancelled,
+				},
+			},
+			Order: []cqrs.GetTraceRunOrder{{
+				Field:     enums.TraceRunTimeEndedAt,
+				Direction: enums.TraceRunOrderDesc,
+			}},
+			Cursor: cursor,
+			Items:  alertRunsPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			samples = append(samples, alerts.RunSample{
+				Failed:   row.Status == enums.RunStatusFailed,
+				Duration: row.Duration,
+			})
+		}
+		if len(rows) < alertRunsPageSize {
+			break
+		}
+		cursor = rows[len(rows)-1].Cursor
+	}
+	return samples, nil
+}
+
+func (s *alertSource) BacklogAge(ctx context.Context, r alerts.Rule, now time.Time) (time.Duration, error) {
+	if s.queue == nil {
+		return 0, fmt.Errorf("queue statistics are not supported")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fetchedAt.IsZero() || now.Sub(s.fetchedAt) >= metrics.DefaultQueueStatsTTL {
+		stats, err := s.queue.FunctionQueueStats(ctx)
+		if err != nil {
+			return 0, err
+		}
+		s.stats, s.fetchedAt = stats, now
+	}
+
+	for _, st := range s.stats {
+		if st.FunctionID == r.FunctionID && !st.OldestDueAt.IsZero() {
+			return max(now.Sub(st.OldestDueAt), 0), nil
+		}
+	}
+	return 0, nil
+}
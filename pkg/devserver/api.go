@@ -317,6 +317,11 @@ func (a devapi) register(ctx context.Context, r sdk.RegisterRequest) (*sync.Repl
 			if app.Name != "" || util.NormalizeAppURL(app.Url, false) != normalizedURL {
 				continue
 			}
+			// Keep the TLS config set when the app was added by URL, as the
+			// SDK can't provide it.
+			if appParams.TLS == nil {
+				appParams.TLS = app.TLS
+			}
 			fns, fnsErr := a.devserver.Data.GetFunctionsByAppInternalID(ctx, app.ID)
 			if fnsErr == nil && len(fns) > 0 {
 				// Legacy row with attached functions — adopt the row by id
@@ -326,6 +331,7 @@ func (a devapi) register(ctx context.Context, r sdk.RegisterRequest) (*sync.Repl
 				if _, err := a.devserver.Data.UpsertApp(ctx, adopt); err != nil {
 					l.Error("error adopting URL-keyed legacy app row", "appID", app.ID, "error", err)
 				}
+				a.devserver.appTLS.Invalidate(app.ID)
 				continue
 			}
 			if err := a.devserver.Data.DeleteApp(ctx, app.ID); err != nil {
@@ -418,6 +424,7 @@ func (a devapi) register(ctx context.Context, r sdk.RegisterRequest) (*sync.Repl
 		if err != nil {
 			l.Error("error registering functions", "error", err)
 		}
+		a.devserver.appTLS.Invalidate(appID)
 
 		// handle cron sync to system queue
 		for _, ci := range crons {
@@ -511,6 +518,19 @@ func (a devapi) register(ctx context.Context, r sdk.RegisterRequest) (*sync.Repl
 			return nil, publicerr.Wrap(err, 500, "Error marshalling function")
 		}
 
+		// Record the config under its version so that runs can be tied back
+		// to the exact config they executed with, and syncs can be diffed.
+		err = tx.InsertFunctionVersion(ctx, cqrs.FunctionVersion{
+			FunctionID: fn.ID,
+			Version:    fn.FunctionVersion,
+			AppID:      appID,
+			SyncID:     syncID,
+			Config:     config,
+		})
+		if err != nil {
+			return nil, publicerr.Wrap(err, 500, "Error recording function version")
+		}
+
 		if fnExists {
 			// Update the function config.
 			_, err = tx.UpdateFunctionConfig(ctx, cqrs.UpdateFunctionConfigParams{
@@ -0,0 +1,91 @@
+package devserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiv2 "github.com/inngest/inngest/pkg/api/v2"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/execution/bulk"
+	"github.com/oklog/ulid/v2"
+)
+
+// NewBulkRunOperationProvider returns an API v2 bulk operation provider which
+// manages operations within the dev server's environment.
+func NewBulkRunOperationProvider(m bulk.Manager) apiv2.BulkRunOperationProvider {
+	return &bulkRunOperationProvider{m: m}
+}
+
+type bulkRunOperationProvider struct {
+	m bulk.Manager
+}
+
+func (p *bulkRunOperationProvider) Preview(ctx context.Context, f bulk.Filter) (int, error) {
+	return p.m.Preview(ctx, consts.DevServerAccountID, consts.DevServerEnvID, f)
+}
+
+func (p *bulkRunOperationProvider) Create(ctx context.Context, opts apiv2.CreateBulkRunOperationOpts) (*bulk.Operation, error) {
+	return p.m.Create(ctx, bulk.CreateOpts{
+		AccountID:     consts.DevServerAccountID,
+		WorkspaceID:   consts.DevServerEnvID,
+		Action:        opts.Action,
+		Filter:        opts.Filter,
+		RatePerSecond: opts.RatePerSecond,
+	})
+}
+
+func (p *bulkRunOperationProvider) Get(ctx context.Context, id ulid.ULID) (*bulk.Operation, error) {
+	return p.m.Get(ctx, consts.DevServerEnvID, id)
+}
+
+func (p *bulkRunOperationProvider) List(ctx context.Context, opts bulk.ListOpts) ([]bulk.Operation, bool, error) {
+	return p.m.List(ctx, consts.DevServerEnvID, opts)
+}
+
+func (p *bulkRunOperationProvider) Abort(ctx context.Context, id ulid.ULID) (*bulk.Operation, error) {
+	return p.m.Abort(ctx, consts.DevServerEnvID, id)
+}
+
+// NewBulkRunActioner returns a bulk.RunActioner which cancels and reruns runs
+// using the given run provider, so that bulk operations behave identically to
+// cancelling or rerunning a single run via the API.
+func NewBulkRunActioner(runs apiv2.RunProvider) bulk.RunActioner {
+	return &bulkRunActioner{runs: runs}
+}
+
+type bulkRunActioner struct {
+	runs apiv2.RunProvider
+}
+
+func (a *bulkRunActioner) Cancel(ctx context.Context, op bulk.Operation, run *cqrs.TraceRun) error {
+	runID, err := ulid.Parse(run.RunID)
+	if err != nil {
+		return fmt.Errorf("invalid run ID: %w", err)
+	}
+
+	err = a.runs.Cancel(ctx, runID)
+	switch {
+	case errors.Is(err, apiv2.ErrRunNotFound),
+		errors.Is(err, apiv2.ErrRunAlreadyCancelled),
+		errors.Is(err, apiv2.ErrRunEnded):
+		return fmt.Errorf("%w: %w", bulk.ErrRunSkipped, err)
+	}
+	return err
+}
+
+func (a *bulkRunActioner) Rerun(ctx context.Context, op bulk.Operation, run *cqrs.TraceRun) error {
+	runID, err := ulid.Parse(run.RunID)
+	if err != nil {
+		return fmt.Errorf("invalid run ID: %w", err)
+	}
+
+	_, err = a.runs.Rerun(ctx, runID, apiv2.RerunOpts{})
+	switch {
+	case errors.Is(err, apiv2.ErrRunNotFound),
+		errors.Is(err, apiv2.ErrCronRerunNotSupported):
+		return fmt.Errorf("%w: %w", bulk.ErrRunSkipped, err)
+	}
+	return err
+}
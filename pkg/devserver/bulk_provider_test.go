@@ -0,0 +1,80 @@
+package devserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apiv2 "github.com/inngest/inngest/pkg/api/v2"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/bulk"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkRunActionerMapsSkippedRuns(t *testing.T) {
+	ctx := context.Background()
+	runID := ulid.MustParse("01HR3ZJ4Z4E0MZ6PRP7Z3A4T00")
+	run := &cqrs.TraceRun{RunID: runID.String()}
+
+	tests := []struct {
+		name    string
+		action  enums.BulkOperationAction
+		err     error
+		skipped bool
+	}{
+		{name: "cancel"},
+		{name: "rerun", action: enums.BulkOperationActionRerun},
+		{name: "cancel not found", err: apiv2.ErrRunNotFound, skipped: true},
+		{name: "cancel already cancelled", err: apiv2.ErrRunAlreadyCancelled, skipped: true},
+		{name: "cancel ended", err: apiv2.ErrRunEnded, skipped: true},
+		{name: "cancel internal error", err: errors.New("boom")},
+		{name: "rerun not found", action: enums.BulkOperationActionRerun, err: apiv2.ErrRunNotFound, skipped: true},
+		{name: "rerun cron", action: enums.BulkOperationActionRerun, err: apiv2.ErrCronRerunNotSupported, skipped: true},
+		{name: "rerun internal error", action: enums.BulkOperationActionRerun, err: errors.New("boom")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runs := &stubBulkRunProvider{err: test.err}
+			a := NewBulkRunActioner(runs)
+
+			op := bulk.Operation{Action: test.action}
+			var err error
+			if test.action == enums.BulkOperationActionRerun {
+				err = a.Rerun(ctx, op, run)
+			} else {
+				err = a.Cancel(ctx, op, run)
+			}
+
+			switch {
+			case test.err == nil:
+				require.NoError(t, err)
+			case test.skipped:
+				require.ErrorIs(t, err, bulk.ErrRunSkipped)
+				require.ErrorIs(t, err, test.err)
+			default:
+				require.ErrorIs(t, err, test.err)
+				require.NotErrorIs(t, err, bulk.ErrRunSkipped)
+			}
+			require.Equal(t, []ulid.ULID{runID}, runs.called)
+		})
+	}
+}
+
+type stubBulkRunProvider struct {
+	apiv2.RunProvider
+	err    error
+	called []ulid.ULID
+}
+
+func (s *stubBulkRunProvider) Cancel(ctx context.Context, runID ulid.ULID) error {
+	s.called = append(s.called, runID)
+	return s.err
+}
+
+func (s *stubBulkRunProvider) Rerun(ctx context.Context, runID ulid.ULID, opts apiv2.RerunOpts) (ulid.ULID, error) {
+	s.called = append(s.called, runID)
+	return ulid.Make(), s.err
+}
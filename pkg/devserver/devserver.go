@@ -2,7 +2,9 @@ package devserver
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -48,23 +50,33 @@ import (
 	"github.com/inngest/inngest/pkg/enums"
 	"github.com/inngest/inngest/pkg/event"
 	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/alerts"
 	"github.com/inngest/inngest/pkg/execution/batch"
+	"github.com/inngest/inngest/pkg/execution/bulk"
 	"github.com/inngest/inngest/pkg/execution/cron"
 	"github.com/inngest/inngest/pkg/execution/debounce"
 	"github.com/inngest/inngest/pkg/execution/driver"
+	"github.com/inngest/inngest/pkg/execution/driver/execdriver"
+	"github.com/inngest/inngest/pkg/execution/driver/grpcdriver"
 	"github.com/inngest/inngest/pkg/execution/driver/httpv2"
 	"github.com/inngest/inngest/pkg/execution/exechttp"
 	"github.com/inngest/inngest/pkg/execution/executor"
 	"github.com/inngest/inngest/pkg/execution/history"
+	"github.com/inngest/inngest/pkg/execution/history/historystream"
 	"github.com/inngest/inngest/pkg/execution/pauses"
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/ratelimit"
 	"github.com/inngest/inngest/pkg/execution/realtime"
 	"github.com/inngest/inngest/pkg/execution/runner"
+	"github.com/inngest/inngest/pkg/execution/scheduled"
 	"github.com/inngest/inngest/pkg/execution/singleton"
 	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state"
 	"github.com/inngest/inngest/pkg/execution/state/redis_state"
 	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/stepcache"
+	"github.com/inngest/inngest/pkg/execution/steplock"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
 	"github.com/inngest/inngest/pkg/expressions"
 	"github.com/inngest/inngest/pkg/expressions/expragg"
 	"github.com/inngest/inngest/pkg/logger"
@@ -76,11 +88,13 @@ import (
 	"github.com/inngest/inngest/pkg/testapi"
 	"github.com/inngest/inngest/pkg/tracing"
 	"github.com/inngest/inngest/pkg/tracing/metadata/extractors"
+	"github.com/inngest/inngest/pkg/usage"
 	"github.com/inngest/inngest/pkg/util"
 	"github.com/inngest/inngest/pkg/util/awsgateway"
 	"github.com/jonboulle/clockwork"
 	"github.com/redis/rueidis"
 	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -154,8 +168,64 @@ type StartOpts struct {
 	// SQLiteDir specifies where SQLite files should be stored
 	SQLiteDir string `json:"sqlite_dir"`
 
+	// QueueBackend selects the queue shard implementation.  The Postgres
+	// backend requires PostgresURI to be set.  Defaults to Redis.
+	QueueBackend string `json:"queue_backend"`
+
+	// StateBackend selects the store used for run state and pauses.  The
+	// Postgres backend requires PostgresURI and RedisURI to be set, as other
+	// execution state is stored in Redis.  Defaults to Redis.
+	StateBackend string `json:"state_backend"`
+
+	// MetricsMaxFunctions is the maximum number of functions reported with
+	// their own label in per-function queue metrics.  Zero uses the default,
+	// and a negative value reports all functions within a single series.
+	MetricsMaxFunctions int `json:"metrics_max_functions"`
+
+	// IngestBacklogLimit is the number of due, unprocessed queue items across
+	// all functions at which the event API applies back-pressure.  Zero
+	// disables the limit.
+	IngestBacklogLimit int64 `json:"ingest_backlog_limit"`
+	// IngestFunctionBacklogLimit is the number of due, unprocessed queue items
+	// for a single function at which the event API applies back-pressure to
+	// events triggering the function.  Zero disables the limit.
+	IngestFunctionBacklogLimit int64 `json:"ingest_function_backlog_limit"`
+	// IngestBackpressure selects how back-pressure is applied: "reject"
+	// responds with a 429 and Retry-After, and "defer" accepts events but
+	// defers fan-out until the backlog drains.  Defaults to "reject".
+	IngestBackpressure string `json:"ingest_backpressure"`
+
+	// TraceExport exports run traces to an external OTLP collector.  Export is
+	// disabled if no endpoint is set.
+	TraceExport tracing.OTLPExportOpts `json:"trace_export"`
+
+	// HistoryStream publishes run history to Kafka or NATS.  Publishing is
+	// disabled if no broker is set.
+	HistoryStream historystream.Opts `json:"history_stream"`
+
 	// Debug API
 	DebugAPIPort int `json:"debugAPIPort"`
+
+	// ExecDirs are the directories containing executables which may be run
+	// by exec:// functions.  If empty, exec functions are disabled.
+	ExecDirs []string `json:"exec_dirs"`
+	// ExecEnv are the names of environment variables passed from the
+	// server's environment to exec functions.
+	ExecEnv []string `json:"exec_env"`
+	// ExecTimeout is the maximum time each exec function process may run.
+	// Zero uses the driver's default.
+	ExecTimeout time.Duration `json:"exec_timeout"`
+	// ExecLimits are the maximum resource limits for each exec function
+	// process.
+	ExecLimits execdriver.Limits `json:"exec_limits"`
+
+	// GRPCCAFile is a PEM file of the CAs used to verify grpcs:// function
+	// servers.  If empty, the system roots are used.
+	GRPCCAFile string `json:"grpc_ca_file"`
+	// GRPCCertFile and GRPCKeyFile are the PEM client certificate and key
+	// presented to grpcs:// function servers for mTLS.
+	GRPCCertFile string `json:"grpc_cert_file"`
+	GRPCKeyFile  string `json:"grpc_key_file"`
 }
 
 // Create and start a new dev server.  The dev server is used during (surprise surprise)
@@ -176,6 +246,23 @@ func enforceConnectLeaseExpiry(ctx context.Context, accountID uuid.UUID) bool {
 	return os.Getenv("INNGEST_CONNECT_DISABLE_ENFORCE_LEASE_EXPIRY") != "true"
 }
 
+// validateStateBackend checks that the selected state backend is durable.  Only
+// run state and pauses are stored in Postgres: batches, debounces, singletons
+// and capacity leases are always stored in Redis, so the Postgres backend
+// requires external Redis for them to survive restarts.
+func validateStateBackend(opts StartOpts) error {
+	if enums.StateStoreKind(opts.StateBackend) != enums.StateStoreKindPostgres {
+		return nil
+	}
+	if opts.PostgresURI == "" {
+		return fmt.Errorf("the postgres state backend requires a postgres URI")
+	}
+	if opts.RedisURI == "" {
+		return fmt.Errorf("the postgres state backend requires a redis URI, as batches, debounces and singletons are stored in redis")
+	}
+	return nil
+}
+
 func start(ctx context.Context, opts StartOpts) error {
 	l := logger.StdlibLogger(ctx)
 	ctx = logger.WithStdlib(ctx, l)
@@ -186,6 +273,10 @@ func start(ctx context.Context, opts StartOpts) error {
 		opts.Tick = DefaultTickDuration
 	}
 
+	if err := validateStateBackend(opts); err != nil {
+		return err
+	}
+
 	var err error
 
 	// Initialize the devserver
@@ -193,9 +284,10 @@ func start(ctx context.Context, opts StartOpts) error {
 		dbpkg.Adapter
 		Helpers() driverhelp.DialectHelpers
 	}
+	var pgDB *sql.DB
 	if opts.PostgresURI != "" {
 		// Pool settings are in minutes, mirroring the postgres-conn-max-* flags.
-		db, err := dbpostgres.Open(ctx, dbpostgres.Options{
+		pgDB, err = dbpostgres.Open(ctx, dbpostgres.Options{
 			URI:             opts.PostgresURI,
 			MaxIdleConns:    opts.PostgresMaxIdleConns,
 			MaxOpenConns:    opts.PostgresMaxOpenConns,
@@ -205,7 +297,7 @@ func start(ctx context.Context, opts StartOpts) error {
 		if err != nil {
 			return err
 		}
-		adapter = dbpostgres.New(db)
+		adapter = dbpostgres.New(pgDB)
 	} else {
 		db, err := dbsqlite.Open(ctx, dbsqlite.Options{
 			Persist:   opts.Persist,
@@ -218,6 +310,17 @@ func start(ctx context.Context, opts StartOpts) error {
 	}
 	dbcqrs := cqrsmanager.New(adapter)
 	hd := base_cqrs.NewHistoryDriver(adapter)
+	historyDrivers := []history.Driver{hd}
+	// historyStream publishes run history to an external broker, eg. for
+	// loading into a data warehouse.
+	var historyStream history.Driver
+	if opts.HistoryStream.Enabled() {
+		historyStream, err = historystream.New(ctx, opts.HistoryStream)
+		if err != nil {
+			return fmt.Errorf("failed to create history stream: %w", err)
+		}
+		historyDrivers = append(historyDrivers, historyStream)
+	}
 	loader := dbcqrs.(state.FunctionLoader)
 
 	stepLimitOverrides := make(map[string]int)
@@ -301,14 +404,34 @@ func start(ctx context.Context, opts StartOpts) error {
 		QueueDefaultKey:        redis_state.QueueDefaultKey,
 	})
 
-	pauseMgr := pauses.NewPauseStoreManager(unshardedClient)
-
-	var sm state.Manager
-	sm, err = redis_state.New(ctx, redis_state.WithShardedClient(shardedClient), redis_state.WithPauseDeleter(pauseMgr))
-	if err != nil {
-		return err
+	var (
+		pauseMgr pauses.Manager
+		sm       state.Manager
+		smv2     sv2.RunService
+	)
+	stateBackend := enums.StateStoreKind(opts.StateBackend)
+	if stateBackend == "" {
+		stateBackend = enums.StateStoreKindRedis
+	}
+	switch stateBackend {
+	case enums.StateStoreKindPostgres:
+		pauseMgr = pauses.NewManager(pauses.StateBufferer(postgres_state.NewPauseStore(pgDB)), nil)
+		sm, err = postgres_state.New(ctx, pgDB, postgres_state.WithPauseDeleter(pauseMgr))
+		if err != nil {
+			return err
+		}
+		smv2 = postgres_state.MustRunServiceV2(sm)
+	case enums.StateStoreKindRedis:
+		pauseMgr = pauses.NewPauseStoreManager(unshardedClient)
+		sm, err = redis_state.New(ctx, redis_state.WithShardedClient(shardedClient), redis_state.WithPauseDeleter(pauseMgr))
+		if err != nil {
+			return err
+		}
+		smv2 = redis_state.MustRunServiceV2(sm)
+	default:
+		return fmt.Errorf("unknown state backend %q", opts.StateBackend)
 	}
-	smv2 := redis_state.MustRunServiceV2(sm)
+	l.Info("using state backend", "backend", stateBackend)
 
 	broadcaster := realtime.NewRedisBroadcaster(realtimePubRc, realtimeSubRc)
 	defer func() {
@@ -325,6 +448,10 @@ func start(ctx context.Context, opts StartOpts) error {
 		Partition:     true,
 		Continuations: true,
 	}
+	usePostgresQueue := enums.QueueShardKind(opts.QueueBackend) == enums.QueueShardKindPostgres
+	if usePostgresQueue && pgDB == nil {
+		return fmt.Errorf("the postgres queue backend requires a postgres URI")
+	}
 	enableKeyQueues := os.Getenv("EXPERIMENTAL_KEY_QUEUES_ENABLE") == "true"
 	// Step metadata is enabled by default in the dev server; set EXPERIMENTAL_STEP_METADATA=false to disable.
 	enableStepMetadata := os.Getenv("EXPERIMENTAL_STEP_METADATA") != "false"
@@ -355,8 +482,21 @@ func start(ctx context.Context, opts StartOpts) error {
 		return fmt.Errorf("could not create constraint API: %w", err)
 	}
 
+	// Per-function queue metrics are exposed via the /metrics endpoint.
+	queueMetrics := metrics.NewQueueMetrics(metrics.QueueMetricsOpts{
+		MaxFunctions: opts.MetricsMaxFunctions,
+		FunctionName: func(ctx context.Context, fnID uuid.UUID) (string, error) {
+			fn, err := dbcqrs.GetFunctionByInternalUUID(ctx, fnID)
+			if err != nil {
+				return "", err
+			}
+			return fn.Slug, nil
+		},
+	})
+
 	queueOpts := []queue.QueueOpt{
 		queue.WithRunMode(runMode),
+		queue.WithMetricsRecorder(queueMetrics),
 		queue.WithIdempotencyTTL(time.Hour),
 		queue.WithNumWorkers(int32(opts.QueueWorkers)),
 		queue.WithPollTick(opts.Tick),
@@ -398,7 +538,12 @@ func start(ctx context.Context, opts StartOpts) error {
 		queueOpts = append(queueOpts, queue.WithBackoffFunc(retryBackoff))
 	}
 
-	queueShard := redis_state.NewQueueShard(consts.DefaultQueueShardName, unshardedClient.Queue(), queueOpts...)
+	var queueShard queue.QueueShard
+	if usePostgresQueue {
+		queueShard = postgres_state.NewQueueShard(consts.DefaultQueueShardName, pgDB, queueOpts...)
+	} else {
+		queueShard = redis_state.NewQueueShard(consts.DefaultQueueShardName, unshardedClient.Queue(), queueOpts...)
+	}
 	shardRegistry, err := queue.NewSingleShardRegistry(queueShard)
 	if err != nil {
 		return fmt.Errorf("could not create shard registry: %w", err)
@@ -474,6 +619,19 @@ func start(ctx context.Context, opts StartOpts) error {
 	httpClient.Client.Transport = awsgateway.NewTransformTripper(httpClient.Client.Transport)
 	deploy.Client.Transport = awsgateway.NewTransformTripper(deploy.Client.Transport)
 
+	// Load each app's TLS config when calling the app, allowing apps behind
+	// internal PKIs.  Configs are cached, and invalidated when the app syncs.
+	tlsLoader := exechttp.NewTLSLoaderCache(func(ctx context.Context, appID uuid.UUID) (*exechttp.TLSConfig, error) {
+		app, err := dbcqrs.GetAppByID(ctx, appID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return app.TLS, nil
+	})
+
 	drivers := []driver.DriverV1{}
 	for _, driverConfig := range opts.Config.Execution.Drivers {
 		d, err := driverConfig.NewDriver(registration.NewDriverOpts{
@@ -482,6 +640,7 @@ func start(ctx context.Context, opts StartOpts) error {
 			HTTPClient:             httpClient,
 			LocalSigningKey:        opts.SigningKey,
 			RequireLocalSigningKey: opts.RequireKeys,
+			AppTLSLoader:           tlsLoader.Load,
 		})
 		if err != nil {
 			return err
@@ -493,7 +652,45 @@ func start(ctx context.Context, opts StartOpts) error {
 		return fmt.Errorf("failed to create publisher: %w", err)
 	}
 
-	tp := tracing.NewSqlcTracerProvider(adapter.Q())
+	var traceExporters []sdktrace.SpanExporter
+	if opts.TraceExport.Endpoint != "" {
+		exp, err := tracing.NewOTLPRunExporter(ctx, opts.TraceExport)
+		if err != nil {
+			return fmt.Errorf("failed to create otlp trace exporter: %w", err)
+		}
+		traceExporters = append(traceExporters, exp)
+	}
+	tp := tracing.NewSqlcTracerProvider(adapter.Q(), traceExporters...)
+
+	// runUpdates streams run updates from the executor to GraphQL
+	// subscriptions.
+	runUpdates := run.NewUpdateBroker()
+
+	// Outbound webhooks notify external systems of run lifecycle events.
+	// Deliveries are queued by the lifecycle listener and sent by the
+	// webhook service.
+	webhookStore := webhooks.NewRedisStore(unshardedRc, webhooks.DefaultPrefix)
+	// Alert rules on function health are evaluated by the alert service,
+	// which counts skipped runs via its lifecycle listener.
+	alertStore := alerts.NewRedisStore(unshardedRc, alerts.DefaultPrefix)
+
+	var grpcOpts []grpcdriver.Opt
+	if opts.GRPCCAFile != "" || opts.GRPCCertFile != "" || opts.GRPCKeyFile != "" {
+		tlsConfig, err := grpcdriver.LoadTLSConfig(opts.GRPCCAFile, opts.GRPCCertFile, opts.GRPCKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load grpc driver tls config: %w", err)
+		}
+		grpcOpts = append(grpcOpts, grpcdriver.WithTLSConfig(tlsConfig))
+	}
+
+	execOpts := []execdriver.Opt{
+		execdriver.WithAllowedDirs(opts.ExecDirs...),
+		execdriver.WithEnv(opts.ExecEnv...),
+		execdriver.WithLimits(opts.ExecLimits),
+	}
+	if opts.ExecTimeout > 0 {
+		execOpts = append(execOpts, execdriver.WithTimeout(opts.ExecTimeout))
+	}
 
 	url := opts.Config.CoreAPI.Addr
 	if url == "0.0.0.0" {
@@ -505,7 +702,7 @@ func start(ctx context.Context, opts StartOpts) error {
 		executor.WithStateManager(smv2),
 		executor.WithPauseManager(pauseMgr),
 		executor.WithDriverV1(drivers...),
-		executor.WithDriverV2(httpv2.NewDriver(httpClient)),
+		executor.WithDriverV2(httpv2.NewDriver(httpClient, httpv2.WithTLSLoader(tlsLoader.Load)), grpcdriver.NewDriver(grpcOpts...), execdriver.NewDriver(execOpts...)),
 		executor.WithExpressionAggregator(agg),
 		executor.WithQueue(rq),
 		executor.WithRateLimiter(rl),
@@ -523,7 +720,7 @@ func start(ctx context.Context, opts StartOpts) error {
 			append([]execution.LifecycleListener{
 				history.NewLifecycleListener(
 					nil,
-					hd,
+					historyDrivers...,
 				),
 				Lifecycle{
 					Cqrs:       dbcqrs,
@@ -531,6 +728,9 @@ func start(ctx context.Context, opts StartOpts) error {
 					EventTopic: opts.Config.EventStream.Service.Concrete.TopicName(),
 				},
 				run.NewTraceLifecycleListener(nil),
+				runUpdates.LifecycleListener(),
+				webhooks.NewLifecycleListener(webhookStore),
+				alerts.NewLifecycleListener(alertStore),
 			}, metrics.NewLifecycleListeners()...)...,
 		),
 		executor.WithEventLifecycleListeners(execution.NoopEventLifecycleListener{}),
@@ -555,6 +755,8 @@ func start(ctx context.Context, opts StartOpts) error {
 		executor.WithDebouncer(debouncer),
 		executor.WithSingletonManager(sn),
 		executor.WithBatcher(batcher),
+		executor.WithStepCache(stepcache.NewRedisCache(unshardedRc, stepcache.DefaultPrefix)),
+		executor.WithLocker(steplock.NewRedisLocker(unshardedRc, steplock.DefaultPrefix)),
 		executor.WithShardRegistry(shardRegistry),
 		executor.WithTraceReader(dbcqrs),
 		executor.WithRealtimeConfig(executor.ExecutorRealtimeConfig{
@@ -581,6 +783,14 @@ func start(ctx context.Context, opts StartOpts) error {
 		return err
 	}
 
+	// Scheduled invocations are enqueued via the executor to start at a future
+	// time, and indexed so that they can be listed and cancelled.
+	scheduler := scheduled.NewScheduler(scheduled.NewRedisStore(unshardedRc, scheduled.DefaultPrefix), exec)
+
+	// Waits let runs blocked on step.waitForEvent or step.waitForSignal be
+	// inspected and resolved manually.
+	waits := pauses.NewWaits(pauseMgr, exec)
+
 	// Create an executor.
 	executorSvc := executor.NewService(
 		opts.Config,
@@ -611,6 +821,7 @@ func start(ctx context.Context, opts StartOpts) error {
 
 	// The devserver embeds the event API.
 	ds := NewService(opts, runner, dbcqrs, pb, stepLimitOverrides, stateSizeLimitOverrides, unshardedRc, hd, nil)
+	ds.appTLS = tlsLoader
 	ds.State = sm
 	ds.Executor = exec
 	ds.SemaphoreManager = semaphores
@@ -621,17 +832,21 @@ func start(ctx context.Context, opts StartOpts) error {
 	devAPI := NewDevAPI(ds, DevAPIOptions{AuthMiddleware: authn.SigningKeyMiddleware(opts.SigningKey), disableUI: opts.NoUI})
 
 	core, err := coreapi.NewCoreApi(coreapi.Options{
-		AuthMiddleware: authn.SigningKeyMiddleware(opts.SigningKey),
-		Data:           ds.Data,
-		Config:         ds.Opts.Config,
-		Logger:         l,
-		Runner:         ds.Runner,
-		State:          ds.State,
-		QueueReader:    rq,
-		EventHandler:   ds.HandleEvent,
-		Executor:       ds.Executor,
-		HistoryReader:  cqrsmanager.NewHistoryReader(adapter),
-		DisableGraphQL: &opts.NoUI,
+		AuthMiddleware:       authn.SigningKeyMiddleware(opts.SigningKey),
+		Data:                 ds.Data,
+		Config:               ds.Opts.Config,
+		Logger:               l,
+		Runner:               ds.Runner,
+		State:                ds.State,
+		QueueReader:          rq,
+		EventHandler:         ds.HandleEvent,
+		Executor:             ds.Executor,
+		HistoryReader:        cqrsmanager.NewHistoryReader(adapter),
+		ScheduledInvocations: scheduler,
+		Waits:                waits,
+		RunUpdates:           runUpdates,
+		DisableGraphQL:       &opts.NoUI,
+		AuditLog:             dbcqrs,
 		ConnectOpts: connectv0.Opts{
 			GroupManager:               connectionManager,
 			ConnectManager:             connectionManager,
@@ -692,6 +907,8 @@ func start(ctx context.Context, opts StartOpts) error {
 				},
 				SpanExtractor: extractors.DefaultSpanExtractors,
 			},
+
+			AuditLog: dbcqrs,
 		})
 	})
 
@@ -710,23 +927,35 @@ func start(ctx context.Context, opts StartOpts) error {
 	)
 
 	// Initialize metrics API for Prometheus-compatible metrics endpoint.
-	// This provides system queue depth metrics via /metrics endpoint.
+	// This provides system and per-function queue metrics via /metrics endpoint.
 	metricsAPI, err := metrics.NewMetricsAPI(metrics.Opts{
 		QueueManager: queueShard,
+		QueueMetrics: queueMetrics,
 	})
 	if err != nil {
 		return err
 	}
 
+	// Bulk operations cancel or rerun runs matching a filter in the background,
+	// using the same run provider as single run cancellations and reruns.
+	runs := NewRunProvider(dbcqrs, exec)
+	bulkStore := bulk.NewRedisStore(unshardedRc, bulk.DefaultPrefix)
+
 	// Create the API v2 service handler
 	serviceOpts := apiv2.ServiceOptions{
 		SigningKeysProvider: apiv2.NewSigningKeysProvider(opts.SigningKey),
 		EventKeysProvider:   apiv2.NewEventKeysProvider(opts.EventKeys),
 		Apps:                NewAppProvider(dbcqrs),
 		Functions:           NewFunctionProvider(dbcqrs),
-		Runs:                NewRunProvider(dbcqrs, exec),
+		FunctionVersions:    dbcqrs,
+		Runs:                runs,
+		BulkRuns:            NewBulkRunOperationProvider(bulk.NewManager(bulkStore, dbcqrs)),
+		Waits:               NewWaitProvider(waits),
+		OutboundWebhooks:    NewOutboundWebhookProvider(webhooks.NewManager(webhookStore)),
+		Alerts:              NewAlertProvider(alerts.NewManager(alertStore)),
 		FunctionTraces:      NewFunctionTraceReader(dbcqrs),
 		Executor:            exec,
+		Scheduler:           scheduler,
 		EventPublisher:      runner,
 		EventSender: func(ctx context.Context, evt *event.Event) (string, error) {
 			return ds.HandleEvent(ctx, evt, nil)
@@ -742,11 +971,13 @@ func start(ctx context.Context, opts StartOpts) error {
 			},
 			MissingStateLoader: scoreMetadataLoader(dbcqrs),
 		}),
+		Usage: dbcqrs,
 	}
 
 	apiv2Base := apiv2base.NewBase()
 	apiv2Handler, err := apiv2.NewHTTPHandler(ctx, serviceOpts, apiv2.HTTPHandlerOptions{
 		AuthnMiddleware: authn.SigningKeyMiddleware(opts.SigningKey),
+		AuditLog:        dbcqrs,
 	}, apiv2Base)
 	if err != nil {
 		return fmt.Errorf("failed to create v2 handler: %w", err)
@@ -802,14 +1033,54 @@ func start(ctx context.Context, opts StartOpts) error {
 		})})
 	}
 
+	var admission api.AdmissionController
+	if opts.IngestBacklogLimit > 0 || opts.IngestFunctionBacklogLimit > 0 {
+		admissionOpts := api.BacklogAdmissionOpts{
+			Stats:       queueShard,
+			Functions:   dbcqrs,
+			GlobalLimit: opts.IngestBacklogLimit,
+			Defer:       opts.IngestBackpressure == api.BackpressureDefer,
+		}
+		if opts.IngestFunctionBacklogLimit > 0 {
+			admissionOpts.FunctionLimit = func(_ context.Context, _ uuid.UUID) int64 {
+				return opts.IngestFunctionBacklogLimit
+			}
+		}
+		admission = api.NewBacklogAdmission(admissionOpts)
+	}
+
 	ds.Apiservice = api.NewService(api.APIServiceOptions{
 		Config:         ds.Opts.Config,
 		Mounts:         mounts,
 		LocalEventKeys: opts.EventKeys,
+		Admission:      admission,
 		Logger:         l,
 	})
 
 	services = append(services, ds, runner, executorSvc, ds.Apiservice, connGateway)
+	if historyStream != nil {
+		services = append(services, historystream.NewService(historyStream))
+	}
+	services = append(services, bulk.NewService(bulkStore, dbcqrs, NewBulkRunActioner(runs)))
+	services = append(services, usage.NewRollupService(dbcqrs))
+	services = append(services, webhooks.NewService(webhookStore, webhooks.WithHTTPClient(&http.Client{
+		Timeout:       webhooks.DefaultTimeout,
+		CheckRedirect: exechttp.CheckRedirect,
+		// In local dev, webhooks are commonly sent to local servers.
+		Transport: exechttp.Transport(exechttp.SecureDialerOpts{
+			AllowHostDocker: true,
+			AllowPrivate:    true,
+			AllowNAT64:      true,
+		}),
+	})))
+	queueStats, _ := queueShard.(metrics.QueueStatsProvider)
+	services = append(services, alerts.NewService(alertStore, NewAlertSource(dbcqrs, queueStats),
+		alerts.WithWebhooks(webhookStore),
+		alerts.WithEventSender(func(ctx context.Context, _ uuid.UUID, evt event.Event) error {
+			_, err := ds.HandleEvent(ctx, &evt, nil)
+			return err
+		}),
+	))
 
 	if os.Getenv("DEBUG") != "" {
 		services = append(services, debugapi.NewDebugAPI(debugapi.Opts{
@@ -829,6 +1100,7 @@ func start(ctx context.Context, opts StartOpts) error {
 			// Dependencies for batching and debounce insights
 			BatchManager: batcher,
 			Debouncer:    debouncer,
+			AuditLog:     ds.Data,
 		}))
 	}
 
@@ -956,7 +1228,7 @@ func NormalizeConcurrencyKeys(smv2 sv2.StateLoader, dbcqrs cqrs.Manager) queue.N
 
 		evtMap := evt0.Map()
 
-		return queue.GetCustomConcurrencyKeys(ctx, id, fn.Concurrency.Limits, evtMap), nil
+		return queue.GetCustomConcurrencyKeys(ctx, id, fn.Concurrency.Limits, fn.Concurrency.Fairness, evtMap), nil
 	}
 }
 
@@ -1053,6 +1325,13 @@ func PartitionConstraintConfigGetter(dbcqrs cqrs.Manager) queue.PartitionConstra
 			}
 		}
 
+		if fn.Concurrency != nil && fn.Concurrency.Fairness != nil {
+			constraints.Concurrency.Fairness = &queue.PartitionFairness{
+				Mode:    fn.Concurrency.Fairness.Mode,
+				Quantum: fn.Concurrency.Fairness.EffectiveQuantum(),
+			}
+		}
+
 		if fn.Throttle != nil {
 			var keyExpr string
 			if fn.Throttle.Key != nil {
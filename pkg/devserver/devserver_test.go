@@ -0,0 +1,40 @@
+package devserver
+
+import (
+	"testing"
+
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartRequiresDurableStateBackend(t *testing.T) {
+	postgres := string(enums.StateStoreKindPostgres)
+
+	t.Run("postgres state without redis", func(t *testing.T) {
+		err := start(t.Context(), StartOpts{
+			StateBackend: postgres,
+			PostgresURI:  "postgres://localhost:5432/inngest",
+		})
+		require.ErrorContains(t, err, "requires a redis URI")
+	})
+
+	t.Run("postgres state without postgres", func(t *testing.T) {
+		err := start(t.Context(), StartOpts{
+			StateBackend: postgres,
+			RedisURI:     "redis://localhost:6379",
+		})
+		require.ErrorContains(t, err, "requires a postgres URI")
+	})
+
+	t.Run("postgres state with postgres and redis", func(t *testing.T) {
+		require.NoError(t, validateStateBackend(StartOpts{
+			StateBackend: postgres,
+			PostgresURI:  "postgres://localhost:5432/inngest",
+			RedisURI:     "redis://localhost:6379",
+		}))
+	})
+
+	t.Run("redis state", func(t *testing.T) {
+		require.NoError(t, validateStateBackend(StartOpts{}))
+	})
+}
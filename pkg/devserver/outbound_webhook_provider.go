@@ -0,0 +1,57 @@
+package devserver
+
+import (
+	"context"
+
+	apiv2 "github.com/inngest/inngest/pkg/api/v2"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
+	"github.com/oklog/ulid/v2"
+)
+
+// NewOutboundWebhookProvider returns an API v2 outbound webhook provider which
+// manages webhooks within the dev server's environment.
+func NewOutboundWebhookProvider(m webhooks.Manager) apiv2.OutboundWebhookProvider {
+	return &outboundWebhookProvider{m: m}
+}
+
+type outboundWebhookProvider struct {
+	m webhooks.Manager
+}
+
+func (p *outboundWebhookProvider) Create(ctx context.Context, opts apiv2.CreateOutboundWebhookOpts) (*webhooks.Endpoint, error) {
+	return p.m.CreateEndpoint(ctx, webhooks.CreateEndpointOpts{
+		AccountID:         consts.DevServerAccountID,
+		WorkspaceID:       consts.DevServerEnvID,
+		URL:               opts.URL,
+		Secret:            opts.Secret,
+		Events:            opts.Events,
+		Filter:            opts.Filter,
+		DurationThreshold: opts.DurationThreshold,
+		Disabled:          opts.Disabled,
+	})
+}
+
+func (p *outboundWebhookProvider) Get(ctx context.Context, id ulid.ULID) (*webhooks.Endpoint, error) {
+	return p.m.GetEndpoint(ctx, consts.DevServerEnvID, id)
+}
+
+func (p *outboundWebhookProvider) List(ctx context.Context) ([]webhooks.Endpoint, error) {
+	return p.m.ListEndpoints(ctx, consts.DevServerEnvID)
+}
+
+func (p *outboundWebhookProvider) Update(ctx context.Context, id ulid.ULID, opts webhooks.UpdateEndpointOpts) (*webhooks.Endpoint, error) {
+	return p.m.UpdateEndpoint(ctx, consts.DevServerEnvID, id, opts)
+}
+
+func (p *outboundWebhookProvider) Delete(ctx context.Context, id ulid.ULID) error {
+	return p.m.DeleteEndpoint(ctx, consts.DevServerEnvID, id)
+}
+
+func (p *outboundWebhookProvider) ListDeliveries(ctx context.Context, id ulid.ULID, opts webhooks.ListOpts) ([]webhooks.Delivery, bool, error) {
+	return p.m.ListDeliveries(ctx, consts.DevServerEnvID, id, opts)
+}
+
+func (p *outboundWebhookProvider) Replay(ctx context.Context, id ulid.ULID, deliveryID ulid.ULID) (*webhooks.Delivery, error) {
+	return p.m.Replay(ctx, consts.DevServerEnvID, id, deliveryID)
+}
@@ -112,6 +112,7 @@ func (p *runProvider) GetRuns(ctx context.Context, opts apiv2.GetRunsOpts) (*api
 			Until:        until,
 			Status:       opts.Status,
 			IsDeferred:   opts.IsDeferred,
+			Search:       opts.Search,
 		},
 		Order: []cqrs.GetTraceRunOrder{{
 			Field:     timeField,
@@ -279,6 +280,9 @@ func functionRunFromSpan(ctx context.Context, reader runSpanReader, runID ulid.U
 	if root.Attributes.CronSchedule != nil {
 		run.Cron = root.Attributes.CronSchedule
 	}
+	if root.Attributes.FunctionVersion != nil {
+		run.FunctionVersion = int64(*root.Attributes.FunctionVersion)
+	}
 
 	return run, nil
 }
@@ -299,12 +303,13 @@ func runEventID(root *cqrs.OtelSpan) (ulid.ULID, error) {
 func runListItemFromCQRS(row *cqrs.TraceRun, includeOutput bool) *apiv2.RunListItem {
 	runID, _ := ulid.Parse(row.RunID)
 	run := &apiv2.RunListItem{
-		RunID:        runID,
-		Cursor:       row.Cursor,
-		RunStartedAt: row.StartedAt,
-		FunctionID:   row.FunctionID.String(),
-		AppID:        row.AppID.String(),
-		Status:       row.Status,
+		RunID:           runID,
+		Cursor:          row.Cursor,
+		RunStartedAt:    row.StartedAt,
+		FunctionID:      row.FunctionID.String(),
+		AppID:           row.AppID.String(),
+		Status:          row.Status,
+		FunctionVersion: row.FunctionVersion,
 	}
 	if len(row.TriggerIDs) > 0 {
 		run.EventID, _ = ulid.Parse(row.TriggerIDs[0])
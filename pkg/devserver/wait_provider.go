@@ -0,0 +1,35 @@
+package devserver
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	apiv2 "github.com/inngest/inngest/pkg/api/v2"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/execution/pauses"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+)
+
+// NewWaitProvider returns an API v2 wait provider which inspects and resolves
+// waits within the dev server's environment.
+func NewWaitProvider(w pauses.Waits) apiv2.WaitProvider {
+	return &waitProvider{w: w}
+}
+
+type waitProvider struct {
+	w pauses.Waits
+}
+
+func (p *waitProvider) ListRunWaits(ctx context.Context, runID ulid.ULID) ([]state.Pause, error) {
+	return p.w.ForRun(ctx, consts.DevServerEnvID, runID)
+}
+
+func (p *waitProvider) ResolveWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID, data json.RawMessage) (*state.Pause, error) {
+	return p.w.Resolve(ctx, consts.DevServerEnvID, runID, waitID, data)
+}
+
+func (p *waitProvider) TimeoutWait(ctx context.Context, runID ulid.ULID, waitID uuid.UUID) (*state.Pause, error) {
+	return p.w.Timeout(ctx, consts.DevServerEnvID, runID, waitID)
+}
@@ -0,0 +1,13 @@
+//go:generate go run github.com/dmarkham/enumer -trimprefix=BulkOperationAction -type=BulkOperationAction -json -text -transform=snake
+
+package enums
+
+type BulkOperationAction int
+
+const (
+	// BulkOperationActionCancel cancels every run matching a bulk operation's filter.
+	BulkOperationActionCancel BulkOperationAction = iota
+	// BulkOperationActionRerun reruns every run matching a bulk operation's filter
+	// using the original run's triggering events.
+	BulkOperationActionRerun
+)
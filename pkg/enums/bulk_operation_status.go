@@ -0,0 +1,25 @@
+//go:generate go run github.com/dmarkham/enumer -trimprefix=BulkOperationStatus -type=BulkOperationStatus -json -text -transform=snake
+
+package enums
+
+type BulkOperationStatus int
+
+const (
+	// BulkOperationStatusRunning represents a bulk operation which is still
+	// processing matching runs.
+	BulkOperationStatusRunning BulkOperationStatus = iota
+	// BulkOperationStatusCompleted represents a bulk operation which has
+	// processed every matching run.
+	BulkOperationStatusCompleted
+	// BulkOperationStatusAborted represents a bulk operation which was stopped
+	// before processing every matching run.
+	BulkOperationStatusAborted
+	// BulkOperationStatusFailed represents a bulk operation which stopped due
+	// to an unrecoverable error, such as an invalid filter.
+	BulkOperationStatusFailed
+)
+
+// BulkOperationStatusEnded returns whether the given status is a terminal status.
+func BulkOperationStatusEnded(s BulkOperationStatus) bool {
+	return s != BulkOperationStatusRunning
+}
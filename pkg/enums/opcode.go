@@ -28,6 +28,10 @@ const (
 
 	OpcodeDeferAdd
 	OpcodeDeferAbort
+
+	// OpcodeLockAcquire indicates that the run wants to hold a named lock
+	// around a step.  The run waits in the queue until the lock is granted.
+	OpcodeLockAcquire
 )
 
 // opcodeSyncMap explicitly represents the sync opcodes that can be checkpointed.
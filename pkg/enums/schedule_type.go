@@ -10,4 +10,7 @@ const (
 	ScheduleTypeCron
 	ScheduleTypeDebounce
 	ScheduleTypeRerun
+	// ScheduleTypeScheduledInvoke represents a function invoked via the API
+	// to run at a future time.
+	ScheduleTypeScheduledInvoke
 )
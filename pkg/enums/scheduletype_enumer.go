@@ -10,11 +10,11 @@ import (
 	"strings"
 )
 
-const _ScheduleTypeName = "UnknownEventCronDebounceRerun"
+const _ScheduleTypeName = "UnknownEventCronDebounceRerunScheduledInvoke"
 
-var _ScheduleTypeIndex = [...]uint8{0, 7, 12, 16, 24, 29}
+var _ScheduleTypeIndex = [...]uint8{0, 7, 12, 16, 24, 29, 44}
 
-const _ScheduleTypeLowerName = "unknowneventcrondebouncererun"
+const _ScheduleTypeLowerName = "unknowneventcrondebouncererunscheduledinvoke"
 
 func (i ScheduleType) String() string {
 	if i < 0 || i >= ScheduleType(len(_ScheduleTypeIndex)-1) {
@@ -32,9 +32,10 @@ func _ScheduleTypeNoOp() {
 	_ = x[ScheduleTypeCron-(2)]
 	_ = x[ScheduleTypeDebounce-(3)]
 	_ = x[ScheduleTypeRerun-(4)]
+	_ = x[ScheduleTypeScheduledInvoke-(5)]
 }
 
-var _ScheduleTypeValues = []ScheduleType{ScheduleTypeUnknown, ScheduleTypeEvent, ScheduleTypeCron, ScheduleTypeDebounce, ScheduleTypeRerun}
+var _ScheduleTypeValues = []ScheduleType{ScheduleTypeUnknown, ScheduleTypeEvent, ScheduleTypeCron, ScheduleTypeDebounce, ScheduleTypeRerun, ScheduleTypeScheduledInvoke}
 
 var _ScheduleTypeNameToValueMap = map[string]ScheduleType{
 	_ScheduleTypeName[0:7]:        ScheduleTypeUnknown,
@@ -47,6 +48,8 @@ var _ScheduleTypeNameToValueMap = map[string]ScheduleType{
 	_ScheduleTypeLowerName[16:24]: ScheduleTypeDebounce,
 	_ScheduleTypeName[24:29]:      ScheduleTypeRerun,
 	_ScheduleTypeLowerName[24:29]: ScheduleTypeRerun,
+	_ScheduleTypeName[29:44]:      ScheduleTypeScheduledInvoke,
+	_ScheduleTypeLowerName[29:44]: ScheduleTypeScheduledInvoke,
 }
 
 var _ScheduleTypeNames = []string{
@@ -55,6 +58,7 @@ var _ScheduleTypeNames = []string{
 	_ScheduleTypeName[12:16],
 	_ScheduleTypeName[16:24],
 	_ScheduleTypeName[24:29],
+	_ScheduleTypeName[29:44],
 }
 
 // ScheduleTypeString retrieves an enum value from the enum constants string name.
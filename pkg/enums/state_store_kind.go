@@ -0,0 +1,8 @@
+package enums
+
+type StateStoreKind string
+
+const (
+	StateStoreKindRedis    StateStoreKind = "redis"
+	StateStoreKindPostgres StateStoreKind = "postgres"
+)
@@ -2,11 +2,13 @@ package event
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/base64"
 	"encoding/binary"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 )
@@ -60,6 +62,17 @@ func SeededIDFromString(value string, index int) *SeededID {
 	}
 }
 
+// NewSeededID returns a SeededID with random entropy for the given time.  This
+// allows an event's internal ID to be known before the event is published.
+func NewSeededID(t time.Time) *SeededID {
+	entropy := make([]byte, 10)
+	_, _ = rand.Read(entropy)
+	return &SeededID{
+		Entropy: entropy,
+		Millis:  t.UnixMilli(),
+	}
+}
+
 type SeededID struct {
 	// Entropy is the 10-byte entropy value used to generate the ULID.
 	Entropy []byte
@@ -0,0 +1,384 @@
+// Package alerts evaluates alert rules on function health.  Each rule watches
+// a single metric of a function, such as its failure rate or the age of its
+// backlog, over a rolling window.  An alert fires when the metric exceeds the
+// rule's threshold and resolves once it recovers, and both transitions are
+// recorded in the rule's alert history and sent as internal events and/or
+// outbound webhooks.
+package alerts
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// MinWindow is the shortest window a rule may evaluate.
+	MinWindow = time.Minute
+	// MaxWindow is the longest window a rule may evaluate.
+	MaxWindow = 24 * time.Hour
+	// AlertRetention is how long alerts are kept in the alert history.
+	AlertRetention = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrRuleNotFound is returned when a rule does not exist within the given
+	// workspace.
+	ErrRuleNotFound = errors.New("alert rule not found")
+	// ErrAlertNotFound is returned when an alert does not exist or has
+	// expired.
+	ErrAlertNotFound = errors.New("alert not found")
+	// ErrInvalidRule is returned when creating or updating a rule with an
+	// invalid configuration.
+	ErrInvalidRule = errors.New("invalid alert rule")
+)
+
+// Metric is the function metric that a rule watches.
+type Metric string
+
+const (
+	// MetricFailureRate is the fraction of runs ending within the window
+	// which failed, between 0 and 1.
+	MetricFailureRate Metric = "failure_rate"
+	// MetricP95Duration is the 95th percentile duration, in seconds, of runs
+	// ending within the window.
+	MetricP95Duration Metric = "p95_duration"
+	// MetricBacklogAge is the age, in seconds, of the function's oldest
+	// queue item which is due but not yet started.  It's measured at each
+	// evaluation rather than over the window.
+	MetricBacklogAge Metric = "backlog_age"
+	// MetricSkippedCount is the number of runs skipped within the window,
+	// optionally of a single SkipReason.
+	MetricSkippedCount Metric = "skipped_count"
+)
+
+// Metrics lists every metric that rules may watch.
+var Metrics = []Metric{
+	MetricFailureRate,
+	MetricP95Duration,
+	MetricBacklogAge,
+	MetricSkippedCount,
+}
+
+// Channel is a way of sending alert notifications.
+type Channel string
+
+const (
+	// ChannelEvent sends the internal consts.AlertFiredName and
+	// consts.AlertResolvedName events, which functions can be triggered by.
+	ChannelEvent Channel = "event"
+	// ChannelWebhook sends alert.fired and alert.resolved notifications to
+	// the workspace's subscribed outbound webhooks.
+	ChannelWebhook Channel = "webhook"
+)
+
+// Channels lists every notification channel.
+var Channels = []Channel{
+	ChannelEvent,
+	ChannelWebhook,
+}
+
+// AlertStatus is the status of a single alert.
+type AlertStatus string
+
+const (
+	AlertStatusFiring   AlertStatus = "firing"
+	AlertStatusResolved AlertStatus = "resolved"
+)
+
+// Rule fires an alert when a function's metric exceeds a threshold.
+type Rule struct {
+	ID          ulid.ULID `json:"id"`
+	AccountID   uuid.UUID `json:"account_id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+
+	Name         string    `json:"name"`
+	FunctionID   uuid.UUID `json:"function_id"`
+	FunctionSlug string    `json:"function_slug"`
+	Metric       Metric    `json:"metric"`
+	// SkipReason limits MetricSkippedCount to runs skipped for a single
+	// reason.  All skipped runs are counted if nil.
+	SkipReason *enums.SkipReason `json:"skip_reason,omitempty"`
+	// Threshold is the metric value above which the alert fires, in the
+	// metric's units.
+	Threshold float64 `json:"threshold"`
+	// Window is the rolling window the metric is measured over.  It's unused
+	// for MetricBacklogAge.
+	Window   time.Duration `json:"window,omitempty"`
+	Channels []Channel     `json:"channels"`
+	Disabled bool          `json:"disabled,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// State is the result of the rule's latest evaluation.  It's stored
+	// separately from the rule, so that evaluations never overwrite updates.
+	State State `json:"-"`
+}
+
+// State is the result of a rule's latest evaluation.
+type State struct {
+	// AlertID is the rule's firing alert, if any.
+	AlertID     *ulid.ULID `json:"alert_id,omitempty"`
+	Value       float64    `json:"value"`
+	EvaluatedAt time.Time  `json:"evaluated_at"`
+}
+
+// Firing returns whether the rule has a firing alert.
+func (s State) Firing() bool {
+	return s.AlertID != nil
+}
+
+// Validate checks the rule's configuration, returning an error wrapping
+// ErrInvalidRule if the rule is invalid.
+func (r Rule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+	if r.FunctionID == uuid.Nil {
+		return fmt.Errorf("%w: function is required", ErrInvalidRule)
+	}
+	if !slices.Contains(Metrics, r.Metric) {
+		return fmt.Errorf("%w: unknown metric %q", ErrInvalidRule, r.Metric)
+	}
+	if r.SkipReason != nil && r.Metric != MetricSkippedCount {
+		return fmt.Errorf("%w: skip reason is only used with %s", ErrInvalidRule, MetricSkippedCount)
+	}
+	if r.Threshold < 0 {
+		return fmt.Errorf("%w: threshold must not be negative", ErrInvalidRule)
+	}
+	if r.Metric == MetricFailureRate && r.Threshold >= 1 {
+		return fmt.Errorf("%w: failure rate threshold must be less than 1", ErrInvalidRule)
+	}
+	if r.Metric != MetricBacklogAge && (r.Window < MinWindow || r.Window > MaxWindow) {
+		return fmt.Errorf("%w: window must be between %s and %s", ErrInvalidRule, MinWindow, MaxWindow)
+	}
+	if len(r.Channels) == 0 {
+		return fmt.Errorf("%w: at least one channel is required", ErrInvalidRule)
+	}
+	for _, c := range r.Channels {
+		if !slices.Contains(Channels, c) {
+			return fmt.Errorf("%w: unknown channel %q", ErrInvalidRule, c)
+		}
+	}
+	return nil
+}
+
+// Alert is a single breach of a rule's threshold, from when it fired until
+// it resolved.
+type Alert struct {
+	ID           ulid.ULID `json:"id"`
+	RuleID       ulid.ULID `json:"rule_id"`
+	WorkspaceID  uuid.UUID `json:"workspace_id"`
+	RuleName     string    `json:"rule_name"`
+	FunctionID   uuid.UUID `json:"function_id"`
+	FunctionSlug string    `json:"function_slug"`
+	Metric       Metric    `json:"metric"`
+	Threshold    float64   `json:"threshold"`
+
+	// Value is the metric's value when the alert fired.
+	Value float64 `json:"value"`
+	// PeakValue is the highest value of the metric whilst firing.
+	PeakValue float64 `json:"peak_value"`
+
+	Status     AlertStatus `json:"status"`
+	FiredAt    time.Time   `json:"fired_at"`
+	ResolvedAt *time.Time  `json:"resolved_at,omitempty"`
+}
+
+// ListAlertsOpts lists a workspace's alerts, newest first.
+type ListAlertsOpts struct {
+	// RuleID limits the alerts to a single rule.
+	RuleID *ulid.ULID
+	// Cursor is the ID of the last alert from the previous page.
+	Cursor *ulid.ULID
+	Limit  int
+}
+
+// Store persists rules, their state, alerts, and skipped run counts.
+type Store interface {
+	// CreateRule stores a new rule.
+	CreateRule(ctx context.Context, r Rule) error
+	// GetRule returns a rule and its state, or ErrRuleNotFound.
+	GetRule(ctx context.Context, wsID uuid.UUID, id ulid.ULID) (*Rule, error)
+	// ListRules returns every rule in the workspace with its state.
+	ListRules(ctx context.Context, wsID uuid.UUID) ([]Rule, error)
+	// AllRules returns every rule in every workspace with its state.
+	AllRules(ctx context.Context) ([]Rule, error)
+	// UpdateRule replaces an existing rule, returning ErrRuleNotFound if the
+	// rule does not exist.  The rule's state is unchanged.
+	UpdateRule(ctx context.Context, r Rule) error
+	// DeleteRule deletes a rule and its state, returning ErrRuleNotFound if
+	// the rule does not exist.
+	DeleteRule(ctx context.Context, wsID uuid.UUID, id ulid.ULID) error
+	// SaveState stores the result of a rule's evaluation.
+	SaveState(ctx context.Context, ruleID ulid.ULID, s State) error
+
+	// SaveAlert creates or updates an alert.
+	SaveAlert(ctx context.Context, a Alert) error
+	// GetAlert returns an alert, or ErrAlertNotFound.
+	GetAlert(ctx context.Context, id ulid.ULID) (*Alert, error)
+	// ListAlerts returns a page of alerts, and whether more alerts exist.
+	ListAlerts(ctx context.Context, wsID uuid.UUID, opts ListAlertsOpts) ([]Alert, bool, error)
+
+	// RecordSkip counts a skipped run of the function.
+	RecordSkip(ctx context.Context, fnID uuid.UUID, reason enums.SkipReason, at time.Time) error
+	// CountSkips returns the number of the function's runs skipped between
+	// from and until, optionally for a single reason.
+	CountSkips(ctx context.Context, fnID uuid.UUID, reason *enums.SkipReason, from, until time.Time) (int64, error)
+}
+
+// CreateRuleOpts creates a rule.
+type CreateRuleOpts struct {
+	AccountID    uuid.UUID
+	WorkspaceID  uuid.UUID
+	Name         string
+	FunctionID   uuid.UUID
+	FunctionSlug string
+	Metric       Metric
+	SkipReason   *enums.SkipReason
+	Threshold    float64
+	Window       time.Duration
+	Channels     []Channel
+	Disabled     bool
+}
+
+// UpdateRuleOpts updates a rule.  Nil fields are left unchanged.
+type UpdateRuleOpts struct {
+	Name       *string
+	SkipReason *enums.SkipReason
+	Threshold  *float64
+	Window     *time.Duration
+	Channels   []Channel
+	Disabled   *bool
+}
+
+// Manager manages a workspace's rules and alerts.
+type Manager interface {
+	CreateRule(ctx context.Context, opts CreateRuleOpts) (*Rule, error)
+	GetRule(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Rule, error)
+	ListRules(ctx context.Context, workspaceID uuid.UUID) ([]Rule, error)
+	UpdateRule(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID, opts UpdateRuleOpts) (*Rule, error)
+	// DeleteRule deletes a rule, resolving its firing alert.
+	DeleteRule(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) error
+	ListAlerts(ctx context.Context, workspaceID uuid.UUID, opts ListAlertsOpts) ([]Alert, bool, error)
+}
+
+// NewManager returns a Manager backed by the given store.
+func NewManager(store Store) Manager {
+	return &manager{store: store, now: time.Now}
+}
+
+type manager struct {
+	store Store
+	now   func() time.Time
+}
+
+func (m *manager) CreateRule(ctx context.Context, opts CreateRuleOpts) (*Rule, error) {
+	now := m.now()
+	r := Rule{
+		ID:           ulid.MustNew(ulid.Timestamp(now), rand.Reader),
+		AccountID:    opts.AccountID,
+		WorkspaceID:  opts.WorkspaceID,
+		Name:         strings.TrimSpace(opts.Name),
+		FunctionID:   opts.FunctionID,
+		FunctionSlug: opts.FunctionSlug,
+		Metric:       opts.Metric,
+		SkipReason:   opts.SkipReason,
+		Threshold:    opts.Threshold,
+		Window:       opts.Window,
+		Channels:     opts.Channels,
+		Disabled:     opts.Disabled,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if err := m.store.CreateRule(ctx, r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (m *manager) GetRule(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Rule, error) {
+	return m.store.GetRule(ctx, workspaceID, id)
+}
+
+func (m *manager) ListRules(ctx context.Context, workspaceID uuid.UUID) ([]Rule, error) {
+	return m.store.ListRules(ctx, workspaceID)
+}
+
+func (m *manager) UpdateRule(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID, opts UpdateRuleOpts) (*Rule, error) {
+	r, err := m.store.GetRule(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Name != nil {
+		r.Name = strings.TrimSpace(*opts.Name)
+	}
+	if opts.SkipReason != nil {
+		r.SkipReason = opts.SkipReason
+	}
+	if opts.Threshold != nil {
+		r.Threshold = *opts.Threshold
+	}
+	if opts.Window != nil {
+		r.Window = *opts.Window
+	}
+	if opts.Channels != nil {
+		r.Channels = opts.Channels
+	}
+	if opts.Disabled != nil {
+		r.Disabled = *opts.Disabled
+	}
+	r.UpdatedAt = m.now()
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if err := m.store.UpdateRule(ctx, *r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (m *manager) DeleteRule(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) error {
+	r, err := m.store.GetRule(ctx, workspaceID, id)
+	if err != nil {
+		return err
+	}
+	if err := m.store.DeleteRule(ctx, workspaceID, id); err != nil {
+		return err
+	}
+	if !r.State.Firing() {
+		return nil
+	}
+
+	// Resolve the firing alert so that the history doesn't show the alert
+	// firing forever.
+	a, err := m.store.GetAlert(ctx, *r.State.AlertID)
+	if errors.Is(err, ErrAlertNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	now := m.now()
+	a.Status = AlertStatusResolved
+	a.ResolvedAt = &now
+	return m.store.SaveAlert(ctx, *a)
+}
+
+func (m *manager) ListAlerts(ctx context.Context, workspaceID uuid.UUID, opts ListAlertsOpts) ([]Alert, bool, error) {
+	return m.store.ListAlerts(ctx, workspaceID, opts)
+}
@@ -0,0 +1,318 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) rueidis.Client {
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return rc
+}
+
+// source returns fixed metrics.
+type source struct {
+	runs    []RunSample
+	backlog time.Duration
+}
+
+func (s *source) Runs(ctx context.Context, r Rule, from, until time.Time) ([]RunSample, error) {
+	return s.runs, nil
+}
+
+func (s *source) BacklogAge(ctx context.Context, r Rule, now time.Time) (time.Duration, error) {
+	return s.backlog, nil
+}
+
+func validOpts(wsID uuid.UUID) CreateRuleOpts {
+	return CreateRuleOpts{
+		WorkspaceID:  wsID,
+		Name:         "Too many failures",
+		FunctionID:   uuid.New(),
+		FunctionSlug: "app-fn",
+		Metric:       MetricFailureRate,
+		Threshold:    0.5,
+		Window:       5 * time.Minute,
+		Channels:     []Channel{ChannelEvent},
+	}
+}
+
+func TestRuleValidation(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(NewRedisStore(newTestClient(t), ""))
+	wsID := uuid.New()
+	skip := enums.SkipReasonFunctionPaused
+
+	tests := []struct {
+		name   string
+		modify func(o *CreateRuleOpts)
+	}{
+		{"missing name", func(o *CreateRuleOpts) { o.Name = " " }},
+		{"missing function", func(o *CreateRuleOpts) { o.FunctionID = uuid.Nil }},
+		{"unknown metric", func(o *CreateRuleOpts) { o.Metric = "latency" }},
+		{"skip reason without skipped count", func(o *CreateRuleOpts) { o.SkipReason = &skip }},
+		{"negative threshold", func(o *CreateRuleOpts) { o.Threshold = -1 }},
+		{"failure rate above 1", func(o *CreateRuleOpts) { o.Threshold = 1 }},
+		{"short window", func(o *CreateRuleOpts) { o.Window = time.Second }},
+		{"long window", func(o *CreateRuleOpts) { o.Window = 48 * time.Hour }},
+		{"no channels", func(o *CreateRuleOpts) { o.Channels = nil }},
+		{"unknown channel", func(o *CreateRuleOpts) { o.Channels = []Channel{"email"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := validOpts(wsID)
+			tt.modify(&opts)
+			_, err := m.CreateRule(ctx, opts)
+			require.ErrorIs(t, err, ErrInvalidRule)
+		})
+	}
+
+	// Backlog age is measured at each evaluation, so has no window.
+	opts := validOpts(wsID)
+	opts.Metric, opts.Window, opts.Threshold = MetricBacklogAge, 0, 60
+	_, err := m.CreateRule(ctx, opts)
+	require.NoError(t, err)
+}
+
+func TestRuleCRUD(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(NewRedisStore(newTestClient(t), ""))
+	wsID := uuid.New()
+
+	r, err := m.CreateRule(ctx, validOpts(wsID))
+	require.NoError(t, err)
+
+	// Rules are scoped to their workspace.
+	_, err = m.GetRule(ctx, uuid.New(), r.ID)
+	require.ErrorIs(t, err, ErrRuleNotFound)
+
+	threshold, disabled := 0.25, true
+	updated, err := m.UpdateRule(ctx, wsID, r.ID, UpdateRuleOpts{Threshold: &threshold, Disabled: &disabled})
+	require.NoError(t, err)
+	require.Equal(t, 0.25, updated.Threshold)
+	require.True(t, updated.Disabled)
+	require.Equal(t, r.Name, updated.Name)
+
+	threshold = 2
+	_, err = m.UpdateRule(ctx, wsID, r.ID, UpdateRuleOpts{Threshold: &threshold})
+	require.ErrorIs(t, err, ErrInvalidRule)
+
+	rules, err := m.ListRules(ctx, wsID)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.Equal(t, 0.25, rules[0].Threshold)
+
+	require.NoError(t, m.DeleteRule(ctx, wsID, r.ID))
+	require.ErrorIs(t, m.DeleteRule(ctx, wsID, r.ID), ErrRuleNotFound)
+	_, err = m.UpdateRule(ctx, wsID, r.ID, UpdateRuleOpts{Threshold: &threshold})
+	require.ErrorIs(t, err, ErrRuleNotFound)
+}
+
+func TestMetrics(t *testing.T) {
+	require.Zero(t, FailureRate(nil))
+	require.Zero(t, P95Duration(nil))
+
+	var runs []RunSample
+	for i := 1; i <= 20; i++ {
+		runs = append(runs, RunSample{Failed: i%4 == 0, Duration: time.Duration(i) * time.Second})
+	}
+	require.Equal(t, 0.25, FailureRate(runs))
+	require.Equal(t, 19*time.Second, P95Duration(runs))
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	rc := newTestClient(t)
+	store := NewRedisStore(rc, "")
+	whStore := webhooks.NewRedisStore(rc, "")
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	_, err := webhooks.NewManager(whStore).CreateEndpoint(ctx, webhooks.CreateEndpointOpts{
+		WorkspaceID: wsID,
+		URL:         "https://example.com/hook",
+		Events:      []webhooks.EventType{webhooks.EventAlertFired, webhooks.EventAlertResolved},
+	})
+	require.NoError(t, err)
+
+	opts := validOpts(wsID)
+	opts.Channels = []Channel{ChannelEvent, ChannelWebhook}
+	r, err := m.CreateRule(ctx, opts)
+	require.NoError(t, err)
+
+	var sent []event.Event
+	src := &source{}
+	s := NewService(store, src,
+		WithWebhooks(whStore),
+		WithEventSender(func(ctx context.Context, id uuid.UUID, evt event.Event) error {
+			require.Equal(t, wsID, id)
+			sent = append(sent, evt)
+			return nil
+		}),
+	).(*svc)
+
+	// Below the threshold, nothing fires.
+	src.runs = []RunSample{{Failed: true}, {}, {}}
+	require.NoError(t, s.evaluateAll(ctx))
+	require.Empty(t, sent)
+	got, err := m.GetRule(ctx, wsID, r.ID)
+	require.NoError(t, err)
+	require.False(t, got.State.Firing())
+	require.InDelta(t, 1.0/3, got.State.Value, 0.001)
+
+	// Breaching the threshold fires once, however many evaluations breach.
+	src.runs = []RunSample{{Failed: true}, {Failed: true}, {}}
+	require.NoError(t, s.evaluateAll(ctx))
+	src.runs = []RunSample{{Failed: true}}
+	require.NoError(t, s.evaluateAll(ctx))
+	require.Len(t, sent, 1)
+	require.Equal(t, consts.AlertFiredName, sent[0].Name)
+	require.Equal(t, "firing", sent[0].Data["alert"].(map[string]any)["status"])
+
+	got, err = m.GetRule(ctx, wsID, r.ID)
+	require.NoError(t, err)
+	require.True(t, got.State.Firing())
+
+	alerts, _, err := m.ListAlerts(ctx, wsID, ListAlertsOpts{})
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertStatusFiring, alerts[0].Status)
+	require.InDelta(t, 2.0/3, alerts[0].Value, 0.001)
+	require.Equal(t, 1.0, alerts[0].PeakValue)
+
+	// Recovering resolves the alert.
+	src.runs = nil
+	require.NoError(t, s.evaluateAll(ctx))
+	require.Len(t, sent, 2)
+	require.Equal(t, consts.AlertResolvedName, sent[1].Name)
+
+	alerts, _, err = m.ListAlerts(ctx, wsID, ListAlertsOpts{RuleID: &r.ID})
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertStatusResolved, alerts[0].Status)
+	require.NotNil(t, alerts[0].ResolvedAt)
+
+	// Both transitions were queued for the webhook.
+	eps, err := whStore.ListEndpoints(ctx, wsID)
+	require.NoError(t, err)
+	deliveries, _, err := whStore.ListDeliveries(ctx, eps[0].ID, webhooks.ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	n := webhooks.Notification{}
+	require.NoError(t, json.Unmarshal(deliveries[0].Payload, &n))
+	require.Equal(t, webhooks.EventAlertResolved, n.Type)
+	require.Nil(t, n.Run)
+	require.Equal(t, r.ID, n.Alert.RuleID)
+}
+
+func TestDeleteResolvesAlert(t *testing.T) {
+	ctx := context.Background()
+	store := NewRedisStore(newTestClient(t), "")
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	r, err := m.CreateRule(ctx, validOpts(wsID))
+	require.NoError(t, err)
+	s := NewService(store, &source{runs: []RunSample{{Failed: true}}}).(*svc)
+	require.NoError(t, s.evaluateAll(ctx))
+
+	require.NoError(t, m.DeleteRule(ctx, wsID, r.ID))
+	alerts, _, err := m.ListAlerts(ctx, wsID, ListAlertsOpts{})
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertStatusResolved, alerts[0].Status)
+
+	// Deleted rules are no longer evaluated.
+	require.NoError(t, s.evaluateAll(ctx))
+	all, err := store.AllRules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
+
+func TestMissingAlertResetsState(t *testing.T) {
+	ctx := context.Background()
+	rc := newTestClient(t)
+	store := NewRedisStore(rc, "")
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	r, err := m.CreateRule(ctx, validOpts(wsID))
+	require.NoError(t, err)
+	s := NewService(store, &source{runs: []RunSample{{Failed: true}}}).(*svc)
+	require.NoError(t, s.evaluateAll(ctx))
+
+	got, err := m.GetRule(ctx, wsID, r.ID)
+	require.NoError(t, err)
+	require.True(t, got.State.Firing())
+	first := *got.State.AlertID
+
+	// The firing alert expires while the rule is still breached.
+	key := store.(redisStore).alertKey(first)
+	require.NoError(t, rc.Do(ctx, rc.B().Del().Key(key).Build()).Error())
+
+	require.NoError(t, s.evaluate(ctx, *got))
+	got, err = m.GetRule(ctx, wsID, r.ID)
+	require.NoError(t, err)
+	require.False(t, got.State.Firing())
+	require.Equal(t, 1.0, got.State.Value)
+
+	// The next evaluation fires a new alert.
+	require.NoError(t, s.evaluate(ctx, *got))
+	got, err = m.GetRule(ctx, wsID, r.ID)
+	require.NoError(t, err)
+	require.True(t, got.State.Firing())
+	require.NotEqual(t, first, *got.State.AlertID)
+}
+
+func TestSkippedCount(t *testing.T) {
+	ctx := context.Background()
+	store := NewRedisStore(newTestClient(t), "")
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	opts := validOpts(wsID)
+	paused := enums.SkipReasonFunctionPaused
+	opts.Metric, opts.SkipReason, opts.Threshold = MetricSkippedCount, &paused, 1
+	r, err := m.CreateRule(ctx, opts)
+	require.NoError(t, err)
+
+	l := NewLifecycleListener(store)
+	md := statev2.Metadata{ID: statev2.ID{FunctionID: r.FunctionID}}
+	l.OnFunctionSkipped(ctx, md, execution.SkipState{Reason: enums.SkipReasonFunctionPaused})
+	l.OnFunctionSkipped(ctx, md, execution.SkipState{Reason: enums.SkipReasonFunctionPaused})
+	l.OnFunctionSkipped(ctx, md, execution.SkipState{Reason: enums.SkipReasonSingleton})
+
+	now := time.Now()
+	n, err := store.CountSkips(ctx, r.FunctionID, nil, now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, n)
+	n, err = store.CountSkips(ctx, r.FunctionID, &paused, now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+
+	s := NewService(store, &source{}).(*svc)
+	require.NoError(t, s.evaluateAll(ctx))
+	got, err := m.GetRule(ctx, wsID, r.ID)
+	require.NoError(t, err)
+	require.True(t, got.State.Firing())
+	require.Equal(t, 2.0, got.State.Value)
+}
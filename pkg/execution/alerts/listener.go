@@ -0,0 +1,30 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/inngest/inngest/pkg/execution"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/logger"
+)
+
+// NewLifecycleListener returns a lifecycle listener which counts skipped runs
+// for MetricSkippedCount.  Skipped runs never start, so they're not reported
+// by the Source.
+func NewLifecycleListener(store Store) execution.LifecycleListener {
+	return &lifecycle{store: store, now: time.Now}
+}
+
+type lifecycle struct {
+	execution.NoopLifecyceListener
+
+	store Store
+	now   func() time.Time
+}
+
+func (l *lifecycle) OnFunctionSkipped(ctx context.Context, md statev2.Metadata, s execution.SkipState) {
+	if err := l.store.RecordSkip(context.WithoutCancel(ctx), md.ID.FunctionID, s.Reason, l.now()); err != nil {
+		logger.StdlibLogger(ctx).Error("error recording skipped run", "error", err, "function_id", md.ID.FunctionID)
+	}
+}
@@ -0,0 +1,373 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+)
+
+const DefaultPrefix = "{alerts}"
+
+// skipBucket is the granularity at which skipped runs are counted.
+const skipBucket = time.Minute
+
+// NewRedisStore returns a Store which persists rules and alerts in Redis.  All
+// keys share the given prefix, which must contain a hash tag so that keys are
+// stored within the same slot.
+func NewRedisStore(r rueidis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return redisStore{r: r, prefix: prefix}
+}
+
+type redisStore struct {
+	r      rueidis.Client
+	prefix string
+}
+
+// Rules are stored in a hash per workspace, keyed by rule ID, and the
+// workspaces with rules are tracked in a set so that every rule can be
+// evaluated.  Rule state is stored in a single hash keyed by rule ID.  Each
+// alert is stored as a JSON string, which expires AlertRetention after it
+// resolves, and is indexed in both its workspace's and its rule's alert
+// history, lexically sorted sets of alert IDs.  Skipped runs are counted in a
+// hash of counts by reason per function and minute.
+
+func (r redisStore) CreateRule(ctx context.Context, rule Rule) error {
+	byt, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+	cmds := rueidis.Commands{
+		r.r.B().Hset().Key(r.rulesKey(rule.WorkspaceID)).FieldValue().FieldValue(rule.ID.String(), string(byt)).Build(),
+		r.r.B().Sadd().Key(r.workspacesKey()).Member(rule.WorkspaceID.String()).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) GetRule(ctx context.Context, wsID uuid.UUID, id ulid.ULID) (*Rule, error) {
+	data, err := r.r.Do(ctx, r.r.B().Hget().Key(r.rulesKey(wsID)).Field(id.String()).Build()).ToString()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rule := Rule{}
+	if err := json.Unmarshal([]byte(data), &rule); err != nil {
+		return nil, fmt.Errorf("error decoding alert rule: %w", err)
+	}
+	if err := r.loadState(ctx, []*Rule{&rule}); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r redisStore) ListRules(ctx context.Context, wsID uuid.UUID) ([]Rule, error) {
+	vals, err := r.r.Do(ctx, r.r.B().Hvals().Key(r.rulesKey(wsID)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Rule, len(vals))
+	ptrs := make([]*Rule, len(vals))
+	for i, data := range vals {
+		if err := json.Unmarshal([]byte(data), &result[i]); err != nil {
+			return nil, fmt.Errorf("error decoding alert rule: %w", err)
+		}
+		ptrs[i] = &result[i]
+	}
+	if err := r.loadState(ctx, ptrs); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(result, func(a, b Rule) int {
+		return a.ID.Compare(b.ID)
+	})
+	return result, nil
+}
+
+func (r redisStore) AllRules(ctx context.Context) ([]Rule, error) {
+	members, err := r.r.Do(ctx, r.r.B().Smembers().Key(r.workspacesKey()).Build()).AsStrSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Rule
+	for _, m := range members {
+		wsID, err := uuid.Parse(m)
+		if err != nil {
+			continue
+		}
+		rules, err := r.ListRules(ctx, wsID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rules...)
+	}
+	return result, nil
+}
+
+func (r redisStore) UpdateRule(ctx context.Context, rule Rule) error {
+	byt, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+	ok, err := r.r.Do(ctx, r.r.B().Hexists().Key(r.rulesKey(rule.WorkspaceID)).Field(rule.ID.String()).Build()).AsBool()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrRuleNotFound
+	}
+	return r.r.Do(ctx, r.r.B().Hset().Key(r.rulesKey(rule.WorkspaceID)).FieldValue().FieldValue(rule.ID.String(), string(byt)).Build()).Error()
+}
+
+func (r redisStore) DeleteRule(ctx context.Context, wsID uuid.UUID, id ulid.ULID) error {
+	n, err := r.r.Do(ctx, r.r.B().Hdel().Key(r.rulesKey(wsID)).Field(id.String()).Build()).AsInt64()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrRuleNotFound
+	}
+	// The rule's alerts remain in the workspace's alert history until they
+	// expire.
+	return r.r.Do(ctx, r.r.B().Hdel().Key(r.stateKey()).Field(id.String()).Build()).Error()
+}
+
+func (r redisStore) SaveState(ctx context.Context, ruleID ulid.ULID, s State) error {
+	byt, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return r.r.Do(ctx, r.r.B().Hset().Key(r.stateKey()).FieldValue().FieldValue(ruleID.String(), string(byt)).Build()).Error()
+}
+
+// loadState sets the state of each rule.
+func (r redisStore) loadState(ctx context.Context, rules []*Rule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	fields := make([]string, len(rules))
+	for i, rule := range rules {
+		fields[i] = rule.ID.String()
+	}
+	vals, err := r.r.Do(ctx, r.r.B().Hmget().Key(r.stateKey()).Field(fields...).Build()).ToArray()
+	if err != nil {
+		return err
+	}
+	for i, v := range vals {
+		data, err := v.ToString()
+		if rueidis.IsRedisNil(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(data), &rules[i].State); err != nil {
+			return fmt.Errorf("error decoding alert rule state: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r redisStore) SaveAlert(ctx context.Context, a Alert) error {
+	byt, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	// Firing alerts never expire, so that they can always be resolved.
+	set := r.r.B().Set().Key(r.alertKey(a.ID)).Value(string(byt)).Build()
+	if a.ResolvedAt != nil {
+		ttl := max(time.Until(a.ResolvedAt.Add(AlertRetention)), time.Second)
+		set = r.r.B().Set().Key(r.alertKey(a.ID)).Value(string(byt)).PxMilliseconds(ttl.Milliseconds()).Build()
+	}
+
+	// Trim alerts older than the retention period from the history.  Firing
+	// alerts are re-added whenever they're saved.
+	cutoff := ulid.ULID{}
+	_ = cutoff.SetTime(ulid.Timestamp(time.Now().Add(-AlertRetention)))
+
+	cmds := rueidis.Commands{
+		set,
+		r.r.B().Zadd().Key(r.historyKey(a.WorkspaceID)).ScoreMember().ScoreMember(0, a.ID.String()).Build(),
+		r.r.B().Zremrangebylex().Key(r.historyKey(a.WorkspaceID)).Min("-").Max("(" + cutoff.String()).Build(),
+		r.r.B().Zadd().Key(r.ruleHistoryKey(a.RuleID)).ScoreMember().ScoreMember(0, a.ID.String()).Build(),
+		r.r.B().Zremrangebylex().Key(r.ruleHistoryKey(a.RuleID)).Min("-").Max("(" + cutoff.String()).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) GetAlert(ctx context.Context, id ulid.ULID) (*Alert, error) {
+	data, err := r.r.Do(ctx, r.r.B().Get().Key(r.alertKey(id)).Build()).ToString()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrAlertNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Alert{}
+	if err := json.Unmarshal([]byte(data), a); err != nil {
+		return nil, fmt.Errorf("error decoding alert: %w", err)
+	}
+	return a, nil
+}
+
+func (r redisStore) ListAlerts(ctx context.Context, wsID uuid.UUID, opts ListAlertsOpts) ([]Alert, bool, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	key := r.historyKey(wsID)
+	if opts.RuleID != nil {
+		key = r.ruleHistoryKey(*opts.RuleID)
+	}
+
+	max := "+"
+	if opts.Cursor != nil {
+		max = "(" + opts.Cursor.String()
+	}
+
+	ids, err := r.r.Do(ctx, r.r.B().Zrevrangebylex().
+		Key(key).
+		Max(max).
+		Min("-").
+		Limit(0, int64(limit+1)).
+		Build(),
+	).AsStrSlice()
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(ids) > limit
+	if hasMore {
+		ids = ids[:limit]
+	}
+
+	result := make([]Alert, 0, len(ids))
+	for _, s := range ids {
+		id, err := ulid.Parse(s)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid alert ID %q: %w", s, err)
+		}
+		a, err := r.GetAlert(ctx, id)
+		if err == ErrAlertNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		// Rule histories are keyed by rule ID alone, so never return
+		// another workspace's alerts.
+		if a.WorkspaceID != wsID {
+			continue
+		}
+		result = append(result, *a)
+	}
+	return result, hasMore, nil
+}
+
+func (r redisStore) RecordSkip(ctx context.Context, fnID uuid.UUID, reason enums.SkipReason, at time.Time) error {
+	key := r.skipsKey(fnID, at)
+	cmds := rueidis.Commands{
+		r.r.B().Hincrby().Key(key).Field(reason.String()).Increment(1).Build(),
+		r.r.B().Pexpire().Key(key).Milliseconds((MaxWindow + skipBucket).Milliseconds()).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) CountSkips(ctx context.Context, fnID uuid.UUID, reason *enums.SkipReason, from, until time.Time) (int64, error) {
+	var cmds rueidis.Commands
+	for at := from.Truncate(skipBucket); at.Before(until); at = at.Add(skipBucket) {
+		key := r.skipsKey(fnID, at)
+		if reason != nil {
+			cmds = append(cmds, r.r.B().Hget().Key(key).Field(reason.String()).Build())
+		} else {
+			cmds = append(cmds, r.r.B().Hvals().Key(key).Build())
+		}
+	}
+
+	var total int64
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if reason != nil {
+			n, err := resp.AsInt64()
+			if rueidis.IsRedisNil(err) {
+				continue
+			}
+			if err != nil {
+				return 0, err
+			}
+			total += n
+			continue
+		}
+
+		vals, err := resp.AsStrSlice()
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range vals {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid skip count %q: %w", v, err)
+			}
+			total += n
+		}
+	}
+	return total, nil
+}
+
+func (r redisStore) rulesKey(wsID uuid.UUID) string {
+	return fmt.Sprintf("%s:rules:%s", r.prefix, wsID)
+}
+
+func (r redisStore) workspacesKey() string {
+	return fmt.Sprintf("%s:workspaces", r.prefix)
+}
+
+func (r redisStore) stateKey() string {
+	return fmt.Sprintf("%s:state", r.prefix)
+}
+
+func (r redisStore) alertKey(id ulid.ULID) string {
+	return fmt.Sprintf("%s:alert:%s", r.prefix, id)
+}
+
+func (r redisStore) historyKey(wsID uuid.UUID) string {
+	return fmt.Sprintf("%s:alerts:%s", r.prefix, wsID)
+}
+
+func (r redisStore) ruleHistoryKey(ruleID ulid.ULID) string {
+	return fmt.Sprintf("%s:rule-alerts:%s", r.prefix, ruleID)
+}
+
+func (r redisStore) skipsKey(fnID uuid.UUID, at time.Time) string {
+	return fmt.Sprintf("%s:skips:%s:%d", r.prefix, fnID, at.Truncate(skipBucket).Unix())
+}
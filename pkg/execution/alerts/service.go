@@ -0,0 +1,355 @@
+package alerts
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/webhooks"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/service"
+	"github.com/oklog/ulid/v2"
+)
+
+// DefaultInterval is how often rules are evaluated.
+const DefaultInterval = 30 * time.Second
+
+// RunSample is a single run which ended within a rule's window.
+type RunSample struct {
+	Failed   bool
+	Duration time.Duration
+}
+
+// Source reports the metrics which rules are evaluated against.
+type Source interface {
+	// Runs returns the function's runs which ended between from and until.
+	Runs(ctx context.Context, r Rule, from, until time.Time) ([]RunSample, error)
+	// BacklogAge returns how long the function's oldest due queue item has
+	// been waiting to start, or zero if its backlog is empty.
+	BacklogAge(ctx context.Context, r Rule, now time.Time) (time.Duration, error)
+}
+
+// EventSender sends an internal event to the given workspace.
+type EventSender func(ctx context.Context, workspaceID uuid.UUID, evt event.Event) error
+
+type ServiceOpt func(s *svc)
+
+// WithInterval sets how often rules are evaluated.
+func WithInterval(d time.Duration) ServiceOpt {
+	return func(s *svc) {
+		s.interval = d
+	}
+}
+
+// WithEventSender sets the sender used for ChannelEvent notifications.  Event
+// notifications are dropped if no sender is set.
+func WithEventSender(f EventSender) ServiceOpt {
+	return func(s *svc) {
+		s.sendEvent = f
+	}
+}
+
+// WithWebhooks sets the outbound webhook store used for ChannelWebhook
+// notifications.  Webhook notifications are dropped if no store is set.
+func WithWebhooks(store webhooks.Store) ServiceOpt {
+	return func(s *svc) {
+		s.webhooks = store
+	}
+}
+
+// NewService returns a service which periodically evaluates every rule,
+// firing and resolving alerts as their metrics cross the rules' thresholds.
+// Only a single service may run at a time.
+func NewService(store Store, source Source, opts ...ServiceOpt) service.Service {
+	s := &svc{
+		store:    store,
+		source:   source,
+		interval: DefaultInterval,
+		now:      time.Now,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+type svc struct {
+	store     Store
+	source    Source
+	interval  time.Duration
+	sendEvent EventSender
+	webhooks  webhooks.Store
+	now       func() time.Time
+}
+
+func (s *svc) Name() string {
+	return "alerts"
+}
+
+func (s *svc) Pre(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Run(ctx context.Context) error {
+	l := logger.StdlibLogger(ctx).With("service", s.Name())
+
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := s.evaluateAll(ctx); err != nil {
+				l.Error("error evaluating alert rules", "error", err)
+			}
+		}
+	}
+}
+
+// evaluateAll evaluates every rule.  A failing rule is logged and doesn't
+// prevent other rules from being evaluated.
+func (s *svc) evaluateAll(ctx context.Context) error {
+	rules, err := s.store.AllRules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		if err := s.evaluate(ctx, r); err != nil {
+			logger.StdlibLogger(ctx).Error("error evaluating alert rule", "error", err, "rule_id", r.ID)
+		}
+	}
+	return nil
+}
+
+// evaluate measures the rule's metric, firing an alert if it exceeds the
+// threshold and resolving the rule's firing alert otherwise.  The rule's state
+// is saved whether or not evaluation succeeds, so that an alert fired before a
+// later step fails is still tracked.
+func (s *svc) evaluate(ctx context.Context, r Rule) (err error) {
+	now := s.now()
+
+	state := r.State
+	defer func() {
+		if serr := s.store.SaveState(ctx, r.ID, state); serr != nil {
+			err = errors.Join(err, serr)
+		}
+	}()
+
+	var (
+		value    float64
+		breached bool
+	)
+	if !r.Disabled {
+		if value, err = s.measure(ctx, r, now); err != nil {
+			return err
+		}
+		breached = value > r.Threshold
+	}
+
+	state = State{AlertID: r.State.AlertID, Value: value, EvaluatedAt: now}
+	switch {
+	case breached && !r.State.Firing():
+		a := Alert{
+			ID:           ulid.MustNew(ulid.Timestamp(now), rand.Reader),
+			RuleID:       r.ID,
+			WorkspaceID:  r.WorkspaceID,
+			RuleName:     r.Name,
+			FunctionID:   r.FunctionID,
+			FunctionSlug: r.FunctionSlug,
+			Metric:       r.Metric,
+			Threshold:    r.Threshold,
+			Value:        value,
+			PeakValue:    value,
+			Status:       AlertStatusFiring,
+			FiredAt:      now,
+		}
+		if err := s.store.SaveAlert(ctx, a); err != nil {
+			return err
+		}
+		state.AlertID = &a.ID
+		s.notify(ctx, r, a, value)
+
+	case breached:
+		a, err := s.store.GetAlert(ctx, *r.State.AlertID)
+		if errors.Is(err, ErrAlertNotFound) {
+			// The firing alert was deleted or has expired.  Reset the state,
+			// so that the next evaluation fires a new alert.
+			state.AlertID = nil
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if value > a.PeakValue {
+			a.PeakValue = value
+			if err := s.store.SaveAlert(ctx, *a); err != nil {
+				return err
+			}
+		}
+
+	case r.State.Firing():
+		a, err := s.store.GetAlert(ctx, *r.State.AlertID)
+		if err != nil && !errors.Is(err, ErrAlertNotFound) {
+			return err
+		}
+		if a != nil {
+			a.Status = AlertStatusResolved
+			a.ResolvedAt = &now
+			if err := s.store.SaveAlert(ctx, *a); err != nil {
+				return err
+			}
+			s.notify(ctx, r, *a, value)
+		}
+		state.AlertID = nil
+	}
+
+	return nil
+}
+
+// measure returns the rule's metric at the given time.
+func (s *svc) measure(ctx context.Context, r Rule, now time.Time) (float64, error) {
+	switch r.Metric {
+	case MetricBacklogAge:
+		age, err := s.source.BacklogAge(ctx, r, now)
+		if err != nil {
+			return 0, fmt.Errorf("error loading backlog age: %w", err)
+		}
+		return age.Seconds(), nil
+
+	case MetricSkippedCount:
+		n, err := s.store.CountSkips(ctx, r.FunctionID, r.SkipReason, now.Add(-r.Window), now)
+		if err != nil {
+			return 0, fmt.Errorf("error counting skipped runs: %w", err)
+		}
+		return float64(n), nil
+
+	case MetricFailureRate, MetricP95Duration:
+		runs, err := s.source.Runs(ctx, r, now.Add(-r.Window), now)
+		if err != nil {
+			return 0, fmt.Errorf("error loading runs: %w", err)
+		}
+		if r.Metric == MetricFailureRate {
+			return FailureRate(runs), nil
+		}
+		return P95Duration(runs).Seconds(), nil
+	}
+	return 0, fmt.Errorf("unknown metric %q", r.Metric)
+}
+
+// FailureRate returns the fraction of runs which failed, or zero if there
+// are no runs.
+func FailureRate(runs []RunSample) float64 {
+	if len(runs) == 0 {
+		return 0
+	}
+	failed := 0
+	for _, r := range runs {
+		if r.Failed {
+			failed++
+		}
+	}
+	return float64(failed) / float64(len(runs))
+}
+
+// P95Duration returns the nearest-rank 95th percentile of the runs'
+// durations, or zero if there are no runs.
+func P95Duration(runs []RunSample) time.Duration {
+	if len(runs) == 0 {
+		return 0
+	}
+	durations := make([]time.Duration, len(runs))
+	for i, r := range runs {
+		durations[i] = r.Duration
+	}
+	slices.Sort(durations)
+	rank := int(math.Ceil(0.95 * float64(len(durations))))
+	return durations[rank-1]
+}
+
+// notify sends the alert's current status to each of the rule's channels.
+// Failures are logged, as the alert's status has already been stored.
+func (s *svc) notify(ctx context.Context, r Rule, a Alert, value float64) {
+	log := logger.StdlibLogger(ctx).With("rule_id", r.ID, "alert_id", a.ID, "status", a.Status)
+	now := s.now()
+
+	n := webhooks.NotificationAlert{
+		ID:           a.ID,
+		RuleID:       a.RuleID,
+		RuleName:     a.RuleName,
+		FunctionID:   a.FunctionID,
+		FunctionSlug: a.FunctionSlug,
+		Metric:       string(a.Metric),
+		Threshold:    a.Threshold,
+		Value:        value,
+		Status:       string(a.Status),
+		FiredAt:      a.FiredAt,
+		ResolvedAt:   a.ResolvedAt,
+	}
+
+	for _, c := range r.Channels {
+		switch c {
+		case ChannelEvent:
+			if s.sendEvent == nil {
+				continue
+			}
+			name := consts.AlertFiredName
+			if a.Status == AlertStatusResolved {
+				name = consts.AlertResolvedName
+			}
+			data, err := toMap(n)
+			if err != nil {
+				log.Error("error encoding alert event", "error", err)
+				continue
+			}
+			evt := event.Event{
+				ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+				Name:      name,
+				Timestamp: now.UnixMilli(),
+				Data:      map[string]any{"alert": data},
+			}
+			if err := s.sendEvent(ctx, r.WorkspaceID, evt); err != nil {
+				log.Error("error sending alert event", "error", err)
+			}
+
+		case ChannelWebhook:
+			if s.webhooks == nil {
+				continue
+			}
+			t := webhooks.EventAlertFired
+			if a.Status == AlertStatusResolved {
+				t = webhooks.EventAlertResolved
+			}
+			err := webhooks.Publish(ctx, s.webhooks, r.WorkspaceID, webhooks.Notification{
+				ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader),
+				Type:      t,
+				CreatedAt: now,
+				Alert:     &n,
+			})
+			if err != nil {
+				log.Error("error publishing alert webhook", "error", err)
+			}
+		}
+	}
+}
+
+func toMap(v any) (map[string]any, error) {
+	byt, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	return m, json.Unmarshal(byt, &m)
+}
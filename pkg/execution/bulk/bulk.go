@@ -0,0 +1,311 @@
+// Package bulk implements bulk operations against function runs.  A bulk
+// operation selects runs using the same filters as the runs list (including
+// CEL expressions), then cancels or reruns every matching run at a controlled
+// rate as a trackable, abortable job.
+package bulk
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// DefaultRatePerSecond is the number of runs processed per second when an
+	// operation does not specify a rate.
+	DefaultRatePerSecond = 10
+	// MaxRatePerSecond is the maximum number of runs an operation may process
+	// per second.
+	MaxRatePerSecond = 100
+)
+
+var (
+	// ErrOperationNotFound is returned when an operation does not exist within
+	// the given workspace.
+	ErrOperationNotFound = errors.New("bulk operation not found")
+	// ErrOperationEnded is returned when aborting an operation which has already
+	// completed, failed, or been aborted.
+	ErrOperationEnded = errors.New("bulk operation has already ended")
+	// ErrRunSkipped is returned by a RunActioner when a run does not need the
+	// operation's action applied, eg. cancelling a run which has already ended.
+	// Skipped runs are counted separately from failures.
+	ErrRunSkipped = errors.New("run skipped")
+)
+
+// Filter selects the runs that a bulk operation applies to.  Filters mirror the
+// runs list, including CEL expressions supported by run.ExpressionHandler.
+type Filter struct {
+	// AppIDs filters runs to the given app IDs.
+	AppIDs []string `json:"app_ids,omitempty"`
+	// FunctionIDs filters runs to the given function slugs.  AppIDs must be
+	// set when filtering by function.
+	FunctionIDs []string `json:"function_ids,omitempty"`
+	// Status filters runs to the given statuses.
+	Status []enums.RunStatus `json:"status,omitempty"`
+	// TimeField is the run timestamp used with From and Until.
+	TimeField enums.TraceRunTime `json:"time_field"`
+	// From is the inclusive lower bound of the run time range.
+	From time.Time `json:"from"`
+	// Until is the inclusive upper bound of the run time range.  When creating
+	// an operation this defaults to the creation time, so that runs created
+	// by the operation itself (eg. reruns) are never selected.
+	Until time.Time `json:"until"`
+	// Query is an optional CEL expression, eg. `event.data.userId == "123"`.
+	Query string `json:"query,omitempty"`
+}
+
+// Validate checks that the filter can be converted into a run query.
+func (f Filter) Validate() error {
+	if len(f.FunctionIDs) > 0 && len(f.AppIDs) == 0 {
+		return fmt.Errorf("app IDs are required when filtering by function IDs")
+	}
+	if !f.Until.IsZero() && f.From.After(f.Until) {
+		return fmt.Errorf("from must be before until")
+	}
+	return nil
+}
+
+// TraceRunFilter converts the filter into a cqrs run filter for the given tenant.
+func (f Filter) TraceRunFilter(accountID, workspaceID uuid.UUID) cqrs.GetTraceRunFilter {
+	return cqrs.GetTraceRunFilter{
+		AccountID:    accountID,
+		WorkspaceID:  workspaceID,
+		AppName:      f.AppIDs,
+		FunctionSlug: f.FunctionIDs,
+		TimeField:    f.TimeField,
+		From:         f.From,
+		Until:        f.Until,
+		Status:       f.Status,
+		CEL:          f.Query,
+	}
+}
+
+// Operation represents a single bulk operation and its progress.
+type Operation struct {
+	ID          ulid.ULID `json:"id"`
+	AccountID   uuid.UUID `json:"account_id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+
+	Action enums.BulkOperationAction `json:"action"`
+	Status enums.BulkOperationStatus `json:"status"`
+	Filter Filter                    `json:"filter"`
+
+	// RatePerSecond is the maximum number of runs processed per second.
+	RatePerSecond int `json:"rate_per_second"`
+
+	// Total is the number of runs which matched the filter when the operation
+	// was created.
+	Total int `json:"total"`
+	// Processed is the number of runs the action has been applied to so far,
+	// and is always the sum of Succeeded, Skipped and Failed.
+	Processed int `json:"processed"`
+	Succeeded int `json:"succeeded"`
+	Skipped   int `json:"skipped"`
+	Failed    int `json:"failed"`
+
+	// Cursor is the pagination cursor of the last run processed.
+	Cursor string `json:"cursor,omitempty"`
+	// Error records why an operation failed.
+	Error string `json:"error,omitempty"`
+
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+// Remaining returns the number of runs left to process, based off of the count
+// of matching runs when the operation was created.
+func (o Operation) Remaining() int {
+	if o.Processed >= o.Total {
+		return 0
+	}
+	return o.Total - o.Processed
+}
+
+// CreateOpts creates a new bulk operation.
+type CreateOpts struct {
+	AccountID     uuid.UUID
+	WorkspaceID   uuid.UUID
+	Action        enums.BulkOperationAction
+	Filter        Filter
+	RatePerSecond int
+}
+
+// ListOpts lists bulk operations for a workspace, newest first.
+type ListOpts struct {
+	// Cursor is the ID of the last operation from the previous page.
+	Cursor *ulid.ULID
+	Limit  int
+}
+
+// RunReader loads runs matching a bulk operation's filter.  This is
+// implemented by the cqrs manager.
+type RunReader interface {
+	GetTraceRuns(ctx context.Context, opt cqrs.GetTraceRunOpt) ([]*cqrs.TraceRun, error)
+	GetTraceRunsCount(ctx context.Context, opt cqrs.GetTraceRunOpt) (int, error)
+}
+
+// RunActioner applies a bulk operation's action to a single run.
+// Implementations should return ErrRunSkipped when the run cannot have the
+// action applied, eg. cancelling a run that has already ended.
+type RunActioner interface {
+	Cancel(ctx context.Context, op Operation, run *cqrs.TraceRun) error
+	Rerun(ctx context.Context, op Operation, run *cqrs.TraceRun) error
+}
+
+// Store persists bulk operations and their progress.
+type Store interface {
+	// Create stores a new operation and marks it as active.
+	Create(ctx context.Context, op Operation) error
+	// Get loads an operation by ID, returning ErrOperationNotFound if the
+	// operation doesn't exist.
+	Get(ctx context.Context, id ulid.ULID) (*Operation, error)
+	// List lists operations for a workspace, newest first.
+	List(ctx context.Context, wsID uuid.UUID, opts ListOpts) ([]Operation, bool, error)
+	// SaveProgress stores the progress of an operation.  This must only be
+	// called by the worker holding the operation's lease, and never updates
+	// the operation's status.
+	SaveProgress(ctx context.Context, op Operation) error
+	// End transitions a running operation to the given terminal status,
+	// returning false if the operation had already ended.
+	End(ctx context.Context, id ulid.ULID, status enums.BulkOperationStatus, reason string, at time.Time) (bool, error)
+	// Active returns the IDs of all operations that still need processing.
+	Active(ctx context.Context) ([]ulid.ULID, error)
+	// Deactivate removes an operation from the active set.
+	Deactivate(ctx context.Context, id ulid.ULID) error
+	// Lease leases an operation for processing, returning false if another
+	// worker already holds the lease.
+	Lease(ctx context.Context, id ulid.ULID, leaseID ulid.ULID, dur time.Duration) (bool, error)
+	// ReleaseLease releases a lease held by the given lease ID.
+	ReleaseLease(ctx context.Context, id ulid.ULID, leaseID ulid.ULID) error
+}
+
+// Manager previews, creates, and manages bulk operations.  Operations are
+// processed asynchronously by the bulk Service.
+type Manager interface {
+	// Preview returns the number of runs matching the given filter.
+	Preview(ctx context.Context, accountID, workspaceID uuid.UUID, f Filter) (int, error)
+	// Create creates a new operation, which is processed asynchronously.
+	Create(ctx context.Context, opts CreateOpts) (*Operation, error)
+	// Get returns a single operation within a workspace.
+	Get(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Operation, error)
+	// List lists operations within a workspace, newest first.
+	List(ctx context.Context, workspaceID uuid.UUID, opts ListOpts) ([]Operation, bool, error)
+	// Abort stops a running operation.  Runs which were already processed are
+	// left as-is.
+	Abort(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Operation, error)
+}
+
+// NewManager returns a Manager which stores operations in the given store and
+// reads runs from the given reader.
+func NewManager(store Store, reader RunReader) Manager {
+	return &manager{store: store, reader: reader, now: time.Now}
+}
+
+type manager struct {
+	store  Store
+	reader RunReader
+	now    func() time.Time
+}
+
+func (m *manager) Preview(ctx context.Context, accountID, workspaceID uuid.UUID, f Filter) (int, error) {
+	if f.Until.IsZero() {
+		f.Until = m.now()
+	}
+	if err := f.Validate(); err != nil {
+		return 0, err
+	}
+	return m.reader.GetTraceRunsCount(ctx, cqrs.GetTraceRunOpt{
+		Filter:  f.TraceRunFilter(accountID, workspaceID),
+		Preview: true,
+	})
+}
+
+func (m *manager) Create(ctx context.Context, opts CreateOpts) (*Operation, error) {
+	now := m.now()
+
+	f := opts.Filter
+	if f.Until.IsZero() || f.Until.After(now) {
+		// Never select runs created after the operation, which would otherwise
+		// include every rerun created by this operation.
+		f.Until = now
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	rate := opts.RatePerSecond
+	if rate == 0 {
+		rate = DefaultRatePerSecond
+	}
+	if rate < 1 || rate > MaxRatePerSecond {
+		return nil, fmt.Errorf("rate per second must be between 1 and %d", MaxRatePerSecond)
+	}
+
+	total, err := m.reader.GetTraceRunsCount(ctx, cqrs.GetTraceRunOpt{
+		Filter:  f.TraceRunFilter(opts.AccountID, opts.WorkspaceID),
+		Preview: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error counting matching runs: %w", err)
+	}
+
+	id, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	op := Operation{
+		ID:            id,
+		AccountID:     opts.AccountID,
+		WorkspaceID:   opts.WorkspaceID,
+		Action:        opts.Action,
+		Status:        enums.BulkOperationStatusRunning,
+		Filter:        f,
+		RatePerSecond: rate,
+		Total:         total,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := m.store.Create(ctx, op); err != nil {
+		return nil, fmt.Errorf("error creating bulk operation: %w", err)
+	}
+	return &op, nil
+}
+
+func (m *manager) Get(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Operation, error) {
+	op, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if op.WorkspaceID != workspaceID {
+		return nil, ErrOperationNotFound
+	}
+	return op, nil
+}
+
+func (m *manager) List(ctx context.Context, workspaceID uuid.UUID, opts ListOpts) ([]Operation, bool, error) {
+	return m.store.List(ctx, workspaceID, opts)
+}
+
+func (m *manager) Abort(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Operation, error) {
+	if _, err := m.Get(ctx, workspaceID, id); err != nil {
+		return nil, err
+	}
+
+	ok, err := m.store.End(ctx, id, enums.BulkOperationStatusAborted, "", m.now())
+	if err != nil {
+		return nil, fmt.Errorf("error aborting bulk operation: %w", err)
+	}
+	if !ok {
+		return nil, ErrOperationEnded
+	}
+	return m.store.Get(ctx, id)
+}
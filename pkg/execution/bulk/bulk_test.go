@@ -0,0 +1,359 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReader pages through a fixed set of runs, using the run's index as the
+// cursor.
+type fakeReader struct {
+	runs    []*cqrs.TraceRun
+	filters []cqrs.GetTraceRunOpt
+}
+
+func (f *fakeReader) GetTraceRunsCount(ctx context.Context, opt cqrs.GetTraceRunOpt) (int, error) {
+	f.filters = append(f.filters, opt)
+	return len(f.runs), nil
+}
+
+func (f *fakeReader) GetTraceRuns(ctx context.Context, opt cqrs.GetTraceRunOpt) ([]*cqrs.TraceRun, error) {
+	f.filters = append(f.filters, opt)
+	start := 0
+	if opt.Cursor != "" {
+		n, err := strconv.Atoi(opt.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = n + 1
+	}
+	end := min(start+int(opt.Items), len(f.runs))
+	if start >= end {
+		return nil, nil
+	}
+	return f.runs[start:end], nil
+}
+
+type fakeActioner struct {
+	mu        sync.Mutex
+	cancelled []string
+	rerun     []string
+	skip      map[string]bool
+	fail      map[string]bool
+}
+
+func (f *fakeActioner) Cancel(ctx context.Context, op Operation, run *cqrs.TraceRun) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.skip[run.RunID] {
+		return ErrRunSkipped
+	}
+	if f.fail[run.RunID] {
+		return fmt.Errorf("boom")
+	}
+	f.cancelled = append(f.cancelled, run.RunID)
+	return nil
+}
+
+func (f *fakeActioner) Rerun(ctx context.Context, op Operation, run *cqrs.TraceRun) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rerun = append(f.rerun, run.RunID)
+	return nil
+}
+
+func newTestRuns(n int) []*cqrs.TraceRun {
+	runs := make([]*cqrs.TraceRun, n)
+	for i := range runs {
+		runs[i] = &cqrs.TraceRun{
+			RunID:  ulid.Make().String(),
+			Cursor: strconv.Itoa(i),
+			Status: enums.RunStatusRunning,
+		}
+	}
+	return runs
+}
+
+func newTestStore(t *testing.T) Store {
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return NewRedisStore(rc, "")
+}
+
+func TestCreateAndPreview(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	reader := &fakeReader{runs: newTestRuns(3)}
+	m := NewManager(store, reader)
+
+	acctID, wsID := uuid.New(), uuid.New()
+	filter := Filter{
+		AppIDs:      []string{"app"},
+		FunctionIDs: []string{"fn"},
+		Status:      []enums.RunStatus{enums.RunStatusFailed},
+		Query:       `event.data.userId == "123"`,
+	}
+
+	count, err := m.Preview(ctx, acctID, wsID, filter)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.True(t, reader.filters[0].Preview)
+	require.Equal(t, filter.Query, reader.filters[0].Filter.CEL)
+	require.Equal(t, wsID, reader.filters[0].Filter.WorkspaceID)
+
+	op, err := m.Create(ctx, CreateOpts{
+		AccountID:   acctID,
+		WorkspaceID: wsID,
+		Action:      enums.BulkOperationActionCancel,
+		Filter:      filter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, op.Total)
+	require.Equal(t, DefaultRatePerSecond, op.RatePerSecond)
+	require.Equal(t, enums.BulkOperationStatusRunning, op.Status)
+	require.False(t, op.Filter.Until.IsZero(), "until should default to the creation time")
+
+	found, err := m.Get(ctx, wsID, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, op.ID, found.ID)
+	require.Equal(t, filter.Query, found.Filter.Query)
+
+	_, err = m.Get(ctx, uuid.New(), op.ID)
+	require.ErrorIs(t, err, ErrOperationNotFound)
+
+	t.Run("invalid filters are rejected", func(t *testing.T) {
+		_, err := m.Create(ctx, CreateOpts{
+			AccountID:   acctID,
+			WorkspaceID: wsID,
+			Filter:      Filter{FunctionIDs: []string{"fn"}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("rates are bounded", func(t *testing.T) {
+		_, err := m.Create(ctx, CreateOpts{
+			AccountID:     acctID,
+			WorkspaceID:   wsID,
+			RatePerSecond: MaxRatePerSecond + 1,
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestServiceProcessesOperation(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	runs := newTestRuns(12)
+	reader := &fakeReader{runs: runs}
+	actioner := &fakeActioner{
+		skip: map[string]bool{runs[0].RunID: true},
+		fail: map[string]bool{runs[1].RunID: true},
+	}
+	m := NewManager(store, reader)
+	s := NewService(store, reader, actioner).(*svc)
+
+	wsID := uuid.New()
+	op, err := m.Create(ctx, CreateOpts{
+		AccountID:     uuid.New(),
+		WorkspaceID:   wsID,
+		Action:        enums.BulkOperationActionCancel,
+		RatePerSecond: MaxRatePerSecond,
+	})
+	require.NoError(t, err)
+
+	// A single tick processes every run, as the page size exceeds the number of runs.
+	require.NoError(t, s.tick(ctx))
+
+	found, err := m.Get(ctx, wsID, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, enums.BulkOperationStatusCompleted, found.Status)
+	require.NotNil(t, found.EndedAt)
+	require.Equal(t, 12, found.Processed)
+	require.Equal(t, 10, found.Succeeded)
+	require.Equal(t, 1, found.Skipped)
+	require.Equal(t, 1, found.Failed)
+	require.Len(t, actioner.cancelled, 10)
+	require.Empty(t, actioner.rerun)
+
+	active, err := store.Active(ctx)
+	require.NoError(t, err)
+	require.Empty(t, active)
+}
+
+func TestServicePagesAtRate(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	reader := &fakeReader{runs: newTestRuns(7)}
+	actioner := &fakeActioner{}
+	m := NewManager(store, reader)
+	s := NewService(store, reader, actioner).(*svc)
+
+	wsID := uuid.New()
+	op, err := m.Create(ctx, CreateOpts{
+		AccountID:     uuid.New(),
+		WorkspaceID:   wsID,
+		Action:        enums.BulkOperationActionRerun,
+		RatePerSecond: 1,
+	})
+	require.NoError(t, err)
+
+	// Override the page size by processing the first page directly: a rate of
+	// 1/s processes 5 runs per page.
+	start := time.Now()
+	require.NoError(t, s.processPage(ctx, op.ID))
+	require.GreaterOrEqual(t, time.Since(start), 4*time.Second)
+
+	found, err := m.Get(ctx, wsID, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, enums.BulkOperationStatusRunning, found.Status)
+	require.Equal(t, 5, found.Processed)
+	require.Equal(t, "4", found.Cursor)
+	require.Len(t, actioner.rerun, 5)
+}
+
+func TestAbort(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	reader := &fakeReader{runs: newTestRuns(3)}
+	actioner := &fakeActioner{}
+	m := NewManager(store, reader)
+	s := NewService(store, reader, actioner).(*svc)
+
+	wsID := uuid.New()
+	op, err := m.Create(ctx, CreateOpts{
+		AccountID:   uuid.New(),
+		WorkspaceID: wsID,
+		Action:      enums.BulkOperationActionCancel,
+	})
+	require.NoError(t, err)
+
+	aborted, err := m.Abort(ctx, wsID, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, enums.BulkOperationStatusAborted, aborted.Status)
+	require.NotNil(t, aborted.EndedAt)
+
+	_, err = m.Abort(ctx, wsID, op.ID)
+	require.ErrorIs(t, err, ErrOperationEnded)
+
+	// Aborted operations are never processed.
+	require.NoError(t, s.tick(ctx))
+	require.Empty(t, actioner.cancelled)
+
+	found, err := m.Get(ctx, wsID, op.ID)
+	require.NoError(t, err)
+	require.Equal(t, enums.BulkOperationStatusAborted, found.Status)
+	require.Equal(t, 0, found.Processed)
+}
+
+func TestLeases(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	id := ulid.Make()
+
+	a, b := ulid.Make(), ulid.Make()
+	ok, err := store.Lease(ctx, id, a, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = store.Lease(ctx, id, b, time.Minute)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Releasing another worker's lease is a noop.
+	require.NoError(t, store.ReleaseLease(ctx, id, b))
+	ok, err = store.Lease(ctx, id, b, time.Minute)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, store.ReleaseLease(ctx, id, a))
+	ok, err = store.Lease(ctx, id, b, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	m := NewManager(store, &fakeReader{})
+
+	wsID := uuid.New()
+	ids := []ulid.ULID{}
+	for range 5 {
+		op, err := m.Create(ctx, CreateOpts{AccountID: uuid.New(), WorkspaceID: wsID})
+		require.NoError(t, err)
+		ids = append(ids, op.ID)
+		time.Sleep(2 * time.Millisecond)
+	}
+	// Operations in other workspaces are never listed.
+	_, err := m.Create(ctx, CreateOpts{AccountID: uuid.New(), WorkspaceID: uuid.New()})
+	require.NoError(t, err)
+
+	page, hasMore, err := m.List(ctx, wsID, ListOpts{Limit: 3})
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Len(t, page, 3)
+	require.Equal(t, ids[4], page[0].ID)
+	require.Equal(t, ids[2], page[2].ID)
+
+	page, hasMore, err = m.List(ctx, wsID, ListOpts{Limit: 3, Cursor: &page[2].ID})
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Len(t, page, 2)
+	require.Equal(t, ids[1], page[0].ID)
+	require.Equal(t, ids[0], page[1].ID)
+}
+
+func TestEndedOperationsExpire(t *testing.T) {
+	ctx := context.Background()
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	store := NewRedisStore(rc, "")
+	m := NewManager(store, &fakeReader{})
+
+	wsID := uuid.New()
+	running, err := m.Create(ctx, CreateOpts{AccountID: uuid.New(), WorkspaceID: wsID})
+	require.NoError(t, err)
+	ended, err := m.Create(ctx, CreateOpts{AccountID: uuid.New(), WorkspaceID: wsID})
+	require.NoError(t, err)
+	_, err = m.Abort(ctx, wsID, ended.ID)
+	require.NoError(t, err)
+
+	// Running operations never expire.
+	require.Zero(t, r.TTL(DefaultPrefix+":op:"+running.ID.String()))
+	require.Equal(t, EndedOperationTTL, r.TTL(DefaultPrefix+":op:"+ended.ID.String()))
+
+	r.FastForward(EndedOperationTTL)
+	_, err = m.Get(ctx, wsID, ended.ID)
+	require.ErrorIs(t, err, ErrOperationNotFound)
+
+	page, _, err := m.List(ctx, wsID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, running.ID, page[0].ID)
+
+	members, err := r.ZMembers(DefaultPrefix + ":ws:" + wsID.String())
+	require.NoError(t, err)
+	require.Equal(t, []string{running.ID.String()}, members)
+}
@@ -0,0 +1,283 @@
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+)
+
+const (
+	DefaultPrefix = "{bulk}"
+
+	// EndedOperationTTL is how long an operation is kept once it has ended.
+	EndedOperationTTL = 7 * 24 * time.Hour
+)
+
+// NewRedisStore returns a Store which persists bulk operations in Redis.  All
+// keys share the given prefix, which must contain a hash tag so that keys are
+// stored within the same slot.
+func NewRedisStore(r rueidis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return redisStore{r: r, prefix: prefix}
+}
+
+type redisStore struct {
+	r      rueidis.Client
+	prefix string
+}
+
+// Each operation is stored as a hash.  The operation's data is only ever written
+// by the worker holding the operation's lease, whereas the status fields may be
+// changed at any time (eg. when aborting) and are only ever updated via
+// endScript, which guarantees that ended operations are never resumed and
+// expires them after EndedOperationTTL.
+const (
+	fieldData    = "data"
+	fieldStatus  = "status"
+	fieldEndedAt = "ended_at"
+	fieldError   = "error"
+)
+
+func (r redisStore) Create(ctx context.Context, op Operation) error {
+	byt, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	cmds := rueidis.Commands{
+		r.r.B().Hset().Key(r.opKey(op.ID)).FieldValue().
+			FieldValue(fieldData, string(byt)).
+			FieldValue(fieldStatus, strconv.Itoa(int(op.Status))).
+			Build(),
+		r.r.B().Zadd().Key(r.workspaceKey(op.WorkspaceID)).ScoreMember().ScoreMember(0, op.ID.String()).Build(),
+		r.r.B().Sadd().Key(r.activeKey()).Member(op.ID.String()).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) Get(ctx context.Context, id ulid.ULID) (*Operation, error) {
+	fields, err := r.r.Do(ctx, r.r.B().Hgetall().Key(r.opKey(id)).Build()).AsStrMap()
+	if err != nil {
+		return nil, err
+	}
+	data, ok := fields[fieldData]
+	if !ok {
+		return nil, ErrOperationNotFound
+	}
+
+	op := &Operation{}
+	if err := json.Unmarshal([]byte(data), op); err != nil {
+		return nil, fmt.Errorf("error decoding bulk operation: %w", err)
+	}
+
+	if s, ok := fields[fieldStatus]; ok {
+		status, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bulk operation status: %w", err)
+		}
+		op.Status = enums.BulkOperationStatus(status)
+	}
+	if s, ok := fields[fieldEndedAt]; ok && s != "" {
+		ms, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bulk operation end time: %w", err)
+		}
+		endedAt := time.UnixMilli(ms).UTC()
+		op.EndedAt = &endedAt
+	}
+	if s, ok := fields[fieldError]; ok && s != "" {
+		op.Error = s
+	}
+	return op, nil
+}
+
+func (r redisStore) List(ctx context.Context, wsID uuid.UUID, opts ListOpts) ([]Operation, bool, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	max := "+"
+	if opts.Cursor != nil {
+		max = "(" + opts.Cursor.String()
+	}
+
+	ids, err := r.r.Do(ctx, r.r.B().Zrevrangebylex().
+		Key(r.workspaceKey(wsID)).
+		Max(max).
+		Min("-").
+		Limit(0, int64(limit+1)).
+		Build(),
+	).AsStrSlice()
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(ids) > limit
+	if hasMore {
+		ids = ids[:limit]
+	}
+
+	result := make([]Operation, 0, len(ids))
+	for _, s := range ids {
+		id, err := ulid.Parse(s)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid bulk operation ID %q: %w", s, err)
+		}
+		op, err := r.Get(ctx, id)
+		if err == ErrOperationNotFound {
+			// The operation ended and expired, so drop it from the index.
+			if err := r.r.Do(ctx, r.r.B().Zrem().Key(r.workspaceKey(wsID)).Member(s).Build()).Error(); err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		result = append(result, *op)
+	}
+	return result, hasMore, nil
+}
+
+func (r redisStore) SaveProgress(ctx context.Context, op Operation) error {
+	byt, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+	return r.r.Do(ctx, r.r.B().Hset().Key(r.opKey(op.ID)).FieldValue().FieldValue(fieldData, string(byt)).Build()).Error()
+}
+
+func (r redisStore) End(ctx context.Context, id ulid.ULID, status enums.BulkOperationStatus, reason string, at time.Time) (bool, error) {
+	res, err := endScript.Exec(
+		ctx,
+		r.r,
+		[]string{r.opKey(id)},
+		[]string{
+			strconv.Itoa(int(enums.BulkOperationStatusRunning)),
+			strconv.Itoa(int(status)),
+			strconv.FormatInt(at.UnixMilli(), 10),
+			reason,
+			strconv.FormatInt(EndedOperationTTL.Milliseconds(), 10),
+		},
+	).AsInt64()
+	if err != nil {
+		return false, err
+	}
+	switch res {
+	case 1:
+		return true, nil
+	case 0:
+		return false, nil
+	default:
+		return false, ErrOperationNotFound
+	}
+}
+
+func (r redisStore) Active(ctx context.Context) ([]ulid.ULID, error) {
+	members, err := r.r.Do(ctx, r.r.B().Smembers().Key(r.activeKey()).Build()).AsStrSlice()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]ulid.ULID, 0, len(members))
+	for _, m := range members {
+		id, err := ulid.Parse(m)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r redisStore) Deactivate(ctx context.Context, id ulid.ULID) error {
+	return r.r.Do(ctx, r.r.B().Srem().Key(r.activeKey()).Member(id.String()).Build()).Error()
+}
+
+func (r redisStore) Lease(ctx context.Context, id ulid.ULID, leaseID ulid.ULID, dur time.Duration) (bool, error) {
+	err := r.r.Do(ctx, r.r.B().Set().Key(r.leaseKey(id)).Value(leaseID.String()).Nx().PxMilliseconds(dur.Milliseconds()).Build()).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r redisStore) ReleaseLease(ctx context.Context, id ulid.ULID, leaseID ulid.ULID) error {
+	return releaseScript.Exec(ctx, r.r, []string{r.leaseKey(id)}, []string{leaseID.String()}).Error()
+}
+
+func (r redisStore) opKey(id ulid.ULID) string {
+	return fmt.Sprintf("%s:op:%s", r.prefix, id)
+}
+
+func (r redisStore) workspaceKey(wsID uuid.UUID) string {
+	return fmt.Sprintf("%s:ws:%s", r.prefix, wsID)
+}
+
+func (r redisStore) activeKey() string {
+	return fmt.Sprintf("%s:active", r.prefix)
+}
+
+func (r redisStore) leaseKey(id ulid.ULID) string {
+	return fmt.Sprintf("%s:lease:%s", r.prefix, id)
+}
+
+var (
+	endScript = rueidis.NewLuaScript(`
+--[[
+
+Output:
+  1: Operation ended
+  0: Operation had already ended
+ -1: Operation not found
+
+]]
+
+local keyOp = KEYS[1]
+
+local running = ARGV[1]
+local status  = ARGV[2]
+local endedAt = ARGV[3]
+local reason  = ARGV[4]
+local ttl     = tonumber(ARGV[5])
+
+local current = redis.call("HGET", keyOp, "status")
+if current == false then
+	return -1
+end
+if current ~= running then
+	return 0
+end
+
+redis.call("HSET", keyOp, "status", status, "ended_at", endedAt, "error", reason)
+redis.call("PEXPIRE", keyOp, ttl)
+return 1
+	`)
+
+	releaseScript = rueidis.NewLuaScript(`
+local keyLease = KEYS[1]
+local leaseID  = ARGV[1]
+
+if redis.call("GET", keyLease) == leaseID then
+	redis.call("DEL", keyLease)
+end
+return 0
+	`)
+)
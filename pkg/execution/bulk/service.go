@@ -0,0 +1,227 @@
+package bulk
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/service"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	DefaultPollInterval = time.Second
+
+	// leaseDuration is the duration a worker holds an operation whilst
+	// processing a single page of runs.  Pages are sized so that they can
+	// always be processed well within this duration at the operation's rate.
+	leaseDuration = 30 * time.Second
+	// pageSeconds is the number of seconds of work processed per page.
+	pageSeconds = 5
+	maxPageSize = 100
+)
+
+type ServiceOpt func(s *svc)
+
+// WithPollInterval sets how often the service checks for active operations.
+func WithPollInterval(d time.Duration) ServiceOpt {
+	return func(s *svc) {
+		s.interval = d
+	}
+}
+
+// NewService returns a service which processes active bulk operations, applying
+// each operation's action to matching runs at the operation's rate.  Operations
+// are leased whilst processing, so many services may run concurrently.
+func NewService(store Store, reader RunReader, actioner RunActioner, opts ...ServiceOpt) service.Service {
+	s := &svc{
+		store:    store,
+		reader:   reader,
+		actioner: actioner,
+		interval: DefaultPollInterval,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+type svc struct {
+	store    Store
+	reader   RunReader
+	actioner RunActioner
+	interval time.Duration
+}
+
+func (s *svc) Name() string {
+	return "bulk-operations"
+}
+
+func (s *svc) Pre(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Run(ctx context.Context) error {
+	l := logger.StdlibLogger(ctx).With("service", s.Name())
+
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		if err := s.tick(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			l.Error("error processing bulk operations", "error", err)
+		}
+	}
+}
+
+// tick processes a single page of runs for every active operation.
+func (s *svc) tick(ctx context.Context) error {
+	ids, err := s.store.Active(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading active bulk operations: %w", err)
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		leaseID, err := ulid.New(ulid.Now(), rand.Reader)
+		if err != nil {
+			return err
+		}
+		ok, err := s.store.Lease(ctx, id, leaseID, leaseDuration)
+		if err != nil {
+			return fmt.Errorf("error leasing bulk operation: %w", err)
+		}
+		if !ok {
+			// Another worker is processing this operation.
+			continue
+		}
+
+		err = s.processPage(ctx, id)
+		_ = s.store.ReleaseLease(context.WithoutCancel(ctx), id, leaseID)
+		if err != nil {
+			logger.StdlibLogger(ctx).Error("error processing bulk operation", "error", err, "operation_id", id)
+		}
+	}
+	return nil
+}
+
+// processPage processes a single page of runs for the given operation, ending
+// the operation when there are no more runs to process.
+func (s *svc) processPage(ctx context.Context, id ulid.ULID) error {
+	op, err := s.store.Get(ctx, id)
+	if errors.Is(err, ErrOperationNotFound) {
+		return s.store.Deactivate(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	if enums.BulkOperationStatusEnded(op.Status) {
+		return s.store.Deactivate(ctx, id)
+	}
+
+	rate := op.RatePerSecond
+	if rate < 1 {
+		rate = DefaultRatePerSecond
+	}
+	pageSize := min(rate*pageSeconds, maxPageSize)
+
+	runs, err := s.reader.GetTraceRuns(ctx, cqrs.GetTraceRunOpt{
+		Filter: op.Filter.TraceRunFilter(op.AccountID, op.WorkspaceID),
+		Order: []cqrs.GetTraceRunOrder{{
+			Field:     op.Filter.TimeField,
+			Direction: enums.TraceRunOrderAsc,
+		}},
+		Cursor:  op.Cursor,
+		Items:   uint(pageSize),
+		Preview: true,
+	})
+	if err != nil {
+		if _, endErr := s.store.End(ctx, id, enums.BulkOperationStatusFailed, fmt.Sprintf("error loading runs: %s", err), time.Now()); endErr != nil {
+			return endErr
+		}
+		return s.store.Deactivate(ctx, id)
+	}
+
+	interval := time.Second / time.Duration(rate)
+	for i, run := range runs {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return s.store.SaveProgress(context.WithoutCancel(ctx), *op)
+			case <-time.After(interval):
+			}
+		}
+
+		// Check for aborts before every run, so that aborting an operation
+		// takes effect immediately.
+		current, err := s.store.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if enums.BulkOperationStatusEnded(current.Status) {
+			if err := s.store.SaveProgress(ctx, *op); err != nil {
+				return err
+			}
+			return s.store.Deactivate(ctx, id)
+		}
+
+		switch err := s.apply(ctx, *op, run); {
+		case err == nil:
+			op.Succeeded++
+		case errors.Is(err, ErrRunSkipped):
+			op.Skipped++
+		default:
+			logger.StdlibLogger(ctx).Warn("error applying bulk operation to run",
+				"error", err,
+				"operation_id", op.ID,
+				"run_id", run.RunID,
+				"action", op.Action,
+			)
+			op.Failed++
+		}
+		op.Processed++
+		op.Cursor = run.Cursor
+		op.UpdatedAt = time.Now()
+	}
+
+	if err := s.store.SaveProgress(ctx, *op); err != nil {
+		return err
+	}
+
+	if len(runs) < pageSize {
+		if _, err := s.store.End(ctx, id, enums.BulkOperationStatusCompleted, "", time.Now()); err != nil {
+			return err
+		}
+		return s.store.Deactivate(ctx, id)
+	}
+	return nil
+}
+
+func (s *svc) apply(ctx context.Context, op Operation, run *cqrs.TraceRun) error {
+	switch op.Action {
+	case enums.BulkOperationActionCancel:
+		return s.actioner.Cancel(ctx, op, run)
+	case enums.BulkOperationActionRerun:
+		return s.actioner.Rerun(ctx, op, run)
+	default:
+		return fmt.Errorf("unknown bulk operation action: %s", op.Action)
+	}
+}
@@ -97,7 +97,7 @@ func (d *deleteManager) DeleteQueueItem(ctx context.Context, shard queue.QueueSh
 		}
 	// Some items do not have any other associated data
 	// TODO: Should we drop state for function runs?
-	case queue.KindEdge, queue.KindEdgeError, queue.KindStart, queue.KindSleep:
+	case queue.KindEdge, queue.KindEdgeError, queue.KindStart, queue.KindSleep, queue.KindLock:
 		break
 	// The following system queues do not have associated state we need to clean up
 	case queue.KindCancel, queue.KindJobPromote, queue.KindPauseBlockFlush:
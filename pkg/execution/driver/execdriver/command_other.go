@@ -0,0 +1,18 @@
+//go:build !unix
+
+package execdriver
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// command returns the command for an executable.  Resource limits are only
+// supported on unix platforms.
+func command(ctx context.Context, path string, args []string, limits Limits) (*exec.Cmd, error) {
+	if limits.MaxMemoryBytes > 0 || limits.MaxCPU > 0 {
+		return nil, fmt.Errorf("exec resource limits are not supported on this platform")
+	}
+	return exec.CommandContext(ctx, path, args...), nil
+}
@@ -0,0 +1,35 @@
+//go:build unix
+
+package execdriver
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"syscall"
+)
+
+// command returns the command for an executable.  Resource limits are applied
+// with ulimit via a shell, which then replaces itself with the executable.
+func command(ctx context.Context, path string, args []string, limits Limits) (*exec.Cmd, error) {
+	cmd := exec.CommandContext(ctx, path, args...)
+
+	script := ""
+	if limits.MaxMemoryBytes > 0 {
+		script += fmt.Sprintf("ulimit -v %d || exit 1; ", max(1, limits.MaxMemoryBytes/1024))
+	}
+	if limits.MaxCPU > 0 {
+		script += fmt.Sprintf("ulimit -t %d || exit 1; ", max(1, int64(limits.MaxCPU.Seconds())))
+	}
+	if script != "" {
+		cmd = exec.CommandContext(ctx, "/bin/sh", append([]string{"-c", script + `exec "$0" "$@"`, path}, args...)...)
+	}
+
+	// Run the process in its own group so that any children are killed when
+	// the process times out.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	return cmd, nil
+}
@@ -0,0 +1,370 @@
+package execdriver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/execution/driver"
+	"github.com/inngest/inngest/pkg/execution/driver/httpv2"
+	"github.com/inngest/inngest/pkg/execution/state"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/util/errs"
+)
+
+const (
+	// Scheme is the URL scheme for functions run as local executables, eg.
+	// exec:///usr/local/bin/my-function.
+	Scheme = "exec"
+
+	// DefaultTimeout is the default timeout for each process.
+	DefaultTimeout = 5 * time.Minute
+
+	// maxStderrSize is the maximum amount of stderr retained as a step error's
+	// stack.
+	maxStderrSize = 64 * 1024
+)
+
+var (
+	// ErrDisabled is returned when no directories are allowed to contain
+	// executables.
+	ErrDisabled = errors.New("exec functions are not enabled")
+	// ErrNotAllowed is returned when a function's executable isn't within
+	// an allowed directory.
+	ErrNotAllowed = errors.New("executable is not within an allowed directory")
+)
+
+// Limits are resource limits applied to each process.  Zero values are
+// unlimited.
+type Limits struct {
+	// MaxMemoryBytes limits the process' virtual memory.
+	MaxMemoryBytes int64
+	// MaxCPU limits the process' CPU time.
+	MaxCPU time.Duration
+}
+
+// ExecConfig is the per-function configuration for exec functions, stored in
+// the function's driver metadata.
+type ExecConfig struct {
+	// Args are the arguments passed to the executable.
+	Args []string `json:"args"`
+
+	// Timeout is the timeout for each process, eg. "30s".  This may lower but
+	// never raise the driver's timeout.
+	Timeout string `json:"timeout"`
+
+	// MaxMemoryMB and MaxCPUSeconds may lower but never raise the driver's
+	// limits.
+	MaxMemoryMB   int64 `json:"max_memory_mb"`
+	MaxCPUSeconds int64 `json:"max_cpu_seconds"`
+
+	// Env are environment variables set for the process.
+	Env map[string]string `json:"env"`
+}
+
+type Opt func(d *execDriver)
+
+// WithAllowedDirs sets the directories which may contain executables.  The
+// driver refuses to run any executable outside of these directories, and
+// runs nothing if no directories are allowed.
+func WithAllowedDirs(dirs ...string) Opt {
+	return func(d *execDriver) {
+		for _, dir := range dirs {
+			if abs, err := filepath.Abs(dir); err == nil {
+				d.dirs = append(d.dirs, abs)
+			}
+		}
+	}
+}
+
+// WithTimeout sets the maximum timeout for each process.
+func WithTimeout(t time.Duration) Opt {
+	return func(d *execDriver) {
+		d.timeout = t
+	}
+}
+
+// WithEnv sets the names of environment variables passed from the server's
+// environment to each process.  Processes only inherit PATH and these
+// variables, so that the server's secrets aren't exposed to functions.
+func WithEnv(names ...string) Opt {
+	return func(d *execDriver) {
+		d.env = append(d.env, names...)
+	}
+}
+
+// WithLimits sets the maximum resource limits for each process.
+func WithLimits(l Limits) Opt {
+	return func(d *execDriver) {
+		d.limits = l
+	}
+}
+
+func NewDriver(opts ...Opt) driver.DriverV2 {
+	d := &execDriver{
+		timeout: DefaultTimeout,
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
+}
+
+// execDriver runs functions as local executables, registered with an
+// exec:// URL.
+//
+// The SDK request is written to the process' stdin, and the process must write
+// its opcodes to stdout exactly as an SDK responds to HTTP requests.  A non-zero
+// exit is a step error, with stderr as the error's stack.
+type execDriver struct {
+	dirs    []string
+	env     []string
+	timeout time.Duration
+	limits  Limits
+}
+
+func (d *execDriver) Name() string {
+	return "exec"
+}
+
+// Do executes the function by spawning its executable.
+func (d *execDriver) Do(ctx context.Context, sl sv2.StateLoader, opts driver.V2RequestOpts) (*state.DriverResponse, errs.UserError, errs.InternalError) {
+	if len(opts.Fn.Steps) == 0 {
+		return nil, nil, errs.Wrap(0, false, "function has no steps")
+	}
+
+	path, err := d.executable(opts.URL)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, false, "error resolving executable: %w", err)
+	}
+
+	cfg, err := config(opts.Fn.Driver.Metadata)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, false, "invalid exec config: %w", err)
+	}
+	timeout, limits, err := d.resolve(cfg)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, false, "invalid exec config: %w", err)
+	}
+
+	step := opts.Fn.Steps[0]
+	ctx = driver.WithRequestIDs(ctx, opts.RequestID, opts.JobID)
+	payload, err := driver.MarshalV1(ctx, sl, opts.Metadata, step, opts.Index, "", opts.Attempt, step.RetryCount()+1, opts.QueueRef)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, true, "error marshalling request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd, err := command(ctx, path, cfg.Args, limits)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, false, "error creating command: %w", err)
+	}
+
+	env := append(d.environ(cfg),
+		"INNGEST_RUN_ID="+opts.Metadata.ID.RunID.String(),
+		"INNGEST_REQUEST_ID="+opts.RequestID,
+		fmt.Sprintf("INNGEST_REQUEST_VERSION=%d", opts.Metadata.Config.RequestVersion),
+	)
+	if sig := httpv2.Sign(ctx, opts.SigningKey, payload); sig != "" {
+		env = append(env, "INNGEST_SIGNATURE="+sig)
+	}
+	if opts.StepID != nil && *opts.StepID != "" && *opts.StepID != "step" {
+		env = append(env, "INNGEST_STEP_ID="+*opts.StepID)
+	}
+
+	stdout := &limitedBuffer{max: consts.MaxSDKResponseBodySize}
+	stderr := &limitedBuffer{max: maxStderrSize, tail: true}
+	cmd.Env = env
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	start := time.Now()
+	err = cmd.Run()
+	dur := time.Since(start)
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return failed(dur, fmt.Sprintf("Process timed out after %s", timeout), stderr.String())
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return failed(dur, fmt.Sprintf("Process exited with %s", exitErr.ProcessState), stderr.String())
+	}
+	if err != nil {
+		return nil, nil, errs.Wrap(0, true, "error running executable: %w", err)
+	}
+	if stdout.truncated {
+		return nil, errs.WrapUser(0, false, "SDK response too large"), nil
+	}
+
+	r := &state.DriverResponse{
+		StatusCode:     200,
+		Duration:       dur,
+		RequestVersion: opts.Metadata.Config.RequestVersion,
+	}
+	ops, uerr := httpv2.ParseOpcodes(stdout.Bytes(), 200)
+	if uerr != nil {
+		return r, uerr, nil
+	}
+	r.Generator = ops
+	return r, nil, nil
+}
+
+// executable returns the path to the function's executable, ensuring that it's
+// within an allowed directory.
+func (d *execDriver) executable(uri string) (string, error) {
+	if len(d.dirs) == 0 {
+		return "", ErrDisabled
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid function url: %w", err)
+	}
+	if u.Scheme != Scheme {
+		return "", fmt.Errorf("invalid function url scheme: %s", u.Scheme)
+	}
+	if u.Host != "" || !filepath.IsAbs(u.Path) {
+		return "", fmt.Errorf("function url must be an absolute path, eg. exec:///usr/local/bin/fn")
+	}
+
+	// Resolve symlinks so that links within an allowed directory can't
+	// point elsewhere.
+	path, err := filepath.EvalSymlinks(filepath.Clean(u.Path))
+	if err != nil {
+		return "", err
+	}
+
+	for _, dir := range d.dirs {
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			dir = resolved
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return path, nil
+		}
+	}
+	return "", ErrNotAllowed
+}
+
+// environ returns the environment for a process: PATH, the allowed variables
+// from the server's environment, and the function's configured variables.
+// Request variables are appended after, so that functions can't override
+// them.
+func (d *execDriver) environ(cfg ExecConfig) []string {
+	env := []string{}
+	for _, name := range append([]string{"PATH"}, d.env...) {
+		if val, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+val)
+		}
+	}
+	keys := make([]string, 0, len(cfg.Env))
+	for k := range cfg.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		env = append(env, k+"="+cfg.Env[k])
+	}
+	return env
+}
+
+// resolve returns the timeout and limits for a process, using the function's
+// config where it's stricter than the driver's.
+func (d *execDriver) resolve(cfg ExecConfig) (time.Duration, Limits, error) {
+	timeout, limits := d.timeout, d.limits
+
+	if cfg.Timeout != "" {
+		t, err := time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return 0, limits, fmt.Errorf("invalid timeout: %w", err)
+		}
+		if t > 0 && t < timeout {
+			timeout = t
+		}
+	}
+	if mem := cfg.MaxMemoryMB * 1024 * 1024; mem > 0 && (limits.MaxMemoryBytes == 0 || mem < limits.MaxMemoryBytes) {
+		limits.MaxMemoryBytes = mem
+	}
+	if cpu := time.Duration(cfg.MaxCPUSeconds) * time.Second; cpu > 0 && (limits.MaxCPU == 0 || cpu < limits.MaxCPU) {
+		limits.MaxCPU = cpu
+	}
+	return timeout, limits, nil
+}
+
+func config(md map[string]any) (ExecConfig, error) {
+	cfg := ExecConfig{}
+	if len(md) == 0 {
+		return cfg, nil
+	}
+	byt, err := json.Marshal(md)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(byt, &cfg); err != nil {
+		return cfg, err
+	}
+	for k := range cfg.Env {
+		if k == "" || strings.ContainsAny(k, "=\x00") {
+			return cfg, fmt.Errorf("invalid env var name %q", k)
+		}
+	}
+	return cfg, nil
+}
+
+// failed returns a retryable step error for a process which didn't complete,
+// using the process' stderr as the error's stack.
+func failed(dur time.Duration, msg, stderr string) (*state.DriverResponse, errs.UserError, errs.InternalError) {
+	se := state.StandardError{
+		Error:   msg,
+		Name:    state.DefaultErrorName,
+		Message: msg,
+		Stack:   stderr,
+	}
+	raw, _ := json.Marshal(se)
+
+	r := &state.DriverResponse{
+		StatusCode: 500,
+		Duration:   dur,
+		Err:        &msg,
+	}
+	return r, errs.WrapResponseAsUser(0, true, raw, "%s", msg), nil
+}
+
+// limitedBuffer retains at most max bytes written to it.  If tail is set, the
+// last max bytes are retained;  otherwise, writes past max are discarded.
+type limitedBuffer struct {
+	bytes.Buffer
+
+	max       int
+	tail      bool
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if b.Len()+len(p) > b.max {
+		b.truncated = true
+		if !b.tail {
+			p = p[:max(0, b.max-b.Len())]
+		}
+	}
+	_, _ = b.Buffer.Write(p)
+	if b.tail && b.Len() > b.max {
+		b.Buffer.Next(b.Len() - b.max)
+	}
+	return n, nil
+}
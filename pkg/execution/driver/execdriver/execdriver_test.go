@@ -0,0 +1,168 @@
+//go:build unix
+
+package execdriver
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/driver"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type stateLoader struct {
+	sv2.StateLoader
+}
+
+func (stateLoader) LoadEvents(ctx context.Context, id sv2.ID) ([]json.RawMessage, error) {
+	return []json.RawMessage{json.RawMessage(`{"name":"test/event","data":{}}`)}, nil
+}
+
+func (stateLoader) LoadSteps(ctx context.Context, id sv2.ID) (map[string]json.RawMessage, error) {
+	return map[string]json.RawMessage{}, nil
+}
+
+func (stateLoader) LoadDefersMeta(ctx context.Context, id sv2.ID) (map[string]sv2.DeferMeta, error) {
+	return nil, nil
+}
+
+// script writes an executable shell script to dir, returning its exec:// URL.
+func script(t *testing.T, dir, name, body string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755))
+	return "exec://" + path
+}
+
+func requestOpts(uri string, md map[string]any) driver.V2RequestOpts {
+	return driver.V2RequestOpts{
+		Metadata: sv2.Metadata{
+			ID:     sv2.ID{RunID: ulid.Make(), FunctionID: uuid.New()},
+			Config: *sv2.InitConfig(&sv2.Config{RequestVersion: 2}),
+		},
+		Fn: inngest.Function{
+			Driver: inngest.FunctionDriver{URI: uri, Metadata: md},
+			Steps:  []inngest.Step{{ID: "step", Name: "step", URI: uri}},
+		},
+		SigningKey: []byte("signing-key"),
+		URL:        uri,
+	}
+}
+
+func TestNewDriver(t *testing.T) {
+	d := NewDriver()
+	require.Equal(t, "exec", d.Name())
+	require.Equal(t, "exec", inngest.Driver(inngest.Function{Driver: inngest.FunctionDriver{URI: "exec:///usr/local/bin/fn"}}))
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	d := NewDriver(WithAllowedDirs(dir))
+
+	t.Run("reads opcodes from stdout", func(t *testing.T) {
+		// Echo the event name from the request on stdin as the step's output.
+		uri := script(t, dir, "ok.sh", `
+input=$(cat)
+case "$input" in
+  *test/event*) name=ok ;;
+  *) name=missing ;;
+esac
+[ -n "$INNGEST_SIGNATURE" ] || name=unsigned
+echo "[{\"op\":\"StepRun\",\"id\":\"a\",\"name\":\"a\",\"data\":\"$name-$1\"}]"`)
+
+		resp, uerr, ierr := d.Do(ctx, stateLoader{}, requestOpts(uri, map[string]any{"args": []string{"arg"}}))
+		require.NoError(t, ierr)
+		require.NoError(t, uerr)
+		require.Equal(t, 200, resp.StatusCode)
+		require.Len(t, resp.Generator, 1)
+		require.Equal(t, enums.OpcodeStepRun, resp.Generator[0].Op)
+		require.Equal(t, `"ok-arg"`, string(resp.Generator[0].Data))
+	})
+
+	t.Run("non-zero exits are step errors", func(t *testing.T) {
+		uri := script(t, dir, "fail.sh", `echo "something broke" >&2; exit 3`)
+
+		resp, uerr, ierr := d.Do(ctx, stateLoader{}, requestOpts(uri, nil))
+		require.NoError(t, ierr)
+		require.Error(t, uerr)
+		require.True(t, uerr.Retryable())
+		require.Contains(t, string(uerr.Raw()), "something broke")
+		require.Contains(t, *resp.Err, "exit status 3")
+	})
+
+	t.Run("non-opcode output is a user error", func(t *testing.T) {
+		uri := script(t, dir, "text.sh", `echo "hello"`)
+
+		_, uerr, ierr := d.Do(ctx, stateLoader{}, requestOpts(uri, nil))
+		require.NoError(t, ierr)
+		require.Error(t, uerr)
+	})
+
+	t.Run("enforces the function timeout", func(t *testing.T) {
+		uri := script(t, dir, "slow.sh", `sleep 10`)
+
+		start := time.Now()
+		resp, uerr, ierr := d.Do(ctx, stateLoader{}, requestOpts(uri, map[string]any{"timeout": "100ms"}))
+		require.NoError(t, ierr)
+		require.Error(t, uerr)
+		require.Contains(t, *resp.Err, "timed out")
+		require.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("runs with a minimal environment", func(t *testing.T) {
+		t.Setenv("EXEC_TEST_SECRET", "secret")
+		t.Setenv("EXEC_TEST_ALLOWED", "allowed")
+
+		uri := script(t, dir, "env.sh", `echo "[{\"op\":\"StepRun\",\"id\":\"a\",\"name\":\"a\",\"data\":\"$EXEC_TEST_SECRET|$EXEC_TEST_ALLOWED|$FN_VAR|$INNGEST_RUN_ID\"}]"`)
+		opts := requestOpts(uri, map[string]any{"env": map[string]string{"FN_VAR": "fn", "INNGEST_RUN_ID": "overridden"}})
+
+		resp, uerr, ierr := NewDriver(WithAllowedDirs(dir), WithEnv("EXEC_TEST_ALLOWED")).Do(ctx, stateLoader{}, opts)
+		require.NoError(t, ierr)
+		require.NoError(t, uerr)
+		require.Len(t, resp.Generator, 1)
+		require.Equal(t, `"|allowed|fn|`+opts.Metadata.ID.RunID.String()+`"`, string(resp.Generator[0].Data))
+	})
+
+	t.Run("rejects invalid env var names", func(t *testing.T) {
+		uri := script(t, dir, "env-invalid.sh", `echo "[]"`)
+
+		_, _, ierr := d.Do(ctx, stateLoader{}, requestOpts(uri, map[string]any{"env": map[string]string{"A=B": "c"}}))
+		require.ErrorContains(t, ierr, "invalid env var name")
+	})
+
+	t.Run("refuses executables outside allowed dirs", func(t *testing.T) {
+		uri := script(t, t.TempDir(), "other.sh", `echo "[]"`)
+
+		_, _, ierr := d.Do(ctx, stateLoader{}, requestOpts(uri, nil))
+		require.ErrorIs(t, ierr, ErrNotAllowed)
+	})
+
+	t.Run("disabled without allowed dirs", func(t *testing.T) {
+		uri := script(t, dir, "empty.sh", `echo "[]"`)
+
+		_, _, ierr := NewDriver().Do(ctx, stateLoader{}, requestOpts(uri, nil))
+		require.ErrorIs(t, ierr, ErrDisabled)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	d := NewDriver(WithTimeout(time.Minute), WithLimits(Limits{MaxMemoryBytes: 512 * 1024 * 1024})).(*execDriver)
+
+	timeout, limits, err := d.resolve(ExecConfig{Timeout: "1h", MaxMemoryMB: 128, MaxCPUSeconds: 10})
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, timeout, "functions can't raise the driver's timeout")
+	require.Equal(t, int64(128*1024*1024), limits.MaxMemoryBytes)
+	require.Equal(t, 10*time.Second, limits.MaxCPU)
+
+	_, _, err = d.resolve(ExecConfig{Timeout: "soon"})
+	require.Error(t, err)
+}
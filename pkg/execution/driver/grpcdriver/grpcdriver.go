@@ -0,0 +1,398 @@
+package grpcdriver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/driver"
+	"github.com/inngest/inngest/pkg/execution/driver/httpv2"
+	"github.com/inngest/inngest/pkg/execution/state"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/headers"
+	"github.com/inngest/inngest/pkg/util/errs"
+	driverv1 "github.com/inngest/inngest/proto/gen/driver/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SchemeInsecure is the URL scheme for functions served over plaintext gRPC.
+	SchemeInsecure = "grpc"
+	// SchemeTLS is the URL scheme for functions served over gRPC with TLS.
+	SchemeTLS = "grpcs"
+
+	// DefaultTimeout is the default timeout for each Execute call.
+	DefaultTimeout = 2 * time.Hour
+	// DefaultIdleTimeout is the default time after which a connection with no
+	// in-flight requests is closed.
+	DefaultIdleTimeout = 10 * time.Minute
+)
+
+type Opt func(d *grpcDriver)
+
+// WithTLSConfig sets the TLS config used when calling grpcs:// functions,
+// eg. to trust a private CA or to present a client certificate.  By default,
+// the host's root CAs are used.
+func WithTLSConfig(c *tls.Config) Opt {
+	return func(d *grpcDriver) {
+		d.tls = c
+	}
+}
+
+// WithTimeout sets the timeout for each Execute call.
+func WithTimeout(t time.Duration) Opt {
+	return func(d *grpcDriver) {
+		d.timeout = t
+	}
+}
+
+// WithIdleTimeout sets the time after which a connection with no in-flight
+// requests is closed.  Connections are re-created on the next request.
+func WithIdleTimeout(t time.Duration) Opt {
+	return func(d *grpcDriver) {
+		d.idleTimeout = t
+	}
+}
+
+// WithDialOptions adds dial options to every connection created by the driver.
+func WithDialOptions(opts ...grpc.DialOption) Opt {
+	return func(d *grpcDriver) {
+		d.dialOpts = append(d.dialOpts, opts...)
+	}
+}
+
+// NewDriver returns a gRPC driver.  The driver implements io.Closer, closing
+// every cached connection.
+func NewDriver(opts ...Opt) driver.DriverV2 {
+	d := &grpcDriver{
+		timeout:     DefaultTimeout,
+		idleTimeout: DefaultIdleTimeout,
+		conns:       map[string]*conn{},
+		now:         time.Now,
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
+}
+
+// grpcDriver executes functions served by a driver.v1.ExecutionService,
+// registered with a grpc:// or grpcs:// URL.
+//
+// Requests contain the same payload as the HTTP driver, signed via the
+// x-inngest-signature metadata key.  Responses are always opcodes.
+type grpcDriver struct {
+	tls         *tls.Config
+	timeout     time.Duration
+	idleTimeout time.Duration
+	dialOpts    []grpc.DialOption
+	now         func() time.Time
+
+	// conns caches a client connection per target.  gRPC connections are
+	// multiplexed, so a single connection is shared by every request.
+	conns  map[string]*conn
+	closed bool
+	l      sync.Mutex
+}
+
+// conn is a cached client connection.
+type conn struct {
+	cc *grpc.ClientConn
+	// active is the number of in-flight requests using the connection.
+	active int
+	// lastUsed is when the last request using the connection finished.
+	lastUsed time.Time
+}
+
+func (d *grpcDriver) Name() string {
+	return "grpc"
+}
+
+// Do executes the function via a gRPC Execute call.
+func (d *grpcDriver) Do(ctx context.Context, sl sv2.StateLoader, opts driver.V2RequestOpts) (*state.DriverResponse, errs.UserError, errs.InternalError) {
+	if len(opts.Fn.Steps) == 0 {
+		return nil, nil, errs.Wrap(0, false, "function has no steps")
+	}
+
+	client, release, err := d.client(opts.URL)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, false, "error creating grpc client: %w", err)
+	}
+	defer release()
+
+	step := opts.Fn.Steps[0]
+	ctx = driver.WithRequestIDs(ctx, opts.RequestID, opts.JobID)
+	payload, err := driver.MarshalV1(ctx, sl, opts.Metadata, step, opts.Index, "", opts.Attempt, step.RetryCount()+1, opts.QueueRef)
+	if err != nil {
+		return nil, nil, errs.Wrap(0, true, "error marshalling request: %w", err)
+	}
+
+	req := &driverv1.ExecuteRequest{
+		FunctionId:     opts.Metadata.ID.FunctionID.String(),
+		RunId:          opts.Metadata.ID.RunID.String(),
+		Attempt:        int32(opts.Attempt),
+		RequestId:      opts.RequestID,
+		GenerationId:   int64(opts.GenerationID),
+		JobId:          opts.JobID,
+		RequestVersion: int32(opts.Metadata.Config.RequestVersion),
+		Payload:        payload,
+	}
+	if opts.StepID != nil && *opts.StepID != "" && *opts.StepID != "step" {
+		req.StepId = opts.StepID
+	}
+
+	md := metadata.Pairs(headers.HeaderKeyRequestVersion, fmt.Sprintf("%d", opts.Metadata.Config.RequestVersion))
+	if sig := httpv2.Sign(ctx, opts.SigningKey, payload); sig != "" {
+		md.Set(headers.HeaderKeySignature, sig)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := client.Execute(ctx, req)
+	dur := time.Since(start)
+	if err != nil {
+		return handleError(err, dur)
+	}
+
+	ops, uerr := parseOpcodes(resp)
+	r := &state.DriverResponse{
+		Generator:      ops,
+		StatusCode:     200,
+		SDK:            resp.GetSdk(),
+		Duration:       dur,
+		NoRetry:        resp.GetNoRetry(),
+		RequestVersion: int(resp.GetRequestVersion()),
+	}
+	if resp.RetryAt != nil {
+		at := resp.RetryAt.AsTime()
+		r.RetryAt = &at
+	}
+	if uerr != nil {
+		return r, uerr, nil
+	}
+	return r, nil, nil
+}
+
+// Close closes every cached connection.  Requests made after Close fail.
+func (d *grpcDriver) Close() error {
+	d.l.Lock()
+	defer d.l.Unlock()
+
+	d.closed = true
+	var err error
+	for key, c := range d.conns {
+		err = errors.Join(err, c.cc.Close())
+		delete(d.conns, key)
+	}
+	return err
+}
+
+// client returns an ExecutionService client for the given function URL,
+// re-using any existing connection for the URL's host.  The returned func must
+// be called once the request finishes.
+func (d *grpcDriver) client(uri string) (driverv1.ExecutionServiceClient, func(), error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid function url: %w", err)
+	}
+	if u.Host == "" {
+		return nil, nil, fmt.Errorf("invalid function url: missing host")
+	}
+
+	var creds credentials.TransportCredentials
+	switch u.Scheme {
+	case SchemeInsecure:
+		creds = insecure.NewCredentials()
+	case SchemeTLS:
+		creds = credentials.NewTLS(d.tls)
+	default:
+		return nil, nil, fmt.Errorf("invalid function url scheme: %s", u.Scheme)
+	}
+
+	key := u.Scheme + "://" + u.Host
+
+	d.l.Lock()
+	defer d.l.Unlock()
+
+	if d.closed {
+		return nil, nil, fmt.Errorf("driver is closed")
+	}
+	d.evictIdle()
+
+	c, ok := d.conns[key]
+	if !ok {
+		dialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, d.dialOpts...)
+		cc, err := grpc.NewClient(u.Host, dialOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		c = &conn{cc: cc}
+		d.conns[key] = c
+	}
+	c.active++
+
+	release := func() {
+		d.l.Lock()
+		defer d.l.Unlock()
+		c.active--
+		c.lastUsed = d.now()
+	}
+	return driverv1.NewExecutionServiceClient(c.cc), release, nil
+}
+
+// evictIdle closes connections which have had no in-flight requests for the
+// idle timeout, so that connections to function servers which are no longer
+// called are not held forever.  This must be called with the lock held.
+func (d *grpcDriver) evictIdle() {
+	if d.idleTimeout <= 0 {
+		return
+	}
+	now := d.now()
+	for key, c := range d.conns {
+		if c.active == 0 && now.Sub(c.lastUsed) > d.idleTimeout {
+			_ = c.cc.Close()
+			delete(d.conns, key)
+		}
+	}
+}
+
+// handleError maps a failed Execute call to a driver response.  Errors reaching
+// the service are internal errors;  any other status returned by the service
+// is a user error, as the function failed without reporting opcodes.
+func handleError(err error, dur time.Duration) (*state.DriverResponse, errs.UserError, errs.InternalError) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, nil, errs.Wrap(0, true, "error executing request: %w", err)
+	}
+
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return nil, nil, errs.Wrap(0, true, "Unable to reach SDK: %w", err)
+	}
+
+	msg := fmt.Sprintf("Your server returned gRPC status %s: %s", st.Code(), st.Message())
+	se := state.StandardError{
+		Error:   msg,
+		Name:    state.FatalServerErrorName,
+		Message: msg,
+	}
+	raw, _ := json.Marshal(se)
+
+	str := fmt.Sprintf("invalid status code: %s", st.Code())
+	r := &state.DriverResponse{
+		StatusCode: 500,
+		Duration:   dur,
+		Err:        &str,
+	}
+	return r, errs.WrapResponseAsUser(0, true, raw, "SDK returned an error: %w", err), nil
+}
+
+// parseOpcodes converts the response's opcodes into generator opcodes,
+// validating each opcode exactly as the HTTP driver does.
+func parseOpcodes(resp *driverv1.ExecuteResponse) ([]*state.GeneratorOpcode, errs.UserError) {
+	if len(resp.Opcodes) == 0 {
+		// An empty list of opcodes is implicitly an enums.OpcodeNone step.
+		return []*state.GeneratorOpcode{{Op: enums.OpcodeNone}}, nil
+	}
+
+	gen := make([]*state.GeneratorOpcode, len(resp.Opcodes))
+	for n, item := range resp.Opcodes {
+		op, err := fromProto(item)
+		if err == nil {
+			err = op.Validate()
+		}
+		if err != nil {
+			err = fmt.Errorf("error validating generator opcode %s: %w", item.GetId(), err)
+			return nil, errs.WrapUser(0, false, "invalid opcode: %w", err)
+		}
+		gen[n] = op
+	}
+	return gen, nil
+}
+
+func fromProto(item *driverv1.Opcode) (*state.GeneratorOpcode, error) {
+	code, err := enums.OpcodeString(item.GetOp())
+	if err != nil {
+		return nil, err
+	}
+
+	op := &state.GeneratorOpcode{
+		Op:          code,
+		ID:          item.GetId(),
+		Name:        item.GetName(),
+		DisplayName: item.DisplayName,
+	}
+	if len(item.Opts) > 0 {
+		if err := json.Unmarshal(item.Opts, &op.Opts); err != nil {
+			return nil, fmt.Errorf("invalid opts: %w", err)
+		}
+	}
+	if len(item.Data) > 0 {
+		if !json.Valid(item.Data) {
+			return nil, fmt.Errorf("invalid data: not JSON")
+		}
+		op.Data = json.RawMessage(item.Data)
+	}
+	if e := item.Error; e != nil {
+		op.Error = &state.UserError{
+			Name:    e.GetName(),
+			Message: e.GetMessage(),
+			Stack:   e.GetStack(),
+			NoRetry: e.GetNoRetry(),
+		}
+		if len(e.Data) > 0 {
+			op.Error.Data = json.RawMessage(e.Data)
+		}
+		if len(e.Cause) > 0 {
+			op.Error.Cause = json.RawMessage(e.Cause)
+		}
+	}
+	if u := item.Userland; u != nil {
+		op.Userland = &struct {
+			ID    string `json:"id"`
+			Index int    `json:"index,omitempty"`
+		}{ID: u.GetId(), Index: int(u.GetIndex())}
+	}
+	return op, nil
+}
+
+// LoadTLSConfig returns a TLS config for grpcs:// functions which trusts the
+// given CA and, if certFile and keyFile are set, presents a client certificate.
+// An empty caFile uses the host's root CAs.
+func LoadTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		byt, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca file: %w", err)
+		}
+		c.RootCAs = x509.NewCertPool()
+		if !c.RootCAs.AppendCertsFromPEM(byt) {
+			return nil, fmt.Errorf("no certificates found in ca file")
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return c, nil
+}
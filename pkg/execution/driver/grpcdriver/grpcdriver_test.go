@@ -0,0 +1,272 @@
+package grpcdriver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/driver"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/headers"
+	"github.com/inngest/inngest/pkg/inngest"
+	driverv1 "github.com/inngest/inngest/proto/gen/driver/v1"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testServer struct {
+	driverv1.UnimplementedExecutionServiceServer
+
+	handler func(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error)
+}
+
+func (s *testServer) Execute(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error) {
+	return s.handler(ctx, req)
+}
+
+// serve starts an ExecutionService on a local port, returning its grpc:// URL.
+func serve(t *testing.T, srv *testServer) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	driverv1.RegisterExecutionServiceServer(s, srv)
+	go func() { _ = s.Serve(l) }()
+	t.Cleanup(s.Stop)
+
+	return "grpc://" + l.Addr().String()
+}
+
+type stateLoader struct {
+	sv2.StateLoader
+}
+
+func (stateLoader) LoadEvents(ctx context.Context, id sv2.ID) ([]json.RawMessage, error) {
+	return []json.RawMessage{json.RawMessage(`{"name":"test/event","data":{"ok":true}}`)}, nil
+}
+
+func (stateLoader) LoadSteps(ctx context.Context, id sv2.ID) (map[string]json.RawMessage, error) {
+	return map[string]json.RawMessage{}, nil
+}
+
+func (stateLoader) LoadDefersMeta(ctx context.Context, id sv2.ID) (map[string]sv2.DeferMeta, error) {
+	return nil, nil
+}
+
+func requestOpts(uri string) driver.V2RequestOpts {
+	stepID := "step-a"
+	return driver.V2RequestOpts{
+		Metadata: sv2.Metadata{
+			ID: sv2.ID{RunID: ulid.Make(), FunctionID: uuid.New()},
+			Config: *sv2.InitConfig(&sv2.Config{
+				RequestVersion: 2,
+			}),
+		},
+		Fn: inngest.Function{
+			Steps: []inngest.Step{{ID: "step", Name: "step", URI: uri}},
+		},
+		SigningKey: []byte("signing-key"),
+		Attempt:    1,
+		RequestID:  "req-1",
+		JobID:      "job-1",
+		StepID:     &stepID,
+		URL:        uri,
+	}
+}
+
+func TestNewDriver(t *testing.T) {
+	d := NewDriver()
+	require.NotNil(t, d)
+	require.Equal(t, "grpc", d.Name())
+	require.Equal(t, "grpc", inngest.Driver(inngest.Function{Driver: inngest.FunctionDriver{URI: "grpc://localhost:9000"}}))
+	require.Equal(t, "grpc", inngest.Driver(inngest.Function{Driver: inngest.FunctionDriver{URI: "grpcs://localhost:9000"}}))
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	retryAt := time.Now().Add(time.Minute).Truncate(time.Second)
+
+	var (
+		received *driverv1.ExecuteRequest
+		md       metadata.MD
+	)
+	uri := serve(t, &testServer{
+		handler: func(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error) {
+			received = req
+			md, _ = metadata.FromIncomingContext(ctx)
+			return &driverv1.ExecuteResponse{
+				Sdk:            "go:v1.0.0",
+				RequestVersion: 2,
+				RetryAt:        timestamppb.New(retryAt),
+				Opcodes: []*driverv1.Opcode{
+					{
+						Op:   enums.OpcodeStepRun.String(),
+						Id:   "step-a",
+						Name: "a",
+						Data: []byte(`{"result":1}`),
+						Userland: &driverv1.Userland{
+							Id: "a",
+						},
+					},
+					{
+						Op:    enums.OpcodeStepError.String(),
+						Id:    "step-b",
+						Name:  "b",
+						Error: &driverv1.StepError{Name: "Error", Message: "broken", NoRetry: true},
+					},
+				},
+			}, nil
+		},
+	})
+
+	opts := requestOpts(uri)
+	resp, uerr, ierr := NewDriver().Do(ctx, stateLoader{}, opts)
+	require.NoError(t, ierr)
+	require.NoError(t, uerr)
+
+	require.NotNil(t, received)
+	require.Equal(t, opts.Metadata.ID.RunID.String(), received.RunId)
+	require.Equal(t, "step-a", received.GetStepId())
+	require.Equal(t, "req-1", received.RequestId)
+
+	var payload driver.SDKRequest
+	require.NoError(t, json.Unmarshal(received.Payload, &payload))
+	require.Equal(t, "test/event", payload.Event["name"])
+	require.Equal(t, 5, payload.Context.MaxAttempts)
+
+	sig := md.Get(headers.HeaderKeySignature)
+	require.Len(t, sig, 1)
+	require.Contains(t, sig[0], "s=")
+
+	require.Equal(t, 200, resp.StatusCode)
+	require.Equal(t, "go:v1.0.0", resp.SDK)
+	require.Equal(t, 2, resp.RequestVersion)
+	require.NotNil(t, resp.RetryAt)
+	require.True(t, retryAt.Equal(*resp.RetryAt))
+
+	require.Len(t, resp.Generator, 2)
+	require.Equal(t, enums.OpcodeStepRun, resp.Generator[0].Op)
+	require.JSONEq(t, `{"result":1}`, string(resp.Generator[0].Data))
+	require.Equal(t, "a", resp.Generator[0].Userland.ID)
+	require.Equal(t, enums.OpcodeStepError, resp.Generator[1].Op)
+	require.Equal(t, "broken", resp.Generator[1].Error.Message)
+	require.True(t, resp.Generator[1].Error.NoRetry)
+}
+
+func TestDoNoOpcodes(t *testing.T) {
+	uri := serve(t, &testServer{
+		handler: func(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error) {
+			return &driverv1.ExecuteResponse{}, nil
+		},
+	})
+
+	resp, uerr, ierr := NewDriver().Do(context.Background(), stateLoader{}, requestOpts(uri))
+	require.NoError(t, ierr)
+	require.NoError(t, uerr)
+	require.Len(t, resp.Generator, 1)
+	require.Equal(t, enums.OpcodeNone, resp.Generator[0].Op)
+}
+
+func TestDoErrors(t *testing.T) {
+	t.Run("invalid opcodes are user errors", func(t *testing.T) {
+		uri := serve(t, &testServer{
+			handler: func(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error) {
+				return &driverv1.ExecuteResponse{
+					Opcodes: []*driverv1.Opcode{{Op: "NotAnOpcode", Id: "step-a"}},
+				}, nil
+			},
+		})
+
+		_, uerr, ierr := NewDriver().Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.NoError(t, ierr)
+		require.ErrorContains(t, uerr, "invalid opcode")
+		require.False(t, uerr.Retryable())
+	})
+
+	t.Run("status errors are retryable user errors", func(t *testing.T) {
+		uri := serve(t, &testServer{
+			handler: func(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error) {
+				return nil, status.Error(codes.Internal, "panic")
+			},
+		})
+
+		resp, uerr, ierr := NewDriver().Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.NoError(t, ierr)
+		require.Error(t, uerr)
+		require.True(t, uerr.Retryable())
+		require.Contains(t, string(uerr.Raw()), "panic")
+		require.NotNil(t, resp.Err)
+	})
+
+	t.Run("unreachable services are internal errors", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		uri := "grpc://" + l.Addr().String()
+		require.NoError(t, l.Close())
+
+		_, uerr, ierr := NewDriver(WithTimeout(time.Second)).Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.NoError(t, uerr)
+		require.ErrorContains(t, ierr, "Unable to reach SDK")
+		require.True(t, ierr.Retryable())
+	})
+
+	t.Run("unsupported schemes are internal errors", func(t *testing.T) {
+		_, _, ierr := NewDriver().Do(context.Background(), stateLoader{}, requestOpts("https://example.com"))
+		require.ErrorContains(t, ierr, "invalid function url scheme")
+	})
+}
+
+func TestConnections(t *testing.T) {
+	uri := serve(t, &testServer{
+		handler: func(ctx context.Context, req *driverv1.ExecuteRequest) (*driverv1.ExecuteResponse, error) {
+			return &driverv1.ExecuteResponse{}, nil
+		},
+	})
+
+	t.Run("idle connections are evicted", func(t *testing.T) {
+		now := time.Now()
+		d := NewDriver(WithIdleTimeout(time.Minute)).(*grpcDriver)
+		d.now = func() time.Time { return now }
+
+		_, _, ierr := d.Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.NoError(t, ierr)
+		require.Len(t, d.conns, 1)
+
+		// A connection in use is never evicted.
+		_, release, err := d.client(uri)
+		require.NoError(t, err)
+		now = now.Add(2 * time.Minute)
+		_, release2, err := d.client("grpc://127.0.0.1:1")
+		require.NoError(t, err)
+		require.Len(t, d.conns, 2)
+		release()
+		release2()
+
+		now = now.Add(2 * time.Minute)
+		_, _, ierr = d.Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.NoError(t, ierr)
+		require.Len(t, d.conns, 1)
+	})
+
+	t.Run("close closes every connection", func(t *testing.T) {
+		d := NewDriver().(*grpcDriver)
+		_, _, ierr := d.Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.NoError(t, ierr)
+		require.Len(t, d.conns, 1)
+
+		require.NoError(t, d.Close())
+		require.Empty(t, d.conns)
+
+		_, _, ierr = d.Do(context.Background(), stateLoader{}, requestOpts(uri))
+		require.ErrorContains(t, ierr, "driver is closed")
+	})
+}
@@ -27,6 +27,7 @@ func (c Config) NewDriver(opts ...registration.NewDriverOpts) (driver.DriverV1,
 	var skey []byte
 	requireLocalSigningKey := false
 	client := exechttp.RequestExecutor(defaultClient)
+	var tlsLoader exechttp.TLSLoader
 	if len(opts) > 0 {
 		if opts[0].LocalSigningKey != nil {
 			skey = []byte(*opts[0].LocalSigningKey)
@@ -39,11 +40,14 @@ func (c Config) NewDriver(opts ...registration.NewDriverOpts) (driver.DriverV1,
 		if opts[0].HTTPClient != nil {
 			client = opts[0].HTTPClient
 		}
+
+		tlsLoader = opts[0].AppTLSLoader
 	}
 
 	return &executor{
 		Client:                 client,
 		localSigningKey:        skey,
 		requireLocalSigningKey: requireLocalSigningKey,
+		tlsLoader:              tlsLoader,
 	}, nil
 }
@@ -48,6 +48,8 @@ type executor struct {
 	Client                 exechttp.RequestExecutor
 	localSigningKey        []byte
 	requireLocalSigningKey bool
+	// tlsLoader loads each app's TLS config, if set.
+	tlsLoader exechttp.TLSLoader
 }
 
 // Name fulfiils the inngest.Runtime interface.
@@ -67,6 +69,13 @@ func (e executor) Execute(ctx context.Context, sl sv2.StateLoader, s sv2.Metadat
 		return nil, err
 	}
 
+	var tlsConfig *exechttp.TLSConfig
+	if e.tlsLoader != nil {
+		if tlsConfig, err = e.tlsLoader(ctx, s.ID.Tenant.AppID); err != nil {
+			return nil, fmt.Errorf("error loading app tls config: %w", err)
+		}
+	}
+
 	jID := queueref.StringFromCtx(ctx)
 
 	input, err := driver.MarshalV1(ctx, sl, s, step, idx, "", attempt, item.GetMaxAttempts(), jID)
@@ -106,6 +115,7 @@ func (e executor) Execute(ctx context.Context, sl sv2.StateLoader, s sv2.Metadat
 		Step:           step,
 		Headers:        headers,
 		RequestVersion: &s.Config.RequestVersion,
+		TLS:            tlsConfig,
 	})
 	if dr != nil && httpstatResult != nil {
 		dr.HTTPStat = httpstatResult
@@ -141,6 +151,9 @@ type Request struct {
 
 	// Headers are additional headers to add to the request.
 	Headers map[string]string
+
+	// TLS is the app's TLS config, if any.
+	TLS *exechttp.TLSConfig
 }
 
 // ExecuteDriverRequest executes the HTTP request with the given input.
@@ -320,6 +333,7 @@ func do(ctx context.Context, c exechttp.RequestExecutor, r Request) (*Response,
 		return nil, nil, fmt.Errorf("error creating request: %w", err)
 	}
 	req.Header.Add("Content-Type", "application/json")
+	req.TLS = r.TLS
 
 	if r.AccountID != uuid.Nil {
 		req.Header.Add(AccountIDHeader, r.AccountID.String())
@@ -23,10 +23,23 @@ import (
 	inngestgo "github.com/inngest/inngestgo"
 )
 
-func NewDriver(client exechttp.RequestExecutor) driver.DriverV2 {
-	return &httpv2{
+type Opt func(d *httpv2)
+
+// WithTLSLoader sets the loader used to load each app's TLS config.
+func WithTLSLoader(l exechttp.TLSLoader) Opt {
+	return func(d *httpv2) {
+		d.tlsLoader = l
+	}
+}
+
+func NewDriver(client exechttp.RequestExecutor, opts ...Opt) driver.DriverV2 {
+	d := &httpv2{
 		Client: client,
 	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
 }
 
 // The HTTPV2 driver is the new driver for HTTP-based step invocation.
@@ -36,6 +49,9 @@ func NewDriver(client exechttp.RequestExecutor) driver.DriverV2 {
 type httpv2 struct {
 	// Client represents an http client used to create outgoing requests.
 	Client exechttp.RequestExecutor
+
+	// tlsLoader loads each app's TLS config, if set.
+	tlsLoader exechttp.TLSLoader
 }
 
 type HTTPV2Config struct {
@@ -139,6 +155,11 @@ func (d httpv2) sync(ctx context.Context, sl sv2.StateLoader, opts driver.V2Requ
 	if err != nil {
 		return nil, nil, errs.Wrap(0, true, "error creating request: %w", err)
 	}
+	if d.tlsLoader != nil {
+		if req.TLS, err = d.tlsLoader(ctx, opts.Metadata.ID.Tenant.AppID); err != nil {
+			return nil, nil, errs.Wrap(0, true, "error loading app tls config: %w", err)
+		}
+	}
 	req.Header.Add("Content-Type", contentType)
 	req.Header.Add("X-Inngest-Signature", sig)
 	req.Header.Add("X-Run-ID", opts.Metadata.ID.RunID.String())
@@ -200,7 +221,7 @@ func (d httpv2) sync(ctx context.Context, sl sv2.StateLoader, opts driver.V2Requ
 	// We always expect opcodes from the API endpoint.  Whenever we re-enter a sync function,
 	// the API becomes, to effect, an async function and each HTTP request we make should always
 	// result in well-formed ops.
-	ops, userErr := parseOpcodes(resp.Body, resp.StatusCode)
+	ops, userErr := ParseOpcodes(resp.Body, resp.StatusCode)
 	if userErr != nil {
 		// Return a DriverResponse with the HTTP response data so the executor
 		// can detect the error. Without this, a nil response gets converted to
@@ -255,7 +276,9 @@ func (d httpv2) async(ctx context.Context, opts driver.V2RequestOpts) (*state.Dr
 	return nil, nil, errs.Wrap(0, false, "async v2 http driver not implemneted")
 }
 
-func parseOpcodes(byt []byte, status int) ([]*state.GeneratorOpcode, errs.UserError) {
+// ParseOpcodes parses and validates the opcodes returned by an SDK.  This is
+// shared by every driver which receives the SDK's JSON opcode response.
+func ParseOpcodes(byt []byte, status int) ([]*state.GeneratorOpcode, errs.UserError) {
 	trimmed := bytes.TrimSpace(byt)
 	if len(trimmed) == 0 || trimmed[0] != '[' {
 		return nil, NewNonGeneratorError(byt, status)
@@ -109,9 +109,14 @@ func (e ExtendedClient) DoRequest(ctx context.Context, r SerializableRequest) (*
 		return nil, err
 	}
 
+	client, err := ClientForTLS(e.Client, r.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("error loading tls config: %w", err)
+	}
+
 	tracking := &httpstat.Result{}
 	req = req.WithContext(httpstat.WithHTTPStat(req.Context(), tracking))
-	resp, err := e.Do(req)
+	resp, err := client.Do(req)
 	tracking.End(time.Now())
 
 	if resp != nil {
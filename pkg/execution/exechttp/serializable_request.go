@@ -73,6 +73,10 @@ type SerializableRequest struct {
 	// If specified, the HTTP response will be tee-read into the specific channel
 	// and topic.
 	Publish RequestPublishOpts `json:"publish,omitzero"`
+
+	// TLS is the optional per-app TLS config used for this request.  If nil,
+	// the client's default TLS config is used.
+	TLS *TLSConfig `json:"tls,omitempty"`
 }
 
 func NewRequest(method string, url string, body json.RawMessage) (SerializableRequest, error) {
@@ -0,0 +1,226 @@
+package exechttp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// tlsClientTTL is how long a client created for a TLSConfig is re-used before
+// the config's files are re-read, allowing certificates to be rotated on disk.
+const tlsClientTTL = 5 * time.Minute
+
+// TLSConfig is per-app TLS configuration used when calling an app, eg. for apps
+// served behind an internal PKI.  Files are read from the executor's host.
+type TLSConfig struct {
+	// CAFile is a PEM bundle of CAs trusted when verifying the app's
+	// certificate, in addition to the host's root CAs.
+	CAFile string `json:"ca_file,omitempty"`
+	// CertFile and KeyFile are a PEM client certificate and key presented to
+	// the app for mTLS.
+	CertFile string `json:"cert_file,omitempty"`
+	KeyFile  string `json:"key_file,omitempty"`
+	// ServerName overrides the server name used to verify the app's
+	// certificate.
+	ServerName string `json:"server_name,omitempty"`
+}
+
+// TLSLoader returns the TLS config for an app, or nil if the app uses the
+// default TLS config.
+type TLSLoader func(ctx context.Context, appID uuid.UUID) (*TLSConfig, error)
+
+// tlsLoaderTTL is how long a TLSLoaderCache caches an app's TLS config before
+// reloading it, in case the app changed without the entry being invalidated.
+const tlsLoaderTTL = time.Minute
+
+// TLSLoaderCache caches the TLS config loaded for each app, so that the config
+// isn't loaded for every request made to the app.  Entries must be invalidated
+// whenever an app is synced.
+type TLSLoaderCache struct {
+	load    TLSLoader
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[uuid.UUID]tlsLoaderEntry
+}
+
+type tlsLoaderEntry struct {
+	cfg       *TLSConfig
+	expiresAt time.Time
+}
+
+// NewTLSLoaderCache returns a cache which loads each app's TLS config using
+// the given loader.
+func NewTLSLoaderCache(load TLSLoader) *TLSLoaderCache {
+	return &TLSLoaderCache{
+		load:    load,
+		now:     time.Now,
+		entries: map[uuid.UUID]tlsLoaderEntry{},
+	}
+}
+
+// Load returns the app's TLS config, loading it if it isn't cached.  Errors
+// are never cached.  Load satisfies TLSLoader.
+func (c *TLSLoaderCache) Load(ctx context.Context, appID uuid.UUID) (*TLSConfig, error) {
+	c.mu.Lock()
+	e, ok := c.entries[appID]
+	c.mu.Unlock()
+	if ok && c.now().Before(e.expiresAt) {
+		return e.cfg, nil
+	}
+
+	cfg, err := c.load(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[appID] = tlsLoaderEntry{cfg: cfg, expiresAt: c.now().Add(tlsLoaderTTL)}
+	c.mu.Unlock()
+	return cfg, nil
+}
+
+// Invalidate removes the app's cached TLS config, so that it's loaded again on
+// the next request.
+func (c *TLSLoaderCache) Invalidate(appID uuid.UUID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	delete(c.entries, appID)
+	c.mu.Unlock()
+}
+
+func (c *TLSConfig) IsZero() bool {
+	return c == nil || *c == TLSConfig{}
+}
+
+// Validate ensures that the config's files exist and are valid.
+func (c *TLSConfig) Validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("tls cert_file and key_file must be set together")
+	}
+	_, err := c.Load()
+	return err
+}
+
+// Load returns a *tls.Config for the config, reading all files from disk.
+func (c *TLSConfig) Load() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+
+	if c.CAFile != "" {
+		byt, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading tls ca_file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(byt) {
+			return nil, fmt.Errorf("no certificates found in tls ca_file")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading tls client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+type tlsClientKey struct {
+	base *http.Client
+	cfg  TLSConfig
+}
+
+type tlsClient struct {
+	client    *http.Client
+	expiresAt time.Time
+}
+
+// tlsClients caches clients created for each base client and TLS config.
+var tlsClients sync.Map
+
+// ClientForTLS returns a copy of the given client which uses the TLS config.
+// Clients are cached, so this is safe to call for every request.
+func ClientForTLS(base *http.Client, c *TLSConfig) (*http.Client, error) {
+	if c.IsZero() {
+		return base, nil
+	}
+
+	key := tlsClientKey{base: base, cfg: *c}
+	if cached, ok := tlsClients.Load(key); ok && time.Now().Before(cached.(tlsClient).expiresAt) {
+		return cached.(tlsClient).client, nil
+	}
+
+	cfg, err := c.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := WithTLSConfig(base.Transport, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client := *base
+	client.Transport = transport
+	if prev, loaded := tlsClients.Swap(key, tlsClient{client: &client, expiresAt: time.Now().Add(tlsClientTTL)}); loaded {
+		// Close the replaced transport's idle connections so that they aren't
+		// held open after the client is no longer used.
+		prev.(tlsClient).client.CloseIdleConnections()
+	}
+	evictTLSClients(time.Now())
+	return &client, nil
+}
+
+// evictTLSClients removes expired clients, eg. for configs which are no longer
+// used, closing their idle connections.
+func evictTLSClients(now time.Time) {
+	tlsClients.Range(func(key, value any) bool {
+		if now.After(value.(tlsClient).expiresAt) && tlsClients.CompareAndDelete(key, value) {
+			value.(tlsClient).client.CloseIdleConnections()
+		}
+		return true
+	})
+}
+
+// TLSConfigurable is implemented by http.RoundTripper wrappers which can
+// return a copy of themselves whose underlying transport uses the given TLS
+// config.
+type TLSConfigurable interface {
+	WithTLSConfig(cfg *tls.Config) (http.RoundTripper, error)
+}
+
+// WithTLSConfig returns a copy of the round tripper which uses the given TLS
+// config, keeping all other transport settings such as the dialer.
+func WithTLSConfig(rt http.RoundTripper, cfg *tls.Config) (http.RoundTripper, error) {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	switch t := rt.(type) {
+	case *http.Transport:
+		clone := t.Clone()
+		clone.TLSClientConfig = cfg
+		return clone, nil
+	case TLSConfigurable:
+		return t.WithTLSConfig(cfg)
+	default:
+		return nil, fmt.Errorf("transport %T does not support per-app tls config", rt)
+	}
+}
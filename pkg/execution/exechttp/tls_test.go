@@ -0,0 +1,238 @@
+package exechttp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newCert creates a certificate signed by parent, or a self-signed CA if
+// parent is nil.
+func newCert(t *testing.T, name string, parent *testCert, tmpl x509.Certificate) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.Subject = pkix.Name{CommonName: name}
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+
+	signer, signerKey := &tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+// write writes the cert and key as PEM files to dir, returning their paths.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, name+".crt")
+	keyPath := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certPath, keyPath
+}
+
+func TestClientForTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newCert(t, "test-ca", nil, x509.Certificate{})
+	caFile, _ := ca.write(t, dir, "ca")
+
+	server := newCert(t, "internal.example", ca, x509.Certificate{
+		DNSNames:    []string{"internal.example"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	client := newCert(t, "client", ca, x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	certFile, keyFile := client.write(t, dir, "client")
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{server.der}, PrivateKey: server.key}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	base := &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: &http.Transport{DialContext: (&net.Dialer{}).DialContext},
+	}
+
+	t.Run("nil configs use the base client", func(t *testing.T) {
+		c, err := ClientForTLS(base, nil)
+		require.NoError(t, err)
+		require.Same(t, base, c)
+
+		_, err = c.Get(srv.URL)
+		require.Error(t, err)
+	})
+
+	t.Run("custom CAs, server names, and client certs", func(t *testing.T) {
+		cfg := &TLSConfig{
+			CAFile:     caFile,
+			CertFile:   certFile,
+			KeyFile:    keyFile,
+			ServerName: "internal.example",
+		}
+		require.NoError(t, cfg.Validate())
+
+		c, err := ClientForTLS(base, cfg)
+		require.NoError(t, err)
+		require.NotSame(t, base, c)
+
+		resp, err := c.Get(srv.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// Clients are cached per config.
+		cached, err := ClientForTLS(base, &TLSConfig{
+			CAFile:     caFile,
+			CertFile:   certFile,
+			KeyFile:    keyFile,
+			ServerName: "internal.example",
+		})
+		require.NoError(t, err)
+		require.Same(t, c, cached)
+	})
+
+	t.Run("missing client certs are rejected by the server", func(t *testing.T) {
+		c, err := ClientForTLS(base, &TLSConfig{CAFile: caFile, ServerName: "internal.example"})
+		require.NoError(t, err)
+
+		_, err = c.Get(srv.URL)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid configs", func(t *testing.T) {
+		require.ErrorContains(t, (&TLSConfig{CertFile: certFile}).Validate(), "must be set together")
+		require.ErrorContains(t, (&TLSConfig{CAFile: filepath.Join(dir, "missing.crt")}).Validate(), "ca_file")
+		require.ErrorContains(t, (&TLSConfig{CAFile: keyFile}).Validate(), "no certificates")
+	})
+}
+
+// closingTransport records when its idle connections are closed.
+type closingTransport struct {
+	http.RoundTripper
+	closed *bool
+}
+
+func (t closingTransport) WithTLSConfig(cfg *tls.Config) (http.RoundTripper, error) {
+	return closingTransport{RoundTripper: t.RoundTripper, closed: new(bool)}, nil
+}
+
+func (t closingTransport) CloseIdleConnections() {
+	*t.closed = true
+}
+
+func TestClientForTLSEviction(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCert(t, "test-ca", nil, x509.Certificate{})
+	caFile, _ := ca.write(t, dir, "ca")
+
+	base := &http.Client{Transport: closingTransport{RoundTripper: http.DefaultTransport}}
+
+	t.Run("expired clients are replaced and closed", func(t *testing.T) {
+		cfg := &TLSConfig{CAFile: caFile, ServerName: "replaced.example"}
+		c, err := ClientForTLS(base, cfg)
+		require.NoError(t, err)
+		closed := c.Transport.(closingTransport).closed
+
+		key := tlsClientKey{base: base, cfg: *cfg}
+		tlsClients.Store(key, tlsClient{client: c, expiresAt: time.Now().Add(-time.Second)})
+
+		next, err := ClientForTLS(base, cfg)
+		require.NoError(t, err)
+		require.NotSame(t, c, next)
+		require.True(t, *closed)
+		require.False(t, *next.Transport.(closingTransport).closed)
+	})
+
+	t.Run("unused expired clients are evicted", func(t *testing.T) {
+		cfg := &TLSConfig{CAFile: caFile, ServerName: "unused.example"}
+		c, err := ClientForTLS(base, cfg)
+		require.NoError(t, err)
+		closed := c.Transport.(closingTransport).closed
+
+		evictTLSClients(time.Now().Add(tlsClientTTL + time.Second))
+		require.True(t, *closed)
+		_, ok := tlsClients.Load(tlsClientKey{base: base, cfg: *cfg})
+		require.False(t, ok)
+	})
+}
+
+func TestTLSLoaderCache(t *testing.T) {
+	ctx := context.Background()
+	appID := uuid.New()
+
+	loads := 0
+	cfg := &TLSConfig{ServerName: "cached.example"}
+	c := NewTLSLoaderCache(func(ctx context.Context, id uuid.UUID) (*TLSConfig, error) {
+		loads++
+		return cfg, nil
+	})
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	for range 3 {
+		loaded, err := c.Load(ctx, appID)
+		require.NoError(t, err)
+		require.Same(t, cfg, loaded)
+	}
+	require.Equal(t, 1, loads)
+
+	// Syncing the app invalidates its config.
+	c.Invalidate(appID)
+	_, err := c.Load(ctx, appID)
+	require.NoError(t, err)
+	require.Equal(t, 2, loads)
+
+	// Entries expire in case the app changed without being invalidated.
+	now = now.Add(tlsLoaderTTL)
+	_, err = c.Load(ctx, appID)
+	require.NoError(t, err)
+	require.Equal(t, 3, loads)
+}
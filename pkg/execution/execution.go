@@ -128,6 +128,10 @@ type Executor interface {
 
 	CloseLifecycleListeners(ctx context.Context)
 
+	// CloseDrivers closes any drivers which hold resources, such as cached
+	// connections.  This must only be called once no more steps will execute.
+	CloseDrivers(ctx context.Context)
+
 	// SetFinalizer sets the function which publishes finalization events on
 	// run completion
 	SetFinalizer(f FinalizePublisher)
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
 	"net/url"
 	"runtime/debug"
@@ -41,6 +42,8 @@ import (
 	"github.com/inngest/inngest/pkg/execution/singleton"
 	"github.com/inngest/inngest/pkg/execution/state"
 	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/stepcache"
+	"github.com/inngest/inngest/pkg/execution/steplock"
 	"github.com/inngest/inngest/pkg/expressions"
 	"github.com/inngest/inngest/pkg/expressions/expragg"
 	"github.com/inngest/inngest/pkg/inngest"
@@ -299,6 +302,24 @@ func WithDebouncer(d debounce.Debouncer) ExecutorOpt {
 	}
 }
 
+// WithStepCache configures the cache used for steps which opt into cross-run
+// caching of their output.
+func WithStepCache(c stepcache.Cache) ExecutorOpt {
+	return func(e execution.Executor) error {
+		e.(*executor).stepCache = c
+		return nil
+	}
+}
+
+// WithLocker configures the locker used to acquire locks requested via
+// OpcodeLockAcquire.
+func WithLocker(l steplock.Locker) ExecutorOpt {
+	return func(e execution.Executor) error {
+		e.(*executor).locker = l
+		return nil
+	}
+}
+
 func WithSingletonManager(sn singleton.Singleton) ExecutorOpt {
 	return func(e execution.Executor) error {
 		e.(*executor).singletonMgr = sn
@@ -511,6 +532,8 @@ type executor struct {
 	debouncer    debounce.Debouncer
 	batcher      batch.BatchManager
 	singletonMgr singleton.Singleton
+	stepCache    stepcache.Cache
+	locker       steplock.Locker
 
 	capacityManager               constraintapi.CapacityManager
 	semaphoreManager              constraintapi.SemaphoreManager
@@ -643,6 +666,23 @@ func (e *executor) CloseLifecycleListeners(ctx context.Context) {
 	}
 }
 
+func (e *executor) CloseDrivers(ctx context.Context) {
+	var err error
+	for _, d := range e.driverv1 {
+		if c, ok := d.(io.Closer); ok {
+			err = errors.Join(err, c.Close())
+		}
+	}
+	for _, d := range e.driverv2 {
+		if c, ok := d.(io.Closer); ok {
+			err = errors.Join(err, c.Close())
+		}
+	}
+	if err != nil {
+		e.log.Error("error closing drivers", "error", err)
+	}
+}
+
 func idempotencyKey(req execution.ScheduleRequest, runID ulid.ULID) string {
 	var key string
 	if req.IdempotencyKey != nil {
@@ -1344,7 +1384,7 @@ func (e *executor) schedule(
 
 	// Evaluate concurrency keys to use initially
 	if req.Function.Concurrency != nil {
-		metadata.Config.CustomConcurrencyKeys = queue.GetCustomConcurrencyKeys(ctx, metadata.ID, req.Function.Concurrency.Limits, evtMap)
+		metadata.Config.CustomConcurrencyKeys = queue.GetCustomConcurrencyKeys(ctx, metadata.ID, req.Function.Concurrency.Limits, req.Function.Concurrency.Fairness, evtMap)
 		metadata.Config.Semaphores = e.evaluateFnConcurrency(ctx, req.AccountID, req.Function.ID, req.Function.Concurrency.Fn, evtMap)
 	}
 
@@ -1934,6 +1974,19 @@ func (e *executor) Execute(ctx context.Context, id state.Identifier, item queue.
 	conditionalSpan.SetAttributes(attribute.String("request_id", requestID))
 	conditionalSpan.SetAttributes(attribute.String("job_id", jobID))
 
+	// If this is a lock, acquire the lock before re-calling the SDK.  This
+	// saves "nil" for the lock step, as with sleeps below.
+	if item.Kind == queue.KindLock {
+		hasPendingSteps, err := e.acquireStepLock(ctx, id, item, edge)
+		if err != nil {
+			return nil, err
+		}
+		if !shouldEnqueueDiscovery(hasPendingSteps, item.ParallelMode) {
+			return nil, nil
+		}
+		ctx = state.WithGroupID(ctx, uuid.New().String())
+	}
+
 	// If this is of type sleep, ensure that we save "nil" within the state store
 	// for the outgoing edge ID.  This ensures that we properly increase the stack
 	// for `tools.sleep` within generator functions.
@@ -3908,6 +3961,8 @@ func (e *executor) handleGeneratorOp(ctx context.Context, runCtx execution.RunCo
 		return e.handleGeneratorGateway(ctx, runCtx, gen, edge, group)
 	case enums.OpcodeWaitForSignal:
 		return e.handleGeneratorWaitForSignal(ctx, runCtx, gen, edge, group)
+	case enums.OpcodeLockAcquire:
+		return e.handleGeneratorLockAcquire(ctx, runCtx, gen, edge, group)
 	case enums.OpcodeRunComplete:
 		return e.handleGeneratorFunctionFinished(ctx, runCtx, gen, edge)
 	case enums.OpcodeSyncRunComplete:
@@ -4208,6 +4263,9 @@ func (e *executor) handleGeneratorStep(ctx context.Context, runCtx execution.Run
 		return err
 	}
 
+	e.maybeCacheStepOutput(ctx, runCtx, gen, edge, output)
+	e.releaseStepLocks(ctx, runCtx.Metadata().ID.Tenant.EnvID, runCtx.Metadata().ID.RunID, gen.ID)
+
 	// Once step output has been saved, we can release the held capacity.
 	// This allows us to continue work in the queue on other items even before
 	// the next step is enqueued and accounting is handled.
@@ -4313,6 +4371,8 @@ func (e *executor) handleStepFailed(ctx context.Context, runCtx execution.RunCon
 		return err
 	}
 
+	e.releaseStepLocks(ctx, runCtx.Metadata().ID.Tenant.EnvID, runCtx.Metadata().ID.RunID, gen.ID)
+
 	// Once step output has been saved, we can release the held capacity.
 	// This allows us to continue work in the queue on other items even before
 	// the next step is enqueued and accounting is handled.
@@ -4470,6 +4530,16 @@ func (e *executor) handleGeneratorSyncFunctionFinished(ctx context.Context, runC
 }
 
 func (e *executor) handleGeneratorStepPlanned(ctx context.Context, runCtx execution.RunContext, gen state.GeneratorOpcode, edge queue.PayloadEdge, group OpcodeGroup) error {
+	// Steps which opt into caching reuse a previously cached output instead of
+	// executing, if one exists.
+	cache := e.stepCacheOpts(ctx, gen)
+	if cache != nil {
+		hit, err := e.handleStepCacheHit(ctx, runCtx, gen, edge, group, cache)
+		if hit || err != nil {
+			return err
+		}
+	}
+
 	nextEdge := inngest.Edge{
 		// Planned generator IDs are the same as the actual OpcodeStep IDs.
 		// We can't set edge.Edge.Outgoing here because the step hasn't yet ran.
@@ -4477,10 +4547,11 @@ func (e *executor) handleGeneratorStepPlanned(ctx context.Context, runCtx execut
 		// We do, though, want to store the incoming step ID name _without_ overriding
 		// the actual DAG step, though.
 		// Run the same action.
-		IncomingGeneratorStep:     gen.ID,
-		IncomingGeneratorStepName: gen.Name,
-		Outgoing:                  edge.Edge.Outgoing,
-		Incoming:                  edge.Edge.Incoming,
+		IncomingGeneratorStep:      gen.ID,
+		IncomingGeneratorStepName:  gen.Name,
+		IncomingGeneratorStepCache: cache,
+		Outgoing:                   edge.Edge.Outgoing,
+		Incoming:                   edge.Edge.Incoming,
 	}
 	// prefer DisplayName if available
 	if gen.DisplayName != nil {
@@ -6273,7 +6344,8 @@ func hasPlanOp(ops []*state.GeneratorOpcode) bool {
 			enums.OpcodeSleep,
 			enums.OpcodeStepPlanned,
 			enums.OpcodeWaitForEvent,
-			enums.OpcodeWaitForSignal:
+			enums.OpcodeWaitForSignal,
+			enums.OpcodeLockAcquire:
 			return true
 		}
 	}
@@ -130,6 +130,10 @@ func (e *executor) Finalize(ctx context.Context, opts execution.FinalizeOpts) er
 		}
 	}
 
+	// Release any step locks still held by this run, eg. if the run was
+	// cancelled while holding a lock.
+	e.releaseRunStepLocks(ctx, opts.Metadata.ID.Tenant.EnvID, opts.Metadata.ID.RunID)
+
 	// Load defers BEFORE Delete since they live in state and won't survive the
 	// deletion. Retry on transient failures so the events get a chance to
 	// publish even when Redis is briefly unavailable. Defer-related failures
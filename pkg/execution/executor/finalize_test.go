@@ -84,6 +84,9 @@ func (r *racingShardRegistry) ByGroup(string) []queue.QueueShard { return nil }
 func (r *racingShardRegistry) Resolve(_ context.Context, _ queue.Scope, _ *string) (queue.QueueShard, error) {
 	return r.shard, nil
 }
+func (r *racingShardRegistry) Home(_ context.Context, _ queue.Scope, _ *string) (queue.QueueShard, error) {
+	return r.shard, nil
+}
 func (r *racingShardRegistry) ForEach(ctx context.Context, fn func(context.Context, queue.QueueShard) error) error {
 	return fn(ctx, r.shard)
 }
@@ -0,0 +1,186 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/steplock"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/tracing"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngestgo"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	// ErrStepLocksDisabled is returned when a run requests a lock but the
+	// executor has no locker configured.
+	ErrStepLocksDisabled = errors.New("step locks are not enabled")
+	// ErrStepLockHeld is returned when a lock queue item is retried because
+	// the lock is held by another run.
+	ErrStepLockHeld = errors.New("step lock held by another run")
+)
+
+// handleGeneratorLockAcquire handles OpcodeLockAcquire.  This enqueues a lock
+// item which acquires the lock and re-calls the SDK once the lock is granted,
+// waiting in the queue while the lock is held by another run.
+//
+// The lock is held until the step it's requested for completes or fails, the
+// run finishes, or the lock's lease expires.
+func (e *executor) handleGeneratorLockAcquire(ctx context.Context, runCtx execution.RunContext, gen state.GeneratorOpcode, edge queue.PayloadEdge, group OpcodeGroup) error {
+	if e.locker == nil {
+		return queue.NeverRetryError(ErrStepLocksDisabled)
+	}
+
+	opts, err := gen.LockOpts()
+	if err != nil {
+		return err
+	}
+	lease, err := opts.LeaseDuration()
+	if err != nil {
+		return queue.NeverRetryError(fmt.Errorf("invalid lock lease: %w", err))
+	}
+	if err := steplock.Validate(opts.Step, opts.Key, lease); err != nil {
+		return queue.NeverRetryError(err)
+	}
+
+	nextEdge := inngest.Edge{
+		Outgoing: gen.ID,             // Leaving the lock
+		Incoming: edge.Edge.Incoming, // To re-call the SDK
+		Lock:     &inngest.StepLock{Key: opts.Key, Lease: lease, Step: opts.Step},
+	}
+
+	groupID := uuid.New().String()
+	ctx = state.WithGroupID(ctx, groupID)
+
+	jobID := queue.HashID(ctx, fmt.Sprintf("%s-%s-lock", runCtx.Metadata().IdempotencyKey(), gen.ID))
+	nextItem := queue.Item{
+		JobID:                 &jobID,
+		WorkspaceID:           runCtx.Metadata().ID.Tenant.EnvID,
+		GroupID:               groupID,
+		Kind:                  queue.KindLock,
+		Identifier:            sv2.V1FromMetadata(*runCtx.Metadata()),
+		PriorityFactor:        runCtx.PriorityFactor(),
+		CustomConcurrencyKeys: runCtx.ConcurrencyKeys(),
+		Semaphores:            stepSemaphores(*runCtx.Metadata()),
+		Attempt:               0,
+		MaxAttempts:           runCtx.MaxAttempts(),
+		Payload:               queue.PayloadEdge{Edge: nextEdge},
+		ParallelMode:          gen.ParallelMode(),
+	}
+
+	lifecycleItem := runCtx.LifecycleItem()
+	attrs := tracing.GeneratorAttrs(&gen)
+	{
+		handledAt := e.opcodeHandledAt(group)
+		tracing.AddTimingAttrs(attrs, handledAt, handledAt, time.Time{}, time.Time{})
+	}
+	meta.AddAttr(attrs, meta.Attrs.DynamicStatus, inngestgo.Ptr(enums.StepStatusWaiting))
+
+	// Create the lock's span, which is completed once the lock is acquired.
+	span, err := e.tracerProvider.CreateDroppableSpan(
+		ctx,
+		meta.SpanNameStep,
+		&tracing.CreateSpanOptions{
+			FollowsFrom:           tracing.SpanRefFromQueueItem(&lifecycleItem),
+			Debug:                 &tracing.SpanDebugData{Location: "executor.handleGeneratorLockAcquire"},
+			Metadata:              runCtx.Metadata(),
+			QueueItem:             &nextItem,
+			Parent:                runCtx.RootSpan(),
+			Attributes:            attrs,
+			DynamicSpanIDOverride: tracing.DeterministicSpanConfig(tracing.LockStepDynamicSeed(gen.ID)).SpanID.String(),
+		},
+	)
+	if err != nil {
+		e.log.Debug("error creating span for lock", "error", err)
+	}
+
+	err = e.queue.Enqueue(ctx, nextItem, e.now(), queue.EnqueueOpts{
+		PassthroughJobId: true,
+	})
+	if errors.Is(err, queue.ErrQueueItemExists) {
+		span.Drop()
+		return nil
+	}
+
+	_ = span.Send()
+	return err
+}
+
+// acquireStepLock acquires the lock for a lock queue item, saving the lock
+// step's output once the lock is granted.  If the lock is held by another run,
+// this returns an error retrying the item once the lock may be available
+// without consuming an attempt.
+func (e *executor) acquireStepLock(ctx context.Context, id state.Identifier, item queue.Item, edge inngest.Edge) (hasPendingSteps bool, err error) {
+	if e.locker == nil || edge.Lock == nil {
+		return false, queue.NeverRetryError(ErrStepLocksDisabled)
+	}
+
+	res, err := e.locker.Acquire(ctx, id.WorkspaceID, id.RunID, edge.Lock.Step, edge.Lock.Key, edge.Lock.Lease)
+	if err != nil {
+		return false, err
+	}
+	if !res.Acquired {
+		at := res.RetryAt(e.now())
+		return false, queue.RetryAtError(queue.AlwaysRetryError(ErrStepLockHeld), &at)
+	}
+
+	ref := tracing.SpanRefFromQueueItem(&item)
+	if ref == nil {
+		ref = tracing.LockStepSpanRef(id.RunID, edge.Outgoing)
+	}
+	err = e.tracerProvider.UpdateSpan(ctx, &tracing.UpdateSpanOptions{
+		EndTime:    e.now(),
+		Debug:      &tracing.SpanDebugData{Location: "executor.acquireStepLock"},
+		QueueItem:  &item,
+		Status:     enums.StepStatusCompleted,
+		TargetSpan: ref,
+	})
+	if err != nil {
+		e.log.Debug("error updating lock span", "error", err)
+	}
+
+	hasPendingSteps, err = e.smv2.SaveStep(ctx, sv2.IDFromV1(id), edge.Outgoing, []byte("null"))
+	if errors.Is(err, state.ErrDuplicateResponse) {
+		err = nil
+	}
+	if err != nil {
+		// Don't hold the lock for a run which can't continue.
+		e.releaseStepLocks(ctx, id.WorkspaceID, id.RunID, edge.Lock.Step)
+		return false, err
+	}
+	return hasPendingSteps, nil
+}
+
+// releaseStepLocks releases the locks held by the run for the given step, once
+// the step completes or fails.  Locks held for the run's other steps, eg.
+// parallel steps, are untouched.  Errors are logged and otherwise ignored;
+// locks are always released when their lease expires.
+func (e *executor) releaseStepLocks(ctx context.Context, envID uuid.UUID, runID ulid.ULID, stepID string) {
+	if e.locker == nil {
+		return
+	}
+	if err := e.locker.ReleaseStep(ctx, envID, runID, stepID); err != nil {
+		logger.StdlibLogger(ctx).Warn("error releasing step locks", "error", err, "run_id", runID, "step_id", stepID)
+	}
+}
+
+// releaseRunStepLocks releases every lock held by the run, once the run
+// finishes.  Errors are logged and otherwise ignored.
+func (e *executor) releaseRunStepLocks(ctx context.Context, envID uuid.UUID, runID ulid.ULID) {
+	if e.locker == nil {
+		return
+	}
+	if err := e.locker.ReleaseRun(ctx, envID, runID); err != nil {
+		logger.StdlibLogger(ctx).Warn("error releasing step locks", "error", err, "run_id", runID)
+	}
+}
@@ -0,0 +1,144 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/steplock"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/tracing"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLocker grants each lock to the first run which acquires it.
+type fakeLocker struct {
+	holders map[string]ulid.ULID
+	steps   map[string]string
+}
+
+func (l *fakeLocker) Acquire(_ context.Context, _ uuid.UUID, runID ulid.ULID, stepID, key string, lease time.Duration) (steplock.Result, error) {
+	if holder, ok := l.holders[key]; ok && holder != runID {
+		return steplock.Result{ExpiresAt: time.Now().Add(lease)}, nil
+	}
+	l.holders[key] = runID
+	l.steps[key] = stepID
+	return steplock.Result{Acquired: true, ExpiresAt: time.Now().Add(lease)}, nil
+}
+
+func (l *fakeLocker) ReleaseStep(_ context.Context, _ uuid.UUID, runID ulid.ULID, stepID string) error {
+	for key, holder := range l.holders {
+		if holder == runID && l.steps[key] == stepID {
+			delete(l.holders, key)
+		}
+	}
+	return nil
+}
+
+func (l *fakeLocker) ReleaseRun(_ context.Context, _ uuid.UUID, runID ulid.ULID) error {
+	for key, holder := range l.holders {
+		if holder == runID {
+			delete(l.holders, key)
+		}
+	}
+	return nil
+}
+
+func newLockTest() (*executor, *fakeLocker, *savingRunService, *stubQueue, *mockRunContext) {
+	l := &fakeLocker{holders: map[string]ulid.ULID{}, steps: map[string]string{}}
+	rs := &savingRunService{saved: map[string][]byte{}}
+	q := &stubQueue{}
+	e := &executor{
+		smv2:           rs,
+		queue:          q,
+		locker:         l,
+		log:            logger.From(context.Background()),
+		tracerProvider: tracing.NewNoopTracerProvider(),
+	}
+	rc := &mockRunContext{md: sv2.Metadata{
+		ID:     sv2.ID{RunID: ulid.MustNew(ulid.Now(), nil), FunctionID: uuid.New()},
+		Config: *sv2.InitConfig(&sv2.Config{}),
+	}}
+	return e, l, rs, q, rc
+}
+
+func TestHandleGeneratorLockAcquire(t *testing.T) {
+	ctx := context.Background()
+	e, l, rs, q, rc := newLockTest()
+
+	gen := state.GeneratorOpcode{
+		Op:   enums.OpcodeLockAcquire,
+		ID:   "lock",
+		Opts: map[string]any{"key": "account:1", "lease": "30s", "step": "guarded"},
+	}
+	err := e.handleGeneratorLockAcquire(ctx, rc, gen, queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}}, OpcodeGroup{})
+	require.NoError(t, err)
+
+	require.Len(t, q.enqueued, 1)
+	item := q.enqueued[0]
+	require.Equal(t, queue.KindLock, item.Kind)
+	edge := item.Payload.(queue.PayloadEdge).Edge
+	require.Equal(t, "lock", edge.Outgoing)
+	require.Equal(t, &inngest.StepLock{Key: "account:1", Lease: 30 * time.Second, Step: "guarded"}, edge.Lock)
+
+	id := sv2.V1FromMetadata(rc.md)
+
+	t.Run("waits while held by another run", func(t *testing.T) {
+		l.holders["account:1"] = ulid.Make()
+
+		_, err := e.acquireStepLock(ctx, id, item, edge)
+		require.ErrorContains(t, err, ErrStepLockHeld.Error())
+		require.True(t, queue.IsAlwaysRetryable(err), "waiting must not consume attempts")
+		require.NotNil(t, queue.AsRetryAtError(err).NextRetryAt())
+		require.Empty(t, rs.saved)
+	})
+
+	t.Run("saves the lock step once granted", func(t *testing.T) {
+		delete(l.holders, "account:1")
+
+		_, err := e.acquireStepLock(ctx, id, item, edge)
+		require.NoError(t, err)
+		require.Equal(t, rc.md.ID.RunID, l.holders["account:1"])
+		require.Equal(t, "null", string(rs.saved["lock"]))
+	})
+
+	t.Run("held while other parallel steps complete", func(t *testing.T) {
+		err := e.handleGeneratorStep(ctx, rc, state.GeneratorOpcode{Op: enums.OpcodeStepRun, ID: "sibling", Data: json.RawMessage(`null`)}, queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}})
+		require.NoError(t, err)
+		require.Equal(t, rc.md.ID.RunID, l.holders["account:1"])
+	})
+
+	t.Run("released when the guarded step completes", func(t *testing.T) {
+		err := e.handleGeneratorStep(ctx, rc, state.GeneratorOpcode{Op: enums.OpcodeStepRun, ID: "guarded", Data: json.RawMessage(`null`)}, queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}})
+		require.NoError(t, err)
+		require.NotContains(t, l.holders, "account:1")
+	})
+}
+
+func TestHandleGeneratorLockAcquire_InvalidOpts(t *testing.T) {
+	ctx := context.Background()
+	e, _, _, q, rc := newLockTest()
+
+	gen := state.GeneratorOpcode{
+		Op:   enums.OpcodeLockAcquire,
+		ID:   "lock",
+		Opts: map[string]any{"key": "account:1", "lease": "365d", "step": "guarded"},
+	}
+	err := e.handleGeneratorLockAcquire(ctx, rc, gen, queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}}, OpcodeGroup{})
+	require.ErrorContains(t, err, steplock.ErrInvalidOpts.Error())
+	require.False(t, queue.ShouldRetry(err, 0, 3))
+	require.Empty(t, q.enqueued)
+
+	gen.Opts = map[string]any{"key": "account:1", "lease": "30s"}
+	err = e.handleGeneratorLockAcquire(ctx, rc, gen, queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}}, OpcodeGroup{})
+	require.ErrorContains(t, err, "step is required")
+	require.Empty(t, q.enqueued)
+}
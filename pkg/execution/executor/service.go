@@ -266,7 +266,7 @@ func (s *svc) Run(ctx context.Context) error {
 		)
 
 		switch item.Kind {
-		case queue.KindStart, queue.KindEdge, queue.KindSleep, queue.KindEdgeError:
+		case queue.KindStart, queue.KindEdge, queue.KindSleep, queue.KindLock, queue.KindEdgeError:
 			continuation, err = s.handleQueueItem(ctx, item)
 		case queue.KindPause:
 			err = s.handlePauseTimeout(ctx, item)
@@ -306,6 +306,8 @@ func (s *svc) Stop(ctx context.Context) error {
 
 	// Wait for all in-flight queue runs to finish
 	s.wg.Wait()
+
+	s.exec.CloseDrivers(ctx)
 	return nil
 }
 
@@ -0,0 +1,130 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/stepcache"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngestgo"
+)
+
+// stepCacheOpts returns the cache configuration for a planned step, or nil if
+// the step hasn't opted into caching.  Invalid cache options are logged and
+// ignored:  caching is an optimization and must never fail a step.
+func (e *executor) stepCacheOpts(ctx context.Context, gen state.GeneratorOpcode) *inngest.StepCache {
+	if e.stepCache == nil || gen.Opts == nil {
+		return nil
+	}
+
+	opts, err := gen.RunOpts()
+	if err != nil || opts.Cache == nil {
+		return nil
+	}
+
+	ttl, err := opts.Cache.TTLDuration()
+	if err == nil {
+		err = stepcache.Validate(opts.Cache.Key, ttl)
+	}
+	if err != nil {
+		logger.StdlibLogger(ctx).Warn("ignoring invalid step cache options", "error", err, "step_id", gen.ID)
+		return nil
+	}
+
+	return &inngest.StepCache{Key: opts.Cache.Key, TTL: ttl}
+}
+
+// handleStepCacheHit looks up the planned step's output in the step cache.  On
+// a hit, the cached output is memoized into the run's state as if the step ran
+// and the function is re-invoked without calling the SDK to execute the step.
+//
+// This returns false if there's no cached output, in which case the step must be
+// scheduled as usual.
+func (e *executor) handleStepCacheHit(ctx context.Context, runCtx execution.RunContext, gen state.GeneratorOpcode, edge queue.PayloadEdge, group OpcodeGroup, cache *inngest.StepCache) (bool, error) {
+	md := runCtx.Metadata()
+	l := logger.StdlibLogger(ctx).With("run_id", md.ID.RunID, "step_id", gen.ID)
+
+	output, err := e.stepCache.Get(ctx, md.ID.Tenant.EnvID, md.ID.FunctionID, cache.Key)
+	if errors.Is(err, stepcache.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		l.Warn("error reading step cache", "error", err)
+		return false, nil
+	}
+
+	if err := e.validateStateSize(len(output), *md); err != nil {
+		return false, err
+	}
+
+	// Cached outputs are stored wrapped in "data", exactly as saved to state.
+	var wrapped struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(output, &wrapped); err != nil {
+		l.Warn("ignoring invalid step cache entry", "error", err)
+		return false, nil
+	}
+
+	hit := gen
+	hit.Op = enums.OpcodeStepRun
+	hit.Data = wrapped.Data
+	e.emitStepSpan(ctx, runCtx, &hit, nil, meta.NewAttrSet(
+		meta.Attr(meta.Attrs.StepCacheHit, inngestgo.Ptr(true)),
+		meta.Attr(meta.Attrs.StepCacheKey, &cache.Key),
+	))
+
+	if delta := md.Metrics.SwapMetadataSizeDelta(); delta > 0 {
+		ctx = state.WithMetadataSizeDelta(ctx, delta)
+	}
+
+	hasPendingSteps, err := e.smv2.SaveStep(ctx, md.ID, gen.ID, output)
+	if errors.Is(err, state.ErrDuplicateResponse) || errors.Is(err, state.ErrIdempotentResponse) {
+		l.Warn("step output already persisted; keeping existing output", "error", err)
+		err = nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	e.releaseStepLocks(ctx, md.ID.Tenant.EnvID, md.ID.RunID, gen.ID)
+
+	var coalesceKey *string
+	if group.ParallelCoalesceKey != "" {
+		ck := group.ParallelCoalesceKey
+		coalesceKey = &ck
+	}
+
+	groupID := uuid.New().String()
+	return true, e.maybeEnqueueDiscoveryStep(
+		state.WithGroupID(ctx, groupID),
+		runCtx,
+		hit,
+		edge,
+		groupID,
+		hasPendingSteps,
+		coalesceKey,
+	)
+}
+
+// maybeCacheStepOutput caches the output of a step which opted into caching
+// when it was planned.  Errors are logged and otherwise ignored.
+func (e *executor) maybeCacheStepOutput(ctx context.Context, runCtx execution.RunContext, gen state.GeneratorOpcode, edge queue.PayloadEdge, output string) {
+	cache := edge.Edge.IncomingGeneratorStepCache
+	if e.stepCache == nil || cache == nil || edge.Edge.IncomingGeneratorStep != gen.ID {
+		return
+	}
+
+	md := runCtx.Metadata()
+	if err := e.stepCache.Set(ctx, md.ID.Tenant.EnvID, md.ID.FunctionID, cache.Key, json.RawMessage(output), cache.TTL); err != nil {
+		logger.StdlibLogger(ctx).Warn("error writing step cache", "error", err, "run_id", md.ID.RunID, "step_id", gen.ID)
+	}
+}
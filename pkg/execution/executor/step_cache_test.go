@@ -0,0 +1,126 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/execution/stepcache"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/tracing"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStepCache struct {
+	entries map[string]json.RawMessage
+	ttls    map[string]time.Duration
+}
+
+func (c *fakeStepCache) Get(_ context.Context, _, _ uuid.UUID, key string) (json.RawMessage, error) {
+	if out, ok := c.entries[key]; ok {
+		return out, nil
+	}
+	return nil, stepcache.ErrNotFound
+}
+
+func (c *fakeStepCache) Set(_ context.Context, _, _ uuid.UUID, key string, output json.RawMessage, ttl time.Duration) error {
+	c.entries[key] = output
+	c.ttls[key] = ttl
+	return nil
+}
+
+type savingRunService struct {
+	sv2.RunService
+	saved map[string][]byte
+}
+
+func (s *savingRunService) SaveStep(_ context.Context, _ sv2.ID, stepID string, data []byte) (bool, error) {
+	s.saved[stepID] = data
+	return false, nil
+}
+
+func newStepCacheTest() (*executor, *fakeStepCache, *savingRunService, *stubQueue, *mockRunContext) {
+	c := &fakeStepCache{entries: map[string]json.RawMessage{}, ttls: map[string]time.Duration{}}
+	rs := &savingRunService{saved: map[string][]byte{}}
+	q := &stubQueue{}
+	e := &executor{
+		smv2:           rs,
+		queue:          q,
+		stepCache:      c,
+		log:            logger.From(context.Background()),
+		tracerProvider: tracing.NewNoopTracerProvider(),
+	}
+	rc := &mockRunContext{md: sv2.Metadata{
+		ID:     sv2.ID{RunID: ulid.MustNew(ulid.Now(), nil), FunctionID: uuid.New()},
+		Config: *sv2.InitConfig(&sv2.Config{}),
+	}}
+	return e, c, rs, q, rc
+}
+
+func cachedPlannedStep(id, key, ttl string) state.GeneratorOpcode {
+	return state.GeneratorOpcode{
+		Op: enums.OpcodeStepPlanned,
+		ID: id,
+		Opts: map[string]any{
+			"cache": map[string]any{"key": key, "ttl": ttl},
+		},
+	}
+}
+
+func TestHandleGeneratorStepPlanned_StepCacheHit(t *testing.T) {
+	ctx := context.Background()
+	e, c, rs, q, rc := newStepCacheTest()
+	c.entries["user:1"] = json.RawMessage(`{"data":{"enriched":true}}`)
+
+	err := e.handleGeneratorStepPlanned(ctx, rc, cachedPlannedStep("step", "user:1", "1h"), queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}}, OpcodeGroup{})
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"data":{"enriched":true}}`, string(rs.saved["step"]), "cached output must be memoized into state")
+	require.Len(t, q.enqueued, 1)
+	edge := q.enqueued[0].Payload.(queue.PayloadEdge).Edge
+	require.Empty(t, edge.IncomingGeneratorStep, "the step must not be planned for execution")
+	require.Nil(t, edge.IncomingGeneratorStepCache)
+}
+
+func TestHandleGeneratorStepPlanned_StepCacheMiss(t *testing.T) {
+	ctx := context.Background()
+	e, c, rs, q, rc := newStepCacheTest()
+
+	err := e.handleGeneratorStepPlanned(ctx, rc, cachedPlannedStep("step", "user:1", "1h"), queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}}, OpcodeGroup{})
+	require.NoError(t, err)
+
+	require.Empty(t, rs.saved)
+	require.Len(t, q.enqueued, 1)
+	edge := q.enqueued[0].Payload.(queue.PayloadEdge).Edge
+	require.Equal(t, "step", edge.IncomingGeneratorStep)
+	require.Equal(t, &inngest.StepCache{Key: "user:1", TTL: time.Hour}, edge.IncomingGeneratorStepCache)
+
+	// Once the planned step succeeds, its output is cached.
+	err = e.handleGeneratorStep(ctx, rc, state.GeneratorOpcode{Op: enums.OpcodeStepRun, ID: "step", Data: json.RawMessage(`{"enriched":true}`)}, queue.PayloadEdge{Edge: edge})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"enriched":true}}`, string(c.entries["user:1"]))
+	require.Equal(t, time.Hour, c.ttls["user:1"])
+}
+
+func TestHandleGeneratorStepPlanned_InvalidStepCacheOpts(t *testing.T) {
+	ctx := context.Background()
+	e, c, _, q, rc := newStepCacheTest()
+	c.entries["user:1"] = json.RawMessage(`{"data":{"enriched":true}}`)
+
+	// Invalid TTLs are ignored and the step executes as usual.
+	err := e.handleGeneratorStepPlanned(ctx, rc, cachedPlannedStep("step", "user:1", "365d"), queue.PayloadEdge{Edge: inngest.Edge{Incoming: "step"}}, OpcodeGroup{})
+	require.NoError(t, err)
+
+	require.Len(t, q.enqueued, 1)
+	edge := q.enqueued[0].Payload.(queue.PayloadEdge).Edge
+	require.Equal(t, "step", edge.IncomingGeneratorStep)
+	require.Nil(t, edge.IncomingGeneratorStepCache)
+}
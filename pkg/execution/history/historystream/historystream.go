@@ -0,0 +1,312 @@
+// Package historystream publishes run history to a message broker, such as
+// Kafka or NATS, as a change-data stream.
+//
+// Every history.History written to the driver is published as a JSON encoded
+// Record keyed by its run ID.  Delivery is at least once: Write publishes the
+// record synchronously, retrying failed publishes, and only returns once the
+// broker has durably stored it.  Writes which fail, eg. because the broker is
+// unavailable for longer than the publish timeout, return an error.  Retried
+// publishes may store a record more than once, so consumers should
+// deduplicate by record ID.
+//
+// The executor writes a run's history concurrently, so writes for a run are
+// serialized: each record is given the run's next sequence number and is
+// published before the next record of the run, so brokers which order by key
+// store a run's records in sequence order.
+package historystream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/inngest/inngest/pkg/execution/history"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// DefaultShards is the number of runs published concurrently.
+	DefaultShards = 16
+	// DefaultPublishTimeout is how long a write retries publishing a record
+	// before failing.
+	DefaultPublishTimeout = 30 * time.Second
+
+	// HeaderSchemaVersion is the message header holding the record's
+	// SchemaVersion.
+	HeaderSchemaVersion = "schema_version"
+	// HeaderRecordID is the message header holding the record's ID.
+	HeaderRecordID = "id"
+
+	minRetryInterval = 100 * time.Millisecond
+	maxRetryInterval = 10 * time.Second
+)
+
+// Opts configures the broker history is published to.  Exactly one of Kafka
+// or NATS must be configured.
+type Opts struct {
+	// KafkaBrokers are the seed brokers of the Kafka cluster.
+	KafkaBrokers []string `json:"kafka_brokers"`
+	// KafkaTopic is the topic records are produced to.
+	KafkaTopic string `json:"kafka_topic"`
+	// KafkaSASLMechanism is the SASL mechanism used to authenticate with
+	// Kafka: PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.  SASL is disabled if
+	// empty.
+	KafkaSASLMechanism string `json:"kafka_sasl_mechanism"`
+	// KafkaSASLUsername and KafkaSASLPassword are the SASL credentials.
+	KafkaSASLUsername string `json:"kafka_sasl_username"`
+	KafkaSASLPassword string `json:"kafka_sasl_password"`
+	// KafkaTLS connects to the brokers over TLS.  It's implied if any of the
+	// TLS files are set.
+	KafkaTLS bool `json:"kafka_tls"`
+	// KafkaTLSCAFile is a PEM file of the CAs used to verify the brokers.  If
+	// empty, the host's root CAs are used.
+	KafkaTLSCAFile string `json:"kafka_tls_ca_file"`
+	// KafkaTLSCertFile and KafkaTLSKeyFile are a PEM client certificate and
+	// key presented to the brokers.
+	KafkaTLSCertFile string `json:"kafka_tls_cert_file"`
+	KafkaTLSKeyFile  string `json:"kafka_tls_key_file"`
+	// NatsURLs are the comma delimited URLs of the NATS servers.
+	NatsURLs string `json:"nats_urls"`
+	// NatsSubject is the JetStream subject records are published to.
+	NatsSubject string `json:"nats_subject"`
+}
+
+// Enabled returns whether a broker is configured.
+func (o Opts) Enabled() bool {
+	return len(o.KafkaBrokers) > 0 || o.NatsURLs != ""
+}
+
+// New returns a history driver publishing to the broker configured in opts.
+func New(ctx context.Context, opts Opts, dopts ...DriverOpt) (history.Driver, error) {
+	var (
+		p   Publisher
+		err error
+	)
+	switch {
+	case len(opts.KafkaBrokers) > 0 && opts.NatsURLs != "":
+		return nil, fmt.Errorf("history stream can publish to either kafka or nats, not both")
+	case len(opts.KafkaBrokers) > 0:
+		kopts := []KafkaPublisherOpt{WithKafkaBrokers(opts.KafkaBrokers), WithKafkaTopic(opts.KafkaTopic)}
+		if opts.KafkaSASLMechanism != "" {
+			kopts = append(kopts, WithKafkaSASL(opts.KafkaSASLMechanism, opts.KafkaSASLUsername, opts.KafkaSASLPassword))
+		}
+		if opts.KafkaTLS || opts.KafkaTLSCAFile != "" || opts.KafkaTLSCertFile != "" || opts.KafkaTLSKeyFile != "" {
+			tlsConfig, err := LoadKafkaTLSConfig(opts.KafkaTLSCAFile, opts.KafkaTLSCertFile, opts.KafkaTLSKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			kopts = append(kopts, WithKafkaTLS(tlsConfig))
+		}
+		p, err = NewKafkaPublisher(ctx, kopts...)
+	case opts.NatsURLs != "":
+		p, err = NewNatsPublisher(ctx, WithNatsUrls(opts.NatsURLs), WithNatsSubject(opts.NatsSubject))
+	default:
+		return nil, fmt.Errorf("no broker provided for history stream")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewDriver(p, dopts...), nil
+}
+
+// ErrClosed is returned when writing to a closed driver.
+var ErrClosed = errors.New("history stream is closed")
+
+// Message is a single message sent to a broker.
+type Message struct {
+	// Key is the run ID.  Messages with the same key must be stored in the
+	// order they're published.
+	Key string
+	// ID uniquely identifies the message, allowing brokers and consumers to
+	// deduplicate retried publishes.
+	ID string
+	// Value is the JSON encoded Record.
+	Value []byte
+}
+
+// Publisher sends messages to a broker.
+type Publisher interface {
+	// Publish sends a single message, returning once the broker has durably
+	// stored it.
+	Publish(ctx context.Context, m Message) error
+	Close(ctx context.Context) error
+}
+
+type DriverOpt func(d *driver)
+
+// WithShards sets the number of runs published concurrently.
+func WithShards(n int) DriverOpt {
+	return func(d *driver) {
+		if n > 0 {
+			d.shards = n
+		}
+	}
+}
+
+// WithPublishTimeout sets how long a write retries publishing a record before
+// failing.
+func WithPublishTimeout(timeout time.Duration) DriverOpt {
+	return func(d *driver) {
+		if timeout > 0 {
+			d.timeout = timeout
+		}
+	}
+}
+
+// WithRetryInterval sets the minimum and maximum delay between retries of a
+// failed publish.  The delay doubles with each attempt.
+func WithRetryInterval(min, max time.Duration) DriverOpt {
+	return func(d *driver) {
+		d.minRetry, d.maxRetry = min, max
+	}
+}
+
+// NewDriver returns a history driver which publishes history to the given
+// publisher.  The publisher is closed when the driver is closed.
+func NewDriver(p Publisher, opts ...DriverOpt) history.Driver {
+	d := &driver{
+		pub:      p,
+		shards:   DefaultShards,
+		timeout:  DefaultPublishTimeout,
+		minRetry: minRetryInterval,
+		maxRetry: maxRetryInterval,
+		now:      time.Now,
+	}
+	for _, o := range opts {
+		o(d)
+	}
+
+	// In-flight publishes are only cancelled once Close gives up on waiting
+	// for them.
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	d.runs = make([]sync.Mutex, d.shards)
+	return d
+}
+
+type driver struct {
+	pub      Publisher
+	shards   int
+	timeout  time.Duration
+	minRetry time.Duration
+	maxRetry time.Duration
+	now      func() time.Time
+
+	// mu guards closed, ensuring no writes start once Close waits for
+	// in-flight writes.
+	mu       sync.RWMutex
+	closed   bool
+	inflight sync.WaitGroup
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	// runs serializes the writes of each run, by the run's shard.
+	runs []sync.Mutex
+
+	seqMu sync.Mutex
+	seq   int64
+}
+
+func (d *driver) Write(ctx context.Context, h history.History) error {
+	// Skips have no ID, but every record needs one for deduplication.
+	if h.ID == (ulid.ULID{}) {
+		h.ID = ulid.Make()
+	}
+
+	d.mu.RLock()
+	if d.closed {
+		d.mu.RUnlock()
+		return ErrClosed
+	}
+	d.inflight.Add(1)
+	d.mu.RUnlock()
+	defer d.inflight.Done()
+
+	key := h.RunID.String()
+	run := &d.runs[shard(key, len(d.runs))]
+	run.Lock()
+	defer run.Unlock()
+
+	r := NewRecord(h)
+	r.Sequence = d.nextSequence()
+	byt, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("error encoding history record: %w", err)
+	}
+	return d.publish(ctx, Message{
+		Key:   key,
+		ID:    h.ID.String(),
+		Value: byt,
+	})
+}
+
+// nextSequence returns the next record's sequence number.  Sequence numbers
+// are the current time in microseconds, unless that's not greater than the
+// last sequence number, so that they keep increasing for runs which continue
+// after a restart.
+func (d *driver) nextSequence() int64 {
+	d.seqMu.Lock()
+	defer d.seqMu.Unlock()
+	d.seq = max(d.seq+1, d.now().UnixMicro())
+	return d.seq
+}
+
+// Close stops accepting writes and waits for in-flight writes to publish their
+// records, cancelling them once ctx is done.
+func (d *driver) Close(ctx context.Context) error {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		// Stop retrying; the in-flight writes fail.
+		d.cancel()
+		<-done
+	}
+	d.cancel()
+
+	return d.pub.Close(context.WithoutCancel(ctx))
+}
+
+// publish sends a message, retrying until it succeeds, the publish timeout
+// elapses or the driver gives up on in-flight writes.
+func (d *driver) publish(ctx context.Context, m Message) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+	defer context.AfterFunc(d.ctx, cancel)()
+
+	delay := d.minRetry
+	for {
+		err := d.pub.Publish(ctx, m)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("error publishing history record %s for run %s: %w", m.ID, m.Key, err)
+		}
+
+		logger.StdlibLogger(ctx).Warn("error publishing history record", "error", err, "run_id", m.Key, "id", m.ID, "retry_in", delay)
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, d.maxRetry)
+	}
+}
+
+func shard(key string, n int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(n))
+}
@@ -0,0 +1,204 @@
+package historystream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/history"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// publisher records published messages, failing every nth publish.
+type publisher struct {
+	mu        sync.Mutex
+	failEvery int
+	calls     int
+	published []Message
+	closed    bool
+}
+
+func (p *publisher) Publish(ctx context.Context, m Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.failEvery > 0 && p.calls%p.failEvery == 0 {
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, m)
+	return nil
+}
+
+func (p *publisher) Close(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func TestDriverPublishesBeforeWriteReturns(t *testing.T) {
+	ctx := context.Background()
+	p := &publisher{failEvery: 3}
+	d := NewDriver(p, WithShards(4), WithRetryInterval(time.Millisecond, time.Millisecond))
+
+	// The executor writes a run's history concurrently.
+	runs := []ulid.ULID{ulid.Make(), ulid.Make(), ulid.Make()}
+	var wg sync.WaitGroup
+	for _, runID := range runs {
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				h := history.History{ID: ulid.Make(), RunID: runID}
+				assert.NoError(t, d.Write(ctx, h))
+
+				// Records are published once the write returns.
+				p.mu.Lock()
+				defer p.mu.Unlock()
+				assert.True(t, slices.ContainsFunc(p.published, func(m Message) bool {
+					return m.ID == h.ID.String()
+				}))
+			}()
+		}
+	}
+	wg.Wait()
+	require.NoError(t, d.Close(ctx))
+	require.True(t, p.closed)
+
+	// Every record is published despite failures, in sequence order within
+	// each run.
+	sequences := map[string][]int64{}
+	for _, m := range p.published {
+		r := Record{}
+		require.NoError(t, json.Unmarshal(m.Value, &r))
+		sequences[m.Key] = append(sequences[m.Key], r.Sequence)
+	}
+	require.Len(t, sequences, len(runs))
+	for _, seqs := range sequences {
+		require.Len(t, seqs, 20)
+		require.True(t, slices.IsSorted(seqs))
+		require.Len(t, slices.Compact(seqs), 20)
+	}
+
+	require.ErrorIs(t, d.Write(ctx, history.History{RunID: runs[0]}), ErrClosed)
+}
+
+func TestDriverSequenceSurvivesRestarts(t *testing.T) {
+	now := time.Now()
+	d := &driver{now: func() time.Time { return now }}
+
+	// Sequence numbers increase within the same microsecond.
+	first := d.nextSequence()
+	require.Equal(t, now.UnixMicro(), first)
+	require.Equal(t, first+1, d.nextSequence())
+
+	// A restarted driver continues from the current time.
+	restarted := &driver{now: func() time.Time { return now.Add(time.Second) }}
+	require.Greater(t, restarted.nextSequence(), first+1)
+}
+
+func TestDriverWriteFailsOnceUnpublished(t *testing.T) {
+	t.Run("publish timeout", func(t *testing.T) {
+		p := &publisher{failEvery: 1}
+		d := NewDriver(p, WithRetryInterval(time.Millisecond, time.Millisecond), WithPublishTimeout(50*time.Millisecond))
+
+		err := d.Write(context.Background(), history.History{RunID: ulid.Make()})
+		require.ErrorContains(t, err, "broker unavailable")
+		require.NoError(t, d.Close(context.Background()))
+		require.Empty(t, p.published)
+	})
+
+	t.Run("close gives up on in-flight writes", func(t *testing.T) {
+		p := &publisher{failEvery: 1}
+		d := NewDriver(p, WithRetryInterval(time.Millisecond, time.Millisecond))
+
+		written := make(chan error, 1)
+		go func() {
+			written <- d.Write(context.Background(), history.History{RunID: ulid.Make()})
+		}()
+		require.Eventually(t, func() bool {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			return p.calls > 0
+		}, time.Second, time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		require.NoError(t, d.Close(ctx))
+		require.Error(t, <-written)
+		require.True(t, p.closed)
+	})
+}
+
+func TestRecord(t *testing.T) {
+	p := &publisher{}
+	d := NewDriver(p)
+
+	runID, eventID, userID := ulid.Make(), ulid.Make(), uuid.New()
+	stepType := enums.HistoryStepTypeRun
+	skip := enums.SkipReasonFunctionPaused
+	require.NoError(t, d.Write(context.Background(), history.History{
+		ID:         ulid.Make(),
+		RunID:      runID,
+		EventID:    eventID,
+		Type:       enums.HistoryTypeFunctionCancelled.String(),
+		StepType:   &stepType,
+		SkipReason: &skip,
+		Cancel:     &execution.CancelRequest{UserID: &userID},
+	}))
+	// Skips have no ID, so one is generated.
+	require.NoError(t, d.Write(context.Background(), history.History{
+		RunID: runID,
+		Type:  enums.HistoryTypeFunctionSkipped.String(),
+	}))
+	require.NoError(t, d.Close(context.Background()))
+	require.Len(t, p.published, 2)
+
+	r := map[string]any{}
+	require.NoError(t, json.Unmarshal(p.published[0].Value, &r))
+	require.EqualValues(t, SchemaVersion, r["schema_version"])
+	require.Equal(t, runID.String(), r["run_id"])
+	require.Equal(t, eventID.String(), r["event_id"])
+	require.Equal(t, "FunctionCancelled", r["type"])
+	require.Equal(t, stepType.String(), r["step_type"])
+	require.Equal(t, skip.String(), r["skip_reason"])
+	require.Equal(t, map[string]any{"user_id": userID.String()}, r["cancel"])
+	require.NotContains(t, r, "result")
+
+	require.Equal(t, runID.String(), p.published[1].Key)
+	_, err := ulid.Parse(p.published[1].ID)
+	require.NoError(t, err)
+	require.NotEqual(t, p.published[0].ID, p.published[1].ID)
+}
+
+func TestKafkaSASL(t *testing.T) {
+	for _, mechanism := range []string{KafkaSASLPlain, KafkaSASLScramSHA256, KafkaSASLScramSHA512} {
+		m, err := kafkaSASL(mechanism, "user", "pass")
+		require.NoError(t, err)
+		require.Equal(t, mechanism, m.Name())
+	}
+
+	_, err := kafkaSASL("GSSAPI", "user", "pass")
+	require.ErrorContains(t, err, "unknown kafka sasl mechanism")
+
+	_, err = kafkaSASL(KafkaSASLPlain, "", "pass")
+	require.ErrorContains(t, err, "no username")
+}
+
+func TestLoadKafkaTLSConfig(t *testing.T) {
+	c, err := LoadKafkaTLSConfig("", "", "")
+	require.NoError(t, err)
+	require.Nil(t, c.RootCAs)
+
+	_, err = LoadKafkaTLSConfig(t.TempDir()+"/missing.pem", "", "")
+	require.ErrorContains(t, err, "error reading kafka ca file")
+}
@@ -0,0 +1,202 @@
+package historystream
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+const defaultKafkaMaxProduceMB = 30
+
+// Kafka SASL mechanisms.
+const (
+	KafkaSASLPlain       = "PLAIN"
+	KafkaSASLScramSHA256 = "SCRAM-SHA-256"
+	KafkaSASLScramSHA512 = "SCRAM-SHA-512"
+)
+
+type kafkaPublisherOpts struct {
+	addrs           []string
+	topic           string
+	autoCreateTopic bool
+	saslMechanism   string
+	saslUser        string
+	saslPass        string
+	tls             *tls.Config
+	maxProduceMB    int
+}
+
+type KafkaPublisherOpt func(k *kafkaPublisherOpts)
+
+func WithKafkaBrokers(addrs []string) KafkaPublisherOpt {
+	return func(k *kafkaPublisherOpts) {
+		k.addrs = addrs
+	}
+}
+
+func WithKafkaTopic(topic string) KafkaPublisherOpt {
+	return func(k *kafkaPublisherOpts) {
+		k.topic = topic
+	}
+}
+
+func WithKafkaAutoCreateTopic() KafkaPublisherOpt {
+	return func(k *kafkaPublisherOpts) {
+		k.autoCreateTopic = true
+	}
+}
+
+func WithKafkaScramAuth(user, pass string) KafkaPublisherOpt {
+	return WithKafkaSASL(KafkaSASLScramSHA512, user, pass)
+}
+
+// WithKafkaSASL authenticates with the given SASL mechanism: PLAIN,
+// SCRAM-SHA-256 or SCRAM-SHA-512.
+func WithKafkaSASL(mechanism, user, pass string) KafkaPublisherOpt {
+	return func(k *kafkaPublisherOpts) {
+		k.saslMechanism = strings.ToUpper(mechanism)
+		k.saslUser = user
+		k.saslPass = pass
+	}
+}
+
+// WithKafkaTLS connects to the brokers over TLS.
+func WithKafkaTLS(c *tls.Config) KafkaPublisherOpt {
+	return func(k *kafkaPublisherOpts) {
+		k.tls = c
+	}
+}
+
+// LoadKafkaTLSConfig returns a TLS config which trusts the given CA and, if
+// certFile and keyFile are set, presents a client certificate.  An empty
+// caFile uses the host's root CAs.
+func LoadKafkaTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		byt, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading kafka ca file: %w", err)
+		}
+		c.RootCAs = x509.NewCertPool()
+		if !c.RootCAs.AppendCertsFromPEM(byt) {
+			return nil, fmt.Errorf("no certificates found in kafka ca file")
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading kafka client certificate: %w", err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return c, nil
+}
+
+// kafkaSASL returns the SASL mechanism for the given name.
+func kafkaSASL(mechanism, user, pass string) (sasl.Mechanism, error) {
+	if user == "" {
+		return nil, fmt.Errorf("no username provided for kafka sasl")
+	}
+	switch mechanism {
+	case KafkaSASLPlain:
+		return plain.Auth{User: user, Pass: pass}.AsMechanism(), nil
+	case KafkaSASLScramSHA256:
+		return scram.Auth{User: user, Pass: pass}.AsSha256Mechanism(), nil
+	case KafkaSASLScramSHA512:
+		return scram.Auth{User: user, Pass: pass}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unknown kafka sasl mechanism %q; expected %s, %s or %s", mechanism, KafkaSASLPlain, KafkaSASLScramSHA256, KafkaSASLScramSHA512)
+	}
+}
+
+// NewKafkaPublisher returns a publisher which produces records to a Kafka
+// topic.  Records are keyed by run ID, so each run's records are stored in a
+// single partition in the order they're published.
+func NewKafkaPublisher(ctx context.Context, opts ...KafkaPublisherOpt) (Publisher, error) {
+	conf := &kafkaPublisherOpts{
+		maxProduceMB: defaultKafkaMaxProduceMB,
+	}
+	for _, apply := range opts {
+		apply(conf)
+	}
+
+	if len(conf.addrs) == 0 {
+		return nil, fmt.Errorf("no kafka broker addresses provided")
+	}
+	if conf.topic == "" {
+		return nil, fmt.Errorf("no topic provided for history stream")
+	}
+
+	kclopts := []kgo.Opt{
+		kgo.SeedBrokers(conf.addrs...),
+		kgo.DefaultProduceTopic(conf.topic),
+		kgo.ProducerBatchCompression(
+			kgo.ZstdCompression(),
+			kgo.Lz4Compression(),
+			kgo.GzipCompression(),
+			kgo.NoCompression(),
+		),
+		// Wait for all in-sync replicas so acknowledged records aren't lost.
+		// The idempotent producer, which is enabled by default, prevents
+		// retries from duplicating or reordering records within a partition.
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+		kgo.ProducerBatchMaxBytes(int32(conf.maxProduceMB * 1024 * 1024)),
+	}
+	if conf.autoCreateTopic {
+		kclopts = append(kclopts, kgo.AllowAutoTopicCreation())
+	}
+	if conf.saslMechanism != "" {
+		mechanism, err := kafkaSASL(conf.saslMechanism, conf.saslUser, conf.saslPass)
+		if err != nil {
+			return nil, err
+		}
+		kclopts = append(kclopts, kgo.SASL(mechanism))
+	}
+	if conf.tls != nil {
+		kclopts = append(kclopts, kgo.DialTLSConfig(conf.tls))
+	}
+
+	cl, err := kgo.NewClient(kclopts...)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing kafka client: %w", err)
+	}
+	if err := cl.Ping(ctx); err != nil {
+		cl.Close()
+		return nil, fmt.Errorf("error establishing connection to kafka: %w", err)
+	}
+
+	return &kafkaPublisher{client: cl}, nil
+}
+
+type kafkaPublisher struct {
+	client *kgo.Client
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, m Message) error {
+	rec := &kgo.Record{
+		Key:   []byte(m.Key),
+		Value: m.Value,
+		Headers: []kgo.RecordHeader{
+			{Key: HeaderSchemaVersion, Value: []byte(strconv.Itoa(SchemaVersion))},
+			{Key: HeaderRecordID, Value: []byte(m.ID)},
+		},
+	}
+	return p.client.ProduceSync(ctx, rec).FirstErr()
+}
+
+func (p *kafkaPublisher) Close(ctx context.Context) error {
+	if err := p.client.Flush(ctx); err != nil {
+		return err
+	}
+	p.client.Close()
+	return nil
+}
@@ -0,0 +1,115 @@
+package historystream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/inngest/inngest/pkg/pubsub/broker"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type natsPublisherOpts struct {
+	subject string
+	// Comma delimited URLs of the NATS server to use
+	urls string
+	// The path of the nkey file to be used for authentication
+	nkeyFile string
+	// The credentials file to be used for authentication
+	credsFile string
+}
+
+type NatsPublisherOpt func(n *natsPublisherOpts)
+
+// WithNatsSubject sets the subject records are published to.  The subject
+// must be bound to a JetStream stream.
+func WithNatsSubject(subject string) NatsPublisherOpt {
+	return func(n *natsPublisherOpts) {
+		n.subject = subject
+	}
+}
+
+func WithNatsUrls(urls string) NatsPublisherOpt {
+	return func(n *natsPublisherOpts) {
+		n.urls = urls
+	}
+}
+
+func WithNatsNKeyFile(nkeyFilePath string) NatsPublisherOpt {
+	return func(n *natsPublisherOpts) {
+		n.nkeyFile = nkeyFilePath
+	}
+}
+
+func WithNatsCredsFile(credsFilePath string) NatsPublisherOpt {
+	return func(n *natsPublisherOpts) {
+		n.credsFile = credsFilePath
+	}
+}
+
+// NewNatsPublisher returns a publisher which publishes records to a
+// JetStream subject.  Each record is acknowledged by the stream before the
+// next record of the run is published, and the record ID is used as the
+// message ID so that the stream deduplicates retried publishes.
+func NewNatsPublisher(ctx context.Context, opts ...NatsPublisherOpt) (Publisher, error) {
+	conf := &natsPublisherOpts{}
+	for _, apply := range opts {
+		apply(conf)
+	}
+	if conf.subject == "" {
+		return nil, fmt.Errorf("no subject provided for history stream")
+	}
+
+	connOpts := []nats.Option{}
+	if conf.nkeyFile != "" {
+		auth, err := nats.NkeyOptionFromSeed(conf.nkeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing nkey file for NATS: %w", err)
+		}
+		connOpts = append(connOpts, auth)
+	}
+	if conf.credsFile != "" {
+		connOpts = append(connOpts, nats.UserCredentials(conf.credsFile))
+	}
+
+	conn, err := broker.NewNATSConnector(ctx, broker.NatsConnOpt{
+		Name:      "history-stream",
+		URLS:      conf.urls,
+		JetStream: true,
+		Opts:      connOpts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting up nats: %w", err)
+	}
+	js, err := conn.JSConn()
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsPublisher{
+		conn:    conn,
+		js:      js,
+		subject: conf.subject,
+	}, nil
+}
+
+type natsPublisher struct {
+	conn    *broker.NatsConnector
+	js      jetstream.JetStream
+	subject string
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, m Message) error {
+	msg := nats.NewMsg(p.subject)
+	msg.Data = m.Value
+	msg.Header.Set(HeaderSchemaVersion, strconv.Itoa(SchemaVersion))
+	msg.Header.Set(HeaderRecordID, m.ID)
+
+	_, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(m.ID))
+	return err
+}
+
+func (p *natsPublisher) Close(ctx context.Context) error {
+	return p.conn.Shutdown(ctx)
+}
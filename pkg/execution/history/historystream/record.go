@@ -0,0 +1,118 @@
+package historystream
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/history"
+	"github.com/oklog/ulid/v2"
+)
+
+// SchemaVersion is the version of the Record schema.  It's incremented
+// whenever a field is removed or changes meaning; new fields may be added
+// without changing the version.
+const SchemaVersion = 1
+
+// Record is the JSON encoding of a history.History published to the stream.
+// Each record is one row of run history, eg. a run being scheduled, a step
+// finishing, or a run being skipped.
+type Record struct {
+	SchemaVersion int `json:"schema_version"`
+
+	// ID uniquely identifies the record.  Records may be delivered more than
+	// once, so consumers should deduplicate using the ID.
+	ID ulid.ULID `json:"id"`
+	// Sequence increases with each record of a run, ordering the run's
+	// records.  Sequence numbers aren't contiguous.
+	Sequence  int64     `json:"sequence"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+
+	AccountID       uuid.UUID  `json:"account_id"`
+	WorkspaceID     uuid.UUID  `json:"workspace_id"`
+	FunctionID      uuid.UUID  `json:"function_id"`
+	FunctionVersion int64      `json:"function_version"`
+	RunID           ulid.ULID  `json:"run_id"`
+	OriginalRunID   *ulid.ULID `json:"original_run_id,omitempty"`
+	EventID         ulid.ULID  `json:"event_id"`
+	BatchID         *ulid.ULID `json:"batch_id,omitempty"`
+	GroupID         *uuid.UUID `json:"group_id,omitempty"`
+	IdempotencyKey  string     `json:"idempotency_key,omitempty"`
+	Cron            *string    `json:"cron,omitempty"`
+
+	Attempt            int64                  `json:"attempt"`
+	Status             *string                `json:"status,omitempty"`
+	LatencyMS          *int64                 `json:"latency_ms,omitempty"`
+	CompletedStepCount *int64                 `json:"completed_step_count,omitempty"`
+	StepID             *string                `json:"step_id,omitempty"`
+	StepName           *string                `json:"step_name,omitempty"`
+	StepType           *enums.HistoryStepType `json:"step_type,omitempty"`
+	SkipReason         *enums.SkipReason      `json:"skip_reason,omitempty"`
+	URL                *string                `json:"url,omitempty"`
+
+	Cancel               *Cancel                       `json:"cancel,omitempty"`
+	Sleep                *history.Sleep                `json:"sleep,omitempty"`
+	WaitForEvent         *history.WaitForEvent         `json:"wait_for_event,omitempty"`
+	WaitResult           *history.WaitResult           `json:"wait_result,omitempty"`
+	WaitForSignal        *history.WaitForSignal        `json:"wait_for_signal,omitempty"`
+	WaitForSignalResult  *history.WaitForSignalResult  `json:"wait_for_signal_result,omitempty"`
+	InvokeFunction       *history.InvokeFunction       `json:"invoke_function,omitempty"`
+	InvokeFunctionResult *history.InvokeFunctionResult `json:"invoke_function_result,omitempty"`
+	Result               *history.Result               `json:"result,omitempty"`
+}
+
+// Cancel describes what cancelled a run.
+type Cancel struct {
+	EventID        *ulid.ULID `json:"event_id,omitempty"`
+	Expression     *string    `json:"expression,omitempty"`
+	UserID         *uuid.UUID `json:"user_id,omitempty"`
+	CancellationID *ulid.ULID `json:"cancellation_id,omitempty"`
+}
+
+// NewRecord returns the record published for the given history.
+func NewRecord(h history.History) Record {
+	r := Record{
+		SchemaVersion:        SchemaVersion,
+		ID:                   h.ID,
+		Type:                 h.Type,
+		CreatedAt:            h.CreatedAt,
+		AccountID:            h.AccountID,
+		WorkspaceID:          h.WorkspaceID,
+		FunctionID:           h.FunctionID,
+		FunctionVersion:      h.FunctionVersion,
+		RunID:                h.RunID,
+		OriginalRunID:        h.OriginalRunID,
+		EventID:              h.EventID,
+		BatchID:              h.BatchID,
+		GroupID:              h.GroupID,
+		IdempotencyKey:       h.IdempotencyKey,
+		Cron:                 h.Cron,
+		Attempt:              h.Attempt,
+		Status:               h.Status,
+		LatencyMS:            h.LatencyMS,
+		CompletedStepCount:   h.CompletedStepCount,
+		StepID:               h.StepID,
+		StepName:             h.StepName,
+		StepType:             h.StepType,
+		SkipReason:           h.SkipReason,
+		URL:                  h.URL,
+		Sleep:                h.Sleep,
+		WaitForEvent:         h.WaitForEvent,
+		WaitResult:           h.WaitResult,
+		WaitForSignal:        h.WaitForSignal,
+		WaitForSignalResult:  h.WaitForSignalResult,
+		InvokeFunction:       h.InvokeFunction,
+		InvokeFunctionResult: h.InvokeFunctionResult,
+		Result:               h.Result,
+	}
+	if h.Cancel != nil {
+		r.Cancel = &Cancel{
+			EventID:        h.Cancel.EventID,
+			Expression:     h.Cancel.Expression,
+			UserID:         h.Cancel.UserID,
+			CancellationID: h.Cancel.CancellationID,
+		}
+	}
+	return r
+}
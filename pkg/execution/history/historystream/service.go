@@ -0,0 +1,48 @@
+package historystream
+
+import (
+	"context"
+	"time"
+
+	"github.com/inngest/inngest/pkg/execution/history"
+	"github.com/inngest/inngest/pkg/service"
+)
+
+// DefaultFlushTimeout is how long in-flight writes are waited for when
+// shutting down.
+const DefaultFlushTimeout = 30 * time.Second
+
+// NewService returns a service which closes the driver on shutdown, waiting for
+// in-flight writes to publish their records.
+func NewService(d history.Driver) service.Service {
+	return &svc{d: d}
+}
+
+type svc struct {
+	d history.Driver
+}
+
+func (s *svc) Name() string {
+	return "history-stream"
+}
+
+func (s *svc) Pre(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (s *svc) Stop(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultFlushTimeout)
+	defer cancel()
+	return s.d.Close(ctx)
+}
+
+// StopTimeout allows Stop to give up flushing before the service is
+// considered stuck.
+func (s *svc) StopTimeout() time.Duration {
+	return DefaultFlushTimeout + 5*time.Second
+}
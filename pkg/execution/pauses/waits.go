@@ -0,0 +1,186 @@
+package pauses
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	// ErrWaitNotFound is returned when a wait doesn't exist within the given
+	// workspace and run, or has already been resolved.
+	ErrWaitNotFound = errors.New("wait not found")
+	// ErrWaitNotResolvable is returned when manually resolving a pause which
+	// isn't a step.waitForEvent or step.waitForSignal wait.
+	ErrWaitNotResolvable = errors.New("wait cannot be resolved manually")
+	// ErrInvalidWaitData is returned when resolving a step.waitForEvent wait
+	// with data that isn't a JSON object.
+	ErrInvalidWaitData = errors.New("event data must be a JSON object")
+)
+
+// Resumer resumes pauses.  This is implemented by the executor.
+type Resumer interface {
+	Resume(ctx context.Context, p state.Pause, r execution.ResumeRequest) error
+	ResumePauseTimeout(ctx context.Context, p state.Pause, r execution.ResumeRequest) error
+}
+
+// Waits inspects and manually resolves the pauses that runs are blocked on,
+// such as step.waitForEvent and step.waitForSignal.
+type Waits interface {
+	// ForRun returns all pending waits for a run, in creation order.
+	// Cancellation pauses are not waits and are never returned.
+	ForRun(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID) ([]state.Pause, error)
+	// Resolve resumes a wait as if its event or signal was received with
+	// the given JSON payload.  For step.waitForEvent the payload is used as
+	// the event's data, and for step.waitForSignal as the signal's data.
+	Resolve(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID, pauseID uuid.UUID, data json.RawMessage) (*state.Pause, error)
+	// Timeout resumes a wait as if it timed out, without waiting for its
+	// expiry.
+	Timeout(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID, pauseID uuid.UUID) (*state.Pause, error)
+}
+
+// NewWaits returns Waits which loads pauses from the given manager and resumes
+// them via the given resumer.
+func NewWaits(m Manager, r Resumer) Waits {
+	return waits{m: m, r: r, now: time.Now}
+}
+
+type waits struct {
+	m   Manager
+	r   Resumer
+	now func() time.Time
+}
+
+func (w waits) ForRun(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID) ([]state.Pause, error) {
+	ids, err := w.m.PauseIDsForRun(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading pause IDs for run: %w", err)
+	}
+
+	result := make([]state.Pause, 0, len(ids))
+	for _, id := range ids {
+		p, err := w.m.PauseByID(ctx, Index{WorkspaceID: workspaceID}, id)
+		if errors.Is(err, state.ErrPauseNotFound) {
+			// The pause was consumed after loading the run's pause IDs.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error loading pause: %w", err)
+		}
+		if p == nil || p.WorkspaceID != workspaceID || p.Cancel {
+			continue
+		}
+		result = append(result, *p)
+	}
+
+	slices.SortStableFunc(result, func(a, b state.Pause) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return result, nil
+}
+
+func (w waits) Resolve(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID, pauseID uuid.UUID, data json.RawMessage) (*state.Pause, error) {
+	p, err := w.load(ctx, workspaceID, runID, pauseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolved waits are resumed with a unique idempotency key, as there's no
+	// event or signal to key on.
+	key := ulid.Make()
+	req := execution.ResumeRequest{
+		RunID:          &p.Identifier.RunID,
+		StepName:       p.StepName,
+		IdempotencyKey: key.String(),
+	}
+
+	switch {
+	case p.GetOpcode() == enums.OpcodeWaitForEvent && p.Event != nil:
+		evtData := map[string]any{}
+		if len(data) > 0 && string(data) != "null" {
+			if err := json.Unmarshal(data, &evtData); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidWaitData, err)
+			}
+		}
+		evt := event.Event{
+			ID:        key.String(),
+			Name:      *p.Event,
+			Data:      evtData,
+			Timestamp: w.now().UnixMilli(),
+		}
+		req.With = p.GetResumeData(evt).With
+		req.EventID = &key
+		req.EventName = evt.Name
+	case p.GetOpcode() == enums.OpcodeWaitForSignal && p.SignalID != nil:
+		if len(data) == 0 {
+			data = json.RawMessage("null")
+		}
+		req.With = map[string]any{
+			execution.StateDataKey: state.SignalStepReturn{
+				Signal: *p.SignalID,
+				Data:   data,
+			},
+		}
+	default:
+		return nil, ErrWaitNotResolvable
+	}
+
+	if err := w.r.Resume(ctx, *p, req); err != nil {
+		return nil, w.resumeError(err)
+	}
+	return p, nil
+}
+
+func (w waits) Timeout(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID, pauseID uuid.UUID) (*state.Pause, error) {
+	p, err := w.load(ctx, workspaceID, runID, pauseID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := execution.ResumeRequest{
+		IsTimeout:      true,
+		IdempotencyKey: p.ID.String(),
+	}
+	if p.GetOpcode() == enums.OpcodeInvokeFunction {
+		req.SetInvokeTimeoutError()
+	}
+
+	if err := w.r.ResumePauseTimeout(ctx, *p, req); err != nil {
+		return nil, w.resumeError(err)
+	}
+	return p, nil
+}
+
+func (w waits) load(ctx context.Context, workspaceID uuid.UUID, runID ulid.ULID, pauseID uuid.UUID) (*state.Pause, error) {
+	p, err := w.m.PauseByID(ctx, Index{WorkspaceID: workspaceID}, pauseID)
+	if errors.Is(err, state.ErrPauseNotFound) || (err == nil && p == nil) {
+		return nil, ErrWaitNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading pause: %w", err)
+	}
+	if p.WorkspaceID != workspaceID || p.Identifier.RunID != runID || p.Cancel {
+		return nil, ErrWaitNotFound
+	}
+	return p, nil
+}
+
+func (w waits) resumeError(err error) error {
+	if errors.Is(err, state.ErrPauseLeased) ||
+		errors.Is(err, state.ErrPauseNotFound) ||
+		errors.Is(err, state.ErrRunNotFound) {
+		// Another process resumed the wait first.
+		return fmt.Errorf("%w: %w", ErrWaitNotFound, err)
+	}
+	return fmt.Errorf("error resuming wait: %w", err)
+}
@@ -0,0 +1,210 @@
+package pauses
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type mockResumer struct {
+	resumed  []execution.ResumeRequest
+	timedOut []execution.ResumeRequest
+	err      error
+}
+
+func (m *mockResumer) Resume(ctx context.Context, p state.Pause, r execution.ResumeRequest) error {
+	m.resumed = append(m.resumed, r)
+	return m.err
+}
+
+func (m *mockResumer) ResumePauseTimeout(ctx context.Context, p state.Pause, r execution.ResumeRequest) error {
+	m.timedOut = append(m.timedOut, r)
+	return m.err
+}
+
+func opcode(o enums.Opcode) *string {
+	s := o.String()
+	return &s
+}
+
+func newWaitsTest(pauses ...*state.Pause) (Waits, *mockResumer) {
+	r := &mockResumer{}
+	return NewWaits(NewManager(&mockBufferer{pauses: pauses}, nil), r), r
+}
+
+func TestWaitsForRun(t *testing.T) {
+	ctx := context.Background()
+	wsID := uuid.New()
+	runID := ulid.Make()
+	now := time.Now()
+
+	evt := "api/user.created"
+	first := &state.Pause{
+		ID:          uuid.New(),
+		WorkspaceID: wsID,
+		Identifier:  state.PauseIdentifier{RunID: runID},
+		Opcode:      opcode(enums.OpcodeWaitForEvent),
+		Event:       &evt,
+		CreatedAt:   now.Add(-time.Minute),
+	}
+	second := &state.Pause{
+		ID:          uuid.New(),
+		WorkspaceID: wsID,
+		Identifier:  state.PauseIdentifier{RunID: runID},
+		Opcode:      opcode(enums.OpcodeWaitForSignal),
+		CreatedAt:   now,
+	}
+	cancel := &state.Pause{
+		ID:          uuid.New(),
+		WorkspaceID: wsID,
+		Identifier:  state.PauseIdentifier{RunID: runID},
+		Cancel:      true,
+		CreatedAt:   now,
+	}
+	otherWorkspace := &state.Pause{
+		ID:          uuid.New(),
+		WorkspaceID: uuid.New(),
+		Identifier:  state.PauseIdentifier{RunID: runID},
+		CreatedAt:   now,
+	}
+
+	w, _ := newWaitsTest(second, cancel, otherWorkspace, first)
+	result, err := w.ForRun(ctx, wsID, runID)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, first.ID, result[0].ID)
+	require.Equal(t, second.ID, result[1].ID)
+
+	result, err = w.ForRun(ctx, wsID, ulid.Make())
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
+
+func TestWaitsResolve(t *testing.T) {
+	ctx := context.Background()
+	wsID := uuid.New()
+	runID := ulid.Make()
+
+	t.Run("waitForEvent", func(t *testing.T) {
+		evt := "api/user.created"
+		p := &state.Pause{
+			ID:          uuid.New(),
+			WorkspaceID: wsID,
+			Identifier:  state.PauseIdentifier{RunID: runID},
+			Opcode:      opcode(enums.OpcodeWaitForEvent),
+			StepName:    "wait",
+			Event:       &evt,
+		}
+		w, r := newWaitsTest(p)
+
+		_, err := w.Resolve(ctx, wsID, runID, p.ID, json.RawMessage(`{"id":1}`))
+		require.NoError(t, err)
+		require.Len(t, r.resumed, 1)
+
+		req := r.resumed[0]
+		require.Equal(t, evt, req.EventName)
+		require.NotNil(t, req.EventID)
+		require.Equal(t, req.EventID.String(), req.IdempotencyKey)
+
+		with, ok := req.With.(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, evt, with["name"])
+		require.Equal(t, map[string]any{"id": float64(1)}, with["data"])
+
+		_, err = w.Resolve(ctx, wsID, runID, p.ID, json.RawMessage(`[1]`))
+		require.ErrorIs(t, err, ErrInvalidWaitData)
+	})
+
+	t.Run("waitForSignal", func(t *testing.T) {
+		signal := "approval"
+		p := &state.Pause{
+			ID:          uuid.New(),
+			WorkspaceID: wsID,
+			Identifier:  state.PauseIdentifier{RunID: runID},
+			Opcode:      opcode(enums.OpcodeWaitForSignal),
+			SignalID:    &signal,
+		}
+		w, r := newWaitsTest(p)
+
+		_, err := w.Resolve(ctx, wsID, runID, p.ID, json.RawMessage(`"yes"`))
+		require.NoError(t, err)
+		require.Len(t, r.resumed, 1)
+		require.Equal(t, map[string]any{
+			execution.StateDataKey: state.SignalStepReturn{
+				Signal: signal,
+				Data:   json.RawMessage(`"yes"`),
+			},
+		}, r.resumed[0].With)
+	})
+
+	t.Run("invoke cannot be resolved", func(t *testing.T) {
+		p := &state.Pause{
+			ID:          uuid.New(),
+			WorkspaceID: wsID,
+			Identifier:  state.PauseIdentifier{RunID: runID},
+			Opcode:      opcode(enums.OpcodeInvokeFunction),
+		}
+		w, r := newWaitsTest(p)
+
+		_, err := w.Resolve(ctx, wsID, runID, p.ID, nil)
+		require.ErrorIs(t, err, ErrWaitNotResolvable)
+		require.Empty(t, r.resumed)
+	})
+
+	t.Run("other run", func(t *testing.T) {
+		p := &state.Pause{
+			ID:          uuid.New(),
+			WorkspaceID: wsID,
+			Identifier:  state.PauseIdentifier{RunID: ulid.Make()},
+			Opcode:      opcode(enums.OpcodeWaitForSignal),
+		}
+		w, _ := newWaitsTest(p)
+
+		_, err := w.Resolve(ctx, wsID, runID, p.ID, nil)
+		require.ErrorIs(t, err, ErrWaitNotFound)
+	})
+
+	t.Run("already resumed", func(t *testing.T) {
+		signal := "approval"
+		p := &state.Pause{
+			ID:          uuid.New(),
+			WorkspaceID: wsID,
+			Identifier:  state.PauseIdentifier{RunID: runID},
+			Opcode:      opcode(enums.OpcodeWaitForSignal),
+			SignalID:    &signal,
+		}
+		w, r := newWaitsTest(p)
+		r.err = state.ErrPauseLeased
+
+		_, err := w.Resolve(ctx, wsID, runID, p.ID, nil)
+		require.ErrorIs(t, err, ErrWaitNotFound)
+	})
+}
+
+func TestWaitsTimeout(t *testing.T) {
+	ctx := context.Background()
+	wsID := uuid.New()
+	runID := ulid.Make()
+
+	p := &state.Pause{
+		ID:          uuid.New(),
+		WorkspaceID: wsID,
+		Identifier:  state.PauseIdentifier{RunID: runID},
+		Opcode:      opcode(enums.OpcodeWaitForEvent),
+	}
+	w, r := newWaitsTest(p)
+
+	_, err := w.Timeout(ctx, wsID, runID, p.ID)
+	require.NoError(t, err)
+	require.Len(t, r.timedOut, 1)
+	require.True(t, r.timedOut[0].IsTimeout)
+	require.Equal(t, p.ID.String(), r.timedOut[0].IdempotencyKey)
+}
@@ -38,6 +38,11 @@ type BacklogConcurrencyKey struct {
 
 	// ConcurrencyMode represents the concurrency mode.
 	ConcurrencyMode enums.ConcurrencyMode `json:"mode"`
+
+	// Weight is the fairness weight of the key, if the function configures concurrency fairness
+	// with a weight expression.  As backlog metadata is only written when the backlog is created,
+	// this is the weight evaluated for the run that created the backlog.
+	Weight int `json:"w,omitempty"`
 }
 
 type BacklogThrottle struct {
@@ -243,6 +248,8 @@ func ItemBacklog(ctx context.Context, i QueueItem) QueueBacklog {
 
 				// Just for debugging purposes (only passed on Enqueue after Schedule or backlog normalization)
 				UnhashedValue: key.UnhashedEvaluatedKeyValue, // "customer1"
+
+				Weight: key.Weight,
 			}
 		}
 	}
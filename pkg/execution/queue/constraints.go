@@ -67,6 +67,9 @@ type PartitionConcurrency struct {
 	// Up to two custom concurrency keys on user-defined scopes, optionally specifying a key. The key is required
 	// on env or account level scopes.
 	CustomConcurrencyKeys []CustomConcurrencyLimit `json:"cck,omitempty"`
+
+	// Fairness configures fair refills across custom concurrency key backlogs, if set.
+	Fairness *PartitionFairness `json:"fair,omitempty"`
 }
 
 type BacklogRefillConstraintCheckResult struct {
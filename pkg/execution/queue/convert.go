@@ -439,6 +439,7 @@ func CustomConcurrencySliceToProto(keys []state.CustomConcurrency) []*pb.CustomC
 			Hash:                      key.Hash,
 			Limit:                     int64(key.Limit),
 			UnhashedEvaluatedKeyValue: key.UnhashedEvaluatedKeyValue,
+			Weight:                    int64(key.Weight),
 		}
 	}
 	return result
@@ -455,6 +456,7 @@ func CustomConcurrencySliceFromProto(keys []*pb.CustomConcurrency) []state.Custo
 			Hash:                      key.GetHash(),
 			Limit:                     int(key.GetLimit()),
 			UnhashedEvaluatedKeyValue: key.GetUnhashedEvaluatedKeyValue(),
+			Weight:                    int(key.GetWeight()),
 		}
 	}
 	return result
@@ -517,6 +519,38 @@ func EnqueueOptionsFromProto(msg *pb.EnqueueOptions) EnqueueOpts {
 	}
 }
 
+func RequeueOptionsToProto(opts RequeueOptions) *pb.RequeueOptions {
+	return &pb.RequeueOptions{
+		RequireUnleased: opts.RequireUnleased,
+	}
+}
+
+func RequeueOptionsFromProto(msg *pb.RequeueOptions) RequeueOptions {
+	if msg == nil {
+		return RequeueOptions{}
+	}
+	return RequeueOptions{
+		RequireUnleased: msg.GetRequireUnleased(),
+	}
+}
+
+func DequeueOptionsToProto(opts DequeueOptions) *pb.DequeueOptions {
+	return &pb.DequeueOptions{
+		RequireUnleased:    opts.RequireUnleased,
+		DisableIdempotency: opts.DisableIdempotency,
+	}
+}
+
+func DequeueOptionsFromProto(msg *pb.DequeueOptions) DequeueOptions {
+	if msg == nil {
+		return DequeueOptions{}
+	}
+	return DequeueOptions{
+		RequireUnleased:    msg.GetRequireUnleased(),
+		DisableIdempotency: msg.GetDisableIdempotency(),
+	}
+}
+
 func jsonBytes(v any) ([]byte, error) {
 	if v == nil {
 		return nil, nil
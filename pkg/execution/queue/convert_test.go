@@ -857,7 +857,7 @@ func TestLeafProtoRoundTrips(t *testing.T) {
 	require.ErrorContains(t, err, "singleton mode")
 
 	keys := []state.CustomConcurrency{
-		{Key: "key", Hash: "hash", Limit: 1, UnhashedEvaluatedKeyValue: "customer-1"},
+		{Key: "key", Hash: "hash", Limit: 1, UnhashedEvaluatedKeyValue: "customer-1", Weight: 4},
 	}
 	require.Equal(t, keys, CustomConcurrencySliceFromProto(CustomConcurrencySliceToProto(keys)))
 	require.Nil(t, CustomConcurrencySliceToProto(nil))
@@ -1137,6 +1137,7 @@ func TestProtoConversionFieldCoverage(t *testing.T) {
 			"Hash",
 			"Limit",
 			"UnhashedEvaluatedKeyValue",
+			"Weight",
 		},
 	})
 
@@ -1159,16 +1160,19 @@ func TestProtoConversionFieldCoverage(t *testing.T) {
 	})
 }
 
-// TestQueueProxyVariadicOptionCompatibilityGuard documents that queue-proxy
-// does not serialize Requeue or Dequeue variadic options. This is safe while
-// the option structs are empty; if fields are added, the proxy contract must
-// carry those options explicitly.
+// TestQueueProxyVariadicOptionCompatibilityGuard guards Requeue and Dequeue
+// option drift between the Go interface and the queue-proxy request schema.
 func TestQueueProxyVariadicOptionCompatibilityGuard(t *testing.T) {
-	require.Equal(t, 0, reflect.TypeOf(RequeueOptions{}).NumField())
-	require.Equal(t, 0, reflect.TypeOf(DequeueOptions{}).NumField())
+	assertCoveredFields(t, reflect.TypeOf(RequeueOptions{}), fieldCoverage{covered: []string{"RequireUnleased"}})
+	assertCoveredFields(t, reflect.TypeOf(DequeueOptions{}), fieldCoverage{covered: []string{"RequireUnleased", "DisableIdempotency"}})
+
+	requeue := RequeueOptions{RequireUnleased: true}
+	require.Equal(t, requeue, RequeueOptionsFromProto(RequeueOptionsToProto(requeue)))
+	require.Equal(t, RequeueOptions{}, RequeueOptionsFromProto(nil))
 
-	assertCoveredFields(t, reflect.TypeOf(RequeueOptions{}), fieldCoverage{covered: []string{}})
-	assertCoveredFields(t, reflect.TypeOf(DequeueOptions{}), fieldCoverage{covered: []string{}})
+	dequeue := DequeueOptions{RequireUnleased: true, DisableIdempotency: true}
+	require.Equal(t, dequeue, DequeueOptionsFromProto(DequeueOptionsToProto(dequeue)))
+	require.Equal(t, DequeueOptions{}, DequeueOptionsFromProto(nil))
 }
 
 func assertItemEqual(t *testing.T, expected, actual Item) {
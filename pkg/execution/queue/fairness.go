@@ -0,0 +1,184 @@
+package queue
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/inngest"
+	"gonum.org/v1/gonum/stat/sampleuv"
+)
+
+const (
+	// fairnessMaxRounds caps how many rounds of unused credit a backlog can
+	// accumulate in deficit round-robin mode, bounding the burst a starved
+	// backlog may refill once capacity frees up.
+	fairnessMaxRounds = 4
+
+	// fairnessDeficitTTL is how long deficits are kept for shadow partitions
+	// that are no longer processed by this worker.
+	fairnessDeficitTTL = 10 * time.Minute
+)
+
+// PartitionFairness configures fair backlog refills across the custom
+// concurrency keys of a shadow partition.
+type PartitionFairness struct {
+	Mode inngest.ConcurrencyFairnessMode `json:"m"`
+
+	// Quantum is the number of items refilled per round for a backlog with
+	// weight 1.
+	Quantum int `json:"q,omitempty"`
+}
+
+func (f PartitionFairness) quantum() int64 {
+	if f.Quantum <= 0 {
+		return consts.DefaultConcurrencyFairnessQuantum
+	}
+	return int64(f.Quantum)
+}
+
+// FairnessWeight returns the weight of the backlog for fair refills.  Backlogs
+// without concurrency keys, or with keys enqueued without a weight, have a
+// weight of 1.
+func (b QueueBacklog) FairnessWeight() int {
+	weight := 1
+	for _, key := range b.ConcurrencyKeys {
+		weight = max(weight, key.Weight)
+	}
+	return min(weight, consts.MaxConcurrencyFairnessWeight)
+}
+
+// fairBacklog is a backlog along with the maximum number of items to refill
+// from it in the current pass.
+type fairBacklog struct {
+	backlog *QueueBacklog
+	limit   int64
+}
+
+// fairBacklogs orders backlogs for refilling and limits each backlog's refill
+// to its fair share.  As with ShuffleBacklogs, backlogs for existing runs are
+// prioritized over start backlogs.
+func (q *queueProcessor) fairBacklogs(partitionID string, backlogs []*QueueBacklog, f PartitionFairness) []fairBacklog {
+	if len(backlogs) == 0 {
+		return nil
+	}
+
+	quantum := f.quantum()
+
+	if f.Mode == inngest.ConcurrencyFairnessDeficitRoundRobin {
+		credits := q.fairness.credit(q.Clock().Now(), partitionID, backlogs, quantum)
+
+		// Visit the backlogs owed the most rounds first, ie. the highest credit
+		// relative to their weight.  Shuffle first so that backlogs owed the
+		// same number of rounds are visited in a random order.
+		shuffled := ShuffleBacklogs(backlogs)
+		sort.SliceStable(shuffled, func(i, j int) bool {
+			a, b := shuffled[i], shuffled[j]
+			if a.Start != b.Start {
+				return !a.Start
+			}
+			return credits[a.BacklogID]*int64(b.FairnessWeight()) > credits[b.BacklogID]*int64(a.FairnessWeight())
+		})
+
+		result := make([]fairBacklog, len(shuffled))
+		for n, b := range shuffled {
+			result[n] = fairBacklog{backlog: b, limit: credits[b.BacklogID]}
+		}
+		return result
+	}
+
+	// Weighted round-robin: visit backlogs in a random order weighted by their
+	// fairness weight, refilling at most one weighted quantum each.
+	weights := make([]float64, len(backlogs))
+	for i, b := range backlogs {
+		weights[i] = float64(b.FairnessWeight())
+		if !b.Start {
+			weights[i] *= 10
+		}
+	}
+
+	w := sampleuv.NewWeighted(weights, rnd)
+	result := make([]fairBacklog, 0, len(backlogs))
+	for range backlogs {
+		idx, ok := w.Take()
+		if !ok {
+			break
+		}
+		b := backlogs[idx]
+		result = append(result, fairBacklog{backlog: b, limit: quantum * int64(b.FairnessWeight())})
+	}
+	return result
+}
+
+// backlogDeficits tracks deficit round-robin credit for backlogs of shadow
+// partitions processed by this worker.  Shadow partitions are leased by a
+// single worker at a time, so credit is kept in memory; credit is lost if the
+// shadow partition is processed by another worker, which only affects fairness
+// briefly.
+//
+// The zero value is ready to use.
+type backlogDeficits struct {
+	mu         sync.Mutex
+	partitions map[string]*partitionDeficits
+	lastSweep  time.Time
+}
+
+type partitionDeficits struct {
+	deficits map[string]int64
+	lastSeen time.Time
+}
+
+// credit adds one round of credit to each backlog and returns the credit
+// available to each backlog.
+func (d *backlogDeficits) credit(now time.Time, partitionID string, backlogs []*QueueBacklog, quantum int64) map[string]int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.partitions == nil {
+		d.partitions = map[string]*partitionDeficits{}
+	}
+
+	if now.Sub(d.lastSweep) > fairnessDeficitTTL {
+		for id, p := range d.partitions {
+			if now.Sub(p.lastSeen) > fairnessDeficitTTL {
+				delete(d.partitions, id)
+			}
+		}
+		d.lastSweep = now
+	}
+
+	p, ok := d.partitions[partitionID]
+	if !ok {
+		p = &partitionDeficits{deficits: map[string]int64{}}
+		d.partitions[partitionID] = p
+	}
+	p.lastSeen = now
+
+	result := make(map[string]int64, len(backlogs))
+	for _, b := range backlogs {
+		share := quantum * int64(b.FairnessWeight())
+		credit := min(p.deficits[b.BacklogID]+share, share*fairnessMaxRounds)
+		p.deficits[b.BacklogID] = credit
+		result[b.BacklogID] = credit
+	}
+	return result
+}
+
+// charge deducts refilled items from the backlog's credit.  Backlogs that were
+// fully refilled lose their remaining credit, as in deficit round-robin empty
+// queues do not accumulate credit.
+func (d *backlogDeficits) charge(partitionID, backlogID string, refilled int, empty bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	p, ok := d.partitions[partitionID]
+	if !ok {
+		return
+	}
+	if empty {
+		delete(p.deficits, backlogID)
+		return
+	}
+	p.deficits[backlogID] = max(p.deficits[backlogID]-int64(refilled), 0)
+}
@@ -0,0 +1,235 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/constraintapi"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/inngest/inngest/pkg/util/errs"
+	"github.com/jonboulle/clockwork"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func fairnessTestBacklog(id string, weight int) *QueueBacklog {
+	return &QueueBacklog{
+		BacklogID: id,
+		Start:     true,
+		ConcurrencyKeys: []BacklogConcurrencyKey{
+			{CanonicalKeyID: id, Weight: weight},
+		},
+	}
+}
+
+func TestBacklogFairnessWeight(t *testing.T) {
+	require.Equal(t, 1, QueueBacklog{}.FairnessWeight())
+	require.Equal(t, 1, fairnessTestBacklog("a", 0).FairnessWeight())
+	require.Equal(t, 3, fairnessTestBacklog("a", 3).FairnessWeight())
+	require.Equal(t, consts.MaxConcurrencyFairnessWeight, fairnessTestBacklog("a", 1_000).FairnessWeight())
+}
+
+func TestFairBacklogs(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	opts := NewQueueOptions()
+	opts.Clock = clock
+
+	t.Run("weighted round-robin limits each backlog to its weighted quantum", func(t *testing.T) {
+		q := &queueProcessor{QueueOptions: opts}
+
+		noisy, quiet := fairnessTestBacklog("noisy", 1), fairnessTestBacklog("quiet", 3)
+		fair := q.fairBacklogs("p", []*QueueBacklog{noisy, quiet}, PartitionFairness{
+			Mode:    inngest.ConcurrencyFairnessWeightedRoundRobin,
+			Quantum: 5,
+		})
+
+		require.Len(t, fair, 2)
+		limits := map[string]int64{}
+		for _, fb := range fair {
+			limits[fb.backlog.BacklogID] = fb.limit
+		}
+		require.Equal(t, map[string]int64{"noisy": 5, "quiet": 15}, limits)
+	})
+
+	t.Run("weighted round-robin prioritizes non-start backlogs", func(t *testing.T) {
+		q := &queueProcessor{QueueOptions: opts}
+
+		steps := fairnessTestBacklog("steps", 1)
+		steps.Start = false
+
+		// Start backlogs have a 1 in 11 chance of being picked first.
+		firstSteps := 0
+		for range 200 {
+			fair := q.fairBacklogs("p", []*QueueBacklog{fairnessTestBacklog("start", 1), steps}, PartitionFairness{
+				Mode: inngest.ConcurrencyFairnessWeightedRoundRobin,
+			})
+			if fair[0].backlog.BacklogID == "steps" {
+				firstSteps++
+			}
+		}
+		require.Greater(t, firstSteps, 150)
+	})
+
+	t.Run("deficit round-robin carries credit to starved backlogs", func(t *testing.T) {
+		q := &queueProcessor{QueueOptions: opts}
+		f := PartitionFairness{Mode: inngest.ConcurrencyFairnessDeficitRoundRobin, Quantum: 10}
+
+		a, b := fairnessTestBacklog("a", 1), fairnessTestBacklog("b", 2)
+
+		// Round 1: both backlogs receive one weighted quantum of credit.
+		fair := q.fairBacklogs("p", []*QueueBacklog{a, b}, f)
+		limits := map[string]int64{}
+		for _, fb := range fair {
+			limits[fb.backlog.BacklogID] = fb.limit
+		}
+		require.Equal(t, map[string]int64{"a": 10, "b": 20}, limits)
+
+		// b refills its full credit and the function hits its limit before a is refilled.
+		q.fairness.charge("p", "b", 20, false)
+
+		// Round 2: a has been owed credit for two rounds and is refilled first.
+		fair = q.fairBacklogs("p", []*QueueBacklog{a, b}, f)
+		require.Equal(t, "a", fair[0].backlog.BacklogID)
+		require.EqualValues(t, 20, fair[0].limit)
+		require.EqualValues(t, 20, fair[1].limit)
+	})
+
+	t.Run("deficit round-robin caps credit and resets empty backlogs", func(t *testing.T) {
+		q := &queueProcessor{QueueOptions: opts}
+		f := PartitionFairness{Mode: inngest.ConcurrencyFairnessDeficitRoundRobin, Quantum: 10}
+
+		a := fairnessTestBacklog("a", 1)
+		var fair []fairBacklog
+		for range fairnessMaxRounds + 3 {
+			fair = q.fairBacklogs("p", []*QueueBacklog{a}, f)
+		}
+		require.EqualValues(t, 10*fairnessMaxRounds, fair[0].limit)
+
+		q.fairness.charge("p", "a", 5, true)
+		fair = q.fairBacklogs("p", []*QueueBacklog{a}, f)
+		require.EqualValues(t, 10, fair[0].limit)
+	})
+
+	t.Run("deficits for idle partitions are removed", func(t *testing.T) {
+		q := &queueProcessor{QueueOptions: opts}
+		f := PartitionFairness{Mode: inngest.ConcurrencyFairnessDeficitRoundRobin}
+
+		q.fairBacklogs("idle", []*QueueBacklog{fairnessTestBacklog("a", 1)}, f)
+		clock.Advance(fairnessDeficitTTL + time.Minute)
+		q.fairBacklogs("active", []*QueueBacklog{fairnessTestBacklog("a", 1)}, f)
+
+		require.NotContains(t, q.fairness.partitions, "idle")
+		require.Contains(t, q.fairness.partitions, "active")
+	})
+}
+
+// fairnessShard serves backlogs of start items from memory.  Refilling items
+// removes them from their backlog.
+type fairnessShard struct {
+	*mockShardForIterator
+	backlogs []*QueueBacklog
+	items    map[string][]*QueueItem
+	refilled map[string]int
+}
+
+func (s *fairnessShard) ShadowPartitionPeek(ctx context.Context, sp *QueueShadowPartition, sequential bool, until time.Time, limit int64, opts ...PeekOpt) ([]*QueueBacklog, int, error) {
+	return s.backlogs, len(s.backlogs), nil
+}
+
+func (s *fairnessShard) BacklogPeek(ctx context.Context, b *QueueBacklog, from time.Time, until time.Time, limit int64, opts ...PeekOpt) (*BacklogPeekResult, error) {
+	items := s.items[b.BacklogID]
+	return &BacklogPeekResult{Items: items[:min(int(limit), len(items))], TotalCount: len(items)}, nil
+}
+
+func (s *fairnessShard) BacklogRefill(ctx context.Context, b *QueueBacklog, sp *QueueShadowPartition, refillUntil time.Time, refillItems []string, options ...BacklogRefillOptionFn) (*BacklogRefillResult, error) {
+	total := len(s.items[b.BacklogID])
+	s.items[b.BacklogID] = s.items[b.BacklogID][len(refillItems):]
+	s.refilled[b.BacklogID] += len(refillItems)
+	return &BacklogRefillResult{TotalBacklogCount: total, BacklogCountUntil: total, RefilledItems: refillItems}, nil
+}
+
+// fairnessCapacityManager grants capacity to every item, unless the item's
+// backlog is full.
+type fairnessCapacityManager struct {
+	constraintapi.CapacityManager
+	full map[string]bool
+}
+
+func (m *fairnessCapacityManager) Acquire(ctx context.Context, req *constraintapi.CapacityAcquireRequest) (*constraintapi.CapacityAcquireResponse, errs.InternalError) {
+	res := &constraintapi.CapacityAcquireResponse{}
+	for _, key := range req.LeaseIdempotencyKeys {
+		if m.full[strings.SplitN(key, "-", 2)[0]] {
+			continue
+		}
+		res.Leases = append(res.Leases, constraintapi.CapacityLease{LeaseID: ulid.Make(), IdempotencyKey: key})
+	}
+	return res, nil
+}
+
+func TestFairnessKeepsCreditWhileAtCapacity(t *testing.T) {
+	ctx := context.Background()
+	accountID, envID, fnID := uuid.New(), uuid.New(), uuid.New()
+
+	shard := &fairnessShard{
+		mockShardForIterator: &mockShardForIterator{name: "test"},
+		backlogs:             []*QueueBacklog{fairnessTestBacklog("noisy", 1), fairnessTestBacklog("quiet", 3)},
+		items:                map[string][]*QueueItem{},
+		refilled:             map[string]int{},
+	}
+	for _, b := range shard.backlogs {
+		for i := range 30 {
+			shard.items[b.BacklogID] = append(shard.items[b.BacklogID], &QueueItem{
+				ID:         fmt.Sprintf("%s-%d", b.BacklogID, i),
+				FunctionID: fnID,
+				Data:       Item{Identifier: state.Identifier{RunID: ulid.Make()}},
+			})
+		}
+	}
+
+	cm := &fairnessCapacityManager{full: map[string]bool{"quiet": true}}
+	registry, err := NewSingleShardRegistry(shard)
+	require.NoError(t, err)
+	q, err := New(ctx, "test", registry,
+		WithCapacityManager(cm),
+		WithAcquireCapacityLeaseOnBacklogRefill(true),
+		WithAllowKeyQueues(func(ctx context.Context, acctID, envID, fnID uuid.UUID) bool {
+			return true
+		}),
+		WithPartitionConstraintConfigGetter(func(ctx context.Context, p PartitionIdentifier) PartitionConstraintConfig {
+			return PartitionConstraintConfig{
+				FunctionVersion: 1,
+				Concurrency: PartitionConcurrency{
+					AccountConcurrency:    1000,
+					FunctionConcurrency:   1000,
+					CustomConcurrencyKeys: []CustomConcurrencyLimit{{Limit: 1000}},
+					Fairness:              &PartitionFairness{Mode: inngest.ConcurrencyFairnessDeficitRoundRobin, Quantum: 2},
+				},
+			}
+		}),
+	)
+	require.NoError(t, err)
+
+	sp := &QueueShadowPartition{
+		PartitionID: fnID.String(),
+		AccountID:   &accountID,
+		EnvID:       &envID,
+		FunctionID:  &fnID,
+	}
+
+	// The heavier quiet key is at capacity, so nothing is refilled from it
+	// although items are waiting.
+	require.NoError(t, q.ProcessShadowPartition(ctx, sp, 0))
+	require.Equal(t, map[string]int{"noisy": 2}, shard.refilled)
+
+	// Once capacity frees up, the quiet key refills the credit it was owed
+	// for both passes.
+	cm.full = nil
+	require.NoError(t, q.ProcessShadowPartition(ctx, sp, 0))
+	require.Equal(t, map[string]int{"noisy": 4, "quiet": 12}, shard.refilled)
+}
@@ -14,12 +14,45 @@ import (
 
 type DequeueOptionFn func(o *DequeueOptions)
 
-type DequeueOptions struct{}
+type DequeueOptions struct {
+	// RequireUnleased refuses to dequeue an item which is currently leased,
+	// returning ErrQueueItemAlreadyLeased.
+	RequireUnleased bool
+	// DisableIdempotency skips recording the item's idempotency key, allowing
+	// the same item to be enqueued to this shard again immediately.
+	DisableIdempotency bool
+}
 
-type RequeueOptions struct{}
+// DequeueRequireUnleased only dequeues the item if no worker holds a lease on it.
+func DequeueRequireUnleased() DequeueOptionFn {
+	return func(o *DequeueOptions) {
+		o.RequireUnleased = true
+	}
+}
+
+// DequeueDisableIdempotency removes the item without recording its idempotency
+// key.  This is used when moving items between shards.
+func DequeueDisableIdempotency() DequeueOptionFn {
+	return func(o *DequeueOptions) {
+		o.DisableIdempotency = true
+	}
+}
+
+type RequeueOptions struct {
+	// RequireUnleased refuses to requeue an item which is currently leased,
+	// returning ErrQueueItemAlreadyLeased.
+	RequireUnleased bool
+}
 
 type RequeueOptionFn func(o *RequeueOptions)
 
+// RequeueRequireUnleased only requeues the item if no worker holds a lease on it.
+func RequeueRequireUnleased() RequeueOptionFn {
+	return func(o *RequeueOptions) {
+		o.RequireUnleased = true
+	}
+}
+
 type LeaseOptions struct {
 	Backlog         QueueBacklog
 	ShadowPartition QueueShadowPartition
@@ -282,6 +315,10 @@ type InsightsOperations interface {
 	// Total queue depth of all partitions including backlog and ready state items
 	TotalSystemQueueDepth(ctx context.Context) (int64, error)
 
+	// FunctionQueueStats returns queue statistics for each function with queued
+	// or leased items.
+	FunctionQueueStats(ctx context.Context) ([]FunctionQueueStats, error)
+
 	OutstandingJobCount(ctx context.Context, scope Scope, runID ulid.ULID) (int, error)
 	RunningCount(ctx context.Context, scope Scope) (int64, error)
 	StatusCount(ctx context.Context, scope Scope, status string) (int64, error)
@@ -328,6 +365,21 @@ type ShardOperations interface {
 
 	IsMigrationLocked(ctx context.Context, scope Scope) (*time.Time, error)
 
+	// ShardRoute returns the name of the shard that the scope's queue items are
+	// routed to, or an empty string if the scope is not routed.  Function routes
+	// take precedence over account routes.
+	ShardRoute(ctx context.Context, scope Scope) (string, error)
+	// SetShardRoute routes the scope's queue items to the given shard.  Scopes
+	// with a function ID set a function route, otherwise an account route is set.
+	// An empty shard name removes the route.
+	SetShardRoute(ctx context.Context, scope Scope, shardName string) error
+	// SaveShardMigration stores the state of a shard migration moving items
+	// off this shard, replacing any previously stored state.  Finished
+	// migrations are kept for ShardMigrationRetention.
+	SaveShardMigration(ctx context.Context, m ShardMigration) error
+	// ShardMigrations returns the shard migrations stored on this shard.
+	ShardMigrations(ctx context.Context) ([]ShardMigration, error)
+
 	ItemExists(ctx context.Context, scope Scope, jobID string) (bool, error)
 	ItemsByRunID(ctx context.Context, scope Scope, runID ulid.ULID) ([]*QueueItem, error)
 	PartitionByID(ctx context.Context, scope Scope, partitionID string) (*PartitionInspectionResult, error)
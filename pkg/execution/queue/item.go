@@ -164,7 +164,7 @@ func (q *QueueItem) SetID(ctx context.Context, str string) {
 // IsPromotableScore returns whether a score can be fudged.
 func (q QueueItem) IsPromotableScore() bool {
 	switch q.Data.Kind {
-	case KindStart, KindSleep, KindEdge, KindPause, KindEdgeError:
+	case KindStart, KindSleep, KindLock, KindEdge, KindPause, KindEdgeError:
 		// All user jobs can be fudged.
 		return true
 	}
@@ -482,7 +482,7 @@ func (i Item) GetPriorityFactor() int64 {
 
 // IsStepKind determines if the item is considered a step
 func (i Item) IsStepKind() bool {
-	return i.Kind == KindStart || i.Kind == KindEdge || i.Kind == KindSleep || i.Kind == KindEdgeError
+	return i.Kind == KindStart || i.Kind == KindEdge || i.Kind == KindSleep || i.Kind == KindLock || i.Kind == KindEdgeError
 }
 
 func (i *Item) UnmarshalJSON(b []byte) error {
@@ -542,10 +542,11 @@ func decodePayloadForKind(kind string, payload json.RawMessage) (any, error) {
 	}
 
 	switch kind {
-	case KindStart, KindEdge, KindSleep, KindEdgeError:
+	case KindStart, KindEdge, KindSleep, KindLock, KindEdgeError:
 		// Edge and Sleep are the same;  the only difference is that the executor
 		// runner should always save nil to the state store using the outgoing edge's
 		// ID when processing a sleep so that the state + stack are updated properly.
+		// Locks are the same as sleeps, once the lock has been acquired.
 		p := &PayloadEdge{}
 		if err := json.Unmarshal(payload, p); err != nil {
 			return nil, err
@@ -681,13 +682,21 @@ func GetThrottleConfig(ctx context.Context, fnID uuid.UUID, throttle *inngest.Th
 	}
 }
 
-func GetCustomConcurrencyKeys(ctx context.Context, id sv2.ID, customConcurrency []inngest.StepConcurrency, evtMap map[string]any) []state.CustomConcurrency {
+// GetCustomConcurrencyKeys evaluates the custom concurrency keys for a run.  If
+// fairness is configured with a weight expression, the evaluated weight is
+// stored on each key.
+func GetCustomConcurrencyKeys(ctx context.Context, id sv2.ID, customConcurrency []inngest.StepConcurrency, fairness *inngest.ConcurrencyFairness, evtMap map[string]any) []state.CustomConcurrency {
 	if len(customConcurrency) == 0 {
 		return nil
 	}
 
 	var keys []state.CustomConcurrency
 
+	var weight int
+	if fairness != nil && fairness.Weight != nil {
+		weight = fairness.EvaluateWeight(ctx, evtMap)
+	}
+
 	// Ensure we evaluate concurrency keys when scheduling the function.
 	for _, limit := range customConcurrency {
 		if !limit.IsCustomLimit() {
@@ -719,6 +728,7 @@ func GetCustomConcurrencyKeys(ctx context.Context, id sv2.ID, customConcurrency
 				Hash:                      limit.Hash,
 				Limit:                     limit.Limit,
 				UnhashedEvaluatedKeyValue: evaluated,
+				Weight:                    weight,
 			},
 		)
 	}
@@ -6,6 +6,7 @@ const (
 	KindStart           = "start"
 	KindEdge            = "edge"
 	KindSleep           = "sleep"
+	KindLock            = "lock" // KindLock waits for a step lock to be granted before re-calling the SDK.
 	KindPause           = "pause"
 	KindDebounce        = "debounce"
 	KindScheduleBatch   = "schedule-batch"
@@ -254,6 +254,8 @@ func (q *queueProcessor) LeaseItem(ctx context.Context, req LeaseItemRequest, di
 
 	switch {
 	case errors.Is(cause, ErrQueueItemThrottled):
+		q.metricsRecorder.RecordConstrained(ctx, fnID, enums.QueueConstraintThrottle)
+
 		metrics.IncrQueueItemProcessedCounter(ctx, metrics.CounterOpt{
 			PkgName: pkgName,
 			Tags:    map[string]any{"status": "throttled", "queue_shard": q.Shard().Name(), "constraint_source": "constraintapi"},
@@ -293,11 +295,13 @@ func (q *queueProcessor) LeaseItem(ctx context.Context, req LeaseItemRequest, di
 			status = "system_concurrency_limit"
 		case errors.Is(cause, ErrPartitionConcurrencyLimit):
 			status = "partition_concurrency_limit"
+			q.metricsRecorder.RecordConstrained(ctx, fnID, enums.QueueConstraintFunctionConcurrency)
 			if fnID != uuid.Nil {
 				q.Options().lifecycles.OnFnConcurrencyLimitReached(context.WithoutCancel(ctx), fnID)
 			}
 		case errors.Is(cause, ErrAccountConcurrencyLimit):
 			status = "account_concurrency_limit"
+			q.metricsRecorder.RecordConstrained(ctx, fnID, enums.QueueConstraintAccountConcurrency)
 			// For backwards compatibility, we report on the function level as well.
 			if fnID != uuid.Nil {
 				q.Options().lifecycles.OnFnConcurrencyLimitReached(context.WithoutCancel(ctx), fnID)
@@ -336,6 +340,12 @@ func (q *queueProcessor) LeaseItem(ctx context.Context, req LeaseItemRequest, di
 
 		return LeaseItemResult{Status: LeaseItemStatusConcurrencyLimited, RetryAfter: limitedRetryAfter}, fmt.Errorf("concurrency hit: %w", ErrProcessNoUserConstraintCapacity)
 	case errors.Is(cause, ErrConcurrencyLimitCustomKey):
+		customKey := enums.QueueConstraintCustomConcurrencyKey1
+		if constraintRes.LimitingConstraint == enums.QueueConstraintCustomConcurrencyKey2 {
+			customKey = enums.QueueConstraintCustomConcurrencyKey2
+		}
+		q.metricsRecorder.RecordConstrained(ctx, fnID, customKey)
+
 		// For backwards compatibility, we report on the function level as well.
 		if fnID != uuid.Nil {
 			q.Options().lifecycles.OnFnConcurrencyLimitReached(context.WithoutCancel(ctx), fnID)
@@ -372,6 +382,7 @@ func (q *queueProcessor) LeaseItem(ctx context.Context, req LeaseItemRequest, di
 		// Semaphore capacity exhausted for this specific item (e.g., start job with fn concurrency).
 		// Skip this item and continue scanning; other items without semaphores (step 2, etc.)
 		// can still be processed.
+		q.metricsRecorder.RecordConstrained(ctx, fnID, enums.QueueConstraintSemaphore)
 		metrics.IncrQueueItemProcessedCounter(ctx, metrics.CounterOpt{
 			PkgName: pkgName,
 			Tags:    map[string]any{"status": "semaphore_limit", "queue_shard": q.Shard().Name(), "constraint_source": "constraintapi"},
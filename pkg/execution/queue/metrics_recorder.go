@@ -0,0 +1,47 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+)
+
+// MetricsRecorder records per-function queue metrics as items are processed,
+// eg. to expose them via Prometheus.  Implementations are called from the hot
+// path of the queue, so they must be safe for concurrent use and must not block.
+type MetricsRecorder interface {
+	// RecordDequeueLatency records how long an item waited between becoming
+	// available and being dequeued by a worker.
+	RecordDequeueLatency(ctx context.Context, fnID uuid.UUID, kind string, latency time.Duration)
+
+	// RecordConstrained records that an item or backlog of the function could
+	// not be processed as the given constraint was hit.
+	RecordConstrained(ctx context.Context, fnID uuid.UUID, constraint enums.QueueConstraint)
+}
+
+type noopMetricsRecorder struct{}
+
+func (noopMetricsRecorder) RecordDequeueLatency(context.Context, uuid.UUID, string, time.Duration) {}
+
+func (noopMetricsRecorder) RecordConstrained(context.Context, uuid.UUID, enums.QueueConstraint) {}
+
+// FunctionQueueStats summarizes the queue items of a single function.
+type FunctionQueueStats struct {
+	FunctionID uuid.UUID
+
+	// Ready is the number of unleased items that are due.
+	Ready int64
+	// Scheduled is the number of unleased items scheduled in the future.
+	Scheduled int64
+	// Backlog is the number of items in key queue backlogs waiting to be
+	// refilled, both due and scheduled in the future.
+	Backlog int64
+	// Leased is the number of items leased by workers.
+	Leased int64
+
+	// OldestDueAt is when the earliest due unleased item, including items in
+	// backlogs, became due.  It is zero if no items are due.
+	OldestDueAt time.Time
+}
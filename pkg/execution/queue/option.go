@@ -71,6 +71,16 @@ func WithQueueLifecycles(l ...QueueLifecycleListener) QueueOpt {
 	}
 }
 
+// WithMetricsRecorder sets the recorder used to record per-function queue
+// metrics while processing items.
+func WithMetricsRecorder(r MetricsRecorder) QueueOpt {
+	return func(q *QueueOptions) {
+		if r != nil {
+			q.metricsRecorder = r
+		}
+	}
+}
+
 func WithPartitionPriorityFinder(ppf PartitionPriorityFinder) QueueOpt {
 	return func(q *QueueOptions) {
 		q.PartitionPriorityFinder = ppf
@@ -437,6 +447,8 @@ type QueueOptions struct {
 
 	lifecycles QueueLifecycleListeners
 
+	metricsRecorder MetricsRecorder
+
 	AllowKeyQueues                  AllowKeyQueues
 	PartitionConstraintConfigGetter PartitionConstraintConfigGetter
 
@@ -868,6 +880,7 @@ func NewQueueOptions(
 		PartitionPausedGetter: func(ctx context.Context, fnID uuid.UUID) PartitionPausedInfo {
 			return PartitionPausedInfo{}
 		},
+		metricsRecorder:                 noopMetricsRecorder{},
 		PeekMin:                         DefaultQueuePeekMin,
 		PeekMax:                         DefaultQueuePeekMax,
 		PeekSizeExponent:                7,
@@ -431,6 +431,7 @@ func (q *queueProcessor) ProcessItem(
 				PkgName: pkgName,
 				Tags:    map[string]any{"kind": qi.Data.Kind, "queue_shard": shard.Name()},
 			})
+			q.metricsRecorder.RecordDequeueLatency(ctx, qi.FunctionID, qi.Data.Kind, latency)
 		}()
 
 		metrics.IncrQueueItemStatusCounter(ctx, metrics.CounterOpt{
@@ -196,6 +196,9 @@ type queueProcessor struct {
 	shadowContinues        map[string]ShadowContinuation
 	shadowContinueCooldown map[string]time.Time
 	shadowContinuesLock    *sync.Mutex
+
+	// fairness tracks deficit round-robin credit for concurrency key backlogs.
+	fairness backlogDeficits
 }
 
 func (q *queueProcessor) GetShadowContinuations() map[string]ShadowContinuation {
@@ -404,10 +404,30 @@ func (m *mockShardForIterator) IsMigrationLocked(ctx context.Context, scope Scop
 	return nil, nil
 }
 
+func (m *mockShardForIterator) ShardRoute(ctx context.Context, scope Scope) (string, error) {
+	return "", nil
+}
+
+func (m *mockShardForIterator) SetShardRoute(ctx context.Context, scope Scope, shardName string) error {
+	return nil
+}
+
+func (m *mockShardForIterator) SaveShardMigration(ctx context.Context, sm ShardMigration) error {
+	return nil
+}
+
+func (m *mockShardForIterator) ShardMigrations(ctx context.Context) ([]ShardMigration, error) {
+	return nil, nil
+}
+
 func (m *mockShardForIterator) TotalSystemQueueDepth(ctx context.Context) (int64, error) {
 	return 0, nil
 }
 
+func (m *mockShardForIterator) FunctionQueueStats(ctx context.Context) ([]FunctionQueueStats, error) {
+	return nil, nil
+}
+
 func (m *mockShardForIterator) DequeueByJobID(ctx context.Context, jobID string) error {
 	return nil
 }
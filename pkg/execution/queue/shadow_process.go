@@ -238,10 +238,25 @@ func (q *queueProcessor) ProcessShadowPartition(ctx context.Context, shadowPart
 		refilledItems  int  // Number of refilled items
 	)
 
-	// Always shuffle backlogs while prioritizing non-start backlogs.
-	// This is necessary to ensure we refill items to finish existing runs before
-	// refilling run starts.
-	backlogs = ShuffleBacklogs(backlogs)
+	// If fairness is configured across concurrency keys, order backlogs by their fair share
+	// and limit how many items each backlog may refill, so that one key with many items
+	// cannot use all of the function's capacity.
+	var fairLimits map[string]int64
+	if fairness := latestConstraints.Concurrency.Fairness; fairness != nil && keyQueuesEnabled && len(latestConstraints.Concurrency.CustomConcurrencyKeys) > 0 {
+		fair := q.fairBacklogs(shadowPart.PartitionID, backlogs, *fairness)
+
+		backlogs = make([]*QueueBacklog, len(fair))
+		fairLimits = make(map[string]int64, len(fair))
+		for i, fb := range fair {
+			backlogs[i] = fb.backlog
+			fairLimits[fb.backlog.BacklogID] = fb.limit
+		}
+	} else {
+		// Always shuffle backlogs while prioritizing non-start backlogs.
+		// This is necessary to ensure we refill items to finish existing runs before
+		// refilling run starts.
+		backlogs = ShuffleBacklogs(backlogs)
+	}
 
 	// If throttle is configured without custom concurrency keys, we have a mismatch:
 	// - Each start queue item is added to a dedicated backlog per key
@@ -276,18 +291,29 @@ func (q *queueProcessor) ProcessShadowPartition(ctx context.Context, shadowPart
 				return nil
 			}
 
-			res, limitingConstraint, err := q.ProcessShadowPartitionBacklog(logger.WithStdlib(ctx, l), shadowPart, backlog, refillUntil, latestConstraints)
+			res, limitingConstraint, err := q.processShadowPartitionBacklog(logger.WithStdlib(ctx, l), shadowPart, backlog, refillUntil, latestConstraints, fairLimits[backlog.BacklogID])
 			if err != nil {
 				return fmt.Errorf("could not process backlog: %w", err)
 			}
 
-			var fullyProcessed bool
+			var (
+				fullyProcessed bool
+				refilled       int
+			)
 			if res != nil {
-				refilled := len(res.RefilledItems)
+				refilled = len(res.RefilledItems)
 				refilledItems += refilled
 				fullyProcessed = (res.TotalBacklogCount - refilled) <= 0
 			}
 
+			if fairLimits != nil {
+				// Only reset the backlog's credit once nothing is left to
+				// refill.  A backlog limited by capacity reports its peeked
+				// size in BacklogCountUntil and keeps its credit.
+				empty := res == nil || max(res.TotalBacklogCount, res.BacklogCountUntil)-refilled <= 0
+				q.fairness.charge(shadowPart.PartitionID, backlog.BacklogID, refilled, empty)
+			}
+
 			// If we fully refilled, track and continue
 			if fullyProcessed {
 				fullyProcessedBacklogs++
@@ -403,6 +429,19 @@ func (q *queueProcessor) ProcessShadowPartitionBacklog(
 	backlog *QueueBacklog,
 	refillUntil time.Time,
 	constraints PartitionConstraintConfig,
+) (*BacklogRefillResult, enums.QueueConstraint, error) {
+	return q.processShadowPartitionBacklog(ctx, shadowPart, backlog, refillUntil, constraints, 0)
+}
+
+// processShadowPartitionBacklog refills items from the backlog.  If maxRefill is
+// positive, at most maxRefill items are refilled.
+func (q *queueProcessor) processShadowPartitionBacklog(
+	ctx context.Context,
+	shadowPart *QueueShadowPartition,
+	backlog *QueueBacklog,
+	refillUntil time.Time,
+	constraints PartitionConstraintConfig,
+	maxRefill int64,
 ) (*BacklogRefillResult, enums.QueueConstraint, error) {
 	l := logger.StdlibLogger(ctx).With(
 		"backlog_id", backlog.BacklogID,
@@ -466,6 +505,9 @@ func (q *queueProcessor) ProcessShadowPartitionBacklog(
 	if q.backlogRefillLimit > 0 && q.backlogRefillLimit < refillLimit {
 		refillLimit = q.backlogRefillLimit
 	}
+	if maxRefill > 0 && maxRefill < refillLimit {
+		refillLimit = maxRefill
+	}
 
 	// Peek items (scheduled to run within the next 2s) to be refilled.
 	//
@@ -585,6 +627,10 @@ func (q *queueProcessor) ProcessShadowPartitionBacklog(
 				},
 			})
 
+			if shadowPart.FunctionID != nil {
+				q.metricsRecorder.RecordConstrained(ctx, *shadowPart.FunctionID, constraintCheckRes.LimitingConstraint)
+			}
+
 			// Invoke previous constraint lifecycles to update UI
 			switch constraintCheckRes.LimitingConstraint {
 			case enums.QueueConstraintAccountConcurrency:
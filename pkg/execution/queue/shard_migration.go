@@ -0,0 +1,736 @@
+package queue
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// DefaultShardMigrationDrainTimeout is how long a migration waits for
+	// leased items on the source shard to be released before failing.
+	DefaultShardMigrationDrainTimeout = 10 * time.Minute
+
+	// ShardMigrationRetention is how long finished migrations are stored.
+	ShardMigrationRetention = 7 * 24 * time.Hour
+
+	shardMigrationPollInterval = time.Second
+	shardMigrationBatchSize    = 1_000
+
+	// shardMigrationAbandonedAfter is how long a stored migration may go
+	// without progress before it is considered abandoned.  Running
+	// migrations update their state after every drain pass.
+	shardMigrationAbandonedAfter = 10 * time.Minute
+)
+
+var (
+	ErrShardMigrationNotFound   = fmt.Errorf("shard migration not found")
+	ErrShardMigrationInProgress = fmt.Errorf("a shard migration is already running for this scope")
+	// ErrShardMigrationUnsupported is returned by preflight checks refusing
+	// to migrate a scope, eg. one using singletons, debounces or batches.
+	ErrShardMigrationUnsupported = fmt.Errorf("shard migration is not supported for this scope")
+)
+
+type ShardMigrationStatus string
+
+const (
+	ShardMigrationStatusRunning    ShardMigrationStatus = "running"
+	ShardMigrationStatusCompleted  ShardMigrationStatus = "completed"
+	ShardMigrationStatusFailed     ShardMigrationStatus = "failed"
+	ShardMigrationStatusCancelled  ShardMigrationStatus = "cancelled"
+	ShardMigrationStatusRolledBack ShardMigrationStatus = "rolled_back"
+)
+
+type ShardMigrationPhase string
+
+const (
+	// ShardMigrationPhaseRouting updates the scope's shard route so that new
+	// queue items are enqueued to the target shard.
+	ShardMigrationPhaseRouting ShardMigrationPhase = "routing"
+	// ShardMigrationPhaseDraining moves unleased items from the source shard
+	// to the target shard, waiting for leased items to be released.
+	ShardMigrationPhaseDraining ShardMigrationPhase = "draining"
+	// ShardMigrationPhaseDone is set once the migration has stopped.
+	ShardMigrationPhaseDone ShardMigrationPhase = "done"
+)
+
+// ShardMigrationRequest starts moving an account's or function's queue items
+// from one shard to another.
+type ShardMigrationRequest struct {
+	// Scope is the account, or function within an account, to migrate.
+	// AccountID is required.
+	Scope Scope
+	// SourceShard is the shard to move items from.  Defaults to the shard the
+	// scope currently resolves to.
+	SourceShard string
+	// TargetShard is the shard to move items to.
+	TargetShard string
+	// DrainTimeout defaults to DefaultShardMigrationDrainTimeout.
+	DrainTimeout time.Duration
+}
+
+// ShardMigration reports the progress of a shard migration.
+type ShardMigration struct {
+	ID          ulid.ULID
+	Scope       Scope
+	SourceShard string
+	TargetShard string
+	// DrainTimeout is how long the migration waits for leased items.
+	DrainTimeout time.Duration
+
+	Status ShardMigrationStatus
+	Phase  ShardMigrationPhase
+	Error  string
+
+	// RollbackOf is set for migrations started by RollbackShardMigration.
+	RollbackOf *ulid.ULID
+	// RolledBackBy is set once this migration has been rolled back.
+	RolledBackBy *ulid.ULID
+
+	// Functions is the number of functions found with items on the source
+	// shard.
+	Functions int
+	// Passes is the number of drain passes over the source shard.
+	Passes int
+	// ItemsMoved is the number of queue items moved to the target shard.
+	ItemsMoved int64
+	// ItemsLeased is the number of leased items skipped in the latest pass.
+	ItemsLeased int64
+	// InProgress is the number of leased items on the source shard at the
+	// end of the latest pass.
+	InProgress int64
+
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Done returns whether the migration has stopped.
+func (m ShardMigration) Done() bool {
+	return m.Status != ShardMigrationStatusRunning
+}
+
+// ShardMigrator moves queue items for an account or function between shards
+// while the queue keeps processing.  A migration:
+//
+//  1. routes the scope to the target shard, so that new items are enqueued
+//     there;
+//  2. sets the migration lock for each function on the source shard, pausing
+//     backlog refills;
+//  3. repeatedly moves unleased items from the source to the target, waiting
+//     for leased items to be released by their workers; and
+//  4. releases the migration locks once the source shard is empty.
+//
+// Rolling back runs the same migration in reverse and restores the route.
+//
+// Migration state is stored on the source shard, so that progress and
+// history survive restarts.  A running migration whose process stopped is
+// reported as failed once it has made no progress for ten minutes;  its
+// route stays pointing to the target and it can be rolled back.
+//
+// Only queue items are moved.  Singleton locks, debounces and batches are
+// stored separately and would be left behind on the source shard, so scopes
+// using them must be refused with a preflight check;  see
+// WithShardMigratorPreflight.
+type ShardMigrator interface {
+	StartShardMigration(ctx context.Context, req ShardMigrationRequest) (ShardMigration, error)
+	ShardMigration(ctx context.Context, id ulid.ULID) (ShardMigration, error)
+	ShardMigrations(ctx context.Context) ([]ShardMigration, error)
+	// CancelShardMigration stops a running migration.  Items already moved
+	// stay on the target shard and the route is left pointing to the target.
+	CancelShardMigration(ctx context.Context, id ulid.ULID) (ShardMigration, error)
+	// RollbackShardMigration starts a new migration moving the scope back to
+	// the original source shard.  Running migrations are cancelled first.
+	RollbackShardMigration(ctx context.Context, id ulid.ULID) (ShardMigration, error)
+}
+
+type ShardMigratorOpt func(m *shardMigrator)
+
+// WithShardMigratorPollInterval sets how often the source shard is checked
+// for remaining items while draining.
+func WithShardMigratorPollInterval(d time.Duration) ShardMigratorOpt {
+	return func(m *shardMigrator) {
+		m.pollInterval = d
+	}
+}
+
+// WithShardMigratorRouteCacheTTL sets how long migrations wait for producers
+// to observe a route change.  This must match the registry's route cache TTL.
+func WithShardMigratorRouteCacheTTL(d time.Duration) ShardMigratorOpt {
+	return func(m *shardMigrator) {
+		m.routeTTL = d
+	}
+}
+
+// WithShardMigratorPreflight sets a check run before each migration starts.
+// Returning an error, typically wrapping ErrShardMigrationUnsupported,
+// refuses the migration.
+func WithShardMigratorPreflight(fn func(ctx context.Context, scope Scope) error) ShardMigratorOpt {
+	return func(m *shardMigrator) {
+		m.preflight = fn
+	}
+}
+
+func NewShardMigrator(shards ShardRegistry, opts ...ShardMigratorOpt) ShardMigrator {
+	m := &shardMigrator{
+		shards:       shards,
+		pollInterval: shardMigrationPollInterval,
+		routeTTL:     ShardRouteCacheTTL,
+		migrations:   map[ulid.ULID]*shardMigrationRun{},
+	}
+	for _, apply := range opts {
+		apply(m)
+	}
+	return m
+}
+
+type shardMigrator struct {
+	shards       ShardRegistry
+	pollInterval time.Duration
+	routeTTL     time.Duration
+	preflight    func(ctx context.Context, scope Scope) error
+
+	// mu guards migrations, which holds the migrations started by this
+	// process.  Migrations started elsewhere are read from the shards.
+	mu         sync.Mutex
+	migrations map[ulid.ULID]*shardMigrationRun
+}
+
+type shardMigrationRun struct {
+	state  ShardMigration
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// saveMu serializes writes of the state to the source shard, so that
+	// the latest state is always written last.
+	saveMu sync.Mutex
+}
+
+func (m *shardMigrator) StartShardMigration(ctx context.Context, req ShardMigrationRequest) (ShardMigration, error) {
+	return m.start(ctx, req, nil)
+}
+
+func (m *shardMigrator) start(ctx context.Context, req ShardMigrationRequest, rollbackOf *ulid.ULID) (ShardMigration, error) {
+	if req.Scope.AccountID == uuid.Nil {
+		return ShardMigration{}, fmt.Errorf("account ID is required")
+	}
+	if req.TargetShard == "" {
+		return ShardMigration{}, fmt.Errorf("target shard is required")
+	}
+	if req.DrainTimeout <= 0 {
+		req.DrainTimeout = DefaultShardMigrationDrainTimeout
+	}
+	if m.preflight != nil {
+		if err := m.preflight(ctx, req.Scope); err != nil {
+			return ShardMigration{}, err
+		}
+	}
+
+	if req.SourceShard == "" {
+		current, err := m.shards.Resolve(ctx, req.Scope, nil)
+		if err != nil {
+			return ShardMigration{}, fmt.Errorf("could not resolve source shard: %w", err)
+		}
+		req.SourceShard = current.Name()
+	}
+	if req.SourceShard == req.TargetShard {
+		return ShardMigration{}, fmt.Errorf("source and target shard are both %q", req.SourceShard)
+	}
+
+	source, err := m.shards.ByName(req.SourceShard)
+	if err != nil {
+		return ShardMigration{}, fmt.Errorf("could not find source shard %q: %w", req.SourceShard, err)
+	}
+	target, err := m.shards.ByName(req.TargetShard)
+	if err != nil {
+		return ShardMigration{}, fmt.Errorf("could not find target shard %q: %w", req.TargetShard, err)
+	}
+	home, err := m.shards.Home(ctx, req.Scope, nil)
+	if err != nil {
+		return ShardMigration{}, fmt.Errorf("could not resolve home shard: %w", err)
+	}
+
+	now := time.Now()
+	run := &shardMigrationRun{
+		state: ShardMigration{
+			ID:           ulid.MustNew(ulid.Timestamp(now), rand.Reader),
+			Scope:        req.Scope,
+			SourceShard:  source.Name(),
+			TargetShard:  target.Name(),
+			DrainTimeout: req.DrainTimeout,
+			Status:       ShardMigrationStatusRunning,
+			Phase:        ShardMigrationPhaseRouting,
+			RollbackOf:   rollbackOf,
+			StartedAt:    now,
+			UpdatedAt:    now,
+		},
+		done: make(chan struct{}),
+	}
+
+	// Migrations started by other processes are only visible on the shards.
+	stored, err := m.stored(ctx)
+	if err != nil {
+		return ShardMigration{}, err
+	}
+
+	m.mu.Lock()
+	for _, existing := range m.merge(stored) {
+		if !existing.Done() && scopesOverlap(existing.Scope, req.Scope) {
+			m.mu.Unlock()
+			return ShardMigration{}, ErrShardMigrationInProgress
+		}
+	}
+	// Migrations outlive the request that started them.
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	run.cancel = cancel
+	m.migrations[run.state.ID] = run
+	state := run.state
+	m.mu.Unlock()
+
+	if err := m.save(ctx, run); err != nil {
+		cancel()
+		m.mu.Lock()
+		delete(m.migrations, state.ID)
+		m.mu.Unlock()
+		return ShardMigration{}, err
+	}
+
+	go func() {
+		defer close(run.done)
+		defer cancel()
+		m.run(runCtx, run, home, source, target)
+	}()
+
+	return state, nil
+}
+
+func (m *shardMigrator) ShardMigration(ctx context.Context, id ulid.ULID) (ShardMigration, error) {
+	m.mu.Lock()
+	run, ok := m.migrations[id]
+	if ok {
+		state := run.state
+		m.mu.Unlock()
+		return state, nil
+	}
+	m.mu.Unlock()
+
+	stored, err := m.stored(ctx)
+	if err != nil {
+		return ShardMigration{}, err
+	}
+	state, ok := stored[id]
+	if !ok {
+		return ShardMigration{}, ErrShardMigrationNotFound
+	}
+	return abandoned(state), nil
+}
+
+func (m *shardMigrator) ShardMigrations(ctx context.Context) ([]ShardMigration, error) {
+	stored, err := m.stored(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	result := m.merge(stored)
+	m.mu.Unlock()
+
+	slices.SortFunc(result, func(a, b ShardMigration) int {
+		return a.ID.Compare(b.ID)
+	})
+	return result, nil
+}
+
+// stored returns the migrations stored on every shard.
+func (m *shardMigrator) stored(ctx context.Context) (map[ulid.ULID]ShardMigration, error) {
+	var (
+		mu     sync.Mutex
+		errs   error
+		result = map[ulid.ULID]ShardMigration{}
+	)
+	err := m.shards.ForEach(ctx, func(ctx context.Context, shard QueueShard) error {
+		found, err := shard.ShardMigrations(ctx)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("could not load shard migrations from %q: %w", shard.Name(), err))
+			return nil
+		}
+		for _, s := range found {
+			result[s.ID] = s
+		}
+		return nil
+	})
+	return result, errors.Join(err, errs)
+}
+
+// merge returns the stored migrations, replaced by the migrations running in
+// this process.  The caller must hold m.mu.
+func (m *shardMigrator) merge(stored map[ulid.ULID]ShardMigration) []ShardMigration {
+	result := make([]ShardMigration, 0, len(stored)+len(m.migrations))
+	for id, s := range stored {
+		if _, ok := m.migrations[id]; !ok {
+			result = append(result, abandoned(s))
+		}
+	}
+	for _, run := range m.migrations {
+		result = append(result, run.state)
+	}
+	return result
+}
+
+// abandoned reports a stored running migration as failed if it has made no
+// progress for shardMigrationAbandonedAfter, eg. because the process running
+// it stopped.
+func abandoned(s ShardMigration) ShardMigration {
+	if s.Done() || time.Since(s.UpdatedAt) < shardMigrationAbandonedAfter {
+		return s
+	}
+	s.Status = ShardMigrationStatusFailed
+	s.Phase = ShardMigrationPhaseDone
+	s.Error = fmt.Sprintf("migration abandoned: no progress since %s", s.UpdatedAt.Format(time.RFC3339))
+	s.FinishedAt = s.UpdatedAt
+	return s
+}
+
+func (m *shardMigrator) CancelShardMigration(ctx context.Context, id ulid.ULID) (ShardMigration, error) {
+	m.mu.Lock()
+	run, ok := m.migrations[id]
+	m.mu.Unlock()
+	if !ok {
+		state, err := m.ShardMigration(ctx, id)
+		if err != nil {
+			return ShardMigration{}, err
+		}
+		if !state.Done() {
+			return ShardMigration{}, fmt.Errorf("shard migration %s is running in another process", id)
+		}
+		return state, nil
+	}
+
+	run.cancel()
+	select {
+	case <-run.done:
+	case <-ctx.Done():
+		return ShardMigration{}, ctx.Err()
+	}
+	return m.ShardMigration(ctx, id)
+}
+
+func (m *shardMigrator) RollbackShardMigration(ctx context.Context, id ulid.ULID) (ShardMigration, error) {
+	orig, err := m.CancelShardMigration(ctx, id)
+	if err != nil {
+		return ShardMigration{}, err
+	}
+	if orig.RolledBackBy != nil {
+		return ShardMigration{}, fmt.Errorf("shard migration was already rolled back by %s", orig.RolledBackBy)
+	}
+
+	rollback, err := m.start(ctx, ShardMigrationRequest{
+		Scope:        orig.Scope,
+		SourceShard:  orig.TargetShard,
+		TargetShard:  orig.SourceShard,
+		DrainTimeout: orig.DrainTimeout,
+	}, &orig.ID)
+	if err != nil {
+		return ShardMigration{}, fmt.Errorf("could not start rollback: %w", err)
+	}
+
+	markRolledBack := func(s *ShardMigration) {
+		s.RolledBackBy = &rollback.ID
+		s.Status = ShardMigrationStatusRolledBack
+	}
+
+	m.mu.Lock()
+	_, local := m.migrations[id]
+	m.mu.Unlock()
+	if local {
+		m.update(ctx, id, markRolledBack)
+		return rollback, nil
+	}
+
+	// The migration was started by another process.
+	markRolledBack(&orig)
+	orig.UpdatedAt = time.Now()
+	source, err := m.shards.ByName(orig.SourceShard)
+	if err == nil {
+		err = source.SaveShardMigration(ctx, orig)
+	}
+	if err != nil {
+		logger.StdlibLogger(ctx).Error("could not save rolled back shard migration", "error", err, "migration_id", id)
+	}
+	return rollback, nil
+}
+
+func (m *shardMigrator) update(ctx context.Context, id ulid.ULID, fn func(s *ShardMigration)) {
+	m.mu.Lock()
+	run, ok := m.migrations[id]
+	if ok {
+		fn(&run.state)
+		run.state.UpdatedAt = time.Now()
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	if err := m.save(ctx, run); err != nil {
+		logger.StdlibLogger(ctx).Error("could not save shard migration", "error", err, "migration_id", id)
+	}
+}
+
+// save writes the run's latest state to its source shard.
+func (m *shardMigrator) save(ctx context.Context, run *shardMigrationRun) error {
+	run.saveMu.Lock()
+	defer run.saveMu.Unlock()
+
+	m.mu.Lock()
+	state := run.state
+	m.mu.Unlock()
+
+	source, err := m.shards.ByName(state.SourceShard)
+	if err != nil {
+		return fmt.Errorf("could not find source shard %q: %w", state.SourceShard, err)
+	}
+	// State is saved when a migration is cancelled, too.
+	return source.SaveShardMigration(context.WithoutCancel(ctx), state)
+}
+
+func (m *shardMigrator) finish(ctx context.Context, id ulid.ULID, status ShardMigrationStatus, err error) {
+	m.update(ctx, id, func(s *ShardMigration) {
+		s.Status = status
+		s.Phase = ShardMigrationPhaseDone
+		s.FinishedAt = time.Now()
+		if err != nil {
+			s.Error = err.Error()
+		}
+	})
+}
+
+func (m *shardMigrator) run(ctx context.Context, run *shardMigrationRun, home, source, target QueueShard) {
+	id := run.state.ID
+	scope := run.state.Scope
+	l := logger.StdlibLogger(ctx).With(
+		"migration_id", id,
+		"account_id", scope.AccountID,
+		"fn_id", scope.FunctionID,
+		"source_shard", source.Name(),
+		"target_shard", target.Name(),
+	)
+
+	fail := func(err error) {
+		status := ShardMigrationStatusFailed
+		if errors.Is(err, context.Canceled) {
+			status = ShardMigrationStatusCancelled
+		}
+		l.Error("shard migration stopped", "error", err, "status", status)
+		m.finish(ctx, id, status, err)
+	}
+
+	// Route new items to the target.  The route lives on the home shard;
+	// routing back to the home shard removes the route entirely.
+	route := target.Name()
+	if route == home.Name() {
+		route = ""
+	}
+	if err := home.SetShardRoute(ctx, scope, route); err != nil {
+		fail(fmt.Errorf("could not update shard route: %w", err))
+		return
+	}
+	routedAt := time.Now()
+	m.update(ctx, id, func(s *ShardMigration) { s.Phase = ShardMigrationPhaseDraining })
+	l.Info("shard route updated, draining source shard")
+
+	deadline := routedAt.Add(run.state.DrainTimeout)
+	lockUntil := deadline.Add(time.Minute)
+
+	functions := map[uuid.UUID]struct{}{}
+	if scope.FunctionID != uuid.Nil {
+		functions[scope.FunctionID] = struct{}{}
+	}
+
+	// Always release the migration locks, even when failing, so that the
+	// source shard keeps processing anything left behind.
+	defer func() {
+		for fnID := range functions {
+			fnScope := Scope{AccountID: scope.AccountID, EnvID: scope.EnvID, FunctionID: fnID}
+			if err := source.SetFunctionMigrate(context.WithoutCancel(ctx), fnScope, nil); err != nil {
+				l.Error("could not release migration lock", "error", err, "fn_id", fnID)
+			}
+		}
+	}()
+
+	for {
+		if scope.FunctionID == uuid.Nil {
+			found, err := m.sourceFunctions(ctx, source, scope.AccountID)
+			if err != nil {
+				fail(err)
+				return
+			}
+			for _, fnID := range found {
+				functions[fnID] = struct{}{}
+			}
+		}
+
+		var moved, leased, inProgress int64
+		for fnID := range functions {
+			fnScope := Scope{AccountID: scope.AccountID, EnvID: scope.EnvID, FunctionID: fnID}
+
+			// Pause backlog refills on the source while items are moved.
+			if err := source.SetFunctionMigrate(ctx, fnScope, &lockUntil); err != nil {
+				fail(fmt.Errorf("could not set migration lock for function %s: %w", fnID, err))
+				return
+			}
+
+			n, skipped, err := m.moveFunctionItems(ctx, source, target, fnScope)
+			if err != nil {
+				fail(err)
+				return
+			}
+			moved += n
+			leased += skipped
+
+			running, err := source.RunningCount(ctx, fnScope)
+			if err != nil {
+				fail(fmt.Errorf("could not count in progress items for function %s: %w", fnID, err))
+				return
+			}
+			inProgress += running
+		}
+
+		m.update(ctx, id, func(s *ShardMigration) {
+			s.Passes++
+			s.Functions = len(functions)
+			s.ItemsMoved += moved
+			s.ItemsLeased = leased
+			s.InProgress = inProgress
+		})
+
+		// Producers may keep enqueueing to the source until their cached route
+		// expires, so the source is only considered empty after the cache TTL.
+		quiet := moved == 0 && leased == 0 && inProgress == 0
+		if quiet && time.Since(routedAt) >= m.routeTTL {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			fail(fmt.Errorf("timed out draining source shard with %d leased and %d in progress items remaining", leased, inProgress))
+			return
+		}
+
+		if moved == 0 {
+			select {
+			case <-ctx.Done():
+				fail(ctx.Err())
+				return
+			case <-time.After(m.pollInterval):
+			}
+		}
+	}
+
+	l.Info("shard migration completed")
+	m.finish(ctx, id, ShardMigrationStatusCompleted, nil)
+}
+
+// sourceFunctions returns the functions with partitions on the source shard for
+// the given account.
+func (m *shardMigrator) sourceFunctions(ctx context.Context, source QueueShard, accountID uuid.UUID) ([]uuid.UUID, error) {
+	until := time.Now().Add(365 * 24 * time.Hour)
+
+	var result []uuid.UUID
+	partitions, err := source.PeekAccountPartitions(ctx, accountID, 0, until, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not peek account partitions: %w", err)
+	}
+	for _, p := range partitions {
+		if p.FunctionID != nil && !p.IsSystem() {
+			result = append(result, *p.FunctionID)
+		}
+	}
+
+	shadows, err := source.PeekShadowPartitions(ctx, &accountID, true, ShadowPartitionPeekMax, until)
+	if err != nil {
+		return nil, fmt.Errorf("could not peek account shadow partitions: %w", err)
+	}
+	for _, sp := range shadows {
+		if sp.FunctionID != nil && sp.SystemQueueName == nil {
+			result = append(result, *sp.FunctionID)
+		}
+	}
+	return result, nil
+}
+
+// moveFunctionItems moves up to shardMigrationBatchSize unleased items for a
+// function from the source to the target shard, returning the number of items
+// moved and the number of leased items skipped.
+//
+// Items are removed from the source before being enqueued to the target, so
+// that an item can never be processed by both shards.  If the target rejects
+// the item it is restored to the source.
+func (m *shardMigrator) moveFunctionItems(ctx context.Context, source, target QueueShard, scope Scope) (int64, int64, error) {
+	until := time.Now().Add(365 * 24 * time.Hour)
+	items, err := source.ItemsByPartition(ctx, scope, scope.FunctionID.String(), time.Time{}, until,
+		WithQueueItemIterInterval(0),
+		WithQueueItemIterBatchSize(shardMigrationBatchSize),
+	)
+	if errors.Is(err, ErrPartitionNotFound) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not iterate items for function %s: %w", scope.FunctionID, err)
+	}
+
+	// Collect first, as removing items mutates the sets being iterated.
+	var batch []*QueueItem
+	for qi := range items {
+		batch = append(batch, qi)
+		if len(batch) == shardMigrationBatchSize {
+			break
+		}
+	}
+
+	var moved, leased int64
+	for _, qi := range batch {
+		err := source.Dequeue(ctx, *qi, DequeueRequireUnleased(), DequeueDisableIdempotency())
+		switch {
+		case errors.Is(err, ErrQueueItemAlreadyLeased):
+			leased++
+			continue
+		case errors.Is(err, ErrQueueItemNotFound):
+			continue
+		case err != nil:
+			return moved, leased, fmt.Errorf("could not remove item %s from source shard: %w", qi.ID, err)
+		}
+
+		item := *qi
+		item.LeaseID = nil
+		at := time.UnixMilli(item.AtMS)
+
+		_, err = target.EnqueueItem(ctx, item, at, EnqueueOpts{PassthroughJobId: true})
+		if err != nil && !errors.Is(err, ErrQueueItemExists) {
+			if _, restoreErr := source.EnqueueItem(ctx, item, at, EnqueueOpts{PassthroughJobId: true}); restoreErr != nil && !errors.Is(restoreErr, ErrQueueItemExists) {
+				return moved, leased, fmt.Errorf("could not enqueue item %s to target shard (%w) or restore it to the source shard: %w", qi.ID, err, restoreErr)
+			}
+			return moved, leased, fmt.Errorf("could not enqueue item %s to target shard: %w", qi.ID, err)
+		}
+		moved++
+	}
+	return moved, leased, nil
+}
+
+// scopesOverlap returns whether migrations for both scopes would move the same
+// items.
+func scopesOverlap(a, b Scope) bool {
+	if a.AccountID != b.AccountID {
+		return false
+	}
+	return a.FunctionID == uuid.Nil || b.FunctionID == uuid.Nil || a.FunctionID == b.FunctionID
+}
@@ -33,9 +33,15 @@ type ShardRegistry interface {
 	ByGroup(groupName string) []QueueShard
 
 	// Resolve picks a shard for a given enqueue, applying the registry's
-	// shard selector. Resolve errors if no selector has been configured.
+	// shard selector and any shard route stored on the selected shard.
+	// Resolve errors if no selector has been configured.
 	Resolve(ctx context.Context, scope Scope, queueItemKind *string) (QueueShard, error)
 
+	// Home returns the shard picked by the registry's shard selector,
+	// ignoring shard routes. Shard routes for a scope are stored on its
+	// home shard.
+	Home(ctx context.Context, scope Scope, queueItemKind *string) (QueueShard, error)
+
 	// ForEach runs fn against every active shard concurrently. Shard errors
 	// are logged without cancelling work against other shards. The shard set
 	// is snapshotted at call time; mutations during iteration are not observed.
@@ -74,6 +80,8 @@ type shardRegistry struct {
 	shards   map[string]QueueShard
 	primary  QueueShard
 	selector shardSelector
+
+	routes *shardRouteCache
 }
 
 // ShardRegistryOpt configures a shardRegistry at construction.
@@ -119,6 +127,7 @@ func NewShardRegistry(
 	}
 	r := &shardRegistry{
 		shards: maps.Clone(shards),
+		routes: newShardRouteCache(ShardRouteCacheTTL),
 	}
 	for _, opt := range opts {
 		opt(r)
@@ -180,6 +189,14 @@ func (r *shardRegistry) ByGroup(groupName string) []QueueShard {
 }
 
 func (r *shardRegistry) Resolve(ctx context.Context, scope Scope, queueItemKind *string) (QueueShard, error) {
+	home, err := r.selector(ctx, scope, queueItemKind)
+	if err != nil {
+		return nil, err
+	}
+	return r.route(ctx, home, scope, queueItemKind), nil
+}
+
+func (r *shardRegistry) Home(ctx context.Context, scope Scope, queueItemKind *string) (QueueShard, error) {
 	return r.selector(ctx, scope, queueItemKind)
 }
 
@@ -0,0 +1,119 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/logger"
+)
+
+// ShardRouteCacheTTL is how long a registry caches the shard route for a
+// scope.  Route changes may take up to this long to be observed by every
+// producer.
+const ShardRouteCacheTTL = 5 * time.Second
+
+// WithShardRouteCacheTTL overrides ShardRouteCacheTTL.  A TTL of zero disables
+// caching, looking up the route on every Resolve.
+func WithShardRouteCacheTTL(ttl time.Duration) ShardRegistryOpt {
+	return func(r *shardRegistry) {
+		r.routes = newShardRouteCache(ttl)
+	}
+}
+
+// route applies the shard route stored on the home shard for the scope, if
+// any.  System queues and items without an account are never routed.  Routing
+// errors are logged and fall back to the home shard so that enqueues are never
+// blocked by a failed lookup.
+func (r *shardRegistry) route(ctx context.Context, home QueueShard, scope Scope, queueItemKind *string) QueueShard {
+	if queueItemKind != nil || scope.AccountID == uuid.Nil || r.size() < 2 {
+		return home
+	}
+
+	name, err := r.routes.get(ctx, home, scope)
+	if err != nil {
+		logger.StdlibLogger(ctx).Error("error loading shard route", "error", err, "shard", home.Name(), "account_id", scope.AccountID, "fn_id", scope.FunctionID)
+		return home
+	}
+	if name == "" || name == home.Name() {
+		return home
+	}
+
+	routed, err := r.ByName(name)
+	if err != nil {
+		logger.StdlibLogger(ctx).Error("shard route references unknown shard", "shard", name, "account_id", scope.AccountID, "fn_id", scope.FunctionID)
+		return home
+	}
+	return routed
+}
+
+func (r *shardRegistry) size() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.shards)
+}
+
+type shardRouteCacheKey struct {
+	shard      string
+	accountID  uuid.UUID
+	functionID uuid.UUID
+}
+
+type shardRouteCacheEntry struct {
+	name    string
+	expires time.Time
+}
+
+// shardRouteCache caches shard routes for a short period, avoiding a lookup
+// against the home shard for every enqueue.
+type shardRouteCache struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	entries   map[shardRouteCacheKey]shardRouteCacheEntry
+	lastSweep time.Time
+}
+
+func newShardRouteCache(ttl time.Duration) *shardRouteCache {
+	return &shardRouteCache{
+		ttl:     ttl,
+		entries: map[shardRouteCacheKey]shardRouteCacheEntry{},
+	}
+}
+
+func (c *shardRouteCache) get(ctx context.Context, home QueueShard, scope Scope) (string, error) {
+	key := shardRouteCacheKey{shard: home.Name(), accountID: scope.AccountID, functionID: scope.FunctionID}
+	now := time.Now()
+
+	if c.ttl > 0 {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		c.mu.Unlock()
+		if ok && now.Before(entry.expires) {
+			return entry.name, nil
+		}
+	}
+
+	name, err := home.ShardRoute(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+
+	if c.ttl > 0 {
+		c.mu.Lock()
+		// Periodically drop expired entries so that the cache does not grow
+		// unbounded with accounts that are no longer enqueueing.
+		if now.Sub(c.lastSweep) > c.ttl {
+			for k, e := range c.entries {
+				if now.After(e.expires) {
+					delete(c.entries, k)
+				}
+			}
+			c.lastSweep = now
+		}
+		c.entries[key] = shardRouteCacheEntry{name: name, expires: now.Add(c.ttl)}
+		c.mu.Unlock()
+	}
+	return name, nil
+}
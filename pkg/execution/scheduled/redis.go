@@ -0,0 +1,179 @@
+package scheduled
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+)
+
+const DefaultPrefix = "{scheduled}"
+
+// expiryGrace is how long an invocation is retained after it fires, so that
+// lookups shortly after the scheduled time still resolve.
+const expiryGrace = time.Hour
+
+// NewRedisStore returns a Store which indexes scheduled invocations in Redis.
+// All keys share the given prefix, which must contain a hash tag so that keys
+// are stored within the same slot.
+func NewRedisStore(r rueidis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return redisStore{r: r, prefix: prefix, now: time.Now}
+}
+
+type redisStore struct {
+	r      rueidis.Client
+	prefix string
+	now    func() time.Time
+}
+
+// Pending invocations for each function are stored in a per-workspace sorted
+// set with equal scores, with members formatted as "<zero-padded fire time
+// ms>:<id>".  This keeps members in fire order whilst letting ZRANGEBYLEX use
+// the last member as a cursor, and allows fired invocations to be pruned by
+// range.
+
+func (r redisStore) Add(ctx context.Context, inv Invocation) error {
+	byt, err := json.Marshal(inv)
+	if err != nil {
+		return err
+	}
+
+	expiry := time.Until(inv.At) + expiryGrace
+	cmds := rueidis.Commands{
+		r.r.B().Set().Key(r.invKey(inv.ID)).Value(string(byt)).PxMilliseconds(expiry.Milliseconds()).Build(),
+		r.r.B().Zadd().Key(r.fnKey(inv.WorkspaceID, inv.FunctionID)).ScoreMember().ScoreMember(0, member(inv.At, inv.ID)).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) Get(ctx context.Context, id ulid.ULID) (*Invocation, error) {
+	data, err := r.r.Do(ctx, r.r.B().Get().Key(r.invKey(id)).Build()).ToString()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrInvocationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	inv := &Invocation{}
+	if err := json.Unmarshal([]byte(data), inv); err != nil {
+		return nil, fmt.Errorf("error decoding scheduled invocation: %w", err)
+	}
+	return inv, nil
+}
+
+func (r redisStore) List(ctx context.Context, wsID, fnID uuid.UUID, opts ListOpts) ([]Invocation, string, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	min := "-"
+	if opts.Cursor != "" {
+		if _, _, err := parseMember(opts.Cursor); err != nil {
+			return nil, "", ErrInvalidCursor
+		}
+		min = "(" + opts.Cursor
+	}
+
+	// Prune invocations which have already fired before listing, so that the
+	// index doesn't grow unbounded.
+	key := r.fnKey(wsID, fnID)
+	err := r.r.Do(ctx, r.r.B().Zremrangebylex().Key(key).Min("-").Max("("+padTime(r.now())).Build()).Error()
+	if err != nil {
+		return nil, "", err
+	}
+
+	members, err := r.r.Do(ctx, r.r.B().Zrangebylex().
+		Key(key).
+		Min(min).
+		Max("+").
+		Limit(0, int64(limit+1)).
+		Build(),
+	).AsStrSlice()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var cursor string
+	if len(members) > limit {
+		members = members[:limit]
+		cursor = members[limit-1]
+	}
+
+	result := make([]Invocation, 0, len(members))
+	for _, m := range members {
+		_, id, err := parseMember(m)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid scheduled invocation member %q: %w", m, err)
+		}
+		inv, err := r.Get(ctx, id)
+		if err == ErrInvocationNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		result = append(result, *inv)
+	}
+	return result, cursor, nil
+}
+
+func (r redisStore) Remove(ctx context.Context, inv Invocation) error {
+	cmds := rueidis.Commands{
+		r.r.B().Zrem().Key(r.fnKey(inv.WorkspaceID, inv.FunctionID)).Member(member(inv.At, inv.ID)).Build(),
+		r.r.B().Del().Key(r.invKey(inv.ID)).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) invKey(id ulid.ULID) string {
+	return fmt.Sprintf("%s:inv:%s", r.prefix, id)
+}
+
+func (r redisStore) fnKey(wsID, fnID uuid.UUID) string {
+	return fmt.Sprintf("%s:fn:%s:%s", r.prefix, wsID, fnID)
+}
+
+func padTime(t time.Time) string {
+	return fmt.Sprintf("%013d", t.UnixMilli())
+}
+
+func member(at time.Time, id ulid.ULID) string {
+	return padTime(at) + ":" + id.String()
+}
+
+func parseMember(m string) (time.Time, ulid.ULID, error) {
+	ts, id, ok := strings.Cut(m, ":")
+	if !ok || len(ts) != 13 {
+		return time.Time{}, ulid.ULID{}, fmt.Errorf("malformed member")
+	}
+	ms, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, ulid.ULID{}, err
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return time.Time{}, ulid.ULID{}, err
+	}
+	return time.UnixMilli(ms).UTC(), parsed, nil
+}
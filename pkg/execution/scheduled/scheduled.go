@@ -0,0 +1,172 @@
+// Package scheduled manages function invocations scheduled to run at a future
+// time.  Scheduled invocations are enqueued immediately as runs which start at
+// the scheduled time;  this package indexes pending invocations per function so
+// that they can be listed and cancelled before they fire.
+package scheduled
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/oklog/ulid/v2"
+)
+
+// MaxDelay is the furthest into the future an invocation may be scheduled.
+const MaxDelay = consts.MaxSleepDuration
+
+var (
+	// ErrInvocationNotFound is returned when a scheduled invocation does not
+	// exist for the given function, or has already fired.
+	ErrInvocationNotFound = errors.New("scheduled invocation not found")
+	// ErrInvalidSchedule is returned when scheduling an invocation in the past
+	// or further than MaxDelay into the future.
+	ErrInvalidSchedule = errors.New("invalid schedule time")
+	// ErrInvalidCursor is returned when listing invocations with a malformed
+	// cursor.
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Invocation is a function invocation which is scheduled to run in the future.
+type Invocation struct {
+	// ID is the ID of the scheduled invocation.  This is also the ID of the
+	// run which starts when the invocation fires.
+	ID          ulid.ULID `json:"id"`
+	AccountID   uuid.UUID `json:"account_id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	AppID       uuid.UUID `json:"app_id"`
+	FunctionID  uuid.UUID `json:"function_id"`
+	// At is the time the invocation fires.
+	At        time.Time `json:"at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListOpts lists pending invocations for a function, soonest first.
+type ListOpts struct {
+	// Cursor is the opaque cursor returned by the previous page.
+	Cursor string
+	Limit  int
+}
+
+// Store indexes pending scheduled invocations.
+type Store interface {
+	// Add indexes a newly scheduled invocation.
+	Add(ctx context.Context, inv Invocation) error
+	// Get loads a pending invocation by ID, returning ErrInvocationNotFound if
+	// the invocation doesn't exist or has already fired.
+	Get(ctx context.Context, id ulid.ULID) (*Invocation, error)
+	// List lists pending invocations for a function within a workspace, soonest
+	// first, returning the cursor for the next page if there are more
+	// invocations.
+	List(ctx context.Context, workspaceID, functionID uuid.UUID, opts ListOpts) ([]Invocation, string, error)
+	// Remove removes an invocation from the index.
+	Remove(ctx context.Context, inv Invocation) error
+}
+
+// Executor schedules and cancels function runs.  This is implemented by the
+// executor.
+type Executor interface {
+	Schedule(ctx context.Context, req execution.ScheduleRequest) (*ulid.ULID, *sv2.Metadata, error)
+	Cancel(ctx context.Context, id sv2.ID, r execution.CancelRequest) error
+}
+
+// Scheduler schedules function invocations for a future time, and lists or
+// cancels pending invocations.
+type Scheduler interface {
+	// Schedule schedules the given request to run at req.At, which is required.
+	//
+	// Errors from the executor are returned as-is so that callers can handle
+	// flow control, alongside the invocation when the executor returned a run
+	// ID (eg. for idempotency conflicts).
+	Schedule(ctx context.Context, req execution.ScheduleRequest) (*Invocation, error)
+	// List lists pending invocations for a function within a workspace.
+	List(ctx context.Context, workspaceID, functionID uuid.UUID, opts ListOpts) ([]Invocation, string, error)
+	// Cancel cancels a pending invocation, ensuring that the run never starts.
+	Cancel(ctx context.Context, workspaceID, functionID uuid.UUID, id ulid.ULID) (*Invocation, error)
+}
+
+// NewScheduler returns a Scheduler which schedules runs via the given executor
+// and indexes pending invocations in the given store.
+func NewScheduler(store Store, exec Executor) Scheduler {
+	return &scheduler{store: store, exec: exec, now: time.Now}
+}
+
+type scheduler struct {
+	store Store
+	exec  Executor
+	now   func() time.Time
+}
+
+func (s *scheduler) Schedule(ctx context.Context, req execution.ScheduleRequest) (*Invocation, error) {
+	now := s.now()
+	if req.At == nil || !req.At.After(now) {
+		return nil, fmt.Errorf("%w: invocations must be scheduled in the future", ErrInvalidSchedule)
+	}
+	if req.At.Sub(now) > MaxDelay {
+		return nil, fmt.Errorf("%w: invocations cannot be scheduled more than %s in the future", ErrInvalidSchedule, MaxDelay)
+	}
+
+	req.ScheduleType = enums.ScheduleTypeScheduledInvoke
+	runID, md, err := s.exec.Schedule(ctx, req)
+
+	var inv *Invocation
+	if runID != nil {
+		inv = &Invocation{
+			ID:          *runID,
+			AccountID:   req.AccountID,
+			WorkspaceID: req.WorkspaceID,
+			AppID:       req.AppID,
+			FunctionID:  req.Function.ID,
+			At:          req.At.UTC(),
+			CreatedAt:   now.UTC(),
+		}
+	}
+	if err != nil || md == nil || inv == nil {
+		// The run was either not scheduled or was impacted by flow control,
+		// so there's nothing pending to index.
+		return inv, err
+	}
+
+	if err := s.store.Add(ctx, *inv); err != nil {
+		return inv, fmt.Errorf("error indexing scheduled invocation: %w", err)
+	}
+	return inv, nil
+}
+
+func (s *scheduler) List(ctx context.Context, workspaceID, functionID uuid.UUID, opts ListOpts) ([]Invocation, string, error) {
+	return s.store.List(ctx, workspaceID, functionID, opts)
+}
+
+func (s *scheduler) Cancel(ctx context.Context, workspaceID, functionID uuid.UUID, id ulid.ULID) (*Invocation, error) {
+	inv, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv.WorkspaceID != workspaceID || inv.FunctionID != functionID || !inv.At.After(s.now()) {
+		return nil, ErrInvocationNotFound
+	}
+
+	err = s.exec.Cancel(ctx, sv2.ID{
+		RunID:      inv.ID,
+		FunctionID: inv.FunctionID,
+		Tenant: sv2.Tenant{
+			AppID:     inv.AppID,
+			EnvID:     inv.WorkspaceID,
+			AccountID: inv.AccountID,
+		},
+	}, execution.CancelRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error cancelling scheduled invocation: %w", err)
+	}
+
+	if err := s.store.Remove(ctx, *inv); err != nil {
+		return nil, fmt.Errorf("error removing scheduled invocation: %w", err)
+	}
+	return inv, nil
+}
@@ -0,0 +1,170 @@
+package scheduled
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	sv2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExecutor struct {
+	scheduled []execution.ScheduleRequest
+	cancelled []sv2.ID
+	err       error
+}
+
+func (f *fakeExecutor) Schedule(ctx context.Context, req execution.ScheduleRequest) (*ulid.ULID, *sv2.Metadata, error) {
+	f.scheduled = append(f.scheduled, req)
+	id := ulid.Make()
+	if f.err != nil {
+		return &id, nil, f.err
+	}
+	return &id, &sv2.Metadata{ID: sv2.ID{RunID: id}}, nil
+}
+
+func (f *fakeExecutor) Cancel(ctx context.Context, id sv2.ID, r execution.CancelRequest) error {
+	f.cancelled = append(f.cancelled, id)
+	return nil
+}
+
+func newTestStore(t *testing.T) Store {
+	t.Helper()
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return NewRedisStore(rc, "")
+}
+
+func newRequest(wsID, fnID uuid.UUID, at time.Time) execution.ScheduleRequest {
+	return execution.ScheduleRequest{
+		Function:    inngest.Function{ID: fnID},
+		AccountID:   uuid.New(),
+		WorkspaceID: wsID,
+		AppID:       uuid.New(),
+		At:          &at,
+	}
+}
+
+func TestScheduleValidatesTime(t *testing.T) {
+	ctx := context.Background()
+	exec := &fakeExecutor{}
+	s := NewScheduler(newTestStore(t), exec)
+
+	wsID, fnID := uuid.New(), uuid.New()
+
+	_, err := s.Schedule(ctx, execution.ScheduleRequest{Function: inngest.Function{ID: fnID}})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = s.Schedule(ctx, newRequest(wsID, fnID, time.Now().Add(-time.Minute)))
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = s.Schedule(ctx, newRequest(wsID, fnID, time.Now().Add(MaxDelay+time.Hour)))
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	require.Empty(t, exec.scheduled)
+}
+
+func TestScheduleListCancel(t *testing.T) {
+	ctx := context.Background()
+	exec := &fakeExecutor{}
+	s := NewScheduler(newTestStore(t), exec)
+
+	wsID, fnID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	var invs []*Invocation
+	for i := 3; i > 0; i-- {
+		inv, err := s.Schedule(ctx, newRequest(wsID, fnID, now.Add(time.Duration(i)*time.Hour)))
+		require.NoError(t, err)
+		invs = append(invs, inv)
+	}
+	require.Len(t, exec.scheduled, 3)
+	for _, req := range exec.scheduled {
+		require.Equal(t, enums.ScheduleTypeScheduledInvoke, req.ScheduleType)
+	}
+
+	// Invocations for other workspaces are never listed.
+	_, err := s.Schedule(ctx, newRequest(uuid.New(), fnID, now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	page, cursor, err := s.List(ctx, wsID, fnID, ListOpts{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.NotEmpty(t, cursor)
+	// Invocations are listed soonest first.
+	require.Equal(t, invs[2].ID, page[0].ID)
+	require.Equal(t, invs[1].ID, page[1].ID)
+
+	page, cursor, err = s.List(ctx, wsID, fnID, ListOpts{Limit: 2, Cursor: cursor})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Empty(t, cursor)
+	require.Equal(t, invs[0].ID, page[0].ID)
+
+	_, _, err = s.List(ctx, wsID, fnID, ListOpts{Cursor: "nope"})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	// Cancelling requires the invocation to belong to the function.
+	_, err = s.Cancel(ctx, wsID, uuid.New(), invs[1].ID)
+	require.ErrorIs(t, err, ErrInvocationNotFound)
+
+	cancelled, err := s.Cancel(ctx, wsID, fnID, invs[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, invs[1].ID, cancelled.ID)
+	require.Len(t, exec.cancelled, 1)
+	require.Equal(t, invs[1].ID, exec.cancelled[0].RunID)
+	require.Equal(t, wsID, exec.cancelled[0].Tenant.EnvID)
+
+	_, err = s.Cancel(ctx, wsID, fnID, invs[1].ID)
+	require.ErrorIs(t, err, ErrInvocationNotFound)
+
+	page, _, err = s.List(ctx, wsID, fnID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+}
+
+func TestScheduleNotIndexedOnExecutorError(t *testing.T) {
+	ctx := context.Background()
+	debounced := errors.New("debounced")
+	exec := &fakeExecutor{err: debounced}
+	s := NewScheduler(newTestStore(t), exec)
+
+	wsID, fnID := uuid.New(), uuid.New()
+	inv, err := s.Schedule(ctx, newRequest(wsID, fnID, time.Now().Add(time.Hour)))
+	require.ErrorIs(t, err, debounced)
+	require.NotNil(t, inv)
+
+	page, _, err := s.List(ctx, wsID, fnID, ListOpts{})
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func TestListPrunesFiredInvocations(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	wsID, fnID := uuid.New(), uuid.New()
+
+	fired := Invocation{ID: ulid.Make(), WorkspaceID: wsID, FunctionID: fnID, At: time.Now().Add(-time.Minute)}
+	pending := Invocation{ID: ulid.Make(), WorkspaceID: wsID, FunctionID: fnID, At: time.Now().Add(time.Minute)}
+	require.NoError(t, store.Add(ctx, fired))
+	require.NoError(t, store.Add(ctx, pending))
+
+	page, _, err := store.List(ctx, wsID, fnID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, pending.ID, page[0].ID)
+}
@@ -365,6 +365,52 @@ func (s SignalOpts) Expires() (time.Time, error) {
 	return strtimeout.ParseTimeout(s.Timeout, time.Now)
 }
 
+func (g GeneratorOpcode) LockOpts() (*LockOpts, error) {
+	opts := &LockOpts{}
+	if err := opts.UnmarshalAny(g.Opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// LockOpts are the options for OpcodeLockAcquire.
+type LockOpts struct {
+	// Key is the name of the lock.  Locks are scoped to an environment.
+	Key string `json:"key"`
+	// Lease is how long the lock is held for if it's never released, eg. "30s".
+	// This bounds how long a crashed or stuck run can hold the lock.
+	Lease string `json:"lease"`
+	// Step is the ID of the step the lock is held around.  The lock is
+	// released once this step completes or fails, leaving locks held for
+	// other parallel steps untouched.
+	Step string `json:"step"`
+}
+
+func (l *LockOpts) UnmarshalAny(a any) error {
+	opts := LockOpts{}
+	var mappedByt []byte
+	switch typ := a.(type) {
+	case []byte:
+		mappedByt = typ
+	default:
+		byt, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		mappedByt = byt
+	}
+	if err := json.Unmarshal(mappedByt, &opts); err != nil {
+		return err
+	}
+	*l = opts
+	return nil
+}
+
+// LeaseDuration parses the lock's lease.
+func (l LockOpts) LeaseDuration() (time.Duration, error) {
+	return str2duration.ParseDuration(l.Lease)
+}
+
 func (g GeneratorOpcode) InvokeFunctionOpts() (*InvokeFunctionOpts, error) {
 	opts := &InvokeFunctionOpts{}
 	if err := opts.UnmarshalAny(g.Opts); err != nil {
@@ -561,6 +607,23 @@ func (s *SleepOpts) UnmarshalAny(a any) error {
 type RunOpts struct {
 	Type  string          `json:"type,omitempty"`
 	Input json.RawMessage `json:"input"`
+	// Cache opts the step into cross-run caching of its output.  This is
+	// declared on the OpcodeStepPlanned opcode for the step.
+	Cache *StepCacheOpts `json:"cache,omitempty"`
+}
+
+// StepCacheOpts declares how a step's output is cached across runs.
+type StepCacheOpts struct {
+	// Key is the user-defined cache key.  Runs of the same function which
+	// plan a step with the same key reuse the cached output.
+	Key string `json:"key"`
+	// TTL is how long the output is cached for, eg. "1h" or "7d".
+	TTL string `json:"ttl"`
+}
+
+// TTLDuration parses the cache TTL.
+func (s StepCacheOpts) TTLDuration() (time.Duration, error) {
+	return str2duration.ParseDuration(s.TTL)
 }
 
 func (r *RunOpts) UnmarshalAny(a any) error {
@@ -0,0 +1,136 @@
+package postgres_state
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/inngest/inngest/pkg/enums"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+)
+
+// leaseConstraintCheck enforces concurrency and throttle constraints for an item
+// being leased.  This must be called within the leasing transaction.
+//
+// When a capacity manager is configured, constraints are checked before leasing
+// and this is a no-op.
+func (q *queue) leaseConstraintCheck(ctx context.Context, qs *sqlc.Queries, o *osqueue.LeaseOptions, now time.Time) error {
+	if q.CapacityManager != nil {
+		return nil
+	}
+
+	sp := o.ShadowPartition
+	if sp.SystemQueueName != nil || sp.AccountID == nil || sp.FunctionID == nil {
+		return nil
+	}
+
+	// Serialize leases within an account so that in-progress counts can't
+	// change between checking and leasing.
+	if err := qs.AdvisoryXactLock(ctx, q.name+":"+sp.AccountID.String()); err != nil {
+		return fmt.Errorf("error locking account: %w", err)
+	}
+
+	nowMS := now.UnixMilli()
+	concurrency := o.Constraints.Concurrency
+
+	if concurrency.AccountConcurrency > 0 {
+		count, err := qs.CountAccountInProgress(ctx, sqlc.CountAccountInProgressParams{
+			Shard:     q.name,
+			AccountID: sp.AccountID.String(),
+			NowMs:     nowMS,
+		})
+		if err != nil {
+			return fmt.Errorf("error counting account in progress: %w", err)
+		}
+		if count >= int64(concurrency.AccountConcurrency) {
+			return osqueue.NewKeyError(osqueue.ErrAccountConcurrencyLimit, sp.AccountID.String())
+		}
+	}
+
+	if concurrency.FunctionConcurrency > 0 {
+		count, err := qs.CountFunctionInProgress(ctx, sqlc.CountFunctionInProgressParams{
+			Shard:      q.name,
+			FunctionID: sp.FunctionID.String(),
+			NowMs:      nowMS,
+		})
+		if err != nil {
+			return fmt.Errorf("error counting function in progress: %w", err)
+		}
+		if count >= int64(concurrency.FunctionConcurrency) {
+			return osqueue.NewKeyError(osqueue.ErrPartitionConcurrencyLimit, sp.FunctionID.String())
+		}
+	}
+
+	for idx, key := range o.Backlog.ConcurrencyKeys {
+		limit := customKeyLimit(o.Constraints, key)
+		if limit <= 0 {
+			continue
+		}
+
+		count, err := qs.CountCustomKeyInProgress(ctx, sqlc.CountCustomKeyInProgressParams{
+			Shard: q.name,
+			Key:   key.CanonicalKeyID,
+			NowMs: nowMS,
+		})
+		if err != nil {
+			return fmt.Errorf("error counting custom key in progress: %w", err)
+		}
+		if count >= int64(limit) {
+			return osqueue.NewKeyError(osqueue.ErrConcurrencyLimitCustomKey, o.Backlog.CustomConcurrencyKeyID(idx+1))
+		}
+	}
+
+	return q.throttleCheck(ctx, qs, o, now)
+}
+
+// customKeyLimit returns the step concurrency limit configured for the given
+// backlog key, or zero if the key is no longer configured.
+func customKeyLimit(constraints osqueue.PartitionConstraintConfig, key osqueue.BacklogConcurrencyKey) int {
+	for _, c := range constraints.Concurrency.CustomConcurrencyKeys {
+		if c.Mode != enums.ConcurrencyModeStep {
+			continue
+		}
+		if c.Scope == key.Scope && c.HashedKeyExpression == key.HashedKeyExpression {
+			return c.Limit
+		}
+	}
+	return 0
+}
+
+// throttleCheck applies GCRA to the item's throttle key, storing the
+// theoretical arrival time in the kv table.
+func (q *queue) throttleCheck(ctx context.Context, qs *sqlc.Queries, o *osqueue.LeaseOptions, now time.Time) error {
+	throttle := o.Constraints.Throttle
+	if o.Backlog.Throttle == nil || throttle == nil || throttle.Limit <= 0 {
+		return nil
+	}
+	if o.Backlog.Throttle.ThrottleKeyExpressionHash != throttle.ThrottleKeyExpressionHash {
+		return nil
+	}
+
+	key := kvThrottlePrefix + o.Backlog.Throttle.ThrottleKey
+	nowMS := now.UnixMilli()
+
+	emission := int64(throttle.Period) * 1000 / int64(throttle.Limit)
+	dvt := emission * int64(throttle.Burst+1)
+
+	tat := nowMS
+	val, ok, err := q.kvGet(ctx, qs, key)
+	if err != nil {
+		return fmt.Errorf("error loading throttle: %w", err)
+	}
+	if ok {
+		if stored, err := strconv.ParseInt(val, 10, 64); err == nil && stored > tat {
+			tat = stored
+		}
+	}
+
+	newTAT := tat + emission
+	if nowMS < newTAT-dvt {
+		return osqueue.NewKeyError(osqueue.ErrQueueItemThrottled, o.Backlog.Throttle.ThrottleKey)
+	}
+
+	return q.kvSetAt(ctx, qs, key, strconv.FormatInt(newTAT, 10), time.UnixMilli(newTAT))
+}
@@ -0,0 +1,332 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/oklog/ulid/v2"
+)
+
+// debounceTimes contains the fields of a stored debounce item read when
+// updating or migrating debounces.
+type debounceTimes struct {
+	Event struct {
+		Timestamp int64 `json:"ts"`
+	} `json:"e"`
+	Timeout int64 `json:"t"`
+}
+
+func debouncePointerKey(scope osqueue.Scope, key string) string {
+	return kvDebouncePointerPrefix + scope.FunctionID.String() + ":" + key
+}
+
+// DebounceCreate implements queue.DebounceOperations.
+func (q *queue) DebounceCreate(ctx context.Context, scope osqueue.Scope, key string, debounceID ulid.ULID, item []byte, ttl time.Duration) (*ulid.ULID, error) {
+	ptrKey := debouncePointerKey(scope, key)
+
+	var existing string
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+ptrKey); err != nil {
+			return err
+		}
+
+		val, ok, err := q.kvGet(ctx, qs, ptrKey)
+		if err != nil {
+			return err
+		}
+		if ok {
+			// A debounce for this function exists.
+			existing = val
+			return nil
+		}
+
+		if err := q.kvSet(ctx, qs, ptrKey, debounceID.String(), ttl); err != nil {
+			return err
+		}
+		return q.kvSet(ctx, qs, kvDebounceItemPrefix+debounceID.String(), string(item), 0)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating debounce: %w", err)
+	}
+
+	if existing == "" {
+		return nil, nil
+	}
+
+	existingID, err := ulid.Parse(existing)
+	if err != nil {
+		return nil, fmt.Errorf("unknown new debounce return value: %s", existing)
+	}
+	return &existingID, nil
+}
+
+// DebounceUpdate implements queue.DebounceOperations.
+func (q *queue) DebounceUpdate(
+	ctx context.Context,
+	scope osqueue.Scope,
+	key string,
+	debounceID ulid.ULID,
+	item []byte,
+	ttl time.Duration,
+	jobID string,
+	now time.Time,
+	eventTimestamp int64,
+) (int64, osqueue.DebounceUpdateStatus, error) {
+	ptrKey := debouncePointerKey(scope, key)
+	itemKey := kvDebounceItemPrefix + debounceID.String()
+	ttlSeconds := int64(ttl.Seconds())
+	currentTime := now.UnixMilli()
+
+	var status osqueue.DebounceUpdateStatus
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+ptrKey); err != nil {
+			return err
+		}
+
+		row, err := qs.GetQueueItem(ctx, sqlc.GetQueueItemParams{Shard: q.name, ID: jobID})
+		if errors.Is(err, sql.ErrNoRows) {
+			// The queue item was not found. return not found but set the debounce
+			// for lookup
+			status = osqueue.DebounceUpdateNotFound
+			if err := q.kvSet(ctx, qs, ptrKey, debounceID.String(), time.Duration(ttlSeconds)*time.Second); err != nil {
+				return err
+			}
+			return q.kvSet(ctx, qs, itemKey, string(item), 0)
+		}
+		if err != nil {
+			return err
+		}
+
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return err
+		}
+		if qi.LeaseID != nil && int64(qi.LeaseID.Time()) > currentTime {
+			// The debounce queue item is leased.
+			status = osqueue.DebounceUpdateInProgress
+			return nil
+		}
+
+		existing, ok, err := q.kvGet(ctx, qs, itemKey)
+		if err != nil {
+			return err
+		}
+		if ok {
+			prev := debounceTimes{}
+			if err := json.Unmarshal([]byte(existing), &prev); err == nil {
+				if prev.Event.Timestamp > eventTimestamp {
+					// The stored event occurs after the event we're updating, so do nothing.
+					status = osqueue.DebounceUpdateOutOfOrder
+					return nil
+				}
+
+				// Ensure that we respect the max timeout for the debounce, carrying
+				// the max over to the updated item.
+				if prev.Timeout > 0 {
+					if currentTime+(ttlSeconds*1000) > prev.Timeout {
+						ttlSeconds = (prev.Timeout - currentTime) / 1000
+						if ttlSeconds <= 0 {
+							// Ensure we always use a minimum.
+							ttlSeconds = 1
+						}
+					}
+
+					next := map[string]any{}
+					if err := json.Unmarshal(item, &next); err != nil {
+						return fmt.Errorf("error decoding debounce item: %w", err)
+					}
+					next["t"] = prev.Timeout
+					if item, err = json.Marshal(next); err != nil {
+						return fmt.Errorf("error encoding debounce item: %w", err)
+					}
+				}
+			}
+		}
+
+		status = osqueue.DebounceUpdateOK
+		if err := q.kvSet(ctx, qs, ptrKey, debounceID.String(), time.Duration(ttlSeconds)*time.Second); err != nil {
+			return err
+		}
+		return q.kvSet(ctx, qs, itemKey, string(item), 0)
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("error updating debounce: %w", err)
+	}
+
+	if status != osqueue.DebounceUpdateOK {
+		return 0, status, nil
+	}
+	return ttlSeconds, status, nil
+}
+
+// DebounceStartExecution implements queue.DebounceOperations.
+func (q *queue) DebounceStartExecution(ctx context.Context, scope osqueue.Scope, key string, newDebounceID, debounceID ulid.ULID) (osqueue.DebounceStartStatus, error) {
+	ptrKey := debouncePointerKey(scope, key)
+
+	migrating := false
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+ptrKey); err != nil {
+			return err
+		}
+
+		// If debounce is being migrated, we don't want to run the timeout job.
+		if _, ok, err := q.kvGet(ctx, qs, kvDebounceMigratingPrefix+debounceID.String()); err != nil {
+			return err
+		} else if ok {
+			migrating = true
+			return nil
+		}
+
+		// update the pointer value only if the existing one matches
+		current, ok, err := q.kvGet(ctx, qs, ptrKey)
+		if err != nil {
+			return err
+		}
+		if ok && current == debounceID.String() {
+			return q.kvSet(ctx, qs, ptrKey, newDebounceID.String(), 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if migrating {
+		return osqueue.DebounceStartMigrating, nil
+	}
+	return osqueue.DebounceStartStarted, nil
+}
+
+// DebouncePrepareMigration implements queue.DebounceOperations.
+func (q *queue) DebouncePrepareMigration(ctx context.Context, scope osqueue.Scope, key string, fakeDebounceID ulid.ULID) (*ulid.ULID, int64, time.Duration, error) {
+	ptrKey := debouncePointerKey(scope, key)
+
+	var (
+		existingID    string
+		timeoutMillis int64
+		pointerTTL    time.Duration
+	)
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+ptrKey); err != nil {
+			return err
+		}
+
+		ptr, err := q.kvGetRow(ctx, qs, ptrKey)
+		if err != nil || ptr == nil {
+			return err
+		}
+		existing, ok, err := q.kvGet(ctx, qs, kvDebounceItemPrefix+ptr.Value)
+		if err != nil || !ok {
+			return err
+		}
+
+		// Prevent the next prepareMigration() call from finding the same debounce again.
+		if ptr.ExpiresAtMs.Valid {
+			pointerTTL = time.Duration(ptr.ExpiresAtMs.Int64-q.Clock.Now().UnixMilli()) * time.Millisecond
+			if err := q.kvSetAt(ctx, qs, ptrKey, fakeDebounceID.String(), time.UnixMilli(ptr.ExpiresAtMs.Int64)); err != nil {
+				return err
+			}
+		} else if err := q.kvSet(ctx, qs, ptrKey, fakeDebounceID.String(), 0); err != nil {
+			return err
+		}
+
+		// Prevent the timeout job from running, in case we are racing with StartExecution().
+		if err := q.kvSet(ctx, qs, kvDebounceMigratingPrefix+ptr.Value, "1", 0); err != nil {
+			return err
+		}
+
+		existingID = ptr.Value
+		prev := debounceTimes{}
+		if err := json.Unmarshal([]byte(existing), &prev); err == nil && prev.Timeout > 0 {
+			timeoutMillis = prev.Timeout
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("error preparing debounce migration: %w", err)
+	}
+
+	if existingID == "" {
+		return nil, 0, 0, nil
+	}
+
+	id, err := ulid.Parse(existingID)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("unknown debounce ID return value: %s", existingID)
+	}
+	return &id, timeoutMillis, pointerTTL, nil
+}
+
+// DebounceGetItem implements queue.DebounceOperations.
+func (q *queue) DebounceGetItem(ctx context.Context, scope osqueue.Scope, debounceID ulid.ULID) ([]byte, error) {
+	val, ok, err := q.kvGet(ctx, q.queries, kvDebounceItemPrefix+debounceID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, osqueue.ErrDebounceNotFound
+	}
+	return []byte(val), nil
+}
+
+// DebounceDeleteItems implements queue.DebounceOperations.
+func (q *queue) DebounceDeleteItems(ctx context.Context, scope osqueue.Scope, debounceIDs ...ulid.ULID) error {
+	if len(debounceIDs) == 0 {
+		return nil
+	}
+
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		for _, id := range debounceIDs {
+			if err := q.kvDelete(ctx, qs, kvDebounceItemPrefix+id.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error removing debounce: %w", err)
+	}
+	return nil
+}
+
+// DebounceDeleteMigratingFlag implements queue.DebounceOperations.
+func (q *queue) DebounceDeleteMigratingFlag(ctx context.Context, scope osqueue.Scope, debounceID ulid.ULID) error {
+	if err := q.kvDelete(ctx, q.queries, kvDebounceMigratingPrefix+debounceID.String()); err != nil {
+		return fmt.Errorf("error removing debounce migrating flag: %w", err)
+	}
+	return nil
+}
+
+// DebounceGetPointer implements queue.DebounceOperations.
+func (q *queue) DebounceGetPointer(ctx context.Context, scope osqueue.Scope, key string) (string, error) {
+	val, ok, err := q.kvGet(ctx, q.queries, debouncePointerKey(scope, key))
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", osqueue.ErrDebounceNotFound
+	}
+	return val, nil
+}
+
+// DebounceSetPointer implements queue.DebounceOperations.
+func (q *queue) DebounceSetPointer(ctx context.Context, scope osqueue.Scope, key string, debounceID ulid.ULID, ttl time.Duration) error {
+	if err := q.kvSet(ctx, q.queries, debouncePointerKey(scope, key), debounceID.String(), ttl); err != nil {
+		return fmt.Errorf("error setting debounce pointer: %w", err)
+	}
+	return nil
+}
+
+// DebounceDeletePointer implements queue.DebounceOperations.
+func (q *queue) DebounceDeletePointer(ctx context.Context, scope osqueue.Scope, key string) error {
+	if err := q.kvDelete(ctx, q.queries, debouncePointerKey(scope, key)); err != nil {
+		return fmt.Errorf("error deleting debounce pointer: %w", err)
+	}
+	return nil
+}
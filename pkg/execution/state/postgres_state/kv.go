@@ -0,0 +1,112 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+)
+
+// Keys used within the queue_kv table.  Each mirrors a standalone key used by
+// the Redis queue.
+const (
+	kvIdempotencyPrefix       = "idempotency:"
+	kvSingletonPrefix         = "singleton:"
+	kvSingletonRunPrefix      = "singleton-run:"
+	kvEarliestPeekTimePrefix  = "peek-time:"
+	kvMigrationLockPrefix     = "migrate:"
+	kvThrottlePrefix          = "throttle:"
+	kvRoleLeasePrefix         = "role:"
+	kvShardLeasePrefix        = "shard-lease:"
+	kvDebouncePointerPrefix   = "debounce:"
+	kvDebounceItemPrefix      = "debounce-item:"
+	kvDebounceMigratingPrefix = "debounce-migrating:"
+	kvPeekEWMAPrefix          = "ewma:"
+	kvShardRoutePrefix        = "shard-route:"
+	kvShardMigrationPrefix    = "shard-migration:"
+	kvBacklogNormalizePrefix  = "backlog-normalize:"
+)
+
+// kvGet returns the unexpired value stored for key.  The boolean is false if
+// the key does not exist.
+func (q *queue) kvGet(ctx context.Context, qs *sqlc.Queries, key string) (string, bool, error) {
+	row, err := qs.GetQueueKV(ctx, sqlc.GetQueueKVParams{
+		Shard: q.name,
+		Key:   key,
+		NowMs: q.Clock.Now().UnixMilli(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return row.Value, true, nil
+}
+
+// kvGetRow returns the unexpired row stored for key, or nil if the key
+// does not exist.
+func (q *queue) kvGetRow(ctx context.Context, qs *sqlc.Queries, key string) (*sqlc.QueueKv, error) {
+	row, err := qs.GetQueueKV(ctx, sqlc.GetQueueKVParams{
+		Shard: q.name,
+		Key:   key,
+		NowMs: q.Clock.Now().UnixMilli(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return row, err
+}
+
+// kvSet upserts key.  A ttl of zero stores the key without an expiry.
+func (q *queue) kvSet(ctx context.Context, qs *sqlc.Queries, key, value string, ttl time.Duration) error {
+	return qs.SetQueueKV(ctx, sqlc.SetQueueKVParams{
+		Shard:       q.name,
+		Key:         key,
+		Value:       value,
+		ExpiresAtMs: q.kvExpiry(ttl),
+	})
+}
+
+// kvSetAt upserts key, expiring the key at the given time.
+func (q *queue) kvSetAt(ctx context.Context, qs *sqlc.Queries, key, value string, expiresAt time.Time) error {
+	return qs.SetQueueKV(ctx, sqlc.SetQueueKVParams{
+		Shard:       q.name,
+		Key:         key,
+		Value:       value,
+		ExpiresAtMs: sql.NullInt64{Int64: expiresAt.UnixMilli(), Valid: true},
+	})
+}
+
+// kvSetIfAbsent stores key only if it does not exist or has expired, returning
+// whether the key was stored.
+func (q *queue) kvSetIfAbsent(ctx context.Context, qs *sqlc.Queries, key, value string, ttl time.Duration) (bool, error) {
+	n, err := qs.SetQueueKVIfAbsent(ctx, sqlc.SetQueueKVIfAbsentParams{
+		Shard:       q.name,
+		Key:         key,
+		Value:       value,
+		ExpiresAtMs: q.kvExpiry(ttl),
+		NowMs:       q.Clock.Now().UnixMilli(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (q *queue) kvDelete(ctx context.Context, qs *sqlc.Queries, key string) error {
+	_, err := qs.DeleteQueueKV(ctx, sqlc.DeleteQueueKVParams{
+		Shard: q.name,
+		Key:   key,
+	})
+	return err
+}
+
+func (q *queue) kvExpiry(ttl time.Duration) sql.NullInt64 {
+	if ttl <= 0 {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: q.Clock.Now().Add(ttl).UnixMilli(), Valid: true}
+}
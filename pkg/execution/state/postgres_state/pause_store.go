@@ -0,0 +1,514 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+// Kinds of lookups within the run_state_pause_lookups table.
+const (
+	pauseLookupInvoke = "invoke"
+	pauseLookupSignal = "signal"
+)
+
+// pausePageSize is the number of pauses loaded by each query while iterating.
+const pausePageSize = 100
+
+// pauseExpiryGrace is how long a pause is kept after it expires, allowing it
+// to be loaded by ID when handling timeouts.  This mirrors the Redis store.
+const pauseExpiryGrace = 10 * time.Minute
+
+var _ state.PauseManager = (*PauseStore)(nil)
+
+// PauseStore implements pause operations using Postgres.
+//
+// Pauses are never flushed to block storage;  the table acts as both the
+// buffer and the index of pauses for each run.
+type PauseStore struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewPauseStore creates a new PauseStore using the given database.  The
+// database must have all migrations applied.
+func NewPauseStore(db *sql.DB) *PauseStore {
+	return &PauseStore{db: db, queries: sqlc.New(db)}
+}
+
+func (s *PauseStore) tx(ctx context.Context, f func(qs *sqlc.Queries) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	if err := f(s.queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// pauseEventName returns the event name a pause is indexed under.  Invoke
+// pauses are matched by correlation ID, and are not indexed by event name.
+func pauseEventName(p state.Pause) string {
+	if p.Event != nil && (p.InvokeCorrelationID == nil || *p.InvokeCorrelationID == "") {
+		return *p.Event
+	}
+	return ""
+}
+
+func (s *PauseStore) SavePause(ctx context.Context, p state.Pause) (int64, error) {
+	evt := pauseEventName(p)
+
+	createdAt := p.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	p.CreatedAt = createdAt
+
+	packed, err := json.Marshal(p)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = s.tx(ctx, func(qs *sqlc.Queries) error {
+		now := time.Now().UnixMilli()
+		n, err := qs.InsertRunStatePause(ctx, sqlc.InsertRunStatePauseParams{
+			PauseID:     p.ID.String(),
+			WorkspaceID: p.WorkspaceID.String(),
+			RunID:       p.Identifier.RunID.String(),
+			EventName:   evt,
+			Data:        packed,
+			CreatedAtS:  createdAt.Unix(),
+			ExpiresAtMs: p.Expires.Time().Add(pauseExpiryGrace).UnixMilli(),
+			NowMs:       now,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return state.ErrPauseAlreadyExists
+		}
+
+		if p.InvokeCorrelationID != nil && *p.InvokeCorrelationID != "" {
+			if _, err := qs.SetRunStatePauseLookupIfAbsent(ctx, sqlc.SetRunStatePauseLookupIfAbsentParams{
+				WorkspaceID: p.WorkspaceID.String(),
+				Kind:        pauseLookupInvoke,
+				LookupKey:   *p.InvokeCorrelationID,
+				PauseID:     p.ID.String(),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if p.SignalID != nil && *p.SignalID != "" {
+			if err := saveSignal(ctx, qs, p); err != nil {
+				return err
+			}
+		}
+
+		count, err = qs.CountRunStatePauses(ctx, sqlc.CountRunStatePausesParams{
+			WorkspaceID: p.WorkspaceID.String(),
+			EventName:   evt,
+			NowMs:       now,
+		})
+		return err
+	})
+	if errors.Is(err, state.ErrPauseAlreadyExists) {
+		return -1, err
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// saveSignal maps the pause's signal ID to the pause.  Unless the pause may
+// replace an existing signal, this returns ErrSignalConflict if the signal
+// already belongs to another pause.
+func saveSignal(ctx context.Context, qs *sqlc.Queries, p state.Pause) error {
+	if p.ReplaceSignalOnConflict {
+		// This may overwrite an existing signal wait, which is left to reach
+		// its timeout.
+		return qs.SetRunStatePauseLookup(ctx, sqlc.SetRunStatePauseLookupParams{
+			WorkspaceID: p.WorkspaceID.String(),
+			Kind:        pauseLookupSignal,
+			LookupKey:   *p.SignalID,
+			PauseID:     p.ID.String(),
+		})
+	}
+
+	n, err := qs.SetRunStatePauseLookupIfAbsent(ctx, sqlc.SetRunStatePauseLookupIfAbsentParams{
+		WorkspaceID: p.WorkspaceID.String(),
+		Kind:        pauseLookupSignal,
+		LookupKey:   *p.SignalID,
+		PauseID:     p.ID.String(),
+	})
+	if err != nil || n > 0 {
+		return err
+	}
+
+	// The signal already exists.  This is fine if this is an idempotent
+	// retry saving the same pause.
+	existing, err := qs.GetRunStatePauseLookup(ctx, sqlc.GetRunStatePauseLookupParams{
+		WorkspaceID: p.WorkspaceID.String(),
+		Kind:        pauseLookupSignal,
+		LookupKey:   *p.SignalID,
+	})
+	if err != nil {
+		return err
+	}
+	if existing != p.ID.String() {
+		return state.ErrSignalConflict
+	}
+	return nil
+}
+
+func (s *PauseStore) DeletePause(ctx context.Context, p state.Pause, options ...state.DeletePauseOpt) error {
+	opts := state.DeletePauseOpts{}
+	for _, fn := range options {
+		fn(&opts)
+	}
+
+	var deleted int64
+	err := s.tx(ctx, func(qs *sqlc.Queries) error {
+		var err error
+		deleted, err = qs.DeleteRunStatePause(ctx, p.ID.String())
+		if err != nil {
+			return err
+		}
+
+		lookups := map[string]*string{
+			pauseLookupInvoke: p.InvokeCorrelationID,
+			pauseLookupSignal: p.SignalID,
+		}
+		for kind, key := range lookups {
+			if key == nil || *key == "" {
+				continue
+			}
+			// Only remove the lookup if it belongs to this pause.
+			if err := qs.DeleteRunStatePauseLookup(ctx, sqlc.DeleteRunStatePauseLookupParams{
+				WorkspaceID: p.WorkspaceID.String(),
+				Kind:        kind,
+				LookupKey:   *key,
+				PauseID:     p.ID.String(),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting pause: %w", err)
+	}
+
+	// Pauses are never written to blocks, so a block delete of a pause which
+	// is not in the table means the pause was already removed.
+	if deleted == 0 && opts.WriteBlockIndex.BlockID != "" {
+		return state.ErrPauseNotInBuffer
+	}
+	return nil
+}
+
+func (s *PauseStore) DeletePauseByID(ctx context.Context, pauseID uuid.UUID, workspaceID uuid.UUID) error {
+	pause, err := s.PauseByID(ctx, pauseID)
+	if err != nil {
+		if err == state.ErrPauseNotFound {
+			// pause doesn't exist, nothing to delete
+			return nil
+		}
+		return err
+	}
+	return s.DeletePause(ctx, *pause)
+}
+
+func (s *PauseStore) PauseIDsForRun(ctx context.Context, runID ulid.ULID) ([]uuid.UUID, error) {
+	ids, err := s.queries.GetRunStatePauseIDs(ctx, runID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	pauseIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		pauseID, err := uuid.Parse(id)
+		if err != nil {
+			logger.StdlibLogger(ctx).Error("invalid pause ID in run pauses", "error", err, "pauseID", id, "runID", runID)
+			continue
+		}
+		pauseIDs = append(pauseIDs, pauseID)
+	}
+	return pauseIDs, nil
+}
+
+// DeleteRunPausesIndex is a no-op:  the pauses table is the index of pauses
+// for each run, and rows are removed as pauses are deleted.
+func (s *PauseStore) DeleteRunPausesIndex(ctx context.Context, runID ulid.ULID) error {
+	return nil
+}
+
+func (s *PauseStore) DeletePausesForRun(ctx context.Context, runID ulid.ULID, workspaceID uuid.UUID) error {
+	pauseIDs, err := s.PauseIDsForRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	for _, pauseID := range pauseIDs {
+		if err := s.DeletePauseByID(ctx, pauseID, workspaceID); err != nil {
+			return err
+		}
+	}
+
+	// Clear out any expired pauses for the workspace while we're here.
+	if _, err := s.queries.DeleteExpiredRunStatePauses(ctx, sqlc.DeleteExpiredRunStatePausesParams{
+		WorkspaceID: workspaceID.String(),
+		NowMs:       time.Now().UnixMilli(),
+	}); err != nil {
+		logger.StdlibLogger(ctx).Warn("error deleting expired pauses", "error", err)
+	}
+	if _, err := s.queries.DeleteOrphanedRunStatePauseLookups(ctx, workspaceID.String()); err != nil {
+		logger.StdlibLogger(ctx).Warn("error deleting orphaned pause lookups", "error", err)
+	}
+	return nil
+}
+
+func (s *PauseStore) EventHasPauses(ctx context.Context, workspaceID uuid.UUID, event string) (bool, error) {
+	n, err := s.PauseLen(ctx, workspaceID, event)
+	return n > 0, err
+}
+
+func (s *PauseStore) PauseByID(ctx context.Context, pauseID uuid.UUID) (*state.Pause, error) {
+	data, err := s.queries.GetRunStatePause(ctx, sqlc.GetRunStatePauseParams{
+		PauseID: pauseID.String(),
+		NowMs:   time.Now().UnixMilli(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, state.ErrPauseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	pause := &state.Pause{}
+	err = json.Unmarshal(data, pause)
+	return pause, err
+}
+
+// pauseLookup returns the ID of the pause stored for the given lookup.  The
+// boolean is false if there is no such lookup.
+func (s *PauseStore) pauseLookup(ctx context.Context, wsID uuid.UUID, kind, key string) (uuid.UUID, bool, error) {
+	id, err := s.queries.GetRunStatePauseLookup(ctx, sqlc.GetRunStatePauseLookupParams{
+		WorkspaceID: wsID.String(),
+		Kind:        kind,
+		LookupKey:   key,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.UUID{}, false, nil
+	}
+	if err != nil {
+		return uuid.UUID{}, false, err
+	}
+	pauseID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("failed to parse pauseID UUID: %w", err)
+	}
+	return pauseID, true, nil
+}
+
+func (s *PauseStore) PauseByInvokeCorrelationID(ctx context.Context, wsID uuid.UUID, correlationID string) (*state.Pause, error) {
+	pauseID, ok, err := s.pauseLookup(ctx, wsID, pauseLookupInvoke, correlationID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, state.ErrInvokePauseNotFound
+	}
+	return s.PauseByID(ctx, pauseID)
+}
+
+func (s *PauseStore) PauseBySignalID(ctx context.Context, wsID uuid.UUID, signalID string) (*state.Pause, error) {
+	pauseID, ok, err := s.pauseLookup(ctx, wsID, pauseLookupSignal, signalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signalID: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	p, err := s.PauseByID(ctx, pauseID)
+	if err != nil {
+		if err == state.ErrPauseNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pause by ID: %w", err)
+	}
+	return p, nil
+}
+
+// PauseCreatedAt returns the timestamp a pause was created, using the given
+// workspace <> event Index.
+func (s *PauseStore) PauseCreatedAt(ctx context.Context, workspaceID uuid.UUID, event string, pauseID uuid.UUID) (time.Time, error) {
+	ts, err := s.queries.GetRunStatePauseCreatedAt(ctx, sqlc.GetRunStatePauseCreatedAtParams{
+		WorkspaceID: workspaceID.String(),
+		EventName:   event,
+		PauseID:     pauseID.String(),
+		NowMs:       time.Now().UnixMilli(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, state.ErrPauseNotFound
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(ts, 0), nil
+}
+
+func (s *PauseStore) PauseLen(ctx context.Context, workspaceID uuid.UUID, event string) (int64, error) {
+	if event == "" {
+		// Mirror the Redis store, which only indexes pauses with an event.
+		return 0, nil
+	}
+	return s.queries.CountRunStatePauses(ctx, sqlc.CountRunStatePausesParams{
+		WorkspaceID: workspaceID.String(),
+		EventName:   event,
+		NowMs:       time.Now().UnixMilli(),
+	})
+}
+
+// PausesByEvent returns all pauses for a given event within a workspace.
+func (s *PauseStore) PausesByEvent(ctx context.Context, workspaceID uuid.UUID, event string) (state.PauseIterator, error) {
+	return s.PausesByEventSince(ctx, workspaceID, event, time.Time{})
+}
+
+func (s *PauseStore) PausesByEventSince(ctx context.Context, workspaceID uuid.UUID, event string, since time.Time) (state.PauseIterator, error) {
+	return s.PausesByEventSinceWithCreatedAt(ctx, workspaceID, event, since, 0)
+}
+
+// PausesByEventSinceWithCreatedAt returns up to limit pauses for an event,
+// ordered by creation time.  A limit of zero returns all pauses.
+func (s *PauseStore) PausesByEventSinceWithCreatedAt(ctx context.Context, workspaceID uuid.UUID, event string, since time.Time, limit int64) (state.PauseIterator, error) {
+	now := time.Now().UnixMilli()
+	count, err := s.queries.CountRunStatePauses(ctx, sqlc.CountRunStatePausesParams{
+		WorkspaceID: workspaceID.String(),
+		EventName:   event,
+		NowMs:       now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && count > limit {
+		count = limit
+	}
+
+	afterCreatedAt := int64(-1)
+	if !since.IsZero() {
+		// The since time is inclusive.
+		afterCreatedAt = since.Unix() - 1
+	}
+
+	return &pauseIter{
+		qs:             s.queries,
+		workspaceID:    workspaceID.String(),
+		event:          event,
+		nowMs:          now,
+		count:          int(count),
+		limit:          limit,
+		afterCreatedAt: afterCreatedAt,
+	}, nil
+}
+
+// pauseIter iterates over the pauses for an event in pages, ordered by
+// creation time.
+type pauseIter struct {
+	qs          *sqlc.Queries
+	workspaceID string
+	event       string
+	nowMs       int64
+	count       int
+	limit       int64
+
+	// afterCreatedAt and afterID are the cursor for the next page.
+	afterCreatedAt int64
+	afterID        string
+
+	page []*state.Pause
+	val  *state.Pause
+	n    int64
+	done bool
+	err  error
+}
+
+func (i *pauseIter) Count() int {
+	return i.count
+}
+
+func (i *pauseIter) Index() int64 {
+	return i.n
+}
+
+func (i *pauseIter) Error() error {
+	return i.err
+}
+
+func (i *pauseIter) Val(context.Context) *state.Pause {
+	return i.val
+}
+
+func (i *pauseIter) Next(ctx context.Context) bool {
+	if i.limit > 0 && i.n >= i.limit {
+		return false
+	}
+	for len(i.page) == 0 && !i.done {
+		if err := i.fetch(ctx); err != nil {
+			i.err = err
+			return false
+		}
+	}
+	if len(i.page) == 0 {
+		return false
+	}
+
+	i.val, i.page = i.page[0], i.page[1:]
+	i.n++
+	return true
+}
+
+func (i *pauseIter) fetch(ctx context.Context) error {
+	rows, err := i.qs.ListRunStatePauses(ctx, sqlc.ListRunStatePausesParams{
+		WorkspaceID:     i.workspaceID,
+		EventName:       i.event,
+		NowMs:           i.nowMs,
+		AfterCreatedAtS: i.afterCreatedAt,
+		AfterPauseID:    i.afterID,
+		MaxRows:         pausePageSize,
+	})
+	if err != nil {
+		return err
+	}
+	if len(rows) < pausePageSize {
+		i.done = true
+	}
+
+	for _, row := range rows {
+		i.afterCreatedAt, i.afterID = row.CreatedAtS, row.PauseID
+
+		pause := &state.Pause{}
+		if err := json.Unmarshal(row.Data, pause); err != nil {
+			logger.StdlibLogger(ctx).Error("error unmarshalling pause", "error", err, "pauseID", row.PauseID)
+			continue
+		}
+		if pause.CreatedAt.IsZero() {
+			pause.CreatedAt = time.Unix(row.CreatedAtS, 0)
+		}
+		i.page = append(i.page, pause)
+	}
+	return nil
+}
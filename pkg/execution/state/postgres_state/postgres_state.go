@@ -0,0 +1,43 @@
+// Package postgres_state implements a queue shard and a run state store
+// backed by PostgreSQL.
+//
+// Queue items, partitions and the small keyed values used for idempotency,
+// singletons, debounces and leases are stored in the queue_items,
+// queue_partitions and queue_kv tables.  Items are claimed using row locks
+// with SKIP LOCKED, allowing many shared-nothing workers to lease from the
+// same database without contention.
+//
+// Key queues store their backlogs and shadow partitions in the queue_backlogs
+// and queue_shadow_partitions tables, with backlogged items kept in
+// queue_items until they're refilled into their partition.
+//
+// Run state (metadata, events, steps, pending steps and defers) is stored in
+// the run_state tables, with function idempotency keys and finalization claims
+// in run_state_kv.  Each mutation runs in a transaction holding a row lock on
+// the run, giving the same atomicity as the Redis store's Lua scripts.
+//
+// Pauses are stored in run_state_pauses, with invoke correlation IDs and
+// signal IDs mapped to pauses in run_state_pause_lookups.
+package postgres_state
+
+import (
+	"github.com/inngest/inngest/pkg/config/registration"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/util"
+)
+
+var rnd *util.FrandRNG
+
+func init() {
+	registration.RegisterQueue(func() any { return registration.QueueConfig(&queueConfig{}) })
+
+	// For weighted shuffles generate a new rand.
+	rnd = util.NewFrandRNG()
+}
+
+type queueConfig struct{}
+
+func (c queueConfig) QueueName() string             { return "postgres" }
+func (c queueConfig) Queue() (osqueue.Queue, error) { return nil, nil }
+func (c queueConfig) Consumer() osqueue.Consumer    { return nil }
+func (c queueConfig) Producer() osqueue.Producer    { return nil }
@@ -0,0 +1,1781 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VividCortex/ewma"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/telemetry/metrics"
+	itrace "github.com/inngest/inngest/pkg/telemetry/trace"
+	"github.com/inngest/inngest/pkg/util"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"gonum.org/v1/gonum/stat/sampleuv"
+)
+
+const (
+	pkgName = "postgres_state.state.execution.inngest"
+)
+
+func (q *queue) Name() string {
+	return q.name
+}
+
+func (q *queue) Kind() enums.QueueShardKind {
+	return enums.QueueShardKindPostgres
+}
+
+func (q *queue) ShardAssignmentConfig() osqueue.ShardAssignmentConfig {
+	return q.QueueOptions.ShardAssignmentConfig
+}
+
+// NewQueueShard returns a queue shard which stores all queue state within the
+// given Postgres database.  The database must have the queue tables migrated.
+func NewQueueShard(name string, db *sql.DB, opts ...osqueue.QueueOpt) osqueue.QueueShard {
+	options := osqueue.NewQueueOptions(opts...)
+
+	return &queue{
+		name:         name,
+		QueueOptions: *options,
+		db:           db,
+		queries:      sqlc.New(db),
+	}
+}
+
+type queue struct {
+	osqueue.QueueOptions
+
+	name string
+
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// tx runs f within a single transaction, committing if f returns nil.
+func (q *queue) tx(ctx context.Context, f func(qs *sqlc.Queries) error) error {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	if err := f(q.queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// partitionAccountID returns the account ID stored alongside a partition.
+// System partitions are never part of account queues.
+func partitionAccountID(p osqueue.QueuePartition) string {
+	if p.IsSystem() || p.AccountID == uuid.Nil {
+		return ""
+	}
+	return p.AccountID.String()
+}
+
+// itemFunctionID returns the function ID stored alongside a queue item, used
+// for in-progress counts.  System items are never counted against functions.
+func itemFunctionID(p osqueue.QueuePartition) string {
+	if p.IsSystem() || p.FunctionID == nil {
+		return ""
+	}
+	return p.FunctionID.String()
+}
+
+func decodeQueueItem(byt []byte) (*osqueue.QueueItem, error) {
+	qi := &osqueue.QueueItem{}
+	if err := json.Unmarshal(byt, qi); err != nil {
+		return nil, fmt.Errorf("error unmarshalling queue item: %w", err)
+	}
+	// The nested osqueue.Item never has an ID set;  always re-set it
+	qi.Data.JobID = &qi.ID
+	return qi, nil
+}
+
+func decodeQueuePartition(byt []byte) (*osqueue.QueuePartition, error) {
+	qp := &osqueue.QueuePartition{}
+	if err := json.Unmarshal(byt, qp); err != nil {
+		return nil, fmt.Errorf("error unmarshalling queue partition: %w", err)
+	}
+	return qp, nil
+}
+
+func (q *queue) EnqueueItem(ctx context.Context, i osqueue.QueueItem, at time.Time, opts osqueue.EnqueueOpts) (osqueue.QueueItem, error) {
+	l := logger.StdlibLogger(ctx)
+
+	if len(i.ID) == 0 {
+		i.SetID(ctx, ulid.MustNew(ulid.Now(), rnd).String())
+	} else {
+		if !opts.PassthroughJobId {
+			i.SetID(ctx, i.ID)
+		}
+	}
+
+	now := q.Clock.Now()
+
+	if i.WallTimeMS == 0 {
+		i.WallTimeMS = at.UnixMilli()
+	}
+
+	if at.Before(now) {
+		// Normalize to now to minimize latency.
+		i.WallTimeMS = now.UnixMilli()
+	}
+
+	// Add the At timestamp, if not included.
+	if i.AtMS == 0 {
+		i.AtMS = at.UnixMilli()
+	}
+
+	if i.Data.JobID == nil {
+		i.Data.JobID = &i.ID
+	}
+
+	// Start GenerationID at 1 so the very first dispatch carries a non-zero
+	// value to the SDK. The validator treats 0 as "no value sent"
+	if i.GenerationID == 0 {
+		i.GenerationID = 1
+	}
+
+	partitionTime := at
+	if at.Before(now) {
+		// We don't want to enqueue partitions (pointers to fns) before now.
+		// Doing so allows users to stay at the front of the queue for
+		// leases.
+		partitionTime = now
+	}
+
+	i.EnqueuedAt = now.UnixMilli()
+
+	partition := osqueue.ItemPartition(ctx, i)
+
+	if partition.AccountID == uuid.Nil && !partition.IsSystem() {
+		l.Warn("attempting to enqueue item to non-system partition without account ID", "item", i)
+	}
+
+	enqueueToBacklog := q.ItemEnableKeyQueues(ctx, i)
+
+	var backlog osqueue.QueueBacklog
+	var shadowPartition osqueue.QueueShadowPartition
+	if enqueueToBacklog {
+		backlog = osqueue.ItemBacklog(ctx, i)
+		shadowPartition = osqueue.ItemShadowPartition(ctx, i)
+	}
+
+	// Only skip idempotency checks if we're normalizing a backlog, moving an
+	// existing item to its new backlog.
+	isNormalize := opts.NormalizeFromBacklogID != ""
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.EnqueueItem", osqueue.TraceScopeFromQueueItem(i, q.Name()))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", partition.ID))
+	span.SetAttributes(attribute.String("item_id", i.ID))
+	span.SetAttributes(attribute.String("run_id", i.Data.Identifier.RunID.String()))
+	if i.Data.JobID != nil {
+		span.SetAttributes(attribute.String("job_id", *i.Data.JobID))
+	}
+
+	byt, err := json.Marshal(i)
+	if err != nil {
+		return i, fmt.Errorf("error marshalling queue item: %w", err)
+	}
+
+	l.Trace("enqueue item",
+		"id", i.ID,
+		"kind", i.Data.Kind,
+		"time", at.Format(time.StampMilli),
+		"partition_time", partitionTime.Format(time.StampMilli),
+		"partition", partition.ID,
+		"backlog", enqueueToBacklog,
+	)
+
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		if !isNormalize {
+			if _, ok, err := q.kvGet(ctx, qs, kvIdempotencyPrefix+i.ID); err != nil {
+				return fmt.Errorf("error checking idempotency key: %w", err)
+			} else if ok {
+				return osqueue.ErrQueueItemExists
+			}
+		}
+
+		n, err := qs.InsertQueueItem(ctx, sqlc.InsertQueueItemParams{
+			Shard:       q.name,
+			ID:          i.ID,
+			PartitionID: partition.ID,
+			AccountID:   i.Data.Identifier.AccountID.String(),
+			FunctionID:  itemFunctionID(partition),
+			RunID:       i.Data.Identifier.RunID.String(),
+			Kind:        i.Data.Kind,
+			ScoreMs:     at.UnixMilli(),
+			BacklogID:   nullBacklogID(backlog.BacklogID),
+			Item:        byt,
+		})
+		if err != nil {
+			return fmt.Errorf("error inserting queue item: %w", err)
+		}
+		if n == 0 {
+			if !isNormalize {
+				return osqueue.ErrQueueItemExists
+			}
+			if err := q.moveItemBacklog(ctx, qs, i.ID, at, backlog.BacklogID, byt); err != nil {
+				return err
+			}
+		}
+
+		if i.Data.Singleton != nil && i.Data.Singleton.Key != "" && !isNormalize {
+			singletonKey := kvSingletonPrefix + i.Data.Singleton.Key
+			runID := i.Data.Identifier.RunID.String()
+
+			stored, err := q.kvSetIfAbsent(ctx, qs, singletonKey, runID, 0)
+			if err != nil {
+				return fmt.Errorf("error setting singleton key: %w", err)
+			}
+			if !stored {
+				return osqueue.ErrQueueItemSingletonExists
+			}
+			if err := q.kvSet(ctx, qs, kvSingletonRunPrefix+runID, singletonKey, 0); err != nil {
+				return fmt.Errorf("error setting singleton run key: %w", err)
+			}
+		}
+
+		if !enqueueToBacklog && !isNormalize {
+			return q.enqueueToPartition(ctx, qs, partition, partitionTime, now)
+		}
+
+		spID := osqueue.ItemShadowPartition(ctx, i).PartitionID
+		if err := q.lockShadowPartition(ctx, qs, spID); err != nil {
+			return err
+		}
+
+		if enqueueToBacklog {
+			err = q.enqueueToBacklog(ctx, qs, backlog, shadowPartition, at.UnixMilli())
+		} else {
+			err = q.enqueueToPartition(ctx, qs, partition, partitionTime, now)
+		}
+		if err != nil {
+			return err
+		}
+
+		// Normalization only: clean up the old backlog after moving the item.
+		if isNormalize {
+			return q.updateBacklogPointer(ctx, qs, opts.NormalizeFromBacklogID, spID)
+		}
+		return nil
+	})
+
+	switch {
+	case err == nil:
+		return i, nil
+	case errors.Is(err, osqueue.ErrQueueItemExists):
+		var runID *ulid.ULID
+		if existing, loadErr := q.LoadQueueItem(ctx, i.ID); loadErr == nil {
+			id := existing.Data.Identifier.RunID
+			runID = &id
+		} else if loadErr != osqueue.ErrQueueItemNotFound {
+			return i, loadErr
+		}
+		return i, osqueue.QueueItemExists(i.ID, runID)
+	case errors.Is(err, osqueue.ErrQueueItemSingletonExists):
+		return i, osqueue.ErrQueueItemSingletonExists
+	default:
+		return i, fmt.Errorf("error enqueueing item: %w", err)
+	}
+}
+
+// enqueueToPartition stores the partition if it doesn't yet exist, and moves the
+// partition pointer earlier if partitionTime is before the current pointer.
+func (q *queue) enqueueToPartition(ctx context.Context, qs *sqlc.Queries, p osqueue.QueuePartition, partitionTime time.Time, now time.Time) error {
+	byt, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("error marshalling partition: %w", err)
+	}
+
+	n, err := qs.InsertQueuePartition(ctx, sqlc.InsertQueuePartitionParams{
+		Shard:     q.name,
+		ID:        p.ID,
+		AccountID: partitionAccountID(p),
+		AtS:       partitionTime.Unix(),
+		Partition: byt,
+	})
+	if err != nil {
+		return fmt.Errorf("error inserting partition: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	row, err := qs.GetQueuePartitionForUpdate(ctx, sqlc.GetQueuePartitionForUpdateParams{
+		Shard: q.name,
+		ID:    p.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("error loading partition: %w", err)
+	}
+	existing, err := decodeQueuePartition(row.Partition)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	atS := row.AtS
+
+	// Old partitions may not include an account ID;  migrate them just in time.
+	if existing.AccountID == uuid.Nil && p.AccountID != uuid.Nil {
+		existing.AccountID = p.AccountID
+		changed = true
+	}
+
+	// Don't continually update the pointer if the partition has been forced
+	// to a specific time.
+	if atS > partitionTime.Unix() && now.UnixMilli() > existing.ForceAtMS {
+		atS = partitionTime.Unix()
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return q.updatePartition(ctx, qs, existing, atS)
+}
+
+// requeueToPartition stores the partition if it doesn't yet exist, and moves the
+// partition pointer to the earliest ready item within the partition.
+func (q *queue) requeueToPartition(ctx context.Context, qs *sqlc.Queries, p osqueue.QueuePartition, now time.Time) error {
+	earliest, err := qs.EarliestReadyQueueItemScore(ctx, sqlc.EarliestReadyQueueItemScoreParams{
+		Shard:       q.name,
+		PartitionID: p.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading earliest partition item: %w", err)
+	}
+	earliestS := earliest / 1000
+
+	byt, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("error marshalling partition: %w", err)
+	}
+	n, err := qs.InsertQueuePartition(ctx, sqlc.InsertQueuePartitionParams{
+		Shard:     q.name,
+		ID:        p.ID,
+		AccountID: partitionAccountID(p),
+		AtS:       earliestS,
+		Partition: byt,
+	})
+	if err != nil {
+		return fmt.Errorf("error inserting partition: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	row, err := qs.GetQueuePartitionForUpdate(ctx, sqlc.GetQueuePartitionForUpdateParams{
+		Shard: q.name,
+		ID:    p.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("error loading partition: %w", err)
+	}
+	existing, err := decodeQueuePartition(row.Partition)
+	if err != nil {
+		return err
+	}
+
+	if row.AtS == earliestS || now.UnixMilli() <= existing.ForceAtMS {
+		return nil
+	}
+	return q.updatePartition(ctx, qs, existing, earliestS)
+}
+
+func (q *queue) updatePartition(ctx context.Context, qs *sqlc.Queries, p *osqueue.QueuePartition, atS int64) error {
+	byt, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("error marshalling partition: %w", err)
+	}
+	err = qs.UpdateQueuePartition(ctx, sqlc.UpdateQueuePartitionParams{
+		Shard:     q.name,
+		ID:        p.ID,
+		AccountID: partitionAccountID(*p),
+		AtS:       atS,
+		Partition: byt,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating partition: %w", err)
+	}
+	return nil
+}
+
+func (q *queue) SetFunctionMigrate(ctx context.Context, scope osqueue.Scope, migrateLockUntil *time.Time) error {
+	key := kvMigrationLockPrefix + scope.FunctionID.String()
+
+	if migrateLockUntil == nil {
+		if err := q.kvDelete(ctx, q.queries, key); err != nil {
+			return fmt.Errorf("could not remove migration lock: %w", err)
+		}
+		return nil
+	}
+
+	lockID, err := ulid.New(ulid.Timestamp(*migrateLockUntil), rnd)
+	if err != nil {
+		return fmt.Errorf("could not generate lockID: %w", err)
+	}
+	if err := q.kvSetAt(ctx, q.queries, key, lockID.String(), *migrateLockUntil); err != nil {
+		return fmt.Errorf("could not set migration lock: %w", err)
+	}
+	return nil
+}
+
+func (q *queue) IsMigrationLocked(ctx context.Context, scope osqueue.Scope) (*time.Time, error) {
+	val, ok, err := q.kvGet(ctx, q.queries, kvMigrationLockPrefix+scope.FunctionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("could not check for migration lock: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	parsed, err := ulid.Parse(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lock format: %w", err)
+	}
+
+	lockUntil := parsed.Timestamp()
+	return &lockUntil, nil
+}
+
+func shardRouteKey(scope osqueue.Scope) string {
+	if scope.FunctionID == uuid.Nil {
+		return kvShardRoutePrefix + scope.AccountID.String()
+	}
+	return kvShardRoutePrefix + scope.AccountID.String() + ":" + scope.FunctionID.String()
+}
+
+func (q *queue) ShardRoute(ctx context.Context, scope osqueue.Scope) (string, error) {
+	keys := []string{kvShardRoutePrefix + scope.AccountID.String()}
+	if scope.FunctionID != uuid.Nil {
+		// The function route takes precedence.
+		keys = append([]string{shardRouteKey(scope)}, keys...)
+	}
+
+	for _, key := range keys {
+		val, ok, err := q.kvGet(ctx, q.queries, key)
+		if err != nil {
+			return "", fmt.Errorf("could not load shard route: %w", err)
+		}
+		if ok && val != "" {
+			return val, nil
+		}
+	}
+	return "", nil
+}
+
+func (q *queue) SetShardRoute(ctx context.Context, scope osqueue.Scope, shardName string) error {
+	if shardName == "" {
+		if err := q.kvDelete(ctx, q.queries, shardRouteKey(scope)); err != nil {
+			return fmt.Errorf("could not remove shard route: %w", err)
+		}
+		return nil
+	}
+	if err := q.kvSet(ctx, q.queries, shardRouteKey(scope), shardName, 0); err != nil {
+		return fmt.Errorf("could not set shard route: %w", err)
+	}
+	return nil
+}
+
+// SaveShardMigration stores the migration in queue_kv.  Finished migrations
+// expire after ShardMigrationRetention.
+func (q *queue) SaveShardMigration(ctx context.Context, m osqueue.ShardMigration) error {
+	byt, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("could not marshal shard migration: %w", err)
+	}
+
+	key := kvShardMigrationPrefix + m.ID.String()
+	if m.Done() {
+		err = q.kvSetAt(ctx, q.queries, key, string(byt), m.FinishedAt.Add(osqueue.ShardMigrationRetention))
+	} else {
+		err = q.kvSet(ctx, q.queries, key, string(byt), 0)
+	}
+	if err != nil {
+		return fmt.Errorf("could not save shard migration: %w", err)
+	}
+	return nil
+}
+
+func (q *queue) ShardMigrations(ctx context.Context) ([]osqueue.ShardMigration, error) {
+	rows, err := q.queries.ListQueueKVByPrefix(ctx, sqlc.ListQueueKVByPrefixParams{
+		Shard:  q.name,
+		Prefix: kvShardMigrationPrefix,
+		NowMs:  q.Clock.Now().UnixMilli(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load shard migrations: %w", err)
+	}
+
+	result := make([]osqueue.ShardMigration, 0, len(rows))
+	for _, row := range rows {
+		var m osqueue.ShardMigration
+		if err := json.Unmarshal([]byte(row.Value), &m); err != nil {
+			return nil, fmt.Errorf("could not unmarshal shard migration %s: %w", strings.TrimPrefix(row.Key, kvShardMigrationPrefix), err)
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// RemoveQueueItem removes a specific item from the queue, along with its
+// earliest peek time.
+func (q *queue) RemoveQueueItem(ctx context.Context, scope osqueue.Scope, partitionID string, itemID string) error {
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{Shard: q.name, ID: itemID})
+		if errors.Is(err, sql.ErrNoRows) {
+			return q.kvDelete(ctx, qs, kvEarliestPeekTimePrefix+itemID)
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := qs.DeleteQueueItem(ctx, sqlc.DeleteQueueItemParams{Shard: q.name, ID: itemID}); err != nil {
+			return err
+		}
+		if err := q.kvDelete(ctx, qs, kvEarliestPeekTimePrefix+itemID); err != nil {
+			return err
+		}
+
+		if !row.BacklogID.Valid {
+			return nil
+		}
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return err
+		}
+		spID := osqueue.ItemShadowPartition(ctx, *qi).PartitionID
+		if err := q.lockShadowPartition(ctx, qs, spID); err != nil {
+			return err
+		}
+		return q.updateBacklogPointer(ctx, qs, row.BacklogID.String, spID)
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting queue item: %w", err)
+	}
+
+	logger.StdlibLogger(ctx).Debug("removed queue item", "item_id", itemID)
+	return nil
+}
+
+func (q *queue) LoadQueueItem(ctx context.Context, itemID string) (*osqueue.QueueItem, error) {
+	row, err := q.queries.GetQueueItem(ctx, sqlc.GetQueueItemParams{
+		Shard: q.name,
+		ID:    itemID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, osqueue.ErrQueueItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load queue item: %w", err)
+	}
+	return decodeQueueItem(row.Item)
+}
+
+func (q *queue) SetEarliestPeekTime(ctx context.Context, item osqueue.QueueItem, at time.Time) (time.Time, error) {
+	if item.ID == "" {
+		return time.Time{}, fmt.Errorf("cannot set earliest peek time for queue item with empty ID")
+	}
+
+	at = time.UnixMilli(at.UnixMilli())
+	key := kvEarliestPeekTimePrefix + item.ID
+
+	var result time.Time
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		stored, err := q.kvSetIfAbsent(ctx, qs, key, strconv.FormatInt(at.UnixMilli(), 10), osqueue.QueueItemEarliestPeekTimeTTL)
+		if err != nil {
+			return err
+		}
+		if stored {
+			result = at
+			return nil
+		}
+
+		prev, _, err := q.kvGet(ctx, qs, key)
+		if err != nil {
+			return err
+		}
+		ms, err := strconv.ParseInt(prev, 10, 64)
+		if err != nil {
+			return fmt.Errorf("could not parse earliest peek time %q: %w", prev, err)
+		}
+		result = time.UnixMilli(ms)
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not set earliest peek time: %w", err)
+	}
+	return result, nil
+}
+
+// Peek takes n items from a queue, up until QueuePeekMax.  For peeking workflow/
+// function jobs the queue name must be the ID of the workflow;  each workflow has
+// its own queue of jobs using its ID as the queue name.
+//
+// If limit is -1, this will return the first unleased item - representing the next available item in the
+// queue.
+func (q *queue) Peek(ctx context.Context, partition *osqueue.QueuePartition, until time.Time, limit int64) ([]*osqueue.QueueItem, error) {
+	if partition == nil {
+		return nil, fmt.Errorf("expected partition to be set")
+	}
+
+	// Check whether limit is -1, peeking next available time
+	isPeekNext := limit == -1
+
+	if limit > osqueue.AbsoluteQueuePeekMax {
+		limit = osqueue.AbsoluteQueuePeekMax
+	}
+	if limit > q.PeekMax {
+		limit = q.PeekMax
+	}
+	if limit <= 0 {
+		limit = q.PeekMin
+	}
+	if isPeekNext {
+		limit = 1
+	}
+
+	res, err := q.peek(ctx, peekOpts{
+		PartitionID: partition.ID,
+		Until:       until,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Items, nil
+}
+
+func (q *queue) PeekRandom(ctx context.Context, partition *osqueue.QueuePartition, until time.Time, limit int64) ([]*osqueue.QueueItem, error) {
+	if partition == nil {
+		return nil, fmt.Errorf("expected partition to be set")
+	}
+	if limit > osqueue.AbsoluteQueuePeekMax {
+		limit = osqueue.AbsoluteQueuePeekMax
+	}
+	if limit > q.PeekMax {
+		limit = q.PeekMax
+	}
+	if limit <= 0 {
+		limit = q.PeekMin
+	}
+
+	res, err := q.peek(ctx, peekOpts{
+		PartitionID: partition.ID,
+		Until:       until,
+		Limit:       limit,
+		Random:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Items, nil
+}
+
+type peekOpts struct {
+	PartitionID string
+	Random      bool
+	From        *time.Time
+	Until       time.Time
+	Limit       int64
+}
+
+// peekResult contains the items returned by peek, along with the number of
+// rows read and the score of the last row, used as an iteration cursor.
+type peekResult struct {
+	Items     []*osqueue.QueueItem
+	RawCount  int
+	LastScore int64
+}
+
+func (q *queue) peek(ctx context.Context, opts peekOpts) (*peekResult, error) {
+	fromMS := int64(math.MinInt64)
+	if opts.From != nil && !opts.From.IsZero() {
+		fromMS = opts.From.UnixMilli()
+	}
+
+	untilMS := int64(math.MaxInt64)
+	if opts.Until.UnixMilli() > 0 {
+		untilMS = opts.Until.UnixMilli()
+	}
+
+	var offset int64
+	if opts.Random {
+		count, err := q.queries.CountReadyQueueItems(ctx, sqlc.CountReadyQueueItemsParams{
+			Shard:       q.name,
+			PartitionID: opts.PartitionID,
+			FromMs:      fromMS,
+			UntilMs:     untilMS,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error counting queue items: %w", err)
+		}
+		if count > opts.Limit {
+			offset = int64(rnd.Uint64n(uint64(count - opts.Limit + 1)))
+		}
+	}
+
+	rows, err := q.queries.PeekQueueItems(ctx, sqlc.PeekQueueItemsParams{
+		Shard:       q.name,
+		PartitionID: opts.PartitionID,
+		FromMs:      fromMS,
+		UntilMs:     untilMS,
+		Off:         int32(offset),
+		Lim:         int32(opts.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error peeking queue items: %w", err)
+	}
+
+	now := q.Clock.Now()
+	result := &peekResult{
+		Items:    make([]*osqueue.QueueItem, 0, len(rows)),
+		RawCount: len(rows),
+	}
+	for _, row := range rows {
+		result.LastScore = row.ScoreMs
+
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return nil, err
+		}
+		if qi.IsLeased(now) {
+			metrics.IncrQueuePeekLeaseContentionCounter(ctx, metrics.CounterOpt{
+				PkgName: pkgName,
+				Tags: map[string]any{
+					"queue_shard": q.Name(),
+				},
+			})
+			// Leased item, don't return.
+			continue
+		}
+		result.Items = append(result.Items, qi)
+	}
+	return result, nil
+}
+
+func (q *queue) ResetAttemptsByJobID(ctx context.Context, scope osqueue.Scope, jobID string) error {
+	return q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{
+			Shard: q.name,
+			ID:    jobID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrQueueItemNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading queue item: %w", err)
+		}
+
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return err
+		}
+		qi.Data.Attempt = 0
+
+		return q.updateItem(ctx, qs, row, qi)
+	})
+}
+
+// updateItem stores the given item, keeping the row's score, lease and
+// concurrency keys as-is.
+func (q *queue) updateItem(ctx context.Context, qs *sqlc.Queries, row *sqlc.QueueItem, qi *osqueue.QueueItem) error {
+	byt, err := json.Marshal(qi)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue item: %w", err)
+	}
+	return qs.UpdateQueueItem(ctx, sqlc.UpdateQueueItemParams{
+		Shard:           q.name,
+		ID:              row.ID,
+		ScoreMs:         row.ScoreMs,
+		LeaseID:         row.LeaseID,
+		LeaseUntilMs:    row.LeaseUntilMs,
+		ConcurrencyKey1: row.ConcurrencyKey1,
+		ConcurrencyKey2: row.ConcurrencyKey2,
+		BacklogID:       row.BacklogID,
+		Item:            byt,
+	})
+}
+
+// moveItemBacklog stores an existing item within the given backlog, used when
+// normalizing backlogs.  An empty backlogID makes the item ready within its
+// partition.
+func (q *queue) moveItemBacklog(ctx context.Context, qs *sqlc.Queries, itemID string, at time.Time, backlogID string, item []byte) error {
+	row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{
+		Shard: q.name,
+		ID:    itemID,
+	})
+	if err != nil {
+		return fmt.Errorf("error loading queue item: %w", err)
+	}
+	return qs.UpdateQueueItem(ctx, sqlc.UpdateQueueItemParams{
+		Shard:           q.name,
+		ID:              row.ID,
+		ScoreMs:         at.UnixMilli(),
+		LeaseID:         row.LeaseID,
+		LeaseUntilMs:    row.LeaseUntilMs,
+		ConcurrencyKey1: row.ConcurrencyKey1,
+		ConcurrencyKey2: row.ConcurrencyKey2,
+		BacklogID:       nullBacklogID(backlogID),
+		Item:            item,
+	})
+}
+
+// RequeueByJobID requeues a job for a specific time given a partition name and job ID.
+//
+// If the queue item referenced by the job ID is not outstanding (ie. it has a lease, is in
+// progress, or doesn't exist) this returns an error.
+func (q *queue) RequeueByJobID(ctx context.Context, jobID string, at time.Time) error {
+	jobID = osqueue.HashID(ctx, jobID)
+
+	now := q.Clock.Now()
+	if at.Before(now) {
+		at = now
+	}
+
+	return q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{
+			Shard: q.name,
+			ID:    jobID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrQueueItemNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading queue item: %w", err)
+		}
+
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return err
+		}
+		if qi.IsLeased(now) {
+			return osqueue.ErrQueueItemAlreadyLeased
+		}
+
+		qi.AtMS = at.UnixMilli()
+		qi.WallTimeMS = at.UnixMilli()
+		qi.EarliestPeekTime = 0
+
+		row.ScoreMs = at.UnixMilli()
+		if err := q.updateItem(ctx, qs, row, qi); err != nil {
+			return err
+		}
+		if err := q.kvDelete(ctx, qs, kvEarliestPeekTimePrefix+qi.ID); err != nil {
+			return err
+		}
+
+		if row.BacklogID.Valid {
+			spID := osqueue.ItemShadowPartition(ctx, *qi).PartitionID
+			if err := q.lockShadowPartition(ctx, qs, spID); err != nil {
+				return err
+			}
+			return q.updateBacklogPointer(ctx, qs, row.BacklogID.String, spID)
+		}
+		return q.requeueToPartition(ctx, qs, osqueue.ItemPartition(ctx, *qi), now)
+	})
+}
+
+// Lease temporarily dequeues an item from the queue by obtaining a lease, preventing
+// other workers from working on this queue item at the same time.
+//
+// When no capacity manager is configured, concurrency and throttle constraints
+// are checked within the same transaction as the lease.
+func (q *queue) Lease(
+	ctx context.Context,
+	item osqueue.QueueItem,
+	leaseDuration time.Duration,
+	now time.Time,
+	options ...osqueue.LeaseOptionFn,
+) (*ulid.ULID, error) {
+	l := logger.StdlibLogger(ctx)
+
+	o := &osqueue.LeaseOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	if o.Backlog.BacklogID == "" {
+		o.Backlog = osqueue.ItemBacklog(ctx, item)
+	}
+
+	if o.ShadowPartition.PartitionID == "" {
+		o.ShadowPartition = osqueue.ItemShadowPartition(ctx, item)
+	}
+
+	if o.Constraints.FunctionVersion == 0 {
+		o.Constraints = q.PartitionConstraintConfigGetter(ctx, o.ShadowPartition.Identifier())
+	}
+
+	if now.IsZero() {
+		now = q.Clock.Now()
+	}
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.Lease", osqueue.TraceScopeFromQueueItem(item, q.Name()))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", o.ShadowPartition.PartitionID))
+	span.SetAttributes(attribute.String("item_id", item.ID))
+	span.SetAttributes(attribute.String("run_id", item.Data.Identifier.RunID.String()))
+	if item.Data.JobID != nil {
+		span.SetAttributes(attribute.String("job_id", *item.Data.JobID))
+	}
+
+	leaseID, err := ulid.New(ulid.Timestamp(now.Add(leaseDuration).UTC()), rnd)
+	if err != nil {
+		return nil, fmt.Errorf("error generating id: %w", err)
+	}
+
+	setEarliestPeekTime := q.ItemEarliestPeekTimeConfig(ctx, q.Name(), item).Enabled
+
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdateSkipLocked(ctx, sqlc.GetQueueItemForUpdateSkipLockedParams{
+			Shard: q.name,
+			ID:    item.ID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// The row is either missing or locked by another worker.
+			exists, err := qs.QueueItemExists(ctx, sqlc.QueueItemExistsParams{Shard: q.name, ID: item.ID})
+			if err != nil {
+				return err
+			}
+			if exists {
+				return osqueue.ErrQueueItemAlreadyLeased
+			}
+			return osqueue.ErrQueueItemNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading queue item: %w", err)
+		}
+
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return err
+		}
+		if qi.IsLeased(now) {
+			return osqueue.ErrQueueItemAlreadyLeased
+		}
+
+		if err := q.leaseConstraintCheck(ctx, qs, o, now); err != nil {
+			return err
+		}
+
+		// Track the earliest time this job was attempted in the queue.
+		if setEarliestPeekTime && item.EarliestPeekTime > 0 {
+			qi.EarliestPeekTime = item.EarliestPeekTime
+		} else if qi.EarliestPeekTime == 0 {
+			qi.EarliestPeekTime = now.UnixMilli()
+		}
+		qi.LeaseID = &leaseID
+
+		byt, err := json.Marshal(qi)
+		if err != nil {
+			return fmt.Errorf("error marshalling queue item: %w", err)
+		}
+
+		var keys [2]sql.NullString
+		for n, key := range o.Backlog.ConcurrencyKeys {
+			if n >= len(keys) {
+				break
+			}
+			keys[n] = sql.NullString{String: key.CanonicalKeyID, Valid: key.CanonicalKeyID != ""}
+		}
+
+		return qs.UpdateQueueItem(ctx, sqlc.UpdateQueueItemParams{
+			Shard:           q.name,
+			ID:              row.ID,
+			ScoreMs:         row.ScoreMs,
+			LeaseID:         sql.NullString{String: leaseID.String(), Valid: true},
+			LeaseUntilMs:    sql.NullInt64{Int64: int64(leaseID.Time()), Valid: true},
+			ConcurrencyKey1: keys[0],
+			ConcurrencyKey2: keys[1],
+			BacklogID:       row.BacklogID,
+			Item:            byt,
+		})
+	})
+
+	itemDelay := item.ExpectedDelay()
+	metrics.HistogramQueueOperationDelay(ctx, itemDelay, metrics.HistogramOpt{
+		PkgName: pkgName,
+		Tags: map[string]any{
+			"queue_shard": q.Name(),
+			"op":          "item",
+		},
+	})
+	span.SetAttributes(attribute.Int64("item_delay", itemDelay.Milliseconds()))
+
+	l.Trace("leasing item",
+		"id", item.ID,
+		"kind", item.Data.Kind,
+		"lease_id", leaseID.String(),
+		"partition_id", o.ShadowPartition.PartitionID,
+		"item_delay", itemDelay.String(),
+		"error", err,
+	)
+
+	if err != nil {
+		var keyErr osqueue.KeyError
+		switch {
+		case errors.Is(err, osqueue.ErrQueueItemNotFound),
+			errors.Is(err, osqueue.ErrQueueItemAlreadyLeased),
+			errors.As(err, &keyErr):
+			return nil, err
+		}
+		span.RecordError(err)
+		return nil, fmt.Errorf("error leasing queue item: %w", err)
+	}
+
+	return &leaseID, nil
+}
+
+// ExtendLease extens the lease for a given queue item, given the queue item is currently
+// leased with the given ID.  This returns a new lease ID if the lease is successfully ended.
+//
+// The existing lease ID must be passed in so that we can guarantee that the worker
+// renewing the lease still owns the lease.
+//
+// Renewing a lease updates the vesting time for the queue item until now() +
+// lease duration. This returns the newly acquired lease ID on success.
+func (q *queue) ExtendLease(ctx context.Context, i osqueue.QueueItem, leaseID ulid.ULID, duration time.Duration, options ...osqueue.ExtendLeaseOptionFn) (*ulid.ULID, error) {
+	o := &osqueue.ExtendLeaseOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	newLeaseID, err := ulid.New(ulid.Timestamp(q.Clock.Now().Add(duration).UTC()), rnd)
+	if err != nil {
+		return nil, fmt.Errorf("error generating id: %w", err)
+	}
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.ExtendLease", osqueue.TraceScopeFromQueueItem(i, q.Name()))
+	defer span.End()
+	span.SetAttributes(attribute.String("item_id", i.ID))
+	span.SetAttributes(attribute.String("run_id", i.Data.Identifier.RunID.String()))
+
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{
+			Shard: q.name,
+			ID:    i.ID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrQueueItemNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading queue item: %w", err)
+		}
+
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return err
+		}
+		if qi.LeaseID == nil {
+			return osqueue.ErrQueueItemNotLeased
+		}
+		if *qi.LeaseID != leaseID {
+			return osqueue.ErrQueueItemLeaseMismatch
+		}
+
+		qi.LeaseID = &newLeaseID
+		row.LeaseID = sql.NullString{String: newLeaseID.String(), Valid: true}
+		row.LeaseUntilMs = sql.NullInt64{Int64: int64(newLeaseID.Time()), Valid: true}
+		return q.updateItem(ctx, qs, row, qi)
+	})
+	switch {
+	case err == nil:
+		return &newLeaseID, nil
+	case errors.Is(err, osqueue.ErrQueueItemNotFound),
+		errors.Is(err, osqueue.ErrQueueItemNotLeased),
+		errors.Is(err, osqueue.ErrQueueItemLeaseMismatch):
+		return nil, err
+	default:
+		return nil, fmt.Errorf("error extending lease: %w", err)
+	}
+}
+
+// PartitionLease leases a partition for a given workflow ID.  It returns the new lease ID.
+//
+// NOTE: This does not check the queue/partition name against allow or denylists;  it assumes
+// that the worker always wants to lease the given queue.  Filtering must be done when peeking
+// when running a worker.
+func (q *queue) PartitionLease(
+	ctx context.Context,
+	p *osqueue.QueuePartition,
+	duration time.Duration,
+	options ...osqueue.PartitionLeaseOpt,
+) (*ulid.ULID, error) {
+	l := logger.StdlibLogger(ctx)
+
+	partitionID := p.Identifier()
+	scope := itrace.Scope(itrace.UserScope{
+		AccountID: partitionID.AccountID,
+		EnvID:     partitionID.EnvID,
+		FnID:      partitionID.FunctionID,
+	})
+	if partitionID.SystemQueueName != nil {
+		scope = itrace.SystemScope{
+			QueueName:      partitionID.SystemQueueName,
+			QueueShardName: q.Name(),
+		}
+	}
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.partitionLease", scope)
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", p.ID))
+
+	o := &osqueue.PartitionLeaseOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	now := q.Clock.Now()
+	leaseExpires := now.Add(duration).UTC().Truncate(time.Millisecond)
+	leaseID, err := ulid.New(ulid.Timestamp(leaseExpires), rnd)
+	if err != nil {
+		return nil, fmt.Errorf("error generating id: %w", err)
+	}
+
+	var last int64
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueuePartitionForUpdateSkipLocked(ctx, sqlc.GetQueuePartitionForUpdateSkipLockedParams{
+			Shard: q.name,
+			ID:    p.Queue(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// The row is either missing or locked by another worker.
+			exists, err := qs.QueuePartitionExists(ctx, sqlc.QueuePartitionExistsParams{Shard: q.name, ID: p.Queue()})
+			if err != nil {
+				return err
+			}
+			if exists {
+				return osqueue.ErrPartitionAlreadyLeased
+			}
+			return osqueue.ErrPartitionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading partition: %w", err)
+		}
+
+		existing, err := decodeQueuePartition(row.Partition)
+		if err != nil {
+			return err
+		}
+		if existing.LeaseID != nil && ulid.Time(existing.LeaseID.Time()).After(now) {
+			return osqueue.ErrPartitionAlreadyLeased
+		}
+
+		last = existing.Last
+		existing.LeaseID = &leaseID
+		existing.Last = now.UnixMilli()
+		if existing.AccountID == uuid.Nil && p.AccountID != uuid.Nil {
+			existing.AccountID = p.AccountID
+		}
+
+		return q.updatePartition(ctx, qs, existing, leaseExpires.Unix())
+	})
+
+	l.Trace("leased partition",
+		"partition", p.Queue(),
+		"lease_id", leaseID.String(),
+		"expires", leaseExpires.Format(time.StampMilli),
+		"error", err,
+	)
+
+	switch {
+	case err == nil:
+		// Update the partition's last indicator.
+		if last > p.Last {
+			p.Last = last
+		}
+		return &leaseID, nil
+	case errors.Is(err, osqueue.ErrPartitionNotFound), errors.Is(err, osqueue.ErrPartitionAlreadyLeased):
+		return nil, err
+	default:
+		return nil, fmt.Errorf("error leasing partition: %w", err)
+	}
+}
+
+// PartitionPeek returns up to PartitionSelectionMax partition items from the queue. This
+// returns the indexes of partitions.
+//
+// If sequential is set to true this returns partitions in order from earliest to latest
+// available lease times. Otherwise, this shuffles all partitions and picks partitions
+// randomly, with higher priority partitions more likely to be selected.  This reduces
+// lease contention amongst multiple shared-nothing workers.
+func (q *queue) PartitionPeek(ctx context.Context, sequential bool, until time.Time, limit int64) ([]*osqueue.QueuePartition, error) {
+	return osqueue.DurationWithTags(ctx, q.name, "partition_peek", q.Clock.Now(), func(ctx context.Context) ([]*osqueue.QueuePartition, error) {
+		return q.partitionPeek(ctx, sequential, until, limit, nil)
+	}, map[string]any{
+		"is_global_partition_peek": true,
+	})
+}
+
+func (q *queue) PeekAccountPartitions(
+	ctx context.Context,
+	accountID uuid.UUID,
+	peekLimit int64,
+	peekUntil time.Time,
+	sequential bool,
+) ([]*osqueue.QueuePartition, error) {
+	return osqueue.DurationWithTags(ctx, q.name, "partition_peek", q.Clock.Now(), func(ctx context.Context) ([]*osqueue.QueuePartition, error) {
+		return q.partitionPeek(ctx, sequential, peekUntil, peekLimit, &accountID)
+	}, map[string]any{
+		"is_global_partition_peek": false,
+	})
+}
+
+func (q *queue) partitionPeek(ctx context.Context, sequential bool, until time.Time, limit int64, accountID *uuid.UUID) ([]*osqueue.QueuePartition, error) {
+	l := logger.StdlibLogger(ctx)
+
+	if limit > osqueue.PartitionPeekMax {
+		return nil, osqueue.ErrPartitionPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.PartitionPeekMax
+	}
+
+	ms := until.UnixMilli()
+	untilS := int64(math.Ceil(float64(ms) / 1000))
+
+	var (
+		count int64
+		err   error
+	)
+	if !sequential {
+		if accountID == nil {
+			count, err = q.queries.CountPeekableQueuePartitions(ctx, sqlc.CountPeekableQueuePartitionsParams{
+				Shard:  q.name,
+				UntilS: untilS,
+			})
+		} else {
+			count, err = q.queries.CountPeekableAccountQueuePartitions(ctx, sqlc.CountPeekableAccountQueuePartitionsParams{
+				Shard:     q.name,
+				AccountID: accountID.String(),
+				UntilS:    untilS,
+			})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error counting partitions: %w", err)
+		}
+	}
+
+	var offset int64
+	if count > limit {
+		offset = int64(rnd.Uint64n(uint64(count - limit + 1)))
+	}
+
+	var rows [][]byte
+	if accountID == nil {
+		rows, err = q.queries.PeekQueuePartitions(ctx, sqlc.PeekQueuePartitionsParams{
+			Shard:  q.name,
+			UntilS: untilS,
+			Off:    int32(offset),
+			Lim:    int32(limit),
+		})
+	} else {
+		rows, err = q.queries.PeekAccountQueuePartitions(ctx, sqlc.PeekAccountQueuePartitionsParams{
+			Shard:     q.name,
+			AccountID: accountID.String(),
+			UntilS:    untilS,
+			Off:       int32(offset),
+			Lim:       int32(limit),
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error peeking partition items: %w", err)
+	}
+
+	partitions := make([]*osqueue.QueuePartition, 0, len(rows))
+	for _, byt := range rows {
+		item, err := decodeQueuePartition(byt)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding partitions: %w", err)
+		}
+		partitions = append(partitions, item)
+	}
+
+	weights := []float64{}
+	items := make([]*osqueue.QueuePartition, 0, len(partitions))
+	for _, item := range partitions {
+		if item.FunctionID != nil {
+			// Check paused status from database with a timeout
+			// PartitionPausedGetter does not return errors and simply returns a zero value of
+			// info.Paused = false when it encounters an error.
+			dbCtx, dbCtxCancel := context.WithTimeout(ctx, osqueue.DatabaseReadTimeout)
+			info := q.PartitionPausedGetter(dbCtx, *item.FunctionID)
+
+			if dbCtx.Err() == context.DeadlineExceeded {
+				metrics.IncrQueueDatabaseContextTimeoutCounter(ctx, metrics.CounterOpt{
+					PkgName: pkgName,
+					Tags: map[string]any{
+						"operation": "partition_paused_getter",
+					},
+				})
+			}
+
+			dbCtxCancel()
+
+			if info.Paused {
+				// Only push back partition if the partition is marked as paused in the database.
+				// If the in-memory cache is stale, we don't want to accidentally push back the partition
+				// in case the function was unpaused in the last 60s.
+				if !info.Stale {
+					err := q.PartitionRequeue(ctx, item, q.Clock.Now().Truncate(time.Second).Add(q.PausedRequeueExtension()), true)
+					if err != nil && !errors.Is(err, osqueue.ErrPartitionGarbageCollected) {
+						l.Error("failed to push back paused partition", "error", err, "partition", item)
+					} else {
+						l.Trace("pushed back paused partition", "partition", item.Queue())
+					}
+				}
+				continue
+			}
+
+			lockedUntil, err := q.IsMigrationLocked(ctx, osqueue.Scope{FunctionID: *item.FunctionID})
+			if err != nil {
+				l.Warn("could not check migration lock", "error", err, "partition", item.Queue())
+			}
+			if lockedUntil != nil {
+				err := q.PartitionRequeue(ctx, item, *lockedUntil, true)
+				if err != nil && !errors.Is(err, osqueue.ErrPartitionGarbageCollected) {
+					l.Error("failed to push back migrating partition", "error", err, "partition", item)
+				} else {
+					l.Trace("pushed back migrating partition", "partition", item.Queue())
+				}
+				// skip this since the executor is not responsible for migrating queues
+				continue
+			}
+		}
+
+		// We want to ignore any partitions requeued because of conflicts, as this
+		// will cause needless churn every peek MS.
+		if item.ForceAtMS > ms {
+			continue
+		}
+
+		// If we have an allowlist, only accept this partition if its in the allowlist.
+		if len(q.AllowQueues) > 0 && !checkList(item.Queue(), q.AllowQueueMap, q.AllowQueuePrefixes) {
+			continue
+		}
+
+		// Ignore any denied queues if they're explicitly in the denylist.
+		if len(q.DenyQueues) > 0 && checkList(item.Queue(), q.DenyQueueMap, q.DenyQueuePrefixes) {
+			continue
+		}
+
+		items = append(items, item)
+		partPriority := q.PartitionPriorityFinder(ctx, *item)
+		weights = append(weights, float64(10-partPriority))
+	}
+
+	// Some scanners run sequentially, ensuring we always work on the functions with
+	// the oldest run at times in order, no matter the priority.
+	if sequential {
+		n := int(math.Min(float64(len(items)), float64(osqueue.PartitionSelectionMax)))
+		return items[0:n], nil
+	}
+
+	// Weighted shuffle the resulting array, favouring higher-priority partitions
+	// whilst reducing lease contention between shared-nothing workers.
+	w := sampleuv.NewWeighted(weights, rnd)
+	result := make([]*osqueue.QueuePartition, len(items))
+	for n := range result {
+		idx, ok := w.Take()
+		if !ok {
+			return nil, util.ErrWeightedSampleRead
+		}
+		result[n] = items[idx]
+	}
+
+	return result, nil
+}
+
+func (q *queue) PartitionSize(ctx context.Context, scope osqueue.Scope, partitionID string, until time.Time) (int64, error) {
+	return q.queries.CountReadyQueueItems(ctx, sqlc.CountReadyQueueItemsParams{
+		Shard:       q.name,
+		PartitionID: partitionID,
+		FromMs:      math.MinInt64,
+		UntilMs:     until.UnixMilli(),
+	})
+}
+
+// TotalSystemQueueDepth returns the number of items across all partitions
+// within the shard.
+func (q *queue) TotalSystemQueueDepth(ctx context.Context) (int64, error) {
+	return q.queries.CountQueueItems(ctx, q.name)
+}
+
+// FunctionQueueStats returns queue statistics for each function with queued
+// or leased items within the shard.
+func (q *queue) FunctionQueueStats(ctx context.Context) ([]osqueue.FunctionQueueStats, error) {
+	rows, err := q.queries.FunctionQueueStats(ctx, sqlc.FunctionQueueStatsParams{
+		Shard: q.name,
+		NowMs: q.Clock.Now().UnixMilli(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving function queue stats: %w", err)
+	}
+
+	result := make([]osqueue.FunctionQueueStats, 0, len(rows))
+	for _, row := range rows {
+		fnID, err := uuid.Parse(row.FunctionID)
+		if err != nil {
+			continue
+		}
+		stats := osqueue.FunctionQueueStats{
+			FunctionID: fnID,
+			Ready:      row.Ready,
+			Scheduled:  row.Scheduled,
+			Leased:     row.Leased,
+		}
+		if row.OldestDueMs > 0 {
+			stats.OldestDueAt = time.UnixMilli(row.OldestDueMs)
+		}
+		result = append(result, stats)
+	}
+	return result, nil
+}
+
+func (q *queue) AccountPeek(ctx context.Context, sequential bool, until time.Time, limit int64) ([]uuid.UUID, error) {
+	if limit > osqueue.AccountPeekMax {
+		return nil, osqueue.ErrAccountPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.AccountPeekMax
+	}
+
+	untilS := int64(math.Ceil(float64(until.UnixMilli()) / 1000))
+
+	var offset int64
+	if !sequential {
+		count, err := q.queries.CountPeekableQueueAccounts(ctx, sqlc.CountPeekableQueueAccountsParams{
+			Shard:  q.name,
+			UntilS: untilS,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error counting accounts: %w", err)
+		}
+		if count > limit {
+			offset = int64(rnd.Uint64n(uint64(count - limit + 1)))
+		}
+	}
+
+	ids, err := q.queries.PeekQueueAccounts(ctx, sqlc.PeekQueueAccountsParams{
+		Shard:  q.name,
+		UntilS: untilS,
+		Off:    int32(offset),
+		Lim:    int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error peeking accounts: %w", err)
+	}
+
+	items := make([]uuid.UUID, len(ids))
+	for i, s := range ids {
+		parsed, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse account id from partitions: %w", err)
+		}
+		items[i] = parsed
+	}
+
+	weights := make([]float64, len(items))
+	for i := range items {
+		accountPriority := q.AccountPriorityFinder(ctx, items[i])
+		weights[i] = float64(10 - accountPriority)
+	}
+
+	// Some scanners run sequentially, ensuring we always work on the accounts with
+	// the oldest run at times in order, no matter the priority.
+	if sequential {
+		n := int(math.Min(float64(len(items)), float64(osqueue.PartitionSelectionMax)))
+		return items[0:n], nil
+	}
+
+	w := sampleuv.NewWeighted(weights, rnd)
+	result := make([]uuid.UUID, len(items))
+	for n := range result {
+		idx, ok := w.Take()
+		if !ok {
+			return nil, util.ErrWeightedSampleRead
+		}
+		result[n] = items[idx]
+	}
+
+	return result, nil
+}
+
+func checkList(check string, exact, prefixes map[string]*struct{}) bool {
+	for k := range exact {
+		if check == k {
+			return true
+		}
+	}
+	for k := range prefixes {
+		if strings.HasPrefix(check, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// PartitionRequeue requeues a parition with a new score, ensuring that the partition will be
+// read at (or very close to) the given time.
+//
+// This is used after peeking and passing all queue items onto workers; we then take the next
+// unleased available time for the queue item and requeue the partition.
+//
+// forceAt is used to enforce the given queue time.  This is used when partitions are at a
+// concurrency limit;  we don't want to scan the partition next time, so we force the partition
+// to be at a specific time instead of taking the earliest available queue item time
+func (q *queue) PartitionRequeue(ctx context.Context, p *osqueue.QueuePartition, at time.Time, forceAt bool) error {
+	l := logger.StdlibLogger(ctx)
+
+	partitionID := p.Identifier()
+	scope := itrace.Scope(itrace.UserScope{
+		AccountID: partitionID.AccountID,
+		EnvID:     partitionID.EnvID,
+		FnID:      partitionID.FunctionID,
+	})
+	if partitionID.SystemQueueName != nil {
+		scope = itrace.SystemScope{
+			QueueName:      partitionID.SystemQueueName,
+			QueueShardName: q.Name(),
+		}
+	}
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.partitionRequeue", scope)
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", p.ID))
+
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueuePartitionForUpdate(ctx, sqlc.GetQueuePartitionForUpdateParams{
+			Shard: q.name,
+			ID:    p.Queue(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrPartitionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading partition: %w", err)
+		}
+
+		existing, err := decodeQueuePartition(row.Partition)
+		if err != nil {
+			return err
+		}
+		existing.LeaseID = nil
+
+		// Garbage collect the partition if there are no outstanding or
+		// in-progress items.
+		count, err := qs.CountPartitionQueueItems(ctx, sqlc.CountPartitionQueueItemsParams{
+			Shard:       q.name,
+			PartitionID: row.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error counting partition items: %w", err)
+		}
+		if count == 0 {
+			if err := qs.DeleteQueuePartition(ctx, sqlc.DeleteQueuePartitionParams{Shard: q.name, ID: row.ID}); err != nil {
+				return fmt.Errorf("error deleting partition: %w", err)
+			}
+			return osqueue.ErrPartitionGarbageCollected
+		}
+
+		atS := at.UnixMilli() / 1000
+		if !forceAt {
+			earliest, err := qs.EarliestReadyQueueItemScore(ctx, sqlc.EarliestReadyQueueItemScoreParams{
+				Shard:       q.name,
+				PartitionID: row.ID,
+			})
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("error reading earliest partition item: %w", err)
+			}
+			if err == nil && earliest/1000 < atS {
+				atS = earliest / 1000
+			}
+		}
+
+		existing.ForceAtMS = 0
+		if forceAt {
+			existing.ForceAtMS = at.UnixMilli()
+		}
+
+		return q.updatePartition(ctx, qs, existing, atS)
+	})
+
+	l.Trace("requeued partition",
+		"partition", p.Queue(),
+		"at", at.Format(time.StampMilli),
+		"error", err,
+	)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, osqueue.ErrPartitionGarbageCollected):
+		// The transaction committed the deletion before returning the sentinel.
+		return err
+	case errors.Is(err, osqueue.ErrPartitionNotFound):
+		return err
+	default:
+		return fmt.Errorf("error requeueing partition: %w", err)
+	}
+}
+
+func (q *queue) Instrument(ctx context.Context) error {
+	count, err := q.queries.CountQueuePartitions(ctx, q.name)
+	if err != nil {
+		return fmt.Errorf("failed to count queue partitions during instrumentation: %w", err)
+	}
+
+	metrics.GaugeGlobalPartitionSize(ctx, count, metrics.GaugeOpt{
+		PkgName: pkgName,
+		Tags: map[string]any{
+			"queue_shard": q.Name(),
+		},
+	})
+	return nil
+}
+
+// RoleLease allows a worker to lease queue roles.
+// Leasing this key works similar to leasing partitions or queue items:
+//
+//   - If the key isn't leased, a new lease is accepted.
+//   - If the lease is expired, a new lease is accepted.
+//   - If the key is leased, you must pass in the existing lease ID to renew the lease.  Mismatches do not
+//     grant a lease.
+//
+// This returns the new lease ID on success.
+func (q *queue) RoleLease(ctx context.Context, key string, duration time.Duration, existingLeaseID ...*ulid.ULID) (*ulid.ULID, error) {
+	if duration > osqueue.RoleLeaseMax {
+		return nil, osqueue.ErrRoleLeaseExceedsLimits
+	}
+
+	now := q.Clock.Now()
+	newLeaseID, err := ulid.New(ulid.Timestamp(now.Add(duration)), rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing string
+	if len(existingLeaseID) > 0 && existingLeaseID[0] != nil {
+		existing = existingLeaseID[0].String()
+	}
+
+	kvKey := kvRoleLeasePrefix + key
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+kvKey); err != nil {
+			return err
+		}
+
+		current, ok, err := q.kvGet(ctx, qs, kvKey)
+		if err != nil {
+			return err
+		}
+		if ok && current != existing {
+			parsed, err := ulid.Parse(current)
+			if err == nil && int64(parsed.Time()) >= now.UnixMilli() {
+				return osqueue.ErrRoleAlreadyLeased
+			}
+		}
+
+		return q.kvSet(ctx, qs, kvKey, newLeaseID.String(), 0)
+	})
+	switch {
+	case err == nil:
+		return &newLeaseID, nil
+	case errors.Is(err, osqueue.ErrRoleAlreadyLeased):
+		return nil, err
+	default:
+		return nil, fmt.Errorf("error claiming role lease: %w", err)
+	}
+}
+
+// PeekEWMA returns the calculated EWMA value from the list
+func (q *queue) PeekEWMA(ctx context.Context, fnID uuid.UUID) (int64, error) {
+	val, ok, err := q.kvGet(ctx, q.queries, kvPeekEWMAPrefix+fnID.String())
+	if err != nil {
+		return 0, fmt.Errorf("error reading function concurrency EWMA values: %w", err)
+	}
+	if !ok || val == "" {
+		return 0, nil
+	}
+
+	strlist := strings.Split(val, ",")
+
+	hasNonZero := false
+	vals := make([]float64, len(strlist))
+	for i, s := range strlist {
+		v, _ := strconv.ParseFloat(s, 64)
+		vals[i] = v
+		if v > 0 {
+			hasNonZero = true
+		}
+	}
+
+	if !hasNonZero {
+		// short-circuit.
+		return 0, nil
+	}
+
+	mavg := ewma.NewMovingAverage()
+	for _, v := range vals {
+		mavg.Add(v)
+	}
+
+	// round up to the nearest integer
+	return int64(math.Round(mavg.Value())), nil
+}
+
+// SetPeekEWMA add the new value to the existing list.
+// if the length of the list exceeds the predetermined size, pop out the first item
+func (q *queue) SetPeekEWMA(ctx context.Context, fnID *uuid.UUID, val int64) error {
+	if fnID == nil {
+		return nil
+	}
+
+	listSize := q.PeekEWMALen
+	if listSize == 0 {
+		listSize = osqueue.QueuePeekEWMALen
+	}
+
+	kvKey := kvPeekEWMAPrefix + fnID.String()
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+kvKey); err != nil {
+			return err
+		}
+
+		current, _, err := q.kvGet(ctx, qs, kvKey)
+		if err != nil {
+			return err
+		}
+
+		list := []string{strconv.FormatInt(val, 10)}
+		if current != "" {
+			list = append(list, strings.Split(current, ",")...)
+		}
+		if len(list) > listSize {
+			list = list[:listSize]
+		}
+
+		return q.kvSet(ctx, qs, kvKey, strings.Join(list, ","), time.Minute)
+	})
+	if err != nil {
+		return fmt.Errorf("error updating function concurrency EWMA: %w", err)
+	}
+	return nil
+}
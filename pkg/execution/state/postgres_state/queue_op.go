@@ -0,0 +1,333 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/logger"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (q *queue) DequeueByJobID(ctx context.Context, jobID string) error {
+	item, err := q.LoadQueueItem(ctx, jobID)
+	switch err {
+	case nil:
+		// no-op
+	case osqueue.ErrQueueItemNotFound:
+		return nil
+	default:
+		return fmt.Errorf("error retrieving item by ID: %w", err)
+	}
+
+	return q.Dequeue(ctx, *item)
+}
+
+// Dequeue removes an item from the queue entirely.
+func (q *queue) Dequeue(ctx context.Context, i osqueue.QueueItem, options ...osqueue.DequeueOptionFn) error {
+	l := logger.StdlibLogger(ctx)
+
+	o := &osqueue.DequeueOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	partition := osqueue.ItemPartition(ctx, i)
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.Dequeue", osqueue.TraceScopeFromQueueItem(i, q.Name()))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", partition.ID))
+	span.SetAttributes(attribute.String("item_id", i.ID))
+	span.SetAttributes(attribute.String("run_id", i.Data.Identifier.RunID.String()))
+	if i.Data.JobID != nil {
+		span.SetAttributes(attribute.String("job_id", *i.Data.JobID))
+	}
+
+	idempotency := q.IdempotencyTTL
+	if q.IdempotencyTTLFunc != nil {
+		idempotency = q.IdempotencyTTLFunc(ctx, i)
+	}
+	// If custom idempotency period is set on the queue item, use that
+	if i.IdempotencyPeriod != nil {
+		idempotency = *i.IdempotencyPeriod
+	}
+	if o.DisableIdempotency {
+		idempotency = 0
+	}
+
+	now := q.Clock.Now()
+	runID := i.Data.Identifier.RunID.String()
+
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{Shard: q.name, ID: i.ID})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrQueueItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if o.RequireUnleased {
+			if qi, err := decodeQueueItem(row.Item); err != nil {
+				return err
+			} else if qi.IsLeased(now) {
+				return osqueue.ErrQueueItemAlreadyLeased
+			}
+		}
+
+		if _, err := qs.DeleteQueueItem(ctx, sqlc.DeleteQueueItemParams{Shard: q.name, ID: i.ID}); err != nil {
+			return err
+		}
+
+		if err := q.kvDelete(ctx, qs, kvEarliestPeekTimePrefix+i.ID); err != nil {
+			return err
+		}
+
+		if idempotency > 0 {
+			if err := q.kvSet(ctx, qs, kvIdempotencyPrefix+i.ID, "", idempotency); err != nil {
+				return err
+			}
+		}
+
+		if row.BacklogID.Valid {
+			spID := osqueue.ItemShadowPartition(ctx, i).PartitionID
+			if err := q.lockShadowPartition(ctx, qs, spID); err != nil {
+				return err
+			}
+			if err := q.updateBacklogPointer(ctx, qs, row.BacklogID.String, spID); err != nil {
+				return err
+			}
+		} else if err := q.dequeueFromPartition(ctx, qs, partition.ID, now); err != nil {
+			return err
+		}
+
+		// Release the singleton lock once the run has no more items.
+		singletonKey, ok, err := q.kvGet(ctx, qs, kvSingletonRunPrefix+runID)
+		if err != nil || !ok {
+			return err
+		}
+		remaining, err := qs.CountRunQueueItems(ctx, sqlc.CountRunQueueItemsParams{Shard: q.name, RunID: runID})
+		if err != nil {
+			return err
+		}
+		if remaining > 0 {
+			return nil
+		}
+		if err := q.kvDelete(ctx, qs, kvSingletonRunPrefix+runID); err != nil {
+			return err
+		}
+		if current, ok, err := q.kvGet(ctx, qs, singletonKey); err != nil {
+			return err
+		} else if ok && current == runID {
+			return q.kvDelete(ctx, qs, singletonKey)
+		}
+		return nil
+	})
+
+	switch {
+	case err == nil:
+		if rand.Float64() < 0.05 {
+			l.Trace("dequeued item", "job_id", i.ID, "item", i)
+		}
+		return nil
+	case errors.Is(err, osqueue.ErrQueueItemNotFound), errors.Is(err, osqueue.ErrQueueItemAlreadyLeased):
+		return err
+	default:
+		return fmt.Errorf("error dequeueing item: %w", err)
+	}
+}
+
+// dequeueFromPartition moves the partition pointer earlier if the earliest
+// remaining item is before the current pointer.
+func (q *queue) dequeueFromPartition(ctx context.Context, qs *sqlc.Queries, partitionID string, now time.Time) error {
+	earliest, err := qs.EarliestReadyQueueItemScore(ctx, sqlc.EarliestReadyQueueItemScoreParams{
+		Shard:       q.name,
+		PartitionID: partitionID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	row, err := qs.GetQueuePartitionForUpdate(ctx, sqlc.GetQueuePartitionForUpdateParams{
+		Shard: q.name,
+		ID:    partitionID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if row.AtS <= earliest/1000 {
+		return nil
+	}
+
+	existing, err := decodeQueuePartition(row.Partition)
+	if err != nil {
+		return err
+	}
+	existing.ForceAtMS = 0
+	return q.updatePartition(ctx, qs, existing, earliest/1000)
+}
+
+// Requeue requeues an item in the future.
+func (q *queue) Requeue(ctx context.Context, i osqueue.QueueItem, at time.Time, options ...osqueue.RequeueOptionFn) error {
+	o := &osqueue.RequeueOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	l := logger.StdlibLogger(ctx).With("item", i)
+
+	now := q.Clock.Now()
+	if at.Before(now) {
+		at = now
+	}
+
+	// Unset any lease ID as this is requeued.
+	i.LeaseID = nil
+	// Bump GenerationID so the next dispatch supersedes the previous one.
+	i.GenerationID++
+	// Update the At timestamp.
+	// NOTE: This does no priority factorization or FIFO for function ordering,
+	// eg. adjusting AtMS based off of function run time.
+	i.AtMS = at.UnixMilli()
+	// Update the wall time that this should run at.
+	i.WallTimeMS = at.UnixMilli()
+
+	// Reset refill details
+	i.RefilledFrom = ""
+	i.RefilledAt = 0
+
+	// Reset enqueuedAt (used for latency calculation)
+	i.EnqueuedAt = now.UnixMilli()
+	i.EarliestPeekTime = 0
+
+	fnPartition := osqueue.ItemPartition(ctx, i)
+	shadowPartition := osqueue.ItemShadowPartition(ctx, i)
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.Requeue", osqueue.TraceScopeFromQueueItem(i, q.Name()))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", fnPartition.ID))
+	span.SetAttributes(attribute.String("item_id", i.ID))
+	span.SetAttributes(attribute.String("run_id", i.Data.Identifier.RunID.String()))
+	if i.Data.JobID != nil {
+		span.SetAttributes(attribute.String("job_id", *i.Data.JobID))
+	}
+
+	requeueToBacklog := q.ItemEnableKeyQueues(ctx, i)
+
+	var backlog osqueue.QueueBacklog
+	if requeueToBacklog {
+		// To avoid requeueing item into a stale backlog, retrieve latest throttle
+		if i.Data.Throttle != nil && i.Data.Throttle.KeyExpressionHash == "" {
+			refreshedThrottle, err := q.RefreshItemThrottle(ctx, &i)
+			if err != nil {
+				// If we cannot find the event for the queue item, dequeue it. The state
+				// must exist for the entire duration of a function run.
+				if errors.Is(err, state.ErrEventNotFound) {
+					l.Warn("could not find event for refreshing throttle before requeue")
+
+					err := q.Dequeue(ctx, i)
+					if err != nil && !errors.Is(err, osqueue.ErrQueueItemNotFound) {
+						return fmt.Errorf("could not dequeue item with missing throttle state: %w", err)
+					}
+
+					return nil
+				}
+
+				return fmt.Errorf("could not refresh item throttle: %w", err)
+			}
+
+			// Update throttle to latest evaluated value + expression hash
+			i.Data.Throttle = refreshedThrottle
+		}
+
+		backlog = osqueue.ItemBacklog(ctx, i)
+	}
+
+	byt, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue item: %w", err)
+	}
+
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueItemForUpdate(ctx, sqlc.GetQueueItemForUpdateParams{Shard: q.name, ID: i.ID})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrQueueItemNotFound
+		} else if err != nil {
+			return err
+		}
+		if o.RequireUnleased {
+			if qi, err := decodeQueueItem(row.Item); err != nil {
+				return err
+			} else if qi.IsLeased(now) {
+				return osqueue.ErrQueueItemAlreadyLeased
+			}
+		}
+
+		err = qs.UpdateQueueItem(ctx, sqlc.UpdateQueueItemParams{
+			Shard:     q.name,
+			ID:        i.ID,
+			ScoreMs:   at.UnixMilli(),
+			BacklogID: nullBacklogID(backlog.BacklogID),
+			Item:      byt,
+		})
+		if err != nil {
+			return err
+		}
+		if err := q.kvDelete(ctx, qs, kvEarliestPeekTimePrefix+i.ID); err != nil {
+			return err
+		}
+
+		if !requeueToBacklog && !row.BacklogID.Valid {
+			return q.requeueToPartition(ctx, qs, fnPartition, now)
+		}
+
+		if err := q.lockShadowPartition(ctx, qs, shadowPartition.PartitionID); err != nil {
+			return err
+		}
+
+		if requeueToBacklog {
+			earliest, err := qs.EarliestBacklogQueueItemScore(ctx, sqlc.EarliestBacklogQueueItemScoreParams{
+				Shard:     q.name,
+				BacklogID: nullBacklogID(backlog.BacklogID),
+			})
+			if err != nil {
+				return fmt.Errorf("error reading earliest backlog item: %w", err)
+			}
+			err = q.enqueueToBacklog(ctx, qs, backlog, shadowPartition, earliest)
+			if err != nil {
+				return err
+			}
+		} else if err := q.requeueToPartition(ctx, qs, fnPartition, now); err != nil {
+			return err
+		}
+
+		// Clean up the item's previous backlog.
+		if row.BacklogID.Valid && row.BacklogID.String != backlog.BacklogID {
+			return q.updateBacklogPointer(ctx, qs, row.BacklogID.String, shadowPartition.PartitionID)
+		}
+		return nil
+	})
+
+	l.Trace("requeued item", "id", i.ID, "kind", i.Data.Kind, "time", at.Format(time.StampMilli), "error", err)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, osqueue.ErrQueueItemNotFound), errors.Is(err, osqueue.ErrQueueItemAlreadyLeased):
+		return err
+	default:
+		return fmt.Errorf("error requeueing item: %w", err)
+	}
+}
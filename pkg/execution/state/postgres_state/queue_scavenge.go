@@ -0,0 +1,68 @@
+package postgres_state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/telemetry/metrics"
+)
+
+// Scavenge attempts to find jobs that may have been lost due to killed workers.  Workers are shared
+// nothing, and each item in a queue has a lease.  If a worker dies, it will not finish the job and
+// cannot renew the item's lease.
+//
+// Items with expired leases are requeued immediately.  Expired keys within the
+// queue_kv table are also removed.
+func (q *queue) Scavenge(ctx context.Context, limit int) (int, error) {
+	l := logger.StdlibLogger(ctx)
+
+	now := q.Clock.Now().UnixMilli()
+
+	rows, err := q.queries.ScavengeQueueItems(ctx, sqlc.ScavengeQueueItemsParams{
+		Shard: q.name,
+		NowMs: now,
+		Lim:   int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error scavenging for lost items: %w", err)
+	}
+
+	var (
+		counter   int
+		resultErr error
+	)
+	for _, byt := range rows {
+		qi, err := decodeQueueItem(byt)
+		if err != nil {
+			resultErr = multierror.Append(resultErr, err)
+			continue
+		}
+		qi.ScavengeCount++
+		if err := q.Requeue(ctx, *qi, q.Clock.Now()); err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("error requeueing job '%s': %w", qi.ID, err))
+			continue
+		}
+		l.Debug("scavenger requeued queue item",
+			"id", qi.ID,
+			"kind", qi.Data.Kind,
+			"run_id", qi.Data.Identifier.RunID,
+		)
+		counter++
+	}
+
+	metrics.IncrQueueScavengerRequeuedItemsCounter(ctx, int64(len(rows)), metrics.CounterOpt{
+		PkgName: pkgName,
+		Tags: map[string]any{
+			"kind": "partition_index",
+		},
+	})
+
+	if _, err := q.queries.DeleteExpiredQueueKV(ctx, sqlc.DeleteExpiredQueueKVParams{Shard: q.name, NowMs: now}); err != nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("error deleting expired keys: %w", err))
+	}
+
+	return counter, resultErr
+}
@@ -0,0 +1,388 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/inngest/inngest/pkg/consts"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+// RunJobs returns a list of jobs that are due to run for a given run ID.
+func (q *queue) RunJobs(ctx context.Context, scope osqueue.Scope, runID ulid.ULID, limit, offset int64) ([]osqueue.JobResponse, error) {
+	if limit > 1000 || limit <= 0 {
+		limit = 1000
+	}
+
+	rows, err := q.queries.GetRunQueueItemsPage(ctx, sqlc.GetRunQueueItemsPageParams{
+		Shard: q.name,
+		RunID: runID.String(),
+		Off:   int32(offset),
+		Lim:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading jobs: %w", err)
+	}
+
+	resp := []osqueue.JobResponse{}
+	for _, byt := range rows {
+		qi, err := decodeQueueItem(byt)
+		if err != nil {
+			return nil, err
+		}
+		if qi.Data.Identifier.WorkspaceID != scope.EnvID {
+			continue
+		}
+
+		pos, err := q.queries.QueueItemPosition(ctx, sqlc.QueueItemPositionParams{
+			Shard:       q.name,
+			PartitionID: osqueue.ItemPartition(ctx, *qi).ID,
+			ScoreMs:     qi.AtMS,
+			ID:          qi.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error reading queue position: %w", err)
+		}
+		resp = append(resp, osqueue.JobResponse{
+			JobID:    qi.ID,
+			At:       time.UnixMilli(qi.AtMS),
+			Position: pos,
+			Kind:     qi.Data.Kind,
+			Attempt:  qi.Data.Attempt,
+			Raw:      qi,
+		})
+	}
+
+	return resp, nil
+}
+
+func (q *queue) OutstandingJobCount(ctx context.Context, scope osqueue.Scope, runID ulid.ULID) (int, error) {
+	count, err := q.queries.CountRunQueueItems(ctx, sqlc.CountRunQueueItemsParams{
+		Shard: q.name,
+		RunID: runID.String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error counting run items: %w", err)
+	}
+	return int(count), nil
+}
+
+func (q *queue) StatusCount(ctx context.Context, scope osqueue.Scope, status string) (int64, error) {
+	var kinds []string
+	switch status {
+	case "start":
+		kinds = []string{osqueue.KindStart}
+	case "in-progress":
+		kinds = []string{osqueue.KindEdge, osqueue.KindEdgeError}
+	case "sleep":
+		kinds = []string{osqueue.KindSleep}
+	default:
+		return 0, nil
+	}
+
+	count, err := q.queries.CountFunctionQueueItemsByKind(ctx, sqlc.CountFunctionQueueItemsByKindParams{
+		Shard:      q.name,
+		FunctionID: scope.FunctionID.String(),
+		Kinds:      strings.Join(kinds, ","),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error inspecting function queue status: %w", err)
+	}
+	return count, nil
+}
+
+func (q *queue) RunningCount(ctx context.Context, scope osqueue.Scope) (int64, error) {
+	// Only consider unexpired leases;  expired items will be scavenged.
+	count, err := q.queries.CountPartitionInProgress(ctx, sqlc.CountPartitionInProgressParams{
+		Shard:       q.name,
+		PartitionID: scope.FunctionID.String(),
+		NowMs:       q.Clock.Now().UnixMilli(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error inspecting job count: %w", err)
+	}
+	return count, nil
+}
+
+func (q *queue) ItemsByPartition(ctx context.Context, scope osqueue.Scope, partitionID string, from time.Time, until time.Time, opts ...osqueue.QueueIterOpt) (iter.Seq[*osqueue.QueueItem], error) {
+	l := logger.StdlibLogger(ctx)
+
+	opt := osqueue.QueueIterOptions{
+		BatchSize:                 1000,
+		Interval:                  500 * time.Millisecond,
+		IterateBacklogs:           true,
+		EnableMillisecondIncrease: true,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	l = l.With(
+		"method", "ItemsByPartition",
+		"partition_id", partitionID,
+		"from", from,
+		"until", until,
+		"queue_shard", q.Name(),
+	)
+
+	exists, err := q.queries.QueuePartitionExists(ctx, sqlc.QueuePartitionExistsParams{
+		Shard: q.name,
+		ID:    partitionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving partition '%s': %w", partitionID, err)
+	}
+	if !exists {
+		// Partitions with all items held in backlogs only have a shadow
+		// partition.
+		exists, err = q.queries.QueueShadowPartitionExists(ctx, sqlc.QueueShadowPartitionExistsParams{
+			Shard: q.name,
+			ID:    partitionID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving shadow partition '%s': %w", partitionID, err)
+		}
+	}
+	if !exists {
+		return nil, fmt.Errorf("error retrieving partition '%s': %w", partitionID, osqueue.ErrPartitionNotFound)
+	}
+
+	return func(yield func(*osqueue.QueueItem) bool) {
+		ptFrom := from
+
+		for {
+			result, err := q.peek(ctx, peekOpts{
+				From:        &ptFrom,
+				Until:       until,
+				Limit:       opt.BatchSize,
+				PartitionID: partitionID,
+			})
+			if err != nil {
+				if !errors.Is(err, context.Canceled) {
+					l.ReportError(err, "error peeking items for partition iterator")
+				}
+				return
+			}
+
+			for _, qi := range result.Items {
+				if !yield(qi) {
+					return
+				}
+			}
+
+			// Fewer rows than the batch size means the range is exhausted.
+			if int64(result.RawCount) < opt.BatchSize {
+				break
+			}
+
+			ptFrom = time.UnixMilli(result.LastScore)
+			if opt.EnableMillisecondIncrease {
+				// shift the starting point 1ms so it doesn't try to grab the same stuff again
+				// NOTE: this could result skipping items if the previous batch of items are all on
+				// the same millisecond
+				ptFrom = ptFrom.Add(time.Millisecond)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(opt.Interval):
+			}
+		}
+
+		if !opt.IterateBacklogs {
+			return
+		}
+
+		// Iterate through items held in the partition's backlogs.
+		backlogFrom := from.UnixMilli()
+		if from.IsZero() {
+			backlogFrom = math.MinInt64
+		}
+		untilMS := int64(math.MaxInt64)
+		if until.UnixMilli() > 0 {
+			untilMS = until.UnixMilli()
+		}
+
+		for {
+			rows, err := q.queries.PeekPartitionBacklogQueueItems(ctx, sqlc.PeekPartitionBacklogQueueItemsParams{
+				Shard:       q.name,
+				PartitionID: partitionID,
+				FromMs:      backlogFrom,
+				UntilMs:     untilMS,
+				Lim:         int32(opt.BatchSize),
+			})
+			if err != nil {
+				if !errors.Is(err, context.Canceled) {
+					l.ReportError(err, "error peeking backlog items for partition iterator")
+				}
+				return
+			}
+
+			for _, row := range rows {
+				qi, err := decodeQueueItem(row.Item)
+				if err != nil {
+					l.ReportError(err, "error decoding backlog item for partition iterator")
+					return
+				}
+				if !yield(qi) {
+					return
+				}
+			}
+
+			if int64(len(rows)) < opt.BatchSize {
+				return
+			}
+
+			backlogFrom = rows[len(rows)-1].ScoreMs
+			if opt.EnableMillisecondIncrease {
+				backlogFrom++
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(opt.Interval):
+			}
+		}
+	}, nil
+}
+
+func (q *queue) ItemExists(ctx context.Context, scope osqueue.Scope, jobID string) (bool, error) {
+	return q.queries.QueueItemExists(ctx, sqlc.QueueItemExistsParams{
+		Shard: q.name,
+		ID:    jobID,
+	})
+}
+
+func (q *queue) ItemsByRunID(ctx context.Context, scope osqueue.Scope, runID ulid.ULID) ([]*osqueue.QueueItem, error) {
+	rows, err := q.queries.GetRunQueueItemsPage(ctx, sqlc.GetRunQueueItemsPageParams{
+		Shard: q.name,
+		RunID: runID.String(),
+		Lim:   int32(consts.DefaultMaxStepLimit), // use the default step limit for this
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving queue items: %w", err)
+	}
+
+	res := []*osqueue.QueueItem{}
+	for _, byt := range rows {
+		if qi, err := decodeQueueItem(byt); err == nil {
+			res = append(res, qi)
+		}
+	}
+	return res, nil
+}
+
+// PartitionByID returns the partition along with its current counters.
+func (q *queue) PartitionByID(ctx context.Context, scope osqueue.Scope, partitionID string) (*osqueue.PartitionInspectionResult, error) {
+	var result osqueue.PartitionInspectionResult
+
+	row, err := q.queries.GetQueuePartition(ctx, sqlc.GetQueuePartitionParams{
+		Shard: q.name,
+		ID:    partitionID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, osqueue.ErrPartitionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving queue partition: %w", err)
+	}
+	qp, err := decodeQueuePartition(row.Partition)
+	if err != nil {
+		return nil, err
+	}
+	result.QueuePartition = qp
+
+	now := q.Clock.Now().UnixMilli()
+
+	if row.AccountID != "" {
+		count, err := q.queries.CountAccountInProgress(ctx, sqlc.CountAccountInProgressParams{
+			Shard:     q.name,
+			AccountID: row.AccountID,
+			NowMs:     now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retriving counters: %w", err)
+		}
+		result.AccountInProgress = int(count)
+	}
+
+	ready, err := q.queries.CountReadyQueueItems(ctx, sqlc.CountReadyQueueItemsParams{
+		Shard:       q.name,
+		PartitionID: qp.ID,
+		FromMs:      math.MinInt64,
+		UntilMs:     now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retriving counters: %w", err)
+	}
+	result.Ready = int(ready)
+
+	inProgress, err := q.queries.CountPartitionInProgress(ctx, sqlc.CountPartitionInProgressParams{
+		Shard:       q.name,
+		PartitionID: qp.ID,
+		NowMs:       now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retriving counters: %w", err)
+	}
+	result.InProgress = int(inProgress)
+
+	future, err := q.queries.CountFutureQueueItems(ctx, sqlc.CountFutureQueueItemsParams{
+		Shard:       q.name,
+		PartitionID: qp.ID,
+		NowMs:       now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retriving counters: %w", err)
+	}
+	result.Future = int(future)
+
+	// Fetch paused + migrating state
+	if qp.FunctionID != nil && qp.EnvID != nil {
+		paused := q.PartitionPausedGetter(ctx, *qp.FunctionID)
+		result.Paused = paused.Paused
+
+		locked, err := q.IsMigrationLocked(ctx, osqueue.Scope{
+			AccountID:  qp.AccountID,
+			EnvID:      *qp.EnvID,
+			FunctionID: *qp.FunctionID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get locked state: %w", err)
+		}
+		result.Migrate = locked != nil
+	}
+
+	return &result, nil
+}
+
+func (q *queue) UnpauseFunction(ctx context.Context, scope osqueue.Scope) error {
+	l := logger.StdlibLogger(ctx)
+
+	part := &osqueue.QueuePartition{
+		ID:         scope.FunctionID.String(),
+		FunctionID: &scope.FunctionID,
+		AccountID:  scope.AccountID,
+		EnvID:      &scope.EnvID,
+	}
+
+	err := q.PartitionRequeue(ctx, part, q.Clock.Now(), false)
+	if err != nil && !errors.Is(err, osqueue.ErrPartitionNotFound) && !errors.Is(err, osqueue.ErrPartitionGarbageCollected) {
+		l.Error("failed to requeue unpaused partition", "error", err, "partition", part)
+		return fmt.Errorf("could not unpause partition: %w", err)
+	}
+
+	l.Trace("requeued unpaused partition", "partition", part.Queue())
+	return nil
+}
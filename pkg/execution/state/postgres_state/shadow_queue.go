@@ -0,0 +1,1079 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/logger"
+	itrace "github.com/inngest/inngest/pkg/telemetry/trace"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Key queues hold items in backlogs until the shadow scanner refills them into
+// their partition.  Queue items within a backlog have backlog_id set and are
+// never peeked from their partition.  Backlogs are stored in queue_backlogs,
+// and each shadow partition in queue_shadow_partitions is scored by its
+// earliest backlog, mirroring the shadow partition sets used by the Redis
+// queue.
+//
+// Every transaction which adds items to or removes items from a backlog holds
+// an advisory lock on the shadow partition, so that backlogs and shadow
+// partitions are never garbage-collected while items are being added to them.
+
+func decodeShadowPartition(byt []byte) (*osqueue.QueueShadowPartition, error) {
+	sp := &osqueue.QueueShadowPartition{}
+	if err := json.Unmarshal(byt, sp); err != nil {
+		return nil, fmt.Errorf("error unmarshalling shadow partition: %w", err)
+	}
+	return sp, nil
+}
+
+func decodeBacklog(byt []byte) (*osqueue.QueueBacklog, error) {
+	b := &osqueue.QueueBacklog{}
+	if err := json.Unmarshal(byt, b); err != nil {
+		return nil, fmt.Errorf("error unmarshalling backlog: %w", err)
+	}
+	return b, nil
+}
+
+// shadowPartitionAccountID returns the account ID stored alongside a shadow
+// partition.  System partitions are never part of account queues.
+func shadowPartitionAccountID(sp osqueue.QueueShadowPartition) string {
+	if sp.SystemQueueName != nil || sp.AccountID == nil || *sp.AccountID == uuid.Nil {
+		return ""
+	}
+	return sp.AccountID.String()
+}
+
+func nullBacklogID(backlogID string) sql.NullString {
+	return sql.NullString{String: backlogID, Valid: backlogID != ""}
+}
+
+func (q *queue) shadowPartitionScope(sp *osqueue.QueueShadowPartition) itrace.Scope {
+	partitionID := sp.Identifier()
+	if partitionID.SystemQueueName != nil {
+		return itrace.SystemScope{
+			QueueName:      partitionID.SystemQueueName,
+			QueueShardName: q.Name(),
+		}
+	}
+	return itrace.UserScope{
+		AccountID: partitionID.AccountID,
+		EnvID:     partitionID.EnvID,
+		FnID:      partitionID.FunctionID,
+	}
+}
+
+// lockShadowPartition takes a transaction-scoped lock on the given shadow
+// partition.  It must be taken after any queue item row locks, and before
+// any partition, backlog or shadow partition row locks.
+func (q *queue) lockShadowPartition(ctx context.Context, qs *sqlc.Queries, partitionID string) error {
+	if err := qs.AdvisoryXactLock(ctx, q.name+":shadow:"+partitionID); err != nil {
+		return fmt.Errorf("error locking shadow partition: %w", err)
+	}
+	return nil
+}
+
+// enqueueToBacklog stores the backlog and shadow partition if they don't yet
+// exist, and moves both pointers earlier if scoreMS is before the current
+// pointers.  The shadow partition is updated to the latest function version,
+// keeping any existing lease.
+func (q *queue) enqueueToBacklog(ctx context.Context, qs *sqlc.Queries, b osqueue.QueueBacklog, sp osqueue.QueueShadowPartition, scoreMS int64) error {
+	byt, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("error marshalling backlog: %w", err)
+	}
+
+	normalizing := false
+	n, err := qs.InsertQueueBacklog(ctx, sqlc.InsertQueueBacklogParams{
+		Shard:             q.name,
+		ID:                b.BacklogID,
+		ShadowPartitionID: sp.PartitionID,
+		AccountID:         shadowPartitionAccountID(sp),
+		AtMs:              scoreMS,
+		Backlog:           byt,
+	})
+	if err != nil {
+		return fmt.Errorf("error inserting backlog: %w", err)
+	}
+	if n == 0 {
+		row, err := qs.GetQueueBacklogForUpdate(ctx, sqlc.GetQueueBacklogForUpdateParams{
+			Shard: q.name,
+			ID:    b.BacklogID,
+		})
+		if err != nil {
+			return fmt.Errorf("error loading backlog: %w", err)
+		}
+		normalizing = row.NormalizeAtMs.Valid
+		if row.AtMs > scoreMS {
+			err := qs.UpdateQueueBacklog(ctx, sqlc.UpdateQueueBacklogParams{
+				Shard:         q.name,
+				ID:            b.BacklogID,
+				AtMs:          scoreMS,
+				NormalizeAtMs: row.NormalizeAtMs,
+			})
+			if err != nil {
+				return fmt.Errorf("error updating backlog: %w", err)
+			}
+		}
+	}
+
+	byt, err = json.Marshal(sp)
+	if err != nil {
+		return fmt.Errorf("error marshalling shadow partition: %w", err)
+	}
+	n, err = qs.InsertQueueShadowPartition(ctx, sqlc.InsertQueueShadowPartitionParams{
+		Shard:           q.name,
+		ID:              sp.PartitionID,
+		AccountID:       shadowPartitionAccountID(sp),
+		AtMs:            scoreMS,
+		ShadowPartition: byt,
+	})
+	if err != nil {
+		return fmt.Errorf("error inserting shadow partition: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	row, err := qs.GetQueueShadowPartitionForUpdate(ctx, sqlc.GetQueueShadowPartitionForUpdateParams{
+		Shard: q.name,
+		ID:    sp.PartitionID,
+	})
+	if err != nil {
+		return fmt.Errorf("error loading shadow partition: %w", err)
+	}
+	existing, err := decodeShadowPartition(row.ShadowPartition)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	atMS := row.AtMs
+
+	// Update to the current limits, transferring the existing lease.
+	if existing.FunctionVersion < sp.FunctionVersion {
+		sp.LeaseID = existing.LeaseID
+		existing = &sp
+		changed = true
+	}
+
+	// Backlogs being normalized are not refilled, so they never move the
+	// shadow partition pointer.
+	if !normalizing && atMS > scoreMS {
+		atMS = scoreMS
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return q.updateShadowPartition(ctx, qs, existing, atMS)
+}
+
+// updateBacklogPointer moves the backlog pointer to the earliest item within
+// the backlog, deleting the backlog if it is empty, then updates the shadow
+// partition pointer.
+func (q *queue) updateBacklogPointer(ctx context.Context, qs *sqlc.Queries, backlogID, partitionID string) error {
+	earliest, err := qs.EarliestBacklogQueueItemScore(ctx, sqlc.EarliestBacklogQueueItemScoreParams{
+		Shard:     q.name,
+		BacklogID: nullBacklogID(backlogID),
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err := qs.DeleteQueueBacklog(ctx, sqlc.DeleteQueueBacklogParams{
+			Shard: q.name,
+			ID:    backlogID,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting backlog: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("error reading earliest backlog item: %w", err)
+	default:
+		row, err := qs.GetQueueBacklogForUpdate(ctx, sqlc.GetQueueBacklogForUpdateParams{
+			Shard: q.name,
+			ID:    backlogID,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error loading backlog: %w", err)
+		}
+		if err == nil && row.AtMs != earliest {
+			err := qs.UpdateQueueBacklog(ctx, sqlc.UpdateQueueBacklogParams{
+				Shard:         q.name,
+				ID:            backlogID,
+				AtMs:          earliest,
+				NormalizeAtMs: row.NormalizeAtMs,
+			})
+			if err != nil {
+				return fmt.Errorf("error updating backlog: %w", err)
+			}
+		}
+	}
+
+	return q.updateShadowPartitionPointer(ctx, qs, partitionID)
+}
+
+// updateShadowPartitionPointer moves the shadow partition pointer to its
+// earliest backlog, deleting the shadow partition if it has no backlogs.
+func (q *queue) updateShadowPartitionPointer(ctx context.Context, qs *sqlc.Queries, partitionID string) error {
+	atMS, ok, err := q.shadowPartitionScore(ctx, qs, partitionID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		err := qs.DeleteQueueShadowPartition(ctx, sqlc.DeleteQueueShadowPartitionParams{
+			Shard: q.name,
+			ID:    partitionID,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting shadow partition: %w", err)
+		}
+		return nil
+	}
+
+	row, err := qs.GetQueueShadowPartitionForUpdate(ctx, sqlc.GetQueueShadowPartitionForUpdateParams{
+		Shard: q.name,
+		ID:    partitionID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error loading shadow partition: %w", err)
+	}
+	if row.AtMs == atMS {
+		return nil
+	}
+
+	err = qs.UpdateQueueShadowPartition(ctx, sqlc.UpdateQueueShadowPartitionParams{
+		Shard:           q.name,
+		ID:              partitionID,
+		AccountID:       row.AccountID,
+		AtMs:            atMS,
+		ShadowPartition: row.ShadowPartition,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating shadow partition: %w", err)
+	}
+	return nil
+}
+
+// shadowPartitionScore returns the score of the earliest backlog which can be
+// refilled.  Shadow partitions with only backlogs being normalized are scored
+// by the earliest normalization, so that the normalizer still finds them when
+// peeking shadow partitions.  The boolean is false if the shadow partition has
+// no backlogs.
+func (q *queue) shadowPartitionScore(ctx context.Context, qs *sqlc.Queries, partitionID string) (int64, bool, error) {
+	atMS, err := qs.EarliestQueueBacklogScore(ctx, sqlc.EarliestQueueBacklogScoreParams{
+		Shard:             q.name,
+		ShadowPartitionID: partitionID,
+	})
+	if err == nil {
+		return atMS, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, false, fmt.Errorf("error reading earliest backlog: %w", err)
+	}
+
+	atMS, err = qs.EarliestNormalizeQueueBacklogScore(ctx, sqlc.EarliestNormalizeQueueBacklogScoreParams{
+		Shard:             q.name,
+		ShadowPartitionID: partitionID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("error reading earliest normalizing backlog: %w", err)
+	}
+	return atMS, true, nil
+}
+
+func (q *queue) updateShadowPartition(ctx context.Context, qs *sqlc.Queries, sp *osqueue.QueueShadowPartition, atMS int64) error {
+	byt, err := json.Marshal(sp)
+	if err != nil {
+		return fmt.Errorf("error marshalling shadow partition: %w", err)
+	}
+	err = qs.UpdateQueueShadowPartition(ctx, sqlc.UpdateQueueShadowPartitionParams{
+		Shard:           q.name,
+		ID:              sp.PartitionID,
+		AccountID:       shadowPartitionAccountID(*sp),
+		AtMs:            atMS,
+		ShadowPartition: byt,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating shadow partition: %w", err)
+	}
+	return nil
+}
+
+func (q *queue) LeaseBacklogForNormalization(ctx context.Context, bl *osqueue.QueueBacklog) error {
+	leaseID, err := ulid.New(ulid.Timestamp(q.Clock.Now().Add(osqueue.BacklogNormalizeLeaseDuration)), rnd)
+	if err != nil {
+		return fmt.Errorf("could not generate leaseID: %w", err)
+	}
+
+	stored, err := q.kvSetIfAbsent(ctx, q.queries, kvBacklogNormalizePrefix+bl.BacklogID, leaseID.String(), osqueue.BacklogNormalizeLeaseDuration)
+	if err != nil {
+		return fmt.Errorf("error leasing backlog for normalization: %w", err)
+	}
+	if !stored {
+		return osqueue.ErrBacklogAlreadyLeasedForNormalization
+	}
+	return nil
+}
+
+func (q *queue) ExtendBacklogNormalizationLease(ctx context.Context, now time.Time, bl *osqueue.QueueBacklog) error {
+	leaseExpiry := now.Add(osqueue.BacklogNormalizeLeaseDuration)
+	newLeaseID, err := ulid.New(ulid.Timestamp(leaseExpiry), rnd)
+	if err != nil {
+		return fmt.Errorf("could not generate newLeaseID: %w", err)
+	}
+
+	key := kvBacklogNormalizePrefix + bl.BacklogID
+	return q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+key); err != nil {
+			return err
+		}
+		if _, ok, err := q.kvGet(ctx, qs, key); err != nil {
+			return err
+		} else if !ok {
+			return osqueue.ErrBacklogNormalizationLeaseExpired
+		}
+		return q.kvSetAt(ctx, qs, key, newLeaseID.String(), leaseExpiry)
+	})
+}
+
+func (q *queue) ShadowPartitionRequeue(ctx context.Context, sp *osqueue.QueueShadowPartition, requeueAt *time.Time) error {
+	l := logger.StdlibLogger(ctx)
+
+	sp.LeaseID = nil
+
+	var requeueAtStr string
+	if requeueAt != nil {
+		requeueAtStr = requeueAt.Format(time.StampMilli)
+	}
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.ShadowPartitionRequeue", q.shadowPartitionScope(sp))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", sp.PartitionID))
+
+	now := q.Clock.Now()
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := q.lockShadowPartition(ctx, qs, sp.PartitionID); err != nil {
+			return err
+		}
+
+		row, err := qs.GetQueueShadowPartitionForUpdate(ctx, sqlc.GetQueueShadowPartitionForUpdateParams{
+			Shard: q.name,
+			ID:    sp.PartitionID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrShadowPartitionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading shadow partition: %w", err)
+		}
+		existing, err := decodeShadowPartition(row.ShadowPartition)
+		if err != nil {
+			return err
+		}
+		existing.LeaseID = nil
+
+		atMS, err := qs.EarliestQueueBacklogScore(ctx, sqlc.EarliestQueueBacklogScoreParams{
+			Shard:             q.name,
+			ShadowPartitionID: sp.PartitionID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			_, err := qs.EarliestNormalizeQueueBacklogScore(ctx, sqlc.EarliestNormalizeQueueBacklogScoreParams{
+				Shard:             q.name,
+				ShadowPartitionID: sp.PartitionID,
+			})
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("error reading earliest normalizing backlog: %w", err)
+			}
+			if err != nil {
+				// No more backlogs, clean up the shadow partition.
+				return qs.DeleteQueueShadowPartition(ctx, sqlc.DeleteQueueShadowPartitionParams{
+					Shard: q.name,
+					ID:    sp.PartitionID,
+				})
+			}
+			// Only backlogs being normalized remain;  check back once the
+			// normalizer has had a chance to move their items.
+			atMS = now.Add(osqueue.ShadowPartitionRequeueExtendedDuration).UnixMilli()
+		} else if err != nil {
+			return fmt.Errorf("error reading earliest backlog: %w", err)
+		}
+
+		if requeueAt != nil && requeueAt.UnixMilli() > atMS {
+			atMS = requeueAt.UnixMilli()
+		}
+		return q.updateShadowPartition(ctx, qs, existing, atMS)
+	})
+
+	l.Trace("requeued shadow partition",
+		"id", sp.PartitionID,
+		"time", requeueAtStr,
+		"error", err,
+	)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, osqueue.ErrShadowPartitionNotFound):
+		return err
+	default:
+		return fmt.Errorf("error returning shadow partition lease: %w", err)
+	}
+}
+
+func (q *queue) ShadowPartitionLease(ctx context.Context, sp *osqueue.QueueShadowPartition, duration time.Duration) (*ulid.ULID, error) {
+	now := q.Clock.Now()
+	leaseExpiry := now.Add(duration)
+	leaseID, err := ulid.New(ulid.Timestamp(leaseExpiry), rnd)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate leaseID: %w", err)
+	}
+
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := q.lockShadowPartition(ctx, qs, sp.PartitionID); err != nil {
+			return err
+		}
+
+		row, err := qs.GetQueueShadowPartitionForUpdate(ctx, sqlc.GetQueueShadowPartitionForUpdateParams{
+			Shard: q.name,
+			ID:    sp.PartitionID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrShadowPartitionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading shadow partition: %w", err)
+		}
+		existing, err := decodeShadowPartition(row.ShadowPartition)
+		if err != nil {
+			return err
+		}
+		if existing.LeaseID != nil && ulid.Time(existing.LeaseID.Time()).After(now) {
+			return osqueue.ErrShadowPartitionAlreadyLeased
+		}
+
+		// Push the pointer back to prevent other scanners from peeking the
+		// shadow partition while it's leased.
+		existing.LeaseID = &leaseID
+		return q.updateShadowPartition(ctx, qs, existing, leaseExpiry.UnixMilli())
+	})
+	switch {
+	case err == nil:
+		sp.LeaseID = &leaseID
+		return &leaseID, nil
+	case errors.Is(err, osqueue.ErrShadowPartitionNotFound), errors.Is(err, osqueue.ErrShadowPartitionAlreadyLeased):
+		return nil, err
+	default:
+		return nil, fmt.Errorf("error leasing shadow partition: %w", err)
+	}
+}
+
+func (q *queue) ShadowPartitionExtendLease(ctx context.Context, sp *osqueue.QueueShadowPartition, leaseID ulid.ULID, duration time.Duration) (*ulid.ULID, error) {
+	now := q.Clock.Now()
+	leaseExpiry := now.Add(duration)
+	newLeaseID, err := ulid.New(ulid.Timestamp(leaseExpiry), rnd)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate new leaseID: %w", err)
+	}
+
+	err = q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := q.lockShadowPartition(ctx, qs, sp.PartitionID); err != nil {
+			return err
+		}
+
+		row, err := qs.GetQueueShadowPartitionForUpdate(ctx, sqlc.GetQueueShadowPartitionForUpdateParams{
+			Shard: q.name,
+			ID:    sp.PartitionID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrShadowPartitionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading shadow partition: %w", err)
+		}
+		existing, err := decodeShadowPartition(row.ShadowPartition)
+		if err != nil {
+			return err
+		}
+		if existing.LeaseID == nil {
+			return osqueue.ErrShadowPartitionLeaseNotFound
+		}
+		if *existing.LeaseID != leaseID && ulid.Time(existing.LeaseID.Time()).After(now) {
+			return osqueue.ErrShadowPartitionAlreadyLeased
+		}
+
+		existing.LeaseID = &newLeaseID
+		return q.updateShadowPartition(ctx, qs, existing, leaseExpiry.UnixMilli())
+	})
+	switch {
+	case err == nil:
+		sp.LeaseID = &newLeaseID
+		return &newLeaseID, nil
+	case errors.Is(err, osqueue.ErrShadowPartitionNotFound),
+		errors.Is(err, osqueue.ErrShadowPartitionLeaseNotFound),
+		errors.Is(err, osqueue.ErrShadowPartitionAlreadyLeased):
+		return nil, err
+	default:
+		return nil, fmt.Errorf("error extending shadow partition lease: %w", err)
+	}
+}
+
+// BacklogPrepareNormalize removes the backlog from refills and marks it for
+// normalization, garbage-collecting the backlog if it is empty.
+func (q *queue) BacklogPrepareNormalize(ctx context.Context, b *osqueue.QueueBacklog, sp *osqueue.QueueShadowPartition) error {
+	nowMS := q.Clock.Now().UnixMilli()
+
+	collected := false
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := q.lockShadowPartition(ctx, qs, sp.PartitionID); err != nil {
+			return err
+		}
+
+		_, err := qs.EarliestBacklogQueueItemScore(ctx, sqlc.EarliestBacklogQueueItemScoreParams{
+			Shard:     q.name,
+			BacklogID: nullBacklogID(b.BacklogID),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			collected = true
+			return q.updateBacklogPointer(ctx, qs, b.BacklogID, sp.PartitionID)
+		}
+		if err != nil {
+			return fmt.Errorf("error reading earliest backlog item: %w", err)
+		}
+
+		row, err := qs.GetQueueBacklogForUpdate(ctx, sqlc.GetQueueBacklogForUpdateParams{
+			Shard: q.name,
+			ID:    b.BacklogID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrBacklogNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading backlog: %w", err)
+		}
+
+		normalizeAtMS := nowMS
+		if row.NormalizeAtMs.Valid && row.NormalizeAtMs.Int64 < normalizeAtMS {
+			normalizeAtMS = row.NormalizeAtMs.Int64
+		}
+		err = qs.UpdateQueueBacklog(ctx, sqlc.UpdateQueueBacklogParams{
+			Shard:         q.name,
+			ID:            b.BacklogID,
+			AtMs:          row.AtMs,
+			NormalizeAtMs: sql.NullInt64{Int64: normalizeAtMS, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("error updating backlog: %w", err)
+		}
+
+		return q.updateShadowPartitionPointer(ctx, qs, sp.PartitionID)
+	})
+	switch {
+	case err == nil && collected:
+		return osqueue.ErrBacklogGarbageCollected
+	case err == nil:
+		return nil
+	case errors.Is(err, osqueue.ErrBacklogNotFound):
+		return err
+	default:
+		return fmt.Errorf("error preparing backlog normalization: %w", err)
+	}
+}
+
+// BacklogRefill moves the given items from the backlog into their partition.
+// Items which are no longer in the backlog, or which are locked by another
+// transaction, are skipped.
+func (q *queue) BacklogRefill(
+	ctx context.Context,
+	b *osqueue.QueueBacklog,
+	sp *osqueue.QueueShadowPartition,
+	refillUntil time.Time,
+	refillItems []string,
+	options ...osqueue.BacklogRefillOptionFn,
+) (*osqueue.BacklogRefillResult, error) {
+	o := &osqueue.BacklogRefillOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.BacklogRefill", q.shadowPartitionScope(sp))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", sp.PartitionID))
+	span.SetAttributes(attribute.String("backlog_id", b.BacklogID))
+
+	now := q.Clock.Now()
+	backlogID := nullBacklogID(b.BacklogID)
+
+	var result *osqueue.BacklogRefillResult
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		result = &osqueue.BacklogRefillResult{RefilledItems: []string{}}
+
+		if err := q.lockShadowPartition(ctx, qs, sp.PartitionID); err != nil {
+			return err
+		}
+
+		total, err := qs.CountBacklogQueueItems(ctx, sqlc.CountBacklogQueueItemsParams{
+			Shard:     q.name,
+			BacklogID: backlogID,
+			FromMs:    math.MinInt64,
+			UntilMs:   math.MaxInt64,
+		})
+		if err != nil {
+			return fmt.Errorf("error counting backlog items: %w", err)
+		}
+		if total == 0 {
+			return q.updateBacklogPointer(ctx, qs, b.BacklogID, sp.PartitionID)
+		}
+		result.TotalBacklogCount = int(total)
+
+		until, err := qs.CountBacklogQueueItems(ctx, sqlc.CountBacklogQueueItemsParams{
+			Shard:     q.name,
+			BacklogID: backlogID,
+			FromMs:    math.MinInt64,
+			UntilMs:   refillUntil.UnixMilli(),
+		})
+		if err != nil {
+			return fmt.Errorf("error counting backlog items: %w", err)
+		}
+		if until == 0 {
+			return q.updateBacklogPointer(ctx, qs, b.BacklogID, sp.PartitionID)
+		}
+		result.BacklogCountUntil = int(until)
+
+		var (
+			partition  *osqueue.QueuePartition
+			earliestMS int64 = math.MaxInt64
+		)
+		for n, itemID := range refillItems {
+			// Skip items locked by concurrent dequeues or requeues, which
+			// take the item lock before the shadow partition lock.
+			row, err := qs.GetQueueItemForUpdateSkipLocked(ctx, sqlc.GetQueueItemForUpdateSkipLockedParams{
+				Shard: q.name,
+				ID:    itemID,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("error loading queue item: %w", err)
+			}
+			if row.BacklogID != backlogID {
+				continue
+			}
+
+			qi, err := decodeQueueItem(row.Item)
+			if err != nil {
+				return err
+			}
+			qi.RefilledFrom = b.BacklogID
+			qi.RefilledAt = now.UnixMilli()
+			if n < len(o.CapacityLeases) {
+				lease := o.CapacityLeases[n]
+				qi.CapacityLease = &lease
+			}
+
+			row.BacklogID = sql.NullString{}
+			if err := q.updateItem(ctx, qs, row, qi); err != nil {
+				return fmt.Errorf("error updating queue item: %w", err)
+			}
+
+			if partition == nil {
+				p := osqueue.ItemPartition(ctx, *qi)
+				partition = &p
+			}
+			earliestMS = min(earliestMS, row.ScoreMs)
+			result.RefilledItems = append(result.RefilledItems, itemID)
+		}
+
+		if partition != nil {
+			partitionTime := time.UnixMilli(earliestMS)
+			if partitionTime.Before(now) {
+				partitionTime = now
+			}
+			if err := q.enqueueToPartition(ctx, qs, *partition, partitionTime, now); err != nil {
+				return err
+			}
+		}
+
+		return q.updateBacklogPointer(ctx, qs, b.BacklogID, sp.PartitionID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error refilling backlog: %w", err)
+	}
+	return result, nil
+}
+
+func (q *queue) BacklogRequeue(ctx context.Context, backlog *osqueue.QueueBacklog, sp *osqueue.QueueShadowPartition, requeueAt time.Time) error {
+	l := logger.StdlibLogger(ctx)
+
+	ctx, span := q.ConditionalTracer.NewSpan(ctx, "queue.BacklogRequeue", q.shadowPartitionScope(sp))
+	defer span.End()
+	span.SetAttributes(attribute.String("partition_id", sp.PartitionID))
+	span.SetAttributes(attribute.String("backlog_id", backlog.BacklogID))
+
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := q.lockShadowPartition(ctx, qs, sp.PartitionID); err != nil {
+			return err
+		}
+
+		row, err := qs.GetQueueBacklogForUpdate(ctx, sqlc.GetQueueBacklogForUpdateParams{
+			Shard: q.name,
+			ID:    backlog.BacklogID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return osqueue.ErrBacklogNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("error loading backlog: %w", err)
+		}
+
+		// Clean up empty backlogs.
+		_, err = qs.EarliestBacklogQueueItemScore(ctx, sqlc.EarliestBacklogQueueItemScoreParams{
+			Shard:     q.name,
+			BacklogID: nullBacklogID(backlog.BacklogID),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return q.updateBacklogPointer(ctx, qs, backlog.BacklogID, sp.PartitionID)
+		}
+		if err != nil {
+			return fmt.Errorf("error reading earliest backlog item: %w", err)
+		}
+
+		err = qs.UpdateQueueBacklog(ctx, sqlc.UpdateQueueBacklogParams{
+			Shard:         q.name,
+			ID:            backlog.BacklogID,
+			AtMs:          requeueAt.UnixMilli(),
+			NormalizeAtMs: row.NormalizeAtMs,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating backlog: %w", err)
+		}
+
+		return q.updateShadowPartitionPointer(ctx, qs, sp.PartitionID)
+	})
+
+	l.Trace("requeued backlog",
+		"id", backlog.BacklogID,
+		"partition", sp.PartitionID,
+		"time", requeueAt.Format(time.StampMilli),
+		"error", err,
+	)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, osqueue.ErrBacklogNotFound):
+		return err
+	default:
+		return fmt.Errorf("could not requeue backlog: %w", err)
+	}
+}
+
+func (q *queue) ShadowPartitionPeekNormalizeBacklogs(ctx context.Context, sp *osqueue.QueueShadowPartition, limit int64) ([]*osqueue.QueueBacklog, error) {
+	if limit > osqueue.NormalizePartitionPeekMax {
+		return nil, osqueue.ErrShadowPartitionBacklogPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.NormalizePartitionPeekMax
+	}
+
+	rows, err := q.queries.PeekNormalizeQueueBacklogs(ctx, sqlc.PeekNormalizeQueueBacklogsParams{
+		Shard:             q.name,
+		ShadowPartitionID: sp.PartitionID,
+		Lim:               int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not peek backlogs for normalization: %w", err)
+	}
+
+	backlogs := make([]*osqueue.QueueBacklog, 0, len(rows))
+	for _, byt := range rows {
+		b, err := decodeBacklog(byt)
+		if err != nil {
+			return nil, err
+		}
+		backlogs = append(backlogs, b)
+	}
+	return backlogs, nil
+}
+
+func (q *queue) BacklogNormalizePeek(ctx context.Context, b *osqueue.QueueBacklog, limit int64) (*osqueue.PeekResult[osqueue.QueueItem], error) {
+	if limit > osqueue.NormalizeBacklogPeekMax {
+		return nil, osqueue.ErrBacklogPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.NormalizeBacklogPeekMax
+	}
+
+	res, err := q.backlogPeek(ctx, b.BacklogID, math.MinInt64, math.MaxInt64, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not peek backlog items for normalization: %w", err)
+	}
+	return &osqueue.PeekResult[osqueue.QueueItem]{
+		Items:      res.Items,
+		TotalCount: res.TotalCount,
+		Cursor:     res.Cursor,
+	}, nil
+}
+
+func (q *queue) PeekGlobalNormalizeAccounts(ctx context.Context, until time.Time, limit int64) ([]uuid.UUID, error) {
+	if limit > osqueue.NormalizeAccountPeekMax {
+		return nil, osqueue.ErrAccountPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.NormalizeAccountPeekMax
+	}
+
+	ids, err := q.queries.PeekQueueNormalizeAccounts(ctx, sqlc.PeekQueueNormalizeAccountsParams{
+		Shard:   q.name,
+		UntilMs: until.UnixMilli(),
+		Lim:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error peeking normalize accounts: %w", err)
+	}
+	return parseAccountIDs(ids)
+}
+
+func (q *queue) PeekGlobalShadowPartitionAccounts(ctx context.Context, sequential bool, until time.Time, limit int64) ([]uuid.UUID, error) {
+	if limit > osqueue.ShadowPartitionAccountPeekMax {
+		return nil, osqueue.ErrShadowPartitionAccountPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.ShadowPartitionAccountPeekMax
+	}
+
+	var offset int64
+	if !sequential {
+		count, err := q.queries.CountPeekableQueueShadowPartitionAccounts(ctx, sqlc.CountPeekableQueueShadowPartitionAccountsParams{
+			Shard:   q.name,
+			UntilMs: until.UnixMilli(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error counting shadow partition accounts: %w", err)
+		}
+		if count > limit {
+			offset = int64(rnd.Uint64n(uint64(count - limit + 1)))
+		}
+	}
+
+	ids, err := q.queries.PeekQueueShadowPartitionAccounts(ctx, sqlc.PeekQueueShadowPartitionAccountsParams{
+		Shard:   q.name,
+		UntilMs: until.UnixMilli(),
+		Off:     int32(offset),
+		Lim:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error peeking shadow partition accounts: %w", err)
+	}
+	return parseAccountIDs(ids)
+}
+
+func parseAccountIDs(ids []string) ([]uuid.UUID, error) {
+	items := make([]uuid.UUID, len(ids))
+	for i, s := range ids {
+		parsed, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse account id: %w", err)
+		}
+		items[i] = parsed
+	}
+	return items, nil
+}
+
+func (q *queue) ShadowPartitionPeek(ctx context.Context, sp *osqueue.QueueShadowPartition, sequential bool, until time.Time, limit int64, opts ...osqueue.PeekOpt) ([]*osqueue.QueueBacklog, int, error) {
+	if limit > osqueue.ShadowPartitionPeekMaxBacklogs {
+		return nil, 0, osqueue.ErrShadowPartitionBacklogPeekMaxExceedsLimits
+	}
+	if limit <= 0 {
+		limit = osqueue.ShadowPartitionPeekMaxBacklogs
+	}
+
+	count, err := q.queries.CountPeekableQueueBacklogs(ctx, sqlc.CountPeekableQueueBacklogsParams{
+		Shard:             q.name,
+		ShadowPartitionID: sp.PartitionID,
+		UntilMs:           until.UnixMilli(),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting shadow partition backlogs: %w", err)
+	}
+
+	var offset int64
+	if !sequential && count > limit {
+		offset = int64(rnd.Uint64n(uint64(count - limit + 1)))
+	}
+
+	rows, err := q.queries.PeekQueueBacklogs(ctx, sqlc.PeekQueueBacklogsParams{
+		Shard:             q.name,
+		ShadowPartitionID: sp.PartitionID,
+		UntilMs:           until.UnixMilli(),
+		Off:               int32(offset),
+		Lim:               int32(limit),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not peek shadow partition backlogs: %w", err)
+	}
+
+	backlogs := make([]*osqueue.QueueBacklog, 0, len(rows))
+	for _, byt := range rows {
+		b, err := decodeBacklog(byt)
+		if err != nil {
+			return nil, 0, err
+		}
+		backlogs = append(backlogs, b)
+	}
+	return backlogs, int(count), nil
+}
+
+// PeekShadowPartitions returns shadow partitions with backlogs due before until,
+// either globally or within the given account.
+func (q *queue) PeekShadowPartitions(ctx context.Context, accountID *uuid.UUID, sequential bool, peekLimit int64, until time.Time) ([]*osqueue.QueueShadowPartition, error) {
+	l := logger.StdlibLogger(ctx)
+
+	if peekLimit > osqueue.ShadowPartitionPeekMax {
+		return nil, osqueue.ErrShadowPartitionPeekMaxExceedsLimits
+	}
+	if peekLimit <= 0 {
+		peekLimit = osqueue.ShadowPartitionPeekMax
+	}
+
+	untilMS := until.UnixMilli()
+
+	var (
+		count int64
+		err   error
+	)
+	if !sequential {
+		if accountID == nil {
+			count, err = q.queries.CountPeekableQueueShadowPartitions(ctx, sqlc.CountPeekableQueueShadowPartitionsParams{
+				Shard:   q.name,
+				UntilMs: untilMS,
+			})
+		} else {
+			count, err = q.queries.CountPeekableAccountQueueShadowPartitions(ctx, sqlc.CountPeekableAccountQueueShadowPartitionsParams{
+				Shard:     q.name,
+				AccountID: accountID.String(),
+				UntilMs:   untilMS,
+			})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error counting shadow partitions: %w", err)
+		}
+	}
+
+	var offset int64
+	if count > peekLimit {
+		offset = int64(rnd.Uint64n(uint64(count - peekLimit + 1)))
+	}
+
+	var rows [][]byte
+	if accountID == nil {
+		rows, err = q.queries.PeekQueueShadowPartitions(ctx, sqlc.PeekQueueShadowPartitionsParams{
+			Shard:   q.name,
+			UntilMs: untilMS,
+			Off:     int32(offset),
+			Lim:     int32(peekLimit),
+		})
+	} else {
+		rows, err = q.queries.PeekAccountQueueShadowPartitions(ctx, sqlc.PeekAccountQueueShadowPartitionsParams{
+			Shard:     q.name,
+			AccountID: accountID.String(),
+			UntilMs:   untilMS,
+			Off:       int32(offset),
+			Lim:       int32(peekLimit),
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not peek shadow partitions: %w", err)
+	}
+
+	partitions := make([]*osqueue.QueueShadowPartition, 0, len(rows))
+	for _, byt := range rows {
+		sp, err := decodeShadowPartition(byt)
+		if err != nil {
+			return nil, err
+		}
+		l.Trace("peeked shadow partition", "partition_id", sp.PartitionID, "until", until.Format(time.StampMilli))
+		partitions = append(partitions, sp)
+	}
+	return partitions, nil
+}
+
+// BacklogPeek peeks items from the given backlog in order, from the given
+// time until the given time.
+func (q *queue) BacklogPeek(ctx context.Context, b *osqueue.QueueBacklog, from time.Time, until time.Time, limit int64, opts ...osqueue.PeekOpt) (*osqueue.BacklogPeekResult, error) {
+	if b == nil {
+		return nil, fmt.Errorf("expected backlog to be provided")
+	}
+
+	if limit > osqueue.AbsoluteQueuePeekMax || limit > q.PeekMax {
+		limit = q.PeekMax
+	}
+	if limit <= 0 {
+		limit = q.PeekMin
+	}
+
+	fromMS := int64(math.MinInt64)
+	if !from.IsZero() {
+		fromMS = from.UnixMilli()
+	}
+
+	res, err := q.backlogPeek(ctx, b.BacklogID, fromMS, until.UnixMilli(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error peeking backlog queue items, %w", err)
+	}
+	return res, nil
+}
+
+func (q *queue) backlogPeek(ctx context.Context, backlogID string, fromMS, untilMS, limit int64) (*osqueue.BacklogPeekResult, error) {
+	count, err := q.queries.CountBacklogQueueItems(ctx, sqlc.CountBacklogQueueItemsParams{
+		Shard:     q.name,
+		BacklogID: nullBacklogID(backlogID),
+		FromMs:    fromMS,
+		UntilMs:   untilMS,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error counting backlog items: %w", err)
+	}
+
+	rows, err := q.queries.PeekBacklogQueueItems(ctx, sqlc.PeekBacklogQueueItemsParams{
+		Shard:     q.name,
+		BacklogID: nullBacklogID(backlogID),
+		FromMs:    fromMS,
+		UntilMs:   untilMS,
+		Lim:       int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error peeking backlog items: %w", err)
+	}
+
+	res := &osqueue.BacklogPeekResult{
+		Items:      make([]*osqueue.QueueItem, 0, len(rows)),
+		TotalCount: int(count),
+	}
+	for _, row := range rows {
+		qi, err := decodeQueueItem(row.Item)
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, qi)
+		res.Cursor = row.ScoreMs
+	}
+	return res, nil
+}
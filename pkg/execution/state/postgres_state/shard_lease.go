@@ -0,0 +1,117 @@
+package postgres_state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/oklog/ulid/v2"
+)
+
+// Implements ShardLease() in ShardOperations interface
+//
+// ShardLease allows a worker to lease config keys to process the shard
+// Leasing this key works similar to leasing partitions or queue items:
+//
+//   - If the key has fewer than maxLeases granted, a new lease is accepted.
+//   - If some of the leases in the key are expired, a new lease is granted to replenish those expired leases
+//   - If an existing lease ID is provided, it is renewed if it is still held.
+//
+// Each lease is stored as its own key in queue_kv, prefixed by the shard lease key.
+//
+// This returns the new lease ID on success.
+func (q *queue) ShardLease(ctx context.Context, key string, duration time.Duration, maxLeases int, existingLeaseID ...*ulid.ULID) (*ulid.ULID, error) {
+	if duration > osqueue.ShardLeaseMax {
+		return nil, osqueue.ErrShardLeaseExceedsLimits
+	}
+
+	now := q.Clock.Now()
+	newLeaseID, err := ulid.New(ulid.Timestamp(now.Add(duration)), rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *ulid.ULID
+	if len(existingLeaseID) > 0 {
+		existing = existingLeaseID[0]
+	}
+
+	err = q.shardLease(ctx, key, now, newLeaseID.String(), existing, maxLeases)
+	switch {
+	case err == nil:
+		return &newLeaseID, nil
+	case errors.Is(err, osqueue.ErrShardLeaseNotFound), errors.Is(err, osqueue.ErrAllShardsAlreadyLeased):
+		return nil, err
+	default:
+		return nil, fmt.Errorf("error claiming shard lease: %w", err)
+	}
+}
+
+// ReleaseShardLease releases an existing shard lease without renewing it.
+// This removes the lease from the set, freeing a slot for other workers.
+func (q *queue) ReleaseShardLease(ctx context.Context, key string, existingLeaseID ulid.ULID) error {
+	err := q.shardLease(ctx, key, q.Clock.Now(), "", &existingLeaseID, 0)
+	if err != nil && !errors.Is(err, osqueue.ErrShardLeaseNotFound) {
+		return fmt.Errorf("error releasing shard lease: %w", err)
+	}
+	return nil
+}
+
+// shardLease renews, releases or grants a shard lease.  An empty newLeaseID
+// releases the existing lease.
+func (q *queue) shardLease(ctx context.Context, key string, now time.Time, newLeaseID string, existing *ulid.ULID, maxLeases int) error {
+	prefix := kvShardLeasePrefix + key + ":"
+
+	return q.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.AdvisoryXactLock(ctx, q.name+":"+prefix); err != nil {
+			return err
+		}
+
+		if existing != nil {
+			// Expired leases which are still held are safe to renew;  there
+			// has been no membership change since.
+			n, err := qs.DeleteQueueKV(ctx, sqlc.DeleteQueueKVParams{Shard: q.name, Key: prefix + existing.String()})
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return osqueue.ErrShardLeaseNotFound
+			}
+			if newLeaseID == "" {
+				return nil
+			}
+			return q.kvSet(ctx, qs, prefix+newLeaseID, "", 0)
+		}
+
+		rows, err := qs.ListQueueKVByPrefix(ctx, sqlc.ListQueueKVByPrefixParams{
+			Shard:  q.name,
+			Prefix: prefix,
+			NowMs:  now.UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+
+		valid := 0
+		for _, row := range rows {
+			leaseID, err := ulid.Parse(strings.TrimPrefix(row.Key, prefix))
+			if err == nil && int64(leaseID.Time()) >= now.UnixMilli() {
+				valid++
+				continue
+			}
+			// Expired lease, remove it
+			if err := q.kvDelete(ctx, qs, row.Key); err != nil {
+				return err
+			}
+		}
+
+		if valid >= maxLeases {
+			return osqueue.ErrAllShardsAlreadyLeased
+		}
+		return q.kvSet(ctx, qs, prefix+newLeaseID, "", 0)
+	})
+}
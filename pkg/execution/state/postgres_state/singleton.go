@@ -0,0 +1,57 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/oklog/ulid/v2"
+)
+
+// SingletonGetRunID implements queue.ShardOperations.
+func (q *queue) SingletonGetRunID(ctx context.Context, scope osqueue.Scope, key string) (*ulid.ULID, error) {
+	val, ok, err := q.kvGet(ctx, q.queries, kvSingletonPrefix+key)
+	return parseRunIDFromKV(val, ok, err)
+}
+
+// SingletonReleaseRunID implements queue.ShardOperations.
+func (q *queue) SingletonReleaseRunID(ctx context.Context, scope osqueue.Scope, key string) (*ulid.ULID, error) {
+	var (
+		val string
+		ok  bool
+	)
+	err := q.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := qs.GetQueueKVForUpdate(ctx, sqlc.GetQueueKVForUpdateParams{
+			Shard: q.name,
+			Key:   kvSingletonPrefix + key,
+			NowMs: q.Clock.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		val, ok = row.Value, true
+		return q.kvDelete(ctx, qs, row.Key)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return parseRunIDFromKV(val, ok, err)
+}
+
+func parseRunIDFromKV(val string, ok bool, err error) (*ulid.ULID, error) {
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	runID, err := ulid.Parse(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runID, nil
+}
@@ -0,0 +1,620 @@
+-- name: AdvisoryXactLock :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(key)::text, 0));
+
+--
+-- Queue items
+--
+
+-- name: InsertQueueItem :execrows
+INSERT INTO queue_items (
+    shard, id, partition_id, account_id, function_id, run_id, kind, score_ms, backlog_id, item
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) ON CONFLICT (shard, id) DO NOTHING;
+
+-- name: GetQueueItem :one
+SELECT * FROM queue_items WHERE shard = $1 AND id = $2;
+
+-- name: GetQueueItemForUpdate :one
+SELECT * FROM queue_items WHERE shard = $1 AND id = $2 FOR UPDATE;
+
+-- name: GetQueueItemForUpdateSkipLocked :one
+SELECT * FROM queue_items WHERE shard = $1 AND id = $2 FOR UPDATE SKIP LOCKED;
+
+-- name: QueueItemExists :one
+SELECT EXISTS(SELECT 1 FROM queue_items WHERE shard = $1 AND id = $2);
+
+-- name: UpdateQueueItem :exec
+UPDATE queue_items SET
+    score_ms = $3,
+    lease_id = $4,
+    lease_until_ms = $5,
+    concurrency_key_1 = $6,
+    concurrency_key_2 = $7,
+    backlog_id = $8,
+    item = $9
+WHERE shard = $1 AND id = $2;
+
+-- name: DeleteQueueItem :execrows
+DELETE FROM queue_items WHERE shard = $1 AND id = $2;
+
+-- name: PeekQueueItems :many
+SELECT score_ms, item FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND lease_id IS NULL AND backlog_id IS NULL AND score_ms >= sqlc.arg(from_ms) AND score_ms <= sqlc.arg(until_ms)
+ORDER BY score_ms, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountReadyQueueItems :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND lease_id IS NULL AND backlog_id IS NULL AND score_ms >= sqlc.arg(from_ms) AND score_ms <= sqlc.arg(until_ms);
+
+-- name: EarliestReadyQueueItemScore :one
+SELECT score_ms FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND lease_id IS NULL AND backlog_id IS NULL
+ORDER BY score_ms
+LIMIT 1;
+
+-- name: CountPartitionQueueItems :one
+SELECT COUNT(*) FROM queue_items WHERE shard = $1 AND partition_id = $2 AND backlog_id IS NULL;
+
+-- name: PeekPartitionBacklogQueueItems :many
+SELECT score_ms, item FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND backlog_id IS NOT NULL AND score_ms >= sqlc.arg(from_ms) AND score_ms <= sqlc.arg(until_ms)
+ORDER BY score_ms, id
+LIMIT sqlc.arg(lim);
+
+-- name: PeekBacklogQueueItems :many
+SELECT score_ms, item FROM queue_items
+WHERE shard = $1 AND backlog_id = $2 AND score_ms >= sqlc.arg(from_ms) AND score_ms <= sqlc.arg(until_ms)
+ORDER BY score_ms, id
+LIMIT sqlc.arg(lim);
+
+-- name: CountBacklogQueueItems :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND backlog_id = $2 AND score_ms >= sqlc.arg(from_ms) AND score_ms <= sqlc.arg(until_ms);
+
+-- name: EarliestBacklogQueueItemScore :one
+SELECT score_ms FROM queue_items
+WHERE shard = $1 AND backlog_id = $2
+ORDER BY score_ms
+LIMIT 1;
+
+-- name: ScavengeQueueItems :many
+SELECT item FROM queue_items
+WHERE shard = $1 AND lease_id IS NOT NULL AND lease_until_ms < sqlc.arg(now_ms)::bigint
+ORDER BY lease_until_ms
+LIMIT sqlc.arg(lim)
+FOR UPDATE SKIP LOCKED;
+
+-- name: CountAccountInProgress :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND account_id = $2 AND function_id <> '' AND lease_id IS NOT NULL AND lease_until_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: CountFunctionInProgress :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND function_id = $2 AND lease_id IS NOT NULL AND lease_until_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: CountPartitionInProgress :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND lease_id IS NOT NULL AND lease_until_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: CountCustomKeyInProgress :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND (concurrency_key_1 = sqlc.arg(key)::text OR concurrency_key_2 = sqlc.arg(key)::text) AND lease_id IS NOT NULL AND lease_until_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: CountFutureQueueItems :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND lease_id IS NULL AND backlog_id IS NULL AND score_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: CountFunctionQueueItemsByKind :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND function_id = $2 AND kind = ANY(string_to_array(sqlc.arg(kinds)::text, ','));
+
+-- name: CountRunQueueItems :one
+SELECT COUNT(*) FROM queue_items WHERE shard = $1 AND run_id = $2;
+
+-- name: GetRunQueueItems :many
+SELECT item FROM queue_items
+WHERE shard = $1 AND run_id = $2
+ORDER BY score_ms, id;
+
+-- name: GetRunQueueItemsPage :many
+SELECT item FROM queue_items
+WHERE shard = $1 AND run_id = $2
+ORDER BY score_ms, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: QueueItemPosition :one
+SELECT COUNT(*) FROM queue_items
+WHERE shard = $1 AND partition_id = $2 AND lease_id IS NULL AND backlog_id IS NULL AND (score_ms, id) < (sqlc.arg(score_ms)::bigint, sqlc.arg(id)::text);
+
+-- name: CountQueueItems :one
+SELECT COUNT(*) FROM queue_items WHERE shard = $1;
+
+-- name: FunctionQueueStats :many
+SELECT
+    function_id,
+    COUNT(*) FILTER (WHERE lease_id IS NULL AND score_ms <= sqlc.arg(now_ms)::bigint) AS ready,
+    COUNT(*) FILTER (WHERE lease_id IS NULL AND score_ms > sqlc.arg(now_ms)::bigint) AS scheduled,
+    COUNT(*) FILTER (WHERE lease_id IS NOT NULL AND lease_until_ms > sqlc.arg(now_ms)::bigint) AS leased,
+    COALESCE(MIN(score_ms) FILTER (WHERE lease_id IS NULL AND score_ms <= sqlc.arg(now_ms)::bigint), 0)::bigint AS oldest_due_ms
+FROM queue_items
+WHERE shard = $1 AND function_id <> ''
+GROUP BY function_id;
+
+--
+-- Queue partitions
+--
+
+-- name: InsertQueuePartition :execrows
+INSERT INTO queue_partitions (
+    shard, id, account_id, at_s, partition
+) VALUES (
+    $1, $2, $3, $4, $5
+) ON CONFLICT (shard, id) DO NOTHING;
+
+-- name: GetQueuePartition :one
+SELECT * FROM queue_partitions WHERE shard = $1 AND id = $2;
+
+-- name: GetQueuePartitionForUpdate :one
+SELECT * FROM queue_partitions WHERE shard = $1 AND id = $2 FOR UPDATE;
+
+-- name: GetQueuePartitionForUpdateSkipLocked :one
+SELECT * FROM queue_partitions WHERE shard = $1 AND id = $2 FOR UPDATE SKIP LOCKED;
+
+-- name: QueuePartitionExists :one
+SELECT EXISTS(SELECT 1 FROM queue_partitions WHERE shard = $1 AND id = $2);
+
+-- name: UpdateQueuePartition :exec
+UPDATE queue_partitions SET
+    account_id = $3,
+    at_s = $4,
+    partition = $5
+WHERE shard = $1 AND id = $2;
+
+-- name: DeleteQueuePartition :exec
+DELETE FROM queue_partitions WHERE shard = $1 AND id = $2;
+
+-- name: PeekQueuePartitions :many
+SELECT partition FROM queue_partitions
+WHERE shard = $1 AND at_s <= sqlc.arg(until_s)
+ORDER BY at_s, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableQueuePartitions :one
+SELECT COUNT(*) FROM queue_partitions WHERE shard = $1 AND at_s <= sqlc.arg(until_s);
+
+-- name: PeekAccountQueuePartitions :many
+SELECT partition FROM queue_partitions
+WHERE shard = $1 AND account_id = $2 AND at_s <= sqlc.arg(until_s)
+ORDER BY at_s, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableAccountQueuePartitions :one
+SELECT COUNT(*) FROM queue_partitions WHERE shard = $1 AND account_id = $2 AND at_s <= sqlc.arg(until_s);
+
+-- name: PeekQueueAccounts :many
+SELECT account_id FROM queue_partitions
+WHERE shard = $1 AND account_id <> ''
+GROUP BY account_id
+HAVING MIN(at_s) <= sqlc.arg(until_s)::bigint
+ORDER BY MIN(at_s), account_id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableQueueAccounts :one
+SELECT COUNT(*) FROM (
+    SELECT account_id FROM queue_partitions
+    WHERE shard = $1 AND account_id <> ''
+    GROUP BY account_id
+    HAVING MIN(at_s) <= sqlc.arg(until_s)::bigint
+) AS accounts;
+
+-- name: CountQueuePartitions :one
+SELECT COUNT(*) FROM queue_partitions WHERE shard = $1;
+
+--
+-- Queue shadow partitions
+--
+
+-- name: InsertQueueShadowPartition :execrows
+INSERT INTO queue_shadow_partitions (
+    shard, id, account_id, at_ms, shadow_partition
+) VALUES (
+    $1, $2, $3, $4, $5
+) ON CONFLICT (shard, id) DO NOTHING;
+
+-- name: GetQueueShadowPartitionForUpdate :one
+SELECT * FROM queue_shadow_partitions WHERE shard = $1 AND id = $2 FOR UPDATE;
+
+-- name: QueueShadowPartitionExists :one
+SELECT EXISTS(SELECT 1 FROM queue_shadow_partitions WHERE shard = $1 AND id = $2);
+
+-- name: UpdateQueueShadowPartition :exec
+UPDATE queue_shadow_partitions SET
+    account_id = $3,
+    at_ms = $4,
+    shadow_partition = $5
+WHERE shard = $1 AND id = $2;
+
+-- name: DeleteQueueShadowPartition :exec
+DELETE FROM queue_shadow_partitions WHERE shard = $1 AND id = $2;
+
+-- name: PeekQueueShadowPartitions :many
+SELECT shadow_partition FROM queue_shadow_partitions
+WHERE shard = $1 AND at_ms <= sqlc.arg(until_ms)
+ORDER BY at_ms, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableQueueShadowPartitions :one
+SELECT COUNT(*) FROM queue_shadow_partitions WHERE shard = $1 AND at_ms <= sqlc.arg(until_ms);
+
+-- name: PeekAccountQueueShadowPartitions :many
+SELECT shadow_partition FROM queue_shadow_partitions
+WHERE shard = $1 AND account_id = $2 AND at_ms <= sqlc.arg(until_ms)
+ORDER BY at_ms, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableAccountQueueShadowPartitions :one
+SELECT COUNT(*) FROM queue_shadow_partitions WHERE shard = $1 AND account_id = $2 AND at_ms <= sqlc.arg(until_ms);
+
+-- name: PeekQueueShadowPartitionAccounts :many
+SELECT account_id FROM queue_shadow_partitions
+WHERE shard = $1 AND account_id <> ''
+GROUP BY account_id
+HAVING MIN(at_ms) <= sqlc.arg(until_ms)::bigint
+ORDER BY MIN(at_ms), account_id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableQueueShadowPartitionAccounts :one
+SELECT COUNT(*) FROM (
+    SELECT account_id FROM queue_shadow_partitions
+    WHERE shard = $1 AND account_id <> ''
+    GROUP BY account_id
+    HAVING MIN(at_ms) <= sqlc.arg(until_ms)::bigint
+) AS accounts;
+
+--
+-- Queue backlogs
+--
+
+-- name: InsertQueueBacklog :execrows
+INSERT INTO queue_backlogs (
+    shard, id, shadow_partition_id, account_id, at_ms, backlog
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (shard, id) DO NOTHING;
+
+-- name: GetQueueBacklogForUpdate :one
+SELECT * FROM queue_backlogs WHERE shard = $1 AND id = $2 FOR UPDATE;
+
+-- name: UpdateQueueBacklog :exec
+UPDATE queue_backlogs SET
+    at_ms = $3,
+    normalize_at_ms = $4
+WHERE shard = $1 AND id = $2;
+
+-- name: DeleteQueueBacklog :exec
+DELETE FROM queue_backlogs WHERE shard = $1 AND id = $2;
+
+-- name: EarliestQueueBacklogScore :one
+SELECT at_ms FROM queue_backlogs
+WHERE shard = $1 AND shadow_partition_id = $2 AND normalize_at_ms IS NULL
+ORDER BY at_ms
+LIMIT 1;
+
+-- name: EarliestNormalizeQueueBacklogScore :one
+SELECT normalize_at_ms::bigint FROM queue_backlogs
+WHERE shard = $1 AND shadow_partition_id = $2 AND normalize_at_ms IS NOT NULL
+ORDER BY normalize_at_ms
+LIMIT 1;
+
+-- name: PeekQueueBacklogs :many
+SELECT backlog FROM queue_backlogs
+WHERE shard = $1 AND shadow_partition_id = $2 AND normalize_at_ms IS NULL AND at_ms <= sqlc.arg(until_ms)
+ORDER BY at_ms, id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountPeekableQueueBacklogs :one
+SELECT COUNT(*) FROM queue_backlogs
+WHERE shard = $1 AND shadow_partition_id = $2 AND normalize_at_ms IS NULL AND at_ms <= sqlc.arg(until_ms);
+
+-- name: PeekNormalizeQueueBacklogs :many
+SELECT backlog FROM queue_backlogs
+WHERE shard = $1 AND shadow_partition_id = $2 AND normalize_at_ms IS NOT NULL
+ORDER BY normalize_at_ms, id
+LIMIT sqlc.arg(lim);
+
+-- name: PeekQueueNormalizeAccounts :many
+SELECT account_id FROM queue_backlogs
+WHERE shard = $1 AND account_id <> '' AND normalize_at_ms IS NOT NULL
+GROUP BY account_id
+HAVING MIN(normalize_at_ms) <= sqlc.arg(until_ms)::bigint
+ORDER BY MIN(normalize_at_ms), account_id
+LIMIT sqlc.arg(lim);
+
+--
+-- Queue key/values
+--
+
+-- name: GetQueueKV :one
+SELECT * FROM queue_kv
+WHERE shard = $1 AND key = $2 AND (expires_at_ms IS NULL OR expires_at_ms > sqlc.arg(now_ms)::bigint);
+
+-- name: GetQueueKVForUpdate :one
+SELECT * FROM queue_kv
+WHERE shard = $1 AND key = $2 AND (expires_at_ms IS NULL OR expires_at_ms > sqlc.arg(now_ms)::bigint)
+FOR UPDATE;
+
+-- name: SetQueueKV :exec
+INSERT INTO queue_kv (shard, key, value, expires_at_ms)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (shard, key) DO UPDATE SET
+    value = EXCLUDED.value,
+    expires_at_ms = EXCLUDED.expires_at_ms;
+
+-- name: SetQueueKVIfAbsent :execrows
+INSERT INTO queue_kv (shard, key, value, expires_at_ms)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (shard, key) DO UPDATE SET
+    value = EXCLUDED.value,
+    expires_at_ms = EXCLUDED.expires_at_ms
+WHERE queue_kv.expires_at_ms IS NOT NULL AND queue_kv.expires_at_ms <= sqlc.arg(now_ms)::bigint;
+
+-- name: DeleteQueueKV :execrows
+DELETE FROM queue_kv WHERE shard = $1 AND key = $2;
+
+-- name: ListQueueKVByPrefix :many
+SELECT * FROM queue_kv
+WHERE shard = $1 AND starts_with(key, sqlc.arg(prefix)::text) AND (expires_at_ms IS NULL OR expires_at_ms > sqlc.arg(now_ms)::bigint)
+ORDER BY key;
+
+-- name: DeleteExpiredQueueKV :execrows
+DELETE FROM queue_kv WHERE shard = $1 AND expires_at_ms IS NOT NULL AND expires_at_ms <= sqlc.arg(now_ms)::bigint;
+
+--
+-- Run state
+--
+
+-- name: InsertRunState :execrows
+INSERT INTO run_state (
+    run_id, account_id, function_id, status, metadata, events, state_size, event_size, step_count
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) ON CONFLICT (run_id) DO NOTHING;
+
+-- name: UpsertRunState :exec
+INSERT INTO run_state (
+    run_id, account_id, function_id, status, metadata, events, state_size, event_size, step_count, metadata_size, defer_input_size
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) ON CONFLICT (run_id) DO UPDATE SET
+    account_id = EXCLUDED.account_id,
+    function_id = EXCLUDED.function_id,
+    status = EXCLUDED.status,
+    metadata = EXCLUDED.metadata,
+    events = EXCLUDED.events,
+    state_size = EXCLUDED.state_size,
+    event_size = EXCLUDED.event_size,
+    step_count = EXCLUDED.step_count,
+    metadata_size = EXCLUDED.metadata_size,
+    defer_input_size = EXCLUDED.defer_input_size;
+
+-- name: GetRunState :one
+SELECT run_id, account_id, function_id, status, metadata, state_size, event_size, step_count, metadata_size, defer_input_size
+FROM run_state WHERE run_id = $1;
+
+-- name: GetRunStateForUpdate :one
+SELECT run_id, account_id, function_id, status, metadata, state_size, event_size, step_count, metadata_size, defer_input_size
+FROM run_state WHERE run_id = $1 FOR UPDATE;
+
+-- name: GetRunStateEvents :one
+SELECT events FROM run_state WHERE run_id = $1;
+
+-- name: RunStateExists :one
+SELECT EXISTS(SELECT 1 FROM run_state WHERE run_id = $1);
+
+-- name: UpdateRunStateMetadata :exec
+UPDATE run_state SET metadata = $2 WHERE run_id = $1;
+
+-- name: UpdateRunStateStatus :execrows
+UPDATE run_state SET status = $2 WHERE run_id = $1;
+
+-- name: IncrementRunStateSizes :exec
+UPDATE run_state SET
+    state_size = state_size + sqlc.arg(state_size_delta)::bigint,
+    step_count = step_count + sqlc.arg(step_count_delta)::bigint,
+    metadata_size = metadata_size + sqlc.arg(metadata_size_delta)::bigint
+WHERE run_id = $1;
+
+-- name: IncrementRunStateDeferInputSize :exec
+UPDATE run_state SET defer_input_size = defer_input_size + sqlc.arg(delta)::bigint WHERE run_id = $1;
+
+-- name: DeleteRunState :exec
+DELETE FROM run_state WHERE run_id = $1;
+
+--
+-- Run state steps
+--
+
+-- name: GetRunStateSteps :many
+SELECT step_id, output, input FROM run_state_steps WHERE run_id = $1;
+
+-- name: GetRunStateStepOutputs :many
+SELECT step_id, output FROM run_state_steps
+WHERE run_id = $1 AND step_id = ANY(sqlc.arg(step_ids)::text[]) AND output IS NOT NULL;
+
+-- name: GetRunStateStepForUpdate :one
+SELECT * FROM run_state_steps WHERE run_id = $1 AND step_id = $2 FOR UPDATE;
+
+-- name: GetRunStateStack :many
+SELECT step_id FROM run_state_steps
+WHERE run_id = $1 AND stack_index IS NOT NULL
+ORDER BY stack_index;
+
+-- name: NextRunStateStackIndex :one
+SELECT (COALESCE(MAX(stack_index), -1) + 1)::integer FROM run_state_steps WHERE run_id = $1;
+
+-- name: SetRunStateStepOutput :exec
+INSERT INTO run_state_steps (run_id, step_id, output, stack_index)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (run_id, step_id) DO UPDATE SET
+    output = EXCLUDED.output,
+    stack_index = EXCLUDED.stack_index;
+
+-- name: SetRunStateStepInput :exec
+INSERT INTO run_state_steps (run_id, step_id, input)
+VALUES ($1, $2, $3)
+ON CONFLICT (run_id, step_id) DO UPDATE SET input = EXCLUDED.input;
+
+-- name: ClearRunStateStack :exec
+UPDATE run_state_steps SET stack_index = NULL WHERE run_id = $1 AND stack_index IS NOT NULL;
+
+-- name: DeleteRunStateSteps :exec
+DELETE FROM run_state_steps WHERE run_id = $1;
+
+--
+-- Run state pending steps
+--
+
+-- name: GetRunStatePendingSteps :many
+SELECT step_id FROM run_state_pending_steps WHERE run_id = $1 ORDER BY step_id;
+
+-- name: CountRunStatePendingSteps :one
+SELECT COUNT(*) FROM run_state_pending_steps WHERE run_id = $1;
+
+-- name: InsertRunStatePendingStep :exec
+INSERT INTO run_state_pending_steps (run_id, step_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+
+-- name: DeleteRunStatePendingStep :exec
+DELETE FROM run_state_pending_steps WHERE run_id = $1 AND step_id = $2;
+
+-- name: DeleteRunStatePendingSteps :exec
+DELETE FROM run_state_pending_steps WHERE run_id = $1;
+
+--
+-- Run state defers
+--
+
+-- name: GetRunStateDefers :many
+SELECT * FROM run_state_defers WHERE run_id = $1 ORDER BY hashed_id LIMIT sqlc.arg(lim);
+
+-- name: GetRunStateDeferForUpdate :one
+SELECT * FROM run_state_defers WHERE run_id = $1 AND hashed_id = $2 FOR UPDATE;
+
+-- name: CountRunStateDefers :one
+SELECT COUNT(*) FROM run_state_defers WHERE run_id = $1;
+
+-- name: InsertRunStateDefer :execrows
+INSERT INTO run_state_defers (run_id, hashed_id, fn_slug, schedule_status, input, meta)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (run_id, hashed_id) DO NOTHING;
+
+-- name: UpsertRunStateDefer :exec
+INSERT INTO run_state_defers (run_id, hashed_id, fn_slug, schedule_status, input, meta)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (run_id, hashed_id) DO UPDATE SET
+    fn_slug = EXCLUDED.fn_slug,
+    schedule_status = EXCLUDED.schedule_status,
+    input = EXCLUDED.input,
+    meta = EXCLUDED.meta;
+
+-- name: UpdateRunStateDefer :exec
+UPDATE run_state_defers SET schedule_status = $3, input = $4 WHERE run_id = $1 AND hashed_id = $2;
+
+-- name: DeleteRunStateDefers :exec
+DELETE FROM run_state_defers WHERE run_id = $1;
+
+--
+-- Run state key/values
+--
+
+-- name: GetRunStateKV :one
+SELECT value FROM run_state_kv
+WHERE account_id = $1 AND key = $2 AND expires_at_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: SetRunStateKVIfAbsent :execrows
+INSERT INTO run_state_kv (account_id, key, value, expires_at_ms)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (account_id, key) DO UPDATE SET
+    value = EXCLUDED.value,
+    expires_at_ms = EXCLUDED.expires_at_ms
+WHERE run_state_kv.expires_at_ms <= sqlc.arg(now_ms)::bigint;
+
+-- name: ReplaceRunStateKV :exec
+UPDATE run_state_kv SET value = $3
+WHERE account_id = $1 AND key = $2 AND expires_at_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: DeleteRunStateKVIfValue :execrows
+DELETE FROM run_state_kv WHERE account_id = $1 AND key = $2 AND value = $3;
+
+-- name: DeleteExpiredRunStateKV :execrows
+DELETE FROM run_state_kv WHERE account_id = $1 AND expires_at_ms <= sqlc.arg(now_ms)::bigint;
+
+--
+-- Run state pauses
+--
+
+-- name: InsertRunStatePause :execrows
+INSERT INTO run_state_pauses (pause_id, workspace_id, run_id, event_name, data, created_at_s, expires_at_ms)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (pause_id) DO UPDATE SET
+    workspace_id = EXCLUDED.workspace_id,
+    run_id = EXCLUDED.run_id,
+    event_name = EXCLUDED.event_name,
+    data = EXCLUDED.data,
+    created_at_s = EXCLUDED.created_at_s,
+    expires_at_ms = EXCLUDED.expires_at_ms
+WHERE run_state_pauses.expires_at_ms <= sqlc.arg(now_ms)::bigint;
+
+-- name: GetRunStatePause :one
+SELECT data FROM run_state_pauses
+WHERE pause_id = $1 AND expires_at_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: GetRunStatePauseCreatedAt :one
+SELECT created_at_s FROM run_state_pauses
+WHERE workspace_id = $1 AND event_name = $2 AND pause_id = $3 AND expires_at_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: CountRunStatePauses :one
+SELECT COUNT(*) FROM run_state_pauses
+WHERE workspace_id = $1 AND event_name = $2 AND expires_at_ms > sqlc.arg(now_ms)::bigint;
+
+-- name: ListRunStatePauses :many
+SELECT pause_id, data, created_at_s FROM run_state_pauses
+WHERE workspace_id = $1 AND event_name = $2 AND expires_at_ms > sqlc.arg(now_ms)::bigint
+  AND (created_at_s, pause_id) > (sqlc.arg(after_created_at_s)::bigint, sqlc.arg(after_pause_id)::varchar)
+ORDER BY created_at_s, pause_id
+LIMIT sqlc.arg(max_rows);
+
+-- name: GetRunStatePauseIDs :many
+SELECT pause_id FROM run_state_pauses WHERE run_id = $1 ORDER BY pause_id;
+
+-- name: DeleteRunStatePause :execrows
+DELETE FROM run_state_pauses WHERE pause_id = $1;
+
+-- name: DeleteExpiredRunStatePauses :execrows
+DELETE FROM run_state_pauses WHERE workspace_id = $1 AND expires_at_ms <= sqlc.arg(now_ms)::bigint;
+
+-- name: GetRunStatePauseLookup :one
+SELECT pause_id FROM run_state_pause_lookups
+WHERE workspace_id = $1 AND kind = $2 AND lookup_key = $3;
+
+-- name: SetRunStatePauseLookupIfAbsent :execrows
+INSERT INTO run_state_pause_lookups (workspace_id, kind, lookup_key, pause_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, kind, lookup_key) DO NOTHING;
+
+-- name: SetRunStatePauseLookup :exec
+INSERT INTO run_state_pause_lookups (workspace_id, kind, lookup_key, pause_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, kind, lookup_key) DO UPDATE SET pause_id = EXCLUDED.pause_id;
+
+-- name: DeleteRunStatePauseLookup :exec
+DELETE FROM run_state_pause_lookups
+WHERE workspace_id = $1 AND kind = $2 AND lookup_key = $3 AND pause_id = $4;
+
+-- name: DeleteOrphanedRunStatePauseLookups :execrows
+DELETE FROM run_state_pause_lookups
+WHERE run_state_pause_lookups.workspace_id = $1
+  AND NOT EXISTS (
+    SELECT 1 FROM run_state_pauses WHERE run_state_pauses.pause_id = run_state_pause_lookups.pause_id
+  );
@@ -0,0 +1,984 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+// A number to version backend logic in order to prevent non-backward compatible
+// changes to break
+const currentVersion = 1
+
+// Opt represents an option to use when creating a postgres-backed state store.
+type Opt func(m *mgr)
+
+// WithPauseDeleter adds a pause deletion handler that deletes pauses when runs are deleted.
+func WithPauseDeleter(d state.PauseDeleter) Opt {
+	return func(m *mgr) {
+		m.pauseDeleter = d
+	}
+}
+
+// New returns a state manager which uses Postgres as the backing state store.
+// The database must have all migrations applied.
+func New(ctx context.Context, db *sql.DB, opts ...Opt) (state.Manager, error) {
+	m := &mgr{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m, nil
+}
+
+type mgr struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+
+	pauseDeleter state.PauseDeleter
+}
+
+func (m *mgr) tx(ctx context.Context, f func(qs *sqlc.Queries) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	if err := f(m.queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// runMetadata is stored for each invocation of a function.  Status and the
+// size counters are stored in their own columns, so that they can be updated
+// atomically, and are not part of the encoded metadata.
+type runMetadata struct {
+	Identifier                state.Identifier `json:"id"`
+	Debugger                  bool             `json:"debugger"`
+	RunType                   string           `json:"runType,omitempty"`
+	Version                   int              `json:"version"`
+	RequestVersion            int              `json:"rv"`
+	Context                   map[string]any   `json:"ctx,omitempty"`
+	DisableImmediateExecution bool             `json:"die,omitempty"`
+	SpanID                    string           `json:"sid"`
+	StartedAt                 int64            `json:"sat,omitempty"`
+	HasAI                     bool             `json:"hasAI,omitempty"`
+}
+
+// runRow is a loaded run_state row, excluding events.
+type runRow struct {
+	md             runMetadata
+	status         enums.RunStatus
+	stateSize      int
+	eventSize      int
+	stepCount      int
+	metadataSize   int
+	deferInputSize int
+}
+
+func newRunRow(row *sqlc.GetRunStateRow) (*runRow, error) {
+	r := &runRow{
+		status:         enums.RunStatus(row.Status),
+		stateSize:      int(row.StateSize),
+		eventSize:      int(row.EventSize),
+		stepCount:      int(row.StepCount),
+		metadataSize:   int(row.MetadataSize),
+		deferInputSize: int(row.DeferInputSize),
+	}
+	if err := json.Unmarshal(row.Metadata, &r.md); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal run metadata: %w", err)
+	}
+	return r, nil
+}
+
+func (r runRow) Metadata() state.Metadata {
+	m := state.Metadata{
+		Identifier:                r.md.Identifier,
+		Debugger:                  r.md.Debugger,
+		Status:                    r.status,
+		Version:                   r.md.Version,
+		RequestVersion:            r.md.RequestVersion,
+		Context:                   r.md.Context,
+		DisableImmediateExecution: r.md.DisableImmediateExecution,
+		SpanID:                    r.md.SpanID,
+		HasAI:                     r.md.HasAI,
+	}
+	// 0 != time.IsZero
+	// only convert to time if runMetadata's StartedAt is > 0
+	if r.md.StartedAt > 0 {
+		m.StartedAt = time.UnixMilli(r.md.StartedAt)
+	}
+
+	if r.md.RunType != "" {
+		m.RunType = &r.md.RunType
+	}
+	return m
+}
+
+func (m *mgr) run(ctx context.Context, qs *sqlc.Queries, runID ulid.ULID) (*runRow, error) {
+	row, err := qs.GetRunState(ctx, runID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, state.ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRunRow(row)
+}
+
+// runForUpdate loads and locks a run's row until the transaction ends.
+func (m *mgr) runForUpdate(ctx context.Context, qs *sqlc.Queries, runID ulid.ULID) (*runRow, error) {
+	row, err := qs.GetRunStateForUpdate(ctx, runID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, state.ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRunRow((*sqlc.GetRunStateRow)(row))
+}
+
+// idempotencyKey returns the run_state_kv key for the run's idempotency key.
+func idempotencyKey(id state.Identifier) string {
+	return "key:" + id.IdempotencyKey()
+}
+
+func (m *mgr) New(ctx context.Context, input state.Input) (state.State, error) {
+	// Firstly, check idempotency here.
+	{
+		runID, err := m.idempotencyCheck(ctx, input.Identifier)
+		switch err {
+		case nil: // no-op
+		case state.ErrIdentifierTombstone:
+			if runID != nil {
+				input.Identifier.RunID = *runID
+			}
+			return state.NewStateInstance(
+				input.Identifier,
+				state.Metadata{Identifier: input.Identifier},
+				nil, nil, nil,
+			), state.ErrIdentifierTombstone
+		default:
+			return nil, err
+		}
+
+		// If a state already exists with the idempotency key, override the input's runID and continue
+		if runID != nil && input.Identifier.RunID != *runID {
+			input.Identifier.RunID = *runID
+		}
+	}
+
+	events, err := json.Marshal(input.EventBatchData)
+	if err != nil {
+		return nil, err
+	}
+
+	var stepsByt []byte
+	if len(input.Steps) > 0 {
+		stepsByt, err = json.Marshal(input.Steps)
+		if err != nil {
+			return nil, fmt.Errorf("error storing run state when marshalling steps: %w", err)
+		}
+	}
+
+	var stepInputsByt []byte
+	if len(input.StepInputs) > 0 {
+		stepInputsByt, err = json.Marshal(input.StepInputs)
+		if err != nil {
+			return nil, fmt.Errorf("error storing run state when marshalling step inputs: %w", err)
+		}
+	}
+
+	rv := consts.RequestVersionUnknown
+	if input.RequestVersion != nil {
+		rv = *input.RequestVersion
+	}
+
+	row := runRow{
+		md: runMetadata{
+			Identifier:     input.Identifier,
+			Debugger:       input.Debugger,
+			Version:        currentVersion,
+			RequestVersion: rv,
+			Context:        input.Context,
+			SpanID:         input.SpanID,
+		},
+		status:    enums.RunStatusScheduled,
+		eventSize: len(events),
+		stateSize: len(events) + len(stepsByt) + len(stepInputsByt),
+		stepCount: len(input.Steps),
+	}
+	if input.RunType != nil {
+		row.md.RunType = *input.RunType
+	}
+
+	metadataByt, err := json.Marshal(row.md)
+	if err != nil {
+		return nil, fmt.Errorf("error storing run state: %w", err)
+	}
+
+	exists := false
+	err = m.tx(ctx, func(qs *sqlc.Queries) error {
+		n, err := qs.InsertRunState(ctx, sqlc.InsertRunStateParams{
+			RunID:      input.Identifier.RunID.String(),
+			AccountID:  input.Identifier.AccountID.String(),
+			FunctionID: input.Identifier.WorkflowID.String(),
+			Status:     int32(row.status),
+			Metadata:   metadataByt,
+			Events:     events,
+			StateSize:  int64(row.stateSize),
+			EventSize:  int64(row.eventSize),
+			StepCount:  int64(row.stepCount),
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			// state is already created
+			exists = true
+			return nil
+		}
+
+		// Save pre-memoized steps
+		for n, step := range input.Steps {
+			byt, err := json.Marshal(step.Data)
+			if err != nil {
+				return fmt.Errorf("error marshalling step %q: %w", step.ID, err)
+			}
+			if err := qs.SetRunStateStepOutput(ctx, sqlc.SetRunStateStepOutputParams{
+				RunID:      input.Identifier.RunID.String(),
+				StepID:     step.ID,
+				Output:     byt,
+				StackIndex: sql.NullInt32{Int32: int32(n), Valid: true},
+			}); err != nil {
+				return err
+			}
+		}
+
+		// Save pre-memoized step inputs
+		for _, step := range input.StepInputs {
+			byt, err := json.Marshal(step.Data)
+			if err != nil {
+				return fmt.Errorf("error marshalling step input %q: %w", step.ID, err)
+			}
+			if err := qs.SetRunStateStepInput(ctx, sqlc.SetRunStateStepInputParams{
+				RunID:  input.Identifier.RunID.String(),
+				StepID: step.ID,
+				Input:  byt,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error storing run state: %w", err)
+	}
+
+	if exists {
+		// XXX: Returns a shell of a state with mutated identifier to the existing runID
+		// It does not load the existing run state anymore.
+		return state.NewStateInstance(
+			input.Identifier,
+			row.Metadata(),
+			make([]map[string]any, 0),
+			make([]state.MemoizedStep, 0),
+			make([]string, 0),
+		), state.ErrIdentifierExists
+	}
+
+	return state.NewStateInstance(
+		input.Identifier,
+		row.Metadata(),
+		input.EventBatchData,
+		input.Steps,
+		make([]string, 0),
+	), nil
+}
+
+// idempotencyCheck checks if the function state already exists, and return the runID of the existing state
+// if it does
+func (m *mgr) idempotencyCheck(ctx context.Context, id state.Identifier) (*ulid.ULID, error) {
+	now := time.Now()
+	key := idempotencyKey(id)
+
+	n, err := m.queries.SetRunStateKVIfAbsent(ctx, sqlc.SetRunStateKVIfAbsentParams{
+		AccountID:   id.AccountID.String(),
+		Key:         key,
+		Value:       id.RunID.String(),
+		ExpiresAtMs: now.Add(consts.FunctionIdempotencyPeriod).UnixMilli(),
+		NowMs:       now.UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return nil, nil // no previous state exists, entirely new
+	}
+
+	prev, err := m.queries.GetRunStateKV(ctx, sqlc.GetRunStateKVParams{
+		AccountID: id.AccountID.String(),
+		Key:       key,
+		NowMs:     now.UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// When a run finishes, we prefix the run ID with the tombstone marker.
+	// This is needed for scheduling idempotency:  if scheduling retries the new state op
+	// and elsewhere we've updated with the tombstone prefix, scheduling can stop.
+	if len(prev) > 0 && prev[0] == consts.FunctionIdempotencyTombstone {
+		runID, err := ulid.Parse(prev[1:])
+		if err != nil {
+			return nil, state.ErrIdentifierTombstone
+		}
+		return &runID, state.ErrIdentifierTombstone
+	}
+
+	runID, err := ulid.Parse(prev)
+	if err != nil {
+		// there already is a value but is not a valid ULID
+		return nil, state.ErrInvalidIdentifier
+	}
+	return &runID, nil
+}
+
+func (m *mgr) UpdateMetadata(ctx context.Context, accountID uuid.UUID, runID ulid.ULID, md state.MetadataUpdate) error {
+	return m.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := m.runForUpdate(ctx, qs, runID)
+		if err != nil {
+			return err
+		}
+
+		row.md.DisableImmediateExecution = md.DisableImmediateExecution
+		row.md.RequestVersion = md.RequestVersion
+		if row.md.StartedAt == 0 && !md.StartedAt.IsZero() {
+			row.md.StartedAt = md.StartedAt.UnixMilli()
+		}
+		if md.HasAI {
+			row.md.HasAI = true
+		}
+
+		byt, err := json.Marshal(row.md)
+		if err != nil {
+			return fmt.Errorf("error marshalling metadata: %w", err)
+		}
+		return qs.UpdateRunStateMetadata(ctx, sqlc.UpdateRunStateMetadataParams{
+			RunID:    runID.String(),
+			Metadata: byt,
+		})
+	})
+}
+
+func (m *mgr) Exists(ctx context.Context, accountId uuid.UUID, runID ulid.ULID) (bool, error) {
+	return m.queries.RunStateExists(ctx, runID.String())
+}
+
+func (m *mgr) IncrementMetadataSize(ctx context.Context, accountID uuid.UUID, runID ulid.ULID, delta int) error {
+	return m.queries.IncrementRunStateSizes(ctx, sqlc.IncrementRunStateSizesParams{
+		RunID:             runID.String(),
+		MetadataSizeDelta: int64(delta),
+	})
+}
+
+func (m *mgr) SetStatus(ctx context.Context, id state.Identifier, status enums.RunStatus) error {
+	_, err := m.queries.UpdateRunStateStatus(ctx, sqlc.UpdateRunStateStatusParams{
+		RunID:  id.RunID.String(),
+		Status: int32(status),
+	})
+	if err != nil {
+		return fmt.Errorf("error setting status: %w", err)
+	}
+	return nil
+}
+
+func (m *mgr) Metadata(ctx context.Context, accountId uuid.UUID, runID ulid.ULID) (*state.Metadata, error) {
+	row, err := m.run(ctx, m.queries, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata: %w", err)
+	}
+	meta := row.Metadata()
+	return &meta, nil
+}
+
+func (m *mgr) Load(ctx context.Context, accountId uuid.UUID, runID ulid.ULID) (state.State, error) {
+	row, err := m.run(ctx, m.queries, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata; %w", err)
+	}
+
+	id := row.md.Identifier
+
+	byt, err := m.queries.GetRunStateEvents(ctx, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch; %w", err)
+	}
+	events := []map[string]any{}
+	if err := json.Unmarshal(byt, &events); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch; %w", err)
+	}
+
+	steps, err := m.queries.GetRunStateSteps(ctx, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed loading actions; %w", err)
+	}
+
+	actions := []state.MemoizedStep{}
+	for _, step := range steps {
+		if step.Output == nil {
+			wrappedData, err := json.Marshal(map[string]json.RawMessage{
+				"input": json.RawMessage(step.Input),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to wrap action input for \"%s\"; %w", step.StepID, err)
+			}
+			actions = append(actions, state.MemoizedStep{
+				ID:   step.StepID,
+				Data: wrappedData,
+			})
+			continue
+		}
+
+		var data any
+		if err := json.Unmarshal(step.Output, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal step \"%s\" with data \"%s\"; %w", step.StepID, step.Output, err)
+		}
+		actions = append(actions, state.MemoizedStep{
+			ID:   step.StepID,
+			Data: data,
+		})
+	}
+
+	stack, err := m.stack(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching stack: %w", err)
+	}
+
+	return state.NewStateInstance(id, row.Metadata(), events, actions, stack), nil
+}
+
+func (m *mgr) LoadEvents(ctx context.Context, accountId uuid.UUID, fnID uuid.UUID, runID ulid.ULID) ([]json.RawMessage, error) {
+	byt, err := m.queries.GetRunStateEvents(ctx, runID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, state.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event; %w", err)
+	}
+
+	var events []json.RawMessage
+	if err := json.Unmarshal(byt, &events); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch; %w", err)
+	}
+	return events, nil
+}
+
+func (m *mgr) LoadSteps(ctx context.Context, accountId uuid.UUID, fnID uuid.UUID, runID ulid.ULID) (map[string]json.RawMessage, error) {
+	rows, err := m.queries.GetRunStateSteps(ctx, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed loading actions; %w", err)
+	}
+
+	steps := map[string]json.RawMessage{}
+	for _, row := range rows {
+		if row.Output != nil {
+			steps[row.StepID] = json.RawMessage(row.Output)
+			continue
+		}
+		wrappedData, err := json.Marshal(map[string]json.RawMessage{
+			"input": json.RawMessage(row.Input),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap action input for \"%s\"; %w", row.StepID, err)
+		}
+		steps[row.StepID] = wrappedData
+	}
+	return steps, nil
+}
+
+func (m *mgr) LoadStepInputs(ctx context.Context, accountId uuid.UUID, fnID uuid.UUID, runID ulid.ULID) (map[string]json.RawMessage, error) {
+	rows, err := m.queries.GetRunStateSteps(ctx, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed loading action inputs; %w", err)
+	}
+
+	steps := map[string]json.RawMessage{}
+	for _, row := range rows {
+		if row.Input != nil {
+			steps[row.StepID] = json.RawMessage(row.Input)
+		}
+	}
+	return steps, nil
+}
+
+func (m *mgr) LoadStepsWithIDs(ctx context.Context, accountId uuid.UUID, fnID uuid.UUID, runID ulid.ULID, stepIDs []string) (map[string]json.RawMessage, error) {
+	rows, err := m.queries.GetRunStateStepOutputs(ctx, sqlc.GetRunStateStepOutputsParams{
+		RunID:   runID.String(),
+		StepIds: stepIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed loading actions; %w", err)
+	}
+
+	steps := map[string]json.RawMessage{}
+	for _, row := range rows {
+		steps[row.StepID] = json.RawMessage(row.Output)
+	}
+	return steps, nil
+}
+
+func (m *mgr) loadPending(ctx context.Context, runID ulid.ULID) ([]string, error) {
+	pending, err := m.queries.GetRunStatePendingSteps(ctx, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("error loading pending: %w", err)
+	}
+	return pending, nil
+}
+
+func (m *mgr) stack(ctx context.Context, runID ulid.ULID) ([]string, error) {
+	stack, err := m.queries.GetRunStateStack(ctx, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("error fetching stack: %w", err)
+	}
+	if stack == nil {
+		stack = []string{}
+	}
+	return stack, nil
+}
+
+func (m *mgr) SaveResponse(ctx context.Context, i state.Identifier, stepID, marshalledOuptut string) (bool, error) {
+	metadataSizeDelta := state.MetadataSizeDeltaFromContext(ctx)
+
+	var (
+		hasPending bool
+		resultErr  error
+	)
+	err := m.tx(ctx, func(qs *sqlc.Queries) error {
+		if _, err := m.runForUpdate(ctx, qs, i.RunID); err != nil {
+			return err
+		}
+
+		step, err := qs.GetRunStateStepForUpdate(ctx, sqlc.GetRunStateStepForUpdateParams{
+			RunID:  i.RunID.String(),
+			StepID: stepID,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if step != nil && step.Output != nil {
+			if string(step.Output) != marshalledOuptut {
+				// This is a duplicate response, so we don't need to do anything.
+				resultErr = state.ErrDuplicateResponse
+				return nil
+			}
+			// This step was already saved with the current data.
+			count, err := qs.CountRunStatePendingSteps(ctx, i.RunID.String())
+			if err != nil {
+				return err
+			}
+			hasPending = count > 0
+			resultErr = state.ErrIdempotentResponse
+			return nil
+		}
+
+		// If we're saving a response for a step that previously had input, remove the
+		// input from the state size in order to keep it as accurate as possible.
+		stateSizeDelta := len(marshalledOuptut)
+		if step != nil {
+			stateSizeDelta -= len(step.Input)
+		}
+		if metadataSizeDelta < 0 {
+			metadataSizeDelta = 0
+		}
+		if err := qs.IncrementRunStateSizes(ctx, sqlc.IncrementRunStateSizesParams{
+			RunID:             i.RunID.String(),
+			StateSizeDelta:    int64(stateSizeDelta),
+			StepCountDelta:    1,
+			MetadataSizeDelta: int64(metadataSizeDelta),
+		}); err != nil {
+			return err
+		}
+
+		idx, err := qs.NextRunStateStackIndex(ctx, i.RunID.String())
+		if err != nil {
+			return err
+		}
+		if err := qs.SetRunStateStepOutput(ctx, sqlc.SetRunStateStepOutputParams{
+			RunID:      i.RunID.String(),
+			StepID:     stepID,
+			Output:     []byte(marshalledOuptut),
+			StackIndex: sql.NullInt32{Int32: idx, Valid: true},
+		}); err != nil {
+			return err
+		}
+
+		if err := qs.DeleteRunStatePendingStep(ctx, sqlc.DeleteRunStatePendingStepParams{
+			RunID:  i.RunID.String(),
+			StepID: stepID,
+		}); err != nil {
+			return err
+		}
+		count, err := qs.CountRunStatePendingSteps(ctx, i.RunID.String())
+		if err != nil {
+			return err
+		}
+		hasPending = count > 0
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("error saving response: %w", err)
+	}
+	return hasPending, resultErr
+}
+
+func (m *mgr) SavePending(ctx context.Context, i state.Identifier, pending []string) error {
+	err := m.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.DeleteRunStatePendingSteps(ctx, i.RunID.String()); err != nil {
+			return err
+		}
+		for _, stepID := range pending {
+			if err := qs.InsertRunStatePendingStep(ctx, sqlc.InsertRunStatePendingStepParams{
+				RunID:  i.RunID.String(),
+				StepID: stepID,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error saving pending: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes state from the state store, marking the run's idempotency key
+// with a tombstone so that scheduling retries can detect and stop.
+func (m *mgr) Delete(ctx context.Context, i state.Identifier, opts ...state.DeleteOption) error {
+	o := state.ApplyDeleteOpts(opts)
+	callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 20*time.Second)
+	defer cancel()
+	if err := m.delete(callCtx, i); err != nil {
+		return err
+	}
+	if o.IsMigration || m.pauseDeleter == nil {
+		return nil
+	}
+	return m.pauseDeleter.DeletePausesForRun(ctx, i.RunID, i.WorkspaceID)
+}
+
+func (m *mgr) delete(ctx context.Context, i state.Identifier) error {
+	now := time.Now().UnixMilli()
+
+	id := i
+	if i.Key == "" {
+		if md, err := m.Metadata(ctx, i.AccountID, i.RunID); err == nil {
+			id = md.Identifier
+		}
+	}
+
+	// update the idempotency key to the tombstone prefix to indicate this run is done
+	// so scheduling retries can detect and stop.
+	_ = m.queries.ReplaceRunStateKV(ctx, sqlc.ReplaceRunStateKVParams{
+		AccountID: id.AccountID.String(),
+		Key:       idempotencyKey(id),
+		Value:     string(consts.FunctionIdempotencyTombstone) + i.RunID.String(),
+		NowMs:     now,
+	})
+
+	// Clear out any expired keys for the account while we're here.
+	if _, err := m.queries.DeleteExpiredRunStateKV(ctx, sqlc.DeleteExpiredRunStateKVParams{
+		AccountID: id.AccountID.String(),
+		NowMs:     now,
+	}); err != nil {
+		logger.StdlibLogger(ctx).Warn("error deleting expired run state keys", "error", err)
+	}
+
+	// Clear all other data for a job.
+	return m.tx(ctx, func(qs *sqlc.Queries) error {
+		runID := i.RunID.String()
+		if err := qs.DeleteRunStateSteps(ctx, runID); err != nil {
+			return err
+		}
+		if err := qs.DeleteRunStatePendingSteps(ctx, runID); err != nil {
+			return err
+		}
+		if err := qs.DeleteRunStateDefers(ctx, runID); err != nil {
+			return err
+		}
+		return qs.DeleteRunState(ctx, runID)
+	})
+}
+
+// ConsumePause consumes a pause, writing the consumed pause to run state
+func (m *mgr) ConsumePause(ctx context.Context, p state.Pause, opts state.ConsumePauseOpts) (state.ConsumePauseResult, error) {
+	if p.DataKey == "" {
+		// Cancel pauses don't have a DataKey since they don't store data in
+		// run state — they only cancel the function. Skip the write.
+		return state.ConsumePauseResult{DidConsume: true}, nil
+	}
+
+	var marshalledData []byte
+	if b, ok := opts.Data.([]byte); ok && json.Valid(b) {
+		// Already marshalled data, just use it
+		marshalledData = b
+	} else {
+		var err error
+		marshalledData, err = json.Marshal(opts.Data)
+		if err != nil {
+			return state.ConsumePauseResult{}, fmt.Errorf("cannot marshal data to store in state: %w", err)
+		}
+	}
+
+	id := state.Identifier{
+		RunID:      p.Identifier.RunID,
+		WorkflowID: p.Identifier.FunctionID,
+		AccountID:  p.Identifier.AccountID,
+	}
+
+	hasPending, err := m.SaveResponse(ctx, id, p.DataKey, string(marshalledData))
+	switch {
+	case err == nil:
+		return state.ConsumePauseResult{DidConsume: true, HasPendingSteps: hasPending}, nil
+	case errors.Is(err, state.ErrIdempotentResponse):
+		// Same data already written ensures a safe retry after a transient failure,
+		// caller will likely try to resume a run again.
+		return state.ConsumePauseResult{DidConsume: true, HasPendingSteps: hasPending}, nil
+	case errors.Is(err, state.ErrDuplicateResponse):
+		// Different data already exists, this pause was consumed by a different event.
+		return state.ConsumePauseResult{}, nil
+	default:
+		return state.ConsumePauseResult{}, fmt.Errorf("error consuming pause: %w", err)
+	}
+}
+
+// LoadDefersMeta returns each defer's metadata without loading Input.
+func (m *mgr) LoadDefersMeta(ctx context.Context, runID ulid.ULID) (map[string]statev2.DeferMeta, error) {
+	rows, err := m.queries.GetRunStateDefers(ctx, sqlc.GetRunStateDefersParams{
+		RunID: runID.String(),
+		Lim:   consts.MaxDefersPerRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metas := make(map[string]statev2.DeferMeta, len(rows))
+	for _, row := range rows {
+		dm := statev2.DeferMeta{
+			FnSlug:         row.FnSlug,
+			HashedID:       row.HashedID,
+			ScheduleStatus: enums.DeferStatus(row.ScheduleStatus),
+		}
+		if len(row.Meta) > 0 {
+			dm.Meta = json.RawMessage(row.Meta)
+		}
+		metas[row.HashedID] = dm
+	}
+	return metas, nil
+}
+
+// LoadDefers loads all defers for a given run, including their metadata and
+// input data.
+func (m *mgr) LoadDefers(ctx context.Context, runID ulid.ULID) (map[string]statev2.Defer, error) {
+	rows, err := m.queries.GetRunStateDefers(ctx, sqlc.GetRunStateDefersParams{
+		RunID: runID.String(),
+		Lim:   consts.MaxDefersPerRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defers := make(map[string]statev2.Defer, len(rows))
+	for _, row := range rows {
+		d := statev2.Defer{
+			FnSlug:         row.FnSlug,
+			HashedID:       row.HashedID,
+			ScheduleStatus: enums.DeferStatus(row.ScheduleStatus),
+		}
+		if len(row.Input) > 0 {
+			d.Input = json.RawMessage(row.Input)
+		}
+		if len(row.Meta) > 0 {
+			d.Meta = json.RawMessage(row.Meta)
+		}
+		defers[row.HashedID] = d
+	}
+	return defers, nil
+}
+
+// SaveDefer inserts a defer.  Any existing entry for the hashedID is a no-op,
+// so SDK retransmits are idempotent regardless of payload.  Writes that would
+// exceed the aggregate input cap are converted into a Rejected sentinel.
+func (m *mgr) SaveDefer(ctx context.Context, runID ulid.ULID, d statev2.Defer) error {
+	var resultErr error
+	err := m.tx(ctx, func(qs *sqlc.Queries) error {
+		row, err := m.runForUpdate(ctx, qs, runID)
+		if err != nil {
+			return err
+		}
+
+		if ok, err := m.deferExists(ctx, qs, runID, d.HashedID); err != nil || ok {
+			return err
+		}
+
+		total, err := qs.CountRunStateDefers(ctx, runID.String())
+		if err != nil {
+			return err
+		}
+		if total >= consts.MaxDefersPerRun {
+			resultErr = fmt.Errorf("%w: %d", statev2.ErrDeferLimitExceeded, consts.MaxDefersPerRun)
+			return nil
+		}
+
+		if len(d.Input) > 0 && row.deferInputSize+len(d.Input) > consts.MaxDeferInputAggregateSize {
+			// Write a Rejected sentinel (no input, no aggregate increment).
+			resultErr = fmt.Errorf("%w: %d bytes", statev2.ErrDeferInputAggregateExceeded, consts.MaxDeferInputAggregateSize)
+			_, err := qs.InsertRunStateDefer(ctx, sqlc.InsertRunStateDeferParams{
+				RunID:          runID.String(),
+				HashedID:       d.HashedID,
+				FnSlug:         d.FnSlug,
+				ScheduleStatus: int32(enums.DeferStatusRejected),
+			})
+			return err
+		}
+
+		if _, err := qs.InsertRunStateDefer(ctx, sqlc.InsertRunStateDeferParams{
+			RunID:          runID.String(),
+			HashedID:       d.HashedID,
+			FnSlug:         d.FnSlug,
+			ScheduleStatus: int32(d.ScheduleStatus),
+			Input:          nilIfEmpty(d.Input),
+			Meta:           nilIfEmpty(d.Meta),
+		}); err != nil {
+			return err
+		}
+		if len(d.Input) == 0 {
+			return nil
+		}
+		return qs.IncrementRunStateDeferInputSize(ctx, sqlc.IncrementRunStateDeferInputSizeParams{
+			RunID: runID.String(),
+			Delta: int64(len(d.Input)),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error saving defer: %w", err)
+	}
+	return resultErr
+}
+
+// SetDeferStatus updates a defer's ScheduleStatus.  The Aborted transition also
+// deletes the Input and releases it from the aggregate budget.
+func (m *mgr) SetDeferStatus(ctx context.Context, runID ulid.ULID, hashedID string, status enums.DeferStatus) error {
+	err := m.tx(ctx, func(qs *sqlc.Queries) error {
+		if _, err := m.runForUpdate(ctx, qs, runID); err != nil {
+			return err
+		}
+
+		d, err := qs.GetRunStateDeferForUpdate(ctx, sqlc.GetRunStateDeferForUpdateParams{
+			RunID:    runID.String(),
+			HashedID: hashedID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("defer not found for hashedID %q", hashedID)
+		}
+		if err != nil {
+			return err
+		}
+
+		input := d.Input
+		if status == enums.DeferStatusAborted && len(input) > 0 {
+			if err := qs.IncrementRunStateDeferInputSize(ctx, sqlc.IncrementRunStateDeferInputSizeParams{
+				RunID: runID.String(),
+				Delta: -int64(len(input)),
+			}); err != nil {
+				return err
+			}
+			input = nil
+		}
+
+		return qs.UpdateRunStateDefer(ctx, sqlc.UpdateRunStateDeferParams{
+			RunID:          runID.String(),
+			HashedID:       hashedID,
+			ScheduleStatus: int32(status),
+			Input:          input,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error setting defer status: %w", err)
+	}
+	return nil
+}
+
+// SaveRejectedDefer idempotently writes a Rejected meta sentinel. No-op if
+// any defer already exists for the hashedID. Returns ErrDeferLimitExceeded
+// if the run is at MaxDefersPerRun.
+func (m *mgr) SaveRejectedDefer(ctx context.Context, runID ulid.ULID, fnSlug string, hashedID string) error {
+	var resultErr error
+	err := m.tx(ctx, func(qs *sqlc.Queries) error {
+		if _, err := m.runForUpdate(ctx, qs, runID); err != nil {
+			return err
+		}
+
+		if ok, err := m.deferExists(ctx, qs, runID, hashedID); err != nil || ok {
+			return err
+		}
+
+		total, err := qs.CountRunStateDefers(ctx, runID.String())
+		if err != nil {
+			return err
+		}
+		if total >= consts.MaxDefersPerRun {
+			resultErr = fmt.Errorf("%w: %d", statev2.ErrDeferLimitExceeded, consts.MaxDefersPerRun)
+			return nil
+		}
+
+		_, err = qs.InsertRunStateDefer(ctx, sqlc.InsertRunStateDeferParams{
+			RunID:          runID.String(),
+			HashedID:       hashedID,
+			FnSlug:         fnSlug,
+			ScheduleStatus: int32(enums.DeferStatusRejected),
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error saving rejected defer sentinel: %w", err)
+	}
+	return resultErr
+}
+
+func (m *mgr) deferExists(ctx context.Context, qs *sqlc.Queries, runID ulid.ULID, hashedID string) (bool, error) {
+	_, err := qs.GetRunStateDeferForUpdate(ctx, sqlc.GetRunStateDeferForUpdateParams{
+		RunID:    runID.String(),
+		HashedID: hashedID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func nilIfEmpty(b []byte) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,688 @@
+package postgres_state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/consts"
+	"github.com/inngest/inngest/pkg/enums"
+	statev1 "github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state/sqlc"
+	"github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/telemetry/metrics"
+	"github.com/inngest/inngest/pkg/util"
+	"github.com/oklog/ulid/v2"
+)
+
+func MustRunServiceV2(m statev1.Manager, opts ...MgrV2Opt) state.RunService {
+	o := &mgrV2Opts{}
+	for _, apply := range opts {
+		apply(o)
+	}
+
+	v2, err := runServiceV2(m, *o)
+	if err != nil {
+		panic(err)
+	}
+
+	return v2
+}
+
+func runServiceV2(m statev1.Manager, opts mgrV2Opts) (state.RunService, error) {
+	mgr, ok := m.(*mgr)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %T into type postgres_state.*mgr", m)
+	}
+
+	v2 := v2{mgr: mgr, disabledRetries: opts.disabledRetries}
+	return v2, nil
+}
+
+type (
+	MgrV2Opt  func(o *mgrV2Opts)
+	mgrV2Opts struct {
+		disabledRetries bool
+	}
+)
+
+func WithDisabledRetries() MgrV2Opt {
+	return func(o *mgrV2Opts) {
+		o.disabledRetries = true
+	}
+}
+
+type v2 struct {
+	mgr             *mgr
+	disabledRetries bool
+}
+
+// Create creates new state in the store for the given run ID.
+func (v v2) Create(ctx context.Context, s state.CreateState) (state.State, error) {
+	batchData := make([]map[string]any, len(s.Events))
+	for n, evt := range s.Events {
+		data := map[string]any{}
+		if err := json.Unmarshal(evt, &data); err != nil {
+			return state.State{}, err
+		}
+		batchData[n] = data
+	}
+	st, err := v.mgr.New(ctx, statev1.Input{
+		Identifier:     v1Identifier(s.Metadata),
+		EventBatchData: batchData,
+		Context:        s.Metadata.Config.Context,
+		SpanID:         s.Metadata.Config.SpanID,
+		Steps:          s.Steps,
+		StepInputs:     s.StepInputs,
+		RequestVersion: &s.Metadata.Config.RequestVersion,
+	})
+	switch err {
+	case nil:
+		// no-op continue
+	case statev1.ErrIdentifierExists:
+		s.Metadata.ID.RunID = st.RunID()
+		// NOTE:  Idempotency keys are written before state in a separate statement,
+		// so we want to retry this LoadState call up to 3 times, to ensure that the
+		// original thread between saving idempotency keys and saving state is set.
+		st, err := util.WithRetry(
+			ctx,
+			"load-state",
+			func(ctx context.Context) (state.State, error) {
+				return v.LoadState(ctx, s.Metadata.ID)
+			},
+			util.RetryConf{
+				MaxAttempts:    3,
+				InitialBackoff: 25 * time.Millisecond,
+				MaxBackoff:     150 * time.Millisecond,
+			},
+		)
+		if err != nil {
+			// If the run already completed and was GC'd, we still know the
+			// identifier exists.  Return ErrIdentifierExists with whatever
+			// metadata we have (the run ID was already extracted above).
+			return state.State{Metadata: s.Metadata}, statev1.ErrIdentifierExists
+		}
+		return st, statev1.ErrIdentifierExists
+	case statev1.ErrIdentifierTombstone:
+		s.Metadata.ID.RunID = st.RunID()
+		return state.State{Metadata: s.Metadata}, statev1.ErrIdentifierTombstone
+	default:
+		return state.State{}, err
+	}
+
+	// XXX: We do the exact same size calculations done in `mgr.New` to return a v2 state without changing the v1 interface.
+	var stepsByt []byte
+	if len(s.Steps) > 0 {
+		stepsByt, err = json.Marshal(s.Steps)
+		if err != nil {
+			return state.State{}, fmt.Errorf("error storing run state when marshalling steps: %w", err)
+		}
+	}
+
+	var stepInputsByt []byte
+	if len(s.StepInputs) > 0 {
+		stepInputsByt, err = json.Marshal(s.StepInputs)
+		if err != nil {
+			return state.State{}, fmt.Errorf("error storing run state when marshalling step inputs: %w", err)
+		}
+	}
+
+	events, err := json.Marshal(batchData)
+	if err != nil {
+		return state.State{}, fmt.Errorf("error storing run state when marshalling batchData: %w", err)
+	}
+
+	metadata := s.Metadata
+	metadata.ID = state.ID{
+		// Set the returned run ID from the state manager
+		RunID:      st.RunID(),
+		FunctionID: s.Metadata.ID.FunctionID,
+		Tenant: state.Tenant{
+			AppID:     s.Metadata.ID.Tenant.AppID,
+			EnvID:     s.Metadata.ID.Tenant.EnvID,
+			AccountID: s.Metadata.ID.Tenant.AccountID,
+		},
+	}
+	stateSize := len(events) + len(stepsByt) + len(stepInputsByt)
+	metadata.Metrics = state.RunMetrics{
+		EventSize: len(events),
+		StateSize: stateSize,
+		StepCount: len(s.Steps),
+	}
+
+	metrics.IncrStateWrittenCounter(ctx, stateSize, metrics.CounterOpt{
+		PkgName: pkgName,
+		Tags: map[string]any{
+			"account_id": s.Metadata.ID.Tenant.AccountID,
+		},
+	})
+	metrics.HistogramStateWrittenCounter(ctx, int64(stateSize), metrics.HistogramOpt{
+		PkgName: pkgName,
+	})
+
+	steps := make(map[string]json.RawMessage)
+	for _, step := range s.Steps {
+		if data, err := json.Marshal(step.Data); err == nil {
+			steps[step.ID] = json.RawMessage(data)
+		}
+	}
+
+	return state.State{Metadata: metadata, Events: s.Events, Steps: steps}, nil
+}
+
+// Migrate writes a run-state snapshot into the store, overwriting any
+// existing metadata, stack, pending steps and defers for the run.
+func (v v2) Migrate(ctx context.Context, s state.MigrateState) error {
+	id := s.Metadata.ID
+	cfg := s.Metadata.Config
+	runID := id.RunID.String()
+
+	eventsBlob, err := json.Marshal(s.Events)
+	if err != nil {
+		return fmt.Errorf("postgres_state: migrate marshal events: %w", err)
+	}
+
+	var startedAtMS int64
+	if !cfg.StartedAt.IsZero() {
+		startedAtMS = cfg.StartedAt.UnixMilli()
+	}
+	md := runMetadata{
+		Identifier:                v1Identifier(s.Metadata),
+		Version:                   currentVersion,
+		RequestVersion:            cfg.RequestVersion,
+		Context:                   cfg.Context,
+		DisableImmediateExecution: cfg.ForceStepPlan,
+		SpanID:                    cfg.SpanID,
+		StartedAt:                 startedAtMS,
+		HasAI:                     cfg.HasAI,
+	}
+	mdBlob, err := json.Marshal(md)
+	if err != nil {
+		return fmt.Errorf("postgres_state: migrate marshal metadata: %w", err)
+	}
+
+	var deferInputSize int
+	for _, d := range s.Defers {
+		deferInputSize += len(d.Input)
+	}
+
+	stackIndex := make(map[string]int, len(s.Stack))
+	for n, stepID := range s.Stack {
+		stackIndex[stepID] = n
+	}
+
+	return v.mgr.tx(ctx, func(qs *sqlc.Queries) error {
+		if err := qs.UpsertRunState(ctx, sqlc.UpsertRunStateParams{
+			RunID:          runID,
+			AccountID:      id.Tenant.AccountID.String(),
+			FunctionID:     id.FunctionID.String(),
+			Status:         int32(enums.RunStatusRunning),
+			Metadata:       mdBlob,
+			Events:         eventsBlob,
+			StateSize:      int64(s.Metadata.Metrics.StateSize),
+			EventSize:      int64(s.Metadata.Metrics.EventSize),
+			StepCount:      int64(s.Metadata.Metrics.StepCount),
+			MetadataSize:   int64(s.Metadata.Metrics.MetadataSize),
+			DeferInputSize: int64(deferInputSize),
+		}); err != nil {
+			return fmt.Errorf("postgres_state: migrate metadata: %w", err)
+		}
+
+		if err := qs.ClearRunStateStack(ctx, runID); err != nil {
+			return fmt.Errorf("postgres_state: migrate reset stack: %w", err)
+		}
+		for stepID, data := range s.Steps {
+			idx := sql.NullInt32{}
+			if n, ok := stackIndex[stepID]; ok {
+				idx = sql.NullInt32{Int32: int32(n), Valid: true}
+			}
+			if err := qs.SetRunStateStepOutput(ctx, sqlc.SetRunStateStepOutputParams{
+				RunID:      runID,
+				StepID:     stepID,
+				Output:     data,
+				StackIndex: idx,
+			}); err != nil {
+				return fmt.Errorf("postgres_state: migrate steps: %w", err)
+			}
+		}
+		for stepID, data := range s.StepInputs {
+			if err := qs.SetRunStateStepInput(ctx, sqlc.SetRunStateStepInputParams{
+				RunID:  runID,
+				StepID: stepID,
+				Input:  data,
+			}); err != nil {
+				return fmt.Errorf("postgres_state: migrate step inputs: %w", err)
+			}
+		}
+
+		if err := qs.DeleteRunStatePendingSteps(ctx, runID); err != nil {
+			return fmt.Errorf("postgres_state: migrate reset pending: %w", err)
+		}
+		for _, stepID := range s.PendingSteps {
+			if err := qs.InsertRunStatePendingStep(ctx, sqlc.InsertRunStatePendingStepParams{
+				RunID:  runID,
+				StepID: stepID,
+			}); err != nil {
+				return fmt.Errorf("postgres_state: migrate pending: %w", err)
+			}
+		}
+
+		for hashedID, d := range s.Defers {
+			if err := qs.UpsertRunStateDefer(ctx, sqlc.UpsertRunStateDeferParams{
+				RunID:          runID,
+				HashedID:       hashedID,
+				FnSlug:         d.FnSlug,
+				ScheduleStatus: int32(d.ScheduleStatus),
+				Input:          nilIfEmpty(d.Input),
+				Meta:           nilIfEmpty(d.Meta),
+			}); err != nil {
+				return fmt.Errorf("postgres_state: migrate defer %s: %w", hashedID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (v v2) LookupIdempotency(ctx context.Context, id state.ID, key string) (*state.IdempotencyEntry, error) {
+	v1id := statev1.Identifier{
+		Key:        key,
+		WorkflowID: id.FunctionID,
+		AccountID:  id.Tenant.AccountID,
+	}
+
+	val, err := v.mgr.queries.GetRunStateKV(ctx, sqlc.GetRunStateKVParams{
+		AccountID: id.Tenant.AccountID.String(),
+		Key:       idempotencyKey(v1id),
+		NowMs:     time.Now().UnixMilli(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("postgres_state: lookup idempotency: %w", err)
+	}
+
+	entry := &state.IdempotencyEntry{}
+	if len(val) > 0 && val[0] == consts.FunctionIdempotencyTombstone {
+		entry.IsTombstone = true
+		val = val[1:]
+		if val == "" {
+			return entry, nil
+		}
+	}
+	runID, err := ulid.Parse(val)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_state: parse idempotency runID: %w", err)
+	}
+	entry.RunID = runID
+	return entry, nil
+}
+
+// Delete deletes state, metadata, and - when pauses are included - associated pauses
+// for the run from the store.  Nothing referencing the run should exist in the state
+// store after.
+func (v v2) Delete(ctx context.Context, id state.ID, opts ...state.DeleteOption) error {
+	return v.mgr.Delete(ctx, statev1.Identifier{
+		RunID:       id.RunID,
+		WorkflowID:  id.FunctionID,
+		AccountID:   id.Tenant.AccountID,
+		WorkspaceID: id.Tenant.EnvID,
+	}, opts...)
+}
+
+// ClaimFinalization claims finish-effect emission for a run by inserting a
+// keyed row alongside the run's idempotency key.  The claim expires with the
+// idempotency period and is released by deleting the row if we still own it.
+func (v v2) ClaimFinalization(ctx context.Context, md state.Metadata) (state.FinalizationClaim, error) {
+	v1id := statev1.Identifier{
+		RunID:      md.ID.RunID,
+		WorkflowID: md.ID.FunctionID,
+		AccountID:  md.ID.Tenant.AccountID,
+		Key:        md.Config.Idempotency,
+	}
+	accountID := md.ID.Tenant.AccountID.String()
+	key := fmt.Sprintf("%s:finalize", idempotencyKey(v1id))
+	claimToken := uuid.NewString()
+
+	now := time.Now()
+	n, err := v.mgr.queries.SetRunStateKVIfAbsent(ctx, sqlc.SetRunStateKVIfAbsentParams{
+		AccountID:   accountID,
+		Key:         key,
+		Value:       claimToken,
+		ExpiresAtMs: now.Add(consts.FunctionIdempotencyPeriod).UnixMilli(),
+		NowMs:       now.UnixMilli(),
+	})
+	if err != nil {
+		return state.NewFinalizationClaim(false, nil), fmt.Errorf("error claiming finalization: %w", err)
+	}
+	if n == 0 {
+		return state.NewFinalizationClaim(false, nil), nil
+	}
+
+	return state.NewFinalizationClaim(true, func(ctx context.Context) error {
+		if _, err := v.mgr.queries.DeleteRunStateKVIfValue(ctx, sqlc.DeleteRunStateKVIfValueParams{
+			AccountID: accountID,
+			Key:       key,
+			Value:     claimToken,
+		}); err != nil {
+			return fmt.Errorf("error releasing finalization claim: %w", err)
+		}
+		return nil
+	}), nil
+}
+
+func (v v2) Exists(ctx context.Context, id state.ID) (bool, error) {
+	return v.mgr.Exists(ctx, id.Tenant.AccountID, id.RunID)
+}
+
+func (v v2) LoadDefers(ctx context.Context, id state.ID) (map[string]state.Defer, error) {
+	return v.mgr.LoadDefers(ctx, id.RunID)
+}
+
+func (v v2) LoadDefersMeta(ctx context.Context, id state.ID) (map[string]state.DeferMeta, error) {
+	return v.mgr.LoadDefersMeta(ctx, id.RunID)
+}
+
+// LoadEvents returns all events for a run.
+func (v v2) LoadEvents(ctx context.Context, id state.ID) ([]json.RawMessage, error) {
+	return v.mgr.LoadEvents(ctx, id.Tenant.AccountID, id.FunctionID, id.RunID)
+}
+
+// LoadSteps returns all steps for a run.
+func (v v2) LoadSteps(ctx context.Context, id state.ID) (map[string]json.RawMessage, error) {
+	return v.mgr.LoadSteps(ctx, id.Tenant.AccountID, id.FunctionID, id.RunID)
+}
+
+// LoadStepInputs returns only the step inputs for a run.
+func (v v2) LoadStepInputs(ctx context.Context, id state.ID) (map[string]json.RawMessage, error) {
+	return v.mgr.LoadStepInputs(ctx, id.Tenant.AccountID, id.FunctionID, id.RunID)
+}
+
+// LoadStepsWithIDs returns a list of steps with the given IDs for a run.
+func (v v2) LoadStepsWithIDs(ctx context.Context, id state.ID, stepIDs []string) (map[string]json.RawMessage, error) {
+	return v.mgr.LoadStepsWithIDs(ctx, id.Tenant.AccountID, id.FunctionID, id.RunID, stepIDs)
+}
+
+// LoadState returns all state for a run.
+func (v v2) LoadState(ctx context.Context, id state.ID) (state.State, error) {
+	var (
+		err   error
+		state = state.State{}
+	)
+
+	if state.Metadata, err = v.LoadMetadata(ctx, id); err != nil {
+		return state, err
+	}
+
+	// Reassign id since state.Metadata.ID has more complete info. Specifically,
+	// it has the function ID
+	id = state.Metadata.ID
+
+	if state.Events, err = v.LoadEvents(ctx, id); err != nil {
+		return state, err
+	}
+	if state.Steps, err = v.LoadSteps(ctx, id); err != nil {
+		return state, err
+	}
+
+	return state, nil
+}
+
+func (v v2) SaveDefer(ctx context.Context, id state.ID, d state.Defer) error {
+	return v.mgr.SaveDefer(ctx, id.RunID, d)
+}
+
+func (v v2) SetDeferStatus(ctx context.Context, id state.ID, hashedID string, status enums.DeferStatus) error {
+	return v.mgr.SetDeferStatus(ctx, id.RunID, hashedID, status)
+}
+
+func (v v2) SaveRejectedDefer(ctx context.Context, id state.ID, fnSlug string, hashedID string) error {
+	return v.mgr.SaveRejectedDefer(ctx, id.RunID, fnSlug, hashedID)
+}
+
+// Metadata returns metadata for a given run
+func (v v2) LoadMetadata(ctx context.Context, id state.ID, _ ...state.LoadMetadataOption) (state.Metadata, error) {
+	row, err := v.mgr.run(ctx, v.mgr.queries, id.RunID)
+	if err != nil {
+		return state.Metadata{}, err
+	}
+	md := row.md
+
+	stack, err := v.mgr.stack(ctx, id.RunID)
+	if err != nil {
+		return state.Metadata{}, err
+	}
+
+	var startedAt time.Time
+	if md.StartedAt > 0 {
+		startedAt = time.UnixMilli(md.StartedAt)
+	}
+
+	result := state.Metadata{
+		ID: state.ID{
+			RunID:      md.Identifier.RunID,
+			FunctionID: md.Identifier.WorkflowID,
+			Tenant: state.Tenant{
+				AppID:     md.Identifier.AppID,
+				EnvID:     md.Identifier.WorkspaceID,
+				AccountID: md.Identifier.AccountID,
+			},
+		},
+		Config: *state.InitConfig(&state.Config{
+			FunctionVersion:       md.Identifier.WorkflowVersion,
+			SpanID:                md.SpanID,
+			StartedAt:             startedAt,
+			EventIDs:              md.Identifier.EventIDs,
+			BatchID:               md.Identifier.BatchID,
+			RequestVersion:        md.RequestVersion,
+			Idempotency:           md.Identifier.Key,
+			ReplayID:              md.Identifier.ReplayID,
+			OriginalRunID:         md.Identifier.OriginalRunID,
+			PriorityFactor:        md.Identifier.PriorityFactor,
+			CustomConcurrencyKeys: md.Identifier.CustomConcurrencyKeys,
+			Semaphores:            md.Identifier.Semaphores,
+			Context:               md.Context,
+			ForceStepPlan:         md.DisableImmediateExecution,
+			HasAI:                 md.HasAI,
+		}),
+		Stack: stack,
+		Metrics: state.RunMetrics{
+			EventSize:          row.eventSize,
+			StateSize:          row.stateSize,
+			StepCount:          row.stepCount,
+			MetadataSize:       row.metadataSize,
+			MetadataSizeLoaded: row.metadataSize,
+		},
+	}
+
+	// initialize function trace eagerly; this needs to unmarshal the trace carrier
+	_ = result.Config.FunctionTrace()
+
+	return result, nil
+}
+
+// LoadStack returns the current stack for a run.
+func (v v2) LoadStack(ctx context.Context, id state.ID) ([]string, error) {
+	return v.mgr.stack(ctx, id.RunID)
+}
+
+// LoadPending returns the set of pending step IDs for a run.
+func (v v2) LoadPending(ctx context.Context, id state.ID) ([]string, error) {
+	return v.mgr.loadPending(ctx, id.RunID)
+}
+
+// Update updates configuration on the state, eg. setting the execution
+// version after communicating with the SDK.
+func (v v2) UpdateMetadata(ctx context.Context, id state.ID, mutation state.MutableConfig) error {
+	_, err := util.WithRetry(
+		ctx,
+		"state.UpdateMetadata",
+		func(ctx context.Context) (bool, error) {
+			err := v.mgr.UpdateMetadata(ctx, id.Tenant.AccountID, id.RunID, statev1.MetadataUpdate{
+				DisableImmediateExecution: mutation.ForceStepPlan,
+				RequestVersion:            mutation.RequestVersion,
+				StartedAt:                 mutation.StartedAt,
+				HasAI:                     mutation.HasAI,
+			})
+
+			return false, err
+		},
+		v.retryPolicy(),
+	)
+
+	return err
+}
+
+// SaveStep saves step output for the given run ID and step ID.
+func (v v2) SaveStep(ctx context.Context, id state.ID, stepID string, data []byte) (bool, error) {
+	v1id := statev1.Identifier{
+		RunID:      id.RunID,
+		WorkflowID: id.FunctionID,
+		AccountID:  id.Tenant.AccountID,
+	}
+
+	attempt := 0
+	hasPending, err := util.WithRetry(
+		ctx,
+		"state.SaveStep",
+		func(ctx context.Context) (bool, error) {
+			attempt++
+			return v.mgr.SaveResponse(ctx, v1id, stepID, string(data))
+		},
+		v.retryPolicy(
+			util.WithRetryConfRetryableErrors(v.retryableError),
+			util.WithRetryConfMaxBackoff(10*time.Second),
+			util.WithRetryConfMaxAttempts(10),
+		),
+	)
+
+	if errors.Is(err, statev1.ErrIdempotentResponse) {
+		// This step data for this step ID has already been saved exactly as before.
+		logger.StdlibLogger(ctx).Warn(
+			"swallowing idempotent step response",
+			"attempt", attempt,
+			"run_id", id.RunID,
+			"step_id", stepID,
+		)
+		// NOTE: hasPending should be accurate in this case.
+		return hasPending, nil
+	}
+
+	if errors.Is(err, statev1.ErrDuplicateResponse) && attempt > 1 {
+		// Swallow the error. Since the 2nd attempt has a "duplicate response"
+		// we can assume that the first attempt committed despite the retry.
+		// This can happen if we get a context timeout in Go code after the
+		// transaction was committed.
+		logger.StdlibLogger(ctx).Warn(
+			"swallowing duplicate response",
+			"attempt", attempt,
+			"run_id", id.RunID,
+			"step_id", stepID,
+		)
+		return false, nil
+	}
+
+	// We only record the number of bytes written after handling idempotent and
+	// duplicate errors;  those don't count towards backing state store growth.
+	metrics.IncrStateWrittenCounter(ctx, len(data), metrics.CounterOpt{
+		PkgName: pkgName,
+		Tags: map[string]any{
+			"account_id": id.Tenant.AccountID,
+		},
+	})
+	metrics.HistogramStateWrittenCounter(ctx, int64(len(data)), metrics.HistogramOpt{
+		PkgName: pkgName,
+	})
+
+	return hasPending, err
+}
+
+// SavePending saves pending step IDs for the given run ID.
+func (v v2) SavePending(ctx context.Context, id state.ID, pending []string) error {
+	v1id := statev1.Identifier{
+		RunID:      id.RunID,
+		WorkflowID: id.FunctionID,
+		AccountID:  id.Tenant.AccountID,
+	}
+
+	_, err := util.WithRetry(
+		ctx,
+		"state.SavePending",
+		func(ctx context.Context) (bool, error) {
+			err := v.mgr.SavePending(ctx, v1id, pending)
+			return false, err
+		},
+		v.retryPolicy(),
+	)
+
+	return err
+}
+
+// ConsumePause consumes a pause by its ID such that it can't be used again.
+func (v v2) ConsumePause(ctx context.Context, p statev1.Pause, opts statev1.ConsumePauseOpts) (statev1.ConsumePauseResult, error) {
+	r, err := util.WithRetry(
+		ctx,
+		"state.ConsumePause",
+		func(ctx context.Context) (statev1.ConsumePauseResult, error) {
+			res, err := v.mgr.ConsumePause(ctx, p, opts)
+			return res, err
+		},
+		v.retryPolicy(),
+	)
+
+	return r, err
+}
+
+// IncrementMetadataSize atomically increments the cumulative metadata size
+// counter for a run.
+func (v v2) IncrementMetadataSize(ctx context.Context, id state.ID, delta int) error {
+	return v.mgr.IncrementMetadataSize(ctx, id.Tenant.AccountID, id.RunID, delta)
+}
+
+func (v v2) retryPolicy(opts ...util.RetryConfSetting) util.RetryConf {
+	if v.disabledRetries {
+		opts = append(opts, util.WithRetryConfMaxAttempts(1))
+	}
+	return util.NewRetryConf(opts...)
+}
+
+// determine what errors are retriable
+func (v v2) retryableError(err error) bool {
+	switch {
+	case errors.Is(err, statev1.ErrIdempotentResponse):
+		return false
+	case errors.Is(err, statev1.ErrDuplicateResponse):
+		return false
+	case errors.Is(err, statev1.ErrRunNotFound):
+		return false
+	}
+
+	return true
+}
+
+// v1Identifier converts v2 run metadata into the v1 identifier stored
+// alongside each run.
+func v1Identifier(md state.Metadata) statev1.Identifier {
+	return statev1.Identifier{
+		RunID:                 md.ID.RunID,
+		WorkflowID:            md.ID.FunctionID,
+		WorkflowVersion:       md.Config.FunctionVersion,
+		EventID:               md.Config.EventID(),
+		EventIDs:              md.Config.EventIDs,
+		Key:                   md.Config.Idempotency,
+		AccountID:             md.ID.Tenant.AccountID,
+		WorkspaceID:           md.ID.Tenant.EnvID,
+		AppID:                 md.ID.Tenant.AppID,
+		OriginalRunID:         md.Config.OriginalRunID,
+		ReplayID:              md.Config.ReplayID,
+		PriorityFactor:        md.Config.PriorityFactor,
+		CustomConcurrencyKeys: md.Config.CustomConcurrencyKeys,
+		Semaphores:            md.Config.Semaphores,
+		BatchID:               md.Config.BatchID,
+	}
+}
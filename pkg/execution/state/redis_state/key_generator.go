@@ -215,6 +215,12 @@ type QueueKeyGenerator interface {
 	// ShardLeaseKey returns the key which allows a worker to claim one of n available leases per shard to begin processing
 	// partitions in that shard
 	ShardLeaseKey(scope string) string
+	// ShardRoutes returns the hash storing the shard routes for an account,
+	// keyed by function ID, or "-" for the account-wide route.
+	ShardRoutes(accountID uuid.UUID) string
+	// ShardMigrations returns the hash storing shard migrations moving items
+	// off this shard, keyed by migration ID.
+	ShardMigrations() string
 	// Sequential returns the key which allows a worker to claim sequential processing
 	// of the partitions.
 	Sequential() string
@@ -338,6 +344,14 @@ func (u queueKeyGenerator) ShardLeaseKey(scope string) string {
 	return fmt.Sprintf("{%s}:queue:shard-lease:%s", u.queueDefaultKey, scope)
 }
 
+func (u queueKeyGenerator) ShardRoutes(accountID uuid.UUID) string {
+	return fmt.Sprintf("{%s}:queue:shard-routes:%s", u.queueDefaultKey, accountID)
+}
+
+func (u queueKeyGenerator) ShardMigrations() string {
+	return fmt.Sprintf("{%s}:queue:shard-migrations", u.queueDefaultKey)
+}
+
 func (u queueKeyGenerator) Idempotency(key string) string {
 	return fmt.Sprintf("{%s}:queue:seen:%s", u.queueDefaultKey, key)
 }
@@ -3,6 +3,7 @@
 Output:
   0: Successfully dequeued item
   1: Queue item not found
+  2: Queue item is leased and requireUnleased was set
 
 ]]
 
@@ -42,6 +43,8 @@ local backlogID      = ARGV[3]
 local accountID      = ARGV[4]
 local runID          = ARGV[5]
 local idempotencyTTL = tonumber(ARGV[6])
+local requireUnleased = tonumber(ARGV[7])
+local nowMS          = tonumber(ARGV[8])
 
 -- $include(get_queue_item.lua)
 -- $include(get_partition_item.lua)
@@ -49,6 +52,7 @@ local idempotencyTTL = tonumber(ARGV[6])
 -- $include(ends_with.lua)
 -- $include(update_account_queues.lua)
 -- $include(update_backlog_pointer.lua)
+-- $include(decode_ulid_time.lua)
 
 --
 -- Fetch this item to see if it was in progress prior to deleting.
@@ -57,6 +61,10 @@ if item == nil then
 	return 1
 end
 
+if requireUnleased == 1 and item.leaseID ~= nil and item.leaseID ~= cjson.null and decode_ulid_time(item.leaseID) > nowMS then
+	return 2
+end
+
 redis.call("HDEL", keyQueueMap, queueID)
 redis.call("DEL", keyEarliestPeekTime)
 
@@ -6,6 +6,7 @@ Output:
   0: Successfully re-enqueued item
   1: Queue item not found
   2: Successfully re-queued to backlog -- TODO: this should be a temporary status
+  3: Queue item is leased and requireUnleased was set
 ]]
 
 local queueKey                = KEYS[1] -- queue:item - hash: { $itemID: $item }
@@ -47,6 +48,7 @@ local requeueToBacklog				= tonumber(ARGV[8])
 local shadowPartitionItem     = ARGV[9]
 local backlogID               = ARGV[10]
 local backlogItem             = ARGV[11]
+local requireUnleased         = tonumber(ARGV[12])
 
 -- $include(get_queue_item.lua)
 -- $include(get_partition_item.lua)
@@ -54,12 +56,17 @@ local backlogItem             = ARGV[11]
 -- $include(ends_with.lua)
 -- $include(update_account_queues.lua)
 -- $include(enqueue_to_partition.lua)
+-- $include(decode_ulid_time.lua)
 
 local item = get_queue_item(queueKey, queueID)
 if item == nil then
     return 1
 end
 
+if requireUnleased == 1 and item.leaseID ~= nil and item.leaseID ~= cjson.null and decode_ulid_time(item.leaseID) > nowMS then
+    return 3
+end
+
 -- Update the queue item with a nil lease, at, atMS, etc.
 redis.call("HSET", queueKey, queueID, queueItem)
 redis.call("DEL", keyEarliestPeekTime)
@@ -1,6 +1,7 @@
 package redis_state
 
 import (
+	"flag"
 	"fmt"
 	"io/fs"
 	"os"
@@ -49,6 +50,10 @@ func TestLuaEndsWith(t *testing.T) {
 	})
 }
 
+// updateLuaSnapshots writes the expanded Lua scripts to testdata/snapshots,
+// which is gitignored, for inspecting scripts with their includes inlined.
+var updateLuaSnapshots = flag.Bool("update", false, "write expanded lua scripts to testdata/snapshots")
+
 func TestLuaScriptSnapshots(t *testing.T) {
 	// read the lua scripts
 	entries, err := embedded.ReadDir("lua")
@@ -100,14 +105,14 @@ func TestLuaScriptSnapshots(t *testing.T) {
 
 	readRedisScripts("lua", entries)
 
+	if !*updateLuaSnapshots {
+		t.Skip("run with -update to write lua script snapshots")
+	}
+
 	// Test each script
 	for scriptName, rawContent := range scripts {
 		t.Run(scriptName, func(t *testing.T) {
-			// Process the script
-
-			// Read expected snapshot from fixture file
 			snapshotPath := filepath.Join("testdata", "snapshots", scriptName+".lua")
-			// Generate snapshot file if it doesn't exist
 			err := os.MkdirAll(filepath.Dir(snapshotPath), 0o755)
 			require.NoError(t, err)
 
@@ -327,6 +327,40 @@ func TestQueueDequeue(t *testing.T) {
 		})
 	})
 
+	t.Run("It should not remove a leased item when unleased items are required", func(t *testing.T) {
+		r.FlushAll()
+
+		acctID := uuid.New()
+		fnID := uuid.New()
+
+		item, err := shard.EnqueueItem(ctx, osqueue.QueueItem{
+			FunctionID: fnID,
+			Data: osqueue.Item{
+				Identifier: state.Identifier{
+					AccountID:  acctID,
+					WorkflowID: fnID,
+					RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+				},
+			},
+		}, time.Now(), osqueue.EnqueueOpts{})
+		require.NoError(t, err)
+
+		_, err = shard.Lease(ctx, item, 10*time.Second, time.Now())
+		require.NoError(t, err)
+
+		err = shard.Dequeue(ctx, item, osqueue.DequeueRequireUnleased())
+		require.ErrorIs(t, err, osqueue.ErrQueueItemAlreadyLeased)
+		require.NotEmpty(t, r.HGet(kg.QueueItem(), item.ID))
+
+		err = shard.Requeue(ctx, item, time.Now(), osqueue.RequeueRequireUnleased())
+		require.ErrorIs(t, err, osqueue.ErrQueueItemAlreadyLeased)
+
+		// Without the option, leased items are dequeued as before.
+		err = shard.Dequeue(ctx, item)
+		require.NoError(t, err)
+		require.Empty(t, r.HGet(kg.QueueItem(), item.ID))
+	})
+
 	t.Run("backcompat: it should not drop previous partition names from concurrency index", func(t *testing.T) {
 		// This tests backwards compatibility with the old concurrency index member naming scheme
 		r.FlushAll()
@@ -45,7 +45,7 @@ func QueueItemIndexerFunc(ctx context.Context, i osqueue.QueueItem, kg QueueKeyG
 			kg.RunIndex(i.Data.Identifier.RunID),
 			kg.Status("sleep", i.FunctionID),
 		}
-	case osqueue.KindPause:
+	case osqueue.KindPause, osqueue.KindLock:
 		// Still keep this in the run index so that we know jobs are present
 		// for the run.
 		return QueueItemIndex{
@@ -99,6 +99,9 @@ func (q *queue) Dequeue(ctx context.Context, i osqueue.QueueItem, options ...osq
 	if i.IdempotencyPeriod != nil {
 		idempotency = *i.IdempotencyPeriod
 	}
+	if o.DisableIdempotency {
+		idempotency = 0
+	}
 
 	args, err := StrSlice([]any{
 		i.ID,
@@ -108,6 +111,8 @@ func (q *queue) Dequeue(ctx context.Context, i osqueue.QueueItem, options ...osq
 		i.Data.Identifier.RunID.String(),
 
 		int(idempotency.Seconds()),
+		o.RequireUnleased,
+		q.Clock.Now().UnixMilli(),
 	})
 	if err != nil {
 		return err
@@ -131,6 +136,8 @@ func (q *queue) Dequeue(ctx context.Context, i osqueue.QueueItem, options ...osq
 		return nil
 	case 1:
 		return osqueue.ErrQueueItemNotFound
+	case 2:
+		return osqueue.ErrQueueItemAlreadyLeased
 	default:
 		return fmt.Errorf("unknown response dequeueing item: %d", status)
 	}
@@ -263,6 +270,8 @@ func (q *queue) Requeue(ctx context.Context, i osqueue.QueueItem, at time.Time,
 		shadowPartition,
 		backlog.BacklogID,
 		backlog,
+
+		o.RequireUnleased,
 	})
 	if err != nil {
 		return err
@@ -309,6 +318,8 @@ func (q *queue) Requeue(ctx context.Context, i osqueue.QueueItem, at time.Time,
 		// This should only ever happen if a run is cancelled and all queue items
 		// are deleted before requeueing.
 		return osqueue.ErrQueueItemNotFound
+	case 3:
+		return osqueue.ErrQueueItemAlreadyLeased
 	default:
 		return fmt.Errorf("unknown response requeueing item: %v (%T)", status, status)
 	}
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"iter"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/inngest/inngest/pkg/consts"
 	"github.com/inngest/inngest/pkg/enums"
 	osqueue "github.com/inngest/inngest/pkg/execution/queue"
@@ -17,6 +19,7 @@ import (
 	"github.com/inngest/inngest/pkg/telemetry/redis_telemetry"
 	"github.com/oklog/ulid/v2"
 	"github.com/redis/rueidis"
+	"github.com/sourcegraph/conc/pool"
 )
 
 // RunJobs returns a list of jobs that are due to run for a given run ID.
@@ -160,6 +163,9 @@ func (q *queue) ItemsByPartition(ctx context.Context, scope osqueue.Scope, parti
 
 	cmd := rc.B().Hget().Key(hash).Field(partitionID).Build()
 	byt, err := rc.Do(ctx, cmd).AsBytes()
+	if rueidis.IsRedisNil(err) {
+		return nil, fmt.Errorf("error retrieving partition '%s': %w: %w", partitionID, osqueue.ErrPartitionNotFound, err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving partition '%s': %w", partitionID, err)
 	}
@@ -554,6 +560,182 @@ func (q *queue) QueueIterator(ctx context.Context, opts QueueIteratorOpts) (part
 	return atomic.LoadInt64(&totalPartitions), atomic.LoadInt64(&totalQueueItems), nil
 }
 
+// FunctionQueueStats returns queue statistics for every function partition,
+// including partitions whose items are all held in key queue backlogs.
+func (q *queue) FunctionQueueStats(ctx context.Context) ([]osqueue.FunctionQueueStats, error) {
+	ctx = redis_telemetry.WithScope(redis_telemetry.WithOpName(ctx, "FunctionQueueStats"), redis_telemetry.ScopeQueue)
+
+	rc := q.RedisClient.Client()
+	kg := q.RedisClient.kg
+	chunkSize := int64(1000)
+
+	// Function partitions are keyed by function ID.  System partitions and
+	// legacy key partitions are skipped.
+	fnIDs := map[uuid.UUID]struct{}{}
+	for _, key := range []string{kg.GlobalPartitionIndex(), kg.GlobalShadowPartitionSet()} {
+		for offset := int64(0); ; offset += chunkSize {
+			cmd := rc.B().Zrange().Key(key).Min("-inf").Max("+inf").Byscore().Limit(offset, chunkSize).Build()
+			ids, err := rc.Do(ctx, cmd).AsStrSlice()
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving partitions for stats: %w", err)
+			}
+			for _, id := range ids {
+				if fnID, err := uuid.Parse(id); err == nil {
+					fnIDs[fnID] = struct{}{}
+				}
+			}
+			if int64(len(ids)) < chunkSize {
+				break
+			}
+		}
+	}
+
+	stats, err := q.functionQueueStats(ctx, fnIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]osqueue.FunctionQueueStats, 0, len(stats))
+	for _, s := range stats {
+		if s.Ready+s.Scheduled+s.Backlog+s.Leased > 0 {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// FunctionQueueStatsPage returns queue statistics for a page of function
+// partitions, walking the partition indexes with ZSCAN so that each call costs
+// roughly limit partitions regardless of the size of the queue.  Pass an empty
+// cursor to start a scan and the returned cursor to continue it;  the scan is
+// complete once the returned cursor is empty.  As with ZSCAN, a function may be
+// returned more than once within a scan.  Functions without items are returned
+// with zero counts so that callers can drop them.
+func (q *queue) FunctionQueueStatsPage(ctx context.Context, cursor string, limit int) ([]osqueue.FunctionQueueStats, string, error) {
+	ctx = redis_telemetry.WithScope(redis_telemetry.WithOpName(ctx, "FunctionQueueStatsPage"), redis_telemetry.ScopeQueue)
+
+	rc := q.RedisClient.Client()
+	kg := q.RedisClient.kg
+	keys := []string{kg.GlobalPartitionIndex(), kg.GlobalShadowPartitionSet()}
+
+	// The cursor is the index of the partition index being scanned and the
+	// ZSCAN cursor within it.
+	var (
+		keyIdx     int
+		scanCursor uint64
+	)
+	if cursor != "" {
+		if _, err := fmt.Sscanf(cursor, "%d:%d", &keyIdx, &scanCursor); err != nil || keyIdx < 0 || keyIdx >= len(keys) {
+			return nil, "", fmt.Errorf("invalid function queue stats cursor %q", cursor)
+		}
+	}
+
+	cmd := rc.B().Zscan().Key(keys[keyIdx]).Cursor(scanCursor).Count(int64(limit)).Build()
+	scan, err := rc.Do(ctx, cmd).AsScanEntry()
+	if err != nil {
+		return nil, "", fmt.Errorf("error scanning partitions for stats: %w", err)
+	}
+
+	next := ""
+	switch {
+	case scan.Cursor != 0:
+		next = fmt.Sprintf("%d:%d", keyIdx, scan.Cursor)
+	case keyIdx+1 < len(keys):
+		next = fmt.Sprintf("%d:0", keyIdx+1)
+	}
+
+	// ZSCAN returns interleaved [member, score] pairs.
+	fnIDs := map[uuid.UUID]struct{}{}
+	for i := 0; i < len(scan.Elements); i += 2 {
+		if fnID, err := uuid.Parse(scan.Elements[i]); err == nil {
+			fnIDs[fnID] = struct{}{}
+		}
+	}
+
+	stats, err := q.functionQueueStats(ctx, fnIDs)
+	if err != nil {
+		return nil, "", err
+	}
+	return stats, next, nil
+}
+
+// functionQueueStats loads queue statistics for the given function partitions.
+func (q *queue) functionQueueStats(ctx context.Context, fnIDs map[uuid.UUID]struct{}) ([]osqueue.FunctionQueueStats, error) {
+	rc := q.RedisClient.Client()
+	kg := q.RedisClient.kg
+
+	now := q.Clock.Now()
+	nowMS := strconv.FormatInt(now.UnixMilli(), 10)
+
+	var (
+		mu     sync.Mutex
+		result = make([]osqueue.FunctionQueueStats, 0, len(fnIDs))
+	)
+
+	concurrency := q.PartitionBacklogSizeConcurrency()
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	wg := pool.New().WithErrors().WithMaxGoroutines(int(concurrency))
+	for fnID := range fnIDs {
+		wg.Go(func() error {
+			partitionID := fnID.String()
+			readyKey := kg.PartitionQueueSet(enums.PartitionTypeDefault, partitionID, "")
+			shadowKey := kg.ShadowPartitionSet(partitionID)
+
+			res := rc.DoMulti(ctx,
+				rc.B().Zcount().Key(readyKey).Min("-inf").Max(nowMS).Build(),
+				rc.B().Zcount().Key(readyKey).Min("("+nowMS).Max("+inf").Build(),
+				rc.B().Zrange().Key(readyKey).Min("-inf").Max(nowMS).Byscore().Limit(0, 1).Withscores().Build(),
+				rc.B().Zcount().Key(kg.PartitionScavengerIndex(partitionID)).Min(nowMS).Max("+inf").Build(),
+				rc.B().Zrange().Key(shadowKey).Min("-inf").Max(nowMS).Byscore().Limit(0, 1).Withscores().Build(),
+				rc.B().Zcard().Key(shadowKey).Build(),
+			)
+			for _, r := range res {
+				if err := r.Error(); err != nil {
+					return fmt.Errorf("error retrieving stats for partition %q: %w", partitionID, err)
+				}
+			}
+
+			stats := osqueue.FunctionQueueStats{FunctionID: fnID}
+			stats.Ready, _ = res[0].AsInt64()
+			stats.Scheduled, _ = res[1].AsInt64()
+			stats.Leased, _ = res[3].AsInt64()
+
+			// Backlogs are scored by their earliest item, so the earliest due
+			// backlog bounds the age of backlog items.
+			var oldestMS int64
+			for _, r := range []rueidis.RedisResult{res[2], res[4]} {
+				scores, _ := r.AsZScores()
+				if len(scores) > 0 && (oldestMS == 0 || int64(scores[0].Score) < oldestMS) {
+					oldestMS = int64(scores[0].Score)
+				}
+			}
+			if oldestMS > 0 {
+				stats.OldestDueAt = time.UnixMilli(oldestMS)
+			}
+
+			if backlogs, _ := res[5].AsInt64(); backlogs > 0 {
+				size, err := q.PartitionBacklogSize(ctx, osqueue.Scope{FunctionID: fnID}, partitionID)
+				if err != nil {
+					return fmt.Errorf("error retrieving backlog size for partition %q: %w", partitionID, err)
+				}
+				stats.Backlog = size
+			}
+
+			mu.Lock()
+			result = append(result, stats)
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (q *queue) ItemExists(ctx context.Context, scope osqueue.Scope, jobID string) (bool, error) {
 	rc := q.RedisClient.Client()
 	kg := q.RedisClient.kg
@@ -1490,3 +1490,118 @@ func TestItemExists(t *testing.T) {
 		require.False(t, exists, "dequeued item should not exist")
 	})
 }
+
+func TestFunctionQueueStats(t *testing.T) {
+	r, rc := initRedis(t)
+	defer rc.Close()
+
+	ctx := context.Background()
+	clock := clockwork.NewFakeClockAt(time.Now().Truncate(time.Second))
+
+	acctID, wsID := uuid.New(), uuid.New()
+	fnA, fnB := uuid.New(), uuid.New()
+
+	_, shard := newQueue(
+		t, rc,
+		osqueue.WithAllowKeyQueues(func(ctx context.Context, acctID uuid.UUID, envID, fnID uuid.UUID) bool {
+			return false
+		}),
+		osqueue.WithClock(clock),
+	)
+
+	enqueue := func(t *testing.T, fnID uuid.UUID, id string, at time.Time) osqueue.QueueItem {
+		t.Helper()
+		item := osqueue.QueueItem{
+			ID:          id,
+			FunctionID:  fnID,
+			WorkspaceID: wsID,
+			Data: osqueue.Item{
+				WorkspaceID: wsID,
+				Kind:        osqueue.KindEdge,
+				Identifier: state.Identifier{
+					AccountID:       acctID,
+					WorkspaceID:     wsID,
+					WorkflowID:      fnID,
+					WorkflowVersion: 1,
+				},
+			},
+		}
+		enqueued, err := shard.EnqueueItem(ctx, item, at, osqueue.EnqueueOpts{})
+		require.NoError(t, err)
+		return enqueued
+	}
+
+	t.Run("empty queue", func(t *testing.T) {
+		r.FlushAll()
+
+		stats, err := shard.FunctionQueueStats(ctx)
+		require.NoError(t, err)
+		require.Empty(t, stats)
+	})
+
+	t.Run("counts ready, scheduled and leased items per function", func(t *testing.T) {
+		r.FlushAll()
+
+		oldest := clock.Now().Add(-30 * time.Second)
+		enqueue(t, fnA, "a-0", oldest)
+		enqueue(t, fnA, "a-1", clock.Now())
+		enqueue(t, fnA, "a-2", clock.Now().Add(10*time.Minute))
+
+		leased := enqueue(t, fnB, "b-0", clock.Now())
+		_, err := shard.Lease(ctx, leased, 10*time.Second, clock.Now())
+		require.NoError(t, err)
+
+		stats, err := shard.FunctionQueueStats(ctx)
+		require.NoError(t, err)
+
+		byFn := map[uuid.UUID]osqueue.FunctionQueueStats{}
+		for _, s := range stats {
+			byFn[s.FunctionID] = s
+		}
+		require.Len(t, byFn, 2)
+
+		a := byFn[fnA]
+		require.EqualValues(t, 2, a.Ready)
+		require.EqualValues(t, 1, a.Scheduled)
+		require.EqualValues(t, 0, a.Leased)
+		require.Equal(t, oldest.UnixMilli(), a.OldestDueAt.UnixMilli())
+
+		b := byFn[fnB]
+		require.EqualValues(t, 0, b.Ready)
+		require.EqualValues(t, 1, b.Leased)
+		require.True(t, b.OldestDueAt.IsZero())
+	})
+
+	t.Run("pages through function partitions", func(t *testing.T) {
+		r.FlushAll()
+
+		enqueue(t, fnA, "a-0", clock.Now())
+		enqueue(t, fnB, "b-0", clock.Now().Add(time.Minute))
+
+		q, ok := shard.(*queue)
+		require.True(t, ok)
+
+		byFn := map[uuid.UUID]osqueue.FunctionQueueStats{}
+		cursor, pages := "", 0
+		for {
+			stats, next, err := q.FunctionQueueStatsPage(ctx, cursor, 1)
+			require.NoError(t, err)
+			for _, s := range stats {
+				byFn[s.FunctionID] = s
+			}
+			pages++
+			require.Less(t, pages, 10)
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		require.Len(t, byFn, 2)
+		require.EqualValues(t, 1, byFn[fnA].Ready)
+		require.EqualValues(t, 1, byFn[fnB].Scheduled)
+
+		_, _, err := q.FunctionQueueStatsPage(ctx, "nope", 1)
+		require.Error(t, err)
+	})
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/inngest/inngest/pkg/enums"
 	osqueue "github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/inngest"
 	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/util"
 	"github.com/jonboulle/clockwork"
 	"github.com/oklog/ulid/v2"
 	"github.com/redis/rueidis"
@@ -1919,3 +1921,120 @@ func (t testLifecycleListener) OnCustomKeyConcurrencyLimitReached(_ context.Cont
 	i := t.ckConcurrency[key]
 	t.ckConcurrency[key] = i + 1
 }
+
+func TestShadowPartitionConcurrencyKeyFairness(t *testing.T) {
+	setup := func(t *testing.T, fairness *osqueue.PartitionFairness) (int, int) {
+		r := miniredis.RunT(t)
+		rc, err := rueidis.NewClient(rueidis.ClientOption{
+			InitAddress:  []string{r.Addr()},
+			DisableCache: true,
+		})
+		require.NoError(t, err)
+		defer rc.Close()
+
+		ctx := context.Background()
+		clock := clockwork.NewFakeClock()
+
+		accountID, envID, fnID := uuid.New(), uuid.New(), uuid.New()
+		keyExprHash := util.XXHash("event.data.customerId")
+
+		constraints := osqueue.PartitionConstraintConfig{
+			FunctionVersion: 1,
+			Concurrency: osqueue.PartitionConcurrency{
+				AccountConcurrency:  1000,
+				FunctionConcurrency: 1000,
+				CustomConcurrencyKeys: []osqueue.CustomConcurrencyLimit{
+					{
+						Mode:                enums.ConcurrencyModeStep,
+						Scope:               enums.ConcurrencyScopeFn,
+						HashedKeyExpression: keyExprHash,
+						Limit:               1000,
+					},
+				},
+				Fairness: fairness,
+			},
+		}
+
+		q, shard := newQueue(
+			t, rc,
+			osqueue.WithClock(clock),
+			osqueue.WithAllowKeyQueues(func(ctx context.Context, acctID uuid.UUID, envID, fnID uuid.UUID) bool {
+				return true
+			}),
+			osqueue.WithPartitionConstraintConfigGetter(func(ctx context.Context, p osqueue.PartitionIdentifier) osqueue.PartitionConstraintConfig {
+				return constraints
+			}),
+		)
+		kg := shard.Client().kg
+
+		var item osqueue.QueueItem
+		enqueue := func(customer string, weight int, n int) {
+			for range n {
+				item, err = shard.EnqueueItem(ctx, osqueue.QueueItem{
+					FunctionID:  fnID,
+					WorkspaceID: envID,
+					Data: osqueue.Item{
+						Kind: osqueue.KindStart,
+						Identifier: state.Identifier{
+							RunID:           ulid.Make(),
+							AccountID:       accountID,
+							WorkspaceID:     envID,
+							WorkflowID:      fnID,
+							WorkflowVersion: 1,
+						},
+						CustomConcurrencyKeys: []state.CustomConcurrency{
+							{
+								Key:                       util.ConcurrencyKey(enums.ConcurrencyScopeFn, fnID, customer),
+								Hash:                      keyExprHash,
+								Limit:                     1000,
+								UnhashedEvaluatedKeyValue: customer,
+								Weight:                    weight,
+							},
+						},
+					},
+				}, clock.Now(), osqueue.EnqueueOpts{})
+				require.NoError(t, err)
+			}
+		}
+
+		enqueue("noisy", 1, 20)
+		enqueue("quiet", 3, 8)
+
+		shadowPart := osqueue.ItemShadowPartition(ctx, item)
+		require.NoError(t, q.ProcessShadowPartition(ctx, &shadowPart, 0))
+
+		ids, err := r.ZMembers(kg.PartitionQueueSet(enums.PartitionTypeDefault, fnID.String(), ""))
+		require.NoError(t, err)
+
+		var noisy, quiet int
+		for _, id := range ids {
+			qi, err := shard.LoadQueueItem(ctx, id)
+			require.NoError(t, err)
+			switch qi.Data.CustomConcurrencyKeys[0].Key {
+			case util.ConcurrencyKey(enums.ConcurrencyScopeFn, fnID, "noisy"):
+				noisy++
+			case util.ConcurrencyKey(enums.ConcurrencyScopeFn, fnID, "quiet"):
+				quiet++
+			}
+		}
+		return noisy, quiet
+	}
+
+	t.Run("without fairness each backlog is refilled completely", func(t *testing.T) {
+		noisy, quiet := setup(t, nil)
+		require.Equal(t, 20, noisy)
+		require.Equal(t, 8, quiet)
+	})
+
+	t.Run("weighted round-robin refills a weighted quantum per backlog", func(t *testing.T) {
+		noisy, quiet := setup(t, &osqueue.PartitionFairness{Mode: inngest.ConcurrencyFairnessWeightedRoundRobin, Quantum: 2})
+		require.Equal(t, 2, noisy)
+		require.Equal(t, 6, quiet)
+	})
+
+	t.Run("deficit round-robin refills a weighted quantum per backlog", func(t *testing.T) {
+		noisy, quiet := setup(t, &osqueue.PartitionFairness{Mode: inngest.ConcurrencyFairnessDeficitRoundRobin, Quantum: 2})
+		require.Equal(t, 2, noisy)
+		require.Equal(t, 6, quiet)
+	})
+}
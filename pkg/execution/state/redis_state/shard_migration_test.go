@@ -0,0 +1,217 @@
+package redis_state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardMigration(t *testing.T) {
+	_, rc1 := initRedis(t)
+	defer rc1.Close()
+	_, rc2 := initRedis(t)
+	defer rc2.Close()
+
+	ctx := context.Background()
+
+	shard1 := shardFromClient("one", rc1)
+	shard2 := shardFromClient("two", rc2)
+
+	registry, err := osqueue.NewShardRegistry(
+		mapFromShards(shard1, shard2),
+		osqueue.WithShardSelector(alwaysSelectShard(shard1)),
+		osqueue.WithPrimary(shard1),
+		osqueue.WithShardRouteCacheTTL(0),
+	)
+	require.NoError(t, err)
+
+	migrator := osqueue.NewShardMigrator(
+		registry,
+		osqueue.WithShardMigratorPollInterval(10*time.Millisecond),
+		osqueue.WithShardMigratorRouteCacheTTL(0),
+	)
+
+	acctID, wsID, fnID := uuid.New(), uuid.New(), uuid.New()
+	scope := osqueue.Scope{AccountID: acctID, EnvID: wsID, FunctionID: fnID}
+
+	var items []osqueue.QueueItem
+	for i := range 10 {
+		qi, err := shard1.EnqueueItem(ctx, osqueue.QueueItem{
+			ID:          fmt.Sprintf("item-%d", i),
+			FunctionID:  fnID,
+			WorkspaceID: wsID,
+			Data: osqueue.Item{
+				WorkspaceID: wsID,
+				Kind:        osqueue.KindEdge,
+				Identifier: state.Identifier{
+					AccountID:   acctID,
+					WorkspaceID: wsID,
+					WorkflowID:  fnID,
+				},
+			},
+		}, time.Now(), osqueue.EnqueueOpts{})
+		require.NoError(t, err)
+		items = append(items, qi)
+	}
+
+	countItems := func(shard osqueue.QueueShard) int {
+		all, err := shard.ItemsByPartition(ctx, scope, fnID.String(), time.Time{}, time.Now().Add(time.Hour), osqueue.WithQueueItemIterInterval(0))
+		if errors.Is(err, osqueue.ErrPartitionNotFound) {
+			return 0
+		}
+		require.NoError(t, err)
+		n := 0
+		for range all {
+			n++
+		}
+		return n
+	}
+
+	waitFor := func(id ulid.ULID, status osqueue.ShardMigrationStatus) osqueue.ShardMigration {
+		var m osqueue.ShardMigration
+		require.Eventually(t, func() bool {
+			var err error
+			m, err = migrator.ShardMigration(ctx, id)
+			require.NoError(t, err)
+			return m.Status == status
+		}, 5*time.Second, 10*time.Millisecond)
+		return m
+	}
+
+	// A leased item is in progress on the source shard and must not be moved.
+	leased := items[0]
+	leaseID, err := shard1.Lease(ctx, leased, 10*time.Second, time.Now())
+	require.NoError(t, err)
+	leased.LeaseID = leaseID
+
+	m, err := migrator.StartShardMigration(ctx, osqueue.ShardMigrationRequest{Scope: osqueue.Scope{AccountID: acctID}, TargetShard: shard2.Name()})
+	require.NoError(t, err)
+	require.Equal(t, shard1.Name(), m.SourceShard)
+
+	t.Run("only one migration runs per scope", func(t *testing.T) {
+		_, err := migrator.StartShardMigration(ctx, osqueue.ShardMigrationRequest{Scope: scope, TargetShard: shard2.Name()})
+		require.ErrorIs(t, err, osqueue.ErrShardMigrationInProgress)
+	})
+
+	t.Run("new items are routed to the target shard", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			resolved, err := registry.Resolve(ctx, scope, nil)
+			require.NoError(t, err)
+			return resolved.Name() == shard2.Name()
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("unleased items are moved while leased items drain", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return countItems(shard2) == 9
+		}, 5*time.Second, 10*time.Millisecond)
+
+		locked, err := shard1.IsMigrationLocked(ctx, scope)
+		require.NoError(t, err)
+		require.NotNil(t, locked)
+
+		current, err := migrator.ShardMigration(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, osqueue.ShardMigrationStatusRunning, current.Status)
+		require.EqualValues(t, 1, current.InProgress)
+	})
+
+	t.Run("the migration completes once leased items finish", func(t *testing.T) {
+		require.NoError(t, shard1.Dequeue(ctx, leased))
+
+		done := waitFor(m.ID, osqueue.ShardMigrationStatusCompleted)
+		require.EqualValues(t, 9, done.ItemsMoved)
+		require.Equal(t, 0, countItems(shard1))
+
+		locked, err := shard1.IsMigrationLocked(ctx, scope)
+		require.NoError(t, err)
+		require.Nil(t, locked)
+	})
+
+	t.Run("rollback moves items back to the source shard", func(t *testing.T) {
+		rb, err := migrator.RollbackShardMigration(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, shard2.Name(), rb.SourceShard)
+		require.Equal(t, shard1.Name(), rb.TargetShard)
+		require.Equal(t, m.ID, *rb.RollbackOf)
+
+		done := waitFor(rb.ID, osqueue.ShardMigrationStatusCompleted)
+		require.EqualValues(t, 9, done.ItemsMoved)
+		require.Equal(t, 9, countItems(shard1))
+		require.Equal(t, 0, countItems(shard2))
+
+		resolved, err := registry.Resolve(ctx, scope, nil)
+		require.NoError(t, err)
+		require.Equal(t, shard1.Name(), resolved.Name())
+
+		route, err := shard1.ShardRoute(ctx, scope)
+		require.NoError(t, err)
+		require.Empty(t, route)
+
+		orig, err := migrator.ShardMigration(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, osqueue.ShardMigrationStatusRolledBack, orig.Status)
+		require.Equal(t, rb.ID, *orig.RolledBackBy)
+	})
+	t.Run("migrations are stored on the source shard", func(t *testing.T) {
+		// A new migrator, eg. after a restart, reads migrations from the shards.
+		restarted := osqueue.NewShardMigrator(registry)
+
+		all, err := restarted.ShardMigrations(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		require.Equal(t, m.ID, all[0].ID)
+		require.Equal(t, osqueue.ShardMigrationStatusRolledBack, all[0].Status)
+		require.Equal(t, osqueue.ShardMigrationStatusCompleted, all[1].Status)
+
+		stored, err := shard1.ShardMigrations(ctx)
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, m.ID, stored[0].ID)
+	})
+
+	t.Run("abandoned migrations are reported as failed", func(t *testing.T) {
+		stale := osqueue.ShardMigration{
+			ID:          ulid.Make(),
+			Scope:       osqueue.Scope{AccountID: uuid.New()},
+			SourceShard: shard1.Name(),
+			TargetShard: shard2.Name(),
+			Status:      osqueue.ShardMigrationStatusRunning,
+			Phase:       osqueue.ShardMigrationPhaseDraining,
+			StartedAt:   time.Now().Add(-time.Hour),
+			UpdatedAt:   time.Now().Add(-time.Hour),
+		}
+		require.NoError(t, shard1.SaveShardMigration(ctx, stale))
+
+		found, err := migrator.ShardMigration(ctx, stale.ID)
+		require.NoError(t, err)
+		require.Equal(t, osqueue.ShardMigrationStatusFailed, found.Status)
+		require.Contains(t, found.Error, "abandoned")
+
+		// The scope is no longer considered to be migrating.
+		_, err = migrator.StartShardMigration(ctx, osqueue.ShardMigrationRequest{
+			Scope:       stale.Scope,
+			TargetShard: shard2.Name(),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("preflight checks refuse migrations", func(t *testing.T) {
+		refusing := osqueue.NewShardMigrator(registry, osqueue.WithShardMigratorPreflight(func(ctx context.Context, scope osqueue.Scope) error {
+			return fmt.Errorf("%w: uses debounce", osqueue.ErrShardMigrationUnsupported)
+		}))
+		_, err := refusing.StartShardMigration(ctx, osqueue.ShardMigrationRequest{
+			Scope:       osqueue.Scope{AccountID: uuid.New()},
+			TargetShard: shard2.Name(),
+		})
+		require.ErrorIs(t, err, osqueue.ErrShardMigrationUnsupported)
+	})
+}
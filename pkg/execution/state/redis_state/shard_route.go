@@ -0,0 +1,126 @@
+package redis_state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/telemetry/redis_telemetry"
+)
+
+// shardRouteAccountField is the field within the shard routes hash storing the
+// account-wide route.
+const shardRouteAccountField = "-"
+
+func shardRouteField(scope osqueue.Scope) string {
+	if scope.FunctionID == uuid.Nil {
+		return shardRouteAccountField
+	}
+	return scope.FunctionID.String()
+}
+
+// ShardRoute implements ShardOperations.
+func (q *queue) ShardRoute(ctx context.Context, scope osqueue.Scope) (string, error) {
+	ctx = redis_telemetry.WithScope(redis_telemetry.WithOpName(ctx, "ShardRoute"), redis_telemetry.ScopeQueue)
+
+	client := q.RedisClient.Client()
+	kg := q.RedisClient.KeyGenerator()
+
+	cmd := client.B().Hmget().Key(kg.ShardRoutes(scope.AccountID)).Field(shardRouteField(scope), shardRouteAccountField).Build()
+	vals, err := client.Do(ctx, cmd).ToArray()
+	if err != nil {
+		return "", fmt.Errorf("could not load shard route: %w", err)
+	}
+
+	// The function route, if any, comes first and takes precedence.
+	for _, v := range vals {
+		name, err := v.ToString()
+		if err == nil && name != "" {
+			return name, nil
+		}
+	}
+	return "", nil
+}
+
+// SetShardRoute implements ShardOperations.
+func (q *queue) SetShardRoute(ctx context.Context, scope osqueue.Scope, shardName string) error {
+	ctx = redis_telemetry.WithScope(redis_telemetry.WithOpName(ctx, "SetShardRoute"), redis_telemetry.ScopeQueue)
+
+	client := q.RedisClient.Client()
+	kg := q.RedisClient.KeyGenerator()
+
+	key := kg.ShardRoutes(scope.AccountID)
+	if shardName == "" {
+		cmd := client.B().Hdel().Key(key).Field(shardRouteField(scope)).Build()
+		if err := client.Do(ctx, cmd).Error(); err != nil {
+			return fmt.Errorf("could not remove shard route: %w", err)
+		}
+		return nil
+	}
+
+	cmd := client.B().Hset().Key(key).FieldValue().FieldValue(shardRouteField(scope), shardName).Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("could not set shard route: %w", err)
+	}
+	return nil
+}
+
+// SaveShardMigration implements ShardOperations.
+func (q *queue) SaveShardMigration(ctx context.Context, m osqueue.ShardMigration) error {
+	ctx = redis_telemetry.WithScope(redis_telemetry.WithOpName(ctx, "SaveShardMigration"), redis_telemetry.ScopeQueue)
+
+	client := q.RedisClient.Client()
+	kg := q.RedisClient.KeyGenerator()
+
+	byt, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("could not marshal shard migration: %w", err)
+	}
+
+	cmd := client.B().Hset().Key(kg.ShardMigrations()).FieldValue().FieldValue(m.ID.String(), string(byt)).Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("could not save shard migration: %w", err)
+	}
+	return nil
+}
+
+// ShardMigrations implements ShardOperations.  Migrations which finished more
+// than ShardMigrationRetention ago are removed.
+func (q *queue) ShardMigrations(ctx context.Context) ([]osqueue.ShardMigration, error) {
+	ctx = redis_telemetry.WithScope(redis_telemetry.WithOpName(ctx, "ShardMigrations"), redis_telemetry.ScopeQueue)
+
+	client := q.RedisClient.Client()
+	kg := q.RedisClient.KeyGenerator()
+
+	vals, err := client.Do(ctx, client.B().Hgetall().Key(kg.ShardMigrations()).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not load shard migrations: %w", err)
+	}
+
+	var (
+		result  []osqueue.ShardMigration
+		expired []string
+	)
+	for id, val := range vals {
+		var m osqueue.ShardMigration
+		if err := json.Unmarshal([]byte(val), &m); err != nil {
+			return nil, fmt.Errorf("could not unmarshal shard migration %s: %w", id, err)
+		}
+		if m.Done() && time.Since(m.FinishedAt) > osqueue.ShardMigrationRetention {
+			expired = append(expired, id)
+			continue
+		}
+		result = append(result, m)
+	}
+
+	if len(expired) > 0 {
+		cmd := client.B().Hdel().Key(kg.ShardMigrations()).Field(expired...).Build()
+		if err := client.Do(ctx, cmd).Error(); err != nil {
+			return nil, fmt.Errorf("could not remove expired shard migrations: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -153,6 +153,10 @@ type CustomConcurrency struct {
 	// UnhashedEvaluatedKeyValue stores the unhashed evaluated key value.
 	// This is only set after Schedule() or backlog normalization.
 	UnhashedEvaluatedKeyValue string `json:"-"`
+
+	// Weight is the fairness weight evaluated for the run when the function
+	// configures weighted concurrency fairness.  Zero means the default weight of 1.
+	Weight int `json:"w,omitempty"`
 }
 
 func (c CustomConcurrency) Validate() error {
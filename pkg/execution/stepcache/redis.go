@@ -0,0 +1,58 @@
+package stepcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/rueidis"
+)
+
+// DefaultPrefix is the default prefix for cached step outputs.  Entries are
+// independent of each other, so this intentionally has no hash tag and
+// entries are spread across slots.
+const DefaultPrefix = "stepcache"
+
+// NewRedisCache returns a Cache which stores step outputs in Redis, expiring
+// each output after its TTL.
+func NewRedisCache(r rueidis.Client, prefix string) Cache {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return redisCache{r: r, prefix: prefix}
+}
+
+type redisCache struct {
+	r      rueidis.Client
+	prefix string
+}
+
+func (r redisCache) Get(ctx context.Context, wsID, fnID uuid.UUID, key string) (json.RawMessage, error) {
+	data, err := r.r.Do(ctx, r.r.B().Get().Key(r.key(wsID, fnID, key)).Build()).AsBytes()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r redisCache) Set(ctx context.Context, wsID, fnID uuid.UUID, key string, output json.RawMessage, ttl time.Duration) error {
+	if err := Validate(key, ttl); err != nil {
+		return err
+	}
+	cmd := r.r.B().Set().Key(r.key(wsID, fnID, key)).Value(rueidis.BinaryString(output)).PxMilliseconds(ttl.Milliseconds()).Build()
+	return r.r.Do(ctx, cmd).Error()
+}
+
+// key hashes the user-defined cache key, bounding the length of Redis keys
+// regardless of the key's contents.
+func (r redisCache) key(wsID, fnID uuid.UUID, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%s:%s:%s:%s", r.prefix, wsID, fnID, hex.EncodeToString(sum[:]))
+}
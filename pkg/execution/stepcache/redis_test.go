@@ -0,0 +1,63 @@
+package stepcache
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCache(t *testing.T) (Cache, *miniredis.Miniredis) {
+	t.Helper()
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return NewRedisCache(rc, ""), r
+}
+
+func TestRedisCache(t *testing.T) {
+	ctx := context.Background()
+	c, r := newTestCache(t)
+	wsID, fnID := uuid.New(), uuid.New()
+	output := json.RawMessage(`{"data":{"enriched":true}}`)
+
+	_, err := c.Get(ctx, wsID, fnID, "user:1")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.Set(ctx, wsID, fnID, "user:1", output, time.Minute))
+
+	got, err := c.Get(ctx, wsID, fnID, "user:1")
+	require.NoError(t, err)
+	require.JSONEq(t, string(output), string(got))
+
+	t.Run("scoped to function", func(t *testing.T) {
+		_, err := c.Get(ctx, wsID, uuid.New(), "user:1")
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = c.Get(ctx, uuid.New(), fnID, "user:1")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		r.FastForward(time.Minute + time.Second)
+		_, err := c.Get(ctx, wsID, fnID, "user:1")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate("key", time.Hour))
+	require.ErrorIs(t, Validate("", time.Hour), ErrInvalidOpts)
+	require.ErrorIs(t, Validate(strings.Repeat("a", MaxKeyLength+1), time.Hour), ErrInvalidOpts)
+	require.ErrorIs(t, Validate("key", 0), ErrInvalidOpts)
+	require.ErrorIs(t, Validate("key", MaxTTL+time.Second), ErrInvalidOpts)
+}
@@ -0,0 +1,53 @@
+// Package stepcache stores the outputs of steps which opt into cross-run
+// caching, so that runs invoking the same step with the same cache key can
+// memoize the stored output instead of calling the SDK.
+package stepcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxTTL is the maximum time an output can be cached for.
+	MaxTTL = 30 * 24 * time.Hour
+	// MaxKeyLength is the maximum length of a user-defined cache key.
+	MaxKeyLength = 1024
+)
+
+var (
+	// ErrNotFound is returned when there's no cached output for a key.
+	ErrNotFound = errors.New("step cache entry not found")
+	// ErrInvalidOpts is returned when a step's cache key or TTL is invalid.
+	ErrInvalidOpts = errors.New("invalid step cache options")
+)
+
+// Cache stores step outputs by user-defined cache key.  Keys are scoped to a
+// function within a workspace, so that steps in different functions never
+// share outputs.
+type Cache interface {
+	// Get returns the cached output for the given key, or ErrNotFound.
+	Get(ctx context.Context, workspaceID, functionID uuid.UUID, key string) (json.RawMessage, error)
+	// Set caches the output for the given key, replacing any existing output.
+	Set(ctx context.Context, workspaceID, functionID uuid.UUID, key string, output json.RawMessage, ttl time.Duration) error
+}
+
+// Validate checks that a cache key and TTL can be stored.
+func Validate(key string, ttl time.Duration) error {
+	switch {
+	case key == "":
+		return fmt.Errorf("%w: key is required", ErrInvalidOpts)
+	case len(key) > MaxKeyLength:
+		return fmt.Errorf("%w: key cannot be longer than %d characters", ErrInvalidOpts, MaxKeyLength)
+	case ttl <= 0:
+		return fmt.Errorf("%w: ttl must be positive", ErrInvalidOpts)
+	case ttl > MaxTTL:
+		return fmt.Errorf("%w: ttl cannot exceed %s", ErrInvalidOpts, MaxTTL)
+	}
+	return nil
+}
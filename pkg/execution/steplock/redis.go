@@ -0,0 +1,152 @@
+package steplock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+)
+
+// DefaultPrefix is the default prefix for lock keys.  Keys are hash tagged by
+// environment, so that a run's locks can be released atomically.
+const DefaultPrefix = "steplock"
+
+// NewRedisLocker returns a Locker which stores locks in Redis.
+func NewRedisLocker(r rueidis.Client, prefix string) Locker {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return redisLocker{r: r, prefix: prefix}
+}
+
+type redisLocker struct {
+	r      rueidis.Client
+	prefix string
+}
+
+func (r redisLocker) Acquire(ctx context.Context, envID uuid.UUID, runID ulid.ULID, stepID, key string, lease time.Duration) (Result, error) {
+	if err := Validate(stepID, key, lease); err != nil {
+		return Result{}, err
+	}
+
+	hash := hashKey(key)
+	res, err := acquireScript.Exec(
+		ctx,
+		r.r,
+		[]string{r.lockKey(envID, hash), r.runKey(envID, runID)},
+		[]string{runID.String(), hash, stepID, fmt.Sprintf("%d", lease.Milliseconds())},
+	).AsIntSlice()
+	if err != nil {
+		return Result{}, fmt.Errorf("error acquiring lock: %w", err)
+	}
+	if len(res) != 2 {
+		return Result{}, fmt.Errorf("unexpected lock result: %v", res)
+	}
+
+	result := Result{Acquired: res[0] == 1}
+	if res[1] > 0 {
+		result.ExpiresAt = time.Now().Add(time.Duration(res[1]) * time.Millisecond)
+	}
+	return result, nil
+}
+
+func (r redisLocker) ReleaseStep(ctx context.Context, envID uuid.UUID, runID ulid.ULID, stepID string) error {
+	return r.release(ctx, envID, runID, stepID)
+}
+
+func (r redisLocker) ReleaseRun(ctx context.Context, envID uuid.UUID, runID ulid.ULID) error {
+	return r.release(ctx, envID, runID, "")
+}
+
+// release releases the run's locks held for the given step, or every lock
+// held by the run if stepID is empty.
+func (r redisLocker) release(ctx context.Context, envID uuid.UUID, runID ulid.ULID, stepID string) error {
+	err := releaseScript.Exec(
+		ctx,
+		r.r,
+		[]string{r.runKey(envID, runID)},
+		[]string{runID.String(), r.lockKey(envID, ""), stepID},
+	).Error()
+	if err != nil {
+		return fmt.Errorf("error releasing locks: %w", err)
+	}
+	return nil
+}
+
+func (r redisLocker) lockKey(envID uuid.UUID, hash string) string {
+	return fmt.Sprintf("{%s:%s}:lock:%s", r.prefix, envID, hash)
+}
+
+func (r redisLocker) runKey(envID uuid.UUID, runID ulid.ULID) string {
+	return fmt.Sprintf("{%s:%s}:run:%s", r.prefix, envID, runID)
+}
+
+// hashKey hashes the user-defined lock key, bounding the length of Redis keys
+// regardless of the key's contents.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	acquireScript = rueidis.NewLuaScript(`
+--[[
+
+Output:
+  {1, lease}: Lock acquired or renewed, with the lease in milliseconds
+  {0, ttl}:   Lock held by another run, with the remaining lease in milliseconds
+
+]]
+
+local keyLock = KEYS[1]
+local keyRun  = KEYS[2]
+
+local runID   = ARGV[1]
+local hash    = ARGV[2]
+local stepID  = ARGV[3]
+local leaseMS = tonumber(ARGV[4])
+
+local holder = redis.call("GET", keyLock)
+if holder ~= false and holder ~= runID then
+	return {0, redis.call("PTTL", keyLock)}
+end
+
+redis.call("SET", keyLock, runID, "PX", leaseMS)
+-- The run's held locks map each lock to the step it's held around.
+redis.call("HSET", keyRun, hash, stepID)
+-- The run's held locks must live at least as long as any lock it holds.
+if redis.call("PTTL", keyRun) < leaseMS then
+	redis.call("PEXPIRE", keyRun, leaseMS)
+end
+return {1, leaseMS}
+	`)
+
+	releaseScript = rueidis.NewLuaScript(`
+local keyRun     = KEYS[1]
+
+local runID      = ARGV[1]
+local lockPrefix = ARGV[2]
+-- If empty, every lock held by the run is released.
+local stepID     = ARGV[3]
+
+local held = redis.call("HGETALL", keyRun)
+for i = 1, #held, 2 do
+	local hash = held[i]
+	if stepID == "" or held[i + 1] == stepID then
+		local keyLock = lockPrefix .. hash
+		-- Only release locks still held by this run;  the lease may have
+		-- expired and been granted to another run.
+		if redis.call("GET", keyLock) == runID then
+			redis.call("DEL", keyLock)
+		end
+		redis.call("HDEL", keyRun, hash)
+	end
+end
+return 0
+	`)
+)
@@ -0,0 +1,126 @@
+package steplock
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocker(t *testing.T) (Locker, *miniredis.Miniredis) {
+	t.Helper()
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return NewRedisLocker(rc, ""), r
+}
+
+func TestRedisLocker(t *testing.T) {
+	ctx := context.Background()
+	l, r := newTestLocker(t)
+	envID := uuid.New()
+	runA, runB := ulid.Make(), ulid.Make()
+
+	res, err := l.Acquire(ctx, envID, runA, "step", "account:1", time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Acquired)
+
+	t.Run("re-entrant for the holder", func(t *testing.T) {
+		res, err := l.Acquire(ctx, envID, runA, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, res.Acquired)
+	})
+
+	t.Run("other runs wait for the lease", func(t *testing.T) {
+		res, err := l.Acquire(ctx, envID, runB, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.False(t, res.Acquired)
+		require.WithinDuration(t, time.Now().Add(time.Minute), res.ExpiresAt, time.Second)
+	})
+
+	t.Run("scoped to environment", func(t *testing.T) {
+		res, err := l.Acquire(ctx, uuid.New(), runB, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, res.Acquired)
+	})
+
+	t.Run("released with the run", func(t *testing.T) {
+		require.NoError(t, l.ReleaseRun(ctx, envID, runA))
+		res, err := l.Acquire(ctx, envID, runB, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, res.Acquired)
+
+		// Releasing again must not release locks now held by other runs.
+		require.NoError(t, l.ReleaseRun(ctx, envID, runA))
+		res, err = l.Acquire(ctx, envID, runA, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.False(t, res.Acquired)
+	})
+
+	t.Run("granted once the lease expires", func(t *testing.T) {
+		r.FastForward(time.Minute + time.Second)
+		res, err := l.Acquire(ctx, envID, runA, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, res.Acquired)
+
+		// The previous holder's release doesn't affect the new holder.
+		require.NoError(t, l.ReleaseRun(ctx, envID, runB))
+		res, err = l.Acquire(ctx, envID, runB, "step", "account:1", time.Minute)
+		require.NoError(t, err)
+		require.False(t, res.Acquired)
+	})
+}
+
+func TestRedisLocker_ReleaseStep(t *testing.T) {
+	ctx := context.Background()
+	l, _ := newTestLocker(t)
+	envID := uuid.New()
+	runA, runB := ulid.Make(), ulid.Make()
+
+	for step, key := range map[string]string{"a": "account:1", "b": "account:2"} {
+		res, err := l.Acquire(ctx, envID, runA, step, key, time.Minute)
+		require.NoError(t, err)
+		require.True(t, res.Acquired)
+	}
+
+	// Only the completed step's lock is released.
+	require.NoError(t, l.ReleaseStep(ctx, envID, runA, "a"))
+	res, err := l.Acquire(ctx, envID, runB, "c", "account:1", time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Acquired)
+	res, err = l.Acquire(ctx, envID, runB, "c", "account:2", time.Minute)
+	require.NoError(t, err)
+	require.False(t, res.Acquired)
+
+	// Releasing the run releases the remaining locks.
+	require.NoError(t, l.ReleaseRun(ctx, envID, runA))
+	res, err = l.Acquire(ctx, envID, runB, "c", "account:2", time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Acquired)
+}
+
+func TestResultRetryAt(t *testing.T) {
+	now := time.Now()
+	require.Equal(t, now.Add(PollInterval), Result{}.RetryAt(now))
+	require.Equal(t, now.Add(PollInterval), Result{ExpiresAt: now.Add(time.Hour)}.RetryAt(now))
+	require.Equal(t, now.Add(time.Second), Result{ExpiresAt: now.Add(time.Second)}.RetryAt(now))
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate("step", "key", time.Minute))
+	require.ErrorIs(t, Validate("", "key", time.Minute), ErrInvalidOpts)
+	require.ErrorIs(t, Validate("step", "", time.Minute), ErrInvalidOpts)
+	require.ErrorIs(t, Validate("step", strings.Repeat("a", MaxKeyLength+1), time.Minute), ErrInvalidOpts)
+	require.ErrorIs(t, Validate("step", "key", 0), ErrInvalidOpts)
+	require.ErrorIs(t, Validate("step", "key", MaxLease+time.Second), ErrInvalidOpts)
+}
@@ -0,0 +1,78 @@
+// Package steplock manages named locks which runs hold around individual
+// steps.  A run acquires a lock for a step via OpcodeLockAcquire and holds it
+// until that step completes or fails, the run finishes, or the lock's lease
+// expires.
+package steplock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// MaxLease is the maximum lease for a lock.
+	MaxLease = 24 * time.Hour
+	// MaxKeyLength is the maximum length of a lock's key.
+	MaxKeyLength = 1024
+	// PollInterval is the maximum interval between attempts to acquire a lock
+	// which is held by another run.
+	PollInterval = 5 * time.Second
+)
+
+// ErrInvalidOpts is returned when a lock's key or lease is invalid.
+var ErrInvalidOpts = errors.New("invalid lock options")
+
+// Result is the result of attempting to acquire a lock.
+type Result struct {
+	// Acquired is true if the run now holds the lock.
+	Acquired bool
+	// ExpiresAt is when the lock's current lease expires, regardless of which
+	// run holds the lock.
+	ExpiresAt time.Time
+}
+
+// RetryAt returns when a run waiting on the lock should next attempt to
+// acquire it.
+func (r Result) RetryAt(now time.Time) time.Time {
+	if r.ExpiresAt.IsZero() || r.ExpiresAt.After(now.Add(PollInterval)) {
+		return now.Add(PollInterval)
+	}
+	return r.ExpiresAt
+}
+
+// Locker acquires and releases step locks.  Locks are scoped to an
+// environment.
+type Locker interface {
+	// Acquire attempts to acquire the lock for the given run's step.  This is
+	// re-entrant:  if the run already holds the lock, its lease is renewed and
+	// the lock is moved to the given step.
+	Acquire(ctx context.Context, envID uuid.UUID, runID ulid.ULID, stepID, key string, lease time.Duration) (Result, error)
+	// ReleaseStep releases the locks the given run holds for the given step.
+	// This is a no-op if the step holds no locks.
+	ReleaseStep(ctx context.Context, envID uuid.UUID, runID ulid.ULID, stepID string) error
+	// ReleaseRun releases every lock held by the given run.  This is a no-op
+	// if the run holds no locks.
+	ReleaseRun(ctx context.Context, envID uuid.UUID, runID ulid.ULID) error
+}
+
+// Validate checks that a lock's step, key and lease are valid.
+func Validate(stepID, key string, lease time.Duration) error {
+	switch {
+	case stepID == "":
+		return fmt.Errorf("%w: step is required", ErrInvalidOpts)
+	case key == "":
+		return fmt.Errorf("%w: key is required", ErrInvalidOpts)
+	case len(key) > MaxKeyLength:
+		return fmt.Errorf("%w: key cannot be longer than %d characters", ErrInvalidOpts, MaxKeyLength)
+	case lease <= 0:
+		return fmt.Errorf("%w: lease must be positive", ErrInvalidOpts)
+	case lease > MaxLease:
+		return fmt.Errorf("%w: lease cannot exceed %s", ErrInvalidOpts, MaxLease)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	statev1 "github.com/inngest/inngest/pkg/execution/state"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/oklog/ulid/v2"
+)
+
+// NewLifecycleListener returns a lifecycle listener which queues deliveries
+// for every endpoint matching a run's events.  Deliveries are sent by the
+// webhook Service.
+func NewLifecycleListener(store Store) execution.LifecycleListener {
+	return &lifecycle{store: store, now: time.Now}
+}
+
+type lifecycle struct {
+	execution.NoopLifecyceListener
+
+	store Store
+	now   func() time.Time
+}
+
+// OnFunctionStarted schedules duration notifications, which are sent once
+// each endpoint's threshold passes unless the run ends first.
+func (l *lifecycle) OnFunctionStarted(ctx context.Context, md statev2.Metadata, _ queue.Item, _ []json.RawMessage) {
+	startedAt := l.now()
+	l.notify(ctx, md, EventRunDurationExceeded, func(e Endpoint) (NotificationRun, time.Time) {
+		run := newNotificationRun(md, enums.RunStatusRunning, startedAt)
+		run.DurationMS = e.DurationThreshold.Milliseconds()
+		return run, startedAt.Add(e.DurationThreshold)
+	})
+}
+
+func (l *lifecycle) OnFunctionFinished(ctx context.Context, md statev2.Metadata, _ queue.Item, _ []json.RawMessage, resp statev1.DriverResponse) {
+	l.cancelDurationNotifications(ctx, md)
+	if resp.Err == nil {
+		return
+	}
+
+	now := l.now()
+	l.notify(ctx, md, EventRunFailed, func(Endpoint) (NotificationRun, time.Time) {
+		run := newNotificationRun(md, enums.RunStatusFailed, now)
+		run.Error = *resp.Err
+		return run, now
+	})
+}
+
+func (l *lifecycle) OnFunctionCancelled(ctx context.Context, md statev2.Metadata, _ execution.CancelRequest, _ []json.RawMessage) {
+	l.cancelDurationNotifications(ctx, md)
+
+	now := l.now()
+	l.notify(ctx, md, EventRunCancelled, func(Endpoint) (NotificationRun, time.Time) {
+		return newNotificationRun(md, enums.RunStatusCancelled, now), now
+	})
+}
+
+func (l *lifecycle) cancelDurationNotifications(ctx context.Context, md statev2.Metadata) {
+	if err := l.store.CancelRunDeliveries(ctx, md.ID.RunID); err != nil {
+		logger.StdlibLogger(ctx).Error("error cancelling webhook deliveries", "error", err, "run_id", md.ID.RunID)
+	}
+}
+
+// notify queues a delivery of the given event type for every matching
+// endpoint in the run's workspace.  build returns the run details sent to the
+// endpoint and when the delivery should first be attempted.
+func (l *lifecycle) notify(ctx context.Context, md statev2.Metadata, t EventType, build func(e Endpoint) (NotificationRun, time.Time)) {
+	log := logger.StdlibLogger(ctx).With("run_id", md.ID.RunID, "type", t)
+
+	endpoints, err := l.store.ListEndpoints(ctx, md.ID.Tenant.EnvID)
+	if err != nil {
+		log.Error("error loading webhook endpoints", "error", err)
+		return
+	}
+
+	for _, e := range endpoints {
+		if !e.Subscribed(t) {
+			continue
+		}
+
+		run, at := build(e)
+		n := Notification{
+			ID:        ulid.MustNew(ulid.Timestamp(at), rand.Reader),
+			Type:      t,
+			CreatedAt: at,
+			Run:       &run,
+		}
+		if err := enqueue(ctx, l.store, e, n, md.ID.RunID, at, l.now()); err != nil {
+			log.Error("error queueing webhook delivery", "error", err, "endpoint_id", e.ID)
+		}
+	}
+}
+
+// Publish queues a delivery of the notification for every matching endpoint
+// in the workspace.  It's used for events which aren't created by the
+// lifecycle listener, such as alerts.
+func Publish(ctx context.Context, store Store, workspaceID uuid.UUID, n Notification) error {
+	endpoints, err := store.ListEndpoints(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("error loading webhook endpoints: %w", err)
+	}
+
+	now := time.Now()
+	for _, e := range endpoints {
+		if !e.Subscribed(n.Type) {
+			continue
+		}
+		if err := enqueue(ctx, store, e, n, ulid.ULID{}, now, now); err != nil {
+			return fmt.Errorf("error queueing webhook delivery: %w", err)
+		}
+	}
+	return nil
+}
+
+// enqueue queues a delivery of the notification to the endpoint, to be first
+// attempted at the given time, if the notification matches the endpoint's
+// filter.  Filter errors are logged and the notification is skipped, as they
+// are caused by the endpoint's configuration.
+func enqueue(ctx context.Context, store Store, e Endpoint, n Notification, runID ulid.ULID, at, now time.Time) error {
+	ok, err := e.Matches(ctx, n)
+	if err != nil {
+		logger.StdlibLogger(ctx).Warn("error evaluating webhook filter", "error", err, "endpoint_id", e.ID, "type", n.Type)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("error encoding webhook notification: %w", err)
+	}
+
+	return store.Enqueue(ctx, Delivery{
+		ID:            ulid.MustNew(ulid.Timestamp(now), rand.Reader),
+		EndpointID:    e.ID,
+		WorkspaceID:   e.WorkspaceID,
+		RunID:         runID,
+		Type:          n.Type,
+		Payload:       payload,
+		Status:        DeliveryStatusPending,
+		NextAttemptAt: at,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+}
+
+func newNotificationRun(md statev2.Metadata, status enums.RunStatus, now time.Time) NotificationRun {
+	startedAt := md.Config.StartedAt
+	if startedAt.IsZero() {
+		startedAt = ulid.Time(md.ID.RunID.Time())
+	}
+	if startedAt.After(now) {
+		startedAt = now
+	}
+	return NotificationRun{
+		ID:           md.ID.RunID,
+		AppID:        md.ID.Tenant.AppID,
+		FunctionID:   md.ID.FunctionID,
+		FunctionSlug: md.Config.FunctionSlug(),
+		Status:       status.String(),
+		StartedAt:    startedAt,
+		DurationMS:   now.Sub(startedAt).Milliseconds(),
+	}
+}
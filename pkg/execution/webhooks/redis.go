@@ -0,0 +1,327 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+)
+
+const DefaultPrefix = "{webhooks}"
+
+// NewRedisStore returns a Store which persists endpoints and deliveries in
+// Redis.  All keys share the given prefix, which must contain a hash tag so
+// that keys are stored within the same slot.
+func NewRedisStore(r rueidis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return redisStore{r: r, prefix: prefix}
+}
+
+type redisStore struct {
+	r      rueidis.Client
+	prefix string
+}
+
+// Endpoints are stored in a hash per workspace, keyed by endpoint ID.  Each
+// delivery is stored as a JSON string which expires after DeliveryRetention,
+// and is indexed in its endpoint's delivery log, a lexically sorted set of
+// delivery IDs.  The queue is a sorted set of pending delivery IDs scored by
+// the time of their next attempt.
+
+func (r redisStore) CreateEndpoint(ctx context.Context, e Endpoint) error {
+	return r.UpdateEndpoint(ctx, e)
+}
+
+func (r redisStore) GetEndpoint(ctx context.Context, wsID uuid.UUID, id ulid.ULID) (*Endpoint, error) {
+	data, err := r.r.Do(ctx, r.r.B().Hget().Key(r.endpointsKey(wsID)).Field(id.String()).Build()).ToString()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrEndpointNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Endpoint{}
+	if err := json.Unmarshal([]byte(data), e); err != nil {
+		return nil, fmt.Errorf("error decoding webhook endpoint: %w", err)
+	}
+	return e, nil
+}
+
+func (r redisStore) ListEndpoints(ctx context.Context, wsID uuid.UUID) ([]Endpoint, error) {
+	vals, err := r.r.Do(ctx, r.r.B().Hvals().Key(r.endpointsKey(wsID)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Endpoint, 0, len(vals))
+	for _, data := range vals {
+		e := Endpoint{}
+		if err := json.Unmarshal([]byte(data), &e); err != nil {
+			return nil, fmt.Errorf("error decoding webhook endpoint: %w", err)
+		}
+		result = append(result, e)
+	}
+	slices.SortFunc(result, func(a, b Endpoint) int {
+		return a.ID.Compare(b.ID)
+	})
+	return result, nil
+}
+
+func (r redisStore) UpdateEndpoint(ctx context.Context, e Endpoint) error {
+	byt, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return r.r.Do(ctx, r.r.B().Hset().Key(r.endpointsKey(e.WorkspaceID)).FieldValue().FieldValue(e.ID.String(), string(byt)).Build()).Error()
+}
+
+func (r redisStore) DeleteEndpoint(ctx context.Context, wsID uuid.UUID, id ulid.ULID) error {
+	cmds := rueidis.Commands{
+		r.r.B().Hdel().Key(r.endpointsKey(wsID)).Field(id.String()).Build(),
+		r.r.B().Del().Key(r.logKey(id)).Build(),
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) Enqueue(ctx context.Context, d Delivery) error {
+	byt, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	ttl := max(time.Until(d.NextAttemptAt), 0) + DeliveryRetention
+	// Trim deliveries older than the retention period from the log.  Their
+	// data has already expired.
+	cutoff := ulid.ULID{}
+	_ = cutoff.SetTime(ulid.Timestamp(time.Now().Add(-DeliveryRetention)))
+
+	cmds := rueidis.Commands{
+		r.r.B().Set().Key(r.deliveryKey(d.ID)).Value(string(byt)).PxMilliseconds(ttl.Milliseconds()).Build(),
+		r.r.B().Zadd().Key(r.logKey(d.EndpointID)).ScoreMember().ScoreMember(0, d.ID.String()).Build(),
+		r.r.B().Zremrangebylex().Key(r.logKey(d.EndpointID)).Min("-").Max("(" + cutoff.String()).Build(),
+		r.r.B().Zadd().Key(r.queueKey()).ScoreMember().ScoreMember(float64(d.NextAttemptAt.UnixMilli()), d.ID.String()).Build(),
+	}
+	if d.Type == EventRunDurationExceeded && d.ReplayOf == nil {
+		cmds = append(cmds,
+			r.r.B().Sadd().Key(r.runKey(d.RunID)).Member(d.ID.String()).Build(),
+			r.r.B().Pexpire().Key(r.runKey(d.RunID)).Milliseconds(ttl.Milliseconds()).Build(),
+		)
+	}
+	for _, resp := range r.r.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r redisStore) GetDelivery(ctx context.Context, id ulid.ULID) (*Delivery, error) {
+	data, err := r.r.Do(ctx, r.r.B().Get().Key(r.deliveryKey(id)).Build()).ToString()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Delivery{}
+	if err := json.Unmarshal([]byte(data), d); err != nil {
+		return nil, fmt.Errorf("error decoding webhook delivery: %w", err)
+	}
+	return d, nil
+}
+
+func (r redisStore) ListDeliveries(ctx context.Context, endpointID ulid.ULID, opts ListOpts) ([]Delivery, bool, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	max := "+"
+	if opts.Cursor != nil {
+		max = "(" + opts.Cursor.String()
+	}
+
+	ids, err := r.r.Do(ctx, r.r.B().Zrevrangebylex().
+		Key(r.logKey(endpointID)).
+		Max(max).
+		Min("-").
+		Limit(0, int64(limit+1)).
+		Build(),
+	).AsStrSlice()
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(ids) > limit
+	if hasMore {
+		ids = ids[:limit]
+	}
+
+	result := make([]Delivery, 0, len(ids))
+	for _, s := range ids {
+		id, err := ulid.Parse(s)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid webhook delivery ID %q: %w", s, err)
+		}
+		d, err := r.GetDelivery(ctx, id)
+		if err == ErrDeliveryNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		result = append(result, *d)
+	}
+	return result, hasMore, nil
+}
+
+func (r redisStore) SaveDelivery(ctx context.Context, d Delivery) error {
+	byt, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	next := ""
+	if d.Status == DeliveryStatusPending {
+		next = strconv.FormatInt(d.NextAttemptAt.UnixMilli(), 10)
+	}
+	return saveScript.Exec(
+		ctx,
+		r.r,
+		[]string{r.deliveryKey(d.ID), r.queueKey(), r.runKey(d.RunID)},
+		[]string{d.ID.String(), string(byt), next},
+	).Error()
+}
+
+func (r redisStore) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]ulid.ULID, error) {
+	members, err := claimScript.Exec(
+		ctx,
+		r.r,
+		[]string{r.queueKey()},
+		[]string{
+			strconv.FormatInt(now.UnixMilli(), 10),
+			strconv.Itoa(limit),
+			strconv.FormatInt(now.Add(lease).UnixMilli(), 10),
+		},
+	).AsStrSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]ulid.ULID, 0, len(members))
+	for _, m := range members {
+		id, err := ulid.Parse(m)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r redisStore) CancelRunDeliveries(ctx context.Context, runID ulid.ULID) error {
+	return cancelScript.Exec(
+		ctx,
+		r.r,
+		[]string{r.runKey(runID), r.queueKey()},
+		[]string{r.prefix},
+	).Error()
+}
+
+func (r redisStore) endpointsKey(wsID uuid.UUID) string {
+	return fmt.Sprintf("%s:endpoints:%s", r.prefix, wsID)
+}
+
+func (r redisStore) deliveryKey(id ulid.ULID) string {
+	return fmt.Sprintf("%s:delivery:%s", r.prefix, id)
+}
+
+func (r redisStore) logKey(endpointID ulid.ULID) string {
+	return fmt.Sprintf("%s:log:%s", r.prefix, endpointID)
+}
+
+func (r redisStore) queueKey() string {
+	return fmt.Sprintf("%s:queue", r.prefix)
+}
+
+func (r redisStore) runKey(runID ulid.ULID) string {
+	return fmt.Sprintf("%s:run:%s", r.prefix, runID)
+}
+
+var (
+	// saveScript only updates deliveries which still exist, so that
+	// deliveries cancelled whilst being attempted are never recreated.
+	saveScript = rueidis.NewLuaScript(`
+local keyDelivery = KEYS[1]
+local keyQueue    = KEYS[2]
+local keyRun      = KEYS[3]
+
+local id   = ARGV[1]
+local data = ARGV[2]
+local next = ARGV[3]
+
+if redis.call("SET", keyDelivery, data, "XX", "KEEPTTL") == false then
+	redis.call("ZREM", keyQueue, id)
+	return 0
+end
+
+-- Deliveries which have been attempted can no longer be cancelled.
+redis.call("SREM", keyRun, id)
+
+if next == "" then
+	redis.call("ZREM", keyQueue, id)
+else
+	redis.call("ZADD", keyQueue, tonumber(next), id)
+end
+return 1
+	`)
+
+	claimScript = rueidis.NewLuaScript(`
+local keyQueue = KEYS[1]
+
+local now        = tonumber(ARGV[1])
+local limit      = tonumber(ARGV[2])
+local leaseUntil = tonumber(ARGV[3])
+
+local ids = redis.call("ZRANGEBYSCORE", keyQueue, "-inf", now, "LIMIT", 0, limit)
+for _, id in ipairs(ids) do
+	redis.call("ZADD", keyQueue, leaseUntil, id)
+end
+return ids
+	`)
+
+	cancelScript = rueidis.NewLuaScript(`
+local keyRun   = KEYS[1]
+local keyQueue = KEYS[2]
+
+local prefix = ARGV[1]
+
+local ids = redis.call("SMEMBERS", keyRun)
+for _, id in ipairs(ids) do
+	local data = redis.call("GET", prefix .. ":delivery:" .. id)
+	if data ~= false then
+		local d = cjson.decode(data)
+		redis.call("ZREM", prefix .. ":log:" .. d["endpoint_id"], id)
+		redis.call("DEL", prefix .. ":delivery:" .. id)
+	end
+	redis.call("ZREM", keyQueue, id)
+end
+redis.call("DEL", keyRun)
+return #ids
+	`)
+)
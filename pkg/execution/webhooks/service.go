@@ -0,0 +1,249 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/inngest/inngest/pkg/backoff"
+	"github.com/inngest/inngest/pkg/execution/driver/httpdriver"
+	"github.com/inngest/inngest/pkg/execution/exechttp"
+	"github.com/inngest/inngest/pkg/headers"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/service"
+	"github.com/oklog/ulid/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	DefaultPollInterval = time.Second
+	// DefaultTimeout is the maximum duration of a single delivery attempt.
+	DefaultTimeout = 30 * time.Second
+
+	// HeaderKeyDeliveryID identifies each delivery, and changes between
+	// replays of the same notification.
+	HeaderKeyDeliveryID = "X-Inngest-Delivery-Id"
+	// HeaderKeyEvent is the notification's event type.
+	HeaderKeyEvent = "X-Inngest-Event"
+
+	// leaseDuration is the duration a worker holds a delivery whilst
+	// attempting it, after which the delivery is attempted again.
+	leaseDuration = 2 * DefaultTimeout
+	// claimLimit is the maximum number of deliveries claimed per poll.
+	claimLimit = 100
+	// concurrency is the number of deliveries attempted concurrently.
+	concurrency = 10
+	// maxErrorBody is the amount of a failed response's body recorded in
+	// the attempt's error.
+	maxErrorBody = 512
+)
+
+type ServiceOpt func(s *svc)
+
+// WithPollInterval sets how often the service checks for due deliveries.
+func WithPollInterval(d time.Duration) ServiceOpt {
+	return func(s *svc) {
+		s.interval = d
+	}
+}
+
+// WithHTTPClient sets the client used to send deliveries.  By default
+// deliveries can only be sent to public addresses.
+func WithHTTPClient(c *http.Client) ServiceOpt {
+	return func(s *svc) {
+		s.client = c
+	}
+}
+
+// WithMaxAttempts sets the number of times a delivery is attempted before it
+// is marked as failed.
+func WithMaxAttempts(n int) ServiceOpt {
+	return func(s *svc) {
+		s.maxAttempts = n
+	}
+}
+
+// NewService returns a service which sends due deliveries, signing each
+// request with the endpoint's secret and retrying failed attempts with
+// backoff.  Deliveries are leased whilst being attempted, so many services
+// may run concurrently.
+func NewService(store Store, opts ...ServiceOpt) service.Service {
+	s := &svc{
+		store:       store,
+		interval:    DefaultPollInterval,
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     backoff.DefaultBackoff,
+		now:         time.Now,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	if s.client == nil {
+		s.client = &http.Client{
+			Timeout:       DefaultTimeout,
+			CheckRedirect: exechttp.CheckRedirect,
+			Transport:     exechttp.Transport(exechttp.SecureDialerOpts{}),
+		}
+	}
+	return s
+}
+
+type svc struct {
+	store       Store
+	client      *http.Client
+	interval    time.Duration
+	maxAttempts int
+	backoff     backoff.BackoffFunc
+	now         func() time.Time
+}
+
+func (s *svc) Name() string {
+	return "outbound-webhooks"
+}
+
+func (s *svc) Pre(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *svc) Run(ctx context.Context) error {
+	l := logger.StdlibLogger(ctx).With("service", s.Name())
+
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		if err := s.tick(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			l.Error("error sending webhook deliveries", "error", err)
+		}
+	}
+}
+
+// tick attempts every due delivery.
+func (s *svc) tick(ctx context.Context) error {
+	for {
+		ids, err := s.store.Claim(ctx, s.now(), claimLimit, leaseDuration)
+		if err != nil {
+			return fmt.Errorf("error claiming webhook deliveries: %w", err)
+		}
+
+		eg := errgroup.Group{}
+		eg.SetLimit(concurrency)
+		for _, id := range ids {
+			eg.Go(func() error {
+				if err := s.attempt(ctx, id); err != nil {
+					logger.StdlibLogger(ctx).Error("error attempting webhook delivery", "error", err, "delivery_id", id)
+				}
+				return nil
+			})
+		}
+		_ = eg.Wait()
+
+		if len(ids) < claimLimit || ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// attempt sends a single claimed delivery, recording the result.
+func (s *svc) attempt(ctx context.Context, id ulid.ULID) error {
+	d, err := s.store.GetDelivery(ctx, id)
+	if errors.Is(err, ErrDeliveryNotFound) {
+		// The delivery expired or was cancelled; SaveDelivery removes it
+		// from the queue.
+		return s.store.SaveDelivery(ctx, Delivery{ID: id})
+	}
+	if err != nil {
+		return err
+	}
+	if d.Status != DeliveryStatusPending {
+		return s.store.SaveDelivery(ctx, *d)
+	}
+
+	e, err := s.store.GetEndpoint(ctx, d.WorkspaceID, d.EndpointID)
+	switch {
+	case errors.Is(err, ErrEndpointNotFound):
+		return s.fail(ctx, *d, "endpoint was deleted")
+	case err != nil:
+		return err
+	case e.Disabled:
+		return s.fail(ctx, *d, "endpoint is disabled")
+	}
+
+	start := s.now()
+	status, sendErr := s.send(ctx, *e, *d)
+	a := Attempt{
+		At:         start,
+		StatusCode: status,
+		Duration:   s.now().Sub(start),
+	}
+	if sendErr != nil {
+		a.Error = sendErr.Error()
+	}
+	d.Attempts = append(d.Attempts, a)
+	d.UpdatedAt = s.now()
+
+	switch {
+	case sendErr == nil:
+		d.Status = DeliveryStatusSucceeded
+	case len(d.Attempts) >= s.maxAttempts:
+		d.Status = DeliveryStatusFailed
+	default:
+		d.NextAttemptAt = s.backoff(len(d.Attempts) - 1)
+	}
+	return s.store.SaveDelivery(context.WithoutCancel(ctx), *d)
+}
+
+func (s *svc) fail(ctx context.Context, d Delivery, reason string) error {
+	now := s.now()
+	d.Attempts = append(d.Attempts, Attempt{At: now, Error: reason})
+	d.Status = DeliveryStatusFailed
+	d.UpdatedAt = now
+	return s.store.SaveDelivery(ctx, d)
+}
+
+// send posts the delivery's payload to the endpoint, returning the response
+// status code and an error if the endpoint did not respond with a 2xx status.
+func (s *svc) send(ctx context.Context, e Endpoint, d Delivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headers.HeaderKeySignature, httpdriver.Sign(ctx, []byte(e.Secret), d.Payload))
+	req.Header.Set(HeaderKeyDeliveryID, d.ID.String())
+	req.Header.Set(HeaderKeyEvent, string(d.Type))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if len(body) > 0 {
+		return resp.StatusCode, fmt.Errorf("received status %d: %s", resp.StatusCode, body)
+	}
+	return resp.StatusCode, fmt.Errorf("received status %d", resp.StatusCode)
+}
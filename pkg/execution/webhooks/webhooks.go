@@ -0,0 +1,485 @@
+// Package webhooks delivers outbound webhooks, notifying external systems when
+// runs fail, are cancelled, or exceed a duration, and when alerts fire or
+// resolve.  Endpoints subscribe to events and optionally filter them using CEL
+// expressions.  Run notifications are created by the package's lifecycle
+// listener, other notifications via Publish, and all are delivered by its
+// Service, which signs each request and retries failed deliveries with
+// backoff.
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/expressions"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// DefaultMaxAttempts is the number of times a delivery is attempted before
+	// it is marked as failed.
+	DefaultMaxAttempts = 10
+	// DeliveryRetention is how long deliveries are kept in an endpoint's
+	// delivery log.
+	DeliveryRetention = 7 * 24 * time.Hour
+)
+
+var (
+	// ErrEndpointNotFound is returned when an endpoint does not exist within
+	// the given workspace.
+	ErrEndpointNotFound = errors.New("webhook endpoint not found")
+	// ErrDeliveryNotFound is returned when a delivery does not exist for the
+	// given endpoint.
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrDeliveryPending is returned when replaying a delivery which has not
+	// yet succeeded or failed.
+	ErrDeliveryPending = errors.New("webhook delivery is still pending")
+	// ErrInvalidEndpoint is returned when creating or updating an endpoint
+	// with an invalid configuration.
+	ErrInvalidEndpoint = errors.New("invalid webhook endpoint")
+)
+
+// EventType is the type of event that endpoints subscribe to.
+type EventType string
+
+const (
+	// EventRunFailed is sent when a run fails after exhausting its retries.
+	EventRunFailed EventType = "run.failed"
+	// EventRunCancelled is sent when a run is cancelled.
+	EventRunCancelled EventType = "run.cancelled"
+	// EventRunDurationExceeded is sent when a run is still running once the
+	// endpoint's duration threshold has passed.
+	EventRunDurationExceeded EventType = "run.duration_exceeded"
+	// EventAlertFired is sent when an alert rule's threshold is breached.
+	EventAlertFired EventType = "alert.fired"
+	// EventAlertResolved is sent when a fired alert's metric recovers.
+	EventAlertResolved EventType = "alert.resolved"
+)
+
+// EventTypes lists every event type that endpoints may subscribe to.
+var EventTypes = []EventType{
+	EventRunFailed,
+	EventRunCancelled,
+	EventRunDurationExceeded,
+	EventAlertFired,
+	EventAlertResolved,
+}
+
+// DeliveryStatus is the status of a single delivery.
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// Endpoint is a URL which receives notifications for the subscribed events.
+type Endpoint struct {
+	ID          ulid.ULID `json:"id"`
+	AccountID   uuid.UUID `json:"account_id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+
+	URL string `json:"url"`
+	// Secret is the signing key used to sign every request, allowing
+	// receivers to verify that requests come from Inngest.
+	Secret string      `json:"secret"`
+	Events []EventType `json:"events"`
+	// Filter is an optional CEL expression evaluated against each
+	// notification, eg. `run.function_slug == "app-charge" && run.status ==
+	// "Failed"` or `alert.metric == "failure_rate"`.  Notifications are only
+	// sent when the expression is true.
+	Filter string `json:"filter,omitempty"`
+	// DurationThreshold is the run duration after which
+	// EventRunDurationExceeded is sent.
+	DurationThreshold time.Duration `json:"duration_threshold,omitempty"`
+	Disabled          bool          `json:"disabled,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Subscribed returns whether the endpoint receives the given event type.
+func (e Endpoint) Subscribed(t EventType) bool {
+	return !e.Disabled && slices.Contains(e.Events, t)
+}
+
+// Validate checks the endpoint's configuration, returning an error wrapping
+// ErrInvalidEndpoint if the endpoint is invalid.
+func (e Endpoint) Validate(ctx context.Context) error {
+	u, err := url.Parse(e.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidEndpoint)
+	}
+	if len(e.Events) == 0 {
+		return fmt.Errorf("%w: at least one event is required", ErrInvalidEndpoint)
+	}
+	for _, t := range e.Events {
+		if !slices.Contains(EventTypes, t) {
+			return fmt.Errorf("%w: unknown event %q", ErrInvalidEndpoint, t)
+		}
+	}
+	if slices.Contains(e.Events, EventRunDurationExceeded) && e.DurationThreshold <= 0 {
+		return fmt.Errorf("%w: a duration threshold is required for %s", ErrInvalidEndpoint, EventRunDurationExceeded)
+	}
+	if e.DurationThreshold < 0 {
+		return fmt.Errorf("%w: duration threshold must be positive", ErrInvalidEndpoint)
+	}
+	if e.Filter != "" {
+		if _, err := expressions.NewBooleanEvaluator(ctx, e.Filter); err != nil {
+			return fmt.Errorf("%w: invalid filter: %w", ErrInvalidEndpoint, err)
+		}
+	}
+	return nil
+}
+
+// Notification is the JSON body sent to endpoints.
+type Notification struct {
+	// ID uniquely identifies the notification.  Replayed deliveries keep
+	// the original notification's ID, allowing receivers to deduplicate.
+	ID        ulid.ULID `json:"id"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	// Run is set for run events.
+	Run *NotificationRun `json:"run,omitempty"`
+	// Alert is set for alert events.
+	Alert *NotificationAlert `json:"alert,omitempty"`
+}
+
+// NotificationRun describes the run a notification is sent for.
+type NotificationRun struct {
+	ID           ulid.ULID `json:"id"`
+	AppID        uuid.UUID `json:"app_id"`
+	FunctionID   uuid.UUID `json:"function_id"`
+	FunctionSlug string    `json:"function_slug"`
+	// Status is the run's status when the notification was created, eg.
+	// "Failed", "Cancelled", or "Running".
+	Status     string    `json:"status"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMS int64     `json:"duration_ms"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// NotificationAlert describes the alert a notification is sent for.
+type NotificationAlert struct {
+	ID           ulid.ULID `json:"id"`
+	RuleID       ulid.ULID `json:"rule_id"`
+	RuleName     string    `json:"rule_name"`
+	FunctionID   uuid.UUID `json:"function_id"`
+	FunctionSlug string    `json:"function_slug"`
+	Metric       string    `json:"metric"`
+	Threshold    float64   `json:"threshold"`
+	// Value is the metric's value when the alert fired or resolved.
+	Value float64 `json:"value"`
+	// Status is either "firing" or "resolved".
+	Status     string     `json:"status"`
+	FiredAt    time.Time  `json:"fired_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// Matches returns whether the endpoint should receive the notification.
+func (e Endpoint) Matches(ctx context.Context, n Notification) (bool, error) {
+	if !e.Subscribed(n.Type) {
+		return false, nil
+	}
+	if e.Filter == "" {
+		return true, nil
+	}
+	data := map[string]any{"type": string(n.Type)}
+	if n.Run != nil {
+		data["run"] = map[string]any{
+			"id":            n.Run.ID.String(),
+			"app_id":        n.Run.AppID.String(),
+			"function_id":   n.Run.FunctionID.String(),
+			"function_slug": n.Run.FunctionSlug,
+			"status":        n.Run.Status,
+			"duration_ms":   n.Run.DurationMS,
+			"error":         n.Run.Error,
+		}
+	}
+	if n.Alert != nil {
+		data["alert"] = map[string]any{
+			"id":            n.Alert.ID.String(),
+			"rule_id":       n.Alert.RuleID.String(),
+			"rule_name":     n.Alert.RuleName,
+			"function_id":   n.Alert.FunctionID.String(),
+			"function_slug": n.Alert.FunctionSlug,
+			"metric":        n.Alert.Metric,
+			"threshold":     n.Alert.Threshold,
+			"value":         n.Alert.Value,
+			"status":        n.Alert.Status,
+		}
+	}
+	return expressions.EvaluateBoolean(ctx, e.Filter, data)
+}
+
+// Attempt records a single attempt to deliver a notification.
+type Attempt struct {
+	At time.Time `json:"at"`
+	// StatusCode is the response status code, or zero if no response was
+	// received.
+	StatusCode int           `json:"status_code,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// Delivery is a notification queued for, or delivered to, a single endpoint.
+type Delivery struct {
+	ID          ulid.ULID `json:"id"`
+	EndpointID  ulid.ULID `json:"endpoint_id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	// RunID is the run the notification is sent for, or zero for events
+	// which aren't about a single run.
+	RunID ulid.ULID `json:"run_id"`
+	Type  EventType `json:"type"`
+	// Payload is the JSON encoded Notification.
+	Payload []byte `json:"payload"`
+
+	Status   DeliveryStatus `json:"status"`
+	Attempts []Attempt      `json:"attempts,omitempty"`
+	// NextAttemptAt is when a pending delivery is next attempted.
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	// ReplayOf is the ID of the delivery that this delivery replays.
+	ReplayOf *ulid.ULID `json:"replay_of,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListOpts lists deliveries for an endpoint, newest first.
+type ListOpts struct {
+	// Cursor is the ID of the last delivery from the previous page.
+	Cursor *ulid.ULID
+	Limit  int
+}
+
+// Store persists endpoints, deliveries, and the delivery queue.
+type Store interface {
+	// CreateEndpoint stores a new endpoint.
+	CreateEndpoint(ctx context.Context, e Endpoint) error
+	// GetEndpoint loads an endpoint within a workspace, returning
+	// ErrEndpointNotFound if the endpoint doesn't exist.
+	GetEndpoint(ctx context.Context, wsID uuid.UUID, id ulid.ULID) (*Endpoint, error)
+	// ListEndpoints lists every endpoint within a workspace, oldest first.
+	ListEndpoints(ctx context.Context, wsID uuid.UUID) ([]Endpoint, error)
+	// UpdateEndpoint replaces an existing endpoint.
+	UpdateEndpoint(ctx context.Context, e Endpoint) error
+	// DeleteEndpoint deletes an endpoint.  Pending deliveries fail when
+	// next attempted.
+	DeleteEndpoint(ctx context.Context, wsID uuid.UUID, id ulid.ULID) error
+
+	// Enqueue stores a new pending delivery, adding it to the endpoint's
+	// delivery log and the queue at its NextAttemptAt time.
+	Enqueue(ctx context.Context, d Delivery) error
+	// GetDelivery loads a delivery, returning ErrDeliveryNotFound if the
+	// delivery doesn't exist.
+	GetDelivery(ctx context.Context, id ulid.ULID) (*Delivery, error)
+	// ListDeliveries lists an endpoint's deliveries, newest first.
+	ListDeliveries(ctx context.Context, endpointID ulid.ULID, opts ListOpts) ([]Delivery, bool, error)
+	// SaveDelivery stores the result of an attempt, requeueing the delivery
+	// at its NextAttemptAt time if it is still pending.
+	SaveDelivery(ctx context.Context, d Delivery) error
+	// Claim returns up to limit deliveries which are due, leasing each
+	// delivery by pushing it back in the queue by the lease duration.
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]ulid.ULID, error)
+	// CancelRunDeliveries removes deliveries for the given run which have
+	// not yet been attempted, eg. duration notifications for a run which
+	// finished before the threshold.
+	CancelRunDeliveries(ctx context.Context, runID ulid.ULID) error
+}
+
+// CreateEndpointOpts creates a new endpoint.
+type CreateEndpointOpts struct {
+	AccountID   uuid.UUID
+	WorkspaceID uuid.UUID
+	URL         string
+	// Secret is the signing key for the endpoint.  A random secret is
+	// generated if this is empty.
+	Secret            string
+	Events            []EventType
+	Filter            string
+	DurationThreshold time.Duration
+	Disabled          bool
+}
+
+// UpdateEndpointOpts updates an endpoint.  Nil fields are left unchanged.
+type UpdateEndpointOpts struct {
+	URL               *string
+	Events            []EventType
+	Filter            *string
+	DurationThreshold *time.Duration
+	Disabled          *bool
+}
+
+// Manager manages endpoints and their deliveries.
+type Manager interface {
+	CreateEndpoint(ctx context.Context, opts CreateEndpointOpts) (*Endpoint, error)
+	GetEndpoint(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Endpoint, error)
+	ListEndpoints(ctx context.Context, workspaceID uuid.UUID) ([]Endpoint, error)
+	UpdateEndpoint(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID, opts UpdateEndpointOpts) (*Endpoint, error)
+	DeleteEndpoint(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) error
+	// ListDeliveries lists an endpoint's deliveries, newest first.
+	ListDeliveries(ctx context.Context, workspaceID uuid.UUID, endpointID ulid.ULID, opts ListOpts) ([]Delivery, bool, error)
+	// Replay queues a new delivery of a succeeded or failed delivery's
+	// notification.
+	Replay(ctx context.Context, workspaceID uuid.UUID, endpointID, deliveryID ulid.ULID) (*Delivery, error)
+}
+
+// NewManager returns a Manager which stores endpoints and deliveries in the
+// given store.
+func NewManager(store Store) Manager {
+	return &manager{store: store, now: time.Now}
+}
+
+type manager struct {
+	store Store
+	now   func() time.Time
+}
+
+func (m *manager) CreateEndpoint(ctx context.Context, opts CreateEndpointOpts) (*Endpoint, error) {
+	now := m.now()
+
+	secret := opts.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	e := Endpoint{
+		ID:                id,
+		AccountID:         opts.AccountID,
+		WorkspaceID:       opts.WorkspaceID,
+		URL:               opts.URL,
+		Secret:            secret,
+		Events:            opts.Events,
+		Filter:            opts.Filter,
+		DurationThreshold: opts.DurationThreshold,
+		Disabled:          opts.Disabled,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if err := e.Validate(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.store.CreateEndpoint(ctx, e); err != nil {
+		return nil, fmt.Errorf("error creating webhook endpoint: %w", err)
+	}
+	return &e, nil
+}
+
+func (m *manager) GetEndpoint(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) (*Endpoint, error) {
+	return m.store.GetEndpoint(ctx, workspaceID, id)
+}
+
+func (m *manager) ListEndpoints(ctx context.Context, workspaceID uuid.UUID) ([]Endpoint, error) {
+	return m.store.ListEndpoints(ctx, workspaceID)
+}
+
+func (m *manager) UpdateEndpoint(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID, opts UpdateEndpointOpts) (*Endpoint, error) {
+	e, err := m.store.GetEndpoint(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.URL != nil {
+		e.URL = *opts.URL
+	}
+	if opts.Events != nil {
+		e.Events = opts.Events
+	}
+	if opts.Filter != nil {
+		e.Filter = *opts.Filter
+	}
+	if opts.DurationThreshold != nil {
+		e.DurationThreshold = *opts.DurationThreshold
+	}
+	if opts.Disabled != nil {
+		e.Disabled = *opts.Disabled
+	}
+	e.UpdatedAt = m.now()
+
+	if err := e.Validate(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.store.UpdateEndpoint(ctx, *e); err != nil {
+		return nil, fmt.Errorf("error updating webhook endpoint: %w", err)
+	}
+	return e, nil
+}
+
+func (m *manager) DeleteEndpoint(ctx context.Context, workspaceID uuid.UUID, id ulid.ULID) error {
+	if _, err := m.store.GetEndpoint(ctx, workspaceID, id); err != nil {
+		return err
+	}
+	return m.store.DeleteEndpoint(ctx, workspaceID, id)
+}
+
+func (m *manager) ListDeliveries(ctx context.Context, workspaceID uuid.UUID, endpointID ulid.ULID, opts ListOpts) ([]Delivery, bool, error) {
+	if _, err := m.store.GetEndpoint(ctx, workspaceID, endpointID); err != nil {
+		return nil, false, err
+	}
+	return m.store.ListDeliveries(ctx, endpointID, opts)
+}
+
+func (m *manager) Replay(ctx context.Context, workspaceID uuid.UUID, endpointID, deliveryID ulid.ULID) (*Delivery, error) {
+	if _, err := m.store.GetEndpoint(ctx, workspaceID, endpointID); err != nil {
+		return nil, err
+	}
+
+	orig, err := m.store.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if orig.EndpointID != endpointID {
+		return nil, ErrDeliveryNotFound
+	}
+	if orig.Status == DeliveryStatusPending {
+		return nil, ErrDeliveryPending
+	}
+
+	now := m.now()
+	id, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	d := Delivery{
+		ID:            id,
+		EndpointID:    orig.EndpointID,
+		WorkspaceID:   orig.WorkspaceID,
+		RunID:         orig.RunID,
+		Type:          orig.Type,
+		Payload:       orig.Payload,
+		Status:        DeliveryStatusPending,
+		NextAttemptAt: now,
+		ReplayOf:      &orig.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := m.store.Enqueue(ctx, d); err != nil {
+		return nil, fmt.Errorf("error replaying webhook delivery: %w", err)
+	}
+	return &d, nil
+}
+
+func newSecret() (string, error) {
+	byt := make([]byte, 32)
+	if _, err := rand.Read(byt); err != nil {
+		return "", fmt.Errorf("error generating webhook secret: %w", err)
+	}
+	return hex.EncodeToString(byt), nil
+}
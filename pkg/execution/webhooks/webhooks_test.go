@@ -0,0 +1,307 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	statev1 "github.com/inngest/inngest/pkg/execution/state"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/headers"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) Store {
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return NewRedisStore(rc, "")
+}
+
+func newMetadata(wsID uuid.UUID, slug string) statev2.Metadata {
+	md := statev2.Metadata{ID: statev2.ID{
+		RunID:      ulid.Make(),
+		FunctionID: uuid.New(),
+		Tenant: statev2.Tenant{
+			EnvID: wsID,
+			AppID: uuid.New(),
+		},
+	}}
+	md.Config = *statev2.InitConfig(&statev2.Config{})
+	md.Config.SetFunctionSlug(slug)
+	return md
+}
+
+// receiver records requests sent to it, responding with the given statuses
+// in order and then 200.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	if len(rc.statuses) > 0 {
+		w.WriteHeader(rc.statuses[0])
+		rc.statuses = rc.statuses[1:]
+	}
+}
+
+func TestEndpointValidation(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(newTestStore(t))
+	wsID := uuid.New()
+
+	tests := []struct {
+		name string
+		opts CreateEndpointOpts
+	}{
+		{"relative URL", CreateEndpointOpts{URL: "/hook", Events: []EventType{EventRunFailed}}},
+		{"no events", CreateEndpointOpts{URL: "https://example.com"}},
+		{"unknown event", CreateEndpointOpts{URL: "https://example.com", Events: []EventType{"run.completed"}}},
+		{"missing threshold", CreateEndpointOpts{URL: "https://example.com", Events: []EventType{EventRunDurationExceeded}}},
+		{"invalid filter", CreateEndpointOpts{URL: "https://example.com", Events: []EventType{EventRunFailed}, Filter: "run.status =="}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.opts.WorkspaceID = wsID
+			_, err := m.CreateEndpoint(ctx, test.opts)
+			require.ErrorIs(t, err, ErrInvalidEndpoint)
+		})
+	}
+
+	list, err := m.ListEndpoints(ctx, wsID)
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
+
+func TestManageEndpoints(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(newTestStore(t))
+	wsID := uuid.New()
+
+	e, err := m.CreateEndpoint(ctx, CreateEndpointOpts{
+		WorkspaceID: wsID,
+		URL:         "https://example.com/hook",
+		Events:      []EventType{EventRunFailed},
+	})
+	require.NoError(t, err)
+	require.Len(t, e.Secret, 64, "a secret should be generated")
+
+	_, err = m.GetEndpoint(ctx, uuid.New(), e.ID)
+	require.ErrorIs(t, err, ErrEndpointNotFound)
+
+	disabled, threshold := true, time.Minute
+	updated, err := m.UpdateEndpoint(ctx, wsID, e.ID, UpdateEndpointOpts{
+		Events:            []EventType{EventRunFailed, EventRunDurationExceeded},
+		DurationThreshold: &threshold,
+		Disabled:          &disabled,
+	})
+	require.NoError(t, err)
+	require.Equal(t, e.URL, updated.URL)
+	require.Equal(t, e.Secret, updated.Secret)
+	require.True(t, updated.Disabled)
+	require.False(t, updated.Subscribed(EventRunFailed))
+
+	list, err := m.ListEndpoints(ctx, wsID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, threshold, list[0].DurationThreshold)
+
+	require.NoError(t, m.DeleteEndpoint(ctx, wsID, e.ID))
+	require.ErrorIs(t, m.DeleteEndpoint(ctx, wsID, e.ID), ErrEndpointNotFound)
+}
+
+func TestDeliverNotifications(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	rc := &receiver{statuses: []int{http.StatusInternalServerError}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+	filteredRC := &receiver{}
+	filteredSrv := httptest.NewServer(filteredRC)
+	defer filteredSrv.Close()
+
+	all, err := m.CreateEndpoint(ctx, CreateEndpointOpts{
+		WorkspaceID: wsID,
+		URL:         srv.URL,
+		Events:      []EventType{EventRunFailed, EventRunCancelled},
+	})
+	require.NoError(t, err)
+	filtered, err := m.CreateEndpoint(ctx, CreateEndpointOpts{
+		WorkspaceID: wsID,
+		URL:         filteredSrv.URL,
+		Events:      []EventType{EventRunFailed},
+		Filter:      `run.function_slug == "app-charge"`,
+	})
+	require.NoError(t, err)
+
+	l := NewLifecycleListener(store)
+	s := NewService(store, WithHTTPClient(srv.Client())).(*svc)
+	s.backoff = func(int) time.Time { return time.Now() }
+
+	charge := newMetadata(wsID, "app-charge")
+	other := newMetadata(wsID, "app-other")
+	boom := "boom"
+	l.OnFunctionFinished(ctx, charge, queue.Item{}, nil, statev1.DriverResponse{Err: &boom})
+	l.OnFunctionFinished(ctx, other, queue.Item{}, nil, statev1.DriverResponse{})
+
+	deliveries, _, err := m.ListDeliveries(ctx, wsID, all.ID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	deliveries, _, err = m.ListDeliveries(ctx, wsID, filtered.ID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+
+	// The first attempt fails and is retried.
+	require.NoError(t, s.tick(ctx))
+	require.NoError(t, s.tick(ctx))
+	require.Len(t, rc.requests, 2)
+	require.Len(t, filteredRC.requests, 1)
+
+	deliveries, _, err = m.ListDeliveries(ctx, wsID, all.ID, ListOpts{})
+	require.NoError(t, err)
+	d := deliveries[0]
+	require.Equal(t, DeliveryStatusSucceeded, d.Status)
+	require.Len(t, d.Attempts, 2)
+	require.Equal(t, http.StatusInternalServerError, d.Attempts[0].StatusCode)
+	require.NotEmpty(t, d.Attempts[0].Error)
+
+	req := rc.requests[len(rc.requests)-1]
+	require.Equal(t, string(EventRunFailed), req.Header.Get(HeaderKeyEvent))
+	require.Regexp(t, `^t=\d+&s=[0-9a-f]{64}$`, req.Header.Get(headers.HeaderKeySignature))
+
+	n := Notification{}
+	require.NoError(t, json.Unmarshal(rc.bodies[0], &n))
+	require.Equal(t, EventRunFailed, n.Type)
+	require.Equal(t, charge.ID.RunID, n.Run.ID)
+	require.Equal(t, "app-charge", n.Run.FunctionSlug)
+	require.Equal(t, "Failed", n.Run.Status)
+	require.Equal(t, "boom", n.Run.Error)
+
+	// Replaying a delivery resends the same notification.
+	_, err = m.Replay(ctx, wsID, filtered.ID, d.ID)
+	require.ErrorIs(t, err, ErrDeliveryNotFound)
+	replay, err := m.Replay(ctx, wsID, all.ID, d.ID)
+	require.NoError(t, err)
+	require.Equal(t, d.ID, *replay.ReplayOf)
+	_, err = m.Replay(ctx, wsID, all.ID, replay.ID)
+	require.ErrorIs(t, err, ErrDeliveryPending)
+
+	require.NoError(t, s.tick(ctx))
+	require.Len(t, rc.requests, 3)
+	require.Equal(t, d.Payload, rc.bodies[2])
+	require.Equal(t, replay.ID.String(), rc.requests[2].Header.Get(HeaderKeyDeliveryID))
+}
+
+func TestDeliveryMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	rc := &receiver{statuses: []int{http.StatusBadGateway, http.StatusBadGateway}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	e, err := m.CreateEndpoint(ctx, CreateEndpointOpts{
+		WorkspaceID: wsID,
+		URL:         srv.URL,
+		Events:      []EventType{EventRunCancelled},
+	})
+	require.NoError(t, err)
+
+	s := NewService(store, WithHTTPClient(srv.Client()), WithMaxAttempts(2)).(*svc)
+	s.backoff = func(int) time.Time { return time.Now() }
+
+	l := NewLifecycleListener(store)
+	l.OnFunctionCancelled(ctx, newMetadata(wsID, "app-fn"), execution.CancelRequest{}, nil)
+
+	for range 3 {
+		require.NoError(t, s.tick(ctx))
+	}
+	require.Len(t, rc.requests, 2)
+
+	deliveries, _, err := m.ListDeliveries(ctx, wsID, e.ID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, DeliveryStatusFailed, deliveries[0].Status)
+	require.Len(t, deliveries[0].Attempts, 2)
+}
+
+func TestDurationNotifications(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	m := NewManager(store)
+	wsID := uuid.New()
+
+	rc := &receiver{}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	e, err := m.CreateEndpoint(ctx, CreateEndpointOpts{
+		WorkspaceID:       wsID,
+		URL:               srv.URL,
+		Events:            []EventType{EventRunDurationExceeded},
+		DurationThreshold: time.Minute,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	l := NewLifecycleListener(store).(*lifecycle)
+	l.now = func() time.Time { return now }
+	s := NewService(store, WithHTTPClient(srv.Client())).(*svc)
+
+	finished := newMetadata(wsID, "app-fn")
+	slow := newMetadata(wsID, "app-fn")
+	l.OnFunctionStarted(ctx, finished, queue.Item{}, nil)
+	l.OnFunctionStarted(ctx, slow, queue.Item{}, nil)
+
+	// Nothing is sent before the threshold.
+	require.NoError(t, s.tick(ctx))
+	require.Empty(t, rc.requests)
+
+	// Runs ending before the threshold are never notified.
+	l.OnFunctionFinished(ctx, finished, queue.Item{}, nil, statev1.DriverResponse{})
+
+	s.now = func() time.Time { return now.Add(time.Minute) }
+	require.NoError(t, s.tick(ctx))
+	require.Len(t, rc.requests, 1)
+
+	n := Notification{}
+	require.NoError(t, json.Unmarshal(rc.bodies[0], &n))
+	require.Equal(t, EventRunDurationExceeded, n.Type)
+	require.Equal(t, slow.ID.RunID, n.Run.ID)
+	require.Equal(t, "Running", n.Run.Status)
+	require.Equal(t, time.Minute.Milliseconds(), n.Run.DurationMS)
+
+	deliveries, _, err := m.ListDeliveries(ctx, wsID, e.ID, ListOpts{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, DeliveryStatusSucceeded, deliveries[0].Status)
+}
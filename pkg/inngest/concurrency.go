@@ -24,6 +24,10 @@ type ConcurrencyLimits struct {
 	Fn   []FnConcurrency   `json:"fn"`
 	Step []StepConcurrency `json:"step"`
 
+	// Fairness optionally shares capacity fairly between the keys of custom
+	// concurrency limits when the function is at capacity.
+	Fairness *ConcurrencyFairness `json:"fairness,omitempty"`
+
 	// Deprecated: use Step instead.  This exists for backcompat where concurrency only worked
 	// across steps.
 	Limits []StepConcurrency
@@ -64,6 +68,14 @@ func (c ConcurrencyLimits) Validate(ctx context.Context) error {
 			return err
 		}
 	}
+	if c.Fairness != nil {
+		if err := c.Fairness.Validate(ctx); err != nil {
+			return syscode.Error{
+				Code:    syscode.CodeConcurrencyLimitInvalid,
+				Message: err.Error(),
+			}
+		}
+	}
 	return nil
 }
 
@@ -78,12 +90,14 @@ func (c *ConcurrencyLimits) UnmarshalJSON(b []byte) error {
 	case '{':
 		// Try the new format first: {"fn": [...], "step": [...]}
 		raw := struct {
-			Fn   []FnConcurrency   `json:"fn"`
-			Step []StepConcurrency `json:"step"`
+			Fn       []FnConcurrency      `json:"fn"`
+			Step     []StepConcurrency    `json:"step"`
+			Fairness *ConcurrencyFairness `json:"fairness"`
 		}{}
-		if err := json.Unmarshal(b, &raw); err == nil && (len(raw.Fn) > 0 || len(raw.Step) > 0) {
+		if err := json.Unmarshal(b, &raw); err == nil && (len(raw.Fn) > 0 || len(raw.Step) > 0 || raw.Fairness != nil) {
 			c.Fn = raw.Fn
 			c.Step = raw.Step
+			c.Fairness = raw.Fairness
 			c.Limits = raw.Step
 		} else {
 			// Legacy: single step concurrency object, e.g. {"limit": 5, "key": "..."}
@@ -147,14 +161,16 @@ func hashConcurrencyKey(key string) string {
 }
 
 func (c *ConcurrencyLimits) MarshalJSON() ([]byte, error) {
-	if len(c.Fn) > 0 {
-		// New format: {"fn": [...], "step": [...]}
+	if len(c.Fn) > 0 || c.Fairness != nil {
+		// New format: {"fn": [...], "step": [...], "fairness": {...}}
 		return json.Marshal(struct {
-			Fn   []FnConcurrency   `json:"fn,omitempty"`
-			Step []StepConcurrency `json:"step,omitempty"`
+			Fn       []FnConcurrency      `json:"fn,omitempty"`
+			Step     []StepConcurrency    `json:"step,omitempty"`
+			Fairness *ConcurrencyFairness `json:"fairness,omitempty"`
 		}{
-			Fn:   c.Fn,
-			Step: c.Limits,
+			Fn:       c.Fn,
+			Step:     c.Limits,
+			Fairness: c.Fairness,
 		})
 	}
 	// Legacy format: [...] (flat array of step concurrency)
@@ -275,3 +291,91 @@ func (c StepConcurrency) IsCustomLimit() bool {
 func (c StepConcurrency) IsPartitionLimit() bool {
 	return c.Scope == enums.ConcurrencyScopeFn && c.Key == nil
 }
+
+// ConcurrencyFairnessMode selects how capacity is shared between concurrency keys.
+type ConcurrencyFairnessMode string
+
+const (
+	// ConcurrencyFairnessWeightedRoundRobin visits each concurrency key in a
+	// random order weighted by the key's weight, refilling at most a quantum
+	// multiplied by the key's weight per visit.
+	ConcurrencyFairnessWeightedRoundRobin ConcurrencyFairnessMode = "wrr"
+	// ConcurrencyFairnessDeficitRoundRobin credits each concurrency key with a
+	// quantum multiplied by the key's weight per round, refilling keys that are
+	// owed the most capacity first.  Unused credit carries over to later rounds
+	// while the key has items waiting.
+	ConcurrencyFairnessDeficitRoundRobin ConcurrencyFairnessMode = "drr"
+)
+
+// ConcurrencyFairness configures fair queuing across the keys of a function's
+// custom concurrency limits.  Without fairness, one key with many waiting items
+// can use all of the function's capacity and starve other keys.
+type ConcurrencyFairness struct {
+	Mode ConcurrencyFairnessMode `json:"mode"`
+	// Weight is an optional expression evaluated against the triggering event
+	// which returns the relative weight of the run's concurrency keys, eg.
+	// `event.data.plan == "enterprise" ? 4 : 1`.  Defaults to 1.
+	Weight *string `json:"weight,omitempty"`
+	// Quantum is the number of items refilled per round for a key with
+	// weight 1.  Defaults to consts.DefaultConcurrencyFairnessQuantum.
+	Quantum int `json:"quantum,omitempty"`
+}
+
+func (f ConcurrencyFairness) Validate(ctx context.Context) error {
+	switch f.Mode {
+	case ConcurrencyFairnessWeightedRoundRobin, ConcurrencyFairnessDeficitRoundRobin:
+	default:
+		return fmt.Errorf("Invalid concurrency fairness mode '%s', must be one of '%s' or '%s'", f.Mode, ConcurrencyFairnessWeightedRoundRobin, ConcurrencyFairnessDeficitRoundRobin)
+	}
+	if f.Quantum < 0 || f.Quantum > consts.MaxConcurrencyFairnessQuantum {
+		return fmt.Errorf("Concurrency fairness quantum must be between 0 and %d", consts.MaxConcurrencyFairnessQuantum)
+	}
+	if f.Weight != nil {
+		if _, err := expressions.NewExpressionEvaluator(ctx, *f.Weight); err != nil {
+			return fmt.Errorf("Invalid concurrency fairness weight '%s': %w", *f.Weight, err)
+		}
+	}
+	return nil
+}
+
+// EffectiveQuantum returns the quantum, defaulting to consts.DefaultConcurrencyFairnessQuantum.
+func (f ConcurrencyFairness) EffectiveQuantum() int {
+	if f.Quantum <= 0 {
+		return consts.DefaultConcurrencyFairnessQuantum
+	}
+	return f.Quantum
+}
+
+// EvaluateWeight evaluates the weight expression for the given event, clamped to
+// [1, consts.MaxConcurrencyFairnessWeight].  Non-numeric results use a weight of 1.
+func (f ConcurrencyFairness) EvaluateWeight(ctx context.Context, input map[string]any) int {
+	if f.Weight == nil {
+		return 1
+	}
+
+	// The input data is always wrapped in an event variable, for event.data.foo
+	val, _ := expressions.Evaluate(ctx, *f.Weight, map[string]any{"event": input})
+
+	var weight float64
+	switch v := val.(type) {
+	case int:
+		weight = float64(v)
+	case int64:
+		weight = float64(v)
+	case float64:
+		weight = v
+	case uint64:
+		weight = float64(v)
+	default:
+		return 1
+	}
+
+	switch {
+	case weight < 1:
+		return 1
+	case weight > consts.MaxConcurrencyFairnessWeight:
+		return consts.MaxConcurrencyFairnessWeight
+	default:
+		return int(weight)
+	}
+}
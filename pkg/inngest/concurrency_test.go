@@ -189,6 +189,30 @@ func TestConcurrencyLimits_Unmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: []byte(`{"step": [{"limit": 5, "key": "event.data.customerId"}], "fairness": {"mode": "drr", "weight": "event.data.weight", "quantum": 5}}`),
+			expected: ConcurrencyLimits{
+				Step: []StepConcurrency{
+					{
+						Limit: 5,
+						Key:   strptr("event.data.customerId"),
+						Hash:  hashConcurrencyKey("event.data.customerId"),
+					},
+				},
+				Limits: []StepConcurrency{
+					{
+						Limit: 5,
+						Key:   strptr("event.data.customerId"),
+						Hash:  hashConcurrencyKey("event.data.customerId"),
+					},
+				},
+				Fairness: &ConcurrencyFairness{
+					Mode:    ConcurrencyFairnessDeficitRoundRobin,
+					Weight:  strptr("event.data.weight"),
+					Quantum: 5,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -279,3 +303,54 @@ func TestConcurrencyEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestConcurrencyFairness(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("validate", func(t *testing.T) {
+		require.NoError(t, ConcurrencyFairness{Mode: ConcurrencyFairnessWeightedRoundRobin}.Validate(ctx))
+		require.NoError(t, ConcurrencyFairness{Mode: ConcurrencyFairnessDeficitRoundRobin, Weight: strptr("event.data.weight"), Quantum: 20}.Validate(ctx))
+
+		require.ErrorContains(t, ConcurrencyFairness{Mode: "fifo"}.Validate(ctx), "Invalid concurrency fairness mode")
+		require.ErrorContains(t, ConcurrencyFairness{Mode: ConcurrencyFairnessDeficitRoundRobin, Quantum: -1}.Validate(ctx), "quantum")
+		require.ErrorContains(t, ConcurrencyFairness{Mode: ConcurrencyFairnessDeficitRoundRobin, Weight: strptr("event.data.")}.Validate(ctx), "Invalid concurrency fairness weight")
+
+		limits := ConcurrencyLimits{Fairness: &ConcurrencyFairness{Mode: "fifo"}}
+		require.Error(t, limits.Validate(ctx))
+	})
+
+	t.Run("evaluate weight", func(t *testing.T) {
+		f := ConcurrencyFairness{
+			Mode:   ConcurrencyFairnessWeightedRoundRobin,
+			Weight: strptr(`event.data.plan == "enterprise" ? 4 : event.data.weight`),
+		}
+
+		evt := func(data map[string]any) map[string]any {
+			return event.Event{Data: data}.Map()
+		}
+
+		require.Equal(t, 4, f.EvaluateWeight(ctx, evt(map[string]any{"plan": "enterprise"})))
+		require.Equal(t, 2, f.EvaluateWeight(ctx, evt(map[string]any{"plan": "free", "weight": 2})))
+		require.Equal(t, 100, f.EvaluateWeight(ctx, evt(map[string]any{"plan": "free", "weight": 5000})))
+		require.Equal(t, 1, f.EvaluateWeight(ctx, evt(map[string]any{"plan": "free", "weight": -3})))
+		require.Equal(t, 1, f.EvaluateWeight(ctx, evt(map[string]any{"plan": "free", "weight": "heavy"})))
+		require.Equal(t, 1, ConcurrencyFairness{}.EvaluateWeight(ctx, evt(nil)))
+	})
+
+	t.Run("marshal round trip", func(t *testing.T) {
+		limits := ConcurrencyLimits{
+			Limits: []StepConcurrency{
+				{Limit: 5, Key: strptr("event.data.customerId"), Scope: enums.ConcurrencyScopeFn, Hash: hashConcurrencyKey("event.data.customerId")},
+			},
+			Fairness: &ConcurrencyFairness{Mode: ConcurrencyFairnessWeightedRoundRobin, Quantum: 3},
+		}
+
+		byt, err := json.Marshal(&limits)
+		require.NoError(t, err)
+
+		actual := ConcurrencyLimits{}
+		require.NoError(t, json.Unmarshal(byt, &actual))
+		require.Equal(t, limits.Fairness, actual.Fairness)
+		require.Equal(t, limits.Limits, actual.Limits)
+	})
+}
@@ -470,7 +470,7 @@ func (f Function) Validate(ctx context.Context) error {
 			err = multierror.Append(err, fmt.Errorf("Steps must have a valid URI"))
 		}
 		switch uri.Scheme {
-		case "http", "https", "ws", "wss":
+		case "http", "https", "ws", "wss", "grpc", "grpcs", "exec":
 			continue
 		default:
 			err = multierror.Append(err, fmt.Errorf("Non-supported step schema: %s", uri.Scheme))
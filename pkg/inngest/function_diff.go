@@ -0,0 +1,80 @@
+package inngest
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// FunctionChange records a single top-level configuration field which differs
+// between two versions of a function.  Before and After hold the JSON-encoded
+// value of the field in each version, or null if the field is unset.
+type FunctionChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// functionDiffFields lists the configuration fields compared by DiffFunctions,
+// in the order that changes are returned.  The function's ID, version and
+// config version are bookkeeping and are never reported as changes.
+var functionDiffFields = []struct {
+	name  string
+	value func(f Function) any
+}{
+	{"name", func(f Function) any { return f.Name }},
+	{"slug", func(f Function) any { return f.Slug }},
+	{"triggers", func(f Function) any { return f.Triggers }},
+	{"concurrency", func(f Function) any { return f.Concurrency }},
+	{"throttle", func(f Function) any { return f.Throttle }},
+	{"rateLimit", func(f Function) any { return f.RateLimit }},
+	{"debounce", func(f Function) any { return f.Debounce }},
+	{"batchEvents", func(f Function) any { return f.EventBatch }},
+	{"priority", func(f Function) any { return f.Priority }},
+	{"timeouts", func(f Function) any { return f.Timeouts }},
+	{"cancel", func(f Function) any { return f.Cancel }},
+	{"singleton", func(f Function) any { return f.Singleton }},
+	{"checkpoint", func(f Function) any { return f.Checkpoint }},
+	{"retries", func(f Function) any { return f.MaxAttempts() - 1 }},
+	{"steps", func(f Function) any { return f.Steps }},
+	{"driver", func(f Function) any { return f.Driver }},
+}
+
+// DiffFunctions returns the configuration fields which differ between the
+// function versions a and b.  An empty slice is returned if the versions are
+// equivalent.
+func DiffFunctions(a, b Function) ([]FunctionChange, error) {
+	changes := []FunctionChange{}
+	for _, field := range functionDiffFields {
+		before, err := diffValue(field.value(a))
+		if err != nil {
+			return nil, err
+		}
+		after, err := diffValue(field.value(b))
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(before, after) {
+			continue
+		}
+		changes = append(changes, FunctionChange{
+			Field:  field.name,
+			Before: before,
+			After:  after,
+		})
+	}
+	return changes, nil
+}
+
+// diffValue encodes v for comparison, treating empty lists and objects as
+// unset so that eg. a nil and an empty cancellation list are equivalent.
+func diffValue(v any) (json.RawMessage, error) {
+	byt, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	switch string(byt) {
+	case "[]", "{}":
+		return json.RawMessage("null"), nil
+	}
+	return byt, nil
+}
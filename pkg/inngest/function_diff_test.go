@@ -0,0 +1,62 @@
+package inngest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffFunctions(t *testing.T) {
+	retries := 5
+	base := Function{
+		ID:              uuid.New(),
+		FunctionVersion: 1,
+		Name:            "Send email",
+		Slug:            "app-send-email",
+		Triggers: MultipleTriggers{
+			{EventTrigger: &EventTrigger{Event: "user/created"}},
+		},
+		Steps: []Step{{ID: "step", Name: "step", URI: "http://localhost:3000/api/inngest"}},
+	}
+
+	t.Run("identical versions have no changes", func(t *testing.T) {
+		next := base
+		next.FunctionVersion = 2
+		next.Cancel = []Cancel{}
+
+		changes, err := DiffFunctions(base, next)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+	})
+
+	t.Run("changed fields are returned in order", func(t *testing.T) {
+		next := base
+		next.FunctionVersion = 2
+		next.Throttle = &Throttle{Limit: 10, Period: time.Minute}
+		next.Triggers = MultipleTriggers{
+			{EventTrigger: &EventTrigger{Event: "user/updated"}},
+		}
+		next.Steps = []Step{{ID: "step", Name: "step", URI: "http://localhost:3000/api/inngest", Retries: &retries}}
+
+		changes, err := DiffFunctions(base, next)
+		require.NoError(t, err)
+
+		fields := []string{}
+		for _, c := range changes {
+			fields = append(fields, c.Field)
+		}
+		require.Equal(t, []string{"triggers", "throttle", "retries", "steps"}, fields)
+
+		require.JSONEq(t, "null", string(changes[1].Before))
+		require.JSONEq(t, `{"limit":10,"period":"1m","burst":0}`, string(changes[1].After))
+
+		var before, after int
+		require.NoError(t, json.Unmarshal(changes[2].Before, &before))
+		require.NoError(t, json.Unmarshal(changes[2].After, &after))
+		require.Equal(t, 4, before)
+		require.Equal(t, 5, after)
+	})
+}
@@ -41,6 +41,10 @@ func Driver(f Function) string {
 		return "http"
 	case "ws", "wss":
 		return "connect"
+	case "grpc", "grpcs":
+		return "grpc"
+	case "exec":
+		return "exec"
 	}
 
 	return "http"
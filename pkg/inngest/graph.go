@@ -1,5 +1,7 @@
 package inngest
 
+import "time"
+
 const (
 	TriggerName = "$trigger"
 )
@@ -21,10 +23,31 @@ type Edge struct {
 	// IncomingGeneratorStepName is the name from step planned. it should be empty for
 	// other cases
 	IncomingGeneratorStepName string `json:"gen_name,omitempty"`
+	// IncomingGeneratorStepCache is the cross-run cache configuration for the
+	// planned step, if it opted into caching.  The step's output is cached
+	// once it succeeds.
+	IncomingGeneratorStepCache *StepCache `json:"gen_cache,omitempty"`
+	// Lock is the lock that must be acquired before the incoming step is
+	// executed.  This is only set for lock queue items waiting on a lock.
+	Lock *StepLock `json:"lock,omitempty"`
 	// Outgoing is the name of the generator step or step that last ran.
 	Outgoing string `json:"outgoing"`
 }
 
+// StepCache is the cache key and TTL for a planned step's output.
+type StepCache struct {
+	Key string        `json:"key"`
+	TTL time.Duration `json:"ttl"`
+}
+
+// StepLock is the key and lease for a lock requested via OpcodeLockAcquire,
+// and the ID of the step the lock is held around.
+type StepLock struct {
+	Key   string        `json:"key"`
+	Lease time.Duration `json:"lease"`
+	Step  string        `json:"step"`
+}
+
 func (e Edge) IsSource() bool {
 	return e.Outgoing == "" && e.Incoming == TriggerName || e.Outgoing == TriggerName
 }
@@ -91,6 +91,10 @@ type QueueManager interface {
 type Opts struct {
 	AuthMiddleware func(http.Handler) http.Handler
 	QueueManager   QueueManager
+
+	// QueueMetrics reports per-function queue metrics.  Queue statistics are
+	// loaded from the QueueManager if it implements QueueStatsProvider.
+	QueueMetrics *QueueMetrics
 }
 
 // ExperimentalPromMetricsEnabled reports whether the experimental Prometheus
@@ -138,6 +142,10 @@ func NewMetricsAPI(opts Opts) (*MetricsAPI, error) {
 		queueGauge: queueDepthGauge,
 	}
 
+	if opts.QueueMetrics != nil {
+		activeQueueMetrics.active.Store(opts.QueueMetrics)
+	}
+
 	api.setupRoutes()
 	return api, nil
 }
@@ -172,6 +180,13 @@ func (api *MetricsAPI) handleMetrics(w http.ResponseWriter, r *http.Request) {
 
 	api.queueGauge.Set(float64(sanitizedDepth))
 
+	if provider, ok := api.opts.QueueManager.(QueueStatsProvider); ok && api.opts.QueueMetrics != nil {
+		if err := api.opts.QueueMetrics.Refresh(r.Context(), provider); err != nil {
+			http.Error(w, "Failed to get function queue stats", http.StatusInternalServerError)
+			return
+		}
+	}
+
 	metricFamilies, err := registry.Gather()
 	if err != nil {
 		http.Error(w, "Failed to gather metrics", http.StatusInternalServerError)
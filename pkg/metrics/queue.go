@@ -0,0 +1,411 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultMaxFunctionSeries is the default number of functions reported
+	// with their own label in per-function queue metrics.
+	DefaultMaxFunctionSeries = 500
+
+	// DefaultQueueStatsTTL is how long per-function queue statistics are
+	// cached between scrapes, bounding the load scrapes put on the queue.
+	DefaultQueueStatsTTL = 10 * time.Second
+
+	// DefaultQueueStatsPageSize is the default number of function partitions
+	// loaded per refresh from providers which load statistics incrementally.
+	DefaultQueueStatsPageSize = 250
+
+	// DefaultFunctionSeriesTTL is how long a function keeps its function_id
+	// label and function info after it was last seen.
+	DefaultFunctionSeriesTTL = time.Hour
+
+	// OtherFunctionsLabel is the function label used for functions over the
+	// series limit.
+	OtherFunctionsLabel = "_other"
+
+	// SystemFunctionLabel is the function label used for system queue items,
+	// which don't belong to a function.
+	SystemFunctionLabel = "_system"
+)
+
+var (
+	queueDequeueLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "inngest_queue_dequeue_latency_seconds",
+		Help:    "Time between a queue item becoming available and being dequeued by a worker",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"function_id", "kind"})
+
+	queueConstrained = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "inngest_queue_constrained_total",
+		Help: "The total number of times queue items or backlogs were held back by a throttle or concurrency constraint",
+	}, []string{"function_id", "constraint"})
+
+	queueFunctionDepthDesc = prometheus.NewDesc(
+		"inngest_queue_function_depth",
+		"Number of unleased queue items per function, by state: ready, scheduled or backlog",
+		[]string{"function_id", "state"}, nil,
+	)
+	queueFunctionOldestAgeDesc = prometheus.NewDesc(
+		"inngest_queue_function_oldest_item_age_seconds",
+		"Age of the oldest due, unleased queue item per function",
+		[]string{"function_id"}, nil,
+	)
+	queueFunctionLeasedDesc = prometheus.NewDesc(
+		"inngest_queue_function_leased",
+		"Number of queue items per function leased by workers",
+		[]string{"function_id"}, nil,
+	)
+	functionInfoDesc = prometheus.NewDesc(
+		"inngest_function_info",
+		"Maps function IDs used in queue metrics to function slugs",
+		[]string{"function_id", "fn"}, nil,
+	)
+
+	// activeQueueMetrics reports the gauges of the QueueMetrics served by the
+	// metrics API.
+	activeQueueMetrics = &queueMetricsCollector{}
+)
+
+func init() {
+	registry.MustRegister(
+		queueDequeueLatency,
+		queueConstrained,
+		activeQueueMetrics,
+	)
+}
+
+// QueueStatsProvider returns per-function queue statistics.  Queue shards
+// implement this.
+type QueueStatsProvider interface {
+	FunctionQueueStats(ctx context.Context) ([]queue.FunctionQueueStats, error)
+}
+
+// QueueStatsPager returns per-function queue statistics a page at a time.
+// Providers implementing it are refreshed incrementally, one page per refresh,
+// instead of loading statistics for every function at once.
+type QueueStatsPager interface {
+	// FunctionQueueStatsPage returns statistics for a page of functions,
+	// including functions without items, and the cursor of the next page.  An
+	// empty cursor starts a scan, and an empty next cursor ends it.
+	FunctionQueueStatsPage(ctx context.Context, cursor string, limit int) ([]queue.FunctionQueueStats, string, error)
+}
+
+// QueueMetricsOpts configures per-function queue metrics.
+type QueueMetricsOpts struct {
+	// MaxFunctions is the maximum number of functions reported with their own
+	// function_id label.  Functions first seen once the limit is reached are
+	// reported as "_other".  Zero uses DefaultMaxFunctionSeries, and a negative
+	// value reports every function as "_other".
+	MaxFunctions int
+
+	// FunctionName returns the slug of a function for inngest_function_info.
+	// If nil, no function info is reported.
+	FunctionName func(ctx context.Context, fnID uuid.UUID) (string, error)
+
+	// StatsTTL is how long queue statistics are cached between scrapes.  Zero
+	// uses DefaultQueueStatsTTL.
+	StatsTTL time.Duration
+
+	// StatsPageSize is the number of function partitions loaded per refresh
+	// from a QueueStatsPager.  Zero uses DefaultQueueStatsPageSize.
+	StatsPageSize int
+
+	// FunctionSeriesTTL is how long a function that is neither queued nor
+	// processed keeps its function_id label, function info and series.  Zero
+	// uses DefaultFunctionSeriesTTL.
+	FunctionSeriesTTL time.Duration
+}
+
+// QueueMetrics records per-function queue metrics.  It implements
+// queue.MetricsRecorder to record dequeue latencies and constraint hits as
+// items are processed, and reports queue depth, oldest item age and lease
+// counts from statistics loaded when metrics are scraped.
+type QueueMetrics struct {
+	opts    QueueMetricsOpts
+	labeler *functionLabeler
+
+	mu        sync.Mutex
+	stats     map[uuid.UUID]functionQueueEntry
+	fetchedAt time.Time
+	names     map[uuid.UUID]string
+
+	// cursor and scanStart track the scan of a QueueStatsPager.
+	cursor    string
+	scanStart time.Time
+}
+
+type functionQueueEntry struct {
+	stats  queue.FunctionQueueStats
+	seenAt time.Time
+}
+
+// NewQueueMetrics returns per-function queue metrics.
+func NewQueueMetrics(opts QueueMetricsOpts) *QueueMetrics {
+	if opts.MaxFunctions == 0 {
+		opts.MaxFunctions = DefaultMaxFunctionSeries
+	}
+	if opts.StatsTTL <= 0 {
+		opts.StatsTTL = DefaultQueueStatsTTL
+	}
+	if opts.StatsPageSize <= 0 {
+		opts.StatsPageSize = DefaultQueueStatsPageSize
+	}
+	if opts.FunctionSeriesTTL <= 0 {
+		opts.FunctionSeriesTTL = DefaultFunctionSeriesTTL
+	}
+	return &QueueMetrics{
+		opts:    opts,
+		labeler: newFunctionLabeler(opts.MaxFunctions),
+		stats:   map[uuid.UUID]functionQueueEntry{},
+		names:   map[uuid.UUID]string{},
+	}
+}
+
+func (m *QueueMetrics) RecordDequeueLatency(_ context.Context, fnID uuid.UUID, kind string, latency time.Duration) {
+	queueDequeueLatency.WithLabelValues(m.labeler.label(fnID), kind).Observe(latency.Seconds())
+}
+
+func (m *QueueMetrics) RecordConstrained(_ context.Context, fnID uuid.UUID, constraint enums.QueueConstraint) {
+	queueConstrained.WithLabelValues(m.labeler.label(fnID), constraint.String()).Inc()
+}
+
+// Refresh loads queue statistics from the provider, unless statistics were
+// loaded within the stats TTL.  If the provider implements QueueStatsPager, a
+// single page is loaded and merged into the statistics of previous pages.
+// Functions which haven't been seen within the function series TTL are
+// pruned.
+func (m *QueueMetrics) Refresh(ctx context.Context, provider QueueStatsProvider) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if !m.fetchedAt.IsZero() && now.Sub(m.fetchedAt) < m.opts.StatsTTL {
+		return nil
+	}
+
+	var (
+		stats []queue.FunctionQueueStats
+		err   error
+	)
+	pager, incremental := provider.(QueueStatsPager)
+	if incremental {
+		if m.cursor == "" {
+			m.scanStart = now
+		}
+		stats, m.cursor, err = pager.FunctionQueueStatsPage(ctx, m.cursor, m.opts.StatsPageSize)
+	} else {
+		stats, err = provider.FunctionQueueStats(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	m.fetchedAt = now
+
+	for _, s := range stats {
+		if s.Ready+s.Scheduled+s.Backlog+s.Leased == 0 {
+			delete(m.stats, s.FunctionID)
+			continue
+		}
+		m.stats[s.FunctionID] = functionQueueEntry{stats: s, seenAt: now}
+	}
+
+	// Drop functions which no longer have items: those not returned by a full
+	// load, or not seen during a complete scan.
+	var before time.Time
+	switch {
+	case !incremental:
+		before = now
+	case m.cursor == "":
+		before = m.scanStart
+	}
+	if !before.IsZero() {
+		for fnID, e := range m.stats {
+			if e.seenAt.Before(before) {
+				delete(m.stats, fnID)
+			}
+		}
+	}
+
+	m.prune(now.Add(-m.opts.FunctionSeriesTTL))
+
+	if m.opts.FunctionName != nil {
+		for _, s := range stats {
+			if _, ok := m.names[s.FunctionID]; ok {
+				continue
+			}
+			if _, ok := m.stats[s.FunctionID]; !ok {
+				continue
+			}
+			if m.labeler.label(s.FunctionID) != s.FunctionID.String() {
+				continue
+			}
+			name, err := m.opts.FunctionName(ctx, s.FunctionID)
+			if err != nil || name == "" {
+				// Retry on the next refresh, eg. if the function hasn't synced yet.
+				continue
+			}
+			m.names[s.FunctionID] = name
+		}
+	}
+
+	return nil
+}
+
+// prune releases the labels of functions not seen since before, along with
+// their function info and series, so that labels are freed for new functions.
+// Functions with queued items are always kept.
+func (m *QueueMetrics) prune(before time.Time) {
+	for _, fnID := range m.labeler.prune(before, func(fnID uuid.UUID) bool {
+		_, ok := m.stats[fnID]
+		return ok
+	}) {
+		delete(m.names, fnID)
+		lbls := prometheus.Labels{"function_id": fnID.String()}
+		queueDequeueLatency.DeletePartialMatch(lbls)
+		queueConstrained.DeletePartialMatch(lbls)
+	}
+}
+
+type functionQueueGauges struct {
+	ready, scheduled, backlog, leased int64
+	oldestDueAt                       time.Time
+}
+
+func (m *QueueMetrics) collect(ch chan<- prometheus.Metric) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Aggregate by label, as functions over the series limit share a label.
+	gauges := map[string]*functionQueueGauges{}
+	for _, e := range m.stats {
+		s := e.stats
+		lbl := m.labeler.label(s.FunctionID)
+		g, ok := gauges[lbl]
+		if !ok {
+			g = &functionQueueGauges{}
+			gauges[lbl] = g
+		}
+		g.ready += s.Ready
+		g.scheduled += s.Scheduled
+		g.backlog += s.Backlog
+		g.leased += s.Leased
+		if !s.OldestDueAt.IsZero() && (g.oldestDueAt.IsZero() || s.OldestDueAt.Before(g.oldestDueAt)) {
+			g.oldestDueAt = s.OldestDueAt
+		}
+	}
+
+	for lbl, g := range gauges {
+		ch <- prometheus.MustNewConstMetric(queueFunctionDepthDesc, prometheus.GaugeValue, float64(g.ready), lbl, "ready")
+		ch <- prometheus.MustNewConstMetric(queueFunctionDepthDesc, prometheus.GaugeValue, float64(g.scheduled), lbl, "scheduled")
+		ch <- prometheus.MustNewConstMetric(queueFunctionDepthDesc, prometheus.GaugeValue, float64(g.backlog), lbl, "backlog")
+		ch <- prometheus.MustNewConstMetric(queueFunctionLeasedDesc, prometheus.GaugeValue, float64(g.leased), lbl)
+
+		var age float64
+		if !g.oldestDueAt.IsZero() {
+			age = max(m.fetchedAt.Sub(g.oldestDueAt).Seconds(), 0)
+		}
+		ch <- prometheus.MustNewConstMetric(queueFunctionOldestAgeDesc, prometheus.GaugeValue, age, lbl)
+	}
+
+	for fnID, name := range m.names {
+		ch <- prometheus.MustNewConstMetric(functionInfoDesc, prometheus.GaugeValue, 1, fnID.String(), name)
+	}
+}
+
+// queueMetricsCollector is registered once and reports the gauges of the
+// active QueueMetrics, so that the metrics API can be created more than once
+// within a process.
+type queueMetricsCollector struct {
+	active atomic.Pointer[QueueMetrics]
+}
+
+func (c *queueMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueFunctionDepthDesc
+	ch <- queueFunctionOldestAgeDesc
+	ch <- queueFunctionLeasedDesc
+	ch <- functionInfoDesc
+}
+
+func (c *queueMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	if m := c.active.Load(); m != nil {
+		m.collect(ch)
+	}
+}
+
+// functionLabeler bounds the cardinality of function labels.  Functions are
+// labelled by ID on a first come, first served basis until the limit is
+// reached, and keep their label until they are pruned.
+type functionLabeler struct {
+	max int
+
+	mu     sync.RWMutex
+	labels map[uuid.UUID]*atomic.Int64
+}
+
+func newFunctionLabeler(max int) *functionLabeler {
+	return &functionLabeler{max: max, labels: map[uuid.UUID]*atomic.Int64{}}
+}
+
+func (l *functionLabeler) label(fnID uuid.UUID) string {
+	if fnID == uuid.Nil {
+		return SystemFunctionLabel
+	}
+	now := time.Now().UnixNano()
+	if seen := l.seen(fnID); seen != nil {
+		seen.Store(now)
+		return fnID.String()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if seen, ok := l.labels[fnID]; ok {
+		seen.Store(now)
+		return fnID.String()
+	}
+	if len(l.labels) >= l.max {
+		return OtherFunctionsLabel
+	}
+	seen := &atomic.Int64{}
+	seen.Store(now)
+	l.labels[fnID] = seen
+	return fnID.String()
+}
+
+func (l *functionLabeler) labelled(fnID uuid.UUID) bool {
+	return l.seen(fnID) != nil
+}
+
+func (l *functionLabeler) seen(fnID uuid.UUID) *atomic.Int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.labels[fnID]
+}
+
+// prune removes the labels of functions last labelled before the given time,
+// unless keep returns true, and returns the pruned functions.
+func (l *functionLabeler) prune(before time.Time, keep func(fnID uuid.UUID) bool) []uuid.UUID {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var pruned []uuid.UUID
+	for fnID, seen := range l.labels {
+		if seen.Load() >= before.UnixNano() || keep(fnID) {
+			continue
+		}
+		delete(l.labels, fnID)
+		pruned = append(pruned, fnID)
+	}
+	return pruned
+}
+
+var _ queue.MetricsRecorder = (*QueueMetrics)(nil)
@@ -0,0 +1,296 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// histogramCount reads the sample count of a histogram from the package-level
+// registry by metric name and label values.
+func histogramCount(t *testing.T, name string, labels map[string]string) uint64 {
+	t.Helper()
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			match := len(m.GetLabel()) == len(labels)
+			for _, lp := range m.GetLabel() {
+				if v, ok := labels[lp.GetName()]; !ok || v != lp.GetValue() {
+					match = false
+					break
+				}
+			}
+			if match {
+				return m.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return 0
+}
+
+// gaugeValue reads the value of a gauge from the package-level registry by
+// metric name and label values.
+func gaugeValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			match := len(m.GetLabel()) == len(labels)
+			for _, lp := range m.GetLabel() {
+				if v, ok := labels[lp.GetName()]; !ok || v != lp.GetValue() {
+					match = false
+					break
+				}
+			}
+			if match {
+				return m.GetGauge().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+// statsQueueManager implements QueueManager and QueueStatsProvider.
+type statsQueueManager struct {
+	mockQueueManager
+	stats []queue.FunctionQueueStats
+	err   error
+	calls int
+}
+
+func (m *statsQueueManager) FunctionQueueStats(ctx context.Context) ([]queue.FunctionQueueStats, error) {
+	m.calls++
+	return m.stats, m.err
+}
+
+func TestQueueMetricsRecorder(t *testing.T) {
+	ctx := context.Background()
+	m := NewQueueMetrics(QueueMetricsOpts{})
+	fnID := uuid.New()
+
+	t.Run("records constraint hits", func(t *testing.T) {
+		labels := map[string]string{"function_id": fnID.String(), "constraint": "throttle"}
+		base := counterValue(t, "inngest_queue_constrained_total", labels)
+
+		m.RecordConstrained(ctx, fnID, enums.QueueConstraintThrottle)
+		m.RecordConstrained(ctx, fnID, enums.QueueConstraintThrottle)
+
+		assert.Equal(t, base+2, counterValue(t, "inngest_queue_constrained_total", labels))
+	})
+
+	t.Run("records dequeue latency", func(t *testing.T) {
+		labels := map[string]string{"function_id": fnID.String(), "kind": queue.KindStart}
+		base := histogramCount(t, "inngest_queue_dequeue_latency_seconds", labels)
+
+		m.RecordDequeueLatency(ctx, fnID, queue.KindStart, 250*time.Millisecond)
+
+		assert.Equal(t, base+1, histogramCount(t, "inngest_queue_dequeue_latency_seconds", labels))
+	})
+
+	t.Run("labels system items", func(t *testing.T) {
+		labels := map[string]string{"function_id": SystemFunctionLabel, "kind": queue.KindPause}
+		base := histogramCount(t, "inngest_queue_dequeue_latency_seconds", labels)
+
+		m.RecordDequeueLatency(ctx, uuid.Nil, queue.KindPause, time.Second)
+
+		assert.Equal(t, base+1, histogramCount(t, "inngest_queue_dequeue_latency_seconds", labels))
+	})
+}
+
+func TestFunctionLabeler(t *testing.T) {
+	t.Run("labels functions up to the limit", func(t *testing.T) {
+		l := newFunctionLabeler(2)
+		a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+		assert.Equal(t, a.String(), l.label(a))
+		assert.Equal(t, b.String(), l.label(b))
+		assert.Equal(t, OtherFunctionsLabel, l.label(c))
+
+		// Functions keep their label once assigned.
+		assert.Equal(t, a.String(), l.label(a))
+		assert.False(t, l.labelled(c))
+	})
+
+	t.Run("negative limit disables function labels", func(t *testing.T) {
+		m := NewQueueMetrics(QueueMetricsOpts{MaxFunctions: -1})
+		assert.Equal(t, OtherFunctionsLabel, m.labeler.label(uuid.New()))
+	})
+}
+
+func TestQueueMetricsScrape(t *testing.T) {
+	fnA, fnB, fnC := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	qm := &statsQueueManager{
+		stats: []queue.FunctionQueueStats{
+			{FunctionID: fnA, Ready: 3, Scheduled: 1, Backlog: 5, Leased: 2, OldestDueAt: now.Add(-time.Minute)},
+			{FunctionID: fnB, Ready: 1, Leased: 1, OldestDueAt: now.Add(-10 * time.Second)},
+			{FunctionID: fnC, Ready: 2, Backlog: 1},
+		},
+	}
+
+	metrics := NewQueueMetrics(QueueMetricsOpts{
+		MaxFunctions: 1,
+		FunctionName: func(ctx context.Context, fnID uuid.UUID) (string, error) {
+			if fnID == fnA {
+				return "app-fn-a", nil
+			}
+			return "", errors.New("not found")
+		},
+	})
+
+	api, err := NewMetricsAPI(Opts{QueueManager: qm, QueueMetrics: metrics})
+	require.NoError(t, err)
+
+	scrape := func() string {
+		rec := httptest.NewRecorder()
+		api.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	body := scrape()
+
+	assert.Contains(t, body, `inngest_queue_function_depth{function_id="`+fnA.String()+`",state="ready"} 3`)
+	assert.Contains(t, body, `inngest_queue_function_depth{function_id="`+fnA.String()+`",state="backlog"} 5`)
+	assert.Contains(t, body, `inngest_queue_function_leased{function_id="`+fnA.String()+`"} 2`)
+	assert.Contains(t, body, `inngest_function_info{fn="app-fn-a",function_id="`+fnA.String()+`"} 1`)
+
+	// Functions over the limit are aggregated.
+	assert.Contains(t, body, `inngest_queue_function_depth{function_id="_other",state="ready"} 3`)
+	assert.Contains(t, body, `inngest_queue_function_depth{function_id="_other",state="backlog"} 1`)
+	assert.Contains(t, body, `inngest_queue_function_leased{function_id="_other"} 1`)
+	assert.NotContains(t, body, fnB.String())
+	assert.NotContains(t, body, fnC.String())
+
+	ageA := gaugeValue(t, "inngest_queue_function_oldest_item_age_seconds", map[string]string{"function_id": fnA.String()})
+	assert.InDelta(t, 60, ageA, 5)
+	ageOther := gaugeValue(t, "inngest_queue_function_oldest_item_age_seconds", map[string]string{"function_id": OtherFunctionsLabel})
+	assert.InDelta(t, 10, ageOther, 5)
+
+	// Stats are cached between scrapes.
+	scrape()
+	assert.Equal(t, 1, qm.calls)
+
+	t.Run("fails when stats can't be loaded", func(t *testing.T) {
+		failing := &statsQueueManager{err: errors.New("boom")}
+		api, err := NewMetricsAPI(Opts{QueueManager: failing, QueueMetrics: NewQueueMetrics(QueueMetricsOpts{})})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		api.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+// pagedQueueManager implements QueueManager and QueueStatsPager, returning one
+// page of stats per call.
+type pagedQueueManager struct {
+	mockQueueManager
+	pages [][]queue.FunctionQueueStats
+	calls int
+}
+
+func (m *pagedQueueManager) FunctionQueueStats(ctx context.Context) ([]queue.FunctionQueueStats, error) {
+	return nil, errors.New("stats should be paged")
+}
+
+func (m *pagedQueueManager) FunctionQueueStatsPage(ctx context.Context, cursor string, limit int) ([]queue.FunctionQueueStats, string, error) {
+	page := m.calls % len(m.pages)
+	m.calls++
+	next := ""
+	if page+1 < len(m.pages) {
+		next = "next"
+	}
+	return m.pages[page], next, nil
+}
+
+func TestQueueMetricsPagedRefresh(t *testing.T) {
+	ctx := context.Background()
+	fnA, fnB := uuid.New(), uuid.New()
+
+	qm := &pagedQueueManager{
+		pages: [][]queue.FunctionQueueStats{
+			{{FunctionID: fnA, Ready: 1}},
+			{{FunctionID: fnB, Ready: 2}},
+		},
+	}
+	m := NewQueueMetrics(QueueMetricsOpts{StatsTTL: time.Nanosecond})
+
+	require.NoError(t, m.Refresh(ctx, qm))
+	require.Len(t, m.stats, 1)
+
+	// Pages are merged.
+	require.NoError(t, m.Refresh(ctx, qm))
+	require.Len(t, m.stats, 2)
+	assert.Equal(t, 2, qm.calls)
+
+	// Functions without items are dropped when seen.
+	qm.pages[0] = []queue.FunctionQueueStats{{FunctionID: fnA}}
+	require.NoError(t, m.Refresh(ctx, qm))
+	require.NotContains(t, m.stats, fnA)
+
+	// Functions not seen during a complete scan are dropped.
+	qm.pages[1] = nil
+	require.NoError(t, m.Refresh(ctx, qm))
+	require.Empty(t, m.stats)
+}
+
+func TestQueueMetricsPrune(t *testing.T) {
+	ctx := context.Background()
+	fnA, fnB := uuid.New(), uuid.New()
+
+	qm := &statsQueueManager{
+		stats: []queue.FunctionQueueStats{
+			{FunctionID: fnA, Ready: 1},
+			{FunctionID: fnB, Ready: 1},
+		},
+	}
+	m := NewQueueMetrics(QueueMetricsOpts{
+		MaxFunctions:      2,
+		StatsTTL:          time.Nanosecond,
+		FunctionSeriesTTL: time.Millisecond,
+		FunctionName: func(ctx context.Context, fnID uuid.UUID) (string, error) {
+			return fnID.String(), nil
+		},
+	})
+
+	require.NoError(t, m.Refresh(ctx, qm))
+	require.Len(t, m.names, 2)
+
+	labels := map[string]string{"function_id": fnA.String(), "constraint": "throttle"}
+	m.RecordConstrained(ctx, fnA, enums.QueueConstraintThrottle)
+	require.Equal(t, float64(1), counterValue(t, "inngest_queue_constrained_total", labels))
+
+	// fnA is no longer queued, while fnB is.
+	qm.stats = qm.stats[1:]
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, m.Refresh(ctx, qm))
+
+	assert.NotContains(t, m.names, fnA)
+	assert.Contains(t, m.names, fnB)
+	assert.False(t, m.labeler.labelled(fnA))
+	assert.True(t, m.labeler.labelled(fnB))
+	assert.Zero(t, counterValue(t, "inngest_queue_constrained_total", labels))
+
+	// The pruned label is free for new functions.
+	fnC := uuid.New()
+	assert.Equal(t, fnC.String(), m.labeler.label(fnC))
+}
@@ -0,0 +1,221 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	statev1 "github.com/inngest/inngest/pkg/execution/state"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/oklog/ulid/v2"
+)
+
+// DefaultUpdateBuffer is the number of updates buffered for each subscriber.
+const DefaultUpdateBuffer = 256
+
+// Update is a change to a run, published as the run progresses.
+type Update struct {
+	RunID      ulid.ULID
+	EnvID      uuid.UUID
+	AppID      uuid.UUID
+	FunctionID uuid.UUID
+	// Status is the run's status after the update.
+	Status enums.RunStatus
+	// Step is true if the update is for a step within the run, eg. a step
+	// starting or finishing, rather than a change to the run's status.
+	Step bool
+	At   time.Time
+}
+
+// Ended returns whether the run has ended.
+func (u Update) Ended() bool {
+	return enums.RunStatusEnded(u.Status)
+}
+
+// UpdateFilter selects the updates sent to a subscriber.  Empty fields match
+// every run.
+type UpdateFilter struct {
+	RunID      *ulid.ULID
+	AppID      *uuid.UUID
+	FunctionID *uuid.UUID
+	// StatusOnly skips step updates.
+	StatusOnly bool
+}
+
+func (f UpdateFilter) matches(u Update) bool {
+	if f.StatusOnly && u.Step {
+		return false
+	}
+	if f.RunID != nil && *f.RunID != u.RunID {
+		return false
+	}
+	if f.AppID != nil && *f.AppID != u.AppID {
+		return false
+	}
+	if f.FunctionID != nil && *f.FunctionID != u.FunctionID {
+		return false
+	}
+	return true
+}
+
+// UpdateBroker fans out run updates from the executor to subscribers, eg.
+// GraphQL subscriptions.  Its lifecycle listener publishes updates as runs
+// progress.
+//
+// Updates are delivered in memory and on a best effort basis: subscribers
+// which fall behind by more than the buffer size miss updates.
+type UpdateBroker struct {
+	buffer int
+
+	mu   sync.RWMutex
+	subs map[*updateSub]struct{}
+}
+
+type updateSub struct {
+	filter UpdateFilter
+	ch     chan Update
+}
+
+// NewUpdateBroker returns a new UpdateBroker.
+func NewUpdateBroker() *UpdateBroker {
+	return &UpdateBroker{
+		buffer: DefaultUpdateBuffer,
+		subs:   map[*updateSub]struct{}{},
+	}
+}
+
+// Subscribe returns a channel receiving updates matching the filter.  The
+// channel is closed once ctx is cancelled.
+func (b *UpdateBroker) Subscribe(ctx context.Context, filter UpdateFilter) <-chan Update {
+	sub := &updateSub{
+		filter: filter,
+		ch:     make(chan Update, b.buffer),
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, sub)
+		close(sub.ch)
+		b.mu.Unlock()
+	}()
+
+	return sub.ch
+}
+
+// Publish sends the update to all matching subscribers without blocking.
+func (b *UpdateBroker) Publish(u Update) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subs {
+		if !sub.filter.matches(u) {
+			continue
+		}
+		select {
+		case sub.ch <- u:
+		default:
+			// The subscriber is too far behind; drop the update rather than
+			// blocking execution.
+		}
+	}
+}
+
+// LifecycleListener returns a lifecycle listener publishing updates to the
+// broker.
+func (b *UpdateBroker) LifecycleListener() execution.LifecycleListener {
+	return updateLifecycle{broker: b}
+}
+
+type updateLifecycle struct {
+	execution.NoopLifecyceListener
+
+	broker *UpdateBroker
+}
+
+func (l updateLifecycle) publish(md statev2.Metadata, status enums.RunStatus, step bool) {
+	l.broker.Publish(Update{
+		RunID:      md.ID.RunID,
+		EnvID:      md.ID.Tenant.EnvID,
+		AppID:      md.ID.Tenant.AppID,
+		FunctionID: md.ID.FunctionID,
+		Status:     status,
+		Step:       step,
+		At:         time.Now(),
+	})
+}
+
+func (l updateLifecycle) OnFunctionScheduled(_ context.Context, md statev2.Metadata, _ queue.Item, _ []event.TrackedEvent) {
+	l.publish(md, enums.RunStatusScheduled, false)
+}
+
+func (l updateLifecycle) OnFunctionSkipped(_ context.Context, md statev2.Metadata, _ execution.SkipState) {
+	l.publish(md, enums.RunStatusSkipped, false)
+}
+
+func (l updateLifecycle) OnFunctionStarted(_ context.Context, md statev2.Metadata, _ queue.Item, _ []json.RawMessage) {
+	l.publish(md, enums.RunStatusRunning, false)
+}
+
+func (l updateLifecycle) OnFunctionFinished(_ context.Context, md statev2.Metadata, _ queue.Item, _ []json.RawMessage, resp statev1.DriverResponse) {
+	status := enums.RunStatusCompleted
+	if resp.Err != nil {
+		status = enums.RunStatusFailed
+	}
+	l.publish(md, status, false)
+}
+
+func (l updateLifecycle) OnFunctionCancelled(_ context.Context, md statev2.Metadata, _ execution.CancelRequest, _ []json.RawMessage) {
+	l.publish(md, enums.RunStatusCancelled, false)
+}
+
+func (l updateLifecycle) OnStepScheduled(_ context.Context, md statev2.Metadata, _ queue.Item, _ *string) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnStepStarted(_ context.Context, md statev2.Metadata, _ queue.Item, _ inngest.Edge, _ string) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnStepFinished(_ context.Context, md statev2.Metadata, _ queue.Item, _ inngest.Edge, _ *statev1.DriverResponse, _ error) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnWaitForEvent(_ context.Context, md statev2.Metadata, _ queue.Item, _ statev1.GeneratorOpcode, _ statev1.Pause) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnWaitForEventResumed(_ context.Context, md statev2.Metadata, _ statev1.Pause, _ execution.ResumeRequest) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnInvokeFunction(_ context.Context, md statev2.Metadata, _ queue.Item, _ statev1.GeneratorOpcode, _ event.Event) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnInvokeFunctionResumed(_ context.Context, md statev2.Metadata, _ statev1.Pause, _ execution.ResumeRequest) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnWaitForSignal(_ context.Context, md statev2.Metadata, _ queue.Item, _ statev1.GeneratorOpcode, _ statev1.Pause) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnWaitForSignalResumed(_ context.Context, md statev2.Metadata, _ statev1.Pause, _ execution.ResumeRequest) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
+
+func (l updateLifecycle) OnSleep(_ context.Context, md statev2.Metadata, _ queue.Item, _ statev1.GeneratorOpcode, _ time.Time) {
+	l.publish(md, enums.RunStatusRunning, true)
+}
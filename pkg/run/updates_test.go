@@ -0,0 +1,118 @@
+package run
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	statev1 "github.com/inngest/inngest/pkg/execution/state"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/pkg/inngest"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveUpdate(t *testing.T, ch <-chan Update) Update {
+	t.Helper()
+	select {
+	case u := <-ch:
+		return u
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for update")
+		return Update{}
+	}
+}
+
+func requireNoUpdate(t *testing.T, ch <-chan Update) {
+	t.Helper()
+	select {
+	case u := <-ch:
+		t.Fatalf("unexpected update: %+v", u)
+	default:
+	}
+}
+
+func TestUpdateBroker(t *testing.T) {
+	ctx := t.Context()
+	b := NewUpdateBroker()
+	l := b.LifecycleListener()
+
+	appID, fnA, fnB := uuid.New(), uuid.New(), uuid.New()
+	mdA := statev2.Metadata{ID: statev2.ID{
+		RunID:      ulid.Make(),
+		FunctionID: fnA,
+		Tenant:     statev2.Tenant{AppID: appID},
+	}}
+	mdB := statev2.Metadata{ID: statev2.ID{
+		RunID:      ulid.Make(),
+		FunctionID: fnB,
+		Tenant:     statev2.Tenant{AppID: appID},
+	}}
+
+	all := b.Subscribe(ctx, UpdateFilter{})
+	byFn := b.Subscribe(ctx, UpdateFilter{FunctionID: &fnA, StatusOnly: true})
+	byApp := b.Subscribe(ctx, UpdateFilter{AppID: &appID, StatusOnly: true})
+	byRun := b.Subscribe(ctx, UpdateFilter{RunID: &mdA.ID.RunID})
+
+	l.OnFunctionStarted(ctx, mdA, queue.Item{}, nil)
+	l.OnStepFinished(ctx, mdA, queue.Item{}, inngest.Edge{}, nil, nil)
+	l.OnFunctionFinished(ctx, mdB, queue.Item{}, nil, statev1.DriverResponse{Err: strPtr("boom")})
+
+	u := receiveUpdate(t, all)
+	require.Equal(t, mdA.ID.RunID, u.RunID)
+	require.Equal(t, enums.RunStatusRunning, u.Status)
+	require.False(t, u.Step)
+	require.True(t, receiveUpdate(t, all).Step)
+	u = receiveUpdate(t, all)
+	require.Equal(t, enums.RunStatusFailed, u.Status)
+	require.True(t, u.Ended())
+
+	// Function subscriptions only see status changes for the function.
+	u = receiveUpdate(t, byFn)
+	require.Equal(t, fnA, u.FunctionID)
+	requireNoUpdate(t, byFn)
+
+	// App subscriptions see status changes for every function in the app.
+	require.Equal(t, fnA, receiveUpdate(t, byApp).FunctionID)
+	require.Equal(t, fnB, receiveUpdate(t, byApp).FunctionID)
+	requireNoUpdate(t, byApp)
+
+	// Run subscriptions see status changes and step updates for the run.
+	require.False(t, receiveUpdate(t, byRun).Step)
+	require.True(t, receiveUpdate(t, byRun).Step)
+	requireNoUpdate(t, byRun)
+}
+
+func TestUpdateBrokerSubscriberLifetime(t *testing.T) {
+	b := NewUpdateBroker()
+	b.buffer = 2
+
+	ctx, cancel := context.WithCancel(t.Context())
+	ch := b.Subscribe(ctx, UpdateFilter{})
+
+	// Publishing never blocks on slow subscribers.
+	for i := range 5 {
+		b.Publish(Update{RunID: ulid.Make(), At: time.UnixMilli(int64(i))})
+	}
+	require.Len(t, ch, 2)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+		return len(b.subs) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	var received int
+	for range ch {
+		received++
+	}
+	require.Equal(t, 2, received)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -107,6 +107,10 @@ var Attrs = struct {
 
 	// step.run attributes
 	StepRunType attr[*string]
+	// StepCacheHit marks steps whose output was memoized from the cross-run
+	// step cache instead of calling the SDK.
+	StepCacheHit attr[*bool]
+	StepCacheKey attr[*string]
 
 	// step.experiment attributes
 	ExperimentName    attr[*string]
@@ -134,6 +138,10 @@ var Attrs = struct {
 	// Signal attributes
 	StepSignalName attr[*string]
 
+	// Lock attributes
+	StepLockKey   attr[*string]
+	StepLockLease attr[*time.Duration]
+
 	// Gateway attributes
 	StepGatewayResponseStatusCode      attr[*int]
 	StepGatewayResponseOutputSizeBytes attr[*int]
@@ -242,11 +250,15 @@ var Attrs = struct {
 	StepOutput:                         StringAttr("step.output"),
 	StepOutputRef:                      StringAttr("step.output_ref"),
 	StepRunType:                        StringAttr("step.run.type"),
+	StepCacheHit:                       BoolAttr("step.cache.hit"),
+	StepCacheKey:                       StringAttr("step.cache.key"),
 	StepType:                           TextAttr[enums.StepType]("step.type"),
 	ExperimentName:                     StringAttr("inngest.experiment.name"),
 	ExperimentStepID:                   StringAttr("inngest.experiment.step_id"),
 	ExperimentVariant:                  StringAttr("inngest.experiment.variant"),
 	StepSignalName:                     StringAttr("step.signal.name"),
+	StepLockKey:                        StringAttr("step.lock.key"),
+	StepLockLease:                      DurationAttr("step.lock.lease"),
 	StepSleepDuration:                  DurationAttr("step.sleep.duration"),
 	StepUserlandID:                     TruncatedStringAttr("step.userland.id", 256),
 	StepUserlandIndex:                  IntAttr("step.userland.index"),
@@ -71,6 +71,8 @@ type ExtractedValues struct {
 	StepUserlandID *string
 	StepUserlandIndex *int
 	StepRunType *string
+	StepCacheHit *bool
+	StepCacheKey *string
 	ExperimentName *string
 	ExperimentStepID *string
 	ExperimentVariant *string
@@ -85,6 +87,8 @@ type ExtractedValues struct {
 	StepWaitForEventName *string
 	StepWaitForEventMatchedID *ulid.ULID
 	StepSignalName *string
+	StepLockKey *string
+	StepLockLease *time.Duration
 	StepGatewayResponseStatusCode *int
 	StepGatewayResponseOutputSizeBytes *int
 	RequestID *string
@@ -0,0 +1,248 @@
+// Package spanmap maps execution spans to the form they're exported in, so
+// that every exporter names spans and attributes the same way.  Attributes
+// with an OpenTelemetry semantic convention are exported under it, and other
+// Inngest attributes are exported with the "inngest." prefix.
+package spanmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/inngest/inngest/pkg/inngest/version"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// AttrPrefix replaces meta.AttrKeyPrefix in exported attributes.
+	AttrPrefix = "inngest."
+
+	// AttrStatus is the span's step status.
+	AttrStatus = AttrPrefix + "status"
+
+	// ScopeName is the instrumentation scope of Inngest spans.
+	ScopeName = "inngest"
+)
+
+// skippedAttrs are internal attributes which aren't exported.  Timings are
+// exported as the span's start and end times instead, and userland span
+// details as the span's name, kind, service and scope.
+var skippedAttrs = map[string]bool{
+	meta.Attrs.DynamicSpanID.Key():              true,
+	meta.Attrs.DynamicTraceID.Key():             true,
+	meta.Attrs.DropSpan.Key():                   true,
+	meta.Attrs.InternalLocation.Key():           true,
+	meta.Attrs.StartedAt.Key():                  true,
+	meta.Attrs.EndedAt.Key():                    true,
+	meta.Attrs.IsUserland.Key():                 true,
+	meta.Attrs.UserlandSpanID.Key():             true,
+	meta.Attrs.UserlandName.Key():               true,
+	meta.Attrs.UserlandKind.Key():               true,
+	meta.Attrs.UserlandServiceName.Key():        true,
+	meta.Attrs.UserlandScopeName.Key():          true,
+	meta.Attrs.UserlandScopeVersion.Key():       true,
+	meta.Attrs.UserlandResourceAttributes.Key(): true,
+}
+
+// ioAttrs are attributes containing user data, exported only with IncludeIO.
+var ioAttrs = map[string]bool{
+	meta.Attrs.EventsInput.Key(): true,
+	meta.Attrs.StepInput.Key():   true,
+	meta.Attrs.StepOutput.Key():  true,
+}
+
+// Opts configures how spans are mapped.
+type Opts struct {
+	// ServiceName is the service.name of mapped spans.  Userland spans keep
+	// the service name of the app that sent them.
+	ServiceName string
+	// IncludeIO exports event payloads and step inputs and outputs.  These may
+	// contain sensitive data, so they're omitted by default.
+	IncludeIO bool
+}
+
+// Span is the exported form of an execution span.
+type Span struct {
+	Name        string
+	Kind        trace.SpanKind
+	ServiceName string
+	Scope       instrumentation.Scope
+	// Attributes are sorted by key.
+	Attributes []attribute.KeyValue
+}
+
+// Map maps an execution span with the given name and attributes to its
+// exported form.  Attribute values may be raw values, as stored with spans,
+// or attribute values as returned by attribute.Value.AsInterface.
+func Map(name string, attrs map[string]any, opts Opts) Span {
+	s := Span{
+		Name:        name,
+		Kind:        trace.SpanKindInternal,
+		ServiceName: opts.ServiceName,
+		Scope:       instrumentation.Scope{Name: ScopeName, Version: version.Print()},
+	}
+
+	for k, v := range attrs {
+		if kv, ok := Attr(k, v, opts.IncludeIO); ok {
+			s.Attributes = append(s.Attributes, kv)
+		}
+	}
+
+	switch name {
+	case meta.SpanNameRun:
+		s.Kind = trace.SpanKindServer
+		if slug := str(attrs, meta.Attrs.FunctionSlug.Key()); slug != "" {
+			s.Name = slug
+		}
+		if _, ok := attrs[meta.Attrs.CronSchedule.Key()]; ok {
+			s.Attributes = append(s.Attributes, semconv.FaaSTriggerTimer)
+		} else {
+			s.Attributes = append(s.Attributes, semconv.FaaSTriggerPubsub)
+		}
+	case meta.SpanNameStep, meta.SpanNameStepDiscovery, meta.SpanNameExecution, meta.SpanNameStepFailed:
+		if step := str(attrs, meta.Attrs.StepName.Key()); step != "" {
+			s.Name = step
+		}
+	}
+
+	userland, _ := meta.Attrs.IsUserland.DeserializeTypedValue(attrs[meta.Attrs.IsUserland.Key()])
+	if name == meta.SpanNameUserland || (userland != nil && *userland) {
+		if n := str(attrs, meta.Attrs.UserlandName.Key()); n != "" {
+			s.Name = n
+		}
+		s.Kind = userlandSpanKind(str(attrs, meta.Attrs.UserlandKind.Key()))
+		if svc := str(attrs, meta.Attrs.UserlandServiceName.Key()); svc != "" {
+			s.ServiceName = svc
+		}
+		if scope := str(attrs, meta.Attrs.UserlandScopeName.Key()); scope != "" {
+			s.Scope = instrumentation.Scope{
+				Name:    scope,
+				Version: str(attrs, meta.Attrs.UserlandScopeVersion.Key()),
+			}
+		}
+	}
+
+	slices.SortFunc(s.Attributes, func(a, b attribute.KeyValue) int {
+		return strings.Compare(string(a.Key), string(b.Key))
+	})
+	return s
+}
+
+// Attr maps an execution span attribute to its exported form, returning false
+// for attributes that aren't exported.
+func Attr(key string, v any, includeIO bool) (attribute.KeyValue, bool) {
+	if skippedAttrs[key] || (ioAttrs[key] && !includeIO) {
+		return attribute.KeyValue{}, false
+	}
+
+	switch key {
+	case meta.Attrs.FunctionSlug.Key():
+		return semconv.FaaSNameKey.String(fmt.Sprint(v)), true
+	case meta.Attrs.RunID.Key():
+		return semconv.FaaSInvocationIDKey.String(fmt.Sprint(v)), true
+	case meta.Attrs.FunctionVersion.Key():
+		return semconv.FaaSVersionKey.String(fmt.Sprint(v)), true
+	case meta.Attrs.RequestURL.Key():
+		return semconv.HTTPURLKey.String(fmt.Sprint(v)), true
+	case meta.Attrs.ResponseStatusCode.Key():
+		if code, ok := toInt64(v); ok {
+			return semconv.HTTPStatusCodeKey.Int64(code), true
+		}
+	case meta.Attrs.DynamicStatus.Key():
+		return attribute.String(AttrStatus, fmt.Sprint(v)), true
+	}
+
+	// Userland attributes are exported as sent, and other Inngest
+	// attributes are exported without the internal prefix.
+	if rest, ok := strings.CutPrefix(key, meta.AttrKeyPrefix); ok {
+		key = AttrPrefix + rest
+	}
+	return attribute.KeyValue{Key: attribute.Key(key), Value: value(v)}, true
+}
+
+// value converts a raw or decoded JSON attribute value to an attribute value.
+func value(v any) attribute.Value {
+	switch val := v.(type) {
+	case string:
+		return attribute.StringValue(val)
+	case bool:
+		return attribute.BoolValue(val)
+	case int:
+		return attribute.IntValue(val)
+	case int64:
+		return attribute.Int64Value(val)
+	case float64:
+		return attribute.Float64Value(val)
+	case []string:
+		return attribute.StringSliceValue(val)
+	case []bool:
+		return attribute.BoolSliceValue(val)
+	case []int64:
+		return attribute.Int64SliceValue(val)
+	case []float64:
+		return attribute.Float64SliceValue(val)
+	case []any:
+		strs := make([]string, 0, len(val))
+		for _, item := range val {
+			s, ok := item.(string)
+			if !ok {
+				break
+			}
+			strs = append(strs, s)
+		}
+		if len(strs) == len(val) {
+			return attribute.StringSliceValue(strs)
+		}
+	case nil:
+		return attribute.StringValue("")
+	}
+
+	byt, err := json.Marshal(v)
+	if err != nil {
+		return attribute.StringValue(fmt.Sprintf("%v", v))
+	}
+	return attribute.StringValue(string(byt))
+}
+
+func toInt64(v any) (int64, bool) {
+	switch val := v.(type) {
+	case int:
+		return int64(val), true
+	case int64:
+		return val, true
+	case float64:
+		return int64(val), true
+	case string:
+		i, err := strconv.ParseInt(val, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func str(attrs map[string]any, key string) string {
+	s, _ := attrs[key].(string)
+	return s
+}
+
+// userlandSpanKind parses the kind of a userland span, as either the OTLP enum
+// name (eg. "SPAN_KIND_CLIENT") or trace.SpanKind's string form (eg. "client").
+func userlandSpanKind(kind string) trace.SpanKind {
+	switch strings.ToLower(strings.TrimPrefix(kind, "SPAN_KIND_")) {
+	case "server":
+		return trace.SpanKindServer
+	case "client":
+		return trace.SpanKindClient
+	case "producer":
+		return trace.SpanKindProducer
+	case "consumer":
+		return trace.SpanKindConsumer
+	default:
+		return trace.SpanKindInternal
+	}
+}
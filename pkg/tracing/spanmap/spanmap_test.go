@@ -0,0 +1,64 @@
+package spanmap
+
+import (
+	"testing"
+
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("maps run spans", func(t *testing.T) {
+		s := Map(meta.SpanNameRun, map[string]any{
+			meta.Attrs.FunctionSlug.Key():       "app-checkout",
+			meta.Attrs.ResponseStatusCode.Key(): "500",
+			meta.Attrs.DynamicSpanID.Key():      "span",
+			meta.Attrs.EventsInput.Key():        "[]",
+			meta.AttrKeyPrefix + "custom":       int64(1),
+		}, Opts{ServiceName: "inngest"})
+
+		require.Equal(t, "app-checkout", s.Name)
+		require.Equal(t, trace.SpanKindServer, s.Kind)
+		require.Equal(t, "inngest", s.ServiceName)
+		require.Equal(t, ScopeName, s.Scope.Name)
+		require.Equal(t, []attribute.KeyValue{
+			semconv.FaaSNameKey.String("app-checkout"),
+			semconv.FaaSTriggerPubsub,
+			semconv.HTTPStatusCodeKey.Int64(500),
+			attribute.Int64(AttrPrefix+"custom", 1),
+		}, s.Attributes)
+	})
+
+	t.Run("exports io when enabled", func(t *testing.T) {
+		s := Map(meta.SpanNameStep, map[string]any{
+			meta.Attrs.StepName.Key():  "charge",
+			meta.Attrs.StepInput.Key(): `{"amount":10}`,
+		}, Opts{IncludeIO: true})
+
+		require.Equal(t, "charge", s.Name)
+		require.Equal(t, trace.SpanKindInternal, s.Kind)
+		require.Contains(t, s.Attributes, attribute.String(AttrPrefix+"step.input", `{"amount":10}`))
+	})
+
+	t.Run("keeps userland span details", func(t *testing.T) {
+		s := Map(meta.SpanNameUserland, map[string]any{
+			meta.Attrs.IsUserland.Key():           "true",
+			meta.Attrs.UserlandName.Key():         "SELECT",
+			meta.Attrs.UserlandKind.Key():         "SPAN_KIND_CLIENT",
+			meta.Attrs.UserlandServiceName.Key():  "billing",
+			meta.Attrs.UserlandScopeName.Key():    "pg",
+			meta.Attrs.UserlandScopeVersion.Key(): "1.0.0",
+			"db.system":                           "postgresql",
+		}, Opts{ServiceName: "inngest"})
+
+		require.Equal(t, "SELECT", s.Name)
+		require.Equal(t, trace.SpanKindClient, s.Kind)
+		require.Equal(t, "billing", s.ServiceName)
+		require.Equal(t, "pg", s.Scope.Name)
+		require.Equal(t, "1.0.0", s.Scope.Version)
+		require.Equal(t, []attribute.KeyValue{attribute.String("db.system", "postgresql")}, s.Attributes)
+	})
+}
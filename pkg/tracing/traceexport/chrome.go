@@ -0,0 +1,145 @@
+package traceexport
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// The Chrome trace event format is described at
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU.
+//
+// Each span is a complete ("X") event.  Complete events on the same thread
+// must nest, so spans which overlap their siblings, eg. parallel steps, are
+// moved to a new thread.
+
+const (
+	chromePhaseComplete = "X"
+	chromePhaseMetadata = "M"
+
+	chromeCategorySpan  = "span"
+	chromeCategoryQueue = "queue"
+
+	// chromePID is the process ID of every event, as a trace only contains
+	// a single run.
+	chromePID = 1
+)
+
+type chromeTrace struct {
+	TraceEvents     []chromeEvent     `json:"traceEvents"`
+	DisplayTimeUnit string            `json:"displayTimeUnit"`
+	OtherData       map[string]string `json:"otherData,omitempty"`
+}
+
+type chromeEvent struct {
+	Name string `json:"name"`
+	Cat  string `json:"cat,omitempty"`
+	Ph   string `json:"ph"`
+	// Ts and Dur are in microseconds.
+	Ts   int64          `json:"ts"`
+	Dur  *int64         `json:"dur,omitempty"`
+	PID  int            `json:"pid"`
+	TID  int            `json:"tid"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+type chromeWriter struct {
+	events  []chromeEvent
+	nextTID int
+}
+
+func writeChrome(w io.Writer, root *span) error {
+	c := &chromeWriter{nextTID: 1}
+	c.add(root, c.nextTID)
+
+	trace := chromeTrace{
+		TraceEvents: append([]chromeEvent{{
+			Name: "process_name",
+			Ph:   chromePhaseMetadata,
+			PID:  chromePID,
+			Args: map[string]any{"name": root.name},
+		}}, c.events...),
+		DisplayTimeUnit: "ms",
+		OtherData: map[string]string{
+			"traceId": root.traceID,
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(trace)
+}
+
+// add adds events for the span on the given thread, then adds its children.
+func (c *chromeWriter) add(s *span, tid int) {
+	if !s.runnable.IsZero() {
+		c.events = append(c.events, chromeEvent{
+			Name: "queued",
+			Cat:  chromeCategoryQueue,
+			Ph:   chromePhaseComplete,
+			Ts:   s.runnable.UnixMicro(),
+			Dur:  duration(s.runnable, s.start),
+			PID:  chromePID,
+			TID:  tid,
+			Args: map[string]any{"span_id": s.spanID},
+		})
+	}
+
+	args := make(map[string]any, len(s.attrs)+2)
+	for k, v := range s.attrs {
+		args[k] = v
+	}
+	args["span_id"] = s.spanID
+	if s.parentSpanID != "" {
+		args["parent_span_id"] = s.parentSpanID
+	}
+
+	c.events = append(c.events, chromeEvent{
+		Name: s.name,
+		Cat:  chromeCategorySpan,
+		Ph:   chromePhaseComplete,
+		Ts:   s.start.UnixMicro(),
+		Dur:  duration(s.start, s.end),
+		PID:  chromePID,
+		TID:  tid,
+		Args: args,
+	})
+
+	// Children are sorted by start time.  Each is placed on the first
+	// thread that's free when it begins, starting with the parent's.
+	type lane struct {
+		tid int
+		end time.Time
+	}
+	lanes := []lane{{tid: tid}}
+	for _, child := range s.children {
+		begin := child.start
+		if !child.runnable.IsZero() {
+			begin = child.runnable
+		}
+
+		placed := -1
+		for i, l := range lanes {
+			if !begin.Before(l.end) {
+				placed = i
+				break
+			}
+		}
+		if placed == -1 {
+			c.nextTID++
+			lanes = append(lanes, lane{tid: c.nextTID})
+			placed = len(lanes) - 1
+		}
+		lanes[placed].end = child.end
+
+		c.add(child, lanes[placed].tid)
+	}
+}
+
+func duration(start, end time.Time) *int64 {
+	d := end.Sub(start).Microseconds()
+	if d < 0 {
+		d = 0
+	}
+	return &d
+}
@@ -0,0 +1,192 @@
+package traceexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/inngest/inngest/pkg/enums"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The OTLP JSON encoding follows the protobuf JSON mapping, except that trace
+// and span IDs are hex encoded rather than base64 encoded, so the protobuf
+// types can't be marshalled directly.
+//
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+
+type otlpTracesData struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource      `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              trace.SpanKind `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpStatusOk    = 1
+	otlpStatusError = 2
+)
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+func writeOTLP(w io.Writer, root *span) error {
+	data := otlpTracesData{}
+
+	// Group spans by service and instrumentation scope, keeping the order
+	// in which they're first seen.
+	resources := map[string]*otlpResourceSpans{}
+	scopes := map[[3]string]*otlpScopeSpans{}
+
+	walk(root, func(s *span) {
+		rs, ok := resources[s.serviceName]
+		if !ok {
+			rs = &otlpResourceSpans{
+				Resource: otlpResource{Attributes: []otlpKeyValue{
+					{Key: "service.name", Value: otlpValue(s.serviceName)},
+				}},
+			}
+			resources[s.serviceName] = rs
+			data.ResourceSpans = append(data.ResourceSpans, rs)
+		}
+
+		key := [3]string{s.serviceName, s.scopeName, s.scopeVersion}
+		ss, ok := scopes[key]
+		if !ok {
+			ss = &otlpScopeSpans{Scope: otlpScope{Name: s.scopeName, Version: s.scopeVersion}}
+			scopes[key] = ss
+			rs.ScopeSpans = append(rs.ScopeSpans, ss)
+		}
+
+		ss.Spans = append(ss.Spans, toOTLPSpan(s))
+	})
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+func toOTLPSpan(s *span) *otlpSpan {
+	out := &otlpSpan{
+		TraceID:           s.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentSpanID,
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: formatUnixNano(s.start),
+		EndTimeUnixNano:   formatUnixNano(s.end),
+	}
+
+	for _, k := range sortedKeys(s.attrs) {
+		out.Attributes = append(out.Attributes, otlpKeyValue{Key: k, Value: otlpValue(s.attrs[k])})
+	}
+
+	switch {
+	case s.failed():
+		out.Status = otlpStatus{Code: otlpStatusError, Message: s.status.String()}
+	case s.status == enums.StepStatusCompleted:
+		out.Status = otlpStatus{Code: otlpStatusOk}
+	}
+
+	return out
+}
+
+// otlpValue converts a decoded JSON attribute value to an OTLP value.
+func otlpValue(v any) otlpAnyValue {
+	switch val := v.(type) {
+	case string:
+		return otlpAnyValue{StringValue: &val}
+	case bool:
+		return otlpAnyValue{BoolValue: &val}
+	case int:
+		s := strconv.Itoa(val)
+		return otlpAnyValue{IntValue: &s}
+	case int64:
+		s := strconv.FormatInt(val, 10)
+		return otlpAnyValue{IntValue: &s}
+	case float64:
+		if val == math.Trunc(val) && math.Abs(val) < 1<<53 {
+			s := strconv.FormatInt(int64(val), 10)
+			return otlpAnyValue{IntValue: &s}
+		}
+		return otlpAnyValue{DoubleValue: &val}
+	case []any:
+		return otlpArray(val)
+	case []string:
+		return otlpArray(val)
+	case []bool:
+		return otlpArray(val)
+	case []int64:
+		return otlpArray(val)
+	case []float64:
+		return otlpArray(val)
+	case nil:
+		s := ""
+		return otlpAnyValue{StringValue: &s}
+	default:
+		byt, err := json.Marshal(val)
+		if err != nil {
+			s := fmt.Sprintf("%v", val)
+			return otlpAnyValue{StringValue: &s}
+		}
+		s := string(byt)
+		return otlpAnyValue{StringValue: &s}
+	}
+}
+
+func otlpArray[T any](items []T) otlpAnyValue {
+	arr := &otlpArrayValue{Values: make([]otlpAnyValue, 0, len(items))}
+	for _, item := range items {
+		arr.Values = append(arr.Values, otlpValue(item))
+	}
+	return otlpAnyValue{ArrayValue: arr}
+}
@@ -0,0 +1,271 @@
+// Package traceexport converts a single run's trace into formats understood by
+// external tools, so that runs can be shared with vendors or inspected in
+// trace viewers:
+//
+//   - OTLP JSON, as accepted by OpenTelemetry collectors and most tracing
+//     vendors.
+//   - The Chrome trace event format, as opened by Perfetto and
+//     chrome://tracing.
+package traceexport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngest/pkg/tracing/spanmap"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Format is a trace export format.
+type Format string
+
+const (
+	// FormatOTLP exports traces as OTLP JSON.
+	FormatOTLP Format = "otlp"
+	// FormatChrome exports traces in the Chrome trace event format.
+	FormatChrome Format = "chrome"
+)
+
+const (
+	// AttrQueueLatency is the time in milliseconds between a span becoming
+	// runnable and starting, ie. the time spent waiting in the queue.
+	AttrQueueLatency = spanmap.AttrPrefix + "queue.latency_ms"
+
+	defaultServiceName = "inngest"
+)
+
+// ParseFormat parses the given export format, defaulting to FormatOTLP.
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case "", FormatOTLP:
+		return FormatOTLP, nil
+	case FormatChrome, "perfetto":
+		return FormatChrome, nil
+	default:
+		return "", fmt.Errorf("unknown trace export format: %q", s)
+	}
+}
+
+// Filename returns the file name used when downloading the given run's trace.
+func (f Format) Filename(runID string) string {
+	if f == FormatChrome {
+		return runID + ".trace.json"
+	}
+	return runID + ".otlp.json"
+}
+
+// OutputReader loads span inputs and outputs, which are stored separately from
+// spans.
+type OutputReader interface {
+	GetSpanOutput(ctx context.Context, id cqrs.SpanIdentifier) (*cqrs.SpanOutput, error)
+}
+
+// Opts configures a trace export.
+type Opts struct {
+	Format Format
+	// IncludeIO exports event payloads and step inputs and outputs.  These may
+	// contain sensitive data, so they're omitted by default.
+	IncludeIO bool
+	// Outputs loads inputs and outputs when IncludeIO is set.
+	Outputs OutputReader
+	// ServiceName is the service.name of exported spans.  Userland spans keep
+	// the service name of the app that sent them.  Defaults to "inngest".
+	ServiceName string
+}
+
+// Write writes the trace rooted at the given run span to w in the format
+// given by opts.
+func Write(ctx context.Context, w io.Writer, root *cqrs.OtelSpan, opts Opts) error {
+	if root == nil {
+		return fmt.Errorf("no trace to export")
+	}
+	if opts.ServiceName == "" {
+		opts.ServiceName = defaultServiceName
+	}
+
+	s, err := convert(ctx, root, root.TraceID, opts)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case "", FormatOTLP:
+		return writeOTLP(w, s)
+	case FormatChrome:
+		return writeChrome(w, s)
+	default:
+		return fmt.Errorf("unknown trace export format: %q", opts.Format)
+	}
+}
+
+// span is a format-agnostic span, with Inngest attributes mapped to their
+// exported form by spanmap.
+type span struct {
+	traceID      string
+	spanID       string
+	parentSpanID string
+
+	name   string
+	kind   trace.SpanKind
+	status enums.StepStatus
+
+	start time.Time
+	end   time.Time
+	// runnable is when the span was runnable, used to show time spent in
+	// the queue.  This is zero if unknown.
+	runnable time.Time
+
+	serviceName  string
+	scopeName    string
+	scopeVersion string
+
+	attrs    map[string]any
+	children []*span
+}
+
+// failed returns whether the span's status is an error.
+func (s *span) failed() bool {
+	switch s.status {
+	case enums.StepStatusFailed, enums.StepStatusErrored, enums.StepStatusTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// convert maps the given span and its children, skipping dropped spans.
+func convert(ctx context.Context, in *cqrs.OtelSpan, traceID string, opts Opts) (*span, error) {
+	values := in.Attributes
+	if values == nil {
+		values = &meta.ExtractedValues{}
+	}
+
+	mapped := spanmap.Map(in.Name, in.RawOtelSpan.Attributes, spanmap.Opts{
+		ServiceName: opts.ServiceName,
+		IncludeIO:   opts.IncludeIO,
+	})
+
+	s := &span{
+		traceID:      in.TraceID,
+		spanID:       in.SpanID,
+		name:         mapped.Name,
+		kind:         mapped.Kind,
+		status:       in.Status,
+		start:        in.StartTime,
+		end:          in.EndTime,
+		serviceName:  mapped.ServiceName,
+		scopeName:    mapped.Scope.Name,
+		scopeVersion: mapped.Scope.Version,
+		attrs:        make(map[string]any, len(mapped.Attributes)),
+	}
+	if s.traceID == "" {
+		s.traceID = traceID
+	}
+	if in.ParentSpanID != nil {
+		s.parentSpanID = *in.ParentSpanID
+	}
+	if s.end.Before(s.start) {
+		s.end = s.start
+	}
+
+	for _, kv := range mapped.Attributes {
+		s.attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	s.attrs[spanmap.AttrStatus] = in.Status.String()
+
+	// Queue timestamps are stored as strings, so export them as numbers
+	// alongside the time spent in the queue.
+	if values.QueuedAt != nil {
+		s.attrs[spanmap.AttrPrefix+"queued_at"] = values.QueuedAt.UnixMilli()
+		s.runnable = *values.QueuedAt
+	}
+	if values.ScheduledAt != nil {
+		s.attrs[spanmap.AttrPrefix+"scheduled_at"] = values.ScheduledAt.UnixMilli()
+		s.runnable = *values.ScheduledAt
+	}
+	if !s.runnable.IsZero() && s.runnable.Before(s.start) {
+		s.attrs[AttrQueueLatency] = s.start.Sub(s.runnable).Milliseconds()
+	} else {
+		s.runnable = time.Time{}
+	}
+
+	if opts.IncludeIO && opts.Outputs != nil && in.GetOutputID() != nil {
+		if err := loadIO(ctx, s, *in.GetOutputID(), opts.Outputs); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, child := range in.Children {
+		if child == nil || child.MarkedAsDropped {
+			continue
+		}
+		c, err := convert(ctx, child, s.traceID, opts)
+		if err != nil {
+			return nil, err
+		}
+		c.parentSpanID = s.spanID
+		s.children = append(s.children, c)
+	}
+	slices.SortStableFunc(s.children, func(a, b *span) int {
+		return a.start.Compare(b.start)
+	})
+
+	return s, nil
+}
+
+func loadIO(ctx context.Context, s *span, outputID string, r OutputReader) error {
+	var id cqrs.SpanIdentifier
+	if err := id.Decode(outputID); err != nil {
+		return fmt.Errorf("error decoding span output ID: %w", err)
+	}
+
+	out, err := r.GetSpanOutput(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error loading span output: %w", err)
+	}
+	if out == nil {
+		return nil
+	}
+
+	if len(out.Input) > 0 {
+		s.attrs[spanmap.AttrPrefix+"step.input"] = string(out.Input)
+	}
+	if len(out.Data) > 0 {
+		s.attrs[spanmap.AttrPrefix+"step.output"] = string(out.Data)
+	}
+	return nil
+}
+
+// walk calls f for the given span and all of its descendants, depth first.
+func walk(s *span, f func(*span)) {
+	f(s)
+	for _, c := range s.children {
+		walk(c, f)
+	}
+}
+
+// sortedKeys returns the span's attribute keys in order, so that exports are
+// deterministic.
+func sortedKeys(attrs map[string]any) []string {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func formatUnixNano(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
@@ -0,0 +1,203 @@
+package traceexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/pkg/cqrs"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngest/pkg/tracing/spanmap"
+	"github.com/stretchr/testify/require"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceID = "0af7651916cd43dd8448eb211c80319c"
+
+type outputReader map[string]*cqrs.SpanOutput
+
+func (o outputReader) GetSpanOutput(ctx context.Context, id cqrs.SpanIdentifier) (*cqrs.SpanOutput, error) {
+	return o[id.SpanID], nil
+}
+
+func testTrace(t *testing.T) *cqrs.OtelSpan {
+	t.Helper()
+
+	start := time.UnixMilli(1_700_000_000_000)
+	queuedAt := start.Add(-50 * time.Millisecond)
+	slug := "app-checkout"
+	stepA, stepB := "charge", "email"
+
+	outputID, err := (&cqrs.SpanIdentifier{TraceID: traceID, SpanID: "b7ad6b7169203331"}).Encode()
+	require.NoError(t, err)
+
+	step := func(id, name string, offset, dur time.Duration) *cqrs.OtelSpan {
+		return &cqrs.OtelSpan{
+			RawOtelSpan: cqrs.RawOtelSpan{
+				Name:      meta.SpanNameStep,
+				SpanID:    id,
+				TraceID:   traceID,
+				StartTime: start.Add(offset),
+				EndTime:   start.Add(offset + dur),
+				Attributes: map[string]any{
+					meta.Attrs.StepName.Key():      name,
+					meta.Attrs.DynamicSpanID.Key(): id,
+				},
+			},
+			Status:     enums.StepStatusCompleted,
+			Attributes: &meta.ExtractedValues{StepName: &name},
+		}
+	}
+
+	a := step("b7ad6b7169203331", stepA, 10*time.Millisecond, 100*time.Millisecond)
+	a.OutputID = &outputID
+	// b runs in parallel with a.
+	b := step("c7ad6b7169203332", stepB, 20*time.Millisecond, 100*time.Millisecond)
+	b.Status = enums.StepStatusFailed
+	dropped := step("d7ad6b7169203333", "dropped", 0, time.Millisecond)
+	dropped.MarkedAsDropped = true
+
+	return &cqrs.OtelSpan{
+		RawOtelSpan: cqrs.RawOtelSpan{
+			Name:      meta.SpanNameRun,
+			SpanID:    "a7ad6b7169203330",
+			TraceID:   traceID,
+			StartTime: start,
+			EndTime:   start.Add(200 * time.Millisecond),
+			Attributes: map[string]any{
+				meta.Attrs.FunctionSlug.Key(): slug,
+				meta.Attrs.QueuedAt.Key():     "1699999999950",
+				meta.Attrs.EventsInput.Key():  `[{"name":"order.created"}]`,
+			},
+		},
+		Status: enums.StepStatusCompleted,
+		Attributes: &meta.ExtractedValues{
+			FunctionSlug: &slug,
+			QueuedAt:     &queuedAt,
+		},
+		Children: []*cqrs.OtelSpan{b, dropped, a},
+	}
+}
+
+func TestWriteOTLP(t *testing.T) {
+	ctx := context.Background()
+
+	buf := &bytes.Buffer{}
+	err := Write(ctx, buf, testTrace(t), Opts{Format: FormatOTLP})
+	require.NoError(t, err)
+
+	data := otlpTracesData{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &data))
+	require.Len(t, data.ResourceSpans, 1)
+	require.Equal(t, "inngest", *data.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	require.Len(t, data.ResourceSpans[0].ScopeSpans, 1)
+
+	spans := data.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 3, "dropped spans should not be exported")
+
+	run := spans[0]
+	require.Equal(t, "app-checkout", run.Name)
+	require.Equal(t, traceID, run.TraceID)
+	require.Equal(t, trace.SpanKindServer, run.Kind)
+	require.Equal(t, "1700000000000000000", run.StartTimeUnixNano)
+	require.Equal(t, otlpStatusOk, run.Status.Code)
+
+	attrs := map[string]otlpAnyValue{}
+	for _, kv := range run.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	require.Equal(t, "50", *attrs[AttrQueueLatency].IntValue)
+	require.Equal(t, "1699999999950", *attrs["inngest.queued_at"].IntValue)
+	require.NotContains(t, attrs, "inngest.events.input", "IO should be omitted by default")
+	// Attributes are mapped as by the OTLP run exporter.
+	require.Equal(t, "app-checkout", *attrs[string(semconv.FaaSNameKey)].StringValue)
+	require.Equal(t, "Completed", *attrs[spanmap.AttrStatus].StringValue)
+
+	// Children are ordered by start time and parented to the run.
+	require.Equal(t, "charge", spans[1].Name)
+	require.Equal(t, run.SpanID, spans[1].ParentSpanID)
+	require.Equal(t, "email", spans[2].Name)
+	require.Equal(t, otlpStatusError, spans[2].Status.Code)
+	dynamicSpanID := spanmap.AttrPrefix + strings.TrimPrefix(meta.Attrs.DynamicSpanID.Key(), meta.AttrKeyPrefix)
+	for _, kv := range spans[1].Attributes {
+		require.NotEqual(t, dynamicSpanID, kv.Key)
+	}
+}
+
+func TestWriteIncludeIO(t *testing.T) {
+	ctx := context.Background()
+
+	outputs := outputReader{
+		"b7ad6b7169203331": {Input: []byte(`{"amount":10}`), Data: []byte(`{"ok":true}`)},
+	}
+
+	buf := &bytes.Buffer{}
+	err := Write(ctx, buf, testTrace(t), Opts{Format: FormatOTLP, IncludeIO: true, Outputs: outputs})
+	require.NoError(t, err)
+
+	data := otlpTracesData{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &data))
+	spans := data.ResourceSpans[0].ScopeSpans[0].Spans
+
+	attrs := map[string]string{}
+	for _, s := range spans {
+		for _, kv := range s.Attributes {
+			if kv.Value.StringValue != nil {
+				attrs[kv.Key] = *kv.Value.StringValue
+			}
+		}
+	}
+	require.Equal(t, `[{"name":"order.created"}]`, attrs["inngest.events.input"])
+	require.Equal(t, `{"amount":10}`, attrs["inngest.step.input"])
+	require.Equal(t, `{"ok":true}`, attrs["inngest.step.output"])
+}
+
+func TestWriteChrome(t *testing.T) {
+	ctx := context.Background()
+
+	buf := &bytes.Buffer{}
+	err := Write(ctx, buf, testTrace(t), Opts{Format: FormatChrome})
+	require.NoError(t, err)
+
+	trace := chromeTrace{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &trace))
+	require.Equal(t, traceID, trace.OtherData["traceId"])
+
+	byName := map[string]chromeEvent{}
+	for _, e := range trace.TraceEvents {
+		byName[e.Name] = e
+	}
+
+	require.Equal(t, chromePhaseMetadata, byName["process_name"].Ph)
+
+	run := byName["app-checkout"]
+	require.Equal(t, int64(1_700_000_000_000_000), run.Ts)
+	require.Equal(t, int64(200_000), *run.Dur)
+
+	queued := byName["queued"]
+	require.Equal(t, chromeCategoryQueue, queued.Cat)
+	require.Equal(t, int64(50_000), *queued.Dur)
+	require.Equal(t, run.Ts, queued.Ts+*queued.Dur)
+
+	// Overlapping siblings are placed on separate threads.
+	require.Equal(t, run.TID, byName["charge"].TID)
+	require.NotEqual(t, byName["charge"].TID, byName["email"].TID)
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("")
+	require.NoError(t, err)
+	require.Equal(t, FormatOTLP, f)
+
+	f, err = ParseFormat("Perfetto")
+	require.NoError(t, err)
+	require.Equal(t, FormatChrome, f)
+
+	_, err = ParseFormat("zipkin")
+	require.Error(t, err)
+}
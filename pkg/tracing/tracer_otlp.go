@@ -0,0 +1,370 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/inngest/inngest/pkg/tracing/spanmap"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// OTLPProtocolHTTP exports run traces using OTLP over HTTP.
+	OTLPProtocolHTTP = "http"
+	// OTLPProtocolGRPC exports run traces using OTLP over gRPC.
+	OTLPProtocolGRPC = "grpc"
+
+	defaultOTLPServiceName = "inngest"
+	defaultMaxPendingSpans = 100_000
+	defaultPendingSpanTTL  = 24 * time.Hour
+)
+
+// exportedSpanNames are the execution spans exported to external collectors.
+// Other spans, such as metadata spans, only make sense within Inngest.
+var exportedSpanNames = map[string]bool{
+	meta.SpanNameRun:           true,
+	meta.SpanNameStep:          true,
+	meta.SpanNameStepDiscovery: true,
+	meta.SpanNameExecution:     true,
+	meta.SpanNameStepFailed:    true,
+	meta.SpanNameUserland:      true,
+}
+
+// OTLPExportOpts configures exporting run traces to an external OTLP
+// collector.
+type OTLPExportOpts struct {
+	// Endpoint is the collector endpoint, either as host:port or as a URL, eg.
+	// "localhost:4318" or "https://api.honeycomb.io".
+	Endpoint string `json:"endpoint"`
+	// Protocol is either OTLPProtocolHTTP or OTLPProtocolGRPC.  Defaults to
+	// OTLPProtocolHTTP.
+	Protocol string `json:"protocol"`
+	// Headers are sent with every export request, eg. for authentication.
+	Headers map[string]string `json:"-"`
+	// Insecure disables TLS.
+	Insecure bool `json:"insecure"`
+	// ServiceName is the service.name of exported spans.  Userland spans keep
+	// the service name of the app that sent them.  Defaults to "inngest".
+	ServiceName string `json:"service_name"`
+	// IncludeIO exports event payloads and step inputs and outputs.  These may
+	// contain sensitive data, so they're omitted by default.
+	IncludeIO bool `json:"include_io"`
+
+	// MaxPendingSpans is the maximum number of unfinished spans held in memory
+	// while waiting for them to finish.
+	MaxPendingSpans int `json:"-"`
+	// PendingSpanTTL is how long unfinished spans are held in memory before
+	// they're dropped.
+	PendingSpanTTL time.Duration `json:"-"`
+}
+
+// NewOTLPRunExporter returns a span exporter which forwards run traces to an
+// external OTLP collector.  It's used alongside the exporter writing run
+// traces to the store, eg. via NewSqlcTracerProvider.
+func NewOTLPRunExporter(ctx context.Context, opts OTLPExportOpts) (sdktrace.SpanExporter, error) {
+	if opts.Endpoint == "" {
+		return nil, fmt.Errorf("no OTLP endpoint provided")
+	}
+
+	var (
+		client otlptrace.Client
+		isURL  = strings.Contains(opts.Endpoint, "://")
+	)
+	switch opts.Protocol {
+	case "", OTLPProtocolHTTP:
+		copts := []otlptracehttp.Option{otlptracehttp.WithHeaders(opts.Headers)}
+		if isURL {
+			copts = append(copts, otlptracehttp.WithEndpointURL(opts.Endpoint))
+		} else {
+			copts = append(copts, otlptracehttp.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			copts = append(copts, otlptracehttp.WithInsecure())
+		}
+		client = otlptracehttp.NewClient(copts...)
+	case OTLPProtocolGRPC:
+		copts := []otlptracegrpc.Option{otlptracegrpc.WithHeaders(opts.Headers)}
+		if isURL {
+			copts = append(copts, otlptracegrpc.WithEndpointURL(opts.Endpoint))
+		} else {
+			copts = append(copts, otlptracegrpc.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			copts = append(copts, otlptracegrpc.WithInsecure())
+		}
+		client = otlptracegrpc.NewClient(copts...)
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol: %q", opts.Protocol)
+	}
+
+	exp, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("error creating otlp trace exporter: %w", err)
+	}
+
+	return newRunSpanExporter(exp, opts), nil
+}
+
+// runSpanExporter converts execution spans into finished spans for external
+// collectors.
+//
+// Execution spans are written when a run or step starts and extended with
+// EXTEND spans as their status changes, which readers of the trace store
+// merge at query time.  External collectors expect a single span with its
+// final duration and status, so unfinished spans are held in memory until an
+// extension ends them.
+type runSpanExporter struct {
+	opts OTLPExportOpts
+
+	// bsp batches finished spans, exporting them without blocking execution.
+	bsp sdktrace.SpanProcessor
+
+	mu      sync.Mutex
+	pending map[string]*pendingSpan
+	full    bool
+}
+
+type pendingSpan struct {
+	span      tracetest.SpanStub
+	attrs     map[attribute.Key]attribute.Value
+	status    enums.StepStatus
+	createdAt time.Time
+}
+
+func newRunSpanExporter(exp sdktrace.SpanExporter, opts OTLPExportOpts) *runSpanExporter {
+	if opts.ServiceName == "" {
+		opts.ServiceName = defaultOTLPServiceName
+	}
+	if opts.MaxPendingSpans <= 0 {
+		opts.MaxPendingSpans = defaultMaxPendingSpans
+	}
+	if opts.PendingSpanTTL <= 0 {
+		opts.PendingSpanTTL = defaultPendingSpanTTL
+	}
+	return &runSpanExporter{
+		opts:    opts,
+		bsp:     sdktrace.NewBatchSpanProcessor(exp),
+		pending: map[string]*pendingSpan{},
+	}
+}
+
+func (e *runSpanExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	for _, s := range spans {
+		attrs := make(map[attribute.Key]attribute.Value, len(s.Attributes()))
+		for _, kv := range s.Attributes() {
+			attrs[kv.Key] = kv.Value
+		}
+
+		dynamicSpanID := attrs[attribute.Key(meta.Attrs.DynamicSpanID.Key())].AsString()
+		traceID := s.SpanContext().TraceID().String()
+		if tid := attrs[attribute.Key(meta.Attrs.DynamicTraceID.Key())].AsString(); tid != "" {
+			traceID = tid
+		}
+		// Deterministic step span IDs are shared across runs, so pending
+		// spans are keyed by trace too.
+		key := traceID + ":" + dynamicSpanID
+
+		if s.Name() == meta.SpanNameDynamicExtension {
+			p, ok := e.pending[key]
+			if !ok {
+				// The span was started before this exporter, or was dropped.
+				continue
+			}
+			p.merge(attrs)
+			if p.ended() {
+				delete(e.pending, key)
+				e.emit(ctx, p)
+			}
+			continue
+		}
+
+		if !exportedSpanNames[s.Name()] {
+			continue
+		}
+		if drop, _ := meta.Attrs.DropSpan.DeserializeTypedValue(attrs[attribute.Key(meta.Attrs.DropSpan.Key())].AsInterface()); drop != nil && *drop {
+			continue
+		}
+
+		p := &pendingSpan{
+			span:      tracetest.SpanStubFromReadOnlySpan(s),
+			attrs:     map[attribute.Key]attribute.Value{},
+			createdAt: now,
+		}
+		p.merge(attrs)
+
+		// Spans created with an end time, such as userland spans, are
+		// already finished.
+		if p.ended() || dynamicSpanID == "" {
+			e.emit(ctx, p)
+			continue
+		}
+
+		if prev, ok := e.pending[key]; ok {
+			// Spans sharing a dynamic span ID are separate physical spans of
+			// the same logical span; export the earlier one as it stands.
+			e.emit(ctx, prev)
+		} else if len(e.pending) >= e.opts.MaxPendingSpans {
+			e.sweep(now)
+			if len(e.pending) >= e.opts.MaxPendingSpans {
+				if !e.full {
+					logger.StdlibLogger(ctx).Warn("dropping spans from otlp run trace export; too many unfinished spans", "max", e.opts.MaxPendingSpans)
+					e.full = true
+				}
+				continue
+			}
+		}
+		e.full = false
+		e.pending[key] = p
+	}
+
+	return nil
+}
+
+// sweep drops unfinished spans older than the pending span TTL.
+func (e *runSpanExporter) sweep(now time.Time) {
+	for id, p := range e.pending {
+		if now.Sub(p.createdAt) > e.opts.PendingSpanTTL {
+			delete(e.pending, id)
+		}
+	}
+}
+
+func (e *runSpanExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	e.pending = map[string]*pendingSpan{}
+	e.mu.Unlock()
+	return e.bsp.Shutdown(ctx)
+}
+
+// ForceFlush exports all finished spans.
+func (e *runSpanExporter) ForceFlush(ctx context.Context) error {
+	return e.bsp.ForceFlush(ctx)
+}
+
+func (p *pendingSpan) merge(attrs map[attribute.Key]attribute.Value) {
+	for k, v := range attrs {
+		p.attrs[k] = v
+	}
+	if v, ok := p.attrs[attribute.Key(meta.Attrs.DynamicStatus.Key())]; ok {
+		if status, ok := meta.Attrs.DynamicStatus.DeserializeTypedValue(v.AsInterface()); ok && status != nil {
+			p.status = *status
+		}
+	}
+}
+
+// ended returns whether the span has finished.
+func (p *pendingSpan) ended() bool {
+	return p.status.IsEnded() || p.has(meta.Attrs.EndedAt.Key())
+}
+
+func (p *pendingSpan) has(key string) bool {
+	_, ok := p.attrs[attribute.Key(key)]
+	return ok
+}
+
+func (p *pendingSpan) str(key string) string {
+	return p.attrs[attribute.Key(key)].AsString()
+}
+
+func (p *pendingSpan) time(key string) (time.Time, bool) {
+	v, ok := p.attrs[attribute.Key(key)]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, ok := meta.Attrs.EndedAt.DeserializeTypedValue(v.AsInterface())
+	if !ok || t == nil {
+		return time.Time{}, false
+	}
+	return *t, true
+}
+
+// emit converts the span to an exportable span, mapping Inngest attributes to
+// semantic conventions, and queues it for export.
+func (e *runSpanExporter) emit(ctx context.Context, p *pendingSpan) {
+	stub := p.span
+
+	if tid := p.str(meta.Attrs.DynamicTraceID.Key()); tid != "" {
+		if traceID, err := trace.TraceIDFromHex(tid); err == nil {
+			stub.SpanContext = stub.SpanContext.WithTraceID(traceID)
+			if stub.Parent.IsValid() {
+				stub.Parent = stub.Parent.WithTraceID(traceID)
+			}
+		}
+	}
+
+	if t, ok := p.time(meta.Attrs.StartedAt.Key()); ok {
+		stub.StartTime = t
+	}
+	if t, ok := p.time(meta.Attrs.EndedAt.Key()); ok {
+		stub.EndTime = t
+	}
+	if stub.EndTime.Before(stub.StartTime) {
+		stub.EndTime = stub.StartTime
+	}
+
+	attrs := make(map[string]any, len(p.attrs))
+	for k, v := range p.attrs {
+		attrs[string(k)] = v.AsInterface()
+	}
+	mapped := spanmap.Map(stub.Name, attrs, spanmap.Opts{
+		ServiceName: e.opts.ServiceName,
+		IncludeIO:   e.opts.IncludeIO,
+	})
+	stub.Name = mapped.Name
+	stub.SpanKind = mapped.Kind
+	stub.InstrumentationScope = mapped.Scope
+	stub.Attributes = mapped.Attributes
+	stub.Resource = resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(mapped.ServiceName))
+
+	switch p.status {
+	case enums.StepStatusFailed, enums.StepStatusErrored, enums.StepStatusTimedOut:
+		stub.Status = sdktrace.Status{Code: codes.Error, Description: p.status.String()}
+	case enums.StepStatusCompleted:
+		stub.Status = sdktrace.Status{Code: codes.Ok}
+	}
+
+	e.bsp.OnEnd(stub.Snapshot())
+}
+
+// fanoutExporter exports spans to several exporters.
+type fanoutExporter []sdktrace.SpanExporter
+
+func (f fanoutExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	var errs []error
+	for _, exp := range f {
+		if err := exp.ExportSpans(ctx, spans); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f fanoutExporter) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, exp := range f {
+		if err := exp.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,255 @@
+package tracing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/tracing/meta"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func exportedAttr(s tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestRunSpanExporter(t *testing.T) {
+	ctx := t.Context()
+
+	newExporter := func(opts OTLPExportOpts) (*runSpanExporter, *tracetest.InMemoryExporter, TracerProvider) {
+		mem := tracetest.NewInMemoryExporter()
+		exp := newRunSpanExporter(mem, opts)
+		return exp, mem, NewOtelTracerProvider(exp, time.Millisecond)
+	}
+
+	t.Run("exports runs and steps once they end", func(t *testing.T) {
+		exp, mem, tp := newExporter(OTLPExportOpts{})
+
+		runID := ulid.Make()
+		slug := "app-fn"
+		version := 3
+		input := `[{"name":"test/event"}]`
+		start := time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+
+		runAttrs := meta.NewAttrSet(
+			meta.Attr(meta.Attrs.RunID, &runID),
+			meta.Attr(meta.Attrs.FunctionSlug, &slug),
+			meta.Attr(meta.Attrs.FunctionVersion, &version),
+			meta.Attr(meta.Attrs.EventsInput, &input),
+		)
+		run, err := tp.CreateSpan(ctx, meta.SpanNameRun, &CreateSpanOptions{
+			Seed:       []byte(runID.String()),
+			StartTime:  start,
+			Attributes: runAttrs,
+		})
+		require.NoError(t, err)
+
+		stepName := "send-email"
+		step, err := tp.CreateSpan(ctx, meta.SpanNameStep, &CreateSpanOptions{
+			Parent:     run,
+			StartTime:  start.Add(time.Second),
+			Attributes: meta.NewAttrSet(meta.Attr(meta.Attrs.StepName, &stepName)),
+		})
+		require.NoError(t, err)
+
+		// Nothing is exported while spans are running.
+		require.NoError(t, exp.ForceFlush(ctx))
+		require.Empty(t, mem.GetSpans())
+
+		statusCode := 500
+		require.NoError(t, tp.UpdateSpan(ctx, &UpdateSpanOptions{
+			TargetSpan: step,
+			Status:     enums.StepStatusFailed,
+			EndTime:    start.Add(2 * time.Second),
+			Attributes: meta.NewAttrSet(meta.Attr(meta.Attrs.ResponseStatusCode, &statusCode)),
+		}))
+		require.NoError(t, tp.UpdateSpan(ctx, &UpdateSpanOptions{
+			TargetSpan: run,
+			Status:     enums.StepStatusCompleted,
+			EndTime:    start.Add(3 * time.Second),
+		}))
+
+		require.NoError(t, exp.ForceFlush(ctx))
+		spans := mem.GetSpans()
+		require.Len(t, spans, 2)
+
+		stepSpan, runSpan := spans[0], spans[1]
+		require.Equal(t, "send-email", stepSpan.Name)
+		require.Equal(t, trace.SpanKindInternal, stepSpan.SpanKind)
+		require.Equal(t, codes.Error, stepSpan.Status.Code)
+		require.Equal(t, runSpan.SpanContext.TraceID(), stepSpan.SpanContext.TraceID())
+		require.Equal(t, runSpan.SpanContext.SpanID(), stepSpan.Parent.SpanID())
+		v, ok := exportedAttr(stepSpan, semconv.HTTPStatusCodeKey)
+		require.True(t, ok)
+		require.EqualValues(t, 500, v.AsInt64())
+
+		require.Equal(t, "app-fn", runSpan.Name)
+		require.Equal(t, trace.SpanKindServer, runSpan.SpanKind)
+		require.Equal(t, codes.Ok, runSpan.Status.Code)
+		require.Equal(t, start, runSpan.StartTime)
+		require.Equal(t, start.Add(3*time.Second), runSpan.EndTime)
+		require.Contains(t, runSpan.Resource.Attributes(), semconv.ServiceName("inngest"))
+
+		v, ok = exportedAttr(runSpan, semconv.FaaSInvocationIDKey)
+		require.True(t, ok)
+		require.Equal(t, runID.String(), v.AsString())
+		v, ok = exportedAttr(runSpan, semconv.FaaSNameKey)
+		require.True(t, ok)
+		require.Equal(t, "app-fn", v.AsString())
+		v, ok = exportedAttr(runSpan, semconv.FaaSVersionKey)
+		require.True(t, ok)
+		require.Equal(t, "3", v.AsString())
+		require.Contains(t, runSpan.Attributes, semconv.FaaSTriggerPubsub)
+
+		// Internal and IO attributes aren't exported.
+		for _, kv := range runSpan.Attributes {
+			require.NotContains(t, string(kv.Key), meta.AttrKeyPrefix)
+		}
+		_, ok = exportedAttr(runSpan, attribute.Key("inngest.events.input"))
+		require.False(t, ok)
+	})
+
+	t.Run("exports IO when enabled", func(t *testing.T) {
+		exp, mem, tp := newExporter(OTLPExportOpts{IncludeIO: true})
+
+		input := `[{"name":"test/event"}]`
+		run, err := tp.CreateSpan(ctx, meta.SpanNameRun, &CreateSpanOptions{
+			Attributes: meta.NewAttrSet(meta.Attr(meta.Attrs.EventsInput, &input)),
+		})
+		require.NoError(t, err)
+		require.NoError(t, tp.UpdateSpan(ctx, &UpdateSpanOptions{
+			TargetSpan: run,
+			Status:     enums.StepStatusCompleted,
+		}))
+
+		require.NoError(t, exp.ForceFlush(ctx))
+		spans := mem.GetSpans()
+		require.Len(t, spans, 1)
+		v, ok := exportedAttr(spans[0], attribute.Key("inngest.events.input"))
+		require.True(t, ok)
+		require.Equal(t, input, v.AsString())
+	})
+
+	t.Run("exports userland spans as sent", func(t *testing.T) {
+		exp, mem, tp := newExporter(OTLPExportOpts{ServiceName: "my-inngest"})
+
+		run, err := tp.CreateSpan(ctx, meta.SpanNameRun, &CreateSpanOptions{})
+		require.NoError(t, err)
+
+		var (
+			isUserland = true
+			spanID     = trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8}
+			sid        = spanID.String()
+			name       = "db.query"
+			kind       = trace.SpanKindClient.String()
+			svc        = "billing"
+			scope      = "pg"
+			scopeVer   = "1.0.0"
+			start      = time.Now().Add(-time.Second).Truncate(time.Millisecond)
+		)
+		_, err = tp.CreateSpan(ctx, meta.SpanNameUserland, &CreateSpanOptions{
+			Parent:    run,
+			SpanID:    spanID,
+			StartTime: start,
+			EndTime:   start.Add(500 * time.Millisecond),
+			Attributes: meta.NewAttrSet(
+				meta.Attr(meta.Attrs.IsUserland, &isUserland),
+				meta.Attr(meta.Attrs.DynamicSpanID, &sid),
+				meta.Attr(meta.Attrs.UserlandName, &name),
+				meta.Attr(meta.Attrs.UserlandKind, &kind),
+				meta.Attr(meta.Attrs.UserlandServiceName, &svc),
+				meta.Attr(meta.Attrs.UserlandScopeName, &scope),
+				meta.Attr(meta.Attrs.UserlandScopeVersion, &scopeVer),
+			),
+			RawOtelSpanOptions: []trace.SpanStartOption{
+				trace.WithAttributes(attribute.String("db.system", "postgresql")),
+			},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, exp.ForceFlush(ctx))
+		spans := mem.GetSpans()
+		require.Len(t, spans, 1)
+
+		s := spans[0]
+		require.Equal(t, "db.query", s.Name)
+		require.Equal(t, trace.SpanKindClient, s.SpanKind)
+		require.Equal(t, spanID, s.SpanContext.SpanID())
+		require.Equal(t, start.Add(500*time.Millisecond), s.EndTime)
+		require.Equal(t, "pg", s.InstrumentationScope.Name)
+		require.Equal(t, "1.0.0", s.InstrumentationScope.Version)
+		require.Contains(t, s.Resource.Attributes(), semconv.ServiceName("billing"))
+		require.Contains(t, s.Attributes, attribute.String("db.system", "postgresql"))
+	})
+
+	t.Run("skips dropped and internal spans", func(t *testing.T) {
+		exp, mem, tp := newExporter(OTLPExportOpts{})
+
+		ds, err := tp.CreateDroppableSpan(ctx, meta.SpanNameStep, &CreateSpanOptions{})
+		require.NoError(t, err)
+		ds.Drop()
+
+		_, err = tp.CreateSpan(ctx, meta.SpanNameMetadata, &CreateSpanOptions{
+			EndTime: time.Now(),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, exp.ForceFlush(ctx))
+		require.Empty(t, mem.GetSpans())
+	})
+
+	t.Run("keeps steps of separate runs apart", func(t *testing.T) {
+		exp, mem, tp := newExporter(OTLPExportOpts{})
+
+		// Deterministic step span IDs are shared across runs.
+		override := uuid.NewString()
+		var steps []*meta.SpanReference
+		for range 2 {
+			run, err := tp.CreateSpan(ctx, meta.SpanNameRun, &CreateSpanOptions{})
+			require.NoError(t, err)
+			step, err := tp.CreateSpan(ctx, meta.SpanNameStep, &CreateSpanOptions{
+				Parent:                run,
+				DynamicSpanIDOverride: override,
+			})
+			require.NoError(t, err)
+			steps = append(steps, step)
+		}
+
+		require.NoError(t, tp.UpdateSpan(ctx, &UpdateSpanOptions{
+			TargetSpan: steps[0],
+			Status:     enums.StepStatusCompleted,
+		}))
+		require.NoError(t, exp.ForceFlush(ctx))
+		require.Len(t, mem.GetSpans(), 1)
+	})
+
+	t.Run("drops unfinished spans over the limit", func(t *testing.T) {
+		exp, mem, tp := newExporter(OTLPExportOpts{MaxPendingSpans: 1})
+
+		first, err := tp.CreateSpan(ctx, meta.SpanNameRun, &CreateSpanOptions{})
+		require.NoError(t, err)
+		second, err := tp.CreateSpan(ctx, meta.SpanNameRun, &CreateSpanOptions{})
+		require.NoError(t, err)
+
+		for _, ref := range []*meta.SpanReference{first, second} {
+			require.NoError(t, tp.UpdateSpan(ctx, &UpdateSpanOptions{
+				TargetSpan: ref,
+				Status:     enums.StepStatusCompleted,
+			}))
+		}
+		require.NoError(t, exp.ForceFlush(ctx))
+		require.Len(t, mem.GetSpans(), 1)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"strings"
 	"time"
 
 	dbpkg "github.com/inngest/inngest/pkg/db"
@@ -14,11 +15,27 @@ import (
 
 const (
 	cleanAttrs = false
+
+	// maxSearchContentSize caps the bytes of each payload added to the run
+	// search index.  Postgres limits a tsvector to 1MB.
+	maxSearchContentSize = 256 * 1024
+
+	searchKindEvent  = "event"
+	searchKindOutput = "output"
+	searchKindError  = "error"
 )
 
-func NewSqlcTracerProvider(q dbpkg.Querier) TracerProvider {
+// NewSqlcTracerProvider returns a TracerProvider which writes spans to the
+// database.  Spans are also exported to any given exporters, eg. those created
+// by NewOTLPRunExporter.
+func NewSqlcTracerProvider(q dbpkg.Querier, exporters ...sdktrace.SpanExporter) TracerProvider {
+	var exp sdktrace.SpanExporter = &dbExporter{q: q}
+	if len(exporters) > 0 {
+		exp = append(fanoutExporter{exp}, exporters...)
+	}
+
 	// With sqlc, write every 50.
-	return NewOtelTracerProvider(&dbExporter{q: q}, 50*time.Millisecond)
+	return NewOtelTracerProvider(exp, 50*time.Millisecond)
 }
 
 type dbExporter struct {
@@ -38,6 +55,7 @@ func (e *dbExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlyS
 		var functionID string
 		var output any
 		var input any
+		var isEventsInput bool
 		var runID string
 		var debugSessionID string
 		var debugRunID string
@@ -58,6 +76,7 @@ func (e *dbExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlyS
 			// This is always cleaned
 			if string(attr.Key) == meta.Attrs.EventsInput.Key() || string(attr.Key) == meta.Attrs.StepInput.Key() {
 				input = attr.Value.AsInterface()
+				isEventsInput = string(attr.Key) == meta.Attrs.EventsInput.Key()
 				continue
 			}
 
@@ -279,6 +298,22 @@ func (e *dbExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlyS
 			)
 			continue
 		}
+
+		var eventsByt []byte
+		if isEventsInput {
+			eventsByt = inputByt
+		}
+		for _, doc := range searchDocuments(runID, spanID, eventsByt, outputByt) {
+			if err := e.q.InsertRunSearchDocument(ctx, doc); err != nil {
+				logger.StdlibLogger(ctx).Error("failed to index span for run search",
+					"span_id", spanID,
+					"trace_id", traceID,
+					"run_id", runID,
+					"kind", doc.Kind,
+					"error", err,
+				)
+			}
+		}
 	}
 	return nil
 }
@@ -308,3 +343,43 @@ func anyToBytes(v any) []byte {
 		return byt
 	}
 }
+
+// searchDocuments returns the run search index entries for a span's triggering
+// events and output.  Step inputs aren't indexed as they repeat the outputs of
+// earlier steps.
+func searchDocuments(runID, spanID string, events, output []byte) []dbpkg.InsertRunSearchDocumentParams {
+	docs := []dbpkg.InsertRunSearchDocumentParams{}
+	if len(events) > 0 {
+		docs = append(docs, dbpkg.InsertRunSearchDocumentParams{
+			RunID:   runID,
+			SpanID:  spanID,
+			Kind:    searchKindEvent,
+			Content: searchContent(events),
+		})
+	}
+	if len(output) > 0 {
+		kind := searchKindOutput
+		// Errors are stored in the output wrapped as {"error": ...}.
+		var wrapped map[string]json.RawMessage
+		if err := json.Unmarshal(output, &wrapped); err == nil {
+			if _, ok := wrapped["error"]; ok {
+				kind = searchKindError
+			}
+		}
+		docs = append(docs, dbpkg.InsertRunSearchDocumentParams{
+			RunID:   runID,
+			SpanID:  spanID,
+			Kind:    kind,
+			Content: searchContent(output),
+		})
+	}
+	return docs
+}
+
+func searchContent(byt []byte) string {
+	if len(byt) > maxSearchContentSize {
+		byt = byt[:maxSearchContentSize]
+	}
+	// Truncation may split a multi-byte character.
+	return strings.ToValidUTF8(string(byt), "")
+}
@@ -1,7 +1,9 @@
 package tracing
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,3 +42,33 @@ func TestAnyToBytes(t *testing.T) {
 		assert.Equal(t, []byte(`{"x":1}`), got)
 	})
 }
+
+func TestSearchDocuments(t *testing.T) {
+	t.Run("nothing to index", func(t *testing.T) {
+		assert.Empty(t, searchDocuments("run", "span", nil, nil))
+	})
+
+	t.Run("events and output", func(t *testing.T) {
+		docs := searchDocuments("run", "span", []byte(`[{"name":"order.created"}]`), []byte(`{"data":{"order":8812}}`))
+		assert.Len(t, docs, 2)
+		assert.Equal(t, searchKindEvent, docs[0].Kind)
+		assert.Equal(t, `[{"name":"order.created"}]`, docs[0].Content)
+		assert.Equal(t, searchKindOutput, docs[1].Kind)
+		assert.Equal(t, "run", docs[1].RunID)
+		assert.Equal(t, "span", docs[1].SpanID)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		docs := searchDocuments("run", "span", nil, []byte(`{"error":{"message":"card declined"}}`))
+		assert.Len(t, docs, 1)
+		assert.Equal(t, searchKindError, docs[0].Kind)
+	})
+
+	t.Run("content is truncated to valid UTF-8", func(t *testing.T) {
+		output := []byte("a" + strings.Repeat("é", maxSearchContentSize))
+		docs := searchDocuments("run", "span", nil, output)
+		assert.Len(t, docs, 1)
+		assert.LessOrEqual(t, len(docs[0].Content), maxSearchContentSize)
+		assert.True(t, utf8.ValidString(docs[0].Content))
+	})
+}
@@ -267,6 +267,20 @@ func generatorAttrs(op *state.GeneratorOpcode) *meta.SerializableAttrs {
 			}
 		}
 
+	case enums.OpcodeLockAcquire:
+		{
+			if opts, err := op.LockOpts(); err == nil {
+				meta.AddAttr(rawAttrs, meta.Attrs.StepLockKey, &opts.Key)
+				if lease, err := opts.LeaseDuration(); err == nil {
+					meta.AddAttr(rawAttrs, meta.Attrs.StepLockLease, &lease)
+				} else {
+					rawAttrs.AddErr(fmt.Errorf("failed to get lock lease: %w", err))
+				}
+			} else {
+				rawAttrs.AddErr(fmt.Errorf("failed to get lock opts: %w", err))
+			}
+		}
+
 	case enums.OpcodeStep, enums.OpcodeStepRun, enums.OpcodeStepError, enums.OpcodeStepFailed:
 		{
 			// Output (success or error)
@@ -500,6 +514,23 @@ func SleepStepDynamicSeed(stepID string) []byte {
 	return fmt.Appendf(nil, "sleep-step:%s", stepID)
 }
 
+// LockStepDynamicSeed derives the dynamic_span_id seed for a lock step's
+// own span, which is updated once the lock is acquired.
+func LockStepDynamicSeed(stepID string) []byte {
+	return fmt.Appendf(nil, "lock-step:%s", stepID)
+}
+
+// LockStepSpanRef reconstructs the SpanReference for a lock step's own span.
+func LockStepSpanRef(runID ulid.ULID, stepID string) *meta.SpanReference {
+	cfg := DeterministicSpanConfig(runID[:])
+	stepSpanID := DeterministicSpanConfig(LockStepDynamicSeed(stepID)).SpanID
+	return &meta.SpanReference{
+		DynamicSpanID:          stepSpanID.String(),
+		DynamicSpanTraceParent: fmt.Sprintf("00-%s-%s-00", cfg.TraceID.String(), cfg.SpanID.String()),
+		TraceParent:            fmt.Sprintf("00-%s-%s-00", cfg.TraceID.String(), stepSpanID.String()),
+	}
+}
+
 // SleepDiscoveryDynamicSeed derives the dynamic_span_id seed for the
 // post-sleep discovery placeholder span.
 func SleepDiscoveryDynamicSeed(stepID string) []byte {
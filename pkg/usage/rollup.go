@@ -0,0 +1,99 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/service"
+)
+
+const (
+	// DefaultRollupInterval is how often usage is rolled up.
+	DefaultRollupInterval = 5 * time.Minute
+	// DefaultRollupLookback is how far back hours are rolled up.  Older hours
+	// which were never rolled up are aggregated whenever they're read.
+	DefaultRollupLookback = 7 * 24 * time.Hour
+)
+
+// Roller stores hourly usage rollups.
+type Roller interface {
+	// RollupUsage computes and stores rollups for every hour between from
+	// and until which hasn't already been rolled up.
+	RollupUsage(ctx context.Context, from, until time.Time) error
+}
+
+type RollupOpt func(s *rollupSvc)
+
+// WithRollupInterval sets how often usage is rolled up.
+func WithRollupInterval(d time.Duration) RollupOpt {
+	return func(s *rollupSvc) {
+		s.interval = d
+	}
+}
+
+// WithRollupLookback sets how far back hours are rolled up.
+func WithRollupLookback(d time.Duration) RollupOpt {
+	return func(s *rollupSvc) {
+		s.lookback = d
+	}
+}
+
+// NewRollupService returns a service which rolls up usage when it starts and
+// periodically afterwards, so that reading usage for hours which have ended
+// doesn't need to aggregate their spans and events.
+func NewRollupService(r Roller, opts ...RollupOpt) service.Service {
+	s := &rollupSvc{
+		roller:   r,
+		interval: DefaultRollupInterval,
+		lookback: DefaultRollupLookback,
+		now:      time.Now,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+type rollupSvc struct {
+	roller   Roller
+	interval time.Duration
+	lookback time.Duration
+	now      func() time.Time
+}
+
+func (s *rollupSvc) Name() string {
+	return "usage-rollup"
+}
+
+func (s *rollupSvc) Pre(ctx context.Context) error {
+	return nil
+}
+
+func (s *rollupSvc) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *rollupSvc) Run(ctx context.Context) error {
+	l := logger.StdlibLogger(ctx).With("service", s.Name())
+
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		if err := s.rollup(ctx); err != nil && ctx.Err() == nil {
+			l.Error("error rolling up usage", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// rollup rolls up every hour within the lookback.
+func (s *rollupSvc) rollup(ctx context.Context) error {
+	now := s.now()
+	return s.roller.RollupUsage(ctx, now.Add(-s.lookback), now)
+}
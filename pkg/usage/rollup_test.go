@@ -0,0 +1,56 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rollupCall struct {
+	from, until time.Time
+}
+
+type mockRoller struct {
+	mu    sync.Mutex
+	calls []rollupCall
+}
+
+func (m *mockRoller) RollupUsage(ctx context.Context, from, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, rollupCall{from: from, until: until})
+	return nil
+}
+
+func (m *mockRoller) callCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls)
+}
+
+func TestRollupService(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	r := &mockRoller{}
+	s := NewRollupService(r, WithRollupInterval(10*time.Millisecond), WithRollupLookback(time.Hour)).(*rollupSvc)
+	s.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	// Usage is rolled up as soon as the service starts, then periodically.
+	require.Eventually(t, func() bool { return r.callCount() >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.calls {
+		assert.Equal(t, now.Add(-time.Hour), c.from)
+		assert.Equal(t, now, c.until)
+	}
+}
@@ -42,9 +42,96 @@ func (u *UsageInput) Validate(ctx context.Context, min *enums.Period) error {
 	if enums.MaxRange(*u.Period) < *u.Range {
 		return errors.Errorf("range must be smaller than %s", enums.MaxRange(*u.Period))
 	}
+	if u.From != nil {
+		to := time.Now()
+		if u.To != nil {
+			to = *u.To
+		}
+		if to.Before(*u.From) {
+			return errors.New("from must be before to")
+		}
+		if u.From.Before(RangeStart(to, enums.MaxRange(*u.Period))) {
+			return errors.Errorf("range must be smaller than %s", enums.MaxRange(*u.Period))
+		}
+	}
 	return nil
 }
 
+// Window returns the time range covered by the input.  To defaults to now and
+// From defaults to the input's range before To.  From is truncated to the
+// start of its period so that the first bucket is complete.
+func (u UsageInput) Window(now time.Time) (from, to time.Time) {
+	period := enums.PeriodHour
+	if u.Period != nil {
+		period = *u.Period
+	}
+
+	to = now.UTC()
+	if u.To != nil {
+		to = u.To.UTC()
+	}
+
+	if u.From != nil {
+		from = u.From.UTC()
+	} else {
+		r := enums.DefaultRange(period)
+		if u.Range != nil {
+			r = *u.Range
+		}
+		from = RangeStart(to, r)
+	}
+	return Truncate(from, period), to
+}
+
+// RangeStart returns the time a range ending at t begins.
+func RangeStart(t time.Time, r enums.Timerange) time.Time {
+	switch r {
+	case enums.TimerangeHour:
+		return t.Add(-time.Hour)
+	case enums.TimerangeWeek:
+		return t.AddDate(0, 0, -7)
+	case enums.TimerangeMonth:
+		return t.AddDate(0, -1, 0)
+	case enums.TimerangeYear:
+		return t.AddDate(-1, 0, 0)
+	}
+	return t.AddDate(0, 0, -1)
+}
+
+// Truncate returns the start of the period containing t, in UTC.  Weeks start
+// on Monday.
+func Truncate(t time.Time, p enums.Period) time.Time {
+	t = t.UTC()
+	switch p {
+	case enums.PeriodMinute:
+		return t.Truncate(time.Minute)
+	case enums.PeriodDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case enums.PeriodWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case enums.PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// Next returns the start of the period after the one containing t.
+func Next(t time.Time, p enums.Period) time.Time {
+	t = Truncate(t, p)
+	switch p {
+	case enums.PeriodMinute:
+		return t.Add(time.Minute)
+	case enums.PeriodDay:
+		return t.AddDate(0, 0, 1)
+	case enums.PeriodWeek:
+		return t.AddDate(0, 0, 7)
+	case enums.PeriodMonth:
+		return t.AddDate(0, 1, 0)
+	}
+	return t.Add(time.Hour)
+}
+
 // UsageResponse represents event usage as queried by our event store
 type UsageResponse struct {
 	// Period is the period that this usage represents
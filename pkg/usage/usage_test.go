@@ -0,0 +1,92 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageInputValidate(t *testing.T) {
+	period := func(p enums.Period) *enums.Period { return &p }
+	ts := func(d time.Duration) *time.Time {
+		t := time.Now().Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name  string
+		input UsageInput
+		err   string
+	}{
+		{
+			name:  "defaults are valid",
+			input: UsageInput{},
+		},
+		{
+			name:  "from within the maximum range is valid",
+			input: UsageInput{Period: period(enums.PeriodDay), From: ts(-14 * 24 * time.Hour)},
+		},
+		{
+			name:  "from before the maximum range is invalid",
+			input: UsageInput{Period: period(enums.PeriodHour), From: ts(-48 * time.Hour)},
+			err:   "range must be smaller than day",
+		},
+		{
+			name:  "from after to is invalid",
+			input: UsageInput{From: ts(-time.Hour), To: ts(-2 * time.Hour)},
+			err:   "from must be before to",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.input.Validate(context.Background(), nil)
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestUsageInputWindow(t *testing.T) {
+	now := time.Date(2026, 4, 9, 15, 42, 10, 0, time.UTC)
+
+	day := enums.PeriodDay
+	from, to := UsageInput{Period: &day}.Window(now)
+	assert.Equal(t, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, now, to)
+
+	from, to = UsageInput{}.Window(now)
+	assert.Equal(t, time.Date(2026, 4, 8, 15, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, now, to)
+}
+
+func TestTruncate(t *testing.T) {
+	// A Thursday.
+	ts := time.Date(2026, 4, 9, 15, 42, 10, 0, time.UTC)
+
+	tests := []struct {
+		period   enums.Period
+		expected time.Time
+		next     time.Time
+	}{
+		{enums.PeriodMinute, time.Date(2026, 4, 9, 15, 42, 0, 0, time.UTC), time.Date(2026, 4, 9, 15, 43, 0, 0, time.UTC)},
+		{enums.PeriodHour, time.Date(2026, 4, 9, 15, 0, 0, 0, time.UTC), time.Date(2026, 4, 9, 16, 0, 0, 0, time.UTC)},
+		{enums.PeriodDay, time.Date(2026, 4, 9, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)},
+		{enums.PeriodWeek, time.Date(2026, 4, 6, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 13, 0, 0, 0, 0, time.UTC)},
+		{enums.PeriodMonth, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.period.String(), func(t *testing.T) {
+			assert.Equal(t, test.expected, Truncate(ts, test.period))
+			assert.Equal(t, test.next, Next(ts, test.period))
+		})
+	}
+}
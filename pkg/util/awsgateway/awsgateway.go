@@ -2,6 +2,7 @@ package awsgateway
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-lambda-go/events"
+	"github.com/inngest/inngest/pkg/execution/exechttp"
 )
 
 // NewTransformTripper creates a new transform tripper which attempts to
@@ -34,6 +36,23 @@ type transformTripper struct {
 	http.RoundTripper
 }
 
+// WithTLSConfig returns a copy of the tripper whose underlying transport uses
+// the given TLS config, allowing per-app TLS config in the dev server.
+func (t transformTripper) WithTLSConfig(cfg *tls.Config) (http.RoundTripper, error) {
+	rt, err := exechttp.WithTLSConfig(t.RoundTripper, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return transformTripper{RoundTripper: rt}, nil
+}
+
+// CloseIdleConnections closes the underlying transport's idle connections.
+func (t transformTripper) CloseIdleConnections() {
+	if c, ok := t.RoundTripper.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
 func (t transformTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	isLambda := strings.Contains(req.URL.Path, "2015-03-31/functions/function/invocations")
 	if isLambda {
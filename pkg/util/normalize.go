@@ -17,11 +17,16 @@ func NormalizeAppURL(u string, forceHTTPS bool) string {
 
 	parsed = stripInternalQueryParams(*parsed)
 
+	// Only insecure schemes are upgraded, so that schemes without a secure
+	// variant (eg. exec://) are left as-is.
 	if forceHTTPS {
-		isWebSocket := strings.HasPrefix(parsed.Scheme, "ws")
-		parsed.Scheme = "https"
-		if isWebSocket {
+		switch parsed.Scheme {
+		case "http":
+			parsed.Scheme = "https"
+		case "ws":
 			parsed.Scheme = "wss"
+		case "grpc":
+			parsed.Scheme = "grpcs"
 		}
 	}
 
@@ -71,6 +71,30 @@ func TestNormalizeAppURL(t *testing.T) {
 			inputURL:    "ws://api.example.com/api/inngest",
 			expectedURL: "ws://api.example.com/api/inngest",
 		},
+		{
+			name:        "insecure gRPC URL should be normalized to grpcs",
+			inputURL:    "grpc://api.example.com:9000",
+			expectedURL: "grpcs://api.example.com:9000",
+			forceHTTPS:  true,
+		},
+		{
+			name:        "secure gRPC URL should stay the same with force",
+			inputURL:    "grpcs://api.example.com:9000",
+			expectedURL: "grpcs://api.example.com:9000",
+			forceHTTPS:  true,
+		},
+		{
+			name:        "exec URL should stay the same with force",
+			inputURL:    "exec:///usr/local/bin/fn",
+			expectedURL: "exec:///usr/local/bin/fn",
+			forceHTTPS:  true,
+		},
+		{
+			name:        "https URL should stay the same with force",
+			inputURL:    "https://api.example.com/api/inngest",
+			expectedURL: "https://api.example.com/api/inngest",
+			forceHTTPS:  true,
+		},
 	}
 
 	for _, test := range tests {
@@ -3,3 +3,12 @@ package util
 func ToPtr[T any](s T) *T {
 	return &s
 }
+
+// FromPtr returns the value pointed to by s, or the zero value if s is nil.
+func FromPtr[T any](s *T) T {
+	if s == nil {
+		var zero T
+		return zero
+	}
+	return *s
+}
@@ -72,6 +72,61 @@ const (
 	V2RerunProcedure = "/api.v2.V2/Rerun"
 	// V2CancelRunProcedure is the fully-qualified name of the V2's CancelRun RPC.
 	V2CancelRunProcedure = "/api.v2.V2/CancelRun"
+	// V2ListRunWaitsProcedure is the fully-qualified name of the V2's ListRunWaits RPC.
+	V2ListRunWaitsProcedure = "/api.v2.V2/ListRunWaits"
+	// V2ListFunctionWaitsProcedure is the fully-qualified name of the V2's ListFunctionWaits RPC.
+	V2ListFunctionWaitsProcedure = "/api.v2.V2/ListFunctionWaits"
+	// V2ResolveWaitProcedure is the fully-qualified name of the V2's ResolveWait RPC.
+	V2ResolveWaitProcedure = "/api.v2.V2/ResolveWait"
+	// V2TimeoutWaitProcedure is the fully-qualified name of the V2's TimeoutWait RPC.
+	V2TimeoutWaitProcedure = "/api.v2.V2/TimeoutWait"
+	// V2PreviewBulkRunOperationProcedure is the fully-qualified name of the V2's
+	// PreviewBulkRunOperation RPC.
+	V2PreviewBulkRunOperationProcedure = "/api.v2.V2/PreviewBulkRunOperation"
+	// V2CreateBulkRunOperationProcedure is the fully-qualified name of the V2's CreateBulkRunOperation
+	// RPC.
+	V2CreateBulkRunOperationProcedure = "/api.v2.V2/CreateBulkRunOperation"
+	// V2ListBulkRunOperationsProcedure is the fully-qualified name of the V2's ListBulkRunOperations
+	// RPC.
+	V2ListBulkRunOperationsProcedure = "/api.v2.V2/ListBulkRunOperations"
+	// V2GetBulkRunOperationProcedure is the fully-qualified name of the V2's GetBulkRunOperation RPC.
+	V2GetBulkRunOperationProcedure = "/api.v2.V2/GetBulkRunOperation"
+	// V2AbortBulkRunOperationProcedure is the fully-qualified name of the V2's AbortBulkRunOperation
+	// RPC.
+	V2AbortBulkRunOperationProcedure = "/api.v2.V2/AbortBulkRunOperation"
+	// V2CreateOutboundWebhookProcedure is the fully-qualified name of the V2's CreateOutboundWebhook
+	// RPC.
+	V2CreateOutboundWebhookProcedure = "/api.v2.V2/CreateOutboundWebhook"
+	// V2ListOutboundWebhooksProcedure is the fully-qualified name of the V2's ListOutboundWebhooks RPC.
+	V2ListOutboundWebhooksProcedure = "/api.v2.V2/ListOutboundWebhooks"
+	// V2GetOutboundWebhookProcedure is the fully-qualified name of the V2's GetOutboundWebhook RPC.
+	V2GetOutboundWebhookProcedure = "/api.v2.V2/GetOutboundWebhook"
+	// V2UpdateOutboundWebhookProcedure is the fully-qualified name of the V2's UpdateOutboundWebhook
+	// RPC.
+	V2UpdateOutboundWebhookProcedure = "/api.v2.V2/UpdateOutboundWebhook"
+	// V2DeleteOutboundWebhookProcedure is the fully-qualified name of the V2's DeleteOutboundWebhook
+	// RPC.
+	V2DeleteOutboundWebhookProcedure = "/api.v2.V2/DeleteOutboundWebhook"
+	// V2ListOutboundWebhookDeliveriesProcedure is the fully-qualified name of the V2's
+	// ListOutboundWebhookDeliveries RPC.
+	V2ListOutboundWebhookDeliveriesProcedure = "/api.v2.V2/ListOutboundWebhookDeliveries"
+	// V2ReplayOutboundWebhookDeliveryProcedure is the fully-qualified name of the V2's
+	// ReplayOutboundWebhookDelivery RPC.
+	V2ReplayOutboundWebhookDeliveryProcedure = "/api.v2.V2/ReplayOutboundWebhookDelivery"
+	// V2CreateAlertRuleProcedure is the fully-qualified name of the V2's CreateAlertRule RPC.
+	V2CreateAlertRuleProcedure = "/api.v2.V2/CreateAlertRule"
+	// V2ListAlertRulesProcedure is the fully-qualified name of the V2's ListAlertRules RPC.
+	V2ListAlertRulesProcedure = "/api.v2.V2/ListAlertRules"
+	// V2GetAlertRuleProcedure is the fully-qualified name of the V2's GetAlertRule RPC.
+	V2GetAlertRuleProcedure = "/api.v2.V2/GetAlertRule"
+	// V2UpdateAlertRuleProcedure is the fully-qualified name of the V2's UpdateAlertRule RPC.
+	V2UpdateAlertRuleProcedure = "/api.v2.V2/UpdateAlertRule"
+	// V2DeleteAlertRuleProcedure is the fully-qualified name of the V2's DeleteAlertRule RPC.
+	V2DeleteAlertRuleProcedure = "/api.v2.V2/DeleteAlertRule"
+	// V2ListAlertsProcedure is the fully-qualified name of the V2's ListAlerts RPC.
+	V2ListAlertsProcedure = "/api.v2.V2/ListAlerts"
+	// V2GetUsageProcedure is the fully-qualified name of the V2's GetUsage RPC.
+	V2GetUsageProcedure = "/api.v2.V2/GetUsage"
 	// V2GetAppProcedure is the fully-qualified name of the V2's GetApp RPC.
 	V2GetAppProcedure = "/api.v2.V2/GetApp"
 	// V2GetAppsProcedure is the fully-qualified name of the V2's GetApps RPC.
@@ -118,10 +173,20 @@ const (
 	V2GetFunctionProcedure = "/api.v2.V2/GetFunction"
 	// V2GetFunctionsProcedure is the fully-qualified name of the V2's GetFunctions RPC.
 	V2GetFunctionsProcedure = "/api.v2.V2/GetFunctions"
+	// V2ListFunctionVersionsProcedure is the fully-qualified name of the V2's ListFunctionVersions RPC.
+	V2ListFunctionVersionsProcedure = "/api.v2.V2/ListFunctionVersions"
+	// V2GetFunctionVersionDiffProcedure is the fully-qualified name of the V2's GetFunctionVersionDiff RPC.
+	V2GetFunctionVersionDiffProcedure = "/api.v2.V2/GetFunctionVersionDiff"
 	// V2SendEventProcedure is the fully-qualified name of the V2's SendEvent RPC.
 	V2SendEventProcedure = "/api.v2.V2/SendEvent"
 	// V2InvokeFunctionProcedure is the fully-qualified name of the V2's InvokeFunction RPC.
 	V2InvokeFunctionProcedure = "/api.v2.V2/InvokeFunction"
+	// V2ListScheduledInvocationsProcedure is the fully-qualified name of the V2's
+	// ListScheduledInvocations RPC.
+	V2ListScheduledInvocationsProcedure = "/api.v2.V2/ListScheduledInvocations"
+	// V2CancelScheduledInvocationProcedure is the fully-qualified name of the V2's
+	// CancelScheduledInvocation RPC.
+	V2CancelScheduledInvocationProcedure = "/api.v2.V2/CancelScheduledInvocation"
 	// V2ListInsightsTablesProcedure is the fully-qualified name of the V2's ListInsightsTables RPC.
 	V2ListInsightsTablesProcedure = "/api.v2.V2/ListInsightsTables"
 	// V2ListInsightsEventSchemasProcedure is the fully-qualified name of the V2's
@@ -166,6 +231,29 @@ type V2Client interface {
 	GetEventRuns(context.Context, *connect.Request[v2.GetEventRunsRequest]) (*connect.Response[v2.GetEventRunsResponse], error)
 	Rerun(context.Context, *connect.Request[v2.RerunRequest]) (*connect.Response[v2.RerunResponse], error)
 	CancelRun(context.Context, *connect.Request[v2.CancelRunRequest]) (*connect.Response[v2.CancelRunResponse], error)
+	ListRunWaits(context.Context, *connect.Request[v2.ListRunWaitsRequest]) (*connect.Response[v2.ListRunWaitsResponse], error)
+	ListFunctionWaits(context.Context, *connect.Request[v2.ListFunctionWaitsRequest]) (*connect.Response[v2.ListFunctionWaitsResponse], error)
+	ResolveWait(context.Context, *connect.Request[v2.ResolveWaitRequest]) (*connect.Response[v2.ResolveWaitResponse], error)
+	TimeoutWait(context.Context, *connect.Request[v2.TimeoutWaitRequest]) (*connect.Response[v2.TimeoutWaitResponse], error)
+	PreviewBulkRunOperation(context.Context, *connect.Request[v2.PreviewBulkRunOperationRequest]) (*connect.Response[v2.PreviewBulkRunOperationResponse], error)
+	CreateBulkRunOperation(context.Context, *connect.Request[v2.CreateBulkRunOperationRequest]) (*connect.Response[v2.CreateBulkRunOperationResponse], error)
+	ListBulkRunOperations(context.Context, *connect.Request[v2.ListBulkRunOperationsRequest]) (*connect.Response[v2.ListBulkRunOperationsResponse], error)
+	GetBulkRunOperation(context.Context, *connect.Request[v2.GetBulkRunOperationRequest]) (*connect.Response[v2.GetBulkRunOperationResponse], error)
+	AbortBulkRunOperation(context.Context, *connect.Request[v2.AbortBulkRunOperationRequest]) (*connect.Response[v2.AbortBulkRunOperationResponse], error)
+	CreateOutboundWebhook(context.Context, *connect.Request[v2.CreateOutboundWebhookRequest]) (*connect.Response[v2.CreateOutboundWebhookResponse], error)
+	ListOutboundWebhooks(context.Context, *connect.Request[v2.ListOutboundWebhooksRequest]) (*connect.Response[v2.ListOutboundWebhooksResponse], error)
+	GetOutboundWebhook(context.Context, *connect.Request[v2.GetOutboundWebhookRequest]) (*connect.Response[v2.GetOutboundWebhookResponse], error)
+	UpdateOutboundWebhook(context.Context, *connect.Request[v2.UpdateOutboundWebhookRequest]) (*connect.Response[v2.UpdateOutboundWebhookResponse], error)
+	DeleteOutboundWebhook(context.Context, *connect.Request[v2.DeleteOutboundWebhookRequest]) (*connect.Response[v2.DeleteOutboundWebhookResponse], error)
+	ListOutboundWebhookDeliveries(context.Context, *connect.Request[v2.ListOutboundWebhookDeliveriesRequest]) (*connect.Response[v2.ListOutboundWebhookDeliveriesResponse], error)
+	ReplayOutboundWebhookDelivery(context.Context, *connect.Request[v2.ReplayOutboundWebhookDeliveryRequest]) (*connect.Response[v2.ReplayOutboundWebhookDeliveryResponse], error)
+	CreateAlertRule(context.Context, *connect.Request[v2.CreateAlertRuleRequest]) (*connect.Response[v2.CreateAlertRuleResponse], error)
+	ListAlertRules(context.Context, *connect.Request[v2.ListAlertRulesRequest]) (*connect.Response[v2.ListAlertRulesResponse], error)
+	GetAlertRule(context.Context, *connect.Request[v2.GetAlertRuleRequest]) (*connect.Response[v2.GetAlertRuleResponse], error)
+	UpdateAlertRule(context.Context, *connect.Request[v2.UpdateAlertRuleRequest]) (*connect.Response[v2.UpdateAlertRuleResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[v2.DeleteAlertRuleRequest]) (*connect.Response[v2.DeleteAlertRuleResponse], error)
+	ListAlerts(context.Context, *connect.Request[v2.ListAlertsRequest]) (*connect.Response[v2.ListAlertsResponse], error)
+	GetUsage(context.Context, *connect.Request[v2.GetUsageRequest]) (*connect.Response[v2.GetUsageResponse], error)
 	GetApp(context.Context, *connect.Request[v2.GetAppRequest]) (*connect.Response[v2.GetAppResponse], error)
 	GetApps(context.Context, *connect.Request[v2.GetAppsRequest]) (*connect.Response[v2.GetAppsResponse], error)
 	CreateSandbox(context.Context, *connect.Request[v2.CreateSandboxRequest]) (*connect.Response[v2.CreateSandboxResponse], error)
@@ -188,8 +276,12 @@ type V2Client interface {
 	GetFunctionTrace(context.Context, *connect.Request[v2.GetFunctionTraceRequest]) (*connect.Response[v2.GetFunctionTraceResponse], error)
 	GetFunction(context.Context, *connect.Request[v2.GetFunctionRequest]) (*connect.Response[v2.GetFunctionResponse], error)
 	GetFunctions(context.Context, *connect.Request[v2.GetFunctionsRequest]) (*connect.Response[v2.GetFunctionsResponse], error)
+	ListFunctionVersions(context.Context, *connect.Request[v2.ListFunctionVersionsRequest]) (*connect.Response[v2.ListFunctionVersionsResponse], error)
+	GetFunctionVersionDiff(context.Context, *connect.Request[v2.GetFunctionVersionDiffRequest]) (*connect.Response[v2.GetFunctionVersionDiffResponse], error)
 	SendEvent(context.Context, *connect.Request[v2.SendEventRequest]) (*connect.Response[v2.SendEventResponse], error)
 	InvokeFunction(context.Context, *connect.Request[v2.InvokeFunctionRequest]) (*connect.Response[v2.InvokeFunctionResponse], error)
+	ListScheduledInvocations(context.Context, *connect.Request[v2.ListScheduledInvocationsRequest]) (*connect.Response[v2.ListScheduledInvocationsResponse], error)
+	CancelScheduledInvocation(context.Context, *connect.Request[v2.CancelScheduledInvocationRequest]) (*connect.Response[v2.CancelScheduledInvocationResponse], error)
 	ListInsightsTables(context.Context, *connect.Request[v2.ListInsightsTablesRequest]) (*connect.Response[v2.ListInsightsTablesResponse], error)
 	ListInsightsEventSchemas(context.Context, *connect.Request[v2.ListInsightsEventSchemasRequest]) (*connect.Response[v2.ListInsightsEventSchemasResponse], error)
 	QueryInsightsPrompt(context.Context, *connect.Request[v2.QueryInsightsPromptRequest]) (*connect.Response[v2.QueryInsightsPromptResponse], error)
@@ -320,6 +412,144 @@ func NewV2Client(httpClient connect.HTTPClient, baseURL string, opts ...connect.
 			connect.WithSchema(v2Methods.ByName("CancelRun")),
 			connect.WithClientOptions(opts...),
 		),
+		listRunWaits: connect.NewClient[v2.ListRunWaitsRequest, v2.ListRunWaitsResponse](
+			httpClient,
+			baseURL+V2ListRunWaitsProcedure,
+			connect.WithSchema(v2Methods.ByName("ListRunWaits")),
+			connect.WithClientOptions(opts...),
+		),
+		listFunctionWaits: connect.NewClient[v2.ListFunctionWaitsRequest, v2.ListFunctionWaitsResponse](
+			httpClient,
+			baseURL+V2ListFunctionWaitsProcedure,
+			connect.WithSchema(v2Methods.ByName("ListFunctionWaits")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveWait: connect.NewClient[v2.ResolveWaitRequest, v2.ResolveWaitResponse](
+			httpClient,
+			baseURL+V2ResolveWaitProcedure,
+			connect.WithSchema(v2Methods.ByName("ResolveWait")),
+			connect.WithClientOptions(opts...),
+		),
+		timeoutWait: connect.NewClient[v2.TimeoutWaitRequest, v2.TimeoutWaitResponse](
+			httpClient,
+			baseURL+V2TimeoutWaitProcedure,
+			connect.WithSchema(v2Methods.ByName("TimeoutWait")),
+			connect.WithClientOptions(opts...),
+		),
+		previewBulkRunOperation: connect.NewClient[v2.PreviewBulkRunOperationRequest, v2.PreviewBulkRunOperationResponse](
+			httpClient,
+			baseURL+V2PreviewBulkRunOperationProcedure,
+			connect.WithSchema(v2Methods.ByName("PreviewBulkRunOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		createBulkRunOperation: connect.NewClient[v2.CreateBulkRunOperationRequest, v2.CreateBulkRunOperationResponse](
+			httpClient,
+			baseURL+V2CreateBulkRunOperationProcedure,
+			connect.WithSchema(v2Methods.ByName("CreateBulkRunOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		listBulkRunOperations: connect.NewClient[v2.ListBulkRunOperationsRequest, v2.ListBulkRunOperationsResponse](
+			httpClient,
+			baseURL+V2ListBulkRunOperationsProcedure,
+			connect.WithSchema(v2Methods.ByName("ListBulkRunOperations")),
+			connect.WithClientOptions(opts...),
+		),
+		getBulkRunOperation: connect.NewClient[v2.GetBulkRunOperationRequest, v2.GetBulkRunOperationResponse](
+			httpClient,
+			baseURL+V2GetBulkRunOperationProcedure,
+			connect.WithSchema(v2Methods.ByName("GetBulkRunOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		abortBulkRunOperation: connect.NewClient[v2.AbortBulkRunOperationRequest, v2.AbortBulkRunOperationResponse](
+			httpClient,
+			baseURL+V2AbortBulkRunOperationProcedure,
+			connect.WithSchema(v2Methods.ByName("AbortBulkRunOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		createOutboundWebhook: connect.NewClient[v2.CreateOutboundWebhookRequest, v2.CreateOutboundWebhookResponse](
+			httpClient,
+			baseURL+V2CreateOutboundWebhookProcedure,
+			connect.WithSchema(v2Methods.ByName("CreateOutboundWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listOutboundWebhooks: connect.NewClient[v2.ListOutboundWebhooksRequest, v2.ListOutboundWebhooksResponse](
+			httpClient,
+			baseURL+V2ListOutboundWebhooksProcedure,
+			connect.WithSchema(v2Methods.ByName("ListOutboundWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		getOutboundWebhook: connect.NewClient[v2.GetOutboundWebhookRequest, v2.GetOutboundWebhookResponse](
+			httpClient,
+			baseURL+V2GetOutboundWebhookProcedure,
+			connect.WithSchema(v2Methods.ByName("GetOutboundWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		updateOutboundWebhook: connect.NewClient[v2.UpdateOutboundWebhookRequest, v2.UpdateOutboundWebhookResponse](
+			httpClient,
+			baseURL+V2UpdateOutboundWebhookProcedure,
+			connect.WithSchema(v2Methods.ByName("UpdateOutboundWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOutboundWebhook: connect.NewClient[v2.DeleteOutboundWebhookRequest, v2.DeleteOutboundWebhookResponse](
+			httpClient,
+			baseURL+V2DeleteOutboundWebhookProcedure,
+			connect.WithSchema(v2Methods.ByName("DeleteOutboundWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listOutboundWebhookDeliveries: connect.NewClient[v2.ListOutboundWebhookDeliveriesRequest, v2.ListOutboundWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+V2ListOutboundWebhookDeliveriesProcedure,
+			connect.WithSchema(v2Methods.ByName("ListOutboundWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		replayOutboundWebhookDelivery: connect.NewClient[v2.ReplayOutboundWebhookDeliveryRequest, v2.ReplayOutboundWebhookDeliveryResponse](
+			httpClient,
+			baseURL+V2ReplayOutboundWebhookDeliveryProcedure,
+			connect.WithSchema(v2Methods.ByName("ReplayOutboundWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+		createAlertRule: connect.NewClient[v2.CreateAlertRuleRequest, v2.CreateAlertRuleResponse](
+			httpClient,
+			baseURL+V2CreateAlertRuleProcedure,
+			connect.WithSchema(v2Methods.ByName("CreateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlertRules: connect.NewClient[v2.ListAlertRulesRequest, v2.ListAlertRulesResponse](
+			httpClient,
+			baseURL+V2ListAlertRulesProcedure,
+			connect.WithSchema(v2Methods.ByName("ListAlertRules")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlertRule: connect.NewClient[v2.GetAlertRuleRequest, v2.GetAlertRuleResponse](
+			httpClient,
+			baseURL+V2GetAlertRuleProcedure,
+			connect.WithSchema(v2Methods.ByName("GetAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAlertRule: connect.NewClient[v2.UpdateAlertRuleRequest, v2.UpdateAlertRuleResponse](
+			httpClient,
+			baseURL+V2UpdateAlertRuleProcedure,
+			connect.WithSchema(v2Methods.ByName("UpdateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertRule: connect.NewClient[v2.DeleteAlertRuleRequest, v2.DeleteAlertRuleResponse](
+			httpClient,
+			baseURL+V2DeleteAlertRuleProcedure,
+			connect.WithSchema(v2Methods.ByName("DeleteAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlerts: connect.NewClient[v2.ListAlertsRequest, v2.ListAlertsResponse](
+			httpClient,
+			baseURL+V2ListAlertsProcedure,
+			connect.WithSchema(v2Methods.ByName("ListAlerts")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[v2.GetUsageRequest, v2.GetUsageResponse](
+			httpClient,
+			baseURL+V2GetUsageProcedure,
+			connect.WithSchema(v2Methods.ByName("GetUsage")),
+			connect.WithClientOptions(opts...),
+		),
 		getApp: connect.NewClient[v2.GetAppRequest, v2.GetAppResponse](
 			httpClient,
 			baseURL+V2GetAppProcedure,
@@ -452,6 +682,18 @@ func NewV2Client(httpClient connect.HTTPClient, baseURL string, opts ...connect.
 			connect.WithSchema(v2Methods.ByName("GetFunctions")),
 			connect.WithClientOptions(opts...),
 		),
+		listFunctionVersions: connect.NewClient[v2.ListFunctionVersionsRequest, v2.ListFunctionVersionsResponse](
+			httpClient,
+			baseURL+V2ListFunctionVersionsProcedure,
+			connect.WithSchema(v2Methods.ByName("ListFunctionVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		getFunctionVersionDiff: connect.NewClient[v2.GetFunctionVersionDiffRequest, v2.GetFunctionVersionDiffResponse](
+			httpClient,
+			baseURL+V2GetFunctionVersionDiffProcedure,
+			connect.WithSchema(v2Methods.ByName("GetFunctionVersionDiff")),
+			connect.WithClientOptions(opts...),
+		),
 		sendEvent: connect.NewClient[v2.SendEventRequest, v2.SendEventResponse](
 			httpClient,
 			baseURL+V2SendEventProcedure,
@@ -464,6 +706,18 @@ func NewV2Client(httpClient connect.HTTPClient, baseURL string, opts ...connect.
 			connect.WithSchema(v2Methods.ByName("InvokeFunction")),
 			connect.WithClientOptions(opts...),
 		),
+		listScheduledInvocations: connect.NewClient[v2.ListScheduledInvocationsRequest, v2.ListScheduledInvocationsResponse](
+			httpClient,
+			baseURL+V2ListScheduledInvocationsProcedure,
+			connect.WithSchema(v2Methods.ByName("ListScheduledInvocations")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledInvocation: connect.NewClient[v2.CancelScheduledInvocationRequest, v2.CancelScheduledInvocationResponse](
+			httpClient,
+			baseURL+V2CancelScheduledInvocationProcedure,
+			connect.WithSchema(v2Methods.ByName("CancelScheduledInvocation")),
+			connect.WithClientOptions(opts...),
+		),
 		listInsightsTables: connect.NewClient[v2.ListInsightsTablesRequest, v2.ListInsightsTablesResponse](
 			httpClient,
 			baseURL+V2ListInsightsTablesProcedure,
@@ -523,57 +777,84 @@ func NewV2Client(httpClient connect.HTTPClient, baseURL string, opts ...connect.
 
 // v2Client implements V2Client.
 type v2Client struct {
-	health                     *connect.Client[v2.HealthRequest, v2.HealthResponse]
-	xSchemaOnly                *connect.Client[v2.HealthRequest, v2.ErrorResponse]
-	createPartnerAccount       *connect.Client[v2.CreateAccountRequest, v2.CreateAccountResponse]
-	createEnv                  *connect.Client[v2.CreateEnvRequest, v2.CreateEnvResponse]
-	fetchPartnerAccounts       *connect.Client[v2.FetchAccountsRequest, v2.FetchAccountsResponse]
-	fetchAccount               *connect.Client[v2.FetchAccountRequest, v2.FetchAccountResponse]
-	fetchAccountEnvs           *connect.Client[v2.FetchAccountEnvsRequest, v2.FetchAccountEnvsResponse]
-	fetchAccountEventKeys      *connect.Client[v2.FetchAccountEventKeysRequest, v2.FetchAccountEventKeysResponse]
-	fetchAccountSigningKeys    *connect.Client[v2.FetchAccountSigningKeysRequest, v2.FetchAccountSigningKeysResponse]
-	createWebhook              *connect.Client[v2.CreateWebhookRequest, v2.CreateWebhookResponse]
-	listWebhooks               *connect.Client[v2.ListWebhooksRequest, v2.ListWebhooksResponse]
-	patchEnv                   *connect.Client[v2.PatchEnvRequest, v2.PatchEnvsResponse]
-	getFunctionRun             *connect.Client[v2.GetFunctionRunRequest, v2.GetFunctionRunResponse]
-	listRuns                   *connect.Client[v2.ListRunsRequest, v2.ListRunsResponse]
-	listFunctionRuns           *connect.Client[v2.ListFunctionRunsRequest, v2.ListFunctionRunsResponse]
-	getEventRuns               *connect.Client[v2.GetEventRunsRequest, v2.GetEventRunsResponse]
-	rerun                      *connect.Client[v2.RerunRequest, v2.RerunResponse]
-	cancelRun                  *connect.Client[v2.CancelRunRequest, v2.CancelRunResponse]
-	getApp                     *connect.Client[v2.GetAppRequest, v2.GetAppResponse]
-	getApps                    *connect.Client[v2.GetAppsRequest, v2.GetAppsResponse]
-	createSandbox              *connect.Client[v2.CreateSandboxRequest, v2.CreateSandboxResponse]
-	listSandboxes              *connect.Client[v2.ListSandboxesRequest, v2.ListSandboxesResponse]
-	getSandbox                 *connect.Client[v2.GetSandboxRequest, v2.GetSandboxResponse]
-	destroySandbox             *connect.Client[v2.DestroySandboxRequest, v2.DestroySandboxResponse]
-	execSandbox                *connect.Client[v2.ExecSandboxRequest, v2.ExecSandboxResponse]
-	streamSandboxLogs          *connect.Client[v2.StreamSandboxLogsRequest, v2.StreamSandboxLogsResponse]
-	writeSandboxFile           *connect.Client[v2.WriteSandboxFileRequest, v2.WriteSandboxFileResponse]
-	readSandboxFile            *connect.Client[v2.ReadSandboxFileRequest, httpbody.HttpBody]
-	startSandboxProcess        *connect.Client[v2.StartSandboxProcessRequest, v2.StartSandboxProcessResponse]
-	listSandboxProcesses       *connect.Client[v2.ListSandboxProcessesRequest, v2.ListSandboxProcessesResponse]
-	getSandboxProcess          *connect.Client[v2.GetSandboxProcessRequest, v2.GetSandboxProcessResponse]
-	signalSandboxProcess       *connect.Client[v2.SignalSandboxProcessRequest, v2.SignalSandboxProcessResponse]
-	waitSandboxProcess         *connect.Client[v2.WaitSandboxProcessRequest, v2.WaitSandboxProcessResponse]
-	getSandboxProcessOutput    *connect.Client[v2.GetSandboxProcessOutputRequest, v2.GetSandboxProcessOutputResponse]
-	streamSandboxProcessOutput *connect.Client[v2.StreamSandboxProcessOutputRequest, v2.StreamSandboxProcessOutputResponse]
-	createScore                *connect.Client[v2.CreateScoreRequest, v2.CreateScoreResponse]
-	syncApp                    *connect.Client[v2.SyncAppRequest, v2.SyncAppResponse]
-	getFunctionTrace           *connect.Client[v2.GetFunctionTraceRequest, v2.GetFunctionTraceResponse]
-	getFunction                *connect.Client[v2.GetFunctionRequest, v2.GetFunctionResponse]
-	getFunctions               *connect.Client[v2.GetFunctionsRequest, v2.GetFunctionsResponse]
-	sendEvent                  *connect.Client[v2.SendEventRequest, v2.SendEventResponse]
-	invokeFunction             *connect.Client[v2.InvokeFunctionRequest, v2.InvokeFunctionResponse]
-	listInsightsTables         *connect.Client[v2.ListInsightsTablesRequest, v2.ListInsightsTablesResponse]
-	listInsightsEventSchemas   *connect.Client[v2.ListInsightsEventSchemasRequest, v2.ListInsightsEventSchemasResponse]
-	queryInsightsPrompt        *connect.Client[v2.QueryInsightsPromptRequest, v2.QueryInsightsPromptResponse]
-	queryInsights              *connect.Client[v2.QueryInsightsRequest, v2.QueryInsightsResponse]
-	listExperiments            *connect.Client[v2.ListExperimentsRequest, v2.ListExperimentsResponse]
-	getExperiment              *connect.Client[v2.GetExperimentRequest, v2.GetExperimentResponse]
-	listSessionKeys            *connect.Client[v2.ListSessionKeysRequest, v2.ListSessionKeysResponse]
-	listSessions               *connect.Client[v2.ListSessionsRequest, v2.ListSessionsResponse]
-	listSessionRuns            *connect.Client[v2.ListSessionRunsRequest, v2.ListSessionRunsResponse]
+	health                        *connect.Client[v2.HealthRequest, v2.HealthResponse]
+	xSchemaOnly                   *connect.Client[v2.HealthRequest, v2.ErrorResponse]
+	createPartnerAccount          *connect.Client[v2.CreateAccountRequest, v2.CreateAccountResponse]
+	createEnv                     *connect.Client[v2.CreateEnvRequest, v2.CreateEnvResponse]
+	fetchPartnerAccounts          *connect.Client[v2.FetchAccountsRequest, v2.FetchAccountsResponse]
+	fetchAccount                  *connect.Client[v2.FetchAccountRequest, v2.FetchAccountResponse]
+	fetchAccountEnvs              *connect.Client[v2.FetchAccountEnvsRequest, v2.FetchAccountEnvsResponse]
+	fetchAccountEventKeys         *connect.Client[v2.FetchAccountEventKeysRequest, v2.FetchAccountEventKeysResponse]
+	fetchAccountSigningKeys       *connect.Client[v2.FetchAccountSigningKeysRequest, v2.FetchAccountSigningKeysResponse]
+	createWebhook                 *connect.Client[v2.CreateWebhookRequest, v2.CreateWebhookResponse]
+	listWebhooks                  *connect.Client[v2.ListWebhooksRequest, v2.ListWebhooksResponse]
+	patchEnv                      *connect.Client[v2.PatchEnvRequest, v2.PatchEnvsResponse]
+	getFunctionRun                *connect.Client[v2.GetFunctionRunRequest, v2.GetFunctionRunResponse]
+	listRuns                      *connect.Client[v2.ListRunsRequest, v2.ListRunsResponse]
+	listFunctionRuns              *connect.Client[v2.ListFunctionRunsRequest, v2.ListFunctionRunsResponse]
+	getEventRuns                  *connect.Client[v2.GetEventRunsRequest, v2.GetEventRunsResponse]
+	rerun                         *connect.Client[v2.RerunRequest, v2.RerunResponse]
+	cancelRun                     *connect.Client[v2.CancelRunRequest, v2.CancelRunResponse]
+	listRunWaits                  *connect.Client[v2.ListRunWaitsRequest, v2.ListRunWaitsResponse]
+	listFunctionWaits             *connect.Client[v2.ListFunctionWaitsRequest, v2.ListFunctionWaitsResponse]
+	resolveWait                   *connect.Client[v2.ResolveWaitRequest, v2.ResolveWaitResponse]
+	timeoutWait                   *connect.Client[v2.TimeoutWaitRequest, v2.TimeoutWaitResponse]
+	previewBulkRunOperation       *connect.Client[v2.PreviewBulkRunOperationRequest, v2.PreviewBulkRunOperationResponse]
+	createBulkRunOperation        *connect.Client[v2.CreateBulkRunOperationRequest, v2.CreateBulkRunOperationResponse]
+	listBulkRunOperations         *connect.Client[v2.ListBulkRunOperationsRequest, v2.ListBulkRunOperationsResponse]
+	getBulkRunOperation           *connect.Client[v2.GetBulkRunOperationRequest, v2.GetBulkRunOperationResponse]
+	abortBulkRunOperation         *connect.Client[v2.AbortBulkRunOperationRequest, v2.AbortBulkRunOperationResponse]
+	createOutboundWebhook         *connect.Client[v2.CreateOutboundWebhookRequest, v2.CreateOutboundWebhookResponse]
+	listOutboundWebhooks          *connect.Client[v2.ListOutboundWebhooksRequest, v2.ListOutboundWebhooksResponse]
+	getOutboundWebhook            *connect.Client[v2.GetOutboundWebhookRequest, v2.GetOutboundWebhookResponse]
+	updateOutboundWebhook         *connect.Client[v2.UpdateOutboundWebhookRequest, v2.UpdateOutboundWebhookResponse]
+	deleteOutboundWebhook         *connect.Client[v2.DeleteOutboundWebhookRequest, v2.DeleteOutboundWebhookResponse]
+	listOutboundWebhookDeliveries *connect.Client[v2.ListOutboundWebhookDeliveriesRequest, v2.ListOutboundWebhookDeliveriesResponse]
+	replayOutboundWebhookDelivery *connect.Client[v2.ReplayOutboundWebhookDeliveryRequest, v2.ReplayOutboundWebhookDeliveryResponse]
+	createAlertRule               *connect.Client[v2.CreateAlertRuleRequest, v2.CreateAlertRuleResponse]
+	listAlertRules                *connect.Client[v2.ListAlertRulesRequest, v2.ListAlertRulesResponse]
+	getAlertRule                  *connect.Client[v2.GetAlertRuleRequest, v2.GetAlertRuleResponse]
+	updateAlertRule               *connect.Client[v2.UpdateAlertRuleRequest, v2.UpdateAlertRuleResponse]
+	deleteAlertRule               *connect.Client[v2.DeleteAlertRuleRequest, v2.DeleteAlertRuleResponse]
+	listAlerts                    *connect.Client[v2.ListAlertsRequest, v2.ListAlertsResponse]
+	getUsage                      *connect.Client[v2.GetUsageRequest, v2.GetUsageResponse]
+	getApp                        *connect.Client[v2.GetAppRequest, v2.GetAppResponse]
+	getApps                       *connect.Client[v2.GetAppsRequest, v2.GetAppsResponse]
+	createSandbox                 *connect.Client[v2.CreateSandboxRequest, v2.CreateSandboxResponse]
+	listSandboxes                 *connect.Client[v2.ListSandboxesRequest, v2.ListSandboxesResponse]
+	getSandbox                    *connect.Client[v2.GetSandboxRequest, v2.GetSandboxResponse]
+	destroySandbox                *connect.Client[v2.DestroySandboxRequest, v2.DestroySandboxResponse]
+	execSandbox                   *connect.Client[v2.ExecSandboxRequest, v2.ExecSandboxResponse]
+	streamSandboxLogs             *connect.Client[v2.StreamSandboxLogsRequest, v2.StreamSandboxLogsResponse]
+	writeSandboxFile              *connect.Client[v2.WriteSandboxFileRequest, v2.WriteSandboxFileResponse]
+	readSandboxFile               *connect.Client[v2.ReadSandboxFileRequest, httpbody.HttpBody]
+	startSandboxProcess           *connect.Client[v2.StartSandboxProcessRequest, v2.StartSandboxProcessResponse]
+	listSandboxProcesses          *connect.Client[v2.ListSandboxProcessesRequest, v2.ListSandboxProcessesResponse]
+	getSandboxProcess             *connect.Client[v2.GetSandboxProcessRequest, v2.GetSandboxProcessResponse]
+	signalSandboxProcess          *connect.Client[v2.SignalSandboxProcessRequest, v2.SignalSandboxProcessResponse]
+	waitSandboxProcess            *connect.Client[v2.WaitSandboxProcessRequest, v2.WaitSandboxProcessResponse]
+	getSandboxProcessOutput       *connect.Client[v2.GetSandboxProcessOutputRequest, v2.GetSandboxProcessOutputResponse]
+	streamSandboxProcessOutput    *connect.Client[v2.StreamSandboxProcessOutputRequest, v2.StreamSandboxProcessOutputResponse]
+	createScore                   *connect.Client[v2.CreateScoreRequest, v2.CreateScoreResponse]
+	syncApp                       *connect.Client[v2.SyncAppRequest, v2.SyncAppResponse]
+	getFunctionTrace              *connect.Client[v2.GetFunctionTraceRequest, v2.GetFunctionTraceResponse]
+	getFunction                   *connect.Client[v2.GetFunctionRequest, v2.GetFunctionResponse]
+	getFunctions                  *connect.Client[v2.GetFunctionsRequest, v2.GetFunctionsResponse]
+	listFunctionVersions          *connect.Client[v2.ListFunctionVersionsRequest, v2.ListFunctionVersionsResponse]
+	getFunctionVersionDiff        *connect.Client[v2.GetFunctionVersionDiffRequest, v2.GetFunctionVersionDiffResponse]
+	sendEvent                     *connect.Client[v2.SendEventRequest, v2.SendEventResponse]
+	invokeFunction                *connect.Client[v2.InvokeFunctionRequest, v2.InvokeFunctionResponse]
+	listScheduledInvocations      *connect.Client[v2.ListScheduledInvocationsRequest, v2.ListScheduledInvocationsResponse]
+	cancelScheduledInvocation     *connect.Client[v2.CancelScheduledInvocationRequest, v2.CancelScheduledInvocationResponse]
+	listInsightsTables            *connect.Client[v2.ListInsightsTablesRequest, v2.ListInsightsTablesResponse]
+	listInsightsEventSchemas      *connect.Client[v2.ListInsightsEventSchemasRequest, v2.ListInsightsEventSchemasResponse]
+	queryInsightsPrompt           *connect.Client[v2.QueryInsightsPromptRequest, v2.QueryInsightsPromptResponse]
+	queryInsights                 *connect.Client[v2.QueryInsightsRequest, v2.QueryInsightsResponse]
+	listExperiments               *connect.Client[v2.ListExperimentsRequest, v2.ListExperimentsResponse]
+	getExperiment                 *connect.Client[v2.GetExperimentRequest, v2.GetExperimentResponse]
+	listSessionKeys               *connect.Client[v2.ListSessionKeysRequest, v2.ListSessionKeysResponse]
+	listSessions                  *connect.Client[v2.ListSessionsRequest, v2.ListSessionsResponse]
+	listSessionRuns               *connect.Client[v2.ListSessionRunsRequest, v2.ListSessionRunsResponse]
 }
 
 // Health calls api.v2.V2.Health.
@@ -666,6 +947,121 @@ func (c *v2Client) CancelRun(ctx context.Context, req *connect.Request[v2.Cancel
 	return c.cancelRun.CallUnary(ctx, req)
 }
 
+// ListRunWaits calls api.v2.V2.ListRunWaits.
+func (c *v2Client) ListRunWaits(ctx context.Context, req *connect.Request[v2.ListRunWaitsRequest]) (*connect.Response[v2.ListRunWaitsResponse], error) {
+	return c.listRunWaits.CallUnary(ctx, req)
+}
+
+// ListFunctionWaits calls api.v2.V2.ListFunctionWaits.
+func (c *v2Client) ListFunctionWaits(ctx context.Context, req *connect.Request[v2.ListFunctionWaitsRequest]) (*connect.Response[v2.ListFunctionWaitsResponse], error) {
+	return c.listFunctionWaits.CallUnary(ctx, req)
+}
+
+// ResolveWait calls api.v2.V2.ResolveWait.
+func (c *v2Client) ResolveWait(ctx context.Context, req *connect.Request[v2.ResolveWaitRequest]) (*connect.Response[v2.ResolveWaitResponse], error) {
+	return c.resolveWait.CallUnary(ctx, req)
+}
+
+// TimeoutWait calls api.v2.V2.TimeoutWait.
+func (c *v2Client) TimeoutWait(ctx context.Context, req *connect.Request[v2.TimeoutWaitRequest]) (*connect.Response[v2.TimeoutWaitResponse], error) {
+	return c.timeoutWait.CallUnary(ctx, req)
+}
+
+// PreviewBulkRunOperation calls api.v2.V2.PreviewBulkRunOperation.
+func (c *v2Client) PreviewBulkRunOperation(ctx context.Context, req *connect.Request[v2.PreviewBulkRunOperationRequest]) (*connect.Response[v2.PreviewBulkRunOperationResponse], error) {
+	return c.previewBulkRunOperation.CallUnary(ctx, req)
+}
+
+// CreateBulkRunOperation calls api.v2.V2.CreateBulkRunOperation.
+func (c *v2Client) CreateBulkRunOperation(ctx context.Context, req *connect.Request[v2.CreateBulkRunOperationRequest]) (*connect.Response[v2.CreateBulkRunOperationResponse], error) {
+	return c.createBulkRunOperation.CallUnary(ctx, req)
+}
+
+// ListBulkRunOperations calls api.v2.V2.ListBulkRunOperations.
+func (c *v2Client) ListBulkRunOperations(ctx context.Context, req *connect.Request[v2.ListBulkRunOperationsRequest]) (*connect.Response[v2.ListBulkRunOperationsResponse], error) {
+	return c.listBulkRunOperations.CallUnary(ctx, req)
+}
+
+// GetBulkRunOperation calls api.v2.V2.GetBulkRunOperation.
+func (c *v2Client) GetBulkRunOperation(ctx context.Context, req *connect.Request[v2.GetBulkRunOperationRequest]) (*connect.Response[v2.GetBulkRunOperationResponse], error) {
+	return c.getBulkRunOperation.CallUnary(ctx, req)
+}
+
+// AbortBulkRunOperation calls api.v2.V2.AbortBulkRunOperation.
+func (c *v2Client) AbortBulkRunOperation(ctx context.Context, req *connect.Request[v2.AbortBulkRunOperationRequest]) (*connect.Response[v2.AbortBulkRunOperationResponse], error) {
+	return c.abortBulkRunOperation.CallUnary(ctx, req)
+}
+
+// CreateOutboundWebhook calls api.v2.V2.CreateOutboundWebhook.
+func (c *v2Client) CreateOutboundWebhook(ctx context.Context, req *connect.Request[v2.CreateOutboundWebhookRequest]) (*connect.Response[v2.CreateOutboundWebhookResponse], error) {
+	return c.createOutboundWebhook.CallUnary(ctx, req)
+}
+
+// ListOutboundWebhooks calls api.v2.V2.ListOutboundWebhooks.
+func (c *v2Client) ListOutboundWebhooks(ctx context.Context, req *connect.Request[v2.ListOutboundWebhooksRequest]) (*connect.Response[v2.ListOutboundWebhooksResponse], error) {
+	return c.listOutboundWebhooks.CallUnary(ctx, req)
+}
+
+// GetOutboundWebhook calls api.v2.V2.GetOutboundWebhook.
+func (c *v2Client) GetOutboundWebhook(ctx context.Context, req *connect.Request[v2.GetOutboundWebhookRequest]) (*connect.Response[v2.GetOutboundWebhookResponse], error) {
+	return c.getOutboundWebhook.CallUnary(ctx, req)
+}
+
+// UpdateOutboundWebhook calls api.v2.V2.UpdateOutboundWebhook.
+func (c *v2Client) UpdateOutboundWebhook(ctx context.Context, req *connect.Request[v2.UpdateOutboundWebhookRequest]) (*connect.Response[v2.UpdateOutboundWebhookResponse], error) {
+	return c.updateOutboundWebhook.CallUnary(ctx, req)
+}
+
+// DeleteOutboundWebhook calls api.v2.V2.DeleteOutboundWebhook.
+func (c *v2Client) DeleteOutboundWebhook(ctx context.Context, req *connect.Request[v2.DeleteOutboundWebhookRequest]) (*connect.Response[v2.DeleteOutboundWebhookResponse], error) {
+	return c.deleteOutboundWebhook.CallUnary(ctx, req)
+}
+
+// ListOutboundWebhookDeliveries calls api.v2.V2.ListOutboundWebhookDeliveries.
+func (c *v2Client) ListOutboundWebhookDeliveries(ctx context.Context, req *connect.Request[v2.ListOutboundWebhookDeliveriesRequest]) (*connect.Response[v2.ListOutboundWebhookDeliveriesResponse], error) {
+	return c.listOutboundWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ReplayOutboundWebhookDelivery calls api.v2.V2.ReplayOutboundWebhookDelivery.
+func (c *v2Client) ReplayOutboundWebhookDelivery(ctx context.Context, req *connect.Request[v2.ReplayOutboundWebhookDeliveryRequest]) (*connect.Response[v2.ReplayOutboundWebhookDeliveryResponse], error) {
+	return c.replayOutboundWebhookDelivery.CallUnary(ctx, req)
+}
+
+// CreateAlertRule calls api.v2.V2.CreateAlertRule.
+func (c *v2Client) CreateAlertRule(ctx context.Context, req *connect.Request[v2.CreateAlertRuleRequest]) (*connect.Response[v2.CreateAlertRuleResponse], error) {
+	return c.createAlertRule.CallUnary(ctx, req)
+}
+
+// ListAlertRules calls api.v2.V2.ListAlertRules.
+func (c *v2Client) ListAlertRules(ctx context.Context, req *connect.Request[v2.ListAlertRulesRequest]) (*connect.Response[v2.ListAlertRulesResponse], error) {
+	return c.listAlertRules.CallUnary(ctx, req)
+}
+
+// GetAlertRule calls api.v2.V2.GetAlertRule.
+func (c *v2Client) GetAlertRule(ctx context.Context, req *connect.Request[v2.GetAlertRuleRequest]) (*connect.Response[v2.GetAlertRuleResponse], error) {
+	return c.getAlertRule.CallUnary(ctx, req)
+}
+
+// UpdateAlertRule calls api.v2.V2.UpdateAlertRule.
+func (c *v2Client) UpdateAlertRule(ctx context.Context, req *connect.Request[v2.UpdateAlertRuleRequest]) (*connect.Response[v2.UpdateAlertRuleResponse], error) {
+	return c.updateAlertRule.CallUnary(ctx, req)
+}
+
+// DeleteAlertRule calls api.v2.V2.DeleteAlertRule.
+func (c *v2Client) DeleteAlertRule(ctx context.Context, req *connect.Request[v2.DeleteAlertRuleRequest]) (*connect.Response[v2.DeleteAlertRuleResponse], error) {
+	return c.deleteAlertRule.CallUnary(ctx, req)
+}
+
+// ListAlerts calls api.v2.V2.ListAlerts.
+func (c *v2Client) ListAlerts(ctx context.Context, req *connect.Request[v2.ListAlertsRequest]) (*connect.Response[v2.ListAlertsResponse], error) {
+	return c.listAlerts.CallUnary(ctx, req)
+}
+
+// GetUsage calls api.v2.V2.GetUsage.
+func (c *v2Client) GetUsage(ctx context.Context, req *connect.Request[v2.GetUsageRequest]) (*connect.Response[v2.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
 // GetApp calls api.v2.V2.GetApp.
 func (c *v2Client) GetApp(ctx context.Context, req *connect.Request[v2.GetAppRequest]) (*connect.Response[v2.GetAppResponse], error) {
 	return c.getApp.CallUnary(ctx, req)
@@ -776,6 +1172,16 @@ func (c *v2Client) GetFunctions(ctx context.Context, req *connect.Request[v2.Get
 	return c.getFunctions.CallUnary(ctx, req)
 }
 
+// ListFunctionVersions calls api.v2.V2.ListFunctionVersions.
+func (c *v2Client) ListFunctionVersions(ctx context.Context, req *connect.Request[v2.ListFunctionVersionsRequest]) (*connect.Response[v2.ListFunctionVersionsResponse], error) {
+	return c.listFunctionVersions.CallUnary(ctx, req)
+}
+
+// GetFunctionVersionDiff calls api.v2.V2.GetFunctionVersionDiff.
+func (c *v2Client) GetFunctionVersionDiff(ctx context.Context, req *connect.Request[v2.GetFunctionVersionDiffRequest]) (*connect.Response[v2.GetFunctionVersionDiffResponse], error) {
+	return c.getFunctionVersionDiff.CallUnary(ctx, req)
+}
+
 // SendEvent calls api.v2.V2.SendEvent.
 func (c *v2Client) SendEvent(ctx context.Context, req *connect.Request[v2.SendEventRequest]) (*connect.Response[v2.SendEventResponse], error) {
 	return c.sendEvent.CallUnary(ctx, req)
@@ -786,6 +1192,16 @@ func (c *v2Client) InvokeFunction(ctx context.Context, req *connect.Request[v2.I
 	return c.invokeFunction.CallUnary(ctx, req)
 }
 
+// ListScheduledInvocations calls api.v2.V2.ListScheduledInvocations.
+func (c *v2Client) ListScheduledInvocations(ctx context.Context, req *connect.Request[v2.ListScheduledInvocationsRequest]) (*connect.Response[v2.ListScheduledInvocationsResponse], error) {
+	return c.listScheduledInvocations.CallUnary(ctx, req)
+}
+
+// CancelScheduledInvocation calls api.v2.V2.CancelScheduledInvocation.
+func (c *v2Client) CancelScheduledInvocation(ctx context.Context, req *connect.Request[v2.CancelScheduledInvocationRequest]) (*connect.Response[v2.CancelScheduledInvocationResponse], error) {
+	return c.cancelScheduledInvocation.CallUnary(ctx, req)
+}
+
 // ListInsightsTables calls api.v2.V2.ListInsightsTables.
 func (c *v2Client) ListInsightsTables(ctx context.Context, req *connect.Request[v2.ListInsightsTablesRequest]) (*connect.Response[v2.ListInsightsTablesResponse], error) {
 	return c.listInsightsTables.CallUnary(ctx, req)
@@ -854,6 +1270,29 @@ type V2Handler interface {
 	GetEventRuns(context.Context, *connect.Request[v2.GetEventRunsRequest]) (*connect.Response[v2.GetEventRunsResponse], error)
 	Rerun(context.Context, *connect.Request[v2.RerunRequest]) (*connect.Response[v2.RerunResponse], error)
 	CancelRun(context.Context, *connect.Request[v2.CancelRunRequest]) (*connect.Response[v2.CancelRunResponse], error)
+	ListRunWaits(context.Context, *connect.Request[v2.ListRunWaitsRequest]) (*connect.Response[v2.ListRunWaitsResponse], error)
+	ListFunctionWaits(context.Context, *connect.Request[v2.ListFunctionWaitsRequest]) (*connect.Response[v2.ListFunctionWaitsResponse], error)
+	ResolveWait(context.Context, *connect.Request[v2.ResolveWaitRequest]) (*connect.Response[v2.ResolveWaitResponse], error)
+	TimeoutWait(context.Context, *connect.Request[v2.TimeoutWaitRequest]) (*connect.Response[v2.TimeoutWaitResponse], error)
+	PreviewBulkRunOperation(context.Context, *connect.Request[v2.PreviewBulkRunOperationRequest]) (*connect.Response[v2.PreviewBulkRunOperationResponse], error)
+	CreateBulkRunOperation(context.Context, *connect.Request[v2.CreateBulkRunOperationRequest]) (*connect.Response[v2.CreateBulkRunOperationResponse], error)
+	ListBulkRunOperations(context.Context, *connect.Request[v2.ListBulkRunOperationsRequest]) (*connect.Response[v2.ListBulkRunOperationsResponse], error)
+	GetBulkRunOperation(context.Context, *connect.Request[v2.GetBulkRunOperationRequest]) (*connect.Response[v2.GetBulkRunOperationResponse], error)
+	AbortBulkRunOperation(context.Context, *connect.Request[v2.AbortBulkRunOperationRequest]) (*connect.Response[v2.AbortBulkRunOperationResponse], error)
+	CreateOutboundWebhook(context.Context, *connect.Request[v2.CreateOutboundWebhookRequest]) (*connect.Response[v2.CreateOutboundWebhookResponse], error)
+	ListOutboundWebhooks(context.Context, *connect.Request[v2.ListOutboundWebhooksRequest]) (*connect.Response[v2.ListOutboundWebhooksResponse], error)
+	GetOutboundWebhook(context.Context, *connect.Request[v2.GetOutboundWebhookRequest]) (*connect.Response[v2.GetOutboundWebhookResponse], error)
+	UpdateOutboundWebhook(context.Context, *connect.Request[v2.UpdateOutboundWebhookRequest]) (*connect.Response[v2.UpdateOutboundWebhookResponse], error)
+	DeleteOutboundWebhook(context.Context, *connect.Request[v2.DeleteOutboundWebhookRequest]) (*connect.Response[v2.DeleteOutboundWebhookResponse], error)
+	ListOutboundWebhookDeliveries(context.Context, *connect.Request[v2.ListOutboundWebhookDeliveriesRequest]) (*connect.Response[v2.ListOutboundWebhookDeliveriesResponse], error)
+	ReplayOutboundWebhookDelivery(context.Context, *connect.Request[v2.ReplayOutboundWebhookDeliveryRequest]) (*connect.Response[v2.ReplayOutboundWebhookDeliveryResponse], error)
+	CreateAlertRule(context.Context, *connect.Request[v2.CreateAlertRuleRequest]) (*connect.Response[v2.CreateAlertRuleResponse], error)
+	ListAlertRules(context.Context, *connect.Request[v2.ListAlertRulesRequest]) (*connect.Response[v2.ListAlertRulesResponse], error)
+	GetAlertRule(context.Context, *connect.Request[v2.GetAlertRuleRequest]) (*connect.Response[v2.GetAlertRuleResponse], error)
+	UpdateAlertRule(context.Context, *connect.Request[v2.UpdateAlertRuleRequest]) (*connect.Response[v2.UpdateAlertRuleResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[v2.DeleteAlertRuleRequest]) (*connect.Response[v2.DeleteAlertRuleResponse], error)
+	ListAlerts(context.Context, *connect.Request[v2.ListAlertsRequest]) (*connect.Response[v2.ListAlertsResponse], error)
+	GetUsage(context.Context, *connect.Request[v2.GetUsageRequest]) (*connect.Response[v2.GetUsageResponse], error)
 	GetApp(context.Context, *connect.Request[v2.GetAppRequest]) (*connect.Response[v2.GetAppResponse], error)
 	GetApps(context.Context, *connect.Request[v2.GetAppsRequest]) (*connect.Response[v2.GetAppsResponse], error)
 	CreateSandbox(context.Context, *connect.Request[v2.CreateSandboxRequest]) (*connect.Response[v2.CreateSandboxResponse], error)
@@ -876,8 +1315,12 @@ type V2Handler interface {
 	GetFunctionTrace(context.Context, *connect.Request[v2.GetFunctionTraceRequest]) (*connect.Response[v2.GetFunctionTraceResponse], error)
 	GetFunction(context.Context, *connect.Request[v2.GetFunctionRequest]) (*connect.Response[v2.GetFunctionResponse], error)
 	GetFunctions(context.Context, *connect.Request[v2.GetFunctionsRequest]) (*connect.Response[v2.GetFunctionsResponse], error)
+	ListFunctionVersions(context.Context, *connect.Request[v2.ListFunctionVersionsRequest]) (*connect.Response[v2.ListFunctionVersionsResponse], error)
+	GetFunctionVersionDiff(context.Context, *connect.Request[v2.GetFunctionVersionDiffRequest]) (*connect.Response[v2.GetFunctionVersionDiffResponse], error)
 	SendEvent(context.Context, *connect.Request[v2.SendEventRequest]) (*connect.Response[v2.SendEventResponse], error)
 	InvokeFunction(context.Context, *connect.Request[v2.InvokeFunctionRequest]) (*connect.Response[v2.InvokeFunctionResponse], error)
+	ListScheduledInvocations(context.Context, *connect.Request[v2.ListScheduledInvocationsRequest]) (*connect.Response[v2.ListScheduledInvocationsResponse], error)
+	CancelScheduledInvocation(context.Context, *connect.Request[v2.CancelScheduledInvocationRequest]) (*connect.Response[v2.CancelScheduledInvocationResponse], error)
 	ListInsightsTables(context.Context, *connect.Request[v2.ListInsightsTablesRequest]) (*connect.Response[v2.ListInsightsTablesResponse], error)
 	ListInsightsEventSchemas(context.Context, *connect.Request[v2.ListInsightsEventSchemasRequest]) (*connect.Response[v2.ListInsightsEventSchemasResponse], error)
 	QueryInsightsPrompt(context.Context, *connect.Request[v2.QueryInsightsPromptRequest]) (*connect.Response[v2.QueryInsightsPromptResponse], error)
@@ -1004,6 +1447,144 @@ func NewV2Handler(svc V2Handler, opts ...connect.HandlerOption) (string, http.Ha
 		connect.WithSchema(v2Methods.ByName("CancelRun")),
 		connect.WithHandlerOptions(opts...),
 	)
+	v2ListRunWaitsHandler := connect.NewUnaryHandler(
+		V2ListRunWaitsProcedure,
+		svc.ListRunWaits,
+		connect.WithSchema(v2Methods.ByName("ListRunWaits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ListFunctionWaitsHandler := connect.NewUnaryHandler(
+		V2ListFunctionWaitsProcedure,
+		svc.ListFunctionWaits,
+		connect.WithSchema(v2Methods.ByName("ListFunctionWaits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ResolveWaitHandler := connect.NewUnaryHandler(
+		V2ResolveWaitProcedure,
+		svc.ResolveWait,
+		connect.WithSchema(v2Methods.ByName("ResolveWait")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2TimeoutWaitHandler := connect.NewUnaryHandler(
+		V2TimeoutWaitProcedure,
+		svc.TimeoutWait,
+		connect.WithSchema(v2Methods.ByName("TimeoutWait")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2PreviewBulkRunOperationHandler := connect.NewUnaryHandler(
+		V2PreviewBulkRunOperationProcedure,
+		svc.PreviewBulkRunOperation,
+		connect.WithSchema(v2Methods.ByName("PreviewBulkRunOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2CreateBulkRunOperationHandler := connect.NewUnaryHandler(
+		V2CreateBulkRunOperationProcedure,
+		svc.CreateBulkRunOperation,
+		connect.WithSchema(v2Methods.ByName("CreateBulkRunOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ListBulkRunOperationsHandler := connect.NewUnaryHandler(
+		V2ListBulkRunOperationsProcedure,
+		svc.ListBulkRunOperations,
+		connect.WithSchema(v2Methods.ByName("ListBulkRunOperations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2GetBulkRunOperationHandler := connect.NewUnaryHandler(
+		V2GetBulkRunOperationProcedure,
+		svc.GetBulkRunOperation,
+		connect.WithSchema(v2Methods.ByName("GetBulkRunOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2AbortBulkRunOperationHandler := connect.NewUnaryHandler(
+		V2AbortBulkRunOperationProcedure,
+		svc.AbortBulkRunOperation,
+		connect.WithSchema(v2Methods.ByName("AbortBulkRunOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2CreateOutboundWebhookHandler := connect.NewUnaryHandler(
+		V2CreateOutboundWebhookProcedure,
+		svc.CreateOutboundWebhook,
+		connect.WithSchema(v2Methods.ByName("CreateOutboundWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ListOutboundWebhooksHandler := connect.NewUnaryHandler(
+		V2ListOutboundWebhooksProcedure,
+		svc.ListOutboundWebhooks,
+		connect.WithSchema(v2Methods.ByName("ListOutboundWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2GetOutboundWebhookHandler := connect.NewUnaryHandler(
+		V2GetOutboundWebhookProcedure,
+		svc.GetOutboundWebhook,
+		connect.WithSchema(v2Methods.ByName("GetOutboundWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2UpdateOutboundWebhookHandler := connect.NewUnaryHandler(
+		V2UpdateOutboundWebhookProcedure,
+		svc.UpdateOutboundWebhook,
+		connect.WithSchema(v2Methods.ByName("UpdateOutboundWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2DeleteOutboundWebhookHandler := connect.NewUnaryHandler(
+		V2DeleteOutboundWebhookProcedure,
+		svc.DeleteOutboundWebhook,
+		connect.WithSchema(v2Methods.ByName("DeleteOutboundWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ListOutboundWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		V2ListOutboundWebhookDeliveriesProcedure,
+		svc.ListOutboundWebhookDeliveries,
+		connect.WithSchema(v2Methods.ByName("ListOutboundWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ReplayOutboundWebhookDeliveryHandler := connect.NewUnaryHandler(
+		V2ReplayOutboundWebhookDeliveryProcedure,
+		svc.ReplayOutboundWebhookDelivery,
+		connect.WithSchema(v2Methods.ByName("ReplayOutboundWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2CreateAlertRuleHandler := connect.NewUnaryHandler(
+		V2CreateAlertRuleProcedure,
+		svc.CreateAlertRule,
+		connect.WithSchema(v2Methods.ByName("CreateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ListAlertRulesHandler := connect.NewUnaryHandler(
+		V2ListAlertRulesProcedure,
+		svc.ListAlertRules,
+		connect.WithSchema(v2Methods.ByName("ListAlertRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2GetAlertRuleHandler := connect.NewUnaryHandler(
+		V2GetAlertRuleProcedure,
+		svc.GetAlertRule,
+		connect.WithSchema(v2Methods.ByName("GetAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2UpdateAlertRuleHandler := connect.NewUnaryHandler(
+		V2UpdateAlertRuleProcedure,
+		svc.UpdateAlertRule,
+		connect.WithSchema(v2Methods.ByName("UpdateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2DeleteAlertRuleHandler := connect.NewUnaryHandler(
+		V2DeleteAlertRuleProcedure,
+		svc.DeleteAlertRule,
+		connect.WithSchema(v2Methods.ByName("DeleteAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2ListAlertsHandler := connect.NewUnaryHandler(
+		V2ListAlertsProcedure,
+		svc.ListAlerts,
+		connect.WithSchema(v2Methods.ByName("ListAlerts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2GetUsageHandler := connect.NewUnaryHandler(
+		V2GetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(v2Methods.ByName("GetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	v2GetAppHandler := connect.NewUnaryHandler(
 		V2GetAppProcedure,
 		svc.GetApp,
@@ -1136,6 +1717,18 @@ func NewV2Handler(svc V2Handler, opts ...connect.HandlerOption) (string, http.Ha
 		connect.WithSchema(v2Methods.ByName("GetFunctions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	v2ListFunctionVersionsHandler := connect.NewUnaryHandler(
+		V2ListFunctionVersionsProcedure,
+		svc.ListFunctionVersions,
+		connect.WithSchema(v2Methods.ByName("ListFunctionVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2GetFunctionVersionDiffHandler := connect.NewUnaryHandler(
+		V2GetFunctionVersionDiffProcedure,
+		svc.GetFunctionVersionDiff,
+		connect.WithSchema(v2Methods.ByName("GetFunctionVersionDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
 	v2SendEventHandler := connect.NewUnaryHandler(
 		V2SendEventProcedure,
 		svc.SendEvent,
@@ -1148,6 +1741,18 @@ func NewV2Handler(svc V2Handler, opts ...connect.HandlerOption) (string, http.Ha
 		connect.WithSchema(v2Methods.ByName("InvokeFunction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	v2ListScheduledInvocationsHandler := connect.NewUnaryHandler(
+		V2ListScheduledInvocationsProcedure,
+		svc.ListScheduledInvocations,
+		connect.WithSchema(v2Methods.ByName("ListScheduledInvocations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	v2CancelScheduledInvocationHandler := connect.NewUnaryHandler(
+		V2CancelScheduledInvocationProcedure,
+		svc.CancelScheduledInvocation,
+		connect.WithSchema(v2Methods.ByName("CancelScheduledInvocation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	v2ListInsightsTablesHandler := connect.NewUnaryHandler(
 		V2ListInsightsTablesProcedure,
 		svc.ListInsightsTables,
@@ -1240,6 +1845,52 @@ func NewV2Handler(svc V2Handler, opts ...connect.HandlerOption) (string, http.Ha
 			v2RerunHandler.ServeHTTP(w, r)
 		case V2CancelRunProcedure:
 			v2CancelRunHandler.ServeHTTP(w, r)
+		case V2ListRunWaitsProcedure:
+			v2ListRunWaitsHandler.ServeHTTP(w, r)
+		case V2ListFunctionWaitsProcedure:
+			v2ListFunctionWaitsHandler.ServeHTTP(w, r)
+		case V2ResolveWaitProcedure:
+			v2ResolveWaitHandler.ServeHTTP(w, r)
+		case V2TimeoutWaitProcedure:
+			v2TimeoutWaitHandler.ServeHTTP(w, r)
+		case V2PreviewBulkRunOperationProcedure:
+			v2PreviewBulkRunOperationHandler.ServeHTTP(w, r)
+		case V2CreateBulkRunOperationProcedure:
+			v2CreateBulkRunOperationHandler.ServeHTTP(w, r)
+		case V2ListBulkRunOperationsProcedure:
+			v2ListBulkRunOperationsHandler.ServeHTTP(w, r)
+		case V2GetBulkRunOperationProcedure:
+			v2GetBulkRunOperationHandler.ServeHTTP(w, r)
+		case V2AbortBulkRunOperationProcedure:
+			v2AbortBulkRunOperationHandler.ServeHTTP(w, r)
+		case V2CreateOutboundWebhookProcedure:
+			v2CreateOutboundWebhookHandler.ServeHTTP(w, r)
+		case V2ListOutboundWebhooksProcedure:
+			v2ListOutboundWebhooksHandler.ServeHTTP(w, r)
+		case V2GetOutboundWebhookProcedure:
+			v2GetOutboundWebhookHandler.ServeHTTP(w, r)
+		case V2UpdateOutboundWebhookProcedure:
+			v2UpdateOutboundWebhookHandler.ServeHTTP(w, r)
+		case V2DeleteOutboundWebhookProcedure:
+			v2DeleteOutboundWebhookHandler.ServeHTTP(w, r)
+		case V2ListOutboundWebhookDeliveriesProcedure:
+			v2ListOutboundWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case V2ReplayOutboundWebhookDeliveryProcedure:
+			v2ReplayOutboundWebhookDeliveryHandler.ServeHTTP(w, r)
+		case V2CreateAlertRuleProcedure:
+			v2CreateAlertRuleHandler.ServeHTTP(w, r)
+		case V2ListAlertRulesProcedure:
+			v2ListAlertRulesHandler.ServeHTTP(w, r)
+		case V2GetAlertRuleProcedure:
+			v2GetAlertRuleHandler.ServeHTTP(w, r)
+		case V2UpdateAlertRuleProcedure:
+			v2UpdateAlertRuleHandler.ServeHTTP(w, r)
+		case V2DeleteAlertRuleProcedure:
+			v2DeleteAlertRuleHandler.ServeHTTP(w, r)
+		case V2ListAlertsProcedure:
+			v2ListAlertsHandler.ServeHTTP(w, r)
+		case V2GetUsageProcedure:
+			v2GetUsageHandler.ServeHTTP(w, r)
 		case V2GetAppProcedure:
 			v2GetAppHandler.ServeHTTP(w, r)
 		case V2GetAppsProcedure:
@@ -1284,10 +1935,18 @@ func NewV2Handler(svc V2Handler, opts ...connect.HandlerOption) (string, http.Ha
 			v2GetFunctionHandler.ServeHTTP(w, r)
 		case V2GetFunctionsProcedure:
 			v2GetFunctionsHandler.ServeHTTP(w, r)
+		case V2ListFunctionVersionsProcedure:
+			v2ListFunctionVersionsHandler.ServeHTTP(w, r)
+		case V2GetFunctionVersionDiffProcedure:
+			v2GetFunctionVersionDiffHandler.ServeHTTP(w, r)
 		case V2SendEventProcedure:
 			v2SendEventHandler.ServeHTTP(w, r)
 		case V2InvokeFunctionProcedure:
 			v2InvokeFunctionHandler.ServeHTTP(w, r)
+		case V2ListScheduledInvocationsProcedure:
+			v2ListScheduledInvocationsHandler.ServeHTTP(w, r)
+		case V2CancelScheduledInvocationProcedure:
+			v2CancelScheduledInvocationHandler.ServeHTTP(w, r)
 		case V2ListInsightsTablesProcedure:
 			v2ListInsightsTablesHandler.ServeHTTP(w, r)
 		case V2ListInsightsEventSchemasProcedure:
@@ -1387,6 +2046,98 @@ func (UnimplementedV2Handler) CancelRun(context.Context, *connect.Request[v2.Can
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.CancelRun is not implemented"))
 }
 
+func (UnimplementedV2Handler) ListRunWaits(context.Context, *connect.Request[v2.ListRunWaitsRequest]) (*connect.Response[v2.ListRunWaitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListRunWaits is not implemented"))
+}
+
+func (UnimplementedV2Handler) ListFunctionWaits(context.Context, *connect.Request[v2.ListFunctionWaitsRequest]) (*connect.Response[v2.ListFunctionWaitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListFunctionWaits is not implemented"))
+}
+
+func (UnimplementedV2Handler) ResolveWait(context.Context, *connect.Request[v2.ResolveWaitRequest]) (*connect.Response[v2.ResolveWaitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ResolveWait is not implemented"))
+}
+
+func (UnimplementedV2Handler) TimeoutWait(context.Context, *connect.Request[v2.TimeoutWaitRequest]) (*connect.Response[v2.TimeoutWaitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.TimeoutWait is not implemented"))
+}
+
+func (UnimplementedV2Handler) PreviewBulkRunOperation(context.Context, *connect.Request[v2.PreviewBulkRunOperationRequest]) (*connect.Response[v2.PreviewBulkRunOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.PreviewBulkRunOperation is not implemented"))
+}
+
+func (UnimplementedV2Handler) CreateBulkRunOperation(context.Context, *connect.Request[v2.CreateBulkRunOperationRequest]) (*connect.Response[v2.CreateBulkRunOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.CreateBulkRunOperation is not implemented"))
+}
+
+func (UnimplementedV2Handler) ListBulkRunOperations(context.Context, *connect.Request[v2.ListBulkRunOperationsRequest]) (*connect.Response[v2.ListBulkRunOperationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListBulkRunOperations is not implemented"))
+}
+
+func (UnimplementedV2Handler) GetBulkRunOperation(context.Context, *connect.Request[v2.GetBulkRunOperationRequest]) (*connect.Response[v2.GetBulkRunOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetBulkRunOperation is not implemented"))
+}
+
+func (UnimplementedV2Handler) AbortBulkRunOperation(context.Context, *connect.Request[v2.AbortBulkRunOperationRequest]) (*connect.Response[v2.AbortBulkRunOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.AbortBulkRunOperation is not implemented"))
+}
+
+func (UnimplementedV2Handler) CreateOutboundWebhook(context.Context, *connect.Request[v2.CreateOutboundWebhookRequest]) (*connect.Response[v2.CreateOutboundWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.CreateOutboundWebhook is not implemented"))
+}
+
+func (UnimplementedV2Handler) ListOutboundWebhooks(context.Context, *connect.Request[v2.ListOutboundWebhooksRequest]) (*connect.Response[v2.ListOutboundWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListOutboundWebhooks is not implemented"))
+}
+
+func (UnimplementedV2Handler) GetOutboundWebhook(context.Context, *connect.Request[v2.GetOutboundWebhookRequest]) (*connect.Response[v2.GetOutboundWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetOutboundWebhook is not implemented"))
+}
+
+func (UnimplementedV2Handler) UpdateOutboundWebhook(context.Context, *connect.Request[v2.UpdateOutboundWebhookRequest]) (*connect.Response[v2.UpdateOutboundWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.UpdateOutboundWebhook is not implemented"))
+}
+
+func (UnimplementedV2Handler) DeleteOutboundWebhook(context.Context, *connect.Request[v2.DeleteOutboundWebhookRequest]) (*connect.Response[v2.DeleteOutboundWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.DeleteOutboundWebhook is not implemented"))
+}
+
+func (UnimplementedV2Handler) ListOutboundWebhookDeliveries(context.Context, *connect.Request[v2.ListOutboundWebhookDeliveriesRequest]) (*connect.Response[v2.ListOutboundWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListOutboundWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedV2Handler) ReplayOutboundWebhookDelivery(context.Context, *connect.Request[v2.ReplayOutboundWebhookDeliveryRequest]) (*connect.Response[v2.ReplayOutboundWebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ReplayOutboundWebhookDelivery is not implemented"))
+}
+
+func (UnimplementedV2Handler) CreateAlertRule(context.Context, *connect.Request[v2.CreateAlertRuleRequest]) (*connect.Response[v2.CreateAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.CreateAlertRule is not implemented"))
+}
+
+func (UnimplementedV2Handler) ListAlertRules(context.Context, *connect.Request[v2.ListAlertRulesRequest]) (*connect.Response[v2.ListAlertRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListAlertRules is not implemented"))
+}
+
+func (UnimplementedV2Handler) GetAlertRule(context.Context, *connect.Request[v2.GetAlertRuleRequest]) (*connect.Response[v2.GetAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetAlertRule is not implemented"))
+}
+
+func (UnimplementedV2Handler) UpdateAlertRule(context.Context, *connect.Request[v2.UpdateAlertRuleRequest]) (*connect.Response[v2.UpdateAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.UpdateAlertRule is not implemented"))
+}
+
+func (UnimplementedV2Handler) DeleteAlertRule(context.Context, *connect.Request[v2.DeleteAlertRuleRequest]) (*connect.Response[v2.DeleteAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.DeleteAlertRule is not implemented"))
+}
+
+func (UnimplementedV2Handler) ListAlerts(context.Context, *connect.Request[v2.ListAlertsRequest]) (*connect.Response[v2.ListAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListAlerts is not implemented"))
+}
+
+func (UnimplementedV2Handler) GetUsage(context.Context, *connect.Request[v2.GetUsageRequest]) (*connect.Response[v2.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetUsage is not implemented"))
+}
+
 func (UnimplementedV2Handler) GetApp(context.Context, *connect.Request[v2.GetAppRequest]) (*connect.Response[v2.GetAppResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetApp is not implemented"))
 }
@@ -1475,6 +2226,14 @@ func (UnimplementedV2Handler) GetFunctions(context.Context, *connect.Request[v2.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetFunctions is not implemented"))
 }
 
+func (UnimplementedV2Handler) ListFunctionVersions(context.Context, *connect.Request[v2.ListFunctionVersionsRequest]) (*connect.Response[v2.ListFunctionVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListFunctionVersions is not implemented"))
+}
+
+func (UnimplementedV2Handler) GetFunctionVersionDiff(context.Context, *connect.Request[v2.GetFunctionVersionDiffRequest]) (*connect.Response[v2.GetFunctionVersionDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.GetFunctionVersionDiff is not implemented"))
+}
+
 func (UnimplementedV2Handler) SendEvent(context.Context, *connect.Request[v2.SendEventRequest]) (*connect.Response[v2.SendEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.SendEvent is not implemented"))
 }
@@ -1483,6 +2242,14 @@ func (UnimplementedV2Handler) InvokeFunction(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.InvokeFunction is not implemented"))
 }
 
+func (UnimplementedV2Handler) ListScheduledInvocations(context.Context, *connect.Request[v2.ListScheduledInvocationsRequest]) (*connect.Response[v2.ListScheduledInvocationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListScheduledInvocations is not implemented"))
+}
+
+func (UnimplementedV2Handler) CancelScheduledInvocation(context.Context, *connect.Request[v2.CancelScheduledInvocationRequest]) (*connect.Response[v2.CancelScheduledInvocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.CancelScheduledInvocation is not implemented"))
+}
+
 func (UnimplementedV2Handler) ListInsightsTables(context.Context, *connect.Request[v2.ListInsightsTablesRequest]) (*connect.Response[v2.ListInsightsTablesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v2.V2.ListInsightsTables is not implemented"))
 }
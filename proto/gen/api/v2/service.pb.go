@@ -616,6 +616,263 @@ func (InsightsDiagnosticSeverity) EnumDescriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{10}
 }
 
+type WaitType int32
+
+const (
+	WaitType_WAIT_TYPE_UNSPECIFIED WaitType = 0
+	WaitType_WAIT_TYPE_EVENT       WaitType = 1
+	WaitType_WAIT_TYPE_SIGNAL      WaitType = 2
+	WaitType_WAIT_TYPE_INVOKE      WaitType = 3
+)
+
+// Enum value maps for WaitType.
+var (
+	WaitType_name = map[int32]string{
+		0: "WAIT_TYPE_UNSPECIFIED",
+		1: "WAIT_TYPE_EVENT",
+		2: "WAIT_TYPE_SIGNAL",
+		3: "WAIT_TYPE_INVOKE",
+	}
+	WaitType_value = map[string]int32{
+		"WAIT_TYPE_UNSPECIFIED": 0,
+		"WAIT_TYPE_EVENT":       1,
+		"WAIT_TYPE_SIGNAL":      2,
+		"WAIT_TYPE_INVOKE":      3,
+	}
+)
+
+func (x WaitType) Enum() *WaitType {
+	p := new(WaitType)
+	*p = x
+	return p
+}
+
+func (x WaitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_service_proto_enumTypes[11].Descriptor()
+}
+
+func (WaitType) Type() protoreflect.EnumType {
+	return &file_api_v2_service_proto_enumTypes[11]
+}
+
+func (x WaitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitType.Descriptor instead.
+func (WaitType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{11}
+}
+
+type BulkRunOperationAction int32
+
+const (
+	BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_UNSPECIFIED BulkRunOperationAction = 0
+	BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_CANCEL      BulkRunOperationAction = 1
+	BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_RERUN       BulkRunOperationAction = 2
+)
+
+// Enum value maps for BulkRunOperationAction.
+var (
+	BulkRunOperationAction_name = map[int32]string{
+		0: "BULK_RUN_OPERATION_ACTION_UNSPECIFIED",
+		1: "BULK_RUN_OPERATION_ACTION_CANCEL",
+		2: "BULK_RUN_OPERATION_ACTION_RERUN",
+	}
+	BulkRunOperationAction_value = map[string]int32{
+		"BULK_RUN_OPERATION_ACTION_UNSPECIFIED": 0,
+		"BULK_RUN_OPERATION_ACTION_CANCEL":      1,
+		"BULK_RUN_OPERATION_ACTION_RERUN":       2,
+	}
+)
+
+func (x BulkRunOperationAction) Enum() *BulkRunOperationAction {
+	p := new(BulkRunOperationAction)
+	*p = x
+	return p
+}
+
+func (x BulkRunOperationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkRunOperationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_service_proto_enumTypes[12].Descriptor()
+}
+
+func (BulkRunOperationAction) Type() protoreflect.EnumType {
+	return &file_api_v2_service_proto_enumTypes[12]
+}
+
+func (x BulkRunOperationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkRunOperationAction.Descriptor instead.
+func (BulkRunOperationAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{12}
+}
+
+type BulkRunOperationStatus int32
+
+const (
+	BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_UNSPECIFIED BulkRunOperationStatus = 0
+	BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_RUNNING     BulkRunOperationStatus = 1
+	BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_COMPLETED   BulkRunOperationStatus = 2
+	BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_ABORTED     BulkRunOperationStatus = 3
+	BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_FAILED      BulkRunOperationStatus = 4
+)
+
+// Enum value maps for BulkRunOperationStatus.
+var (
+	BulkRunOperationStatus_name = map[int32]string{
+		0: "BULK_RUN_OPERATION_STATUS_UNSPECIFIED",
+		1: "BULK_RUN_OPERATION_STATUS_RUNNING",
+		2: "BULK_RUN_OPERATION_STATUS_COMPLETED",
+		3: "BULK_RUN_OPERATION_STATUS_ABORTED",
+		4: "BULK_RUN_OPERATION_STATUS_FAILED",
+	}
+	BulkRunOperationStatus_value = map[string]int32{
+		"BULK_RUN_OPERATION_STATUS_UNSPECIFIED": 0,
+		"BULK_RUN_OPERATION_STATUS_RUNNING":     1,
+		"BULK_RUN_OPERATION_STATUS_COMPLETED":   2,
+		"BULK_RUN_OPERATION_STATUS_ABORTED":     3,
+		"BULK_RUN_OPERATION_STATUS_FAILED":      4,
+	}
+)
+
+func (x BulkRunOperationStatus) Enum() *BulkRunOperationStatus {
+	p := new(BulkRunOperationStatus)
+	*p = x
+	return p
+}
+
+func (x BulkRunOperationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkRunOperationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_service_proto_enumTypes[13].Descriptor()
+}
+
+func (BulkRunOperationStatus) Type() protoreflect.EnumType {
+	return &file_api_v2_service_proto_enumTypes[13]
+}
+
+func (x BulkRunOperationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkRunOperationStatus.Descriptor instead.
+func (BulkRunOperationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{13}
+}
+
+type OutboundWebhookDeliveryStatus int32
+
+const (
+	OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED OutboundWebhookDeliveryStatus = 0
+	OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_PENDING     OutboundWebhookDeliveryStatus = 1
+	OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   OutboundWebhookDeliveryStatus = 2
+	OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_FAILED      OutboundWebhookDeliveryStatus = 3
+)
+
+// Enum value maps for OutboundWebhookDeliveryStatus.
+var (
+	OutboundWebhookDeliveryStatus_name = map[int32]string{
+		0: "OUTBOUND_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "OUTBOUND_WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "OUTBOUND_WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "OUTBOUND_WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	OutboundWebhookDeliveryStatus_value = map[string]int32{
+		"OUTBOUND_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"OUTBOUND_WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"OUTBOUND_WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"OUTBOUND_WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x OutboundWebhookDeliveryStatus) Enum() *OutboundWebhookDeliveryStatus {
+	p := new(OutboundWebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x OutboundWebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutboundWebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_service_proto_enumTypes[14].Descriptor()
+}
+
+func (OutboundWebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_api_v2_service_proto_enumTypes[14]
+}
+
+func (x OutboundWebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutboundWebhookDeliveryStatus.Descriptor instead.
+func (OutboundWebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{14}
+}
+
+type AlertStatus int32
+
+const (
+	AlertStatus_ALERT_STATUS_UNSPECIFIED AlertStatus = 0
+	AlertStatus_ALERT_STATUS_FIRING      AlertStatus = 1
+	AlertStatus_ALERT_STATUS_RESOLVED    AlertStatus = 2
+)
+
+// Enum value maps for AlertStatus.
+var (
+	AlertStatus_name = map[int32]string{
+		0: "ALERT_STATUS_UNSPECIFIED",
+		1: "ALERT_STATUS_FIRING",
+		2: "ALERT_STATUS_RESOLVED",
+	}
+	AlertStatus_value = map[string]int32{
+		"ALERT_STATUS_UNSPECIFIED": 0,
+		"ALERT_STATUS_FIRING":      1,
+		"ALERT_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x AlertStatus) Enum() *AlertStatus {
+	p := new(AlertStatus)
+	*p = x
+	return p
+}
+
+func (x AlertStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_service_proto_enumTypes[15].Descriptor()
+}
+
+func (AlertStatus) Type() protoreflect.EnumType {
+	return &file_api_v2_service_proto_enumTypes[15]
+}
+
+func (x AlertStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertStatus.Descriptor instead.
+func (AlertStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{15}
+}
+
 type HealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2061,19 +2318,20 @@ func (x *RunTrigger) GetCronSchedule() string {
 }
 
 type FunctionRun struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Function      *FunctionRef           `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
-	App           *AppRef                `protobuf:"bytes,3,opt,name=app,proto3" json:"app,omitempty"`
-	Status        FunctionRunStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=api.v2.FunctionRunStatus" json:"status,omitempty"`
-	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
-	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
-	DurationMs    *uint64                `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
-	Trigger       *RunTrigger            `protobuf:"bytes,9,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	Output        *structpb.Struct       `protobuf:"bytes,10,opt,name=output,proto3,oneof" json:"output,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Function        *FunctionRef           `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	App             *AppRef                `protobuf:"bytes,3,opt,name=app,proto3" json:"app,omitempty"`
+	Status          FunctionRunStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=api.v2.FunctionRunStatus" json:"status,omitempty"`
+	QueuedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	EndedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	DurationMs      *uint64                `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	Trigger         *RunTrigger            `protobuf:"bytes,9,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Output          *structpb.Struct       `protobuf:"bytes,10,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	FunctionVersion *int32                 `protobuf:"varint,11,opt,name=function_version,json=functionVersion,proto3,oneof" json:"function_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *FunctionRun) Reset() {
@@ -2176,6 +2434,13 @@ func (x *FunctionRun) GetOutput() *structpb.Struct {
 	return nil
 }
 
+func (x *FunctionRun) GetFunctionVersion() int32 {
+	if x != nil && x.FunctionVersion != nil {
+		return *x.FunctionVersion
+	}
+	return 0
+}
+
 type GetFunctionRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
@@ -3620,28 +3885,30 @@ func (x *GetFunctionsResponse) GetPage() *Page {
 	return nil
 }
 
-type CreateAccountRequest struct {
+type FunctionVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	SyncId        string                 `protobuf:"bytes,2,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Config        *structpb.Struct       `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
+func (x *FunctionVersion) Reset() {
+	*x = FunctionVersion{}
 	mi := &file_api_v2_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountRequest) String() string {
+func (x *FunctionVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
+func (*FunctionVersion) ProtoMessage() {}
 
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *FunctionVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3653,47 +3920,61 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FunctionVersion.ProtoReflect.Descriptor instead.
+func (*FunctionVersion) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateAccountRequest) GetEmail() string {
+func (x *FunctionVersion) GetVersion() int32 {
 	if x != nil {
-		return x.Email
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAccountRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *FunctionVersion) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
 	}
 	return ""
 }
 
-type CreateAccountResponse struct {
+func (x *FunctionVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FunctionVersion) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ListFunctionVersionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *CreateAccountData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountResponse) Reset() {
-	*x = CreateAccountResponse{}
+func (x *ListFunctionVersionsRequest) Reset() {
+	*x = ListFunctionVersionsRequest{}
 	mi := &file_api_v2_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountResponse) String() string {
+func (x *ListFunctionVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountResponse) ProtoMessage() {}
+func (*ListFunctionVersionsRequest) ProtoMessage() {}
 
-func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *ListFunctionVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3705,47 +3986,47 @@ func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
-func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFunctionVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFunctionVersionsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateAccountResponse) GetData() *CreateAccountData {
+func (x *ListFunctionVersionsRequest) GetAppId() string {
 	if x != nil {
-		return x.Data
+		return x.AppId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateAccountResponse) GetMetadata() *ResponseMetadata {
+func (x *ListFunctionVersionsRequest) GetFunctionId() string {
 	if x != nil {
-		return x.Metadata
+		return x.FunctionId
 	}
-	return nil
+	return ""
 }
 
-type CreateEnvRequest struct {
+type ListFunctionVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id            *string                `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Data          []*FunctionVersion     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEnvRequest) Reset() {
-	*x = CreateEnvRequest{}
+func (x *ListFunctionVersionsResponse) Reset() {
+	*x = ListFunctionVersionsResponse{}
 	mi := &file_api_v2_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEnvRequest) String() string {
+func (x *ListFunctionVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnvRequest) ProtoMessage() {}
+func (*ListFunctionVersionsResponse) ProtoMessage() {}
 
-func (x *CreateEnvRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFunctionVersionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3757,47 +4038,49 @@ func (x *CreateEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnvRequest.ProtoReflect.Descriptor instead.
-func (*CreateEnvRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFunctionVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFunctionVersionsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CreateEnvRequest) GetName() string {
+func (x *ListFunctionVersionsResponse) GetData() []*FunctionVersion {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateEnvRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *ListFunctionVersionsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-type CreateEnvResponse struct {
+type GetFunctionVersionDiffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Env                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	From          *int32                 `protobuf:"varint,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *int32                 `protobuf:"varint,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEnvResponse) Reset() {
-	*x = CreateEnvResponse{}
+func (x *GetFunctionVersionDiffRequest) Reset() {
+	*x = GetFunctionVersionDiffRequest{}
 	mi := &file_api_v2_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEnvResponse) String() string {
+func (x *GetFunctionVersionDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnvResponse) ProtoMessage() {}
+func (*GetFunctionVersionDiffRequest) ProtoMessage() {}
 
-func (x *CreateEnvResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFunctionVersionDiffRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3809,50 +4092,62 @@ func (x *CreateEnvResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnvResponse.ProtoReflect.Descriptor instead.
-func (*CreateEnvResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFunctionVersionDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetFunctionVersionDiffRequest) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateEnvResponse) GetData() *Env {
+func (x *GetFunctionVersionDiffRequest) GetAppId() string {
 	if x != nil {
-		return x.Data
+		return x.AppId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateEnvResponse) GetMetadata() *ResponseMetadata {
+func (x *GetFunctionVersionDiffRequest) GetFunctionId() string {
 	if x != nil {
-		return x.Metadata
+		return x.FunctionId
 	}
-	return nil
+	return ""
 }
 
-type Env struct {
+func (x *GetFunctionVersionDiffRequest) GetFrom() int32 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *GetFunctionVersionDiffRequest) GetTo() int32 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+type FunctionConfigChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          EnvType                `protobuf:"varint,3,opt,name=type,proto3,enum=api.v2.EnvType" json:"type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	IsArchived    bool                   `protobuf:"varint,5,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        *structpb.Value        `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Value        `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Env) Reset() {
-	*x = Env{}
+func (x *FunctionConfigChange) Reset() {
+	*x = FunctionConfigChange{}
 	mi := &file_api_v2_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Env) String() string {
+func (x *FunctionConfigChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Env) ProtoMessage() {}
+func (*FunctionConfigChange) ProtoMessage() {}
 
-func (x *Env) ProtoReflect() protoreflect.Message {
+func (x *FunctionConfigChange) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3864,72 +4159,55 @@ func (x *Env) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Env.ProtoReflect.Descriptor instead.
-func (*Env) Descriptor() ([]byte, []int) {
+// Deprecated: Use FunctionConfigChange.ProtoReflect.Descriptor instead.
+func (*FunctionConfigChange) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Env) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Env) GetName() string {
+func (x *FunctionConfigChange) GetField() string {
 	if x != nil {
-		return x.Name
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Env) GetType() EnvType {
-	if x != nil {
-		return x.Type
-	}
-	return EnvType_PRODUCTION
-}
-
-func (x *Env) GetCreatedAt() *timestamppb.Timestamp {
+func (x *FunctionConfigChange) GetBefore() *structpb.Value {
 	if x != nil {
-		return x.CreatedAt
+		return x.Before
 	}
 	return nil
 }
 
-func (x *Env) GetIsArchived() bool {
+func (x *FunctionConfigChange) GetAfter() *structpb.Value {
 	if x != nil {
-		return x.IsArchived
+		return x.After
 	}
-	return false
+	return nil
 }
 
-type CreateAccountData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ApiKey        string                 `protobuf:"bytes,4,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+type FunctionVersionDiff struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	From          int32                   `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                   `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Changes       []*FunctionConfigChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountData) Reset() {
-	*x = CreateAccountData{}
+func (x *FunctionVersionDiff) Reset() {
+	*x = FunctionVersionDiff{}
 	mi := &file_api_v2_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountData) String() string {
+func (x *FunctionVersionDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountData) ProtoMessage() {}
+func (*FunctionVersionDiff) ProtoMessage() {}
 
-func (x *CreateAccountData) ProtoReflect() protoreflect.Message {
+func (x *FunctionVersionDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3941,75 +4219,54 @@ func (x *CreateAccountData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountData.ProtoReflect.Descriptor instead.
-func (*CreateAccountData) Descriptor() ([]byte, []int) {
+// Deprecated: Use FunctionVersionDiff.ProtoReflect.Descriptor instead.
+func (*FunctionVersionDiff) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateAccountData) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateAccountData) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *CreateAccountData) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateAccountData) GetApiKey() string {
+func (x *FunctionVersionDiff) GetFrom() int32 {
 	if x != nil {
-		return x.ApiKey
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAccountData) GetCreatedAt() *timestamppb.Timestamp {
+func (x *FunctionVersionDiff) GetTo() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.To
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateAccountData) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *FunctionVersionDiff) GetChanges() []*FunctionConfigChange {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Changes
 	}
 	return nil
 }
 
-type FetchAccountsRequest struct {
+type GetFunctionVersionDiffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Data          *FunctionVersionDiff   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountsRequest) Reset() {
-	*x = FetchAccountsRequest{}
+func (x *GetFunctionVersionDiffResponse) Reset() {
+	*x = GetFunctionVersionDiffResponse{}
 	mi := &file_api_v2_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountsRequest) String() string {
+func (x *GetFunctionVersionDiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountsRequest) ProtoMessage() {}
+func (*GetFunctionVersionDiffResponse) ProtoMessage() {}
 
-func (x *FetchAccountsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFunctionVersionDiffResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4021,48 +4278,47 @@ func (x *FetchAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountsRequest.ProtoReflect.Descriptor instead.
-func (*FetchAccountsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFunctionVersionDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetFunctionVersionDiffResponse) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *FetchAccountsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *GetFunctionVersionDiffResponse) GetData() *FunctionVersionDiff {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *FetchAccountsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *GetFunctionVersionDiffResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type FetchAccountsResponse struct {
+type CreateAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Account             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountsResponse) Reset() {
-	*x = FetchAccountsResponse{}
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
 	mi := &file_api_v2_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountsResponse) String() string {
+func (x *CreateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountsResponse) ProtoMessage() {}
+func (*CreateAccountRequest) ProtoMessage() {}
 
-func (x *FetchAccountsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4074,54 +4330,47 @@ func (x *FetchAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountsResponse.ProtoReflect.Descriptor instead.
-func (*FetchAccountsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *FetchAccountsResponse) GetData() []*Account {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *FetchAccountsResponse) GetMetadata() *ResponseMetadata {
+func (x *CreateAccountRequest) GetEmail() string {
 	if x != nil {
-		return x.Metadata
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *FetchAccountsResponse) GetPage() *Page {
-	if x != nil {
-		return x.Page
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
+	return ""
 }
 
-type FetchAccountResponse struct {
+type CreateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Account               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *CreateAccountData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountResponse) Reset() {
-	*x = FetchAccountResponse{}
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
 	mi := &file_api_v2_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountResponse) String() string {
+func (x *CreateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountResponse) ProtoMessage() {}
+func (*CreateAccountResponse) ProtoMessage() {}
 
-func (x *FetchAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4133,50 +4382,47 @@ func (x *FetchAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountResponse.ProtoReflect.Descriptor instead.
-func (*FetchAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *FetchAccountResponse) GetData() *Account {
+func (x *CreateAccountResponse) GetData() *CreateAccountData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *FetchAccountResponse) GetMetadata() *ResponseMetadata {
+func (x *CreateAccountResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type Account struct {
+type CreateEnvRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            *string                `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *CreateEnvRequest) Reset() {
+	*x = CreateEnvRequest{}
 	mi := &file_api_v2_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) String() string {
+func (x *CreateEnvRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*CreateEnvRequest) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
+func (x *CreateEnvRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4188,69 +4434,47 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEnvRequest.ProtoReflect.Descriptor instead.
+func (*CreateEnvRequest) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Account) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Account) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *Account) GetName() string {
+func (x *CreateEnvRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
+func (x *CreateEnvRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return nil
+	return ""
 }
 
-type Page struct {
+type CreateEnvResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=hasMore,proto3" json:"hasMore,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Data          *Env                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Page) Reset() {
-	*x = Page{}
+func (x *CreateEnvResponse) Reset() {
+	*x = CreateEnvResponse{}
 	mi := &file_api_v2_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Page) String() string {
+func (x *CreateEnvResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Page) ProtoMessage() {}
+func (*CreateEnvResponse) ProtoMessage() {}
 
-func (x *Page) ProtoReflect() protoreflect.Message {
+func (x *CreateEnvResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4262,54 +4486,50 @@ func (x *Page) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Page.ProtoReflect.Descriptor instead.
-func (*Page) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEnvResponse.ProtoReflect.Descriptor instead.
+func (*CreateEnvResponse) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Page) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
-	}
-	return ""
-}
-
-func (x *Page) GetHasMore() bool {
+func (x *CreateEnvResponse) GetData() *Env {
 	if x != nil {
-		return x.HasMore
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-func (x *Page) GetLimit() int32 {
+func (x *CreateEnvResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Limit
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type FetchAccountEventKeysRequest struct {
+type Env struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          EnvType                `protobuf:"varint,3,opt,name=type,proto3,enum=api.v2.EnvType" json:"type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	IsArchived    bool                   `protobuf:"varint,5,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountEventKeysRequest) Reset() {
-	*x = FetchAccountEventKeysRequest{}
+func (x *Env) Reset() {
+	*x = Env{}
 	mi := &file_api_v2_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountEventKeysRequest) String() string {
+func (x *Env) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountEventKeysRequest) ProtoMessage() {}
+func (*Env) ProtoMessage() {}
 
-func (x *FetchAccountEventKeysRequest) ProtoReflect() protoreflect.Message {
+func (x *Env) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4321,111 +4541,73 @@ func (x *FetchAccountEventKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountEventKeysRequest.ProtoReflect.Descriptor instead.
-func (*FetchAccountEventKeysRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Env.ProtoReflect.Descriptor instead.
+func (*Env) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *FetchAccountEventKeysRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *Env) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FetchAccountEventKeysRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
-}
-
-type FetchAccountEventKeysResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*EventKey            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FetchAccountEventKeysResponse) Reset() {
-	*x = FetchAccountEventKeysResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[61]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FetchAccountEventKeysResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FetchAccountEventKeysResponse) ProtoMessage() {}
-
-func (x *FetchAccountEventKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[61]
+func (x *Env) GetName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Name
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FetchAccountEventKeysResponse.ProtoReflect.Descriptor instead.
-func (*FetchAccountEventKeysResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{61}
+	return ""
 }
 
-func (x *FetchAccountEventKeysResponse) GetData() []*EventKey {
+func (x *Env) GetType() EnvType {
 	if x != nil {
-		return x.Data
+		return x.Type
 	}
-	return nil
+	return EnvType_PRODUCTION
 }
 
-func (x *FetchAccountEventKeysResponse) GetMetadata() *ResponseMetadata {
+func (x *Env) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *FetchAccountEventKeysResponse) GetPage() *Page {
+func (x *Env) GetIsArchived() bool {
 	if x != nil {
-		return x.Page
+		return x.IsArchived
 	}
-	return nil
+	return false
 }
 
-type EventKey struct {
+type CreateAccountData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Environment   string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
-	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ApiKey        string                 `protobuf:"bytes,4,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventKey) Reset() {
-	*x = EventKey{}
-	mi := &file_api_v2_service_proto_msgTypes[62]
+func (x *CreateAccountData) Reset() {
+	*x = CreateAccountData{}
+	mi := &file_api_v2_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventKey) String() string {
+func (x *CreateAccountData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventKey) ProtoMessage() {}
+func (*CreateAccountData) ProtoMessage() {}
 
-func (x *EventKey) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[62]
+func (x *CreateAccountData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4436,47 +4618,54 @@ func (x *EventKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventKey.ProtoReflect.Descriptor instead.
-func (*EventKey) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CreateAccountData.ProtoReflect.Descriptor instead.
+func (*CreateAccountData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *EventKey) GetId() string {
+func (x *CreateAccountData) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *EventKey) GetName() string {
+func (x *CreateAccountData) GetEmail() string {
 	if x != nil {
-		return x.Name
+		return x.Email
 	}
 	return ""
 }
 
-func (x *EventKey) GetEnvironment() string {
+func (x *CreateAccountData) GetName() string {
 	if x != nil {
-		return x.Environment
+		return x.Name
 	}
 	return ""
 }
 
-func (x *EventKey) GetKey() string {
+func (x *CreateAccountData) GetApiKey() string {
 	if x != nil {
-		return x.Key
+		return x.ApiKey
 	}
 	return ""
 }
 
-func (x *EventKey) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateAccountData) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type FetchAccountEnvsRequest struct {
+func (x *CreateAccountData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FetchAccountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
@@ -4484,21 +4673,21 @@ type FetchAccountEnvsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountEnvsRequest) Reset() {
-	*x = FetchAccountEnvsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[63]
+func (x *FetchAccountsRequest) Reset() {
+	*x = FetchAccountsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountEnvsRequest) String() string {
+func (x *FetchAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountEnvsRequest) ProtoMessage() {}
+func (*FetchAccountsRequest) ProtoMessage() {}
 
-func (x *FetchAccountEnvsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[63]
+func (x *FetchAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4509,49 +4698,49 @@ func (x *FetchAccountEnvsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountEnvsRequest.ProtoReflect.Descriptor instead.
-func (*FetchAccountEnvsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use FetchAccountsRequest.ProtoReflect.Descriptor instead.
+func (*FetchAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *FetchAccountEnvsRequest) GetCursor() string {
+func (x *FetchAccountsRequest) GetCursor() string {
 	if x != nil && x.Cursor != nil {
 		return *x.Cursor
 	}
 	return ""
 }
 
-func (x *FetchAccountEnvsRequest) GetLimit() int32 {
+func (x *FetchAccountsRequest) GetLimit() int32 {
 	if x != nil && x.Limit != nil {
 		return *x.Limit
 	}
 	return 0
 }
 
-type FetchAccountEnvsResponse struct {
+type FetchAccountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Env                 `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*Account             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountEnvsResponse) Reset() {
-	*x = FetchAccountEnvsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[64]
+func (x *FetchAccountsResponse) Reset() {
+	*x = FetchAccountsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountEnvsResponse) String() string {
+func (x *FetchAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountEnvsResponse) ProtoMessage() {}
+func (*FetchAccountsResponse) ProtoMessage() {}
 
-func (x *FetchAccountEnvsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[64]
+func (x *FetchAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4562,55 +4751,55 @@ func (x *FetchAccountEnvsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountEnvsResponse.ProtoReflect.Descriptor instead.
-func (*FetchAccountEnvsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use FetchAccountsResponse.ProtoReflect.Descriptor instead.
+func (*FetchAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *FetchAccountEnvsResponse) GetData() []*Env {
+func (x *FetchAccountsResponse) GetData() []*Account {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *FetchAccountEnvsResponse) GetMetadata() *ResponseMetadata {
+func (x *FetchAccountsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *FetchAccountEnvsResponse) GetPage() *Page {
+func (x *FetchAccountsResponse) GetPage() *Page {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type FetchAccountSigningKeysRequest struct {
+type FetchAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Data          *Account               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountSigningKeysRequest) Reset() {
-	*x = FetchAccountSigningKeysRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[65]
+func (x *FetchAccountResponse) Reset() {
+	*x = FetchAccountResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountSigningKeysRequest) String() string {
+func (x *FetchAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountSigningKeysRequest) ProtoMessage() {}
+func (*FetchAccountResponse) ProtoMessage() {}
 
-func (x *FetchAccountSigningKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[65]
+func (x *FetchAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4621,48 +4810,124 @@ func (x *FetchAccountSigningKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountSigningKeysRequest.ProtoReflect.Descriptor instead.
-func (*FetchAccountSigningKeysRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use FetchAccountResponse.ProtoReflect.Descriptor instead.
+func (*FetchAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *FetchAccountSigningKeysRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *FetchAccountResponse) GetData() *Account {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *FetchAccountSigningKeysRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *FetchAccountResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type FetchAccountSigningKeysResponse struct {
+type Account struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SigningKey          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchAccountSigningKeysResponse) Reset() {
-	*x = FetchAccountSigningKeysResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[66]
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_api_v2_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchAccountSigningKeysResponse) String() string {
+func (x *Account) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAccountSigningKeysResponse) ProtoMessage() {}
+func (*Account) ProtoMessage() {}
 
-func (x *FetchAccountSigningKeysResponse) ProtoReflect() protoreflect.Message {
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Page struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=hasMore,proto3" json:"hasMore,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	mi := &file_api_v2_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v2_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4674,33 +4939,145 @@ func (x *FetchAccountSigningKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAccountSigningKeysResponse.ProtoReflect.Descriptor instead.
-func (*FetchAccountSigningKeysResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
 	return file_api_v2_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *FetchAccountSigningKeysResponse) GetData() []*SigningKey {
+func (x *Page) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *Page) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *Page) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FetchAccountEventKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAccountEventKeysRequest) Reset() {
+	*x = FetchAccountEventKeysRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAccountEventKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAccountEventKeysRequest) ProtoMessage() {}
+
+func (x *FetchAccountEventKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAccountEventKeysRequest.ProtoReflect.Descriptor instead.
+func (*FetchAccountEventKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FetchAccountEventKeysRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *FetchAccountEventKeysRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type FetchAccountEventKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*EventKey            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAccountEventKeysResponse) Reset() {
+	*x = FetchAccountEventKeysResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAccountEventKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAccountEventKeysResponse) ProtoMessage() {}
+
+func (x *FetchAccountEventKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAccountEventKeysResponse.ProtoReflect.Descriptor instead.
+func (*FetchAccountEventKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FetchAccountEventKeysResponse) GetData() []*EventKey {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *FetchAccountSigningKeysResponse) GetMetadata() *ResponseMetadata {
+func (x *FetchAccountEventKeysResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *FetchAccountSigningKeysResponse) GetPage() *Page {
+func (x *FetchAccountEventKeysResponse) GetPage() *Page {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type SigningKey struct {
+type EventKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -4711,21 +5088,4451 @@ type SigningKey struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SigningKey) Reset() {
-	*x = SigningKey{}
-	mi := &file_api_v2_service_proto_msgTypes[67]
+func (x *EventKey) Reset() {
+	*x = EventKey{}
+	mi := &file_api_v2_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventKey) ProtoMessage() {}
+
+func (x *EventKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventKey.ProtoReflect.Descriptor instead.
+func (*EventKey) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *EventKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventKey) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *EventKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EventKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FetchAccountEnvsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAccountEnvsRequest) Reset() {
+	*x = FetchAccountEnvsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAccountEnvsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAccountEnvsRequest) ProtoMessage() {}
+
+func (x *FetchAccountEnvsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAccountEnvsRequest.ProtoReflect.Descriptor instead.
+func (*FetchAccountEnvsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FetchAccountEnvsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *FetchAccountEnvsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type FetchAccountEnvsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Env                 `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAccountEnvsResponse) Reset() {
+	*x = FetchAccountEnvsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAccountEnvsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAccountEnvsResponse) ProtoMessage() {}
+
+func (x *FetchAccountEnvsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAccountEnvsResponse.ProtoReflect.Descriptor instead.
+func (*FetchAccountEnvsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FetchAccountEnvsResponse) GetData() []*Env {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FetchAccountEnvsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *FetchAccountEnvsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type FetchAccountSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAccountSigningKeysRequest) Reset() {
+	*x = FetchAccountSigningKeysRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAccountSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAccountSigningKeysRequest) ProtoMessage() {}
+
+func (x *FetchAccountSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAccountSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*FetchAccountSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FetchAccountSigningKeysRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *FetchAccountSigningKeysRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type FetchAccountSigningKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*SigningKey          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAccountSigningKeysResponse) Reset() {
+	*x = FetchAccountSigningKeysResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAccountSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAccountSigningKeysResponse) ProtoMessage() {}
+
+func (x *FetchAccountSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAccountSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*FetchAccountSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FetchAccountSigningKeysResponse) GetData() []*SigningKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FetchAccountSigningKeysResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *FetchAccountSigningKeysResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SigningKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Environment   string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	mi := &file_api_v2_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SigningKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SigningKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SigningKey) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *SigningKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SigningKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Transform     string                 `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
+	Response      *string                `protobuf:"bytes,3,opt,name=response,proto3,oneof" json:"response,omitempty"`
+	EventFilter   *EventFilter           `protobuf:"bytes,4,opt,name=event_filter,json=eventFilter,proto3,oneof" json:"event_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetResponse() string {
+	if x != nil && x.Response != nil {
+		return *x.Response
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventFilter() *EventFilter {
+	if x != nil {
+		return x.EventFilter
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Webhook               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateWebhookResponse) GetData() *Webhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type EventFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []string               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Filter        FilterType             `protobuf:"varint,2,opt,name=filter,proto3,enum=api.v2.FilterType" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
+	mi := &file_api_v2_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilter) ProtoMessage() {}
+
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *EventFilter) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *EventFilter) GetFilter() FilterType {
+	if x != nil {
+		return x.Filter
+	}
+	return FilterType_ALLOW
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListWebhooksRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Webhook             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListWebhooksResponse) GetData() []*Webhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Transform     string                 `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
+	Response      *string                `protobuf:"bytes,5,opt,name=response,proto3,oneof" json:"response,omitempty"`
+	EventFilter   *EventFilter           `protobuf:"bytes,6,opt,name=event_filter,json=eventFilter,proto3,oneof" json:"event_filter,omitempty"`
+	Environment   string                 `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_api_v2_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *Webhook) GetResponse() string {
+	if x != nil && x.Response != nil {
+		return *x.Response
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventFilter() *EventFilter {
+	if x != nil {
+		return x.EventFilter
+	}
+	return nil
+}
+
+func (x *Webhook) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PatchEnvRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsArchived    *bool                  `protobuf:"varint,2,opt,name=isArchived,proto3,oneof" json:"isArchived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchEnvRequest) Reset() {
+	*x = PatchEnvRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchEnvRequest) ProtoMessage() {}
+
+func (x *PatchEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchEnvRequest.ProtoReflect.Descriptor instead.
+func (*PatchEnvRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PatchEnvRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchEnvRequest) GetIsArchived() bool {
+	if x != nil && x.IsArchived != nil {
+		return *x.IsArchived
+	}
+	return false
+}
+
+type PatchEnvsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Env                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchEnvsResponse) Reset() {
+	*x = PatchEnvsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchEnvsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchEnvsResponse) ProtoMessage() {}
+
+func (x *PatchEnvsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchEnvsResponse.ProtoReflect.Descriptor instead.
+func (*PatchEnvsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PatchEnvsResponse) GetData() *Env {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PatchEnvsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SendEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	User          *structpb.Struct       `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	Id            *string                `protobuf:"bytes,4,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Ts            *int64                 `protobuf:"varint,5,opt,name=ts,proto3,oneof" json:"ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEventRequest) Reset() {
+	*x = SendEventRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventRequest) ProtoMessage() {}
+
+func (x *SendEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventRequest.ProtoReflect.Descriptor instead.
+func (*SendEventRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SendEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SendEventRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendEventRequest) GetUser() *structpb.Struct {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SendEventRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SendEventRequest) GetTs() int64 {
+	if x != nil && x.Ts != nil {
+		return *x.Ts
+	}
+	return 0
+}
+
+type SendEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *SendEventData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEventResponse) Reset() {
+	*x = SendEventResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventResponse) ProtoMessage() {}
+
+func (x *SendEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventResponse.ProtoReflect.Descriptor instead.
+func (*SendEventResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SendEventResponse) GetData() *SendEventData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendEventResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SendEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEventData) Reset() {
+	*x = SendEventData{}
+	mi := &file_api_v2_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventData) ProtoMessage() {}
+
+func (x *SendEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventData.ProtoReflect.Descriptor instead.
+func (*SendEventData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SendEventData) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type InvokeFunctionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FunctionId     string                 `protobuf:"bytes,1,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	Data           *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	IdempotencyKey *string                `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	AppId          string                 `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ScheduledAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
+	Delay          *string                `protobuf:"bytes,6,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvokeFunctionRequest) Reset() {
+	*x = InvokeFunctionRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeFunctionRequest) ProtoMessage() {}
+
+func (x *InvokeFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeFunctionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *InvokeFunctionRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *InvokeFunctionRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InvokeFunctionRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *InvokeFunctionRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *InvokeFunctionRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *InvokeFunctionRequest) GetDelay() string {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return ""
+}
+
+type InvokeFunctionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *InvokeFunctionData    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeFunctionResponse) Reset() {
+	*x = InvokeFunctionResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeFunctionResponse) ProtoMessage() {}
+
+func (x *InvokeFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeFunctionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *InvokeFunctionResponse) GetData() *InvokeFunctionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InvokeFunctionResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type InvokeFunctionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	Result        *string                `protobuf:"bytes,5,opt,name=result,proto3,oneof" json:"result,omitempty"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	ScheduleId    *string                `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3,oneof" json:"schedule_id,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeFunctionData) Reset() {
+	*x = InvokeFunctionData{}
+	mi := &file_api_v2_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeFunctionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeFunctionData) ProtoMessage() {}
+
+func (x *InvokeFunctionData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeFunctionData.ProtoReflect.Descriptor instead.
+func (*InvokeFunctionData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *InvokeFunctionData) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *InvokeFunctionData) GetQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return nil
+}
+
+func (x *InvokeFunctionData) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *InvokeFunctionData) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *InvokeFunctionData) GetResult() string {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return ""
+}
+
+func (x *InvokeFunctionData) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *InvokeFunctionData) GetScheduleId() string {
+	if x != nil && x.ScheduleId != nil {
+		return *x.ScheduleId
+	}
+	return ""
+}
+
+func (x *InvokeFunctionData) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type ListScheduledInvocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledInvocationsRequest) Reset() {
+	*x = ListScheduledInvocationsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledInvocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledInvocationsRequest) ProtoMessage() {}
+
+func (x *ListScheduledInvocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledInvocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledInvocationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListScheduledInvocationsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListScheduledInvocationsRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *ListScheduledInvocationsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListScheduledInvocationsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListScheduledInvocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ScheduledInvocation `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledInvocationsResponse) Reset() {
+	*x = ListScheduledInvocationsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledInvocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledInvocationsResponse) ProtoMessage() {}
+
+func (x *ListScheduledInvocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledInvocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledInvocationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListScheduledInvocationsResponse) GetData() []*ScheduledInvocation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListScheduledInvocationsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListScheduledInvocationsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CancelScheduledInvocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledInvocationRequest) Reset() {
+	*x = CancelScheduledInvocationRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledInvocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledInvocationRequest) ProtoMessage() {}
+
+func (x *CancelScheduledInvocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledInvocationRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledInvocationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CancelScheduledInvocationRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *CancelScheduledInvocationRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *CancelScheduledInvocationRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type CancelScheduledInvocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ScheduledInvocation   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledInvocationResponse) Reset() {
+	*x = CancelScheduledInvocationResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledInvocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledInvocationResponse) ProtoMessage() {}
+
+func (x *CancelScheduledInvocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledInvocationResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledInvocationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CancelScheduledInvocationResponse) GetData() *ScheduledInvocation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CancelScheduledInvocationResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ScheduledInvocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledInvocation) Reset() {
+	*x = ScheduledInvocation{}
+	mi := &file_api_v2_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledInvocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledInvocation) ProtoMessage() {}
+
+func (x *ScheduledInvocation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledInvocation.ProtoReflect.Descriptor instead.
+func (*ScheduledInvocation) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ScheduledInvocation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledInvocation) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ScheduledInvocation) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduledInvocation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Scores        []*CreateScoreInput    `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScoreRequest) Reset() {
+	*x = CreateScoreRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScoreRequest) ProtoMessage() {}
+
+func (x *CreateScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScoreRequest.ProtoReflect.Descriptor instead.
+func (*CreateScoreRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateScoreRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *CreateScoreRequest) GetScores() []*CreateScoreInput {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type CreateScoreInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	StepId        *string                `protobuf:"bytes,3,opt,name=step_id,json=stepId,proto3,oneof" json:"step_id,omitempty"`
+	Experiment    *ScoreExperiment       `protobuf:"bytes,4,opt,name=experiment,proto3,oneof" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScoreInput) Reset() {
+	*x = CreateScoreInput{}
+	mi := &file_api_v2_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScoreInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScoreInput) ProtoMessage() {}
+
+func (x *CreateScoreInput) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScoreInput.ProtoReflect.Descriptor instead.
+func (*CreateScoreInput) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateScoreInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScoreInput) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CreateScoreInput) GetStepId() string {
+	if x != nil && x.StepId != nil {
+		return *x.StepId
+	}
+	return ""
+}
+
+func (x *CreateScoreInput) GetExperiment() *ScoreExperiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type ScoreExperiment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Variant       string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreExperiment) Reset() {
+	*x = ScoreExperiment{}
+	mi := &file_api_v2_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreExperiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreExperiment) ProtoMessage() {}
+
+func (x *ScoreExperiment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreExperiment.ProtoReflect.Descriptor instead.
+func (*ScoreExperiment) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ScoreExperiment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScoreExperiment) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type CreateScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Score               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScoreResponse) Reset() {
+	*x = CreateScoreResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScoreResponse) ProtoMessage() {}
+
+func (x *CreateScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScoreResponse.ProtoReflect.Descriptor instead.
+func (*CreateScoreResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateScoreResponse) GetData() []*Score {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateScoreResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type Score struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	StepId        *string                `protobuf:"bytes,4,opt,name=step_id,json=stepId,proto3,oneof" json:"step_id,omitempty"`
+	Experiment    *ScoreExperiment       `protobuf:"bytes,5,opt,name=experiment,proto3,oneof" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_api_v2_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Score) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Score) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Score) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Score) GetStepId() string {
+	if x != nil && x.StepId != nil {
+		return *x.StepId
+	}
+	return ""
+}
+
+func (x *Score) GetExperiment() *ScoreExperiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type SyncAppRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAppRequest) Reset() {
+	*x = SyncAppRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAppRequest) ProtoMessage() {}
+
+func (x *SyncAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAppRequest.ProtoReflect.Descriptor instead.
+func (*SyncAppRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SyncAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SyncAppRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SyncAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *SyncAppData           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAppResponse) Reset() {
+	*x = SyncAppResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAppResponse) ProtoMessage() {}
+
+func (x *SyncAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAppResponse.ProtoReflect.Descriptor instead.
+func (*SyncAppResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SyncAppResponse) GetData() *SyncAppData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SyncAppResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SyncAppData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Error         *SyncAppError          `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAppData) Reset() {
+	*x = SyncAppData{}
+	mi := &file_api_v2_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAppData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAppData) ProtoMessage() {}
+
+func (x *SyncAppData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAppData.ProtoReflect.Descriptor instead.
+func (*SyncAppData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SyncAppData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyncAppData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SyncAppData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SyncAppData) GetError() *SyncAppError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type SyncAppError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAppError) Reset() {
+	*x = SyncAppError{}
+	mi := &file_api_v2_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAppError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAppError) ProtoMessage() {}
+
+func (x *SyncAppError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAppError.ProtoReflect.Descriptor instead.
+func (*SyncAppError) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SyncAppError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SyncAppError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type QueryInsightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryInsightsRequest) Reset() {
+	*x = QueryInsightsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInsightsRequest) ProtoMessage() {}
+
+func (x *QueryInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryInsightsRequest.ProtoReflect.Descriptor instead.
+func (*QueryInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *QueryInsightsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type QueryInsightsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *QueryInsightsData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryInsightsResponse) Reset() {
+	*x = QueryInsightsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryInsightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInsightsResponse) ProtoMessage() {}
+
+func (x *QueryInsightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryInsightsResponse.ProtoReflect.Descriptor instead.
+func (*QueryInsightsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *QueryInsightsResponse) GetData() *QueryInsightsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QueryInsightsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type QueryInsightsData struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Columns       []*InsightsOutputColumn `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          []*InsightsRow          `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Diagnostics   []*InsightsDiagnostic   `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryInsightsData) Reset() {
+	*x = QueryInsightsData{}
+	mi := &file_api_v2_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryInsightsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInsightsData) ProtoMessage() {}
+
+func (x *QueryInsightsData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryInsightsData.ProtoReflect.Descriptor instead.
+func (*QueryInsightsData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *QueryInsightsData) GetColumns() []*InsightsOutputColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *QueryInsightsData) GetRows() []*InsightsRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *QueryInsightsData) GetDiagnostics() []*InsightsDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type InsightsOutputColumn struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          InsightsOutputColumnType `protobuf:"varint,2,opt,name=type,proto3,enum=api.v2.InsightsOutputColumnType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsOutputColumn) Reset() {
+	*x = InsightsOutputColumn{}
+	mi := &file_api_v2_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsOutputColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsOutputColumn) ProtoMessage() {}
+
+func (x *InsightsOutputColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsOutputColumn.ProtoReflect.Descriptor instead.
+func (*InsightsOutputColumn) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *InsightsOutputColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsightsOutputColumn) GetType() InsightsOutputColumnType {
+	if x != nil {
+		return x.Type
+	}
+	return InsightsOutputColumnType_VALUE_TYPE_UNSPECIFIED
+}
+
+type InsightsRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*structpb.Value      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsRow) Reset() {
+	*x = InsightsRow{}
+	mi := &file_api_v2_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsRow) ProtoMessage() {}
+
+func (x *InsightsRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsRow.ProtoReflect.Descriptor instead.
+func (*InsightsRow) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *InsightsRow) GetValues() []*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type InsightsDiagnostic struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Severity      InsightsDiagnosticSeverity  `protobuf:"varint,1,opt,name=severity,proto3,enum=api.v2.InsightsDiagnosticSeverity" json:"severity,omitempty"`
+	Code          string                      `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Position      *InsightsDiagnosticPosition `protobuf:"bytes,4,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsDiagnostic) Reset() {
+	*x = InsightsDiagnostic{}
+	mi := &file_api_v2_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsDiagnostic) ProtoMessage() {}
+
+func (x *InsightsDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsDiagnostic.ProtoReflect.Descriptor instead.
+func (*InsightsDiagnostic) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *InsightsDiagnostic) GetSeverity() InsightsDiagnosticSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return InsightsDiagnosticSeverity_SEVERITY_UNSPECIFIED
+}
+
+func (x *InsightsDiagnostic) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InsightsDiagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InsightsDiagnostic) GetPosition() *InsightsDiagnosticPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type InsightsDiagnosticPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Context       string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsDiagnosticPosition) Reset() {
+	*x = InsightsDiagnosticPosition{}
+	mi := &file_api_v2_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsDiagnosticPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsDiagnosticPosition) ProtoMessage() {}
+
+func (x *InsightsDiagnosticPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsDiagnosticPosition.ProtoReflect.Descriptor instead.
+func (*InsightsDiagnosticPosition) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *InsightsDiagnosticPosition) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *InsightsDiagnosticPosition) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *InsightsDiagnosticPosition) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+type ListInsightsTablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInsightsTablesRequest) Reset() {
+	*x = ListInsightsTablesRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInsightsTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInsightsTablesRequest) ProtoMessage() {}
+
+func (x *ListInsightsTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInsightsTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListInsightsTablesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{110}
+}
+
+type ListInsightsTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*InsightsTable       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInsightsTablesResponse) Reset() {
+	*x = ListInsightsTablesResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInsightsTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInsightsTablesResponse) ProtoMessage() {}
+
+func (x *ListInsightsTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInsightsTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListInsightsTablesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListInsightsTablesResponse) GetData() []*InsightsTable {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListInsightsTablesResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type InsightsTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Columns       []*InsightsTableColumn `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsTable) Reset() {
+	*x = InsightsTable{}
+	mi := &file_api_v2_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsTable) ProtoMessage() {}
+
+func (x *InsightsTable) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsTable.ProtoReflect.Descriptor instead.
+func (*InsightsTable) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *InsightsTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsightsTable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InsightsTable) GetColumns() []*InsightsTableColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type InsightsTableColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsTableColumn) Reset() {
+	*x = InsightsTableColumn{}
+	mi := &file_api_v2_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsTableColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsTableColumn) ProtoMessage() {}
+
+func (x *InsightsTableColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsTableColumn.ProtoReflect.Descriptor instead.
+func (*InsightsTableColumn) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *InsightsTableColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsightsTableColumn) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InsightsTableColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type QueryInsightsPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryInsightsPromptRequest) Reset() {
+	*x = QueryInsightsPromptRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryInsightsPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInsightsPromptRequest) ProtoMessage() {}
+
+func (x *QueryInsightsPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryInsightsPromptRequest.ProtoReflect.Descriptor instead.
+func (*QueryInsightsPromptRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *QueryInsightsPromptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+type QueryInsightsPromptResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Data          *QueryInsightsPromptData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryInsightsPromptResponse) Reset() {
+	*x = QueryInsightsPromptResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryInsightsPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInsightsPromptResponse) ProtoMessage() {}
+
+func (x *QueryInsightsPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryInsightsPromptResponse.ProtoReflect.Descriptor instead.
+func (*QueryInsightsPromptResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *QueryInsightsPromptResponse) GetData() *QueryInsightsPromptData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QueryInsightsPromptResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type QueryInsightsPromptData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sql           string                 `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryInsightsPromptData) Reset() {
+	*x = QueryInsightsPromptData{}
+	mi := &file_api_v2_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryInsightsPromptData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInsightsPromptData) ProtoMessage() {}
+
+func (x *QueryInsightsPromptData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryInsightsPromptData.ProtoReflect.Descriptor instead.
+func (*QueryInsightsPromptData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *QueryInsightsPromptData) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *QueryInsightsPromptData) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type ListInsightsEventSchemasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInsightsEventSchemasRequest) Reset() {
+	*x = ListInsightsEventSchemasRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInsightsEventSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInsightsEventSchemasRequest) ProtoMessage() {}
+
+func (x *ListInsightsEventSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInsightsEventSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListInsightsEventSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListInsightsEventSchemasRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListInsightsEventSchemasRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListInsightsEventSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*InsightsEventSchema `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInsightsEventSchemasResponse) Reset() {
+	*x = ListInsightsEventSchemasResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInsightsEventSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInsightsEventSchemasResponse) ProtoMessage() {}
+
+func (x *ListInsightsEventSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInsightsEventSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListInsightsEventSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListInsightsEventSchemasResponse) GetData() []*InsightsEventSchema {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListInsightsEventSchemasResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListInsightsEventSchemasResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type InsightsEventSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Schema        *structpb.Struct       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsEventSchema) Reset() {
+	*x = InsightsEventSchema{}
+	mi := &file_api_v2_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsEventSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsEventSchema) ProtoMessage() {}
+
+func (x *InsightsEventSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsEventSchema.ProtoReflect.Descriptor instead.
+func (*InsightsEventSchema) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *InsightsEventSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsightsEventSchema) GetSchema() *structpb.Struct {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type ListExperimentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,4,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperimentsRequest) Reset() {
+	*x = ListExperimentsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperimentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperimentsRequest) ProtoMessage() {}
+
+func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperimentsRequest.ProtoReflect.Descriptor instead.
+func (*ListExperimentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListExperimentsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListExperimentsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListExperimentsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListExperimentsRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *ListExperimentsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListExperimentsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListExperimentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Experiment          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperimentsResponse) Reset() {
+	*x = ListExperimentsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperimentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperimentsResponse) ProtoMessage() {}
+
+func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperimentsResponse.ProtoReflect.Descriptor instead.
+func (*ListExperimentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListExperimentsResponse) GetData() []*Experiment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListExperimentsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListExperimentsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type Experiment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Function          *FunctionRef           `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	SelectionStrategy string                 `protobuf:"bytes,3,opt,name=selection_strategy,json=selectionStrategy,proto3" json:"selection_strategy,omitempty"`
+	Variants          []string               `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	VariantCount      int32                  `protobuf:"varint,5,opt,name=variant_count,json=variantCount,proto3" json:"variant_count,omitempty"`
+	TotalRuns         int32                  `protobuf:"varint,6,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	FirstSeen         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Experiment) Reset() {
+	*x = Experiment{}
+	mi := &file_api_v2_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experiment) ProtoMessage() {}
+
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *Experiment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Experiment) GetFunction() *FunctionRef {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *Experiment) GetSelectionStrategy() string {
+	if x != nil {
+		return x.SelectionStrategy
+	}
+	return ""
+}
+
+func (x *Experiment) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Experiment) GetVariantCount() int32 {
+	if x != nil {
+		return x.VariantCount
+	}
+	return 0
+}
+
+func (x *Experiment) GetTotalRuns() int32 {
+	if x != nil {
+		return x.TotalRuns
+	}
+	return 0
+}
+
+func (x *Experiment) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *Experiment) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+type GetExperimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FunctionId    string                 `protobuf:"bytes,1,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	ExperimentId  string                 `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Variant       *string                `protobuf:"bytes,3,opt,name=variant,proto3,oneof" json:"variant,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperimentRequest) Reset() {
+	*x = GetExperimentRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperimentRequest) ProtoMessage() {}
+
+func (x *GetExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperimentRequest.ProtoReflect.Descriptor instead.
+func (*GetExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetExperimentRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *GetExperimentRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *GetExperimentRequest) GetVariant() string {
+	if x != nil && x.Variant != nil {
+		return *x.Variant
+	}
+	return ""
+}
+
+func (x *GetExperimentRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetExperimentRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetExperimentRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GetExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExperimentDetail      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperimentResponse) Reset() {
+	*x = GetExperimentResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperimentResponse) ProtoMessage() {}
+
+func (x *GetExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperimentResponse.ProtoReflect.Descriptor instead.
+func (*GetExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetExperimentResponse) GetData() *ExperimentDetail {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetExperimentResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ExperimentDetail struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	Id                string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Variants          []*ExperimentVariantMetrics `protobuf:"bytes,2,rep,name=variants,proto3" json:"variants,omitempty"`
+	VariantWeights    []*ExperimentVariantWeight  `protobuf:"bytes,3,rep,name=variant_weights,json=variantWeights,proto3" json:"variant_weights,omitempty"`
+	FirstSeen         *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen          *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	SelectionStrategy string                      `protobuf:"bytes,6,opt,name=selection_strategy,json=selectionStrategy,proto3" json:"selection_strategy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExperimentDetail) Reset() {
+	*x = ExperimentDetail{}
+	mi := &file_api_v2_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentDetail) ProtoMessage() {}
+
+func (x *ExperimentDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentDetail.ProtoReflect.Descriptor instead.
+func (*ExperimentDetail) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ExperimentDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExperimentDetail) GetVariants() []*ExperimentVariantMetrics {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ExperimentDetail) GetVariantWeights() []*ExperimentVariantWeight {
+	if x != nil {
+		return x.VariantWeights
+	}
+	return nil
+}
+
+func (x *ExperimentDetail) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *ExperimentDetail) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *ExperimentDetail) GetSelectionStrategy() string {
+	if x != nil {
+		return x.SelectionStrategy
+	}
+	return ""
+}
+
+type ExperimentVariantMetrics struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	VariantName   string                     `protobuf:"bytes,1,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
+	RunCount      int32                      `protobuf:"varint,2,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	Metrics       []*ExperimentVariantMetric `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentVariantMetrics) Reset() {
+	*x = ExperimentVariantMetrics{}
+	mi := &file_api_v2_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentVariantMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentVariantMetrics) ProtoMessage() {}
+
+func (x *ExperimentVariantMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentVariantMetrics.ProtoReflect.Descriptor instead.
+func (*ExperimentVariantMetrics) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ExperimentVariantMetrics) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
+func (x *ExperimentVariantMetrics) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *ExperimentVariantMetrics) GetMetrics() []*ExperimentVariantMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type ExperimentVariantMetric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Avg           float64                `protobuf:"fixed64,2,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min           float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentVariantMetric) Reset() {
+	*x = ExperimentVariantMetric{}
+	mi := &file_api_v2_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentVariantMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentVariantMetric) ProtoMessage() {}
+
+func (x *ExperimentVariantMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentVariantMetric.ProtoReflect.Descriptor instead.
+func (*ExperimentVariantMetric) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ExperimentVariantMetric) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExperimentVariantMetric) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *ExperimentVariantMetric) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ExperimentVariantMetric) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ExperimentVariantWeight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VariantName   string                 `protobuf:"bytes,1,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentVariantWeight) Reset() {
+	*x = ExperimentVariantWeight{}
+	mi := &file_api_v2_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentVariantWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentVariantWeight) ProtoMessage() {}
+
+func (x *ExperimentVariantWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentVariantWeight.ProtoReflect.Descriptor instead.
+func (*ExperimentVariantWeight) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ExperimentVariantWeight) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
+func (x *ExperimentVariantWeight) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type ListSessionKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Search        *string                `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionKeysRequest) Reset() {
+	*x = ListSessionKeysRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionKeysRequest) ProtoMessage() {}
+
+func (x *ListSessionKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListSessionKeysRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListSessionKeysRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSessionKeysRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListSessionKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*SessionKey          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionKeysResponse) Reset() {
+	*x = ListSessionKeysResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionKeysResponse) ProtoMessage() {}
+
+func (x *ListSessionKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListSessionKeysResponse) GetData() []*SessionKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSessionKeysResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListSessionKeysResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SessionKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionKey) Reset() {
+	*x = SessionKey{}
+	mi := &file_api_v2_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionKey) ProtoMessage() {}
+
+func (x *SessionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionKey.ProtoReflect.Descriptor instead.
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SessionKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	Search        *string                `protobuf:"bytes,2,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListSessionsRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListSessionsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*SessionGroup        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListSessionsResponse) GetData() []*SessionGroup {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SessionGroup struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunCount       int32                  `protobuf:"varint,2,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	FailedRunCount int32                  `protobuf:"varint,3,opt,name=failed_run_count,json=failedRunCount,proto3" json:"failed_run_count,omitempty"`
+	FailureRate    float64                `protobuf:"fixed64,4,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	LastActiveAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	Functions      []*FunctionRef         `protobuf:"bytes,6,rep,name=functions,proto3" json:"functions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionGroup) Reset() {
+	*x = SessionGroup{}
+	mi := &file_api_v2_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionGroup) ProtoMessage() {}
+
+func (x *SessionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionGroup.ProtoReflect.Descriptor instead.
+func (*SessionGroup) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *SessionGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionGroup) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *SessionGroup) GetFailedRunCount() int32 {
+	if x != nil {
+		return x.FailedRunCount
+	}
+	return 0
+}
+
+func (x *SessionGroup) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *SessionGroup) GetLastActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return nil
+}
+
+func (x *SessionGroup) GetFunctions() []*FunctionRef {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+type ListSessionRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionRunsRequest) Reset() {
+	*x = ListSessionRunsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionRunsRequest) ProtoMessage() {}
+
+func (x *ListSessionRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionRunsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListSessionRunsRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *ListSessionRunsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ListSessionRunsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSessionRunsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListSessionRunsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListSessionRunsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListSessionRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*SessionRun          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionRunsResponse) Reset() {
+	*x = ListSessionRunsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionRunsResponse) ProtoMessage() {}
+
+func (x *ListSessionRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionRunsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListSessionRunsResponse) GetData() []*SessionRun {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSessionRunsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListSessionRunsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SessionRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Function      *FunctionRef           `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	EventName     *string                `protobuf:"bytes,3,opt,name=event_name,json=eventName,proto3,oneof" json:"event_name,omitempty"`
+	Status        FunctionRunStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=api.v2.FunctionRunStatus" json:"status,omitempty"`
+	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRun) Reset() {
+	*x = SessionRun{}
+	mi := &file_api_v2_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRun) ProtoMessage() {}
+
+func (x *SessionRun) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRun.ProtoReflect.Descriptor instead.
+func (*SessionRun) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *SessionRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionRun) GetFunction() *FunctionRef {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *SessionRun) GetEventName() string {
+	if x != nil && x.EventName != nil {
+		return *x.EventName
+	}
+	return ""
+}
+
+func (x *SessionRun) GetStatus() FunctionRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FunctionRunStatus_FUNCTION_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *SessionRun) GetQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return nil
+}
+
+func (x *SessionRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SessionRun) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+type ListRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncludeOutput *bool                  `protobuf:"varint,1,opt,name=include_output,json=includeOutput,proto3,oneof" json:"include_output,omitempty"`
+	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	TimeField     string                 `protobuf:"bytes,6,opt,name=time_field,json=timeField,proto3" json:"time_field,omitempty"`
+	Status        []string               `protobuf:"bytes,7,rep,name=status,proto3" json:"status,omitempty"`
+	AppId         []string               `protobuf:"bytes,8,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    []string               `protobuf:"bytes,9,rep,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	IsDeferred    *bool                  `protobuf:"varint,10,opt,name=is_deferred,json=isDeferred,proto3,oneof" json:"is_deferred,omitempty"`
+	Order         string                 `protobuf:"bytes,11,opt,name=order,proto3" json:"order,omitempty"`
+	Search        *string                `protobuf:"bytes,12,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsRequest) Reset() {
+	*x = ListRunsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsRequest) ProtoMessage() {}
+
+func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListRunsRequest) GetIncludeOutput() bool {
+	if x != nil && x.IncludeOutput != nil {
+		return *x.IncludeOutput
+	}
+	return false
+}
+
+func (x *ListRunsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRunsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListRunsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListRunsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListRunsRequest) GetTimeField() string {
+	if x != nil {
+		return x.TimeField
+	}
+	return ""
+}
+
+func (x *ListRunsRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListRunsRequest) GetAppId() []string {
+	if x != nil {
+		return x.AppId
+	}
+	return nil
+}
+
+func (x *ListRunsRequest) GetFunctionId() []string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return nil
+}
+
+func (x *ListRunsRequest) GetIsDeferred() bool {
+	if x != nil && x.IsDeferred != nil {
+		return *x.IsDeferred
+	}
+	return false
+}
+
+func (x *ListRunsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListRunsRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+type ListFunctionRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	IncludeOutput *bool                  `protobuf:"varint,3,opt,name=include_output,json=includeOutput,proto3,oneof" json:"include_output,omitempty"`
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	TimeField     string                 `protobuf:"bytes,8,opt,name=time_field,json=timeField,proto3" json:"time_field,omitempty"`
+	Status        []string               `protobuf:"bytes,9,rep,name=status,proto3" json:"status,omitempty"`
+	IsDeferred    *bool                  `protobuf:"varint,10,opt,name=is_deferred,json=isDeferred,proto3,oneof" json:"is_deferred,omitempty"`
+	Order         string                 `protobuf:"bytes,11,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFunctionRunsRequest) Reset() {
+	*x = ListFunctionRunsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SigningKey) String() string {
+func (x *ListFunctionRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigningKey) ProtoMessage() {}
+func (*ListFunctionRunsRequest) ProtoMessage() {}
 
-func (x *SigningKey) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[67]
+func (x *ListFunctionRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4736,71 +9543,112 @@ func (x *SigningKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
-func (*SigningKey) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use ListFunctionRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListFunctionRunsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *SigningKey) GetId() string {
+func (x *ListFunctionRunsRequest) GetAppId() string {
 	if x != nil {
-		return x.Id
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *SigningKey) GetName() string {
+func (x *ListFunctionRunsRequest) GetFunctionId() string {
 	if x != nil {
-		return x.Name
+		return x.FunctionId
 	}
 	return ""
 }
 
-func (x *SigningKey) GetEnvironment() string {
-	if x != nil {
-		return x.Environment
+func (x *ListFunctionRunsRequest) GetIncludeOutput() bool {
+	if x != nil && x.IncludeOutput != nil {
+		return *x.IncludeOutput
+	}
+	return false
+}
+
+func (x *ListFunctionRunsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
 	}
 	return ""
 }
 
-func (x *SigningKey) GetKey() string {
+func (x *ListFunctionRunsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListFunctionRunsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Key
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListFunctionRunsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListFunctionRunsRequest) GetTimeField() string {
+	if x != nil {
+		return x.TimeField
 	}
 	return ""
 }
 
-func (x *SigningKey) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListFunctionRunsRequest) GetStatus() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Status
 	}
 	return nil
 }
 
-type CreateWebhookRequest struct {
+func (x *ListFunctionRunsRequest) GetIsDeferred() bool {
+	if x != nil && x.IsDeferred != nil {
+		return *x.IsDeferred
+	}
+	return false
+}
+
+func (x *ListFunctionRunsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type ListRunsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Transform     string                 `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
-	Response      *string                `protobuf:"bytes,3,opt,name=response,proto3,oneof" json:"response,omitempty"`
-	EventFilter   *EventFilter           `protobuf:"bytes,4,opt,name=event_filter,json=eventFilter,proto3,oneof" json:"event_filter,omitempty"`
+	Data          []*FunctionRun         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWebhookRequest) Reset() {
-	*x = CreateWebhookRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[68]
+func (x *ListRunsResponse) Reset() {
+	*x = ListRunsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWebhookRequest) String() string {
+func (x *ListRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWebhookRequest) ProtoMessage() {}
+func (*ListRunsResponse) ProtoMessage() {}
 
-func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[68]
+func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4811,62 +9659,56 @@ func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
-func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *CreateWebhookRequest) GetName() string {
+func (x *ListRunsResponse) GetData() []*FunctionRun {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateWebhookRequest) GetTransform() string {
+func (x *ListRunsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Transform
-	}
-	return ""
-}
-
-func (x *CreateWebhookRequest) GetResponse() string {
-	if x != nil && x.Response != nil {
-		return *x.Response
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateWebhookRequest) GetEventFilter() *EventFilter {
+func (x *ListRunsResponse) GetPage() *Page {
 	if x != nil {
-		return x.EventFilter
+		return x.Page
 	}
 	return nil
 }
 
-type CreateWebhookResponse struct {
+type ListFunctionRunsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Webhook               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*FunctionRun         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWebhookResponse) Reset() {
-	*x = CreateWebhookResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[69]
+func (x *ListFunctionRunsResponse) Reset() {
+	*x = ListFunctionRunsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWebhookResponse) String() string {
+func (x *ListFunctionRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWebhookResponse) ProtoMessage() {}
+func (*ListFunctionRunsResponse) ProtoMessage() {}
 
-func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[69]
+func (x *ListFunctionRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4877,48 +9719,54 @@ func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
-func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use ListFunctionRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListFunctionRunsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *CreateWebhookResponse) GetData() *Webhook {
+func (x *ListFunctionRunsResponse) GetData() []*FunctionRun {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateWebhookResponse) GetMetadata() *ResponseMetadata {
+func (x *ListFunctionRunsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type EventFilter struct {
+func (x *ListFunctionRunsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CancelRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []string               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	Filter        FilterType             `protobuf:"varint,2,opt,name=filter,proto3,enum=api.v2.FilterType" json:"filter,omitempty"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventFilter) Reset() {
-	*x = EventFilter{}
-	mi := &file_api_v2_service_proto_msgTypes[70]
+func (x *CancelRunRequest) Reset() {
+	*x = CancelRunRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventFilter) String() string {
+func (x *CancelRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventFilter) ProtoMessage() {}
+func (*CancelRunRequest) ProtoMessage() {}
 
-func (x *EventFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[70]
+func (x *CancelRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4929,48 +9777,41 @@ func (x *EventFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
-func (*EventFilter) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *EventFilter) GetEvents() []string {
-	if x != nil {
-		return x.Events
-	}
-	return nil
+// Deprecated: Use CancelRunRequest.ProtoReflect.Descriptor instead.
+func (*CancelRunRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *EventFilter) GetFilter() FilterType {
+func (x *CancelRunRequest) GetRunId() string {
 	if x != nil {
-		return x.Filter
+		return x.RunId
 	}
-	return FilterType_ALLOW
+	return ""
 }
 
-type ListWebhooksRequest struct {
+type CancelRunResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Data          *CancelRunData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWebhooksRequest) Reset() {
-	*x = ListWebhooksRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[71]
+func (x *CancelRunResponse) Reset() {
+	*x = CancelRunResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWebhooksRequest) String() string {
+func (x *CancelRunResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWebhooksRequest) ProtoMessage() {}
+func (*CancelRunResponse) ProtoMessage() {}
 
-func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[71]
+func (x *CancelRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4981,49 +9822,47 @@ func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
-func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use CancelRunResponse.ProtoReflect.Descriptor instead.
+func (*CancelRunResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *ListWebhooksRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *CancelRunResponse) GetData() *CancelRunData {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ListWebhooksRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *CancelRunResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type ListWebhooksResponse struct {
+type CancelRunData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Webhook             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWebhooksResponse) Reset() {
-	*x = ListWebhooksResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[72]
+func (x *CancelRunData) Reset() {
+	*x = CancelRunData{}
+	mi := &file_api_v2_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWebhooksResponse) String() string {
+func (x *CancelRunData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWebhooksResponse) ProtoMessage() {}
+func (*CancelRunData) ProtoMessage() {}
 
-func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[72]
+func (x *CancelRunData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5034,62 +9873,49 @@ func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
-func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *ListWebhooksResponse) GetData() []*Webhook {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *ListWebhooksResponse) GetMetadata() *ResponseMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use CancelRunData.ProtoReflect.Descriptor instead.
+func (*CancelRunData) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *ListWebhooksResponse) GetPage() *Page {
+func (x *CancelRunData) GetRunId() string {
 	if x != nil {
-		return x.Page
+		return x.RunId
 	}
-	return nil
-}
-
-type Webhook struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Transform     string                 `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
-	Response      *string                `protobuf:"bytes,5,opt,name=response,proto3,oneof" json:"response,omitempty"`
-	EventFilter   *EventFilter           `protobuf:"bytes,6,opt,name=event_filter,json=eventFilter,proto3,oneof" json:"event_filter,omitempty"`
-	Environment   string                 `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *Webhook) Reset() {
-	*x = Webhook{}
-	mi := &file_api_v2_service_proto_msgTypes[73]
+type Wait struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId            string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Type             WaitType               `protobuf:"varint,3,opt,name=type,proto3,enum=api.v2.WaitType" json:"type,omitempty"`
+	StepName         string                 `protobuf:"bytes,4,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	Event            *string                `protobuf:"bytes,5,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	Expression       *string                `protobuf:"bytes,6,opt,name=expression,proto3,oneof" json:"expression,omitempty"`
+	Signal           *string                `protobuf:"bytes,7,opt,name=signal,proto3,oneof" json:"signal,omitempty"`
+	InvokeFunctionId *string                `protobuf:"bytes,8,opt,name=invoke_function_id,json=invokeFunctionId,proto3,oneof" json:"invoke_function_id,omitempty"`
+	TimeoutAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timeout_at,json=timeoutAt,proto3" json:"timeout_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Wait) Reset() {
+	*x = Wait{}
+	mi := &file_api_v2_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Webhook) String() string {
+func (x *Wait) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Webhook) ProtoMessage() {}
+func (*Wait) ProtoMessage() {}
 
-func (x *Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[73]
+func (x *Wait) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5100,97 +9926,103 @@ func (x *Webhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
-func (*Webhook) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use Wait.ProtoReflect.Descriptor instead.
+func (*Wait) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *Webhook) GetId() string {
+func (x *Wait) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Webhook) GetName() string {
+func (x *Wait) GetRunId() string {
 	if x != nil {
-		return x.Name
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *Webhook) GetUrl() string {
+func (x *Wait) GetType() WaitType {
 	if x != nil {
-		return x.Url
+		return x.Type
 	}
-	return ""
+	return WaitType_WAIT_TYPE_UNSPECIFIED
 }
 
-func (x *Webhook) GetTransform() string {
+func (x *Wait) GetStepName() string {
 	if x != nil {
-		return x.Transform
+		return x.StepName
 	}
 	return ""
 }
 
-func (x *Webhook) GetResponse() string {
-	if x != nil && x.Response != nil {
-		return *x.Response
+func (x *Wait) GetEvent() string {
+	if x != nil && x.Event != nil {
+		return *x.Event
 	}
 	return ""
 }
 
-func (x *Webhook) GetEventFilter() *EventFilter {
-	if x != nil {
-		return x.EventFilter
+func (x *Wait) GetExpression() string {
+	if x != nil && x.Expression != nil {
+		return *x.Expression
 	}
-	return nil
+	return ""
 }
 
-func (x *Webhook) GetEnvironment() string {
-	if x != nil {
-		return x.Environment
+func (x *Wait) GetSignal() string {
+	if x != nil && x.Signal != nil {
+		return *x.Signal
 	}
 	return ""
 }
 
-func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Wait) GetInvokeFunctionId() string {
+	if x != nil && x.InvokeFunctionId != nil {
+		return *x.InvokeFunctionId
+	}
+	return ""
+}
+
+func (x *Wait) GetTimeoutAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.TimeoutAt
 	}
 	return nil
 }
 
-func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Wait) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type PatchEnvRequest struct {
+type ListRunWaitsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IsArchived    *bool                  `protobuf:"varint,2,opt,name=isArchived,proto3,oneof" json:"isArchived,omitempty"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchEnvRequest) Reset() {
-	*x = PatchEnvRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[74]
+func (x *ListRunWaitsRequest) Reset() {
+	*x = ListRunWaitsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchEnvRequest) String() string {
+func (x *ListRunWaitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchEnvRequest) ProtoMessage() {}
+func (*ListRunWaitsRequest) ProtoMessage() {}
 
-func (x *PatchEnvRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[74]
+func (x *ListRunWaitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5201,48 +10033,41 @@ func (x *PatchEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchEnvRequest.ProtoReflect.Descriptor instead.
-func (*PatchEnvRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use ListRunWaitsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunWaitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *PatchEnvRequest) GetId() string {
+func (x *ListRunWaitsRequest) GetRunId() string {
 	if x != nil {
-		return x.Id
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *PatchEnvRequest) GetIsArchived() bool {
-	if x != nil && x.IsArchived != nil {
-		return *x.IsArchived
-	}
-	return false
-}
-
-type PatchEnvsResponse struct {
+type ListRunWaitsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Env                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*Wait                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchEnvsResponse) Reset() {
-	*x = PatchEnvsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[75]
+func (x *ListRunWaitsResponse) Reset() {
+	*x = ListRunWaitsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchEnvsResponse) String() string {
+func (x *ListRunWaitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchEnvsResponse) ProtoMessage() {}
+func (*ListRunWaitsResponse) ProtoMessage() {}
 
-func (x *PatchEnvsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[75]
+func (x *ListRunWaitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5253,51 +10078,50 @@ func (x *PatchEnvsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchEnvsResponse.ProtoReflect.Descriptor instead.
-func (*PatchEnvsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ListRunWaitsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunWaitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *PatchEnvsResponse) GetData() *Env {
+func (x *ListRunWaitsResponse) GetData() []*Wait {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *PatchEnvsResponse) GetMetadata() *ResponseMetadata {
+func (x *ListRunWaitsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type SendEventRequest struct {
+type ListFunctionWaitsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
-	User          *structpb.Struct       `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
-	Id            *string                `protobuf:"bytes,4,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Ts            *int64                 `protobuf:"varint,5,opt,name=ts,proto3,oneof" json:"ts,omitempty"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendEventRequest) Reset() {
-	*x = SendEventRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[76]
+func (x *ListFunctionWaitsRequest) Reset() {
+	*x = ListFunctionWaitsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEventRequest) String() string {
+func (x *ListFunctionWaitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEventRequest) ProtoMessage() {}
+func (*ListFunctionWaitsRequest) ProtoMessage() {}
 
-func (x *SendEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[76]
+func (x *ListFunctionWaitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5308,69 +10132,63 @@ func (x *SendEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEventRequest.ProtoReflect.Descriptor instead.
-func (*SendEventRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ListFunctionWaitsRequest.ProtoReflect.Descriptor instead.
+func (*ListFunctionWaitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *SendEventRequest) GetName() string {
+func (x *ListFunctionWaitsRequest) GetAppId() string {
 	if x != nil {
-		return x.Name
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *SendEventRequest) GetData() *structpb.Struct {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *SendEventRequest) GetUser() *structpb.Struct {
+func (x *ListFunctionWaitsRequest) GetFunctionId() string {
 	if x != nil {
-		return x.User
+		return x.FunctionId
 	}
-	return nil
+	return ""
 }
 
-func (x *SendEventRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *ListFunctionWaitsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
 	}
 	return ""
 }
 
-func (x *SendEventRequest) GetTs() int64 {
-	if x != nil && x.Ts != nil {
-		return *x.Ts
+func (x *ListFunctionWaitsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-type SendEventResponse struct {
+type ListFunctionWaitsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *SendEventData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*Wait                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendEventResponse) Reset() {
-	*x = SendEventResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[77]
+func (x *ListFunctionWaitsResponse) Reset() {
+	*x = ListFunctionWaitsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEventResponse) String() string {
+func (x *ListFunctionWaitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEventResponse) ProtoMessage() {}
+func (*ListFunctionWaitsResponse) ProtoMessage() {}
 
-func (x *SendEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[77]
+func (x *ListFunctionWaitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5381,94 +10199,56 @@ func (x *SendEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEventResponse.ProtoReflect.Descriptor instead.
-func (*SendEventResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use ListFunctionWaitsResponse.ProtoReflect.Descriptor instead.
+func (*ListFunctionWaitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *SendEventResponse) GetData() *SendEventData {
+func (x *ListFunctionWaitsResponse) GetData() []*Wait {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *SendEventResponse) GetMetadata() *ResponseMetadata {
+func (x *ListFunctionWaitsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type SendEventData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SendEventData) Reset() {
-	*x = SendEventData{}
-	mi := &file_api_v2_service_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SendEventData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SendEventData) ProtoMessage() {}
-
-func (x *SendEventData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[78]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SendEventData.ProtoReflect.Descriptor instead.
-func (*SendEventData) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *SendEventData) GetEventId() string {
+func (x *ListFunctionWaitsResponse) GetPage() *Page {
 	if x != nil {
-		return x.EventId
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type InvokeFunctionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	FunctionId     string                 `protobuf:"bytes,1,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
-	Data           *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	IdempotencyKey *string                `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	AppId          string                 `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ResolveWaitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	WaitId        string                 `protobuf:"bytes,2,opt,name=wait_id,json=waitId,proto3" json:"wait_id,omitempty"`
+	Data          *structpb.Value        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeFunctionRequest) Reset() {
-	*x = InvokeFunctionRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[79]
+func (x *ResolveWaitRequest) Reset() {
+	*x = ResolveWaitRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeFunctionRequest) String() string {
+func (x *ResolveWaitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeFunctionRequest) ProtoMessage() {}
+func (*ResolveWaitRequest) ProtoMessage() {}
 
-func (x *InvokeFunctionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[79]
+func (x *ResolveWaitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5479,62 +10259,55 @@ func (x *InvokeFunctionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeFunctionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeFunctionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ResolveWaitRequest.ProtoReflect.Descriptor instead.
+func (*ResolveWaitRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *InvokeFunctionRequest) GetFunctionId() string {
+func (x *ResolveWaitRequest) GetRunId() string {
 	if x != nil {
-		return x.FunctionId
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *InvokeFunctionRequest) GetData() *structpb.Struct {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *InvokeFunctionRequest) GetIdempotencyKey() string {
-	if x != nil && x.IdempotencyKey != nil {
-		return *x.IdempotencyKey
+func (x *ResolveWaitRequest) GetWaitId() string {
+	if x != nil {
+		return x.WaitId
 	}
 	return ""
 }
 
-func (x *InvokeFunctionRequest) GetAppId() string {
+func (x *ResolveWaitRequest) GetData() *structpb.Value {
 	if x != nil {
-		return x.AppId
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type InvokeFunctionResponse struct {
+type ResolveWaitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *InvokeFunctionData    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *Wait                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeFunctionResponse) Reset() {
-	*x = InvokeFunctionResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[80]
+func (x *ResolveWaitResponse) Reset() {
+	*x = ResolveWaitResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeFunctionResponse) String() string {
+func (x *ResolveWaitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeFunctionResponse) ProtoMessage() {}
+func (*ResolveWaitResponse) ProtoMessage() {}
 
-func (x *InvokeFunctionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[80]
+func (x *ResolveWaitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5545,52 +10318,48 @@ func (x *InvokeFunctionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeFunctionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeFunctionResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use ResolveWaitResponse.ProtoReflect.Descriptor instead.
+func (*ResolveWaitResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *InvokeFunctionResponse) GetData() *InvokeFunctionData {
+func (x *ResolveWaitResponse) GetData() *Wait {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *InvokeFunctionResponse) GetMetadata() *ResponseMetadata {
+func (x *ResolveWaitResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type InvokeFunctionData struct {
+type TimeoutWaitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
-	Result        *string                `protobuf:"bytes,5,opt,name=result,proto3,oneof" json:"result,omitempty"`
-	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	WaitId        string                 `protobuf:"bytes,2,opt,name=wait_id,json=waitId,proto3" json:"wait_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeFunctionData) Reset() {
-	*x = InvokeFunctionData{}
-	mi := &file_api_v2_service_proto_msgTypes[81]
+func (x *TimeoutWaitRequest) Reset() {
+	*x = TimeoutWaitRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeFunctionData) String() string {
+func (x *TimeoutWaitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeFunctionData) ProtoMessage() {}
+func (*TimeoutWaitRequest) ProtoMessage() {}
 
-func (x *InvokeFunctionData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[81]
+func (x *TimeoutWaitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5601,76 +10370,48 @@ func (x *InvokeFunctionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeFunctionData.ProtoReflect.Descriptor instead.
-func (*InvokeFunctionData) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use TimeoutWaitRequest.ProtoReflect.Descriptor instead.
+func (*TimeoutWaitRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *InvokeFunctionData) GetRunId() string {
+func (x *TimeoutWaitRequest) GetRunId() string {
 	if x != nil {
 		return x.RunId
 	}
 	return ""
 }
 
-func (x *InvokeFunctionData) GetQueuedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.QueuedAt
-	}
-	return nil
-}
-
-func (x *InvokeFunctionData) GetStartedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartedAt
-	}
-	return nil
-}
-
-func (x *InvokeFunctionData) GetCompletedAt() *timestamppb.Timestamp {
+func (x *TimeoutWaitRequest) GetWaitId() string {
 	if x != nil {
-		return x.CompletedAt
-	}
-	return nil
-}
-
-func (x *InvokeFunctionData) GetResult() string {
-	if x != nil && x.Result != nil {
-		return *x.Result
-	}
-	return ""
-}
-
-func (x *InvokeFunctionData) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+		return x.WaitId
 	}
 	return ""
 }
 
-type CreateScoreRequest struct {
+type TimeoutWaitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Scores        []*CreateScoreInput    `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	Data          *Wait                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScoreRequest) Reset() {
-	*x = CreateScoreRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[82]
+func (x *TimeoutWaitResponse) Reset() {
+	*x = TimeoutWaitResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScoreRequest) String() string {
+func (x *TimeoutWaitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScoreRequest) ProtoMessage() {}
+func (*TimeoutWaitResponse) ProtoMessage() {}
 
-func (x *CreateScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[82]
+func (x *TimeoutWaitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5681,50 +10422,53 @@ func (x *CreateScoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScoreRequest.ProtoReflect.Descriptor instead.
-func (*CreateScoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use TimeoutWaitResponse.ProtoReflect.Descriptor instead.
+func (*TimeoutWaitResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *CreateScoreRequest) GetRunId() string {
+func (x *TimeoutWaitResponse) GetData() *Wait {
 	if x != nil {
-		return x.RunId
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateScoreRequest) GetScores() []*CreateScoreInput {
+func (x *TimeoutWaitResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Scores
+		return x.Metadata
 	}
 	return nil
 }
 
-type CreateScoreInput struct {
+type BulkRunFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	StepId        *string                `protobuf:"bytes,3,opt,name=step_id,json=stepId,proto3,oneof" json:"step_id,omitempty"`
-	Experiment    *ScoreExperiment       `protobuf:"bytes,4,opt,name=experiment,proto3,oneof" json:"experiment,omitempty"`
+	AppId         []string               `protobuf:"bytes,1,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId    []string               `protobuf:"bytes,2,rep,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	Status        []string               `protobuf:"bytes,3,rep,name=status,proto3" json:"status,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	TimeField     string                 `protobuf:"bytes,6,opt,name=time_field,json=timeField,proto3" json:"time_field,omitempty"`
+	Query         *string                `protobuf:"bytes,7,opt,name=query,proto3,oneof" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScoreInput) Reset() {
-	*x = CreateScoreInput{}
-	mi := &file_api_v2_service_proto_msgTypes[83]
+func (x *BulkRunFilter) Reset() {
+	*x = BulkRunFilter{}
+	mi := &file_api_v2_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScoreInput) String() string {
+func (x *BulkRunFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScoreInput) ProtoMessage() {}
+func (*BulkRunFilter) ProtoMessage() {}
 
-func (x *CreateScoreInput) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[83]
+func (x *BulkRunFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5735,62 +10479,82 @@ func (x *CreateScoreInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScoreInput.ProtoReflect.Descriptor instead.
-func (*CreateScoreInput) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use BulkRunFilter.ProtoReflect.Descriptor instead.
+func (*BulkRunFilter) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *CreateScoreInput) GetName() string {
+func (x *BulkRunFilter) GetAppId() []string {
 	if x != nil {
-		return x.Name
+		return x.AppId
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateScoreInput) GetValue() *structpb.Value {
+func (x *BulkRunFilter) GetFunctionId() []string {
 	if x != nil {
-		return x.Value
+		return x.FunctionId
 	}
 	return nil
 }
 
-func (x *CreateScoreInput) GetStepId() string {
-	if x != nil && x.StepId != nil {
-		return *x.StepId
+func (x *BulkRunFilter) GetStatus() []string {
+	if x != nil {
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateScoreInput) GetExperiment() *ScoreExperiment {
+func (x *BulkRunFilter) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Experiment
+		return x.From
 	}
 	return nil
 }
 
-type ScoreExperiment struct {
+func (x *BulkRunFilter) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *BulkRunFilter) GetTimeField() string {
+	if x != nil {
+		return x.TimeField
+	}
+	return ""
+}
+
+func (x *BulkRunFilter) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+type PreviewBulkRunOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Variant       string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	Filter        *BulkRunFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScoreExperiment) Reset() {
-	*x = ScoreExperiment{}
-	mi := &file_api_v2_service_proto_msgTypes[84]
+func (x *PreviewBulkRunOperationRequest) Reset() {
+	*x = PreviewBulkRunOperationRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScoreExperiment) String() string {
+func (x *PreviewBulkRunOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreExperiment) ProtoMessage() {}
+func (*PreviewBulkRunOperationRequest) ProtoMessage() {}
 
-func (x *ScoreExperiment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[84]
+func (x *PreviewBulkRunOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5801,48 +10565,41 @@ func (x *ScoreExperiment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreExperiment.ProtoReflect.Descriptor instead.
-func (*ScoreExperiment) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *ScoreExperiment) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use PreviewBulkRunOperationRequest.ProtoReflect.Descriptor instead.
+func (*PreviewBulkRunOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *ScoreExperiment) GetVariant() string {
+func (x *PreviewBulkRunOperationRequest) GetFilter() *BulkRunFilter {
 	if x != nil {
-		return x.Variant
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-type CreateScoreResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Score               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+type PreviewBulkRunOperationResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Data          *BulkRunOperationPreview `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScoreResponse) Reset() {
-	*x = CreateScoreResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[85]
+func (x *PreviewBulkRunOperationResponse) Reset() {
+	*x = PreviewBulkRunOperationResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScoreResponse) String() string {
+func (x *PreviewBulkRunOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScoreResponse) ProtoMessage() {}
+func (*PreviewBulkRunOperationResponse) ProtoMessage() {}
 
-func (x *CreateScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[85]
+func (x *PreviewBulkRunOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5853,51 +10610,47 @@ func (x *CreateScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScoreResponse.ProtoReflect.Descriptor instead.
-func (*CreateScoreResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use PreviewBulkRunOperationResponse.ProtoReflect.Descriptor instead.
+func (*PreviewBulkRunOperationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *CreateScoreResponse) GetData() []*Score {
+func (x *PreviewBulkRunOperationResponse) GetData() *BulkRunOperationPreview {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateScoreResponse) GetMetadata() *ResponseMetadata {
+func (x *PreviewBulkRunOperationResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type Score struct {
+type BulkRunOperationPreview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value         *structpb.Value        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	StepId        *string                `protobuf:"bytes,4,opt,name=step_id,json=stepId,proto3,oneof" json:"step_id,omitempty"`
-	Experiment    *ScoreExperiment       `protobuf:"bytes,5,opt,name=experiment,proto3,oneof" json:"experiment,omitempty"`
+	RunCount      int32                  `protobuf:"varint,1,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Score) Reset() {
-	*x = Score{}
-	mi := &file_api_v2_service_proto_msgTypes[86]
+func (x *BulkRunOperationPreview) Reset() {
+	*x = BulkRunOperationPreview{}
+	mi := &file_api_v2_service_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Score) String() string {
+func (x *BulkRunOperationPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Score) ProtoMessage() {}
+func (*BulkRunOperationPreview) ProtoMessage() {}
 
-func (x *Score) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[86]
+func (x *BulkRunOperationPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5908,69 +10661,42 @@ func (x *Score) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Score.ProtoReflect.Descriptor instead.
-func (*Score) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{86}
-}
-
-func (x *Score) GetRunId() string {
-	if x != nil {
-		return x.RunId
-	}
-	return ""
-}
-
-func (x *Score) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Score) GetValue() *structpb.Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *Score) GetStepId() string {
-	if x != nil && x.StepId != nil {
-		return *x.StepId
-	}
-	return ""
+// Deprecated: Use BulkRunOperationPreview.ProtoReflect.Descriptor instead.
+func (*BulkRunOperationPreview) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *Score) GetExperiment() *ScoreExperiment {
+func (x *BulkRunOperationPreview) GetRunCount() int32 {
 	if x != nil {
-		return x.Experiment
+		return x.RunCount
 	}
-	return nil
+	return 0
 }
 
-type SyncAppRequest struct {
+type CreateBulkRunOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Filter        *BulkRunFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	RatePerSecond *int32                 `protobuf:"varint,3,opt,name=rate_per_second,json=ratePerSecond,proto3,oneof" json:"rate_per_second,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncAppRequest) Reset() {
-	*x = SyncAppRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[87]
+func (x *CreateBulkRunOperationRequest) Reset() {
+	*x = CreateBulkRunOperationRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAppRequest) String() string {
+func (x *CreateBulkRunOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAppRequest) ProtoMessage() {}
+func (*CreateBulkRunOperationRequest) ProtoMessage() {}
 
-func (x *SyncAppRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[87]
+func (x *CreateBulkRunOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5981,48 +10707,55 @@ func (x *SyncAppRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAppRequest.ProtoReflect.Descriptor instead.
-func (*SyncAppRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use CreateBulkRunOperationRequest.ProtoReflect.Descriptor instead.
+func (*CreateBulkRunOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *SyncAppRequest) GetAppId() string {
+func (x *CreateBulkRunOperationRequest) GetAction() string {
 	if x != nil {
-		return x.AppId
+		return x.Action
 	}
 	return ""
 }
 
-func (x *SyncAppRequest) GetUrl() string {
+func (x *CreateBulkRunOperationRequest) GetFilter() *BulkRunFilter {
 	if x != nil {
-		return x.Url
+		return x.Filter
 	}
-	return ""
+	return nil
+}
+
+func (x *CreateBulkRunOperationRequest) GetRatePerSecond() int32 {
+	if x != nil && x.RatePerSecond != nil {
+		return *x.RatePerSecond
+	}
+	return 0
 }
 
-type SyncAppResponse struct {
+type CreateBulkRunOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *SyncAppData           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *BulkRunOperation      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncAppResponse) Reset() {
-	*x = SyncAppResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[88]
+func (x *CreateBulkRunOperationResponse) Reset() {
+	*x = CreateBulkRunOperationResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAppResponse) String() string {
+func (x *CreateBulkRunOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAppResponse) ProtoMessage() {}
+func (*CreateBulkRunOperationResponse) ProtoMessage() {}
 
-func (x *SyncAppResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[88]
+func (x *CreateBulkRunOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6033,50 +10766,48 @@ func (x *SyncAppResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAppResponse.ProtoReflect.Descriptor instead.
-func (*SyncAppResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use CreateBulkRunOperationResponse.ProtoReflect.Descriptor instead.
+func (*CreateBulkRunOperationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *SyncAppResponse) GetData() *SyncAppData {
+func (x *CreateBulkRunOperationResponse) GetData() *BulkRunOperation {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *SyncAppResponse) GetMetadata() *ResponseMetadata {
+func (x *CreateBulkRunOperationResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type SyncAppData struct {
+type ListBulkRunOperationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Error         *SyncAppError          `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncAppData) Reset() {
-	*x = SyncAppData{}
-	mi := &file_api_v2_service_proto_msgTypes[89]
+func (x *ListBulkRunOperationsRequest) Reset() {
+	*x = ListBulkRunOperationsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAppData) String() string {
+func (x *ListBulkRunOperationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAppData) ProtoMessage() {}
+func (*ListBulkRunOperationsRequest) ProtoMessage() {}
 
-func (x *SyncAppData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[89]
+func (x *ListBulkRunOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6087,62 +10818,49 @@ func (x *SyncAppData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAppData.ProtoReflect.Descriptor instead.
-func (*SyncAppData) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *SyncAppData) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SyncAppData) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use ListBulkRunOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListBulkRunOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *SyncAppData) GetAppId() string {
-	if x != nil {
-		return x.AppId
+func (x *ListBulkRunOperationsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
 	}
 	return ""
 }
 
-func (x *SyncAppData) GetError() *SyncAppError {
-	if x != nil {
-		return x.Error
+func (x *ListBulkRunOperationsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return nil
+	return 0
 }
 
-type SyncAppError struct {
+type ListBulkRunOperationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*BulkRunOperation    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncAppError) Reset() {
-	*x = SyncAppError{}
-	mi := &file_api_v2_service_proto_msgTypes[90]
+func (x *ListBulkRunOperationsResponse) Reset() {
+	*x = ListBulkRunOperationsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAppError) String() string {
+func (x *ListBulkRunOperationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAppError) ProtoMessage() {}
+func (*ListBulkRunOperationsResponse) ProtoMessage() {}
 
-func (x *SyncAppError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[90]
+func (x *ListBulkRunOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6153,47 +10871,54 @@ func (x *SyncAppError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAppError.ProtoReflect.Descriptor instead.
-func (*SyncAppError) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use ListBulkRunOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListBulkRunOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *SyncAppError) GetCode() string {
+func (x *ListBulkRunOperationsResponse) GetData() []*BulkRunOperation {
 	if x != nil {
-		return x.Code
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *SyncAppError) GetMessage() string {
+func (x *ListBulkRunOperationsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Message
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-type QueryInsightsRequest struct {
+func (x *ListBulkRunOperationsResponse) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetBulkRunOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryInsightsRequest) Reset() {
-	*x = QueryInsightsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[91]
+func (x *GetBulkRunOperationRequest) Reset() {
+	*x = GetBulkRunOperationRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryInsightsRequest) String() string {
+func (x *GetBulkRunOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInsightsRequest) ProtoMessage() {}
+func (*GetBulkRunOperationRequest) ProtoMessage() {}
 
-func (x *QueryInsightsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[91]
+func (x *GetBulkRunOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6204,41 +10929,41 @@ func (x *QueryInsightsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryInsightsRequest.ProtoReflect.Descriptor instead.
-func (*QueryInsightsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GetBulkRunOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkRunOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *QueryInsightsRequest) GetQuery() string {
+func (x *GetBulkRunOperationRequest) GetOperationId() string {
 	if x != nil {
-		return x.Query
+		return x.OperationId
 	}
 	return ""
 }
 
-type QueryInsightsResponse struct {
+type GetBulkRunOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *QueryInsightsData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *BulkRunOperation      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryInsightsResponse) Reset() {
-	*x = QueryInsightsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[92]
+func (x *GetBulkRunOperationResponse) Reset() {
+	*x = GetBulkRunOperationResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryInsightsResponse) String() string {
+func (x *GetBulkRunOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInsightsResponse) ProtoMessage() {}
+func (*GetBulkRunOperationResponse) ProtoMessage() {}
 
-func (x *QueryInsightsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[92]
+func (x *GetBulkRunOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6249,49 +10974,47 @@ func (x *QueryInsightsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryInsightsResponse.ProtoReflect.Descriptor instead.
-func (*QueryInsightsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use GetBulkRunOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetBulkRunOperationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *QueryInsightsResponse) GetData() *QueryInsightsData {
+func (x *GetBulkRunOperationResponse) GetData() *BulkRunOperation {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *QueryInsightsResponse) GetMetadata() *ResponseMetadata {
+func (x *GetBulkRunOperationResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type QueryInsightsData struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Columns       []*InsightsOutputColumn `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
-	Rows          []*InsightsRow          `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
-	Diagnostics   []*InsightsDiagnostic   `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+type AbortBulkRunOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryInsightsData) Reset() {
-	*x = QueryInsightsData{}
-	mi := &file_api_v2_service_proto_msgTypes[93]
+func (x *AbortBulkRunOperationRequest) Reset() {
+	*x = AbortBulkRunOperationRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryInsightsData) String() string {
+func (x *AbortBulkRunOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInsightsData) ProtoMessage() {}
+func (*AbortBulkRunOperationRequest) ProtoMessage() {}
 
-func (x *QueryInsightsData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[93]
+func (x *AbortBulkRunOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6302,55 +11025,41 @@ func (x *QueryInsightsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryInsightsData.ProtoReflect.Descriptor instead.
-func (*QueryInsightsData) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{93}
-}
-
-func (x *QueryInsightsData) GetColumns() []*InsightsOutputColumn {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-func (x *QueryInsightsData) GetRows() []*InsightsRow {
-	if x != nil {
-		return x.Rows
-	}
-	return nil
+// Deprecated: Use AbortBulkRunOperationRequest.ProtoReflect.Descriptor instead.
+func (*AbortBulkRunOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *QueryInsightsData) GetDiagnostics() []*InsightsDiagnostic {
+func (x *AbortBulkRunOperationRequest) GetOperationId() string {
 	if x != nil {
-		return x.Diagnostics
+		return x.OperationId
 	}
-	return nil
+	return ""
 }
 
-type InsightsOutputColumn struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          InsightsOutputColumnType `protobuf:"varint,2,opt,name=type,proto3,enum=api.v2.InsightsOutputColumnType" json:"type,omitempty"`
+type AbortBulkRunOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *BulkRunOperation      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsightsOutputColumn) Reset() {
-	*x = InsightsOutputColumn{}
-	mi := &file_api_v2_service_proto_msgTypes[94]
+func (x *AbortBulkRunOperationResponse) Reset() {
+	*x = AbortBulkRunOperationResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsightsOutputColumn) String() string {
+func (x *AbortBulkRunOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsightsOutputColumn) ProtoMessage() {}
+func (*AbortBulkRunOperationResponse) ProtoMessage() {}
 
-func (x *InsightsOutputColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[94]
+func (x *AbortBulkRunOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6361,47 +11070,60 @@ func (x *InsightsOutputColumn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsightsOutputColumn.ProtoReflect.Descriptor instead.
-func (*InsightsOutputColumn) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use AbortBulkRunOperationResponse.ProtoReflect.Descriptor instead.
+func (*AbortBulkRunOperationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *InsightsOutputColumn) GetName() string {
+func (x *AbortBulkRunOperationResponse) GetData() *BulkRunOperation {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *InsightsOutputColumn) GetType() InsightsOutputColumnType {
+func (x *AbortBulkRunOperationResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Type
+		return x.Metadata
 	}
-	return InsightsOutputColumnType_VALUE_TYPE_UNSPECIFIED
+	return nil
 }
 
-type InsightsRow struct {
+type BulkRunOperation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Values        []*structpb.Value      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        BulkRunOperationAction `protobuf:"varint,2,opt,name=action,proto3,enum=api.v2.BulkRunOperationAction" json:"action,omitempty"`
+	Status        BulkRunOperationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=api.v2.BulkRunOperationStatus" json:"status,omitempty"`
+	Filter        *BulkRunFilter         `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	RatePerSecond int32                  `protobuf:"varint,5,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second,omitempty"`
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,7,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,8,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Skipped       int32                  `protobuf:"varint,9,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed        int32                  `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error         *string                `protobuf:"bytes,11,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsightsRow) Reset() {
-	*x = InsightsRow{}
-	mi := &file_api_v2_service_proto_msgTypes[95]
+func (x *BulkRunOperation) Reset() {
+	*x = BulkRunOperation{}
+	mi := &file_api_v2_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsightsRow) String() string {
+func (x *BulkRunOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsightsRow) ProtoMessage() {}
+func (*BulkRunOperation) ProtoMessage() {}
 
-func (x *InsightsRow) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[95]
+func (x *BulkRunOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6412,110 +11134,136 @@ func (x *InsightsRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsightsRow.ProtoReflect.Descriptor instead.
-func (*InsightsRow) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use BulkRunOperation.ProtoReflect.Descriptor instead.
+func (*BulkRunOperation) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *InsightsRow) GetValues() []*structpb.Value {
+func (x *BulkRunOperation) GetId() string {
 	if x != nil {
-		return x.Values
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type InsightsDiagnostic struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Severity      InsightsDiagnosticSeverity  `protobuf:"varint,1,opt,name=severity,proto3,enum=api.v2.InsightsDiagnosticSeverity" json:"severity,omitempty"`
-	Code          string                      `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Position      *InsightsDiagnosticPosition `protobuf:"bytes,4,opt,name=position,proto3,oneof" json:"position,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BulkRunOperation) GetAction() BulkRunOperationAction {
+	if x != nil {
+		return x.Action
+	}
+	return BulkRunOperationAction_BULK_RUN_OPERATION_ACTION_UNSPECIFIED
 }
 
-func (x *InsightsDiagnostic) Reset() {
-	*x = InsightsDiagnostic{}
-	mi := &file_api_v2_service_proto_msgTypes[96]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BulkRunOperation) GetStatus() BulkRunOperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BulkRunOperationStatus_BULK_RUN_OPERATION_STATUS_UNSPECIFIED
 }
 
-func (x *InsightsDiagnostic) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BulkRunOperation) GetFilter() *BulkRunFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (*InsightsDiagnostic) ProtoMessage() {}
+func (x *BulkRunOperation) GetRatePerSecond() int32 {
+	if x != nil {
+		return x.RatePerSecond
+	}
+	return 0
+}
 
-func (x *InsightsDiagnostic) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[96]
+func (x *BulkRunOperation) GetTotal() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use InsightsDiagnostic.ProtoReflect.Descriptor instead.
-func (*InsightsDiagnostic) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{96}
+func (x *BulkRunOperation) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
 }
 
-func (x *InsightsDiagnostic) GetSeverity() InsightsDiagnosticSeverity {
+func (x *BulkRunOperation) GetSucceeded() int32 {
 	if x != nil {
-		return x.Severity
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkRunOperation) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BulkRunOperation) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkRunOperation) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
-	return InsightsDiagnosticSeverity_SEVERITY_UNSPECIFIED
+	return ""
 }
 
-func (x *InsightsDiagnostic) GetCode() string {
+func (x *BulkRunOperation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Code
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *InsightsDiagnostic) GetMessage() string {
+func (x *BulkRunOperation) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *InsightsDiagnostic) GetPosition() *InsightsDiagnosticPosition {
+func (x *BulkRunOperation) GetEndedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Position
+		return x.EndedAt
 	}
 	return nil
 }
 
-type InsightsDiagnosticPosition struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Context       string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateOutboundWebhookRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Url                      string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events                   []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Filter                   *string                `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	DurationThresholdSeconds *int64                 `protobuf:"varint,4,opt,name=duration_threshold_seconds,json=durationThresholdSeconds,proto3,oneof" json:"duration_threshold_seconds,omitempty"`
+	Secret                   *string                `protobuf:"bytes,5,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	Disabled                 *bool                  `protobuf:"varint,6,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *InsightsDiagnosticPosition) Reset() {
-	*x = InsightsDiagnosticPosition{}
-	mi := &file_api_v2_service_proto_msgTypes[97]
+func (x *CreateOutboundWebhookRequest) Reset() {
+	*x = CreateOutboundWebhookRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsightsDiagnosticPosition) String() string {
+func (x *CreateOutboundWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsightsDiagnosticPosition) ProtoMessage() {}
+func (*CreateOutboundWebhookRequest) ProtoMessage() {}
 
-func (x *InsightsDiagnosticPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[97]
+func (x *CreateOutboundWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6526,91 +11274,76 @@ func (x *InsightsDiagnosticPosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsightsDiagnosticPosition.ProtoReflect.Descriptor instead.
-func (*InsightsDiagnosticPosition) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use CreateOutboundWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateOutboundWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *InsightsDiagnosticPosition) GetStart() int32 {
+func (x *CreateOutboundWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Start
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-func (x *InsightsDiagnosticPosition) GetEnd() int32 {
+func (x *CreateOutboundWebhookRequest) GetEvents() []string {
 	if x != nil {
-		return x.End
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-func (x *InsightsDiagnosticPosition) GetContext() string {
-	if x != nil {
-		return x.Context
+func (x *CreateOutboundWebhookRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
 	}
 	return ""
 }
 
-type ListInsightsTablesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListInsightsTablesRequest) Reset() {
-	*x = ListInsightsTablesRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[98]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListInsightsTablesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateOutboundWebhookRequest) GetDurationThresholdSeconds() int64 {
+	if x != nil && x.DurationThresholdSeconds != nil {
+		return *x.DurationThresholdSeconds
+	}
+	return 0
 }
 
-func (*ListInsightsTablesRequest) ProtoMessage() {}
-
-func (x *ListInsightsTablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[98]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateOutboundWebhookRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListInsightsTablesRequest.ProtoReflect.Descriptor instead.
-func (*ListInsightsTablesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{98}
+func (x *CreateOutboundWebhookRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
 }
 
-type ListInsightsTablesResponse struct {
+type CreateOutboundWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*InsightsTable       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          *OutboundWebhook       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInsightsTablesResponse) Reset() {
-	*x = ListInsightsTablesResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[99]
+func (x *CreateOutboundWebhookResponse) Reset() {
+	*x = CreateOutboundWebhookResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInsightsTablesResponse) String() string {
+func (x *CreateOutboundWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInsightsTablesResponse) ProtoMessage() {}
+func (*CreateOutboundWebhookResponse) ProtoMessage() {}
 
-func (x *ListInsightsTablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[99]
+func (x *CreateOutboundWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6621,49 +11354,46 @@ func (x *ListInsightsTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInsightsTablesResponse.ProtoReflect.Descriptor instead.
-func (*ListInsightsTablesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use CreateOutboundWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateOutboundWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *ListInsightsTablesResponse) GetData() []*InsightsTable {
+func (x *CreateOutboundWebhookResponse) GetData() *OutboundWebhook {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListInsightsTablesResponse) GetMetadata() *ResponseMetadata {
+func (x *CreateOutboundWebhookResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type InsightsTable struct {
+type ListOutboundWebhooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Columns       []*InsightsTableColumn `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsightsTable) Reset() {
-	*x = InsightsTable{}
-	mi := &file_api_v2_service_proto_msgTypes[100]
+func (x *ListOutboundWebhooksRequest) Reset() {
+	*x = ListOutboundWebhooksRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsightsTable) String() string {
+func (x *ListOutboundWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsightsTable) ProtoMessage() {}
+func (*ListOutboundWebhooksRequest) ProtoMessage() {}
 
-func (x *InsightsTable) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[100]
+func (x *ListOutboundWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6674,56 +11404,34 @@ func (x *InsightsTable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsightsTable.ProtoReflect.Descriptor instead.
-func (*InsightsTable) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *InsightsTable) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *InsightsTable) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *InsightsTable) GetColumns() []*InsightsTableColumn {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
+// Deprecated: Use ListOutboundWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListOutboundWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{169}
 }
 
-type InsightsTableColumn struct {
+type ListOutboundWebhooksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Data          []*OutboundWebhook     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsightsTableColumn) Reset() {
-	*x = InsightsTableColumn{}
-	mi := &file_api_v2_service_proto_msgTypes[101]
+func (x *ListOutboundWebhooksResponse) Reset() {
+	*x = ListOutboundWebhooksResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsightsTableColumn) String() string {
+func (x *ListOutboundWebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsightsTableColumn) ProtoMessage() {}
+func (*ListOutboundWebhooksResponse) ProtoMessage() {}
 
-func (x *InsightsTableColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[101]
+func (x *ListOutboundWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6734,54 +11442,47 @@ func (x *InsightsTableColumn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsightsTableColumn.ProtoReflect.Descriptor instead.
-func (*InsightsTableColumn) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *InsightsTableColumn) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ListOutboundWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListOutboundWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *InsightsTableColumn) GetDescription() string {
+func (x *ListOutboundWebhooksResponse) GetData() []*OutboundWebhook {
 	if x != nil {
-		return x.Description
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *InsightsTableColumn) GetType() string {
+func (x *ListOutboundWebhooksResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Type
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-type QueryInsightsPromptRequest struct {
+type GetOutboundWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryInsightsPromptRequest) Reset() {
-	*x = QueryInsightsPromptRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[102]
+func (x *GetOutboundWebhookRequest) Reset() {
+	*x = GetOutboundWebhookRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryInsightsPromptRequest) String() string {
+func (x *GetOutboundWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInsightsPromptRequest) ProtoMessage() {}
+func (*GetOutboundWebhookRequest) ProtoMessage() {}
 
-func (x *QueryInsightsPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[102]
+func (x *GetOutboundWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6792,41 +11493,41 @@ func (x *QueryInsightsPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryInsightsPromptRequest.ProtoReflect.Descriptor instead.
-func (*QueryInsightsPromptRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use GetOutboundWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetOutboundWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *QueryInsightsPromptRequest) GetPrompt() string {
+func (x *GetOutboundWebhookRequest) GetWebhookId() string {
 	if x != nil {
-		return x.Prompt
+		return x.WebhookId
 	}
 	return ""
 }
 
-type QueryInsightsPromptResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Data          *QueryInsightsPromptData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+type GetOutboundWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *OutboundWebhook       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryInsightsPromptResponse) Reset() {
-	*x = QueryInsightsPromptResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[103]
+func (x *GetOutboundWebhookResponse) Reset() {
+	*x = GetOutboundWebhookResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryInsightsPromptResponse) String() string {
+func (x *GetOutboundWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInsightsPromptResponse) ProtoMessage() {}
+func (*GetOutboundWebhookResponse) ProtoMessage() {}
 
-func (x *QueryInsightsPromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[103]
+func (x *GetOutboundWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6837,100 +11538,52 @@ func (x *QueryInsightsPromptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryInsightsPromptResponse.ProtoReflect.Descriptor instead.
-func (*QueryInsightsPromptResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use GetOutboundWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetOutboundWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *QueryInsightsPromptResponse) GetData() *QueryInsightsPromptData {
+func (x *GetOutboundWebhookResponse) GetData() *OutboundWebhook {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *QueryInsightsPromptResponse) GetMetadata() *ResponseMetadata {
+func (x *GetOutboundWebhookResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type QueryInsightsPromptData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sql           string                 `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
-	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *QueryInsightsPromptData) Reset() {
-	*x = QueryInsightsPromptData{}
-	mi := &file_api_v2_service_proto_msgTypes[104]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *QueryInsightsPromptData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryInsightsPromptData) ProtoMessage() {}
-
-func (x *QueryInsightsPromptData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[104]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use QueryInsightsPromptData.ProtoReflect.Descriptor instead.
-func (*QueryInsightsPromptData) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *QueryInsightsPromptData) GetSql() string {
-	if x != nil {
-		return x.Sql
-	}
-	return ""
-}
-
-func (x *QueryInsightsPromptData) GetSummary() string {
-	if x != nil {
-		return x.Summary
-	}
-	return ""
-}
-
-type ListInsightsEventSchemasRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListInsightsEventSchemasRequest) Reset() {
-	*x = ListInsightsEventSchemasRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[105]
+type UpdateOutboundWebhookRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId                string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Url                      *string                `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Events                   []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Filter                   *string                `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	DurationThresholdSeconds *int64                 `protobuf:"varint,5,opt,name=duration_threshold_seconds,json=durationThresholdSeconds,proto3,oneof" json:"duration_threshold_seconds,omitempty"`
+	Disabled                 *bool                  `protobuf:"varint,6,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UpdateOutboundWebhookRequest) Reset() {
+	*x = UpdateOutboundWebhookRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInsightsEventSchemasRequest) String() string {
+func (x *UpdateOutboundWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInsightsEventSchemasRequest) ProtoMessage() {}
+func (*UpdateOutboundWebhookRequest) ProtoMessage() {}
 
-func (x *ListInsightsEventSchemasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[105]
+func (x *UpdateOutboundWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6941,49 +11594,76 @@ func (x *ListInsightsEventSchemasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInsightsEventSchemasRequest.ProtoReflect.Descriptor instead.
-func (*ListInsightsEventSchemasRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use UpdateOutboundWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOutboundWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *ListInsightsEventSchemasRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *UpdateOutboundWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *ListInsightsEventSchemasRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *UpdateOutboundWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateOutboundWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateOutboundWebhookRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *UpdateOutboundWebhookRequest) GetDurationThresholdSeconds() int64 {
+	if x != nil && x.DurationThresholdSeconds != nil {
+		return *x.DurationThresholdSeconds
 	}
 	return 0
 }
 
-type ListInsightsEventSchemasResponse struct {
+func (x *UpdateOutboundWebhookRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+type UpdateOutboundWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*InsightsEventSchema `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          *OutboundWebhook       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInsightsEventSchemasResponse) Reset() {
-	*x = ListInsightsEventSchemasResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[106]
+func (x *UpdateOutboundWebhookResponse) Reset() {
+	*x = UpdateOutboundWebhookResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInsightsEventSchemasResponse) String() string {
+func (x *UpdateOutboundWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInsightsEventSchemasResponse) ProtoMessage() {}
+func (*UpdateOutboundWebhookResponse) ProtoMessage() {}
 
-func (x *ListInsightsEventSchemasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[106]
+func (x *UpdateOutboundWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6994,55 +11674,47 @@ func (x *ListInsightsEventSchemasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInsightsEventSchemasResponse.ProtoReflect.Descriptor instead.
-func (*ListInsightsEventSchemasResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use UpdateOutboundWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOutboundWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *ListInsightsEventSchemasResponse) GetData() []*InsightsEventSchema {
+func (x *UpdateOutboundWebhookResponse) GetData() *OutboundWebhook {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListInsightsEventSchemasResponse) GetMetadata() *ResponseMetadata {
+func (x *UpdateOutboundWebhookResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListInsightsEventSchemasResponse) GetPage() *Page {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-type InsightsEventSchema struct {
+type DeleteOutboundWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Schema        *structpb.Struct       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsightsEventSchema) Reset() {
-	*x = InsightsEventSchema{}
-	mi := &file_api_v2_service_proto_msgTypes[107]
+func (x *DeleteOutboundWebhookRequest) Reset() {
+	*x = DeleteOutboundWebhookRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsightsEventSchema) String() string {
+func (x *DeleteOutboundWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsightsEventSchema) ProtoMessage() {}
+func (*DeleteOutboundWebhookRequest) ProtoMessage() {}
 
-func (x *InsightsEventSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[107]
+func (x *DeleteOutboundWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7053,52 +11725,40 @@ func (x *InsightsEventSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsightsEventSchema.ProtoReflect.Descriptor instead.
-func (*InsightsEventSchema) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use DeleteOutboundWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOutboundWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *InsightsEventSchema) GetName() string {
+func (x *DeleteOutboundWebhookRequest) GetWebhookId() string {
 	if x != nil {
-		return x.Name
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *InsightsEventSchema) GetSchema() *structpb.Struct {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
-}
-
-type ListExperimentsRequest struct {
+type DeleteOutboundWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	FunctionId    string                 `protobuf:"bytes,4,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExperimentsRequest) Reset() {
-	*x = ListExperimentsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[108]
+func (x *DeleteOutboundWebhookResponse) Reset() {
+	*x = DeleteOutboundWebhookResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExperimentsRequest) String() string {
+func (x *DeleteOutboundWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExperimentsRequest) ProtoMessage() {}
+func (*DeleteOutboundWebhookResponse) ProtoMessage() {}
 
-func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[108]
+func (x *DeleteOutboundWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7109,77 +11769,102 @@ func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExperimentsRequest.ProtoReflect.Descriptor instead.
-func (*ListExperimentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use DeleteOutboundWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOutboundWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *ListExperimentsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *DeleteOutboundWebhookResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExperimentsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
+type ListOutboundWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExperimentsRequest) GetAppId() string {
+func (x *ListOutboundWebhookDeliveriesRequest) Reset() {
+	*x = ListOutboundWebhookDeliveriesRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutboundWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboundWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListOutboundWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[177]
 	if x != nil {
-		return x.AppId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListExperimentsRequest) GetFunctionId() string {
+// Deprecated: Use ListOutboundWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListOutboundWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ListOutboundWebhookDeliveriesRequest) GetWebhookId() string {
 	if x != nil {
-		return x.FunctionId
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *ListExperimentsRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
+func (x *ListOutboundWebhookDeliveriesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
 	}
-	return nil
+	return ""
 }
 
-func (x *ListExperimentsRequest) GetUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Until
+func (x *ListOutboundWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return nil
+	return 0
 }
 
-type ListExperimentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Experiment          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+type ListOutboundWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Data          []*OutboundWebhookDelivery `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata          `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Page          *Page                      `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExperimentsResponse) Reset() {
-	*x = ListExperimentsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[109]
+func (x *ListOutboundWebhookDeliveriesResponse) Reset() {
+	*x = ListOutboundWebhookDeliveriesResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExperimentsResponse) String() string {
+func (x *ListOutboundWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExperimentsResponse) ProtoMessage() {}
+func (*ListOutboundWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[109]
+func (x *ListOutboundWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7190,61 +11875,55 @@ func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExperimentsResponse.ProtoReflect.Descriptor instead.
-func (*ListExperimentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use ListOutboundWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListOutboundWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *ListExperimentsResponse) GetData() []*Experiment {
+func (x *ListOutboundWebhookDeliveriesResponse) GetData() []*OutboundWebhookDelivery {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListExperimentsResponse) GetMetadata() *ResponseMetadata {
+func (x *ListOutboundWebhookDeliveriesResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListExperimentsResponse) GetPage() *Page {
+func (x *ListOutboundWebhookDeliveriesResponse) GetPage() *Page {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type Experiment struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Function          *FunctionRef           `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
-	SelectionStrategy string                 `protobuf:"bytes,3,opt,name=selection_strategy,json=selectionStrategy,proto3" json:"selection_strategy,omitempty"`
-	Variants          []string               `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
-	VariantCount      int32                  `protobuf:"varint,5,opt,name=variant_count,json=variantCount,proto3" json:"variant_count,omitempty"`
-	TotalRuns         int32                  `protobuf:"varint,6,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
-	FirstSeen         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
-	LastSeen          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ReplayOutboundWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Experiment) Reset() {
-	*x = Experiment{}
-	mi := &file_api_v2_service_proto_msgTypes[110]
+func (x *ReplayOutboundWebhookDeliveryRequest) Reset() {
+	*x = ReplayOutboundWebhookDeliveryRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Experiment) String() string {
+func (x *ReplayOutboundWebhookDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Experiment) ProtoMessage() {}
+func (*ReplayOutboundWebhookDeliveryRequest) ProtoMessage() {}
 
-func (x *Experiment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[110]
+func (x *ReplayOutboundWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7255,94 +11934,107 @@ func (x *Experiment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
-func (*Experiment) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use ReplayOutboundWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayOutboundWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *Experiment) GetId() string {
+func (x *ReplayOutboundWebhookDeliveryRequest) GetWebhookId() string {
 	if x != nil {
-		return x.Id
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *Experiment) GetFunction() *FunctionRef {
+func (x *ReplayOutboundWebhookDeliveryRequest) GetDeliveryId() string {
 	if x != nil {
-		return x.Function
+		return x.DeliveryId
 	}
-	return nil
+	return ""
 }
 
-func (x *Experiment) GetSelectionStrategy() string {
-	if x != nil {
-		return x.SelectionStrategy
-	}
-	return ""
+type ReplayOutboundWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Data          *OutboundWebhookDelivery `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Experiment) GetVariants() []string {
-	if x != nil {
-		return x.Variants
-	}
-	return nil
+func (x *ReplayOutboundWebhookDeliveryResponse) Reset() {
+	*x = ReplayOutboundWebhookDeliveryResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Experiment) GetVariantCount() int32 {
-	if x != nil {
-		return x.VariantCount
-	}
-	return 0
+func (x *ReplayOutboundWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Experiment) GetTotalRuns() int32 {
+func (*ReplayOutboundWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *ReplayOutboundWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[180]
 	if x != nil {
-		return x.TotalRuns
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Experiment) GetFirstSeen() *timestamppb.Timestamp {
+// Deprecated: Use ReplayOutboundWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayOutboundWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ReplayOutboundWebhookDeliveryResponse) GetData() *OutboundWebhookDelivery {
 	if x != nil {
-		return x.FirstSeen
+		return x.Data
 	}
 	return nil
 }
 
-func (x *Experiment) GetLastSeen() *timestamppb.Timestamp {
+func (x *ReplayOutboundWebhookDeliveryResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.LastSeen
+		return x.Metadata
 	}
 	return nil
 }
 
-type GetExperimentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FunctionId    string                 `protobuf:"bytes,1,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
-	ExperimentId  string                 `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
-	Variant       *string                `protobuf:"bytes,3,opt,name=variant,proto3,oneof" json:"variant,omitempty"`
-	AppId         string                 `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetExperimentRequest) Reset() {
-	*x = GetExperimentRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[111]
+type OutboundWebhook struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                      string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events                   []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Filter                   *string                `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	DurationThresholdSeconds *int64                 `protobuf:"varint,5,opt,name=duration_threshold_seconds,json=durationThresholdSeconds,proto3,oneof" json:"duration_threshold_seconds,omitempty"`
+	Disabled                 bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Secret                   *string                `protobuf:"bytes,7,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	CreatedAt                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *OutboundWebhook) Reset() {
+	*x = OutboundWebhook{}
+	mi := &file_api_v2_service_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExperimentRequest) String() string {
+func (x *OutboundWebhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExperimentRequest) ProtoMessage() {}
+func (*OutboundWebhook) ProtoMessage() {}
 
-func (x *GetExperimentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[111]
+func (x *OutboundWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7353,76 +12045,106 @@ func (x *GetExperimentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExperimentRequest.ProtoReflect.Descriptor instead.
-func (*GetExperimentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use OutboundWebhook.ProtoReflect.Descriptor instead.
+func (*OutboundWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *GetExperimentRequest) GetFunctionId() string {
+func (x *OutboundWebhook) GetId() string {
 	if x != nil {
-		return x.FunctionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetExperimentRequest) GetExperimentId() string {
+func (x *OutboundWebhook) GetUrl() string {
 	if x != nil {
-		return x.ExperimentId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *GetExperimentRequest) GetVariant() string {
-	if x != nil && x.Variant != nil {
-		return *x.Variant
+func (x *OutboundWebhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *OutboundWebhook) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
 	}
 	return ""
 }
 
-func (x *GetExperimentRequest) GetAppId() string {
+func (x *OutboundWebhook) GetDurationThresholdSeconds() int64 {
+	if x != nil && x.DurationThresholdSeconds != nil {
+		return *x.DurationThresholdSeconds
+	}
+	return 0
+}
+
+func (x *OutboundWebhook) GetDisabled() bool {
 	if x != nil {
-		return x.AppId
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *OutboundWebhook) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
 	}
 	return ""
 }
 
-func (x *GetExperimentRequest) GetFrom() *timestamppb.Timestamp {
+func (x *OutboundWebhook) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.From
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GetExperimentRequest) GetUntil() *timestamppb.Timestamp {
+func (x *OutboundWebhook) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Until
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type GetExperimentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *ExperimentDetail      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+type OutboundWebhookDelivery struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Id            string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId     string                            `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	RunId         *string                           `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`
+	Event         string                            `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Status        OutboundWebhookDeliveryStatus     `protobuf:"varint,5,opt,name=status,proto3,enum=api.v2.OutboundWebhookDeliveryStatus" json:"status,omitempty"`
+	Payload       string                            `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts      []*OutboundWebhookDeliveryAttempt `protobuf:"bytes,7,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp            `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3,oneof" json:"next_attempt_at,omitempty"`
+	ReplayOf      *string                           `protobuf:"bytes,9,opt,name=replay_of,json=replayOf,proto3,oneof" json:"replay_of,omitempty"`
+	CreatedAt     *timestamppb.Timestamp            `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp            `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExperimentResponse) Reset() {
-	*x = GetExperimentResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[112]
+func (x *OutboundWebhookDelivery) Reset() {
+	*x = OutboundWebhookDelivery{}
+	mi := &file_api_v2_service_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExperimentResponse) String() string {
+func (x *OutboundWebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExperimentResponse) ProtoMessage() {}
+func (*OutboundWebhookDelivery) ProtoMessage() {}
 
-func (x *GetExperimentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[112]
+func (x *OutboundWebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7433,133 +12155,113 @@ func (x *GetExperimentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExperimentResponse.ProtoReflect.Descriptor instead.
-func (*GetExperimentResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use OutboundWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*OutboundWebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *GetExperimentResponse) GetData() *ExperimentDetail {
+func (x *OutboundWebhookDelivery) GetId() string {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetExperimentResponse) GetMetadata() *ResponseMetadata {
+func (x *OutboundWebhookDelivery) GetWebhookId() string {
 	if x != nil {
-		return x.Metadata
+		return x.WebhookId
 	}
-	return nil
-}
-
-type ExperimentDetail struct {
-	state             protoimpl.MessageState      `protogen:"open.v1"`
-	Id                string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Variants          []*ExperimentVariantMetrics `protobuf:"bytes,2,rep,name=variants,proto3" json:"variants,omitempty"`
-	VariantWeights    []*ExperimentVariantWeight  `protobuf:"bytes,3,rep,name=variant_weights,json=variantWeights,proto3" json:"variant_weights,omitempty"`
-	FirstSeen         *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
-	LastSeen          *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	SelectionStrategy string                      `protobuf:"bytes,6,opt,name=selection_strategy,json=selectionStrategy,proto3" json:"selection_strategy,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *ExperimentDetail) Reset() {
-	*x = ExperimentDetail{}
-	mi := &file_api_v2_service_proto_msgTypes[113]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ExperimentDetail) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OutboundWebhookDelivery) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
 }
 
-func (*ExperimentDetail) ProtoMessage() {}
-
-func (x *ExperimentDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[113]
+func (x *OutboundWebhookDelivery) GetEvent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Event
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ExperimentDetail.ProtoReflect.Descriptor instead.
-func (*ExperimentDetail) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{113}
+func (x *OutboundWebhookDelivery) GetStatus() OutboundWebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OutboundWebhookDeliveryStatus_OUTBOUND_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
 }
 
-func (x *ExperimentDetail) GetId() string {
+func (x *OutboundWebhookDelivery) GetPayload() string {
 	if x != nil {
-		return x.Id
+		return x.Payload
 	}
 	return ""
 }
 
-func (x *ExperimentDetail) GetVariants() []*ExperimentVariantMetrics {
+func (x *OutboundWebhookDelivery) GetAttempts() []*OutboundWebhookDeliveryAttempt {
 	if x != nil {
-		return x.Variants
+		return x.Attempts
 	}
 	return nil
 }
 
-func (x *ExperimentDetail) GetVariantWeights() []*ExperimentVariantWeight {
+func (x *OutboundWebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VariantWeights
+		return x.NextAttemptAt
 	}
 	return nil
 }
 
-func (x *ExperimentDetail) GetFirstSeen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FirstSeen
+func (x *OutboundWebhookDelivery) GetReplayOf() string {
+	if x != nil && x.ReplayOf != nil {
+		return *x.ReplayOf
 	}
-	return nil
+	return ""
 }
 
-func (x *ExperimentDetail) GetLastSeen() *timestamppb.Timestamp {
+func (x *OutboundWebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastSeen
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ExperimentDetail) GetSelectionStrategy() string {
+func (x *OutboundWebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SelectionStrategy
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type ExperimentVariantMetrics struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	VariantName   string                     `protobuf:"bytes,1,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
-	RunCount      int32                      `protobuf:"varint,2,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
-	Metrics       []*ExperimentVariantMetric `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+type OutboundWebhookDeliveryAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttemptedAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	StatusCode    *int32                 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExperimentVariantMetrics) Reset() {
-	*x = ExperimentVariantMetrics{}
-	mi := &file_api_v2_service_proto_msgTypes[114]
+func (x *OutboundWebhookDeliveryAttempt) Reset() {
+	*x = OutboundWebhookDeliveryAttempt{}
+	mi := &file_api_v2_service_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExperimentVariantMetrics) String() string {
+func (x *OutboundWebhookDeliveryAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentVariantMetrics) ProtoMessage() {}
+func (*OutboundWebhookDeliveryAttempt) ProtoMessage() {}
 
-func (x *ExperimentVariantMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[114]
+func (x *OutboundWebhookDeliveryAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7570,57 +12272,68 @@ func (x *ExperimentVariantMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentVariantMetrics.ProtoReflect.Descriptor instead.
-func (*ExperimentVariantMetrics) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use OutboundWebhookDeliveryAttempt.ProtoReflect.Descriptor instead.
+func (*OutboundWebhookDeliveryAttempt) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *ExperimentVariantMetrics) GetVariantName() string {
+func (x *OutboundWebhookDeliveryAttempt) GetAttemptedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VariantName
+		return x.AttemptedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ExperimentVariantMetrics) GetRunCount() int32 {
-	if x != nil {
-		return x.RunCount
+func (x *OutboundWebhookDeliveryAttempt) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
 	}
 	return 0
 }
 
-func (x *ExperimentVariantMetrics) GetMetrics() []*ExperimentVariantMetric {
+func (x *OutboundWebhookDeliveryAttempt) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *OutboundWebhookDeliveryAttempt) GetDurationMs() int64 {
 	if x != nil {
-		return x.Metrics
+		return x.DurationMs
 	}
-	return nil
+	return 0
 }
 
-type ExperimentVariantMetric struct {
+type CreateAlertRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Avg           float64                `protobuf:"fixed64,2,opt,name=avg,proto3" json:"avg,omitempty"`
-	Min           float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
-	Max           float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	Metric        string                 `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowSeconds *int64                 `protobuf:"varint,5,opt,name=window_seconds,json=windowSeconds,proto3,oneof" json:"window_seconds,omitempty"`
+	SkipReason    *string                `protobuf:"bytes,6,opt,name=skip_reason,json=skipReason,proto3,oneof" json:"skip_reason,omitempty"`
+	Channels      []string               `protobuf:"bytes,7,rep,name=channels,proto3" json:"channels,omitempty"`
+	Disabled      *bool                  `protobuf:"varint,8,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExperimentVariantMetric) Reset() {
-	*x = ExperimentVariantMetric{}
-	mi := &file_api_v2_service_proto_msgTypes[115]
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExperimentVariantMetric) String() string {
+func (x *CreateAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentVariantMetric) ProtoMessage() {}
+func (*CreateAlertRuleRequest) ProtoMessage() {}
 
-func (x *ExperimentVariantMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[115]
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7631,62 +12344,90 @@ func (x *ExperimentVariantMetric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentVariantMetric.ProtoReflect.Descriptor instead.
-func (*ExperimentVariantMetric) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *ExperimentVariantMetric) GetKey() string {
+func (x *CreateAlertRuleRequest) GetName() string {
 	if x != nil {
-		return x.Key
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExperimentVariantMetric) GetAvg() float64 {
+func (x *CreateAlertRuleRequest) GetFunctionId() string {
 	if x != nil {
-		return x.Avg
+		return x.FunctionId
 	}
-	return 0
+	return ""
 }
 
-func (x *ExperimentVariantMetric) GetMin() float64 {
+func (x *CreateAlertRuleRequest) GetMetric() string {
 	if x != nil {
-		return x.Min
+		return x.Metric
 	}
-	return 0
+	return ""
 }
 
-func (x *ExperimentVariantMetric) GetMax() float64 {
+func (x *CreateAlertRuleRequest) GetThreshold() float64 {
 	if x != nil {
-		return x.Max
+		return x.Threshold
 	}
 	return 0
 }
 
-type ExperimentVariantWeight struct {
+func (x *CreateAlertRuleRequest) GetWindowSeconds() int64 {
+	if x != nil && x.WindowSeconds != nil {
+		return *x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetSkipReason() string {
+	if x != nil && x.SkipReason != nil {
+		return *x.SkipReason
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CreateAlertRuleRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+type CreateAlertRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VariantName   string                 `protobuf:"bytes,1,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
-	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExperimentVariantWeight) Reset() {
-	*x = ExperimentVariantWeight{}
-	mi := &file_api_v2_service_proto_msgTypes[116]
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExperimentVariantWeight) String() string {
+func (x *CreateAlertRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentVariantWeight) ProtoMessage() {}
+func (*CreateAlertRuleResponse) ProtoMessage() {}
 
-func (x *ExperimentVariantWeight) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[116]
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7697,49 +12438,84 @@ func (x *ExperimentVariantWeight) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentVariantWeight.ProtoReflect.Descriptor instead.
-func (*ExperimentVariantWeight) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *ExperimentVariantWeight) GetVariantName() string {
+func (x *CreateAlertRuleResponse) GetData() *AlertRule {
 	if x != nil {
-		return x.VariantName
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ExperimentVariantWeight) GetWeight() float64 {
+func (x *CreateAlertRuleResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Weight
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type ListSessionKeysRequest struct {
+type ListAlertRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *string                `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
-	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionKeysRequest) Reset() {
-	*x = ListSessionKeysRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[117]
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{186}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*AlertRule           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionKeysRequest) String() string {
+func (x *ListAlertRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionKeysRequest) ProtoMessage() {}
+func (*ListAlertRulesResponse) ProtoMessage() {}
 
-func (x *ListSessionKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[117]
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7750,56 +12526,47 @@ func (x *ListSessionKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionKeysRequest.ProtoReflect.Descriptor instead.
-func (*ListSessionKeysRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{117}
-}
-
-func (x *ListSessionKeysRequest) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
-	}
-	return ""
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *ListSessionKeysRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *ListAlertRulesResponse) GetData() []*AlertRule {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ListSessionKeysRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *ListAlertRulesResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type ListSessionKeysResponse struct {
+type GetAlertRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SessionKey          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionKeysResponse) Reset() {
-	*x = ListSessionKeysResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[118]
+func (x *GetAlertRuleRequest) Reset() {
+	*x = GetAlertRuleRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionKeysResponse) String() string {
+func (x *GetAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionKeysResponse) ProtoMessage() {}
+func (*GetAlertRuleRequest) ProtoMessage() {}
 
-func (x *ListSessionKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[118]
+func (x *GetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7810,55 +12577,41 @@ func (x *ListSessionKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionKeysResponse.ProtoReflect.Descriptor instead.
-func (*ListSessionKeysResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{118}
-}
-
-func (x *ListSessionKeysResponse) GetData() []*SessionKey {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *ListSessionKeysResponse) GetMetadata() *ResponseMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use GetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *ListSessionKeysResponse) GetPage() *Page {
+func (x *GetAlertRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.Page
+		return x.RuleId
 	}
-	return nil
+	return ""
 }
 
-type SessionKey struct {
+type GetAlertRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionKey) Reset() {
-	*x = SessionKey{}
-	mi := &file_api_v2_service_proto_msgTypes[119]
+func (x *GetAlertRuleResponse) Reset() {
+	*x = GetAlertRuleResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionKey) String() string {
+func (x *GetAlertRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionKey) ProtoMessage() {}
+func (*GetAlertRuleResponse) ProtoMessage() {}
 
-func (x *SessionKey) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[119]
+func (x *GetAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7869,52 +12622,53 @@ func (x *SessionKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionKey.ProtoReflect.Descriptor instead.
-func (*SessionKey) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use GetAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *SessionKey) GetId() string {
+func (x *GetAlertRuleResponse) GetData() *AlertRule {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *SessionKey) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetAlertRuleResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.CreatedAt
+		return x.Metadata
 	}
 	return nil
 }
 
-type ListSessionsRequest struct {
+type UpdateAlertRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionKey    string                 `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
-	Search        *string                `protobuf:"bytes,2,opt,name=search,proto3,oneof" json:"search,omitempty"`
-	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Threshold     *float64               `protobuf:"fixed64,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	WindowSeconds *int64                 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3,oneof" json:"window_seconds,omitempty"`
+	SkipReason    *string                `protobuf:"bytes,5,opt,name=skip_reason,json=skipReason,proto3,oneof" json:"skip_reason,omitempty"`
+	Channels      []string               `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels,omitempty"`
+	Disabled      *bool                  `protobuf:"varint,7,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionsRequest) Reset() {
-	*x = ListSessionsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[120]
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsRequest) String() string {
+func (x *UpdateAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionsRequest) ProtoMessage() {}
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
 
-func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[120]
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7925,77 +12679,83 @@ func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *ListSessionsRequest) GetSessionKey() string {
+func (x *UpdateAlertRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.SessionKey
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *ListSessionsRequest) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
+func (x *UpdateAlertRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *ListSessionsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *UpdateAlertRuleRequest) GetThreshold() float64 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (x *ListSessionsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *UpdateAlertRuleRequest) GetWindowSeconds() int64 {
+	if x != nil && x.WindowSeconds != nil {
+		return *x.WindowSeconds
 	}
 	return 0
 }
 
-func (x *ListSessionsRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
+func (x *UpdateAlertRuleRequest) GetSkipReason() string {
+	if x != nil && x.SkipReason != nil {
+		return *x.SkipReason
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSessionsRequest) GetUntil() *timestamppb.Timestamp {
+func (x *UpdateAlertRuleRequest) GetChannels() []string {
 	if x != nil {
-		return x.Until
+		return x.Channels
 	}
 	return nil
 }
 
-type ListSessionsResponse struct {
+func (x *UpdateAlertRuleRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+type UpdateAlertRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SessionGroup        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionsResponse) Reset() {
-	*x = ListSessionsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[121]
+func (x *UpdateAlertRuleResponse) Reset() {
+	*x = UpdateAlertRuleResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsResponse) String() string {
+func (x *UpdateAlertRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionsResponse) ProtoMessage() {}
+func (*UpdateAlertRuleResponse) ProtoMessage() {}
 
-func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[121]
+func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8006,59 +12766,47 @@ func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *ListSessionsResponse) GetData() []*SessionGroup {
+func (x *UpdateAlertRuleResponse) GetData() *AlertRule {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListSessionsResponse) GetMetadata() *ResponseMetadata {
+func (x *UpdateAlertRuleResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListSessionsResponse) GetPage() *Page {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-type SessionGroup struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RunCount       int32                  `protobuf:"varint,2,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
-	FailedRunCount int32                  `protobuf:"varint,3,opt,name=failed_run_count,json=failedRunCount,proto3" json:"failed_run_count,omitempty"`
-	FailureRate    float64                `protobuf:"fixed64,4,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
-	LastActiveAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
-	Functions      []*FunctionRef         `protobuf:"bytes,6,rep,name=functions,proto3" json:"functions,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionGroup) Reset() {
-	*x = SessionGroup{}
-	mi := &file_api_v2_service_proto_msgTypes[122]
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionGroup) String() string {
+func (x *DeleteAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionGroup) ProtoMessage() {}
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
 
-func (x *SessionGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[122]
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8069,80 +12817,86 @@ func (x *SessionGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionGroup.ProtoReflect.Descriptor instead.
-func (*SessionGroup) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *SessionGroup) GetId() string {
+func (x *DeleteAlertRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.Id
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *SessionGroup) GetRunCount() int32 {
-	if x != nil {
-		return x.RunCount
-	}
-	return 0
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionGroup) GetFailedRunCount() int32 {
-	if x != nil {
-		return x.FailedRunCount
-	}
-	return 0
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionGroup) GetFailureRate() float64 {
-	if x != nil {
-		return x.FailureRate
-	}
-	return 0
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionGroup) GetLastActiveAt() *timestamppb.Timestamp {
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[193]
 	if x != nil {
-		return x.LastActiveAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SessionGroup) GetFunctions() []*FunctionRef {
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *DeleteAlertRuleResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Functions
+		return x.Metadata
 	}
 	return nil
 }
 
-type ListSessionRunsRequest struct {
+type ListAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionKey    string                 `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	RuleId        *string                `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionRunsRequest) Reset() {
-	*x = ListSessionRunsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[123]
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionRunsRequest) String() string {
+func (x *ListAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionRunsRequest) ProtoMessage() {}
+func (*ListAlertsRequest) ProtoMessage() {}
 
-func (x *ListSessionRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[123]
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8153,77 +12907,56 @@ func (x *ListSessionRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListSessionRunsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{123}
-}
-
-func (x *ListSessionRunsRequest) GetSessionKey() string {
-	if x != nil {
-		return x.SessionKey
-	}
-	return ""
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *ListSessionRunsRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
+func (x *ListAlertsRequest) GetRuleId() string {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
 	}
 	return ""
 }
 
-func (x *ListSessionRunsRequest) GetCursor() string {
+func (x *ListAlertsRequest) GetCursor() string {
 	if x != nil && x.Cursor != nil {
 		return *x.Cursor
 	}
 	return ""
 }
 
-func (x *ListSessionRunsRequest) GetLimit() int32 {
+func (x *ListAlertsRequest) GetLimit() int32 {
 	if x != nil && x.Limit != nil {
 		return *x.Limit
 	}
-	return 0
-}
-
-func (x *ListSessionRunsRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *ListSessionRunsRequest) GetUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Until
-	}
-	return nil
+	return 0
 }
 
-type ListSessionRunsResponse struct {
+type ListAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SessionRun          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*Alert               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionRunsResponse) Reset() {
-	*x = ListSessionRunsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[124]
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionRunsResponse) String() string {
+func (x *ListAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionRunsResponse) ProtoMessage() {}
+func (*ListAlertsResponse) ProtoMessage() {}
 
-func (x *ListSessionRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[124]
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8234,60 +12967,69 @@ func (x *ListSessionRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListSessionRunsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *ListSessionRunsResponse) GetData() []*SessionRun {
+func (x *ListAlertsResponse) GetData() []*Alert {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListSessionRunsResponse) GetMetadata() *ResponseMetadata {
+func (x *ListAlertsResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListSessionRunsResponse) GetPage() *Page {
+func (x *ListAlertsResponse) GetPage() *Page {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type SessionRun struct {
+type AlertRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Function      *FunctionRef           `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
-	EventName     *string                `protobuf:"bytes,3,opt,name=event_name,json=eventName,proto3,oneof" json:"event_name,omitempty"`
-	Status        FunctionRunStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=api.v2.FunctionRunStatus" json:"status,omitempty"`
-	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
-	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,3,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	FunctionSlug  string                 `protobuf:"bytes,4,opt,name=function_slug,json=functionSlug,proto3" json:"function_slug,omitempty"`
+	Metric        string                 `protobuf:"bytes,5,opt,name=metric,proto3" json:"metric,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowSeconds *int64                 `protobuf:"varint,7,opt,name=window_seconds,json=windowSeconds,proto3,oneof" json:"window_seconds,omitempty"`
+	SkipReason    *string                `protobuf:"bytes,8,opt,name=skip_reason,json=skipReason,proto3,oneof" json:"skip_reason,omitempty"`
+	Channels      []string               `protobuf:"bytes,9,rep,name=channels,proto3" json:"channels,omitempty"`
+	Disabled      bool                   `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Status        AlertStatus            `protobuf:"varint,11,opt,name=status,proto3,enum=api.v2.AlertStatus" json:"status,omitempty"`
+	AlertId       *string                `protobuf:"bytes,12,opt,name=alert_id,json=alertId,proto3,oneof" json:"alert_id,omitempty"`
+	Value         *float64               `protobuf:"fixed64,13,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	EvaluatedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=evaluated_at,json=evaluatedAt,proto3,oneof" json:"evaluated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionRun) Reset() {
-	*x = SessionRun{}
-	mi := &file_api_v2_service_proto_msgTypes[125]
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_api_v2_service_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionRun) String() string {
+func (x *AlertRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionRun) ProtoMessage() {}
+func (*AlertRule) ProtoMessage() {}
 
-func (x *SessionRun) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[125]
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8298,216 +13040,156 @@ func (x *SessionRun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionRun.ProtoReflect.Descriptor instead.
-func (*SessionRun) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *SessionRun) GetId() string {
+func (x *AlertRule) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SessionRun) GetFunction() *FunctionRef {
+func (x *AlertRule) GetName() string {
 	if x != nil {
-		return x.Function
-	}
-	return nil
-}
-
-func (x *SessionRun) GetEventName() string {
-	if x != nil && x.EventName != nil {
-		return *x.EventName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SessionRun) GetStatus() FunctionRunStatus {
-	if x != nil {
-		return x.Status
-	}
-	return FunctionRunStatus_FUNCTION_RUN_STATUS_UNSPECIFIED
-}
-
-func (x *SessionRun) GetQueuedAt() *timestamppb.Timestamp {
+func (x *AlertRule) GetFunctionId() string {
 	if x != nil {
-		return x.QueuedAt
+		return x.FunctionId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionRun) GetStartedAt() *timestamppb.Timestamp {
+func (x *AlertRule) GetFunctionSlug() string {
 	if x != nil {
-		return x.StartedAt
+		return x.FunctionSlug
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionRun) GetEndedAt() *timestamppb.Timestamp {
+func (x *AlertRule) GetMetric() string {
 	if x != nil {
-		return x.EndedAt
+		return x.Metric
 	}
-	return nil
-}
-
-type ListRunsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IncludeOutput *bool                  `protobuf:"varint,1,opt,name=include_output,json=includeOutput,proto3,oneof" json:"include_output,omitempty"`
-	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
-	TimeField     string                 `protobuf:"bytes,6,opt,name=time_field,json=timeField,proto3" json:"time_field,omitempty"`
-	Status        []string               `protobuf:"bytes,7,rep,name=status,proto3" json:"status,omitempty"`
-	AppId         []string               `protobuf:"bytes,8,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	FunctionId    []string               `protobuf:"bytes,9,rep,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
-	IsDeferred    *bool                  `protobuf:"varint,10,opt,name=is_deferred,json=isDeferred,proto3,oneof" json:"is_deferred,omitempty"`
-	Order         string                 `protobuf:"bytes,11,opt,name=order,proto3" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListRunsRequest) Reset() {
-	*x = ListRunsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[126]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListRunsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListRunsRequest) ProtoMessage() {}
-
-func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[126]
+func (x *AlertRule) GetThreshold() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Threshold
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListRunsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{126}
+	return 0
 }
 
-func (x *ListRunsRequest) GetIncludeOutput() bool {
-	if x != nil && x.IncludeOutput != nil {
-		return *x.IncludeOutput
+func (x *AlertRule) GetWindowSeconds() int64 {
+	if x != nil && x.WindowSeconds != nil {
+		return *x.WindowSeconds
 	}
-	return false
+	return 0
 }
 
-func (x *ListRunsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *AlertRule) GetSkipReason() string {
+	if x != nil && x.SkipReason != nil {
+		return *x.SkipReason
 	}
 	return ""
 }
 
-func (x *ListRunsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *AlertRule) GetChannels() []string {
+	if x != nil {
+		return x.Channels
 	}
-	return 0
+	return nil
 }
 
-func (x *ListRunsRequest) GetFrom() *timestamppb.Timestamp {
+func (x *AlertRule) GetDisabled() bool {
 	if x != nil {
-		return x.From
+		return x.Disabled
 	}
-	return nil
+	return false
 }
 
-func (x *ListRunsRequest) GetUntil() *timestamppb.Timestamp {
+func (x *AlertRule) GetStatus() AlertStatus {
 	if x != nil {
-		return x.Until
+		return x.Status
 	}
-	return nil
+	return AlertStatus_ALERT_STATUS_UNSPECIFIED
 }
 
-func (x *ListRunsRequest) GetTimeField() string {
-	if x != nil {
-		return x.TimeField
+func (x *AlertRule) GetAlertId() string {
+	if x != nil && x.AlertId != nil {
+		return *x.AlertId
 	}
 	return ""
 }
 
-func (x *ListRunsRequest) GetStatus() []string {
-	if x != nil {
-		return x.Status
+func (x *AlertRule) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
 	}
-	return nil
+	return 0
 }
 
-func (x *ListRunsRequest) GetAppId() []string {
+func (x *AlertRule) GetEvaluatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AppId
+		return x.EvaluatedAt
 	}
 	return nil
 }
 
-func (x *ListRunsRequest) GetFunctionId() []string {
+func (x *AlertRule) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FunctionId
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ListRunsRequest) GetIsDeferred() bool {
-	if x != nil && x.IsDeferred != nil {
-		return *x.IsDeferred
-	}
-	return false
-}
-
-func (x *ListRunsRequest) GetOrder() string {
+func (x *AlertRule) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Order
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type ListFunctionRunsRequest struct {
+type Alert struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	FunctionId    string                 `protobuf:"bytes,2,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
-	IncludeOutput *bool                  `protobuf:"varint,3,opt,name=include_output,json=includeOutput,proto3,oneof" json:"include_output,omitempty"`
-	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3,oneof" json:"until,omitempty"`
-	TimeField     string                 `protobuf:"bytes,8,opt,name=time_field,json=timeField,proto3" json:"time_field,omitempty"`
-	Status        []string               `protobuf:"bytes,9,rep,name=status,proto3" json:"status,omitempty"`
-	IsDeferred    *bool                  `protobuf:"varint,10,opt,name=is_deferred,json=isDeferred,proto3,oneof" json:"is_deferred,omitempty"`
-	Order         string                 `protobuf:"bytes,11,opt,name=order,proto3" json:"order,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,4,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	FunctionSlug  string                 `protobuf:"bytes,5,opt,name=function_slug,json=functionSlug,proto3" json:"function_slug,omitempty"`
+	Metric        string                 `protobuf:"bytes,6,opt,name=metric,proto3" json:"metric,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Value         float64                `protobuf:"fixed64,8,opt,name=value,proto3" json:"value,omitempty"`
+	PeakValue     float64                `protobuf:"fixed64,9,opt,name=peak_value,json=peakValue,proto3" json:"peak_value,omitempty"`
+	Status        AlertStatus            `protobuf:"varint,10,opt,name=status,proto3,enum=api.v2.AlertStatus" json:"status,omitempty"`
+	FiredAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3,oneof" json:"resolved_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFunctionRunsRequest) Reset() {
-	*x = ListFunctionRunsRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[127]
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_api_v2_service_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFunctionRunsRequest) String() string {
+func (x *Alert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFunctionRunsRequest) ProtoMessage() {}
+func (*Alert) ProtoMessage() {}
 
-func (x *ListFunctionRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[127]
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8518,112 +13200,119 @@ func (x *ListFunctionRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFunctionRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListFunctionRunsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *ListFunctionRunsRequest) GetAppId() string {
+func (x *Alert) GetId() string {
 	if x != nil {
-		return x.AppId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListFunctionRunsRequest) GetFunctionId() string {
+func (x *Alert) GetRuleId() string {
 	if x != nil {
-		return x.FunctionId
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *ListFunctionRunsRequest) GetIncludeOutput() bool {
-	if x != nil && x.IncludeOutput != nil {
-		return *x.IncludeOutput
+func (x *Alert) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
 	}
-	return false
+	return ""
 }
 
-func (x *ListFunctionRunsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *Alert) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
 	}
 	return ""
 }
 
-func (x *ListFunctionRunsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *Alert) GetFunctionSlug() string {
+	if x != nil {
+		return x.FunctionSlug
 	}
-	return 0
+	return ""
 }
 
-func (x *ListFunctionRunsRequest) GetFrom() *timestamppb.Timestamp {
+func (x *Alert) GetMetric() string {
 	if x != nil {
-		return x.From
+		return x.Metric
 	}
-	return nil
+	return ""
 }
 
-func (x *ListFunctionRunsRequest) GetUntil() *timestamppb.Timestamp {
+func (x *Alert) GetThreshold() float64 {
 	if x != nil {
-		return x.Until
+		return x.Threshold
 	}
-	return nil
+	return 0
 }
 
-func (x *ListFunctionRunsRequest) GetTimeField() string {
+func (x *Alert) GetValue() float64 {
 	if x != nil {
-		return x.TimeField
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *ListFunctionRunsRequest) GetStatus() []string {
+func (x *Alert) GetPeakValue() float64 {
+	if x != nil {
+		return x.PeakValue
+	}
+	return 0
+}
+
+func (x *Alert) GetStatus() AlertStatus {
 	if x != nil {
 		return x.Status
 	}
-	return nil
+	return AlertStatus_ALERT_STATUS_UNSPECIFIED
 }
 
-func (x *ListFunctionRunsRequest) GetIsDeferred() bool {
-	if x != nil && x.IsDeferred != nil {
-		return *x.IsDeferred
+func (x *Alert) GetFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiredAt
 	}
-	return false
+	return nil
 }
 
-func (x *ListFunctionRunsRequest) GetOrder() string {
+func (x *Alert) GetResolvedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Order
+		return x.ResolvedAt
 	}
-	return ""
+	return nil
 }
 
-type ListRunsResponse struct {
+type GetUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*FunctionRun         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Period        *string                `protobuf:"bytes,1,opt,name=period,proto3,oneof" json:"period,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3,oneof" json:"until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRunsResponse) Reset() {
-	*x = ListRunsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[128]
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_api_v2_service_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRunsResponse) String() string {
+func (x *GetUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRunsResponse) ProtoMessage() {}
+func (*GetUsageRequest) ProtoMessage() {}
 
-func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[128]
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8634,56 +13323,56 @@ func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListRunsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *ListRunsResponse) GetData() []*FunctionRun {
-	if x != nil {
-		return x.Data
+func (x *GetUsageRequest) GetPeriod() string {
+	if x != nil && x.Period != nil {
+		return *x.Period
 	}
-	return nil
+	return ""
 }
 
-func (x *ListRunsResponse) GetMetadata() *ResponseMetadata {
+func (x *GetUsageRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.From
 	}
 	return nil
 }
 
-func (x *ListRunsResponse) GetPage() *Page {
+func (x *GetUsageRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.Until
 	}
 	return nil
 }
 
-type ListFunctionRunsResponse struct {
+type GetUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*FunctionRun         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Data          []*UsageBucket         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Totals        *UsageTotals           `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	Metadata      *ResponseMetadata      `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFunctionRunsResponse) Reset() {
-	*x = ListFunctionRunsResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[129]
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_api_v2_service_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFunctionRunsResponse) String() string {
+func (x *GetUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFunctionRunsResponse) ProtoMessage() {}
+func (*GetUsageResponse) ProtoMessage() {}
 
-func (x *ListFunctionRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[129]
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8694,54 +13383,61 @@ func (x *ListFunctionRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFunctionRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListFunctionRunsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *ListFunctionRunsResponse) GetData() []*FunctionRun {
+func (x *GetUsageResponse) GetData() []*UsageBucket {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListFunctionRunsResponse) GetMetadata() *ResponseMetadata {
+func (x *GetUsageResponse) GetTotals() *UsageTotals {
 	if x != nil {
-		return x.Metadata
+		return x.Totals
 	}
 	return nil
 }
 
-func (x *ListFunctionRunsResponse) GetPage() *Page {
+func (x *GetUsageResponse) GetMetadata() *ResponseMetadata {
 	if x != nil {
-		return x.Page
+		return x.Metadata
 	}
 	return nil
 }
 
-type CancelRunRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UsageBucket struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Bucket         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	AppId          string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FunctionId     string                 `protobuf:"bytes,3,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	EventName      string                 `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Runs           int64                  `protobuf:"varint,5,opt,name=runs,proto3" json:"runs,omitempty"`
+	Steps          int64                  `protobuf:"varint,6,opt,name=steps,proto3" json:"steps,omitempty"`
+	Events         int64                  `protobuf:"varint,7,opt,name=events,proto3" json:"events,omitempty"`
+	StepDurationMs int64                  `protobuf:"varint,8,opt,name=step_duration_ms,json=stepDurationMs,proto3" json:"step_duration_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CancelRunRequest) Reset() {
-	*x = CancelRunRequest{}
-	mi := &file_api_v2_service_proto_msgTypes[130]
+func (x *UsageBucket) Reset() {
+	*x = UsageBucket{}
+	mi := &file_api_v2_service_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelRunRequest) String() string {
+func (x *UsageBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRunRequest) ProtoMessage() {}
+func (*UsageBucket) ProtoMessage() {}
 
-func (x *CancelRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[130]
+func (x *UsageBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8752,92 +13448,92 @@ func (x *CancelRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRunRequest.ProtoReflect.Descriptor instead.
-func (*CancelRunRequest) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use UsageBucket.ProtoReflect.Descriptor instead.
+func (*UsageBucket) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *CancelRunRequest) GetRunId() string {
+func (x *UsageBucket) GetBucket() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RunId
+		return x.Bucket
 	}
-	return ""
+	return nil
 }
 
-type CancelRunResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *CancelRunData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Metadata      *ResponseMetadata      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UsageBucket) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
 }
 
-func (x *CancelRunResponse) Reset() {
-	*x = CancelRunResponse{}
-	mi := &file_api_v2_service_proto_msgTypes[131]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UsageBucket) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
 }
 
-func (x *CancelRunResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UsageBucket) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
 }
 
-func (*CancelRunResponse) ProtoMessage() {}
-
-func (x *CancelRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[131]
+func (x *UsageBucket) GetRuns() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Runs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CancelRunResponse.ProtoReflect.Descriptor instead.
-func (*CancelRunResponse) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{131}
+func (x *UsageBucket) GetSteps() int64 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
 }
 
-func (x *CancelRunResponse) GetData() *CancelRunData {
+func (x *UsageBucket) GetEvents() int64 {
 	if x != nil {
-		return x.Data
+		return x.Events
 	}
-	return nil
+	return 0
 }
 
-func (x *CancelRunResponse) GetMetadata() *ResponseMetadata {
+func (x *UsageBucket) GetStepDurationMs() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.StepDurationMs
 	}
-	return nil
+	return 0
 }
 
-type CancelRunData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UsageTotals struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Runs           int64                  `protobuf:"varint,1,opt,name=runs,proto3" json:"runs,omitempty"`
+	Steps          int64                  `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty"`
+	Events         int64                  `protobuf:"varint,3,opt,name=events,proto3" json:"events,omitempty"`
+	StepDurationMs int64                  `protobuf:"varint,4,opt,name=step_duration_ms,json=stepDurationMs,proto3" json:"step_duration_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CancelRunData) Reset() {
-	*x = CancelRunData{}
-	mi := &file_api_v2_service_proto_msgTypes[132]
+func (x *UsageTotals) Reset() {
+	*x = UsageTotals{}
+	mi := &file_api_v2_service_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelRunData) String() string {
+func (x *UsageTotals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRunData) ProtoMessage() {}
+func (*UsageTotals) ProtoMessage() {}
 
-func (x *CancelRunData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v2_service_proto_msgTypes[132]
+func (x *UsageTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_service_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8848,16 +13544,37 @@ func (x *CancelRunData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRunData.ProtoReflect.Descriptor instead.
-func (*CancelRunData) Descriptor() ([]byte, []int) {
-	return file_api_v2_service_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use UsageTotals.ProtoReflect.Descriptor instead.
+func (*UsageTotals) Descriptor() ([]byte, []int) {
+	return file_api_v2_service_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *CancelRunData) GetRunId() string {
+func (x *UsageTotals) GetRuns() int64 {
 	if x != nil {
-		return x.RunId
+		return x.Runs
 	}
-	return ""
+	return 0
+}
+
+func (x *UsageTotals) GetSteps() int64 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetEvents() int64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetStepDurationMs() int64 {
+	if x != nil {
+		return x.StepDurationMs
+	}
+	return 0
 }
 
 var File_api_v2_service_proto protoreflect.FileDescriptor
@@ -8989,7 +13706,7 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\rcron_schedule\x18\x05 \x01(\tH\x02R\fcronSchedule\x88\x01\x01B\r\n" +
 	"\v_event_nameB\v\n" +
 	"\t_batch_idB\x10\n" +
-	"\x0e_cron_schedule\"\x99\x04\n" +
+	"\x0e_cron_schedule\"\xa0\x05\n" +
 	"\vFunctionRun\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
 	"\bfunction\x18\x02 \x01(\v2\x13.api.v2.FunctionRefR\bfunction\x12 \n" +
@@ -9003,11 +13720,13 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"durationMs\x88\x01\x01\x12,\n" +
 	"\atrigger\x18\t \x01(\v2\x12.api.v2.RunTriggerR\atrigger\x124\n" +
 	"\x06output\x18\n" +
-	" \x01(\v2\x17.google.protobuf.StructH\x03R\x06output\x88\x01\x01B\r\n" +
+	" \x01(\v2\x17.google.protobuf.StructH\x03R\x06output\x88\x01\x01\x12p\n" +
+	"\x10function_version\x18\v \x01(\x05B@\x92A=2;Version of the function's config that the run executed withH\x04R\x0ffunctionVersion\x88\x01\x01B\r\n" +
 	"\v_started_atB\v\n" +
 	"\t_ended_atB\x0e\n" +
 	"\f_duration_msB\t\n" +
-	"\a_output\"m\n" +
+	"\a_outputB\x13\n" +
+	"\x11_function_version\"m\n" +
 	"\x15GetFunctionRunRequest\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12*\n" +
 	"\x0einclude_output\x18\x02 \x01(\bH\x00R\rincludeOutput\x88\x01\x01B\x11\n" +
@@ -9160,7 +13879,39 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x14GetFunctionsResponse\x12$\n" +
 	"\x04data\x18\x01 \x03(\v2\x10.api.v2.FunctionR\x04data\x124\n" +
 	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\x12 \n" +
-	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"N\n" +
+	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"\xb0\x01\n" +
+	"\x0fFunctionVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x17\n" +
+	"\async_id\x18\x02 \x01(\tR\x06syncId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12/\n" +
+	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\"U\n" +
+	"\x1bListFunctionVersionsRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1f\n" +
+	"\vfunction_id\x18\x02 \x01(\tR\n" +
+	"functionId\"\x81\x01\n" +
+	"\x1cListFunctionVersionsResponse\x12+\n" +
+	"\x04data\x18\x01 \x03(\v2\x17.api.v2.FunctionVersionR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x90\x02\n" +
+	"\x1dGetFunctionVersionDiffRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1f\n" +
+	"\vfunction_id\x18\x02 \x01(\tR\n" +
+	"functionId\x12W\n" +
+	"\x04from\x18\x03 \x01(\x05B>\x92A;29Version to diff from, defaulting to the version before toH\x00R\x04from\x88\x01\x01\x12N\n" +
+	"\x02to\x18\x04 \x01(\x05B9\x92A624Version to diff to, defaulting to the latest versionH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\x8a\x01\n" +
+	"\x14FunctionConfigChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12.\n" +
+	"\x06before\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x06before\x12,\n" +
+	"\x05after\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05after\"q\n" +
+	"\x13FunctionVersionDiff\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x05R\x02to\x126\n" +
+	"\achanges\x18\x03 \x03(\v2\x1c.api.v2.FunctionConfigChangeR\achanges\"\x87\x01\n" +
+	"\x1eGetFunctionVersionDiffResponse\x12/\n" +
+	"\x04data\x18\x01 \x01(\v2\x1b.api.v2.FunctionVersionDiffR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"N\n" +
 	"\x14CreateAccountRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
@@ -9311,17 +14062,21 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x01(\v2\x15.api.v2.SendEventDataR\x04data\x124\n" +
 	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"t\n" +
 	"\rSendEventData\x12c\n" +
-	"\bevent_id\x18\x01 \x01(\tBH\x92AE2%Internal event ID assigned by InngestJ\x1c\"01K1QQ3VQ8R3M8QX4D51J8G7XH\"R\aeventId\"\x84\x04\n" +
+	"\bevent_id\x18\x01 \x01(\tBH\x92AE2%Internal event ID assigned by InngestJ\x1c\"01K1QQ3VQ8R3M8QX4D51J8G7XH\"R\aeventId\"\xe8\x06\n" +
 	"\x15InvokeFunctionRequest\x12\\\n" +
 	"\vfunction_id\x18\x01 \x01(\tB;\x92A82 The ID of the function to invokeJ\x14\"my-app-hello-world\"R\n" +
 	"functionId\x12\x86\x01\n" +
 	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructBY\x92AV26JSON object containing the input data for the functionJ\x1c{\"message\": \"Hello, World!\"}R\x04data\x12\x9e\x01\n" +
 	"\x0fidempotency_key\x18\x03 \x01(\tBp\x92Am2XOptional idempotency key to handle duplicate requests within a given idempotency period.J\x11\"user-action-123\"H\x00R\x0eidempotencyKey\x88\x01\x01\x12O\n" +
-	"\x06app_id\x18\x04 \x01(\tB8\x92A52)The ID of the app containing the functionJ\b\"my-app\"R\x05appIdB\x12\n" +
-	"\x10_idempotency_key\"~\n" +
+	"\x06app_id\x18\x04 \x01(\tB8\x92A52)The ID of the app containing the functionJ\b\"my-app\"R\x05appId\x12\xa9\x01\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampBe\x92Ab2`Optional time to run the function at.  Must be in the future, and cannot be combined with delay.H\x01R\vscheduledAt\x88\x01\x01\x12\x9a\x01\n" +
+	"\x05delay\x18\x06 \x01(\tB\x7f\x92A|2tOptional duration to wait before running the function, such as \"30m\" or \"2d\".  Cannot be combined with scheduled_at.J\x04\"1h\"H\x02R\x05delay\x88\x01\x01B\x12\n" +
+	"\x10_idempotency_keyB\x0f\n" +
+	"\r_scheduled_atB\b\n" +
+	"\x06_delay\"~\n" +
 	"\x16InvokeFunctionResponse\x12.\n" +
 	"\x04data\x18\x01 \x01(\v2\x1a.api.v2.InvokeFunctionDataR\x04data\x124\n" +
-	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xba\x06\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xba\t\n" +
 	"\x12InvokeFunctionData\x12g\n" +
 	"\x06run_id\x18\x01 \x01(\tBP\x92AM2-Unique identifier for this function executionJ\x1c\"01hp1zx8m3ng9vp6qn0xk7j4cy\"R\x05runId\x12p\n" +
 	"\tqueued_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB7\x92A422Timestamp when the function execution was enqueuedR\bqueuedAt\x12\x7f\n" +
@@ -9329,11 +14084,43 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB?\x92A<2:Timestamp when the function execution started (if started)H\x00R\tstartedAt\x88\x01\x01\x12\x86\x01\n" +
 	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampBB\x92A?2=Timestamp when the function execution completed (if finished)H\x01R\vcompletedAt\x88\x01\x01\x12\xb9\x01\n" +
 	"\x06result\x18\x05 \x01(\tB\x9b\x01\x92A\x97\x012aJSON string containing the function result (only available for completed synchronous invocations)J2\"{\\\"success\\\": true, \\\"data\\\": \\\"Hello, World!\\\"}\"H\x02R\x06result\x88\x01\x01\x12N\n" +
-	"\x05error\x18\x06 \x01(\tB3\x92A02.Error message if the function execution failedH\x03R\x05error\x88\x01\x01B\r\n" +
+	"\x05error\x18\x06 \x01(\tB3\x92A02.Error message if the function execution failedH\x03R\x05error\x88\x01\x01\x12\xbc\x01\n" +
+	"\vschedule_id\x18\a \x01(\tB\x95\x01\x92A\x91\x012qID of the scheduled invocation, used to cancel the invocation before it runs (only set for scheduled invocations)J\x1c\"01hp1zx8m3ng9vp6qn0xk7j4cy\"H\x04R\n" +
+	"scheduleId\x88\x01\x01\x12\x9d\x01\n" +
+	"\fscheduled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampBY\x92AV2TTimestamp when the function is scheduled to run (only set for scheduled invocations)H\x05R\vscheduledAt\x88\x01\x01B\r\n" +
 	"\v_started_atB\x0f\n" +
 	"\r_completed_atB\t\n" +
 	"\a_resultB\b\n" +
-	"\x06_error\"\x97\x04\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_schedule_idB\x0f\n" +
+	"\r_scheduled_at\"\xa5\x02\n" +
+	"\x1fListScheduledInvocationsRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1f\n" +
+	"\vfunction_id\x18\x02 \x01(\tR\n" +
+	"functionId\x12J\n" +
+	"\x06cursor\x18\x03 \x01(\tB-\x92A*2(Pagination cursor from previous responseH\x00R\x06cursor\x88\x01\x01\x12i\n" +
+	"\x05limit\x18\x04 \x01(\x05BN\x92AK2ENumber of scheduled invocations to return per page (min: 1, max: 100):\x0220H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\xab\x01\n" +
+	" ListScheduledInvocationsResponse\x12/\n" +
+	"\x04data\x18\x01 \x03(\v2\x1b.api.v2.ScheduledInvocationR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\x12 \n" +
+	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"{\n" +
+	" CancelScheduledInvocationRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1f\n" +
+	"\vfunction_id\x18\x02 \x01(\tR\n" +
+	"functionId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\"\x8a\x01\n" +
+	"!CancelScheduledInvocationResponse\x12/\n" +
+	"\x04data\x18\x01 \x01(\v2\x1b.api.v2.ScheduledInvocationR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xcc\x02\n" +
+	"\x13ScheduledInvocation\x123\n" +
+	"\x02id\x18\x01 \x01(\tB#\x92A 2\x1eID of the scheduled invocationR\x02id\x12P\n" +
+	"\x06run_id\x18\x02 \x01(\tB9\x92A624ID of the run which starts when the invocation firesR\x05runId\x12s\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB4\x92A12/Timestamp when the function is scheduled to runR\vscheduledAt\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x97\x04\n" +
 	"\x12CreateScoreRequest\x12d\n" +
 	"\x06run_id\x18\x01 \x01(\tBM\x92AJ2*Run ID (ULID) of the function run to scoreJ\x1c\"01JLW1NNJ69KCD1KMJEXAMPLE0\"R\x05runId\x12\x9a\x03\n" +
 	"\x06scores\x18\x02 \x03(\v2\x18.api.v2.CreateScoreInputB\xe7\x02\x92A\xe3\x022\xe0\x02Scores to record for the run. The REST request body is this array. Writes are applied in order and are not atomic; if a later score fails, earlier scores may already be recorded. Score writes are best-effort: run and step targets are not validated before writing, and scores for targets that do not exist may not surface in queries. Maximum 100 scores.R\x06scores\"\xe9\x05\n" +
@@ -9571,7 +14358,8 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\bended_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendedAt\x88\x01\x01B\r\n" +
 	"\v_event_nameB\r\n" +
 	"\v_started_atB\v\n" +
-	"\t_ended_at\"\xf5\b\n" +
+	"\t_ended_at\"\x84\n" +
+	"\n" +
 	"\x0fListRunsRequest\x12*\n" +
 	"\x0einclude_output\x18\x01 \x01(\bH\x00R\rincludeOutput\x88\x01\x01\x12J\n" +
 	"\x06cursor\x18\x02 \x01(\tB-\x92A*2(Pagination cursor from previous responseH\x01R\x06cursor\x88\x01\x01\x12X\n" +
@@ -9587,13 +14375,15 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\vis_deferred\x18\n" +
 	" \x01(\bB:\x92A725Whether to include only deferred or non-deferred runsH\x05R\n" +
 	"isDeferred\x88\x01\x01\x12E\n" +
-	"\x05order\x18\v \x01(\tB/\x92A,2$Sort direction. Accepts ASC or DESC.:\x04DESCR\x05orderB\x11\n" +
+	"\x05order\x18\v \x01(\tB/\x92A,2$Sort direction. Accepts ASC or DESC.:\x04DESCR\x05order\x12\x81\x01\n" +
+	"\x06search\x18\f \x01(\tBd\x92Aa2_Full-text search over event data, step outputs and errors. Runs match when every term is found.H\x06R\x06search\x88\x01\x01B\x11\n" +
 	"\x0f_include_outputB\t\n" +
 	"\a_cursorB\b\n" +
 	"\x06_limitB\a\n" +
 	"\x05_fromB\b\n" +
 	"\x06_untilB\x0e\n" +
-	"\f_is_deferred\"\xc6\b\n" +
+	"\f_is_deferredB\t\n" +
+	"\a_search\"\xc6\b\n" +
 	"\x17ListFunctionRunsRequest\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1f\n" +
 	"\vfunction_id\x18\x02 \x01(\tR\n" +
@@ -9630,7 +14420,363 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x01(\v2\x15.api.v2.CancelRunDataR\x04data\x124\n" +
 	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"[\n" +
 	"\rCancelRunData\x12J\n" +
-	"\x06run_id\x18\x01 \x01(\tB3\x92A02\x10Cancelled run IDJ\x1c\"01hp1zx8m3ng9vp6qn0xk7j4cy\"R\x05runId*\xdf\x01\n" +
+	"\x06run_id\x18\x01 \x01(\tB3\x92A02\x10Cancelled run IDJ\x1c\"01hp1zx8m3ng9vp6qn0xk7j4cy\"R\x05runId\"\xd3\x05\n" +
+	"\x04Wait\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12$\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x10.api.v2.WaitTypeR\x04type\x12\x1b\n" +
+	"\tstep_name\x18\x04 \x01(\tR\bstepName\x12f\n" +
+	"\x05event\x18\x05 \x01(\tBK\x92AH2FName of the event being waited for (step.waitForEvent and step.invoke)H\x00R\x05event\x88\x01\x01\x12\\\n" +
+	"\n" +
+	"expression\x18\x06 \x01(\tB7\x92A422Expression the event must match to resume the waitH\x01R\n" +
+	"expression\x88\x01\x01\x12Z\n" +
+	"\x06signal\x18\a \x01(\tB=\x92A:28Name of the signal being waited for (step.waitForSignal)H\x02R\x06signal\x88\x01\x01\x12f\n" +
+	"\x12invoke_function_id\x18\b \x01(\tB3\x92A02.ID of the function being invoked (step.invoke)H\x03R\x10invokeFunctionId\x88\x01\x01\x12a\n" +
+	"\n" +
+	"timeout_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB&\x92A#2!Timestamp when the wait times outR\ttimeoutAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\b\n" +
+	"\x06_eventB\r\n" +
+	"\v_expressionB\t\n" +
+	"\a_signalB\x15\n" +
+	"\x13_invoke_function_id\",\n" +
+	"\x13ListRunWaitsRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"n\n" +
+	"\x14ListRunWaitsResponse\x12 \n" +
+	"\x04data\x18\x01 \x03(\v2\f.api.v2.WaitR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x85\x02\n" +
+	"\x18ListFunctionWaitsRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1f\n" +
+	"\vfunction_id\x18\x02 \x01(\tR\n" +
+	"functionId\x12J\n" +
+	"\x06cursor\x18\x03 \x01(\tB-\x92A*2(Pagination cursor from previous responseH\x00R\x06cursor\x88\x01\x01\x12P\n" +
+	"\x05limit\x18\x04 \x01(\x05B5\x92A22,Number of waits to return (min: 1, max: 100):\x0220H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\x95\x01\n" +
+	"\x19ListFunctionWaitsResponse\x12 \n" +
+	"\x04data\x18\x01 \x03(\v2\f.api.v2.WaitR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\x12 \n" +
+	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"\x91\x02\n" +
+	"\x12ResolveWaitRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x17\n" +
+	"\await_id\x18\x02 \x01(\tR\x06waitId\x12\xca\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x16.google.protobuf.ValueB\x9d\x01\x92A\x99\x012\x96\x01Payload to resume the wait with. This is the event's data for step.waitForEvent, which must be an object, and the signal's data for step.waitForSignalR\x04data\"m\n" +
+	"\x13ResolveWaitResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v2.WaitR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"D\n" +
+	"\x12TimeoutWaitRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x17\n" +
+	"\await_id\x18\x02 \x01(\tR\x06waitId\"m\n" +
+	"\x13TimeoutWaitResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v2.WaitR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xc7\x06\n" +
+	"\rBulkRunFilter\x12.\n" +
+	"\x06app_id\x18\x01 \x03(\tB\x17\x92A\x142\x12App IDs to includeR\x05appId\x12N\n" +
+	"\vfunction_id\x18\x02 \x03(\tB-\x92A*2(Function IDs to include. Requires appId.R\n" +
+	"functionId\x12\x87\x01\n" +
+	"\x06status\x18\x03 \x03(\tBo\x92Al2jStatuses to include, using response status values such as COMPLETED, FAILED, RUNNING, QUEUED, or CANCELLEDR\x06status\x12_\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB*\x92A'2%Inclusive start of the run time rangeH\x00R\x04from\x88\x01\x01\x12\xa2\x01\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampBk\x92Ah2fInclusive end of the run time range. Defaults to, and is capped at, the time the operation is created.H\x01R\x05until\x88\x01\x01\x12~\n" +
+	"\n" +
+	"time_field\x18\x06 \x01(\tB_\x92A\\2PRun timestamp field used for filtering. Accepts queuedAt, startedAt, or endedAt.:\bqueuedAtR\ttimeField\x12\x88\x01\n" +
+	"\x05query\x18\a \x01(\tBm\x92Aj2JCEL expression used to filter runs, using the same syntax as the runs listJ\x1c\"event.data.userId == '123'\"H\x02R\x05query\x88\x01\x01B\a\n" +
+	"\x05_fromB\b\n" +
+	"\x06_untilB\b\n" +
+	"\x06_query\"O\n" +
+	"\x1ePreviewBulkRunOperationRequest\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.api.v2.BulkRunFilterR\x06filter\"\x8c\x01\n" +
+	"\x1fPreviewBulkRunOperationResponse\x123\n" +
+	"\x04data\x18\x01 \x01(\v2\x1f.api.v2.BulkRunOperationPreviewR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"i\n" +
+	"\x17BulkRunOperationPreview\x12N\n" +
+	"\trun_count\x18\x01 \x01(\x05B1\x92A.2,Number of runs currently matching the filterR\brunCount\"\xb5\x02\n" +
+	"\x1dCreateBulkRunOperationRequest\x12[\n" +
+	"\x06action\x18\x01 \x01(\tBC\x92A@2>Action applied to every matching run. Accepts CANCEL or RERUN.R\x06action\x12-\n" +
+	"\x06filter\x18\x02 \x01(\v2\x15.api.v2.BulkRunFilterR\x06filter\x12t\n" +
+	"\x0frate_per_second\x18\x03 \x01(\x05BG\x92AD2>Maximum number of runs processed per second (min: 1, max: 100):\x0210H\x00R\rratePerSecond\x88\x01\x01B\x12\n" +
+	"\x10_rate_per_second\"\x84\x01\n" +
+	"\x1eCreateBulkRunOperationResponse\x12,\n" +
+	"\x04data\x18\x01 \x01(\v2\x18.api.v2.BulkRunOperationR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xdf\x01\n" +
+	"\x1cListBulkRunOperationsRequest\x12J\n" +
+	"\x06cursor\x18\x01 \x01(\tB-\x92A*2(Pagination cursor from previous responseH\x00R\x06cursor\x88\x01\x01\x12^\n" +
+	"\x05limit\x18\x02 \x01(\x05BC\x92A@2:Number of operations to return per page (min: 1, max: 100):\x0220H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\xa5\x01\n" +
+	"\x1dListBulkRunOperationsResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.api.v2.BulkRunOperationR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\x12 \n" +
+	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"?\n" +
+	"\x1aGetBulkRunOperationRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\x81\x01\n" +
+	"\x1bGetBulkRunOperationResponse\x12,\n" +
+	"\x04data\x18\x01 \x01(\v2\x18.api.v2.BulkRunOperationR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"A\n" +
+	"\x1cAbortBulkRunOperationRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\x83\x01\n" +
+	"\x1dAbortBulkRunOperationResponse\x12,\n" +
+	"\x04data\x18\x01 \x01(\v2\x18.api.v2.BulkRunOperationR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x82\x06\n" +
+	"\x10BulkRunOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1e.api.v2.BulkRunOperationActionR\x06action\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.api.v2.BulkRunOperationStatusR\x06status\x12-\n" +
+	"\x06filter\x18\x04 \x01(\v2\x15.api.v2.BulkRunFilterR\x06filter\x12&\n" +
+	"\x0frate_per_second\x18\x05 \x01(\x05R\rratePerSecond\x12\\\n" +
+	"\x05total\x18\x06 \x01(\x05BF\x92AC2ANumber of runs matching the filter when the operation was createdR\x05total\x12\x1c\n" +
+	"\tprocessed\x18\a \x01(\x05R\tprocessed\x12\x1c\n" +
+	"\tsucceeded\x18\b \x01(\x05R\tsucceeded\x12\x80\x01\n" +
+	"\askipped\x18\t \x01(\x05Bf\x92Ac2aNumber of runs which did not need the action applied, eg. cancelling runs which had already endedR\askipped\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\x05R\x06failed\x12\x19\n" +
+	"\x05error\x18\v \x01(\tH\x00R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\bended_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendedAt\x88\x01\x01B\b\n" +
+	"\x06_errorB\v\n" +
+	"\t_ended_at\"\xdc\b\n" +
+	"\x1cCreateOutboundWebhookRequest\x12u\n" +
+	"\x03url\x18\x01 \x01(\tBc\x92A`21HTTP or HTTPS URL which notifications are sent toJ+\"https://example.com/inngest/notifications\"R\x03url\x12\x92\x01\n" +
+	"\x06events\x18\x02 \x03(\tBz\x92Aw2uEvents sent to the webhook. Accepts run.failed, run.cancelled, run.duration_exceeded, alert.fired, or alert.resolved.R\x06events\x12\xa2\x03\n" +
+	"\x06filter\x18\x03 \x01(\tB\x84\x03\x92A\x80\x032\xd0\x02CEL expression evaluated against each notification, which is only sent if the expression is true. Exposes type and run, with run fields id, app_id, function_id, function_slug, status, duration_ms, and error, or for alert events alert, with fields id, rule_id, rule_name, function_id, function_slug, metric, threshold, value, and status.J+\"run.function_slug == 'my-app-charge-card'\"H\x00R\x06filter\x88\x01\x01\x12\xb3\x01\n" +
+	"\x1aduration_threshold_seconds\x18\x04 \x01(\x03Bp\x92Am2kRun duration after which run.duration_exceeded is sent. Required when subscribing to run.duration_exceeded.H\x01R\x18durationThresholdSeconds\x88\x01\x01\x12r\n" +
+	"\x06secret\x18\x05 \x01(\tBU\x92AR2PSigning key used to sign notifications. A random secret is generated if not set.H\x02R\x06secret\x88\x01\x01\x12\x1f\n" +
+	"\bdisabled\x18\x06 \x01(\bH\x03R\bdisabled\x88\x01\x01B\t\n" +
+	"\a_filterB\x1d\n" +
+	"\x1b_duration_threshold_secondsB\t\n" +
+	"\a_secretB\v\n" +
+	"\t_disabled\"\x82\x01\n" +
+	"\x1dCreateOutboundWebhookResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.api.v2.OutboundWebhookR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x1d\n" +
+	"\x1bListOutboundWebhooksRequest\"\x81\x01\n" +
+	"\x1cListOutboundWebhooksResponse\x12+\n" +
+	"\x04data\x18\x01 \x03(\v2\x17.api.v2.OutboundWebhookR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\":\n" +
+	"\x19GetOutboundWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"\x7f\n" +
+	"\x1aGetOutboundWebhookResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.api.v2.OutboundWebhookR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xee\x03\n" +
+	"\x1cUpdateOutboundWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03url\x88\x01\x01\x12p\n" +
+	"\x06events\x18\x03 \x03(\tBX\x92AU2SEvents sent to the webhook, replacing the existing events. Left unchanged if empty.R\x06events\x12\x82\x01\n" +
+	"\x06filter\x18\x04 \x01(\tBe\x92Ab2`CEL expression evaluated against each notification. Set to an empty string to remove the filter.H\x01R\x06filter\x88\x01\x01\x12A\n" +
+	"\x1aduration_threshold_seconds\x18\x05 \x01(\x03H\x02R\x18durationThresholdSeconds\x88\x01\x01\x12\x1f\n" +
+	"\bdisabled\x18\x06 \x01(\bH\x03R\bdisabled\x88\x01\x01B\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_filterB\x1d\n" +
+	"\x1b_duration_threshold_secondsB\v\n" +
+	"\t_disabled\"\x82\x01\n" +
+	"\x1dUpdateOutboundWebhookResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.api.v2.OutboundWebhookR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"=\n" +
+	"\x1cDeleteOutboundWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"U\n" +
+	"\x1dDeleteOutboundWebhookResponse\x124\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x86\x02\n" +
+	"$ListOutboundWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12J\n" +
+	"\x06cursor\x18\x02 \x01(\tB-\x92A*2(Pagination cursor from previous responseH\x00R\x06cursor\x88\x01\x01\x12^\n" +
+	"\x05limit\x18\x03 \x01(\x05BC\x92A@2:Number of deliveries to return per page (min: 1, max: 100):\x0220H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\xb4\x01\n" +
+	"%ListOutboundWebhookDeliveriesResponse\x123\n" +
+	"\x04data\x18\x01 \x03(\v2\x1f.api.v2.OutboundWebhookDeliveryR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\x12 \n" +
+	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"f\n" +
+	"$ReplayOutboundWebhookDeliveryRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\"\x92\x01\n" +
+	"%ReplayOutboundWebhookDeliveryResponse\x123\n" +
+	"\x04data\x18\x01 \x01(\v2\x1f.api.v2.OutboundWebhookDeliveryR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xe8\x03\n" +
+	"\x0fOutboundWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tH\x00R\x06filter\x88\x01\x01\x12A\n" +
+	"\x1aduration_threshold_seconds\x18\x05 \x01(\x03H\x01R\x18durationThresholdSeconds\x88\x01\x01\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12t\n" +
+	"\x06secret\x18\a \x01(\tBW\x92AT2RSigning key used to sign notifications. Only returned when the webhook is created.H\x02R\x06secret\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\t\n" +
+	"\a_filterB\x1d\n" +
+	"\x1b_duration_threshold_secondsB\t\n" +
+	"\a_secret\"\xf1\x05\n" +
+	"\x17OutboundWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12[\n" +
+	"\x06run_id\x18\x03 \x01(\tB?\x92A<2:Run the notification was sent for. Unset for alert events.H\x00R\x05runId\x88\x01\x01\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12=\n" +
+	"\x06status\x18\x05 \x01(\x0e2%.api.v2.OutboundWebhookDeliveryStatusR\x06status\x12D\n" +
+	"\apayload\x18\x06 \x01(\tB*\x92A'2%JSON notification sent to the webhookR\apayload\x12B\n" +
+	"\battempts\x18\a \x03(\v2&.api.v2.OutboundWebhookDeliveryAttemptR\battempts\x12w\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB.\x92A+2)When a pending delivery is next attemptedH\x01R\rnextAttemptAt\x88\x01\x01\x12O\n" +
+	"\treplay_of\x18\t \x01(\tB-\x92A*2(ID of the delivery this delivery replaysH\x02R\breplayOf\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\t\n" +
+	"\a_run_idB\x12\n" +
+	"\x10_next_attempt_atB\f\n" +
+	"\n" +
+	"_replay_of\"\x99\x02\n" +
+	"\x1eOutboundWebhookDeliveryAttempt\x12=\n" +
+	"\fattempted_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vattemptedAt\x12b\n" +
+	"\vstatus_code\x18\x02 \x01(\x05B<\x92A927Response status code, unset if no response was receivedH\x00R\n" +
+	"statusCode\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x01R\x05error\x88\x01\x01\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMsB\x0e\n" +
+	"\f_status_codeB\b\n" +
+	"\x06_error\"\xff\a\n" +
+	"\x16CreateAlertRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
+	"\vfunction_id\x18\x02 \x01(\tB)\x92A&2$Function ID or slug the rule watchesR\n" +
+	"functionId\x12\x95\x01\n" +
+	"\x06metric\x18\x03 \x01(\tB}\x92Az2xMetric the rule watches. Accepts failure_rate (0 to 1), p95_duration (seconds), backlog_age (seconds), or skipped_count.R\x06metric\x12R\n" +
+	"\tthreshold\x18\x04 \x01(\x01B4\x92A12/Value of the metric above which the alert firesR\tthreshold\x12\xa8\x01\n" +
+	"\x0ewindow_seconds\x18\x05 \x01(\x03B|\x92Ay2wRolling window the metric is measured over, between 60 and 86400 seconds. Required for every metric except backlog_age.H\x00R\rwindowSeconds\x88\x01\x01\x12\xb6\x01\n" +
+	"\vskip_reason\x18\x06 \x01(\tB\x8f\x01\x92A\x8b\x012\x88\x01Limits skipped_count to runs skipped for a single reason, eg. FunctionPaused, Singleton, FunctionDrained, or FunctionBacklogSizeLimitHitH\x01R\n" +
+	"skipReason\x88\x01\x01\x12\xe3\x01\n" +
+	"\bchannels\x18\a \x03(\tB\xc6\x01\x92A\xc2\x012\xbf\x01Where notifications are sent. Accepts event, sending inngest/alert.fired and inngest/alert.resolved events, or webhook, sending alert.fired and alert.resolved to subscribed outbound webhooks.R\bchannels\x12\x1f\n" +
+	"\bdisabled\x18\b \x01(\bH\x02R\bdisabled\x88\x01\x01B\x11\n" +
+	"\x0f_window_secondsB\x0e\n" +
+	"\f_skip_reasonB\v\n" +
+	"\t_disabled\"v\n" +
+	"\x17CreateAlertRuleResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.api.v2.AlertRuleR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x17\n" +
+	"\x15ListAlertRulesRequest\"u\n" +
+	"\x16ListAlertRulesResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.api.v2.AlertRuleR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\".\n" +
+	"\x13GetAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"s\n" +
+	"\x14GetAlertRuleResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.api.v2.AlertRuleR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xa1\x03\n" +
+	"\x16UpdateAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x03 \x01(\x01H\x01R\tthreshold\x88\x01\x01\x12*\n" +
+	"\x0ewindow_seconds\x18\x04 \x01(\x03H\x02R\rwindowSeconds\x88\x01\x01\x12$\n" +
+	"\vskip_reason\x18\x05 \x01(\tH\x03R\n" +
+	"skipReason\x88\x01\x01\x12x\n" +
+	"\bchannels\x18\x06 \x03(\tB\\\x92AY2WWhere notifications are sent, replacing the existing channels. Left unchanged if empty.R\bchannels\x12\x1f\n" +
+	"\bdisabled\x18\a \x01(\bH\x04R\bdisabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_thresholdB\x11\n" +
+	"\x0f_window_secondsB\x0e\n" +
+	"\f_skip_reasonB\v\n" +
+	"\t_disabled\"v\n" +
+	"\x17UpdateAlertRuleResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.api.v2.AlertRuleR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"1\n" +
+	"\x16DeleteAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"O\n" +
+	"\x17DeleteAlertRuleResponse\x124\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\xa6\x02\n" +
+	"\x11ListAlertsRequest\x12H\n" +
+	"\arule_id\x18\x01 \x01(\tB*\x92A'2%Only return alerts fired by this ruleH\x00R\x06ruleId\x88\x01\x01\x12J\n" +
+	"\x06cursor\x18\x02 \x01(\tB-\x92A*2(Pagination cursor from previous responseH\x01R\x06cursor\x88\x01\x01\x12Z\n" +
+	"\x05limit\x18\x03 \x01(\x05B?\x92A<26Number of alerts to return per page (min: 1, max: 100):\x0220H\x02R\x05limit\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rule_idB\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\x8f\x01\n" +
+	"\x12ListAlertsResponse\x12!\n" +
+	"\x04data\x18\x01 \x03(\v2\r.api.v2.AlertR\x04data\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\x12 \n" +
+	"\x04page\x18\x03 \x01(\v2\f.api.v2.PageR\x04page\"\xd4\x06\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vfunction_id\x18\x03 \x01(\tR\n" +
+	"functionId\x12#\n" +
+	"\rfunction_slug\x18\x04 \x01(\tR\ffunctionSlug\x12\x16\n" +
+	"\x06metric\x18\x05 \x01(\tR\x06metric\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12*\n" +
+	"\x0ewindow_seconds\x18\a \x01(\x03H\x00R\rwindowSeconds\x88\x01\x01\x12$\n" +
+	"\vskip_reason\x18\b \x01(\tH\x01R\n" +
+	"skipReason\x88\x01\x01\x12\x1a\n" +
+	"\bchannels\x18\t \x03(\tR\bchannels\x12\x1a\n" +
+	"\bdisabled\x18\n" +
+	" \x01(\bR\bdisabled\x12\x85\x01\n" +
+	"\x06status\x18\v \x01(\x0e2\x13.api.v2.AlertStatusBX\x92AU2SWhether the rule has a firing alert. Unspecified until the rule is first evaluated.R\x06status\x12B\n" +
+	"\balert_id\x18\f \x01(\tB\"\x92A\x1f2\x1dID of the rule's firing alertH\x02R\aalertId\x88\x01\x01\x12L\n" +
+	"\x05value\x18\r \x01(\x01B1\x92A.2,Value of the metric at the latest evaluationH\x03R\x05value\x88\x01\x01\x12B\n" +
+	"\fevaluated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\vevaluatedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_window_secondsB\x0e\n" +
+	"\f_skip_reasonB\v\n" +
+	"\t_alert_idB\b\n" +
+	"\x06_valueB\x0f\n" +
+	"\r_evaluated_at\"\xa1\x04\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x03 \x01(\tR\bruleName\x12\x1f\n" +
+	"\vfunction_id\x18\x04 \x01(\tR\n" +
+	"functionId\x12#\n" +
+	"\rfunction_slug\x18\x05 \x01(\tR\ffunctionSlug\x12\x16\n" +
+	"\x06metric\x18\x06 \x01(\tR\x06metric\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x01R\tthreshold\x12C\n" +
+	"\x05value\x18\b \x01(\x01B-\x92A*2(Value of the metric when the alert firedR\x05value\x12[\n" +
+	"\n" +
+	"peak_value\x18\t \x01(\x01B<\x92A927Highest value of the metric whilst the alert was firingR\tpeakValue\x12+\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x13.api.v2.AlertStatusR\x06status\x125\n" +
+	"\bfired_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\afiredAt\x12@\n" +
+	"\vresolved_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"resolvedAt\x88\x01\x01B\x0e\n" +
+	"\f_resolved_at\"\x8b\x03\n" +
+	"\x0fGetUsageRequest\x12f\n" +
+	"\x06period\x18\x01 \x01(\tBI\x92AF2>Size of each bucket. Accepts minute, hour, day, week or month.:\x04hourH\x00R\x06period\x88\x01\x01\x12\x81\x01\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBL\x92AI2GInclusive start of the time range, truncated to the start of its bucketH\x01R\x04from\x88\x01\x01\x12n\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB7\x92A422Exclusive end of the time range, defaulting to nowH\x02R\x05until\x88\x01\x01B\t\n" +
+	"\a_periodB\a\n" +
+	"\x05_fromB\b\n" +
+	"\x06_until\"\x9e\x01\n" +
+	"\x10GetUsageResponse\x12'\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.api.v2.UsageBucketR\x04data\x12+\n" +
+	"\x06totals\x18\x02 \x01(\v2\x13.api.v2.UsageTotalsR\x06totals\x124\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x18.api.v2.ResponseMetadataR\bmetadata\"\x82\x04\n" +
+	"\vUsageBucket\x122\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06bucket\x12L\n" +
+	"\x06app_id\x18\x02 \x01(\tB5\x92A220App the usage was recorded in. Empty for events.R\x05appId\x12[\n" +
+	"\vfunction_id\x18\x03 \x01(\tB:\x92A725Function the usage was recorded in. Empty for events.R\n" +
+	"functionId\x12h\n" +
+	"\n" +
+	"event_name\x18\x04 \x01(\tBI\x92AF2DName of the received event, or of the event which triggered the runsR\teventName\x12\x12\n" +
+	"\x04runs\x18\x05 \x01(\x03R\x04runs\x12\x14\n" +
+	"\x05steps\x18\x06 \x01(\x03R\x05steps\x12\x16\n" +
+	"\x06events\x18\a \x01(\x03R\x06events\x12h\n" +
+	"\x10step_duration_ms\x18\b \x01(\x03B>\x92A;29Total time spent executing function code, in millisecondsR\x0estepDurationMs\"y\n" +
+	"\vUsageTotals\x12\x12\n" +
+	"\x04runs\x18\x01 \x01(\x03R\x04runs\x12\x14\n" +
+	"\x05steps\x18\x02 \x01(\x03R\x05steps\x12\x16\n" +
+	"\x06events\x18\x03 \x01(\x03R\x06events\x12(\n" +
+	"\x10step_duration_ms\x18\x04 \x01(\x03R\x0estepDurationMs*\xdf\x01\n" +
 	"\x11FunctionRunStatus\x12#\n" +
 	"\x1fFUNCTION_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aFUNCTION_RUN_STATUS_QUEUED\x10\x01\x12\x1f\n" +
@@ -9696,7 +14842,31 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ERROR\x10\x01\x12\v\n" +
 	"\aWARNING\x10\x02\x12\b\n" +
-	"\x04INFO\x10\x032\xa6\xa7\x01\n" +
+	"\x04INFO\x10\x03*f\n" +
+	"\bWaitType\x12\x19\n" +
+	"\x15WAIT_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fWAIT_TYPE_EVENT\x10\x01\x12\x14\n" +
+	"\x10WAIT_TYPE_SIGNAL\x10\x02\x12\x14\n" +
+	"\x10WAIT_TYPE_INVOKE\x10\x03*\x8e\x01\n" +
+	"\x16BulkRunOperationAction\x12)\n" +
+	"%BULK_RUN_OPERATION_ACTION_UNSPECIFIED\x10\x00\x12$\n" +
+	" BULK_RUN_OPERATION_ACTION_CANCEL\x10\x01\x12#\n" +
+	"\x1fBULK_RUN_OPERATION_ACTION_RERUN\x10\x02*\xe0\x01\n" +
+	"\x16BulkRunOperationStatus\x12)\n" +
+	"%BULK_RUN_OPERATION_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!BULK_RUN_OPERATION_STATUS_RUNNING\x10\x01\x12'\n" +
+	"#BULK_RUN_OPERATION_STATUS_COMPLETED\x10\x02\x12%\n" +
+	"!BULK_RUN_OPERATION_STATUS_ABORTED\x10\x03\x12$\n" +
+	" BULK_RUN_OPERATION_STATUS_FAILED\x10\x04*\xdc\x01\n" +
+	"\x1dOutboundWebhookDeliveryStatus\x120\n" +
+	",OUTBOUND_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12,\n" +
+	"(OUTBOUND_WEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12.\n" +
+	"*OUTBOUND_WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12+\n" +
+	"'OUTBOUND_WEBHOOK_DELIVERY_STATUS_FAILED\x10\x03*_\n" +
+	"\vAlertStatus\x12\x1c\n" +
+	"\x18ALERT_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ALERT_STATUS_FIRING\x10\x01\x12\x19\n" +
+	"\x15ALERT_STATUS_RESOLVED\x10\x022\xeb\xe1\x01\n" +
 	"\x02V2\x12\xbc\x02\n" +
 	"\x06Health\x12\x15.api.v2.HealthRequest\x1a\x16.api.v2.HealthResponse\"\x82\x02\x92A\xef\x01\n" +
 	"\bInternal\x12\fHealth check\x1a,Returns the health status of the API serviceJR\n" +
@@ -9980,7 +15150,148 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x04Beta\x12\x13Cancel function run\x1a#Cancels an in-progress function runb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/runs/{run_id}/cancel\x12\xc8\x01\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/runs/{run_id}/cancel\x12\x87\x02\n" +
+	"\fListRunWaits\x12\x1b.api.v2.ListRunWaitsRequest\x1a\x1c.api.v2.ListRunWaitsResponse\"\xbb\x01\x92A\x9b\x01\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\x0eList run waits\x1akLists the pending waits a run is blocked on, such as step.waitForEvent and step.waitForSignal, oldest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/runs/{run_id}/waits\x12\xbf\x02\n" +
+	"\x11ListFunctionWaits\x12 .api.v2.ListFunctionWaitsRequest\x1a!.api.v2.ListFunctionWaitsResponse\"\xe4\x01\x92A\xac\x01\n" +
+	"\tFunctions\n" +
+	"\x04Beta\x12\x13List function waits\x1arLists the pending waits of a function's running runs, newest run first. Waits within a run are listed oldest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.\x12,/apps/{app_id}/functions/{function_id}/waits\x12\xa0\x02\n" +
+	"\vResolveWait\x12\x1a.api.v2.ResolveWaitRequest\x1a\x1b.api.v2.ResolveWaitResponse\"\xd7\x01\x92A\xa2\x01\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\fResolve wait\x1atResumes a pending step.waitForEvent or step.waitForSignal as if its event or signal was received with the given datab\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02+:\x01*\"&/runs/{run_id}/waits/{wait_id}/resolve\x12\xf6\x01\n" +
+	"\vTimeoutWait\x12\x1a.api.v2.TimeoutWaitRequest\x1a\x1b.api.v2.TimeoutWaitResponse\"\xad\x01\x92Ay\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\rTime out wait\x1aJResumes a pending wait as if it timed out, without waiting for its timeoutb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02+:\x01*\"&/runs/{run_id}/waits/{wait_id}/timeout\x12\x95\x02\n" +
+	"\x17PreviewBulkRunOperation\x12&.api.v2.PreviewBulkRunOperationRequest\x1a'.api.v2.PreviewBulkRunOperationResponse\"\xa8\x01\x92A\x87\x01\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\x1aPreview bulk run operation\x1aKCounts the runs matching a bulk operation filter without modifying any runsb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/runs/bulk/preview\x12\x8d\x02\n" +
+	"\x16CreateBulkRunOperation\x12%.api.v2.CreateBulkRunOperationRequest\x1a&.api.v2.CreateBulkRunOperationResponse\"\xa3\x01\x92A\x8a\x01\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\x19Create bulk run operation\x1aOStarts cancelling or rerunning every run matching a filter at a controlled rateb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/runs/bulk\x12\xff\x01\n" +
+	"\x15ListBulkRunOperations\x12$.api.v2.ListBulkRunOperationsRequest\x1a%.api.v2.ListBulkRunOperationsResponse\"\x98\x01\x92A\x82\x01\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\x18List bulk run operations\x1aHLists bulk run operations in the authenticated environment, newest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/runs/bulk\x12\xf4\x01\n" +
+	"\x13GetBulkRunOperation\x12\".api.v2.GetBulkRunOperationRequest\x1a#.api.v2.GetBulkRunOperationResponse\"\x93\x01\x92Ao\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\x16Get bulk run operation\x1a7Fetches the status and progress of a bulk run operationb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b\x12\x19/runs/bulk/{operation_id}\x12\xa3\x02\n" +
+	"\x15AbortBulkRunOperation\x12$.api.v2.AbortBulkRunOperationRequest\x1a%.api.v2.AbortBulkRunOperationResponse\"\xbc\x01\x92A\x8e\x01\n" +
+	"\x04Runs\n" +
+	"\x04Beta\x12\x18Abort bulk run operation\x1aTStops a running bulk run operation. Runs which were already processed are left as-isb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/runs/bulk/{operation_id}/abort\x12\xaf\x02\n" +
+	"\x15CreateOutboundWebhook\x12$.api.v2.CreateOutboundWebhookRequest\x1a%.api.v2.CreateOutboundWebhookResponse\"\xc8\x01\x92A\xa7\x01\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12\x17Create outbound webhook\x1ajCreates an endpoint which is sent signed notifications when runs fail, are cancelled, or exceed a durationb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/outbound-webhooks\x12\x84\x02\n" +
+	"\x14ListOutboundWebhooks\x12#.api.v2.ListOutboundWebhooksRequest\x1a$.api.v2.ListOutboundWebhooksResponse\"\xa0\x01\x92A\x82\x01\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12\x16List outbound webhooks\x1aFLists outbound webhooks in the authenticated environment, oldest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x14\x12\x12/outbound-webhooks\x12\xe3\x01\n" +
+	"\x12GetOutboundWebhook\x12!.api.v2.GetOutboundWebhookRequest\x1a\".api.v2.GetOutboundWebhookResponse\"\x85\x01\x92A[\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12\x14Get outbound webhook\x1a!Fetches a single outbound webhookb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02!\x12\x1f/outbound-webhooks/{webhook_id}\x12\x9a\x02\n" +
+	"\x15UpdateOutboundWebhook\x12$.api.v2.UpdateOutboundWebhookRequest\x1a%.api.v2.UpdateOutboundWebhookResponse\"\xb3\x01\x92A\x85\x01\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12\x17Update outbound webhook\x1aHUpdates an outbound webhook. Fields which are not set are left unchangedb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$:\x01*2\x1f/outbound-webhooks/{webhook_id}\x12\x8a\x02\n" +
+	"\x15DeleteOutboundWebhook\x12$.api.v2.DeleteOutboundWebhookRequest\x1a%.api.v2.DeleteOutboundWebhookResponse\"\xa3\x01\x92Ay\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12\x17Delete outbound webhook\x1a<Deletes an outbound webhook. Pending deliveries are not sentb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02!*\x1f/outbound-webhooks/{webhook_id}\x12\xce\x02\n" +
+	"\x1dListOutboundWebhookDeliveries\x12,.api.v2.ListOutboundWebhookDeliveriesRequest\x1a-.api.v2.ListOutboundWebhookDeliveriesResponse\"\xcf\x01\x92A\x99\x01\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12 List outbound webhook deliveries\x1aSLists the deliveries sent to an outbound webhook over the last 7 days, newest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02,\x12*/outbound-webhooks/{webhook_id}/deliveries\x12\xde\x02\n" +
+	"\x1dReplayOutboundWebhookDelivery\x12,.api.v2.ReplayOutboundWebhookDeliveryRequest\x1a-.api.v2.ReplayOutboundWebhookDeliveryResponse\"\xdf\x01\x92A\x91\x01\n" +
+	"\bWebhooks\n" +
+	"\x04Beta\x12 Replay outbound webhook delivery\x1aKSends a succeeded or failed delivery's notification again as a new deliveryb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02D:\x01*\"?/outbound-webhooks/{webhook_id}/deliveries/{delivery_id}/replay\x12\xad\x02\n" +
+	"\x0fCreateAlertRule\x12\x1e.api.v2.CreateAlertRuleRequest\x1a\x1f.api.v2.CreateAlertRuleResponse\"\xd8\x01\x92A\xbd\x01\n" +
+	"\x06Alerts\n" +
+	"\x04Beta\x12\x11Create alert rule\x1a\x87\x01Creates a rule which fires an alert when a function's failure rate, p95 duration, backlog age, or skipped run count exceeds a thresholdb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/alert-rules\x12\xfb\x01\n" +
+	"\x0eListAlertRules\x12\x1d.api.v2.ListAlertRulesRequest\x1a\x1e.api.v2.ListAlertRulesResponse\"\xa9\x01\x92A\x91\x01\n" +
+	"\x06Alerts\n" +
+	"\x04Beta\x12\x10List alert rules\x1a]Lists alert rules in the authenticated environment with their latest evaluation, oldest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e\x12\f/alert-rules\x12\xd5\x01\n" +
+	"\fGetAlertRule\x12\x1b.api.v2.GetAlertRuleRequest\x1a\x1c.api.v2.GetAlertRuleResponse\"\x89\x01\x92Ah\n" +
+	"\x06Alerts\n" +
+	"\x04Beta\x12\x0eGet alert rule\x1a6Fetches a single alert rule with its latest evaluationb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18\x12\x16/alert-rules/{rule_id}\x12\xf0\x01\n" +
+	"\x0fUpdateAlertRule\x12\x1e.api.v2.UpdateAlertRuleRequest\x1a\x1f.api.v2.UpdateAlertRuleResponse\"\x9b\x01\x92Aw\n" +
+	"\x06Alerts\n" +
+	"\x04Beta\x12\x11Update alert rule\x1aBUpdates an alert rule. Fields which are not set are left unchangedb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/alert-rules/{rule_id}\x12\xdc\x01\n" +
+	"\x0fDeleteAlertRule\x12\x1e.api.v2.DeleteAlertRuleRequest\x1a\x1f.api.v2.DeleteAlertRuleResponse\"\x87\x01\x92Af\n" +
+	"\x06Alerts\n" +
+	"\x04Beta\x12\x11Delete alert rule\x1a1Deletes an alert rule, resolving its firing alertb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18*\x16/alert-rules/{rule_id}\x12\xdf\x01\n" +
+	"\n" +
+	"ListAlerts\x12\x19.api.v2.ListAlertsRequest\x1a\x1a.api.v2.ListAlertsResponse\"\x99\x01\x92A\x86\x01\n" +
+	"\x06Alerts\n" +
+	"\x04Beta\x12\vList alerts\x1aWLists alerts fired in the authenticated environment over the last 30 days, newest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\t\x12\a/alerts\x12\xe7\x01\n" +
+	"\bGetUsage\x12\x17.api.v2.GetUsageRequest\x1a\x18.api.v2.GetUsageResponse\"\xa7\x01\x92A\x95\x01\n" +
+	"\x05Usage\n" +
+	"\x04Beta\x12\tGet usage\x1aiReturns runs, steps, events and step duration totals per app, function and event name, bucketed by periodb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\b\x12\x06/usage\x12\xc8\x01\n" +
 	"\x06GetApp\x12\x15.api.v2.GetAppRequest\x1a\x16.api.v2.GetAppResponse\"\x8e\x01\x92Au\n" +
 	"\x04Apps\n" +
 	"\x04Beta\x12\aGet app\x1aLFetches details for a single app, including sync metadata and function countb\x10\n" +
@@ -10136,7 +15447,19 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x04Beta\x12\rGet functions\x1a:Lists function configuration and status details for an appb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/apps/{app_id}/functions\x12\x82\t\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/apps/{app_id}/functions\x12\x9b\x02\n" +
+	"\x14ListFunctionVersions\x12#.api.v2.ListFunctionVersionsRequest\x1a$.api.v2.ListFunctionVersionsResponse\"\xb7\x01\x92A}\n" +
+	"\tFunctions\n" +
+	"\x04Beta\x12\x16List function versions\x1a@Lists every config a function has been synced with, newest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x021\x12//apps/{app_id}/functions/{function_id}/versions\x12\xb8\x02\n" +
+	"\x16GetFunctionVersionDiff\x12%.api.v2.GetFunctionVersionDiffRequest\x1a&.api.v2.GetFunctionVersionDiffResponse\"\xce\x01\x92A\x8e\x01\n" +
+	"\tFunctions\n" +
+	"\x04Beta\x12\x16Diff function versions\x1aQReturns the configuration fields which changed between two versions of a functionb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x026\x124/apps/{app_id}/functions/{function_id}/versions/diff\x12\x82\t\n" +
 	"\tSendEvent\x12\x18.api.v2.SendEventRequest\x1a\x19.api.v2.SendEventResponse\"\xbf\b\x92A\xa9\b\n" +
 	"\x06Events\x12\n" +
 	"Send event\x1a\xfb\x02Sends one event for testing and debugging. The request accepts the name, data, user, id, and ts fields; send batches and other Event API fields through an Inngest SDK or the Event API. This endpoint uses REST API rate limits and is not intended for high-volume ingestion. Use the returned event ID with the event runs endpoint to inspect any function runs triggered by the event.J>\n" +
@@ -10202,7 +15525,19 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x1f\x1a\x1d#/definitions/v2ErrorResponseb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x022:\x01*\"-/apps/{app_id}/functions/{function_id}/invoke\x12\xc8\x04\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x022:\x01*\"-/apps/{app_id}/functions/{function_id}/invoke\x12\xcc\x02\n" +
+	"\x18ListScheduledInvocations\x12'.api.v2.ListScheduledInvocationsRequest\x1a(.api.v2.ListScheduledInvocationsResponse\"\xdc\x01\x92A\xa0\x01\n" +
+	"\tFunctions\n" +
+	"\x04Beta\x12\x1aList scheduled invocations\x1a_Lists pending invocations of a function which are scheduled to run in the future, soonest firstb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x022\x120/apps/{app_id}/functions/{function_id}/scheduled\x12\xcc\x02\n" +
+	"\x19CancelScheduledInvocation\x12(.api.v2.CancelScheduledInvocationRequest\x1a).api.v2.CancelScheduledInvocationResponse\"\xd9\x01\x92A\x85\x01\n" +
+	"\tFunctions\n" +
+	"\x04Beta\x12\x1bCancel scheduled invocation\x1aCCancels a pending scheduled invocation so that its run never startsb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02J:\x01*\"E/apps/{app_id}/functions/{function_id}/scheduled/{schedule_id}/cancel\x12\xc8\x04\n" +
 	"\x12ListInsightsTables\x12!.api.v2.ListInsightsTablesRequest\x1a\".api.v2.ListInsightsTablesResponse\"\xea\x03\x92A\xce\x03\n" +
 	"\bInsights\n" +
 	"\x04Beta\x12\x14List insights tables\x1aOLists the available tables that can be queried via the Insights query endpoint.JZ\n" +
@@ -10322,15 +15657,15 @@ const file_api_v2_service_proto_rawDesc = "" +
 	"\x04Beta\x12\x11List session runs\x1aKLists runs associated with one session ID in the authenticated environment.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.\x12,/sessions/{session_key}/{session_id=**}/runsB\xde\x06\x92A\xa7\x06\x12\x9b\x01\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.\x12,/sessions/{session_key}/{session_id=**}/runsB\xeb\x06\x92A\xb4\x06\x12\x9b\x01\n" +
 	"\x13Inngest REST API v2\x12}The v2 API delivers a significantly improved developer experience with consistent design patterns and enhanced functionality.2\x052.0.0\x1a\x0fapi.inngest.com\"\x03/v2*\x01\x02ZX\n" +
 	"V\n" +
 	"\n" +
 	"BearerAuth\x12H\b\x02\x123Bearer token authentication. Format: `Bearer {key}`\x1a\rAuthorization \x02j\x1e\n" +
 	"\aAccount\x12\x13Manage your accountj.\n" +
 	"\fEnvironments\x12\x1eCreate and manage environmentsj%\n" +
-	"\x04Keys\x12\x1dManage event and signing keysj.\n" +
-	"\bWebhooks\x12\"Create and manage inbound webhooksj\x17\n" +
+	"\x04Keys\x12\x1dManage event and signing keysj;\n" +
+	"\bWebhooks\x12/Create and manage inbound and outbound webhooksj\x17\n" +
 	"\x04Apps\x12\x0fSync and managej\x15\n" +
 	"\x06Events\x12\vSend eventsj(\n" +
 	"\tFunctions\x12\x1bInvoke and manage functionsj\x1d\n" +
@@ -10353,8 +15688,8 @@ func file_api_v2_service_proto_rawDescGZIP() []byte {
 	return file_api_v2_service_proto_rawDescData
 }
 
-var file_api_v2_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_api_v2_service_proto_msgTypes = make([]protoimpl.MessageInfo, 134)
+var file_api_v2_service_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_api_v2_service_proto_msgTypes = make([]protoimpl.MessageInfo, 203)
 var file_api_v2_service_proto_goTypes = []any{
 	(FunctionRunStatus)(0),                        // 0: api.v2.FunctionRunStatus
 	(TraceSpanStatus)(0),                          // 1: api.v2.TraceSpanStatus
@@ -10367,480 +15702,707 @@ var file_api_v2_service_proto_goTypes = []any{
 	(FilterType)(0),                               // 8: api.v2.FilterType
 	(InsightsOutputColumnType)(0),                 // 9: api.v2.InsightsOutputColumnType
 	(InsightsDiagnosticSeverity)(0),               // 10: api.v2.InsightsDiagnosticSeverity
-	(*HealthRequest)(nil),                         // 11: api.v2.HealthRequest
-	(*FetchAccountRequest)(nil),                   // 12: api.v2.FetchAccountRequest
-	(*HealthResponse)(nil),                        // 13: api.v2.HealthResponse
-	(*HealthData)(nil),                            // 14: api.v2.HealthData
-	(*Error)(nil),                                 // 15: api.v2.Error
-	(*ErrorResponse)(nil),                         // 16: api.v2.ErrorResponse
-	(*ResponseMetadata)(nil),                      // 17: api.v2.ResponseMetadata
-	(*TimeRange)(nil),                             // 18: api.v2.TimeRange
-	(*FunctionRef)(nil),                           // 19: api.v2.FunctionRef
-	(*AppRef)(nil),                                // 20: api.v2.AppRef
-	(*FunctionApp)(nil),                           // 21: api.v2.FunctionApp
-	(*FunctionTrigger)(nil),                       // 22: api.v2.FunctionTrigger
-	(*FunctionFailureHandler)(nil),                // 23: api.v2.FunctionFailureHandler
-	(*FunctionCancellationConfiguration)(nil),     // 24: api.v2.FunctionCancellationConfiguration
-	(*FunctionRetryConfiguration)(nil),            // 25: api.v2.FunctionRetryConfiguration
-	(*FunctionEventsBatchConfiguration)(nil),      // 26: api.v2.FunctionEventsBatchConfiguration
-	(*FunctionConcurrencyLimitConfiguration)(nil), // 27: api.v2.FunctionConcurrencyLimitConfiguration
-	(*FunctionConcurrencyConfiguration)(nil),      // 28: api.v2.FunctionConcurrencyConfiguration
-	(*FunctionRateLimitConfiguration)(nil),        // 29: api.v2.FunctionRateLimitConfiguration
-	(*FunctionDebounceConfiguration)(nil),         // 30: api.v2.FunctionDebounceConfiguration
-	(*FunctionThrottleConfiguration)(nil),         // 31: api.v2.FunctionThrottleConfiguration
-	(*FunctionSingletonConfiguration)(nil),        // 32: api.v2.FunctionSingletonConfiguration
-	(*FunctionConfiguration)(nil),                 // 33: api.v2.FunctionConfiguration
-	(*Function)(nil),                              // 34: api.v2.Function
-	(*RunTrigger)(nil),                            // 35: api.v2.RunTrigger
-	(*FunctionRun)(nil),                           // 36: api.v2.FunctionRun
-	(*GetFunctionRunRequest)(nil),                 // 37: api.v2.GetFunctionRunRequest
-	(*GetFunctionRunResponse)(nil),                // 38: api.v2.GetFunctionRunResponse
-	(*GetEventRunsRequest)(nil),                   // 39: api.v2.GetEventRunsRequest
-	(*GetEventRunsResponse)(nil),                  // 40: api.v2.GetEventRunsResponse
-	(*RerunRequest)(nil),                          // 41: api.v2.RerunRequest
-	(*RerunFromStep)(nil),                         // 42: api.v2.RerunFromStep
-	(*RerunResponse)(nil),                         // 43: api.v2.RerunResponse
-	(*RerunData)(nil),                             // 44: api.v2.RerunData
-	(*TraceSpanMetadata)(nil),                     // 45: api.v2.TraceSpanMetadata
-	(*TraceSpan)(nil),                             // 46: api.v2.TraceSpan
-	(*FunctionTrace)(nil),                         // 47: api.v2.FunctionTrace
-	(*GetFunctionTraceRequest)(nil),               // 48: api.v2.GetFunctionTraceRequest
-	(*GetFunctionTraceResponse)(nil),              // 49: api.v2.GetFunctionTraceResponse
-	(*GetFunctionRequest)(nil),                    // 50: api.v2.GetFunctionRequest
-	(*GetFunctionResponse)(nil),                   // 51: api.v2.GetFunctionResponse
-	(*App)(nil),                                   // 52: api.v2.App
-	(*AppSync)(nil),                               // 53: api.v2.AppSync
-	(*GetAppRequest)(nil),                         // 54: api.v2.GetAppRequest
-	(*GetAppResponse)(nil),                        // 55: api.v2.GetAppResponse
-	(*GetAppsRequest)(nil),                        // 56: api.v2.GetAppsRequest
-	(*GetAppsResponse)(nil),                       // 57: api.v2.GetAppsResponse
-	(*GetFunctionsRequest)(nil),                   // 58: api.v2.GetFunctionsRequest
-	(*GetFunctionsResponse)(nil),                  // 59: api.v2.GetFunctionsResponse
-	(*CreateAccountRequest)(nil),                  // 60: api.v2.CreateAccountRequest
-	(*CreateAccountResponse)(nil),                 // 61: api.v2.CreateAccountResponse
-	(*CreateEnvRequest)(nil),                      // 62: api.v2.CreateEnvRequest
-	(*CreateEnvResponse)(nil),                     // 63: api.v2.CreateEnvResponse
-	(*Env)(nil),                                   // 64: api.v2.Env
-	(*CreateAccountData)(nil),                     // 65: api.v2.CreateAccountData
-	(*FetchAccountsRequest)(nil),                  // 66: api.v2.FetchAccountsRequest
-	(*FetchAccountsResponse)(nil),                 // 67: api.v2.FetchAccountsResponse
-	(*FetchAccountResponse)(nil),                  // 68: api.v2.FetchAccountResponse
-	(*Account)(nil),                               // 69: api.v2.Account
-	(*Page)(nil),                                  // 70: api.v2.Page
-	(*FetchAccountEventKeysRequest)(nil),          // 71: api.v2.FetchAccountEventKeysRequest
-	(*FetchAccountEventKeysResponse)(nil),         // 72: api.v2.FetchAccountEventKeysResponse
-	(*EventKey)(nil),                              // 73: api.v2.EventKey
-	(*FetchAccountEnvsRequest)(nil),               // 74: api.v2.FetchAccountEnvsRequest
-	(*FetchAccountEnvsResponse)(nil),              // 75: api.v2.FetchAccountEnvsResponse
-	(*FetchAccountSigningKeysRequest)(nil),        // 76: api.v2.FetchAccountSigningKeysRequest
-	(*FetchAccountSigningKeysResponse)(nil),       // 77: api.v2.FetchAccountSigningKeysResponse
-	(*SigningKey)(nil),                            // 78: api.v2.SigningKey
-	(*CreateWebhookRequest)(nil),                  // 79: api.v2.CreateWebhookRequest
-	(*CreateWebhookResponse)(nil),                 // 80: api.v2.CreateWebhookResponse
-	(*EventFilter)(nil),                           // 81: api.v2.EventFilter
-	(*ListWebhooksRequest)(nil),                   // 82: api.v2.ListWebhooksRequest
-	(*ListWebhooksResponse)(nil),                  // 83: api.v2.ListWebhooksResponse
-	(*Webhook)(nil),                               // 84: api.v2.Webhook
-	(*PatchEnvRequest)(nil),                       // 85: api.v2.PatchEnvRequest
-	(*PatchEnvsResponse)(nil),                     // 86: api.v2.PatchEnvsResponse
-	(*SendEventRequest)(nil),                      // 87: api.v2.SendEventRequest
-	(*SendEventResponse)(nil),                     // 88: api.v2.SendEventResponse
-	(*SendEventData)(nil),                         // 89: api.v2.SendEventData
-	(*InvokeFunctionRequest)(nil),                 // 90: api.v2.InvokeFunctionRequest
-	(*InvokeFunctionResponse)(nil),                // 91: api.v2.InvokeFunctionResponse
-	(*InvokeFunctionData)(nil),                    // 92: api.v2.InvokeFunctionData
-	(*CreateScoreRequest)(nil),                    // 93: api.v2.CreateScoreRequest
-	(*CreateScoreInput)(nil),                      // 94: api.v2.CreateScoreInput
-	(*ScoreExperiment)(nil),                       // 95: api.v2.ScoreExperiment
-	(*CreateScoreResponse)(nil),                   // 96: api.v2.CreateScoreResponse
-	(*Score)(nil),                                 // 97: api.v2.Score
-	(*SyncAppRequest)(nil),                        // 98: api.v2.SyncAppRequest
-	(*SyncAppResponse)(nil),                       // 99: api.v2.SyncAppResponse
-	(*SyncAppData)(nil),                           // 100: api.v2.SyncAppData
-	(*SyncAppError)(nil),                          // 101: api.v2.SyncAppError
-	(*QueryInsightsRequest)(nil),                  // 102: api.v2.QueryInsightsRequest
-	(*QueryInsightsResponse)(nil),                 // 103: api.v2.QueryInsightsResponse
-	(*QueryInsightsData)(nil),                     // 104: api.v2.QueryInsightsData
-	(*InsightsOutputColumn)(nil),                  // 105: api.v2.InsightsOutputColumn
-	(*InsightsRow)(nil),                           // 106: api.v2.InsightsRow
-	(*InsightsDiagnostic)(nil),                    // 107: api.v2.InsightsDiagnostic
-	(*InsightsDiagnosticPosition)(nil),            // 108: api.v2.InsightsDiagnosticPosition
-	(*ListInsightsTablesRequest)(nil),             // 109: api.v2.ListInsightsTablesRequest
-	(*ListInsightsTablesResponse)(nil),            // 110: api.v2.ListInsightsTablesResponse
-	(*InsightsTable)(nil),                         // 111: api.v2.InsightsTable
-	(*InsightsTableColumn)(nil),                   // 112: api.v2.InsightsTableColumn
-	(*QueryInsightsPromptRequest)(nil),            // 113: api.v2.QueryInsightsPromptRequest
-	(*QueryInsightsPromptResponse)(nil),           // 114: api.v2.QueryInsightsPromptResponse
-	(*QueryInsightsPromptData)(nil),               // 115: api.v2.QueryInsightsPromptData
-	(*ListInsightsEventSchemasRequest)(nil),       // 116: api.v2.ListInsightsEventSchemasRequest
-	(*ListInsightsEventSchemasResponse)(nil),      // 117: api.v2.ListInsightsEventSchemasResponse
-	(*InsightsEventSchema)(nil),                   // 118: api.v2.InsightsEventSchema
-	(*ListExperimentsRequest)(nil),                // 119: api.v2.ListExperimentsRequest
-	(*ListExperimentsResponse)(nil),               // 120: api.v2.ListExperimentsResponse
-	(*Experiment)(nil),                            // 121: api.v2.Experiment
-	(*GetExperimentRequest)(nil),                  // 122: api.v2.GetExperimentRequest
-	(*GetExperimentResponse)(nil),                 // 123: api.v2.GetExperimentResponse
-	(*ExperimentDetail)(nil),                      // 124: api.v2.ExperimentDetail
-	(*ExperimentVariantMetrics)(nil),              // 125: api.v2.ExperimentVariantMetrics
-	(*ExperimentVariantMetric)(nil),               // 126: api.v2.ExperimentVariantMetric
-	(*ExperimentVariantWeight)(nil),               // 127: api.v2.ExperimentVariantWeight
-	(*ListSessionKeysRequest)(nil),                // 128: api.v2.ListSessionKeysRequest
-	(*ListSessionKeysResponse)(nil),               // 129: api.v2.ListSessionKeysResponse
-	(*SessionKey)(nil),                            // 130: api.v2.SessionKey
-	(*ListSessionsRequest)(nil),                   // 131: api.v2.ListSessionsRequest
-	(*ListSessionsResponse)(nil),                  // 132: api.v2.ListSessionsResponse
-	(*SessionGroup)(nil),                          // 133: api.v2.SessionGroup
-	(*ListSessionRunsRequest)(nil),                // 134: api.v2.ListSessionRunsRequest
-	(*ListSessionRunsResponse)(nil),               // 135: api.v2.ListSessionRunsResponse
-	(*SessionRun)(nil),                            // 136: api.v2.SessionRun
-	(*ListRunsRequest)(nil),                       // 137: api.v2.ListRunsRequest
-	(*ListFunctionRunsRequest)(nil),               // 138: api.v2.ListFunctionRunsRequest
-	(*ListRunsResponse)(nil),                      // 139: api.v2.ListRunsResponse
-	(*ListFunctionRunsResponse)(nil),              // 140: api.v2.ListFunctionRunsResponse
-	(*CancelRunRequest)(nil),                      // 141: api.v2.CancelRunRequest
-	(*CancelRunResponse)(nil),                     // 142: api.v2.CancelRunResponse
-	(*CancelRunData)(nil),                         // 143: api.v2.CancelRunData
-	nil,                                           // 144: api.v2.TraceSpanMetadata.ValuesEntry
-	(*timestamppb.Timestamp)(nil),                 // 145: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                       // 146: google.protobuf.Struct
-	(*structpb.ListValue)(nil),                    // 147: google.protobuf.ListValue
-	(*structpb.Value)(nil),                        // 148: google.protobuf.Value
-	(*CreateSandboxRequest)(nil),                  // 149: api.v2.CreateSandboxRequest
-	(*ListSandboxesRequest)(nil),                  // 150: api.v2.ListSandboxesRequest
-	(*GetSandboxRequest)(nil),                     // 151: api.v2.GetSandboxRequest
-	(*DestroySandboxRequest)(nil),                 // 152: api.v2.DestroySandboxRequest
-	(*ExecSandboxRequest)(nil),                    // 153: api.v2.ExecSandboxRequest
-	(*StreamSandboxLogsRequest)(nil),              // 154: api.v2.StreamSandboxLogsRequest
-	(*WriteSandboxFileRequest)(nil),               // 155: api.v2.WriteSandboxFileRequest
-	(*ReadSandboxFileRequest)(nil),                // 156: api.v2.ReadSandboxFileRequest
-	(*StartSandboxProcessRequest)(nil),            // 157: api.v2.StartSandboxProcessRequest
-	(*ListSandboxProcessesRequest)(nil),           // 158: api.v2.ListSandboxProcessesRequest
-	(*GetSandboxProcessRequest)(nil),              // 159: api.v2.GetSandboxProcessRequest
-	(*SignalSandboxProcessRequest)(nil),           // 160: api.v2.SignalSandboxProcessRequest
-	(*WaitSandboxProcessRequest)(nil),             // 161: api.v2.WaitSandboxProcessRequest
-	(*GetSandboxProcessOutputRequest)(nil),        // 162: api.v2.GetSandboxProcessOutputRequest
-	(*StreamSandboxProcessOutputRequest)(nil),     // 163: api.v2.StreamSandboxProcessOutputRequest
-	(*CreateSandboxResponse)(nil),                 // 164: api.v2.CreateSandboxResponse
-	(*ListSandboxesResponse)(nil),                 // 165: api.v2.ListSandboxesResponse
-	(*GetSandboxResponse)(nil),                    // 166: api.v2.GetSandboxResponse
-	(*DestroySandboxResponse)(nil),                // 167: api.v2.DestroySandboxResponse
-	(*ExecSandboxResponse)(nil),                   // 168: api.v2.ExecSandboxResponse
-	(*StreamSandboxLogsResponse)(nil),             // 169: api.v2.StreamSandboxLogsResponse
-	(*WriteSandboxFileResponse)(nil),              // 170: api.v2.WriteSandboxFileResponse
-	(*httpbody.HttpBody)(nil),                     // 171: google.api.HttpBody
-	(*StartSandboxProcessResponse)(nil),           // 172: api.v2.StartSandboxProcessResponse
-	(*ListSandboxProcessesResponse)(nil),          // 173: api.v2.ListSandboxProcessesResponse
-	(*GetSandboxProcessResponse)(nil),             // 174: api.v2.GetSandboxProcessResponse
-	(*SignalSandboxProcessResponse)(nil),          // 175: api.v2.SignalSandboxProcessResponse
-	(*WaitSandboxProcessResponse)(nil),            // 176: api.v2.WaitSandboxProcessResponse
-	(*GetSandboxProcessOutputResponse)(nil),       // 177: api.v2.GetSandboxProcessOutputResponse
-	(*StreamSandboxProcessOutputResponse)(nil),    // 178: api.v2.StreamSandboxProcessOutputResponse
+	(WaitType)(0),                                 // 11: api.v2.WaitType
+	(BulkRunOperationAction)(0),                   // 12: api.v2.BulkRunOperationAction
+	(BulkRunOperationStatus)(0),                   // 13: api.v2.BulkRunOperationStatus
+	(OutboundWebhookDeliveryStatus)(0),            // 14: api.v2.OutboundWebhookDeliveryStatus
+	(AlertStatus)(0),                              // 15: api.v2.AlertStatus
+	(*HealthRequest)(nil),                         // 16: api.v2.HealthRequest
+	(*FetchAccountRequest)(nil),                   // 17: api.v2.FetchAccountRequest
+	(*HealthResponse)(nil),                        // 18: api.v2.HealthResponse
+	(*HealthData)(nil),                            // 19: api.v2.HealthData
+	(*Error)(nil),                                 // 20: api.v2.Error
+	(*ErrorResponse)(nil),                         // 21: api.v2.ErrorResponse
+	(*ResponseMetadata)(nil),                      // 22: api.v2.ResponseMetadata
+	(*TimeRange)(nil),                             // 23: api.v2.TimeRange
+	(*FunctionRef)(nil),                           // 24: api.v2.FunctionRef
+	(*AppRef)(nil),                                // 25: api.v2.AppRef
+	(*FunctionApp)(nil),                           // 26: api.v2.FunctionApp
+	(*FunctionTrigger)(nil),                       // 27: api.v2.FunctionTrigger
+	(*FunctionFailureHandler)(nil),                // 28: api.v2.FunctionFailureHandler
+	(*FunctionCancellationConfiguration)(nil),     // 29: api.v2.FunctionCancellationConfiguration
+	(*FunctionRetryConfiguration)(nil),            // 30: api.v2.FunctionRetryConfiguration
+	(*FunctionEventsBatchConfiguration)(nil),      // 31: api.v2.FunctionEventsBatchConfiguration
+	(*FunctionConcurrencyLimitConfiguration)(nil), // 32: api.v2.FunctionConcurrencyLimitConfiguration
+	(*FunctionConcurrencyConfiguration)(nil),      // 33: api.v2.FunctionConcurrencyConfiguration
+	(*FunctionRateLimitConfiguration)(nil),        // 34: api.v2.FunctionRateLimitConfiguration
+	(*FunctionDebounceConfiguration)(nil),         // 35: api.v2.FunctionDebounceConfiguration
+	(*FunctionThrottleConfiguration)(nil),         // 36: api.v2.FunctionThrottleConfiguration
+	(*FunctionSingletonConfiguration)(nil),        // 37: api.v2.FunctionSingletonConfiguration
+	(*FunctionConfiguration)(nil),                 // 38: api.v2.FunctionConfiguration
+	(*Function)(nil),                              // 39: api.v2.Function
+	(*RunTrigger)(nil),                            // 40: api.v2.RunTrigger
+	(*FunctionRun)(nil),                           // 41: api.v2.FunctionRun
+	(*GetFunctionRunRequest)(nil),                 // 42: api.v2.GetFunctionRunRequest
+	(*GetFunctionRunResponse)(nil),                // 43: api.v2.GetFunctionRunResponse
+	(*GetEventRunsRequest)(nil),                   // 44: api.v2.GetEventRunsRequest
+	(*GetEventRunsResponse)(nil),                  // 45: api.v2.GetEventRunsResponse
+	(*RerunRequest)(nil),                          // 46: api.v2.RerunRequest
+	(*RerunFromStep)(nil),                         // 47: api.v2.RerunFromStep
+	(*RerunResponse)(nil),                         // 48: api.v2.RerunResponse
+	(*RerunData)(nil),                             // 49: api.v2.RerunData
+	(*TraceSpanMetadata)(nil),                     // 50: api.v2.TraceSpanMetadata
+	(*TraceSpan)(nil),                             // 51: api.v2.TraceSpan
+	(*FunctionTrace)(nil),                         // 52: api.v2.FunctionTrace
+	(*GetFunctionTraceRequest)(nil),               // 53: api.v2.GetFunctionTraceRequest
+	(*GetFunctionTraceResponse)(nil),              // 54: api.v2.GetFunctionTraceResponse
+	(*GetFunctionRequest)(nil),                    // 55: api.v2.GetFunctionRequest
+	(*GetFunctionResponse)(nil),                   // 56: api.v2.GetFunctionResponse
+	(*App)(nil),                                   // 57: api.v2.App
+	(*AppSync)(nil),                               // 58: api.v2.AppSync
+	(*GetAppRequest)(nil),                         // 59: api.v2.GetAppRequest
+	(*GetAppResponse)(nil),                        // 60: api.v2.GetAppResponse
+	(*GetAppsRequest)(nil),                        // 61: api.v2.GetAppsRequest
+	(*GetAppsResponse)(nil),                       // 62: api.v2.GetAppsResponse
+	(*GetFunctionsRequest)(nil),                   // 63: api.v2.GetFunctionsRequest
+	(*GetFunctionsResponse)(nil),                  // 64: api.v2.GetFunctionsResponse
+	(*FunctionVersion)(nil),                       // 65: api.v2.FunctionVersion
+	(*ListFunctionVersionsRequest)(nil),           // 66: api.v2.ListFunctionVersionsRequest
+	(*ListFunctionVersionsResponse)(nil),          // 67: api.v2.ListFunctionVersionsResponse
+	(*GetFunctionVersionDiffRequest)(nil),         // 68: api.v2.GetFunctionVersionDiffRequest
+	(*FunctionConfigChange)(nil),                  // 69: api.v2.FunctionConfigChange
+	(*FunctionVersionDiff)(nil),                   // 70: api.v2.FunctionVersionDiff
+	(*GetFunctionVersionDiffResponse)(nil),        // 71: api.v2.GetFunctionVersionDiffResponse
+	(*CreateAccountRequest)(nil),                  // 72: api.v2.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                 // 73: api.v2.CreateAccountResponse
+	(*CreateEnvRequest)(nil),                      // 74: api.v2.CreateEnvRequest
+	(*CreateEnvResponse)(nil),                     // 75: api.v2.CreateEnvResponse
+	(*Env)(nil),                                   // 76: api.v2.Env
+	(*CreateAccountData)(nil),                     // 77: api.v2.CreateAccountData
+	(*FetchAccountsRequest)(nil),                  // 78: api.v2.FetchAccountsRequest
+	(*FetchAccountsResponse)(nil),                 // 79: api.v2.FetchAccountsResponse
+	(*FetchAccountResponse)(nil),                  // 80: api.v2.FetchAccountResponse
+	(*Account)(nil),                               // 81: api.v2.Account
+	(*Page)(nil),                                  // 82: api.v2.Page
+	(*FetchAccountEventKeysRequest)(nil),          // 83: api.v2.FetchAccountEventKeysRequest
+	(*FetchAccountEventKeysResponse)(nil),         // 84: api.v2.FetchAccountEventKeysResponse
+	(*EventKey)(nil),                              // 85: api.v2.EventKey
+	(*FetchAccountEnvsRequest)(nil),               // 86: api.v2.FetchAccountEnvsRequest
+	(*FetchAccountEnvsResponse)(nil),              // 87: api.v2.FetchAccountEnvsResponse
+	(*FetchAccountSigningKeysRequest)(nil),        // 88: api.v2.FetchAccountSigningKeysRequest
+	(*FetchAccountSigningKeysResponse)(nil),       // 89: api.v2.FetchAccountSigningKeysResponse
+	(*SigningKey)(nil),                            // 90: api.v2.SigningKey
+	(*CreateWebhookRequest)(nil),                  // 91: api.v2.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                 // 92: api.v2.CreateWebhookResponse
+	(*EventFilter)(nil),                           // 93: api.v2.EventFilter
+	(*ListWebhooksRequest)(nil),                   // 94: api.v2.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                  // 95: api.v2.ListWebhooksResponse
+	(*Webhook)(nil),                               // 96: api.v2.Webhook
+	(*PatchEnvRequest)(nil),                       // 97: api.v2.PatchEnvRequest
+	(*PatchEnvsResponse)(nil),                     // 98: api.v2.PatchEnvsResponse
+	(*SendEventRequest)(nil),                      // 99: api.v2.SendEventRequest
+	(*SendEventResponse)(nil),                     // 100: api.v2.SendEventResponse
+	(*SendEventData)(nil),                         // 101: api.v2.SendEventData
+	(*InvokeFunctionRequest)(nil),                 // 102: api.v2.InvokeFunctionRequest
+	(*InvokeFunctionResponse)(nil),                // 103: api.v2.InvokeFunctionResponse
+	(*InvokeFunctionData)(nil),                    // 104: api.v2.InvokeFunctionData
+	(*ListScheduledInvocationsRequest)(nil),       // 105: api.v2.ListScheduledInvocationsRequest
+	(*ListScheduledInvocationsResponse)(nil),      // 106: api.v2.ListScheduledInvocationsResponse
+	(*CancelScheduledInvocationRequest)(nil),      // 107: api.v2.CancelScheduledInvocationRequest
+	(*CancelScheduledInvocationResponse)(nil),     // 108: api.v2.CancelScheduledInvocationResponse
+	(*ScheduledInvocation)(nil),                   // 109: api.v2.ScheduledInvocation
+	(*CreateScoreRequest)(nil),                    // 110: api.v2.CreateScoreRequest
+	(*CreateScoreInput)(nil),                      // 111: api.v2.CreateScoreInput
+	(*ScoreExperiment)(nil),                       // 112: api.v2.ScoreExperiment
+	(*CreateScoreResponse)(nil),                   // 113: api.v2.CreateScoreResponse
+	(*Score)(nil),                                 // 114: api.v2.Score
+	(*SyncAppRequest)(nil),                        // 115: api.v2.SyncAppRequest
+	(*SyncAppResponse)(nil),                       // 116: api.v2.SyncAppResponse
+	(*SyncAppData)(nil),                           // 117: api.v2.SyncAppData
+	(*SyncAppError)(nil),                          // 118: api.v2.SyncAppError
+	(*QueryInsightsRequest)(nil),                  // 119: api.v2.QueryInsightsRequest
+	(*QueryInsightsResponse)(nil),                 // 120: api.v2.QueryInsightsResponse
+	(*QueryInsightsData)(nil),                     // 121: api.v2.QueryInsightsData
+	(*InsightsOutputColumn)(nil),                  // 122: api.v2.InsightsOutputColumn
+	(*InsightsRow)(nil),                           // 123: api.v2.InsightsRow
+	(*InsightsDiagnostic)(nil),                    // 124: api.v2.InsightsDiagnostic
+	(*InsightsDiagnosticPosition)(nil),            // 125: api.v2.InsightsDiagnosticPosition
+	(*ListInsightsTablesRequest)(nil),             // 126: api.v2.ListInsightsTablesRequest
+	(*ListInsightsTablesResponse)(nil),            // 127: api.v2.ListInsightsTablesResponse
+	(*InsightsTable)(nil),                         // 128: api.v2.InsightsTable
+	(*InsightsTableColumn)(nil),                   // 129: api.v2.InsightsTableColumn
+	(*QueryInsightsPromptRequest)(nil),            // 130: api.v2.QueryInsightsPromptRequest
+	(*QueryInsightsPromptResponse)(nil),           // 131: api.v2.QueryInsightsPromptResponse
+	(*QueryInsightsPromptData)(nil),               // 132: api.v2.QueryInsightsPromptData
+	(*ListInsightsEventSchemasRequest)(nil),       // 133: api.v2.ListInsightsEventSchemasRequest
+	(*ListInsightsEventSchemasResponse)(nil),      // 134: api.v2.ListInsightsEventSchemasResponse
+	(*InsightsEventSchema)(nil),                   // 135: api.v2.InsightsEventSchema
+	(*ListExperimentsRequest)(nil),                // 136: api.v2.ListExperimentsRequest
+	(*ListExperimentsResponse)(nil),               // 137: api.v2.ListExperimentsResponse
+	(*Experiment)(nil),                            // 138: api.v2.Experiment
+	(*GetExperimentRequest)(nil),                  // 139: api.v2.GetExperimentRequest
+	(*GetExperimentResponse)(nil),                 // 140: api.v2.GetExperimentResponse
+	(*ExperimentDetail)(nil),                      // 141: api.v2.ExperimentDetail
+	(*ExperimentVariantMetrics)(nil),              // 142: api.v2.ExperimentVariantMetrics
+	(*ExperimentVariantMetric)(nil),               // 143: api.v2.ExperimentVariantMetric
+	(*ExperimentVariantWeight)(nil),               // 144: api.v2.ExperimentVariantWeight
+	(*ListSessionKeysRequest)(nil),                // 145: api.v2.ListSessionKeysRequest
+	(*ListSessionKeysResponse)(nil),               // 146: api.v2.ListSessionKeysResponse
+	(*SessionKey)(nil),                            // 147: api.v2.SessionKey
+	(*ListSessionsRequest)(nil),                   // 148: api.v2.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                  // 149: api.v2.ListSessionsResponse
+	(*SessionGroup)(nil),                          // 150: api.v2.SessionGroup
+	(*ListSessionRunsRequest)(nil),                // 151: api.v2.ListSessionRunsRequest
+	(*ListSessionRunsResponse)(nil),               // 152: api.v2.ListSessionRunsResponse
+	(*SessionRun)(nil),                            // 153: api.v2.SessionRun
+	(*ListRunsRequest)(nil),                       // 154: api.v2.ListRunsRequest
+	(*ListFunctionRunsRequest)(nil),               // 155: api.v2.ListFunctionRunsRequest
+	(*ListRunsResponse)(nil),                      // 156: api.v2.ListRunsResponse
+	(*ListFunctionRunsResponse)(nil),              // 157: api.v2.ListFunctionRunsResponse
+	(*CancelRunRequest)(nil),                      // 158: api.v2.CancelRunRequest
+	(*CancelRunResponse)(nil),                     // 159: api.v2.CancelRunResponse
+	(*CancelRunData)(nil),                         // 160: api.v2.CancelRunData
+	(*Wait)(nil),                                  // 161: api.v2.Wait
+	(*ListRunWaitsRequest)(nil),                   // 162: api.v2.ListRunWaitsRequest
+	(*ListRunWaitsResponse)(nil),                  // 163: api.v2.ListRunWaitsResponse
+	(*ListFunctionWaitsRequest)(nil),              // 164: api.v2.ListFunctionWaitsRequest
+	(*ListFunctionWaitsResponse)(nil),             // 165: api.v2.ListFunctionWaitsResponse
+	(*ResolveWaitRequest)(nil),                    // 166: api.v2.ResolveWaitRequest
+	(*ResolveWaitResponse)(nil),                   // 167: api.v2.ResolveWaitResponse
+	(*TimeoutWaitRequest)(nil),                    // 168: api.v2.TimeoutWaitRequest
+	(*TimeoutWaitResponse)(nil),                   // 169: api.v2.TimeoutWaitResponse
+	(*BulkRunFilter)(nil),                         // 170: api.v2.BulkRunFilter
+	(*PreviewBulkRunOperationRequest)(nil),        // 171: api.v2.PreviewBulkRunOperationRequest
+	(*PreviewBulkRunOperationResponse)(nil),       // 172: api.v2.PreviewBulkRunOperationResponse
+	(*BulkRunOperationPreview)(nil),               // 173: api.v2.BulkRunOperationPreview
+	(*CreateBulkRunOperationRequest)(nil),         // 174: api.v2.CreateBulkRunOperationRequest
+	(*CreateBulkRunOperationResponse)(nil),        // 175: api.v2.CreateBulkRunOperationResponse
+	(*ListBulkRunOperationsRequest)(nil),          // 176: api.v2.ListBulkRunOperationsRequest
+	(*ListBulkRunOperationsResponse)(nil),         // 177: api.v2.ListBulkRunOperationsResponse
+	(*GetBulkRunOperationRequest)(nil),            // 178: api.v2.GetBulkRunOperationRequest
+	(*GetBulkRunOperationResponse)(nil),           // 179: api.v2.GetBulkRunOperationResponse
+	(*AbortBulkRunOperationRequest)(nil),          // 180: api.v2.AbortBulkRunOperationRequest
+	(*AbortBulkRunOperationResponse)(nil),         // 181: api.v2.AbortBulkRunOperationResponse
+	(*BulkRunOperation)(nil),                      // 182: api.v2.BulkRunOperation
+	(*CreateOutboundWebhookRequest)(nil),          // 183: api.v2.CreateOutboundWebhookRequest
+	(*CreateOutboundWebhookResponse)(nil),         // 184: api.v2.CreateOutboundWebhookResponse
+	(*ListOutboundWebhooksRequest)(nil),           // 185: api.v2.ListOutboundWebhooksRequest
+	(*ListOutboundWebhooksResponse)(nil),          // 186: api.v2.ListOutboundWebhooksResponse
+	(*GetOutboundWebhookRequest)(nil),             // 187: api.v2.GetOutboundWebhookRequest
+	(*GetOutboundWebhookResponse)(nil),            // 188: api.v2.GetOutboundWebhookResponse
+	(*UpdateOutboundWebhookRequest)(nil),          // 189: api.v2.UpdateOutboundWebhookRequest
+	(*UpdateOutboundWebhookResponse)(nil),         // 190: api.v2.UpdateOutboundWebhookResponse
+	(*DeleteOutboundWebhookRequest)(nil),          // 191: api.v2.DeleteOutboundWebhookRequest
+	(*DeleteOutboundWebhookResponse)(nil),         // 192: api.v2.DeleteOutboundWebhookResponse
+	(*ListOutboundWebhookDeliveriesRequest)(nil),  // 193: api.v2.ListOutboundWebhookDeliveriesRequest
+	(*ListOutboundWebhookDeliveriesResponse)(nil), // 194: api.v2.ListOutboundWebhookDeliveriesResponse
+	(*ReplayOutboundWebhookDeliveryRequest)(nil),  // 195: api.v2.ReplayOutboundWebhookDeliveryRequest
+	(*ReplayOutboundWebhookDeliveryResponse)(nil), // 196: api.v2.ReplayOutboundWebhookDeliveryResponse
+	(*OutboundWebhook)(nil),                       // 197: api.v2.OutboundWebhook
+	(*OutboundWebhookDelivery)(nil),               // 198: api.v2.OutboundWebhookDelivery
+	(*OutboundWebhookDeliveryAttempt)(nil),        // 199: api.v2.OutboundWebhookDeliveryAttempt
+	(*CreateAlertRuleRequest)(nil),                // 200: api.v2.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),               // 201: api.v2.CreateAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),                 // 202: api.v2.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),                // 203: api.v2.ListAlertRulesResponse
+	(*GetAlertRuleRequest)(nil),                   // 204: api.v2.GetAlertRuleRequest
+	(*GetAlertRuleResponse)(nil),                  // 205: api.v2.GetAlertRuleResponse
+	(*UpdateAlertRuleRequest)(nil),                // 206: api.v2.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil),               // 207: api.v2.UpdateAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),                // 208: api.v2.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),               // 209: api.v2.DeleteAlertRuleResponse
+	(*ListAlertsRequest)(nil),                     // 210: api.v2.ListAlertsRequest
+	(*ListAlertsResponse)(nil),                    // 211: api.v2.ListAlertsResponse
+	(*AlertRule)(nil),                             // 212: api.v2.AlertRule
+	(*Alert)(nil),                                 // 213: api.v2.Alert
+	(*GetUsageRequest)(nil),                       // 214: api.v2.GetUsageRequest
+	(*GetUsageResponse)(nil),                      // 215: api.v2.GetUsageResponse
+	(*UsageBucket)(nil),                           // 216: api.v2.UsageBucket
+	(*UsageTotals)(nil),                           // 217: api.v2.UsageTotals
+	nil,                                           // 218: api.v2.TraceSpanMetadata.ValuesEntry
+	(*timestamppb.Timestamp)(nil),                 // 219: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                       // 220: google.protobuf.Struct
+	(*structpb.ListValue)(nil),                    // 221: google.protobuf.ListValue
+	(*structpb.Value)(nil),                        // 222: google.protobuf.Value
+	(*CreateSandboxRequest)(nil),                  // 223: api.v2.CreateSandboxRequest
+	(*ListSandboxesRequest)(nil),                  // 224: api.v2.ListSandboxesRequest
+	(*GetSandboxRequest)(nil),                     // 225: api.v2.GetSandboxRequest
+	(*DestroySandboxRequest)(nil),                 // 226: api.v2.DestroySandboxRequest
+	(*ExecSandboxRequest)(nil),                    // 227: api.v2.ExecSandboxRequest
+	(*StreamSandboxLogsRequest)(nil),              // 228: api.v2.StreamSandboxLogsRequest
+	(*WriteSandboxFileRequest)(nil),               // 229: api.v2.WriteSandboxFileRequest
+	(*ReadSandboxFileRequest)(nil),                // 230: api.v2.ReadSandboxFileRequest
+	(*StartSandboxProcessRequest)(nil),            // 231: api.v2.StartSandboxProcessRequest
+	(*ListSandboxProcessesRequest)(nil),           // 232: api.v2.ListSandboxProcessesRequest
+	(*GetSandboxProcessRequest)(nil),              // 233: api.v2.GetSandboxProcessRequest
+	(*SignalSandboxProcessRequest)(nil),           // 234: api.v2.SignalSandboxProcessRequest
+	(*WaitSandboxProcessRequest)(nil),             // 235: api.v2.WaitSandboxProcessRequest
+	(*GetSandboxProcessOutputRequest)(nil),        // 236: api.v2.GetSandboxProcessOutputRequest
+	(*StreamSandboxProcessOutputRequest)(nil),     // 237: api.v2.StreamSandboxProcessOutputRequest
+	(*CreateSandboxResponse)(nil),                 // 238: api.v2.CreateSandboxResponse
+	(*ListSandboxesResponse)(nil),                 // 239: api.v2.ListSandboxesResponse
+	(*GetSandboxResponse)(nil),                    // 240: api.v2.GetSandboxResponse
+	(*DestroySandboxResponse)(nil),                // 241: api.v2.DestroySandboxResponse
+	(*ExecSandboxResponse)(nil),                   // 242: api.v2.ExecSandboxResponse
+	(*StreamSandboxLogsResponse)(nil),             // 243: api.v2.StreamSandboxLogsResponse
+	(*WriteSandboxFileResponse)(nil),              // 244: api.v2.WriteSandboxFileResponse
+	(*httpbody.HttpBody)(nil),                     // 245: google.api.HttpBody
+	(*StartSandboxProcessResponse)(nil),           // 246: api.v2.StartSandboxProcessResponse
+	(*ListSandboxProcessesResponse)(nil),          // 247: api.v2.ListSandboxProcessesResponse
+	(*GetSandboxProcessResponse)(nil),             // 248: api.v2.GetSandboxProcessResponse
+	(*SignalSandboxProcessResponse)(nil),          // 249: api.v2.SignalSandboxProcessResponse
+	(*WaitSandboxProcessResponse)(nil),            // 250: api.v2.WaitSandboxProcessResponse
+	(*GetSandboxProcessOutputResponse)(nil),       // 251: api.v2.GetSandboxProcessOutputResponse
+	(*StreamSandboxProcessOutputResponse)(nil),    // 252: api.v2.StreamSandboxProcessOutputResponse
 }
 var file_api_v2_service_proto_depIdxs = []int32{
-	14,  // 0: api.v2.HealthResponse.data:type_name -> api.v2.HealthData
-	17,  // 1: api.v2.HealthResponse.metadata:type_name -> api.v2.ResponseMetadata
-	15,  // 2: api.v2.ErrorResponse.errors:type_name -> api.v2.Error
-	145, // 3: api.v2.ResponseMetadata.fetched_at:type_name -> google.protobuf.Timestamp
-	145, // 4: api.v2.ResponseMetadata.cached_until:type_name -> google.protobuf.Timestamp
-	18,  // 5: api.v2.ResponseMetadata.time_range:type_name -> api.v2.TimeRange
-	145, // 6: api.v2.TimeRange.from:type_name -> google.protobuf.Timestamp
-	145, // 7: api.v2.TimeRange.until:type_name -> google.protobuf.Timestamp
-	20,  // 8: api.v2.FunctionRef.app:type_name -> api.v2.AppRef
+	19,  // 0: api.v2.HealthResponse.data:type_name -> api.v2.HealthData
+	22,  // 1: api.v2.HealthResponse.metadata:type_name -> api.v2.ResponseMetadata
+	20,  // 2: api.v2.ErrorResponse.errors:type_name -> api.v2.Error
+	219, // 3: api.v2.ResponseMetadata.fetched_at:type_name -> google.protobuf.Timestamp
+	219, // 4: api.v2.ResponseMetadata.cached_until:type_name -> google.protobuf.Timestamp
+	23,  // 5: api.v2.ResponseMetadata.time_range:type_name -> api.v2.TimeRange
+	219, // 6: api.v2.TimeRange.from:type_name -> google.protobuf.Timestamp
+	219, // 7: api.v2.TimeRange.until:type_name -> google.protobuf.Timestamp
+	25,  // 8: api.v2.FunctionRef.app:type_name -> api.v2.AppRef
 	3,   // 9: api.v2.FunctionTrigger.type:type_name -> api.v2.FunctionTriggerType
 	4,   // 10: api.v2.FunctionConcurrencyConfiguration.scope:type_name -> api.v2.FunctionConcurrencyScope
-	27,  // 11: api.v2.FunctionConcurrencyConfiguration.limit:type_name -> api.v2.FunctionConcurrencyLimitConfiguration
+	32,  // 11: api.v2.FunctionConcurrencyConfiguration.limit:type_name -> api.v2.FunctionConcurrencyLimitConfiguration
 	5,   // 12: api.v2.FunctionSingletonConfiguration.mode:type_name -> api.v2.FunctionSingletonMode
-	24,  // 13: api.v2.FunctionConfiguration.cancellations:type_name -> api.v2.FunctionCancellationConfiguration
-	25,  // 14: api.v2.FunctionConfiguration.retries:type_name -> api.v2.FunctionRetryConfiguration
-	26,  // 15: api.v2.FunctionConfiguration.events_batch:type_name -> api.v2.FunctionEventsBatchConfiguration
-	28,  // 16: api.v2.FunctionConfiguration.concurrency:type_name -> api.v2.FunctionConcurrencyConfiguration
-	29,  // 17: api.v2.FunctionConfiguration.rate_limit:type_name -> api.v2.FunctionRateLimitConfiguration
-	30,  // 18: api.v2.FunctionConfiguration.debounce:type_name -> api.v2.FunctionDebounceConfiguration
-	31,  // 19: api.v2.FunctionConfiguration.throttle:type_name -> api.v2.FunctionThrottleConfiguration
-	32,  // 20: api.v2.FunctionConfiguration.singleton:type_name -> api.v2.FunctionSingletonConfiguration
-	21,  // 21: api.v2.Function.app:type_name -> api.v2.FunctionApp
-	22,  // 22: api.v2.Function.triggers:type_name -> api.v2.FunctionTrigger
-	23,  // 23: api.v2.Function.failure_handler:type_name -> api.v2.FunctionFailureHandler
-	33,  // 24: api.v2.Function.configuration:type_name -> api.v2.FunctionConfiguration
-	19,  // 25: api.v2.FunctionRun.function:type_name -> api.v2.FunctionRef
-	20,  // 26: api.v2.FunctionRun.app:type_name -> api.v2.AppRef
+	29,  // 13: api.v2.FunctionConfiguration.cancellations:type_name -> api.v2.FunctionCancellationConfiguration
+	30,  // 14: api.v2.FunctionConfiguration.retries:type_name -> api.v2.FunctionRetryConfiguration
+	31,  // 15: api.v2.FunctionConfiguration.events_batch:type_name -> api.v2.FunctionEventsBatchConfiguration
+	33,  // 16: api.v2.FunctionConfiguration.concurrency:type_name -> api.v2.FunctionConcurrencyConfiguration
+	34,  // 17: api.v2.FunctionConfiguration.rate_limit:type_name -> api.v2.FunctionRateLimitConfiguration
+	35,  // 18: api.v2.FunctionConfiguration.debounce:type_name -> api.v2.FunctionDebounceConfiguration
+	36,  // 19: api.v2.FunctionConfiguration.throttle:type_name -> api.v2.FunctionThrottleConfiguration
+	37,  // 20: api.v2.FunctionConfiguration.singleton:type_name -> api.v2.FunctionSingletonConfiguration
+	26,  // 21: api.v2.Function.app:type_name -> api.v2.FunctionApp
+	27,  // 22: api.v2.Function.triggers:type_name -> api.v2.FunctionTrigger
+	28,  // 23: api.v2.Function.failure_handler:type_name -> api.v2.FunctionFailureHandler
+	38,  // 24: api.v2.Function.configuration:type_name -> api.v2.FunctionConfiguration
+	24,  // 25: api.v2.FunctionRun.function:type_name -> api.v2.FunctionRef
+	25,  // 26: api.v2.FunctionRun.app:type_name -> api.v2.AppRef
 	0,   // 27: api.v2.FunctionRun.status:type_name -> api.v2.FunctionRunStatus
-	145, // 28: api.v2.FunctionRun.queued_at:type_name -> google.protobuf.Timestamp
-	145, // 29: api.v2.FunctionRun.started_at:type_name -> google.protobuf.Timestamp
-	145, // 30: api.v2.FunctionRun.ended_at:type_name -> google.protobuf.Timestamp
-	35,  // 31: api.v2.FunctionRun.trigger:type_name -> api.v2.RunTrigger
-	146, // 32: api.v2.FunctionRun.output:type_name -> google.protobuf.Struct
-	36,  // 33: api.v2.GetFunctionRunResponse.data:type_name -> api.v2.FunctionRun
-	17,  // 34: api.v2.GetFunctionRunResponse.metadata:type_name -> api.v2.ResponseMetadata
-	36,  // 35: api.v2.GetEventRunsResponse.data:type_name -> api.v2.FunctionRun
-	17,  // 36: api.v2.GetEventRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 37: api.v2.GetEventRunsResponse.page:type_name -> api.v2.Page
-	42,  // 38: api.v2.RerunRequest.from_step:type_name -> api.v2.RerunFromStep
-	147, // 39: api.v2.RerunFromStep.input:type_name -> google.protobuf.ListValue
-	44,  // 40: api.v2.RerunResponse.data:type_name -> api.v2.RerunData
-	17,  // 41: api.v2.RerunResponse.metadata:type_name -> api.v2.ResponseMetadata
-	144, // 42: api.v2.TraceSpanMetadata.values:type_name -> api.v2.TraceSpanMetadata.ValuesEntry
-	145, // 43: api.v2.TraceSpanMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	219, // 28: api.v2.FunctionRun.queued_at:type_name -> google.protobuf.Timestamp
+	219, // 29: api.v2.FunctionRun.started_at:type_name -> google.protobuf.Timestamp
+	219, // 30: api.v2.FunctionRun.ended_at:type_name -> google.protobuf.Timestamp
+	40,  // 31: api.v2.FunctionRun.trigger:type_name -> api.v2.RunTrigger
+	220, // 32: api.v2.FunctionRun.output:type_name -> google.protobuf.Struct
+	41,  // 33: api.v2.GetFunctionRunResponse.data:type_name -> api.v2.FunctionRun
+	22,  // 34: api.v2.GetFunctionRunResponse.metadata:type_name -> api.v2.ResponseMetadata
+	41,  // 35: api.v2.GetEventRunsResponse.data:type_name -> api.v2.FunctionRun
+	22,  // 36: api.v2.GetEventRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 37: api.v2.GetEventRunsResponse.page:type_name -> api.v2.Page
+	47,  // 38: api.v2.RerunRequest.from_step:type_name -> api.v2.RerunFromStep
+	221, // 39: api.v2.RerunFromStep.input:type_name -> google.protobuf.ListValue
+	49,  // 40: api.v2.RerunResponse.data:type_name -> api.v2.RerunData
+	22,  // 41: api.v2.RerunResponse.metadata:type_name -> api.v2.ResponseMetadata
+	218, // 42: api.v2.TraceSpanMetadata.values:type_name -> api.v2.TraceSpanMetadata.ValuesEntry
+	219, // 43: api.v2.TraceSpanMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	1,   // 44: api.v2.TraceSpan.status:type_name -> api.v2.TraceSpanStatus
 	2,   // 45: api.v2.TraceSpan.step_op:type_name -> api.v2.TraceStepOp
-	145, // 46: api.v2.TraceSpan.queued_at:type_name -> google.protobuf.Timestamp
-	145, // 47: api.v2.TraceSpan.started_at:type_name -> google.protobuf.Timestamp
-	145, // 48: api.v2.TraceSpan.ended_at:type_name -> google.protobuf.Timestamp
-	146, // 49: api.v2.TraceSpan.input:type_name -> google.protobuf.Struct
-	146, // 50: api.v2.TraceSpan.output:type_name -> google.protobuf.Struct
-	45,  // 51: api.v2.TraceSpan.metadata:type_name -> api.v2.TraceSpanMetadata
-	46,  // 52: api.v2.TraceSpan.children:type_name -> api.v2.TraceSpan
-	46,  // 53: api.v2.FunctionTrace.root_span:type_name -> api.v2.TraceSpan
-	47,  // 54: api.v2.GetFunctionTraceResponse.data:type_name -> api.v2.FunctionTrace
-	17,  // 55: api.v2.GetFunctionTraceResponse.metadata:type_name -> api.v2.ResponseMetadata
-	34,  // 56: api.v2.GetFunctionResponse.data:type_name -> api.v2.Function
-	17,  // 57: api.v2.GetFunctionResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 46: api.v2.TraceSpan.queued_at:type_name -> google.protobuf.Timestamp
+	219, // 47: api.v2.TraceSpan.started_at:type_name -> google.protobuf.Timestamp
+	219, // 48: api.v2.TraceSpan.ended_at:type_name -> google.protobuf.Timestamp
+	220, // 49: api.v2.TraceSpan.input:type_name -> google.protobuf.Struct
+	220, // 50: api.v2.TraceSpan.output:type_name -> google.protobuf.Struct
+	50,  // 51: api.v2.TraceSpan.metadata:type_name -> api.v2.TraceSpanMetadata
+	51,  // 52: api.v2.TraceSpan.children:type_name -> api.v2.TraceSpan
+	51,  // 53: api.v2.FunctionTrace.root_span:type_name -> api.v2.TraceSpan
+	52,  // 54: api.v2.GetFunctionTraceResponse.data:type_name -> api.v2.FunctionTrace
+	22,  // 55: api.v2.GetFunctionTraceResponse.metadata:type_name -> api.v2.ResponseMetadata
+	39,  // 56: api.v2.GetFunctionResponse.data:type_name -> api.v2.Function
+	22,  // 57: api.v2.GetFunctionResponse.metadata:type_name -> api.v2.ResponseMetadata
 	6,   // 58: api.v2.App.method:type_name -> api.v2.AppMethod
-	145, // 59: api.v2.App.created_at:type_name -> google.protobuf.Timestamp
-	145, // 60: api.v2.App.archived_at:type_name -> google.protobuf.Timestamp
-	53,  // 61: api.v2.App.latest_sync:type_name -> api.v2.AppSync
-	145, // 62: api.v2.AppSync.synced_at:type_name -> google.protobuf.Timestamp
-	52,  // 63: api.v2.GetAppResponse.data:type_name -> api.v2.App
-	17,  // 64: api.v2.GetAppResponse.metadata:type_name -> api.v2.ResponseMetadata
-	52,  // 65: api.v2.GetAppsResponse.data:type_name -> api.v2.App
-	17,  // 66: api.v2.GetAppsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 67: api.v2.GetAppsResponse.page:type_name -> api.v2.Page
-	34,  // 68: api.v2.GetFunctionsResponse.data:type_name -> api.v2.Function
-	17,  // 69: api.v2.GetFunctionsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 70: api.v2.GetFunctionsResponse.page:type_name -> api.v2.Page
-	65,  // 71: api.v2.CreateAccountResponse.data:type_name -> api.v2.CreateAccountData
-	17,  // 72: api.v2.CreateAccountResponse.metadata:type_name -> api.v2.ResponseMetadata
-	64,  // 73: api.v2.CreateEnvResponse.data:type_name -> api.v2.Env
-	17,  // 74: api.v2.CreateEnvResponse.metadata:type_name -> api.v2.ResponseMetadata
-	7,   // 75: api.v2.Env.type:type_name -> api.v2.EnvType
-	145, // 76: api.v2.Env.createdAt:type_name -> google.protobuf.Timestamp
-	145, // 77: api.v2.CreateAccountData.createdAt:type_name -> google.protobuf.Timestamp
-	145, // 78: api.v2.CreateAccountData.updatedAt:type_name -> google.protobuf.Timestamp
-	69,  // 79: api.v2.FetchAccountsResponse.data:type_name -> api.v2.Account
-	17,  // 80: api.v2.FetchAccountsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 81: api.v2.FetchAccountsResponse.page:type_name -> api.v2.Page
-	69,  // 82: api.v2.FetchAccountResponse.data:type_name -> api.v2.Account
-	17,  // 83: api.v2.FetchAccountResponse.metadata:type_name -> api.v2.ResponseMetadata
-	145, // 84: api.v2.Account.createdAt:type_name -> google.protobuf.Timestamp
-	145, // 85: api.v2.Account.updatedAt:type_name -> google.protobuf.Timestamp
-	73,  // 86: api.v2.FetchAccountEventKeysResponse.data:type_name -> api.v2.EventKey
-	17,  // 87: api.v2.FetchAccountEventKeysResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 88: api.v2.FetchAccountEventKeysResponse.page:type_name -> api.v2.Page
-	145, // 89: api.v2.EventKey.createdAt:type_name -> google.protobuf.Timestamp
-	64,  // 90: api.v2.FetchAccountEnvsResponse.data:type_name -> api.v2.Env
-	17,  // 91: api.v2.FetchAccountEnvsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 92: api.v2.FetchAccountEnvsResponse.page:type_name -> api.v2.Page
-	78,  // 93: api.v2.FetchAccountSigningKeysResponse.data:type_name -> api.v2.SigningKey
-	17,  // 94: api.v2.FetchAccountSigningKeysResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 95: api.v2.FetchAccountSigningKeysResponse.page:type_name -> api.v2.Page
-	145, // 96: api.v2.SigningKey.createdAt:type_name -> google.protobuf.Timestamp
-	81,  // 97: api.v2.CreateWebhookRequest.event_filter:type_name -> api.v2.EventFilter
-	84,  // 98: api.v2.CreateWebhookResponse.data:type_name -> api.v2.Webhook
-	17,  // 99: api.v2.CreateWebhookResponse.metadata:type_name -> api.v2.ResponseMetadata
-	8,   // 100: api.v2.EventFilter.filter:type_name -> api.v2.FilterType
-	84,  // 101: api.v2.ListWebhooksResponse.data:type_name -> api.v2.Webhook
-	17,  // 102: api.v2.ListWebhooksResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 103: api.v2.ListWebhooksResponse.page:type_name -> api.v2.Page
-	81,  // 104: api.v2.Webhook.event_filter:type_name -> api.v2.EventFilter
-	145, // 105: api.v2.Webhook.createdAt:type_name -> google.protobuf.Timestamp
-	145, // 106: api.v2.Webhook.updatedAt:type_name -> google.protobuf.Timestamp
-	64,  // 107: api.v2.PatchEnvsResponse.data:type_name -> api.v2.Env
-	17,  // 108: api.v2.PatchEnvsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	146, // 109: api.v2.SendEventRequest.data:type_name -> google.protobuf.Struct
-	146, // 110: api.v2.SendEventRequest.user:type_name -> google.protobuf.Struct
-	89,  // 111: api.v2.SendEventResponse.data:type_name -> api.v2.SendEventData
-	17,  // 112: api.v2.SendEventResponse.metadata:type_name -> api.v2.ResponseMetadata
-	146, // 113: api.v2.InvokeFunctionRequest.data:type_name -> google.protobuf.Struct
-	92,  // 114: api.v2.InvokeFunctionResponse.data:type_name -> api.v2.InvokeFunctionData
-	17,  // 115: api.v2.InvokeFunctionResponse.metadata:type_name -> api.v2.ResponseMetadata
-	145, // 116: api.v2.InvokeFunctionData.queued_at:type_name -> google.protobuf.Timestamp
-	145, // 117: api.v2.InvokeFunctionData.started_at:type_name -> google.protobuf.Timestamp
-	145, // 118: api.v2.InvokeFunctionData.completed_at:type_name -> google.protobuf.Timestamp
-	94,  // 119: api.v2.CreateScoreRequest.scores:type_name -> api.v2.CreateScoreInput
-	148, // 120: api.v2.CreateScoreInput.value:type_name -> google.protobuf.Value
-	95,  // 121: api.v2.CreateScoreInput.experiment:type_name -> api.v2.ScoreExperiment
-	97,  // 122: api.v2.CreateScoreResponse.data:type_name -> api.v2.Score
-	17,  // 123: api.v2.CreateScoreResponse.metadata:type_name -> api.v2.ResponseMetadata
-	148, // 124: api.v2.Score.value:type_name -> google.protobuf.Value
-	95,  // 125: api.v2.Score.experiment:type_name -> api.v2.ScoreExperiment
-	100, // 126: api.v2.SyncAppResponse.data:type_name -> api.v2.SyncAppData
-	17,  // 127: api.v2.SyncAppResponse.metadata:type_name -> api.v2.ResponseMetadata
-	101, // 128: api.v2.SyncAppData.error:type_name -> api.v2.SyncAppError
-	104, // 129: api.v2.QueryInsightsResponse.data:type_name -> api.v2.QueryInsightsData
-	17,  // 130: api.v2.QueryInsightsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	105, // 131: api.v2.QueryInsightsData.columns:type_name -> api.v2.InsightsOutputColumn
-	106, // 132: api.v2.QueryInsightsData.rows:type_name -> api.v2.InsightsRow
-	107, // 133: api.v2.QueryInsightsData.diagnostics:type_name -> api.v2.InsightsDiagnostic
-	9,   // 134: api.v2.InsightsOutputColumn.type:type_name -> api.v2.InsightsOutputColumnType
-	148, // 135: api.v2.InsightsRow.values:type_name -> google.protobuf.Value
-	10,  // 136: api.v2.InsightsDiagnostic.severity:type_name -> api.v2.InsightsDiagnosticSeverity
-	108, // 137: api.v2.InsightsDiagnostic.position:type_name -> api.v2.InsightsDiagnosticPosition
-	111, // 138: api.v2.ListInsightsTablesResponse.data:type_name -> api.v2.InsightsTable
-	17,  // 139: api.v2.ListInsightsTablesResponse.metadata:type_name -> api.v2.ResponseMetadata
-	112, // 140: api.v2.InsightsTable.columns:type_name -> api.v2.InsightsTableColumn
-	115, // 141: api.v2.QueryInsightsPromptResponse.data:type_name -> api.v2.QueryInsightsPromptData
-	17,  // 142: api.v2.QueryInsightsPromptResponse.metadata:type_name -> api.v2.ResponseMetadata
-	118, // 143: api.v2.ListInsightsEventSchemasResponse.data:type_name -> api.v2.InsightsEventSchema
-	17,  // 144: api.v2.ListInsightsEventSchemasResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 145: api.v2.ListInsightsEventSchemasResponse.page:type_name -> api.v2.Page
-	146, // 146: api.v2.InsightsEventSchema.schema:type_name -> google.protobuf.Struct
-	145, // 147: api.v2.ListExperimentsRequest.from:type_name -> google.protobuf.Timestamp
-	145, // 148: api.v2.ListExperimentsRequest.until:type_name -> google.protobuf.Timestamp
-	121, // 149: api.v2.ListExperimentsResponse.data:type_name -> api.v2.Experiment
-	17,  // 150: api.v2.ListExperimentsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 151: api.v2.ListExperimentsResponse.page:type_name -> api.v2.Page
-	19,  // 152: api.v2.Experiment.function:type_name -> api.v2.FunctionRef
-	145, // 153: api.v2.Experiment.first_seen:type_name -> google.protobuf.Timestamp
-	145, // 154: api.v2.Experiment.last_seen:type_name -> google.protobuf.Timestamp
-	145, // 155: api.v2.GetExperimentRequest.from:type_name -> google.protobuf.Timestamp
-	145, // 156: api.v2.GetExperimentRequest.until:type_name -> google.protobuf.Timestamp
-	124, // 157: api.v2.GetExperimentResponse.data:type_name -> api.v2.ExperimentDetail
-	17,  // 158: api.v2.GetExperimentResponse.metadata:type_name -> api.v2.ResponseMetadata
-	125, // 159: api.v2.ExperimentDetail.variants:type_name -> api.v2.ExperimentVariantMetrics
-	127, // 160: api.v2.ExperimentDetail.variant_weights:type_name -> api.v2.ExperimentVariantWeight
-	145, // 161: api.v2.ExperimentDetail.first_seen:type_name -> google.protobuf.Timestamp
-	145, // 162: api.v2.ExperimentDetail.last_seen:type_name -> google.protobuf.Timestamp
-	126, // 163: api.v2.ExperimentVariantMetrics.metrics:type_name -> api.v2.ExperimentVariantMetric
-	130, // 164: api.v2.ListSessionKeysResponse.data:type_name -> api.v2.SessionKey
-	17,  // 165: api.v2.ListSessionKeysResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 166: api.v2.ListSessionKeysResponse.page:type_name -> api.v2.Page
-	145, // 167: api.v2.SessionKey.created_at:type_name -> google.protobuf.Timestamp
-	145, // 168: api.v2.ListSessionsRequest.from:type_name -> google.protobuf.Timestamp
-	145, // 169: api.v2.ListSessionsRequest.until:type_name -> google.protobuf.Timestamp
-	133, // 170: api.v2.ListSessionsResponse.data:type_name -> api.v2.SessionGroup
-	17,  // 171: api.v2.ListSessionsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 172: api.v2.ListSessionsResponse.page:type_name -> api.v2.Page
-	145, // 173: api.v2.SessionGroup.last_active_at:type_name -> google.protobuf.Timestamp
-	19,  // 174: api.v2.SessionGroup.functions:type_name -> api.v2.FunctionRef
-	145, // 175: api.v2.ListSessionRunsRequest.from:type_name -> google.protobuf.Timestamp
-	145, // 176: api.v2.ListSessionRunsRequest.until:type_name -> google.protobuf.Timestamp
-	136, // 177: api.v2.ListSessionRunsResponse.data:type_name -> api.v2.SessionRun
-	17,  // 178: api.v2.ListSessionRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 179: api.v2.ListSessionRunsResponse.page:type_name -> api.v2.Page
-	19,  // 180: api.v2.SessionRun.function:type_name -> api.v2.FunctionRef
-	0,   // 181: api.v2.SessionRun.status:type_name -> api.v2.FunctionRunStatus
-	145, // 182: api.v2.SessionRun.queued_at:type_name -> google.protobuf.Timestamp
-	145, // 183: api.v2.SessionRun.started_at:type_name -> google.protobuf.Timestamp
-	145, // 184: api.v2.SessionRun.ended_at:type_name -> google.protobuf.Timestamp
-	145, // 185: api.v2.ListRunsRequest.from:type_name -> google.protobuf.Timestamp
-	145, // 186: api.v2.ListRunsRequest.until:type_name -> google.protobuf.Timestamp
-	145, // 187: api.v2.ListFunctionRunsRequest.from:type_name -> google.protobuf.Timestamp
-	145, // 188: api.v2.ListFunctionRunsRequest.until:type_name -> google.protobuf.Timestamp
-	36,  // 189: api.v2.ListRunsResponse.data:type_name -> api.v2.FunctionRun
-	17,  // 190: api.v2.ListRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 191: api.v2.ListRunsResponse.page:type_name -> api.v2.Page
-	36,  // 192: api.v2.ListFunctionRunsResponse.data:type_name -> api.v2.FunctionRun
-	17,  // 193: api.v2.ListFunctionRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
-	70,  // 194: api.v2.ListFunctionRunsResponse.page:type_name -> api.v2.Page
-	143, // 195: api.v2.CancelRunResponse.data:type_name -> api.v2.CancelRunData
-	17,  // 196: api.v2.CancelRunResponse.metadata:type_name -> api.v2.ResponseMetadata
-	11,  // 197: api.v2.V2.Health:input_type -> api.v2.HealthRequest
-	11,  // 198: api.v2.V2._SchemaOnly:input_type -> api.v2.HealthRequest
-	60,  // 199: api.v2.V2.CreatePartnerAccount:input_type -> api.v2.CreateAccountRequest
-	62,  // 200: api.v2.V2.CreateEnv:input_type -> api.v2.CreateEnvRequest
-	66,  // 201: api.v2.V2.FetchPartnerAccounts:input_type -> api.v2.FetchAccountsRequest
-	12,  // 202: api.v2.V2.FetchAccount:input_type -> api.v2.FetchAccountRequest
-	74,  // 203: api.v2.V2.FetchAccountEnvs:input_type -> api.v2.FetchAccountEnvsRequest
-	71,  // 204: api.v2.V2.FetchAccountEventKeys:input_type -> api.v2.FetchAccountEventKeysRequest
-	76,  // 205: api.v2.V2.FetchAccountSigningKeys:input_type -> api.v2.FetchAccountSigningKeysRequest
-	79,  // 206: api.v2.V2.CreateWebhook:input_type -> api.v2.CreateWebhookRequest
-	82,  // 207: api.v2.V2.ListWebhooks:input_type -> api.v2.ListWebhooksRequest
-	85,  // 208: api.v2.V2.PatchEnv:input_type -> api.v2.PatchEnvRequest
-	37,  // 209: api.v2.V2.GetFunctionRun:input_type -> api.v2.GetFunctionRunRequest
-	137, // 210: api.v2.V2.ListRuns:input_type -> api.v2.ListRunsRequest
-	138, // 211: api.v2.V2.ListFunctionRuns:input_type -> api.v2.ListFunctionRunsRequest
-	39,  // 212: api.v2.V2.GetEventRuns:input_type -> api.v2.GetEventRunsRequest
-	41,  // 213: api.v2.V2.Rerun:input_type -> api.v2.RerunRequest
-	141, // 214: api.v2.V2.CancelRun:input_type -> api.v2.CancelRunRequest
-	54,  // 215: api.v2.V2.GetApp:input_type -> api.v2.GetAppRequest
-	56,  // 216: api.v2.V2.GetApps:input_type -> api.v2.GetAppsRequest
-	149, // 217: api.v2.V2.CreateSandbox:input_type -> api.v2.CreateSandboxRequest
-	150, // 218: api.v2.V2.ListSandboxes:input_type -> api.v2.ListSandboxesRequest
-	151, // 219: api.v2.V2.GetSandbox:input_type -> api.v2.GetSandboxRequest
-	152, // 220: api.v2.V2.DestroySandbox:input_type -> api.v2.DestroySandboxRequest
-	153, // 221: api.v2.V2.ExecSandbox:input_type -> api.v2.ExecSandboxRequest
-	154, // 222: api.v2.V2.StreamSandboxLogs:input_type -> api.v2.StreamSandboxLogsRequest
-	155, // 223: api.v2.V2.WriteSandboxFile:input_type -> api.v2.WriteSandboxFileRequest
-	156, // 224: api.v2.V2.ReadSandboxFile:input_type -> api.v2.ReadSandboxFileRequest
-	157, // 225: api.v2.V2.StartSandboxProcess:input_type -> api.v2.StartSandboxProcessRequest
-	158, // 226: api.v2.V2.ListSandboxProcesses:input_type -> api.v2.ListSandboxProcessesRequest
-	159, // 227: api.v2.V2.GetSandboxProcess:input_type -> api.v2.GetSandboxProcessRequest
-	160, // 228: api.v2.V2.SignalSandboxProcess:input_type -> api.v2.SignalSandboxProcessRequest
-	161, // 229: api.v2.V2.WaitSandboxProcess:input_type -> api.v2.WaitSandboxProcessRequest
-	162, // 230: api.v2.V2.GetSandboxProcessOutput:input_type -> api.v2.GetSandboxProcessOutputRequest
-	163, // 231: api.v2.V2.StreamSandboxProcessOutput:input_type -> api.v2.StreamSandboxProcessOutputRequest
-	93,  // 232: api.v2.V2.CreateScore:input_type -> api.v2.CreateScoreRequest
-	98,  // 233: api.v2.V2.SyncApp:input_type -> api.v2.SyncAppRequest
-	48,  // 234: api.v2.V2.GetFunctionTrace:input_type -> api.v2.GetFunctionTraceRequest
-	50,  // 235: api.v2.V2.GetFunction:input_type -> api.v2.GetFunctionRequest
-	58,  // 236: api.v2.V2.GetFunctions:input_type -> api.v2.GetFunctionsRequest
-	87,  // 237: api.v2.V2.SendEvent:input_type -> api.v2.SendEventRequest
-	90,  // 238: api.v2.V2.InvokeFunction:input_type -> api.v2.InvokeFunctionRequest
-	109, // 239: api.v2.V2.ListInsightsTables:input_type -> api.v2.ListInsightsTablesRequest
-	116, // 240: api.v2.V2.ListInsightsEventSchemas:input_type -> api.v2.ListInsightsEventSchemasRequest
-	113, // 241: api.v2.V2.QueryInsightsPrompt:input_type -> api.v2.QueryInsightsPromptRequest
-	102, // 242: api.v2.V2.QueryInsights:input_type -> api.v2.QueryInsightsRequest
-	119, // 243: api.v2.V2.ListExperiments:input_type -> api.v2.ListExperimentsRequest
-	122, // 244: api.v2.V2.GetExperiment:input_type -> api.v2.GetExperimentRequest
-	128, // 245: api.v2.V2.ListSessionKeys:input_type -> api.v2.ListSessionKeysRequest
-	131, // 246: api.v2.V2.ListSessions:input_type -> api.v2.ListSessionsRequest
-	134, // 247: api.v2.V2.ListSessionRuns:input_type -> api.v2.ListSessionRunsRequest
-	13,  // 248: api.v2.V2.Health:output_type -> api.v2.HealthResponse
-	16,  // 249: api.v2.V2._SchemaOnly:output_type -> api.v2.ErrorResponse
-	61,  // 250: api.v2.V2.CreatePartnerAccount:output_type -> api.v2.CreateAccountResponse
-	63,  // 251: api.v2.V2.CreateEnv:output_type -> api.v2.CreateEnvResponse
-	67,  // 252: api.v2.V2.FetchPartnerAccounts:output_type -> api.v2.FetchAccountsResponse
-	68,  // 253: api.v2.V2.FetchAccount:output_type -> api.v2.FetchAccountResponse
-	75,  // 254: api.v2.V2.FetchAccountEnvs:output_type -> api.v2.FetchAccountEnvsResponse
-	72,  // 255: api.v2.V2.FetchAccountEventKeys:output_type -> api.v2.FetchAccountEventKeysResponse
-	77,  // 256: api.v2.V2.FetchAccountSigningKeys:output_type -> api.v2.FetchAccountSigningKeysResponse
-	80,  // 257: api.v2.V2.CreateWebhook:output_type -> api.v2.CreateWebhookResponse
-	83,  // 258: api.v2.V2.ListWebhooks:output_type -> api.v2.ListWebhooksResponse
-	86,  // 259: api.v2.V2.PatchEnv:output_type -> api.v2.PatchEnvsResponse
-	38,  // 260: api.v2.V2.GetFunctionRun:output_type -> api.v2.GetFunctionRunResponse
-	139, // 261: api.v2.V2.ListRuns:output_type -> api.v2.ListRunsResponse
-	140, // 262: api.v2.V2.ListFunctionRuns:output_type -> api.v2.ListFunctionRunsResponse
-	40,  // 263: api.v2.V2.GetEventRuns:output_type -> api.v2.GetEventRunsResponse
-	43,  // 264: api.v2.V2.Rerun:output_type -> api.v2.RerunResponse
-	142, // 265: api.v2.V2.CancelRun:output_type -> api.v2.CancelRunResponse
-	55,  // 266: api.v2.V2.GetApp:output_type -> api.v2.GetAppResponse
-	57,  // 267: api.v2.V2.GetApps:output_type -> api.v2.GetAppsResponse
-	164, // 268: api.v2.V2.CreateSandbox:output_type -> api.v2.CreateSandboxResponse
-	165, // 269: api.v2.V2.ListSandboxes:output_type -> api.v2.ListSandboxesResponse
-	166, // 270: api.v2.V2.GetSandbox:output_type -> api.v2.GetSandboxResponse
-	167, // 271: api.v2.V2.DestroySandbox:output_type -> api.v2.DestroySandboxResponse
-	168, // 272: api.v2.V2.ExecSandbox:output_type -> api.v2.ExecSandboxResponse
-	169, // 273: api.v2.V2.StreamSandboxLogs:output_type -> api.v2.StreamSandboxLogsResponse
-	170, // 274: api.v2.V2.WriteSandboxFile:output_type -> api.v2.WriteSandboxFileResponse
-	171, // 275: api.v2.V2.ReadSandboxFile:output_type -> google.api.HttpBody
-	172, // 276: api.v2.V2.StartSandboxProcess:output_type -> api.v2.StartSandboxProcessResponse
-	173, // 277: api.v2.V2.ListSandboxProcesses:output_type -> api.v2.ListSandboxProcessesResponse
-	174, // 278: api.v2.V2.GetSandboxProcess:output_type -> api.v2.GetSandboxProcessResponse
-	175, // 279: api.v2.V2.SignalSandboxProcess:output_type -> api.v2.SignalSandboxProcessResponse
-	176, // 280: api.v2.V2.WaitSandboxProcess:output_type -> api.v2.WaitSandboxProcessResponse
-	177, // 281: api.v2.V2.GetSandboxProcessOutput:output_type -> api.v2.GetSandboxProcessOutputResponse
-	178, // 282: api.v2.V2.StreamSandboxProcessOutput:output_type -> api.v2.StreamSandboxProcessOutputResponse
-	96,  // 283: api.v2.V2.CreateScore:output_type -> api.v2.CreateScoreResponse
-	99,  // 284: api.v2.V2.SyncApp:output_type -> api.v2.SyncAppResponse
-	49,  // 285: api.v2.V2.GetFunctionTrace:output_type -> api.v2.GetFunctionTraceResponse
-	51,  // 286: api.v2.V2.GetFunction:output_type -> api.v2.GetFunctionResponse
-	59,  // 287: api.v2.V2.GetFunctions:output_type -> api.v2.GetFunctionsResponse
-	88,  // 288: api.v2.V2.SendEvent:output_type -> api.v2.SendEventResponse
-	91,  // 289: api.v2.V2.InvokeFunction:output_type -> api.v2.InvokeFunctionResponse
-	110, // 290: api.v2.V2.ListInsightsTables:output_type -> api.v2.ListInsightsTablesResponse
-	117, // 291: api.v2.V2.ListInsightsEventSchemas:output_type -> api.v2.ListInsightsEventSchemasResponse
-	114, // 292: api.v2.V2.QueryInsightsPrompt:output_type -> api.v2.QueryInsightsPromptResponse
-	103, // 293: api.v2.V2.QueryInsights:output_type -> api.v2.QueryInsightsResponse
-	120, // 294: api.v2.V2.ListExperiments:output_type -> api.v2.ListExperimentsResponse
-	123, // 295: api.v2.V2.GetExperiment:output_type -> api.v2.GetExperimentResponse
-	129, // 296: api.v2.V2.ListSessionKeys:output_type -> api.v2.ListSessionKeysResponse
-	132, // 297: api.v2.V2.ListSessions:output_type -> api.v2.ListSessionsResponse
-	135, // 298: api.v2.V2.ListSessionRuns:output_type -> api.v2.ListSessionRunsResponse
-	248, // [248:299] is the sub-list for method output_type
-	197, // [197:248] is the sub-list for method input_type
-	197, // [197:197] is the sub-list for extension type_name
-	197, // [197:197] is the sub-list for extension extendee
-	0,   // [0:197] is the sub-list for field type_name
+	219, // 59: api.v2.App.created_at:type_name -> google.protobuf.Timestamp
+	219, // 60: api.v2.App.archived_at:type_name -> google.protobuf.Timestamp
+	58,  // 61: api.v2.App.latest_sync:type_name -> api.v2.AppSync
+	219, // 62: api.v2.AppSync.synced_at:type_name -> google.protobuf.Timestamp
+	57,  // 63: api.v2.GetAppResponse.data:type_name -> api.v2.App
+	22,  // 64: api.v2.GetAppResponse.metadata:type_name -> api.v2.ResponseMetadata
+	57,  // 65: api.v2.GetAppsResponse.data:type_name -> api.v2.App
+	22,  // 66: api.v2.GetAppsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 67: api.v2.GetAppsResponse.page:type_name -> api.v2.Page
+	39,  // 68: api.v2.GetFunctionsResponse.data:type_name -> api.v2.Function
+	22,  // 69: api.v2.GetFunctionsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 70: api.v2.GetFunctionsResponse.page:type_name -> api.v2.Page
+	219, // 71: api.v2.FunctionVersion.created_at:type_name -> google.protobuf.Timestamp
+	220, // 72: api.v2.FunctionVersion.config:type_name -> google.protobuf.Struct
+	65,  // 73: api.v2.ListFunctionVersionsResponse.data:type_name -> api.v2.FunctionVersion
+	22,  // 74: api.v2.ListFunctionVersionsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	222, // 75: api.v2.FunctionConfigChange.before:type_name -> google.protobuf.Value
+	222, // 76: api.v2.FunctionConfigChange.after:type_name -> google.protobuf.Value
+	69,  // 77: api.v2.FunctionVersionDiff.changes:type_name -> api.v2.FunctionConfigChange
+	70,  // 78: api.v2.GetFunctionVersionDiffResponse.data:type_name -> api.v2.FunctionVersionDiff
+	22,  // 79: api.v2.GetFunctionVersionDiffResponse.metadata:type_name -> api.v2.ResponseMetadata
+	77,  // 80: api.v2.CreateAccountResponse.data:type_name -> api.v2.CreateAccountData
+	22,  // 81: api.v2.CreateAccountResponse.metadata:type_name -> api.v2.ResponseMetadata
+	76,  // 82: api.v2.CreateEnvResponse.data:type_name -> api.v2.Env
+	22,  // 83: api.v2.CreateEnvResponse.metadata:type_name -> api.v2.ResponseMetadata
+	7,   // 84: api.v2.Env.type:type_name -> api.v2.EnvType
+	219, // 85: api.v2.Env.createdAt:type_name -> google.protobuf.Timestamp
+	219, // 86: api.v2.CreateAccountData.createdAt:type_name -> google.protobuf.Timestamp
+	219, // 87: api.v2.CreateAccountData.updatedAt:type_name -> google.protobuf.Timestamp
+	81,  // 88: api.v2.FetchAccountsResponse.data:type_name -> api.v2.Account
+	22,  // 89: api.v2.FetchAccountsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 90: api.v2.FetchAccountsResponse.page:type_name -> api.v2.Page
+	81,  // 91: api.v2.FetchAccountResponse.data:type_name -> api.v2.Account
+	22,  // 92: api.v2.FetchAccountResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 93: api.v2.Account.createdAt:type_name -> google.protobuf.Timestamp
+	219, // 94: api.v2.Account.updatedAt:type_name -> google.protobuf.Timestamp
+	85,  // 95: api.v2.FetchAccountEventKeysResponse.data:type_name -> api.v2.EventKey
+	22,  // 96: api.v2.FetchAccountEventKeysResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 97: api.v2.FetchAccountEventKeysResponse.page:type_name -> api.v2.Page
+	219, // 98: api.v2.EventKey.createdAt:type_name -> google.protobuf.Timestamp
+	76,  // 99: api.v2.FetchAccountEnvsResponse.data:type_name -> api.v2.Env
+	22,  // 100: api.v2.FetchAccountEnvsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 101: api.v2.FetchAccountEnvsResponse.page:type_name -> api.v2.Page
+	90,  // 102: api.v2.FetchAccountSigningKeysResponse.data:type_name -> api.v2.SigningKey
+	22,  // 103: api.v2.FetchAccountSigningKeysResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 104: api.v2.FetchAccountSigningKeysResponse.page:type_name -> api.v2.Page
+	219, // 105: api.v2.SigningKey.createdAt:type_name -> google.protobuf.Timestamp
+	93,  // 106: api.v2.CreateWebhookRequest.event_filter:type_name -> api.v2.EventFilter
+	96,  // 107: api.v2.CreateWebhookResponse.data:type_name -> api.v2.Webhook
+	22,  // 108: api.v2.CreateWebhookResponse.metadata:type_name -> api.v2.ResponseMetadata
+	8,   // 109: api.v2.EventFilter.filter:type_name -> api.v2.FilterType
+	96,  // 110: api.v2.ListWebhooksResponse.data:type_name -> api.v2.Webhook
+	22,  // 111: api.v2.ListWebhooksResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 112: api.v2.ListWebhooksResponse.page:type_name -> api.v2.Page
+	93,  // 113: api.v2.Webhook.event_filter:type_name -> api.v2.EventFilter
+	219, // 114: api.v2.Webhook.createdAt:type_name -> google.protobuf.Timestamp
+	219, // 115: api.v2.Webhook.updatedAt:type_name -> google.protobuf.Timestamp
+	76,  // 116: api.v2.PatchEnvsResponse.data:type_name -> api.v2.Env
+	22,  // 117: api.v2.PatchEnvsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	220, // 118: api.v2.SendEventRequest.data:type_name -> google.protobuf.Struct
+	220, // 119: api.v2.SendEventRequest.user:type_name -> google.protobuf.Struct
+	101, // 120: api.v2.SendEventResponse.data:type_name -> api.v2.SendEventData
+	22,  // 121: api.v2.SendEventResponse.metadata:type_name -> api.v2.ResponseMetadata
+	220, // 122: api.v2.InvokeFunctionRequest.data:type_name -> google.protobuf.Struct
+	219, // 123: api.v2.InvokeFunctionRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	104, // 124: api.v2.InvokeFunctionResponse.data:type_name -> api.v2.InvokeFunctionData
+	22,  // 125: api.v2.InvokeFunctionResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 126: api.v2.InvokeFunctionData.queued_at:type_name -> google.protobuf.Timestamp
+	219, // 127: api.v2.InvokeFunctionData.started_at:type_name -> google.protobuf.Timestamp
+	219, // 128: api.v2.InvokeFunctionData.completed_at:type_name -> google.protobuf.Timestamp
+	219, // 129: api.v2.InvokeFunctionData.scheduled_at:type_name -> google.protobuf.Timestamp
+	109, // 130: api.v2.ListScheduledInvocationsResponse.data:type_name -> api.v2.ScheduledInvocation
+	22,  // 131: api.v2.ListScheduledInvocationsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 132: api.v2.ListScheduledInvocationsResponse.page:type_name -> api.v2.Page
+	109, // 133: api.v2.CancelScheduledInvocationResponse.data:type_name -> api.v2.ScheduledInvocation
+	22,  // 134: api.v2.CancelScheduledInvocationResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 135: api.v2.ScheduledInvocation.scheduled_at:type_name -> google.protobuf.Timestamp
+	219, // 136: api.v2.ScheduledInvocation.created_at:type_name -> google.protobuf.Timestamp
+	111, // 137: api.v2.CreateScoreRequest.scores:type_name -> api.v2.CreateScoreInput
+	222, // 138: api.v2.CreateScoreInput.value:type_name -> google.protobuf.Value
+	112, // 139: api.v2.CreateScoreInput.experiment:type_name -> api.v2.ScoreExperiment
+	114, // 140: api.v2.CreateScoreResponse.data:type_name -> api.v2.Score
+	22,  // 141: api.v2.CreateScoreResponse.metadata:type_name -> api.v2.ResponseMetadata
+	222, // 142: api.v2.Score.value:type_name -> google.protobuf.Value
+	112, // 143: api.v2.Score.experiment:type_name -> api.v2.ScoreExperiment
+	117, // 144: api.v2.SyncAppResponse.data:type_name -> api.v2.SyncAppData
+	22,  // 145: api.v2.SyncAppResponse.metadata:type_name -> api.v2.ResponseMetadata
+	118, // 146: api.v2.SyncAppData.error:type_name -> api.v2.SyncAppError
+	121, // 147: api.v2.QueryInsightsResponse.data:type_name -> api.v2.QueryInsightsData
+	22,  // 148: api.v2.QueryInsightsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	122, // 149: api.v2.QueryInsightsData.columns:type_name -> api.v2.InsightsOutputColumn
+	123, // 150: api.v2.QueryInsightsData.rows:type_name -> api.v2.InsightsRow
+	124, // 151: api.v2.QueryInsightsData.diagnostics:type_name -> api.v2.InsightsDiagnostic
+	9,   // 152: api.v2.InsightsOutputColumn.type:type_name -> api.v2.InsightsOutputColumnType
+	222, // 153: api.v2.InsightsRow.values:type_name -> google.protobuf.Value
+	10,  // 154: api.v2.InsightsDiagnostic.severity:type_name -> api.v2.InsightsDiagnosticSeverity
+	125, // 155: api.v2.InsightsDiagnostic.position:type_name -> api.v2.InsightsDiagnosticPosition
+	128, // 156: api.v2.ListInsightsTablesResponse.data:type_name -> api.v2.InsightsTable
+	22,  // 157: api.v2.ListInsightsTablesResponse.metadata:type_name -> api.v2.ResponseMetadata
+	129, // 158: api.v2.InsightsTable.columns:type_name -> api.v2.InsightsTableColumn
+	132, // 159: api.v2.QueryInsightsPromptResponse.data:type_name -> api.v2.QueryInsightsPromptData
+	22,  // 160: api.v2.QueryInsightsPromptResponse.metadata:type_name -> api.v2.ResponseMetadata
+	135, // 161: api.v2.ListInsightsEventSchemasResponse.data:type_name -> api.v2.InsightsEventSchema
+	22,  // 162: api.v2.ListInsightsEventSchemasResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 163: api.v2.ListInsightsEventSchemasResponse.page:type_name -> api.v2.Page
+	220, // 164: api.v2.InsightsEventSchema.schema:type_name -> google.protobuf.Struct
+	219, // 165: api.v2.ListExperimentsRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 166: api.v2.ListExperimentsRequest.until:type_name -> google.protobuf.Timestamp
+	138, // 167: api.v2.ListExperimentsResponse.data:type_name -> api.v2.Experiment
+	22,  // 168: api.v2.ListExperimentsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 169: api.v2.ListExperimentsResponse.page:type_name -> api.v2.Page
+	24,  // 170: api.v2.Experiment.function:type_name -> api.v2.FunctionRef
+	219, // 171: api.v2.Experiment.first_seen:type_name -> google.protobuf.Timestamp
+	219, // 172: api.v2.Experiment.last_seen:type_name -> google.protobuf.Timestamp
+	219, // 173: api.v2.GetExperimentRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 174: api.v2.GetExperimentRequest.until:type_name -> google.protobuf.Timestamp
+	141, // 175: api.v2.GetExperimentResponse.data:type_name -> api.v2.ExperimentDetail
+	22,  // 176: api.v2.GetExperimentResponse.metadata:type_name -> api.v2.ResponseMetadata
+	142, // 177: api.v2.ExperimentDetail.variants:type_name -> api.v2.ExperimentVariantMetrics
+	144, // 178: api.v2.ExperimentDetail.variant_weights:type_name -> api.v2.ExperimentVariantWeight
+	219, // 179: api.v2.ExperimentDetail.first_seen:type_name -> google.protobuf.Timestamp
+	219, // 180: api.v2.ExperimentDetail.last_seen:type_name -> google.protobuf.Timestamp
+	143, // 181: api.v2.ExperimentVariantMetrics.metrics:type_name -> api.v2.ExperimentVariantMetric
+	147, // 182: api.v2.ListSessionKeysResponse.data:type_name -> api.v2.SessionKey
+	22,  // 183: api.v2.ListSessionKeysResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 184: api.v2.ListSessionKeysResponse.page:type_name -> api.v2.Page
+	219, // 185: api.v2.SessionKey.created_at:type_name -> google.protobuf.Timestamp
+	219, // 186: api.v2.ListSessionsRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 187: api.v2.ListSessionsRequest.until:type_name -> google.protobuf.Timestamp
+	150, // 188: api.v2.ListSessionsResponse.data:type_name -> api.v2.SessionGroup
+	22,  // 189: api.v2.ListSessionsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 190: api.v2.ListSessionsResponse.page:type_name -> api.v2.Page
+	219, // 191: api.v2.SessionGroup.last_active_at:type_name -> google.protobuf.Timestamp
+	24,  // 192: api.v2.SessionGroup.functions:type_name -> api.v2.FunctionRef
+	219, // 193: api.v2.ListSessionRunsRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 194: api.v2.ListSessionRunsRequest.until:type_name -> google.protobuf.Timestamp
+	153, // 195: api.v2.ListSessionRunsResponse.data:type_name -> api.v2.SessionRun
+	22,  // 196: api.v2.ListSessionRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 197: api.v2.ListSessionRunsResponse.page:type_name -> api.v2.Page
+	24,  // 198: api.v2.SessionRun.function:type_name -> api.v2.FunctionRef
+	0,   // 199: api.v2.SessionRun.status:type_name -> api.v2.FunctionRunStatus
+	219, // 200: api.v2.SessionRun.queued_at:type_name -> google.protobuf.Timestamp
+	219, // 201: api.v2.SessionRun.started_at:type_name -> google.protobuf.Timestamp
+	219, // 202: api.v2.SessionRun.ended_at:type_name -> google.protobuf.Timestamp
+	219, // 203: api.v2.ListRunsRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 204: api.v2.ListRunsRequest.until:type_name -> google.protobuf.Timestamp
+	219, // 205: api.v2.ListFunctionRunsRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 206: api.v2.ListFunctionRunsRequest.until:type_name -> google.protobuf.Timestamp
+	41,  // 207: api.v2.ListRunsResponse.data:type_name -> api.v2.FunctionRun
+	22,  // 208: api.v2.ListRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 209: api.v2.ListRunsResponse.page:type_name -> api.v2.Page
+	41,  // 210: api.v2.ListFunctionRunsResponse.data:type_name -> api.v2.FunctionRun
+	22,  // 211: api.v2.ListFunctionRunsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 212: api.v2.ListFunctionRunsResponse.page:type_name -> api.v2.Page
+	160, // 213: api.v2.CancelRunResponse.data:type_name -> api.v2.CancelRunData
+	22,  // 214: api.v2.CancelRunResponse.metadata:type_name -> api.v2.ResponseMetadata
+	11,  // 215: api.v2.Wait.type:type_name -> api.v2.WaitType
+	219, // 216: api.v2.Wait.timeout_at:type_name -> google.protobuf.Timestamp
+	219, // 217: api.v2.Wait.created_at:type_name -> google.protobuf.Timestamp
+	161, // 218: api.v2.ListRunWaitsResponse.data:type_name -> api.v2.Wait
+	22,  // 219: api.v2.ListRunWaitsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	161, // 220: api.v2.ListFunctionWaitsResponse.data:type_name -> api.v2.Wait
+	22,  // 221: api.v2.ListFunctionWaitsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 222: api.v2.ListFunctionWaitsResponse.page:type_name -> api.v2.Page
+	222, // 223: api.v2.ResolveWaitRequest.data:type_name -> google.protobuf.Value
+	161, // 224: api.v2.ResolveWaitResponse.data:type_name -> api.v2.Wait
+	22,  // 225: api.v2.ResolveWaitResponse.metadata:type_name -> api.v2.ResponseMetadata
+	161, // 226: api.v2.TimeoutWaitResponse.data:type_name -> api.v2.Wait
+	22,  // 227: api.v2.TimeoutWaitResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 228: api.v2.BulkRunFilter.from:type_name -> google.protobuf.Timestamp
+	219, // 229: api.v2.BulkRunFilter.until:type_name -> google.protobuf.Timestamp
+	170, // 230: api.v2.PreviewBulkRunOperationRequest.filter:type_name -> api.v2.BulkRunFilter
+	173, // 231: api.v2.PreviewBulkRunOperationResponse.data:type_name -> api.v2.BulkRunOperationPreview
+	22,  // 232: api.v2.PreviewBulkRunOperationResponse.metadata:type_name -> api.v2.ResponseMetadata
+	170, // 233: api.v2.CreateBulkRunOperationRequest.filter:type_name -> api.v2.BulkRunFilter
+	182, // 234: api.v2.CreateBulkRunOperationResponse.data:type_name -> api.v2.BulkRunOperation
+	22,  // 235: api.v2.CreateBulkRunOperationResponse.metadata:type_name -> api.v2.ResponseMetadata
+	182, // 236: api.v2.ListBulkRunOperationsResponse.data:type_name -> api.v2.BulkRunOperation
+	22,  // 237: api.v2.ListBulkRunOperationsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 238: api.v2.ListBulkRunOperationsResponse.page:type_name -> api.v2.Page
+	182, // 239: api.v2.GetBulkRunOperationResponse.data:type_name -> api.v2.BulkRunOperation
+	22,  // 240: api.v2.GetBulkRunOperationResponse.metadata:type_name -> api.v2.ResponseMetadata
+	182, // 241: api.v2.AbortBulkRunOperationResponse.data:type_name -> api.v2.BulkRunOperation
+	22,  // 242: api.v2.AbortBulkRunOperationResponse.metadata:type_name -> api.v2.ResponseMetadata
+	12,  // 243: api.v2.BulkRunOperation.action:type_name -> api.v2.BulkRunOperationAction
+	13,  // 244: api.v2.BulkRunOperation.status:type_name -> api.v2.BulkRunOperationStatus
+	170, // 245: api.v2.BulkRunOperation.filter:type_name -> api.v2.BulkRunFilter
+	219, // 246: api.v2.BulkRunOperation.created_at:type_name -> google.protobuf.Timestamp
+	219, // 247: api.v2.BulkRunOperation.updated_at:type_name -> google.protobuf.Timestamp
+	219, // 248: api.v2.BulkRunOperation.ended_at:type_name -> google.protobuf.Timestamp
+	197, // 249: api.v2.CreateOutboundWebhookResponse.data:type_name -> api.v2.OutboundWebhook
+	22,  // 250: api.v2.CreateOutboundWebhookResponse.metadata:type_name -> api.v2.ResponseMetadata
+	197, // 251: api.v2.ListOutboundWebhooksResponse.data:type_name -> api.v2.OutboundWebhook
+	22,  // 252: api.v2.ListOutboundWebhooksResponse.metadata:type_name -> api.v2.ResponseMetadata
+	197, // 253: api.v2.GetOutboundWebhookResponse.data:type_name -> api.v2.OutboundWebhook
+	22,  // 254: api.v2.GetOutboundWebhookResponse.metadata:type_name -> api.v2.ResponseMetadata
+	197, // 255: api.v2.UpdateOutboundWebhookResponse.data:type_name -> api.v2.OutboundWebhook
+	22,  // 256: api.v2.UpdateOutboundWebhookResponse.metadata:type_name -> api.v2.ResponseMetadata
+	22,  // 257: api.v2.DeleteOutboundWebhookResponse.metadata:type_name -> api.v2.ResponseMetadata
+	198, // 258: api.v2.ListOutboundWebhookDeliveriesResponse.data:type_name -> api.v2.OutboundWebhookDelivery
+	22,  // 259: api.v2.ListOutboundWebhookDeliveriesResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 260: api.v2.ListOutboundWebhookDeliveriesResponse.page:type_name -> api.v2.Page
+	198, // 261: api.v2.ReplayOutboundWebhookDeliveryResponse.data:type_name -> api.v2.OutboundWebhookDelivery
+	22,  // 262: api.v2.ReplayOutboundWebhookDeliveryResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 263: api.v2.OutboundWebhook.created_at:type_name -> google.protobuf.Timestamp
+	219, // 264: api.v2.OutboundWebhook.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 265: api.v2.OutboundWebhookDelivery.status:type_name -> api.v2.OutboundWebhookDeliveryStatus
+	199, // 266: api.v2.OutboundWebhookDelivery.attempts:type_name -> api.v2.OutboundWebhookDeliveryAttempt
+	219, // 267: api.v2.OutboundWebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	219, // 268: api.v2.OutboundWebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	219, // 269: api.v2.OutboundWebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	219, // 270: api.v2.OutboundWebhookDeliveryAttempt.attempted_at:type_name -> google.protobuf.Timestamp
+	212, // 271: api.v2.CreateAlertRuleResponse.data:type_name -> api.v2.AlertRule
+	22,  // 272: api.v2.CreateAlertRuleResponse.metadata:type_name -> api.v2.ResponseMetadata
+	212, // 273: api.v2.ListAlertRulesResponse.data:type_name -> api.v2.AlertRule
+	22,  // 274: api.v2.ListAlertRulesResponse.metadata:type_name -> api.v2.ResponseMetadata
+	212, // 275: api.v2.GetAlertRuleResponse.data:type_name -> api.v2.AlertRule
+	22,  // 276: api.v2.GetAlertRuleResponse.metadata:type_name -> api.v2.ResponseMetadata
+	212, // 277: api.v2.UpdateAlertRuleResponse.data:type_name -> api.v2.AlertRule
+	22,  // 278: api.v2.UpdateAlertRuleResponse.metadata:type_name -> api.v2.ResponseMetadata
+	22,  // 279: api.v2.DeleteAlertRuleResponse.metadata:type_name -> api.v2.ResponseMetadata
+	213, // 280: api.v2.ListAlertsResponse.data:type_name -> api.v2.Alert
+	22,  // 281: api.v2.ListAlertsResponse.metadata:type_name -> api.v2.ResponseMetadata
+	82,  // 282: api.v2.ListAlertsResponse.page:type_name -> api.v2.Page
+	15,  // 283: api.v2.AlertRule.status:type_name -> api.v2.AlertStatus
+	219, // 284: api.v2.AlertRule.evaluated_at:type_name -> google.protobuf.Timestamp
+	219, // 285: api.v2.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	219, // 286: api.v2.AlertRule.updated_at:type_name -> google.protobuf.Timestamp
+	15,  // 287: api.v2.Alert.status:type_name -> api.v2.AlertStatus
+	219, // 288: api.v2.Alert.fired_at:type_name -> google.protobuf.Timestamp
+	219, // 289: api.v2.Alert.resolved_at:type_name -> google.protobuf.Timestamp
+	219, // 290: api.v2.GetUsageRequest.from:type_name -> google.protobuf.Timestamp
+	219, // 291: api.v2.GetUsageRequest.until:type_name -> google.protobuf.Timestamp
+	216, // 292: api.v2.GetUsageResponse.data:type_name -> api.v2.UsageBucket
+	217, // 293: api.v2.GetUsageResponse.totals:type_name -> api.v2.UsageTotals
+	22,  // 294: api.v2.GetUsageResponse.metadata:type_name -> api.v2.ResponseMetadata
+	219, // 295: api.v2.UsageBucket.bucket:type_name -> google.protobuf.Timestamp
+	16,  // 296: api.v2.V2.Health:input_type -> api.v2.HealthRequest
+	16,  // 297: api.v2.V2._SchemaOnly:input_type -> api.v2.HealthRequest
+	72,  // 298: api.v2.V2.CreatePartnerAccount:input_type -> api.v2.CreateAccountRequest
+	74,  // 299: api.v2.V2.CreateEnv:input_type -> api.v2.CreateEnvRequest
+	78,  // 300: api.v2.V2.FetchPartnerAccounts:input_type -> api.v2.FetchAccountsRequest
+	17,  // 301: api.v2.V2.FetchAccount:input_type -> api.v2.FetchAccountRequest
+	86,  // 302: api.v2.V2.FetchAccountEnvs:input_type -> api.v2.FetchAccountEnvsRequest
+	83,  // 303: api.v2.V2.FetchAccountEventKeys:input_type -> api.v2.FetchAccountEventKeysRequest
+	88,  // 304: api.v2.V2.FetchAccountSigningKeys:input_type -> api.v2.FetchAccountSigningKeysRequest
+	91,  // 305: api.v2.V2.CreateWebhook:input_type -> api.v2.CreateWebhookRequest
+	94,  // 306: api.v2.V2.ListWebhooks:input_type -> api.v2.ListWebhooksRequest
+	97,  // 307: api.v2.V2.PatchEnv:input_type -> api.v2.PatchEnvRequest
+	42,  // 308: api.v2.V2.GetFunctionRun:input_type -> api.v2.GetFunctionRunRequest
+	154, // 309: api.v2.V2.ListRuns:input_type -> api.v2.ListRunsRequest
+	155, // 310: api.v2.V2.ListFunctionRuns:input_type -> api.v2.ListFunctionRunsRequest
+	44,  // 311: api.v2.V2.GetEventRuns:input_type -> api.v2.GetEventRunsRequest
+	46,  // 312: api.v2.V2.Rerun:input_type -> api.v2.RerunRequest
+	158, // 313: api.v2.V2.CancelRun:input_type -> api.v2.CancelRunRequest
+	162, // 314: api.v2.V2.ListRunWaits:input_type -> api.v2.ListRunWaitsRequest
+	164, // 315: api.v2.V2.ListFunctionWaits:input_type -> api.v2.ListFunctionWaitsRequest
+	166, // 316: api.v2.V2.ResolveWait:input_type -> api.v2.ResolveWaitRequest
+	168, // 317: api.v2.V2.TimeoutWait:input_type -> api.v2.TimeoutWaitRequest
+	171, // 318: api.v2.V2.PreviewBulkRunOperation:input_type -> api.v2.PreviewBulkRunOperationRequest
+	174, // 319: api.v2.V2.CreateBulkRunOperation:input_type -> api.v2.CreateBulkRunOperationRequest
+	176, // 320: api.v2.V2.ListBulkRunOperations:input_type -> api.v2.ListBulkRunOperationsRequest
+	178, // 321: api.v2.V2.GetBulkRunOperation:input_type -> api.v2.GetBulkRunOperationRequest
+	180, // 322: api.v2.V2.AbortBulkRunOperation:input_type -> api.v2.AbortBulkRunOperationRequest
+	183, // 323: api.v2.V2.CreateOutboundWebhook:input_type -> api.v2.CreateOutboundWebhookRequest
+	185, // 324: api.v2.V2.ListOutboundWebhooks:input_type -> api.v2.ListOutboundWebhooksRequest
+	187, // 325: api.v2.V2.GetOutboundWebhook:input_type -> api.v2.GetOutboundWebhookRequest
+	189, // 326: api.v2.V2.UpdateOutboundWebhook:input_type -> api.v2.UpdateOutboundWebhookRequest
+	191, // 327: api.v2.V2.DeleteOutboundWebhook:input_type -> api.v2.DeleteOutboundWebhookRequest
+	193, // 328: api.v2.V2.ListOutboundWebhookDeliveries:input_type -> api.v2.ListOutboundWebhookDeliveriesRequest
+	195, // 329: api.v2.V2.ReplayOutboundWebhookDelivery:input_type -> api.v2.ReplayOutboundWebhookDeliveryRequest
+	200, // 330: api.v2.V2.CreateAlertRule:input_type -> api.v2.CreateAlertRuleRequest
+	202, // 331: api.v2.V2.ListAlertRules:input_type -> api.v2.ListAlertRulesRequest
+	204, // 332: api.v2.V2.GetAlertRule:input_type -> api.v2.GetAlertRuleRequest
+	206, // 333: api.v2.V2.UpdateAlertRule:input_type -> api.v2.UpdateAlertRuleRequest
+	208, // 334: api.v2.V2.DeleteAlertRule:input_type -> api.v2.DeleteAlertRuleRequest
+	210, // 335: api.v2.V2.ListAlerts:input_type -> api.v2.ListAlertsRequest
+	214, // 336: api.v2.V2.GetUsage:input_type -> api.v2.GetUsageRequest
+	59,  // 337: api.v2.V2.GetApp:input_type -> api.v2.GetAppRequest
+	61,  // 338: api.v2.V2.GetApps:input_type -> api.v2.GetAppsRequest
+	223, // 339: api.v2.V2.CreateSandbox:input_type -> api.v2.CreateSandboxRequest
+	224, // 340: api.v2.V2.ListSandboxes:input_type -> api.v2.ListSandboxesRequest
+	225, // 341: api.v2.V2.GetSandbox:input_type -> api.v2.GetSandboxRequest
+	226, // 342: api.v2.V2.DestroySandbox:input_type -> api.v2.DestroySandboxRequest
+	227, // 343: api.v2.V2.ExecSandbox:input_type -> api.v2.ExecSandboxRequest
+	228, // 344: api.v2.V2.StreamSandboxLogs:input_type -> api.v2.StreamSandboxLogsRequest
+	229, // 345: api.v2.V2.WriteSandboxFile:input_type -> api.v2.WriteSandboxFileRequest
+	230, // 346: api.v2.V2.ReadSandboxFile:input_type -> api.v2.ReadSandboxFileRequest
+	231, // 347: api.v2.V2.StartSandboxProcess:input_type -> api.v2.StartSandboxProcessRequest
+	232, // 348: api.v2.V2.ListSandboxProcesses:input_type -> api.v2.ListSandboxProcessesRequest
+	233, // 349: api.v2.V2.GetSandboxProcess:input_type -> api.v2.GetSandboxProcessRequest
+	234, // 350: api.v2.V2.SignalSandboxProcess:input_type -> api.v2.SignalSandboxProcessRequest
+	235, // 351: api.v2.V2.WaitSandboxProcess:input_type -> api.v2.WaitSandboxProcessRequest
+	236, // 352: api.v2.V2.GetSandboxProcessOutput:input_type -> api.v2.GetSandboxProcessOutputRequest
+	237, // 353: api.v2.V2.StreamSandboxProcessOutput:input_type -> api.v2.StreamSandboxProcessOutputRequest
+	110, // 354: api.v2.V2.CreateScore:input_type -> api.v2.CreateScoreRequest
+	115, // 355: api.v2.V2.SyncApp:input_type -> api.v2.SyncAppRequest
+	53,  // 356: api.v2.V2.GetFunctionTrace:input_type -> api.v2.GetFunctionTraceRequest
+	55,  // 357: api.v2.V2.GetFunction:input_type -> api.v2.GetFunctionRequest
+	63,  // 358: api.v2.V2.GetFunctions:input_type -> api.v2.GetFunctionsRequest
+	66,  // 359: api.v2.V2.ListFunctionVersions:input_type -> api.v2.ListFunctionVersionsRequest
+	68,  // 360: api.v2.V2.GetFunctionVersionDiff:input_type -> api.v2.GetFunctionVersionDiffRequest
+	99,  // 361: api.v2.V2.SendEvent:input_type -> api.v2.SendEventRequest
+	102, // 362: api.v2.V2.InvokeFunction:input_type -> api.v2.InvokeFunctionRequest
+	105, // 363: api.v2.V2.ListScheduledInvocations:input_type -> api.v2.ListScheduledInvocationsRequest
+	107, // 364: api.v2.V2.CancelScheduledInvocation:input_type -> api.v2.CancelScheduledInvocationRequest
+	126, // 365: api.v2.V2.ListInsightsTables:input_type -> api.v2.ListInsightsTablesRequest
+	133, // 366: api.v2.V2.ListInsightsEventSchemas:input_type -> api.v2.ListInsightsEventSchemasRequest
+	130, // 367: api.v2.V2.QueryInsightsPrompt:input_type -> api.v2.QueryInsightsPromptRequest
+	119, // 368: api.v2.V2.QueryInsights:input_type -> api.v2.QueryInsightsRequest
+	136, // 369: api.v2.V2.ListExperiments:input_type -> api.v2.ListExperimentsRequest
+	139, // 370: api.v2.V2.GetExperiment:input_type -> api.v2.GetExperimentRequest
+	145, // 371: api.v2.V2.ListSessionKeys:input_type -> api.v2.ListSessionKeysRequest
+	148, // 372: api.v2.V2.ListSessions:input_type -> api.v2.ListSessionsRequest
+	151, // 373: api.v2.V2.ListSessionRuns:input_type -> api.v2.ListSessionRunsRequest
+	18,  // 374: api.v2.V2.Health:output_type -> api.v2.HealthResponse
+	21,  // 375: api.v2.V2._SchemaOnly:output_type -> api.v2.ErrorResponse
+	73,  // 376: api.v2.V2.CreatePartnerAccount:output_type -> api.v2.CreateAccountResponse
+	75,  // 377: api.v2.V2.CreateEnv:output_type -> api.v2.CreateEnvResponse
+	79,  // 378: api.v2.V2.FetchPartnerAccounts:output_type -> api.v2.FetchAccountsResponse
+	80,  // 379: api.v2.V2.FetchAccount:output_type -> api.v2.FetchAccountResponse
+	87,  // 380: api.v2.V2.FetchAccountEnvs:output_type -> api.v2.FetchAccountEnvsResponse
+	84,  // 381: api.v2.V2.FetchAccountEventKeys:output_type -> api.v2.FetchAccountEventKeysResponse
+	89,  // 382: api.v2.V2.FetchAccountSigningKeys:output_type -> api.v2.FetchAccountSigningKeysResponse
+	92,  // 383: api.v2.V2.CreateWebhook:output_type -> api.v2.CreateWebhookResponse
+	95,  // 384: api.v2.V2.ListWebhooks:output_type -> api.v2.ListWebhooksResponse
+	98,  // 385: api.v2.V2.PatchEnv:output_type -> api.v2.PatchEnvsResponse
+	43,  // 386: api.v2.V2.GetFunctionRun:output_type -> api.v2.GetFunctionRunResponse
+	156, // 387: api.v2.V2.ListRuns:output_type -> api.v2.ListRunsResponse
+	157, // 388: api.v2.V2.ListFunctionRuns:output_type -> api.v2.ListFunctionRunsResponse
+	45,  // 389: api.v2.V2.GetEventRuns:output_type -> api.v2.GetEventRunsResponse
+	48,  // 390: api.v2.V2.Rerun:output_type -> api.v2.RerunResponse
+	159, // 391: api.v2.V2.CancelRun:output_type -> api.v2.CancelRunResponse
+	163, // 392: api.v2.V2.ListRunWaits:output_type -> api.v2.ListRunWaitsResponse
+	165, // 393: api.v2.V2.ListFunctionWaits:output_type -> api.v2.ListFunctionWaitsResponse
+	167, // 394: api.v2.V2.ResolveWait:output_type -> api.v2.ResolveWaitResponse
+	169, // 395: api.v2.V2.TimeoutWait:output_type -> api.v2.TimeoutWaitResponse
+	172, // 396: api.v2.V2.PreviewBulkRunOperation:output_type -> api.v2.PreviewBulkRunOperationResponse
+	175, // 397: api.v2.V2.CreateBulkRunOperation:output_type -> api.v2.CreateBulkRunOperationResponse
+	177, // 398: api.v2.V2.ListBulkRunOperations:output_type -> api.v2.ListBulkRunOperationsResponse
+	179, // 399: api.v2.V2.GetBulkRunOperation:output_type -> api.v2.GetBulkRunOperationResponse
+	181, // 400: api.v2.V2.AbortBulkRunOperation:output_type -> api.v2.AbortBulkRunOperationResponse
+	184, // 401: api.v2.V2.CreateOutboundWebhook:output_type -> api.v2.CreateOutboundWebhookResponse
+	186, // 402: api.v2.V2.ListOutboundWebhooks:output_type -> api.v2.ListOutboundWebhooksResponse
+	188, // 403: api.v2.V2.GetOutboundWebhook:output_type -> api.v2.GetOutboundWebhookResponse
+	190, // 404: api.v2.V2.UpdateOutboundWebhook:output_type -> api.v2.UpdateOutboundWebhookResponse
+	192, // 405: api.v2.V2.DeleteOutboundWebhook:output_type -> api.v2.DeleteOutboundWebhookResponse
+	194, // 406: api.v2.V2.ListOutboundWebhookDeliveries:output_type -> api.v2.ListOutboundWebhookDeliveriesResponse
+	196, // 407: api.v2.V2.ReplayOutboundWebhookDelivery:output_type -> api.v2.ReplayOutboundWebhookDeliveryResponse
+	201, // 408: api.v2.V2.CreateAlertRule:output_type -> api.v2.CreateAlertRuleResponse
+	203, // 409: api.v2.V2.ListAlertRules:output_type -> api.v2.ListAlertRulesResponse
+	205, // 410: api.v2.V2.GetAlertRule:output_type -> api.v2.GetAlertRuleResponse
+	207, // 411: api.v2.V2.UpdateAlertRule:output_type -> api.v2.UpdateAlertRuleResponse
+	209, // 412: api.v2.V2.DeleteAlertRule:output_type -> api.v2.DeleteAlertRuleResponse
+	211, // 413: api.v2.V2.ListAlerts:output_type -> api.v2.ListAlertsResponse
+	215, // 414: api.v2.V2.GetUsage:output_type -> api.v2.GetUsageResponse
+	60,  // 415: api.v2.V2.GetApp:output_type -> api.v2.GetAppResponse
+	62,  // 416: api.v2.V2.GetApps:output_type -> api.v2.GetAppsResponse
+	238, // 417: api.v2.V2.CreateSandbox:output_type -> api.v2.CreateSandboxResponse
+	239, // 418: api.v2.V2.ListSandboxes:output_type -> api.v2.ListSandboxesResponse
+	240, // 419: api.v2.V2.GetSandbox:output_type -> api.v2.GetSandboxResponse
+	241, // 420: api.v2.V2.DestroySandbox:output_type -> api.v2.DestroySandboxResponse
+	242, // 421: api.v2.V2.ExecSandbox:output_type -> api.v2.ExecSandboxResponse
+	243, // 422: api.v2.V2.StreamSandboxLogs:output_type -> api.v2.StreamSandboxLogsResponse
+	244, // 423: api.v2.V2.WriteSandboxFile:output_type -> api.v2.WriteSandboxFileResponse
+	245, // 424: api.v2.V2.ReadSandboxFile:output_type -> google.api.HttpBody
+	246, // 425: api.v2.V2.StartSandboxProcess:output_type -> api.v2.StartSandboxProcessResponse
+	247, // 426: api.v2.V2.ListSandboxProcesses:output_type -> api.v2.ListSandboxProcessesResponse
+	248, // 427: api.v2.V2.GetSandboxProcess:output_type -> api.v2.GetSandboxProcessResponse
+	249, // 428: api.v2.V2.SignalSandboxProcess:output_type -> api.v2.SignalSandboxProcessResponse
+	250, // 429: api.v2.V2.WaitSandboxProcess:output_type -> api.v2.WaitSandboxProcessResponse
+	251, // 430: api.v2.V2.GetSandboxProcessOutput:output_type -> api.v2.GetSandboxProcessOutputResponse
+	252, // 431: api.v2.V2.StreamSandboxProcessOutput:output_type -> api.v2.StreamSandboxProcessOutputResponse
+	113, // 432: api.v2.V2.CreateScore:output_type -> api.v2.CreateScoreResponse
+	116, // 433: api.v2.V2.SyncApp:output_type -> api.v2.SyncAppResponse
+	54,  // 434: api.v2.V2.GetFunctionTrace:output_type -> api.v2.GetFunctionTraceResponse
+	56,  // 435: api.v2.V2.GetFunction:output_type -> api.v2.GetFunctionResponse
+	64,  // 436: api.v2.V2.GetFunctions:output_type -> api.v2.GetFunctionsResponse
+	67,  // 437: api.v2.V2.ListFunctionVersions:output_type -> api.v2.ListFunctionVersionsResponse
+	71,  // 438: api.v2.V2.GetFunctionVersionDiff:output_type -> api.v2.GetFunctionVersionDiffResponse
+	100, // 439: api.v2.V2.SendEvent:output_type -> api.v2.SendEventResponse
+	103, // 440: api.v2.V2.InvokeFunction:output_type -> api.v2.InvokeFunctionResponse
+	106, // 441: api.v2.V2.ListScheduledInvocations:output_type -> api.v2.ListScheduledInvocationsResponse
+	108, // 442: api.v2.V2.CancelScheduledInvocation:output_type -> api.v2.CancelScheduledInvocationResponse
+	127, // 443: api.v2.V2.ListInsightsTables:output_type -> api.v2.ListInsightsTablesResponse
+	134, // 444: api.v2.V2.ListInsightsEventSchemas:output_type -> api.v2.ListInsightsEventSchemasResponse
+	131, // 445: api.v2.V2.QueryInsightsPrompt:output_type -> api.v2.QueryInsightsPromptResponse
+	120, // 446: api.v2.V2.QueryInsights:output_type -> api.v2.QueryInsightsResponse
+	137, // 447: api.v2.V2.ListExperiments:output_type -> api.v2.ListExperimentsResponse
+	140, // 448: api.v2.V2.GetExperiment:output_type -> api.v2.GetExperimentResponse
+	146, // 449: api.v2.V2.ListSessionKeys:output_type -> api.v2.ListSessionKeysResponse
+	149, // 450: api.v2.V2.ListSessions:output_type -> api.v2.ListSessionsResponse
+	152, // 451: api.v2.V2.ListSessionRuns:output_type -> api.v2.ListSessionRunsResponse
+	374, // [374:452] is the sub-list for method output_type
+	296, // [296:374] is the sub-list for method input_type
+	296, // [296:296] is the sub-list for extension type_name
+	296, // [296:296] is the sub-list for extension extendee
+	0,   // [0:296] is the sub-list for field type_name
 }
 
 func init() { file_api_v2_service_proto_init() }
@@ -10874,40 +16436,60 @@ func file_api_v2_service_proto_init() {
 	file_api_v2_service_proto_msgTypes[42].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[45].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[47].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[49].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[51].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[55].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[59].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[60].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[63].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[65].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[68].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[71].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[73].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[74].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[76].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[72].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[78].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[80].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[81].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[83].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[86].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[88].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[89].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[96].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[105].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[95].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[98].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[101].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[108].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[111].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[117].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[120].OneofWrappers = []any{}
 	file_api_v2_service_proto_msgTypes[123].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[125].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[126].OneofWrappers = []any{}
-	file_api_v2_service_proto_msgTypes[127].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[129].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[132].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[135].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[137].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[138].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[139].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[145].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[148].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[154].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[158].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[160].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[166].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[167].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[173].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[177].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[181].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[182].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[183].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[184].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[190].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[194].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[196].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[197].OneofWrappers = []any{}
+	file_api_v2_service_proto_msgTypes[198].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v2_service_proto_rawDesc), len(file_api_v2_service_proto_rawDesc)),
-			NumEnums:      11,
-			NumMessages:   134,
+			NumEnums:      16,
+			NumMessages:   203,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,57 +20,84 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	V2_Health_FullMethodName                     = "/api.v2.V2/Health"
-	V2_XSchemaOnly_FullMethodName                = "/api.v2.V2/_SchemaOnly"
-	V2_CreatePartnerAccount_FullMethodName       = "/api.v2.V2/CreatePartnerAccount"
-	V2_CreateEnv_FullMethodName                  = "/api.v2.V2/CreateEnv"
-	V2_FetchPartnerAccounts_FullMethodName       = "/api.v2.V2/FetchPartnerAccounts"
-	V2_FetchAccount_FullMethodName               = "/api.v2.V2/FetchAccount"
-	V2_FetchAccountEnvs_FullMethodName           = "/api.v2.V2/FetchAccountEnvs"
-	V2_FetchAccountEventKeys_FullMethodName      = "/api.v2.V2/FetchAccountEventKeys"
-	V2_FetchAccountSigningKeys_FullMethodName    = "/api.v2.V2/FetchAccountSigningKeys"
-	V2_CreateWebhook_FullMethodName              = "/api.v2.V2/CreateWebhook"
-	V2_ListWebhooks_FullMethodName               = "/api.v2.V2/ListWebhooks"
-	V2_PatchEnv_FullMethodName                   = "/api.v2.V2/PatchEnv"
-	V2_GetFunctionRun_FullMethodName             = "/api.v2.V2/GetFunctionRun"
-	V2_ListRuns_FullMethodName                   = "/api.v2.V2/ListRuns"
-	V2_ListFunctionRuns_FullMethodName           = "/api.v2.V2/ListFunctionRuns"
-	V2_GetEventRuns_FullMethodName               = "/api.v2.V2/GetEventRuns"
-	V2_Rerun_FullMethodName                      = "/api.v2.V2/Rerun"
-	V2_CancelRun_FullMethodName                  = "/api.v2.V2/CancelRun"
-	V2_GetApp_FullMethodName                     = "/api.v2.V2/GetApp"
-	V2_GetApps_FullMethodName                    = "/api.v2.V2/GetApps"
-	V2_CreateSandbox_FullMethodName              = "/api.v2.V2/CreateSandbox"
-	V2_ListSandboxes_FullMethodName              = "/api.v2.V2/ListSandboxes"
-	V2_GetSandbox_FullMethodName                 = "/api.v2.V2/GetSandbox"
-	V2_DestroySandbox_FullMethodName             = "/api.v2.V2/DestroySandbox"
-	V2_ExecSandbox_FullMethodName                = "/api.v2.V2/ExecSandbox"
-	V2_StreamSandboxLogs_FullMethodName          = "/api.v2.V2/StreamSandboxLogs"
-	V2_WriteSandboxFile_FullMethodName           = "/api.v2.V2/WriteSandboxFile"
-	V2_ReadSandboxFile_FullMethodName            = "/api.v2.V2/ReadSandboxFile"
-	V2_StartSandboxProcess_FullMethodName        = "/api.v2.V2/StartSandboxProcess"
-	V2_ListSandboxProcesses_FullMethodName       = "/api.v2.V2/ListSandboxProcesses"
-	V2_GetSandboxProcess_FullMethodName          = "/api.v2.V2/GetSandboxProcess"
-	V2_SignalSandboxProcess_FullMethodName       = "/api.v2.V2/SignalSandboxProcess"
-	V2_WaitSandboxProcess_FullMethodName         = "/api.v2.V2/WaitSandboxProcess"
-	V2_GetSandboxProcessOutput_FullMethodName    = "/api.v2.V2/GetSandboxProcessOutput"
-	V2_StreamSandboxProcessOutput_FullMethodName = "/api.v2.V2/StreamSandboxProcessOutput"
-	V2_CreateScore_FullMethodName                = "/api.v2.V2/CreateScore"
-	V2_SyncApp_FullMethodName                    = "/api.v2.V2/SyncApp"
-	V2_GetFunctionTrace_FullMethodName           = "/api.v2.V2/GetFunctionTrace"
-	V2_GetFunction_FullMethodName                = "/api.v2.V2/GetFunction"
-	V2_GetFunctions_FullMethodName               = "/api.v2.V2/GetFunctions"
-	V2_SendEvent_FullMethodName                  = "/api.v2.V2/SendEvent"
-	V2_InvokeFunction_FullMethodName             = "/api.v2.V2/InvokeFunction"
-	V2_ListInsightsTables_FullMethodName         = "/api.v2.V2/ListInsightsTables"
-	V2_ListInsightsEventSchemas_FullMethodName   = "/api.v2.V2/ListInsightsEventSchemas"
-	V2_QueryInsightsPrompt_FullMethodName        = "/api.v2.V2/QueryInsightsPrompt"
-	V2_QueryInsights_FullMethodName              = "/api.v2.V2/QueryInsights"
-	V2_ListExperiments_FullMethodName            = "/api.v2.V2/ListExperiments"
-	V2_GetExperiment_FullMethodName              = "/api.v2.V2/GetExperiment"
-	V2_ListSessionKeys_FullMethodName            = "/api.v2.V2/ListSessionKeys"
-	V2_ListSessions_FullMethodName               = "/api.v2.V2/ListSessions"
-	V2_ListSessionRuns_FullMethodName            = "/api.v2.V2/ListSessionRuns"
+	V2_Health_FullMethodName                        = "/api.v2.V2/Health"
+	V2_XSchemaOnly_FullMethodName                   = "/api.v2.V2/_SchemaOnly"
+	V2_CreatePartnerAccount_FullMethodName          = "/api.v2.V2/CreatePartnerAccount"
+	V2_CreateEnv_FullMethodName                     = "/api.v2.V2/CreateEnv"
+	V2_FetchPartnerAccounts_FullMethodName          = "/api.v2.V2/FetchPartnerAccounts"
+	V2_FetchAccount_FullMethodName                  = "/api.v2.V2/FetchAccount"
+	V2_FetchAccountEnvs_FullMethodName              = "/api.v2.V2/FetchAccountEnvs"
+	V2_FetchAccountEventKeys_FullMethodName         = "/api.v2.V2/FetchAccountEventKeys"
+	V2_FetchAccountSigningKeys_FullMethodName       = "/api.v2.V2/FetchAccountSigningKeys"
+	V2_CreateWebhook_FullMethodName                 = "/api.v2.V2/CreateWebhook"
+	V2_ListWebhooks_FullMethodName                  = "/api.v2.V2/ListWebhooks"
+	V2_PatchEnv_FullMethodName                      = "/api.v2.V2/PatchEnv"
+	V2_GetFunctionRun_FullMethodName                = "/api.v2.V2/GetFunctionRun"
+	V2_ListRuns_FullMethodName                      = "/api.v2.V2/ListRuns"
+	V2_ListFunctionRuns_FullMethodName              = "/api.v2.V2/ListFunctionRuns"
+	V2_GetEventRuns_FullMethodName                  = "/api.v2.V2/GetEventRuns"
+	V2_Rerun_FullMethodName                         = "/api.v2.V2/Rerun"
+	V2_CancelRun_FullMethodName                     = "/api.v2.V2/CancelRun"
+	V2_ListRunWaits_FullMethodName                  = "/api.v2.V2/ListRunWaits"
+	V2_ListFunctionWaits_FullMethodName             = "/api.v2.V2/ListFunctionWaits"
+	V2_ResolveWait_FullMethodName                   = "/api.v2.V2/ResolveWait"
+	V2_TimeoutWait_FullMethodName                   = "/api.v2.V2/TimeoutWait"
+	V2_PreviewBulkRunOperation_FullMethodName       = "/api.v2.V2/PreviewBulkRunOperation"
+	V2_CreateBulkRunOperation_FullMethodName        = "/api.v2.V2/CreateBulkRunOperation"
+	V2_ListBulkRunOperations_FullMethodName         = "/api.v2.V2/ListBulkRunOperations"
+	V2_GetBulkRunOperation_FullMethodName           = "/api.v2.V2/GetBulkRunOperation"
+	V2_AbortBulkRunOperation_FullMethodName         = "/api.v2.V2/AbortBulkRunOperation"
+	V2_CreateOutboundWebhook_FullMethodName         = "/api.v2.V2/CreateOutboundWebhook"
+	V2_ListOutboundWebhooks_FullMethodName          = "/api.v2.V2/ListOutboundWebhooks"
+	V2_GetOutboundWebhook_FullMethodName            = "/api.v2.V2/GetOutboundWebhook"
+	V2_UpdateOutboundWebhook_FullMethodName         = "/api.v2.V2/UpdateOutboundWebhook"
+	V2_DeleteOutboundWebhook_FullMethodName         = "/api.v2.V2/DeleteOutboundWebhook"
+	V2_ListOutboundWebhookDeliveries_FullMethodName = "/api.v2.V2/ListOutboundWebhookDeliveries"
+	V2_ReplayOutboundWebhookDelivery_FullMethodName = "/api.v2.V2/ReplayOutboundWebhookDelivery"
+	V2_CreateAlertRule_FullMethodName               = "/api.v2.V2/CreateAlertRule"
+	V2_ListAlertRules_FullMethodName                = "/api.v2.V2/ListAlertRules"
+	V2_GetAlertRule_FullMethodName                  = "/api.v2.V2/GetAlertRule"
+	V2_UpdateAlertRule_FullMethodName               = "/api.v2.V2/UpdateAlertRule"
+	V2_DeleteAlertRule_FullMethodName               = "/api.v2.V2/DeleteAlertRule"
+	V2_ListAlerts_FullMethodName                    = "/api.v2.V2/ListAlerts"
+	V2_GetUsage_FullMethodName                      = "/api.v2.V2/GetUsage"
+	V2_GetApp_FullMethodName                        = "/api.v2.V2/GetApp"
+	V2_GetApps_FullMethodName                       = "/api.v2.V2/GetApps"
+	V2_CreateSandbox_FullMethodName                 = "/api.v2.V2/CreateSandbox"
+	V2_ListSandboxes_FullMethodName                 = "/api.v2.V2/ListSandboxes"
+	V2_GetSandbox_FullMethodName                    = "/api.v2.V2/GetSandbox"
+	V2_DestroySandbox_FullMethodName                = "/api.v2.V2/DestroySandbox"
+	V2_ExecSandbox_FullMethodName                   = "/api.v2.V2/ExecSandbox"
+	V2_StreamSandboxLogs_FullMethodName             = "/api.v2.V2/StreamSandboxLogs"
+	V2_WriteSandboxFile_FullMethodName              = "/api.v2.V2/WriteSandboxFile"
+	V2_ReadSandboxFile_FullMethodName               = "/api.v2.V2/ReadSandboxFile"
+	V2_StartSandboxProcess_FullMethodName           = "/api.v2.V2/StartSandboxProcess"
+	V2_ListSandboxProcesses_FullMethodName          = "/api.v2.V2/ListSandboxProcesses"
+	V2_GetSandboxProcess_FullMethodName             = "/api.v2.V2/GetSandboxProcess"
+	V2_SignalSandboxProcess_FullMethodName          = "/api.v2.V2/SignalSandboxProcess"
+	V2_WaitSandboxProcess_FullMethodName            = "/api.v2.V2/WaitSandboxProcess"
+	V2_GetSandboxProcessOutput_FullMethodName       = "/api.v2.V2/GetSandboxProcessOutput"
+	V2_StreamSandboxProcessOutput_FullMethodName    = "/api.v2.V2/StreamSandboxProcessOutput"
+	V2_CreateScore_FullMethodName                   = "/api.v2.V2/CreateScore"
+	V2_SyncApp_FullMethodName                       = "/api.v2.V2/SyncApp"
+	V2_GetFunctionTrace_FullMethodName              = "/api.v2.V2/GetFunctionTrace"
+	V2_GetFunction_FullMethodName                   = "/api.v2.V2/GetFunction"
+	V2_GetFunctions_FullMethodName                  = "/api.v2.V2/GetFunctions"
+	V2_ListFunctionVersions_FullMethodName          = "/api.v2.V2/ListFunctionVersions"
+	V2_GetFunctionVersionDiff_FullMethodName        = "/api.v2.V2/GetFunctionVersionDiff"
+	V2_SendEvent_FullMethodName                     = "/api.v2.V2/SendEvent"
+	V2_InvokeFunction_FullMethodName                = "/api.v2.V2/InvokeFunction"
+	V2_ListScheduledInvocations_FullMethodName      = "/api.v2.V2/ListScheduledInvocations"
+	V2_CancelScheduledInvocation_FullMethodName     = "/api.v2.V2/CancelScheduledInvocation"
+	V2_ListInsightsTables_FullMethodName            = "/api.v2.V2/ListInsightsTables"
+	V2_ListInsightsEventSchemas_FullMethodName      = "/api.v2.V2/ListInsightsEventSchemas"
+	V2_QueryInsightsPrompt_FullMethodName           = "/api.v2.V2/QueryInsightsPrompt"
+	V2_QueryInsights_FullMethodName                 = "/api.v2.V2/QueryInsights"
+	V2_ListExperiments_FullMethodName               = "/api.v2.V2/ListExperiments"
+	V2_GetExperiment_FullMethodName                 = "/api.v2.V2/GetExperiment"
+	V2_ListSessionKeys_FullMethodName               = "/api.v2.V2/ListSessionKeys"
+	V2_ListSessions_FullMethodName                  = "/api.v2.V2/ListSessions"
+	V2_ListSessionRuns_FullMethodName               = "/api.v2.V2/ListSessionRuns"
 )
 
 // V2Client is the client API for V2 service.
@@ -98,6 +125,29 @@ type V2Client interface {
 	GetEventRuns(ctx context.Context, in *GetEventRunsRequest, opts ...grpc.CallOption) (*GetEventRunsResponse, error)
 	Rerun(ctx context.Context, in *RerunRequest, opts ...grpc.CallOption) (*RerunResponse, error)
 	CancelRun(ctx context.Context, in *CancelRunRequest, opts ...grpc.CallOption) (*CancelRunResponse, error)
+	ListRunWaits(ctx context.Context, in *ListRunWaitsRequest, opts ...grpc.CallOption) (*ListRunWaitsResponse, error)
+	ListFunctionWaits(ctx context.Context, in *ListFunctionWaitsRequest, opts ...grpc.CallOption) (*ListFunctionWaitsResponse, error)
+	ResolveWait(ctx context.Context, in *ResolveWaitRequest, opts ...grpc.CallOption) (*ResolveWaitResponse, error)
+	TimeoutWait(ctx context.Context, in *TimeoutWaitRequest, opts ...grpc.CallOption) (*TimeoutWaitResponse, error)
+	PreviewBulkRunOperation(ctx context.Context, in *PreviewBulkRunOperationRequest, opts ...grpc.CallOption) (*PreviewBulkRunOperationResponse, error)
+	CreateBulkRunOperation(ctx context.Context, in *CreateBulkRunOperationRequest, opts ...grpc.CallOption) (*CreateBulkRunOperationResponse, error)
+	ListBulkRunOperations(ctx context.Context, in *ListBulkRunOperationsRequest, opts ...grpc.CallOption) (*ListBulkRunOperationsResponse, error)
+	GetBulkRunOperation(ctx context.Context, in *GetBulkRunOperationRequest, opts ...grpc.CallOption) (*GetBulkRunOperationResponse, error)
+	AbortBulkRunOperation(ctx context.Context, in *AbortBulkRunOperationRequest, opts ...grpc.CallOption) (*AbortBulkRunOperationResponse, error)
+	CreateOutboundWebhook(ctx context.Context, in *CreateOutboundWebhookRequest, opts ...grpc.CallOption) (*CreateOutboundWebhookResponse, error)
+	ListOutboundWebhooks(ctx context.Context, in *ListOutboundWebhooksRequest, opts ...grpc.CallOption) (*ListOutboundWebhooksResponse, error)
+	GetOutboundWebhook(ctx context.Context, in *GetOutboundWebhookRequest, opts ...grpc.CallOption) (*GetOutboundWebhookResponse, error)
+	UpdateOutboundWebhook(ctx context.Context, in *UpdateOutboundWebhookRequest, opts ...grpc.CallOption) (*UpdateOutboundWebhookResponse, error)
+	DeleteOutboundWebhook(ctx context.Context, in *DeleteOutboundWebhookRequest, opts ...grpc.CallOption) (*DeleteOutboundWebhookResponse, error)
+	ListOutboundWebhookDeliveries(ctx context.Context, in *ListOutboundWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListOutboundWebhookDeliveriesResponse, error)
+	ReplayOutboundWebhookDelivery(ctx context.Context, in *ReplayOutboundWebhookDeliveryRequest, opts ...grpc.CallOption) (*ReplayOutboundWebhookDeliveryResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error)
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
 	GetApp(ctx context.Context, in *GetAppRequest, opts ...grpc.CallOption) (*GetAppResponse, error)
 	GetApps(ctx context.Context, in *GetAppsRequest, opts ...grpc.CallOption) (*GetAppsResponse, error)
 	CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc.CallOption) (*CreateSandboxResponse, error)
@@ -120,8 +170,12 @@ type V2Client interface {
 	GetFunctionTrace(ctx context.Context, in *GetFunctionTraceRequest, opts ...grpc.CallOption) (*GetFunctionTraceResponse, error)
 	GetFunction(ctx context.Context, in *GetFunctionRequest, opts ...grpc.CallOption) (*GetFunctionResponse, error)
 	GetFunctions(ctx context.Context, in *GetFunctionsRequest, opts ...grpc.CallOption) (*GetFunctionsResponse, error)
+	ListFunctionVersions(ctx context.Context, in *ListFunctionVersionsRequest, opts ...grpc.CallOption) (*ListFunctionVersionsResponse, error)
+	GetFunctionVersionDiff(ctx context.Context, in *GetFunctionVersionDiffRequest, opts ...grpc.CallOption) (*GetFunctionVersionDiffResponse, error)
 	SendEvent(ctx context.Context, in *SendEventRequest, opts ...grpc.CallOption) (*SendEventResponse, error)
 	InvokeFunction(ctx context.Context, in *InvokeFunctionRequest, opts ...grpc.CallOption) (*InvokeFunctionResponse, error)
+	ListScheduledInvocations(ctx context.Context, in *ListScheduledInvocationsRequest, opts ...grpc.CallOption) (*ListScheduledInvocationsResponse, error)
+	CancelScheduledInvocation(ctx context.Context, in *CancelScheduledInvocationRequest, opts ...grpc.CallOption) (*CancelScheduledInvocationResponse, error)
 	ListInsightsTables(ctx context.Context, in *ListInsightsTablesRequest, opts ...grpc.CallOption) (*ListInsightsTablesResponse, error)
 	ListInsightsEventSchemas(ctx context.Context, in *ListInsightsEventSchemasRequest, opts ...grpc.CallOption) (*ListInsightsEventSchemasResponse, error)
 	QueryInsightsPrompt(ctx context.Context, in *QueryInsightsPromptRequest, opts ...grpc.CallOption) (*QueryInsightsPromptResponse, error)
@@ -321,6 +375,236 @@ func (c *v2Client) CancelRun(ctx context.Context, in *CancelRunRequest, opts ...
 	return out, nil
 }
 
+func (c *v2Client) ListRunWaits(ctx context.Context, in *ListRunWaitsRequest, opts ...grpc.CallOption) (*ListRunWaitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunWaitsResponse)
+	err := c.cc.Invoke(ctx, V2_ListRunWaits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ListFunctionWaits(ctx context.Context, in *ListFunctionWaitsRequest, opts ...grpc.CallOption) (*ListFunctionWaitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFunctionWaitsResponse)
+	err := c.cc.Invoke(ctx, V2_ListFunctionWaits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ResolveWait(ctx context.Context, in *ResolveWaitRequest, opts ...grpc.CallOption) (*ResolveWaitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveWaitResponse)
+	err := c.cc.Invoke(ctx, V2_ResolveWait_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) TimeoutWait(ctx context.Context, in *TimeoutWaitRequest, opts ...grpc.CallOption) (*TimeoutWaitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeoutWaitResponse)
+	err := c.cc.Invoke(ctx, V2_TimeoutWait_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) PreviewBulkRunOperation(ctx context.Context, in *PreviewBulkRunOperationRequest, opts ...grpc.CallOption) (*PreviewBulkRunOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewBulkRunOperationResponse)
+	err := c.cc.Invoke(ctx, V2_PreviewBulkRunOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) CreateBulkRunOperation(ctx context.Context, in *CreateBulkRunOperationRequest, opts ...grpc.CallOption) (*CreateBulkRunOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBulkRunOperationResponse)
+	err := c.cc.Invoke(ctx, V2_CreateBulkRunOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ListBulkRunOperations(ctx context.Context, in *ListBulkRunOperationsRequest, opts ...grpc.CallOption) (*ListBulkRunOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBulkRunOperationsResponse)
+	err := c.cc.Invoke(ctx, V2_ListBulkRunOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) GetBulkRunOperation(ctx context.Context, in *GetBulkRunOperationRequest, opts ...grpc.CallOption) (*GetBulkRunOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBulkRunOperationResponse)
+	err := c.cc.Invoke(ctx, V2_GetBulkRunOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) AbortBulkRunOperation(ctx context.Context, in *AbortBulkRunOperationRequest, opts ...grpc.CallOption) (*AbortBulkRunOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortBulkRunOperationResponse)
+	err := c.cc.Invoke(ctx, V2_AbortBulkRunOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) CreateOutboundWebhook(ctx context.Context, in *CreateOutboundWebhookRequest, opts ...grpc.CallOption) (*CreateOutboundWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOutboundWebhookResponse)
+	err := c.cc.Invoke(ctx, V2_CreateOutboundWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ListOutboundWebhooks(ctx context.Context, in *ListOutboundWebhooksRequest, opts ...grpc.CallOption) (*ListOutboundWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutboundWebhooksResponse)
+	err := c.cc.Invoke(ctx, V2_ListOutboundWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) GetOutboundWebhook(ctx context.Context, in *GetOutboundWebhookRequest, opts ...grpc.CallOption) (*GetOutboundWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutboundWebhookResponse)
+	err := c.cc.Invoke(ctx, V2_GetOutboundWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) UpdateOutboundWebhook(ctx context.Context, in *UpdateOutboundWebhookRequest, opts ...grpc.CallOption) (*UpdateOutboundWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOutboundWebhookResponse)
+	err := c.cc.Invoke(ctx, V2_UpdateOutboundWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) DeleteOutboundWebhook(ctx context.Context, in *DeleteOutboundWebhookRequest, opts ...grpc.CallOption) (*DeleteOutboundWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOutboundWebhookResponse)
+	err := c.cc.Invoke(ctx, V2_DeleteOutboundWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ListOutboundWebhookDeliveries(ctx context.Context, in *ListOutboundWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListOutboundWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutboundWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, V2_ListOutboundWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ReplayOutboundWebhookDelivery(ctx context.Context, in *ReplayOutboundWebhookDeliveryRequest, opts ...grpc.CallOption) (*ReplayOutboundWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayOutboundWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, V2_ReplayOutboundWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, V2_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, V2_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertRuleResponse)
+	err := c.cc.Invoke(ctx, V2_GetAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, V2_UpdateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, V2_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, V2_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, V2_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *v2Client) GetApp(ctx context.Context, in *GetAppRequest, opts ...grpc.CallOption) (*GetAppResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAppResponse)
@@ -568,6 +852,26 @@ func (c *v2Client) GetFunctions(ctx context.Context, in *GetFunctionsRequest, op
 	return out, nil
 }
 
+func (c *v2Client) ListFunctionVersions(ctx context.Context, in *ListFunctionVersionsRequest, opts ...grpc.CallOption) (*ListFunctionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFunctionVersionsResponse)
+	err := c.cc.Invoke(ctx, V2_ListFunctionVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) GetFunctionVersionDiff(ctx context.Context, in *GetFunctionVersionDiffRequest, opts ...grpc.CallOption) (*GetFunctionVersionDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFunctionVersionDiffResponse)
+	err := c.cc.Invoke(ctx, V2_GetFunctionVersionDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *v2Client) SendEvent(ctx context.Context, in *SendEventRequest, opts ...grpc.CallOption) (*SendEventResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendEventResponse)
@@ -588,6 +892,26 @@ func (c *v2Client) InvokeFunction(ctx context.Context, in *InvokeFunctionRequest
 	return out, nil
 }
 
+func (c *v2Client) ListScheduledInvocations(ctx context.Context, in *ListScheduledInvocationsRequest, opts ...grpc.CallOption) (*ListScheduledInvocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledInvocationsResponse)
+	err := c.cc.Invoke(ctx, V2_ListScheduledInvocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v2Client) CancelScheduledInvocation(ctx context.Context, in *CancelScheduledInvocationRequest, opts ...grpc.CallOption) (*CancelScheduledInvocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledInvocationResponse)
+	err := c.cc.Invoke(ctx, V2_CancelScheduledInvocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *v2Client) ListInsightsTables(ctx context.Context, in *ListInsightsTablesRequest, opts ...grpc.CallOption) (*ListInsightsTablesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListInsightsTablesResponse)
@@ -703,6 +1027,29 @@ type V2Server interface {
 	GetEventRuns(context.Context, *GetEventRunsRequest) (*GetEventRunsResponse, error)
 	Rerun(context.Context, *RerunRequest) (*RerunResponse, error)
 	CancelRun(context.Context, *CancelRunRequest) (*CancelRunResponse, error)
+	ListRunWaits(context.Context, *ListRunWaitsRequest) (*ListRunWaitsResponse, error)
+	ListFunctionWaits(context.Context, *ListFunctionWaitsRequest) (*ListFunctionWaitsResponse, error)
+	ResolveWait(context.Context, *ResolveWaitRequest) (*ResolveWaitResponse, error)
+	TimeoutWait(context.Context, *TimeoutWaitRequest) (*TimeoutWaitResponse, error)
+	PreviewBulkRunOperation(context.Context, *PreviewBulkRunOperationRequest) (*PreviewBulkRunOperationResponse, error)
+	CreateBulkRunOperation(context.Context, *CreateBulkRunOperationRequest) (*CreateBulkRunOperationResponse, error)
+	ListBulkRunOperations(context.Context, *ListBulkRunOperationsRequest) (*ListBulkRunOperationsResponse, error)
+	GetBulkRunOperation(context.Context, *GetBulkRunOperationRequest) (*GetBulkRunOperationResponse, error)
+	AbortBulkRunOperation(context.Context, *AbortBulkRunOperationRequest) (*AbortBulkRunOperationResponse, error)
+	CreateOutboundWebhook(context.Context, *CreateOutboundWebhookRequest) (*CreateOutboundWebhookResponse, error)
+	ListOutboundWebhooks(context.Context, *ListOutboundWebhooksRequest) (*ListOutboundWebhooksResponse, error)
+	GetOutboundWebhook(context.Context, *GetOutboundWebhookRequest) (*GetOutboundWebhookResponse, error)
+	UpdateOutboundWebhook(context.Context, *UpdateOutboundWebhookRequest) (*UpdateOutboundWebhookResponse, error)
+	DeleteOutboundWebhook(context.Context, *DeleteOutboundWebhookRequest) (*DeleteOutboundWebhookResponse, error)
+	ListOutboundWebhookDeliveries(context.Context, *ListOutboundWebhookDeliveriesRequest) (*ListOutboundWebhookDeliveriesResponse, error)
+	ReplayOutboundWebhookDelivery(context.Context, *ReplayOutboundWebhookDeliveryRequest) (*ReplayOutboundWebhookDeliveryResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error)
+	UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
 	GetApp(context.Context, *GetAppRequest) (*GetAppResponse, error)
 	GetApps(context.Context, *GetAppsRequest) (*GetAppsResponse, error)
 	CreateSandbox(context.Context, *CreateSandboxRequest) (*CreateSandboxResponse, error)
@@ -725,8 +1072,12 @@ type V2Server interface {
 	GetFunctionTrace(context.Context, *GetFunctionTraceRequest) (*GetFunctionTraceResponse, error)
 	GetFunction(context.Context, *GetFunctionRequest) (*GetFunctionResponse, error)
 	GetFunctions(context.Context, *GetFunctionsRequest) (*GetFunctionsResponse, error)
+	ListFunctionVersions(context.Context, *ListFunctionVersionsRequest) (*ListFunctionVersionsResponse, error)
+	GetFunctionVersionDiff(context.Context, *GetFunctionVersionDiffRequest) (*GetFunctionVersionDiffResponse, error)
 	SendEvent(context.Context, *SendEventRequest) (*SendEventResponse, error)
 	InvokeFunction(context.Context, *InvokeFunctionRequest) (*InvokeFunctionResponse, error)
+	ListScheduledInvocations(context.Context, *ListScheduledInvocationsRequest) (*ListScheduledInvocationsResponse, error)
+	CancelScheduledInvocation(context.Context, *CancelScheduledInvocationRequest) (*CancelScheduledInvocationResponse, error)
 	ListInsightsTables(context.Context, *ListInsightsTablesRequest) (*ListInsightsTablesResponse, error)
 	ListInsightsEventSchemas(context.Context, *ListInsightsEventSchemasRequest) (*ListInsightsEventSchemasResponse, error)
 	QueryInsightsPrompt(context.Context, *QueryInsightsPromptRequest) (*QueryInsightsPromptResponse, error)
@@ -800,6 +1151,75 @@ func (UnimplementedV2Server) Rerun(context.Context, *RerunRequest) (*RerunRespon
 func (UnimplementedV2Server) CancelRun(context.Context, *CancelRunRequest) (*CancelRunResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelRun not implemented")
 }
+func (UnimplementedV2Server) ListRunWaits(context.Context, *ListRunWaitsRequest) (*ListRunWaitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRunWaits not implemented")
+}
+func (UnimplementedV2Server) ListFunctionWaits(context.Context, *ListFunctionWaitsRequest) (*ListFunctionWaitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFunctionWaits not implemented")
+}
+func (UnimplementedV2Server) ResolveWait(context.Context, *ResolveWaitRequest) (*ResolveWaitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveWait not implemented")
+}
+func (UnimplementedV2Server) TimeoutWait(context.Context, *TimeoutWaitRequest) (*TimeoutWaitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TimeoutWait not implemented")
+}
+func (UnimplementedV2Server) PreviewBulkRunOperation(context.Context, *PreviewBulkRunOperationRequest) (*PreviewBulkRunOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewBulkRunOperation not implemented")
+}
+func (UnimplementedV2Server) CreateBulkRunOperation(context.Context, *CreateBulkRunOperationRequest) (*CreateBulkRunOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBulkRunOperation not implemented")
+}
+func (UnimplementedV2Server) ListBulkRunOperations(context.Context, *ListBulkRunOperationsRequest) (*ListBulkRunOperationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBulkRunOperations not implemented")
+}
+func (UnimplementedV2Server) GetBulkRunOperation(context.Context, *GetBulkRunOperationRequest) (*GetBulkRunOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBulkRunOperation not implemented")
+}
+func (UnimplementedV2Server) AbortBulkRunOperation(context.Context, *AbortBulkRunOperationRequest) (*AbortBulkRunOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortBulkRunOperation not implemented")
+}
+func (UnimplementedV2Server) CreateOutboundWebhook(context.Context, *CreateOutboundWebhookRequest) (*CreateOutboundWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOutboundWebhook not implemented")
+}
+func (UnimplementedV2Server) ListOutboundWebhooks(context.Context, *ListOutboundWebhooksRequest) (*ListOutboundWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOutboundWebhooks not implemented")
+}
+func (UnimplementedV2Server) GetOutboundWebhook(context.Context, *GetOutboundWebhookRequest) (*GetOutboundWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOutboundWebhook not implemented")
+}
+func (UnimplementedV2Server) UpdateOutboundWebhook(context.Context, *UpdateOutboundWebhookRequest) (*UpdateOutboundWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateOutboundWebhook not implemented")
+}
+func (UnimplementedV2Server) DeleteOutboundWebhook(context.Context, *DeleteOutboundWebhookRequest) (*DeleteOutboundWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOutboundWebhook not implemented")
+}
+func (UnimplementedV2Server) ListOutboundWebhookDeliveries(context.Context, *ListOutboundWebhookDeliveriesRequest) (*ListOutboundWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOutboundWebhookDeliveries not implemented")
+}
+func (UnimplementedV2Server) ReplayOutboundWebhookDelivery(context.Context, *ReplayOutboundWebhookDeliveryRequest) (*ReplayOutboundWebhookDeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayOutboundWebhookDelivery not implemented")
+}
+func (UnimplementedV2Server) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedV2Server) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedV2Server) GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlertRule not implemented")
+}
+func (UnimplementedV2Server) UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedV2Server) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedV2Server) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedV2Server) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedV2Server) GetApp(context.Context, *GetAppRequest) (*GetAppResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetApp not implemented")
 }
@@ -866,12 +1286,24 @@ func (UnimplementedV2Server) GetFunction(context.Context, *GetFunctionRequest) (
 func (UnimplementedV2Server) GetFunctions(context.Context, *GetFunctionsRequest) (*GetFunctionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFunctions not implemented")
 }
+func (UnimplementedV2Server) ListFunctionVersions(context.Context, *ListFunctionVersionsRequest) (*ListFunctionVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFunctionVersions not implemented")
+}
+func (UnimplementedV2Server) GetFunctionVersionDiff(context.Context, *GetFunctionVersionDiffRequest) (*GetFunctionVersionDiffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFunctionVersionDiff not implemented")
+}
 func (UnimplementedV2Server) SendEvent(context.Context, *SendEventRequest) (*SendEventResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendEvent not implemented")
 }
 func (UnimplementedV2Server) InvokeFunction(context.Context, *InvokeFunctionRequest) (*InvokeFunctionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InvokeFunction not implemented")
 }
+func (UnimplementedV2Server) ListScheduledInvocations(context.Context, *ListScheduledInvocationsRequest) (*ListScheduledInvocationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledInvocations not implemented")
+}
+func (UnimplementedV2Server) CancelScheduledInvocation(context.Context, *CancelScheduledInvocationRequest) (*CancelScheduledInvocationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledInvocation not implemented")
+}
 func (UnimplementedV2Server) ListInsightsTables(context.Context, *ListInsightsTablesRequest) (*ListInsightsTablesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListInsightsTables not implemented")
 }
@@ -1244,137 +1676,551 @@ func _V2_CancelRun_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppRequest)
+func _V2_ListRunWaits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunWaitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).GetApp(ctx, in)
+		return srv.(V2Server).ListRunWaits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_GetApp_FullMethodName,
+		FullMethod: V2_ListRunWaits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).GetApp(ctx, req.(*GetAppRequest))
+		return srv.(V2Server).ListRunWaits(ctx, req.(*ListRunWaitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_GetApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppsRequest)
+func _V2_ListFunctionWaits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFunctionWaitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).GetApps(ctx, in)
+		return srv.(V2Server).ListFunctionWaits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_GetApps_FullMethodName,
+		FullMethod: V2_ListFunctionWaits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).GetApps(ctx, req.(*GetAppsRequest))
+		return srv.(V2Server).ListFunctionWaits(ctx, req.(*ListFunctionWaitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_CreateSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSandboxRequest)
+func _V2_ResolveWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveWaitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).CreateSandbox(ctx, in)
+		return srv.(V2Server).ResolveWait(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_CreateSandbox_FullMethodName,
+		FullMethod: V2_ResolveWait_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).CreateSandbox(ctx, req.(*CreateSandboxRequest))
+		return srv.(V2Server).ResolveWait(ctx, req.(*ResolveWaitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_ListSandboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSandboxesRequest)
+func _V2_TimeoutWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeoutWaitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).ListSandboxes(ctx, in)
+		return srv.(V2Server).TimeoutWait(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_ListSandboxes_FullMethodName,
+		FullMethod: V2_TimeoutWait_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).ListSandboxes(ctx, req.(*ListSandboxesRequest))
+		return srv.(V2Server).TimeoutWait(ctx, req.(*TimeoutWaitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_GetSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSandboxRequest)
+func _V2_PreviewBulkRunOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewBulkRunOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).GetSandbox(ctx, in)
+		return srv.(V2Server).PreviewBulkRunOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_GetSandbox_FullMethodName,
+		FullMethod: V2_PreviewBulkRunOperation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).GetSandbox(ctx, req.(*GetSandboxRequest))
+		return srv.(V2Server).PreviewBulkRunOperation(ctx, req.(*PreviewBulkRunOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_DestroySandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DestroySandboxRequest)
+func _V2_CreateBulkRunOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBulkRunOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).DestroySandbox(ctx, in)
+		return srv.(V2Server).CreateBulkRunOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_DestroySandbox_FullMethodName,
+		FullMethod: V2_CreateBulkRunOperation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).DestroySandbox(ctx, req.(*DestroySandboxRequest))
+		return srv.(V2Server).CreateBulkRunOperation(ctx, req.(*CreateBulkRunOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_ExecSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecSandboxRequest)
+func _V2_ListBulkRunOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBulkRunOperationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(V2Server).ExecSandbox(ctx, in)
+		return srv.(V2Server).ListBulkRunOperations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: V2_ExecSandbox_FullMethodName,
+		FullMethod: V2_ListBulkRunOperations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(V2Server).ExecSandbox(ctx, req.(*ExecSandboxRequest))
+		return srv.(V2Server).ListBulkRunOperations(ctx, req.(*ListBulkRunOperationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _V2_StreamSandboxLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamSandboxLogsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
+func _V2_GetBulkRunOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkRunOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetBulkRunOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetBulkRunOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetBulkRunOperation(ctx, req.(*GetBulkRunOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_AbortBulkRunOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortBulkRunOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).AbortBulkRunOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_AbortBulkRunOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).AbortBulkRunOperation(ctx, req.(*AbortBulkRunOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_CreateOutboundWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOutboundWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).CreateOutboundWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_CreateOutboundWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).CreateOutboundWebhook(ctx, req.(*CreateOutboundWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ListOutboundWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboundWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListOutboundWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListOutboundWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListOutboundWebhooks(ctx, req.(*ListOutboundWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetOutboundWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboundWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetOutboundWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetOutboundWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetOutboundWebhook(ctx, req.(*GetOutboundWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_UpdateOutboundWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOutboundWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).UpdateOutboundWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_UpdateOutboundWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).UpdateOutboundWebhook(ctx, req.(*UpdateOutboundWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_DeleteOutboundWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOutboundWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).DeleteOutboundWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_DeleteOutboundWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).DeleteOutboundWebhook(ctx, req.(*DeleteOutboundWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ListOutboundWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboundWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListOutboundWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListOutboundWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListOutboundWebhookDeliveries(ctx, req.(*ListOutboundWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ReplayOutboundWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboundWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ReplayOutboundWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ReplayOutboundWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ReplayOutboundWebhookDelivery(ctx, req.(*ReplayOutboundWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetAlertRule(ctx, req.(*GetAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_UpdateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).UpdateAlertRule(ctx, req.(*UpdateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListAlerts(ctx, req.(*ListAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetApp(ctx, req.(*GetAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetApps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetApps(ctx, req.(*GetAppsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_CreateSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).CreateSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_CreateSandbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).CreateSandbox(ctx, req.(*CreateSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ListSandboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSandboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListSandboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListSandboxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListSandboxes(ctx, req.(*ListSandboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetSandbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetSandbox(ctx, req.(*GetSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_DestroySandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroySandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).DestroySandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_DestroySandbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).DestroySandbox(ctx, req.(*DestroySandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_ExecSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ExecSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ExecSandbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ExecSandbox(ctx, req.(*ExecSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_StreamSandboxLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSandboxLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
 	return srv.(V2Server).StreamSandboxLogs(m, &grpc.GenericServerStream[StreamSandboxLogsRequest, StreamSandboxLogsResponse]{ServerStream: stream})
 }
 
@@ -1619,6 +2465,42 @@ func _V2_GetFunctions_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _V2_ListFunctionVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFunctionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListFunctionVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListFunctionVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListFunctionVersions(ctx, req.(*ListFunctionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_GetFunctionVersionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunctionVersionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).GetFunctionVersionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_GetFunctionVersionDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).GetFunctionVersionDiff(ctx, req.(*GetFunctionVersionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _V2_SendEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendEventRequest)
 	if err := dec(in); err != nil {
@@ -1655,6 +2537,42 @@ func _V2_InvokeFunction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _V2_ListScheduledInvocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledInvocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).ListScheduledInvocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_ListScheduledInvocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).ListScheduledInvocations(ctx, req.(*ListScheduledInvocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V2_CancelScheduledInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledInvocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V2Server).CancelScheduledInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: V2_CancelScheduledInvocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V2Server).CancelScheduledInvocation(ctx, req.(*CancelScheduledInvocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _V2_ListInsightsTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListInsightsTablesRequest)
 	if err := dec(in); err != nil {
@@ -1896,6 +2814,98 @@ var V2_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRun",
 			Handler:    _V2_CancelRun_Handler,
 		},
+		{
+			MethodName: "ListRunWaits",
+			Handler:    _V2_ListRunWaits_Handler,
+		},
+		{
+			MethodName: "ListFunctionWaits",
+			Handler:    _V2_ListFunctionWaits_Handler,
+		},
+		{
+			MethodName: "ResolveWait",
+			Handler:    _V2_ResolveWait_Handler,
+		},
+		{
+			MethodName: "TimeoutWait",
+			Handler:    _V2_TimeoutWait_Handler,
+		},
+		{
+			MethodName: "PreviewBulkRunOperation",
+			Handler:    _V2_PreviewBulkRunOperation_Handler,
+		},
+		{
+			MethodName: "CreateBulkRunOperation",
+			Handler:    _V2_CreateBulkRunOperation_Handler,
+		},
+		{
+			MethodName: "ListBulkRunOperations",
+			Handler:    _V2_ListBulkRunOperations_Handler,
+		},
+		{
+			MethodName: "GetBulkRunOperation",
+			Handler:    _V2_GetBulkRunOperation_Handler,
+		},
+		{
+			MethodName: "AbortBulkRunOperation",
+			Handler:    _V2_AbortBulkRunOperation_Handler,
+		},
+		{
+			MethodName: "CreateOutboundWebhook",
+			Handler:    _V2_CreateOutboundWebhook_Handler,
+		},
+		{
+			MethodName: "ListOutboundWebhooks",
+			Handler:    _V2_ListOutboundWebhooks_Handler,
+		},
+		{
+			MethodName: "GetOutboundWebhook",
+			Handler:    _V2_GetOutboundWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateOutboundWebhook",
+			Handler:    _V2_UpdateOutboundWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteOutboundWebhook",
+			Handler:    _V2_DeleteOutboundWebhook_Handler,
+		},
+		{
+			MethodName: "ListOutboundWebhookDeliveries",
+			Handler:    _V2_ListOutboundWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayOutboundWebhookDelivery",
+			Handler:    _V2_ReplayOutboundWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _V2_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _V2_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "GetAlertRule",
+			Handler:    _V2_GetAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _V2_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _V2_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _V2_ListAlerts_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _V2_GetUsage_Handler,
+		},
 		{
 			MethodName: "GetApp",
 			Handler:    _V2_GetApp_Handler,
@@ -1972,6 +2982,14 @@ var V2_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFunctions",
 			Handler:    _V2_GetFunctions_Handler,
 		},
+		{
+			MethodName: "ListFunctionVersions",
+			Handler:    _V2_ListFunctionVersions_Handler,
+		},
+		{
+			MethodName: "GetFunctionVersionDiff",
+			Handler:    _V2_GetFunctionVersionDiff_Handler,
+		},
 		{
 			MethodName: "SendEvent",
 			Handler:    _V2_SendEvent_Handler,
@@ -1980,6 +2998,14 @@ var V2_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvokeFunction",
 			Handler:    _V2_InvokeFunction_Handler,
 		},
+		{
+			MethodName: "ListScheduledInvocations",
+			Handler:    _V2_ListScheduledInvocations_Handler,
+		},
+		{
+			MethodName: "CancelScheduledInvocation",
+			Handler:    _V2_CancelScheduledInvocation_Handler,
+		},
 		{
 			MethodName: "ListInsightsTables",
 			Handler:    _V2_ListInsightsTables_Handler,
@@ -41,6 +41,12 @@ const (
 	DebugGetPartitionStatusProcedure = "/debug.v1.Debug/GetPartitionStatus"
 	// DebugGetQueueItemProcedure is the fully-qualified name of the Debug's GetQueueItem RPC.
 	DebugGetQueueItemProcedure = "/debug.v1.Debug/GetQueueItem"
+	// DebugListQueueItemsProcedure is the fully-qualified name of the Debug's ListQueueItems RPC.
+	DebugListQueueItemsProcedure = "/debug.v1.Debug/ListQueueItems"
+	// DebugRequeueQueueItemsProcedure is the fully-qualified name of the Debug's RequeueQueueItems RPC.
+	DebugRequeueQueueItemsProcedure = "/debug.v1.Debug/RequeueQueueItems"
+	// DebugPurgeQueueItemsProcedure is the fully-qualified name of the Debug's PurgeQueueItems RPC.
+	DebugPurgeQueueItemsProcedure = "/debug.v1.Debug/PurgeQueueItems"
 	// DebugGetPauseProcedure is the fully-qualified name of the Debug's GetPause RPC.
 	DebugGetPauseProcedure = "/debug.v1.Debug/GetPause"
 	// DebugGetIndexProcedure is the fully-qualified name of the Debug's GetIndex RPC.
@@ -94,6 +100,20 @@ const (
 	DebugGetBacklogsProcedure = "/debug.v1.Debug/GetBacklogs"
 	// DebugGetBacklogSizeProcedure is the fully-qualified name of the Debug's GetBacklogSize RPC.
 	DebugGetBacklogSizeProcedure = "/debug.v1.Debug/GetBacklogSize"
+	// DebugStartShardMigrationProcedure is the fully-qualified name of the Debug's StartShardMigration
+	// RPC.
+	DebugStartShardMigrationProcedure = "/debug.v1.Debug/StartShardMigration"
+	// DebugGetShardMigrationProcedure is the fully-qualified name of the Debug's GetShardMigration RPC.
+	DebugGetShardMigrationProcedure = "/debug.v1.Debug/GetShardMigration"
+	// DebugListShardMigrationsProcedure is the fully-qualified name of the Debug's ListShardMigrations
+	// RPC.
+	DebugListShardMigrationsProcedure = "/debug.v1.Debug/ListShardMigrations"
+	// DebugCancelShardMigrationProcedure is the fully-qualified name of the Debug's
+	// CancelShardMigration RPC.
+	DebugCancelShardMigrationProcedure = "/debug.v1.Debug/CancelShardMigration"
+	// DebugRollbackShardMigrationProcedure is the fully-qualified name of the Debug's
+	// RollbackShardMigration RPC.
+	DebugRollbackShardMigrationProcedure = "/debug.v1.Debug/RollbackShardMigration"
 )
 
 // DebugClient is a client for the debug.v1.Debug service.
@@ -105,6 +125,12 @@ type DebugClient interface {
 	GetPartitionStatus(context.Context, *connect.Request[v1.PartitionRequest]) (*connect.Response[v1.PartitionStatusResponse], error)
 	// GetQueueItem retrieves the queue item object from the queue
 	GetQueueItem(context.Context, *connect.Request[v1.QueueItemRequest]) (*connect.Response[v1.QueueItemResponse], error)
+	// ListQueueItems pages through the items of a partition or backlog
+	ListQueueItems(context.Context, *connect.Request[v1.QueueItemsRequest]) (*connect.Response[v1.QueueItemsResponse], error)
+	// RequeueQueueItems reschedules unleased queue items to run now or after a delay
+	RequeueQueueItems(context.Context, *connect.Request[v1.RequeueQueueItemsRequest]) (*connect.Response[v1.RequeueQueueItemsResponse], error)
+	// PurgeQueueItems removes unleased items matching a filter from a partition or backlog
+	PurgeQueueItems(context.Context, *connect.Request[v1.PurgeQueueItemsRequest]) (*connect.Response[v1.PurgeQueueItemsResponse], error)
 	// GetPause retrieves a single pause item.
 	GetPause(context.Context, *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error)
 	// GetIndex retrieves block information for a pause index.
@@ -151,6 +177,16 @@ type DebugClient interface {
 	GetBacklogs(context.Context, *connect.Request[v1.BacklogsRequest]) (*connect.Response[v1.BacklogsResponse], error)
 	// GetBacklogSize retrieves the number of items in a specific backlog
 	GetBacklogSize(context.Context, *connect.Request[v1.BacklogSizeRequest]) (*connect.Response[v1.BacklogSizeResponse], error)
+	// StartShardMigration starts moving an account or function to another queue shard.
+	StartShardMigration(context.Context, *connect.Request[v1.StartShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
+	// GetShardMigration retrieves the progress of a shard migration.
+	GetShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
+	// ListShardMigrations lists the shard migrations started by this server.
+	ListShardMigrations(context.Context, *connect.Request[v1.ShardMigrationsRequest]) (*connect.Response[v1.ShardMigrationsResponse], error)
+	// CancelShardMigration stops a running shard migration, leaving moved items on the target shard.
+	CancelShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
+	// RollbackShardMigration moves an account or function back to the source shard of a migration.
+	RollbackShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
 }
 
 // NewDebugClient constructs a client for the debug.v1.Debug service. By default, it uses the
@@ -182,6 +218,24 @@ func NewDebugClient(httpClient connect.HTTPClient, baseURL string, opts ...conne
 			connect.WithSchema(debugMethods.ByName("GetQueueItem")),
 			connect.WithClientOptions(opts...),
 		),
+		listQueueItems: connect.NewClient[v1.QueueItemsRequest, v1.QueueItemsResponse](
+			httpClient,
+			baseURL+DebugListQueueItemsProcedure,
+			connect.WithSchema(debugMethods.ByName("ListQueueItems")),
+			connect.WithClientOptions(opts...),
+		),
+		requeueQueueItems: connect.NewClient[v1.RequeueQueueItemsRequest, v1.RequeueQueueItemsResponse](
+			httpClient,
+			baseURL+DebugRequeueQueueItemsProcedure,
+			connect.WithSchema(debugMethods.ByName("RequeueQueueItems")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeQueueItems: connect.NewClient[v1.PurgeQueueItemsRequest, v1.PurgeQueueItemsResponse](
+			httpClient,
+			baseURL+DebugPurgeQueueItemsProcedure,
+			connect.WithSchema(debugMethods.ByName("PurgeQueueItems")),
+			connect.WithClientOptions(opts...),
+		),
 		getPause: connect.NewClient[v1.PauseRequest, v1.PauseResponse](
 			httpClient,
 			baseURL+DebugGetPauseProcedure,
@@ -320,6 +374,36 @@ func NewDebugClient(httpClient connect.HTTPClient, baseURL string, opts ...conne
 			connect.WithSchema(debugMethods.ByName("GetBacklogSize")),
 			connect.WithClientOptions(opts...),
 		),
+		startShardMigration: connect.NewClient[v1.StartShardMigrationRequest, v1.ShardMigration](
+			httpClient,
+			baseURL+DebugStartShardMigrationProcedure,
+			connect.WithSchema(debugMethods.ByName("StartShardMigration")),
+			connect.WithClientOptions(opts...),
+		),
+		getShardMigration: connect.NewClient[v1.ShardMigrationRequest, v1.ShardMigration](
+			httpClient,
+			baseURL+DebugGetShardMigrationProcedure,
+			connect.WithSchema(debugMethods.ByName("GetShardMigration")),
+			connect.WithClientOptions(opts...),
+		),
+		listShardMigrations: connect.NewClient[v1.ShardMigrationsRequest, v1.ShardMigrationsResponse](
+			httpClient,
+			baseURL+DebugListShardMigrationsProcedure,
+			connect.WithSchema(debugMethods.ByName("ListShardMigrations")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelShardMigration: connect.NewClient[v1.ShardMigrationRequest, v1.ShardMigration](
+			httpClient,
+			baseURL+DebugCancelShardMigrationProcedure,
+			connect.WithSchema(debugMethods.ByName("CancelShardMigration")),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackShardMigration: connect.NewClient[v1.ShardMigrationRequest, v1.ShardMigration](
+			httpClient,
+			baseURL+DebugRollbackShardMigrationProcedure,
+			connect.WithSchema(debugMethods.ByName("RollbackShardMigration")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -328,6 +412,9 @@ type debugClient struct {
 	getPartition              *connect.Client[v1.PartitionRequest, v1.PartitionResponse]
 	getPartitionStatus        *connect.Client[v1.PartitionRequest, v1.PartitionStatusResponse]
 	getQueueItem              *connect.Client[v1.QueueItemRequest, v1.QueueItemResponse]
+	listQueueItems            *connect.Client[v1.QueueItemsRequest, v1.QueueItemsResponse]
+	requeueQueueItems         *connect.Client[v1.RequeueQueueItemsRequest, v1.RequeueQueueItemsResponse]
+	purgeQueueItems           *connect.Client[v1.PurgeQueueItemsRequest, v1.PurgeQueueItemsResponse]
 	getPause                  *connect.Client[v1.PauseRequest, v1.PauseResponse]
 	getIndex                  *connect.Client[v1.IndexRequest, v1.IndexResponse]
 	blockPeek                 *connect.Client[v1.BlockPeekRequest, v1.BlockPeekResponse]
@@ -351,6 +438,11 @@ type debugClient struct {
 	getShadowPartition        *connect.Client[v1.ShadowPartitionRequest, v1.ShadowPartitionResponse]
 	getBacklogs               *connect.Client[v1.BacklogsRequest, v1.BacklogsResponse]
 	getBacklogSize            *connect.Client[v1.BacklogSizeRequest, v1.BacklogSizeResponse]
+	startShardMigration       *connect.Client[v1.StartShardMigrationRequest, v1.ShardMigration]
+	getShardMigration         *connect.Client[v1.ShardMigrationRequest, v1.ShardMigration]
+	listShardMigrations       *connect.Client[v1.ShardMigrationsRequest, v1.ShardMigrationsResponse]
+	cancelShardMigration      *connect.Client[v1.ShardMigrationRequest, v1.ShardMigration]
+	rollbackShardMigration    *connect.Client[v1.ShardMigrationRequest, v1.ShardMigration]
 }
 
 // GetPartition calls debug.v1.Debug.GetPartition.
@@ -368,6 +460,21 @@ func (c *debugClient) GetQueueItem(ctx context.Context, req *connect.Request[v1.
 	return c.getQueueItem.CallUnary(ctx, req)
 }
 
+// ListQueueItems calls debug.v1.Debug.ListQueueItems.
+func (c *debugClient) ListQueueItems(ctx context.Context, req *connect.Request[v1.QueueItemsRequest]) (*connect.Response[v1.QueueItemsResponse], error) {
+	return c.listQueueItems.CallUnary(ctx, req)
+}
+
+// RequeueQueueItems calls debug.v1.Debug.RequeueQueueItems.
+func (c *debugClient) RequeueQueueItems(ctx context.Context, req *connect.Request[v1.RequeueQueueItemsRequest]) (*connect.Response[v1.RequeueQueueItemsResponse], error) {
+	return c.requeueQueueItems.CallUnary(ctx, req)
+}
+
+// PurgeQueueItems calls debug.v1.Debug.PurgeQueueItems.
+func (c *debugClient) PurgeQueueItems(ctx context.Context, req *connect.Request[v1.PurgeQueueItemsRequest]) (*connect.Response[v1.PurgeQueueItemsResponse], error) {
+	return c.purgeQueueItems.CallUnary(ctx, req)
+}
+
 // GetPause calls debug.v1.Debug.GetPause.
 func (c *debugClient) GetPause(ctx context.Context, req *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error) {
 	return c.getPause.CallUnary(ctx, req)
@@ -483,6 +590,31 @@ func (c *debugClient) GetBacklogSize(ctx context.Context, req *connect.Request[v
 	return c.getBacklogSize.CallUnary(ctx, req)
 }
 
+// StartShardMigration calls debug.v1.Debug.StartShardMigration.
+func (c *debugClient) StartShardMigration(ctx context.Context, req *connect.Request[v1.StartShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return c.startShardMigration.CallUnary(ctx, req)
+}
+
+// GetShardMigration calls debug.v1.Debug.GetShardMigration.
+func (c *debugClient) GetShardMigration(ctx context.Context, req *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return c.getShardMigration.CallUnary(ctx, req)
+}
+
+// ListShardMigrations calls debug.v1.Debug.ListShardMigrations.
+func (c *debugClient) ListShardMigrations(ctx context.Context, req *connect.Request[v1.ShardMigrationsRequest]) (*connect.Response[v1.ShardMigrationsResponse], error) {
+	return c.listShardMigrations.CallUnary(ctx, req)
+}
+
+// CancelShardMigration calls debug.v1.Debug.CancelShardMigration.
+func (c *debugClient) CancelShardMigration(ctx context.Context, req *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return c.cancelShardMigration.CallUnary(ctx, req)
+}
+
+// RollbackShardMigration calls debug.v1.Debug.RollbackShardMigration.
+func (c *debugClient) RollbackShardMigration(ctx context.Context, req *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return c.rollbackShardMigration.CallUnary(ctx, req)
+}
+
 // DebugHandler is an implementation of the debug.v1.Debug service.
 type DebugHandler interface {
 	// GetPartition retrieves the partition data from the database
@@ -492,6 +624,12 @@ type DebugHandler interface {
 	GetPartitionStatus(context.Context, *connect.Request[v1.PartitionRequest]) (*connect.Response[v1.PartitionStatusResponse], error)
 	// GetQueueItem retrieves the queue item object from the queue
 	GetQueueItem(context.Context, *connect.Request[v1.QueueItemRequest]) (*connect.Response[v1.QueueItemResponse], error)
+	// ListQueueItems pages through the items of a partition or backlog
+	ListQueueItems(context.Context, *connect.Request[v1.QueueItemsRequest]) (*connect.Response[v1.QueueItemsResponse], error)
+	// RequeueQueueItems reschedules unleased queue items to run now or after a delay
+	RequeueQueueItems(context.Context, *connect.Request[v1.RequeueQueueItemsRequest]) (*connect.Response[v1.RequeueQueueItemsResponse], error)
+	// PurgeQueueItems removes unleased items matching a filter from a partition or backlog
+	PurgeQueueItems(context.Context, *connect.Request[v1.PurgeQueueItemsRequest]) (*connect.Response[v1.PurgeQueueItemsResponse], error)
 	// GetPause retrieves a single pause item.
 	GetPause(context.Context, *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error)
 	// GetIndex retrieves block information for a pause index.
@@ -538,6 +676,16 @@ type DebugHandler interface {
 	GetBacklogs(context.Context, *connect.Request[v1.BacklogsRequest]) (*connect.Response[v1.BacklogsResponse], error)
 	// GetBacklogSize retrieves the number of items in a specific backlog
 	GetBacklogSize(context.Context, *connect.Request[v1.BacklogSizeRequest]) (*connect.Response[v1.BacklogSizeResponse], error)
+	// StartShardMigration starts moving an account or function to another queue shard.
+	StartShardMigration(context.Context, *connect.Request[v1.StartShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
+	// GetShardMigration retrieves the progress of a shard migration.
+	GetShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
+	// ListShardMigrations lists the shard migrations started by this server.
+	ListShardMigrations(context.Context, *connect.Request[v1.ShardMigrationsRequest]) (*connect.Response[v1.ShardMigrationsResponse], error)
+	// CancelShardMigration stops a running shard migration, leaving moved items on the target shard.
+	CancelShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
+	// RollbackShardMigration moves an account or function back to the source shard of a migration.
+	RollbackShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error)
 }
 
 // NewDebugHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -565,6 +713,24 @@ func NewDebugHandler(svc DebugHandler, opts ...connect.HandlerOption) (string, h
 		connect.WithSchema(debugMethods.ByName("GetQueueItem")),
 		connect.WithHandlerOptions(opts...),
 	)
+	debugListQueueItemsHandler := connect.NewUnaryHandler(
+		DebugListQueueItemsProcedure,
+		svc.ListQueueItems,
+		connect.WithSchema(debugMethods.ByName("ListQueueItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	debugRequeueQueueItemsHandler := connect.NewUnaryHandler(
+		DebugRequeueQueueItemsProcedure,
+		svc.RequeueQueueItems,
+		connect.WithSchema(debugMethods.ByName("RequeueQueueItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	debugPurgeQueueItemsHandler := connect.NewUnaryHandler(
+		DebugPurgeQueueItemsProcedure,
+		svc.PurgeQueueItems,
+		connect.WithSchema(debugMethods.ByName("PurgeQueueItems")),
+		connect.WithHandlerOptions(opts...),
+	)
 	debugGetPauseHandler := connect.NewUnaryHandler(
 		DebugGetPauseProcedure,
 		svc.GetPause,
@@ -703,6 +869,36 @@ func NewDebugHandler(svc DebugHandler, opts ...connect.HandlerOption) (string, h
 		connect.WithSchema(debugMethods.ByName("GetBacklogSize")),
 		connect.WithHandlerOptions(opts...),
 	)
+	debugStartShardMigrationHandler := connect.NewUnaryHandler(
+		DebugStartShardMigrationProcedure,
+		svc.StartShardMigration,
+		connect.WithSchema(debugMethods.ByName("StartShardMigration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	debugGetShardMigrationHandler := connect.NewUnaryHandler(
+		DebugGetShardMigrationProcedure,
+		svc.GetShardMigration,
+		connect.WithSchema(debugMethods.ByName("GetShardMigration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	debugListShardMigrationsHandler := connect.NewUnaryHandler(
+		DebugListShardMigrationsProcedure,
+		svc.ListShardMigrations,
+		connect.WithSchema(debugMethods.ByName("ListShardMigrations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	debugCancelShardMigrationHandler := connect.NewUnaryHandler(
+		DebugCancelShardMigrationProcedure,
+		svc.CancelShardMigration,
+		connect.WithSchema(debugMethods.ByName("CancelShardMigration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	debugRollbackShardMigrationHandler := connect.NewUnaryHandler(
+		DebugRollbackShardMigrationProcedure,
+		svc.RollbackShardMigration,
+		connect.WithSchema(debugMethods.ByName("RollbackShardMigration")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/debug.v1.Debug/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DebugGetPartitionProcedure:
@@ -711,6 +907,12 @@ func NewDebugHandler(svc DebugHandler, opts ...connect.HandlerOption) (string, h
 			debugGetPartitionStatusHandler.ServeHTTP(w, r)
 		case DebugGetQueueItemProcedure:
 			debugGetQueueItemHandler.ServeHTTP(w, r)
+		case DebugListQueueItemsProcedure:
+			debugListQueueItemsHandler.ServeHTTP(w, r)
+		case DebugRequeueQueueItemsProcedure:
+			debugRequeueQueueItemsHandler.ServeHTTP(w, r)
+		case DebugPurgeQueueItemsProcedure:
+			debugPurgeQueueItemsHandler.ServeHTTP(w, r)
 		case DebugGetPauseProcedure:
 			debugGetPauseHandler.ServeHTTP(w, r)
 		case DebugGetIndexProcedure:
@@ -757,6 +959,16 @@ func NewDebugHandler(svc DebugHandler, opts ...connect.HandlerOption) (string, h
 			debugGetBacklogsHandler.ServeHTTP(w, r)
 		case DebugGetBacklogSizeProcedure:
 			debugGetBacklogSizeHandler.ServeHTTP(w, r)
+		case DebugStartShardMigrationProcedure:
+			debugStartShardMigrationHandler.ServeHTTP(w, r)
+		case DebugGetShardMigrationProcedure:
+			debugGetShardMigrationHandler.ServeHTTP(w, r)
+		case DebugListShardMigrationsProcedure:
+			debugListShardMigrationsHandler.ServeHTTP(w, r)
+		case DebugCancelShardMigrationProcedure:
+			debugCancelShardMigrationHandler.ServeHTTP(w, r)
+		case DebugRollbackShardMigrationProcedure:
+			debugRollbackShardMigrationHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -778,6 +990,18 @@ func (UnimplementedDebugHandler) GetQueueItem(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.GetQueueItem is not implemented"))
 }
 
+func (UnimplementedDebugHandler) ListQueueItems(context.Context, *connect.Request[v1.QueueItemsRequest]) (*connect.Response[v1.QueueItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.ListQueueItems is not implemented"))
+}
+
+func (UnimplementedDebugHandler) RequeueQueueItems(context.Context, *connect.Request[v1.RequeueQueueItemsRequest]) (*connect.Response[v1.RequeueQueueItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.RequeueQueueItems is not implemented"))
+}
+
+func (UnimplementedDebugHandler) PurgeQueueItems(context.Context, *connect.Request[v1.PurgeQueueItemsRequest]) (*connect.Response[v1.PurgeQueueItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.PurgeQueueItems is not implemented"))
+}
+
 func (UnimplementedDebugHandler) GetPause(context.Context, *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.GetPause is not implemented"))
 }
@@ -869,3 +1093,23 @@ func (UnimplementedDebugHandler) GetBacklogs(context.Context, *connect.Request[v
 func (UnimplementedDebugHandler) GetBacklogSize(context.Context, *connect.Request[v1.BacklogSizeRequest]) (*connect.Response[v1.BacklogSizeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.GetBacklogSize is not implemented"))
 }
+
+func (UnimplementedDebugHandler) StartShardMigration(context.Context, *connect.Request[v1.StartShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.StartShardMigration is not implemented"))
+}
+
+func (UnimplementedDebugHandler) GetShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.GetShardMigration is not implemented"))
+}
+
+func (UnimplementedDebugHandler) ListShardMigrations(context.Context, *connect.Request[v1.ShardMigrationsRequest]) (*connect.Response[v1.ShardMigrationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.ListShardMigrations is not implemented"))
+}
+
+func (UnimplementedDebugHandler) CancelShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.CancelShardMigration is not implemented"))
+}
+
+func (UnimplementedDebugHandler) RollbackShardMigration(context.Context, *connect.Request[v1.ShardMigrationRequest]) (*connect.Response[v1.ShardMigration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("debug.v1.Debug.RollbackShardMigration is not implemented"))
+}
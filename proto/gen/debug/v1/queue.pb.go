@@ -22,6 +22,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QueueItemResultStatus int32
+
+const (
+	QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_UNSPECIFIED QueueItemResultStatus = 0
+	QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_OK          QueueItemResultStatus = 1
+	// The item is currently leased by a worker and was left untouched.
+	QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_LEASED    QueueItemResultStatus = 2
+	QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_NOT_FOUND QueueItemResultStatus = 3
+)
+
+// Enum value maps for QueueItemResultStatus.
+var (
+	QueueItemResultStatus_name = map[int32]string{
+		0: "QUEUE_ITEM_RESULT_STATUS_UNSPECIFIED",
+		1: "QUEUE_ITEM_RESULT_STATUS_OK",
+		2: "QUEUE_ITEM_RESULT_STATUS_LEASED",
+		3: "QUEUE_ITEM_RESULT_STATUS_NOT_FOUND",
+	}
+	QueueItemResultStatus_value = map[string]int32{
+		"QUEUE_ITEM_RESULT_STATUS_UNSPECIFIED": 0,
+		"QUEUE_ITEM_RESULT_STATUS_OK":          1,
+		"QUEUE_ITEM_RESULT_STATUS_LEASED":      2,
+		"QUEUE_ITEM_RESULT_STATUS_NOT_FOUND":   3,
+	}
+)
+
+func (x QueueItemResultStatus) Enum() *QueueItemResultStatus {
+	p := new(QueueItemResultStatus)
+	*p = x
+	return p
+}
+
+func (x QueueItemResultStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueItemResultStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_debug_v1_queue_proto_enumTypes[0].Descriptor()
+}
+
+func (QueueItemResultStatus) Type() protoreflect.EnumType {
+	return &file_debug_v1_queue_proto_enumTypes[0]
+}
+
+func (x QueueItemResultStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueItemResultStatus.Descriptor instead.
+func (QueueItemResultStatus) EnumDescriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{0}
+}
+
 // Partition
 type PartitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -946,8 +999,14 @@ type BacklogInfo struct {
 	ConcurrencyKeys         []*BacklogConcurrencyKeyInfo `protobuf:"bytes,5,rep,name=concurrency_keys,json=concurrencyKeys,proto3" json:"concurrency_keys,omitempty"`
 	Throttle                *BacklogThrottleInfo         `protobuf:"bytes,6,opt,name=throttle,proto3" json:"throttle,omitempty"`
 	ItemCount               int64                        `protobuf:"varint,7,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// fairness_weight is the weight of the backlog when refilling fairly across
+	// concurrency keys.
+	FairnessWeight int32 `protobuf:"varint,8,opt,name=fairness_weight,json=fairnessWeight,proto3" json:"fairness_weight,omitempty"`
+	// fairness_share is the backlog's share of refills among the listed backlogs
+	// with concurrency keys, between 0 and 1.
+	FairnessShare float64 `protobuf:"fixed64,9,opt,name=fairness_share,json=fairnessShare,proto3" json:"fairness_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BacklogInfo) Reset() {
@@ -1029,6 +1088,20 @@ func (x *BacklogInfo) GetItemCount() int64 {
 	return 0
 }
 
+func (x *BacklogInfo) GetFairnessWeight() int32 {
+	if x != nil {
+		return x.FairnessWeight
+	}
+	return 0
+}
+
+func (x *BacklogInfo) GetFairnessShare() float64 {
+	if x != nil {
+		return x.FairnessShare
+	}
+	return 0
+}
+
 type BacklogConcurrencyKeyInfo struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	CanonicalKeyId      string                 `protobuf:"bytes,1,opt,name=canonical_key_id,json=canonicalKeyId,proto3" json:"canonical_key_id,omitempty"`
@@ -1038,8 +1111,10 @@ type BacklogConcurrencyKeyInfo struct {
 	HashedValue         string                 `protobuf:"bytes,5,opt,name=hashed_value,json=hashedValue,proto3" json:"hashed_value,omitempty"`
 	UnhashedValue       string                 `protobuf:"bytes,6,opt,name=unhashed_value,json=unhashedValue,proto3" json:"unhashed_value,omitempty"`
 	ConcurrencyMode     string                 `protobuf:"bytes,7,opt,name=concurrency_mode,json=concurrencyMode,proto3" json:"concurrency_mode,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// weight is the fairness weight evaluated for the key, or 0 if unset.
+	Weight        int32 `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BacklogConcurrencyKeyInfo) Reset() {
@@ -1121,6 +1196,13 @@ func (x *BacklogConcurrencyKeyInfo) GetConcurrencyMode() string {
 	return ""
 }
 
+func (x *BacklogConcurrencyKeyInfo) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 type BacklogThrottleInfo struct {
 	state                     protoimpl.MessageState `protogen:"open.v1"`
 	ThrottleKey               string                 `protobuf:"bytes,1,opt,name=throttle_key,json=throttleKey,proto3" json:"throttle_key,omitempty"`
@@ -1181,6 +1263,653 @@ func (x *BacklogThrottleInfo) GetThrottleKeyExpressionHash() string {
 	return ""
 }
 
+// QueueItemsFilter selects queue items within a partition or backlog.
+type QueueItemsFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// partition_id is the function ID or system queue name to select items from.
+	PartitionId string `protobuf:"bytes,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	// backlog_id selects items from a key queue backlog instead of a partition.
+	BacklogId  string `protobuf:"bytes,2,opt,name=backlog_id,json=backlogId,proto3" json:"backlog_id,omitempty"`
+	AccountId  string `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	EnvId      string `protobuf:"bytes,4,opt,name=env_id,json=envId,proto3" json:"env_id,omitempty"`
+	QueueShard string `protobuf:"bytes,5,opt,name=queue_shard,json=queueShard,proto3" json:"queue_shard,omitempty"`
+	// kinds only matches items of the given kinds, eg. "start" or "edge".
+	Kinds []string `protobuf:"bytes,6,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// run_id only matches items belonging to the given run.
+	RunId string `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// min_age_ms only matches items enqueued at least this many milliseconds ago.
+	MinAgeMs      int64 `protobuf:"varint,8,opt,name=min_age_ms,json=minAgeMs,proto3" json:"min_age_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueItemsFilter) Reset() {
+	*x = QueueItemsFilter{}
+	mi := &file_debug_v1_queue_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueItemsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueItemsFilter) ProtoMessage() {}
+
+func (x *QueueItemsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueItemsFilter.ProtoReflect.Descriptor instead.
+func (*QueueItemsFilter) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueueItemsFilter) GetPartitionId() string {
+	if x != nil {
+		return x.PartitionId
+	}
+	return ""
+}
+
+func (x *QueueItemsFilter) GetBacklogId() string {
+	if x != nil {
+		return x.BacklogId
+	}
+	return ""
+}
+
+func (x *QueueItemsFilter) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *QueueItemsFilter) GetEnvId() string {
+	if x != nil {
+		return x.EnvId
+	}
+	return ""
+}
+
+func (x *QueueItemsFilter) GetQueueShard() string {
+	if x != nil {
+		return x.QueueShard
+	}
+	return ""
+}
+
+func (x *QueueItemsFilter) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *QueueItemsFilter) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *QueueItemsFilter) GetMinAgeMs() int64 {
+	if x != nil {
+		return x.MinAgeMs
+	}
+	return 0
+}
+
+type QueueItemsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *QueueItemsFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit  int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the next_cursor returned by a previous request.  Items are
+	// ordered by the time they're scheduled at and their ID, and the cursor is
+	// the position of the last item returned.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueItemsRequest) Reset() {
+	*x = QueueItemsRequest{}
+	mi := &file_debug_v1_queue_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueItemsRequest) ProtoMessage() {}
+
+func (x *QueueItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueItemsRequest.ProtoReflect.Descriptor instead.
+func (*QueueItemsRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueueItemsRequest) GetFilter() *QueueItemsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueueItemsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueueItemsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type QueueItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*QueueItemSummary    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// next_cursor is empty once all matching items have been returned.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	QueueShard    string `protobuf:"bytes,3,opt,name=queue_shard,json=queueShard,proto3" json:"queue_shard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueItemsResponse) Reset() {
+	*x = QueueItemsResponse{}
+	mi := &file_debug_v1_queue_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueItemsResponse) ProtoMessage() {}
+
+func (x *QueueItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueItemsResponse.ProtoReflect.Descriptor instead.
+func (*QueueItemsResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueueItemsResponse) GetItems() []*QueueItemSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *QueueItemsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *QueueItemsResponse) GetQueueShard() string {
+	if x != nil {
+		return x.QueueShard
+	}
+	return ""
+}
+
+type QueueItemSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	FunctionId    string                 `protobuf:"bytes,4,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	Attempt       int32                  `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=at,proto3" json:"at,omitempty"`
+	EnqueuedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=enqueued_at,json=enqueuedAt,proto3" json:"enqueued_at,omitempty"`
+	Leased        bool                   `protobuf:"varint,8,opt,name=leased,proto3" json:"leased,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueItemSummary) Reset() {
+	*x = QueueItemSummary{}
+	mi := &file_debug_v1_queue_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueItemSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueItemSummary) ProtoMessage() {}
+
+func (x *QueueItemSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueItemSummary.ProtoReflect.Descriptor instead.
+func (*QueueItemSummary) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueueItemSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueueItemSummary) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QueueItemSummary) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *QueueItemSummary) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *QueueItemSummary) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *QueueItemSummary) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *QueueItemSummary) GetEnqueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnqueuedAt
+	}
+	return nil
+}
+
+func (x *QueueItemSummary) GetLeased() bool {
+	if x != nil {
+		return x.Leased
+	}
+	return false
+}
+
+type RequeueQueueItemsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	QueueShard string                 `protobuf:"bytes,1,opt,name=queue_shard,json=queueShard,proto3" json:"queue_shard,omitempty"`
+	ItemIds    []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	// delay_ms schedules the items this many milliseconds from now.  Zero
+	// requeues the items to run immediately.
+	DelayMs       int64 `protobuf:"varint,3,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueQueueItemsRequest) Reset() {
+	*x = RequeueQueueItemsRequest{}
+	mi := &file_debug_v1_queue_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueQueueItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueQueueItemsRequest) ProtoMessage() {}
+
+func (x *RequeueQueueItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueQueueItemsRequest.ProtoReflect.Descriptor instead.
+func (*RequeueQueueItemsRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RequeueQueueItemsRequest) GetQueueShard() string {
+	if x != nil {
+		return x.QueueShard
+	}
+	return ""
+}
+
+func (x *RequeueQueueItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *RequeueQueueItemsRequest) GetDelayMs() int64 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+type RequeueQueueItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*QueueItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	QueueShard    string                 `protobuf:"bytes,2,opt,name=queue_shard,json=queueShard,proto3" json:"queue_shard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueQueueItemsResponse) Reset() {
+	*x = RequeueQueueItemsResponse{}
+	mi := &file_debug_v1_queue_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueQueueItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueQueueItemsResponse) ProtoMessage() {}
+
+func (x *RequeueQueueItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueQueueItemsResponse.ProtoReflect.Descriptor instead.
+func (*RequeueQueueItemsResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RequeueQueueItemsResponse) GetResults() []*QueueItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *RequeueQueueItemsResponse) GetQueueShard() string {
+	if x != nil {
+		return x.QueueShard
+	}
+	return ""
+}
+
+type QueueItemResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ItemId string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Status QueueItemResultStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=debug.v1.QueueItemResultStatus" json:"status,omitempty"`
+	// at is the new scheduled time of a requeued item.
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueItemResult) Reset() {
+	*x = QueueItemResult{}
+	mi := &file_debug_v1_queue_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueItemResult) ProtoMessage() {}
+
+func (x *QueueItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueItemResult.ProtoReflect.Descriptor instead.
+func (*QueueItemResult) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueueItemResult) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *QueueItemResult) GetStatus() QueueItemResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QueueItemResultStatus_QUEUE_ITEM_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *QueueItemResult) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type PurgeQueueItemsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *QueueItemsFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// dry_run returns the matching items without removing them.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// limit caps the number of items removed by a single request.
+	Limit         int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeQueueItemsRequest) Reset() {
+	*x = PurgeQueueItemsRequest{}
+	mi := &file_debug_v1_queue_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeQueueItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeQueueItemsRequest) ProtoMessage() {}
+
+func (x *PurgeQueueItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeQueueItemsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeQueueItemsRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PurgeQueueItemsRequest) GetFilter() *QueueItemsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *PurgeQueueItemsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeQueueItemsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PurgeQueueItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// purged is the number of items removed, or that would be removed for a dry run.
+	Purged int64 `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	// skipped_leased is the number of matching items left in place because a
+	// worker leased them.
+	SkippedLeased int64 `protobuf:"varint,2,opt,name=skipped_leased,json=skippedLeased,proto3" json:"skipped_leased,omitempty"`
+	// has_more is set when limit was reached before all matching items were seen.
+	HasMore       bool     `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	ItemIds       []string `protobuf:"bytes,4,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	QueueShard    string   `protobuf:"bytes,5,opt,name=queue_shard,json=queueShard,proto3" json:"queue_shard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeQueueItemsResponse) Reset() {
+	*x = PurgeQueueItemsResponse{}
+	mi := &file_debug_v1_queue_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeQueueItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeQueueItemsResponse) ProtoMessage() {}
+
+func (x *PurgeQueueItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_queue_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeQueueItemsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeQueueItemsResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_queue_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PurgeQueueItemsResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+func (x *PurgeQueueItemsResponse) GetSkippedLeased() int64 {
+	if x != nil {
+		return x.SkippedLeased
+	}
+	return 0
+}
+
+func (x *PurgeQueueItemsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *PurgeQueueItemsResponse) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *PurgeQueueItemsResponse) GetQueueShard() string {
+	if x != nil {
+		return x.QueueShard
+	}
+	return ""
+}
+
 var File_debug_v1_queue_proto protoreflect.FileDescriptor
 
 const file_debug_v1_queue_proto_rawDesc = "" +
@@ -1265,7 +1994,7 @@ const file_debug_v1_queue_proto_rawDesc = "" +
 	"backlog_id\x18\x01 \x01(\tR\tbacklogId\x12\x1d\n" +
 	"\n" +
 	"item_count\x18\x02 \x01(\x03R\titemCount\x12/\n" +
-	"\abacklog\x18\x03 \x01(\v2\x15.debug.v1.BacklogInfoR\abacklog\"\xd8\x02\n" +
+	"\abacklog\x18\x03 \x01(\v2\x15.debug.v1.BacklogInfoR\abacklog\"\xa8\x03\n" +
 	"\vBacklogInfo\x12\x1d\n" +
 	"\n" +
 	"backlog_id\x18\x01 \x01(\tR\tbacklogId\x12.\n" +
@@ -1275,7 +2004,9 @@ const file_debug_v1_queue_proto_rawDesc = "" +
 	"\x10concurrency_keys\x18\x05 \x03(\v2#.debug.v1.BacklogConcurrencyKeyInfoR\x0fconcurrencyKeys\x129\n" +
 	"\bthrottle\x18\x06 \x01(\v2\x1d.debug.v1.BacklogThrottleInfoR\bthrottle\x12\x1d\n" +
 	"\n" +
-	"item_count\x18\a \x01(\x03R\titemCount\"\xa1\x02\n" +
+	"item_count\x18\a \x01(\x03R\titemCount\x12'\n" +
+	"\x0ffairness_weight\x18\b \x01(\x05R\x0efairnessWeight\x12%\n" +
+	"\x0efairness_share\x18\t \x01(\x01R\rfairnessShare\"\xb9\x02\n" +
 	"\x19BacklogConcurrencyKeyInfo\x12(\n" +
 	"\x10canonical_key_id\x18\x01 \x01(\tR\x0ecanonicalKeyId\x12\x14\n" +
 	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x1b\n" +
@@ -1283,11 +2014,75 @@ const file_debug_v1_queue_proto_rawDesc = "" +
 	"\x15hashed_key_expression\x18\x04 \x01(\tR\x13hashedKeyExpression\x12!\n" +
 	"\fhashed_value\x18\x05 \x01(\tR\vhashedValue\x12%\n" +
 	"\x0eunhashed_value\x18\x06 \x01(\tR\runhashedValue\x12)\n" +
-	"\x10concurrency_mode\x18\a \x01(\tR\x0fconcurrencyMode\"\xae\x01\n" +
+	"\x10concurrency_mode\x18\a \x01(\tR\x0fconcurrencyMode\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x05R\x06weight\"\xae\x01\n" +
 	"\x13BacklogThrottleInfo\x12!\n" +
 	"\fthrottle_key\x18\x01 \x01(\tR\vthrottleKey\x123\n" +
 	"\x16throttle_key_raw_value\x18\x02 \x01(\tR\x13throttleKeyRawValue\x12?\n" +
-	"\x1cthrottle_key_expression_hash\x18\x03 \x01(\tR\x19throttleKeyExpressionHashB5Z3github.com/inngest/inngest/proto/gen/debug/v1;debugb\x06proto3"
+	"\x1cthrottle_key_expression_hash\x18\x03 \x01(\tR\x19throttleKeyExpressionHash\"\xf6\x01\n" +
+	"\x10QueueItemsFilter\x12!\n" +
+	"\fpartition_id\x18\x01 \x01(\tR\vpartitionId\x12\x1d\n" +
+	"\n" +
+	"backlog_id\x18\x02 \x01(\tR\tbacklogId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x15\n" +
+	"\x06env_id\x18\x04 \x01(\tR\x05envId\x12\x1f\n" +
+	"\vqueue_shard\x18\x05 \x01(\tR\n" +
+	"queueShard\x12\x14\n" +
+	"\x05kinds\x18\x06 \x03(\tR\x05kinds\x12\x15\n" +
+	"\x06run_id\x18\a \x01(\tR\x05runId\x12\x1c\n" +
+	"\n" +
+	"min_age_ms\x18\b \x01(\x03R\bminAgeMs\"u\n" +
+	"\x11QueueItemsRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.debug.v1.QueueItemsFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x88\x01\n" +
+	"\x12QueueItemsResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.debug.v1.QueueItemSummaryR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vqueue_shard\x18\x03 \x01(\tR\n" +
+	"queueShard\"\x89\x02\n" +
+	"\x10QueueItemSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x1f\n" +
+	"\vfunction_id\x18\x04 \x01(\tR\n" +
+	"functionId\x12\x18\n" +
+	"\aattempt\x18\x05 \x01(\x05R\aattempt\x12*\n" +
+	"\x02at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12;\n" +
+	"\venqueued_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"enqueuedAt\x12\x16\n" +
+	"\x06leased\x18\b \x01(\bR\x06leased\"q\n" +
+	"\x18RequeueQueueItemsRequest\x12\x1f\n" +
+	"\vqueue_shard\x18\x01 \x01(\tR\n" +
+	"queueShard\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\x12\x19\n" +
+	"\bdelay_ms\x18\x03 \x01(\x03R\adelayMs\"q\n" +
+	"\x19RequeueQueueItemsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.debug.v1.QueueItemResultR\aresults\x12\x1f\n" +
+	"\vqueue_shard\x18\x02 \x01(\tR\n" +
+	"queueShard\"\x8f\x01\n" +
+	"\x0fQueueItemResult\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.debug.v1.QueueItemResultStatusR\x06status\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"{\n" +
+	"\x16PurgeQueueItemsRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.debug.v1.QueueItemsFilterR\x06filter\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"\xaf\x01\n" +
+	"\x17PurgeQueueItemsResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x03R\x06purged\x12%\n" +
+	"\x0eskipped_leased\x18\x02 \x01(\x03R\rskippedLeased\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x19\n" +
+	"\bitem_ids\x18\x04 \x03(\tR\aitemIds\x12\x1f\n" +
+	"\vqueue_shard\x18\x05 \x01(\tR\n" +
+	"queueShard*\xaf\x01\n" +
+	"\x15QueueItemResultStatus\x12(\n" +
+	"$QUEUE_ITEM_RESULT_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bQUEUE_ITEM_RESULT_STATUS_OK\x10\x01\x12#\n" +
+	"\x1fQUEUE_ITEM_RESULT_STATUS_LEASED\x10\x02\x12&\n" +
+	"\"QUEUE_ITEM_RESULT_STATUS_NOT_FOUND\x10\x03B5Z3github.com/inngest/inngest/proto/gen/debug/v1;debugb\x06proto3"
 
 var (
 	file_debug_v1_queue_proto_rawDescOnce sync.Once
@@ -1301,41 +2096,60 @@ func file_debug_v1_queue_proto_rawDescGZIP() []byte {
 	return file_debug_v1_queue_proto_rawDescData
 }
 
-var file_debug_v1_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_debug_v1_queue_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_debug_v1_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_debug_v1_queue_proto_goTypes = []any{
-	(*PartitionRequest)(nil),          // 0: debug.v1.PartitionRequest
-	(*PartitionResponse)(nil),         // 1: debug.v1.PartitionResponse
-	(*PartitionStatusResponse)(nil),   // 2: debug.v1.PartitionStatusResponse
-	(*PartitionTenant)(nil),           // 3: debug.v1.PartitionTenant
-	(*QueueShard)(nil),                // 4: debug.v1.QueueShard
-	(*CronSchedule)(nil),              // 5: debug.v1.CronSchedule
-	(*QueueItemRequest)(nil),          // 6: debug.v1.QueueItemRequest
-	(*QueueItemResponse)(nil),         // 7: debug.v1.QueueItemResponse
-	(*ShadowPartitionRequest)(nil),    // 8: debug.v1.ShadowPartitionRequest
-	(*ShadowPartitionResponse)(nil),   // 9: debug.v1.ShadowPartitionResponse
-	(*BacklogsRequest)(nil),           // 10: debug.v1.BacklogsRequest
-	(*BacklogsResponse)(nil),          // 11: debug.v1.BacklogsResponse
-	(*BacklogSizeRequest)(nil),        // 12: debug.v1.BacklogSizeRequest
-	(*BacklogSizeResponse)(nil),       // 13: debug.v1.BacklogSizeResponse
-	(*BacklogInfo)(nil),               // 14: debug.v1.BacklogInfo
-	(*BacklogConcurrencyKeyInfo)(nil), // 15: debug.v1.BacklogConcurrencyKeyInfo
-	(*BacklogThrottleInfo)(nil),       // 16: debug.v1.BacklogThrottleInfo
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+	(QueueItemResultStatus)(0),        // 0: debug.v1.QueueItemResultStatus
+	(*PartitionRequest)(nil),          // 1: debug.v1.PartitionRequest
+	(*PartitionResponse)(nil),         // 2: debug.v1.PartitionResponse
+	(*PartitionStatusResponse)(nil),   // 3: debug.v1.PartitionStatusResponse
+	(*PartitionTenant)(nil),           // 4: debug.v1.PartitionTenant
+	(*QueueShard)(nil),                // 5: debug.v1.QueueShard
+	(*CronSchedule)(nil),              // 6: debug.v1.CronSchedule
+	(*QueueItemRequest)(nil),          // 7: debug.v1.QueueItemRequest
+	(*QueueItemResponse)(nil),         // 8: debug.v1.QueueItemResponse
+	(*ShadowPartitionRequest)(nil),    // 9: debug.v1.ShadowPartitionRequest
+	(*ShadowPartitionResponse)(nil),   // 10: debug.v1.ShadowPartitionResponse
+	(*BacklogsRequest)(nil),           // 11: debug.v1.BacklogsRequest
+	(*BacklogsResponse)(nil),          // 12: debug.v1.BacklogsResponse
+	(*BacklogSizeRequest)(nil),        // 13: debug.v1.BacklogSizeRequest
+	(*BacklogSizeResponse)(nil),       // 14: debug.v1.BacklogSizeResponse
+	(*BacklogInfo)(nil),               // 15: debug.v1.BacklogInfo
+	(*BacklogConcurrencyKeyInfo)(nil), // 16: debug.v1.BacklogConcurrencyKeyInfo
+	(*BacklogThrottleInfo)(nil),       // 17: debug.v1.BacklogThrottleInfo
+	(*QueueItemsFilter)(nil),          // 18: debug.v1.QueueItemsFilter
+	(*QueueItemsRequest)(nil),         // 19: debug.v1.QueueItemsRequest
+	(*QueueItemsResponse)(nil),        // 20: debug.v1.QueueItemsResponse
+	(*QueueItemSummary)(nil),          // 21: debug.v1.QueueItemSummary
+	(*RequeueQueueItemsRequest)(nil),  // 22: debug.v1.RequeueQueueItemsRequest
+	(*RequeueQueueItemsResponse)(nil), // 23: debug.v1.RequeueQueueItemsResponse
+	(*QueueItemResult)(nil),           // 24: debug.v1.QueueItemResult
+	(*PurgeQueueItemsRequest)(nil),    // 25: debug.v1.PurgeQueueItemsRequest
+	(*PurgeQueueItemsResponse)(nil),   // 26: debug.v1.PurgeQueueItemsResponse
+	(*timestamppb.Timestamp)(nil),     // 27: google.protobuf.Timestamp
 }
 var file_debug_v1_queue_proto_depIdxs = []int32{
-	3,  // 0: debug.v1.PartitionResponse.tenant:type_name -> debug.v1.PartitionTenant
-	4,  // 1: debug.v1.PartitionResponse.queue_shard:type_name -> debug.v1.QueueShard
-	5,  // 2: debug.v1.PartitionResponse.crons:type_name -> debug.v1.CronSchedule
-	17, // 3: debug.v1.CronSchedule.next:type_name -> google.protobuf.Timestamp
-	14, // 4: debug.v1.BacklogsResponse.backlogs:type_name -> debug.v1.BacklogInfo
-	14, // 5: debug.v1.BacklogSizeResponse.backlog:type_name -> debug.v1.BacklogInfo
-	15, // 6: debug.v1.BacklogInfo.concurrency_keys:type_name -> debug.v1.BacklogConcurrencyKeyInfo
-	16, // 7: debug.v1.BacklogInfo.throttle:type_name -> debug.v1.BacklogThrottleInfo
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 0: debug.v1.PartitionResponse.tenant:type_name -> debug.v1.PartitionTenant
+	5,  // 1: debug.v1.PartitionResponse.queue_shard:type_name -> debug.v1.QueueShard
+	6,  // 2: debug.v1.PartitionResponse.crons:type_name -> debug.v1.CronSchedule
+	27, // 3: debug.v1.CronSchedule.next:type_name -> google.protobuf.Timestamp
+	15, // 4: debug.v1.BacklogsResponse.backlogs:type_name -> debug.v1.BacklogInfo
+	15, // 5: debug.v1.BacklogSizeResponse.backlog:type_name -> debug.v1.BacklogInfo
+	16, // 6: debug.v1.BacklogInfo.concurrency_keys:type_name -> debug.v1.BacklogConcurrencyKeyInfo
+	17, // 7: debug.v1.BacklogInfo.throttle:type_name -> debug.v1.BacklogThrottleInfo
+	18, // 8: debug.v1.QueueItemsRequest.filter:type_name -> debug.v1.QueueItemsFilter
+	21, // 9: debug.v1.QueueItemsResponse.items:type_name -> debug.v1.QueueItemSummary
+	27, // 10: debug.v1.QueueItemSummary.at:type_name -> google.protobuf.Timestamp
+	27, // 11: debug.v1.QueueItemSummary.enqueued_at:type_name -> google.protobuf.Timestamp
+	24, // 12: debug.v1.RequeueQueueItemsResponse.results:type_name -> debug.v1.QueueItemResult
+	0,  // 13: debug.v1.QueueItemResult.status:type_name -> debug.v1.QueueItemResultStatus
+	27, // 14: debug.v1.QueueItemResult.at:type_name -> google.protobuf.Timestamp
+	18, // 15: debug.v1.PurgeQueueItemsRequest.filter:type_name -> debug.v1.QueueItemsFilter
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_debug_v1_queue_proto_init() }
@@ -1348,13 +2162,14 @@ func file_debug_v1_queue_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_debug_v1_queue_proto_rawDesc), len(file_debug_v1_queue_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      1,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_debug_v1_queue_proto_goTypes,
 		DependencyIndexes: file_debug_v1_queue_proto_depIdxs,
+		EnumInfos:         file_debug_v1_queue_proto_enumTypes,
 		MessageInfos:      file_debug_v1_queue_proto_msgTypes,
 	}.Build()
 	File_debug_v1_queue_proto = out.File
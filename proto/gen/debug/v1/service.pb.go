@@ -25,11 +25,14 @@ var File_debug_v1_service_proto protoreflect.FileDescriptor
 
 const file_debug_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x16debug/v1/service.proto\x12\bdebug.v1\x1a\x14debug/v1/queue.proto\x1a\x14debug/v1/pause.proto\x1a\x1cdebug/v1/constraintapi.proto\x1a\x14debug/v1/batch.proto\x1a\x18debug/v1/singleton.proto\x1a\x17debug/v1/debounce.proto\x1a\x1econstraintapi/v1/service.proto2\xb2\x11\n" +
+	"\x16debug/v1/service.proto\x12\bdebug.v1\x1a\x14debug/v1/queue.proto\x1a\x14debug/v1/pause.proto\x1a\x1cdebug/v1/constraintapi.proto\x1a\x14debug/v1/batch.proto\x1a\x18debug/v1/singleton.proto\x1a\x17debug/v1/debounce.proto\x1a\x14debug/v1/shard.proto\x1a\x1econstraintapi/v1/service.proto2\xf0\x16\n" +
 	"\x05Debug\x12I\n" +
 	"\fGetPartition\x12\x1a.debug.v1.PartitionRequest\x1a\x1b.debug.v1.PartitionResponse\"\x00\x12U\n" +
 	"\x12GetPartitionStatus\x12\x1a.debug.v1.PartitionRequest\x1a!.debug.v1.PartitionStatusResponse\"\x00\x12I\n" +
-	"\fGetQueueItem\x12\x1a.debug.v1.QueueItemRequest\x1a\x1b.debug.v1.QueueItemResponse\"\x00\x12=\n" +
+	"\fGetQueueItem\x12\x1a.debug.v1.QueueItemRequest\x1a\x1b.debug.v1.QueueItemResponse\"\x00\x12M\n" +
+	"\x0eListQueueItems\x12\x1b.debug.v1.QueueItemsRequest\x1a\x1c.debug.v1.QueueItemsResponse\"\x00\x12^\n" +
+	"\x11RequeueQueueItems\x12\".debug.v1.RequeueQueueItemsRequest\x1a#.debug.v1.RequeueQueueItemsResponse\"\x00\x12X\n" +
+	"\x0fPurgeQueueItems\x12 .debug.v1.PurgeQueueItemsRequest\x1a!.debug.v1.PurgeQueueItemsResponse\"\x00\x12=\n" +
 	"\bGetPause\x12\x16.debug.v1.PauseRequest\x1a\x17.debug.v1.PauseResponse\"\x00\x12=\n" +
 	"\bGetIndex\x12\x16.debug.v1.IndexRequest\x1a\x17.debug.v1.IndexResponse\"\x00\x12F\n" +
 	"\tBlockPeek\x12\x1a.debug.v1.BlockPeekRequest\x1a\x1b.debug.v1.BlockPeekResponse\"\x00\x12O\n" +
@@ -52,112 +55,144 @@ const file_debug_v1_service_proto_rawDesc = "" +
 	"\x12DeleteDebounceByID\x12#.debug.v1.DeleteDebounceByIDRequest\x1a$.debug.v1.DeleteDebounceByIDResponse\"\x00\x12[\n" +
 	"\x12GetShadowPartition\x12 .debug.v1.ShadowPartitionRequest\x1a!.debug.v1.ShadowPartitionResponse\"\x00\x12F\n" +
 	"\vGetBacklogs\x12\x19.debug.v1.BacklogsRequest\x1a\x1a.debug.v1.BacklogsResponse\"\x00\x12O\n" +
-	"\x0eGetBacklogSize\x12\x1c.debug.v1.BacklogSizeRequest\x1a\x1d.debug.v1.BacklogSizeResponse\"\x00B5Z3github.com/inngest/inngest/proto/gen/debug/v1;debugb\x06proto3"
+	"\x0eGetBacklogSize\x12\x1c.debug.v1.BacklogSizeRequest\x1a\x1d.debug.v1.BacklogSizeResponse\"\x00\x12W\n" +
+	"\x13StartShardMigration\x12$.debug.v1.StartShardMigrationRequest\x1a\x18.debug.v1.ShardMigration\"\x00\x12P\n" +
+	"\x11GetShardMigration\x12\x1f.debug.v1.ShardMigrationRequest\x1a\x18.debug.v1.ShardMigration\"\x00\x12\\\n" +
+	"\x13ListShardMigrations\x12 .debug.v1.ShardMigrationsRequest\x1a!.debug.v1.ShardMigrationsResponse\"\x00\x12S\n" +
+	"\x14CancelShardMigration\x12\x1f.debug.v1.ShardMigrationRequest\x1a\x18.debug.v1.ShardMigration\"\x00\x12U\n" +
+	"\x16RollbackShardMigration\x12\x1f.debug.v1.ShardMigrationRequest\x1a\x18.debug.v1.ShardMigration\"\x00B5Z3github.com/inngest/inngest/proto/gen/debug/v1;debugb\x06proto3"
 
 var file_debug_v1_service_proto_goTypes = []any{
 	(*PartitionRequest)(nil),                 // 0: debug.v1.PartitionRequest
 	(*QueueItemRequest)(nil),                 // 1: debug.v1.QueueItemRequest
-	(*PauseRequest)(nil),                     // 2: debug.v1.PauseRequest
-	(*IndexRequest)(nil),                     // 3: debug.v1.IndexRequest
-	(*BlockPeekRequest)(nil),                 // 4: debug.v1.BlockPeekRequest
-	(*BlockDeletedRequest)(nil),              // 5: debug.v1.BlockDeletedRequest
-	(*v1.CapacityCheckRequest)(nil),          // 6: constraintapi.v1.CapacityCheckRequest
-	(*SemaphoreLevelRequest)(nil),            // 7: debug.v1.SemaphoreLevelRequest
-	(*AppSemaphoreLevelRequest)(nil),         // 8: debug.v1.AppSemaphoreLevelRequest
-	(*FunctionSemaphoreLevelRequest)(nil),    // 9: debug.v1.FunctionSemaphoreLevelRequest
-	(*SetSemaphoreLevelRequest)(nil),         // 10: debug.v1.SetSemaphoreLevelRequest
-	(*SetAppSemaphoreLevelRequest)(nil),      // 11: debug.v1.SetAppSemaphoreLevelRequest
-	(*SetFunctionSemaphoreLevelRequest)(nil), // 12: debug.v1.SetFunctionSemaphoreLevelRequest
-	(*BatchInfoRequest)(nil),                 // 13: debug.v1.BatchInfoRequest
-	(*DeleteBatchRequest)(nil),               // 14: debug.v1.DeleteBatchRequest
-	(*RunBatchRequest)(nil),                  // 15: debug.v1.RunBatchRequest
-	(*SingletonInfoRequest)(nil),             // 16: debug.v1.SingletonInfoRequest
-	(*DeleteSingletonLockRequest)(nil),       // 17: debug.v1.DeleteSingletonLockRequest
-	(*DebounceInfoRequest)(nil),              // 18: debug.v1.DebounceInfoRequest
-	(*DeleteDebounceRequest)(nil),            // 19: debug.v1.DeleteDebounceRequest
-	(*RunDebounceRequest)(nil),               // 20: debug.v1.RunDebounceRequest
-	(*DeleteDebounceByIDRequest)(nil),        // 21: debug.v1.DeleteDebounceByIDRequest
-	(*ShadowPartitionRequest)(nil),           // 22: debug.v1.ShadowPartitionRequest
-	(*BacklogsRequest)(nil),                  // 23: debug.v1.BacklogsRequest
-	(*BacklogSizeRequest)(nil),               // 24: debug.v1.BacklogSizeRequest
-	(*PartitionResponse)(nil),                // 25: debug.v1.PartitionResponse
-	(*PartitionStatusResponse)(nil),          // 26: debug.v1.PartitionStatusResponse
-	(*QueueItemResponse)(nil),                // 27: debug.v1.QueueItemResponse
-	(*PauseResponse)(nil),                    // 28: debug.v1.PauseResponse
-	(*IndexResponse)(nil),                    // 29: debug.v1.IndexResponse
-	(*BlockPeekResponse)(nil),                // 30: debug.v1.BlockPeekResponse
-	(*BlockDeletedResponse)(nil),             // 31: debug.v1.BlockDeletedResponse
-	(*CheckConstraintsResponse)(nil),         // 32: debug.v1.CheckConstraintsResponse
-	(*SemaphoreLevelResponse)(nil),           // 33: debug.v1.SemaphoreLevelResponse
-	(*SetSemaphoreLevelResponse)(nil),        // 34: debug.v1.SetSemaphoreLevelResponse
-	(*BatchInfoResponse)(nil),                // 35: debug.v1.BatchInfoResponse
-	(*DeleteBatchResponse)(nil),              // 36: debug.v1.DeleteBatchResponse
-	(*RunBatchResponse)(nil),                 // 37: debug.v1.RunBatchResponse
-	(*SingletonInfoResponse)(nil),            // 38: debug.v1.SingletonInfoResponse
-	(*DeleteSingletonLockResponse)(nil),      // 39: debug.v1.DeleteSingletonLockResponse
-	(*DebounceInfoResponse)(nil),             // 40: debug.v1.DebounceInfoResponse
-	(*DeleteDebounceResponse)(nil),           // 41: debug.v1.DeleteDebounceResponse
-	(*RunDebounceResponse)(nil),              // 42: debug.v1.RunDebounceResponse
-	(*DeleteDebounceByIDResponse)(nil),       // 43: debug.v1.DeleteDebounceByIDResponse
-	(*ShadowPartitionResponse)(nil),          // 44: debug.v1.ShadowPartitionResponse
-	(*BacklogsResponse)(nil),                 // 45: debug.v1.BacklogsResponse
-	(*BacklogSizeResponse)(nil),              // 46: debug.v1.BacklogSizeResponse
+	(*QueueItemsRequest)(nil),                // 2: debug.v1.QueueItemsRequest
+	(*RequeueQueueItemsRequest)(nil),         // 3: debug.v1.RequeueQueueItemsRequest
+	(*PurgeQueueItemsRequest)(nil),           // 4: debug.v1.PurgeQueueItemsRequest
+	(*PauseRequest)(nil),                     // 5: debug.v1.PauseRequest
+	(*IndexRequest)(nil),                     // 6: debug.v1.IndexRequest
+	(*BlockPeekRequest)(nil),                 // 7: debug.v1.BlockPeekRequest
+	(*BlockDeletedRequest)(nil),              // 8: debug.v1.BlockDeletedRequest
+	(*v1.CapacityCheckRequest)(nil),          // 9: constraintapi.v1.CapacityCheckRequest
+	(*SemaphoreLevelRequest)(nil),            // 10: debug.v1.SemaphoreLevelRequest
+	(*AppSemaphoreLevelRequest)(nil),         // 11: debug.v1.AppSemaphoreLevelRequest
+	(*FunctionSemaphoreLevelRequest)(nil),    // 12: debug.v1.FunctionSemaphoreLevelRequest
+	(*SetSemaphoreLevelRequest)(nil),         // 13: debug.v1.SetSemaphoreLevelRequest
+	(*SetAppSemaphoreLevelRequest)(nil),      // 14: debug.v1.SetAppSemaphoreLevelRequest
+	(*SetFunctionSemaphoreLevelRequest)(nil), // 15: debug.v1.SetFunctionSemaphoreLevelRequest
+	(*BatchInfoRequest)(nil),                 // 16: debug.v1.BatchInfoRequest
+	(*DeleteBatchRequest)(nil),               // 17: debug.v1.DeleteBatchRequest
+	(*RunBatchRequest)(nil),                  // 18: debug.v1.RunBatchRequest
+	(*SingletonInfoRequest)(nil),             // 19: debug.v1.SingletonInfoRequest
+	(*DeleteSingletonLockRequest)(nil),       // 20: debug.v1.DeleteSingletonLockRequest
+	(*DebounceInfoRequest)(nil),              // 21: debug.v1.DebounceInfoRequest
+	(*DeleteDebounceRequest)(nil),            // 22: debug.v1.DeleteDebounceRequest
+	(*RunDebounceRequest)(nil),               // 23: debug.v1.RunDebounceRequest
+	(*DeleteDebounceByIDRequest)(nil),        // 24: debug.v1.DeleteDebounceByIDRequest
+	(*ShadowPartitionRequest)(nil),           // 25: debug.v1.ShadowPartitionRequest
+	(*BacklogsRequest)(nil),                  // 26: debug.v1.BacklogsRequest
+	(*BacklogSizeRequest)(nil),               // 27: debug.v1.BacklogSizeRequest
+	(*StartShardMigrationRequest)(nil),       // 28: debug.v1.StartShardMigrationRequest
+	(*ShardMigrationRequest)(nil),            // 29: debug.v1.ShardMigrationRequest
+	(*ShardMigrationsRequest)(nil),           // 30: debug.v1.ShardMigrationsRequest
+	(*PartitionResponse)(nil),                // 31: debug.v1.PartitionResponse
+	(*PartitionStatusResponse)(nil),          // 32: debug.v1.PartitionStatusResponse
+	(*QueueItemResponse)(nil),                // 33: debug.v1.QueueItemResponse
+	(*QueueItemsResponse)(nil),               // 34: debug.v1.QueueItemsResponse
+	(*RequeueQueueItemsResponse)(nil),        // 35: debug.v1.RequeueQueueItemsResponse
+	(*PurgeQueueItemsResponse)(nil),          // 36: debug.v1.PurgeQueueItemsResponse
+	(*PauseResponse)(nil),                    // 37: debug.v1.PauseResponse
+	(*IndexResponse)(nil),                    // 38: debug.v1.IndexResponse
+	(*BlockPeekResponse)(nil),                // 39: debug.v1.BlockPeekResponse
+	(*BlockDeletedResponse)(nil),             // 40: debug.v1.BlockDeletedResponse
+	(*CheckConstraintsResponse)(nil),         // 41: debug.v1.CheckConstraintsResponse
+	(*SemaphoreLevelResponse)(nil),           // 42: debug.v1.SemaphoreLevelResponse
+	(*SetSemaphoreLevelResponse)(nil),        // 43: debug.v1.SetSemaphoreLevelResponse
+	(*BatchInfoResponse)(nil),                // 44: debug.v1.BatchInfoResponse
+	(*DeleteBatchResponse)(nil),              // 45: debug.v1.DeleteBatchResponse
+	(*RunBatchResponse)(nil),                 // 46: debug.v1.RunBatchResponse
+	(*SingletonInfoResponse)(nil),            // 47: debug.v1.SingletonInfoResponse
+	(*DeleteSingletonLockResponse)(nil),      // 48: debug.v1.DeleteSingletonLockResponse
+	(*DebounceInfoResponse)(nil),             // 49: debug.v1.DebounceInfoResponse
+	(*DeleteDebounceResponse)(nil),           // 50: debug.v1.DeleteDebounceResponse
+	(*RunDebounceResponse)(nil),              // 51: debug.v1.RunDebounceResponse
+	(*DeleteDebounceByIDResponse)(nil),       // 52: debug.v1.DeleteDebounceByIDResponse
+	(*ShadowPartitionResponse)(nil),          // 53: debug.v1.ShadowPartitionResponse
+	(*BacklogsResponse)(nil),                 // 54: debug.v1.BacklogsResponse
+	(*BacklogSizeResponse)(nil),              // 55: debug.v1.BacklogSizeResponse
+	(*ShardMigration)(nil),                   // 56: debug.v1.ShardMigration
+	(*ShardMigrationsResponse)(nil),          // 57: debug.v1.ShardMigrationsResponse
 }
 var file_debug_v1_service_proto_depIdxs = []int32{
 	0,  // 0: debug.v1.Debug.GetPartition:input_type -> debug.v1.PartitionRequest
 	0,  // 1: debug.v1.Debug.GetPartitionStatus:input_type -> debug.v1.PartitionRequest
 	1,  // 2: debug.v1.Debug.GetQueueItem:input_type -> debug.v1.QueueItemRequest
-	2,  // 3: debug.v1.Debug.GetPause:input_type -> debug.v1.PauseRequest
-	3,  // 4: debug.v1.Debug.GetIndex:input_type -> debug.v1.IndexRequest
-	4,  // 5: debug.v1.Debug.BlockPeek:input_type -> debug.v1.BlockPeekRequest
-	5,  // 6: debug.v1.Debug.BlockDeleted:input_type -> debug.v1.BlockDeletedRequest
-	6,  // 7: debug.v1.Debug.CheckConstraints:input_type -> constraintapi.v1.CapacityCheckRequest
-	7,  // 8: debug.v1.Debug.GetSemaphoreLevel:input_type -> debug.v1.SemaphoreLevelRequest
-	8,  // 9: debug.v1.Debug.GetAppSemaphoreLevel:input_type -> debug.v1.AppSemaphoreLevelRequest
-	9,  // 10: debug.v1.Debug.GetFunctionSemaphoreLevel:input_type -> debug.v1.FunctionSemaphoreLevelRequest
-	10, // 11: debug.v1.Debug.SetSemaphoreLevel:input_type -> debug.v1.SetSemaphoreLevelRequest
-	11, // 12: debug.v1.Debug.SetAppSemaphoreLevel:input_type -> debug.v1.SetAppSemaphoreLevelRequest
-	12, // 13: debug.v1.Debug.SetFunctionSemaphoreLevel:input_type -> debug.v1.SetFunctionSemaphoreLevelRequest
-	13, // 14: debug.v1.Debug.GetBatchInfo:input_type -> debug.v1.BatchInfoRequest
-	14, // 15: debug.v1.Debug.DeleteBatch:input_type -> debug.v1.DeleteBatchRequest
-	15, // 16: debug.v1.Debug.RunBatch:input_type -> debug.v1.RunBatchRequest
-	16, // 17: debug.v1.Debug.GetSingletonInfo:input_type -> debug.v1.SingletonInfoRequest
-	17, // 18: debug.v1.Debug.DeleteSingletonLock:input_type -> debug.v1.DeleteSingletonLockRequest
-	18, // 19: debug.v1.Debug.GetDebounceInfo:input_type -> debug.v1.DebounceInfoRequest
-	19, // 20: debug.v1.Debug.DeleteDebounce:input_type -> debug.v1.DeleteDebounceRequest
-	20, // 21: debug.v1.Debug.RunDebounce:input_type -> debug.v1.RunDebounceRequest
-	21, // 22: debug.v1.Debug.DeleteDebounceByID:input_type -> debug.v1.DeleteDebounceByIDRequest
-	22, // 23: debug.v1.Debug.GetShadowPartition:input_type -> debug.v1.ShadowPartitionRequest
-	23, // 24: debug.v1.Debug.GetBacklogs:input_type -> debug.v1.BacklogsRequest
-	24, // 25: debug.v1.Debug.GetBacklogSize:input_type -> debug.v1.BacklogSizeRequest
-	25, // 26: debug.v1.Debug.GetPartition:output_type -> debug.v1.PartitionResponse
-	26, // 27: debug.v1.Debug.GetPartitionStatus:output_type -> debug.v1.PartitionStatusResponse
-	27, // 28: debug.v1.Debug.GetQueueItem:output_type -> debug.v1.QueueItemResponse
-	28, // 29: debug.v1.Debug.GetPause:output_type -> debug.v1.PauseResponse
-	29, // 30: debug.v1.Debug.GetIndex:output_type -> debug.v1.IndexResponse
-	30, // 31: debug.v1.Debug.BlockPeek:output_type -> debug.v1.BlockPeekResponse
-	31, // 32: debug.v1.Debug.BlockDeleted:output_type -> debug.v1.BlockDeletedResponse
-	32, // 33: debug.v1.Debug.CheckConstraints:output_type -> debug.v1.CheckConstraintsResponse
-	33, // 34: debug.v1.Debug.GetSemaphoreLevel:output_type -> debug.v1.SemaphoreLevelResponse
-	33, // 35: debug.v1.Debug.GetAppSemaphoreLevel:output_type -> debug.v1.SemaphoreLevelResponse
-	33, // 36: debug.v1.Debug.GetFunctionSemaphoreLevel:output_type -> debug.v1.SemaphoreLevelResponse
-	34, // 37: debug.v1.Debug.SetSemaphoreLevel:output_type -> debug.v1.SetSemaphoreLevelResponse
-	34, // 38: debug.v1.Debug.SetAppSemaphoreLevel:output_type -> debug.v1.SetSemaphoreLevelResponse
-	34, // 39: debug.v1.Debug.SetFunctionSemaphoreLevel:output_type -> debug.v1.SetSemaphoreLevelResponse
-	35, // 40: debug.v1.Debug.GetBatchInfo:output_type -> debug.v1.BatchInfoResponse
-	36, // 41: debug.v1.Debug.DeleteBatch:output_type -> debug.v1.DeleteBatchResponse
-	37, // 42: debug.v1.Debug.RunBatch:output_type -> debug.v1.RunBatchResponse
-	38, // 43: debug.v1.Debug.GetSingletonInfo:output_type -> debug.v1.SingletonInfoResponse
-	39, // 44: debug.v1.Debug.DeleteSingletonLock:output_type -> debug.v1.DeleteSingletonLockResponse
-	40, // 45: debug.v1.Debug.GetDebounceInfo:output_type -> debug.v1.DebounceInfoResponse
-	41, // 46: debug.v1.Debug.DeleteDebounce:output_type -> debug.v1.DeleteDebounceResponse
-	42, // 47: debug.v1.Debug.RunDebounce:output_type -> debug.v1.RunDebounceResponse
-	43, // 48: debug.v1.Debug.DeleteDebounceByID:output_type -> debug.v1.DeleteDebounceByIDResponse
-	44, // 49: debug.v1.Debug.GetShadowPartition:output_type -> debug.v1.ShadowPartitionResponse
-	45, // 50: debug.v1.Debug.GetBacklogs:output_type -> debug.v1.BacklogsResponse
-	46, // 51: debug.v1.Debug.GetBacklogSize:output_type -> debug.v1.BacklogSizeResponse
-	26, // [26:52] is the sub-list for method output_type
-	0,  // [0:26] is the sub-list for method input_type
+	2,  // 3: debug.v1.Debug.ListQueueItems:input_type -> debug.v1.QueueItemsRequest
+	3,  // 4: debug.v1.Debug.RequeueQueueItems:input_type -> debug.v1.RequeueQueueItemsRequest
+	4,  // 5: debug.v1.Debug.PurgeQueueItems:input_type -> debug.v1.PurgeQueueItemsRequest
+	5,  // 6: debug.v1.Debug.GetPause:input_type -> debug.v1.PauseRequest
+	6,  // 7: debug.v1.Debug.GetIndex:input_type -> debug.v1.IndexRequest
+	7,  // 8: debug.v1.Debug.BlockPeek:input_type -> debug.v1.BlockPeekRequest
+	8,  // 9: debug.v1.Debug.BlockDeleted:input_type -> debug.v1.BlockDeletedRequest
+	9,  // 10: debug.v1.Debug.CheckConstraints:input_type -> constraintapi.v1.CapacityCheckRequest
+	10, // 11: debug.v1.Debug.GetSemaphoreLevel:input_type -> debug.v1.SemaphoreLevelRequest
+	11, // 12: debug.v1.Debug.GetAppSemaphoreLevel:input_type -> debug.v1.AppSemaphoreLevelRequest
+	12, // 13: debug.v1.Debug.GetFunctionSemaphoreLevel:input_type -> debug.v1.FunctionSemaphoreLevelRequest
+	13, // 14: debug.v1.Debug.SetSemaphoreLevel:input_type -> debug.v1.SetSemaphoreLevelRequest
+	14, // 15: debug.v1.Debug.SetAppSemaphoreLevel:input_type -> debug.v1.SetAppSemaphoreLevelRequest
+	15, // 16: debug.v1.Debug.SetFunctionSemaphoreLevel:input_type -> debug.v1.SetFunctionSemaphoreLevelRequest
+	16, // 17: debug.v1.Debug.GetBatchInfo:input_type -> debug.v1.BatchInfoRequest
+	17, // 18: debug.v1.Debug.DeleteBatch:input_type -> debug.v1.DeleteBatchRequest
+	18, // 19: debug.v1.Debug.RunBatch:input_type -> debug.v1.RunBatchRequest
+	19, // 20: debug.v1.Debug.GetSingletonInfo:input_type -> debug.v1.SingletonInfoRequest
+	20, // 21: debug.v1.Debug.DeleteSingletonLock:input_type -> debug.v1.DeleteSingletonLockRequest
+	21, // 22: debug.v1.Debug.GetDebounceInfo:input_type -> debug.v1.DebounceInfoRequest
+	22, // 23: debug.v1.Debug.DeleteDebounce:input_type -> debug.v1.DeleteDebounceRequest
+	23, // 24: debug.v1.Debug.RunDebounce:input_type -> debug.v1.RunDebounceRequest
+	24, // 25: debug.v1.Debug.DeleteDebounceByID:input_type -> debug.v1.DeleteDebounceByIDRequest
+	25, // 26: debug.v1.Debug.GetShadowPartition:input_type -> debug.v1.ShadowPartitionRequest
+	26, // 27: debug.v1.Debug.GetBacklogs:input_type -> debug.v1.BacklogsRequest
+	27, // 28: debug.v1.Debug.GetBacklogSize:input_type -> debug.v1.BacklogSizeRequest
+	28, // 29: debug.v1.Debug.StartShardMigration:input_type -> debug.v1.StartShardMigrationRequest
+	29, // 30: debug.v1.Debug.GetShardMigration:input_type -> debug.v1.ShardMigrationRequest
+	30, // 31: debug.v1.Debug.ListShardMigrations:input_type -> debug.v1.ShardMigrationsRequest
+	29, // 32: debug.v1.Debug.CancelShardMigration:input_type -> debug.v1.ShardMigrationRequest
+	29, // 33: debug.v1.Debug.RollbackShardMigration:input_type -> debug.v1.ShardMigrationRequest
+	31, // 34: debug.v1.Debug.GetPartition:output_type -> debug.v1.PartitionResponse
+	32, // 35: debug.v1.Debug.GetPartitionStatus:output_type -> debug.v1.PartitionStatusResponse
+	33, // 36: debug.v1.Debug.GetQueueItem:output_type -> debug.v1.QueueItemResponse
+	34, // 37: debug.v1.Debug.ListQueueItems:output_type -> debug.v1.QueueItemsResponse
+	35, // 38: debug.v1.Debug.RequeueQueueItems:output_type -> debug.v1.RequeueQueueItemsResponse
+	36, // 39: debug.v1.Debug.PurgeQueueItems:output_type -> debug.v1.PurgeQueueItemsResponse
+	37, // 40: debug.v1.Debug.GetPause:output_type -> debug.v1.PauseResponse
+	38, // 41: debug.v1.Debug.GetIndex:output_type -> debug.v1.IndexResponse
+	39, // 42: debug.v1.Debug.BlockPeek:output_type -> debug.v1.BlockPeekResponse
+	40, // 43: debug.v1.Debug.BlockDeleted:output_type -> debug.v1.BlockDeletedResponse
+	41, // 44: debug.v1.Debug.CheckConstraints:output_type -> debug.v1.CheckConstraintsResponse
+	42, // 45: debug.v1.Debug.GetSemaphoreLevel:output_type -> debug.v1.SemaphoreLevelResponse
+	42, // 46: debug.v1.Debug.GetAppSemaphoreLevel:output_type -> debug.v1.SemaphoreLevelResponse
+	42, // 47: debug.v1.Debug.GetFunctionSemaphoreLevel:output_type -> debug.v1.SemaphoreLevelResponse
+	43, // 48: debug.v1.Debug.SetSemaphoreLevel:output_type -> debug.v1.SetSemaphoreLevelResponse
+	43, // 49: debug.v1.Debug.SetAppSemaphoreLevel:output_type -> debug.v1.SetSemaphoreLevelResponse
+	43, // 50: debug.v1.Debug.SetFunctionSemaphoreLevel:output_type -> debug.v1.SetSemaphoreLevelResponse
+	44, // 51: debug.v1.Debug.GetBatchInfo:output_type -> debug.v1.BatchInfoResponse
+	45, // 52: debug.v1.Debug.DeleteBatch:output_type -> debug.v1.DeleteBatchResponse
+	46, // 53: debug.v1.Debug.RunBatch:output_type -> debug.v1.RunBatchResponse
+	47, // 54: debug.v1.Debug.GetSingletonInfo:output_type -> debug.v1.SingletonInfoResponse
+	48, // 55: debug.v1.Debug.DeleteSingletonLock:output_type -> debug.v1.DeleteSingletonLockResponse
+	49, // 56: debug.v1.Debug.GetDebounceInfo:output_type -> debug.v1.DebounceInfoResponse
+	50, // 57: debug.v1.Debug.DeleteDebounce:output_type -> debug.v1.DeleteDebounceResponse
+	51, // 58: debug.v1.Debug.RunDebounce:output_type -> debug.v1.RunDebounceResponse
+	52, // 59: debug.v1.Debug.DeleteDebounceByID:output_type -> debug.v1.DeleteDebounceByIDResponse
+	53, // 60: debug.v1.Debug.GetShadowPartition:output_type -> debug.v1.ShadowPartitionResponse
+	54, // 61: debug.v1.Debug.GetBacklogs:output_type -> debug.v1.BacklogsResponse
+	55, // 62: debug.v1.Debug.GetBacklogSize:output_type -> debug.v1.BacklogSizeResponse
+	56, // 63: debug.v1.Debug.StartShardMigration:output_type -> debug.v1.ShardMigration
+	56, // 64: debug.v1.Debug.GetShardMigration:output_type -> debug.v1.ShardMigration
+	57, // 65: debug.v1.Debug.ListShardMigrations:output_type -> debug.v1.ShardMigrationsResponse
+	56, // 66: debug.v1.Debug.CancelShardMigration:output_type -> debug.v1.ShardMigration
+	56, // 67: debug.v1.Debug.RollbackShardMigration:output_type -> debug.v1.ShardMigration
+	34, // [34:68] is the sub-list for method output_type
+	0,  // [0:34] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -174,6 +209,7 @@ func file_debug_v1_service_proto_init() {
 	file_debug_v1_batch_proto_init()
 	file_debug_v1_singleton_proto_init()
 	file_debug_v1_debounce_proto_init()
+	file_debug_v1_shard_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
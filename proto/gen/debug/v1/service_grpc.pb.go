@@ -23,6 +23,9 @@ const (
 	Debug_GetPartition_FullMethodName              = "/debug.v1.Debug/GetPartition"
 	Debug_GetPartitionStatus_FullMethodName        = "/debug.v1.Debug/GetPartitionStatus"
 	Debug_GetQueueItem_FullMethodName              = "/debug.v1.Debug/GetQueueItem"
+	Debug_ListQueueItems_FullMethodName            = "/debug.v1.Debug/ListQueueItems"
+	Debug_RequeueQueueItems_FullMethodName         = "/debug.v1.Debug/RequeueQueueItems"
+	Debug_PurgeQueueItems_FullMethodName           = "/debug.v1.Debug/PurgeQueueItems"
 	Debug_GetPause_FullMethodName                  = "/debug.v1.Debug/GetPause"
 	Debug_GetIndex_FullMethodName                  = "/debug.v1.Debug/GetIndex"
 	Debug_BlockPeek_FullMethodName                 = "/debug.v1.Debug/BlockPeek"
@@ -46,6 +49,11 @@ const (
 	Debug_GetShadowPartition_FullMethodName        = "/debug.v1.Debug/GetShadowPartition"
 	Debug_GetBacklogs_FullMethodName               = "/debug.v1.Debug/GetBacklogs"
 	Debug_GetBacklogSize_FullMethodName            = "/debug.v1.Debug/GetBacklogSize"
+	Debug_StartShardMigration_FullMethodName       = "/debug.v1.Debug/StartShardMigration"
+	Debug_GetShardMigration_FullMethodName         = "/debug.v1.Debug/GetShardMigration"
+	Debug_ListShardMigrations_FullMethodName       = "/debug.v1.Debug/ListShardMigrations"
+	Debug_CancelShardMigration_FullMethodName      = "/debug.v1.Debug/CancelShardMigration"
+	Debug_RollbackShardMigration_FullMethodName    = "/debug.v1.Debug/RollbackShardMigration"
 )
 
 // DebugClient is the client API for Debug service.
@@ -59,6 +67,12 @@ type DebugClient interface {
 	GetPartitionStatus(ctx context.Context, in *PartitionRequest, opts ...grpc.CallOption) (*PartitionStatusResponse, error)
 	// GetQueueItem retrieves the queue item object from the queue
 	GetQueueItem(ctx context.Context, in *QueueItemRequest, opts ...grpc.CallOption) (*QueueItemResponse, error)
+	// ListQueueItems pages through the items of a partition or backlog
+	ListQueueItems(ctx context.Context, in *QueueItemsRequest, opts ...grpc.CallOption) (*QueueItemsResponse, error)
+	// RequeueQueueItems reschedules unleased queue items to run now or after a delay
+	RequeueQueueItems(ctx context.Context, in *RequeueQueueItemsRequest, opts ...grpc.CallOption) (*RequeueQueueItemsResponse, error)
+	// PurgeQueueItems removes unleased items matching a filter from a partition or backlog
+	PurgeQueueItems(ctx context.Context, in *PurgeQueueItemsRequest, opts ...grpc.CallOption) (*PurgeQueueItemsResponse, error)
 	// GetPause retrieves a single pause item.
 	GetPause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error)
 	// GetIndex retrieves block information for a pause index.
@@ -105,6 +119,16 @@ type DebugClient interface {
 	GetBacklogs(ctx context.Context, in *BacklogsRequest, opts ...grpc.CallOption) (*BacklogsResponse, error)
 	// GetBacklogSize retrieves the number of items in a specific backlog
 	GetBacklogSize(ctx context.Context, in *BacklogSizeRequest, opts ...grpc.CallOption) (*BacklogSizeResponse, error)
+	// StartShardMigration starts moving an account or function to another queue shard.
+	StartShardMigration(ctx context.Context, in *StartShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error)
+	// GetShardMigration retrieves the progress of a shard migration.
+	GetShardMigration(ctx context.Context, in *ShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error)
+	// ListShardMigrations lists the shard migrations started by this server.
+	ListShardMigrations(ctx context.Context, in *ShardMigrationsRequest, opts ...grpc.CallOption) (*ShardMigrationsResponse, error)
+	// CancelShardMigration stops a running shard migration, leaving moved items on the target shard.
+	CancelShardMigration(ctx context.Context, in *ShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error)
+	// RollbackShardMigration moves an account or function back to the source shard of a migration.
+	RollbackShardMigration(ctx context.Context, in *ShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error)
 }
 
 type debugClient struct {
@@ -145,6 +169,36 @@ func (c *debugClient) GetQueueItem(ctx context.Context, in *QueueItemRequest, op
 	return out, nil
 }
 
+func (c *debugClient) ListQueueItems(ctx context.Context, in *QueueItemsRequest, opts ...grpc.CallOption) (*QueueItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueItemsResponse)
+	err := c.cc.Invoke(ctx, Debug_ListQueueItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RequeueQueueItems(ctx context.Context, in *RequeueQueueItemsRequest, opts ...grpc.CallOption) (*RequeueQueueItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueQueueItemsResponse)
+	err := c.cc.Invoke(ctx, Debug_RequeueQueueItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) PurgeQueueItems(ctx context.Context, in *PurgeQueueItemsRequest, opts ...grpc.CallOption) (*PurgeQueueItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeQueueItemsResponse)
+	err := c.cc.Invoke(ctx, Debug_PurgeQueueItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debugClient) GetPause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PauseResponse)
@@ -375,6 +429,56 @@ func (c *debugClient) GetBacklogSize(ctx context.Context, in *BacklogSizeRequest
 	return out, nil
 }
 
+func (c *debugClient) StartShardMigration(ctx context.Context, in *StartShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShardMigration)
+	err := c.cc.Invoke(ctx, Debug_StartShardMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetShardMigration(ctx context.Context, in *ShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShardMigration)
+	err := c.cc.Invoke(ctx, Debug_GetShardMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListShardMigrations(ctx context.Context, in *ShardMigrationsRequest, opts ...grpc.CallOption) (*ShardMigrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShardMigrationsResponse)
+	err := c.cc.Invoke(ctx, Debug_ListShardMigrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) CancelShardMigration(ctx context.Context, in *ShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShardMigration)
+	err := c.cc.Invoke(ctx, Debug_CancelShardMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RollbackShardMigration(ctx context.Context, in *ShardMigrationRequest, opts ...grpc.CallOption) (*ShardMigration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShardMigration)
+	err := c.cc.Invoke(ctx, Debug_RollbackShardMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 // All implementations must embed UnimplementedDebugServer
 // for forward compatibility.
@@ -386,6 +490,12 @@ type DebugServer interface {
 	GetPartitionStatus(context.Context, *PartitionRequest) (*PartitionStatusResponse, error)
 	// GetQueueItem retrieves the queue item object from the queue
 	GetQueueItem(context.Context, *QueueItemRequest) (*QueueItemResponse, error)
+	// ListQueueItems pages through the items of a partition or backlog
+	ListQueueItems(context.Context, *QueueItemsRequest) (*QueueItemsResponse, error)
+	// RequeueQueueItems reschedules unleased queue items to run now or after a delay
+	RequeueQueueItems(context.Context, *RequeueQueueItemsRequest) (*RequeueQueueItemsResponse, error)
+	// PurgeQueueItems removes unleased items matching a filter from a partition or backlog
+	PurgeQueueItems(context.Context, *PurgeQueueItemsRequest) (*PurgeQueueItemsResponse, error)
 	// GetPause retrieves a single pause item.
 	GetPause(context.Context, *PauseRequest) (*PauseResponse, error)
 	// GetIndex retrieves block information for a pause index.
@@ -432,6 +542,16 @@ type DebugServer interface {
 	GetBacklogs(context.Context, *BacklogsRequest) (*BacklogsResponse, error)
 	// GetBacklogSize retrieves the number of items in a specific backlog
 	GetBacklogSize(context.Context, *BacklogSizeRequest) (*BacklogSizeResponse, error)
+	// StartShardMigration starts moving an account or function to another queue shard.
+	StartShardMigration(context.Context, *StartShardMigrationRequest) (*ShardMigration, error)
+	// GetShardMigration retrieves the progress of a shard migration.
+	GetShardMigration(context.Context, *ShardMigrationRequest) (*ShardMigration, error)
+	// ListShardMigrations lists the shard migrations started by this server.
+	ListShardMigrations(context.Context, *ShardMigrationsRequest) (*ShardMigrationsResponse, error)
+	// CancelShardMigration stops a running shard migration, leaving moved items on the target shard.
+	CancelShardMigration(context.Context, *ShardMigrationRequest) (*ShardMigration, error)
+	// RollbackShardMigration moves an account or function back to the source shard of a migration.
+	RollbackShardMigration(context.Context, *ShardMigrationRequest) (*ShardMigration, error)
 	mustEmbedUnimplementedDebugServer()
 }
 
@@ -451,6 +571,15 @@ func (UnimplementedDebugServer) GetPartitionStatus(context.Context, *PartitionRe
 func (UnimplementedDebugServer) GetQueueItem(context.Context, *QueueItemRequest) (*QueueItemResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetQueueItem not implemented")
 }
+func (UnimplementedDebugServer) ListQueueItems(context.Context, *QueueItemsRequest) (*QueueItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueueItems not implemented")
+}
+func (UnimplementedDebugServer) RequeueQueueItems(context.Context, *RequeueQueueItemsRequest) (*RequeueQueueItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequeueQueueItems not implemented")
+}
+func (UnimplementedDebugServer) PurgeQueueItems(context.Context, *PurgeQueueItemsRequest) (*PurgeQueueItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeQueueItems not implemented")
+}
 func (UnimplementedDebugServer) GetPause(context.Context, *PauseRequest) (*PauseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPause not implemented")
 }
@@ -520,6 +649,21 @@ func (UnimplementedDebugServer) GetBacklogs(context.Context, *BacklogsRequest) (
 func (UnimplementedDebugServer) GetBacklogSize(context.Context, *BacklogSizeRequest) (*BacklogSizeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBacklogSize not implemented")
 }
+func (UnimplementedDebugServer) StartShardMigration(context.Context, *StartShardMigrationRequest) (*ShardMigration, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartShardMigration not implemented")
+}
+func (UnimplementedDebugServer) GetShardMigration(context.Context, *ShardMigrationRequest) (*ShardMigration, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShardMigration not implemented")
+}
+func (UnimplementedDebugServer) ListShardMigrations(context.Context, *ShardMigrationsRequest) (*ShardMigrationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShardMigrations not implemented")
+}
+func (UnimplementedDebugServer) CancelShardMigration(context.Context, *ShardMigrationRequest) (*ShardMigration, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelShardMigration not implemented")
+}
+func (UnimplementedDebugServer) RollbackShardMigration(context.Context, *ShardMigrationRequest) (*ShardMigration, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackShardMigration not implemented")
+}
 func (UnimplementedDebugServer) mustEmbedUnimplementedDebugServer() {}
 func (UnimplementedDebugServer) testEmbeddedByValue()               {}
 
@@ -595,6 +739,60 @@ func _Debug_GetQueueItem_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_ListQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListQueueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_ListQueueItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListQueueItems(ctx, req.(*QueueItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RequeueQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueQueueItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RequeueQueueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_RequeueQueueItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RequeueQueueItems(ctx, req.(*RequeueQueueItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_PurgeQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeQueueItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).PurgeQueueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_PurgeQueueItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).PurgeQueueItems(ctx, req.(*PurgeQueueItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Debug_GetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PauseRequest)
 	if err := dec(in); err != nil {
@@ -1009,6 +1207,96 @@ func _Debug_GetBacklogSize_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_StartShardMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShardMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).StartShardMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_StartShardMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).StartShardMigration(ctx, req.(*StartShardMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetShardMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetShardMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_GetShardMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetShardMigration(ctx, req.(*ShardMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListShardMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListShardMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_ListShardMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListShardMigrations(ctx, req.(*ShardMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_CancelShardMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).CancelShardMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_CancelShardMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).CancelShardMigration(ctx, req.(*ShardMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RollbackShardMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RollbackShardMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Debug_RollbackShardMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RollbackShardMigration(ctx, req.(*ShardMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Debug_ServiceDesc is the grpc.ServiceDesc for Debug service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1028,6 +1316,18 @@ var Debug_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQueueItem",
 			Handler:    _Debug_GetQueueItem_Handler,
 		},
+		{
+			MethodName: "ListQueueItems",
+			Handler:    _Debug_ListQueueItems_Handler,
+		},
+		{
+			MethodName: "RequeueQueueItems",
+			Handler:    _Debug_RequeueQueueItems_Handler,
+		},
+		{
+			MethodName: "PurgeQueueItems",
+			Handler:    _Debug_PurgeQueueItems_Handler,
+		},
 		{
 			MethodName: "GetPause",
 			Handler:    _Debug_GetPause_Handler,
@@ -1120,6 +1420,26 @@ var Debug_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBacklogSize",
 			Handler:    _Debug_GetBacklogSize_Handler,
 		},
+		{
+			MethodName: "StartShardMigration",
+			Handler:    _Debug_StartShardMigration_Handler,
+		},
+		{
+			MethodName: "GetShardMigration",
+			Handler:    _Debug_GetShardMigration_Handler,
+		},
+		{
+			MethodName: "ListShardMigrations",
+			Handler:    _Debug_ListShardMigrations_Handler,
+		},
+		{
+			MethodName: "CancelShardMigration",
+			Handler:    _Debug_CancelShardMigration_Handler,
+		},
+		{
+			MethodName: "RollbackShardMigration",
+			Handler:    _Debug_RollbackShardMigration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "debug/v1/service.proto",
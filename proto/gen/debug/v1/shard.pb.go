@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: debug/v1/shard.proto
+
+package debug
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StartShardMigrationRequest starts moving an account's or function's queue
+// items from one queue shard to another.
+type StartShardMigrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// account_id is the UUID of the account to migrate.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// env_id is the optional UUID of the environment that owns the function.
+	EnvId string `protobuf:"bytes,2,opt,name=env_id,json=envId,proto3" json:"env_id,omitempty"`
+	// function_id optionally limits the migration to a single function.
+	FunctionId string `protobuf:"bytes,3,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	// source_shard is the shard to move items from.  Defaults to the shard the
+	// account or function currently resolves to.
+	SourceShard string `protobuf:"bytes,4,opt,name=source_shard,json=sourceShard,proto3" json:"source_shard,omitempty"`
+	// target_shard is the shard to move items to.
+	TargetShard string `protobuf:"bytes,5,opt,name=target_shard,json=targetShard,proto3" json:"target_shard,omitempty"`
+	// drain_timeout_ms is how long to wait for leased items on the source shard
+	// to be released before failing the migration.
+	DrainTimeoutMs int64 `protobuf:"varint,6,opt,name=drain_timeout_ms,json=drainTimeoutMs,proto3" json:"drain_timeout_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartShardMigrationRequest) Reset() {
+	*x = StartShardMigrationRequest{}
+	mi := &file_debug_v1_shard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartShardMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShardMigrationRequest) ProtoMessage() {}
+
+func (x *StartShardMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_shard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShardMigrationRequest.ProtoReflect.Descriptor instead.
+func (*StartShardMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_shard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartShardMigrationRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *StartShardMigrationRequest) GetEnvId() string {
+	if x != nil {
+		return x.EnvId
+	}
+	return ""
+}
+
+func (x *StartShardMigrationRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *StartShardMigrationRequest) GetSourceShard() string {
+	if x != nil {
+		return x.SourceShard
+	}
+	return ""
+}
+
+func (x *StartShardMigrationRequest) GetTargetShard() string {
+	if x != nil {
+		return x.TargetShard
+	}
+	return ""
+}
+
+func (x *StartShardMigrationRequest) GetDrainTimeoutMs() int64 {
+	if x != nil {
+		return x.DrainTimeoutMs
+	}
+	return 0
+}
+
+// ShardMigrationRequest identifies a shard migration.
+type ShardMigrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardMigrationRequest) Reset() {
+	*x = ShardMigrationRequest{}
+	mi := &file_debug_v1_shard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardMigrationRequest) ProtoMessage() {}
+
+func (x *ShardMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_shard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardMigrationRequest.ProtoReflect.Descriptor instead.
+func (*ShardMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_shard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShardMigrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ShardMigrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardMigrationsRequest) Reset() {
+	*x = ShardMigrationsRequest{}
+	mi := &file_debug_v1_shard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardMigrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardMigrationsRequest) ProtoMessage() {}
+
+func (x *ShardMigrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_shard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardMigrationsRequest.ProtoReflect.Descriptor instead.
+func (*ShardMigrationsRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_shard_proto_rawDescGZIP(), []int{2}
+}
+
+type ShardMigrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Migrations    []*ShardMigration      `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardMigrationsResponse) Reset() {
+	*x = ShardMigrationsResponse{}
+	mi := &file_debug_v1_shard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardMigrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardMigrationsResponse) ProtoMessage() {}
+
+func (x *ShardMigrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_shard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardMigrationsResponse.ProtoReflect.Descriptor instead.
+func (*ShardMigrationsResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_shard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShardMigrationsResponse) GetMigrations() []*ShardMigration {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+// ShardMigration reports the progress of a shard migration.
+type ShardMigration struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId   string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	EnvId       string                 `protobuf:"bytes,3,opt,name=env_id,json=envId,proto3" json:"env_id,omitempty"`
+	FunctionId  string                 `protobuf:"bytes,4,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	SourceShard string                 `protobuf:"bytes,5,opt,name=source_shard,json=sourceShard,proto3" json:"source_shard,omitempty"`
+	TargetShard string                 `protobuf:"bytes,6,opt,name=target_shard,json=targetShard,proto3" json:"target_shard,omitempty"`
+	// status is one of running, completed, failed, cancelled or rolled_back.
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// phase is one of routing, draining or done.
+	Phase string `protobuf:"bytes,8,opt,name=phase,proto3" json:"phase,omitempty"`
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// rollback_of is the migration this migration rolls back, if any.
+	RollbackOf string `protobuf:"bytes,10,opt,name=rollback_of,json=rollbackOf,proto3" json:"rollback_of,omitempty"`
+	// rolled_back_by is the migration that rolled this migration back, if any.
+	RolledBackBy string `protobuf:"bytes,11,opt,name=rolled_back_by,json=rolledBackBy,proto3" json:"rolled_back_by,omitempty"`
+	Functions    int64  `protobuf:"varint,12,opt,name=functions,proto3" json:"functions,omitempty"`
+	Passes       int64  `protobuf:"varint,13,opt,name=passes,proto3" json:"passes,omitempty"`
+	ItemsMoved   int64  `protobuf:"varint,14,opt,name=items_moved,json=itemsMoved,proto3" json:"items_moved,omitempty"`
+	// items_leased is the number of leased items skipped in the latest pass.
+	ItemsLeased int64 `protobuf:"varint,15,opt,name=items_leased,json=itemsLeased,proto3" json:"items_leased,omitempty"`
+	// in_progress is the number of leased items on the source shard.
+	InProgress    int64                  `protobuf:"varint,16,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardMigration) Reset() {
+	*x = ShardMigration{}
+	mi := &file_debug_v1_shard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardMigration) ProtoMessage() {}
+
+func (x *ShardMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_shard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardMigration.ProtoReflect.Descriptor instead.
+func (*ShardMigration) Descriptor() ([]byte, []int) {
+	return file_debug_v1_shard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShardMigration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetEnvId() string {
+	if x != nil {
+		return x.EnvId
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetSourceShard() string {
+	if x != nil {
+		return x.SourceShard
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetTargetShard() string {
+	if x != nil {
+		return x.TargetShard
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetRollbackOf() string {
+	if x != nil {
+		return x.RollbackOf
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetRolledBackBy() string {
+	if x != nil {
+		return x.RolledBackBy
+	}
+	return ""
+}
+
+func (x *ShardMigration) GetFunctions() int64 {
+	if x != nil {
+		return x.Functions
+	}
+	return 0
+}
+
+func (x *ShardMigration) GetPasses() int64 {
+	if x != nil {
+		return x.Passes
+	}
+	return 0
+}
+
+func (x *ShardMigration) GetItemsMoved() int64 {
+	if x != nil {
+		return x.ItemsMoved
+	}
+	return 0
+}
+
+func (x *ShardMigration) GetItemsLeased() int64 {
+	if x != nil {
+		return x.ItemsLeased
+	}
+	return 0
+}
+
+func (x *ShardMigration) GetInProgress() int64 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
+func (x *ShardMigration) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ShardMigration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ShardMigration) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+var File_debug_v1_shard_proto protoreflect.FileDescriptor
+
+const file_debug_v1_shard_proto_rawDesc = "" +
+	"\n" +
+	"\x14debug/v1/shard.proto\x12\bdebug.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x01\n" +
+	"\x1aStartShardMigrationRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x15\n" +
+	"\x06env_id\x18\x02 \x01(\tR\x05envId\x12\x1f\n" +
+	"\vfunction_id\x18\x03 \x01(\tR\n" +
+	"functionId\x12!\n" +
+	"\fsource_shard\x18\x04 \x01(\tR\vsourceShard\x12!\n" +
+	"\ftarget_shard\x18\x05 \x01(\tR\vtargetShard\x12(\n" +
+	"\x10drain_timeout_ms\x18\x06 \x01(\x03R\x0edrainTimeoutMs\"'\n" +
+	"\x15ShardMigrationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16ShardMigrationsRequest\"S\n" +
+	"\x17ShardMigrationsResponse\x128\n" +
+	"\n" +
+	"migrations\x18\x01 \x03(\v2\x18.debug.v1.ShardMigrationR\n" +
+	"migrations\"\x96\x05\n" +
+	"\x0eShardMigration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x15\n" +
+	"\x06env_id\x18\x03 \x01(\tR\x05envId\x12\x1f\n" +
+	"\vfunction_id\x18\x04 \x01(\tR\n" +
+	"functionId\x12!\n" +
+	"\fsource_shard\x18\x05 \x01(\tR\vsourceShard\x12!\n" +
+	"\ftarget_shard\x18\x06 \x01(\tR\vtargetShard\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x14\n" +
+	"\x05phase\x18\b \x01(\tR\x05phase\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1f\n" +
+	"\vrollback_of\x18\n" +
+	" \x01(\tR\n" +
+	"rollbackOf\x12$\n" +
+	"\x0erolled_back_by\x18\v \x01(\tR\frolledBackBy\x12\x1c\n" +
+	"\tfunctions\x18\f \x01(\x03R\tfunctions\x12\x16\n" +
+	"\x06passes\x18\r \x01(\x03R\x06passes\x12\x1f\n" +
+	"\vitems_moved\x18\x0e \x01(\x03R\n" +
+	"itemsMoved\x12!\n" +
+	"\fitems_leased\x18\x0f \x01(\x03R\vitemsLeased\x12\x1f\n" +
+	"\vin_progress\x18\x10 \x01(\x03R\n" +
+	"inProgress\x129\n" +
+	"\n" +
+	"started_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAtB5Z3github.com/inngest/inngest/proto/gen/debug/v1;debugb\x06proto3"
+
+var (
+	file_debug_v1_shard_proto_rawDescOnce sync.Once
+	file_debug_v1_shard_proto_rawDescData []byte
+)
+
+func file_debug_v1_shard_proto_rawDescGZIP() []byte {
+	file_debug_v1_shard_proto_rawDescOnce.Do(func() {
+		file_debug_v1_shard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_debug_v1_shard_proto_rawDesc), len(file_debug_v1_shard_proto_rawDesc)))
+	})
+	return file_debug_v1_shard_proto_rawDescData
+}
+
+var file_debug_v1_shard_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_debug_v1_shard_proto_goTypes = []any{
+	(*StartShardMigrationRequest)(nil), // 0: debug.v1.StartShardMigrationRequest
+	(*ShardMigrationRequest)(nil),      // 1: debug.v1.ShardMigrationRequest
+	(*ShardMigrationsRequest)(nil),     // 2: debug.v1.ShardMigrationsRequest
+	(*ShardMigrationsResponse)(nil),    // 3: debug.v1.ShardMigrationsResponse
+	(*ShardMigration)(nil),             // 4: debug.v1.ShardMigration
+	(*timestamppb.Timestamp)(nil),      // 5: google.protobuf.Timestamp
+}
+var file_debug_v1_shard_proto_depIdxs = []int32{
+	4, // 0: debug.v1.ShardMigrationsResponse.migrations:type_name -> debug.v1.ShardMigration
+	5, // 1: debug.v1.ShardMigration.started_at:type_name -> google.protobuf.Timestamp
+	5, // 2: debug.v1.ShardMigration.updated_at:type_name -> google.protobuf.Timestamp
+	5, // 3: debug.v1.ShardMigration.finished_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_debug_v1_shard_proto_init() }
+func file_debug_v1_shard_proto_init() {
+	if File_debug_v1_shard_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_debug_v1_shard_proto_rawDesc), len(file_debug_v1_shard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_debug_v1_shard_proto_goTypes,
+		DependencyIndexes: file_debug_v1_shard_proto_depIdxs,
+		MessageInfos:      file_debug_v1_shard_proto_msgTypes,
+	}.Build()
+	File_debug_v1_shard_proto = out.File
+	file_debug_v1_shard_proto_goTypes = nil
+	file_debug_v1_shard_proto_depIdxs = nil
+}
@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: driver/v1/driver.proto
+
+package driverv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExecuteRequest is a request to execute a function or a specific step.
+//
+// The request is signed with the environment's signing key:  the
+// x-inngest-signature metadata key contains the signature of the `payload`
+// bytes, in the same format as the X-Inngest-Signature HTTP header.
+type ExecuteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// function_id is the UUID of the function being executed.
+	FunctionId string `protobuf:"bytes,1,opt,name=function_id,json=functionId,proto3" json:"function_id,omitempty"`
+	// run_id is the ULID of the run being executed.
+	RunId string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// step_id is the ID of the step being executed, if the executor is
+	// executing a specific planned step.
+	StepId *string `protobuf:"bytes,3,opt,name=step_id,json=stepId,proto3,oneof" json:"step_id,omitempty"`
+	// attempt is the zero-indexed attempt for this request.
+	Attempt int32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// request_id is a unique ID generated for each request.
+	RequestId string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// generation_id is the monotonic dispatch generation for this request.
+	GenerationId int64 `protobuf:"varint,6,opt,name=generation_id,json=generationId,proto3" json:"generation_id,omitempty"`
+	// job_id is the stable queue item ID for this request.
+	JobId string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// request_version is the version used to manage the SDK request context.
+	RequestVersion int32 `protobuf:"varint,8,opt,name=request_version,json=requestVersion,proto3" json:"request_version,omitempty"`
+	// payload is the JSON-encoded SDK request, containing the run's events,
+	// memoized step state and context.  This is identical to the body sent to
+	// HTTP functions.
+	Payload       []byte `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteRequest) Reset() {
+	*x = ExecuteRequest{}
+	mi := &file_driver_v1_driver_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteRequest) ProtoMessage() {}
+
+func (x *ExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_v1_driver_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_driver_v1_driver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExecuteRequest) GetFunctionId() string {
+	if x != nil {
+		return x.FunctionId
+	}
+	return ""
+}
+
+func (x *ExecuteRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ExecuteRequest) GetStepId() string {
+	if x != nil && x.StepId != nil {
+		return *x.StepId
+	}
+	return ""
+}
+
+func (x *ExecuteRequest) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ExecuteRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ExecuteRequest) GetGenerationId() int64 {
+	if x != nil {
+		return x.GenerationId
+	}
+	return 0
+}
+
+func (x *ExecuteRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ExecuteRequest) GetRequestVersion() int32 {
+	if x != nil {
+		return x.RequestVersion
+	}
+	return 0
+}
+
+func (x *ExecuteRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// ExecuteResponse is the result of executing a function or step.
+type ExecuteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// opcodes are the generator opcodes reported by the SDK.  An empty list
+	// indicates that the function has finished without reporting any steps.
+	Opcodes []*Opcode `protobuf:"bytes,1,rep,name=opcodes,proto3" json:"opcodes,omitempty"`
+	// sdk is the SDK name and version, eg. "go:v0.8.0".
+	Sdk string `protobuf:"bytes,2,opt,name=sdk,proto3" json:"sdk,omitempty"`
+	// request_version is the request version the SDK used for this request.
+	RequestVersion int32 `protobuf:"varint,3,opt,name=request_version,json=requestVersion,proto3" json:"request_version,omitempty"`
+	// no_retry indicates that the function errored and must not be retried.
+	NoRetry bool `protobuf:"varint,4,opt,name=no_retry,json=noRetry,proto3" json:"no_retry,omitempty"`
+	// retry_at indicates when the function should be retried after an error.
+	RetryAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retry_at,json=retryAt,proto3,oneof" json:"retry_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteResponse) Reset() {
+	*x = ExecuteResponse{}
+	mi := &file_driver_v1_driver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteResponse) ProtoMessage() {}
+
+func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_v1_driver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteResponse) Descriptor() ([]byte, []int) {
+	return file_driver_v1_driver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExecuteResponse) GetOpcodes() []*Opcode {
+	if x != nil {
+		return x.Opcodes
+	}
+	return nil
+}
+
+func (x *ExecuteResponse) GetSdk() string {
+	if x != nil {
+		return x.Sdk
+	}
+	return ""
+}
+
+func (x *ExecuteResponse) GetRequestVersion() int32 {
+	if x != nil {
+		return x.RequestVersion
+	}
+	return 0
+}
+
+func (x *ExecuteResponse) GetNoRetry() bool {
+	if x != nil {
+		return x.NoRetry
+	}
+	return false
+}
+
+func (x *ExecuteResponse) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+// Opcode is a single generator opcode, eg. a completed step or a planned
+// sleep.
+type Opcode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// op is the opcode name, eg. "StepRun" or "Sleep".
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// id is the hashed step ID.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the step name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// display_name is the user-facing name of the step.
+	DisplayName *string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	// opts is the JSON-encoded opcode options, if any.
+	Opts []byte `protobuf:"bytes,5,opt,name=opts,proto3" json:"opts,omitempty"`
+	// data is the JSON-encoded step output, if any.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// error is the error returned by the step, if any.
+	Error *StepError `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// userland contains the step's un-hashed ID and index.
+	Userland      *Userland `protobuf:"bytes,8,opt,name=userland,proto3,oneof" json:"userland,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Opcode) Reset() {
+	*x = Opcode{}
+	mi := &file_driver_v1_driver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Opcode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opcode) ProtoMessage() {}
+
+func (x *Opcode) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_v1_driver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opcode.ProtoReflect.Descriptor instead.
+func (*Opcode) Descriptor() ([]byte, []int) {
+	return file_driver_v1_driver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Opcode) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Opcode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Opcode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Opcode) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *Opcode) GetOpts() []byte {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+func (x *Opcode) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Opcode) GetError() *StepError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Opcode) GetUserland() *Userland {
+	if x != nil {
+		return x.Userland
+	}
+	return nil
+}
+
+// StepError is an error returned from a function or step.
+type StepError struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Stack   string                 `protobuf:"bytes,3,opt,name=stack,proto3" json:"stack,omitempty"`
+	// data is the JSON-encoded error data, if any.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// no_retry indicates that the error is not retryable.
+	NoRetry bool `protobuf:"varint,5,opt,name=no_retry,json=noRetry,proto3" json:"no_retry,omitempty"`
+	// cause is the JSON-encoded cause of the error, if any.
+	Cause         []byte `protobuf:"bytes,6,opt,name=cause,proto3" json:"cause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepError) Reset() {
+	*x = StepError{}
+	mi := &file_driver_v1_driver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepError) ProtoMessage() {}
+
+func (x *StepError) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_v1_driver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepError.ProtoReflect.Descriptor instead.
+func (*StepError) Descriptor() ([]byte, []int) {
+	return file_driver_v1_driver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StepError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StepError) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+func (x *StepError) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StepError) GetNoRetry() bool {
+	if x != nil {
+		return x.NoRetry
+	}
+	return false
+}
+
+func (x *StepError) GetCause() []byte {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+// Userland is the step ID and index as written by the user.
+type Userland struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Userland) Reset() {
+	*x = Userland{}
+	mi := &file_driver_v1_driver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Userland) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Userland) ProtoMessage() {}
+
+func (x *Userland) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_v1_driver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Userland.ProtoReflect.Descriptor instead.
+func (*Userland) Descriptor() ([]byte, []int) {
+	return file_driver_v1_driver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Userland) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Userland) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+var File_driver_v1_driver_proto protoreflect.FileDescriptor
+
+const file_driver_v1_driver_proto_rawDesc = "" +
+	"\n" +
+	"\x16driver/v1/driver.proto\x12\tdriver.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x02\n" +
+	"\x0eExecuteRequest\x12\x1f\n" +
+	"\vfunction_id\x18\x01 \x01(\tR\n" +
+	"functionId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x1c\n" +
+	"\astep_id\x18\x03 \x01(\tH\x00R\x06stepId\x88\x01\x01\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12#\n" +
+	"\rgeneration_id\x18\x06 \x01(\x03R\fgenerationId\x12\x15\n" +
+	"\x06job_id\x18\a \x01(\tR\x05jobId\x12'\n" +
+	"\x0frequest_version\x18\b \x01(\x05R\x0erequestVersion\x12\x18\n" +
+	"\apayload\x18\t \x01(\fR\apayloadB\n" +
+	"\n" +
+	"\b_step_id\"\xdd\x01\n" +
+	"\x0fExecuteResponse\x12+\n" +
+	"\aopcodes\x18\x01 \x03(\v2\x11.driver.v1.OpcodeR\aopcodes\x12\x10\n" +
+	"\x03sdk\x18\x02 \x01(\tR\x03sdk\x12'\n" +
+	"\x0frequest_version\x18\x03 \x01(\x05R\x0erequestVersion\x12\x19\n" +
+	"\bno_retry\x18\x04 \x01(\bR\anoRetry\x12:\n" +
+	"\bretry_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aretryAt\x88\x01\x01B\v\n" +
+	"\t_retry_at\"\x9b\x02\n" +
+	"\x06Opcode\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12&\n" +
+	"\fdisplay_name\x18\x04 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12\x12\n" +
+	"\x04opts\x18\x05 \x01(\fR\x04opts\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12/\n" +
+	"\x05error\x18\a \x01(\v2\x14.driver.v1.StepErrorH\x01R\x05error\x88\x01\x01\x124\n" +
+	"\buserland\x18\b \x01(\v2\x13.driver.v1.UserlandH\x02R\buserland\x88\x01\x01B\x0f\n" +
+	"\r_display_nameB\b\n" +
+	"\x06_errorB\v\n" +
+	"\t_userland\"\x94\x01\n" +
+	"\tStepError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05stack\x18\x03 \x01(\tR\x05stack\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x19\n" +
+	"\bno_retry\x18\x05 \x01(\bR\anoRetry\x12\x14\n" +
+	"\x05cause\x18\x06 \x01(\fR\x05cause\"0\n" +
+	"\bUserland\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index2T\n" +
+	"\x10ExecutionService\x12@\n" +
+	"\aExecute\x12\x19.driver.v1.ExecuteRequest\x1a\x1a.driver.v1.ExecuteResponseB9Z7github.com/inngest/inngest/proto/gen/driver/v1;driverv1b\x06proto3"
+
+var (
+	file_driver_v1_driver_proto_rawDescOnce sync.Once
+	file_driver_v1_driver_proto_rawDescData []byte
+)
+
+func file_driver_v1_driver_proto_rawDescGZIP() []byte {
+	file_driver_v1_driver_proto_rawDescOnce.Do(func() {
+		file_driver_v1_driver_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_driver_v1_driver_proto_rawDesc), len(file_driver_v1_driver_proto_rawDesc)))
+	})
+	return file_driver_v1_driver_proto_rawDescData
+}
+
+var file_driver_v1_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_driver_v1_driver_proto_goTypes = []any{
+	(*ExecuteRequest)(nil),        // 0: driver.v1.ExecuteRequest
+	(*ExecuteResponse)(nil),       // 1: driver.v1.ExecuteResponse
+	(*Opcode)(nil),                // 2: driver.v1.Opcode
+	(*StepError)(nil),             // 3: driver.v1.StepError
+	(*Userland)(nil),              // 4: driver.v1.Userland
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_driver_v1_driver_proto_depIdxs = []int32{
+	2, // 0: driver.v1.ExecuteResponse.opcodes:type_name -> driver.v1.Opcode
+	5, // 1: driver.v1.ExecuteResponse.retry_at:type_name -> google.protobuf.Timestamp
+	3, // 2: driver.v1.Opcode.error:type_name -> driver.v1.StepError
+	4, // 3: driver.v1.Opcode.userland:type_name -> driver.v1.Userland
+	0, // 4: driver.v1.ExecutionService.Execute:input_type -> driver.v1.ExecuteRequest
+	1, // 5: driver.v1.ExecutionService.Execute:output_type -> driver.v1.ExecuteResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_driver_v1_driver_proto_init() }
+func file_driver_v1_driver_proto_init() {
+	if File_driver_v1_driver_proto != nil {
+		return
+	}
+	file_driver_v1_driver_proto_msgTypes[0].OneofWrappers = []any{}
+	file_driver_v1_driver_proto_msgTypes[1].OneofWrappers = []any{}
+	file_driver_v1_driver_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_driver_v1_driver_proto_rawDesc), len(file_driver_v1_driver_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_driver_v1_driver_proto_goTypes,
+		DependencyIndexes: file_driver_v1_driver_proto_depIdxs,
+		MessageInfos:      file_driver_v1_driver_proto_msgTypes,
+	}.Build()
+	File_driver_v1_driver_proto = out.File
+	file_driver_v1_driver_proto_goTypes = nil
+	file_driver_v1_driver_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: driver/v1/driver.proto
+
+package driverv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/inngest/inngest/proto/gen/driver/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ExecutionServiceName is the fully-qualified name of the ExecutionService service.
+	ExecutionServiceName = "driver.v1.ExecutionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ExecutionServiceExecuteProcedure is the fully-qualified name of the ExecutionService's Execute
+	// RPC.
+	ExecutionServiceExecuteProcedure = "/driver.v1.ExecutionService/Execute"
+)
+
+// ExecutionServiceClient is a client for the driver.v1.ExecutionService service.
+type ExecutionServiceClient interface {
+	Execute(context.Context, *connect.Request[v1.ExecuteRequest]) (*connect.Response[v1.ExecuteResponse], error)
+}
+
+// NewExecutionServiceClient constructs a client for the driver.v1.ExecutionService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewExecutionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ExecutionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	executionServiceMethods := v1.File_driver_v1_driver_proto.Services().ByName("ExecutionService").Methods()
+	return &executionServiceClient{
+		execute: connect.NewClient[v1.ExecuteRequest, v1.ExecuteResponse](
+			httpClient,
+			baseURL+ExecutionServiceExecuteProcedure,
+			connect.WithSchema(executionServiceMethods.ByName("Execute")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// executionServiceClient implements ExecutionServiceClient.
+type executionServiceClient struct {
+	execute *connect.Client[v1.ExecuteRequest, v1.ExecuteResponse]
+}
+
+// Execute calls driver.v1.ExecutionService.Execute.
+func (c *executionServiceClient) Execute(ctx context.Context, req *connect.Request[v1.ExecuteRequest]) (*connect.Response[v1.ExecuteResponse], error) {
+	return c.execute.CallUnary(ctx, req)
+}
+
+// ExecutionServiceHandler is an implementation of the driver.v1.ExecutionService service.
+type ExecutionServiceHandler interface {
+	Execute(context.Context, *connect.Request[v1.ExecuteRequest]) (*connect.Response[v1.ExecuteResponse], error)
+}
+
+// NewExecutionServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewExecutionServiceHandler(svc ExecutionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	executionServiceMethods := v1.File_driver_v1_driver_proto.Services().ByName("ExecutionService").Methods()
+	executionServiceExecuteHandler := connect.NewUnaryHandler(
+		ExecutionServiceExecuteProcedure,
+		svc.Execute,
+		connect.WithSchema(executionServiceMethods.ByName("Execute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/driver.v1.ExecutionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ExecutionServiceExecuteProcedure:
+			executionServiceExecuteHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedExecutionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedExecutionServiceHandler struct{}
+
+func (UnimplementedExecutionServiceHandler) Execute(context.Context, *connect.Request[v1.ExecuteRequest]) (*connect.Response[v1.ExecuteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.ExecutionService.Execute is not implemented"))
+}
@@ -25,6 +25,7 @@ type DequeueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShardName     string                 `protobuf:"bytes,1,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
 	Item          *QueueItem             `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Opts          *DequeueOptions        `protobuf:"bytes,3,opt,name=opts,proto3" json:"opts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +74,13 @@ func (x *DequeueRequest) GetItem() *QueueItem {
 	return nil
 }
 
+func (x *DequeueRequest) GetOpts() *DequeueOptions {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
 type DequeueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -109,16 +117,72 @@ func (*DequeueResponse) Descriptor() ([]byte, []int) {
 	return file_queue_v1_consumer_proto_rawDescGZIP(), []int{1}
 }
 
+type DequeueOptions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RequireUnleased    bool                   `protobuf:"varint,1,opt,name=require_unleased,json=requireUnleased,proto3" json:"require_unleased,omitempty"`
+	DisableIdempotency bool                   `protobuf:"varint,2,opt,name=disable_idempotency,json=disableIdempotency,proto3" json:"disable_idempotency,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DequeueOptions) Reset() {
+	*x = DequeueOptions{}
+	mi := &file_queue_v1_consumer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DequeueOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueOptions) ProtoMessage() {}
+
+func (x *DequeueOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_v1_consumer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueOptions.ProtoReflect.Descriptor instead.
+func (*DequeueOptions) Descriptor() ([]byte, []int) {
+	return file_queue_v1_consumer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DequeueOptions) GetRequireUnleased() bool {
+	if x != nil {
+		return x.RequireUnleased
+	}
+	return false
+}
+
+func (x *DequeueOptions) GetDisableIdempotency() bool {
+	if x != nil {
+		return x.DisableIdempotency
+	}
+	return false
+}
+
 var File_queue_v1_consumer_proto protoreflect.FileDescriptor
 
 const file_queue_v1_consumer_proto_rawDesc = "" +
 	"\n" +
-	"\x17queue/v1/consumer.proto\x12\bqueue.v1\x1a\x14queue/v1/types.proto\"X\n" +
+	"\x17queue/v1/consumer.proto\x12\bqueue.v1\x1a\x14queue/v1/types.proto\"\x86\x01\n" +
 	"\x0eDequeueRequest\x12\x1d\n" +
 	"\n" +
 	"shard_name\x18\x01 \x01(\tR\tshardName\x12'\n" +
-	"\x04item\x18\x02 \x01(\v2\x13.queue.v1.QueueItemR\x04item\"\x11\n" +
-	"\x0fDequeueResponse2S\n" +
+	"\x04item\x18\x02 \x01(\v2\x13.queue.v1.QueueItemR\x04item\x12,\n" +
+	"\x04opts\x18\x03 \x01(\v2\x18.queue.v1.DequeueOptionsR\x04opts\"\x11\n" +
+	"\x0fDequeueResponse\"l\n" +
+	"\x0eDequeueOptions\x12)\n" +
+	"\x10require_unleased\x18\x01 \x01(\bR\x0frequireUnleased\x12/\n" +
+	"\x13disable_idempotency\x18\x02 \x01(\bR\x12disableIdempotency2S\n" +
 	"\x0fConsumerService\x12@\n" +
 	"\aDequeue\x12\x18.queue.v1.DequeueRequest\x1a\x19.queue.v1.DequeueResponse\"\x00B5Z3github.com/inngest/inngest/proto/gen/queue/v1;queueb\x06proto3"
 
@@ -134,21 +198,23 @@ func file_queue_v1_consumer_proto_rawDescGZIP() []byte {
 	return file_queue_v1_consumer_proto_rawDescData
 }
 
-var file_queue_v1_consumer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_queue_v1_consumer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_queue_v1_consumer_proto_goTypes = []any{
 	(*DequeueRequest)(nil),  // 0: queue.v1.DequeueRequest
 	(*DequeueResponse)(nil), // 1: queue.v1.DequeueResponse
-	(*QueueItem)(nil),       // 2: queue.v1.QueueItem
+	(*DequeueOptions)(nil),  // 2: queue.v1.DequeueOptions
+	(*QueueItem)(nil),       // 3: queue.v1.QueueItem
 }
 var file_queue_v1_consumer_proto_depIdxs = []int32{
-	2, // 0: queue.v1.DequeueRequest.item:type_name -> queue.v1.QueueItem
-	0, // 1: queue.v1.ConsumerService.Dequeue:input_type -> queue.v1.DequeueRequest
-	1, // 2: queue.v1.ConsumerService.Dequeue:output_type -> queue.v1.DequeueResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: queue.v1.DequeueRequest.item:type_name -> queue.v1.QueueItem
+	2, // 1: queue.v1.DequeueRequest.opts:type_name -> queue.v1.DequeueOptions
+	0, // 2: queue.v1.ConsumerService.Dequeue:input_type -> queue.v1.DequeueRequest
+	1, // 3: queue.v1.ConsumerService.Dequeue:output_type -> queue.v1.DequeueResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_queue_v1_consumer_proto_init() }
@@ -163,7 +229,7 @@ func file_queue_v1_consumer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_queue_v1_consumer_proto_rawDesc), len(file_queue_v1_consumer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -124,6 +124,7 @@ type RequeueRequest struct {
 	ShardName     string                 `protobuf:"bytes,1,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
 	Item          *QueueItem             `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
 	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Opts          *RequeueOptions        `protobuf:"bytes,4,opt,name=opts,proto3" json:"opts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,6 +180,13 @@ func (x *RequeueRequest) GetAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *RequeueRequest) GetOpts() *RequeueOptions {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
 type RequeueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -387,6 +395,50 @@ func (x *EnqueueOptions) GetIdempotencyPeriod() *durationpb.Duration {
 	return nil
 }
 
+type RequeueOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequireUnleased bool                   `protobuf:"varint,1,opt,name=require_unleased,json=requireUnleased,proto3" json:"require_unleased,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequeueOptions) Reset() {
+	*x = RequeueOptions{}
+	mi := &file_queue_v1_producer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueOptions) ProtoMessage() {}
+
+func (x *RequeueOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_v1_producer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueOptions.ProtoReflect.Descriptor instead.
+func (*RequeueOptions) Descriptor() ([]byte, []int) {
+	return file_queue_v1_producer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequeueOptions) GetRequireUnleased() bool {
+	if x != nil {
+		return x.RequireUnleased
+	}
+	return false
+}
+
 var File_queue_v1_producer_proto protoreflect.FileDescriptor
 
 const file_queue_v1_producer_proto_rawDesc = "" +
@@ -396,12 +448,13 @@ const file_queue_v1_producer_proto_rawDesc = "" +
 	"\x04item\x18\x01 \x01(\v2\x0e.queue.v1.ItemR\x04item\x12*\n" +
 	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12,\n" +
 	"\x04opts\x18\x03 \x01(\v2\x18.queue.v1.EnqueueOptionsR\x04opts\"\x11\n" +
-	"\x0fEnqueueResponse\"\x84\x01\n" +
+	"\x0fEnqueueResponse\"\xb2\x01\n" +
 	"\x0eRequeueRequest\x12\x1d\n" +
 	"\n" +
 	"shard_name\x18\x01 \x01(\tR\tshardName\x12'\n" +
 	"\x04item\x18\x02 \x01(\v2\x13.queue.v1.QueueItemR\x04item\x12*\n" +
-	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\x11\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12,\n" +
+	"\x04opts\x18\x04 \x01(\v2\x18.queue.v1.RequeueOptionsR\x04opts\"\x11\n" +
 	"\x0fRequeueResponse\"\xa0\x01\n" +
 	"\x15RequeueByJobIDRequest\x12\x1d\n" +
 	"\n" +
@@ -415,7 +468,9 @@ const file_queue_v1_producer_proto_rawDesc = "" +
 	"\x16force_queue_shard_name\x18\x02 \x01(\tR\x13forceQueueShardName\x129\n" +
 	"\x19normalize_from_backlog_id\x18\x03 \x01(\tR\x16normalizeFromBacklogId\x12M\n" +
 	"\x12idempotency_period\x18\x04 \x01(\v2\x19.google.protobuf.DurationH\x00R\x11idempotencyPeriod\x88\x01\x01B\x15\n" +
-	"\x13_idempotency_period2\xec\x01\n" +
+	"\x13_idempotency_period\";\n" +
+	"\x0eRequeueOptions\x12)\n" +
+	"\x10require_unleased\x18\x01 \x01(\bR\x0frequireUnleased2\xec\x01\n" +
 	"\x0fProducerService\x12@\n" +
 	"\aEnqueue\x12\x18.queue.v1.EnqueueRequest\x1a\x19.queue.v1.EnqueueResponse\"\x00\x12@\n" +
 	"\aRequeue\x12\x18.queue.v1.RequeueRequest\x1a\x19.queue.v1.RequeueResponse\"\x00\x12U\n" +
@@ -433,7 +488,7 @@ func file_queue_v1_producer_proto_rawDescGZIP() []byte {
 	return file_queue_v1_producer_proto_rawDescData
 }
 
-var file_queue_v1_producer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_queue_v1_producer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_queue_v1_producer_proto_goTypes = []any{
 	(*EnqueueRequest)(nil),         // 0: queue.v1.EnqueueRequest
 	(*EnqueueResponse)(nil),        // 1: queue.v1.EnqueueResponse
@@ -442,32 +497,34 @@ var file_queue_v1_producer_proto_goTypes = []any{
 	(*RequeueByJobIDRequest)(nil),  // 4: queue.v1.RequeueByJobIDRequest
 	(*RequeueByJobIDResponse)(nil), // 5: queue.v1.RequeueByJobIDResponse
 	(*EnqueueOptions)(nil),         // 6: queue.v1.EnqueueOptions
-	(*Item)(nil),                   // 7: queue.v1.Item
-	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
-	(*QueueItem)(nil),              // 9: queue.v1.QueueItem
-	(*Scope)(nil),                  // 10: queue.v1.Scope
-	(*durationpb.Duration)(nil),    // 11: google.protobuf.Duration
+	(*RequeueOptions)(nil),         // 7: queue.v1.RequeueOptions
+	(*Item)(nil),                   // 8: queue.v1.Item
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*QueueItem)(nil),              // 10: queue.v1.QueueItem
+	(*Scope)(nil),                  // 11: queue.v1.Scope
+	(*durationpb.Duration)(nil),    // 12: google.protobuf.Duration
 }
 var file_queue_v1_producer_proto_depIdxs = []int32{
-	7,  // 0: queue.v1.EnqueueRequest.item:type_name -> queue.v1.Item
-	8,  // 1: queue.v1.EnqueueRequest.at:type_name -> google.protobuf.Timestamp
+	8,  // 0: queue.v1.EnqueueRequest.item:type_name -> queue.v1.Item
+	9,  // 1: queue.v1.EnqueueRequest.at:type_name -> google.protobuf.Timestamp
 	6,  // 2: queue.v1.EnqueueRequest.opts:type_name -> queue.v1.EnqueueOptions
-	9,  // 3: queue.v1.RequeueRequest.item:type_name -> queue.v1.QueueItem
-	8,  // 4: queue.v1.RequeueRequest.at:type_name -> google.protobuf.Timestamp
-	8,  // 5: queue.v1.RequeueByJobIDRequest.at:type_name -> google.protobuf.Timestamp
-	10, // 6: queue.v1.RequeueByJobIDRequest.scope:type_name -> queue.v1.Scope
-	11, // 7: queue.v1.EnqueueOptions.idempotency_period:type_name -> google.protobuf.Duration
-	0,  // 8: queue.v1.ProducerService.Enqueue:input_type -> queue.v1.EnqueueRequest
-	2,  // 9: queue.v1.ProducerService.Requeue:input_type -> queue.v1.RequeueRequest
-	4,  // 10: queue.v1.ProducerService.RequeueByJobID:input_type -> queue.v1.RequeueByJobIDRequest
-	1,  // 11: queue.v1.ProducerService.Enqueue:output_type -> queue.v1.EnqueueResponse
-	3,  // 12: queue.v1.ProducerService.Requeue:output_type -> queue.v1.RequeueResponse
-	5,  // 13: queue.v1.ProducerService.RequeueByJobID:output_type -> queue.v1.RequeueByJobIDResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	10, // 3: queue.v1.RequeueRequest.item:type_name -> queue.v1.QueueItem
+	9,  // 4: queue.v1.RequeueRequest.at:type_name -> google.protobuf.Timestamp
+	7,  // 5: queue.v1.RequeueRequest.opts:type_name -> queue.v1.RequeueOptions
+	9,  // 6: queue.v1.RequeueByJobIDRequest.at:type_name -> google.protobuf.Timestamp
+	11, // 7: queue.v1.RequeueByJobIDRequest.scope:type_name -> queue.v1.Scope
+	12, // 8: queue.v1.EnqueueOptions.idempotency_period:type_name -> google.protobuf.Duration
+	0,  // 9: queue.v1.ProducerService.Enqueue:input_type -> queue.v1.EnqueueRequest
+	2,  // 10: queue.v1.ProducerService.Requeue:input_type -> queue.v1.RequeueRequest
+	4,  // 11: queue.v1.ProducerService.RequeueByJobID:input_type -> queue.v1.RequeueByJobIDRequest
+	1,  // 12: queue.v1.ProducerService.Enqueue:output_type -> queue.v1.EnqueueResponse
+	3,  // 13: queue.v1.ProducerService.Requeue:output_type -> queue.v1.RequeueResponse
+	5,  // 14: queue.v1.ProducerService.RequeueByJobID:output_type -> queue.v1.RequeueByJobIDResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_queue_v1_producer_proto_init() }
@@ -483,7 +540,7 @@ func file_queue_v1_producer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_queue_v1_producer_proto_rawDesc), len(file_queue_v1_producer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -697,6 +697,7 @@ type CustomConcurrency struct {
 	Hash                      string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	Limit                     int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	UnhashedEvaluatedKeyValue string                 `protobuf:"bytes,4,opt,name=unhashed_evaluated_key_value,json=unhashedEvaluatedKeyValue,proto3" json:"unhashed_evaluated_key_value,omitempty"`
+	Weight                    int64                  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
@@ -759,6 +760,13 @@ func (x *CustomConcurrency) GetUnhashedEvaluatedKeyValue() string {
 	return ""
 }
 
+func (x *CustomConcurrency) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 type Semaphore struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1166,12 +1174,13 @@ const file_queue_v1_types_proto_rawDesc = "" +
 	"\x10_original_run_idB\f\n" +
 	"\n" +
 	"_replay_idB\x12\n" +
-	"\x10_priority_factor\"\x90\x01\n" +
+	"\x10_priority_factor\"\xa8\x01\n" +
 	"\x11CustomConcurrency\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12?\n" +
-	"\x1cunhashed_evaluated_key_value\x18\x04 \x01(\tR\x19unhashedEvaluatedKeyValue\"\x9b\x01\n" +
+	"\x1cunhashed_evaluated_key_value\x18\x04 \x01(\tR\x19unhashedEvaluatedKeyValue\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x03R\x06weight\"\x9b\x01\n" +
 	"\tSemaphore\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
 	"\x12evaluated_key_hash\x18\x02 \x01(\tR\x10evaluatedKeyHash\x12\x16\n" +
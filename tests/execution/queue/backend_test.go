@@ -0,0 +1,98 @@
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	dbpostgres "github.com/inngest/inngest/pkg/db/postgres"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state"
+	"github.com/inngest/inngest/pkg/execution/state/redis_state"
+	"github.com/inngest/inngest/tests/testutil"
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/require"
+)
+
+// queueBackend is a queue shard implementation which shard tests run against.
+type queueBackend struct {
+	name string
+	// leaseConstraints is whether the backend enforces concurrency constraints
+	// when leasing without a capacity manager.
+	leaseConstraints bool
+	// newShard creates a shard.  rc is the Redis client used by the Redis
+	// backend.
+	newShard func(t *testing.T, rc rueidis.Client, opts ...queue.QueueOpt) queue.QueueShard
+}
+
+var queueBackends = []queueBackend{
+	{
+		name: "redis",
+		newShard: func(t *testing.T, rc rueidis.Client, opts ...queue.QueueOpt) queue.QueueShard {
+			queueClient := redis_state.NewQueueClient(rc, redis_state.QueueDefaultKey)
+			return redis_state.NewQueueShard("test", queueClient, opts...)
+		},
+	},
+	{
+		name:             "postgres",
+		leaseConstraints: true,
+		newShard: func(t *testing.T, rc rueidis.Client, opts ...queue.QueueOpt) queue.QueueShard {
+			return postgres_state.NewQueueShard("test", newPostgresTestDB(t), opts...)
+		},
+	},
+}
+
+// forEachBackend runs f as a subtest for each queue backend.
+func forEachBackend(t *testing.T, f func(t *testing.T, b queueBackend)) {
+	for _, b := range queueBackends {
+		t.Run(b.name, func(t *testing.T) {
+			f(t, b)
+		})
+	}
+}
+
+// newPostgresTestDB starts a Postgres container with all migrations applied.
+// Tests are skipped unless TEST_DATABASE=postgres.
+func newPostgresTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	if os.Getenv("TEST_DATABASE") != "postgres" {
+		t.Skip("set TEST_DATABASE=postgres to run postgres queue tests")
+	}
+
+	pc, err := testutil.StartPostgres(t)
+	require.NoError(t, err)
+
+	db, err := dbpostgres.Open(t.Context(), dbpostgres.Options{
+		URI:     pc.URI,
+		ForTest: true,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Close()
+		_ = pc.Terminate(context.Background())
+	})
+	return db
+}
+
+func testQueueItem(accountID, envID, fnID uuid.UUID, runID ulid.ULID) queue.QueueItem {
+	return queue.QueueItem{
+		FunctionID:  fnID,
+		WorkspaceID: envID,
+		Data: queue.Item{
+			WorkspaceID: envID,
+			Kind:        queue.KindStart,
+			Identifier: state.Identifier{
+				AccountID:   accountID,
+				WorkspaceID: envID,
+				WorkflowID:  fnID,
+				RunID:       runID,
+			},
+		},
+	}
+}
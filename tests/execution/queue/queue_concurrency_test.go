@@ -25,6 +25,10 @@ import (
 )
 
 func TestQueuePartitionConcurrency(t *testing.T) {
+	forEachBackend(t, testQueuePartitionConcurrency)
+}
+
+func testQueuePartitionConcurrency(t *testing.T, b queueBackend) {
 	r := miniredis.RunT(t)
 
 	rc, err := rueidis.NewClient(rueidis.ClientOption{
@@ -96,7 +100,7 @@ func TestQueuePartitionConcurrency(t *testing.T) {
 		osqueue.WithAcquireCapacityLeaseOnBacklogRefill(true),
 	)
 
-	shard1 := redis_state.NewQueueShard(consts.DefaultQueueShardName, redis_state.NewQueueClient(rc, redis_state.QueueDefaultKey), opts...)
+	shard1 := b.newShard(t, rc, opts...)
 
 	shardRegistry, err := osqueue.NewSingleShardRegistry(shard1)
 	require.NoError(t, err)
@@ -188,6 +192,61 @@ func TestQueuePartitionConcurrency(t *testing.T) {
 	require.Less(t, int(diff), 40, "10 jobs should have taken fewer than 40 seconds") // an extra 2x latency due to race checker
 }
 
+// TestQueuePartitionConstraints asserts that shards which enforce constraints
+// when leasing, rather than via a capacity manager, respect concurrency limits.
+func TestQueuePartitionConstraints(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b queueBackend) {
+		if !b.leaseConstraints {
+			t.Skip("backend does not enforce constraints when leasing")
+		}
+
+		r := miniredis.RunT(t)
+		rc, err := rueidis.NewClient(rueidis.ClientOption{
+			InitAddress:  []string{r.Addr()},
+			DisableCache: true,
+		})
+		require.NoError(t, err)
+		defer rc.Close()
+
+		ctx := context.Background()
+		clock := clockwork.NewFakeClock()
+
+		accountID, envID, fnID := uuid.New(), uuid.New(), uuid.New()
+
+		shard := b.newShard(t, rc,
+			osqueue.WithClock(clock),
+			osqueue.WithPartitionConstraintConfigGetter(func(ctx context.Context, p osqueue.PartitionIdentifier) osqueue.PartitionConstraintConfig {
+				return osqueue.PartitionConstraintConfig{
+					FunctionVersion: 1,
+					Concurrency: osqueue.PartitionConcurrency{
+						AccountConcurrency:  consts.DefaultConcurrencyLimit,
+						FunctionConcurrency: 1,
+					},
+				}
+			}),
+		)
+
+		items := make([]osqueue.QueueItem, 2)
+		for i := range items {
+			runID := ulid.MustNew(ulid.Timestamp(clock.Now()), rand.Reader)
+			qi, err := shard.EnqueueItem(ctx, testQueueItem(accountID, envID, fnID, runID), clock.Now(), osqueue.EnqueueOpts{})
+			require.NoError(t, err)
+			items[i] = qi
+		}
+
+		_, err = shard.Lease(ctx, items[0], 10*time.Second, clock.Now())
+		require.NoError(t, err)
+
+		_, err = shard.Lease(ctx, items[1], 10*time.Second, clock.Now())
+		require.ErrorIs(t, err, osqueue.ErrPartitionConcurrencyLimit)
+
+		require.NoError(t, shard.Dequeue(ctx, items[0]))
+
+		_, err = shard.Lease(ctx, items[1], 10*time.Second, clock.Now())
+		require.NoError(t, err)
+	})
+}
+
 func TestKeyQueueConcurrencyOneDoesNotOverlapSteps(t *testing.T) {
 	ctx := context.Background()
 
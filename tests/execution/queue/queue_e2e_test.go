@@ -12,7 +12,6 @@ import (
 	"github.com/inngest/inngest/pkg/consts"
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/state"
-	"github.com/inngest/inngest/pkg/execution/state/redis_state"
 	"github.com/inngest/inngest/pkg/logger"
 	"github.com/inngest/inngest/pkg/telemetry/trace"
 	"github.com/jonboulle/clockwork"
@@ -22,17 +21,20 @@ import (
 	"golang.org/x/sync/semaphore"
 )
 
-func TestQueueE2E(t *testing.T) {
-	accountID, workspaceID := uuid.New(), uuid.New()
+type queueE2ECase struct {
+	name         string
+	numItems     int
+	numFunctions int
+	interval     time.Duration
+	concurrency  int
+	// capacityManager is whether constraints are checked by a capacity
+	// manager rather than by the shard when leasing.
+	capacityManager bool
+	queueOptions    []queue.QueueOpt
+}
 
-	cases := []struct {
-		name         string
-		numItems     int
-		numFunctions int
-		interval     time.Duration
-		concurrency  int
-		queueOptions []queue.QueueOpt
-	}{
+func TestQueueE2E(t *testing.T) {
+	cases := []queueE2ECase{
 		{
 			name:         "basic test",
 			numItems:     10,
@@ -90,10 +92,11 @@ func TestQueueE2E(t *testing.T) {
 			},
 		},
 		{
-			name:         "with capacity manager and key queues",
-			numItems:     10,
-			numFunctions: 1,
-			concurrency:  1,
+			name:            "with capacity manager and key queues",
+			numItems:        10,
+			numFunctions:    1,
+			concurrency:     1,
+			capacityManager: true,
 			queueOptions: []queue.QueueOpt{
 				queue.WithRunMode(
 					queue.QueueRunMode{
@@ -118,10 +121,11 @@ func TestQueueE2E(t *testing.T) {
 			},
 		},
 		{
-			name:         "with capacity manager",
-			numItems:     10,
-			numFunctions: 1,
-			concurrency:  1,
+			name:            "with capacity manager",
+			numItems:        10,
+			numFunctions:    1,
+			concurrency:     1,
+			capacityManager: true,
 			queueOptions: []queue.QueueOpt{
 				queue.WithRunMode(
 					queue.QueueRunMode{
@@ -147,156 +151,165 @@ func TestQueueE2E(t *testing.T) {
 		},
 	}
 
-	timeTick := 100 * time.Millisecond
-	timeMultiplier := 1
-
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			ctx := context.Background()
-			err := trace.NewSystemTracer(ctx, trace.TracerOpts{
-				ServiceName:   "tracing-system",
-				TraceEndpoint: "localhost:4318",
-				Type:          trace.TracerTypeOTLPHTTP,
+			forEachBackend(t, func(t *testing.T, b queueBackend) {
+				testQueueE2E(t, b, tc)
 			})
-			require.NoError(t, err)
-			defer func() {
-				_ = trace.CloseSystemTracer(ctx)
-			}()
-
-			tracer := trace.NewConditionalTracer(trace.QueueTracer(), trace.AlwaysTrace)
+		})
+	}
+}
 
-			l := logger.StdlibLogger(ctx, logger.WithLoggerLevel(logger.LevelDebug))
-			ctx = logger.WithStdlib(ctx, l)
+func testQueueE2E(t *testing.T, b queueBackend, tc queueE2ECase) {
+	accountID, workspaceID := uuid.New(), uuid.New()
 
-			ctx, cancel := context.WithCancel(ctx)
-			defer cancel()
+	timeTick := 100 * time.Millisecond
+	timeMultiplier := 1
 
-			r := miniredis.RunT(t)
-			rc, err := rueidis.NewClient(rueidis.ClientOption{
-				InitAddress:  []string{r.Addr()},
-				DisableCache: true,
-			})
-			require.NoError(t, err)
-			defer rc.Close()
+	ctx := context.Background()
+	err := trace.NewSystemTracer(ctx, trace.TracerOpts{
+		ServiceName:   "tracing-system",
+		TraceEndpoint: "localhost:4318",
+		Type:          trace.TracerTypeOTLPHTTP,
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = trace.CloseSystemTracer(ctx)
+	}()
 
-			clock := clockwork.NewFakeClock()
-			go func() {
-				for {
-					select {
-					case <-ctx.Done():
-						return
-					case <-time.Tick(timeTick):
-						clock.Advance(time.Duration(timeMultiplier) * timeTick)
-						r.FastForward(time.Duration(timeMultiplier) * timeTick)
-						r.SetTime(clock.Now())
-					}
-				}
-			}()
+	tracer := trace.NewConditionalTracer(trace.QueueTracer(), trace.AlwaysTrace)
 
-			fnIDs := make([]uuid.UUID, tc.numFunctions)
-			for i := range fnIDs {
-				fnIDs[i] = uuid.New()
-			}
+	l := logger.StdlibLogger(ctx, logger.WithLoggerLevel(logger.LevelDebug))
+	ctx = logger.WithStdlib(ctx, l)
 
-			options := append([]queue.QueueOpt{
-				queue.WithClock(clock),
-				queue.WithConditionalTracer(tracer),
-				queue.WithPartitionConstraintConfigGetter(func(ctx context.Context, p queue.PartitionIdentifier) queue.PartitionConstraintConfig {
-					return queue.PartitionConstraintConfig{
-						FunctionVersion: 1,
-						Concurrency: queue.PartitionConcurrency{
-							SystemConcurrency:   consts.DefaultConcurrencyLimit,
-							AccountConcurrency:  consts.DefaultConcurrencyLimit,
-							FunctionConcurrency: consts.DefaultConcurrencyLimit,
-						},
-					}
-				}),
-			}, tc.queueOptions...)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-			cm, err := constraintapi.NewRedisCapacityManager(
-				constraintapi.WithClient(rc),
-				constraintapi.WithShardName("test"),
-				constraintapi.WithClock(clock),
-				constraintapi.WithEnableDebugLogs(true),
-			)
-			require.NoError(t, err)
+	r := miniredis.RunT(t)
+	rc, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{r.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+	defer rc.Close()
 
-			options = append(options, queue.WithCapacityManager(cm))
-			options = append(options,
-				queue.WithAcquireCapacityLeaseOnBacklogRefill(true),
-			)
+	clock := clockwork.NewFakeClock()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.Tick(timeTick):
+				clock.Advance(time.Duration(timeMultiplier) * timeTick)
+				r.FastForward(time.Duration(timeMultiplier) * timeTick)
+				r.SetTime(clock.Now())
+			}
+		}
+	}()
 
-			queueClient := redis_state.NewQueueClient(rc, redis_state.QueueDefaultKey)
-			shard := redis_state.NewQueueShard("test", queueClient, options...)
+	fnIDs := make([]uuid.UUID, tc.numFunctions)
+	for i := range fnIDs {
+		fnIDs[i] = uuid.New()
+	}
 
-			shardRegistry, err := queue.NewSingleShardRegistry(shard)
-			require.NoError(t, err)
-			q, err := queue.New(ctx, "test", shardRegistry, options...)
-			require.NoError(t, err)
+	options := append([]queue.QueueOpt{
+		queue.WithClock(clock),
+		queue.WithConditionalTracer(tracer),
+		queue.WithPartitionConstraintConfigGetter(func(ctx context.Context, p queue.PartitionIdentifier) queue.PartitionConstraintConfig {
+			return queue.PartitionConstraintConfig{
+				FunctionVersion: 1,
+				Concurrency: queue.PartitionConcurrency{
+					SystemConcurrency:   consts.DefaultConcurrencyLimit,
+					AccountConcurrency:  consts.DefaultConcurrencyLimit,
+					FunctionConcurrency: consts.DefaultConcurrencyLimit,
+				},
+			}
+		}),
+	}, tc.queueOptions...)
 
-			sem := pool.New().
-				WithErrors().WithFirstError().WithMaxGoroutines(tc.concurrency)
+	if tc.capacityManager {
+		cm, err := constraintapi.NewRedisCapacityManager(
+			constraintapi.WithClient(rc),
+			constraintapi.WithShardName("test"),
+			constraintapi.WithClock(clock),
+			constraintapi.WithEnableDebugLogs(true),
+		)
+		require.NoError(t, err)
 
-			// Start enqueueing
-			go func() {
-				for i := range tc.numItems {
-					fnID := fnIDs[i%len(fnIDs)]
+		options = append(options, queue.WithCapacityManager(cm))
+		options = append(options,
+			queue.WithAcquireCapacityLeaseOnBacklogRefill(true),
+		)
+	}
 
-					sem.Go(func() error {
-						at := clock.Now().Add(time.Duration(i) * tc.interval)
-						jobID := fmt.Sprintf("item%d", i)
-						err := q.Enqueue(ctx, queue.Item{
-							JobID:       &jobID,
-							WorkspaceID: workspaceID,
-							Identifier: state.Identifier{
-								AccountID:   accountID,
-								WorkspaceID: workspaceID,
-								WorkflowID:  fnID,
-							},
-							Kind: queue.KindStart,
-						}, at, queue.EnqueueOpts{
-							PassthroughJobId: true,
-						})
-						return err
-					})
-				}
-			}()
+	shard := b.newShard(t, rc, options...)
 
-			// Immediately acquire all capacity
-			// When this hits 0, we can quit (all items are processed)
-			waitUntilCompleted := semaphore.NewWeighted(int64(tc.numItems))
-			require.NoError(t, waitUntilCompleted.Acquire(ctx, int64(tc.numItems)))
+	shardRegistry, err := queue.NewSingleShardRegistry(shard)
+	require.NoError(t, err)
+	q, err := queue.New(ctx, "test", shardRegistry, options...)
+	require.NoError(t, err)
 
-			// Start running
-			go func() {
-				err := q.Run(ctx, func(ctx context.Context, ri queue.RunInfo, i queue.Item) (queue.RunResult, error) {
-					l.Debug("completed", "id", *i.JobID)
+	sem := pool.New().
+		WithErrors().WithFirstError().WithMaxGoroutines(tc.concurrency)
 
-					// Decrease in-progress semaphore
-					waitUntilCompleted.Release(1)
+	// Start enqueueing
+	go func() {
+		for i := range tc.numItems {
+			fnID := fnIDs[i%len(fnIDs)]
 
-					return queue.RunResult{}, nil
+			sem.Go(func() error {
+				at := clock.Now().Add(time.Duration(i) * tc.interval)
+				jobID := fmt.Sprintf("item%d", i)
+				err := q.Enqueue(ctx, queue.Item{
+					JobID:       &jobID,
+					WorkspaceID: workspaceID,
+					Identifier: state.Identifier{
+						AccountID:   accountID,
+						WorkspaceID: workspaceID,
+						WorkflowID:  fnID,
+					},
+					Kind: queue.KindStart,
+				}, at, queue.EnqueueOpts{
+					PassthroughJobId: true,
 				})
-				require.NoError(t, err)
+				return err
+			})
+		}
+	}()
 
-				// Wait until all enqueues finished
-				err = sem.Wait()
-				require.NoError(t, err)
-			}()
+	// Immediately acquire all capacity
+	// When this hits 0, we can quit (all items are processed)
+	waitUntilCompleted := semaphore.NewWeighted(int64(tc.numItems))
+	require.NoError(t, waitUntilCompleted.Acquire(ctx, int64(tc.numItems)))
 
-			// Wait until completed
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-time.After(1 * time.Second):
-				}
+	// Start running
+	go func() {
+		err := q.Run(ctx, func(ctx context.Context, ri queue.RunInfo, i queue.Item) (queue.RunResult, error) {
+			l.Debug("completed", "id", *i.JobID)
 
-				if waitUntilCompleted.TryAcquire(int64(tc.numItems)) {
-					// Stop the worker
-					break
-				}
-			}
+			// Decrease in-progress semaphore
+			waitUntilCompleted.Release(1)
+
+			return queue.RunResult{}, nil
 		})
+		require.NoError(t, err)
+
+		// Wait until all enqueues finished
+		err = sem.Wait()
+		require.NoError(t, err)
+	}()
+
+	// Wait until completed
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(1 * time.Second):
+		}
+
+		if waitUntilCompleted.TryAcquire(int64(tc.numItems)) {
+			// Stop the worker
+			break
+		}
 	}
 }
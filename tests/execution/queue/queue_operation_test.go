@@ -11,8 +11,6 @@ import (
 	"github.com/inngest/inngest/pkg/constraintapi"
 	"github.com/inngest/inngest/pkg/consts"
 	"github.com/inngest/inngest/pkg/execution/queue"
-	"github.com/inngest/inngest/pkg/execution/state"
-	"github.com/inngest/inngest/pkg/execution/state/redis_state"
 	"github.com/inngest/inngest/pkg/logger"
 	"github.com/jonboulle/clockwork"
 	"github.com/oklog/ulid/v2"
@@ -21,6 +19,10 @@ import (
 )
 
 func TestQueueOperations(t *testing.T) {
+	forEachBackend(t, testQueueOperations)
+}
+
+func testQueueOperations(t *testing.T, b queueBackend) {
 	ctx := context.Background()
 
 	l := logger.StdlibLogger(ctx, logger.WithLoggerLevel(logger.LevelDebug))
@@ -70,25 +72,11 @@ func TestQueueOperations(t *testing.T) {
 		queue.WithAcquireCapacityLeaseOnBacklogRefill(true),
 	)
 
-	queueClient := redis_state.NewQueueClient(rc, redis_state.QueueDefaultKey)
-	shard := redis_state.NewQueueShard("test", queueClient, options...)
+	shard := b.newShard(t, rc, options...)
 
 	var item *queue.QueueItem
 	t.Run("EnqueueItem", func(t *testing.T) {
-		qi, err := shard.EnqueueItem(ctx, queue.QueueItem{
-			FunctionID:  fnID,
-			WorkspaceID: envID,
-			Data: queue.Item{
-				WorkspaceID: envID,
-				Kind:        queue.KindStart,
-				Identifier: state.Identifier{
-					AccountID:   accountID,
-					WorkspaceID: envID,
-					WorkflowID:  fnID,
-					RunID:       runID,
-				},
-			},
-		}, clock.Now(), queue.EnqueueOpts{})
+		qi, err := shard.EnqueueItem(ctx, testQueueItem(accountID, envID, fnID, runID), clock.Now(), queue.EnqueueOpts{})
 		require.NoError(t, err)
 
 		loaded, err := shard.LoadQueueItem(ctx, qi.ID)
@@ -97,6 +85,11 @@ func TestQueueOperations(t *testing.T) {
 		require.Equal(t, qi, *loaded)
 
 		item = loaded
+
+		t.Run("should not enqueue the same item twice", func(t *testing.T) {
+			_, err := shard.EnqueueItem(ctx, qi, clock.Now(), queue.EnqueueOpts{PassthroughJobId: true})
+			require.ErrorIs(t, err, queue.ErrQueueItemExists)
+		})
 	})
 
 	var partition *queue.QueuePartition
@@ -114,6 +107,12 @@ func TestQueueOperations(t *testing.T) {
 		partition = parts[0]
 	})
 
+	t.Run("AccountPeek", func(t *testing.T) {
+		accounts, err := shard.AccountPeek(ctx, true, clock.Now().Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{accountID}, accounts)
+	})
+
 	t.Run("PartitionLease", func(t *testing.T) {
 		leaseID, err := shard.PartitionLease(ctx, partition, 5*time.Second)
 		require.NoError(t, err)
@@ -126,6 +125,11 @@ func TestQueueOperations(t *testing.T) {
 		res, err := shard.PartitionByID(ctx, scope, partition.ID)
 		require.NoError(t, err)
 		require.Equal(t, partition, res.QueuePartition)
+
+		t.Run("should not be possible to lease again", func(t *testing.T) {
+			_, err := shard.PartitionLease(ctx, partition, 5*time.Second)
+			require.ErrorIs(t, err, queue.ErrPartitionAlreadyLeased)
+		})
 	})
 
 	t.Run("PartitionRequeue", func(t *testing.T) {
@@ -161,6 +165,12 @@ func TestQueueOperations(t *testing.T) {
 			require.Len(t, peeked, 0)
 		})
 
+		t.Run("should count the item as running", func(t *testing.T) {
+			count, err := shard.RunningCount(ctx, scope)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, count)
+		})
+
 		leaseID = lID
 	})
 
@@ -175,6 +185,11 @@ func TestQueueOperations(t *testing.T) {
 		require.NotNil(t, lID)
 		require.NotEqual(t, *leaseID, *lID)
 
+		t.Run("should not extend with a stale lease", func(t *testing.T) {
+			_, err := shard.ExtendLease(ctx, *item, *leaseID, 10*time.Second)
+			require.ErrorIs(t, err, queue.ErrQueueItemLeaseMismatch)
+		})
+
 		leaseID = lID
 	})
 
@@ -209,6 +224,25 @@ func TestQueueOperations(t *testing.T) {
 		})
 	})
 
+	t.Run("Scavenge", func(t *testing.T) {
+		_, err := shard.Lease(ctx, *item, time.Second, clock.Now())
+		require.NoError(t, err)
+
+		clock.Advance(2 * time.Second)
+		r.FastForward(2 * time.Second)
+		r.SetTime(clock.Now())
+
+		count, err := shard.Scavenge(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		loaded, err := shard.LoadQueueItem(ctx, item.ID)
+		require.NoError(t, err)
+		require.Nil(t, loaded.LeaseID)
+		require.Equal(t, 1, loaded.ScavengeCount)
+		item = loaded
+	})
+
 	t.Run("Dequeue", func(t *testing.T) {
 		lID, err := shard.Lease(ctx, *item, 10*time.Second, clock.Now())
 		require.NoError(t, err)
@@ -226,5 +260,34 @@ func TestQueueOperations(t *testing.T) {
 			require.NoError(t, err)
 			require.Len(t, peeked, 0)
 		})
+
+		t.Run("should garbage collect the partition", func(t *testing.T) {
+			err := shard.PartitionRequeue(ctx, partition, clock.Now(), false)
+			require.ErrorIs(t, err, queue.ErrPartitionGarbageCollected)
+		})
+	})
+
+	t.Run("RoleLease", func(t *testing.T) {
+		lID, err := shard.RoleLease(ctx, "scavenger", 5*time.Second)
+		require.NoError(t, err)
+
+		_, err = shard.RoleLease(ctx, "scavenger", 5*time.Second)
+		require.ErrorIs(t, err, queue.ErrRoleAlreadyLeased)
+
+		_, err = shard.RoleLease(ctx, "scavenger", 5*time.Second, lID)
+		require.NoError(t, err)
+	})
+
+	t.Run("ShardLease", func(t *testing.T) {
+		first, err := shard.ShardLease(ctx, "group", 5*time.Second, 1)
+		require.NoError(t, err)
+
+		_, err = shard.ShardLease(ctx, "group", 5*time.Second, 1)
+		require.ErrorIs(t, err, queue.ErrAllShardsAlreadyLeased)
+
+		require.NoError(t, shard.ReleaseShardLease(ctx, "group", *first))
+
+		_, err = shard.ShardLease(ctx, "group", 5*time.Second, 1)
+		require.NoError(t, err)
 	})
 }
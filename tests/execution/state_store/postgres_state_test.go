@@ -0,0 +1,339 @@
+package state_store
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	dbpostgres "github.com/inngest/inngest/pkg/db/postgres"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state"
+	"github.com/inngest/inngest/pkg/execution/state/testharness"
+	statev2 "github.com/inngest/inngest/pkg/execution/state/v2"
+	"github.com/inngest/inngest/tests/testutil"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// newPostgresTestDB starts a Postgres container with all migrations applied.
+// Tests are skipped unless TEST_DATABASE=postgres.
+func newPostgresTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	if os.Getenv("TEST_DATABASE") != "postgres" {
+		t.Skip("set TEST_DATABASE=postgres to run postgres state tests")
+	}
+
+	pc, err := testutil.StartPostgres(t)
+	require.NoError(t, err)
+
+	db, err := dbpostgres.Open(t.Context(), dbpostgres.Options{
+		URI:     pc.URI,
+		ForTest: true,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Close()
+		_ = pc.Terminate(context.Background())
+	})
+	return db
+}
+
+func TestPostgresStateHarness(t *testing.T) {
+	db := newPostgresTestDB(t)
+
+	sm, err := postgres_state.New(context.Background(), db)
+	require.NoError(t, err)
+
+	create := func() (state.Manager, func()) {
+		// Run IDs and idempotency keys are random per check, so there is
+		// nothing to clean up between runs.
+		return sm, func() {}
+	}
+
+	testharness.CheckState(t, create)
+}
+
+func TestPostgresRunServiceV2(t *testing.T) {
+	db := newPostgresTestDB(t)
+	ctx := context.Background()
+
+	sm, err := postgres_state.New(ctx, db)
+	require.NoError(t, err)
+	svc := postgres_state.MustRunServiceV2(sm)
+
+	newMetadata := func() statev2.Metadata {
+		return statev2.Metadata{
+			ID: statev2.ID{
+				RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+				FunctionID: uuid.New(),
+				Tenant: statev2.Tenant{
+					AccountID: uuid.New(),
+					EnvID:     uuid.New(),
+					AppID:     uuid.New(),
+				},
+			},
+			Config: *statev2.InitConfig(&statev2.Config{
+				Idempotency:    uuid.NewString(),
+				RequestVersion: 2,
+			}),
+		}
+	}
+	evt := json.RawMessage(`{"name":"test/event","data":{"ok":true}}`)
+
+	t.Run("Create, SaveStep and Delete round trip", func(t *testing.T) {
+		md := newMetadata()
+		created, err := svc.Create(ctx, statev2.CreateState{
+			Metadata: md,
+			Events:   []json.RawMessage{evt},
+		})
+		require.NoError(t, err)
+		require.Equal(t, md.ID.RunID, created.Metadata.ID.RunID)
+
+		require.NoError(t, svc.SavePending(ctx, md.ID, []string{"a", "b"}))
+
+		hasPending, err := svc.SaveStep(ctx, md.ID, "a", []byte(`{"data":1}`))
+		require.NoError(t, err)
+		require.True(t, hasPending)
+
+		// Saving the same data again is idempotent.
+		hasPending, err = svc.SaveStep(ctx, md.ID, "a", []byte(`{"data":1}`))
+		require.NoError(t, err)
+		require.True(t, hasPending)
+
+		hasPending, err = svc.SaveStep(ctx, md.ID, "b", []byte(`{"data":2}`))
+		require.NoError(t, err)
+		require.False(t, hasPending)
+
+		loaded, err := svc.LoadMetadata(ctx, md.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, loaded.Stack)
+		require.Equal(t, 2, loaded.Metrics.StepCount)
+		require.Equal(t, md.Config.Idempotency, loaded.Config.Idempotency)
+
+		// A second create with the same idempotency key returns the original run.
+		dupe := md
+		dupe.ID.RunID = ulid.MustNew(ulid.Now(), rand.Reader)
+		existing, err := svc.Create(ctx, statev2.CreateState{Metadata: dupe, Events: []json.RawMessage{evt}})
+		require.ErrorIs(t, err, state.ErrIdentifierExists)
+		require.Equal(t, md.ID.RunID, existing.Metadata.ID.RunID)
+
+		require.NoError(t, svc.Delete(ctx, md.ID))
+		exists, err := svc.Exists(ctx, md.ID)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		entry, err := svc.LookupIdempotency(ctx, md.ID, md.Config.Idempotency)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		require.True(t, entry.IsTombstone)
+		require.Equal(t, md.ID.RunID, entry.RunID)
+
+		_, err = svc.Create(ctx, statev2.CreateState{Metadata: dupe, Events: []json.RawMessage{evt}})
+		require.ErrorIs(t, err, state.ErrIdentifierTombstone)
+	})
+
+	t.Run("ConsumePause", func(t *testing.T) {
+		md := newMetadata()
+		_, err := svc.Create(ctx, statev2.CreateState{Metadata: md, Events: []json.RawMessage{evt}})
+		require.NoError(t, err)
+
+		pause := state.Pause{
+			ID: uuid.New(),
+			Identifier: state.PauseIdentifier{
+				RunID:      md.ID.RunID,
+				FunctionID: md.ID.FunctionID,
+				AccountID:  md.ID.Tenant.AccountID,
+			},
+			DataKey: "wait",
+		}
+
+		res, err := svc.ConsumePause(ctx, pause, state.ConsumePauseOpts{Data: map[string]any{"id": 1}})
+		require.NoError(t, err)
+		require.True(t, res.DidConsume)
+
+		// The same data is idempotent; different data was consumed elsewhere.
+		res, err = svc.ConsumePause(ctx, pause, state.ConsumePauseOpts{Data: map[string]any{"id": 1}})
+		require.NoError(t, err)
+		require.True(t, res.DidConsume)
+
+		res, err = svc.ConsumePause(ctx, pause, state.ConsumePauseOpts{Data: map[string]any{"id": 2}})
+		require.NoError(t, err)
+		require.False(t, res.DidConsume)
+	})
+
+	t.Run("Migrate", func(t *testing.T) {
+		md := newMetadata()
+		md.Metrics = statev2.RunMetrics{StateSize: 10, EventSize: 5, StepCount: 1}
+
+		err := svc.Migrate(ctx, statev2.MigrateState{
+			Metadata:     md,
+			Events:       []json.RawMessage{evt},
+			Steps:        map[string]json.RawMessage{"a": json.RawMessage(`{"data":1}`)},
+			StepInputs:   map[string]json.RawMessage{"b": json.RawMessage(`{"in":true}`)},
+			Stack:        []string{"a"},
+			PendingSteps: []string{"b"},
+			Defers: map[string]statev2.Defer{
+				"hash": {FnSlug: "app-fn", HashedID: "hash", ScheduleStatus: enums.DeferStatusAfterRun, Input: json.RawMessage(`{}`)},
+			},
+		})
+		require.NoError(t, err)
+
+		loaded, err := svc.LoadState(ctx, md.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a"}, loaded.Metadata.Stack)
+		require.Equal(t, md.Metrics.StateSize, loaded.Metadata.Metrics.StateSize)
+		require.JSONEq(t, `{"data":1}`, string(loaded.Steps["a"]))
+		require.JSONEq(t, `{"input":{"in":true}}`, string(loaded.Steps["b"]))
+
+		pending, err := svc.LoadPending(ctx, md.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"b"}, pending)
+
+		defers, err := svc.LoadDefers(ctx, md.ID)
+		require.NoError(t, err)
+		require.Len(t, defers, 1)
+		require.Equal(t, "app-fn", defers["hash"].FnSlug)
+	})
+
+	t.Run("ClaimFinalization", func(t *testing.T) {
+		md := newMetadata()
+		claimant, ok := svc.(statev2.FinalizationClaimAdapter)
+		require.True(t, ok)
+
+		claim, err := claimant.ClaimFinalization(ctx, md)
+		require.NoError(t, err)
+		require.True(t, claim.Claimed())
+
+		second, err := claimant.ClaimFinalization(ctx, md)
+		require.NoError(t, err)
+		require.False(t, second.Claimed())
+
+		require.NoError(t, claim.Release(ctx))
+
+		third, err := claimant.ClaimFinalization(ctx, md)
+		require.NoError(t, err)
+		require.True(t, third.Claimed())
+	})
+}
+
+func TestPostgresPauseStore(t *testing.T) {
+	db := newPostgresTestDB(t)
+	ctx := context.Background()
+	ps := postgres_state.NewPauseStore(db)
+
+	newPause := func(wsID uuid.UUID, runID ulid.ULID, event string) state.Pause {
+		return state.Pause{
+			ID:          uuid.New(),
+			WorkspaceID: wsID,
+			Identifier: state.PauseIdentifier{
+				RunID:      runID,
+				FunctionID: uuid.New(),
+				AccountID:  uuid.New(),
+			},
+			Expires: state.Time(time.Now().Add(time.Hour)),
+			Event:   &event,
+		}
+	}
+
+	t.Run("Save, iterate and delete pauses for a run", func(t *testing.T) {
+		wsID := uuid.New()
+		runID := ulid.MustNew(ulid.Now(), rand.Reader)
+
+		first := newPause(wsID, runID, "test/event")
+		n, err := ps.SavePause(ctx, first)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+
+		_, err = ps.SavePause(ctx, first)
+		require.ErrorIs(t, err, state.ErrPauseAlreadyExists)
+
+		second := newPause(wsID, runID, "test/event")
+		n, err = ps.SavePause(ctx, second)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, n)
+
+		has, err := ps.EventHasPauses(ctx, wsID, "test/event")
+		require.NoError(t, err)
+		require.True(t, has)
+
+		it, err := ps.PausesByEventSince(ctx, wsID, "test/event", time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, 2, it.Count())
+		seen := map[uuid.UUID]bool{}
+		for it.Next(ctx) {
+			seen[it.Val(ctx).ID] = true
+		}
+		require.NoError(t, it.Error())
+		require.Equal(t, map[uuid.UUID]bool{first.ID: true, second.ID: true}, seen)
+
+		require.NoError(t, ps.DeletePausesForRun(ctx, runID, wsID))
+
+		_, err = ps.PauseByID(ctx, first.ID)
+		require.ErrorIs(t, err, state.ErrPauseNotFound)
+		ids, err := ps.PauseIDsForRun(ctx, runID)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+	})
+
+	t.Run("Signals conflict unless replaced", func(t *testing.T) {
+		wsID := uuid.New()
+		signal := uuid.NewString()
+
+		first := newPause(wsID, ulid.MustNew(ulid.Now(), rand.Reader), "")
+		first.Event = nil
+		first.SignalID = &signal
+		_, err := ps.SavePause(ctx, first)
+		require.NoError(t, err)
+
+		second := newPause(wsID, ulid.MustNew(ulid.Now(), rand.Reader), "")
+		second.Event = nil
+		second.SignalID = &signal
+		_, err = ps.SavePause(ctx, second)
+		require.ErrorIs(t, err, state.ErrSignalConflict)
+
+		second.ReplaceSignalOnConflict = true
+		_, err = ps.SavePause(ctx, second)
+		require.NoError(t, err)
+
+		found, err := ps.PauseBySignalID(ctx, wsID, signal)
+		require.NoError(t, err)
+		require.Equal(t, second.ID, found.ID)
+
+		// Deleting the replaced pause keeps the new signal.
+		require.NoError(t, ps.DeletePause(ctx, first))
+		found, err = ps.PauseBySignalID(ctx, wsID, signal)
+		require.NoError(t, err)
+		require.Equal(t, second.ID, found.ID)
+	})
+
+	t.Run("Invoke pauses are found by correlation ID", func(t *testing.T) {
+		wsID := uuid.New()
+		correlationID := uuid.NewString()
+
+		p := newPause(wsID, ulid.MustNew(ulid.Now(), rand.Reader), "inngest/function.finished")
+		p.InvokeCorrelationID = &correlationID
+		_, err := ps.SavePause(ctx, p)
+		require.NoError(t, err)
+
+		// Invoke pauses are not indexed by event name.
+		n, err := ps.PauseLen(ctx, wsID, "inngest/function.finished")
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		found, err := ps.PauseByInvokeCorrelationID(ctx, wsID, correlationID)
+		require.NoError(t, err)
+		require.Equal(t, p.ID, found.ID)
+
+		require.NoError(t, ps.DeletePauseByID(ctx, p.ID, wsID))
+		_, err = ps.PauseByInvokeCorrelationID(ctx, wsID, correlationID)
+		require.ErrorIs(t, err, state.ErrInvokePauseNotFound)
+	})
+}
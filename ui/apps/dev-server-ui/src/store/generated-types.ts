@@ -901,6 +901,7 @@ export type RunsFilterV2 = {
   functionIDs?: InputMaybe<Array<Scalars['UUID']>>;
   isDeferred?: InputMaybe<Scalars['Boolean']>;
   query?: InputMaybe<Scalars['String']>;
+  search?: InputMaybe<Scalars['String']>;
   status?: InputMaybe<Array<FunctionRunStatus>>;
   timeField?: InputMaybe<RunsV2OrderByField>;
   until?: InputMaybe<Scalars['Time']>;